@@ -131,9 +131,11 @@ type AwsSettings struct {
 }
 
 type InvalidConfigPolicy struct {
-	ReplaceInvalidRoutes     bool   `json:"replaceInvalidRoutes,omitempty" desc:"Rather than pausing configuration updates, in the event of an invalid Route defined on a virtual service or route table, Gloo will serve the route with a predefined direct response action. This allows valid routes to be updated when other routes are invalid."`
-	InvalidRouteResponseCode int64  `json:"invalidRouteResponseCode,omitempty" desc:"the response code for the direct response"`
-	InvalidRouteResponseBody string `json:"invalidRouteResponseBody,omitempty" desc:"the response body for the direct response"`
+	ReplaceInvalidRoutes             bool   `json:"replaceInvalidRoutes,omitempty" desc:"Rather than pausing configuration updates, in the event of an invalid Route defined on a virtual service or route table, Gloo will serve the route with a predefined direct response action. This allows valid routes to be updated when other routes are invalid."`
+	InvalidRouteResponseCode         int64  `json:"invalidRouteResponseCode,omitempty" desc:"the response code for the direct response"`
+	InvalidRouteResponseBody         string `json:"invalidRouteResponseBody,omitempty" desc:"the response body for the direct response"`
+	PruneInvalidWeightedDestinations bool   `json:"pruneInvalidWeightedDestinations,omitempty" desc:"when replacing invalid routes, only drop the missing destinations of multi-destination routes and re-normalize the weights of the remaining destinations"`
+	SanitizerDryRun                  bool   `json:"sanitizerDryRun,omitempty" desc:"report the changes Gloo would make when replacing invalid routes as warnings on the Proxy status, without applying them"`
 }
 
 type Gloo struct {
@@ -173,14 +175,21 @@ type DiscoveryDeployment struct {
 }
 
 type Gateway struct {
-	Enabled                       *bool              `json:"enabled" desc:"enable Gloo API Gateway features"`
-	Validation                    *GatewayValidation `json:"validation" desc:"enable Validation Webhook on the Gateway. This will cause requests to modify Gateway-related Custom Resources to be validated by the Gateway."`
-	Deployment                    *GatewayDeployment `json:"deployment,omitempty"`
-	CertGenJob                    *CertGenJob        `json:"certGenJob,omitempty" desc:"generate self-signed certs with this job to be used with the gateway validation webhook. this job will only run if validation is enabled for the gateway"`
-	UpdateValues                  bool               `json:"updateValues" desc:"if true, will use a provided helm helper 'gloo.updatevalues' to update values during template render - useful for plugins/extensions"`
-	ProxyServiceAccount           ServiceAccount     `json:"proxyServiceAccount" `
-	ServiceAccount                ServiceAccount     `json:"serviceAccount" `
-	ReadGatewaysFromAllNamespaces bool               `json:"readGatewaysFromAllNamespaces" desc:"if true, read Gateway custom resources from all watched namespaces rather than just the namespace of the Gateway controller"`
+	Enabled                       *bool               `json:"enabled" desc:"enable Gloo API Gateway features"`
+	Validation                    *GatewayValidation  `json:"validation" desc:"enable Validation Webhook on the Gateway. This will cause requests to modify Gateway-related Custom Resources to be validated by the Gateway."`
+	Deployment                    *GatewayDeployment  `json:"deployment,omitempty"`
+	CertGenJob                    *CertGenJob         `json:"certGenJob,omitempty" desc:"generate self-signed certs with this job to be used with the gateway validation webhook. this job will only run if validation is enabled for the gateway"`
+	UpdateValues                  bool                `json:"updateValues" desc:"if true, will use a provided helm helper 'gloo.updatevalues' to update values during template render - useful for plugins/extensions"`
+	ProxyServiceAccount           ServiceAccount      `json:"proxyServiceAccount" `
+	ServiceAccount                ServiceAccount      `json:"serviceAccount" `
+	ReadGatewaysFromAllNamespaces bool                `json:"readGatewaysFromAllNamespaces" desc:"if true, read Gateway custom resources from all watched namespaces rather than just the namespace of the Gateway controller"`
+	NamespacedGateways            *NamespacedGateways `json:"namespacedGateways,omitempty" desc:"self-service gateway-per-namespace mode, where the Gateways outside the install namespace get a proxy of their own"`
+}
+
+type NamespacedGateways struct {
+	Enabled              bool   `json:"enabled" desc:"if true, the Gateways outside the install namespace are translated to a Proxy in their own namespace"`
+	ProvisionDeployments bool   `json:"provisionDeployments" desc:"if true, the Gateway controller provisions an Envoy Deployment and Service for each of these Proxies. Requires cluster-scoped RBAC"`
+	ServiceType          string `json:"serviceType,omitempty" desc:"type of the provisioned Services. Defaults to LoadBalancer"`
 }
 
 type ServiceAccount struct {
@@ -192,13 +201,15 @@ type GatewayValidation struct {
 	Enabled               bool     `json:"enabled" desc:"enable Gloo API Gateway validation hook (default true)"`
 	AlwaysAcceptResources bool     `json:"alwaysAcceptResources" desc:"unless this is set this to false in order to ensure validation webhook rejects invalid resources. by default, validation webhook will only log and report metrics for invalid resource admission without rejecting them outright."`
 	AllowWarnings         bool     `json:"allowWarnings" desc:"set this to false in order to ensure validation webhook rejects resources that would have warning status or rejected status, rather than just rejected."`
+	Strict                string   `json:"strict,omitempty" desc:"strict validation of the admitted resources with a full translation of their Proxies: DISABLED (default), WARN to only log the errors, or REJECT to reject the resources on any error. See the gateway.validation.strict field of the Settings."`
 	SecretName            string   `json:"secretName" desc:"Name of the Kubernetes Secret containing TLS certificates used by the validation webhook server. This secret will be created by the certGen Job if the certGen Job is enabled."`
 	FailurePolicy         string   `json:"failurePolicy" desc:"failurePolicy defines how unrecognized errors from the Gateway validation endpoint are handled - allowed values are 'Ignore' or 'Fail'. Defaults to Ignore "`
 	Webhook               *Webhook `json:"webhook" desc:"webhook specific configuration"`
 }
 
 type Webhook struct {
-	Enabled bool `json:"enabled" desc:"enable validation webhook (default true)"`
+	Enabled              bool              `json:"enabled" desc:"enable validation webhook (default true)"`
+	DeletionObjectLabels map[string]string `json:"deletionObjectLabels,omitempty" desc:"only validate the deletion of the upstreams and secrets with these labels. The deletions are only validated in the namespaces watched by Gloo, on clusters labelling the namespaces with kubernetes.io/metadata.name."`
 }
 
 type GatewayDeployment struct {
@@ -222,6 +233,7 @@ type CertGenJob struct {
 	TtlSecondsAfterFinished int     `json:"ttlSecondsAfterFinished" desc:"Clean up the finished job after this many seconds. Defaults to 60"`
 	FloatingUserId          bool    `json:"floatingUserId" desc:"set to true to allow the cluster to dynamically assign a user ID"`
 	RunAsUser               float64 `json:"runAsUser" desc:"Explicitly set the user ID for the container to run as. Default is 10101"`
+	RenewBefore             string  `json:"renewBefore,omitempty" desc:"if set, e.g. to 720h, the job also runs on upgrades, and only replaces the existing certificates when they expire within this duration"`
 }
 
 type GatewayProxy struct {
@@ -244,6 +256,18 @@ type GatewayProxy struct {
 	LoopBackAddress                string                       `json:"loopBackAddress,omitempty" desc:"Name on which to bind the loop-back interface for this instance of Envoy. Defaults to 127.0.0.1, but other common values may be localhost or ::1"`
 	Failover                       Failover                     `json:"failover" desc:"(Enterprise Only): Failover configuration"`
 	Disabled                       bool                         `json:"disabled,omitempty" desc:"Skips creation of this gateway proxy. Used to turn off gateway proxies created by preceding configurations"`
+	IncrementalXds                 bool                         `json:"incrementalXds,omitempty" desc:"use the incremental (delta) variant of xDS, so envoy is only sent the resources which changed instead of all of them on every update"`
+	RateLimitEnforcingPercentage   *uint32                      `json:"rateLimitEnforcingPercentage,omitempty" desc:"percentage of requests for which envoy enforces the decision of the rate limit server. Set to 0 to run the rate limits in shadow mode, where the requests over the limit are only counted in the ratelimit.over_limit stats. Defaults to 100"`
+	OverloadManager                *OverloadManager             `json:"overloadManager,omitempty" desc:"protect envoy from running out of memory, by shedding load as its heap grows"`
+}
+
+type OverloadManager struct {
+	Enabled                           bool     `json:"enabled" desc:"enable the overload manager"`
+	MaxHeapSizeBytes                  uint64   `json:"maxHeapSizeBytes" desc:"the heap size at which envoy is fully saturated. Set it below the memory limit of the gateway proxy container"`
+	RefreshInterval                   string   `json:"refreshInterval,omitempty" desc:"how often envoy measures its heap size. Defaults to 1s"`
+	ShrinkHeapThreshold               *float32 `json:"shrinkHeapThreshold,omitempty" desc:"fraction of maxHeapSizeBytes above which envoy periodically returns free memory to the system. Defaults to 0.9"`
+	StopAcceptingRequestsThreshold    *float32 `json:"stopAcceptingRequestsThreshold,omitempty" desc:"fraction of maxHeapSizeBytes above which envoy responds to new requests with a 503. Defaults to 0.95"`
+	StopAcceptingConnectionsThreshold *float32 `json:"stopAcceptingConnectionsThreshold,omitempty" desc:"fraction of maxHeapSizeBytes above which envoy stops accepting new connections. Defaults to 0.98"`
 }
 
 type GatewayProxyGatewaySettings struct {
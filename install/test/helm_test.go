@@ -1646,6 +1646,50 @@ spec:
 						testManifest.ExpectUnstructured(settings.GetKind(), settings.GetNamespace(), settings.GetName()).To(BeEquivalentTo(settings))
 					})
 
+					It("correctly sets the strict validation mode in the settings", func() {
+						settings := makeUnstructured(`
+apiVersion: gloo.solo.io/v1
+kind: Settings
+metadata:
+  labels:
+    app: gloo
+  name: default
+  namespace: ` + namespace + `
+spec:
+ discovery:
+   fdsMode: WHITELIST
+ gateway:
+   readGatewaysFromAllNamespaces: false
+   validation:
+     alwaysAccept: true
+     allowWarnings: true
+     strict: REJECT
+     proxyValidationServerAddr: gloo:9988
+ gloo:
+   xdsBindAddr: 0.0.0.0:9977
+   restXdsBindAddr: 0.0.0.0:9976
+   disableKubernetesDestinations: false
+   disableProxyGarbageCollection: false
+   invalidConfigPolicy:
+     invalidRouteResponseBody: Gloo Gateway has invalid configuration. Administrators should run
+       ` + "`" + `glooctl check` + "`" + ` to find and fix config errors.
+     invalidRouteResponseCode: 404
+
+ kubernetesArtifactSource: {}
+ kubernetesConfigSource: {}
+ kubernetesSecretSource: {}
+ refreshRate: 60s
+ discoveryNamespace: ` + namespace + `
+`)
+
+						prepareMakefile(namespace, helmValues{
+							valuesArgs: []string{
+								"gateway.validation.strict=REJECT",
+							},
+						})
+						testManifest.ExpectUnstructured(settings.GetKind(), settings.GetNamespace(), settings.GetName()).To(BeEquivalentTo(settings))
+					})
+
 					It("correctly allows setting readGatewaysFromAllNamespaces field in the settings when validation disabled", func() {
 						settings := makeUnstructured(`
 apiVersion: gloo.solo.io/v1
@@ -1686,6 +1730,54 @@ spec:
 						testManifest.ExpectUnstructured(settings.GetKind(), settings.GetNamespace(), settings.GetName()).To(BeEquivalentTo(settings))
 					})
 
+					It("correctly sets the namespacedGateways field in the settings", func() {
+						settings := makeUnstructured(`
+apiVersion: gloo.solo.io/v1
+kind: Settings
+metadata:
+  labels:
+    app: gloo
+  name: default
+  namespace: ` + namespace + `
+spec:
+ discovery:
+   fdsMode: WHITELIST
+ gateway:
+   readGatewaysFromAllNamespaces: false
+   namespacedGateways:
+     enabled: true
+     provisionDeployments: true
+     proxyImage: quay.io/solo-io/gloo-envoy-wrapper:` + version + `
+     xdsAddress: gloo.` + namespace + `.svc.cluster.local:9977
+     serviceType: NodePort
+ gloo:
+   xdsBindAddr: 0.0.0.0:9977
+   restXdsBindAddr: 0.0.0.0:9976
+   disableKubernetesDestinations: false
+   disableProxyGarbageCollection: false
+   invalidConfigPolicy:
+     invalidRouteResponseBody: Gloo Gateway has invalid configuration. Administrators should run
+       ` + "`" + `glooctl check` + "`" + ` to find and fix config errors.
+     invalidRouteResponseCode: 404
+
+ kubernetesArtifactSource: {}
+ kubernetesConfigSource: {}
+ kubernetesSecretSource: {}
+ refreshRate: 60s
+ discoveryNamespace: ` + namespace + `
+`)
+
+						prepareMakefile(namespace, helmValues{
+							valuesArgs: []string{
+								"gateway.validation.enabled=false",
+								"gateway.namespacedGateways.enabled=true",
+								"gateway.namespacedGateways.provisionDeployments=true",
+								"gateway.namespacedGateways.serviceType=NodePort",
+							},
+						})
+						testManifest.ExpectUnstructured(settings.GetKind(), settings.GetNamespace(), settings.GetName()).To(BeEquivalentTo(settings))
+					})
+
 					It("correctly allows setting ratelimit descriptors in the rateLimit field.", func() {
 						settings := makeUnstructured(`
 apiVersion: gloo.solo.io/v1
@@ -1903,12 +1995,97 @@ webhooks:
        resources: ["*"]
    sideEffects: None
    failurePolicy: Ignore
+ - name: deletion.gateway.` + namespace + `.svc
+   clientConfig:
+     service:
+       name: gateway
+       namespace: ` + namespace + `
+       path: "/validation"
+     caBundle: ""
+   rules:
+     - operations: [ "DELETE" ]
+       apiGroups: ["gloo.solo.io"]
+       apiVersions: ["v1"]
+       resources: ["upstreams"]
+     - operations: [ "DELETE" ]
+       apiGroups: [""]
+       apiVersions: ["v1"]
+       resources: ["secrets"]
+   sideEffects: None
+   failurePolicy: Ignore
 
 `)
 						prepareMakefile(namespace, helmValues{})
 						testManifest.ExpectUnstructured(vwc.GetKind(), vwc.GetNamespace(), vwc.GetName()).To(BeEquivalentTo(vwc))
 					})
 
+					It("scopes the validation of deletions to the watched namespaces and the deletion object labels", func() {
+						vwc := makeUnstructured(`
+
+apiVersion: admissionregistration.k8s.io/v1beta1
+kind: ValidatingWebhookConfiguration
+metadata:
+  name: gloo-gateway-validation-webhook-` + namespace + `
+  labels:
+    app: gloo
+    gloo: gateway
+  annotations:
+    "helm.sh/hook": pre-install
+    "helm.sh/hook-weight": "5" # should come before cert-gen job
+webhooks:
+ - name: gateway.` + namespace + `.svc  # must be a domain with at least three segments separated by dots
+   clientConfig:
+     service:
+       name: gateway
+       namespace: ` + namespace + `
+       path: "/validation"
+     caBundle: "" # update manually or use certgen job
+   rules:
+     - operations: [ "CREATE", "UPDATE", "DELETE" ]
+       apiGroups: ["gateway.solo.io"]
+       apiVersions: ["v1"]
+       resources: ["*"]
+   sideEffects: None
+   failurePolicy: Ignore
+ - name: deletion.gateway.` + namespace + `.svc
+   clientConfig:
+     service:
+       name: gateway
+       namespace: ` + namespace + `
+       path: "/validation"
+     caBundle: ""
+   rules:
+     - operations: [ "DELETE" ]
+       apiGroups: ["gloo.solo.io"]
+       apiVersions: ["v1"]
+       resources: ["upstreams"]
+     - operations: [ "DELETE" ]
+       apiGroups: [""]
+       apiVersions: ["v1"]
+       resources: ["secrets"]
+   namespaceSelector:
+     matchExpressions:
+     - key: kubernetes.io/metadata.name
+       operator: In
+       values:
+       - ns1
+       - ns2
+   objectSelector:
+     matchLabels:
+       validate: deletion
+   sideEffects: None
+   failurePolicy: Ignore
+
+`)
+						prepareMakefile(namespace, helmValues{
+							valuesArgs: []string{
+								"settings.watchNamespaces={ns1,ns2}",
+								"gateway.validation.webhook.deletionObjectLabels.validate=deletion",
+							},
+						})
+						testManifest.ExpectUnstructured(vwc.GetKind(), vwc.GetNamespace(), vwc.GetName()).To(BeEquivalentTo(vwc))
+					})
+
 					It("adds the validation port and mounts the certgen secret to the gateway deployment", func() {
 
 						gwDeployment := makeUnstructured(`
@@ -2754,6 +2931,73 @@ metadata:
 						testManifest.ExpectConfigMapWithYamlData(proxy)
 					})
 				})
+				Describe("gateway proxy - rate limit enforcement", func() {
+					envoyConfig := func() string {
+						var data string
+						testManifest.SelectResources(func(resource *unstructured.Unstructured) bool {
+							return resource.GetKind() == "ConfigMap" && resource.GetName() == gatewayProxyConfigMapName
+						}).ExpectAll(func(configMap *unstructured.Unstructured) {
+							configMapObject, err := kuberesource.ConvertUnstructured(configMap)
+							Expect(err).NotTo(HaveOccurred())
+							data = configMapObject.(*v1.ConfigMap).Data["envoy.yaml"]
+						})
+						return data
+					}
+
+					It("enforces the rate limits by default", func() {
+						prepareMakefile(namespace, helmValues{})
+						Expect(envoyConfig()).NotTo(ContainSubstring("http_filter_enforcing"))
+					})
+
+					It("sets the percentage of requests for which rate limits are enforced", func() {
+						prepareMakefile(namespace, helmValues{
+							valuesArgs: []string{"gatewayProxies.gatewayProxy.rateLimitEnforcingPercentage=0"},
+						})
+						Expect(envoyConfig()).To(ContainSubstring("      ratelimit:\n        http_filter_enforcing: 0\n"))
+					})
+				})
+				Describe("gateway proxy - overload manager", func() {
+					envoyConfig := func() string {
+						var data string
+						testManifest.SelectResources(func(resource *unstructured.Unstructured) bool {
+							return resource.GetKind() == "ConfigMap" && resource.GetName() == gatewayProxyConfigMapName
+						}).ExpectAll(func(configMap *unstructured.Unstructured) {
+							configMapObject, err := kuberesource.ConvertUnstructured(configMap)
+							Expect(err).NotTo(HaveOccurred())
+							data = configMapObject.(*v1.ConfigMap).Data["envoy.yaml"]
+						})
+						return data
+					}
+
+					It("is disabled by default", func() {
+						prepareMakefile(namespace, helmValues{})
+						Expect(envoyConfig()).NotTo(ContainSubstring("overload_manager"))
+					})
+
+					It("sheds load as the heap approaches its maximum size", func() {
+						prepareMakefile(namespace, helmValues{
+							valuesArgs: []string{
+								"gatewayProxies.gatewayProxy.overloadManager.enabled=true",
+								"gatewayProxies.gatewayProxy.overloadManager.maxHeapSizeBytes=2147483648",
+								"gatewayProxies.gatewayProxy.overloadManager.stopAcceptingRequestsThreshold=0.9",
+							},
+						})
+						config := envoyConfig()
+						Expect(config).To(ContainSubstring("overload_manager:\n  refresh_interval: 1s\n"))
+						Expect(config).To(ContainSubstring("      max_heap_size_bytes: 2147483648\n"))
+						Expect(config).To(ContainSubstring("  - name: envoy.overload_actions.shrink_heap\n" +
+							"    triggers:\n" +
+							"    - name: envoy.resource_monitors.fixed_heap\n" +
+							"      threshold:\n" +
+							"        value: 0.9\n"))
+						Expect(config).To(ContainSubstring("  - name: envoy.overload_actions.stop_accepting_requests\n" +
+							"    triggers:\n" +
+							"    - name: envoy.resource_monitors.fixed_heap\n" +
+							"      threshold:\n" +
+							"        value: 0.9\n"))
+						Expect(config).To(ContainSubstring("        value: 0.98\n"))
+					})
+				})
 				Describe("supports multiple gateway proxy config maps", func() {
 					It("can parse multiple config maps", func() {
 						prepareMakefile(namespace, helmValues{
@@ -85,6 +85,16 @@ var _ = Describe("RBAC Test", func() {
 								Resources: []string{"pods", "services", "secrets", "endpoints", "configmaps", "namespaces"},
 								Verbs:     []string{"get", "list", "watch"},
 							},
+							{
+								APIGroups: []string{"discovery.k8s.io"},
+								Resources: []string{"endpointslices"},
+								Verbs:     []string{"get", "list", "watch"},
+							},
+							{
+								APIGroups: []string{""},
+								Resources: []string{"nodes"},
+								Verbs:     []string{"get", "list", "watch"},
+							},
 						},
 						RoleRef: rbacv1.RoleRef{
 							APIGroup: "rbac.authorization.k8s.io",
@@ -217,7 +227,12 @@ var _ = Describe("RBAC Test", func() {
 							{
 								APIGroups: []string{""},
 								Resources: []string{"configmaps"},
-								Verbs:     []string{"get", "update"},
+								Verbs:     []string{"get", "update", "create"},
+							},
+							{
+								APIGroups: []string{""},
+								Resources: []string{"events"},
+								Verbs:     []string{"create", "patch"},
 							},
 						},
 						RoleRef: rbacv1.RoleRef{
@@ -393,6 +408,66 @@ var _ = Describe("RBAC Test", func() {
 				})
 			})
 
+			Context("gateway-proxy-provisioner", func() {
+				BeforeEach(func() {
+					resourceBuilder = ResourceBuilder{
+						Name: "gateway-proxy-provisioner",
+						Labels: map[string]string{
+							"app":  "gloo",
+							"gloo": "rbac",
+						},
+						Rules: []rbacv1.PolicyRule{
+							{
+								APIGroups: []string{"apps"},
+								Resources: []string{"deployments"},
+								Verbs:     []string{"get", "list", "watch", "create", "update", "delete"},
+							}, {
+								APIGroups: []string{""},
+								Resources: []string{"services", "configmaps"},
+								Verbs:     []string{"get", "list", "watch", "create", "update", "delete"},
+							},
+						},
+						RoleRef: rbacv1.RoleRef{
+							APIGroup: "rbac.authorization.k8s.io",
+							Kind:     "ClusterRole",
+							Name:     "gateway-proxy-provisioner",
+						},
+						Subjects: []rbacv1.Subject{{
+							Kind:      "ServiceAccount",
+							Name:      "gateway",
+							Namespace: namespace,
+						}},
+					}
+				})
+				Context("cluster scope", func() {
+					It("role", func() {
+						resourceBuilder.Name += "-" + namespace
+						prepareMakefile("global.glooRbac.namespaced=false", "gateway.namespacedGateways.enabled=true", "gateway.namespacedGateways.provisionDeployments=true")
+						testManifest.ExpectClusterRole(resourceBuilder.GetClusterRole())
+					})
+
+					It("role binding", func() {
+						resourceBuilder.Name += "-binding-" + namespace
+						resourceBuilder.RoleRef.Name += "-" + namespace
+						prepareMakefile("global.glooRbac.namespaced=false", "gateway.namespacedGateways.enabled=true", "gateway.namespacedGateways.provisionDeployments=true")
+						testManifest.ExpectClusterRoleBinding(resourceBuilder.GetClusterRoleBinding())
+					})
+
+					It("is not created without provisioning", func() {
+						prepareMakefile("global.glooRbac.namespaced=false", "gateway.namespacedGateways.enabled=true")
+						testManifest.Expect("ClusterRole", "", resourceBuilder.Name+"-"+namespace).To(BeNil())
+					})
+				})
+				Context("namespace scope", func() {
+					It("is not created, as namespaced RBAC does not allow provisioning in other namespaces", func() {
+						prepareMakefile("global.glooRbac.namespaced=true", "gateway.namespacedGateways.enabled=true", "gateway.namespacedGateways.provisionDeployments=true")
+						testManifest.Expect("Role", namespace, resourceBuilder.Name).To(BeNil())
+						testManifest.Expect("RoleBinding", namespace, resourceBuilder.Name+"-binding").To(BeNil())
+						testManifest.Expect("ClusterRole", "", resourceBuilder.Name+"-"+namespace).To(BeNil())
+					})
+				})
+			})
+
 			Context("gateway-resource-reader", func() {
 				BeforeEach(func() {
 					resourceBuilder = ResourceBuilder{
@@ -404,12 +479,16 @@ var _ = Describe("RBAC Test", func() {
 						Rules: []rbacv1.PolicyRule{
 							{
 								APIGroups: []string{"gateway.solo.io"},
-								Resources: []string{"virtualservices", "routetables"},
+								Resources: []string{"virtualservices", "routetables", "virtualhostoptions", "routeoptions"},
 								Verbs:     []string{"get", "list", "watch", "update"},
 							}, {
 								APIGroups: []string{"gateway.solo.io"},
 								Resources: []string{"gateways"},
 								Verbs:     []string{"get", "list", "watch", "create", "update"},
+							}, {
+								APIGroups: []string{"gloo.solo.io"},
+								Resources: []string{"upstreamgroups"},
+								Verbs:     []string{"get", "list", "watch"},
 							},
 						},
 						RoleRef: rbacv1.RoleRef{
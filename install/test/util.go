@@ -28,8 +28,14 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		"gloo-system.gateway",
 		namespace,
 		[]string{"gateway.solo.io"},
-		[]string{"virtualservices", "routetables"},
+		[]string{"virtualservices", "routetables", "virtualhostoptions", "routeoptions"},
 		[]string{"get", "list", "watch", "update"})
+	permissions.AddExpectedPermission(
+		"gloo-system.gateway",
+		namespace,
+		[]string{"gloo.solo.io"},
+		[]string{"upstreamgroups"},
+		[]string{"get", "list", "watch"})
 
 	// Gloo
 	permissions.AddExpectedPermission(
@@ -38,13 +44,31 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		[]string{""},
 		[]string{"pods", "services", "configmaps", "namespaces", "secrets", "endpoints"},
 		[]string{"get", "list", "watch"})
+	permissions.AddExpectedPermission(
+		"gloo-system.gloo",
+		namespace,
+		[]string{"discovery.k8s.io"},
+		[]string{"endpointslices"},
+		[]string{"get", "list", "watch"})
+	permissions.AddExpectedPermission(
+		"gloo-system.gloo",
+		namespace,
+		[]string{""},
+		[]string{"nodes"},
+		[]string{"get", "list", "watch"})
 	permissions.AddExpectedPermission(
 		"gloo-system.gloo",
 		namespace,
 		[]string{""},
 		[]string{"configmaps"},
-		[]string{"get", "update"},
+		[]string{"get", "update", "create"},
 	)
+	permissions.AddExpectedPermission(
+		"gloo-system.gloo",
+		namespace,
+		[]string{""},
+		[]string{"events"},
+		[]string{"create", "patch"})
 	permissions.AddExpectedPermission(
 		"gloo-system.gloo",
 		namespace,
@@ -71,6 +95,18 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		[]string{""},
 		[]string{"pods", "services", "configmaps", "namespaces", "secrets", "endpoints"},
 		[]string{"get", "list", "watch"})
+	permissions.AddExpectedPermission(
+		"gloo-system.discovery",
+		namespace,
+		[]string{"discovery.k8s.io"},
+		[]string{"endpointslices"},
+		[]string{"get", "list", "watch"})
+	permissions.AddExpectedPermission(
+		"gloo-system.discovery",
+		namespace,
+		[]string{""},
+		[]string{"nodes"},
+		[]string{"get", "list", "watch"})
 	permissions.AddExpectedPermission(
 		"gloo-system.discovery",
 		namespace,
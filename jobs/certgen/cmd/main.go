@@ -28,7 +28,8 @@ func cmd(ctx context.Context) *cobra.Command {
 		Aliases: constants.PROXY_COMMAND.Aliases,
 		Short:   "generate kube secrets with self-signed certs.",
 		Long: "generate kube secrets with self-signed certs. " +
-			"certgen can also patch admission webhook configurations with the matching ca bundle for the generated certs..",
+			"certgen can also patch admission webhook configurations with the matching ca bundle for the generated certs, " +
+			"and keep running to rotate the certs before they expire.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return run.Run(ctx, opts)
 		},
@@ -55,5 +56,10 @@ func cmd(ctx context.Context) *cobra.Command {
 	pFlags.StringVar(&opts.ValidatingWebhookConfigurationName, "validating-webhook-configuration-name", "",
 		"name of the ValidatingWebhookConfiguration to patch with the generated CA bundle. leave empty to skip this step.")
 
+	pFlags.DurationVar(&opts.RenewBefore, "renew-before", 0,
+		"keep the existing certs unless they expire within this duration. leave empty to always generate new certs.")
+	pFlags.DurationVar(&opts.RotationCheckInterval, "rotation-check-interval", 0,
+		"keep running and check the certs at this interval, rotating them ahead of expiry. requires renew-before. leave empty to run once.")
+
 	return cmd
 }
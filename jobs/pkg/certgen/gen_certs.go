@@ -2,7 +2,9 @@ package certgen
 
 import (
 	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"time"
 
 	"github.com/solo-io/go-utils/certutils"
 	"k8s.io/client-go/util/cert"
@@ -24,3 +26,17 @@ func GenCerts(svcName, svcNamespace string) (*certutils.Certificates, error) {
 		Usages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
 	})
 }
+
+// ExpiresWithin returns true if the first certificate in the PEM-encoded chain
+// expires within the given duration, or if it cannot be parsed at all
+func ExpiresWithin(certChain []byte, d time.Duration) bool {
+	block, _ := pem.Decode(certChain)
+	if block == nil {
+		return true
+	}
+	serverCert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return true
+	}
+	return time.Now().Add(d).After(serverCert.NotAfter)
+}
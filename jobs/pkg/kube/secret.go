@@ -47,6 +47,18 @@ func CreateTlsSecret(ctx context.Context, kube kubernetes.Interface, secretCfg T
 	return nil
 }
 
+// GetTlsSecret returns the existing secret, or nil if it does not exist yet
+func GetTlsSecret(ctx context.Context, kube kubernetes.Interface, secretName, secretNamespace string) (*v1.Secret, error) {
+	secret, err := kube.CoreV1().Secrets(secretNamespace).Get(secretName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to retrieve existing secret")
+	}
+	return secret, nil
+}
+
 func makeTlsSecret(args TlsSecret) *v1.Secret {
 	return &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
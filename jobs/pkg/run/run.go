@@ -2,12 +2,15 @@ package run
 
 import (
 	"context"
+	"time"
 
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/jobs/pkg/certgen"
 	"github.com/solo-io/gloo/jobs/pkg/kube"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
 	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
+	"k8s.io/client-go/kubernetes"
 )
 
 type Options struct {
@@ -22,6 +25,11 @@ type Options struct {
 	ServerKeySecretFileName     string
 
 	ValidatingWebhookConfigurationName string
+
+	// if set, the existing certs are kept unless they expire within this duration
+	RenewBefore time.Duration
+	// if set, certgen keeps running and checks the certs at this interval
+	RotationCheckInterval time.Duration
 }
 
 func Run(ctx context.Context, opts Options) error {
@@ -46,45 +54,91 @@ func Run(ctx context.Context, opts Options) error {
 	if opts.ServerKeySecretFileName == "" {
 		return eris.Errorf("must provide name for the server key entry in the secret data")
 	}
-	certs, err := certgen.GenCerts(opts.SvcName, opts.SvcNamespace)
-	if err != nil {
-		return eris.Wrapf(err, "generating self-signed certs and key")
+	if opts.RotationCheckInterval > 0 && opts.RenewBefore <= 0 {
+		return eris.Errorf("must provide renew-before when running with a rotation-check-interval")
 	}
 	kubeClient := helpers.MustKubeClient()
 
-	caCert := append(certs.ServerCertificate, certs.CaCertificate...)
-	secretConfig := kube.TlsSecret{
-		SecretName:         opts.SecretName,
-		SecretNamespace:    opts.SecretNamespace,
-		PrivateKeyFileName: opts.ServerKeySecretFileName,
-		CertFileName:       opts.ServerCertSecretFileName,
-		CaBundleFileName:   opts.ServerCertAuthorityFileName,
-		PrivateKey:         certs.ServerCertKey,
-		Cert:               caCert,
-		CaBundle:           certs.CaCertificate,
+	if opts.RotationCheckInterval <= 0 {
+		if err := RotateCerts(ctx, kubeClient, opts); err != nil {
+			return err
+		}
+		contextutils.LoggerFrom(ctx).Infof("finished successfully.")
+		return nil
+	}
+
+	ticker := time.NewTicker(opts.RotationCheckInterval)
+	defer ticker.Stop()
+	for {
+		// errors are retried at the next check, the current certs are valid until they expire
+		if err := RotateCerts(ctx, kubeClient, opts); err != nil {
+			contextutils.LoggerFrom(ctx).Errorw("failed rotating certs", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// RotateCerts generates new certs unless the secret already holds certs that are valid
+// for longer than opts.RenewBefore, then patches the ValidatingWebhookConfiguration with
+// the CA bundle of the certs in the secret.
+func RotateCerts(ctx context.Context, kubeClient kubernetes.Interface, opts Options) error {
+	existing, err := kube.GetTlsSecret(ctx, kubeClient, opts.SecretName, opts.SecretNamespace)
+	if err != nil {
+		return err
 	}
 
-	if err := kube.CreateTlsSecret(ctx, kubeClient, secretConfig); err != nil {
-		return eris.Wrapf(err, "failed creating secret")
+	var caBundle []byte
+	if opts.RenewBefore > 0 && existing != nil && !certgen.ExpiresWithin(existing.Data[opts.ServerCertSecretFileName], opts.RenewBefore) {
+		contextutils.LoggerFrom(ctx).Infow("existing certs are still valid, skipping generation", zap.String("secret", opts.SecretName))
+		caBundle = existing.Data[opts.ServerCertAuthorityFileName]
+	} else {
+		certs, err := certgen.GenCerts(opts.SvcName, opts.SvcNamespace)
+		if err != nil {
+			return eris.Wrapf(err, "generating self-signed certs and key")
+		}
+
+		caCert := append(certs.ServerCertificate, certs.CaCertificate...)
+		secretConfig := kube.TlsSecret{
+			SecretName:         opts.SecretName,
+			SecretNamespace:    opts.SecretNamespace,
+			PrivateKeyFileName: opts.ServerKeySecretFileName,
+			CertFileName:       opts.ServerCertSecretFileName,
+			CaBundleFileName:   opts.ServerCertAuthorityFileName,
+			PrivateKey:         certs.ServerCertKey,
+			Cert:               caCert,
+			CaBundle:           certs.CaCertificate,
+		}
+
+		if err := kube.CreateTlsSecret(ctx, kubeClient, secretConfig); err != nil {
+			return eris.Wrapf(err, "failed creating secret")
+		}
+		caBundle = certs.CaCertificate
+		if existing != nil {
+			// keep trusting the old CA until the webhook server has reloaded the new cert,
+			// it is dropped from the bundle at the next check
+			caBundle = append(append([]byte{}, certs.CaCertificate...), existing.Data[opts.ServerCertAuthorityFileName]...)
+		}
 	}
 
 	vwcName := opts.ValidatingWebhookConfigurationName
 	if vwcName == "" {
-		contextutils.LoggerFrom(ctx).Infof("no ValidatingWebhookConfiguration provided. finished successfully.")
+		contextutils.LoggerFrom(ctx).Infof("no ValidatingWebhookConfiguration provided, skipping patch.")
 		return nil
 	}
 
 	vwcConfig := kube.WebhookTlsConfig{
 		ServiceName:      opts.SvcName,
 		ServiceNamespace: opts.SvcNamespace,
-		CaBundle:         certs.CaCertificate,
+		CaBundle:         caBundle,
 	}
 
 	if err := kube.UpdateValidatingWebhookConfigurationCaBundle(ctx, kubeClient, vwcName, vwcConfig); err != nil {
 		return eris.Wrapf(err, "failed patching validating webhook config")
 	}
 
-	contextutils.LoggerFrom(ctx).Infof("finished successfully.")
-
 	return nil
 }
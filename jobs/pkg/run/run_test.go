@@ -0,0 +1,90 @@
+package run_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/api/admissionregistration/v1beta1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/solo-io/gloo/jobs/pkg/run"
+)
+
+var _ = Describe("RotateCerts", func() {
+
+	var (
+		kube kubernetes.Interface
+		opts Options
+	)
+
+	getSecret := func() *v1.Secret {
+		secret, err := kube.CoreV1().Secrets("gloo-system").Get("gateway-validation-certs", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return secret
+	}
+
+	getCaBundle := func() []byte {
+		vwc, err := kube.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Get("myvwc", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return vwc.Webhooks[0].ClientConfig.CABundle
+	}
+
+	BeforeEach(func() {
+		kube = fake.NewSimpleClientset(&v1beta1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "myvwc"},
+			Webhooks: []v1beta1.ValidatingWebhook{{
+				Name: "gateway.gloo-system.svc",
+				ClientConfig: v1beta1.WebhookClientConfig{
+					Service: &v1beta1.ServiceReference{Name: "gateway", Namespace: "gloo-system"},
+				},
+			}},
+		})
+		opts = Options{
+			SvcName:                            "gateway",
+			SvcNamespace:                       "gloo-system",
+			SecretName:                         "gateway-validation-certs",
+			SecretNamespace:                    "gloo-system",
+			ServerCertSecretFileName:           v1.TLSCertKey,
+			ServerCertAuthorityFileName:        v1.ServiceAccountRootCAKey,
+			ServerKeySecretFileName:            v1.TLSPrivateKeyKey,
+			ValidatingWebhookConfigurationName: "myvwc",
+			RenewBefore:                        24 * time.Hour,
+		}
+	})
+
+	It("creates the secret and patches the ca bundle", func() {
+		Expect(RotateCerts(context.TODO(), kube, opts)).To(Succeed())
+		secret := getSecret()
+		Expect(secret.Data[v1.TLSPrivateKeyKey]).NotTo(BeEmpty())
+		Expect(getCaBundle()).To(Equal(secret.Data[v1.ServiceAccountRootCAKey]))
+	})
+
+	It("keeps certs that are not about to expire", func() {
+		Expect(RotateCerts(context.TODO(), kube, opts)).To(Succeed())
+		original := getSecret()
+
+		Expect(RotateCerts(context.TODO(), kube, opts)).To(Succeed())
+		Expect(getSecret().Data).To(Equal(original.Data))
+	})
+
+	It("rotates certs that are about to expire and trusts both CAs until the next check", func() {
+		Expect(RotateCerts(context.TODO(), kube, opts)).To(Succeed())
+		oldCa := getSecret().Data[v1.ServiceAccountRootCAKey]
+
+		// the generated certs are valid for a year
+		opts.RenewBefore = 400 * 24 * time.Hour
+		Expect(RotateCerts(context.TODO(), kube, opts)).To(Succeed())
+		newCa := getSecret().Data[v1.ServiceAccountRootCAKey]
+		Expect(newCa).NotTo(Equal(oldCa))
+		Expect(getCaBundle()).To(Equal(append(append([]byte{}, newCa...), oldCa...)))
+
+		opts.RenewBefore = 24 * time.Hour
+		Expect(RotateCerts(context.TODO(), kube, opts)).To(Succeed())
+		Expect(getCaBundle()).To(Equal(newCa))
+	})
+})
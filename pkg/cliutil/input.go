@@ -2,6 +2,7 @@ package cliutil
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -128,6 +129,75 @@ func ChooseFromList(message string, choice *string, options []string) error {
 	return nil
 }
 
+// FuzzyChooseFromList is ChooseFromList, with the options filtered by FuzzyFilter while the user types
+func FuzzyChooseFromList(message string, choice *string, options []string) error {
+	if len(options) == 0 {
+		return fmt.Errorf("No options to select from (for prompt: %v)", message)
+	}
+
+	question := &survey.Select{
+		Message:  message,
+		Options:  options,
+		FilterFn: FuzzyFilter,
+	}
+
+	return AskOne(question, choice, survey.Required)
+}
+
+// FuzzyFilter returns the options which contain the characters of the filter in order, ignoring case.
+// The options which contain the filter as a whole come first, then the ones in which the characters
+// of the filter are the closest to each other.
+func FuzzyFilter(filter string, options []string) []string {
+	filter = strings.ToLower(filter)
+	type match struct {
+		option string
+		score  int
+	}
+	var matches []match
+	for _, option := range options {
+		lower := strings.ToLower(option)
+		if strings.Contains(lower, filter) {
+			matches = append(matches, match{option: option})
+			continue
+		}
+		if span, ok := subsequenceSpan(lower, filter); ok {
+			matches = append(matches, match{option: option, score: span})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score < matches[j].score
+	})
+	out := make([]string, 0, len(matches))
+	for _, m := range matches {
+		out = append(out, m.option)
+	}
+	return out
+}
+
+// subsequenceSpan returns the length of the shortest part of s which contains the runes of sub in order
+func subsequenceSpan(s, sub string) (int, bool) {
+	runes, subRunes := []rune(s), []rune(sub)
+	best := -1
+	for start := range runes {
+		if runes[start] != subRunes[0] {
+			continue
+		}
+		matched := 0
+		for i := start; i < len(runes); i++ {
+			if runes[i] == subRunes[matched] {
+				matched++
+			}
+			if matched == len(subRunes) {
+				if span := i - start + 1; best < 0 || span < best {
+					best = span
+				}
+				break
+			}
+		}
+	}
+	return best, best >= 0
+}
+
 func MultiChooseFromList(message string, choices *[]string, options []string) error {
 	if len(options) == 0 {
 		return fmt.Errorf("No options to select from (for prompt: %v)", message)
@@ -21,3 +21,23 @@ var _ = Describe("GetBoolInput", func() {
 		})
 	})
 })
+
+var _ = Describe("FuzzyFilter", func() {
+	options := []string{"petstore-8080", "gloo-system-petstore", "default-pets-8080", "pay-store"}
+
+	It("returns the options which contain the characters of the filter in order", func() {
+		Expect(FuzzyFilter("PST", options)).To(Equal([]string{"petstore-8080", "gloo-system-petstore", "pay-store"}))
+	})
+
+	It("returns the options which contain the filter first", func() {
+		Expect(FuzzyFilter("pets", []string{"p-e-t-s", "gloo-system-petstore"})).To(Equal([]string{"gloo-system-petstore", "p-e-t-s"}))
+	})
+
+	It("returns the closest matches first", func() {
+		Expect(FuzzyFilter("ps8", options)).To(Equal([]string{"default-pets-8080", "petstore-8080"}))
+	})
+
+	It("returns all the options for an empty filter", func() {
+		Expect(FuzzyFilter("", options)).To(Equal(options))
+	})
+})
@@ -6,6 +6,7 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/duration"
+	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/golang/protobuf/ptypes/wrappers"
 )
 
@@ -103,3 +104,41 @@ func DurationProtoToStd(pr *duration.Duration) *time.Duration {
 	dur, _ := ptypes.Duration(pr)
 	return &dur
 }
+
+func StructGogoToProto(pr *types.Struct) *structpb.Struct {
+	var ret *structpb.Struct
+	if pr != nil {
+		ret = &structpb.Struct{
+			Fields: make(map[string]*structpb.Value, len(pr.GetFields())),
+		}
+		for key, value := range pr.GetFields() {
+			ret.Fields[key] = ValueGogoToProto(value)
+		}
+	}
+	return ret
+}
+
+func ValueGogoToProto(pr *types.Value) *structpb.Value {
+	if pr == nil {
+		return nil
+	}
+	switch kind := pr.GetKind().(type) {
+	case *types.Value_NullValue:
+		return &structpb.Value{Kind: &structpb.Value_NullValue{}}
+	case *types.Value_NumberValue:
+		return &structpb.Value{Kind: &structpb.Value_NumberValue{NumberValue: kind.NumberValue}}
+	case *types.Value_StringValue:
+		return &structpb.Value{Kind: &structpb.Value_StringValue{StringValue: kind.StringValue}}
+	case *types.Value_BoolValue:
+		return &structpb.Value{Kind: &structpb.Value_BoolValue{BoolValue: kind.BoolValue}}
+	case *types.Value_StructValue:
+		return &structpb.Value{Kind: &structpb.Value_StructValue{StructValue: StructGogoToProto(kind.StructValue)}}
+	case *types.Value_ListValue:
+		list := &structpb.ListValue{}
+		for _, value := range kind.ListValue.GetValues() {
+			list.Values = append(list.Values, ValueGogoToProto(value))
+		}
+		return &structpb.Value{Kind: &structpb.Value_ListValue{ListValue: list}}
+	}
+	return &structpb.Value{}
+}
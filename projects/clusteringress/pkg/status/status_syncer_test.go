@@ -65,7 +65,7 @@ var _ = Describe("StatusSyncer", func() {
 			"gloo": "ingress-proxy",
 		})
 		statusEmitter := v1.NewStatusEmitter(kubeServiceClient, ingressClient)
-		statusSync := status.NewSyncer(ingressClient)
+		statusSync := status.NewSyncer(ingressClient, false, "", ingress.NewIngressClassLister(kube))
 		statusEventLoop := v1.NewStatusEventLoop(statusEmitter, statusSync)
 		statusEventLoopErrs, err := statusEventLoop.Run([]string{namespace}, clients.WatchOpts{Ctx: context.TODO()})
 		Expect(err).NotTo(HaveOccurred())
@@ -204,6 +204,7 @@ var _ = Describe("Translate", func() {
 											Options: &gloov1.RouteOptions{
 												Timeout: durptr(1),
 												Retries: &retries.RetryPolicy{
+													RetryOn:       "5xx,connect-failure,refused-stream",
 													NumRetries:    0x0000000e,
 													PerTryTimeout: durptr(1000),
 												},
@@ -258,6 +259,7 @@ var _ = Describe("Translate", func() {
 											Options: &gloov1.RouteOptions{
 												Timeout: durptr(1),
 												Retries: &retries.RetryPolicy{
+													RetryOn:       "5xx,connect-failure,refused-stream",
 													NumRetries:    0x0000000e,
 													PerTryTimeout: durptr(1000),
 												},
@@ -321,6 +323,7 @@ var _ = Describe("Translate", func() {
 											Options: &gloov1.RouteOptions{
 												Timeout: durptr(1),
 												Retries: &retries.RetryPolicy{
+													RetryOn:       "5xx,connect-failure,refused-stream",
 													NumRetries:    0x0000000e,
 													PerTryTimeout: durptr(1000),
 												},
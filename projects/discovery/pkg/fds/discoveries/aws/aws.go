@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	errors "github.com/rotisserie/eris"
@@ -40,6 +41,11 @@ func (f *AWSLambdaFunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream)
 type AWSLambdaFunctionDiscovery struct {
 	timetowait time.Duration
 	upstream   *v1.Upstream
+
+	// the credentials obtained with the web identity token are kept across polls,
+	// so that they are only refreshed from STS once they expire
+	webIdentityCreds   *credentials.Credentials
+	webIdentityRoleArn string
 }
 
 func (f *AWSLambdaFunctionDiscovery) IsFunctional() bool {
@@ -47,7 +53,7 @@ func (f *AWSLambdaFunctionDiscovery) IsFunctional() bool {
 	return ok
 }
 
-func (f *AWSLambdaFunctionDiscovery) DetectType(ctx context.Context, url *url.URL) (*plugins.ServiceSpec, error) {
+func (f *AWSLambdaFunctionDiscovery) DetectType(ctx context.Context, url *url.URL, _ func() fds.Dependencies) (*plugins.ServiceSpec, error) {
 	return nil, nil
 }
 
@@ -103,7 +109,7 @@ func (f *AWSLambdaFunctionDiscovery) DetectFunctions(ctx context.Context, url *u
 		}
 
 		// sleep so we are not hogging
-		if err := contextutils.Sleep(ctx, f.timetowait); err != nil {
+		if err := contextutils.Sleep(ctx, fds.RediscoveryInterval(ctx, f.upstream, f.timetowait)); err != nil {
 			return err
 		}
 	}
@@ -116,31 +122,9 @@ func (f *AWSLambdaFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, se
 	if !ok {
 		return nil, errors.New("not a lambda upstream spec")
 	}
-	lambdaSpec := awsspec.Aws
-	awsRegion := lambdaSpec.GetRegion()
-	if awsRegion == "" {
-		awsRegion = os.Getenv(AWS_REGION)
-	}
-	sess, err := awsutils.GetAwsSession(lambdaSpec.SecretRef, secrets, &aws.Config{Region: aws.String(lambdaSpec.Region)})
+	svc, err := f.lambdaClient(ctx, awsspec.Aws, secrets)
 	if err != nil {
-		return nil, errors.Wrap(err, "unable to create AWS session")
-	}
-
-	var svc *lambda.Lambda
-
-	tokenPath := os.Getenv(AWS_WEB_IDENTITY_TOKEN_FILE)
-	roleArn := os.Getenv(AWS_ROLE_ARN)
-	// If aws web token, and role arn are available, authenticate lambda service using mounted credentials.
-	// See: https://aws.amazon.com/blogs/opensource/introducing-fine-grained-iam-roles-service-accounts/
-	if tokenPath != "" && roleArn != "" {
-		if awsspec.Aws.GetRoleArn() != "" {
-			roleArn = awsspec.Aws.GetRoleArn()
-		}
-		contextutils.LoggerFrom(ctx).Debugf("Discovering lambda functions using assumed role [%s]", roleArn)
-		webProvider := stscreds.NewWebIdentityCredentials(sess, roleArn, "", tokenPath)
-		svc = lambda.New(sess, aws.NewConfig().WithCredentials(webProvider))
-	} else {
-		svc = lambda.New(sess)
+		return nil, err
 	}
 
 	var newfunctions []*glooaws.LambdaFunctionSpec
@@ -172,3 +156,37 @@ func (f *AWSLambdaFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, se
 
 	return newfunctions, nil
 }
+
+// Creates the client used to list the functions of the upstream. The secret of the upstream is used if it has one,
+// as it is for invoking the functions. Otherwise, if the web identity token of an EKS service account is mounted, the
+// role of the upstream (or the one given by AWS_ROLE_ARN) is assumed with it.
+// See: https://aws.amazon.com/blogs/opensource/introducing-fine-grained-iam-roles-service-accounts/
+func (f *AWSLambdaFunctionDiscovery) lambdaClient(ctx context.Context, lambdaSpec *glooaws.UpstreamSpec, secrets v1.SecretList) (*lambda.Lambda, error) {
+	awsRegion := lambdaSpec.GetRegion()
+	if awsRegion == "" {
+		awsRegion = os.Getenv(AWS_REGION)
+	}
+	sess, err := awsutils.GetAwsSession(lambdaSpec.GetSecretRef(), secrets, &aws.Config{Region: aws.String(awsRegion)})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create AWS session")
+	}
+	if lambdaSpec.GetSecretRef() != nil {
+		return lambda.New(sess), nil
+	}
+
+	tokenPath := os.Getenv(AWS_WEB_IDENTITY_TOKEN_FILE)
+	roleArn := lambdaSpec.GetRoleArn()
+	if roleArn == "" {
+		roleArn = os.Getenv(AWS_ROLE_ARN)
+	}
+	if tokenPath == "" || roleArn == "" {
+		return lambda.New(sess), nil
+	}
+
+	if f.webIdentityCreds == nil || f.webIdentityRoleArn != roleArn {
+		contextutils.LoggerFrom(ctx).Debugf("Discovering lambda functions using assumed role [%s]", roleArn)
+		f.webIdentityCreds = stscreds.NewWebIdentityCredentials(sess, roleArn, "", tokenPath)
+		f.webIdentityRoleArn = roleArn
+	}
+	return lambda.New(sess, aws.NewConfig().WithCredentials(f.webIdentityCreds)), nil
+}
@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooaws "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Lambda client credentials", func() {
+
+	var (
+		ctx       context.Context
+		discovery *AWSLambdaFunctionDiscovery
+		spec      *glooaws.UpstreamSpec
+		secrets   v1.SecretList
+		tokenFile string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		spec = &glooaws.UpstreamSpec{Region: "us-east-1"}
+		discovery = &AWSLambdaFunctionDiscovery{
+			upstream: &v1.Upstream{UpstreamType: &v1.Upstream_Aws{Aws: spec}},
+		}
+		secrets = v1.SecretList{{
+			Metadata: core.Metadata{Name: "aws", Namespace: "gloo-system"},
+			Kind: &v1.Secret_Aws{Aws: &v1.AwsSecret{
+				AccessKey: "access-key",
+				SecretKey: "secret-key",
+			}},
+		}}
+
+		f, err := ioutil.TempFile("", "web-identity-token")
+		Expect(err).NotTo(HaveOccurred())
+		tokenFile = f.Name()
+		Expect(f.Close()).To(Succeed())
+		Expect(os.Setenv(AWS_WEB_IDENTITY_TOKEN_FILE, tokenFile)).To(Succeed())
+		Expect(os.Setenv(AWS_ROLE_ARN, "arn:aws:iam::123456789012:role/service-account")).To(Succeed())
+	})
+
+	AfterEach(func() {
+		os.Unsetenv(AWS_WEB_IDENTITY_TOKEN_FILE)
+		os.Unsetenv(AWS_ROLE_ARN)
+		os.Remove(tokenFile)
+	})
+
+	It("uses the secret of the upstream over the web identity token", func() {
+		spec.SecretRef = &core.ResourceRef{Name: "aws", Namespace: "gloo-system"}
+
+		svc, err := discovery.lambdaClient(ctx, spec, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		creds, err := svc.Config.Credentials.Get()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(creds.AccessKeyID).To(Equal("access-key"))
+		Expect(discovery.webIdentityCreds).To(BeNil())
+	})
+
+	It("assumes the role of the upstream with the web identity token", func() {
+		spec.RoleArn = "arn:aws:iam::123456789012:role/upstream"
+
+		svc, err := discovery.lambdaClient(ctx, spec, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(svc.Config.Credentials).To(BeIdenticalTo(discovery.webIdentityCreds))
+		Expect(discovery.webIdentityRoleArn).To(Equal("arn:aws:iam::123456789012:role/upstream"))
+	})
+
+	It("keeps the web identity credentials across polls until the role changes", func() {
+		svc, err := discovery.lambdaClient(ctx, spec, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(discovery.webIdentityRoleArn).To(Equal("arn:aws:iam::123456789012:role/service-account"))
+		firstCreds := svc.Config.Credentials
+
+		svc, err = discovery.lambdaClient(ctx, spec, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(svc.Config.Credentials).To(BeIdenticalTo(firstCreds))
+
+		spec.RoleArn = "arn:aws:iam::123456789012:role/upstream"
+		svc, err = discovery.lambdaClient(ctx, spec, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(svc.Config.Credentials).NotTo(BeIdenticalTo(firstCreds))
+	})
+
+	It("does not use web identity credentials without a token", func() {
+		os.Unsetenv(AWS_WEB_IDENTITY_TOKEN_FILE)
+
+		_, err := discovery.lambdaClient(ctx, spec, secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(discovery.webIdentityCreds).To(BeNil())
+	})
+})
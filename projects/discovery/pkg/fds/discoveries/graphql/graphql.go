@@ -0,0 +1,210 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	graphql_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/graphql"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+// the paths GraphQL endpoints are commonly served on
+var commonGraphqlEndpoints = []string{
+	"/graphql",
+	"/query",
+}
+
+var (
+	UnsupportedUrlError = func(u *url.URL) error {
+		return errors.Errorf("unsupported url for graphql discovery %v", u)
+	}
+
+	IntrospectionFailedError = func(messages []string) error {
+		return errors.Errorf("introspection query failed: %v", strings.Join(messages, "; "))
+	}
+
+	NoSchemaError = errors.New("the response to the introspection query doesn't contain a schema")
+)
+
+func getgraphqlspec(u *v1.Upstream) *graphql_plugins.ServiceSpec {
+	upstreamType, ok := u.UpstreamType.(v1.ServiceSpecGetter)
+	if !ok {
+		return nil
+	}
+
+	if upstreamType.GetServiceSpec() == nil {
+		return nil
+	}
+
+	graphqlwrapper, ok := upstreamType.GetServiceSpec().PluginType.(*plugins.ServiceSpec_Graphql)
+	if !ok {
+		return nil
+	}
+	return graphqlwrapper.Graphql
+}
+
+// Discovers the schema of GraphQL upstreams, with the introspection query of their GraphQL endpoint
+type FunctionDiscoveryFactory struct {
+	DetectionTimeout time.Duration
+	FunctionPollTime time.Duration
+}
+
+func (f *FunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream) fds.UpstreamFunctionDiscovery {
+	return &UpstreamFunctionDiscovery{
+		detectionTimeout: f.DetectionTimeout,
+		functionPollTime: f.FunctionPollTime,
+		upstream:         u,
+	}
+}
+
+type UpstreamFunctionDiscovery struct {
+	detectionTimeout time.Duration
+	functionPollTime time.Duration
+	upstream         *v1.Upstream
+}
+
+func (f *UpstreamFunctionDiscovery) IsFunctional() bool {
+	return getgraphqlspec(f.upstream) != nil
+}
+
+func (f *UpstreamFunctionDiscovery) DetectType(ctx context.Context, baseUrl *url.URL, _ func() fds.Dependencies) (*plugins.ServiceSpec, error) {
+	var spec *plugins.ServiceSpec
+
+	err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{MaxDuration: &f.detectionTimeout}).Backoff(ctx, func(ctx context.Context) error {
+		var err error
+		spec, err = f.detectTypeOnce(ctx, baseUrl)
+		return err
+	})
+
+	return spec, err
+}
+
+func (f *UpstreamFunctionDiscovery) detectTypeOnce(ctx context.Context, baseUrl *url.URL) (*plugins.ServiceSpec, error) {
+	logger := contextutils.LoggerFrom(ctx)
+	logger.Debugf("attempting to detect graphql for %v", baseUrl)
+
+	var errs error
+	for _, endpoint := range commonGraphqlEndpoints {
+		_, err := introspect(ctx, baseUrl, endpoint)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			errs = multierror.Append(errs, errors.Wrapf(err, "endpoint %v", endpoint))
+			continue
+		}
+		logger.Infof("graphql upstream detected: %v%v", baseUrl, endpoint)
+		return &plugins.ServiceSpec{
+			PluginType: &plugins.ServiceSpec_Graphql{
+				Graphql: &graphql_plugins.ServiceSpec{
+					Endpoint: endpoint,
+				},
+			},
+		}, nil
+	}
+	return nil, errors.Wrapf(errs, "service at %v does not serve graphql at a known endpoint, "+
+		"or was unreachable", baseUrl)
+}
+
+func (f *UpstreamFunctionDiscovery) DetectFunctions(ctx context.Context, url *url.URL, _ func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
+	if url == nil {
+		return errors.New("the url of the graphql upstream couldn't be resolved")
+	}
+	for {
+		err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, func(ctx context.Context) error {
+			return f.DetectFunctionsOnce(ctx, url, updatecb)
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// ignore other errors as we would like to continue forever.
+		}
+
+		if err := contextutils.Sleep(ctx, fds.RediscoveryInterval(ctx, f.upstream, f.functionPollTime)); err != nil {
+			return err
+		}
+	}
+}
+
+// introspects the endpoint of the upstream, and stores its schema on the graphql service spec
+func (f *UpstreamFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, url *url.URL, updatecb func(fds.UpstreamMutator) error) error {
+	endpoint := getgraphqlspec(f.upstream).GetEndpoint()
+	if endpoint == "" {
+		endpoint = commonGraphqlEndpoints[0]
+	}
+	schema, err := introspect(ctx, url, endpoint)
+	if err != nil {
+		return err
+	}
+	sdl := printSchema(schema)
+
+	return updatecb(func(out *v1.Upstream) error {
+		svcspec := getgraphqlspec(out)
+		if svcspec == nil {
+			return errors.New("not a graphql upstream")
+		}
+		svcspec.Schema = sdl
+		return nil
+	})
+}
+
+// sends the introspection query to the graphql endpoint at the path of the url
+func introspect(ctx context.Context, baseUrl *url.URL, endpoint string) (*introspectionSchema, error) {
+	resolved := *baseUrl
+	switch resolved.Scheme {
+	case "http", "https":
+	case "tcp":
+		// if it is a tcp address, assume it is plain http
+		resolved.Scheme = "http"
+	default:
+		return nil, UnsupportedUrlError(baseUrl)
+	}
+	target := resolved.ResolveReference(&url.URL{Path: endpoint}).String()
+
+	body, err := json.Marshal(map[string]string{"query": introspectionQuery})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid url for request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Gloo-Discovery", "GraphQL-Discovery")
+
+	res, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not perform HTTP POST on resolved addr: %v", target)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("response code: %v", res.Status)
+	}
+
+	var response introspectionResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, errors.Wrap(err, "decoding the response to the introspection query")
+	}
+	if len(response.Errors) > 0 {
+		var messages []string
+		for _, e := range response.Errors {
+			messages = append(messages, e.Message)
+		}
+		return nil, IntrospectionFailedError(messages)
+	}
+	if response.Data.Schema == nil {
+		return nil, NoSchemaError
+	}
+	return response.Data.Schema, nil
+}
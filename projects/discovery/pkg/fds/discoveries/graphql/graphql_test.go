@@ -0,0 +1,90 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	graphql_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/graphql"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("graphql function discovery", func() {
+
+	var (
+		server               *httptest.Server
+		introspectionEnabled bool
+		url_                 *url.URL
+		upstream             *v1.Upstream
+		discovery            *UpstreamFunctionDiscovery
+	)
+
+	BeforeEach(func() {
+		introspectionEnabled = true
+		mux := http.NewServeMux()
+		mux.HandleFunc("/query", func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				Query string `json:"query"`
+			}
+			if r.Method != http.MethodPost || json.NewDecoder(r.Body).Decode(&req) != nil || req.Query != introspectionQuery {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if !introspectionEnabled {
+				fmt.Fprint(w, `{"errors": [{"message": "introspection is disabled"}]}`)
+				return
+			}
+			fmt.Fprintf(w, `{"data": {"__schema": %s}}`, petstoreIntrospection)
+		})
+		server = httptest.NewServer(mux)
+
+		var err error
+		url_, err = url.Parse(server.URL)
+		Expect(err).NotTo(HaveOccurred())
+		url_.Scheme = "tcp"
+
+		upstream = &v1.Upstream{
+			Metadata:     core.Metadata{Name: "petstore", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Static{Static: &static.UpstreamSpec{}},
+		}
+		discovery = (&FunctionDiscoveryFactory{}).NewFunctionDiscovery(upstream).(*UpstreamFunctionDiscovery)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should detect the graphql endpoint of the upstream", func() {
+		Expect(discovery.IsFunctional()).To(BeFalse())
+		spec, err := discovery.detectTypeOnce(context.TODO(), url_)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spec.GetGraphql()).To(Equal(&graphql_plugins.ServiceSpec{Endpoint: "/query"}))
+	})
+
+	It("should not detect upstreams which don't allow introspection", func() {
+		introspectionEnabled = false
+		_, err := discovery.detectTypeOnce(context.TODO(), url_)
+		Expect(err).To(MatchError(ContainSubstring("introspection is disabled")))
+	})
+
+	It("should store the schema of the upstream on its service spec", func() {
+		upstream.GetStatic().ServiceSpec = &plugins.ServiceSpec{
+			PluginType: &plugins.ServiceSpec_Graphql{Graphql: &graphql_plugins.ServiceSpec{Endpoint: "/query"}},
+		}
+		Expect(discovery.IsFunctional()).To(BeTrue())
+		err := discovery.DetectFunctionsOnce(context.TODO(), url_, func(mutator fds.UpstreamMutator) error {
+			return mutator(upstream)
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getgraphqlspec(upstream).GetSchema()).To(Equal(petstoreSchema))
+	})
+})
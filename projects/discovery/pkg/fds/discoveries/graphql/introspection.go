@@ -0,0 +1,400 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// the standard introspection query of the GraphQL reference implementation
+const introspectionQuery = `query IntrospectionQuery {
+  __schema {
+    queryType { name }
+    mutationType { name }
+    subscriptionType { name }
+    types {
+      ...FullType
+    }
+    directives {
+      name
+      description
+      locations
+      args {
+        ...InputValue
+      }
+    }
+  }
+}
+
+fragment FullType on __Type {
+  kind
+  name
+  description
+  fields(includeDeprecated: true) {
+    name
+    description
+    args {
+      ...InputValue
+    }
+    type {
+      ...TypeRef
+    }
+    isDeprecated
+    deprecationReason
+  }
+  inputFields {
+    ...InputValue
+  }
+  interfaces {
+    ...TypeRef
+  }
+  enumValues(includeDeprecated: true) {
+    name
+    description
+    isDeprecated
+    deprecationReason
+  }
+  possibleTypes {
+    ...TypeRef
+  }
+}
+
+fragment InputValue on __InputValue {
+  name
+  description
+  type { ...TypeRef }
+  defaultValue
+}
+
+fragment TypeRef on __Type {
+  kind
+  name
+  ofType {
+    kind
+    name
+    ofType {
+      kind
+      name
+      ofType {
+        kind
+        name
+        ofType {
+          kind
+          name
+          ofType {
+            kind
+            name
+            ofType {
+              kind
+              name
+              ofType {
+                kind
+                name
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+// the reason graphql implementations give to deprecations without one
+const defaultDeprecationReason = "No longer supported"
+
+type introspectionResponse struct {
+	Data struct {
+		Schema *introspectionSchema `json:"__schema"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+type introspectionSchema struct {
+	QueryType        *typeName       `json:"queryType"`
+	MutationType     *typeName       `json:"mutationType"`
+	SubscriptionType *typeName       `json:"subscriptionType"`
+	Types            []fullType      `json:"types"`
+	Directives       []directiveType `json:"directives"`
+}
+
+type typeName struct {
+	Name string `json:"name"`
+}
+
+type fullType struct {
+	Kind          string       `json:"kind"`
+	Name          string       `json:"name"`
+	Description   *string      `json:"description"`
+	Fields        []field      `json:"fields"`
+	InputFields   []inputValue `json:"inputFields"`
+	Interfaces    []typeRef    `json:"interfaces"`
+	EnumValues    []enumValue  `json:"enumValues"`
+	PossibleTypes []typeRef    `json:"possibleTypes"`
+}
+
+type field struct {
+	Name              string       `json:"name"`
+	Description       *string      `json:"description"`
+	Args              []inputValue `json:"args"`
+	Type              typeRef      `json:"type"`
+	IsDeprecated      bool         `json:"isDeprecated"`
+	DeprecationReason *string      `json:"deprecationReason"`
+}
+
+type inputValue struct {
+	Name         string  `json:"name"`
+	Description  *string `json:"description"`
+	Type         typeRef `json:"type"`
+	DefaultValue *string `json:"defaultValue"`
+}
+
+type enumValue struct {
+	Name              string  `json:"name"`
+	Description       *string `json:"description"`
+	IsDeprecated      bool    `json:"isDeprecated"`
+	DeprecationReason *string `json:"deprecationReason"`
+}
+
+type directiveType struct {
+	Name        string       `json:"name"`
+	Description *string      `json:"description"`
+	Locations   []string     `json:"locations"`
+	Args        []inputValue `json:"args"`
+}
+
+type typeRef struct {
+	Kind   string   `json:"kind"`
+	Name   string   `json:"name"`
+	OfType *typeRef `json:"ofType"`
+}
+
+func (t typeRef) String() string {
+	switch t.Kind {
+	case "NON_NULL":
+		if t.OfType != nil {
+			return t.OfType.String() + "!"
+		}
+	case "LIST":
+		if t.OfType != nil {
+			return "[" + t.OfType.String() + "]"
+		}
+	}
+	return t.Name
+}
+
+var builtinScalars = map[string]bool{
+	"String":  true,
+	"Int":     true,
+	"Float":   true,
+	"Boolean": true,
+	"ID":      true,
+}
+
+var builtinDirectives = map[string]bool{
+	"skip":        true,
+	"include":     true,
+	"deprecated":  true,
+	"specifiedBy": true,
+}
+
+// Prints the schema of an introspection result in the GraphQL schema definition language.
+// Types and directives are sorted by name, so the schema only changes when the API of the upstream does.
+func printSchema(schema *introspectionSchema) string {
+	var definitions []string
+	if def := printSchemaDefinition(schema); def != "" {
+		definitions = append(definitions, def)
+	}
+
+	directives := append([]directiveType(nil), schema.Directives...)
+	sort.SliceStable(directives, func(i, j int) bool { return directives[i].Name < directives[j].Name })
+	for _, directive := range directives {
+		if builtinDirectives[directive.Name] {
+			continue
+		}
+		definitions = append(definitions, printDirective(directive))
+	}
+
+	types := append([]fullType(nil), schema.Types...)
+	sort.SliceStable(types, func(i, j int) bool { return types[i].Name < types[j].Name })
+	for _, t := range types {
+		if strings.HasPrefix(t.Name, "__") || builtinScalars[t.Name] {
+			continue
+		}
+		definitions = append(definitions, printType(t))
+	}
+	return strings.Join(definitions, "\n\n") + "\n"
+}
+
+// the schema definition is omitted if the root types have their conventional names
+func printSchemaDefinition(schema *introspectionSchema) string {
+	conventional := true
+	var operations []string
+	for _, root := range []struct {
+		operation string
+		rootType  *typeName
+		name      string
+	}{
+		{"query", schema.QueryType, "Query"},
+		{"mutation", schema.MutationType, "Mutation"},
+		{"subscription", schema.SubscriptionType, "Subscription"},
+	} {
+		if root.rootType == nil {
+			continue
+		}
+		if root.rootType.Name != root.name {
+			conventional = false
+		}
+		operations = append(operations, fmt.Sprintf("  %s: %s", root.operation, root.rootType.Name))
+	}
+	if conventional {
+		return ""
+	}
+	return "schema {\n" + strings.Join(operations, "\n") + "\n}"
+}
+
+func printDirective(directive directiveType) string {
+	return printDescription(directive.Description, "", true) +
+		"directive @" + directive.Name + printArgs(directive.Args, "") +
+		" on " + strings.Join(directive.Locations, " | ")
+}
+
+func printType(t fullType) string {
+	description := printDescription(t.Description, "", true)
+	switch t.Kind {
+	case "SCALAR":
+		return description + "scalar " + t.Name
+	case "OBJECT":
+		return description + "type " + t.Name + printInterfaces(t.Interfaces) + printFields(t.Fields)
+	case "INTERFACE":
+		return description + "interface " + t.Name + printInterfaces(t.Interfaces) + printFields(t.Fields)
+	case "UNION":
+		var members []string
+		for _, possibleType := range t.PossibleTypes {
+			members = append(members, possibleType.String())
+		}
+		union := description + "union " + t.Name
+		if len(members) > 0 {
+			union += " = " + strings.Join(members, " | ")
+		}
+		return union
+	case "ENUM":
+		var values []string
+		for i, value := range t.EnumValues {
+			values = append(values, printDescription(value.Description, "  ", i == 0)+
+				"  "+value.Name+printDeprecated(value.IsDeprecated, value.DeprecationReason))
+		}
+		return description + "enum " + t.Name + printBlock(values)
+	case "INPUT_OBJECT":
+		var fields []string
+		for i, inputField := range t.InputFields {
+			fields = append(fields, printDescription(inputField.Description, "  ", i == 0)+"  "+printInputValue(inputField))
+		}
+		return description + "input " + t.Name + printBlock(fields)
+	}
+	return description + "scalar " + t.Name
+}
+
+func printInterfaces(interfaces []typeRef) string {
+	if len(interfaces) == 0 {
+		return ""
+	}
+	var names []string
+	for _, iface := range interfaces {
+		names = append(names, iface.String())
+	}
+	return " implements " + strings.Join(names, " & ")
+}
+
+func printFields(fields []field) string {
+	var lines []string
+	for i, f := range fields {
+		lines = append(lines, printDescription(f.Description, "  ", i == 0)+
+			"  "+f.Name+printArgs(f.Args, "  ")+": "+f.Type.String()+
+			printDeprecated(f.IsDeprecated, f.DeprecationReason))
+	}
+	return printBlock(lines)
+}
+
+func printBlock(lines []string) string {
+	if len(lines) == 0 {
+		return ""
+	}
+	return " {\n" + strings.Join(lines, "\n") + "\n}"
+}
+
+// arguments are printed on their own lines if any of them has a description
+func printArgs(args []inputValue, indentation string) string {
+	if len(args) == 0 {
+		return ""
+	}
+	var printed []string
+	multiline := false
+	for _, arg := range args {
+		if arg.Description != nil && *arg.Description != "" {
+			multiline = true
+		}
+	}
+	if !multiline {
+		for _, arg := range args {
+			printed = append(printed, printInputValue(arg))
+		}
+		return "(" + strings.Join(printed, ", ") + ")"
+	}
+	for i, arg := range args {
+		printed = append(printed, printDescription(arg.Description, indentation+"  ", i == 0)+
+			indentation+"  "+printInputValue(arg))
+	}
+	return "(\n" + strings.Join(printed, "\n") + "\n" + indentation + ")"
+}
+
+func printInputValue(value inputValue) string {
+	printed := value.Name + ": " + value.Type.String()
+	if value.DefaultValue != nil {
+		printed += " = " + *value.DefaultValue
+	}
+	return printed
+}
+
+func printDeprecated(isDeprecated bool, reason *string) string {
+	if !isDeprecated {
+		return ""
+	}
+	if reason == nil || *reason == defaultDeprecationReason {
+		return " @deprecated"
+	}
+	return " @deprecated(reason: " + quote(*reason) + ")"
+}
+
+// prints a description followed by a new line, preceded by an empty line unless it's the first in its block
+func printDescription(description *string, indentation string, firstInBlock bool) string {
+	if description == nil || *description == "" {
+		return ""
+	}
+	prefix := ""
+	if !firstInBlock {
+		prefix = "\n"
+	}
+	if !strings.Contains(*description, "\n") {
+		return prefix + indentation + quote(*description) + "\n"
+	}
+	lines := strings.Split(strings.Replace(*description, `"""`, `\"""`, -1), "\n")
+	for i := range lines {
+		if lines[i] != "" {
+			lines[i] = indentation + lines[i]
+		}
+	}
+	return prefix + indentation + `"""` + "\n" + strings.Join(lines, "\n") + "\n" + indentation + `"""` + "\n"
+}
+
+// quotes a string the way GraphQL string values are, which is compatible with JSON
+func quote(s string) string {
+	var quoted bytes.Buffer
+	encoder := json.NewEncoder(&quoted)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(s)
+	return strings.TrimSuffix(quoted.String(), "\n")
+}
@@ -0,0 +1,140 @@
+package graphql
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// the introspection result of a schema with each kind of type, trimmed to the fields the printer uses
+const petstoreIntrospection = `{
+  "queryType": {"name": "Query"},
+  "mutationType": {"name": "Mutation"},
+  "subscriptionType": null,
+  "types": [
+    {"kind": "OBJECT", "name": "Query", "fields": [
+      {"name": "pets", "args": [
+        {"name": "first", "type": {"kind": "SCALAR", "name": "Int"}, "defaultValue": "10"}
+      ], "type": {"kind": "NON_NULL", "ofType": {"kind": "LIST", "ofType": {"kind": "NON_NULL", "ofType": {"kind": "OBJECT", "name": "Pet"}}}}},
+      {"name": "pet", "description": "Finds a pet by id", "args": [
+        {"name": "id", "description": "The id of the pet", "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "ID"}}}
+      ], "type": {"kind": "OBJECT", "name": "Pet"}}
+    ], "interfaces": []},
+    {"kind": "OBJECT", "name": "Mutation", "fields": [
+      {"name": "addPet", "args": [
+        {"name": "pet", "type": {"kind": "NON_NULL", "ofType": {"kind": "INPUT_OBJECT", "name": "PetInput"}}}
+      ], "type": {"kind": "OBJECT", "name": "Pet"}}
+    ], "interfaces": []},
+    {"kind": "INTERFACE", "name": "Node", "fields": [
+      {"name": "id", "args": [], "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "ID"}}}
+    ]},
+    {"kind": "OBJECT", "name": "Pet", "description": "A pet\nof the store", "fields": [
+      {"name": "id", "args": [], "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "ID"}}},
+      {"name": "status", "description": "The status of the pet", "args": [], "type": {"kind": "ENUM", "name": "Status"}},
+      {"name": "tag", "args": [], "type": {"kind": "SCALAR", "name": "String"}, "isDeprecated": true, "deprecationReason": "Use \"tags\""},
+      {"name": "born", "args": [], "type": {"kind": "SCALAR", "name": "Date"}, "isDeprecated": true, "deprecationReason": "No longer supported"}
+    ], "interfaces": [{"kind": "INTERFACE", "name": "Node"}]},
+    {"kind": "ENUM", "name": "Status", "enumValues": [
+      {"name": "AVAILABLE"},
+      {"name": "SOLD", "isDeprecated": true}
+    ]},
+    {"kind": "INPUT_OBJECT", "name": "PetInput", "inputFields": [
+      {"name": "name", "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "String"}}},
+      {"name": "status", "type": {"kind": "ENUM", "name": "Status"}, "defaultValue": "AVAILABLE"}
+    ]},
+    {"kind": "UNION", "name": "SearchResult", "possibleTypes": [
+      {"kind": "OBJECT", "name": "Pet"},
+      {"kind": "OBJECT", "name": "Query"}
+    ]},
+    {"kind": "SCALAR", "name": "Date"},
+    {"kind": "SCALAR", "name": "String"},
+    {"kind": "SCALAR", "name": "ID"},
+    {"kind": "OBJECT", "name": "__Schema", "fields": []}
+  ],
+  "directives": [
+    {"name": "include", "locations": ["FIELD"], "args": []},
+    {"name": "auth", "description": "Requires a role", "locations": ["OBJECT", "FIELD_DEFINITION"], "args": [
+      {"name": "role", "type": {"kind": "SCALAR", "name": "String"}}
+    ]}
+  ]
+}`
+
+const petstoreSchema = `"Requires a role"
+directive @auth(role: String) on OBJECT | FIELD_DEFINITION
+
+scalar Date
+
+type Mutation {
+  addPet(pet: PetInput!): Pet
+}
+
+interface Node {
+  id: ID!
+}
+
+"""
+A pet
+of the store
+"""
+type Pet implements Node {
+  id: ID!
+
+  "The status of the pet"
+  status: Status
+  tag: String @deprecated(reason: "Use \"tags\"")
+  born: Date @deprecated
+}
+
+input PetInput {
+  name: String!
+  status: Status = AVAILABLE
+}
+
+type Query {
+  pets(first: Int = 10): [Pet!]!
+
+  "Finds a pet by id"
+  pet(
+    "The id of the pet"
+    id: ID!
+  ): Pet
+}
+
+union SearchResult = Pet | Query
+
+enum Status {
+  AVAILABLE
+  SOLD @deprecated
+}
+`
+
+var _ = Describe("printSchema", func() {
+
+	parse := func(introspection string) *introspectionSchema {
+		var schema introspectionSchema
+		Expect(json.Unmarshal([]byte(introspection), &schema)).NotTo(HaveOccurred())
+		return &schema
+	}
+
+	It("should print the schema definition language of the introspection result", func() {
+		Expect(printSchema(parse(petstoreIntrospection))).To(Equal(petstoreSchema))
+	})
+
+	It("should print the schema definition if the root types aren't conventionally named", func() {
+		schema := printSchema(parse(`{
+  "queryType": {"name": "RootQuery"},
+  "types": [{"kind": "OBJECT", "name": "RootQuery", "fields": [
+    {"name": "hello", "args": [], "type": {"kind": "SCALAR", "name": "String"}}
+  ]}]
+}`))
+		Expect(schema).To(Equal(`schema {
+  query: RootQuery
+}
+
+type RootQuery {
+  hello: String
+}
+`))
+	})
+})
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/base64"
 	"net/url"
 	"strings"
@@ -18,6 +19,8 @@ import (
 	grpc_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
 	"github.com/solo-io/go-utils/contextutils"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 	reflectpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 )
 
@@ -63,11 +66,11 @@ func (f *UpstreamFunctionDiscovery) IsFunctional() bool {
 	return getgrpcspec(f.upstream) != nil
 }
 
-func (f *UpstreamFunctionDiscovery) DetectType(ctx context.Context, url *url.URL) (*plugins.ServiceSpec, error) {
+func (f *UpstreamFunctionDiscovery) DetectType(ctx context.Context, url *url.URL, dependencies func() fds.Dependencies) (*plugins.ServiceSpec, error) {
 	log := contextutils.LoggerFrom(ctx)
 	log.Debugf("attempting to detect GRPC for %s", f.upstream.Metadata.Name)
 
-	refClient, closeConn, err := getclient(ctx, url)
+	refClient, closeConn, err := f.getclient(ctx, url, dependencies)
 	if err != nil {
 		return nil, err
 	}
@@ -87,11 +90,11 @@ func (f *UpstreamFunctionDiscovery) DetectType(ctx context.Context, url *url.URL
 	return svcInfo, nil
 }
 
-func (f *UpstreamFunctionDiscovery) DetectFunctions(ctx context.Context, url *url.URL, _ func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
+func (f *UpstreamFunctionDiscovery) DetectFunctions(ctx context.Context, url *url.URL, dependencies func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
 	for {
 		// TODO: get backoff values from config?
 		err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, func(ctx context.Context) error {
-			return f.DetectFunctionsOnce(ctx, url, updatecb)
+			return f.DetectFunctionsOnce(ctx, url, dependencies, updatecb)
 		})
 
 		if err != nil {
@@ -103,18 +106,18 @@ func (f *UpstreamFunctionDiscovery) DetectFunctions(ctx context.Context, url *ur
 
 		// sleep so we are not hogging
 		// TODO(yuval-k): customize time to sleep in config
-		if err := contextutils.Sleep(ctx, time.Minute); err != nil {
+		if err := contextutils.Sleep(ctx, fds.RediscoveryInterval(ctx, f.upstream, time.Minute)); err != nil {
 			return err
 		}
 	}
 }
 
-func (f *UpstreamFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, url *url.URL, updatecb func(fds.UpstreamMutator) error) error {
+func (f *UpstreamFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, url *url.URL, dependencies func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
 	log := contextutils.LoggerFrom(ctx)
 
 	log.Infof("%v discovered as a gRPC service", url)
 
-	refClient, closeConn, err := getclient(ctx, url)
+	refClient, closeConn, err := f.getclient(ctx, url, dependencies)
 	if err != nil {
 		return err
 	}
@@ -183,9 +186,20 @@ func (f *UpstreamFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, url
 	})
 }
 
-func getclient(ctx context.Context, url *url.URL) (*grpcreflect.Client, func() error, error) {
+// returns a client of the reflection service of the upstream, over TLS if the upstream has an ssl config,
+// which sends the reflection metadata of the grpc service spec with its requests
+func (f *UpstreamFunctionDiscovery) getclient(ctx context.Context, url *url.URL, dependencies func() fds.Dependencies) (*grpcreflect.Client, func() error, error) {
 	var dialopts []grpc.DialOption
-	if url.Scheme != "https" {
+	switch sslConfig := f.upstream.GetSslConfig(); {
+	case sslConfig != nil:
+		tlsCfg, err := tlsConfig(sslConfig, dependencies().Secrets)
+		if err != nil {
+			return nil, nil, err
+		}
+		dialopts = append(dialopts, grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg)))
+	case url.Scheme == "https":
+		dialopts = append(dialopts, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{})))
+	default:
 		dialopts = append(dialopts, grpc.WithInsecure())
 	}
 
@@ -193,6 +207,15 @@ func getclient(ctx context.Context, url *url.URL) (*grpcreflect.Client, func() e
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "dialing grpc on %v", url.Host)
 	}
+
+	var md []string
+	for key, value := range getgrpcspec(f.upstream).GetReflectionMetadata() {
+		md = append(md, strings.ToLower(key), value)
+	}
+	if len(md) > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, md...)
+	}
+
 	refClient := grpcreflect.NewClient(ctx, reflectpb.NewServerReflectionClient(cc))
 	return refClient, cc.Close, nil
 }
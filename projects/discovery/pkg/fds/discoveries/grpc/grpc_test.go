@@ -0,0 +1,116 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/url"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	grpc_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/gloo/test/helpers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("reflection over TLS with auth metadata", func() {
+
+	var (
+		server   *grpc.Server
+		url_     *url.URL
+		upstream *v1.Upstream
+		secrets  v1.SecretList
+	)
+
+	BeforeEach(func() {
+		cert, err := tls.X509KeyPair([]byte(helpers.Certificate()), []byte(helpers.PrivateKey()))
+		Expect(err).NotTo(HaveOccurred())
+		server = grpc.NewServer(
+			grpc.Creds(credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{cert}})),
+			grpc.StreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				md, _ := metadata.FromIncomingContext(ss.Context())
+				if len(md.Get("authorization")) == 0 || md.Get("authorization")[0] != "Bearer token" {
+					return status.Error(codes.Unauthenticated, "missing token")
+				}
+				return handler(srv, ss)
+			}),
+		)
+		healthpb.RegisterHealthServer(server, health.NewServer())
+		reflection.Register(server)
+
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		go server.Serve(lis)
+		url_ = &url.URL{Scheme: "tcp", Host: lis.Addr().String()}
+
+		secrets = v1.SecretList{{
+			Metadata: core.Metadata{Name: "tls", Namespace: "gloo-system"},
+			Kind: &v1.Secret_Tls{Tls: &v1.TlsSecret{
+				RootCa: helpers.Certificate(),
+			}},
+		}}
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{Name: "grpc", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Static{Static: &static.UpstreamSpec{
+				ServiceSpec: &plugins.ServiceSpec{PluginType: &plugins.ServiceSpec_Grpc{Grpc: &grpc_plugins.ServiceSpec{
+					ReflectionMetadata: map[string]string{"Authorization": "Bearer token"},
+				}}},
+			}},
+			SslConfig: &v1.UpstreamSslConfig{
+				SslSecrets: &v1.UpstreamSslConfig_SecretRef{
+					SecretRef: &core.ResourceRef{Name: "tls", Namespace: "gloo-system"},
+				},
+				VerifySubjectAltName: []string{"gateway-proxy"},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		server.Stop()
+	})
+
+	detectFunctions := func() error {
+		f := &UpstreamFunctionDiscovery{upstream: upstream}
+		dependencies := func() fds.Dependencies { return fds.Dependencies{Secrets: secrets} }
+		return f.DetectFunctionsOnce(context.TODO(), url_, dependencies, func(mutator fds.UpstreamMutator) error {
+			return mutator(upstream)
+		})
+	}
+
+	It("should discover the services of the upstream", func() {
+		Expect(detectFunctions()).NotTo(HaveOccurred())
+		Expect(getgrpcspec(upstream).GetGrpcServices()).To(ConsistOf(&grpc_plugins.ServiceSpec_GrpcService{
+			PackageName:   "grpc.health.v1",
+			ServiceName:   "Health",
+			FunctionNames: []string{"Check", "Watch"},
+		}))
+		Expect(getgrpcspec(upstream).GetReflectionMetadata()).To(HaveKey("Authorization"))
+	})
+
+	It("should fail without the reflection metadata", func() {
+		getgrpcspec(upstream).ReflectionMetadata = nil
+		Expect(detectFunctions()).To(HaveOccurred())
+	})
+
+	It("should fail if the certificate of the server doesn't have the subject alt name", func() {
+		upstream.SslConfig.VerifySubjectAltName = []string{"other"}
+		Expect(detectFunctions()).To(HaveOccurred())
+	})
+
+	It("should fail if the secret of the ssl config isn't a tls secret", func() {
+		secrets[0].Kind = &v1.Secret_Aws{Aws: &v1.AwsSecret{}}
+		Expect(detectFunctions()).To(MatchError(ContainSubstring("is not a TLS secret")))
+	})
+})
@@ -0,0 +1,162 @@
+package grpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+var (
+	SdsNotSupportedError = errors.New("function discovery can't use the SDS certificates of the upstream ssl config")
+
+	NotTlsSecretError = func(name string) error {
+		return errors.Errorf("secret %v is not a TLS secret", name)
+	}
+
+	InvalidRootCaError = errors.New("the root ca of the upstream ssl config doesn't contain a valid certificate")
+
+	SubjectAltNameMismatchError = func(sans []string) error {
+		return errors.Errorf("the certificate of the server doesn't match any of the subject alt names %v", sans)
+	}
+)
+
+// Builds the TLS config to call the reflection service of the upstream with, from its ssl config.
+// Like envoy, the certificate of the server is only verified if the ssl config has a root ca.
+func tlsConfig(sslConfig *v1.UpstreamSslConfig, secrets v1.SecretList) (*tls.Config, error) {
+	var certChain, privateKey, rootCa string
+	switch {
+	case sslConfig.GetSecretRef() != nil:
+		ref := sslConfig.GetSecretRef()
+		secret, err := secrets.Find(ref.Strings())
+		if err != nil {
+			return nil, errors.Wrapf(err, "finding the secret of the upstream ssl config")
+		}
+		tlsSecret := secret.GetTls()
+		if tlsSecret == nil {
+			return nil, NotTlsSecretError(ref.Key())
+		}
+		certChain, privateKey, rootCa = tlsSecret.GetCertChain(), tlsSecret.GetPrivateKey(), tlsSecret.GetRootCa()
+	case sslConfig.GetSslFiles() != nil:
+		files := sslConfig.GetSslFiles()
+		for _, file := range []struct {
+			name string
+			data *string
+		}{
+			{files.GetTlsCert(), &certChain},
+			{files.GetTlsKey(), &privateKey},
+			{files.GetRootCa(), &rootCa},
+		} {
+			if file.name == "" {
+				continue
+			}
+			data, err := ioutil.ReadFile(file.name)
+			if err != nil {
+				return nil, errors.Wrapf(err, "reading the file %v of the upstream ssl config", file.name)
+			}
+			*file.data = string(data)
+		}
+	case sslConfig.GetSds() != nil:
+		return nil, SdsNotSupportedError
+	}
+
+	cfg := &tls.Config{
+		ServerName: sslConfig.GetSni(),
+		// the certificate is verified below instead, as the host the reflection service is dialed on
+		// usually isn't the name the certificate is issued for
+		InsecureSkipVerify: true,
+	}
+	if certChain != "" && privateKey != "" {
+		cert, err := tls.X509KeyPair([]byte(certChain), []byte(privateKey))
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing the certificate of the upstream ssl config")
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	if rootCa != "" {
+		roots := x509.NewCertPool()
+		if !roots.AppendCertsFromPEM([]byte(rootCa)) {
+			return nil, InvalidRootCaError
+		}
+		cfg.VerifyPeerCertificate = verifyPeerCertificate(roots, sslConfig.GetVerifySubjectAltName())
+	}
+	if params := sslConfig.GetParameters(); params != nil {
+		cfg.MinVersion = tlsVersion(params.GetMinimumProtocolVersion())
+		cfg.MaxVersion = tlsVersion(params.GetMaximumProtocolVersion())
+	}
+	return cfg, nil
+}
+
+// verifies the certificate chain of the server against the root ca, and its subject alt names if any are given
+func verifyPeerCertificate(roots *x509.CertPool, subjectAltNames []string) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		var certs []*x509.Certificate
+		for _, raw := range rawCerts {
+			cert, err := x509.ParseCertificate(raw)
+			if err != nil {
+				return errors.Wrapf(err, "parsing the certificate of the server")
+			}
+			certs = append(certs, cert)
+		}
+		if len(certs) == 0 {
+			return errors.New("the server didn't present a certificate")
+		}
+		intermediates := x509.NewCertPool()
+		for _, cert := range certs[1:] {
+			intermediates.AddCert(cert)
+		}
+		if _, err := certs[0].Verify(x509.VerifyOptions{Roots: roots, Intermediates: intermediates}); err != nil {
+			return errors.Wrapf(err, "verifying the certificate of the server")
+		}
+		if len(subjectAltNames) == 0 {
+			return nil
+		}
+		for _, san := range subjectAltNames {
+			if certificateHasSubjectAltName(certs[0], san) {
+				return nil
+			}
+		}
+		return SubjectAltNameMismatchError(subjectAltNames)
+	}
+}
+
+func certificateHasSubjectAltName(cert *x509.Certificate, san string) bool {
+	for _, name := range cert.DNSNames {
+		if name == san {
+			return true
+		}
+	}
+	for _, uri := range cert.URIs {
+		if uri.String() == san {
+			return true
+		}
+	}
+	for _, ip := range cert.IPAddresses {
+		if ip.String() == san {
+			return true
+		}
+	}
+	for _, email := range cert.EmailAddresses {
+		if email == san {
+			return true
+		}
+	}
+	return false
+}
+
+func tlsVersion(version v1.SslParameters_ProtocolVersion) uint16 {
+	switch version {
+	case v1.SslParameters_TLSv1_0:
+		return tls.VersionTLS10
+	case v1.SslParameters_TLSv1_1:
+		return tls.VersionTLS11
+	case v1.SslParameters_TLSv1_2:
+		return tls.VersionTLS12
+	case v1.SslParameters_TLSv1_3:
+		return tls.VersionTLS13
+	}
+	// TLS_AUTO leaves the choice to the tls package
+	return 0
+}
@@ -0,0 +1,69 @@
+package swagger
+
+import (
+	"context"
+	"hash/fnv"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/go-openapi/swag"
+)
+
+// identifies a version of a document, by the validators the server sent with it and the hash of its content
+type documentVersion struct {
+	etag         string
+	lastModified string
+	hash         uint64
+}
+
+// Remembers the version of the last document whose functions were discovered, so that polling a document which
+// hasn't changed neither downloads it again, if the server supports conditional requests, nor parses it again.
+type documentCache struct {
+	last documentVersion
+}
+
+// Loads the document at the url, and returns its version and whether it has changed since the last version.
+// The document is nil if the server answered that it wasn't modified.
+// The version is saved as the last one by the caller, once the functions of the document have been discovered.
+func (c *documentCache) load(ctx context.Context, url string) ([]byte, documentVersion, bool, error) {
+	version := documentVersion{}
+	notModified := false
+	loadHTTP := func(path string) ([]byte, error) {
+		header := http.Header{}
+		if c.last.etag != "" {
+			header.Set("If-None-Match", c.last.etag)
+		}
+		if c.last.lastModified != "" {
+			header.Set("If-Modified-Since", c.last.lastModified)
+		}
+		docBytes, resp, err := loadHTTPDocument(ctx, path, header)
+		if err != nil {
+			return nil, err
+		}
+		if docBytes == nil && resp.StatusCode == http.StatusNotModified {
+			notModified = true
+			return nil, nil
+		}
+		version.etag = resp.Header.Get("ETag")
+		version.lastModified = resp.Header.Get("Last-Modified")
+		return docBytes, nil
+	}
+
+	docBytes, err := swag.LoadStrategy(url, ioutil.ReadFile, loadHTTP)(url)
+	if err != nil {
+		return nil, version, false, err
+	}
+	if notModified {
+		return nil, c.last, false, nil
+	}
+
+	hasher := fnv.New64a()
+	hasher.Write(docBytes)
+	version.hash = hasher.Sum64()
+	if version.hash == c.last.hash {
+		// only the validators of the document may have changed
+		c.last = version
+		return docBytes, version, false, nil
+	}
+	return docBytes, version, true, nil
+}
@@ -0,0 +1,90 @@
+package swagger
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("documentCache", func() {
+
+	var (
+		server   *httptest.Server
+		document string
+		etag     string
+		requests int
+		cache    documentCache
+	)
+
+	BeforeEach(func() {
+		document = `{"swagger": "2.0"}`
+		etag = `"1"`
+		requests = 0
+		cache = documentCache{}
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if etag != "" {
+				if r.Header.Get("If-None-Match") == etag {
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				w.Header().Set("ETag", etag)
+			}
+			fmt.Fprint(w, document)
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	load := func() ([]byte, bool) {
+		docBytes, version, changed, err := cache.load(context.TODO(), server.URL+"/swagger.json")
+		Expect(err).NotTo(HaveOccurred())
+		cache.last = version
+		return docBytes, changed
+	}
+
+	It("should not download a document which wasn't modified again", func() {
+		docBytes, changed := load()
+		Expect(changed).To(BeTrue())
+		Expect(string(docBytes)).To(Equal(document))
+
+		docBytes, changed = load()
+		Expect(changed).To(BeFalse())
+		Expect(docBytes).To(BeNil())
+		Expect(requests).To(Equal(2))
+
+		document = `{"swagger": "2.0", "basePath": "/v2"}`
+		etag = `"2"`
+		docBytes, changed = load()
+		Expect(changed).To(BeTrue())
+		Expect(string(docBytes)).To(Equal(document))
+	})
+
+	It("should compare the content of documents if the server doesn't support conditional requests", func() {
+		etag = ""
+		_, changed := load()
+		Expect(changed).To(BeTrue())
+
+		_, changed = load()
+		Expect(changed).To(BeFalse())
+
+		document = `{"swagger": "2.0", "basePath": "/v2"}`
+		_, changed = load()
+		Expect(changed).To(BeTrue())
+	})
+
+	It("should discover the functions of a document again until they have been saved", func() {
+		_, _, changed, err := cache.load(context.TODO(), server.URL+"/swagger.json")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+
+		_, changed = load()
+		Expect(changed).To(BeTrue())
+	})
+})
@@ -0,0 +1,391 @@
+package swagger
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	errors "github.com/rotisserie/eris"
+	transformation_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
+	"github.com/solo-io/go-utils/log"
+)
+
+const (
+	schemaRefPrefix      = "#/components/schemas/"
+	parameterRefPrefix   = "#/components/parameters/"
+	requestBodyRefPrefix = "#/components/requestBodies/"
+)
+
+var (
+	// matches the templated segments of paths and server urls, e.g. `{id}`
+	pathTemplateRegex = regexp.MustCompile(`{([^{}]+)}`)
+)
+
+// The parts of an OpenAPI 3.0 document needed to discover functions, the rest of the document is ignored
+type openApi3Document struct {
+	OpenApi    string                       `json:"openapi"`
+	Servers    []openApi3Server             `json:"servers"`
+	Paths      map[string]*openApi3PathItem `json:"paths"`
+	Components openApi3Components           `json:"components"`
+}
+
+type openApi3Server struct {
+	Url       string                            `json:"url"`
+	Variables map[string]openApi3ServerVariable `json:"variables"`
+}
+
+type openApi3ServerVariable struct {
+	Default string `json:"default"`
+}
+
+type openApi3Components struct {
+	Schemas       map[string]*openApi3Schema      `json:"schemas"`
+	Parameters    map[string]*openApi3Parameter   `json:"parameters"`
+	RequestBodies map[string]*openApi3RequestBody `json:"requestBodies"`
+}
+
+type openApi3PathItem struct {
+	Servers    []openApi3Server     `json:"servers"`
+	Parameters []*openApi3Parameter `json:"parameters"`
+	Get        *openApi3Operation   `json:"get"`
+	Put        *openApi3Operation   `json:"put"`
+	Post       *openApi3Operation   `json:"post"`
+	Delete     *openApi3Operation   `json:"delete"`
+	Options    *openApi3Operation   `json:"options"`
+	Head       *openApi3Operation   `json:"head"`
+	Patch      *openApi3Operation   `json:"patch"`
+	Trace      *openApi3Operation   `json:"trace"`
+}
+
+type openApi3Operation struct {
+	OperationId string               `json:"operationId"`
+	Servers     []openApi3Server     `json:"servers"`
+	Parameters  []*openApi3Parameter `json:"parameters"`
+	RequestBody *openApi3RequestBody `json:"requestBody"`
+}
+
+type openApi3Parameter struct {
+	Ref    string          `json:"$ref"`
+	Name   string          `json:"name"`
+	In     string          `json:"in"`
+	Style  string          `json:"style"`
+	Schema *openApi3Schema `json:"schema"`
+}
+
+type openApi3RequestBody struct {
+	Ref     string                       `json:"$ref"`
+	Content map[string]openApi3MediaType `json:"content"`
+}
+
+type openApi3MediaType struct {
+	Schema *openApi3Schema `json:"schema"`
+}
+
+type openApi3Schema struct {
+	Ref        string                     `json:"$ref"`
+	Type       string                     `json:"type"`
+	Properties map[string]*openApi3Schema `json:"properties"`
+	Default    interface{}                `json:"default"`
+	AllOf      []*openApi3Schema          `json:"allOf"`
+	OneOf      []*openApi3Schema          `json:"oneOf"`
+	AnyOf      []*openApi3Schema          `json:"anyOf"`
+}
+
+// returns true if the json or yaml document declares an OpenAPI 3.x version
+func isOpenApi3Doc(docBytes []byte) bool {
+	jsn, err := yaml.YAMLToJSON(docBytes)
+	if err != nil {
+		return false
+	}
+	var version struct {
+		OpenApi string `json:"openapi"`
+	}
+	if err := json.Unmarshal(jsn, &version); err != nil {
+		return false
+	}
+	return strings.HasPrefix(version.OpenApi, "3.")
+}
+
+func parseOpenApi3Doc(docBytes []byte) (*openApi3Document, error) {
+	jsn, err := yaml.YAMLToJSON(docBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert openapi doc to json")
+	}
+	var doc openApi3Document
+	if err := json.Unmarshal(jsn, &doc); err != nil {
+		return nil, errors.Wrap(err, "invalid openapi doc")
+	}
+	if !strings.HasPrefix(doc.OpenApi, "3.") {
+		return nil, errors.Errorf("unsupported openapi version %q, expected 3.x", doc.OpenApi)
+	}
+	if doc.Paths == nil {
+		return nil, errors.New("openapi doc has no paths")
+	}
+	return &doc, nil
+}
+
+// creates a function for each operation of the document
+func createFunctionsForOpenApi3Doc(doc *openApi3Document) map[string]*transformation_plugins.TransformationTemplate {
+	funcs := make(map[string]*transformation_plugins.TransformationTemplate)
+	for functionPath, pathItem := range doc.Paths {
+		if pathItem == nil {
+			continue
+		}
+		appendFunction := func(method string, operation *openApi3Operation) {
+			if operation == nil {
+				return
+			}
+			// servers and parameters declared on operations take precedence over the ones of paths and documents
+			servers := doc.Servers
+			if len(pathItem.Servers) > 0 {
+				servers = pathItem.Servers
+			}
+			if len(operation.Servers) > 0 {
+				servers = operation.Servers
+			}
+			params := doc.mergeParameters(pathItem.Parameters, operation.Parameters)
+			name, trans := doc.createFunctionForOperation(method, openApi3BasePath(servers), functionPath, operation, params)
+			funcs[name] = trans
+		}
+		appendFunction("GET", pathItem.Get)
+		appendFunction("PUT", pathItem.Put)
+		appendFunction("POST", pathItem.Post)
+		appendFunction("DELETE", pathItem.Delete)
+		appendFunction("OPTIONS", pathItem.Options)
+		appendFunction("HEAD", pathItem.Head)
+		appendFunction("PATCH", pathItem.Patch)
+		appendFunction("TRACE", pathItem.Trace)
+	}
+	return funcs
+}
+
+func (doc *openApi3Document) createFunctionForOperation(method, basePath, functionPath string, operation *openApi3Operation, params []*openApi3Parameter) (string, *transformation_plugins.TransformationTemplate) {
+	var queryParams, cookieParams []string
+	pathParams := make(map[string]*openApi3Parameter)
+	headersTemplate := make(map[string]string)
+	for _, param := range params {
+		// sort parameters by the template they will go into
+		switch param.In {
+		case "path":
+			pathParams[param.Name] = param
+		case "query":
+			if param.Style == "deepObject" {
+				log.Warnf("deepObject query params not currently supported; ignoring")
+				continue
+			}
+			queryParams = append(queryParams, fmt.Sprintf("%v={{default(%v, \"\")}}", param.Name, param.Name))
+		case "header":
+			headersTemplate[param.Name] = fmt.Sprintf("{{default(%v, \"\")}}", param.Name)
+		case "cookie":
+			cookieParams = append(cookieParams, fmt.Sprintf("%v={{default(%v, \"\")}}", param.Name, param.Name))
+		}
+	}
+	if len(cookieParams) > 0 {
+		headersTemplate["cookie"] = strings.Join(cookieParams, "; ")
+	}
+
+	path := openApi3PathToJinjaTemplate(basePath+functionPath, pathParams)
+	if len(queryParams) > 0 {
+		path += "?" + strings.Join(queryParams, "&")
+	}
+
+	var body *string
+	if schema := doc.jsonRequestBodySchema(operation.RequestBody); schema != nil {
+		body = doc.getBodyTemplate("", schema, nil)
+	}
+
+	fnName := operation.OperationId
+	if fnName == "" {
+		fnName = defaultFunctionName(method, functionPath)
+	}
+
+	return fnName, createTransformation(method, path, headersTemplate, body)
+}
+
+// the parameters of an operation, including the ones of its path which it doesn't override
+func (doc *openApi3Document) mergeParameters(pathParams, operationParams []*openApi3Parameter) []*openApi3Parameter {
+	var params []*openApi3Parameter
+	overridden := make(map[string]bool)
+	for _, param := range operationParams {
+		if param = doc.resolveParameter(param); param != nil {
+			overridden[param.In+"/"+param.Name] = true
+			params = append(params, param)
+		}
+	}
+	for _, param := range pathParams {
+		if param = doc.resolveParameter(param); param != nil && !overridden[param.In+"/"+param.Name] {
+			params = append(params, param)
+		}
+	}
+	// idempotency
+	sort.SliceStable(params, func(i, j int) bool {
+		return params[i].Name < params[j].Name
+	})
+	return params
+}
+
+func (doc *openApi3Document) resolveParameter(param *openApi3Parameter) *openApi3Parameter {
+	if param == nil || param.Ref == "" {
+		return param
+	}
+	return doc.Components.Parameters[strings.TrimPrefix(param.Ref, parameterRefPrefix)]
+}
+
+// the schema of the json content of the request body, if any
+func (doc *openApi3Document) jsonRequestBodySchema(requestBody *openApi3RequestBody) *openApi3Schema {
+	if requestBody != nil && requestBody.Ref != "" {
+		requestBody = doc.Components.RequestBodies[strings.TrimPrefix(requestBody.Ref, requestBodyRefPrefix)]
+	}
+	if requestBody == nil {
+		return nil
+	}
+	mediaType, ok := requestBody.Content["application/json"]
+	if !ok {
+		return nil
+	}
+	return mediaType.Schema
+}
+
+// resolves the reference of the schema, and merges the properties of its allOf schemas
+// refs tracks the references being resolved, to break cycles
+func (doc *openApi3Document) resolveSchema(schema *openApi3Schema, refs map[string]bool) *openApi3Schema {
+	for schema != nil && schema.Ref != "" {
+		if refs[schema.Ref] {
+			return nil
+		}
+		refs[schema.Ref] = true
+		schema = doc.Components.Schemas[strings.TrimPrefix(schema.Ref, schemaRefPrefix)]
+	}
+	if schema == nil || len(schema.AllOf) == 0 {
+		return schema
+	}
+
+	merged := &openApi3Schema{
+		Type:       schema.Type,
+		Properties: make(map[string]*openApi3Schema),
+		Default:    schema.Default,
+		OneOf:      schema.OneOf,
+		AnyOf:      schema.AnyOf,
+	}
+	for key, prop := range schema.Properties {
+		merged.Properties[key] = prop
+	}
+	for _, sub := range schema.AllOf {
+		sub = doc.resolveSchema(sub, copyRefs(refs))
+		if sub == nil {
+			continue
+		}
+		if merged.Type == "" {
+			merged.Type = sub.Type
+		}
+		for key, prop := range sub.Properties {
+			merged.Properties[key] = prop
+		}
+		merged.OneOf = append(merged.OneOf, sub.OneOf...)
+		merged.AnyOf = append(merged.AnyOf, sub.AnyOf...)
+	}
+	return merged
+}
+
+// Builds the template of a json body from its schema, with a parameter for each of its properties.
+// Returns nil if the body can't be templated, e.g. if it is one of several schemas, in which case
+// the body is passed through as is.
+func (doc *openApi3Document) getBodyTemplate(parent string, schema *openApi3Schema, refs map[string]bool) *string {
+	if refs == nil {
+		refs = make(map[string]bool)
+	}
+	schema = doc.resolveSchema(schema, refs)
+	if schema == nil || len(schema.OneOf) > 0 || len(schema.AnyOf) > 0 || len(schema.Properties) == 0 {
+		return nil
+	}
+
+	var fields []string
+	for key, prop := range schema.Properties {
+		paramName := key
+		if parent != "" {
+			paramName = parent + "." + key
+		}
+		resolved := doc.resolveSchema(prop, copyRefs(refs))
+		if resolved == nil {
+			resolved = &openApi3Schema{}
+		}
+		// nested objects are templated field by field
+		if resolved.Type == "object" {
+			if nested := doc.getBodyTemplate(paramName, resolved, copyRefs(refs)); nested != nil {
+				fields = append(fields, fmt.Sprintf(`"%v": %v`, key, *nested))
+				continue
+			}
+		}
+		fields = append(fields, fmt.Sprintf(`"%v": %v`, key, typedParameterTemplate(paramName, resolved)))
+	}
+	// idempotency
+	sort.Strings(fields)
+	bodyTemplate := "{" + strings.Join(fields, ",") + "}"
+	return &bodyTemplate
+}
+
+// the template of a body parameter, which is quoted for strings and inserted as json otherwise
+func typedParameterTemplate(paramName string, schema *openApi3Schema) string {
+	if schema.Type == "string" {
+		defaultValue := ""
+		if schema.Default != nil {
+			defaultValue = fmt.Sprintf("%v", schema.Default)
+		}
+		return fmt.Sprintf(`"{{ default(%v, %q) }}"`, paramName, defaultValue)
+	}
+	defaultValue := "null"
+	if schema.Default != nil {
+		if jsn, err := json.Marshal(schema.Default); err == nil {
+			defaultValue = string(jsn)
+		}
+	}
+	return fmt.Sprintf(`{{ default(%v, %v) }}`, paramName, defaultValue)
+}
+
+// the base path of the first server, with its variables replaced by their defaults
+func openApi3BasePath(servers []openApi3Server) string {
+	if len(servers) == 0 {
+		return ""
+	}
+	server := servers[0]
+	serverUrl := pathTemplateRegex.ReplaceAllStringFunc(server.Url, func(match string) string {
+		return server.Variables[strings.Trim(match, "{}")].Default
+	})
+	parsed, err := url.Parse(serverUrl)
+	if err != nil {
+		log.Warnf("invalid openapi server url %v; ignoring", serverUrl)
+		return ""
+	}
+	return strings.TrimSuffix(parsed.Path, "/")
+}
+
+// replaces the templated segments of the path by parameters, serialized according to their style
+func openApi3PathToJinjaTemplate(path string, params map[string]*openApi3Parameter) string {
+	return pathTemplateRegex.ReplaceAllStringFunc(path, func(match string) string {
+		name := strings.Trim(match, "{}")
+		value := fmt.Sprintf("{{ default(%v, \"\") }}", name)
+		var style string
+		if param, ok := params[name]; ok {
+			style = param.Style
+		}
+		switch style {
+		case "label":
+			return "." + value
+		case "matrix":
+			return ";" + name + "=" + value
+		}
+		return value
+	})
+}
+
+func copyRefs(refs map[string]bool) map[string]bool {
+	copied := make(map[string]bool, len(refs))
+	for ref := range refs {
+		copied[ref] = true
+	}
+	return copied
+}
@@ -0,0 +1,179 @@
+package swagger
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	transformation_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+)
+
+const petstoreOpenApi3 = `
+openapi: 3.0.1
+servers:
+- url: "{scheme}://petstore.example.com/{version}/"
+  variables:
+    scheme:
+      default: https
+    version:
+      default: v3
+paths:
+  /pets/{petId}:
+    parameters:
+    - $ref: "#/components/parameters/petId"
+    get:
+      operationId: getPet
+      parameters:
+      - name: X-Request-Id
+        in: header
+        schema:
+          type: string
+      - name: session
+        in: cookie
+        schema:
+          type: string
+    put:
+      operationId: updatePet
+      requestBody:
+        $ref: "#/components/requestBodies/Pet"
+  /pets/{petId}/photos{format}:
+    get:
+      servers:
+      - url: /media
+      parameters:
+      - $ref: "#/components/parameters/petId"
+      - name: format
+        in: path
+        style: label
+        schema:
+          type: string
+      - name: limit
+        in: query
+        schema:
+          type: integer
+  /pets:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema:
+              oneOf:
+              - $ref: "#/components/schemas/Cat"
+              - $ref: "#/components/schemas/Dog"
+components:
+  parameters:
+    petId:
+      name: petId
+      in: path
+      required: true
+      schema:
+        type: integer
+  requestBodies:
+    Pet:
+      content:
+        application/json:
+          schema:
+            $ref: "#/components/schemas/Pet"
+  schemas:
+    Pet:
+      allOf:
+      - $ref: "#/components/schemas/NewPet"
+      - type: object
+        properties:
+          id:
+            type: integer
+    NewPet:
+      type: object
+      properties:
+        name:
+          type: string
+        status:
+          type: string
+          default: available
+        vaccinated:
+          type: boolean
+        owner:
+          type: object
+          properties:
+            email:
+              type: string
+    Cat:
+      type: object
+    Dog:
+      type: object
+`
+
+var _ = Describe("OpenAPI 3", func() {
+
+	var funcs map[string]*transformation_plugins.TransformationTemplate
+
+	BeforeEach(func() {
+		Expect(isOpenApi3Doc([]byte(petstoreOpenApi3))).To(BeTrue())
+		doc, err := parseOpenApi3Doc([]byte(petstoreOpenApi3))
+		Expect(err).NotTo(HaveOccurred())
+		funcs = createFunctionsForOpenApi3Doc(doc)
+	})
+
+	headers := func(name string) map[string]string {
+		Expect(funcs).To(HaveKey(name))
+		texts := make(map[string]string)
+		for key, value := range funcs[name].GetHeaders() {
+			texts[key] = value.GetText()
+		}
+		return texts
+	}
+
+	It("should not treat swagger 2.0 documents as openapi 3.0", func() {
+		Expect(isOpenApi3Doc([]byte(`{"swagger": "2.0", "paths": {}}`))).To(BeFalse())
+	})
+
+	It("should create a function for each operation", func() {
+		Expect(funcs).To(HaveLen(4))
+		Expect(funcs).To(HaveKey("getPet"))
+		Expect(funcs).To(HaveKey("updatePet"))
+		Expect(funcs).To(HaveKey("get.pets.{petId}.photos{format}"))
+		Expect(funcs).To(HaveKey("post.pets"))
+	})
+
+	It("should template the path parameters under the base path of the server", func() {
+		Expect(headers("getPet")).To(HaveKeyWithValue(":path", `/v3/pets/{{ default(petId, "") }}`))
+	})
+
+	It("should template header and cookie parameters", func() {
+		Expect(headers("getPet")).To(HaveKeyWithValue("X-Request-Id", `{{default(X-Request-Id, "")}}`))
+		Expect(headers("getPet")).To(HaveKeyWithValue("cookie", `session={{default(session, "")}}`))
+	})
+
+	It("should use the servers of operations and the styles of path parameters", func() {
+		Expect(headers("get.pets.{petId}.photos{format}")).To(HaveKeyWithValue(":path",
+			`/media/pets/{{ default(petId, "") }}/photos.{{ default(format, "") }}?limit={{default(limit, "")}}`))
+	})
+
+	It("should template the typed properties of json bodies", func() {
+		Expect(funcs["updatePet"].GetBody().GetText()).To(Equal(`{` +
+			`"id": {{ default(id, null) }},` +
+			`"name": "{{ default(name, "") }}",` +
+			`"owner": {"email": "{{ default(owner.email, "") }}"},` +
+			`"status": "{{ default(status, "available") }}",` +
+			`"vaccinated": {{ default(vaccinated, null) }}}`))
+	})
+
+	It("should pass through bodies which are one of several schemas", func() {
+		Expect(funcs["post.pets"].GetPassthrough()).NotTo(BeNil())
+	})
+
+	It("should detect the functions of inline openapi 3.0 documents", func() {
+		upstream := &v1.Upstream{
+			UpstreamType: &v1.Upstream_Static{Static: &static.UpstreamSpec{}},
+		}
+		f := &SwaggerFunctionDiscovery{upstream: upstream}
+		err := f.detectFunctionsFromInline(context.TODO(), petstoreOpenApi3, upstream, func(mutator fds.UpstreamMutator) error {
+			return mutator(upstream)
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(upstream.GetStatic().GetServiceSpec().GetRest().GetTransformations()).To(Equal(funcs))
+	})
+})
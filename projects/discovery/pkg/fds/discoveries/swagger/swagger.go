@@ -30,6 +30,9 @@ var commonSwaggerURIs = []string{
 	"/swagger/docs/v2",
 	"/v1/swagger",
 	"/v2/swagger",
+	"/openapi.json",
+	"/openapi.yaml",
+	"/v3/api-docs",
 }
 
 // TODO(yuval-k): run this in a back off for a limited amount of time, with high initial retry.
@@ -78,7 +81,7 @@ func (f *SwaggerFunctionDiscovery) IsFunctional() bool {
 	return getswagspec(f.upstream) != nil
 }
 
-func (f *SwaggerFunctionDiscovery) DetectType(ctx context.Context, baseurl *url.URL) (*plugins.ServiceSpec, error) {
+func (f *SwaggerFunctionDiscovery) DetectType(ctx context.Context, baseurl *url.URL, _ func() fds.Dependencies) (*plugins.ServiceSpec, error) {
 	var spec *plugins.ServiceSpec
 
 	err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{MaxDuration: &f.detectionTimeout}).Backoff(ctx, func(ctx context.Context) error {
@@ -128,7 +131,7 @@ func (f *SwaggerFunctionDiscovery) detectUpstreamTypeOnce(ctx context.Context, b
 		}
 		// might have found a swagger service
 		if res.StatusCode == http.StatusOK {
-			if _, err := RetrieveSwaggerDocFromUrl(ctx, url); err != nil {
+			if err := validateDocFromUrl(ctx, url); err != nil {
 				// first check if this is a context error
 				if ctx.Err() != nil {
 					return nil, ctx.Err()
@@ -179,17 +182,23 @@ func (f *SwaggerFunctionDiscovery) DetectFunctions(ctx context.Context, url *url
 }
 
 func (f *SwaggerFunctionDiscovery) detectFunctionsFromUrl(ctx context.Context, url string, in *v1.Upstream, updatecb func(fds.UpstreamMutator) error) error {
+	var cache documentCache
 	for {
 		err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, func(ctx context.Context) error {
 
-			spec, err := RetrieveSwaggerDocFromUrl(ctx, url)
+			docBytes, version, changed, err := cache.load(ctx, url)
 			if err != nil {
-				return err
+				return errors.Wrap(err, "loading swagger doc from url")
+			}
+			if !changed {
+				// the functions of the document have already been discovered
+				return nil
 			}
-			err = f.detectFunctionsFromSpec(ctx, spec, in, updatecb)
+			err = f.detectFunctionsFromDoc(ctx, docBytes, in, updatecb)
 			if err != nil {
 				return err
 			}
+			cache.last = version
 			return nil
 		})
 		if err != nil {
@@ -199,7 +208,7 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromUrl(ctx context.Context, u
 			// ignore other erros as we would like to continue forever.
 		}
 
-		if err := contextutils.Sleep(ctx, f.functionPollTime); err != nil {
+		if err := contextutils.Sleep(ctx, fds.RediscoveryInterval(ctx, in, f.functionPollTime)); err != nil {
 			return err
 		}
 	}
@@ -207,7 +216,19 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromUrl(ctx context.Context, u
 }
 
 func (f *SwaggerFunctionDiscovery) detectFunctionsFromInline(ctx context.Context, document string, in *v1.Upstream, updatecb func(fds.UpstreamMutator) error) error {
-	spec, err := parseSwaggerDoc([]byte(document))
+	return f.detectFunctionsFromDoc(ctx, []byte(document), in, updatecb)
+}
+
+// detects the functions of either a Swagger 2.0 or an OpenAPI 3.0 document
+func (f *SwaggerFunctionDiscovery) detectFunctionsFromDoc(ctx context.Context, docBytes []byte, in *v1.Upstream, updatecb func(fds.UpstreamMutator) error) error {
+	if isOpenApi3Doc(docBytes) {
+		doc, err := parseOpenApi3Doc(docBytes)
+		if err != nil {
+			return err
+		}
+		return updateFunctions(createFunctionsForOpenApi3Doc(doc), updatecb)
+	}
+	spec, err := parseSwaggerDoc(docBytes)
 	if err != nil {
 		return err
 	}
@@ -241,6 +262,11 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromSpec(ctx context.Context,
 		createFunctionsForPath(funcs, swaggerSpec.BasePath, functionPath, pathItem.PathItemProps, swaggerSpec.Definitions)
 	}
 
+	return updateFunctions(funcs, updatecb)
+}
+
+// sets the discovered functions on the rest service spec of the upstream
+func updateFunctions(funcs map[string]*transformation_plugins.TransformationTemplate, updatecb func(fds.UpstreamMutator) error) error {
 	return updatecb(func(u *v1.Upstream) error {
 		upstreamSpec, ok := u.UpstreamType.(v1.ServiceSpecMutator)
 		if !ok {
@@ -265,6 +291,20 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromSpec(ctx context.Context,
 	})
 }
 
+// checks that the document at the url is either a valid Swagger 2.0 or OpenAPI 3.0 document
+func validateDocFromUrl(ctx context.Context, url string) error {
+	docBytes, err := LoadFromFileOrHTTP(ctx, url)
+	if err != nil {
+		return errors.Wrap(err, "loading swagger doc from url")
+	}
+	if isOpenApi3Doc(docBytes) {
+		_, err = parseOpenApi3Doc(docBytes)
+	} else {
+		_, err = parseSwaggerDoc(docBytes)
+	}
+	return err
+}
+
 func RetrieveSwaggerDocFromUrl(ctx context.Context, url string) (*openapi.Swagger, error) {
 	docBytes, err := LoadFromFileOrHTTP(ctx, url)
 	if err != nil {
@@ -279,29 +319,43 @@ func LoadFromFileOrHTTP(ctx context.Context, url string) ([]byte, error) {
 
 func loadHTTPBytes(ctx context.Context) func(path string) ([]byte, error) {
 	return func(path string) ([]byte, error) {
-		req, err := http.NewRequest("GET", path, nil)
-		if err != nil {
-			return nil, err
-		}
-		req = req.WithContext(ctx)
-		resp, err := http.DefaultClient.Do(req)
-		defer func() {
-			if resp != nil {
-				if e := resp.Body.Close(); e != nil {
-					contextutils.LoggerFrom(ctx).Debug(e)
-				}
-			}
-		}()
-		if err != nil {
-			return nil, err
-		}
+		docBytes, _, err := loadHTTPDocument(ctx, path, nil)
+		return docBytes, err
+	}
+}
 
-		if resp.StatusCode != http.StatusOK {
-			return nil, fmt.Errorf("could not access document at %q [%s] ", path, resp.Status)
+// GETs the document at the path with the given headers. The response is returned as well, for its headers,
+// and the document is nil if the response is 304 Not Modified.
+func loadHTTPDocument(ctx context.Context, path string, header http.Header) ([]byte, *http.Response, error) {
+	req, err := http.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req = req.WithContext(ctx)
+	resp, err := http.DefaultClient.Do(req)
+	defer func() {
+		if resp != nil {
+			if e := resp.Body.Close(); e != nil {
+				contextutils.LoggerFrom(ctx).Debug(e)
+			}
 		}
+	}()
+	if err != nil {
+		return nil, nil, err
+	}
 
-		return ioutil.ReadAll(resp.Body)
+	if resp.StatusCode == http.StatusNotModified && len(header) > 0 {
+		return nil, resp, nil
 	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("could not access document at %q [%s] ", path, resp.Status)
+	}
+
+	docBytes, err := ioutil.ReadAll(resp.Body)
+	return docBytes, resp, err
 }
 
 func parseSwaggerDoc(docBytes []byte) (*openapi.Swagger, error) {
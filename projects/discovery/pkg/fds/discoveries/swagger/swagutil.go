@@ -65,30 +65,33 @@ func createFunctionForOpertaion(method string, basePath, functionPath string, op
 		path += "?" + strings.Join(queryParams, "&")
 	}
 
-	headersTemplate := map[string]string{":method": method}
-
+	headersTemplate := make(map[string]string)
 	for _, name := range headerParams {
 		headersTemplate[name] = fmt.Sprintf("{{default(%v, \"\")}}", name)
 	}
 
 	fnName := operation.ID
 	if fnName == "" {
-		fnName = strings.ToLower(method) + strings.Replace(functionPath, "/", ".", -1)
+		fnName = defaultFunctionName(method, functionPath)
 	}
 
-	// build transformation:
+	return fnName, createTransformation(method, path, headersTemplate, body)
+}
 
-	headerTemplatesForTransform := make(map[string]*transformation_plugins.InjaTemplate)
+func defaultFunctionName(method, functionPath string) string {
+	return strings.ToLower(method) + strings.Replace(functionPath, "/", ".", -1)
+}
 
-	needsTransformation := body != nil
+// builds the transformation of a function, from the templates of its path, headers and body
+func createTransformation(method, path string, headersTemplate map[string]string, body *string) *transformation_plugins.TransformationTemplate {
+	headerTemplatesForTransform := make(map[string]*transformation_plugins.InjaTemplate)
+	headerTemplatesForTransform[":method"] = &transformation_plugins.InjaTemplate{Text: method}
 
 	for k, v := range headersTemplate {
-		needsTransformation = true
 		headerTemplatesForTransform[k] = &transformation_plugins.InjaTemplate{Text: v}
 	}
 
 	if path != "" {
-		needsTransformation = true
 		headerTemplatesForTransform[":path"] = &transformation_plugins.InjaTemplate{Text: path}
 	}
 
@@ -100,14 +103,9 @@ func createFunctionForOpertaion(method string, basePath, functionPath string, op
 		clearBody := ""
 		body = &clearBody
 	} else {
-		needsTransformation = true
 		headerTemplatesForTransform["content-type"] = &transformation_plugins.InjaTemplate{Text: "application/json"}
 	}
 
-	// this function doesn't request any kind of transformation
-	if !needsTransformation {
-		return fnName, nil
-	}
 	transtemplate := &transformation_plugins.TransformationTemplate{
 		Headers: headerTemplatesForTransform,
 	}
@@ -124,7 +122,7 @@ func createFunctionForOpertaion(method string, basePath, functionPath string, op
 			}}
 	}
 
-	return fnName, transtemplate
+	return transtemplate
 }
 
 func getBodyTemplate(parent string, schema spec.SchemaProps, definitions spec.Definitions) string {
@@ -0,0 +1,36 @@
+package fds
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+// Upstreams with this label, or discovered from services with it, are polled for functions on the interval of its
+// value, e.g. `5m`, instead of the default interval of the function discovery of the upstream
+const RediscoveryIntervalLabelKey = "discovery.solo.io/function_discovery_interval"
+
+// discovery of an upstream which has given up, e.g. because the upstream has no functions, is started again
+// after this interval if the upstream hasn't changed in the meantime
+var DefaultRediscoveryInterval = 5 * time.Minute
+
+// Returns the interval of the rediscovery label of the upstream, or the default interval if it doesn't have one.
+func RediscoveryInterval(ctx context.Context, us *v1.Upstream, defaultInterval time.Duration) time.Duration {
+	// Fall back to Metadata labels to support legacy Upstreams if needed
+	value, ok := us.GetDiscoveryMetadata().GetLabels()[RediscoveryIntervalLabelKey]
+	if !ok {
+		value, ok = us.GetMetadata().Labels[RediscoveryIntervalLabelKey]
+	}
+	if !ok {
+		return defaultInterval
+	}
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval <= 0 {
+		contextutils.LoggerFrom(ctx).Warnw("invalid rediscovery interval, using the default",
+			"upstream", us.GetMetadata().Ref().Key(), "value", value, "default", defaultInterval)
+		return defaultInterval
+	}
+	return interval
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
 	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/aws"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/graphql"
 	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/grpc"
 	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/swagger"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -74,6 +75,10 @@ func RunFDS(opts bootstrap.Opts) error {
 			DetectionTimeout: time.Minute,
 			FunctionPollTime: time.Second * 15,
 		},
+		&graphql.FunctionDiscoveryFactory{
+			DetectionTimeout: time.Minute,
+			FunctionPollTime: time.Second * 15,
+		},
 	}
 
 	// TODO(yuval-k): max Concurrency here
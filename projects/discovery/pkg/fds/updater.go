@@ -6,10 +6,12 @@ import (
 	"net/url"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/hashutils"
 	"go.uber.org/zap"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -31,6 +33,11 @@ type updaterUpdater struct {
 	upstream          *v1.Upstream
 	functionalPlugins []UpstreamFunctionDiscovery
 
+	// the discovery hash of the upstream as last seen or written by discovery
+	hash uint64
+	// when discovery of the upstream gave up, in unix nanoseconds, 0 while it is running
+	finished int64
+
 	parent *Updater
 }
 
@@ -103,6 +110,9 @@ func (u *Updater) createDiscoveries(upstream *v1.Upstream) []UpstreamFunctionDis
 }
 
 func (u *Updater) UpstreamUpdated(upstream *v1.Upstream) {
+	if existing, ok := u.activeupstreams[upstream.GetMetadata().Ref()]; ok && !existing.shouldRestart(upstream) {
+		return
+	}
 	// remove and re-add for now. think if we want to be sophisticated later.
 	u.UpstreamRemoved(upstream)
 	u.UpstreamAdded(upstream)
@@ -120,11 +130,13 @@ func (u *Updater) UpstreamAdded(upstream *v1.Upstream) {
 		ctx:               ctx,
 		upstream:          upstream,
 		functionalPlugins: u.createDiscoveries(upstream),
+		hash:              discoveryHash(upstream),
 		parent:            u,
 	}
 	u.activeupstreams[key] = updater
 	go func() {
 		updater.Run()
+		atomic.StoreInt64(&updater.finished, time.Now().UnixNano())
 		cancel()
 		// TODO(yuval-k): consider removing upstream from map.
 		// need to be careful here as there might be a race if an update happens in the same time.
@@ -139,6 +151,33 @@ func (u *Updater) UpstreamRemoved(upstream *v1.Upstream) {
 	}
 }
 
+// Discovery of an upstream is only started again if the upstream has changed since it was last seen or written
+// by discovery, or if discovery gave up on it and its rediscovery interval has passed since. Otherwise every
+// resync would poll all the upstreams again.
+func (u *updaterUpdater) shouldRestart(upstream *v1.Upstream) bool {
+	if atomic.LoadUint64(&u.hash) != discoveryHash(upstream) {
+		return true
+	}
+	finished := atomic.LoadInt64(&u.finished)
+	if finished == 0 {
+		return false
+	}
+	return time.Since(time.Unix(0, finished)) >= RediscoveryInterval(u.parent.ctx, upstream, DefaultRediscoveryInterval)
+}
+
+// hashes the upstream without the fields which change without affecting discovery
+func discoveryHash(upstream *v1.Upstream) uint64 {
+	clone := proto.Clone(upstream).(*v1.Upstream)
+	clone.Metadata.ResourceVersion = ""
+	clone.Status = core.Status{}
+	return hashutils.MustHash(clone)
+}
+
+func (u *updaterUpdater) setUpstream(upstream *v1.Upstream) {
+	u.upstream = upstream
+	atomic.StoreUint64(&u.hash, discoveryHash(upstream))
+}
+
 func (u *updaterUpdater) saveUpstream(mutator UpstreamMutator) error {
 	logger := contextutils.LoggerFrom(u.ctx)
 	logger.Debugw("Updating upstream with functions", "upstream", u.upstream.Metadata.Name)
@@ -167,7 +206,7 @@ func (u *updaterUpdater) saveUpstream(mutator UpstreamMutator) error {
 			return err
 		}
 	} else {
-		u.upstream = newupstream
+		u.setUpstream(newupstream)
 		return nil
 	}
 	// try again with the new one
@@ -184,7 +223,7 @@ func (u *updaterUpdater) saveUpstream(mutator UpstreamMutator) error {
 	if err != nil {
 		logger.Warnw("error updating upstream on second try", "upstream", u.upstream.Metadata.Name, "error", err)
 	} else {
-		u.upstream = newupstream
+		u.setUpstream(newupstream)
 	}
 	// TODO: if write failed, we are retrying. we should consider verifying that the error is indeed due to resource conflict,
 
@@ -204,7 +243,7 @@ func (u *updaterUpdater) detectSingle(fp UpstreamFunctionDiscovery, url url.URL,
 	}
 
 	contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(u.ctx, func(ctx context.Context) error {
-		spec, err := fp.DetectType(ctx, &url)
+		spec, err := fp.DetectType(ctx, &url, u.dependencies)
 		if err != nil {
 			return err
 		}
@@ -42,7 +42,8 @@ type testDiscovery struct {
 	detectFunctionsError       error
 	mutate                     UpstreamMutator
 
-	functionsCalled atomic.Value
+	functionsCalled      atomic.Value
+	detectFunctionsCalls int32
 }
 
 func (t *testDiscovery) getFunctionsCalled() functionsCalled {
@@ -63,7 +64,7 @@ func (t *testDiscovery) IsFunctional() bool {
 	t.setFunctionsCalled(fc)
 	return t.isUpstreamFunctionalResult
 }
-func (t *testDiscovery) DetectType(ctx context.Context, url *url.URL) (*plugins.ServiceSpec, error) {
+func (t *testDiscovery) DetectType(ctx context.Context, url *url.URL, dependencies func() Dependencies) (*plugins.ServiceSpec, error) {
 	fc := t.getFunctionsCalled()
 	fc.detectUpstreamType = true
 	t.setFunctionsCalled(fc)
@@ -74,6 +75,7 @@ func (t *testDiscovery) DetectFunctions(ctx context.Context, url *url.URL, depen
 	fc := t.getFunctionsCalled()
 	fc.detectFunctions = true
 	t.setFunctionsCalled(fc)
+	atomic.AddInt32(&t.detectFunctionsCalls, 1)
 	if t.mutate != nil {
 		out(t.mutate)
 	}
@@ -149,4 +151,61 @@ var _ = Describe("Updater", func() {
 		Expect(fc.detectFunctions).To(BeTrue())
 	})
 
+	Context("upstream updates", func() {
+
+		BeforeEach(func() {
+			testDisc.isUpstreamFunctionalResult = true
+			testDisc.detectFunctionsError = fmt.Errorf("no functions")
+		})
+
+		detectFunctionsCalls := func() int32 {
+			return atomic.LoadInt32(&testDisc.detectFunctionsCalls)
+		}
+
+		It("should not discover an unchanged upstream again", func() {
+			updater.UpstreamAdded(up)
+			Eventually(detectFunctionsCalls).Should(BeEquivalentTo(1))
+
+			resynced := *up
+			resynced.Metadata.ResourceVersion = "2"
+			updater.UpstreamUpdated(&resynced)
+			Consistently(detectFunctionsCalls, time.Second/10).Should(BeEquivalentTo(1))
+		})
+
+		It("should discover a changed upstream again", func() {
+			updater.UpstreamAdded(up)
+			Eventually(detectFunctionsCalls).Should(BeEquivalentTo(1))
+
+			changed := *up
+			changed.Metadata.Labels = map[string]string{"app": "petstore"}
+			updater.UpstreamUpdated(&changed)
+			Eventually(detectFunctionsCalls).Should(BeEquivalentTo(2))
+		})
+
+		It("should discover an unchanged upstream again after its rediscovery interval", func() {
+			up.Metadata.Labels = map[string]string{RediscoveryIntervalLabelKey: "100ms"}
+			updater.UpstreamAdded(up)
+			Eventually(detectFunctionsCalls).Should(BeEquivalentTo(1))
+
+			updater.UpstreamUpdated(up)
+			Expect(detectFunctionsCalls()).To(BeEquivalentTo(1))
+
+			time.Sleep(time.Second / 5)
+			updater.UpstreamUpdated(up)
+			Eventually(detectFunctionsCalls).Should(BeEquivalentTo(2))
+		})
+	})
+
+	Context("rediscovery interval", func() {
+
+		It("should use the interval of the label of the upstream", func() {
+			up.DiscoveryMetadata = &v1.DiscoveryMetadata{Labels: map[string]string{RediscoveryIntervalLabelKey: "5m"}}
+			Expect(RediscoveryInterval(ctx, up, time.Second)).To(Equal(5 * time.Minute))
+		})
+
+		It("should use the default interval if the label is invalid", func() {
+			up.Metadata.Labels = map[string]string{RediscoveryIntervalLabelKey: "often"}
+			Expect(RediscoveryInterval(ctx, up, time.Second)).To(Equal(time.Second))
+		})
+	})
 })
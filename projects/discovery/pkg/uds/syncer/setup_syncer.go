@@ -1,10 +1,14 @@
 package syncer
 
 import (
+	"text/template"
+
+	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/pkg/utils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
 	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/errutils"
@@ -12,6 +16,7 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/common/kubernetes"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 func RunUDS(opts bootstrap.Opts) error {
@@ -70,9 +75,13 @@ func RunUDS(opts bootstrap.Opts) error {
 
 	errs := make(chan error)
 
+	discOpts, err := discoveryOpts(opts.Settings)
+	if err != nil {
+		return err
+	}
+
 	uds := discovery.NewUpstreamDiscovery(watchNamespaces, opts.WriteNamespace, upstreamClient, discoveryPlugins)
-	// TODO(ilackarms) expose discovery options
-	udsErrs, err := uds.StartUds(watchOpts, discovery.Opts{})
+	udsErrs, err := uds.StartUds(watchOpts, discOpts)
 	if err != nil {
 		return err
 	}
@@ -104,3 +113,36 @@ func RunUDS(opts bootstrap.Opts) error {
 	}()
 	return nil
 }
+
+// builds the discovery options from the uds options of the settings
+func discoveryOpts(settings *v1.Settings) (discovery.Opts, error) {
+	var discOpts discovery.Opts
+	udsOptions := settings.GetDiscovery().GetUdsOptions()
+	discOpts.KubeOpts.IncludedNamespaces = udsOptions.GetIncludedNamespaces()
+	discOpts.KubeOpts.ExcludedNamespaces = udsOptions.GetExcludedNamespaces()
+	if labelSelector := udsOptions.GetLabelSelector(); labelSelector != "" {
+		selector, err := labels.Parse(labelSelector)
+		if err != nil {
+			return discOpts, eris.Wrapf(err, "parsing the label selector of the uds options")
+		}
+		discOpts.KubeOpts.Selector = selector
+	}
+	if nameTemplate := udsOptions.GetUpstreamNameTemplate(); nameTemplate != "" {
+		tmpl, err := kubeplugin.ParseUpstreamTemplate("upstreamNameTemplate", nameTemplate)
+		if err != nil {
+			return discOpts, eris.Wrapf(err, "parsing the upstream name template of the uds options")
+		}
+		discOpts.KubeOpts.UpstreamNameTemplate = tmpl
+	}
+	for key, value := range udsOptions.GetUpstreamLabels() {
+		tmpl, err := kubeplugin.ParseUpstreamTemplate(key, value)
+		if err != nil {
+			return discOpts, eris.Wrapf(err, "parsing the template of the upstream label %s of the uds options", key)
+		}
+		if discOpts.KubeOpts.UpstreamLabelTemplates == nil {
+			discOpts.KubeOpts.UpstreamLabelTemplates = make(map[string]*template.Template)
+		}
+		discOpts.KubeOpts.UpstreamLabelTemplates[key] = tmpl
+	}
+	return discOpts, nil
+}
@@ -50,10 +50,13 @@ type Gateway struct {
 	// The type of gateway being created
 	// HttpGateway creates a listener with an http_connection_manager
 	// TcpGateway creates a listener with a tcp proxy filter
+	// UdpGateway creates a udp listener with a udp proxy filter
 	//
 	// Types that are valid to be assigned to GatewayType:
 	//	*Gateway_HttpGateway
 	//	*Gateway_TcpGateway
+	//	*Gateway_HybridGateway
+	//	*Gateway_UdpGateway
 	GatewayType isGateway_GatewayType `protobuf_oneof:"GatewayType"`
 	//
 	// Names of the [`Proxy`](https://gloo.solo.io/api/github.com/solo-io/gloo/projects/gloo/api/v1/proxy.proto.sk/)
@@ -114,9 +117,17 @@ type Gateway_HttpGateway struct {
 type Gateway_TcpGateway struct {
 	TcpGateway *TcpGateway `protobuf:"bytes,10,opt,name=tcp_gateway,json=tcpGateway,proto3,oneof" json:"tcp_gateway,omitempty"`
 }
+type Gateway_HybridGateway struct {
+	HybridGateway *HybridGateway `protobuf:"bytes,11,opt,name=hybrid_gateway,json=hybridGateway,proto3,oneof" json:"hybrid_gateway,omitempty"`
+}
+type Gateway_UdpGateway struct {
+	UdpGateway *UdpGateway `protobuf:"bytes,12,opt,name=udp_gateway,json=udpGateway,proto3,oneof" json:"udp_gateway,omitempty"`
+}
 
-func (*Gateway_HttpGateway) isGateway_GatewayType() {}
-func (*Gateway_TcpGateway) isGateway_GatewayType()  {}
+func (*Gateway_HttpGateway) isGateway_GatewayType()   {}
+func (*Gateway_TcpGateway) isGateway_GatewayType()    {}
+func (*Gateway_HybridGateway) isGateway_GatewayType() {}
+func (*Gateway_UdpGateway) isGateway_GatewayType()    {}
 
 func (m *Gateway) GetGatewayType() isGateway_GatewayType {
 	if m != nil {
@@ -188,6 +199,20 @@ func (m *Gateway) GetTcpGateway() *TcpGateway {
 	return nil
 }
 
+func (m *Gateway) GetHybridGateway() *HybridGateway {
+	if x, ok := m.GetGatewayType().(*Gateway_HybridGateway); ok {
+		return x.HybridGateway
+	}
+	return nil
+}
+
+func (m *Gateway) GetUdpGateway() *UdpGateway {
+	if x, ok := m.GetGatewayType().(*Gateway_UdpGateway); ok {
+		return x.UdpGateway
+	}
+	return nil
+}
+
 func (m *Gateway) GetProxyNames() []string {
 	if m != nil {
 		return m.ProxyNames
@@ -200,6 +225,8 @@ func (*Gateway) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Gateway_HttpGateway)(nil),
 		(*Gateway_TcpGateway)(nil),
+		(*Gateway_HybridGateway)(nil),
+		(*Gateway_UdpGateway)(nil),
 	}
 }
 
@@ -218,10 +245,18 @@ type HttpGateway struct {
 	// Setting '*' will search all namespaces, equivalent to omitting this value.
 	VirtualServiceNamespaces []string `protobuf:"bytes,3,rep,name=virtual_service_namespaces,json=virtualServiceNamespaces,proto3" json:"virtual_service_namespaces,omitempty"`
 	// HTTP Gateway configuration
-	Options              *v1.HttpListenerOptions `protobuf:"bytes,8,opt,name=options,proto3" json:"options,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	Options *v1.HttpListenerOptions `protobuf:"bytes,8,opt,name=options,proto3" json:"options,omitempty"`
+	// Certificates served by this gateway, selected by the SNI domains of each config. Only used if `ssl` is true.
+	// Virtual services without their own `sslConfig` are served by this gateway if all of their domains are
+	// matched by these certificates, so one gateway can serve many virtual services with few certificates.
+	// A config without `sniDomains` is the default certificate, served to clients that match no other config.
+	// SNI domains can be exact, or wildcards of the form `*.example.com`, which match all the subdomains of
+	// `example.com`. The SNI domains of the virtual services' own ssl configs take precedence, followed by the
+	// configs in this list in order; a domain already claimed by a previous config is dropped from later ones.
+	SslConfigurations    []*v1.SslConfig `protobuf:"bytes,9,rep,name=ssl_configurations,json=sslConfigurations,proto3" json:"ssl_configurations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *HttpGateway) Reset()         { *m = HttpGateway{} }
@@ -276,6 +311,145 @@ func (m *HttpGateway) GetOptions() *v1.HttpListenerOptions {
 	return nil
 }
 
+func (m *HttpGateway) GetSslConfigurations() []*v1.SslConfig {
+	if m != nil {
+		return m.SslConfigurations
+	}
+	return nil
+}
+
+// A HybridGateway serves HTTP and TCP gateways on the same port. Each connection is handled by the gateway with
+// the most specific matcher, so that e.g. TLS passed through to a TCP upstream and TLS terminated by an
+// HTTP gateway can share port 443.
+// The `ssl` flag of the Gateway is not used: TLS is terminated for the connections of the matchers with an
+// `sslConfig`, and the ssl configs of the virtual services are ignored.
+type HybridGateway struct {
+	// the gateways served on the port, with the matchers that select them.
+	// the matchers of the gateways must be distinct.
+	MatchedGateways      []*MatchedGateway `protobuf:"bytes,1,rep,name=matched_gateways,json=matchedGateways,proto3" json:"matched_gateways,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *HybridGateway) Reset()         { *m = HybridGateway{} }
+func (m *HybridGateway) String() string { return proto.CompactTextString(m) }
+func (*HybridGateway) ProtoMessage()    {}
+func (*HybridGateway) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30f7529f6633771c, []int{2}
+}
+func (m *HybridGateway) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HybridGateway.Unmarshal(m, b)
+}
+func (m *HybridGateway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HybridGateway.Marshal(b, m, deterministic)
+}
+func (m *HybridGateway) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HybridGateway.Merge(m, src)
+}
+func (m *HybridGateway) XXX_Size() int {
+	return xxx_messageInfo_HybridGateway.Size(m)
+}
+func (m *HybridGateway) XXX_DiscardUnknown() {
+	xxx_messageInfo_HybridGateway.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HybridGateway proto.InternalMessageInfo
+
+func (m *HybridGateway) GetMatchedGateways() []*MatchedGateway {
+	if m != nil {
+		return m.MatchedGateways
+	}
+	return nil
+}
+
+type MatchedGateway struct {
+	// the connections handled by this gateway
+	Matcher *v1.Matcher `protobuf:"bytes,1,opt,name=matcher,proto3" json:"matcher,omitempty"`
+	// Types that are valid to be assigned to GatewayType:
+	//	*MatchedGateway_HttpGateway
+	//	*MatchedGateway_TcpGateway
+	GatewayType          isMatchedGateway_GatewayType `protobuf_oneof:"GatewayType"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *MatchedGateway) Reset()         { *m = MatchedGateway{} }
+func (m *MatchedGateway) String() string { return proto.CompactTextString(m) }
+func (*MatchedGateway) ProtoMessage()    {}
+func (*MatchedGateway) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30f7529f6633771c, []int{3}
+}
+func (m *MatchedGateway) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchedGateway.Unmarshal(m, b)
+}
+func (m *MatchedGateway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchedGateway.Marshal(b, m, deterministic)
+}
+func (m *MatchedGateway) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchedGateway.Merge(m, src)
+}
+func (m *MatchedGateway) XXX_Size() int {
+	return xxx_messageInfo_MatchedGateway.Size(m)
+}
+func (m *MatchedGateway) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchedGateway.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchedGateway proto.InternalMessageInfo
+
+type isMatchedGateway_GatewayType interface {
+	isMatchedGateway_GatewayType()
+	Equal(interface{}) bool
+}
+
+type MatchedGateway_HttpGateway struct {
+	HttpGateway *HttpGateway `protobuf:"bytes,2,opt,name=http_gateway,json=httpGateway,proto3,oneof" json:"http_gateway,omitempty"`
+}
+type MatchedGateway_TcpGateway struct {
+	TcpGateway *TcpGateway `protobuf:"bytes,3,opt,name=tcp_gateway,json=tcpGateway,proto3,oneof" json:"tcp_gateway,omitempty"`
+}
+
+func (*MatchedGateway_HttpGateway) isMatchedGateway_GatewayType() {}
+func (*MatchedGateway_TcpGateway) isMatchedGateway_GatewayType()  {}
+
+func (m *MatchedGateway) GetGatewayType() isMatchedGateway_GatewayType {
+	if m != nil {
+		return m.GatewayType
+	}
+	return nil
+}
+
+func (m *MatchedGateway) GetMatcher() *v1.Matcher {
+	if m != nil {
+		return m.Matcher
+	}
+	return nil
+}
+
+func (m *MatchedGateway) GetHttpGateway() *HttpGateway {
+	if x, ok := m.GetGatewayType().(*MatchedGateway_HttpGateway); ok {
+		return x.HttpGateway
+	}
+	return nil
+}
+
+func (m *MatchedGateway) GetTcpGateway() *TcpGateway {
+	if x, ok := m.GetGatewayType().(*MatchedGateway_TcpGateway); ok {
+		return x.TcpGateway
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*MatchedGateway) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*MatchedGateway_HttpGateway)(nil),
+		(*MatchedGateway_TcpGateway)(nil),
+	}
+}
+
 type TcpGateway struct {
 	// TCP hosts that the gateway can route to
 	TcpHosts []*v1.TcpHost `protobuf:"bytes,1,rep,name=tcp_hosts,json=tcpHosts,proto3" json:"tcp_hosts,omitempty"`
@@ -290,7 +464,7 @@ func (m *TcpGateway) Reset()         { *m = TcpGateway{} }
 func (m *TcpGateway) String() string { return proto.CompactTextString(m) }
 func (*TcpGateway) ProtoMessage()    {}
 func (*TcpGateway) Descriptor() ([]byte, []int) {
-	return fileDescriptor_30f7529f6633771c, []int{2}
+	return fileDescriptor_30f7529f6633771c, []int{4}
 }
 func (m *TcpGateway) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpGateway.Unmarshal(m, b)
@@ -324,11 +498,63 @@ func (m *TcpGateway) GetOptions() *v1.TcpListenerOptions {
 	return nil
 }
 
+// A UDP gateway forwards the datagrams it receives to an upstream
+type UdpGateway struct {
+	// The upstream to forward the datagrams to
+	Destination *v1.Destination `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	// UDP Gateway configuration
+	Options              *v1.UdpListenerOptions `protobuf:"bytes,8,opt,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *UdpGateway) Reset()         { *m = UdpGateway{} }
+func (m *UdpGateway) String() string { return proto.CompactTextString(m) }
+func (*UdpGateway) ProtoMessage()    {}
+func (*UdpGateway) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30f7529f6633771c, []int{5}
+}
+func (m *UdpGateway) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UdpGateway.Unmarshal(m, b)
+}
+func (m *UdpGateway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UdpGateway.Marshal(b, m, deterministic)
+}
+func (m *UdpGateway) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UdpGateway.Merge(m, src)
+}
+func (m *UdpGateway) XXX_Size() int {
+	return xxx_messageInfo_UdpGateway.Size(m)
+}
+func (m *UdpGateway) XXX_DiscardUnknown() {
+	xxx_messageInfo_UdpGateway.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UdpGateway proto.InternalMessageInfo
+
+func (m *UdpGateway) GetDestination() *v1.Destination {
+	if m != nil {
+		return m.Destination
+	}
+	return nil
+}
+
+func (m *UdpGateway) GetOptions() *v1.UdpListenerOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Gateway)(nil), "gateway.solo.io.Gateway")
 	proto.RegisterType((*HttpGateway)(nil), "gateway.solo.io.HttpGateway")
 	proto.RegisterMapType((map[string]string)(nil), "gateway.solo.io.HttpGateway.VirtualServiceSelectorEntry")
+	proto.RegisterType((*HybridGateway)(nil), "gateway.solo.io.HybridGateway")
+	proto.RegisterType((*MatchedGateway)(nil), "gateway.solo.io.MatchedGateway")
 	proto.RegisterType((*TcpGateway)(nil), "gateway.solo.io.TcpGateway")
+	proto.RegisterType((*UdpGateway)(nil), "gateway.solo.io.UdpGateway")
 }
 
 func init() {
@@ -336,53 +562,64 @@ func init() {
 }
 
 var fileDescriptor_30f7529f6633771c = []byte{
-	// 727 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x4e, 0xdb, 0x40,
-	0x10, 0xc6, 0x49, 0x80, 0x64, 0x0d, 0x82, 0xae, 0x28, 0x32, 0xe1, 0x2f, 0x44, 0xaa, 0x9a, 0x4b,
-	0x6d, 0x15, 0x0e, 0x45, 0x69, 0xa9, 0x44, 0xa4, 0xaa, 0xf4, 0x8f, 0x22, 0x83, 0x38, 0xf4, 0x12,
-	0x39, 0xce, 0xc6, 0x71, 0x31, 0xd9, 0xd5, 0xee, 0x38, 0x10, 0xa9, 0x27, 0x5e, 0xa1, 0x2f, 0x51,
-	0xf5, 0x09, 0xfa, 0x08, 0x7d, 0x0a, 0x0e, 0x7d, 0x03, 0x2a, 0xf5, 0x5e, 0xed, 0x7a, 0x9d, 0x10,
-	0xd3, 0xa0, 0xde, 0x76, 0xe6, 0x9b, 0xef, 0xf3, 0xcc, 0xec, 0xb7, 0x46, 0x7b, 0x41, 0x08, 0xdd,
-	0xb8, 0x65, 0xfb, 0xf4, 0xdc, 0x11, 0x34, 0xa2, 0x4f, 0x42, 0xea, 0x04, 0x11, 0xa5, 0x0e, 0xe3,
-	0xf4, 0x33, 0xf1, 0x41, 0x38, 0x81, 0x07, 0xe4, 0xc2, 0x1b, 0x38, 0x1e, 0x0b, 0x9d, 0xfe, 0xd3,
-	0x34, 0xb4, 0x19, 0xa7, 0x40, 0xf1, 0x42, 0x1a, 0x4a, 0xae, 0x1d, 0xd2, 0xf2, 0x52, 0x40, 0x03,
-	0xaa, 0x30, 0x47, 0x9e, 0x92, 0xb2, 0x32, 0x26, 0x97, 0x90, 0x24, 0xc9, 0x25, 0xe8, 0xdc, 0x46,
-	0x40, 0x69, 0x10, 0x11, 0x47, 0x45, 0xad, 0xb8, 0xe3, 0x5c, 0x70, 0x8f, 0x31, 0xc2, 0x45, 0x8a,
-	0xab, 0x76, 0xce, 0x42, 0x48, 0xbf, 0x7c, 0x4e, 0xc0, 0x6b, 0x7b, 0xe0, 0x69, 0x7c, 0x2d, 0x8b,
-	0x0b, 0xf0, 0x20, 0x4e, 0xd9, 0x2b, 0x59, 0x94, 0x93, 0xce, 0x24, 0xe1, 0x34, 0xd6, 0xf8, 0xa3,
-	0xcc, 0xfc, 0x32, 0xd2, 0x95, 0x8c, 0xd3, 0x4b, 0x3d, 0x7a, 0xf9, 0xf1, 0xe4, 0x32, 0xca, 0x20,
-	0xa4, 0x3d, 0xdd, 0x4a, 0xf5, 0x7b, 0x01, 0xcd, 0xbe, 0x4e, 0xd6, 0x84, 0x17, 0x51, 0x5e, 0x88,
-	0xc8, 0x32, 0x2a, 0x46, 0xad, 0xe8, 0xca, 0x23, 0xde, 0x42, 0x73, 0xad, 0xb0, 0xd7, 0x6e, 0x7a,
-	0xed, 0x36, 0x27, 0x42, 0x58, 0xf9, 0x8a, 0x51, 0x2b, 0xb9, 0xa6, 0xcc, 0xed, 0x27, 0x29, 0xbc,
-	0x8a, 0x4a, 0xaa, 0x84, 0x51, 0x0e, 0x56, 0xa1, 0x62, 0xd4, 0xe6, 0xdd, 0xa2, 0x4c, 0x1c, 0x51,
-	0x0e, 0xf8, 0x19, 0x9a, 0xd5, 0x9f, 0xb3, 0xa6, 0x2b, 0x46, 0xcd, 0xdc, 0x5e, 0xb7, 0x65, 0x2b,
-	0xe9, 0x85, 0xd8, 0xef, 0x43, 0x01, 0xa4, 0x47, 0xf8, 0xc7, 0xa4, 0xc8, 0x4d, 0xab, 0xf1, 0x3b,
-	0x34, 0x93, 0x6c, 0xcc, 0x9a, 0x51, 0xbc, 0x25, 0xdb, 0xa7, 0x9c, 0x0c, 0x79, 0xc7, 0x0a, 0x6b,
-	0xac, 0xff, 0xf8, 0x53, 0x30, 0x7e, 0x5e, 0x6f, 0x4e, 0xfd, 0xbe, 0xde, 0x7c, 0x00, 0x44, 0x40,
-	0x3b, 0xec, 0x74, 0xea, 0xd5, 0x30, 0xe8, 0x51, 0x4e, 0xaa, 0xae, 0x96, 0xc0, 0xbb, 0xa8, 0x98,
-	0x5e, 0x8f, 0x35, 0xab, 0xe4, 0x96, 0xc7, 0xe5, 0x3e, 0x68, 0xb4, 0x51, 0x90, 0x62, 0xee, 0xb0,
-	0x1a, 0x37, 0xd0, 0x42, 0x2c, 0x48, 0x53, 0x6d, 0xb6, 0xa9, 0x16, 0x66, 0x15, 0x95, 0x40, 0xd9,
-	0x4e, 0x0c, 0x62, 0xa7, 0x06, 0xb1, 0x1b, 0x94, 0x46, 0xa7, 0x5e, 0x14, 0x13, 0x77, 0x3e, 0x16,
-	0xe4, 0x48, 0x32, 0x8e, 0x94, 0x0b, 0xf7, 0xd1, 0x5c, 0x17, 0x80, 0x35, 0xb5, 0x19, 0xad, 0x92,
-	0x12, 0x58, 0xb3, 0x33, 0xe6, 0xb4, 0x0f, 0x00, 0x98, 0xbe, 0x89, 0x83, 0x29, 0xd7, 0xec, 0x8e,
-	0x42, 0xfc, 0x12, 0x99, 0xe0, 0x8f, 0x14, 0x90, 0x52, 0x58, 0xbd, 0xa3, 0x70, 0xe2, 0xdf, 0x12,
-	0x40, 0x30, 0x8c, 0xf0, 0x26, 0x32, 0x93, 0x11, 0x7a, 0xde, 0x39, 0x11, 0xd6, 0x5c, 0x25, 0x5f,
-	0x2b, 0xb9, 0x48, 0xa5, 0x0e, 0x65, 0xa6, 0xbe, 0x7c, 0x75, 0x53, 0x28, 0xa0, 0x5c, 0x70, 0x71,
-	0x75, 0x53, 0x40, 0xb8, 0xa8, 0x85, 0x45, 0x63, 0x1e, 0x99, 0x5a, 0xe3, 0x64, 0xc0, 0x48, 0xf5,
-	0x6b, 0x1e, 0x99, 0xb7, 0xda, 0xc4, 0x6f, 0xd1, 0x62, 0x3f, 0xe4, 0x10, 0x7b, 0x51, 0x53, 0x10,
-	0xde, 0x0f, 0x7d, 0x22, 0x2c, 0xa3, 0x92, 0xaf, 0x99, 0xdb, 0x2b, 0xe3, 0x0b, 0x76, 0x89, 0xa0,
-	0x31, 0xf7, 0x89, 0x4b, 0x3a, 0x7a, 0xc7, 0x0b, 0x9a, 0x78, 0xac, 0x79, 0x98, 0x23, 0x2b, 0xa3,
-	0xd5, 0x14, 0x24, 0x22, 0x3e, 0x50, 0x6e, 0xe5, 0x94, 0xe6, 0xee, 0x7d, 0x2b, 0xb3, 0x4f, 0xc7,
-	0xf4, 0x8e, 0x35, 0xf5, 0x55, 0x0f, 0xf8, 0xc0, 0x5d, 0xee, 0xff, 0x13, 0xc4, 0x2f, 0x50, 0x39,
-	0xfb, 0x4d, 0xb5, 0x21, 0xe6, 0xc9, 0x49, 0xf2, 0x6a, 0x4d, 0xd6, 0x38, 0xf7, 0x70, 0x88, 0xe3,
-	0xe7, 0x23, 0x73, 0x27, 0xa6, 0xd8, 0x1a, 0x37, 0xb7, 0xec, 0x6e, 0x92, 0xc1, 0xcb, 0x6f, 0xd0,
-	0xea, 0x3d, 0x1d, 0xcb, 0xa7, 0x78, 0x46, 0x06, 0xea, 0x29, 0x96, 0x5c, 0x79, 0xc4, 0x4b, 0x68,
-	0xba, 0x2f, 0xed, 0x65, 0xe5, 0x54, 0x2e, 0x09, 0xea, 0xb9, 0x5d, 0xa3, 0xfa, 0x05, 0xa1, 0xd1,
-	0xcd, 0xe3, 0x6d, 0x54, 0x92, 0x5e, 0xe9, 0x52, 0x01, 0xe9, 0x65, 0x3c, 0x1c, 0xef, 0xeb, 0xc4,
-	0x67, 0x07, 0x54, 0x80, 0x5b, 0x84, 0xe4, 0x20, 0x70, 0x3d, 0x3b, 0x49, 0xe5, 0x0e, 0x63, 0xd2,
-	0x20, 0x8d, 0x3d, 0xf9, 0x06, 0xbf, 0xfd, 0xda, 0x30, 0x3e, 0xed, 0xfc, 0xf7, 0xdf, 0x9a, 0x9d,
-	0x05, 0xfa, 0x6f, 0xd4, 0x9a, 0x51, 0x0f, 0x68, 0xe7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x68,
-	0x17, 0x9a, 0x44, 0xeb, 0x05, 0x00, 0x00,
+	// 908 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x0e, 0x25, 0xd9, 0x96, 0x86, 0x56, 0x9c, 0x2c, 0x5c, 0x97, 0x96, 0x13, 0x5b, 0x51, 0x51,
+	0x54, 0x97, 0x92, 0xa8, 0x73, 0xa8, 0xe1, 0x34, 0x05, 0xa2, 0xfe, 0xc4, 0xfd, 0x49, 0x6a, 0xac,
+	0x9c, 0x1c, 0xda, 0x83, 0x40, 0x91, 0x2b, 0x8a, 0x35, 0xa5, 0x25, 0x76, 0x97, 0xb2, 0x05, 0xf4,
+	0x14, 0xa0, 0xef, 0xd2, 0x7b, 0x2f, 0x7d, 0x84, 0xde, 0x7b, 0x2c, 0x90, 0x43, 0xdf, 0x20, 0x05,
+	0x7a, 0x2f, 0x76, 0xb9, 0x14, 0x45, 0xaa, 0x4a, 0x5b, 0xe4, 0xb6, 0x33, 0xf3, 0xcd, 0xc7, 0x6f,
+	0xe7, 0x67, 0x25, 0x78, 0x18, 0x84, 0x62, 0x9c, 0x0c, 0x6d, 0x8f, 0x4e, 0x1c, 0x4e, 0x23, 0xfa,
+	0x7e, 0x48, 0x9d, 0x20, 0xa2, 0xd4, 0x89, 0x19, 0xfd, 0x9e, 0x78, 0x82, 0x3b, 0x81, 0x2b, 0xc8,
+	0x95, 0x3b, 0x77, 0xdc, 0x38, 0x74, 0x66, 0x1f, 0x64, 0xa6, 0x1d, 0x33, 0x2a, 0x28, 0xda, 0xc9,
+	0x4c, 0x99, 0x6b, 0x87, 0xb4, 0xb5, 0x1b, 0xd0, 0x80, 0xaa, 0x98, 0x23, 0x4f, 0x29, 0xac, 0x85,
+	0xc8, 0xb5, 0x48, 0x9d, 0xe4, 0x5a, 0x68, 0xdf, 0x61, 0x40, 0x69, 0x10, 0x11, 0x47, 0x59, 0xc3,
+	0x64, 0xe4, 0x5c, 0x31, 0x37, 0x8e, 0x09, 0xe3, 0x59, 0x5c, 0xc9, 0xb9, 0x0c, 0x45, 0xf6, 0xe5,
+	0x09, 0x11, 0xae, 0xef, 0x0a, 0x57, 0xc7, 0xef, 0x94, 0xe3, 0x5c, 0xb8, 0x22, 0xc9, 0xb2, 0xf7,
+	0xcb, 0x51, 0x46, 0x46, 0xeb, 0x88, 0x33, 0x5b, 0xc7, 0xdf, 0x29, 0xdd, 0x5f, 0x5a, 0x19, 0x92,
+	0x47, 0x1a, 0xf4, 0xee, 0x7a, 0x50, 0xcc, 0xe8, 0xb5, 0xae, 0x4f, 0xeb, 0xbd, 0xf5, 0x30, 0x1a,
+	0x8b, 0x90, 0x4e, 0xb5, 0xde, 0xce, 0xcf, 0x1b, 0xb0, 0xf5, 0x38, 0xad, 0x25, 0xba, 0x05, 0x55,
+	0xce, 0x23, 0xcb, 0x68, 0x1b, 0xdd, 0x3a, 0x96, 0x47, 0x74, 0x0f, 0xb6, 0x87, 0xe1, 0xd4, 0x1f,
+	0xb8, 0xbe, 0xcf, 0x08, 0xe7, 0x56, 0xb5, 0x6d, 0x74, 0x1b, 0xd8, 0x94, 0xbe, 0x47, 0xa9, 0x0b,
+	0x1d, 0x40, 0x43, 0x41, 0x62, 0xca, 0x84, 0x55, 0x6b, 0x1b, 0xdd, 0x26, 0xae, 0x4b, 0xc7, 0x39,
+	0x65, 0x02, 0x7d, 0x08, 0x5b, 0xfa, 0x73, 0xd6, 0x46, 0xdb, 0xe8, 0x9a, 0xc7, 0x77, 0x6d, 0x29,
+	0x25, 0xeb, 0x9a, 0xfd, 0x75, 0xc8, 0x05, 0x99, 0x12, 0xf6, 0x4d, 0x0a, 0xc2, 0x19, 0x1a, 0x7d,
+	0x05, 0x9b, 0x69, 0x59, 0xad, 0x4d, 0x95, 0xb7, 0x6b, 0x7b, 0x94, 0x91, 0x45, 0x5e, 0x5f, 0xc5,
+	0x7a, 0x77, 0x7f, 0xf9, 0xab, 0x66, 0xfc, 0xfa, 0xf2, 0xe8, 0xc6, 0x9f, 0x2f, 0x8f, 0x6e, 0x0b,
+	0xc2, 0x85, 0x1f, 0x8e, 0x46, 0xa7, 0x9d, 0x30, 0x98, 0x52, 0x46, 0x3a, 0x58, 0x53, 0xa0, 0x13,
+	0xa8, 0x67, 0x3d, 0xb4, 0xb6, 0x14, 0xdd, 0x5e, 0x91, 0xee, 0x89, 0x8e, 0xf6, 0x6a, 0x92, 0x0c,
+	0x2f, 0xd0, 0xa8, 0x07, 0x3b, 0x09, 0x27, 0x03, 0x55, 0xd9, 0x81, 0x2a, 0x98, 0x55, 0x57, 0x04,
+	0x2d, 0x3b, 0x9d, 0x22, 0x3b, 0x9b, 0x22, 0xbb, 0x47, 0x69, 0xf4, 0xdc, 0x8d, 0x12, 0x82, 0x9b,
+	0x09, 0x27, 0xe7, 0x32, 0xe3, 0x5c, 0x8d, 0xea, 0x23, 0xd8, 0x1e, 0x0b, 0x11, 0x0f, 0xf4, 0xc4,
+	0x5a, 0x0d, 0x45, 0x70, 0xc7, 0x2e, 0x4d, 0xb0, 0x7d, 0x26, 0x44, 0xac, 0x3b, 0x71, 0x76, 0x03,
+	0x9b, 0xe3, 0xdc, 0x44, 0x1f, 0x83, 0x29, 0xbc, 0x9c, 0x01, 0x14, 0xc3, 0xc1, 0x0a, 0xc3, 0x85,
+	0xb7, 0x44, 0x00, 0x62, 0x61, 0xa1, 0xc7, 0x70, 0x73, 0x3c, 0x1f, 0xb2, 0xd0, 0x5f, 0x50, 0x98,
+	0x8a, 0xe2, 0x70, 0x55, 0x84, 0x82, 0xe5, 0x2c, 0xcd, 0xf1, 0xb2, 0x43, 0x0a, 0x49, 0xfc, 0x5c,
+	0xc8, 0xf6, 0x1a, 0x21, 0xcf, 0xfc, 0x65, 0x21, 0xc9, 0xc2, 0x42, 0x47, 0x60, 0xa6, 0xb5, 0x9c,
+	0xba, 0x13, 0xc2, 0xad, 0xed, 0x76, 0xb5, 0xdb, 0xc0, 0xa0, 0x5c, 0x4f, 0xa5, 0xe7, 0x74, 0xef,
+	0xc5, 0xab, 0x5a, 0x0d, 0x2a, 0xc1, 0xd5, 0x8b, 0x57, 0x35, 0x40, 0x75, 0x4d, 0xcc, 0x7b, 0x4d,
+	0x30, 0x35, 0xc7, 0xc5, 0x3c, 0x26, 0x9d, 0xdf, 0xab, 0x60, 0x2e, 0xd5, 0x0b, 0x7d, 0x09, 0xb7,
+	0x66, 0x21, 0x13, 0x89, 0x1b, 0x0d, 0x38, 0x61, 0xb3, 0xd0, 0x23, 0xdc, 0x32, 0xda, 0xd5, 0xae,
+	0x79, 0xbc, 0x5f, 0xec, 0x34, 0x26, 0x9c, 0x26, 0xcc, 0x23, 0x98, 0x8c, 0x74, 0xb3, 0x77, 0x74,
+	0x62, 0x5f, 0xe7, 0x21, 0x06, 0x56, 0x89, 0x6b, 0xc0, 0x49, 0x44, 0x3c, 0x41, 0x99, 0x55, 0x51,
+	0x9c, 0x27, 0xaf, 0xeb, 0x9d, 0xfd, 0xbc, 0xc0, 0xd7, 0xd7, 0xa9, 0x9f, 0x4d, 0x05, 0x9b, 0xe3,
+	0xbd, 0xd9, 0x3f, 0x06, 0xd1, 0x47, 0xd0, 0x2a, 0x7f, 0x53, 0x55, 0x28, 0x76, 0xe5, 0x4d, 0xaa,
+	0xaa, 0x4c, 0x56, 0x31, 0xf7, 0xe9, 0x22, 0x8e, 0x1e, 0xe4, 0x5b, 0x96, 0x4e, 0xe7, 0xbd, 0xe2,
+	0x96, 0x49, 0x75, 0x6b, 0x37, 0xed, 0x73, 0x40, 0x9c, 0x47, 0x03, 0x8f, 0x4e, 0x47, 0x61, 0x90,
+	0x30, 0x37, 0xe5, 0x69, 0xa8, 0x8b, 0xbe, 0x5d, 0xe4, 0xe9, 0xf3, 0xe8, 0x13, 0x05, 0xc3, 0xb7,
+	0x79, 0x76, 0xcc, 0x32, 0x5a, 0x5f, 0xc0, 0xc1, 0x6b, 0x6e, 0x2e, 0xdf, 0x96, 0x4b, 0x32, 0x57,
+	0x6f, 0x4b, 0x03, 0xcb, 0x23, 0xda, 0x85, 0x8d, 0x99, 0xdc, 0x17, 0xab, 0xa2, 0x7c, 0xa9, 0x71,
+	0x5a, 0x39, 0x31, 0x3a, 0xdf, 0x41, 0xb3, 0x30, 0x87, 0xb2, 0xbd, 0x13, 0x57, 0x78, 0x63, 0xb2,
+	0x18, 0xe0, 0xac, 0xbd, 0x47, 0x2b, 0xad, 0x78, 0x92, 0x02, 0x75, 0x2a, 0xde, 0x99, 0x14, 0x6c,
+	0xde, 0xf9, 0xcd, 0x80, 0x9b, 0x45, 0x0c, 0x72, 0x60, 0x2b, 0x45, 0x31, 0xa5, 0xcf, 0x3c, 0x7e,
+	0xab, 0x78, 0xef, 0x14, 0xce, 0x70, 0x86, 0x5a, 0x59, 0xe9, 0xca, 0x1b, 0xaf, 0x74, 0xf5, 0x7f,
+	0xae, 0x74, 0x79, 0x21, 0x7e, 0x00, 0xc8, 0xa1, 0xe8, 0x18, 0x1a, 0x92, 0x7c, 0x4c, 0xb9, 0xc8,
+	0x0a, 0x55, 0xba, 0xd2, 0x85, 0x17, 0x9f, 0x51, 0x2e, 0x70, 0x5d, 0xa4, 0x07, 0x8e, 0x4e, 0xcb,
+	0x43, 0xd4, 0x5e, 0xc9, 0x58, 0x37, 0x43, 0x9d, 0x1f, 0x0d, 0x80, 0x7c, 0xe7, 0xd1, 0x03, 0x30,
+	0x7d, 0xc2, 0x45, 0x38, 0x55, 0xa3, 0xa1, 0x6b, 0xba, 0x5f, 0xa4, 0xfb, 0x34, 0x07, 0xe0, 0x65,
+	0xf4, 0xbf, 0xea, 0x78, 0xe6, 0xaf, 0xd5, 0xd1, 0x7b, 0x28, 0x7f, 0x0f, 0x7e, 0xfa, 0xe3, 0xd0,
+	0xf8, 0xf6, 0xfe, 0x7f, 0xfe, 0x7b, 0x11, 0x5f, 0x06, 0xfa, 0x97, 0x71, 0xb8, 0xa9, 0x1e, 0xf3,
+	0xfb, 0x7f, 0x0f, 0x00, 0x92, 0x4f, 0xf8, 0xbf, 0x9c, 0x08, 0x00, 0x00,
 }
 
 func (this *Gateway) Equal(that interface{}) bool {
@@ -495,6 +732,54 @@ func (this *Gateway_TcpGateway) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Gateway_HybridGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Gateway_HybridGateway)
+	if !ok {
+		that2, ok := that.(Gateway_HybridGateway)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.HybridGateway.Equal(that1.HybridGateway) {
+		return false
+	}
+	return true
+}
+func (this *Gateway_UdpGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Gateway_UdpGateway)
+	if !ok {
+		that2, ok := that.(Gateway_UdpGateway)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.UdpGateway.Equal(that1.UdpGateway) {
+		return false
+	}
+	return true
+}
 func (this *HttpGateway) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -541,11 +826,135 @@ func (this *HttpGateway) Equal(that interface{}) bool {
 	if !this.Options.Equal(that1.Options) {
 		return false
 	}
+	if len(this.SslConfigurations) != len(that1.SslConfigurations) {
+		return false
+	}
+	for i := range this.SslConfigurations {
+		if !this.SslConfigurations[i].Equal(that1.SslConfigurations[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *HybridGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HybridGateway)
+	if !ok {
+		that2, ok := that.(HybridGateway)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.MatchedGateways) != len(that1.MatchedGateways) {
+		return false
+	}
+	for i := range this.MatchedGateways {
+		if !this.MatchedGateways[i].Equal(that1.MatchedGateways[i]) {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
+func (this *MatchedGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MatchedGateway)
+	if !ok {
+		that2, ok := that.(MatchedGateway)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Matcher.Equal(that1.Matcher) {
+		return false
+	}
+	if that1.GatewayType == nil {
+		if this.GatewayType != nil {
+			return false
+		}
+	} else if this.GatewayType == nil {
+		return false
+	} else if !this.GatewayType.Equal(that1.GatewayType) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MatchedGateway_HttpGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MatchedGateway_HttpGateway)
+	if !ok {
+		that2, ok := that.(MatchedGateway_HttpGateway)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.HttpGateway.Equal(that1.HttpGateway) {
+		return false
+	}
+	return true
+}
+func (this *MatchedGateway_TcpGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MatchedGateway_TcpGateway)
+	if !ok {
+		that2, ok := that.(MatchedGateway_TcpGateway)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.TcpGateway.Equal(that1.TcpGateway) {
+		return false
+	}
+	return true
+}
 func (this *TcpGateway) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -581,3 +990,33 @@ func (this *TcpGateway) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *UdpGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UdpGateway)
+	if !ok {
+		that2, ok := that.(UdpGateway)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Destination.Equal(that1.Destination) {
+		return false
+	}
+	if !this.Options.Equal(that1.Options) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gateway/api/v1/route_option.proto
+
+package v1
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// The **RouteOption** holds route options that can be shared by routes of virtual services and route tables, and be
+// owned by a different team than the routes themselves, e.g. a platform team that owns the timeout and retry policies
+// of the routes of application teams.
+//
+// Routes refer to or select RouteOption resources with their `optionsConfigRefs`. The options of the route take
+// precedence over the options of the RouteOption resources, and the options of earlier RouteOption resources take
+// precedence over the options of later ones. Like the options of the route, the delegated options are inherited by
+// the routes of the route tables it delegates to.
+//
+// For example, the following configuration applies the timeout and retries of the `default-retries` RouteOption to
+// the route:
+//
+// ```yaml
+// apiVersion: gateway.solo.io/v1
+// kind: RouteOption
+// metadata:
+//
+//	name: default-retries
+//	namespace: platform
+//
+// spec:
+//
+//	options:
+//	  timeout: 10s
+//	  retries:
+//	    retryOn: 5xx
+//	    numRetries: 3
+//
+// ---
+// apiVersion: gateway.solo.io/v1
+// kind: VirtualService
+// metadata:
+//
+//	name: app
+//	namespace: app
+//
+// spec:
+//
+//	virtualHost:
+//	  domains:
+//	  - 'app.example.com'
+//	  routes:
+//	  - matchers:
+//	    - prefix: /
+//	    optionsConfigRefs:
+//	      delegateOptions:
+//	      - name: default-retries
+//	        namespace: platform
+//	    routeAction:
+//	      ...
+//
+// ```
+type RouteOption struct {
+	// The route options to merge into the options of the routes that refer to or select this resource.
+	Options *v1.RouteOptions `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	// Status indicates the validation status of this resource.
+	// Status is read-only by clients, and set by gloo during validation
+	Status core.Status `protobuf:"bytes,6,opt,name=status,proto3" json:"status" testdiff:"ignore"`
+	// Metadata contains the object metadata for this resource
+	Metadata             core.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *RouteOption) Reset()         { *m = RouteOption{} }
+func (m *RouteOption) String() string { return proto.CompactTextString(m) }
+func (*RouteOption) ProtoMessage()    {}
+func (*RouteOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaae0d91a72678eb, []int{0}
+}
+func (m *RouteOption) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RouteOption.Unmarshal(m, b)
+}
+func (m *RouteOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RouteOption.Marshal(b, m, deterministic)
+}
+func (m *RouteOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteOption.Merge(m, src)
+}
+func (m *RouteOption) XXX_Size() int {
+	return xxx_messageInfo_RouteOption.Size(m)
+}
+func (m *RouteOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteOption proto.InternalMessageInfo
+
+func (m *RouteOption) GetOptions() *v1.RouteOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *RouteOption) GetStatus() core.Status {
+	if m != nil {
+		return m.Status
+	}
+	return core.Status{}
+}
+
+func (m *RouteOption) GetMetadata() core.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return core.Metadata{}
+}
+
+func init() {
+	proto.RegisterType((*RouteOption)(nil), "gateway.solo.io.RouteOption")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gateway/api/v1/route_option.proto", fileDescriptor_aaae0d91a72678eb)
+}
+
+var fileDescriptor_aaae0d91a72678eb = []byte{
+	// 328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xcd, 0x4a, 0x3b, 0x31,
+	0x10, 0xff, 0x2f, 0x2c, 0xdb, 0xb2, 0xe5, 0x4f, 0x71, 0x29, 0x52, 0x8a, 0xb6, 0xd2, 0x8b, 0x5e,
+	0x4c, 0xd0, 0x7a, 0x90, 0x82, 0x97, 0xbd, 0x8a, 0x08, 0xeb, 0xcd, 0x8b, 0xa4, 0x6d, 0x1a, 0x63,
+	0x3f, 0x26, 0x24, 0x53, 0xad, 0xd7, 0x3e, 0x8d, 0x8f, 0xe0, 0x23, 0xf8, 0x14, 0x3d, 0x78, 0xf5,
+	0x54, 0xc1, 0xbb, 0x24, 0x9b, 0x2d, 0xb5, 0x20, 0x78, 0xcb, 0xcc, 0xef, 0x23, 0xf3, 0x9b, 0x89,
+	0x53, 0x21, 0xf1, 0x7e, 0xd6, 0x23, 0x7d, 0x98, 0x50, 0x03, 0x63, 0x38, 0x96, 0x40, 0xc5, 0x18,
+	0x80, 0x2a, 0x0d, 0x0f, 0xbc, 0x8f, 0x86, 0x0a, 0x86, 0xfc, 0x89, 0x3d, 0x53, 0xa6, 0x24, 0x7d,
+	0x3c, 0xa1, 0x1a, 0x66, 0xc8, 0xef, 0x40, 0xa1, 0x84, 0x29, 0x51, 0x1a, 0x10, 0x92, 0xaa, 0xa7,
+	0x10, 0x6b, 0x40, 0x24, 0x34, 0x6a, 0x02, 0x04, 0x38, 0x8c, 0xda, 0x57, 0x4e, 0x6b, 0x24, 0x7c,
+	0x8e, 0x79, 0x93, 0xcf, 0xd1, 0xf7, 0x9a, 0xee, 0xcf, 0x91, 0xc4, 0xc2, 0x7e, 0xc2, 0x91, 0x0d,
+	0x18, 0x32, 0x8f, 0xef, 0x6d, 0xe3, 0x06, 0x19, 0xce, 0xcc, 0x6f, 0xea, 0xa2, 0xf6, 0xf8, 0xe1,
+	0x56, 0x12, 0x5b, 0x79, 0x66, 0x1e, 0xc0, 0x1b, 0xb5, 0x3f, 0x82, 0xb8, 0x92, 0xd9, 0x60, 0xd7,
+	0xae, 0x9d, 0x9c, 0xc5, 0x25, 0x4f, 0xa8, 0x07, 0x07, 0xc1, 0x51, 0xe5, 0xb4, 0x41, 0xac, 0xb8,
+	0x08, 0x48, 0x36, 0xb8, 0x26, 0x2b, 0xa8, 0xc9, 0x65, 0x1c, 0xe5, 0xe3, 0xd5, 0x23, 0x27, 0xaa,
+	0x91, 0x3e, 0x68, 0xbe, 0x16, 0xdd, 0x38, 0x2c, 0xdd, 0x7f, 0xfd, 0x0a, 0x83, 0xb7, 0x65, 0xeb,
+	0xdf, 0xe7, 0xb2, 0xb5, 0x83, 0xdc, 0xe0, 0x40, 0x0e, 0x87, 0xdd, 0xb6, 0x14, 0x53, 0xd0, 0xbc,
+	0x9d, 0x79, 0x8b, 0xe4, 0x3c, 0x2e, 0x17, 0xbb, 0xa8, 0x97, 0x9c, 0xdd, 0xee, 0x4f, 0xbb, 0x2b,
+	0x8f, 0xa6, 0xa1, 0x35, 0xcb, 0xd6, 0xec, 0x6e, 0x6b, 0xb1, 0x0a, 0xcb, 0x71, 0xa4, 0x11, 0x14,
+	0x9a, 0xc5, 0x2a, 0xac, 0x26, 0xff, 0x37, 0x8f, 0x66, 0xd2, 0x0b, 0x3b, 0xc1, 0xcb, 0x7b, 0x33,
+	0xb8, 0xed, 0xfc, 0xf9, 0xfa, 0x6a, 0x24, 0xfc, 0xea, 0x7a, 0x91, 0xdb, 0x59, 0xe7, 0x7b, 0x00,
+	0x4d, 0x47, 0xc0, 0x17, 0x3b, 0x02, 0x00, 0x00,
+}
+
+func (this *RouteOption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RouteOption)
+	if !ok {
+		that2, ok := that.(RouteOption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Options.Equal(that1.Options) {
+		return false
+	}
+	if !this.Status.Equal(&that1.Status) {
+		return false
+	}
+	if !this.Metadata.Equal(&that1.Metadata) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,47 @@
+// Code generated by solo-kit. DO NOT EDIT.
+
+package v1
+
+import (
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reconcile"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+)
+
+// Option to copy anything from the original to the desired before writing. Return value of false means don't update
+type TransitionRouteOptionFunc func(original, desired *RouteOption) (bool, error)
+
+type RouteOptionReconciler interface {
+	Reconcile(namespace string, desiredResources RouteOptionList, transition TransitionRouteOptionFunc, opts clients.ListOpts) error
+}
+
+func routeOptionsToResources(list RouteOptionList) resources.ResourceList {
+	var resourceList resources.ResourceList
+	for _, routeOption := range list {
+		resourceList = append(resourceList, routeOption)
+	}
+	return resourceList
+}
+
+func NewRouteOptionReconciler(client RouteOptionClient) RouteOptionReconciler {
+	return &routeOptionReconciler{
+		base: reconcile.NewReconciler(client.BaseClient()),
+	}
+}
+
+type routeOptionReconciler struct {
+	base reconcile.Reconciler
+}
+
+func (r *routeOptionReconciler) Reconcile(namespace string, desiredResources RouteOptionList, transition TransitionRouteOptionFunc, opts clients.ListOpts) error {
+	opts = opts.WithDefaults()
+	opts.Ctx = contextutils.WithLogger(opts.Ctx, "routeOption_reconciler")
+	var transitionResources reconcile.TransitionResourcesFunc
+	if transition != nil {
+		transitionResources = func(original, desired resources.Resource) (bool, error) {
+			return transition(original.(*RouteOption), desired.(*RouteOption))
+		}
+	}
+	return r.base.Reconcile(namespace, routeOptionsToResources(desiredResources), transitionResources, opts)
+}
@@ -0,0 +1,200 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gateway/api/v1/virtual_host_option.proto
+
+package v1
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// The **VirtualHostOption** holds virtual host options that can be shared by virtual services, and be owned by a
+// different team than the virtual services themselves, e.g. a platform team that owns the security policies of
+// the virtual hosts of application teams.
+//
+// Virtual services refer to or select VirtualHostOption resources with the `optionsConfigRefs` of their virtual host.
+// The options of the virtual host take precedence over the options of the VirtualHostOption resources, and the options
+// of earlier VirtualHostOption resources take precedence over the options of later ones.
+//
+// For example, the following configuration applies the cors policy of the `cors-policy` VirtualHostOption to the
+// virtual host:
+//
+// ```yaml
+// apiVersion: gateway.solo.io/v1
+// kind: VirtualHostOption
+// metadata:
+//
+//	name: cors-policy
+//	namespace: platform
+//
+// spec:
+//
+//	options:
+//	  cors:
+//	    allowOrigin:
+//	    - https://example.com
+//
+// ---
+// apiVersion: gateway.solo.io/v1
+// kind: VirtualService
+// metadata:
+//
+//	name: app
+//	namespace: app
+//
+// spec:
+//
+//	virtualHost:
+//	  domains:
+//	  - 'app.example.com'
+//	  optionsConfigRefs:
+//	    delegateOptions:
+//	    - name: cors-policy
+//	      namespace: platform
+//	  routes:
+//	  ...
+//
+// ```
+type VirtualHostOption struct {
+	// The virtual host options to merge into the options of the virtual hosts that refer to or select this resource.
+	Options *v1.VirtualHostOptions `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	// Status indicates the validation status of this resource.
+	// Status is read-only by clients, and set by gloo during validation
+	Status core.Status `protobuf:"bytes,6,opt,name=status,proto3" json:"status" testdiff:"ignore"`
+	// Metadata contains the object metadata for this resource
+	Metadata             core.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *VirtualHostOption) Reset()         { *m = VirtualHostOption{} }
+func (m *VirtualHostOption) String() string { return proto.CompactTextString(m) }
+func (*VirtualHostOption) ProtoMessage()    {}
+func (*VirtualHostOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1faee37635f28798, []int{0}
+}
+func (m *VirtualHostOption) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VirtualHostOption.Unmarshal(m, b)
+}
+func (m *VirtualHostOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VirtualHostOption.Marshal(b, m, deterministic)
+}
+func (m *VirtualHostOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VirtualHostOption.Merge(m, src)
+}
+func (m *VirtualHostOption) XXX_Size() int {
+	return xxx_messageInfo_VirtualHostOption.Size(m)
+}
+func (m *VirtualHostOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_VirtualHostOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VirtualHostOption proto.InternalMessageInfo
+
+func (m *VirtualHostOption) GetOptions() *v1.VirtualHostOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *VirtualHostOption) GetStatus() core.Status {
+	if m != nil {
+		return m.Status
+	}
+	return core.Status{}
+}
+
+func (m *VirtualHostOption) GetMetadata() core.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return core.Metadata{}
+}
+
+func init() {
+	proto.RegisterType((*VirtualHostOption)(nil), "gateway.solo.io.VirtualHostOption")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gateway/api/v1/virtual_host_option.proto", fileDescriptor_1faee37635f28798)
+}
+
+var fileDescriptor_1faee37635f28798 = []byte{
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbd, 0x4a, 0x3b, 0x41,
+	0x10, 0xff, 0x1f, 0x1c, 0x97, 0x70, 0xff, 0x42, 0x72, 0x84, 0x10, 0x82, 0x26, 0x21, 0x85, 0xda,
+	0xb8, 0x8b, 0xa6, 0x91, 0x80, 0x4d, 0x2a, 0x45, 0x44, 0x88, 0x60, 0x61, 0x13, 0x36, 0xc9, 0x66,
+	0xb3, 0xe6, 0x63, 0x96, 0xdb, 0xc9, 0x19, 0xdb, 0x3c, 0x8d, 0x8f, 0xe0, 0x23, 0xf8, 0x14, 0x29,
+	0x7c, 0x83, 0x08, 0xb6, 0x22, 0xbb, 0xb7, 0x17, 0x30, 0x2a, 0xd8, 0xdd, 0xcc, 0xef, 0x63, 0xe6,
+	0x37, 0xb7, 0xe1, 0x85, 0x90, 0x38, 0x9a, 0xf7, 0x48, 0x1f, 0xa6, 0x54, 0xc3, 0x04, 0x8e, 0x24,
+	0x50, 0x31, 0x01, 0xa0, 0x2a, 0x86, 0x7b, 0xde, 0x47, 0x4d, 0x05, 0x43, 0xfe, 0xc0, 0x1e, 0x29,
+	0x53, 0x92, 0x26, 0xc7, 0x34, 0x91, 0x31, 0xce, 0xd9, 0xa4, 0x3b, 0x02, 0x8d, 0x5d, 0x50, 0x28,
+	0x61, 0x46, 0x54, 0x0c, 0x08, 0xd1, 0x8e, 0x63, 0x12, 0xe3, 0x43, 0x24, 0x54, 0x8a, 0x02, 0x04,
+	0x58, 0x8c, 0x9a, 0xaf, 0x94, 0x56, 0x89, 0xf8, 0x02, 0xd3, 0x26, 0x5f, 0xa0, 0xeb, 0x55, 0xed,
+	0xe8, 0xb1, 0xc4, 0x6c, 0xca, 0x94, 0x23, 0x1b, 0x30, 0x64, 0x0e, 0xdf, 0xdd, 0xc6, 0x35, 0x32,
+	0x9c, 0xeb, 0xdf, 0xd4, 0x59, 0xed, 0xf0, 0x83, 0xad, 0x40, 0xa6, 0x72, 0xcc, 0x34, 0x80, 0x33,
+	0x6a, 0x7c, 0x78, 0x61, 0xe1, 0x36, 0xcd, 0x77, 0x0e, 0x1a, 0xaf, 0x2d, 0x18, 0xb5, 0xc2, 0x9c,
+	0xa3, 0x95, 0xbd, 0xba, 0x77, 0xf8, 0xff, 0xa4, 0x4e, 0x8c, 0x45, 0x16, 0x93, 0x7c, 0x53, 0xe8,
+	0x4e, 0x26, 0x88, 0x2e, 0xc3, 0x20, 0x5d, 0xb5, 0x1c, 0x58, 0x69, 0x91, 0xf4, 0x21, 0xe6, 0x1b,
+	0xe9, 0x8d, 0xc5, 0xda, 0x7b, 0xcf, 0xef, 0xbe, 0xf7, 0xb2, 0xaa, 0xfd, 0x7b, 0x5b, 0xd5, 0x0a,
+	0xc8, 0x35, 0x0e, 0xe4, 0x70, 0xd8, 0x6a, 0x48, 0x31, 0x83, 0x98, 0x37, 0x3a, 0xce, 0x22, 0x3a,
+	0x0d, 0xf3, 0xd9, 0x5d, 0xca, 0x39, 0x6b, 0x57, 0xfa, 0x6a, 0x77, 0xe5, 0xd0, 0xb6, 0x6f, 0xcc,
+	0x3a, 0x1b, 0x76, 0x6b, 0x7f, 0xb9, 0xf6, 0xf3, 0x61, 0x90, 0x8c, 0x40, 0xa1, 0x5e, 0xae, 0xfd,
+	0x52, 0x54, 0xfc, 0xe1, 0x3f, 0xea, 0xf6, 0x99, 0x59, 0xe4, 0xe9, 0xb5, 0xea, 0xdd, 0x35, 0xff,
+	0xfc, 0x2e, 0xd4, 0x58, 0xb8, 0x6b, 0xf6, 0x02, 0x7b, 0xc6, 0xe6, 0xe7, 0x00, 0x10, 0x6d, 0x32,
+	0x1f, 0x55, 0x02, 0x00, 0x00,
+}
+
+func (this *VirtualHostOption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VirtualHostOption)
+	if !ok {
+		that2, ok := that.(VirtualHostOption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Options.Equal(that1.Options) {
+		return false
+	}
+	if !this.Status.Equal(&that1.Status) {
+		return false
+	}
+	if !this.Metadata.Equal(&that1.Metadata) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
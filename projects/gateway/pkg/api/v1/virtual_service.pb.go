@@ -81,7 +81,7 @@ func (x RouteTableSelector_Expression_Operator) String() string {
 }
 
 func (RouteTableSelector_Expression_Operator) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_93fa9472926a2049, []int{4, 1, 0}
+	return fileDescriptor_93fa9472926a2049, []int{6, 1, 0}
 }
 
 //
@@ -190,6 +190,11 @@ type VirtualService struct {
 	// Unlike metadata.name, DisplayName can be any string
 	// and can be changed after creating the resource.
 	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// If provided, the virtual service is only served by the Gateways that select it and whose labels match this
+	// selector. Use it to expose the virtual service on a subset of the gateways, e.g. only on the internal or
+	// only on the external gateway proxies.
+	// If not provided, the virtual service is served by all the Gateways that select it.
+	GatewaySelector map[string]string `protobuf:"bytes,4,rep,name=gateway_selector,json=gatewaySelector,proto3" json:"gateway_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Status indicates the validation status of this resource.
 	// Status is read-only by clients, and set by gloo during validation
 	Status core.Status `protobuf:"bytes,6,opt,name=status,proto3" json:"status" testdiff:"ignore"`
@@ -245,6 +250,13 @@ func (m *VirtualService) GetDisplayName() string {
 	return ""
 }
 
+func (m *VirtualService) GetGatewaySelector() map[string]string {
+	if m != nil {
+		return m.GatewaySelector
+	}
+	return nil
+}
+
 func (m *VirtualService) GetStatus() core.Status {
 	if m != nil {
 		return m.Status
@@ -283,10 +295,13 @@ type VirtualHost struct {
 	Routes []*Route `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes,omitempty"`
 	// Virtual host options contain additional configuration to be applied to all traffic served by the Virtual Host.
 	// Some configuration here can be overridden by Route Options.
-	Options              *v1.VirtualHostOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	Options *v1.VirtualHostOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	// Delegate the options of the virtual host to VirtualHostOption resources. The options set on the virtual host
+	// take precedence over the delegated ones.
+	OptionsConfigRefs    *DelegateOptionsRefs `protobuf:"bytes,5,opt,name=options_config_refs,json=optionsConfigRefs,proto3" json:"options_config_refs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *VirtualHost) Reset()         { *m = VirtualHost{} }
@@ -334,6 +349,13 @@ func (m *VirtualHost) GetOptions() *v1.VirtualHostOptions {
 	return nil
 }
 
+func (m *VirtualHost) GetOptionsConfigRefs() *DelegateOptionsRefs {
+	if m != nil {
+		return m.OptionsConfigRefs
+	}
+	return nil
+}
+
 //
 // A route specifies how to match a request and what action to take when the request is matched.
 //
@@ -365,10 +387,13 @@ type Route struct {
 	// RouteOption behavior will be inherited by delegated routes which do not specify their own `options`
 	Options *v1.RouteOptions `protobuf:"bytes,6,opt,name=options,proto3" json:"options,omitempty"`
 	// The name provides a convenience for users to be able to refer to a route by name.
-	Name                 string   `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Name string `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	// Delegate the options of the route to RouteOption resources. The options set on the route take precedence over
+	// the delegated ones.
+	OptionsConfigRefs    *DelegateOptionsRefs `protobuf:"bytes,9,opt,name=options_config_refs,json=optionsConfigRefs,proto3" json:"options_config_refs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *Route) Reset()         { *m = Route{} }
@@ -481,6 +506,13 @@ func (m *Route) GetName() string {
 	return ""
 }
 
+func (m *Route) GetOptionsConfigRefs() *DelegateOptionsRefs {
+	if m != nil {
+		return m.OptionsConfigRefs
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Route) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -491,6 +523,110 @@ func (*Route) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// Refers to or selects the option resources (VirtualHostOption or RouteOption) whose options are merged into the
+// options of a virtual host or route. The options of earlier resources take precedence over the options of later
+// ones, and each option that is set by more than one resource is reported as a warning on the status of the
+// resource that delegates to them.
+type DelegateOptionsRefs struct {
+	// The option resources to delegate to, in order of precedence.
+	DelegateOptions []*core.ResourceRef `protobuf:"bytes,1,rep,name=delegate_options,json=delegateOptions,proto3" json:"delegate_options,omitempty"`
+	// Delegate to the option resources that match the selector as well. They come after the referenced ones, sorted
+	// by namespace and name.
+	Selector             *DelegateOptionsSelector `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *DelegateOptionsRefs) Reset()         { *m = DelegateOptionsRefs{} }
+func (m *DelegateOptionsRefs) String() string { return proto.CompactTextString(m) }
+func (*DelegateOptionsRefs) ProtoMessage()    {}
+func (*DelegateOptionsRefs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93fa9472926a2049, []int{3}
+}
+func (m *DelegateOptionsRefs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelegateOptionsRefs.Unmarshal(m, b)
+}
+func (m *DelegateOptionsRefs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelegateOptionsRefs.Marshal(b, m, deterministic)
+}
+func (m *DelegateOptionsRefs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateOptionsRefs.Merge(m, src)
+}
+func (m *DelegateOptionsRefs) XXX_Size() int {
+	return xxx_messageInfo_DelegateOptionsRefs.Size(m)
+}
+func (m *DelegateOptionsRefs) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateOptionsRefs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateOptionsRefs proto.InternalMessageInfo
+
+func (m *DelegateOptionsRefs) GetDelegateOptions() []*core.ResourceRef {
+	if m != nil {
+		return m.DelegateOptions
+	}
+	return nil
+}
+
+func (m *DelegateOptionsRefs) GetSelector() *DelegateOptionsSelector {
+	if m != nil {
+		return m.Selector
+	}
+	return nil
+}
+
+// Select option resources for delegation by namespace, labels, or both.
+type DelegateOptionsSelector struct {
+	// Delegate to option resources in these namespaces. If omitted, Gloo will only select option resources in the
+	// same namespace as the resource (Virtual Service or Route Table) that owns this selector. The reserved value "*"
+	// can be used to select option resources in all namespaces watched by Gloo.
+	Namespaces []string `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// Delegate to option resources whose labels match the ones specified here.
+	Labels               map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DelegateOptionsSelector) Reset()         { *m = DelegateOptionsSelector{} }
+func (m *DelegateOptionsSelector) String() string { return proto.CompactTextString(m) }
+func (*DelegateOptionsSelector) ProtoMessage()    {}
+func (*DelegateOptionsSelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93fa9472926a2049, []int{4}
+}
+func (m *DelegateOptionsSelector) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelegateOptionsSelector.Unmarshal(m, b)
+}
+func (m *DelegateOptionsSelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelegateOptionsSelector.Marshal(b, m, deterministic)
+}
+func (m *DelegateOptionsSelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateOptionsSelector.Merge(m, src)
+}
+func (m *DelegateOptionsSelector) XXX_Size() int {
+	return xxx_messageInfo_DelegateOptionsSelector.Size(m)
+}
+func (m *DelegateOptionsSelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateOptionsSelector.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateOptionsSelector proto.InternalMessageInfo
+
+func (m *DelegateOptionsSelector) GetNamespaces() []string {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+func (m *DelegateOptionsSelector) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 // DelegateActions are used to delegate routing decisions to Route Tables.
 type DelegateAction struct {
 	// The name of the Route Table to delegate to.
@@ -514,7 +650,7 @@ func (m *DelegateAction) Reset()         { *m = DelegateAction{} }
 func (m *DelegateAction) String() string { return proto.CompactTextString(m) }
 func (*DelegateAction) ProtoMessage()    {}
 func (*DelegateAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93fa9472926a2049, []int{3}
+	return fileDescriptor_93fa9472926a2049, []int{5}
 }
 func (m *DelegateAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DelegateAction.Unmarshal(m, b)
@@ -614,7 +750,7 @@ func (m *RouteTableSelector) Reset()         { *m = RouteTableSelector{} }
 func (m *RouteTableSelector) String() string { return proto.CompactTextString(m) }
 func (*RouteTableSelector) ProtoMessage()    {}
 func (*RouteTableSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93fa9472926a2049, []int{4}
+	return fileDescriptor_93fa9472926a2049, []int{6}
 }
 func (m *RouteTableSelector) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteTableSelector.Unmarshal(m, b)
@@ -671,7 +807,7 @@ func (m *RouteTableSelector_Expression) Reset()         { *m = RouteTableSelecto
 func (m *RouteTableSelector_Expression) String() string { return proto.CompactTextString(m) }
 func (*RouteTableSelector_Expression) ProtoMessage()    {}
 func (*RouteTableSelector_Expression) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93fa9472926a2049, []int{4, 1}
+	return fileDescriptor_93fa9472926a2049, []int{6, 1}
 }
 func (m *RouteTableSelector_Expression) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteTableSelector_Expression.Unmarshal(m, b)
@@ -715,8 +851,12 @@ func (m *RouteTableSelector_Expression) GetValues() []string {
 func init() {
 	proto.RegisterEnum("gateway.solo.io.RouteTableSelector_Expression_Operator", RouteTableSelector_Expression_Operator_name, RouteTableSelector_Expression_Operator_value)
 	proto.RegisterType((*VirtualService)(nil), "gateway.solo.io.VirtualService")
+	proto.RegisterMapType((map[string]string)(nil), "gateway.solo.io.VirtualService.GatewaySelectorEntry")
 	proto.RegisterType((*VirtualHost)(nil), "gateway.solo.io.VirtualHost")
 	proto.RegisterType((*Route)(nil), "gateway.solo.io.Route")
+	proto.RegisterType((*DelegateOptionsRefs)(nil), "gateway.solo.io.DelegateOptionsRefs")
+	proto.RegisterType((*DelegateOptionsSelector)(nil), "gateway.solo.io.DelegateOptionsSelector")
+	proto.RegisterMapType((map[string]string)(nil), "gateway.solo.io.DelegateOptionsSelector.LabelsEntry")
 	proto.RegisterType((*DelegateAction)(nil), "gateway.solo.io.DelegateAction")
 	proto.RegisterType((*RouteTableSelector)(nil), "gateway.solo.io.RouteTableSelector")
 	proto.RegisterMapType((map[string]string)(nil), "gateway.solo.io.RouteTableSelector.LabelsEntry")
@@ -728,72 +868,81 @@ func init() {
 }
 
 var fileDescriptor_93fa9472926a2049 = []byte{
-	// 1034 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcb, 0x6e, 0xdb, 0x46,
-	0x14, 0x35, 0x25, 0x9a, 0x96, 0xae, 0x5c, 0x5b, 0x99, 0x1a, 0x2e, 0x63, 0xa4, 0xb6, 0x20, 0xa3,
-	0x88, 0x37, 0x21, 0x51, 0xa7, 0x48, 0x53, 0x03, 0x6d, 0x10, 0xd5, 0x86, 0x9d, 0x36, 0x76, 0x8a,
-	0x71, 0x90, 0x45, 0x36, 0xc2, 0x88, 0xba, 0x92, 0x59, 0x53, 0x1c, 0x76, 0x66, 0xa4, 0x48, 0xdb,
-	0x6c, 0xfa, 0x01, 0x45, 0x77, 0x5d, 0x75, 0xd5, 0x4f, 0xe8, 0x27, 0x74, 0xd7, 0x75, 0x37, 0x59,
-	0xf4, 0x0f, 0x52, 0xa0, 0xfb, 0x82, 0xc3, 0x21, 0xf5, 0xb0, 0x0d, 0xb8, 0x2b, 0xcf, 0x9d, 0x7b,
-	0xce, 0xe1, 0xe1, 0x7d, 0x98, 0x82, 0xa3, 0x7e, 0xa8, 0x2e, 0x86, 0x1d, 0x2f, 0xe0, 0x03, 0x5f,
-	0xf2, 0x88, 0x3f, 0x08, 0xb9, 0xdf, 0x8f, 0x38, 0xf7, 0x13, 0xc1, 0xbf, 0xc7, 0x40, 0x49, 0xbf,
-	0xcf, 0x14, 0xbe, 0x61, 0x13, 0x9f, 0x25, 0xa1, 0x3f, 0xfa, 0xd4, 0x1f, 0x85, 0x42, 0x0d, 0x59,
-	0xd4, 0x96, 0x28, 0x46, 0x61, 0x80, 0x5e, 0x22, 0xb8, 0xe2, 0x64, 0xdd, 0xa0, 0xbc, 0x54, 0xc3,
-	0x0b, 0xf9, 0xd6, 0x46, 0x9f, 0xf7, 0xb9, 0xce, 0xf9, 0xe9, 0x29, 0x83, 0x6d, 0x11, 0x1c, 0xab,
-	0xec, 0x12, 0xc7, 0xca, 0xdc, 0x6d, 0xf7, 0x39, 0xef, 0x47, 0xe8, 0xeb, 0xa8, 0x33, 0xec, 0xf9,
-	0x6f, 0x04, 0x4b, 0x12, 0x14, 0x32, 0xcf, 0x6b, 0x5b, 0x97, 0xa1, 0xca, 0x1d, 0x0c, 0x50, 0xb1,
-	0x2e, 0x53, 0xcc, 0xe4, 0xef, 0x2d, 0xe6, 0xa5, 0x62, 0x6a, 0x98, 0xb3, 0xef, 0x2e, 0x66, 0x05,
-	0xf6, 0x6e, 0x12, 0xce, 0x63, 0x93, 0xdf, 0x5d, 0xa8, 0x43, 0x1a, 0xe5, 0x48, 0x19, 0x19, 0xd0,
-	0x27, 0x37, 0x83, 0x12, 0xc1, 0xc7, 0x13, 0x03, 0xbb, 0x7f, 0x33, 0x8c, 0x27, 0x2a, 0xe4, 0x71,
-	0xee, 0xf7, 0xd1, 0xcd, 0xc0, 0x80, 0x0b, 0xf4, 0x07, 0x4c, 0x05, 0x17, 0x28, 0x64, 0x71, 0xc8,
-	0x78, 0xcd, 0xbf, 0x4a, 0xb0, 0xf6, 0x2a, 0x6b, 0xcd, 0x79, 0xd6, 0x19, 0xf2, 0x04, 0x56, 0xf3,
-	0x66, 0x5d, 0x70, 0xa9, 0x5c, 0xab, 0x61, 0xed, 0xd5, 0xf6, 0xef, 0x79, 0x0b, 0xad, 0xf2, 0x0c,
-	0xed, 0x84, 0x4b, 0x45, 0x6b, 0xa3, 0x69, 0x40, 0x1e, 0x01, 0x48, 0x19, 0xb5, 0x03, 0x1e, 0xf7,
-	0xc2, 0xbe, 0x5b, 0xd2, 0xf4, 0x8f, 0xbc, 0xd4, 0x52, 0xc1, 0x3d, 0x97, 0xd1, 0xd7, 0x3a, 0x4d,
-	0xab, 0x32, 0x3f, 0x92, 0xfb, 0xb0, 0xda, 0x0d, 0x65, 0x12, 0xb1, 0x49, 0x3b, 0x66, 0x03, 0x74,
-	0xcb, 0x0d, 0x6b, 0xaf, 0xda, 0xb2, 0x7f, 0xff, 0xd7, 0xb6, 0x68, 0xcd, 0x64, 0xce, 0xd8, 0x00,
-	0xc9, 0xb7, 0xe0, 0x64, 0xcd, 0x72, 0x1d, 0x2d, 0xbe, 0xe1, 0xa5, 0xef, 0x38, 0x15, 0xd7, 0xb9,
-	0xd6, 0xc7, 0x29, 0xf1, 0x8f, 0x77, 0x3b, 0x4b, 0xff, 0xbc, 0xdb, 0xb9, 0xa3, 0x50, 0xaa, 0x6e,
-	0xd8, 0xeb, 0x1d, 0x34, 0xc3, 0x7e, 0xcc, 0x05, 0x36, 0xa9, 0x91, 0x20, 0x8f, 0xa1, 0x92, 0x4f,
-	0x86, 0xbb, 0xa2, 0xe5, 0x36, 0xe7, 0xe5, 0x4e, 0x4d, 0xb6, 0x65, 0xa7, 0x62, 0xb4, 0x40, 0x1f,
-	0x6c, 0xbf, 0x7d, 0x6f, 0xdb, 0x50, 0x1a, 0xc9, 0xb7, 0xef, 0x6d, 0x42, 0xea, 0x0b, 0x13, 0x2e,
-	0x9b, 0x3f, 0x59, 0x50, 0x9b, 0x29, 0x12, 0x71, 0x61, 0xa5, 0xcb, 0x07, 0x2c, 0x8c, 0xa5, 0x5b,
-	0x6a, 0x94, 0xf7, 0xaa, 0x34, 0x0f, 0x89, 0x07, 0x8e, 0xe0, 0x43, 0x85, 0xd2, 0x2d, 0x37, 0xca,
-	0xda, 0xc1, 0x62, 0xb1, 0x69, 0x9a, 0xa6, 0x06, 0x45, 0x0e, 0x60, 0xc5, 0xb4, 0xdf, 0xb5, 0xb5,
-	0xe5, 0xc6, 0x7c, 0x79, 0x67, 0x9e, 0xfa, 0x22, 0xc3, 0xd1, 0x9c, 0xd0, 0xfc, 0xc5, 0x86, 0x65,
-	0xad, 0x46, 0x9e, 0x40, 0x25, 0x9f, 0x06, 0xd7, 0xd2, 0xcf, 0xdd, 0xf5, 0x8a, 0xf1, 0xd0, 0x25,
-	0x98, 0x13, 0x3d, 0xcd, 0x52, 0xb4, 0x20, 0x91, 0x53, 0xd8, 0x08, 0xe3, 0x0b, 0x14, 0xa1, 0x62,
-	0x9d, 0x08, 0xdb, 0x85, 0x58, 0x45, 0x7b, 0xda, 0xf2, 0xb2, 0x0d, 0xf5, 0xf2, 0x0d, 0xf5, 0x5a,
-	0x9c, 0x47, 0xaf, 0x58, 0x34, 0x44, 0xfa, 0xe1, 0x0c, 0xef, 0x34, 0x97, 0xfb, 0x0a, 0x56, 0xf5,
-	0xfb, 0xb5, 0x59, 0x90, 0x5a, 0x35, 0x93, 0x73, 0x77, 0xde, 0x85, 0xb6, 0xfe, 0x54, 0x03, 0x4e,
-	0x96, 0x68, 0x4d, 0x4c, 0x43, 0x72, 0x0c, 0xeb, 0x02, 0xbb, 0xa1, 0xc0, 0x40, 0xe5, 0x12, 0xe5,
-	0x7c, 0x76, 0xe7, 0x24, 0x0c, 0xa8, 0x50, 0x59, 0x13, 0x73, 0x37, 0xe4, 0x35, 0x6c, 0x1a, 0x19,
-	0x81, 0x32, 0xe1, 0xb1, 0x2c, 0x2c, 0x65, 0xd5, 0x6e, 0xce, 0xeb, 0x1d, 0x6a, 0x2c, 0x35, 0xd0,
-	0x42, 0x75, 0xa3, 0x7b, 0xcd, 0x3d, 0xf9, 0x06, 0xd6, 0xbb, 0x18, 0x61, 0xda, 0xdf, 0x5c, 0x74,
-	0x59, 0x8b, 0xee, 0x5c, 0xe9, 0xf9, 0xa1, 0xc1, 0x4d, 0x7d, 0x76, 0xe7, 0x6e, 0xc8, 0x67, 0xd3,
-	0x31, 0x70, 0xf2, 0x92, 0x5f, 0xa9, 0xd5, 0xe2, 0x00, 0x10, 0x02, 0xb6, 0x5e, 0xaf, 0x74, 0xd8,
-	0xab, 0x54, 0x9f, 0x5b, 0x15, 0x70, 0x32, 0x33, 0xcd, 0x3f, 0x2d, 0x58, 0x9b, 0x7f, 0x30, 0xd9,
-	0x34, 0x04, 0x4b, 0xef, 0x63, 0xc9, 0xb5, 0x32, 0x12, 0x69, 0x40, 0x35, 0xfd, 0x2b, 0x13, 0x16,
-	0xa0, 0x6e, 0x56, 0x96, 0x9c, 0x5e, 0x92, 0x07, 0x50, 0x16, 0xd8, 0x33, 0x5d, 0xb8, 0x3b, 0xbf,
-	0x56, 0x14, 0x25, 0x1f, 0x8a, 0x00, 0x29, 0xf6, 0x4e, 0x96, 0x68, 0x8a, 0x23, 0x4f, 0xa1, 0x22,
-	0x31, 0xc2, 0x40, 0x71, 0x61, 0x2a, 0xbd, 0x7b, 0xfd, 0x22, 0xbc, 0x4c, 0xe7, 0xe6, 0xdc, 0x40,
-	0x4f, 0x96, 0x68, 0x41, 0x6b, 0xdd, 0x29, 0xca, 0x1b, 0xf2, 0xb8, 0xad, 0x26, 0x09, 0x36, 0x7f,
-	0xb5, 0x81, 0x5c, 0x65, 0x91, 0x6d, 0x80, 0xc2, 0x68, 0x36, 0xff, 0x55, 0x3a, 0x73, 0x43, 0x8e,
-	0xc1, 0x89, 0x58, 0x07, 0xa3, 0x6c, 0x59, 0x6b, 0xfb, 0xfe, 0x2d, 0xac, 0x78, 0xcf, 0x35, 0xe3,
-	0x28, 0x56, 0x62, 0x42, 0x0d, 0x9d, 0x7c, 0x07, 0x35, 0x1c, 0x27, 0x02, 0xa5, 0xd4, 0x9d, 0xca,
-	0x36, 0xdc, 0xbb, 0x8d, 0xda, 0x51, 0x41, 0xa3, 0xb3, 0x12, 0x5b, 0x5f, 0x40, 0x6d, 0xe6, 0x41,
-	0xa4, 0x0e, 0xe5, 0x4b, 0x9c, 0x64, 0xed, 0xa1, 0xe9, 0x91, 0x6c, 0xc0, 0xf2, 0x28, 0xdd, 0xb3,
-	0xac, 0x2b, 0x34, 0x0b, 0x0e, 0x4a, 0x8f, 0xad, 0xad, 0x9f, 0x4b, 0x00, 0x53, 0xd9, 0x6b, 0xa8,
-	0xe7, 0x50, 0xe1, 0x09, 0x0a, 0x96, 0xf6, 0x20, 0x65, 0xaf, 0xed, 0x7f, 0xfe, 0xff, 0xac, 0x7a,
-	0x2f, 0x0c, 0x9d, 0x16, 0x42, 0x64, 0x13, 0x1c, 0x6d, 0x21, 0x7b, 0xfb, 0x2a, 0x35, 0x51, 0xf3,
-	0x47, 0x0b, 0x2a, 0x39, 0x9c, 0x00, 0x38, 0x47, 0x3f, 0x0c, 0x59, 0x24, 0xeb, 0x4b, 0xa4, 0x0e,
-	0xab, 0x87, 0x7c, 0xd8, 0x89, 0xd0, 0xdc, 0x58, 0xe4, 0x03, 0xa8, 0x9e, 0x71, 0x65, 0xc2, 0x12,
-	0x71, 0xa0, 0xf4, 0x2c, 0xae, 0x97, 0x49, 0x15, 0x96, 0xcf, 0xb8, 0x7a, 0x16, 0xd7, 0x6d, 0xcd,
-	0x1f, 0x87, 0x52, 0xc9, 0xfa, 0x72, 0xc6, 0x47, 0x99, 0x32, 0xd2, 0xab, 0xba, 0x43, 0xd6, 0xa1,
-	0x76, 0x2c, 0x90, 0x29, 0x14, 0x2f, 0x2f, 0x58, 0x5c, 0x5f, 0x21, 0xab, 0x50, 0x79, 0x8e, 0x52,
-	0xea, 0xa8, 0xd2, 0xfa, 0x32, 0xfd, 0x58, 0xfc, 0xf6, 0xf7, 0xb6, 0xf5, 0xfa, 0xe1, 0xad, 0x7f,
-	0xd9, 0x24, 0x97, 0x7d, 0xf3, 0x8d, 0xed, 0x38, 0xfa, 0x7f, 0xdc, 0xc3, 0xff, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0xc8, 0xef, 0x17, 0xef, 0x17, 0x09, 0x00, 0x00,
+	// 1169 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xbd, 0x6e, 0x1b, 0x47,
+	0x17, 0xd5, 0x92, 0x2b, 0x8a, 0xbc, 0xd4, 0x27, 0xd2, 0x63, 0x42, 0x5e, 0x13, 0xfe, 0x64, 0x82,
+	0x4e, 0x60, 0x35, 0xde, 0x45, 0x6c, 0xc3, 0x71, 0x04, 0x24, 0x86, 0x19, 0x09, 0x92, 0x13, 0x49,
+	0x0e, 0x46, 0x82, 0x0b, 0x37, 0xc4, 0x92, 0x1c, 0x52, 0x1b, 0x2d, 0x77, 0x36, 0x33, 0x43, 0x5a,
+	0x6c, 0xdd, 0xe4, 0x09, 0xf2, 0x00, 0x49, 0x95, 0x47, 0x48, 0x9d, 0x2a, 0x5d, 0xde, 0xc0, 0x45,
+	0xba, 0x94, 0x36, 0x90, 0x36, 0x08, 0x76, 0x7e, 0x96, 0x5c, 0x8a, 0x84, 0x65, 0xb8, 0x9b, 0xb9,
+	0x3f, 0x87, 0x67, 0xee, 0xb9, 0xf7, 0x72, 0x61, 0x6f, 0x10, 0x88, 0xb3, 0x51, 0xc7, 0xed, 0xd2,
+	0xa1, 0xc7, 0x69, 0x48, 0xef, 0x05, 0xd4, 0x1b, 0x84, 0x94, 0x7a, 0x31, 0xa3, 0xdf, 0x93, 0xae,
+	0xe0, 0xde, 0xc0, 0x17, 0xe4, 0x95, 0x3f, 0xf1, 0xfc, 0x38, 0xf0, 0xc6, 0x9f, 0x79, 0xe3, 0x80,
+	0x89, 0x91, 0x1f, 0xb6, 0x39, 0x61, 0xe3, 0xa0, 0x4b, 0xdc, 0x98, 0x51, 0x41, 0x51, 0x45, 0x47,
+	0xb9, 0x09, 0x86, 0x1b, 0xd0, 0x7a, 0x6d, 0x40, 0x07, 0x54, 0xfa, 0xbc, 0xe4, 0xa4, 0xc2, 0xea,
+	0x88, 0x5c, 0x08, 0x65, 0x24, 0x17, 0x42, 0xdb, 0xb6, 0x06, 0x94, 0x0e, 0x42, 0xe2, 0xc9, 0x5b,
+	0x67, 0xd4, 0xf7, 0x5e, 0x31, 0x3f, 0x8e, 0x09, 0xe3, 0xc6, 0x2f, 0x69, 0x9d, 0x07, 0xc2, 0x30,
+	0x18, 0x12, 0xe1, 0xf7, 0x7c, 0xe1, 0x6b, 0xff, 0xad, 0x79, 0x3f, 0x17, 0xbe, 0x18, 0x99, 0xec,
+	0x9b, 0xf3, 0x5e, 0x46, 0xfa, 0xcb, 0x80, 0xcd, 0x5d, 0xfb, 0xef, 0xcc, 0xd5, 0x21, 0xb9, 0x99,
+	0x48, 0x1e, 0xea, 0xa0, 0x4f, 0x97, 0x07, 0xc5, 0x8c, 0x5e, 0x4c, 0x74, 0xd8, 0xdd, 0xe5, 0x61,
+	0x34, 0x16, 0x01, 0x8d, 0x0c, 0xdf, 0x47, 0xcb, 0x03, 0xbb, 0x94, 0x11, 0x6f, 0xe8, 0x8b, 0xee,
+	0x19, 0x61, 0x3c, 0x3d, 0xa8, 0xbc, 0xe6, 0xbf, 0x79, 0xd8, 0x78, 0xa1, 0xa4, 0x39, 0x51, 0xca,
+	0xa0, 0x27, 0xb0, 0x6e, 0xc4, 0x3a, 0xa3, 0x5c, 0x38, 0x56, 0xc3, 0xda, 0x2e, 0xdf, 0xbf, 0xe5,
+	0xce, 0x49, 0xe5, 0xea, 0xb4, 0x03, 0xca, 0x05, 0x2e, 0x8f, 0xa7, 0x17, 0xf4, 0x08, 0x80, 0xf3,
+	0xb0, 0xdd, 0xa5, 0x51, 0x3f, 0x18, 0x38, 0x39, 0x99, 0x7e, 0xc3, 0x4d, 0x28, 0xa5, 0xb9, 0x27,
+	0x3c, 0xfc, 0x5a, 0xba, 0x71, 0x89, 0x9b, 0x23, 0xba, 0x0b, 0xeb, 0xbd, 0x80, 0xc7, 0xa1, 0x3f,
+	0x69, 0x47, 0xfe, 0x90, 0x38, 0xf9, 0x86, 0xb5, 0x5d, 0x6a, 0xd9, 0xbf, 0xfd, 0x63, 0x5b, 0xb8,
+	0xac, 0x3d, 0xc7, 0xfe, 0x90, 0xa0, 0x36, 0x54, 0x35, 0x99, 0x36, 0x27, 0x21, 0xe9, 0x0a, 0xca,
+	0x1c, 0xbb, 0x91, 0xdf, 0x2e, 0xdf, 0x7f, 0xb8, 0x8c, 0xa5, 0x7e, 0x9c, 0xbb, 0xaf, 0xdc, 0x27,
+	0x3a, 0x6d, 0x2f, 0x12, 0x6c, 0x82, 0x2b, 0x83, 0xac, 0x15, 0x7d, 0x0b, 0x05, 0xd5, 0x0d, 0x4e,
+	0x41, 0xb2, 0xaf, 0xb9, 0x49, 0x11, 0xa7, 0xec, 0xa5, 0xaf, 0xf5, 0xff, 0x84, 0xd9, 0x1f, 0x6f,
+	0x6e, 0xaf, 0xbc, 0x7b, 0x73, 0xfb, 0x9a, 0x20, 0x5c, 0xf4, 0x82, 0x7e, 0x7f, 0xa7, 0x19, 0x0c,
+	0x22, 0xca, 0x48, 0x13, 0x6b, 0x08, 0xf4, 0x18, 0x8a, 0xa6, 0xf5, 0x9c, 0x35, 0x09, 0xb7, 0x99,
+	0x85, 0x3b, 0xd2, 0xde, 0x96, 0x9d, 0x80, 0xe1, 0x34, 0xba, 0xde, 0x82, 0xda, 0x22, 0xbe, 0xa8,
+	0x0a, 0xf9, 0x73, 0x32, 0x91, 0xc2, 0x94, 0x70, 0x72, 0x44, 0x35, 0x58, 0x1d, 0xfb, 0xe1, 0x88,
+	0xc8, 0x6a, 0x97, 0xb0, 0xba, 0xec, 0xe4, 0x1e, 0x5b, 0x3b, 0x5b, 0xaf, 0xdf, 0xda, 0x36, 0xe4,
+	0xc6, 0xfc, 0xf5, 0x5b, 0x1b, 0xa1, 0xea, 0xdc, 0x18, 0xf2, 0xe6, 0xdf, 0x16, 0x94, 0x67, 0x94,
+	0x44, 0x0e, 0xac, 0xf5, 0xe8, 0xd0, 0x0f, 0x22, 0xee, 0xe4, 0x1a, 0xf9, 0xed, 0x12, 0x36, 0x57,
+	0xe4, 0x42, 0x81, 0xd1, 0x91, 0x20, 0xdc, 0xc9, 0xcb, 0x5a, 0x6f, 0x5e, 0xaa, 0x35, 0x4e, 0xdc,
+	0x58, 0x47, 0xa1, 0x1d, 0x58, 0xd3, 0x3d, 0xea, 0xd8, 0xf2, 0xd9, 0x8d, 0x6c, 0x0f, 0xcc, 0xfc,
+	0xea, 0x73, 0x15, 0x87, 0x4d, 0x02, 0x3a, 0x85, 0xeb, 0xfa, 0xa8, 0xdb, 0xa8, 0xcd, 0x48, 0x9f,
+	0x3b, 0xab, 0x12, 0xe7, 0x93, 0x4b, 0x3f, 0xbc, 0x4b, 0x42, 0x92, 0xd8, 0x0c, 0x0e, 0xe9, 0x73,
+	0x7c, 0x4d, 0x03, 0xe8, 0x3e, 0x23, 0x7d, 0xde, 0x7c, 0x67, 0xc3, 0xaa, 0xe4, 0x88, 0x9e, 0x40,
+	0xd1, 0x0c, 0x82, 0x63, 0xc9, 0xd7, 0xdc, 0x71, 0x8d, 0x41, 0x89, 0x93, 0xa1, 0x7a, 0xa4, 0x5c,
+	0x38, 0x4d, 0x42, 0x47, 0x50, 0x0b, 0xa2, 0x33, 0xc2, 0x02, 0xe1, 0x77, 0x42, 0xd2, 0x4e, 0xc1,
+	0x8a, 0x92, 0x61, 0xdd, 0x55, 0xcb, 0xc9, 0x35, 0xcb, 0xc9, 0x6d, 0x51, 0x1a, 0xbe, 0x48, 0x44,
+	0xc1, 0xd7, 0x67, 0xf2, 0x8e, 0x0c, 0xdc, 0x57, 0xb0, 0x2e, 0xab, 0xd6, 0xf6, 0xbb, 0x09, 0x69,
+	0x3d, 0x34, 0x37, 0xb3, 0x2c, 0x24, 0xf5, 0xa7, 0x32, 0xe0, 0x60, 0x05, 0x97, 0xd9, 0xf4, 0x8a,
+	0xf6, 0xa1, 0xc2, 0x48, 0x2f, 0x60, 0xa4, 0x2b, 0x0c, 0x44, 0xde, 0x8c, 0x6d, 0x06, 0x42, 0x07,
+	0xa5, 0x28, 0x1b, 0x2c, 0x63, 0x41, 0x2f, 0x61, 0x53, 0xc3, 0x30, 0xc2, 0x63, 0x1a, 0xf1, 0x94,
+	0x92, 0xd2, 0xb0, 0x99, 0xc5, 0xdb, 0x95, 0xb1, 0x58, 0x87, 0xa6, 0xa8, 0xb5, 0xde, 0x02, 0x3b,
+	0xfa, 0x06, 0x2a, 0x3d, 0x2d, 0x94, 0x01, 0x55, 0x82, 0xde, 0x5e, 0x2a, 0xe8, 0x94, 0x67, 0x2f,
+	0x63, 0x41, 0x0f, 0xa7, 0xcd, 0x55, 0x30, 0x25, 0xbf, 0x54, 0xab, 0x4b, 0x6d, 0x85, 0xc0, 0x96,
+	0x9b, 0x65, 0x4d, 0x4e, 0x89, 0x3c, 0x2f, 0x6b, 0xb5, 0xd2, 0x47, 0xb5, 0x5a, 0xab, 0x08, 0x05,
+	0xf5, 0xc4, 0xe6, 0xcf, 0x16, 0x5c, 0x5f, 0x90, 0x84, 0x76, 0xa1, 0x9a, 0x56, 0xc3, 0x3c, 0x45,
+	0xb5, 0xe2, 0xcd, 0xec, 0x7a, 0xc0, 0x84, 0xd3, 0x11, 0xeb, 0x12, 0x4c, 0xfa, 0xb8, 0xd2, 0xcb,
+	0x22, 0xa1, 0x5d, 0x28, 0xa6, 0x2b, 0x50, 0x35, 0xcd, 0xf6, 0xfb, 0x28, 0x9b, 0x5d, 0x82, 0xd3,
+	0xcc, 0xe6, 0xef, 0x16, 0xdc, 0x58, 0x12, 0x85, 0xb6, 0x00, 0x92, 0x3a, 0xf1, 0xd8, 0xef, 0x12,
+	0xc5, 0xb0, 0x84, 0x67, 0x2c, 0xe8, 0x10, 0x0a, 0xa1, 0xdf, 0x21, 0xa1, 0xda, 0x17, 0x8b, 0x56,
+	0xf0, 0x12, 0x64, 0xf7, 0x50, 0xa6, 0xa9, 0x15, 0xac, 0x31, 0xea, 0x5f, 0x40, 0x79, 0xc6, 0xfc,
+	0x21, 0x9b, 0xae, 0xf9, 0xa7, 0x05, 0x1b, 0xd9, 0xbe, 0x41, 0x9b, 0x5a, 0x6f, 0x99, 0xdf, 0xca,
+	0x39, 0x96, 0xd6, 0xbc, 0x01, 0xa5, 0xf4, 0x05, 0x4e, 0x2e, 0x75, 0x4e, 0x8d, 0xe8, 0x1e, 0xe4,
+	0x19, 0xe9, 0xeb, 0x21, 0x5a, 0x2e, 0xc8, 0xc1, 0x0a, 0x4e, 0xe2, 0xd0, 0xd3, 0x19, 0x19, 0xd4,
+	0xa0, 0xdc, 0x59, 0xbc, 0x1d, 0x4f, 0x93, 0xb1, 0x37, 0x15, 0x38, 0x58, 0x99, 0x6a, 0xd0, 0xba,
+	0x96, 0x4e, 0x47, 0x40, 0xa3, 0xb6, 0x98, 0xc4, 0xa4, 0xf9, 0x8b, 0x0d, 0xe8, 0x72, 0xd6, 0x7b,
+	0x15, 0xd9, 0x9f, 0x53, 0xc4, 0xbb, 0x02, 0x95, 0x45, 0x62, 0xa0, 0xef, 0xa0, 0x4c, 0x2e, 0x62,
+	0x46, 0x38, 0x97, 0xdd, 0xa9, 0xd6, 0xbe, 0x7b, 0x15, 0xb4, 0xbd, 0x34, 0x0d, 0xcf, 0x42, 0x7c,
+	0x84, 0xbc, 0xf5, 0x9f, 0x72, 0x00, 0x53, 0xd8, 0x05, 0xa9, 0x27, 0x50, 0xa4, 0x31, 0x61, 0xbe,
+	0x19, 0x85, 0x8d, 0xfb, 0x9f, 0x7f, 0x18, 0x55, 0xf7, 0xb9, 0x4e, 0xc7, 0x29, 0x10, 0xda, 0x84,
+	0x82, 0xa4, 0xa0, 0x5e, 0x5f, 0xc2, 0xfa, 0xd6, 0xfc, 0xd1, 0x82, 0xa2, 0x09, 0x47, 0x00, 0x85,
+	0xbd, 0x1f, 0x46, 0x7e, 0xc8, 0xab, 0x2b, 0xa8, 0x0a, 0xeb, 0xbb, 0x74, 0xd4, 0x09, 0x89, 0xb6,
+	0x58, 0xe8, 0x7f, 0x50, 0x3a, 0xa6, 0x42, 0x5f, 0x73, 0xa8, 0x00, 0xb9, 0x67, 0x51, 0x35, 0x8f,
+	0x4a, 0xb0, 0x7a, 0x4c, 0xc5, 0xb3, 0xa8, 0x6a, 0xcb, 0xfc, 0x8b, 0x80, 0x0b, 0x5e, 0x5d, 0x55,
+	0xf9, 0x84, 0x27, 0x19, 0x89, 0xa9, 0x5a, 0x40, 0x15, 0x28, 0xef, 0x33, 0xe2, 0x0b, 0xc2, 0x4e,
+	0xcf, 0xfc, 0xa8, 0xba, 0x86, 0xd6, 0xa1, 0x78, 0x48, 0x38, 0x97, 0xb7, 0x62, 0xeb, 0xcb, 0xe4,
+	0x2b, 0xe4, 0xd7, 0xbf, 0xb6, 0xac, 0x97, 0x0f, 0xae, 0xfc, 0x4d, 0x1e, 0x9f, 0x0f, 0xf4, 0xd7,
+	0x61, 0xa7, 0x20, 0xff, 0xa2, 0x1e, 0xfc, 0x37, 0x00, 0xc5, 0x2a, 0xb7, 0x51, 0xd1, 0x0b, 0x00,
+	0x00,
 }
 
 func (this *VirtualService) Equal(that interface{}) bool {
@@ -824,6 +973,14 @@ func (this *VirtualService) Equal(that interface{}) bool {
 	if this.DisplayName != that1.DisplayName {
 		return false
 	}
+	if len(this.GatewaySelector) != len(that1.GatewaySelector) {
+		return false
+	}
+	for i := range this.GatewaySelector {
+		if this.GatewaySelector[i] != that1.GatewaySelector[i] {
+			return false
+		}
+	}
 	if !this.Status.Equal(&that1.Status) {
 		return false
 	}
@@ -873,6 +1030,9 @@ func (this *VirtualHost) Equal(that interface{}) bool {
 	if !this.Options.Equal(that1.Options) {
 		return false
 	}
+	if !this.OptionsConfigRefs.Equal(that1.OptionsConfigRefs) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -923,6 +1083,9 @@ func (this *Route) Equal(that interface{}) bool {
 	if this.Name != that1.Name {
 		return false
 	}
+	if !this.OptionsConfigRefs.Equal(that1.OptionsConfigRefs) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1024,6 +1187,81 @@ func (this *Route_DelegateAction) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DelegateOptionsRefs) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegateOptionsRefs)
+	if !ok {
+		that2, ok := that.(DelegateOptionsRefs)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.DelegateOptions) != len(that1.DelegateOptions) {
+		return false
+	}
+	for i := range this.DelegateOptions {
+		if !this.DelegateOptions[i].Equal(that1.DelegateOptions[i]) {
+			return false
+		}
+	}
+	if !this.Selector.Equal(that1.Selector) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *DelegateOptionsSelector) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegateOptionsSelector)
+	if !ok {
+		that2, ok := that.(DelegateOptionsSelector)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Namespaces) != len(that1.Namespaces) {
+		return false
+	}
+	for i := range this.Namespaces {
+		if this.Namespaces[i] != that1.Namespaces[i] {
+			return false
+		}
+	}
+	if len(this.Labels) != len(that1.Labels) {
+		return false
+	}
+	for i := range this.Labels {
+		if this.Labels[i] != that1.Labels[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *DelegateAction) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -77,21 +77,31 @@ func forEachListener(proxy *gloov1.Proxy, reports reporter.ResourceReports, fn f
 	return nil
 }
 
-func forEachVhost(lis *gloov1.Listener, reports reporter.ResourceReports, fn func(*gloov1.VirtualHost, bool)) error {
-	if httpListenerType, ok := lis.ListenerType.(*gloov1.Listener_HttpListener); ok {
-
-		for _, vhost := range httpListenerType.HttpListener.GetVirtualHosts() {
-			accepted, err := reporting.AllSourcesAccepted(reports, vhost)
-			if err != nil {
-				return err
-			}
-
-			fn(vhost, accepted)
+func forEachVhost(httpListener *gloov1.HttpListener, reports reporter.ResourceReports, fn func(*gloov1.VirtualHost, bool)) error {
+	for _, vhost := range httpListener.GetVirtualHosts() {
+		accepted, err := reporting.AllSourcesAccepted(reports, vhost)
+		if err != nil {
+			return err
 		}
+
+		fn(vhost, accepted)
 	}
 	return nil
 }
 
+// returns the http listener of the listener, or the http listeners of its matched listeners if it is a hybrid listener.
+// the matched listeners that are not http have a nil entry, so that the indices match the matched listeners.
+func httpListenersOf(lis *gloov1.Listener) []*gloov1.HttpListener {
+	if httpListener := lis.GetHttpListener(); httpListener != nil {
+		return []*gloov1.HttpListener{httpListener}
+	}
+	var httpListeners []*gloov1.HttpListener
+	for _, matchedListener := range lis.GetHybridListener().GetMatchedListeners() {
+		httpListeners = append(httpListeners, matchedListener.GetHttpListener())
+	}
+	return httpListeners
+}
+
 // validate generated proxies and add reports for the owner resources
 // this function makes a gRPC call to gloo validation server
 func (s *proxyReconciler) addProxyValidationResults(ctx context.Context, proxiesToWrite GeneratedProxies) error {
@@ -153,10 +163,14 @@ func stripInvalidListenersAndVirtualHosts(ctx context.Context, proxiesToWrite Ge
 
 		for _, lis := range proxy.Listeners {
 
-			if httpListenerType, ok := lis.ListenerType.(*gloov1.Listener_HttpListener); ok {
+			for _, httpListener := range httpListenersOf(lis) {
+				if httpListener == nil {
+					continue
+				}
+
 				var validVhosts []*gloov1.VirtualHost
 
-				if err := forEachVhost(lis, reports, func(vhost *gloov1.VirtualHost, accepted bool) {
+				if err := forEachVhost(httpListener, reports, func(vhost *gloov1.VirtualHost, accepted bool) {
 					if accepted {
 						validVhosts = append(validVhosts, vhost)
 					} else {
@@ -170,7 +184,7 @@ func stripInvalidListenersAndVirtualHosts(ctx context.Context, proxiesToWrite Ge
 					return validVhosts[i].Name < validVhosts[j].Name
 				})
 
-				httpListenerType.HttpListener.VirtualHosts = validVhosts
+				httpListener.VirtualHosts = validVhosts
 			}
 		}
 
@@ -209,11 +223,6 @@ func transitionFunc(proxiesToWrite GeneratedProxies) gloov1.TransitionProxyFunc
 		// preserve previous vhosts if new vservice was errored
 		for _, desiredListener := range desired.Listeners {
 
-			desiredHttpListener := desiredListener.GetHttpListener()
-			if desiredHttpListener == nil {
-				continue
-			}
-
 			// find the original listener by its name
 			// if it does not exist in the original, skip
 			var originalListener *gloov1.Listener
@@ -227,20 +236,27 @@ func transitionFunc(proxiesToWrite GeneratedProxies) gloov1.TransitionProxyFunc
 				continue
 			}
 
-			// find any rejected vhosts on the original listener and copy them over
-			if err := forEachVhost(originalListener, proxiesToWrite[desired], func(vhost *gloov1.VirtualHost, accepted bool) {
-				// old vhost was rejected, preserve it on the desired proxy
-				if !accepted {
-					desiredHttpListener.VirtualHosts = append(desiredHttpListener.VirtualHosts, vhost)
+			originalHttpListeners := httpListenersOf(originalListener)
+			for i, desiredHttpListener := range httpListenersOf(desiredListener) {
+				if desiredHttpListener == nil || i >= len(originalHttpListeners) {
+					continue
 				}
-			}); err != nil {
-				// should never happen
-				return false, err
-			}
 
-			sort.SliceStable(desiredHttpListener.VirtualHosts, func(i, j int) bool {
-				return desiredHttpListener.VirtualHosts[i].Name < desiredHttpListener.VirtualHosts[j].Name
-			})
+				// find any rejected vhosts on the original listener and copy them over
+				if err := forEachVhost(originalHttpListeners[i], proxiesToWrite[desired], func(vhost *gloov1.VirtualHost, accepted bool) {
+					// old vhost was rejected, preserve it on the desired proxy
+					if !accepted {
+						desiredHttpListener.VirtualHosts = append(desiredHttpListener.VirtualHosts, vhost)
+					}
+				}); err != nil {
+					// should never happen
+					return false, err
+				}
+
+				sort.SliceStable(desiredHttpListener.VirtualHosts, func(i, j int) bool {
+					return desiredHttpListener.VirtualHosts[i].Name < desiredHttpListener.VirtualHosts[j].Name
+				})
+			}
 
 		}
 
@@ -1,6 +1,9 @@
 package reporting
 
 import (
+	"strings"
+
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -11,9 +14,10 @@ import (
 )
 
 var (
-	invalidReportsListenersErr    = errors.Errorf("internal err: reports did not match number of listeners")
-	invalidReportsVirtualHostsErr = errors.Errorf("internal err: reports did not match number of virtual hosts")
-	missingReportForSourceErr     = errors.Errorf("internal err: missing resource report for source resource")
+	invalidReportsListenersErr        = errors.Errorf("internal err: reports did not match number of listeners")
+	invalidReportsVirtualHostsErr     = errors.Errorf("internal err: reports did not match number of virtual hosts")
+	invalidReportsMatchedListenersErr = errors.Errorf("internal err: reports did not match number of matched listeners")
+	missingReportForSourceErr         = errors.Errorf("internal err: missing resource report for source resource")
 )
 
 // Update a set of ResourceReports with the results of a proxy validation
@@ -36,17 +40,24 @@ func AddProxyValidationResult(resourceReports reporter.ResourceReports, proxy *g
 		}
 
 		if httpListenerReport := listenerReport.GetHttpListenerReport(); httpListenerReport != nil {
-			vhReports := httpListenerReport.GetVirtualHostReports()
-			virtualHosts := listener.GetHttpListener().GetVirtualHosts()
-
-			if len(vhReports) != len(virtualHosts) {
-				return invalidReportsVirtualHostsErr
+			if err := addHttpListenerResult(resourceReports, listener.GetHttpListener(), httpListenerReport); err != nil {
+				return err
 			}
+		}
 
-			for j, vhReport := range vhReports {
-				virtualHost := virtualHosts[j]
+		if hybridListenerReport := listenerReport.GetHybridListenerReport(); hybridListenerReport != nil {
+			matchedListeners := listener.GetHybridListener().GetMatchedListeners()
+			matchedListenerReports := hybridListenerReport.GetMatchedListenerReports()
+			if len(matchedListenerReports) != len(matchedListeners) {
+				return invalidReportsMatchedListenersErr
+			}
 
-				if err := addVirtualHostResult(resourceReports, virtualHost, vhReport); err != nil {
+			for j, matchedListenerReport := range matchedListenerReports {
+				httpListenerReport := matchedListenerReport.GetHttpListenerReport()
+				if httpListenerReport == nil {
+					continue
+				}
+				if err := addHttpListenerResult(resourceReports, matchedListeners[j].GetHttpListener(), httpListenerReport); err != nil {
 					return err
 				}
 			}
@@ -56,17 +67,79 @@ func AddProxyValidationResult(resourceReports reporter.ResourceReports, proxy *g
 	return nil
 }
 
+func addHttpListenerResult(resourceReports reporter.ResourceReports, httpListener *gloov1.HttpListener, httpListenerReport *validation.HttpListenerReport) error {
+	vhReports := httpListenerReport.GetVirtualHostReports()
+	virtualHosts := httpListener.GetVirtualHosts()
+
+	if len(vhReports) != len(virtualHosts) {
+		return invalidReportsVirtualHostsErr
+	}
+
+	for j, vhReport := range vhReports {
+		virtualHost := virtualHosts[j]
+
+		if err := addVirtualHostResult(resourceReports, virtualHost, vhReport); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func addListenerResult(resourceReports reporter.ResourceReports, listener *gloov1.Listener, listenerReport *validation.ListenerReport) error {
-	listenerErrs := getListenerLevelErrors(listenerReport)
+	listenerErrs, listenerWarnings := getListenerLevelErrorsAndWarnings(listenerReport)
 
-	return translator.ForEachSource(listener, func(src translator.SourceRef) error {
+	if err := translator.ForEachSource(listener, func(src translator.SourceRef) error {
 		srcResource, _ := resourceReports.Find(src.ResourceKind, core.ResourceRef{Name: src.Name, Namespace: src.Namespace})
 		if srcResource == nil {
 			return missingReportForSourceErr
 		}
 		resourceReports.AddErrors(srcResource, listenerErrs...)
+		resourceReports.AddWarnings(srcResource, listenerWarnings...)
 		return nil
-	})
+	}); err != nil {
+		return err
+	}
+
+	return addMissingSslSecretWarnings(resourceReports, listener, listenerWarnings)
+}
+
+// the virtual services whose ssl config references a missing secret are warned along with the gateway
+func addMissingSslSecretWarnings(resourceReports reporter.ResourceReports, listener *gloov1.Listener, warnings []string) error {
+	if len(warnings) == 0 {
+		return nil
+	}
+
+	httpListeners := []*gloov1.HttpListener{listener.GetHttpListener()}
+	for _, matchedListener := range listener.GetHybridListener().GetMatchedListeners() {
+		httpListeners = append(httpListeners, matchedListener.GetHttpListener())
+	}
+
+	warned := map[core.ResourceRef]bool{}
+	for _, httpListener := range httpListeners {
+		for _, virtualHost := range httpListener.GetVirtualHosts() {
+			if err := translator.ForEachSource(virtualHost, func(src translator.SourceRef) error {
+				srcResource, _ := resourceReports.Find(src.ResourceKind, core.ResourceRef{Name: src.Name, Namespace: src.Namespace})
+				vs, ok := srcResource.(*v1.VirtualService)
+				if !ok || vs.GetSslConfig().GetSecretRef() == nil || warned[vs.GetMetadata().Ref()] {
+					return nil
+				}
+				warned[vs.GetMetadata().Ref()] = true
+
+				// the warning ends with the ref of the secret which is missing
+				secret := "secret " + vs.GetSslConfig().GetSecretRef().Key()
+				for _, warning := range warnings {
+					if strings.HasSuffix(warning, secret) {
+						resourceReports.AddWarning(vs, warning)
+					}
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 func addVirtualHostResult(resourceReports reporter.ResourceReports, virtualHost *gloov1.VirtualHost, vhReport *validation.VirtualHostReport) error {
@@ -83,9 +156,21 @@ func addVirtualHostResult(resourceReports reporter.ResourceReports, virtualHost
 	})
 }
 
-// get errors that can be caused by gateways
-func getListenerLevelErrors(listenerReport *validation.ListenerReport) []error {
-	listenerErrs := validationutils.GetListenerErr(listenerReport)
+// get errors and warnings that can be caused by gateways
+// gloo accepts listeners without the filter chains whose ssl secret is missing, so those are only warnings
+func getListenerLevelErrorsAndWarnings(listenerReport *validation.ListenerReport) ([]error, []string) {
+	var (
+		listenerErrs     []error
+		listenerWarnings []string
+	)
+	for _, errReport := range listenerReport.GetErrors() {
+		listenerErr := validationutils.MakeListenerErr(errReport.GetType(), errReport.GetReason())
+		if validationutils.IsSslSecretNotFoundErr(errReport) {
+			listenerWarnings = append(listenerWarnings, listenerErr.Error())
+			continue
+		}
+		listenerErrs = append(listenerErrs, listenerErr)
+	}
 
 	switch listenerType := listenerReport.ListenerTypeReport.(type) {
 	case *validation.ListenerReport_HttpListenerReport:
@@ -93,14 +178,29 @@ func getListenerLevelErrors(listenerReport *validation.ListenerReport) []error {
 		listenerErrs = append(listenerErrs, validationutils.GetHttpListenerErr(httpListener)...)
 
 	case *validation.ListenerReport_TcpListenerReport:
-		tcpListener := listenerType.TcpListenerReport
-		listenerErrs = append(listenerErrs, validationutils.GetTcpListenerErr(tcpListener)...)
+		listenerErrs = append(listenerErrs, getTcpListenerLevelErrors(listenerType.TcpListenerReport)...)
 
-		for _, hostReport := range tcpListener.GetTcpHostReports() {
-			listenerErrs = append(listenerErrs, validationutils.GetTcpHostErr(hostReport)...)
+	case *validation.ListenerReport_HybridListenerReport:
+		for _, matchedListenerReport := range listenerType.HybridListenerReport.GetMatchedListenerReports() {
+			if httpListener := matchedListenerReport.GetHttpListenerReport(); httpListener != nil {
+				listenerErrs = append(listenerErrs, validationutils.GetHttpListenerErr(httpListener)...)
+			}
+			if tcpListener := matchedListenerReport.GetTcpListenerReport(); tcpListener != nil {
+				listenerErrs = append(listenerErrs, getTcpListenerLevelErrors(tcpListener)...)
+			}
 		}
 	}
 
+	return listenerErrs, listenerWarnings
+}
+
+func getTcpListenerLevelErrors(tcpListener *validation.TcpListenerReport) []error {
+	listenerErrs := validationutils.GetTcpListenerErr(tcpListener)
+
+	for _, hostReport := range tcpListener.GetTcpHostReports() {
+		listenerErrs = append(listenerErrs, validationutils.GetTcpHostErr(hostReport)...)
+	}
+
 	return listenerErrs
 }
 
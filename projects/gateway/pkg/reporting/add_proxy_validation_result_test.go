@@ -6,9 +6,11 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
 	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
 	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 	"github.com/solo-io/gloo/test/samples"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
@@ -66,4 +68,79 @@ var _ = Describe("AddProxyValidationResult", func() {
 	* Route Error: InvalidMatcherError. Reason: bad route`))
 		}
 	})
+	It("adds the errors of the virtual hosts of hybrid listeners to the virtual services", func() {
+		hybridGateway := &v1.Gateway{
+			Metadata: core.Metadata{Name: "hybrid", Namespace: ignored},
+			GatewayType: &v1.Gateway_HybridGateway{
+				HybridGateway: &v1.HybridGateway{
+					MatchedGateways: []*v1.MatchedGateway{{
+						Matcher: &gloov1.Matcher{},
+						GatewayType: &v1.MatchedGateway_HttpGateway{
+							HttpGateway: &v1.HttpGateway{},
+						},
+					}},
+				},
+			},
+			BindPort: 9999,
+		}
+		snap.Gateways = v1.GatewayList{hybridGateway}
+		tx := translator.NewTranslator([]translator.ListenerFactory{&translator.HybridTranslator{}}, translator.Opts{})
+		proxy, reports = tx.Translate(context.TODO(), ignored, ignored, snap, snap.Gateways)
+
+		proxyReport := validation.MakeReport(proxy)
+		for _, lis := range proxyReport.ListenerReports {
+			for _, matchedListener := range lis.GetHybridListenerReport().GetMatchedListenerReports() {
+				for _, vHost := range matchedListener.GetHttpListenerReport().GetVirtualHostReports() {
+					validation.AppendVirtualHostError(vHost,
+						validationapi.VirtualHostReport_Error_DomainsNotUniqueError,
+						"bad vhost")
+				}
+			}
+		}
+
+		err := AddProxyValidationResult(reports, proxy, proxyReport)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(reports[hybridGateway].Errors).NotTo(HaveOccurred())
+		for _, vs := range snap.VirtualServices {
+			Expect(reports[vs].Errors).To(HaveOccurred())
+			Expect(reports[vs].Errors.Error()).To(ContainSubstring("VirtualHost Error: DomainsNotUniqueError. Reason: bad vhost"))
+		}
+	})
+	It("adds the errors of missing ssl secrets as warnings to the gateway and the virtual services referencing them", func() {
+		sslVirtualService := func(name string) *v1.VirtualService {
+			return &v1.VirtualService{
+				Metadata: core.Metadata{Name: name, Namespace: ignored},
+				VirtualHost: &v1.VirtualHost{
+					Domains: []string{name + ".example.com"},
+				},
+				SslConfig: &gloov1.SslConfig{
+					SslSecrets: &gloov1.SslConfig_SecretRef{SecretRef: &core.ResourceRef{Name: name, Namespace: ignored}},
+				},
+			}
+		}
+		missing, present := sslVirtualService("missing"), sslVirtualService("present")
+		sslGateway := defaults.DefaultSslGateway(ignored)
+		snap.Gateways = v1.GatewayList{sslGateway}
+		snap.VirtualServices = v1.VirtualServiceList{missing, present}
+		tx := translator.NewTranslator([]translator.ListenerFactory{&translator.HttpTranslator{}}, translator.Opts{})
+		proxy, reports = tx.Translate(context.TODO(), ignored, ignored, snap, snap.Gateways)
+
+		_, findErr := gloov1.SecretList{}.Find(ignored, "missing")
+		proxyReport := validation.MakeReport(proxy)
+		for _, lis := range proxyReport.ListenerReports {
+			validation.AppendListenerError(lis,
+				validationapi.ListenerReport_Error_SSLConfigError,
+				utils.SslSecretNotFoundError(findErr).Error())
+		}
+
+		err := AddProxyValidationResult(reports, proxy, proxyReport)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(reports[sslGateway].Errors).NotTo(HaveOccurred())
+		Expect(reports[sslGateway].Warnings).To(ConsistOf(ContainSubstring("SSL secret not found")))
+		Expect(reports[missing].Errors).NotTo(HaveOccurred())
+		Expect(reports[missing].Warnings).To(ConsistOf(ContainSubstring("SSL secret not found")))
+		Expect(reports[present].Warnings).To(BeEmpty())
+	})
 })
@@ -0,0 +1,13 @@
+package acme_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestAcme(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Acme Suite")
+}
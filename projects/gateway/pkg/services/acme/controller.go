@@ -0,0 +1,417 @@
+package acme
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	skerrors "github.com/solo-io/solo-kit/pkg/errors"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/acme"
+)
+
+const (
+	LetsEncryptDirectoryUrl = "https://acme-v02.api.letsencrypt.org/directory"
+	DefaultRenewBefore      = 30 * 24 * time.Hour
+	DefaultSolverPort       = 8089
+
+	// the private key of the ACME account is stored in this secret in the write namespace
+	AccountKeySecretName = "acme-account-key"
+)
+
+var (
+	// the certificates are checked at this interval, in addition to every change of the virtual services
+	checkInterval = time.Hour
+
+	// failed requests are not retried before this interval, to stay within the rate limits of the ACME server
+	retryInterval = 10 * time.Minute
+
+	NoHttp01ChallengeErr = func(domain string) error {
+		return errors.Errorf("acme: the ACME server offered no http-01 challenge for %v", domain)
+	}
+	TermsOfServiceNotAcceptedErr = errors.New("acme: the terms of service of the ACME server must be accepted " +
+		"with settings.gateway.acme.acceptTermsOfService")
+)
+
+// Controller requests certificates from an ACME server for the virtual services annotated with
+// translator.AcmeAnnotation, and renews them before they expire. The issued certificates are
+// stored in the secrets the gateway translator references in their ssl configs.
+type Controller struct {
+	opts           translator.AcmeOpts
+	writeNamespace string
+	secretClient   gloov1.SecretClient
+	upstreamClient gloov1.UpstreamClient
+	solver         *Solver
+
+	lock            sync.Mutex
+	client          *acme.Client
+	virtualServices v1.VirtualServiceList
+	inFlight        map[core.ResourceRef]bool
+	failedAt        map[core.ResourceRef]time.Time
+}
+
+var _ v1.ApiSyncer = new(Controller)
+
+func NewController(opts translator.AcmeOpts, writeNamespace string, secretClient gloov1.SecretClient, upstreamClient gloov1.UpstreamClient) *Controller {
+	if opts.DirectoryUrl == "" {
+		opts.DirectoryUrl = LetsEncryptDirectoryUrl
+	}
+	if opts.RenewBefore <= 0 {
+		opts.RenewBefore = DefaultRenewBefore
+	}
+	if opts.SolverPort == 0 {
+		opts.SolverPort = DefaultSolverPort
+	}
+	return &Controller{
+		opts:           opts,
+		writeNamespace: writeNamespace,
+		secretClient:   secretClient,
+		upstreamClient: upstreamClient,
+		solver:         NewSolver(),
+		inFlight:       map[core.ResourceRef]bool{},
+		failedAt:       map[core.ResourceRef]time.Time{},
+	}
+}
+
+// Start writes the upstream of the challenge solver, serves the challenges, and checks
+// the certificates periodically until the context is cancelled
+func (c *Controller) Start(ctx context.Context) error {
+	if !c.opts.AcceptTermsOfService {
+		return TermsOfServiceNotAcceptedErr
+	}
+	if err := c.writeSolverUpstream(ctx); err != nil {
+		return err
+	}
+
+	solverServer := &http.Server{
+		Addr:    fmt.Sprintf(":%v", c.opts.SolverPort),
+		Handler: c.solver,
+	}
+	go func() {
+		<-ctx.Done()
+		solverServer.Close()
+	}()
+	go func() {
+		contextutils.LoggerFrom(ctx).Infow("starting acme http-01 challenge solver", zap.Int("port", c.opts.SolverPort))
+		if err := solverServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			contextutils.LoggerFrom(ctx).Errorw("acme http-01 challenge solver failed", zap.Error(err))
+		}
+	}()
+
+	go func() {
+		ticker := time.NewTicker(checkInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.check(ctx)
+			}
+		}
+	}()
+	return nil
+}
+
+// Sync checks the certificates of the virtual services in the snapshot
+func (c *Controller) Sync(ctx context.Context, snap *v1.ApiSnapshot) error {
+	c.lock.Lock()
+	c.virtualServices = snap.VirtualServices.Clone()
+	c.lock.Unlock()
+	c.check(ctx)
+	return nil
+}
+
+func (c *Controller) check(ctx context.Context) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, vs := range c.virtualServices {
+		if !translator.IsAcmeVirtualService(vs) || translator.ValidateAcmeDomains(vs) != nil {
+			continue
+		}
+		secretRef := translator.AcmeSecretRef(vs)
+		if c.inFlight[secretRef] || time.Since(c.failedAt[secretRef]) < retryInterval {
+			continue
+		}
+		if !c.needsCertificate(ctx, secretRef, vs.GetVirtualHost().GetDomains()) {
+			continue
+		}
+		c.inFlight[secretRef] = true
+		go func(secretRef core.ResourceRef, domains []string) {
+			err := c.issue(ctx, secretRef, domains)
+			c.lock.Lock()
+			defer c.lock.Unlock()
+			delete(c.inFlight, secretRef)
+			if err != nil {
+				contextutils.LoggerFrom(ctx).Errorw("failed to issue acme certificate", zap.Error(err),
+					zap.String("secret", secretRef.Key()), zap.Strings("domains", domains))
+				c.failedAt[secretRef] = time.Now()
+				return
+			}
+			delete(c.failedAt, secretRef)
+		}(secretRef, vs.GetVirtualHost().GetDomains())
+	}
+}
+
+// needsCertificate returns true if the secret does not hold a certificate for all the domains
+// that is valid for longer than RenewBefore
+func (c *Controller) needsCertificate(ctx context.Context, secretRef core.ResourceRef, domains []string) bool {
+	secret, err := c.secretClient.Read(secretRef.Namespace, secretRef.Name, clients.ReadOpts{Ctx: ctx})
+	if err != nil {
+		if !skerrors.IsNotExist(err) {
+			contextutils.LoggerFrom(ctx).Warnw("failed to read acme secret", zap.Error(err), zap.String("secret", secretRef.Key()))
+		}
+		return true
+	}
+	block, _ := pem.Decode([]byte(secret.GetTls().GetCertChain()))
+	if block == nil {
+		return true
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return true
+	}
+	if time.Now().Add(c.opts.RenewBefore).After(cert.NotAfter) {
+		return true
+	}
+	for _, domain := range domains {
+		if cert.VerifyHostname(domain) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Controller) issue(ctx context.Context, secretRef core.ResourceRef, domains []string) error {
+	client, err := c.acmeClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	contextutils.LoggerFrom(ctx).Infow("requesting acme certificate", zap.String("secret", secretRef.Key()), zap.Strings("domains", domains))
+	order, err := client.AuthorizeOrder(ctx, acme.DomainIDs(domains...))
+	if err != nil {
+		return errors.Wrapf(err, "creating order")
+	}
+	for _, authzUrl := range order.AuthzURLs {
+		if err := c.authorize(ctx, client, authzUrl); err != nil {
+			return err
+		}
+	}
+	order, err = client.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return errors.Wrapf(err, "waiting for order")
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: domains[0]},
+		DNSNames: domains,
+	}, key)
+	if err != nil {
+		return err
+	}
+	der, _, err := client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return errors.Wrapf(err, "finalizing order")
+	}
+
+	var certChain []byte
+	for _, cert := range der {
+		certChain = append(certChain, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert})...)
+	}
+	privateKey, err := encodePrivateKey(key)
+	if err != nil {
+		return err
+	}
+	return c.writeTlsSecret(ctx, secretRef, string(certChain), privateKey)
+}
+
+func (c *Controller) authorize(ctx context.Context, client *acme.Client, authzUrl string) error {
+	authz, err := client.GetAuthorization(ctx, authzUrl)
+	if err != nil {
+		return errors.Wrapf(err, "getting authorization")
+	}
+	if authz.Status == acme.StatusValid {
+		return nil
+	}
+
+	var challenge *acme.Challenge
+	for _, ch := range authz.Challenges {
+		if ch.Type == "http-01" {
+			challenge = ch
+			break
+		}
+	}
+	if challenge == nil {
+		return NoHttp01ChallengeErr(authz.Identifier.Value)
+	}
+
+	keyAuth, err := client.HTTP01ChallengeResponse(challenge.Token)
+	if err != nil {
+		return err
+	}
+	c.solver.Present(challenge.Token, keyAuth)
+	defer c.solver.CleanUp(challenge.Token)
+
+	if _, err := client.Accept(ctx, challenge); err != nil {
+		return errors.Wrapf(err, "accepting challenge for %v", authz.Identifier.Value)
+	}
+	if _, err := client.WaitAuthorization(ctx, authz.URI); err != nil {
+		return errors.Wrapf(err, "waiting for authorization of %v", authz.Identifier.Value)
+	}
+	return nil
+}
+
+// acmeClient returns the client of the ACME account, registering the account the first time
+func (c *Controller) acmeClient(ctx context.Context) (*acme.Client, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.client != nil {
+		return c.client, nil
+	}
+
+	key, err := c.accountKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := &acme.Client{
+		Key:          key,
+		DirectoryURL: c.opts.DirectoryUrl,
+	}
+	account := &acme.Account{}
+	if c.opts.Email != "" {
+		account.Contact = []string{"mailto:" + c.opts.Email}
+	}
+	if _, err := client.Register(ctx, account, acme.AcceptTOS); err != nil && err != acme.ErrAccountAlreadyExists {
+		return nil, errors.Wrapf(err, "registering acme account")
+	}
+	c.client = client
+	return client, nil
+}
+
+// accountKey reads the key of the ACME account, or generates it if it does not exist yet
+func (c *Controller) accountKey(ctx context.Context) (crypto.Signer, error) {
+	secret, err := c.secretClient.Read(c.writeNamespace, AccountKeySecretName, clients.ReadOpts{Ctx: ctx})
+	if err == nil {
+		block, _ := pem.Decode([]byte(secret.GetTls().GetPrivateKey()))
+		if block == nil {
+			return nil, errors.Errorf("acme: secret %v.%v does not hold a PEM-encoded private key", c.writeNamespace, AccountKeySecretName)
+		}
+		return x509.ParseECPrivateKey(block.Bytes)
+	}
+	if !skerrors.IsNotExist(err) {
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := encodePrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	secretRef := core.ResourceRef{Name: AccountKeySecretName, Namespace: c.writeNamespace}
+	if err := c.writeTlsSecret(ctx, secretRef, "", privateKey); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func (c *Controller) writeTlsSecret(ctx context.Context, secretRef core.ResourceRef, certChain, privateKey string) error {
+	secret := &gloov1.Secret{
+		Metadata: core.Metadata{
+			Name:      secretRef.Name,
+			Namespace: secretRef.Namespace,
+		},
+		Kind: &gloov1.Secret_Tls{
+			Tls: &gloov1.TlsSecret{
+				CertChain:  certChain,
+				PrivateKey: privateKey,
+			},
+		},
+	}
+	if existing, err := c.secretClient.Read(secretRef.Namespace, secretRef.Name, clients.ReadOpts{Ctx: ctx}); err == nil {
+		secret.Metadata.ResourceVersion = existing.Metadata.ResourceVersion
+	}
+	if _, err := c.secretClient.Write(secret, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true}); err != nil {
+		return errors.Wrapf(err, "writing secret %v", secretRef.Key())
+	}
+	return nil
+}
+
+// writeSolverUpstream points the upstream of the challenge solver at this pod
+func (c *Controller) writeSolverUpstream(ctx context.Context) error {
+	addr, err := podAddress()
+	if err != nil {
+		return err
+	}
+	upstream := &gloov1.Upstream{
+		Metadata: core.Metadata{
+			Name:      translator.AcmeSolverUpstreamName,
+			Namespace: c.writeNamespace,
+		},
+		UpstreamType: &gloov1.Upstream_Static{
+			Static: &static.UpstreamSpec{
+				Hosts: []*static.Host{{
+					Addr: addr,
+					Port: uint32(c.opts.SolverPort),
+				}},
+			},
+		},
+	}
+	if existing, err := c.upstreamClient.Read(c.writeNamespace, translator.AcmeSolverUpstreamName, clients.ReadOpts{Ctx: ctx}); err == nil {
+		upstream.Metadata.ResourceVersion = existing.Metadata.ResourceVersion
+	}
+	if _, err := c.upstreamClient.Write(upstream, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true}); err != nil {
+		return errors.Wrapf(err, "writing acme solver upstream")
+	}
+	return nil
+}
+
+// podAddress returns the POD_IP env var if it is set, or else the address of the hostname
+func podAddress() (string, error) {
+	if podIp := os.Getenv("POD_IP"); podIp != "" {
+		return podIp, nil
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "", err
+	}
+	addrs, err := net.LookupHost(hostname)
+	if err != nil {
+		return "", errors.Wrapf(err, "resolving the address of the acme solver")
+	}
+	return addrs[0], nil
+}
+
+func encodePrivateKey(key *ecdsa.PrivateKey) (string, error) {
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})), nil
+}
@@ -0,0 +1,81 @@
+package acme_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/gateway/pkg/services/acme"
+	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+)
+
+var _ = Describe("Controller", func() {
+
+	const (
+		ns         = "gloo-system"
+		solverPort = 18089
+	)
+
+	var (
+		ctx            context.Context
+		cancel         context.CancelFunc
+		secretClient   gloov1.SecretClient
+		upstreamClient gloov1.UpstreamClient
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		resourceClientFactory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		var err error
+		secretClient, err = gloov1.NewSecretClient(resourceClientFactory)
+		Expect(err).NotTo(HaveOccurred())
+		upstreamClient, err = gloov1.NewUpstreamClient(resourceClientFactory)
+		Expect(err).NotTo(HaveOccurred())
+		os.Setenv("POD_IP", "10.0.0.1")
+	})
+
+	AfterEach(func() {
+		cancel()
+		os.Unsetenv("POD_IP")
+	})
+
+	It("requires the terms of service to be accepted", func() {
+		controller := NewController(translator.AcmeOpts{}, ns, secretClient, upstreamClient)
+		Expect(controller.Start(ctx)).To(MatchError(TermsOfServiceNotAcceptedErr))
+	})
+
+	It("writes the upstream of the challenge solver and serves the challenges", func() {
+		controller := NewController(translator.AcmeOpts{
+			AcceptTermsOfService: true,
+			SolverPort:           solverPort,
+		}, ns, secretClient, upstreamClient)
+		Expect(controller.Start(ctx)).NotTo(HaveOccurred())
+
+		upstream, err := upstreamClient.Read(ns, translator.AcmeSolverUpstreamName, clients.ReadOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		hosts := upstream.GetStatic().GetHosts()
+		Expect(hosts).To(HaveLen(1))
+		Expect(hosts[0].Addr).To(Equal("10.0.0.1"))
+		Expect(hosts[0].Port).To(BeEquivalentTo(solverPort))
+
+		Eventually(func() (int, error) {
+			resp, err := http.Get(fmt.Sprintf("http://localhost:%v%vunknown", solverPort, translator.AcmeChallengePathPrefix))
+			if err != nil {
+				return 0, err
+			}
+			defer resp.Body.Close()
+			ioutil.ReadAll(resp.Body)
+			return resp.StatusCode, nil
+		}).Should(Equal(http.StatusNotFound))
+	})
+})
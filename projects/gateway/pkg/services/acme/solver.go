@@ -0,0 +1,52 @@
+package acme
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
+)
+
+// Solver answers the HTTP-01 challenges of the ACME server. The plain HTTP gateways route
+// the challenge requests of the virtual services annotated for ACME to it.
+type Solver struct {
+	lock sync.RWMutex
+	// key authorizations by challenge token
+	keyAuths map[string]string
+}
+
+func NewSolver() *Solver {
+	return &Solver{keyAuths: map[string]string{}}
+}
+
+// Present serves the key authorization for the token until CleanUp is called
+func (s *Solver) Present(token, keyAuth string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.keyAuths[token] = keyAuth
+}
+
+func (s *Solver) CleanUp(token string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.keyAuths, token)
+}
+
+func (s *Solver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.URL.Path, translator.AcmeChallengePathPrefix) {
+		http.NotFound(w, r)
+		return
+	}
+	token := strings.TrimPrefix(r.URL.Path, translator.AcmeChallengePathPrefix)
+
+	s.lock.RLock()
+	keyAuth, ok := s.keyAuths[token]
+	s.lock.RUnlock()
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	w.Write([]byte(keyAuth))
+}
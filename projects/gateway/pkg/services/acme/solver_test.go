@@ -0,0 +1,60 @@
+package acme_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/gateway/pkg/services/acme"
+)
+
+var _ = Describe("Solver", func() {
+
+	var (
+		solver *Solver
+		server *httptest.Server
+	)
+
+	BeforeEach(func() {
+		solver = NewSolver()
+		server = httptest.NewServer(solver)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	get := func(path string) (int, string) {
+		resp, err := http.Get(server.URL + path)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return resp.StatusCode, string(body)
+	}
+
+	It("serves the key authorization of presented tokens", func() {
+		solver.Present("token", "token.thumbprint")
+
+		status, body := get("/.well-known/acme-challenge/token")
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(body).To(Equal("token.thumbprint"))
+	})
+
+	It("stops serving tokens after they are cleaned up", func() {
+		solver.Present("token", "token.thumbprint")
+		solver.CleanUp("token")
+
+		status, _ := get("/.well-known/acme-challenge/token")
+		Expect(status).To(Equal(http.StatusNotFound))
+	})
+
+	It("does not serve paths outside of the challenge prefix", func() {
+		solver.Present("token", "token.thumbprint")
+
+		status, _ := get("/token")
+		Expect(status).To(Equal(http.StatusNotFound))
+	})
+})
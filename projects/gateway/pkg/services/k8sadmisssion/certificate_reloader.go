@@ -0,0 +1,88 @@
+package k8sadmisssion
+
+import (
+	"context"
+	"crypto/tls"
+	"os"
+	"sync"
+	"time"
+
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
+)
+
+// certificateReloader serves the webhook key pair, and reloads it when the files change,
+// so that certs rotated by certgen are picked up without restarting the server
+type certificateReloader struct {
+	ctx                           context.Context
+	serverCertPath, serverKeyPath string
+
+	lock                    sync.RWMutex
+	keyPair                 *tls.Certificate
+	certModTime, keyModTime time.Time
+}
+
+func newCertificateReloader(ctx context.Context, serverCertPath, serverKeyPath string) (*certificateReloader, error) {
+	reloader := &certificateReloader{
+		ctx:            ctx,
+		serverCertPath: serverCertPath,
+		serverKeyPath:  serverKeyPath,
+	}
+	certModTime, keyModTime, err := reloader.modTimes()
+	if err != nil {
+		return nil, err
+	}
+	if err := reloader.load(certModTime, keyModTime); err != nil {
+		return nil, err
+	}
+	return reloader, nil
+}
+
+// GetCertificate implements tls.Config.GetCertificate. If the new files cannot be loaded,
+// e.g. because they are still being written, the previous key pair is served.
+func (r *certificateReloader) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	certModTime, keyModTime, err := r.modTimes()
+	if err == nil && r.changed(certModTime, keyModTime) {
+		if err := r.load(certModTime, keyModTime); err != nil {
+			contextutils.LoggerFrom(r.ctx).Warnw("failed to reload validation webhook certs, serving the previous ones", zap.Error(err))
+		} else {
+			contextutils.LoggerFrom(r.ctx).Infow("reloaded validation webhook certs", zap.String("cert", r.serverCertPath))
+		}
+	}
+
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.keyPair, nil
+}
+
+func (r *certificateReloader) modTimes() (time.Time, time.Time, error) {
+	certInfo, err := os.Stat(r.serverCertPath)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	keyInfo, err := os.Stat(r.serverKeyPath)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return certInfo.ModTime(), keyInfo.ModTime(), nil
+}
+
+func (r *certificateReloader) changed(certModTime, keyModTime time.Time) bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return !certModTime.Equal(r.certModTime) || !keyModTime.Equal(r.keyModTime)
+}
+
+func (r *certificateReloader) load(certModTime, keyModTime time.Time) error {
+	keyPair, err := tls.LoadX509KeyPair(r.serverCertPath, r.serverKeyPath)
+	if err != nil {
+		return errors.Wrapf(err, "loading x509 key pair")
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.keyPair = &keyPair
+	r.certModTime = certModTime
+	r.keyModTime = keyModTime
+	return nil
+}
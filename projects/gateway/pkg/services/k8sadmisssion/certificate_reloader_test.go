@@ -0,0 +1,74 @@
+package k8sadmisssion
+
+import (
+	"context"
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/go-utils/certutils"
+	"k8s.io/client-go/util/cert"
+)
+
+var _ = Describe("certificateReloader", func() {
+
+	var (
+		dir                           string
+		serverCertPath, serverKeyPath string
+	)
+
+	writeCerts := func(modTime time.Time) []byte {
+		certs, err := certutils.GenerateSelfSignedCertificate(cert.Config{
+			CommonName: "gateway.gloo-system.svc",
+			Usages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ioutil.WriteFile(serverCertPath, certs.ServerCertificate, 0644)).To(Succeed())
+		Expect(ioutil.WriteFile(serverKeyPath, certs.ServerCertKey, 0644)).To(Succeed())
+		Expect(os.Chtimes(serverCertPath, modTime, modTime)).To(Succeed())
+		Expect(os.Chtimes(serverKeyPath, modTime, modTime)).To(Succeed())
+		return certs.ServerCertificate
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "webhook-certs")
+		Expect(err).NotTo(HaveOccurred())
+		serverCertPath = filepath.Join(dir, "tls.crt")
+		serverKeyPath = filepath.Join(dir, "tls.key")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("reloads the key pair when the files change", func() {
+		writeCerts(time.Now().Add(-time.Hour))
+		reloader, err := newCertificateReloader(context.TODO(), serverCertPath, serverKeyPath)
+		Expect(err).NotTo(HaveOccurred())
+		first, err := reloader.GetCertificate(nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		writeCerts(time.Now())
+		second, err := reloader.GetCertificate(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(second.Certificate[0]).NotTo(Equal(first.Certificate[0]))
+	})
+
+	It("serves the previous key pair while the new files are invalid", func() {
+		writeCerts(time.Now().Add(-time.Hour))
+		reloader, err := newCertificateReloader(context.TODO(), serverCertPath, serverKeyPath)
+		Expect(err).NotTo(HaveOccurred())
+		first, err := reloader.GetCertificate(nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(ioutil.WriteFile(serverKeyPath, []byte("partial"), 0644)).To(Succeed())
+		second, err := reloader.GetCertificate(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(second).To(Equal(first))
+	})
+})
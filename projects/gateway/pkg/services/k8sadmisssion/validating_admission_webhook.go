@@ -66,6 +66,12 @@ var (
 		Group:   "",
 		Kind:    "List",
 	}
+	// the kubernetes secrets, validated on deletion only
+	SecretGVK = schema.GroupVersionKind{
+		Version: "v1",
+		Group:   "",
+		Kind:    "Secret",
+	}
 )
 
 const (
@@ -124,9 +130,9 @@ func NewGatewayValidatingWebhook(cfg WebhookConfig) (*http.Server, error) {
 	readGatewaysFromAllNamespaces := cfg.readGatewaysFromAllNamespaces
 	webhookNamespace := cfg.webhookNamespace
 
-	keyPair, err := tls.LoadX509KeyPair(serverCertPath, serverKeyPath)
+	certReloader, err := newCertificateReloader(ctx, serverCertPath, serverKeyPath)
 	if err != nil {
-		return nil, errors.Wrapf(err, "loading x509 key pair")
+		return nil, err
 	}
 
 	handler := NewGatewayValidationHandler(
@@ -143,7 +149,7 @@ func NewGatewayValidatingWebhook(cfg WebhookConfig) (*http.Server, error) {
 
 	return &http.Server{
 		Addr:      fmt.Sprintf(":%v", port),
-		TLSConfig: &tls.Config{Certificates: []tls.Certificate{keyPair}},
+		TLSConfig: &tls.Config{GetCertificate: certReloader.GetCertificate},
 		Handler:   mux,
 		ErrorLog:  log.New(&debugLogger{ctx: ctx}, "validation-webhook-server", log.LstdFlags),
 	}, nil
@@ -384,36 +390,16 @@ func (wh *gatewayValidationWebhook) getFailureCauses(proxyReports validation.Pro
 			}
 			switch listener := listenerReport.ListenerTypeReport.(type) {
 			case *validationapi.ListenerReport_HttpListenerReport:
-				for _, err := range listener.HttpListenerReport.Errors {
-					causes = append(causes, metav1.StatusCause{
-						Message: fmt.Sprintf("HTTPListener Error %v: %v", err.Type.String(), err.Reason),
-					})
-				}
-				for _, vh := range listener.HttpListenerReport.VirtualHostReports {
-					for _, err := range vh.Errors {
-						causes = append(causes, metav1.StatusCause{
-							Message: fmt.Sprintf("VirtualHost Error %v: %v", err.Type.String(), err.Reason),
-						})
-					}
-					for _, r := range vh.RouteReports {
-						for _, err := range r.Errors {
-							causes = append(causes, metav1.StatusCause{
-								Message: fmt.Sprintf("Route Error %v: %v", err.Type.String(), err.Reason),
-							})
-						}
-					}
-				}
+				causes = append(causes, getHttpListenerFailureCauses(listener.HttpListenerReport)...)
 			case *validationapi.ListenerReport_TcpListenerReport:
-				for _, err := range listener.TcpListenerReport.Errors {
-					causes = append(causes, metav1.StatusCause{
-						Message: fmt.Sprintf("TCPListener Error %v: %v", err.Type.String(), err.Reason),
-					})
-				}
-				for _, host := range listener.TcpListenerReport.TcpHostReports {
-					for _, err := range host.Errors {
-						causes = append(causes, metav1.StatusCause{
-							Message: fmt.Sprintf("TcpHost Error %v: %v", err.Type.String(), err.Reason),
-						})
+				causes = append(causes, getTcpListenerFailureCauses(listener.TcpListenerReport)...)
+			case *validationapi.ListenerReport_HybridListenerReport:
+				for _, matchedListenerReport := range listener.HybridListenerReport.MatchedListenerReports {
+					if httpListenerReport := matchedListenerReport.GetHttpListenerReport(); httpListenerReport != nil {
+						causes = append(causes, getHttpListenerFailureCauses(httpListenerReport)...)
+					}
+					if tcpListenerReport := matchedListenerReport.GetTcpListenerReport(); tcpListenerReport != nil {
+						causes = append(causes, getTcpListenerFailureCauses(tcpListenerReport)...)
 					}
 				}
 			}
@@ -423,6 +409,47 @@ func (wh *gatewayValidationWebhook) getFailureCauses(proxyReports validation.Pro
 	return causes
 }
 
+func getHttpListenerFailureCauses(httpListenerReport *validationapi.HttpListenerReport) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for _, err := range httpListenerReport.Errors {
+		causes = append(causes, metav1.StatusCause{
+			Message: fmt.Sprintf("HTTPListener Error %v: %v", err.Type.String(), err.Reason),
+		})
+	}
+	for _, vh := range httpListenerReport.VirtualHostReports {
+		for _, err := range vh.Errors {
+			causes = append(causes, metav1.StatusCause{
+				Message: fmt.Sprintf("VirtualHost Error %v: %v", err.Type.String(), err.Reason),
+			})
+		}
+		for _, r := range vh.RouteReports {
+			for _, err := range r.Errors {
+				causes = append(causes, metav1.StatusCause{
+					Message: fmt.Sprintf("Route Error %v: %v", err.Type.String(), err.Reason),
+				})
+			}
+		}
+	}
+	return causes
+}
+
+func getTcpListenerFailureCauses(tcpListenerReport *validationapi.TcpListenerReport) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for _, err := range tcpListenerReport.Errors {
+		causes = append(causes, metav1.StatusCause{
+			Message: fmt.Sprintf("TCPListener Error %v: %v", err.Type.String(), err.Reason),
+		})
+	}
+	for _, host := range tcpListenerReport.TcpHostReports {
+		for _, err := range host.Errors {
+			causes = append(causes, metav1.StatusCause{
+				Message: fmt.Sprintf("TcpHost Error %v: %v", err.Type.String(), err.Reason),
+			})
+		}
+	}
+	return causes
+}
+
 func (wh *gatewayValidationWebhook) validate(ctx context.Context, gvk schema.GroupVersionKind, ref core.ResourceRef, rawJson []byte, isDelete, dryRun bool) (validation.ProxyReports, error) {
 
 	switch gvk {
@@ -446,6 +473,26 @@ func (wh *gatewayValidationWebhook) validate(ctx context.Context, gvk schema.Gro
 		} else {
 			return wh.validateRouteTable(ctx, rawJson, dryRun)
 		}
+	case gwv1.VirtualHostOptionGVK:
+		if isDelete {
+			return validation.ProxyReports{}, wh.validator.ValidateDeleteVirtualHostOption(ctx, ref, dryRun)
+		} else {
+			return wh.validateVirtualHostOption(ctx, rawJson, dryRun)
+		}
+	case gwv1.RouteOptionGVK:
+		if isDelete {
+			return validation.ProxyReports{}, wh.validator.ValidateDeleteRouteOption(ctx, ref, dryRun)
+		} else {
+			return wh.validateRouteOption(ctx, rawJson, dryRun)
+		}
+	case gloov1.UpstreamGVK:
+		if isDelete {
+			return validation.ProxyReports{}, wh.validator.ValidateDeleteUpstream(ctx, ref)
+		}
+	case SecretGVK:
+		if isDelete {
+			return validation.ProxyReports{}, wh.validator.ValidateDeleteSecret(ctx, ref)
+		}
 	}
 	return validation.ProxyReports{}, nil
 
@@ -520,3 +567,39 @@ func (wh *gatewayValidationWebhook) validateRouteTable(ctx context.Context, rawJ
 	}
 	return proxyReports, nil
 }
+
+func (wh *gatewayValidationWebhook) validateVirtualHostOption(ctx context.Context, rawJson []byte, dryRun bool) (validation.ProxyReports, error) {
+	var (
+		vho          gwv1.VirtualHostOption
+		proxyReports validation.ProxyReports
+		err          error
+	)
+	if err := protoutils.UnmarshalResource(rawJson, &vho); err != nil {
+		return nil, WrappedUnmarshalErr(err)
+	}
+	if skipValidationCheck(vho.Metadata.Annotations) {
+		return nil, nil
+	}
+	if proxyReports, err = wh.validator.ValidateVirtualHostOption(ctx, &vho, dryRun); err != nil {
+		return proxyReports, errors.Wrapf(err, "Validating %T failed", vho)
+	}
+	return proxyReports, nil
+}
+
+func (wh *gatewayValidationWebhook) validateRouteOption(ctx context.Context, rawJson []byte, dryRun bool) (validation.ProxyReports, error) {
+	var (
+		rto          gwv1.RouteOption
+		proxyReports validation.ProxyReports
+		err          error
+	)
+	if err := protoutils.UnmarshalResource(rawJson, &rto); err != nil {
+		return nil, WrappedUnmarshalErr(err)
+	}
+	if skipValidationCheck(rto.Metadata.Annotations) {
+		return nil, nil
+	}
+	if proxyReports, err = wh.validator.ValidateRouteOption(ctx, &rto, dryRun); err != nil {
+		return proxyReports, errors.Wrapf(err, "Validating %T failed", rto)
+	}
+	return proxyReports, nil
+}
@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/crd"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
@@ -56,6 +57,8 @@ var _ = Describe("ValidatingAdmissionWebhook", func() {
 	}
 
 	routeTable := &v1.RouteTable{Metadata: core.Metadata{Namespace: "namespace", Name: "rt"}}
+	virtualHostOption := &v1.VirtualHostOption{Metadata: core.Metadata{Namespace: "namespace", Name: "vho"}}
+	routeOption := &v1.RouteOption{Metadata: core.Metadata{Namespace: "namespace", Name: "rto"}}
 
 	errMsg := "didn't say the magic word"
 
@@ -76,6 +79,12 @@ var _ = Describe("ValidatingAdmissionWebhook", func() {
 			mv.fValidateRouteTable = func(ctx context.Context, rt *v1.RouteTable, dryRun bool) (validation.ProxyReports, error) {
 				return proxyReports(), fmt.Errorf(errMsg)
 			}
+			mv.fValidateVirtualHostOption = func(ctx context.Context, vho *v1.VirtualHostOption, dryRun bool) (validation.ProxyReports, error) {
+				return proxyReports(), fmt.Errorf(errMsg)
+			}
+			mv.fValidateRouteOption = func(ctx context.Context, rto *v1.RouteOption, dryRun bool) (validation.ProxyReports, error) {
+				return proxyReports(), fmt.Errorf(errMsg)
+			}
 		}
 		req, err := makeReviewRequest(srv.URL, crd, gvk, v1beta1.Create, resource)
 
@@ -104,10 +113,48 @@ var _ = Describe("ValidatingAdmissionWebhook", func() {
 		Entry("invalid virtual service", false, v1.VirtualServiceCrd, v1.VirtualServiceCrd.GroupVersionKind(), vs),
 		Entry("valid route table", true, v1.RouteTableCrd, v1.RouteTableCrd.GroupVersionKind(), routeTable),
 		Entry("invalid route table", false, v1.RouteTableCrd, v1.RouteTableCrd.GroupVersionKind(), routeTable),
+		Entry("valid virtual host option", true, v1.VirtualHostOptionCrd, v1.VirtualHostOptionCrd.GroupVersionKind(), virtualHostOption),
+		Entry("invalid virtual host option", false, v1.VirtualHostOptionCrd, v1.VirtualHostOptionCrd.GroupVersionKind(), virtualHostOption),
+		Entry("valid route option", true, v1.RouteOptionCrd, v1.RouteOptionCrd.GroupVersionKind(), routeOption),
+		Entry("invalid route option", false, v1.RouteOptionCrd, v1.RouteOptionCrd.GroupVersionKind(), routeOption),
 		Entry("valid unstructured list", true, nil, ListGVK, unstructuredList),
 		Entry("invalid unstructured list", false, nil, ListGVK, unstructuredList),
 	)
 
+	DescribeTable("validates the deletion of the resources referenced by the gateway resources", func(gvk schema.GroupVersionKind) {
+		wh.webhookNamespace = routeTable.Metadata.Namespace
+		var deleted core.ResourceRef
+		deleteErr := func(ctx context.Context, ref core.ResourceRef) error {
+			deleted = ref
+			return fmt.Errorf(errMsg)
+		}
+		deleteErrDryRun := func(ctx context.Context, ref core.ResourceRef, dryRun bool) error {
+			return deleteErr(ctx, ref)
+		}
+		mv.fValidateDeleteUpstream = deleteErr
+		mv.fValidateDeleteSecret = deleteErr
+		mv.fValidateDeleteVirtualHostOption = deleteErrDryRun
+		mv.fValidateDeleteRouteOption = deleteErrDryRun
+
+		req, err := makeReviewRequestRawJsonEncoded(srv.URL, gvk, v1beta1.Delete, "referenced", "namespace", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		res, err := srv.Client().Do(req)
+		Expect(err).NotTo(HaveOccurred())
+
+		review, err := parseReviewResponse(res)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(review.Response).NotTo(BeNil())
+		Expect(review.Response.Allowed).To(BeFalse())
+		Expect(review.Response.Result.Message).To(ContainSubstring(errMsg))
+		Expect(deleted).To(Equal(core.ResourceRef{Name: "referenced", Namespace: "namespace"}))
+	},
+		Entry("upstream", gloov1.UpstreamGVK),
+		Entry("secret", SecretGVK),
+		Entry("virtual host option", v1.VirtualHostOptionGVK),
+		Entry("route option", v1.RouteOptionGVK),
+	)
+
 	Context("invalid yaml", func() {
 
 		invalidYamlTests := func(useYamlEncoding bool) {
@@ -266,13 +313,19 @@ func parseReviewResponse(resp *http.Response) (*AdmissionReviewWithProxies, erro
 }
 
 type mockValidator struct {
-	fSync                         func(context.Context, *v1.ApiSnapshot) error
-	fValidateList                 func(ctx context.Context, ul *unstructured.UnstructuredList, dryRun bool) (validation.ProxyReports, error)
-	fValidateGateway              func(ctx context.Context, gw *v1.Gateway, dryRun bool) (validation.ProxyReports, error)
-	fValidateVirtualService       func(ctx context.Context, vs *v1.VirtualService, dryRun bool) (validation.ProxyReports, error)
-	fValidateDeleteVirtualService func(ctx context.Context, vs core.ResourceRef, dryRun bool) error
-	fValidateRouteTable           func(ctx context.Context, rt *v1.RouteTable, dryRun bool) (validation.ProxyReports, error)
-	fValidateDeleteRouteTable     func(ctx context.Context, rt core.ResourceRef, dryRun bool) error
+	fSync                            func(context.Context, *v1.ApiSnapshot) error
+	fValidateList                    func(ctx context.Context, ul *unstructured.UnstructuredList, dryRun bool) (validation.ProxyReports, error)
+	fValidateGateway                 func(ctx context.Context, gw *v1.Gateway, dryRun bool) (validation.ProxyReports, error)
+	fValidateVirtualService          func(ctx context.Context, vs *v1.VirtualService, dryRun bool) (validation.ProxyReports, error)
+	fValidateDeleteVirtualService    func(ctx context.Context, vs core.ResourceRef, dryRun bool) error
+	fValidateRouteTable              func(ctx context.Context, rt *v1.RouteTable, dryRun bool) (validation.ProxyReports, error)
+	fValidateDeleteRouteTable        func(ctx context.Context, rt core.ResourceRef, dryRun bool) error
+	fValidateVirtualHostOption       func(ctx context.Context, vho *v1.VirtualHostOption, dryRun bool) (validation.ProxyReports, error)
+	fValidateDeleteVirtualHostOption func(ctx context.Context, vho core.ResourceRef, dryRun bool) error
+	fValidateRouteOption             func(ctx context.Context, rto *v1.RouteOption, dryRun bool) (validation.ProxyReports, error)
+	fValidateDeleteRouteOption       func(ctx context.Context, rto core.ResourceRef, dryRun bool) error
+	fValidateDeleteUpstream          func(ctx context.Context, us core.ResourceRef) error
+	fValidateDeleteSecret            func(ctx context.Context, secret core.ResourceRef) error
 }
 
 func (v *mockValidator) Sync(ctx context.Context, snap *v1.ApiSnapshot) error {
@@ -324,6 +377,48 @@ func (v *mockValidator) ValidateDeleteRouteTable(ctx context.Context, rt core.Re
 	return v.fValidateDeleteRouteTable(ctx, rt, dryRun)
 }
 
+func (v *mockValidator) ValidateVirtualHostOption(ctx context.Context, vho *v1.VirtualHostOption, dryRun bool) (validation.ProxyReports, error) {
+	if v.fValidateVirtualHostOption == nil {
+		return proxyReports(), nil
+	}
+	return v.fValidateVirtualHostOption(ctx, vho, dryRun)
+}
+
+func (v *mockValidator) ValidateDeleteVirtualHostOption(ctx context.Context, vho core.ResourceRef, dryRun bool) error {
+	if v.fValidateDeleteVirtualHostOption == nil {
+		return nil
+	}
+	return v.fValidateDeleteVirtualHostOption(ctx, vho, dryRun)
+}
+
+func (v *mockValidator) ValidateRouteOption(ctx context.Context, rto *v1.RouteOption, dryRun bool) (validation.ProxyReports, error) {
+	if v.fValidateRouteOption == nil {
+		return proxyReports(), nil
+	}
+	return v.fValidateRouteOption(ctx, rto, dryRun)
+}
+
+func (v *mockValidator) ValidateDeleteRouteOption(ctx context.Context, rto core.ResourceRef, dryRun bool) error {
+	if v.fValidateDeleteRouteOption == nil {
+		return nil
+	}
+	return v.fValidateDeleteRouteOption(ctx, rto, dryRun)
+}
+
+func (v *mockValidator) ValidateDeleteUpstream(ctx context.Context, us core.ResourceRef) error {
+	if v.fValidateDeleteUpstream == nil {
+		return nil
+	}
+	return v.fValidateDeleteUpstream(ctx, us)
+}
+
+func (v *mockValidator) ValidateDeleteSecret(ctx context.Context, secret core.ResourceRef) error {
+	if v.fValidateDeleteSecret == nil {
+		return nil
+	}
+	return v.fValidateDeleteSecret(ctx, secret)
+}
+
 func proxyReports() validation.ProxyReports {
 	return validation.ProxyReports{
 		{
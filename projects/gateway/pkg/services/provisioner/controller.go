@@ -0,0 +1,477 @@
+package provisioner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/version"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	"github.com/solo-io/gloo/projects/gateway/pkg/utils"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kubeerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	DefaultProxyImageRepo = "quay.io/solo-io/gloo-envoy-wrapper"
+	DefaultXdsPort        = 9977
+	DefaultServiceType    = corev1.ServiceTypeLoadBalancer
+
+	// the kubernetes resources provisioned for a proxy have this label, with the name of the proxy as the value
+	ProvisionedProxyLabel = "gateway.solo.io/provisioned-proxy"
+	// the write namespace of the Gloo install which provisioned the resources, so that an install never deletes the
+	// resources provisioned by another one
+	ProvisionedByLabel = "gateway.solo.io/provisioned-by"
+	// the hash of the desired spec of a provisioned resource, so that it is only updated when the spec changes
+	ProvisionedHashAnnotation = "gateway.solo.io/provisioned-hash"
+
+	envoyConfigKey = "envoy.yaml"
+	adminPort      = 19000
+)
+
+var (
+	InvalidXdsAddressErr = func(address string, err error) error {
+		return errors.Wrapf(err, "invalid xds address %v", address)
+	}
+)
+
+// Controller provisions an Envoy Deployment, Service and bootstrap ConfigMap for each proxy of the Gateways
+// outside the write namespace, in the namespace of the proxy, and deletes them once the proxy has no Gateways.
+// It only lists the provisioned resources in the watch namespaces, where the Gateways can be.
+type Controller struct {
+	opts            translator.NamespacedGatewaysOpts
+	writeNamespace  string
+	watchNamespaces []string
+	xdsHost        string
+	xdsPort        int
+	kube           kubernetes.Interface
+}
+
+var _ v1.ApiSyncer = new(Controller)
+
+// watching all the namespaces is represented by empty watch namespaces, or by the empty namespace.
+func NewController(opts translator.NamespacedGatewaysOpts, glooNamespace, writeNamespace string, watchNamespaces []string, kube kubernetes.Interface) (*Controller, error) {
+	if opts.ProxyImage == "" {
+		opts.ProxyImage = DefaultProxyImageRepo + ":" + version.Version
+	}
+	if opts.XdsAddress == "" {
+		opts.XdsAddress = fmt.Sprintf("gloo.%v.svc.cluster.local:%v", glooNamespace, DefaultXdsPort)
+	}
+	if opts.ServiceType == "" {
+		opts.ServiceType = string(DefaultServiceType)
+	}
+	host, port, err := net.SplitHostPort(opts.XdsAddress)
+	if err != nil {
+		return nil, InvalidXdsAddressErr(opts.XdsAddress, err)
+	}
+	xdsPort, err := strconv.Atoi(port)
+	if err != nil {
+		return nil, InvalidXdsAddressErr(opts.XdsAddress, err)
+	}
+	for _, ns := range watchNamespaces {
+		if ns == metav1.NamespaceAll {
+			watchNamespaces = nil
+			break
+		}
+	}
+	if len(watchNamespaces) == 0 {
+		watchNamespaces = []string{metav1.NamespaceAll}
+	}
+	return &Controller{
+		opts:            opts,
+		writeNamespace:  writeNamespace,
+		watchNamespaces: watchNamespaces,
+		xdsHost:         host,
+		xdsPort:         xdsPort,
+		kube:            kube,
+	}, nil
+}
+
+// Sync provisions the resources of the proxies of the gateways in the snapshot, and deletes the stale ones
+func (c *Controller) Sync(ctx context.Context, snap *v1.ApiSnapshot) error {
+	logger := contextutils.LoggerFrom(contextutils.WithLogger(ctx, "provisioner"))
+
+	desiredProxies := map[core.ResourceRef]v1.GatewayList{}
+	for proxyRef, gateways := range utils.GatewaysByProxy(snap.Gateways, c.writeNamespace, true) {
+		// the proxies of the write namespace are deployed by the install
+		if proxyRef.Namespace != c.writeNamespace {
+			desiredProxies[proxyRef] = gateways
+		}
+	}
+
+	proxyRefs := make([]core.ResourceRef, 0, len(desiredProxies))
+	for proxyRef := range desiredProxies {
+		proxyRefs = append(proxyRefs, proxyRef)
+	}
+	sort.SliceStable(proxyRefs, func(i, j int) bool {
+		if proxyRefs[i].Namespace != proxyRefs[j].Namespace {
+			return proxyRefs[i].Namespace < proxyRefs[j].Namespace
+		}
+		return proxyRefs[i].Name < proxyRefs[j].Name
+	})
+
+	var errs error
+	for _, proxyRef := range proxyRefs {
+		if err := c.provision(proxyRef, desiredProxies[proxyRef]); err != nil {
+			errs = multierror.Append(errs, errors.Wrapf(err, "provisioning proxy %v", proxyRef.Key()))
+			continue
+		}
+		logger.Debugf("provisioned proxy %v", proxyRef.Key())
+	}
+
+	if err := c.deleteStale(desiredProxies); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+	return errs
+}
+
+func (c *Controller) provision(proxyRef core.ResourceRef, gateways v1.GatewayList) error {
+	if err := c.applyConfigMap(c.configMap(proxyRef)); err != nil {
+		return err
+	}
+	if err := c.applyDeployment(c.deployment(proxyRef, gateways)); err != nil {
+		return err
+	}
+	return c.applyService(c.service(proxyRef, gateways))
+}
+
+func (c *Controller) applyConfigMap(desired *corev1.ConfigMap) error {
+	client := c.kube.CoreV1().ConfigMaps(desired.Namespace)
+	existing, err := client.Get(desired.Name, metav1.GetOptions{})
+	if kubeerrors.IsNotFound(err) {
+		_, err = client.Create(desired)
+		return err
+	} else if err != nil {
+		return err
+	}
+	if !needsUpdate(existing.ObjectMeta, desired.ObjectMeta) {
+		return nil
+	}
+	existing.Labels = desired.Labels
+	existing.Annotations = desired.Annotations
+	existing.Data = desired.Data
+	_, err = client.Update(existing)
+	return err
+}
+
+func (c *Controller) applyDeployment(desired *appsv1.Deployment) error {
+	client := c.kube.AppsV1().Deployments(desired.Namespace)
+	existing, err := client.Get(desired.Name, metav1.GetOptions{})
+	if kubeerrors.IsNotFound(err) {
+		_, err = client.Create(desired)
+		return err
+	} else if err != nil {
+		return err
+	}
+	if !needsUpdate(existing.ObjectMeta, desired.ObjectMeta) {
+		return nil
+	}
+	existing.Labels = desired.Labels
+	existing.Annotations = desired.Annotations
+	// the replicas may be scaled by the owners of the namespace
+	replicas := existing.Spec.Replicas
+	existing.Spec = desired.Spec
+	existing.Spec.Replicas = replicas
+	_, err = client.Update(existing)
+	return err
+}
+
+func (c *Controller) applyService(desired *corev1.Service) error {
+	client := c.kube.CoreV1().Services(desired.Namespace)
+	existing, err := client.Get(desired.Name, metav1.GetOptions{})
+	if kubeerrors.IsNotFound(err) {
+		_, err = client.Create(desired)
+		return err
+	} else if err != nil {
+		return err
+	}
+	if !needsUpdate(existing.ObjectMeta, desired.ObjectMeta) {
+		return nil
+	}
+	existing.Labels = desired.Labels
+	existing.Annotations = desired.Annotations
+	// the cluster ip and node ports are allocated by kubernetes and cannot be changed
+	existing.Spec.Type = desired.Spec.Type
+	existing.Spec.Selector = desired.Spec.Selector
+	existing.Spec.Ports = desired.Spec.Ports
+	_, err = client.Update(existing)
+	return err
+}
+
+// deleteStale deletes the resources provisioned by this install for the proxies that are not desired anymore
+func (c *Controller) deleteStale(desiredProxies map[core.ResourceRef]v1.GatewayList) error {
+	listOpts := metav1.ListOptions{LabelSelector: fmt.Sprintf("%v,%v=%v", ProvisionedProxyLabel, ProvisionedByLabel, c.writeNamespace)}
+	isStale := func(meta metav1.ObjectMeta) bool {
+		_, desired := desiredProxies[core.ResourceRef{Namespace: meta.Namespace, Name: meta.Labels[ProvisionedProxyLabel]}]
+		return !desired
+	}
+
+	var errs error
+	for _, ns := range c.watchNamespaces {
+		deployments, err := c.kube.AppsV1().Deployments(ns).List(listOpts)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+		} else {
+			for _, deployment := range deployments.Items {
+				if isStale(deployment.ObjectMeta) {
+					errs = appendIgnoreNotFound(errs, c.kube.AppsV1().Deployments(deployment.Namespace).Delete(deployment.Name, &metav1.DeleteOptions{}))
+				}
+			}
+		}
+		services, err := c.kube.CoreV1().Services(ns).List(listOpts)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+		} else {
+			for _, service := range services.Items {
+				if isStale(service.ObjectMeta) {
+					errs = appendIgnoreNotFound(errs, c.kube.CoreV1().Services(service.Namespace).Delete(service.Name, &metav1.DeleteOptions{}))
+				}
+			}
+		}
+		configMaps, err := c.kube.CoreV1().ConfigMaps(ns).List(listOpts)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+		} else {
+			for _, configMap := range configMaps.Items {
+				if isStale(configMap.ObjectMeta) {
+					errs = appendIgnoreNotFound(errs, c.kube.CoreV1().ConfigMaps(configMap.Namespace).Delete(configMap.Name, &metav1.DeleteOptions{}))
+				}
+			}
+		}
+	}
+	return errs
+}
+
+func appendIgnoreNotFound(errs, err error) error {
+	if err == nil || kubeerrors.IsNotFound(err) {
+		return errs
+	}
+	return multierror.Append(errs, err)
+}
+
+func needsUpdate(existing, desired metav1.ObjectMeta) bool {
+	return existing.Labels[ProvisionedProxyLabel] != desired.Labels[ProvisionedProxyLabel] ||
+		existing.Labels[ProvisionedByLabel] != desired.Labels[ProvisionedByLabel] ||
+		existing.Annotations[ProvisionedHashAnnotation] != desired.Annotations[ProvisionedHashAnnotation]
+}
+
+func (c *Controller) objectMeta(proxyRef core.ResourceRef, name string, spec interface{}) metav1.ObjectMeta {
+	labels := proxyLabels(proxyRef)
+	labels[ProvisionedByLabel] = c.writeNamespace
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: proxyRef.Namespace,
+		Labels:    labels,
+		Annotations: map[string]string{
+			ProvisionedHashAnnotation: hashSpec(spec),
+		},
+	}
+}
+
+func proxyLabels(proxyRef core.ResourceRef) map[string]string {
+	return map[string]string{
+		"gloo":                "gateway-proxy",
+		"gateway-proxy-id":    proxyRef.Name,
+		ProvisionedProxyLabel: proxyRef.Name,
+	}
+}
+
+func hashSpec(spec interface{}) string {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		// should never happen, the specs are plain kubernetes types
+		panic(err)
+	}
+	hasher := fnv.New64a()
+	hasher.Write(data)
+	return strconv.FormatUint(hasher.Sum64(), 16)
+}
+
+func configMapName(proxyRef core.ResourceRef) string {
+	return proxyRef.Name + "-envoy-config"
+}
+
+func (c *Controller) configMap(proxyRef core.ResourceRef) *corev1.ConfigMap {
+	data := map[string]string{
+		envoyConfigKey: envoyBootstrap(proxyRef.Name, c.xdsHost, c.xdsPort),
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: c.objectMeta(proxyRef, configMapName(proxyRef), data),
+		Data:       data,
+	}
+}
+
+func (c *Controller) deployment(proxyRef core.ResourceRef, gateways v1.GatewayList) *appsv1.Deployment {
+	var ports []corev1.ContainerPort
+	for _, port := range gatewayPorts(gateways) {
+		ports = append(ports, corev1.ContainerPort{
+			Name:          port.Name,
+			ContainerPort: port.Port,
+			Protocol:      port.Protocol,
+		})
+	}
+	adminProbe := func(path string) *corev1.Probe {
+		return &corev1.Probe{
+			Handler: corev1.Handler{
+				Exec: &corev1.ExecAction{
+					Command: []string{"wget", "-O", "/dev/null", fmt.Sprintf("127.0.0.1:%v%v", adminPort, path)},
+				},
+			},
+			InitialDelaySeconds: 1,
+			PeriodSeconds:       10,
+			FailureThreshold:    10,
+		}
+	}
+	fieldEnv := func(name, fieldPath string) corev1.EnvVar {
+		return corev1.EnvVar{
+			Name: name,
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{FieldPath: fieldPath},
+			},
+		}
+	}
+	replicas := int32(1)
+	spec := appsv1.DeploymentSpec{
+		Replicas: &replicas,
+		Selector: &metav1.LabelSelector{
+			MatchLabels: proxyLabels(proxyRef),
+		},
+		Template: corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: proxyLabels(proxyRef),
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{
+					Name:  proxyRef.Name,
+					Image: c.opts.ProxyImage,
+					Args:  []string{"--disable-hot-restart"},
+					Env: []corev1.EnvVar{
+						fieldEnv("POD_NAMESPACE", "metadata.namespace"),
+						fieldEnv("POD_NAME", "metadata.name"),
+					},
+					Ports:          ports,
+					ReadinessProbe: adminProbe("/ready"),
+					LivenessProbe:  adminProbe("/server_info"),
+					VolumeMounts: []corev1.VolumeMount{{
+						Name:      "envoy-config",
+						MountPath: "/etc/envoy",
+					}},
+				}},
+				Volumes: []corev1.Volume{{
+					Name: "envoy-config",
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{Name: configMapName(proxyRef)},
+						},
+					},
+				}},
+			},
+		},
+	}
+	return &appsv1.Deployment{
+		ObjectMeta: c.objectMeta(proxyRef, proxyRef.Name, spec),
+		Spec:       spec,
+	}
+}
+
+func (c *Controller) service(proxyRef core.ResourceRef, gateways v1.GatewayList) *corev1.Service {
+	spec := corev1.ServiceSpec{
+		Type:     corev1.ServiceType(c.opts.ServiceType),
+		Selector: proxyLabels(proxyRef),
+		Ports:    gatewayPorts(gateways),
+	}
+	return &corev1.Service{
+		ObjectMeta: c.objectMeta(proxyRef, proxyRef.Name, spec),
+		Spec:       spec,
+	}
+}
+
+// gatewayPorts returns the service ports of the bind ports of the gateways, sorted by port
+func gatewayPorts(gateways v1.GatewayList) []corev1.ServicePort {
+	seen := map[string]bool{}
+	var ports []corev1.ServicePort
+	for _, gateway := range gateways {
+		protocol := corev1.ProtocolTCP
+		if gateway.GetUdpGateway() != nil {
+			protocol = corev1.ProtocolUDP
+		}
+		name := fmt.Sprintf("%v-%v", strings.ToLower(string(protocol)), gateway.GetBindPort())
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		ports = append(ports, corev1.ServicePort{
+			Name:       name,
+			Port:       int32(gateway.GetBindPort()),
+			TargetPort: intstr.FromInt(int(gateway.GetBindPort())),
+			Protocol:   protocol,
+		})
+	}
+	sort.SliceStable(ports, func(i, j int) bool {
+		if ports[i].Port != ports[j].Port {
+			return ports[i].Port < ports[j].Port
+		}
+		return ports[i].Protocol < ports[j].Protocol
+	})
+	return ports
+}
+
+// envoyBootstrap returns the bootstrap config of the provisioned envoys. The gloo-envoy-wrapper image renders
+// the pod name and namespace, so that gloo serves the envoys the config of the proxy of their namespace.
+func envoyBootstrap(proxyName, xdsHost string, xdsPort int) string {
+	return fmt.Sprintf(`node:
+  cluster: gateway
+  id: "{{.PodName}}.{{.PodNamespace}}"
+  metadata:
+    # role's value is the key for the in-memory xds cache (projects/gloo/pkg/xds/envoy.go)
+    role: "{{.PodNamespace}}~%[1]v"
+static_resources:
+  clusters:
+  - name: xds_cluster
+    connect_timeout: 5.000s
+    load_assignment:
+      cluster_name: xds_cluster
+      endpoints:
+      - lb_endpoints:
+        - endpoint:
+            address:
+              socket_address:
+                address: %[2]v
+                port_value: %[3]v
+    http2_protocol_options: {}
+    upstream_connection_options:
+      tcp_keepalive: {}
+    type: STRICT_DNS
+    respect_dns_ttl: true
+dynamic_resources:
+  ads_config:
+    api_type: GRPC
+    rate_limit_settings: {}
+    grpc_services:
+    - envoy_grpc: {cluster_name: xds_cluster}
+  cds_config:
+    ads: {}
+  lds_config:
+    ads: {}
+admin:
+  access_log_path: /dev/null
+  address:
+    socket_address:
+      address: 127.0.0.1
+      port_value: %[4]v
+`, proxyName, xdsHost, xdsPort, adminPort)
+}
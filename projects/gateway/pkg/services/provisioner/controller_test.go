@@ -0,0 +1,173 @@
+package provisioner_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gateway/pkg/services/provisioner"
+	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	corev1 "k8s.io/api/core/v1"
+	kubeerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var _ = Describe("Controller", func() {
+
+	const (
+		writeNamespace = "gloo-system"
+		teamNamespace  = "team-a"
+	)
+
+	var (
+		ctx        context.Context
+		kube       kubernetes.Interface
+		controller *Controller
+		snap       *v1.ApiSnapshot
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		kube = fake.NewSimpleClientset()
+		var err error
+		controller, err = NewController(translator.NamespacedGatewaysOpts{ProvisionDeployments: true}, writeNamespace, writeNamespace, nil, kube)
+		Expect(err).NotTo(HaveOccurred())
+		snap = &v1.ApiSnapshot{
+			Gateways: v1.GatewayList{
+				{
+					Metadata:    core.Metadata{Name: "shared", Namespace: writeNamespace},
+					GatewayType: &v1.Gateway_HttpGateway{HttpGateway: &v1.HttpGateway{}},
+					BindPort:    8080,
+				},
+				{
+					Metadata:    core.Metadata{Name: "http", Namespace: teamNamespace},
+					GatewayType: &v1.Gateway_HttpGateway{HttpGateway: &v1.HttpGateway{}},
+					BindPort:    8080,
+				},
+				{
+					Metadata:    core.Metadata{Name: "https", Namespace: teamNamespace},
+					GatewayType: &v1.Gateway_HttpGateway{HttpGateway: &v1.HttpGateway{}},
+					BindPort:    8443,
+					Ssl:         true,
+				},
+			},
+		}
+	})
+
+	It("rejects invalid xds addresses", func() {
+		_, err := NewController(translator.NamespacedGatewaysOpts{XdsAddress: "gloo"}, writeNamespace, writeNamespace, nil, kube)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("provisions the proxies of the gateways outside the write namespace", func() {
+		Expect(controller.Sync(ctx, snap)).NotTo(HaveOccurred())
+
+		configMap, err := kube.CoreV1().ConfigMaps(teamNamespace).Get("gateway-proxy-envoy-config", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configMap.Data["envoy.yaml"]).To(ContainSubstring(`role: "{{.PodNamespace}}~gateway-proxy"`))
+		Expect(configMap.Data["envoy.yaml"]).To(ContainSubstring("address: gloo.gloo-system.svc.cluster.local"))
+
+		deployment, err := kube.AppsV1().Deployments(teamNamespace).Get("gateway-proxy", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		container := deployment.Spec.Template.Spec.Containers[0]
+		Expect(container.Image).To(HavePrefix(DefaultProxyImageRepo + ":"))
+		Expect(container.Ports).To(HaveLen(2))
+		Expect(container.Ports[0].ContainerPort).To(Equal(int32(8080)))
+		Expect(container.Ports[1].ContainerPort).To(Equal(int32(8443)))
+		Expect(deployment.Spec.Template.Labels).To(HaveKeyWithValue(ProvisionedProxyLabel, "gateway-proxy"))
+
+		service, err := kube.CoreV1().Services(teamNamespace).Get("gateway-proxy", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(service.Spec.Type).To(Equal(corev1.ServiceTypeLoadBalancer))
+		Expect(service.Spec.Ports).To(HaveLen(2))
+		Expect(service.Spec.Selector).To(Equal(deployment.Spec.Selector.MatchLabels))
+
+		_, err = kube.AppsV1().Deployments(writeNamespace).Get("gateway-proxy", metav1.GetOptions{})
+		Expect(kubeerrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("updates the provisioned resources when the gateways change, and keeps the replicas", func() {
+		Expect(controller.Sync(ctx, snap)).NotTo(HaveOccurred())
+
+		deployment, err := kube.AppsV1().Deployments(teamNamespace).Get("gateway-proxy", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		replicas := int32(3)
+		deployment.Spec.Replicas = &replicas
+		_, err = kube.AppsV1().Deployments(teamNamespace).Update(deployment)
+		Expect(err).NotTo(HaveOccurred())
+
+		snap.Gateways = snap.Gateways[:2]
+		Expect(controller.Sync(ctx, snap)).NotTo(HaveOccurred())
+
+		deployment, err = kube.AppsV1().Deployments(teamNamespace).Get("gateway-proxy", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*deployment.Spec.Replicas).To(Equal(int32(3)))
+		Expect(deployment.Spec.Template.Spec.Containers[0].Ports).To(HaveLen(1))
+
+		service, err := kube.CoreV1().Services(teamNamespace).Get("gateway-proxy", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(service.Spec.Ports).To(HaveLen(1))
+	})
+
+	It("deletes the provisioned resources of the proxies without gateways", func() {
+		Expect(controller.Sync(ctx, snap)).NotTo(HaveOccurred())
+
+		// a resource of the namespace that was not provisioned is left alone
+		_, err := kube.CoreV1().ConfigMaps(teamNamespace).Create(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: teamNamespace},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		snap.Gateways = snap.Gateways[:1]
+		Expect(controller.Sync(ctx, snap)).NotTo(HaveOccurred())
+
+		_, err = kube.AppsV1().Deployments(teamNamespace).Get("gateway-proxy", metav1.GetOptions{})
+		Expect(kubeerrors.IsNotFound(err)).To(BeTrue())
+		_, err = kube.CoreV1().Services(teamNamespace).Get("gateway-proxy", metav1.GetOptions{})
+		Expect(kubeerrors.IsNotFound(err)).To(BeTrue())
+		_, err = kube.CoreV1().ConfigMaps(teamNamespace).Get("gateway-proxy-envoy-config", metav1.GetOptions{})
+		Expect(kubeerrors.IsNotFound(err)).To(BeTrue())
+		_, err = kube.CoreV1().ConfigMaps(teamNamespace).Get("unrelated", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("does not delete the resources provisioned by another install", func() {
+		other, err := NewController(translator.NamespacedGatewaysOpts{ProvisionDeployments: true}, "other-gloo", "other-gloo", nil, kube)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(other.Sync(ctx, snap)).NotTo(HaveOccurred())
+
+		Expect(controller.Sync(ctx, &v1.ApiSnapshot{})).NotTo(HaveOccurred())
+
+		deployment, err := kube.AppsV1().Deployments(teamNamespace).Get("gateway-proxy", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deployment.Labels).To(HaveKeyWithValue(ProvisionedByLabel, "other-gloo"))
+		_, err = kube.CoreV1().ConfigMaps(teamNamespace).Get("gateway-proxy-envoy-config", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("only lists the provisioned resources in the watch namespaces", func() {
+		// like an install with namespaced RBAC, which is not allowed to list across namespaces
+		fakeKube := fake.NewSimpleClientset()
+		fakeKube.PrependReactor("list", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if action.GetNamespace() == metav1.NamespaceAll {
+				return true, nil, kubeerrors.NewForbidden(schema.GroupResource{Resource: action.GetResource().Resource}, "", nil)
+			}
+			return false, nil, nil
+		})
+		scoped, err := NewController(translator.NamespacedGatewaysOpts{ProvisionDeployments: true}, writeNamespace, writeNamespace, []string{writeNamespace, teamNamespace}, fakeKube)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(scoped.Sync(ctx, snap)).NotTo(HaveOccurred())
+		Expect(scoped.Sync(ctx, &v1.ApiSnapshot{})).NotTo(HaveOccurred())
+
+		_, err = fakeKube.AppsV1().Deployments(teamNamespace).Get("gateway-proxy", metav1.GetOptions{})
+		Expect(kubeerrors.IsNotFound(err)).To(BeTrue())
+	})
+})
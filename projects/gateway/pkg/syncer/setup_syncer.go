@@ -11,12 +11,15 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/solo-io/gloo/projects/gateway/pkg/services/acme"
 	"github.com/solo-io/gloo/projects/gateway/pkg/services/k8sadmisssion"
+	"github.com/solo-io/gloo/projects/gateway/pkg/services/provisioner"
 
 	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
 	gatewayvalidation "github.com/solo-io/gloo/projects/gateway/pkg/validation"
 
 	"github.com/gogo/protobuf/types"
+	vaultapi "github.com/hashicorp/vault/api"
 	"github.com/solo-io/gloo/pkg/utils"
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
@@ -26,11 +29,15 @@ import (
 	gloodefaults "github.com/solo-io/gloo/projects/gloo/pkg/defaults"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/errutils"
+	"github.com/solo-io/go-utils/kubeutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube"
+	corecache "github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 	"github.com/solo-io/solo-kit/pkg/errors"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 )
 
@@ -77,6 +84,21 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 		return err
 	}
 
+	virtualHostOptionFactory, err := bootstrap.ConfigFactoryForSettings(params, v1.VirtualHostOptionCrd)
+	if err != nil {
+		return err
+	}
+
+	routeOptionFactory, err := bootstrap.ConfigFactoryForSettings(params, v1.RouteOptionCrd)
+	if err != nil {
+		return err
+	}
+
+	upstreamGroupFactory, err := bootstrap.ConfigFactoryForSettings(params, gloov1.UpstreamGroupCrd)
+	if err != nil {
+		return err
+	}
+
 	refreshRate, err := types.DurationFromProto(settings.RefreshRate)
 	if err != nil {
 		return err
@@ -111,6 +133,7 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 			IgnoreProxyValidationFailure: validationCfg.GetIgnoreGlooValidationFailure(),
 			AlwaysAcceptResources:        alwaysAcceptResources,
 			AllowWarnings:                allowWarnings,
+			StrictValidation:             validationCfg.GetStrict(),
 		}
 		if validation.ProxyValidationServerAddress == "" {
 			validation.ProxyValidationServerAddress = defaults.GlooProxyValidationServerAddr
@@ -131,14 +154,86 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 		}
 	}
 
+	var acmeOpts *translator.AcmeOpts
+	var secretFactory, upstreamFactory factory.ResourceClientFactory
+	if acmeCfg := settings.GetGateway().GetAcme(); acmeCfg != nil {
+		renewBefore, err := types.DurationFromProto(acmeCfg.GetRenewBefore())
+		if acmeCfg.GetRenewBefore() != nil && err != nil {
+			return err
+		}
+		acmeOpts = &translator.AcmeOpts{
+			DirectoryUrl:         acmeCfg.GetDirectoryUrl(),
+			Email:                acmeCfg.GetEmail(),
+			AcceptTermsOfService: acmeCfg.GetAcceptTermsOfService(),
+			RenewBefore:          renewBefore,
+			SolverPort:           int(acmeCfg.GetSolverPort()),
+		}
+
+		var (
+			clientset     kubernetes.Interface
+			kubeCoreCache corecache.KubeCoreCache
+			vaultClient   *vaultapi.Client
+		)
+		if vaultSettings := settings.GetVaultSecretSource(); vaultSettings != nil {
+			vaultClient, err = bootstrap.VaultClientForSettings(vaultSettings)
+			if err != nil {
+				return err
+			}
+		}
+		secretFactory, err = bootstrap.SecretFactoryForSettings(
+			ctx,
+			settings,
+			inMemoryCache,
+			&cfg,
+			&clientset,
+			&kubeCoreCache,
+			vaultClient,
+			gloov1.SecretCrd.Plural,
+		)
+		if err != nil {
+			return err
+		}
+		upstreamFactory, err = bootstrap.ConfigFactoryForSettings(params, gloov1.UpstreamCrd)
+		if err != nil {
+			return err
+		}
+	}
+
+	var (
+		namespacedGateways *translator.NamespacedGatewaysOpts
+		kubeClient         kubernetes.Interface
+	)
+	if namespacedGatewaysCfg := settings.GetGateway().GetNamespacedGateways(); namespacedGatewaysCfg.GetEnabled() {
+		namespacedGateways = &translator.NamespacedGatewaysOpts{
+			ProvisionDeployments: namespacedGatewaysCfg.GetProvisionDeployments(),
+			ProxyImage:           namespacedGatewaysCfg.GetProxyImage(),
+			XdsAddress:           namespacedGatewaysCfg.GetXdsAddress(),
+			ServiceType:          namespacedGatewaysCfg.GetServiceType(),
+		}
+		if namespacedGateways.ProvisionDeployments {
+			if cfg == nil {
+				cfg, err = kubeutils.GetConfig("", "")
+				if err != nil {
+					return err
+				}
+			}
+			kubeClient, err = kubernetes.NewForConfig(cfg)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	opts := translator.Opts{
-		GlooNamespace:   settings.Metadata.Namespace,
-		WriteNamespace:  writeNamespace,
-		WatchNamespaces: watchNamespaces,
-		Gateways:        gatewayFactory,
-		VirtualServices: virtualServiceFactory,
-		RouteTables:     routeTableFactory,
-		Proxies:         proxyFactory,
+		GlooNamespace:      settings.Metadata.Namespace,
+		WriteNamespace:     writeNamespace,
+		WatchNamespaces:    watchNamespaces,
+		Gateways:           gatewayFactory,
+		VirtualServices:    virtualServiceFactory,
+		RouteTables:        routeTableFactory,
+		VirtualHostOptions: virtualHostOptionFactory,
+		RouteOptions:       routeOptionFactory,
+		Proxies:            proxyFactory,
 		WatchOpts: clients.WatchOpts{
 			Ctx:         ctx,
 			RefreshRate: refreshRate,
@@ -146,6 +241,12 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 		DevMode:                       true,
 		ReadGatewaysFromAllNamespaces: settings.GetGateway().GetReadGatewaysFromAllNamespaces(),
 		Validation:                    validation,
+		Secrets:                       secretFactory,
+		Upstreams:                     upstreamFactory,
+		UpstreamGroups:                upstreamGroupFactory,
+		Acme:                          acmeOpts,
+		NamespacedGateways:            namespacedGateways,
+		KubeClient:                    kubeClient,
 	}
 
 	return RunGateway(opts)
@@ -181,6 +282,22 @@ func RunGateway(opts translator.Opts) error {
 		return err
 	}
 
+	virtualHostOptionClient, err := v1.NewVirtualHostOptionClient(opts.VirtualHostOptions)
+	if err != nil {
+		return err
+	}
+	if err := virtualHostOptionClient.Register(); err != nil {
+		return err
+	}
+
+	routeOptionClient, err := v1.NewRouteOptionClient(opts.RouteOptions)
+	if err != nil {
+		return err
+	}
+	if err := routeOptionClient.Register(); err != nil {
+		return err
+	}
+
 	proxyClient, err := gloov1.NewProxyClient(opts.Proxies)
 	if err != nil {
 		return err
@@ -189,7 +306,8 @@ func RunGateway(opts translator.Opts) error {
 		return err
 	}
 
-	rpt := reporter.NewReporter("gateway", gatewayClient.BaseClient(), virtualServiceClient.BaseClient(), routeTableClient.BaseClient())
+	rpt := reporter.NewReporter("gateway", gatewayClient.BaseClient(), virtualServiceClient.BaseClient(), routeTableClient.BaseClient(),
+		virtualHostOptionClient.BaseClient(), routeOptionClient.BaseClient())
 	writeErrs := make(chan error)
 
 	txlator := translator.NewDefaultTranslator(opts)
@@ -197,8 +315,10 @@ func RunGateway(opts translator.Opts) error {
 	var (
 		// this constructor should be called within a lock
 		validationClient             validation.ProxyValidationServiceClient
+		upstreamGroupClient          gloov1.UpstreamGroupClient
 		ignoreProxyValidationFailure bool
 		allowWarnings                bool
+		strictValidation             gloov1.GatewayOptions_ValidationOptions_StrictValidationMode
 	)
 
 	// construct the channel that resyncs the API Translator loop
@@ -219,18 +339,34 @@ func RunGateway(opts translator.Opts) error {
 			return errors.Wrapf(err, "failed to read notifications from stream")
 		}
 
+		// the upstream groups are read when validating the deletion of the upstreams they reference
+		if opts.UpstreamGroups != nil {
+			upstreamGroupClient, err = gloov1.NewUpstreamGroupClient(opts.UpstreamGroups)
+			if err != nil {
+				return err
+			}
+			if err := upstreamGroupClient.Register(); err != nil {
+				return err
+			}
+		}
+
 		ignoreProxyValidationFailure = opts.Validation.IgnoreProxyValidationFailure
 		allowWarnings = opts.Validation.AllowWarnings
+		strictValidation = opts.Validation.StrictValidation
 	}
 
-	emitter := v1.NewApiEmitterWithEmit(virtualServiceClient, routeTableClient, gatewayClient, notifications)
+	emitter := v1.NewApiEmitterWithEmit(virtualServiceClient, routeTableClient, gatewayClient, virtualHostOptionClient, routeOptionClient, notifications)
 
 	validationSyncer := gatewayvalidation.NewValidator(gatewayvalidation.NewValidatorConfig(
 		txlator,
 		validationClient,
+		upstreamGroupClient,
 		opts.WriteNamespace,
+		opts.WatchNamespaces,
+		opts.NamespacedGateways != nil,
 		ignoreProxyValidationFailure,
 		allowWarnings,
+		strictValidation,
 	))
 
 	proxyReconciler := reconciler.NewProxyReconciler(validationClient, proxyClient)
@@ -238,6 +374,8 @@ func RunGateway(opts translator.Opts) error {
 	translatorSyncer := NewTranslatorSyncer(
 		ctx,
 		opts.WriteNamespace,
+		opts.WatchNamespaces,
+		opts.NamespacedGateways != nil,
 		proxyClient,
 		proxyReconciler,
 		rpt,
@@ -248,6 +386,36 @@ func RunGateway(opts translator.Opts) error {
 		validationSyncer,
 	}
 
+	if opts.Acme != nil {
+		secretClient, err := gloov1.NewSecretClient(opts.Secrets)
+		if err != nil {
+			return err
+		}
+		if err := secretClient.Register(); err != nil {
+			return err
+		}
+		upstreamClient, err := gloov1.NewUpstreamClient(opts.Upstreams)
+		if err != nil {
+			return err
+		}
+		if err := upstreamClient.Register(); err != nil {
+			return err
+		}
+		acmeController := acme.NewController(*opts.Acme, opts.WriteNamespace, secretClient, upstreamClient)
+		if err := acmeController.Start(ctx); err != nil {
+			return errors.Wrapf(err, "starting acme controller")
+		}
+		gatewaySyncers = append(gatewaySyncers, acmeController)
+	}
+
+	if opts.NamespacedGateways != nil && opts.NamespacedGateways.ProvisionDeployments {
+		provisioningController, err := provisioner.NewController(*opts.NamespacedGateways, opts.GlooNamespace, opts.WriteNamespace, opts.WatchNamespaces, opts.KubeClient)
+		if err != nil {
+			return errors.Wrapf(err, "starting proxy provisioning controller")
+		}
+		gatewaySyncers = append(gatewaySyncers, provisioningController)
+	}
+
 	eventLoop := v1.NewApiEventLoop(emitter, gatewaySyncers)
 	eventLoopErrs, err := eventLoop.Run(opts.WatchNamespaces, opts.WatchOpts)
 	if err != nil {
@@ -297,7 +465,7 @@ func RunGateway(opts translator.Opts) error {
 				opts.Validation.ValidatingWebhookCertPath,
 				opts.Validation.ValidatingWebhookKeyPath,
 				opts.Validation.AlwaysAcceptResources,
-				opts.ReadGatewaysFromAllNamespaces,
+				opts.ReadGatewaysFromAllNamespaces || opts.NamespacedGateways != nil,
 				opts.GlooNamespace,
 			),
 		)
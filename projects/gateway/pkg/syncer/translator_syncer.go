@@ -10,6 +10,7 @@ import (
 	"go.uber.org/zap/zapcore"
 
 	"github.com/hashicorp/go-multierror"
+	glooutils "github.com/solo-io/gloo/pkg/utils"
 	"github.com/solo-io/gloo/pkg/utils/settingsutil"
 	"github.com/solo-io/gloo/pkg/utils/syncutil"
 	"github.com/solo-io/gloo/projects/gateway/pkg/reconciler"
@@ -31,25 +32,35 @@ import (
 
 type translatorSyncer struct {
 	writeNamespace     string
+	namespacedGateways bool
 	reporter           reporter.StatusReporter
 	proxyWatcher       gloov1.ProxyWatcher
 	proxyReconciler    reconciler.ProxyReconciler
 	translator         translator.Translator
 	statusSyncer       statusSyncer
 	managedProxyLabels map[string]string
+
+	// the namespaces outside the write namespace that had proxies on the last sync, so that their proxies are
+	// deleted once their gateways are gone
+	proxyNamespaces map[string]bool
 }
 
-func NewTranslatorSyncer(ctx context.Context, writeNamespace string, proxyWatcher gloov1.ProxyWatcher, proxyReconciler reconciler.ProxyReconciler, reporter reporter.StatusReporter, translator translator.Translator) v1.ApiSyncer {
+// NewTranslatorSyncer returns a syncer that writes the proxies of the gateways to the write namespace. If
+// namespacedGateways is true, the gateways outside the write namespace are translated to proxies in their own
+// namespace instead, so the proxies of all the watch namespaces are watched for their statuses.
+func NewTranslatorSyncer(ctx context.Context, writeNamespace string, watchNamespaces []string, namespacedGateways bool, proxyWatcher gloov1.ProxyWatcher, proxyReconciler reconciler.ProxyReconciler, reporter reporter.StatusReporter, translator translator.Translator) v1.ApiSyncer {
 	t := &translatorSyncer{
-		writeNamespace:  writeNamespace,
-		reporter:        reporter,
-		proxyWatcher:    proxyWatcher,
-		proxyReconciler: proxyReconciler,
-		translator:      translator,
-		statusSyncer:    newStatusSyncer(writeNamespace, proxyWatcher, reporter),
+		writeNamespace:     writeNamespace,
+		namespacedGateways: namespacedGateways,
+		reporter:           reporter,
+		proxyWatcher:       proxyWatcher,
+		proxyReconciler:    proxyReconciler,
+		translator:         translator,
+		statusSyncer:       newStatusSyncer(proxyWatchNamespaces(writeNamespace, watchNamespaces, namespacedGateways), proxyWatcher, reporter),
 		managedProxyLabels: map[string]string{
 			"created_by": "gateway",
 		},
+		proxyNamespaces: map[string]bool{},
 	}
 
 	go t.statusSyncer.watchProxies(ctx)
@@ -81,12 +92,12 @@ func (s *translatorSyncer) Sync(ctx context.Context, snap *v1.ApiSnapshot) error
 
 func (s *translatorSyncer) generatedDesiredProxies(ctx context.Context, snap *v1.ApiSnapshot) reconciler.GeneratedProxies {
 	logger := contextutils.LoggerFrom(ctx)
-	gatewaysByProxy := utils.GatewaysByProxyName(snap.Gateways)
+	gatewaysByProxy := utils.GatewaysByProxy(snap.Gateways, s.writeNamespace, s.namespacedGateways)
 
 	desiredProxies := make(reconciler.GeneratedProxies)
 
-	for proxyName, gatewayList := range gatewaysByProxy {
-		proxy, reports := s.translator.Translate(ctx, proxyName, s.writeNamespace, snap, gatewayList)
+	for proxyRef, gatewayList := range gatewaysByProxy {
+		proxy, reports := s.translator.Translate(ctx, proxyRef.Name, proxyRef.Namespace, snap, gatewayList)
 		if proxy != nil {
 
 			if s.shouldCompresss(ctx) {
@@ -106,8 +117,43 @@ func (s *translatorSyncer) shouldCompresss(ctx context.Context) bool {
 }
 
 func (s *translatorSyncer) reconcile(ctx context.Context, desiredProxies reconciler.GeneratedProxies) error {
-	if err := s.proxyReconciler.ReconcileProxies(ctx, desiredProxies, s.writeNamespace, s.managedProxyLabels); err != nil {
-		return err
+	// the proxies are reconciled per namespace; the write namespace and the namespaces that had proxies on the
+	// last sync are reconciled even without desired proxies, to delete the stale ones
+	proxiesByNamespace := map[string]reconciler.GeneratedProxies{
+		s.writeNamespace: {},
+	}
+	for namespace := range s.proxyNamespaces {
+		proxiesByNamespace[namespace] = reconciler.GeneratedProxies{}
+	}
+	for proxy, reports := range desiredProxies {
+		namespace := proxy.GetMetadata().Namespace
+		if _, ok := proxiesByNamespace[namespace]; !ok {
+			proxiesByNamespace[namespace] = reconciler.GeneratedProxies{}
+		}
+		proxiesByNamespace[namespace][proxy] = reports
+	}
+
+	namespaces := make([]string, 0, len(proxiesByNamespace))
+	for namespace := range proxiesByNamespace {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	var errs error
+	proxyNamespaces := map[string]bool{}
+	for _, namespace := range namespaces {
+		proxies := proxiesByNamespace[namespace]
+		err := s.proxyReconciler.ReconcileProxies(ctx, proxies, namespace, s.managedProxyLabels)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+		}
+		if namespace != s.writeNamespace && (len(proxies) > 0 || err != nil) {
+			proxyNamespaces[namespace] = true
+		}
+	}
+	s.proxyNamespaces = proxyNamespaces
+	if errs != nil {
+		return errs
 	}
 
 	// repeat for all resources
@@ -127,22 +173,38 @@ type statusSyncer struct {
 	mapLock                 sync.RWMutex
 	reporter                reporter.StatusReporter
 
-	proxyWatcher   gloov1.ProxyWatcher
-	writeNamespace string
-	syncNeeded     chan struct{}
+	proxyWatcher    gloov1.ProxyWatcher
+	proxyNamespaces []string
+	syncNeeded      chan struct{}
 }
 
-func newStatusSyncer(writeNamespace string, proxyWatcher gloov1.ProxyWatcher, reporter reporter.StatusReporter) statusSyncer {
+func newStatusSyncer(proxyNamespaces []string, proxyWatcher gloov1.ProxyWatcher, reporter reporter.StatusReporter) statusSyncer {
 	return statusSyncer{
 		proxyToLastStatus:       map[core.ResourceRef]reportsAndStatus{},
 		currentGeneratedProxies: nil,
 		reporter:                reporter,
 		proxyWatcher:            proxyWatcher,
-		writeNamespace:          writeNamespace,
+		proxyNamespaces:         proxyNamespaces,
 		syncNeeded:              make(chan struct{}, 1),
 	}
 }
 
+// proxyWatchNamespaces returns the namespaces the proxies of the gateways can be written to
+func proxyWatchNamespaces(writeNamespace string, watchNamespaces []string, namespacedGateways bool) []string {
+	if !namespacedGateways {
+		return []string{writeNamespace}
+	}
+	if glooutils.AllNamespaces(watchNamespaces) {
+		return []string{""}
+	}
+	for _, namespace := range watchNamespaces {
+		if namespace == writeNamespace {
+			return watchNamespaces
+		}
+	}
+	return append([]string{writeNamespace}, watchNamespaces...)
+}
+
 func (s *statusSyncer) setCurrentProxies(desiredProxies reconciler.GeneratedProxies) {
 	s.mapLock.Lock()
 	defer s.mapLock.Unlock()
@@ -176,13 +238,17 @@ func (s *statusSyncer) watchProxies(ctx context.Context) error {
 	ctx = contextutils.WithLogger(ctx, "proxy-err-watcher")
 	logger := contextutils.LoggerFrom(ctx)
 	defer logger.Debugw("done watching proxies")
-	proxies, errs, err := s.proxyWatcher.Watch(s.writeNamespace, clients.WatchOpts{
-		Ctx: ctx,
-	})
-	if err != nil {
-		return errors.Wrapf(err, "creating watch for proxies in %v", s.writeNamespace)
+	for _, namespace := range s.proxyNamespaces {
+		proxies, errs, err := s.proxyWatcher.Watch(namespace, clients.WatchOpts{
+			Ctx: ctx,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "creating watch for proxies in %v", namespace)
+		}
+		go s.watchProxiesFromChannel(ctx, proxies, errs)
 	}
-	return s.watchProxiesFromChannel(ctx, proxies, errs)
+	<-ctx.Done()
+	return nil
 }
 
 func (s *statusSyncer) watchProxiesFromChannel(ctx context.Context, proxies <-chan gloov1.ProxyList, errs <-chan error) error {
@@ -65,7 +65,7 @@ var _ = Describe("TranslatorSyncer integration test", func() {
 		proxyReconciler := reconciler.NewProxyReconciler(nil, proxyClient)
 		rpt := reporter.NewReporter("gateway", gatewayClient.BaseClient(), virtualServiceClient.BaseClient(), routeTableClient.BaseClient())
 		xlator := translator.NewDefaultTranslator(translator.Opts{})
-		ts = NewTranslatorSyncer(ctx, "gloo-system", proxyClient, proxyReconciler, rpt, xlator)
+		ts = NewTranslatorSyncer(ctx, "gloo-system", nil, false, proxyClient, proxyReconciler, rpt, xlator)
 
 		vs = &v1.VirtualService{
 			Metadata: core.Metadata{
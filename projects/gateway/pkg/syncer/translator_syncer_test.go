@@ -28,7 +28,7 @@ var _ = Describe("TranslatorSyncer", func() {
 
 	BeforeEach(func() {
 		mockReporter = &fakeReporter{}
-		curSyncer := newStatusSyncer("gloo-system", fakeWatcher, mockReporter)
+		curSyncer := newStatusSyncer([]string{"gloo-system"}, fakeWatcher, mockReporter)
 		syncer = &curSyncer
 	})
 
@@ -315,10 +315,75 @@ var _ = Describe("TranslatorSyncer", func() {
 			Expect(proxy.Metadata.Annotations).NotTo(HaveKeyWithValue(compress.CompressedKey, compress.CompressedValue))
 		})
 
+		Context("namespaced gateways", func() {
+			var (
+				fakeReconciler *fakeProxyReconciler
+			)
+
+			BeforeEach(func() {
+				fakeReconciler = &fakeProxyReconciler{}
+				ts.namespacedGateways = true
+				ts.proxyReconciler = fakeReconciler
+				ts.proxyNamespaces = map[string]bool{}
+				ts.statusSyncer = newStatusSyncer([]string{""}, fakeWatcher, &fakeReporter{})
+				snap.Gateways = gatewayv1.GatewayList{
+					{Metadata: core.Metadata{Name: "shared", Namespace: "gloo-system"}},
+					{Metadata: core.Metadata{Name: "team", Namespace: "team-a"}},
+				}
+			})
+
+			It("translates the gateways outside the write namespace to proxies in their own namespace", func() {
+				teamProxy := &gloov1.Proxy{Metadata: core.Metadata{Name: "gateway-proxy", Namespace: "team-a"}}
+				mockTranslator.EXPECT().Translate(gomock.Any(), "gateway-proxy", "gloo-system", snap, gatewayv1.GatewayList{snap.Gateways[0]}).
+					Return(proxy, nil)
+				mockTranslator.EXPECT().Translate(gomock.Any(), "gateway-proxy", "team-a", snap, gatewayv1.GatewayList{snap.Gateways[1]}).
+					Return(teamProxy, nil)
+
+				desiredProxies := ts.generatedDesiredProxies(ctx, snap)
+				Expect(desiredProxies).To(HaveLen(2))
+				Expect(desiredProxies).To(HaveKey(teamProxy))
+			})
+
+			It("reconciles the proxies of each namespace, until the namespace has no proxies left", func() {
+				teamProxy := &gloov1.Proxy{Metadata: core.Metadata{Name: "gateway-proxy", Namespace: "team-a"}}
+				proxy.Metadata.Namespace = "gloo-system"
+
+				Expect(ts.reconcile(ctx, reconciler.GeneratedProxies{proxy: nil, teamProxy: nil})).NotTo(HaveOccurred())
+				Expect(fakeReconciler.namespaces).To(Equal([]string{"gloo-system", "team-a"}))
+				Expect(fakeReconciler.proxies["team-a"]).To(ConsistOf(teamProxy))
+
+				fakeReconciler.namespaces = nil
+				Expect(ts.reconcile(ctx, reconciler.GeneratedProxies{proxy: nil})).NotTo(HaveOccurred())
+				Expect(fakeReconciler.namespaces).To(Equal([]string{"gloo-system", "team-a"}))
+				Expect(fakeReconciler.proxies["team-a"]).To(BeEmpty())
+
+				fakeReconciler.namespaces = nil
+				Expect(ts.reconcile(ctx, reconciler.GeneratedProxies{proxy: nil})).NotTo(HaveOccurred())
+				Expect(fakeReconciler.namespaces).To(Equal([]string{"gloo-system"}))
+			})
+		})
+
 	})
 
 })
 
+type fakeProxyReconciler struct {
+	namespaces []string
+	proxies    map[string][]*gloov1.Proxy
+}
+
+func (f *fakeProxyReconciler) ReconcileProxies(ctx context.Context, proxiesToWrite reconciler.GeneratedProxies, writeNamespace string, labels map[string]string) error {
+	f.namespaces = append(f.namespaces, writeNamespace)
+	if f.proxies == nil {
+		f.proxies = map[string][]*gloov1.Proxy{}
+	}
+	f.proxies[writeNamespace] = nil
+	for proxy := range proxiesToWrite {
+		f.proxies[writeNamespace] = append(f.proxies[writeNamespace], proxy)
+	}
+	return nil
+}
+
 type fakeWatcher struct {
 }
 
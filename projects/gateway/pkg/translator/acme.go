@@ -0,0 +1,92 @@
+package translator
+
+import (
+	"strings"
+
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	matchersv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const (
+	// virtual services with this annotation set to "true" get their certificates from the ACME server
+	AcmeAnnotation = "gateway.solo.io/acme"
+
+	// name of the upstream of the HTTP-01 challenge solver, in the write namespace
+	AcmeSolverUpstreamName = "acme-http01-solver"
+
+	AcmeChallengePathPrefix = "/.well-known/acme-challenge/"
+
+	acmeChallengeRouteName = "acme-http01-challenge"
+)
+
+var (
+	AcmeNoDomainsErr = func(vs *v1.VirtualService) error {
+		return errors.Errorf("acme: virtual service [%s] must specify the domains to request a certificate for", vs.Metadata.Ref().Key())
+	}
+	AcmeWildcardDomainErr = func(vs *v1.VirtualService, domain string) error {
+		return errors.Errorf("acme: virtual service [%s] has the wildcard domain %s, which cannot be validated with HTTP-01 challenges", vs.Metadata.Ref().Key(), domain)
+	}
+)
+
+// IsAcmeVirtualService returns true if the virtual service is annotated to get its certificate from
+// the ACME server. Virtual services with their own ssl config are ignored.
+func IsAcmeVirtualService(vs *v1.VirtualService) bool {
+	return vs.SslConfig == nil && vs.Metadata.Annotations[AcmeAnnotation] == "true"
+}
+
+// ValidateAcmeDomains returns an error if no certificate can be requested for the domains of the virtual service
+func ValidateAcmeDomains(vs *v1.VirtualService) error {
+	domains := vs.GetVirtualHost().GetDomains()
+	if len(domains) == 0 {
+		return AcmeNoDomainsErr(vs)
+	}
+	for _, domain := range domains {
+		if domain == "" || strings.Contains(domain, "*") {
+			return AcmeWildcardDomainErr(vs, domain)
+		}
+	}
+	return nil
+}
+
+// AcmeSecretRef is the TLS secret that holds the certificate issued for the virtual service
+func AcmeSecretRef(vs *v1.VirtualService) core.ResourceRef {
+	return core.ResourceRef{
+		Name:      vs.Metadata.Name + "-acme-tls",
+		Namespace: vs.Metadata.Namespace,
+	}
+}
+
+func acmeSslConfig(vs *v1.VirtualService) *gloov1.SslConfig {
+	secretRef := AcmeSecretRef(vs)
+	return &gloov1.SslConfig{
+		SslSecrets: &gloov1.SslConfig_SecretRef{
+			SecretRef: &secretRef,
+		},
+		SniDomains: vs.VirtualHost.Domains,
+	}
+}
+
+func acmeChallengeRoute(solverUpstream core.ResourceRef) *gloov1.Route {
+	return &gloov1.Route{
+		Name: acmeChallengeRouteName,
+		Matchers: []*matchersv1.Matcher{{
+			PathSpecifier: &matchersv1.Matcher_Prefix{
+				Prefix: AcmeChallengePathPrefix,
+			},
+		}},
+		Action: &gloov1.Route_RouteAction{
+			RouteAction: &gloov1.RouteAction{
+				Destination: &gloov1.RouteAction_Single{
+					Single: &gloov1.Destination{
+						DestinationType: &gloov1.Destination_Upstream{
+							Upstream: &solverUpstream,
+						},
+					},
+				},
+			},
+		},
+	}
+}
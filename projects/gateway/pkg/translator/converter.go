@@ -33,6 +33,7 @@ var (
 	InvalidHeaderErr     = errors.New("invalid route: route table matchers must have all headers that were specified on their parent route's matcher")
 	InvalidQueryParamErr = errors.New("invalid route: route table matchers must have all query params that were specified on their parent route's matcher")
 	InvalidMethodErr     = errors.New("invalid route: route table matchers must have all methods that were specified on their parent route's matcher")
+	CaseInsensitiveErr   = errors.New("invalid route: route table matchers cannot be case insensitive if their parent route's matcher is case sensitive")
 
 	DelegationCycleErr = func(cycleInfo string) error {
 		return errors.Errorf("invalid route: delegation cycle detected: %s", cycleInfo)
@@ -49,8 +50,13 @@ var (
 	InvalidRouteTableForDelegateMethodsErr = func(delegateMethods, childMethods []string) error {
 		return errors.Wrapf(InvalidMethodErr, "required methods: %v, methods: %v", delegateMethods, childMethods)
 	}
-	TopLevelVirtualResourceErr = func(rtRef core.Metadata, err error) error {
-		return errors.Wrapf(err, "on sub route table %s", rtRef.Ref().Key())
+	ConflictingMatchersWarning = func(matcher *matchersv1.Matcher, rt1, rt2 *gatewayv1.RouteTable) error {
+		return errors.Errorf("route tables %s and %s have the same weight %d and routes with the same matcher "+
+			"for path %s, so the order of their routes is undefined; give them different weights to choose it",
+			rt1.GetMetadata().Ref().Key(), rt2.GetMetadata().Ref().Key(), rt1.GetWeight().GetValue(), glooutils.PathAsString(matcher))
+	}
+	TopLevelVirtualResourceErr = func(rtRef core.Metadata, delegationChain string, err error) error {
+		return errors.Wrapf(err, "on sub route table %s (delegation chain: %s)", rtRef.Ref().Key(), delegationChain)
 	}
 )
 
@@ -60,10 +66,11 @@ type RouteConverter interface {
 	ConvertVirtualService(virtualService *gatewayv1.VirtualService, reports reporter.ResourceReports) ([]*gloov1.Route, error)
 }
 
-func NewRouteConverter(selector RouteTableSelector, indexer RouteTableIndexer) RouteConverter {
+func NewRouteConverter(selector RouteTableSelector, indexer RouteTableIndexer, routeOptions gatewayv1.RouteOptionList) RouteConverter {
 	return &routeVisitor{
 		routeTableSelector: selector,
 		routeTableIndexer:  indexer,
+		routeOptions:       routeOptions,
 	}
 }
 
@@ -99,6 +106,8 @@ type routeVisitor struct {
 	routeTableSelector RouteTableSelector
 	// Used to sort route tables when multiple ones are matched by a selector.
 	routeTableIndexer RouteTableIndexer
+	// Used to merge the options of the route option resources that routes delegate to.
+	routeOptions gatewayv1.RouteOptionList
 }
 
 // Helper object used to store information about previously visited routes.
@@ -121,24 +130,32 @@ type reporterHelper struct {
 	topLevelVirtualService *gatewayv1.VirtualService
 }
 
-func (r *reporterHelper) addError(resource resources.InputResource, err error) {
+// addError reports the error on the resource. The route tables are the delegation chain from the top level virtual
+// service to the resource.
+func (r *reporterHelper) addError(resource resources.InputResource, err error, routeTables gatewayv1.RouteTableList) {
 	r.reports.AddError(resource, err)
 
 	// If the resource is a Route Table, also add the error to the top level virtual service.
 	if rt, ok := resource.(*gatewayv1.RouteTable); ok {
-		r.reports.AddError(r.topLevelVirtualService, TopLevelVirtualResourceErr(rt.GetMetadata(), err))
+		r.reports.AddError(r.topLevelVirtualService, TopLevelVirtualResourceErr(rt.GetMetadata(), r.delegationChain(routeTables), err))
 	}
 }
 
-func (r *reporterHelper) addWarning(resource resources.InputResource, err error) {
+func (r *reporterHelper) addWarning(resource resources.InputResource, err error, routeTables gatewayv1.RouteTableList) {
 	r.reports.AddWarning(resource, err.Error())
 
 	// If the resource is a Route Table, also add the warning to the top level virtual service.
 	if rt, ok := resource.(*gatewayv1.RouteTable); ok {
-		r.reports.AddWarning(r.topLevelVirtualService, TopLevelVirtualResourceErr(rt.GetMetadata(), err).Error())
+		r.reports.AddWarning(r.topLevelVirtualService, TopLevelVirtualResourceErr(rt.GetMetadata(), r.delegationChain(routeTables), err).Error())
 	}
 }
 
+// delegationChain formats the path from the top level virtual service through the route tables,
+// e.g. "[default.vs] -> [default.rt-1] -> [team-a.rt-2]"
+func (r *reporterHelper) delegationChain(routeTables gatewayv1.RouteTableList) string {
+	return fmt.Sprintf("[%s] -> %s", r.topLevelVirtualService.GetMetadata().Ref().Key(), buildCycleInfoString(routeTables))
+}
+
 func (rv *routeVisitor) ConvertVirtualService(virtualService *gatewayv1.VirtualService, reports reporter.ResourceReports) ([]*gloov1.Route, error) {
 	wrapper := &visitableVirtualService{VirtualService: virtualService}
 	return rv.visit(
@@ -171,12 +188,19 @@ func (rv *routeVisitor) visit(
 		name, routeHasName := routeName(resource.InputResource(), routeClone, parentRoute)
 		routeClone.Name = name
 
+		// Merge the options of the route option resources before the ones of the parent route
+		options, warnings := mergeDelegatedRouteOptions(routeClone, resource.InputResource().GetMetadata().Namespace, rv.routeOptions)
+		routeClone.Options = options
+		for _, warning := range warnings {
+			reporterHelper.addWarning(resource.InputResource(), warning, visitedRouteTables)
+		}
+
 		// If the parent route is not nil, this route has been delegated to and we need to perform additional operations
 		if parentRoute != nil {
 			var err error
 			routeClone, err = validateAndMergeParentRoute(routeClone, parentRoute)
 			if err != nil {
-				reporterHelper.addError(resource.InputResource(), err)
+				reporterHelper.addError(resource.InputResource(), err, visitedRouteTables)
 				continue
 			}
 		}
@@ -187,14 +211,14 @@ func (rv *routeVisitor) visit(
 			// Validate the matcher of the delegate route
 			delegateMatcher, err := getDelegateRouteMatcher(routeClone)
 			if err != nil {
-				reporterHelper.addError(resource.InputResource(), err)
+				reporterHelper.addError(resource.InputResource(), err, visitedRouteTables)
 				continue
 			}
 
 			// Determine the route tables to delegate to
 			routeTables, err := rv.routeTableSelector.SelectRouteTables(action.DelegateAction, resource.InputResource().GetMetadata().Namespace)
 			if err != nil {
-				reporterHelper.addWarning(resource.InputResource(), err)
+				reporterHelper.addWarning(resource.InputResource(), err, visitedRouteTables)
 				continue
 			}
 
@@ -211,14 +235,17 @@ func (rv *routeVisitor) visit(
 			for _, weight := range sortedWeights {
 				routeTablesForWeight := routeTablesByWeight[weight]
 
-				var rtRoutesForWeight []*gloov1.Route
+				var (
+					rtRoutesForWeight   []*gloov1.Route
+					rtMatchersForWeight []routeTableMatcher
+				)
 				for _, routeTable := range routeTablesForWeight {
 
 					// Check for delegation cycles
 					if err := checkForCycles(routeTable, visitedRouteTables); err != nil {
 						// Note that we do not report the error on the table we are currently visiting, but on the
 						// one we are about to visit, since that is the one that started the cycle.
-						reporterHelper.addError(routeTable, err)
+						reporterHelper.addError(routeTable, err, append(append(gatewayv1.RouteTableList{}, visitedRouteTables...), routeTable))
 						continue
 					}
 
@@ -246,6 +273,18 @@ func (rv *routeVisitor) visit(
 						return nil, err
 					}
 
+					// Routes with the same matchers from route tables of the same weight are conflicting, since
+					// nothing defines which one comes first
+					for _, subRoute := range subRoutes {
+						for _, matcher := range subRoute.GetMatchers() {
+							if conflict := findConflictingMatcher(rtMatchersForWeight, matcher, routeTable); conflict != nil {
+								reporterHelper.addWarning(resource.InputResource(),
+									ConflictingMatchersWarning(matcher, conflict.routeTable, routeTable), visitedRouteTables)
+							}
+							rtMatchersForWeight = append(rtMatchersForWeight, routeTableMatcher{matcher: matcher, routeTable: routeTable})
+						}
+					}
+
 					rtRoutesForWeight = append(rtRoutesForWeight, subRoutes...)
 				}
 
@@ -281,7 +320,7 @@ func (rv *routeVisitor) visit(
 			}
 			glooRoute, err := convertSimpleAction(routeClone)
 			if err != nil {
-				reporterHelper.addError(resource.InputResource(), err)
+				reporterHelper.addError(resource.InputResource(), err, visitedRouteTables)
 				continue
 			}
 			routes = append(routes, glooRoute)
@@ -299,6 +338,22 @@ func (rv *routeVisitor) visit(
 	return routes, nil
 }
 
+// The matcher of a route, and the route table that was delegated to for it
+type routeTableMatcher struct {
+	matcher    *matchersv1.Matcher
+	routeTable *gatewayv1.RouteTable
+}
+
+// findConflictingMatcher returns the matcher equal to the given one that comes from another route table, if any
+func findConflictingMatcher(matchers []routeTableMatcher, matcher *matchersv1.Matcher, routeTable *gatewayv1.RouteTable) *routeTableMatcher {
+	for i, existing := range matchers {
+		if existing.routeTable != routeTable && existing.matcher.Equal(matcher) {
+			return &matchers[i]
+		}
+	}
+	return nil
+}
+
 // Returns the name of the route and a flag that is true if either the route or the parent route are explicitly named.
 // Route names have the following format: "vs:myvirtualservice_route:myfirstroute_rt:myroutetable_route:<unnamed>"
 func routeName(resource resources.InputResource, route *gatewayv1.Route, parentRouteInfo *routeInfo) (string, bool) {
@@ -406,12 +461,29 @@ func validateAndMergeParentRoute(child *gatewayv1.Route, parent *routeInfo) (*ga
 		}
 	}
 
+	// Routes without matchers fall back to the default prefix matcher, which has to inherit from and be validated
+	// against the parent matcher like any other one. Otherwise a terminal route (e.g. a redirect or a direct response)
+	// would silently drop the headers, methods and query params of its parent.
+	if len(child.Matchers) == 0 {
+		child.Matchers = []*matchersv1.Matcher{defaults.DefaultMatcher()}
+	}
+
 	// inherit route table config from parent
 	if parent.inheritableMatchers {
+		// the parent matchers are copied, so sibling routes don't share (and overwrite) the same backing arrays
 		for _, childMatch := range child.Matchers {
-			childMatch.Headers = append(parent.matcher.Headers, childMatch.Headers...)
-			childMatch.Methods = append(parent.matcher.Methods, childMatch.Methods...)
-			childMatch.QueryParameters = append(parent.matcher.QueryParameters, childMatch.QueryParameters...)
+			if len(parent.matcher.Headers) > 0 {
+				childMatch.Headers = append(append([]*matchersv1.HeaderMatcher{}, parent.matcher.Headers...), childMatch.Headers...)
+			}
+			if len(parent.matcher.Methods) > 0 {
+				childMatch.Methods = append(append([]string{}, parent.matcher.Methods...), childMatch.Methods...)
+			}
+			if len(parent.matcher.QueryParameters) > 0 {
+				childMatch.QueryParameters = append(append([]*matchersv1.QueryParameterMatcher{}, parent.matcher.QueryParameters...), childMatch.QueryParameters...)
+			}
+			if childMatch.CaseSensitive == nil && parent.matcher.CaseSensitive != nil {
+				childMatch.CaseSensitive = &types.BoolValue{Value: parent.matcher.CaseSensitive.Value}
+			}
 		}
 	}
 
@@ -434,18 +506,17 @@ func validateAndMergeParentRoute(child *gatewayv1.Route, parent *routeInfo) (*ga
 
 func isRouteTableValidForDelegateMatcher(parentMatcher *matchersv1.Matcher, childRoute *gatewayv1.Route) error {
 
-	// If the route has no matchers, we fall back to the default prefix matcher like for regular routes.
-	// In these case, we only accept it if the parent also uses the default matcher.
-	if len(childRoute.Matchers) == 0 && parentMatcher.GetPrefix() != defaults.DefaultMatcher().GetPrefix() {
-		return InvalidRouteTableForDelegatePrefixErr(parentMatcher.GetPrefix(), defaults.DefaultMatcher().GetPrefix())
-	}
-
 	for _, childMatch := range childRoute.Matchers {
 		// ensure all sub-routes in the delegated route table match the parent prefix
-		if pathString := glooutils.PathAsString(childMatch); !strings.HasPrefix(pathString, parentMatcher.GetPrefix()) {
+		if pathString := glooutils.PathAsString(childMatch); !hasDelegatePrefix(parentMatcher, pathString) {
 			return InvalidRouteTableForDelegatePrefixErr(parentMatcher.GetPrefix(), pathString)
 		}
 
+		// a case insensitive sub-route would match paths that the case sensitive parent prefix does not
+		if !isCaseSensitive(childMatch) && isCaseSensitive(parentMatcher) {
+			return CaseInsensitiveErr
+		}
+
 		// ensure all headers in the delegated route table are a superset of those from the parent route resource
 		childHeaderNameToHeader := map[string]*matchersv1.HeaderMatcher{}
 		for _, childHeader := range childMatch.Headers {
@@ -481,6 +552,19 @@ func isRouteTableValidForDelegateMatcher(parentMatcher *matchersv1.Matcher, chil
 	return nil
 }
 
+func isCaseSensitive(matcher *matchersv1.Matcher) bool {
+	return matcher.GetCaseSensitive() == nil || matcher.GetCaseSensitive().GetValue()
+}
+
+// hasDelegatePrefix returns true if the path starts with the prefix of the parent matcher, ignoring
+// the case if the parent matcher is case insensitive
+func hasDelegatePrefix(parentMatcher *matchersv1.Matcher, path string) bool {
+	if !isCaseSensitive(parentMatcher) {
+		return strings.HasPrefix(strings.ToLower(path), strings.ToLower(parentMatcher.GetPrefix()))
+	}
+	return strings.HasPrefix(path, parentMatcher.GetPrefix())
+}
+
 // Handles new and deprecated format for referencing a route table
 // TODO: remove this function when we remove the deprecated fields from the API
 func getRouteTableRef(delegate *gatewayv1.DelegateAction) *core.ResourceRef {
@@ -11,6 +11,7 @@ import (
 	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 )
@@ -29,7 +30,7 @@ var _ = Describe("Route converter", func() {
 					Routes: []*v1.Route{route},
 				},
 			}
-			rv := translator.NewRouteConverter(nil, nil)
+			rv := translator.NewRouteConverter(nil, nil, nil)
 			_, err := rv.ConvertVirtualService(vs, reports)
 			Expect(err).NotTo(HaveOccurred())
 
@@ -143,6 +144,7 @@ var _ = Describe("Route converter", func() {
 			rv := translator.NewRouteConverter(
 				translator.NewRouteTableSelector(v1.RouteTableList{&rt}),
 				translator.NewRouteTableIndexer(),
+				nil,
 			)
 			converted, err := rv.ConvertVirtualService(vs, rpt)
 			Expect(err).NotTo(HaveOccurred())
@@ -180,6 +182,7 @@ var _ = Describe("Route converter", func() {
 			rv := translator.NewRouteConverter(
 				translator.NewRouteTableSelector(v1.RouteTableList{}),
 				translator.NewRouteTableIndexer(),
+				nil,
 			)
 			converted, err := rv.ConvertVirtualService(vs, rpt)
 			Expect(err).NotTo(HaveOccurred())
@@ -223,6 +226,7 @@ var _ = Describe("Route converter", func() {
 			rv := translator.NewRouteConverter(
 				translator.NewRouteTableSelector(v1.RouteTableList{}),
 				translator.NewRouteTableIndexer(),
+				nil,
 			)
 			converted, err := rv.ConvertVirtualService(vs, rpt)
 			Expect(err).NotTo(HaveOccurred())
@@ -275,6 +279,7 @@ var _ = Describe("Route converter", func() {
 			rv := translator.NewRouteConverter(
 				translator.NewRouteTableSelector(v1.RouteTableList{&rt}),
 				translator.NewRouteTableIndexer(),
+				nil,
 			)
 			converted, err := rv.ConvertVirtualService(vs, rpt)
 
@@ -329,6 +334,7 @@ var _ = Describe("Route converter", func() {
 			rv := translator.NewRouteConverter(
 				translator.NewRouteTableSelector(v1.RouteTableList{&rt}),
 				translator.NewRouteTableIndexer(),
+				nil,
 			)
 			converted, err := rv.ConvertVirtualService(vs, rpt)
 
@@ -416,6 +422,7 @@ var _ = Describe("Route converter", func() {
 				rv = translator.NewRouteConverter(
 					translator.NewRouteTableSelector(v1.RouteTableList{rt}),
 					translator.NewRouteTableIndexer(),
+					nil,
 				)
 			})
 
@@ -499,6 +506,7 @@ var _ = Describe("Route converter", func() {
 				rv = translator.NewRouteConverter(
 					translator.NewRouteTableSelector(v1.RouteTableList{rt, rt2, rt3}),
 					translator.NewRouteTableIndexer(),
+					nil,
 				)
 
 				expectedHeaders := append(rtOnlyHeaders, vsOnlyHeaders...)
@@ -528,6 +536,47 @@ var _ = Describe("Route converter", func() {
 				))
 			})
 
+			It("inherits the matchers of the parent on routes without matchers", func() {
+				vs.VirtualHost.Routes[0].Matchers[0].PathSpecifier = &matchers.Matcher_Prefix{Prefix: "/"}
+
+				rpt := reporter.ResourceReports{}
+				converted, err := rv.ConvertVirtualService(vs, rpt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rpt).To(HaveLen(0))
+				Expect(converted).To(HaveLen(1))
+				Expect(converted[0].Matchers).To(HaveLen(1))
+				Expect(converted[0].Matchers[0].GetPrefix()).To(Equal("/"))
+				Expect(converted[0].Matchers[0].Headers).To(ConsistOf(vsOnlyHeaders))
+			})
+
+			It("keeps the redirect actions of route tables, with the inherited matchers", func() {
+				redirect := &gloov1.RedirectAction{
+					HostRedirect:   "example.com",
+					SchemeRedirect: "http",
+					PortRedirect:   8080,
+					StripQuery:     true,
+					PathRewriteSpecifier: &gloov1.RedirectAction_PrefixRewrite{
+						PrefixRewrite: "/bar",
+					},
+				}
+				rt.Routes[0].Matchers = []*matchers.Matcher{{
+					PathSpecifier: &matchers.Matcher_Prefix{
+						Prefix: "/foo/bar",
+					},
+				}}
+				rt.Routes[0].Action = &v1.Route_RedirectAction{
+					RedirectAction: redirect,
+				}
+
+				rpt := reporter.ResourceReports{}
+				converted, err := rv.ConvertVirtualService(vs, rpt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rpt).To(HaveLen(0))
+				Expect(converted).To(HaveLen(1))
+				Expect(converted[0].Matchers[0].Headers).To(ConsistOf(vsOnlyHeaders))
+				Expect(converted[0].GetRedirectAction()).To(Equal(redirect))
+			})
+
 		})
 	})
 
@@ -587,6 +636,7 @@ var _ = Describe("Route converter", func() {
 			rv = translator.NewRouteConverter(
 				translator.NewRouteTableSelector(v1.RouteTableList{rt}),
 				translator.NewRouteTableIndexer(),
+				nil,
 			)
 		})
 
@@ -621,6 +671,41 @@ var _ = Describe("Route converter", func() {
 			})
 		})
 
+		When("route table has a route without matchers", func() {
+			It("reports error if the parent route has a prefix other than '/'", func() {
+				rpt := reporter.ResourceReports{}
+				converted, err := rv.ConvertVirtualService(vs, rpt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(converted).To(BeNil())
+
+				expectedErr := translator.InvalidRouteTableForDelegatePrefixErr("/foo", "/").Error()
+				_, rtReport := rpt.Find("*v1.RouteTable", rt.Metadata.Ref())
+				Expect(rtReport.Errors).To(MatchError(ContainSubstring(expectedErr)))
+			})
+
+			It("reports error if the parent route has headers", func() {
+				vs.VirtualHost.Routes[0].Matchers[0] = &matchers.Matcher{
+					PathSpecifier: &matchers.Matcher_Prefix{
+						Prefix: "/",
+					},
+					Headers: []*matchers.HeaderMatcher{{
+						Name:  "headername",
+						Value: "headerval",
+					}},
+				}
+
+				rpt := reporter.ResourceReports{}
+				converted, err := rv.ConvertVirtualService(vs, rpt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(converted).To(BeNil())
+
+				_, vsReport := rpt.Find("*v1.VirtualService", vs.Metadata.Ref())
+				Expect(vsReport.Errors).To(MatchError(ContainSubstring(translator.InvalidHeaderErr.Error())))
+				_, rtReport := rpt.Find("*v1.RouteTable", rt.Metadata.Ref())
+				Expect(rtReport.Errors).To(MatchError(ContainSubstring(translator.InvalidHeaderErr.Error())))
+			})
+		})
+
 		When("route table has headers that don't match the headers of the parent route", func() {
 
 			var (
@@ -996,6 +1081,74 @@ var _ = Describe("Route converter", func() {
 
 		})
 
+		When("the parent route matcher is case insensitive", func() {
+
+			BeforeEach(func() {
+				vs.VirtualHost.Routes[0].Matchers[0].CaseSensitive = &types.BoolValue{Value: false}
+			})
+
+			It("accepts route table matchers that match the parent prefix regardless of case", func() {
+				rt.Routes[0].Matchers = []*matchers.Matcher{
+					{
+						PathSpecifier: &matchers.Matcher_Prefix{
+							Prefix: "/FOO/bar",
+						},
+					},
+				}
+
+				rpt := reporter.ResourceReports{}
+				converted, err := rv.ConvertVirtualService(vs, rpt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(converted).To(HaveLen(1))
+				Expect(rpt).To(HaveLen(0))
+			})
+
+			It("inherits the case sensitivity of the parent matcher if inheritance is on", func() {
+				vs.VirtualHost.Routes[0].InheritableMatchers = &types.BoolValue{Value: true}
+				rt.Routes[0].Matchers = []*matchers.Matcher{
+					{
+						PathSpecifier: &matchers.Matcher_Prefix{
+							Prefix: "/foo/bar",
+						},
+					},
+				}
+
+				rpt := reporter.ResourceReports{}
+				converted, err := rv.ConvertVirtualService(vs, rpt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rpt).To(HaveLen(0))
+				Expect(converted).To(HaveLen(1))
+				Expect(converted[0].Matchers[0].CaseSensitive).To(Equal(&types.BoolValue{Value: false}))
+			})
+		})
+
+		When("route table has a case insensitive matcher and the parent route matcher is case sensitive", func() {
+			It("reports error on the route table and on the virtual service", func() {
+				rt.Routes[0].Matchers = []*matchers.Matcher{
+					{
+						PathSpecifier: &matchers.Matcher_Prefix{
+							Prefix: "/foo/bar",
+						},
+						CaseSensitive: &types.BoolValue{Value: false},
+					},
+				}
+
+				rpt := reporter.ResourceReports{}
+				converted, err := rv.ConvertVirtualService(vs, rpt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(converted).To(BeNil())
+				Expect(rpt).To(HaveLen(2))
+
+				expectedErr := translator.CaseInsensitiveErr.Error()
+
+				_, vsReport := rpt.Find("*v1.VirtualService", vs.Metadata.Ref())
+				Expect(vsReport.Errors).To(MatchError(ContainSubstring(expectedErr)))
+
+				_, rtReport := rpt.Find("*v1.RouteTable", rt.Metadata.Ref())
+				Expect(rtReport.Errors).To(MatchError(ContainSubstring(expectedErr)))
+			})
+		})
+
 		When("route table has no matchers and the parent route matcher is not the default one", func() {
 			It("reports error on the route table and on the virtual service", func() {
 				rpt := reporter.ResourceReports{}
@@ -1074,6 +1227,7 @@ var _ = Describe("Route converter", func() {
 			visitor = translator.NewRouteConverter(
 				translator.NewRouteTableSelector(allRouteTables),
 				translator.NewRouteTableIndexer(),
+				nil,
 			)
 		})
 
@@ -1353,6 +1507,38 @@ var _ = Describe("Route converter", func() {
 			})
 		})
 
+		When("a nested route table does not match the prefix of its parent", func() {
+
+			BeforeEach(func() {
+				allRouteTables = v1.RouteTableList{
+					buildRouteTableWithSelector("rt-1", "ns-1", "/foo/1", nil,
+						&v1.RouteTableSelector{
+							Namespaces: []string{"*"},
+							Labels:     map[string]string{"team": "a"},
+						}),
+					buildRouteTableWithSimpleAction("rt-2", "ns-2", "/bar", map[string]string{"team": "a"}),
+				}
+			})
+
+			It("reports the delegation chain on the virtual service", func() {
+				vs = buildVirtualService(&v1.RouteTableSelector{
+					Namespaces: []string{"ns-1"},
+				})
+				converted, err := visitor.ConvertVirtualService(vs, reports)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(converted).To(BeEmpty())
+
+				expectedErr := translator.InvalidRouteTableForDelegatePrefixErr("/foo/1", "/bar").Error()
+
+				_, rtReport := reports.Find("*v1.RouteTable", core.ResourceRef{Name: "rt-2", Namespace: "ns-2"})
+				Expect(rtReport.Errors).To(MatchError(ContainSubstring(expectedErr)))
+				_, vsReport := reports.Find("*v1.VirtualService", vs.Metadata.Ref())
+				Expect(vsReport.Errors).To(MatchError(ContainSubstring(
+					"on sub route table ns-2.rt-2 (delegation chain: [ns-1.vs-1] -> [ns-1.rt-1] -> [ns-2.rt-2])")))
+				Expect(vsReport.Errors).To(MatchError(ContainSubstring(expectedErr)))
+			})
+		})
+
 		Describe("route tables with weights", func() {
 
 			var rt1, rt2, rt3, rt1a, rt1b, rt3a, rt3b, rt3c *v1.RouteTable
@@ -1423,6 +1609,130 @@ var _ = Describe("Route converter", func() {
 					Expect(vsReport.Errors).To(BeNil())
 				})
 			})
+
+			It("reports a warning on the virtual service for conflicting matchers of route tables with the same weight", func() {
+				rt3c.Routes[0].Matchers[0].PathSpecifier = &matchers.Matcher_Prefix{Prefix: "/foo/c/1/short-circuited"}
+
+				converted, err := visitor.ConvertVirtualService(vs, reports)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(converted).To(HaveLen(6))
+
+				_, vsReport := reports.Find("*v1.VirtualService", vs.Metadata.Ref())
+				Expect(vsReport.Errors).To(BeNil())
+				Expect(vsReport.Warnings).To(HaveLen(1))
+				Expect(vsReport.Warnings[0]).To(ContainSubstring(
+					translator.ConflictingMatchersWarning(rt3c.Routes[0].Matchers[0], rt3b, rt3c).Error()))
+				Expect(vsReport.Warnings[0]).To(ContainSubstring("on sub route table ns-1.rt-3"))
+
+				_, rtReport := reports.Find("*v1.RouteTable", rt3.Metadata.Ref())
+				Expect(rtReport.Warnings).To(HaveLen(1))
+			})
+		})
+	})
+
+	Describe("route options", func() {
+
+		var (
+			vs      *v1.VirtualService
+			rtOpts1 *v1.RouteOption
+			rtOpts2 *v1.RouteOption
+		)
+
+		BeforeEach(func() {
+			rtOpts1 = &v1.RouteOption{
+				Metadata: core.Metadata{Name: "opts-1", Namespace: "default", Labels: map[string]string{"team": "a"}},
+				Options: &gloov1.RouteOptions{
+					PrefixRewrite: &types.StringValue{Value: "/rewrite-1"},
+					HostRewriteType: &gloov1.RouteOptions_HostRewrite{
+						HostRewrite: "example.com",
+					},
+				},
+			}
+			rtOpts2 = &v1.RouteOption{
+				Metadata: core.Metadata{Name: "opts-2", Namespace: "default", Labels: map[string]string{"team": "a"}},
+				Options: &gloov1.RouteOptions{
+					PrefixRewrite: &types.StringValue{Value: "/rewrite-2"},
+					Retries:       &retries.RetryPolicy{NumRetries: 3},
+				},
+			}
+			vs = &v1.VirtualService{
+				Metadata: core.Metadata{Name: "vs", Namespace: "default"},
+				VirtualHost: &v1.VirtualHost{
+					Routes: []*v1.Route{{
+						Matchers: []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"}}},
+						Action: &v1.Route_DirectResponseAction{
+							DirectResponseAction: &gloov1.DirectResponseAction{Status: 200},
+						},
+					}},
+				},
+			}
+		})
+
+		convert := func() ([]*gloov1.Route, reporter.ResourceReports) {
+			reports := reporter.ResourceReports{}
+			rv := translator.NewRouteConverter(
+				translator.NewRouteTableSelector(nil),
+				translator.NewRouteTableIndexer(),
+				v1.RouteOptionList{rtOpts1, rtOpts2},
+			)
+			converted, err := rv.ConvertVirtualService(vs, reports)
+			Expect(err).NotTo(HaveOccurred())
+			return converted, reports
+		}
+
+		It("merges the options of the referenced route options in order", func() {
+			vs.VirtualHost.Routes[0].OptionsConfigRefs = &v1.DelegateOptionsRefs{
+				DelegateOptions: []*core.ResourceRef{
+					{Name: "opts-2", Namespace: "default"},
+					{Name: "opts-1", Namespace: "default"},
+				},
+			}
+
+			converted, reports := convert()
+			Expect(converted).To(HaveLen(1))
+			Expect(converted[0].Options.PrefixRewrite.GetValue()).To(Equal("/rewrite-2"))
+			Expect(converted[0].Options.Retries.GetNumRetries()).To(BeEquivalentTo(3))
+			Expect(converted[0].Options.GetHostRewrite()).To(Equal("example.com"))
+
+			_, vsReport := reports.Find("*v1.VirtualService", vs.Metadata.Ref())
+			Expect(vsReport.Warnings).To(ConsistOf(
+				translator.DelegateOptionIgnoredWarning("prefix_rewrite", "route option", rtOpts1.Metadata.Ref(), "route option default.opts-2").Error(),
+			))
+		})
+
+		It("prefers the options of the route and sorts the selected route options", func() {
+			vs.VirtualHost.Routes[0].Options = &gloov1.RouteOptions{
+				Retries: &retries.RetryPolicy{NumRetries: 1},
+			}
+			vs.VirtualHost.Routes[0].OptionsConfigRefs = &v1.DelegateOptionsRefs{
+				Selector: &v1.DelegateOptionsSelector{
+					Labels: map[string]string{"team": "a"},
+				},
+			}
+
+			converted, reports := convert()
+			Expect(converted[0].Options.PrefixRewrite.GetValue()).To(Equal("/rewrite-1"))
+			Expect(converted[0].Options.Retries.GetNumRetries()).To(BeEquivalentTo(1))
+			Expect(vs.VirtualHost.Routes[0].Options.PrefixRewrite).To(BeNil())
+
+			_, vsReport := reports.Find("*v1.VirtualService", vs.Metadata.Ref())
+			Expect(vsReport.Warnings).To(ConsistOf(
+				translator.DelegateOptionIgnoredWarning("prefix_rewrite", "route option", rtOpts2.Metadata.Ref(), "route option default.opts-1").Error(),
+				translator.DelegateOptionIgnoredWarning("retries", "route option", rtOpts2.Metadata.Ref(), "the route").Error(),
+			))
+		})
+
+		It("warns about missing route options", func() {
+			missing := core.ResourceRef{Name: "missing", Namespace: "default"}
+			vs.VirtualHost.Routes[0].OptionsConfigRefs = &v1.DelegateOptionsRefs{
+				DelegateOptions: []*core.ResourceRef{&missing},
+			}
+
+			converted, reports := convert()
+			Expect(converted).To(HaveLen(1))
+
+			_, vsReport := reports.Find("*v1.VirtualService", vs.Metadata.Ref())
+			Expect(vsReport.Warnings).To(ConsistOf(translator.DelegateOptionsNotFoundWarning("route option", missing).Error()))
 		})
 	})
 })
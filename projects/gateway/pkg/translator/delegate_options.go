@@ -0,0 +1,178 @@
+package translator
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	virtualHostOptionKind = "virtual host option"
+	routeOptionKind       = "route option"
+)
+
+var (
+	DelegateOptionsNotFoundWarning = func(kind string, ref core.ResourceRef) error {
+		return errors.Errorf("%s %s not found", kind, ref.Key())
+	}
+	DelegateOptionIgnoredWarning = func(option, kind string, ref core.ResourceRef, setBy string) error {
+		return errors.Errorf("option %s of %s %s is ignored, because it is already set by %s", option, kind, ref.Key(), setBy)
+	}
+)
+
+// mergeDelegatedVirtualHostOptions returns the options of the virtual host of the virtual service, merged with the
+// ones of the virtual host option resources it delegates to. The virtual service is not modified.
+func mergeDelegatedVirtualHostOptions(vs *v1.VirtualService, virtualHostOptions v1.VirtualHostOptionList) (*gloov1.VirtualHostOptions, []error) {
+	refs := vs.GetVirtualHost().GetOptionsConfigRefs()
+	if refs == nil {
+		return vs.GetVirtualHost().GetOptions(), nil
+	}
+	delegated, errs := selectDelegatedOptions(refs, vs.GetMetadata().Namespace, virtualHostOptions.AsInputResources(), virtualHostOptionKind)
+	options := &gloov1.VirtualHostOptions{}
+	if vs.GetVirtualHost().GetOptions() != nil {
+		options = proto.Clone(vs.GetVirtualHost().GetOptions()).(*gloov1.VirtualHostOptions)
+	}
+	errs = append(errs, mergeDelegatedOptions(options, "the virtual service", delegated, virtualHostOptionKind, func(resource resources.InputResource) proto.Message {
+		return resource.(*v1.VirtualHostOption).GetOptions()
+	})...)
+	return options, errs
+}
+
+// mergeDelegatedRouteOptions returns the options of the route, merged with the ones of the route option resources
+// it delegates to. The route is not modified.
+func mergeDelegatedRouteOptions(route *v1.Route, ownerNamespace string, routeOptions v1.RouteOptionList) (*gloov1.RouteOptions, []error) {
+	refs := route.GetOptionsConfigRefs()
+	if refs == nil {
+		return route.GetOptions(), nil
+	}
+	delegated, errs := selectDelegatedOptions(refs, ownerNamespace, routeOptions.AsInputResources(), routeOptionKind)
+	options := &gloov1.RouteOptions{}
+	if route.GetOptions() != nil {
+		options = proto.Clone(route.GetOptions()).(*gloov1.RouteOptions)
+	}
+	errs = append(errs, mergeDelegatedOptions(options, "the route", delegated, routeOptionKind, func(resource resources.InputResource) proto.Message {
+		return resource.(*v1.RouteOption).GetOptions()
+	})...)
+	return options, errs
+}
+
+// DelegatesToOption returns true when the refs of a virtual host or route, owned by a resource in the owner namespace,
+// reference or select the given virtual host option or route option resource.
+func DelegatesToOption(refs *v1.DelegateOptionsRefs, ownerNamespace string, option resources.InputResource) bool {
+	selected, _ := selectDelegatedOptions(refs, ownerNamespace, resources.InputResourceList{option}, "")
+	return len(selected) > 0
+}
+
+// selectDelegatedOptions returns the option resources that are referenced by the refs, followed by the ones that
+// match the selector, sorted by namespace and name. References to missing resources are returned as warnings.
+func selectDelegatedOptions(refs *v1.DelegateOptionsRefs, ownerNamespace string, optionResources resources.InputResourceList, kind string) (resources.InputResourceList, []error) {
+	var (
+		result resources.InputResourceList
+		errs   []error
+	)
+	selected := map[core.ResourceRef]bool{}
+
+	for _, ref := range refs.GetDelegateOptions() {
+		if ref == nil {
+			continue
+		}
+		resource, err := optionResources.Find(ref.Strings())
+		if err != nil {
+			errs = append(errs, DelegateOptionsNotFoundWarning(kind, *ref))
+			continue
+		}
+		if !selected[*ref] {
+			selected[*ref] = true
+			result = append(result, resource)
+		}
+	}
+
+	if selector := refs.GetSelector(); selector != nil {
+		var matched resources.InputResourceList
+		labelSelector := labels.SelectorFromSet(selector.GetLabels())
+		for _, resource := range optionResources {
+			ref := resource.GetMetadata().Ref()
+			if selected[ref] || !namespaceSelected(selector.GetNamespaces(), ownerNamespace, ref.Namespace) {
+				continue
+			}
+			if labelSelector.Matches(labels.Set(resource.GetMetadata().Labels)) {
+				matched = append(matched, resource)
+			}
+		}
+		sort.SliceStable(matched, func(i, j int) bool {
+			return matched[i].GetMetadata().Less(matched[j].GetMetadata())
+		})
+		result = append(result, matched...)
+	}
+
+	return result, errs
+}
+
+func namespaceSelected(namespaces []string, ownerNamespace, namespace string) bool {
+	if len(namespaces) == 0 {
+		return namespace == ownerNamespace
+	}
+	for _, ns := range namespaces {
+		if ns == allNamespaceRouteTableSelector || ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeDelegatedOptions sets the top level options of the delegated resources that are not set yet, in order.
+// The options of a resource that are already set by a previous one are ignored and returned as warnings.
+func mergeDelegatedOptions(options proto.Message, owner string, delegated resources.InputResourceList, kind string, getOptions func(resources.InputResource) proto.Message) []error {
+	var errs []error
+	dstValue := reflect.ValueOf(options).Elem()
+
+	setBy := map[string]string{}
+	for i := 0; i < dstValue.NumField(); i++ {
+		if name, ok := optionName(dstValue.Type().Field(i)); ok && !isEmptyValue(dstValue.Field(i)) {
+			setBy[name] = owner
+		}
+	}
+
+	for _, resource := range delegated {
+		src := getOptions(resource)
+		if src == nil || reflect.ValueOf(src).IsNil() {
+			continue
+		}
+		ref := resource.GetMetadata().Ref()
+		srcValue := reflect.ValueOf(proto.Clone(src)).Elem()
+		for i := 0; i < srcValue.NumField(); i++ {
+			name, ok := optionName(srcValue.Type().Field(i))
+			if !ok || isEmptyValue(srcValue.Field(i)) {
+				continue
+			}
+			if previous, set := setBy[name]; set {
+				errs = append(errs, DelegateOptionIgnoredWarning(name, kind, ref, previous))
+				continue
+			}
+			dstValue.Field(i).Set(srcValue.Field(i))
+			setBy[name] = kind + " " + ref.Key()
+		}
+	}
+	return errs
+}
+
+// optionName returns the proto name of the option of the field, or false for the internal fields of the message
+func optionName(field reflect.StructField) (string, bool) {
+	if oneof, ok := field.Tag.Lookup("protobuf_oneof"); ok {
+		return oneof, true
+	}
+	for _, part := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name="), true
+		}
+	}
+	return "", false
+}
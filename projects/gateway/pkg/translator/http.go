@@ -13,6 +13,7 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 )
 
@@ -41,7 +42,12 @@ var (
 	}
 )
 
-type HttpTranslator struct{}
+type HttpTranslator struct {
+	// the upstream of the HTTP-01 challenge solver. if set, the virtual services annotated for ACME are
+	// served by the SSL gateways with the issued certificates, and by the plain HTTP gateways with a
+	// route for the challenges
+	AcmeSolverUpstream *core.ResourceRef
+}
 
 func (t *HttpTranslator) GenerateListeners(ctx context.Context, snap *v1.ApiSnapshot, filteredGateways []*v1.Gateway, reports reporter.ResourceReports) []*gloov1.Listener {
 	if len(snap.VirtualServices) == 0 {
@@ -55,9 +61,9 @@ func (t *HttpTranslator) GenerateListeners(ctx context.Context, snap *v1.ApiSnap
 			continue
 		}
 
-		virtualServices := getVirtualServicesForGateway(gateway, snap.VirtualServices)
+		virtualServices := t.getVirtualServicesForGateway(gateway, snap.VirtualServices)
 		validateVirtualServiceDomains(gateway, virtualServices, reports)
-		listener := desiredListenerForHttp(gateway, virtualServices, snap.RouteTables, reports)
+		listener := t.desiredListenerForHttp(gateway, virtualServices, snap, reports)
 		result = append(result, listener)
 	}
 	return result
@@ -108,11 +114,14 @@ func validateVirtualServiceDomains(gateway *v1.Gateway, virtualServices v1.Virtu
 	}
 }
 
-func getVirtualServicesForGateway(gateway *v1.Gateway, virtualServices v1.VirtualServiceList) v1.VirtualServiceList {
+func (t *HttpTranslator) getVirtualServicesForGateway(gateway *v1.Gateway, virtualServices v1.VirtualServiceList) v1.VirtualServiceList {
 
 	var virtualServicesForGateway v1.VirtualServiceList
 	for _, vs := range virtualServices {
-		if GatewayContainsVirtualService(gateway, vs) {
+		// virtual services with ACME certificates, or covered by the certificates of the gateway, are served
+		// by both the plain HTTP and the SSL gateways
+		vsHasSsl := hasSsl(vs) || (gateway.Ssl && (t.isAcmeVirtualService(vs) || gatewaySslCoversVirtualService(gateway, vs)))
+		if gatewayContainsVirtualService(gateway, vs, vsHasSsl) {
 			virtualServicesForGateway = append(virtualServicesForGateway, vs)
 		}
 	}
@@ -121,28 +130,60 @@ func getVirtualServicesForGateway(gateway *v1.Gateway, virtualServices v1.Virtua
 }
 
 func GatewayContainsVirtualService(gateway *v1.Gateway, virtualService *v1.VirtualService) bool {
+	if !virtualServiceSelectsGateway(virtualService, gateway) {
+		return false
+	}
+	for _, matchedGateway := range gateway.GetHybridGateway().GetMatchedGateways() {
+		if httpGateway := matchedGateway.GetHttpGateway(); httpGateway != nil && httpGatewayContainsVirtualService(httpGateway, virtualService) {
+			return true
+		}
+	}
+	vsHasSsl := hasSsl(virtualService) || gatewaySslCoversVirtualService(gateway, virtualService)
+	return gatewayContainsVirtualService(gateway, virtualService, vsHasSsl)
+}
+
+func gatewayContainsVirtualService(gateway *v1.Gateway, virtualService *v1.VirtualService, hasSsl bool) bool {
 	httpGateway := gateway.GetHttpGateway()
 	if httpGateway == nil {
 		return false
 	}
 
-	if gateway.Ssl != hasSsl(virtualService) {
+	if gateway.Ssl != hasSsl {
+		return false
+	}
+
+	if !virtualServiceSelectsGateway(virtualService, gateway) {
 		return false
 	}
 
+	return httpGatewayContainsVirtualService(httpGateway, virtualService)
+}
+
+// virtualServiceSelectsGateway returns true if the gateway selector of the virtual service matches the labels of the
+// gateway. Virtual services without a gateway selector can be served by any gateway.
+func virtualServiceSelectsGateway(virtualService *v1.VirtualService, gateway *v1.Gateway) bool {
+	if len(virtualService.GetGatewaySelector()) == 0 {
+		return true
+	}
+	selector := labels.SelectorFromSet(virtualService.GetGatewaySelector())
+	return selector.Matches(labels.Set(gateway.GetMetadata().Labels))
+}
+
+// httpGatewayContainsVirtualService returns true if the http gateway selects the virtual service, regardless of ssl
+func httpGatewayContainsVirtualService(httpGateway *v1.HttpGateway, virtualService *v1.VirtualService) bool {
 	if len(httpGateway.VirtualServiceSelector) > 0 {
 		// select virtual services by the label selector
 		selector := labels.SelectorFromSet(httpGateway.VirtualServiceSelector)
 
 		vsLabels := labels.Set(virtualService.Metadata.Labels)
 
-		return virtualServiceNamespaceValidForGateway(gateway, virtualService) && selector.Matches(vsLabels)
+		return virtualServiceNamespaceValidForGateway(httpGateway, virtualService) && selector.Matches(vsLabels)
 	}
 	// use individual refs to collect virtual services
 	virtualServiceRefs := httpGateway.VirtualServices
 
 	if len(virtualServiceRefs) == 0 {
-		return virtualServiceNamespaceValidForGateway(gateway, virtualService)
+		return virtualServiceNamespaceValidForGateway(httpGateway, virtualService)
 	}
 
 	vsRef := virtualService.Metadata.Ref()
@@ -156,12 +197,7 @@ func GatewayContainsVirtualService(gateway *v1.Gateway, virtualService *v1.Virtu
 	return false
 }
 
-func virtualServiceNamespaceValidForGateway(gateway *v1.Gateway, virtualService *v1.VirtualService) bool {
-	httpGateway := gateway.GetHttpGateway()
-	if httpGateway == nil {
-		return false
-	}
-
+func virtualServiceNamespaceValidForGateway(httpGateway *v1.HttpGateway, virtualService *v1.VirtualService) bool {
 	if len(httpGateway.VirtualServiceNamespaces) > 0 {
 		for _, ns := range httpGateway.VirtualServiceNamespaces {
 			if ns == "*" || virtualService.Metadata.Namespace == ns {
@@ -179,7 +215,30 @@ func hasSsl(vs *v1.VirtualService) bool {
 	return vs.SslConfig != nil
 }
 
-func desiredListenerForHttp(gateway *v1.Gateway, virtualServicesForGateway v1.VirtualServiceList, tables v1.RouteTableList, reports reporter.ResourceReports) *gloov1.Listener {
+func (t *HttpTranslator) isAcmeVirtualService(vs *v1.VirtualService) bool {
+	return t.AcmeSolverUpstream != nil && IsAcmeVirtualService(vs) && ValidateAcmeDomains(vs) == nil
+}
+
+func (t *HttpTranslator) desiredListenerForHttp(gateway *v1.Gateway, virtualServicesForGateway v1.VirtualServiceList, snap *v1.ApiSnapshot, reports reporter.ResourceReports) *gloov1.Listener {
+	httpListener, sslConfigs := t.computeHttpListener(gateway.GetHttpGateway(), gateway.Ssl, virtualServicesForGateway, snap, reports)
+
+	listener := makeListener(gateway)
+	listener.ListenerType = &gloov1.Listener_HttpListener{
+		HttpListener: httpListener,
+	}
+	listener.SslConfigurations = append(sslConfigs, gatewaySslConfigs(gateway, sslConfigs, reports)...)
+
+	if err := appendSource(listener, gateway); err != nil {
+		// should never happen
+		reports.AddError(gateway, err)
+	}
+
+	return listener
+}
+
+// computeHttpListener returns the http listener for the virtual services of the http gateway, and the ssl configs
+// of the virtual services
+func (t *HttpTranslator) computeHttpListener(httpGateway *v1.HttpGateway, ssl bool, virtualServicesForGateway v1.VirtualServiceList, snap *v1.ApiSnapshot, reports reporter.ResourceReports) (*gloov1.HttpListener, []*gloov1.SslConfig) {
 	var (
 		virtualHosts []*gloov1.VirtualHost
 		sslConfigs   []*gloov1.SslConfig
@@ -189,51 +248,51 @@ func desiredListenerForHttp(gateway *v1.Gateway, virtualServicesForGateway v1.Vi
 		if virtualService.VirtualHost == nil {
 			virtualService.VirtualHost = &v1.VirtualHost{}
 		}
-		vh, err := virtualServiceToVirtualHost(virtualService, tables, reports)
+		vh, err := virtualServiceToVirtualHost(virtualService, snap, reports)
 		if err != nil {
 			reports.AddError(virtualService, err)
 			continue
 		}
+		if t.AcmeSolverUpstream != nil && IsAcmeVirtualService(virtualService) {
+			if err := ValidateAcmeDomains(virtualService); err != nil {
+				reports.AddWarning(virtualService, err.Error())
+			} else if ssl {
+				sslConfigs = append(sslConfigs, acmeSslConfig(virtualService))
+			} else {
+				// the challenge route comes first, so that it is not shadowed by the routes of the virtual service
+				vh.Routes = append([]*gloov1.Route{acmeChallengeRoute(*t.AcmeSolverUpstream)}, vh.Routes...)
+			}
+		}
 		virtualHosts = append(virtualHosts, vh)
 		if virtualService.SslConfig != nil {
 			sslConfigs = append(sslConfigs, virtualService.SslConfig)
 		}
 	}
 
-	var httpPlugins *gloov1.HttpListenerOptions
-	if httpGateway := gateway.GetHttpGateway(); httpGateway != nil {
-		httpPlugins = httpGateway.Options
-	}
-	listener := makeListener(gateway)
-	listener.ListenerType = &gloov1.Listener_HttpListener{
-		HttpListener: &gloov1.HttpListener{
-			VirtualHosts: virtualHosts,
-			Options:      httpPlugins,
-		},
-	}
-	listener.SslConfigurations = sslConfigs
-
-	if err := appendSource(listener, gateway); err != nil {
-		// should never happen
-		reports.AddError(gateway, err)
-	}
-
-	return listener
+	return &gloov1.HttpListener{
+		VirtualHosts: virtualHosts,
+		Options:      httpGateway.GetOptions(),
+	}, sslConfigs
 }
 
-func virtualServiceToVirtualHost(vs *v1.VirtualService, tables v1.RouteTableList, reports reporter.ResourceReports) (*gloov1.VirtualHost, error) {
-	converter := NewRouteConverter(NewRouteTableSelector(tables), NewRouteTableIndexer())
+func virtualServiceToVirtualHost(vs *v1.VirtualService, snap *v1.ApiSnapshot, reports reporter.ResourceReports) (*gloov1.VirtualHost, error) {
+	converter := NewRouteConverter(NewRouteTableSelector(snap.RouteTables), NewRouteTableIndexer(), snap.RouteOptions)
 	routes, err := converter.ConvertVirtualService(vs, reports)
 	if err != nil {
 		// internal error, should never happen
 		return nil, err
 	}
 
+	options, warnings := mergeDelegatedVirtualHostOptions(vs, snap.VirtualHostOptions)
+	for _, warning := range warnings {
+		reports.AddWarning(vs, warning.Error())
+	}
+
 	vh := &gloov1.VirtualHost{
 		Name:    VirtualHostName(vs),
 		Domains: vs.VirtualHost.Domains,
 		Routes:  routes,
-		Options: vs.VirtualHost.Options,
+		Options: options,
 	}
 
 	if err := appendSource(vh, vs); err != nil {
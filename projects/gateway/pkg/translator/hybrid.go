@@ -0,0 +1,81 @@
+package translator
+
+import (
+	"context"
+
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+)
+
+type HybridTranslator struct {
+	// translates the http gateways of the matched gateways
+	HttpTranslator *HttpTranslator
+}
+
+func (t *HybridTranslator) GenerateListeners(ctx context.Context, snap *v1.ApiSnapshot, filteredGateways []*v1.Gateway, reports reporter.ResourceReports) []*gloov1.Listener {
+	httpTranslator := t.HttpTranslator
+	if httpTranslator == nil {
+		httpTranslator = &HttpTranslator{}
+	}
+
+	var result []*gloov1.Listener
+	for _, gateway := range filteredGateways {
+		hybridGateway := gateway.GetHybridGateway()
+		if hybridGateway == nil {
+			continue
+		}
+
+		hybridListener := &gloov1.HybridListener{}
+		for _, matchedGateway := range hybridGateway.GetMatchedGateways() {
+			matchedListener := &gloov1.MatchedListener{
+				Matcher: matchedGateway.GetMatcher(),
+			}
+			switch gatewayType := matchedGateway.GetGatewayType().(type) {
+			case *v1.MatchedGateway_HttpGateway:
+				// the matcher terminates tls, so the ssl configs of the virtual services are ignored
+				virtualServices := getVirtualServicesForHttpGateway(gateway, gatewayType.HttpGateway, snap.VirtualServices)
+				validateVirtualServiceDomains(gateway, virtualServices, reports)
+				httpListener, _ := httpTranslator.computeHttpListener(gatewayType.HttpGateway, matchedGateway.GetMatcher().GetSslConfig() != nil, virtualServices, snap, reports)
+				matchedListener.ListenerType = &gloov1.MatchedListener_HttpListener{
+					HttpListener: httpListener,
+				}
+			case *v1.MatchedGateway_TcpGateway:
+				matchedListener.ListenerType = &gloov1.MatchedListener_TcpListener{
+					TcpListener: &gloov1.TcpListener{
+						Options:  gatewayType.TcpGateway.GetOptions(),
+						TcpHosts: gatewayType.TcpGateway.GetTcpHosts(),
+					},
+				}
+			default:
+				continue
+			}
+			hybridListener.MatchedListeners = append(hybridListener.MatchedListeners, matchedListener)
+		}
+
+		listener := makeListener(gateway)
+		listener.ListenerType = &gloov1.Listener_HybridListener{
+			HybridListener: hybridListener,
+		}
+
+		if err := appendSource(listener, gateway); err != nil {
+			// should never happen
+			reports.AddError(gateway, err)
+		}
+
+		result = append(result, listener)
+	}
+	return result
+}
+
+// getVirtualServicesForHttpGateway returns the virtual services selected by the http gateway of a matched gateway.
+// The gateway selectors of the virtual services match the labels of the hybrid gateway.
+func getVirtualServicesForHttpGateway(gateway *v1.Gateway, httpGateway *v1.HttpGateway, virtualServices v1.VirtualServiceList) v1.VirtualServiceList {
+	var virtualServicesForGateway v1.VirtualServiceList
+	for _, vs := range virtualServices {
+		if virtualServiceSelectsGateway(vs, gateway) && httpGatewayContainsVirtualService(httpGateway, vs) {
+			virtualServicesForGateway = append(virtualServicesForGateway, vs)
+		}
+	}
+	return virtualServicesForGateway
+}
@@ -1,8 +1,12 @@
 package translator
 
 import (
+	"time"
+
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"k8s.io/client-go/kubernetes"
 )
 
 type Opts struct {
@@ -12,12 +16,20 @@ type Opts struct {
 	Gateways                      factory.ResourceClientFactory
 	VirtualServices               factory.ResourceClientFactory
 	RouteTables                   factory.ResourceClientFactory
+	VirtualHostOptions            factory.ResourceClientFactory
+	RouteOptions                  factory.ResourceClientFactory
 	Proxies                       factory.ResourceClientFactory
 	WatchOpts                     clients.WatchOpts
 	ValidationServerAddress       string
 	DevMode                       bool
 	ReadGatewaysFromAllNamespaces bool
 	Validation                    *ValidationOpts
+	Secrets                       factory.ResourceClientFactory
+	Upstreams                     factory.ResourceClientFactory
+	UpstreamGroups                factory.ResourceClientFactory
+	Acme                          *AcmeOpts
+	NamespacedGateways            *NamespacedGatewaysOpts
+	KubeClient                    kubernetes.Interface
 }
 
 type ValidationOpts struct {
@@ -28,4 +40,20 @@ type ValidationOpts struct {
 	IgnoreProxyValidationFailure bool
 	AlwaysAcceptResources        bool
 	AllowWarnings                bool
+	StrictValidation             gloov1.GatewayOptions_ValidationOptions_StrictValidationMode
+}
+
+type AcmeOpts struct {
+	DirectoryUrl         string
+	Email                string
+	AcceptTermsOfService bool
+	RenewBefore          time.Duration
+	SolverPort           int
+}
+
+type NamespacedGatewaysOpts struct {
+	ProvisionDeployments bool
+	ProxyImage           string
+	XdsAddress           string
+	ServiceType          string
 }
@@ -9,7 +9,7 @@ import (
 type RouteTableIndexer interface {
 	// Indexes the given route tables by weight and returns them as a map.
 	// The map key set is also returned as a sorted array so the client can range over the map in the desired order.
-	// The error slice contain warning about route tables with duplicated weights.
+	// Route tables with the same weight are returned in the given order, and their routes are sorted by the caller.
 	IndexByWeight(routeTables v1.RouteTableList) (map[int32]v1.RouteTableList, []int32)
 }
 
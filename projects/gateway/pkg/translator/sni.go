@@ -0,0 +1,142 @@
+package translator
+
+import (
+	"net"
+	"strings"
+
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+)
+
+var (
+	InvalidSniDomainErr = func(domain string) error {
+		return errors.Errorf("invalid SNI domain %q: wildcards are only supported as the first label, e.g. *.example.com", domain)
+	}
+	SniDomainClaimedErr = func(domain string) error {
+		return errors.Errorf("SNI domain %q of the gateway ssl configurations is already served by another certificate and is ignored", domain)
+	}
+	DuplicateDefaultCertificateErr = errors.New("a gateway ssl configuration without SNI domains is ignored, " +
+		"because the gateway already has a default certificate")
+	SslConfigurationsWithoutSslErr = errors.New("the gateway ssl configurations are ignored because the gateway does not have ssl enabled")
+)
+
+// gatewaySslCoversVirtualService returns true if the ssl configurations of the gateway serve all the domains of
+// the virtual service. Virtual services without domains match all hosts, so they need a default certificate.
+func gatewaySslCoversVirtualService(gateway *v1.Gateway, vs *v1.VirtualService) bool {
+	sslConfigs := gateway.GetHttpGateway().GetSslConfigurations()
+	if !gateway.Ssl || len(sslConfigs) == 0 {
+		return false
+	}
+	domains := vs.GetVirtualHost().GetDomains()
+	if len(domains) == 0 {
+		domains = []string{"*"}
+	}
+	for _, domain := range domains {
+		if !sslConfigsCoverDomain(sslConfigs, domain) {
+			return false
+		}
+	}
+	return true
+}
+
+func sslConfigsCoverDomain(sslConfigs []*gloov1.SslConfig, domain string) bool {
+	// the port of the host header is not part of the SNI
+	if host, _, err := net.SplitHostPort(domain); err == nil {
+		domain = host
+	}
+	for _, sslConfig := range sslConfigs {
+		if len(sslConfig.SniDomains) == 0 {
+			return true
+		}
+		for _, sniDomain := range sslConfig.SniDomains {
+			if validSniDomain(sniDomain) && SniDomainMatches(sniDomain, domain) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SniDomainMatches returns true if clients requesting the domain are served by the SNI domain. Like envoy,
+// a wildcard SNI domain matches subdomains at any depth, but not its own suffix: `*.example.com` matches
+// `a.example.com` and `a.b.example.com`, but not `example.com`.
+func SniDomainMatches(sniDomain, domain string) bool {
+	sniDomain = strings.ToLower(sniDomain)
+	domain = strings.ToLower(domain)
+	if sniDomain == domain {
+		return true
+	}
+	if !strings.HasPrefix(sniDomain, "*.") {
+		return false
+	}
+	return strings.HasSuffix(domain, sniDomain[1:]) && len(domain) > len(sniDomain)-1
+}
+
+func validSniDomain(domain string) bool {
+	if domain == "" {
+		return false
+	}
+	return !strings.Contains(strings.TrimPrefix(domain, "*."), "*")
+}
+
+// gatewaySslConfigs returns the ssl configurations of the gateway to add to the listener after the ones of the
+// virtual services. Envoy rejects listeners whose filter chains match the same SNI domain, so the domains
+// that are already claimed by a previous config are dropped, and so are the configs that are left without any.
+// Invalid and dropped domains are reported as warnings on the gateway.
+func gatewaySslConfigs(gateway *v1.Gateway, virtualServiceSslConfigs []*gloov1.SslConfig, reports reporter.ResourceReports) []*gloov1.SslConfig {
+	sslConfigs := gateway.GetHttpGateway().GetSslConfigurations()
+	if len(sslConfigs) == 0 {
+		return nil
+	}
+	if !gateway.Ssl {
+		reports.AddWarning(gateway, SslConfigurationsWithoutSslErr.Error())
+		return nil
+	}
+
+	claimed := map[string]bool{}
+	hasDefault := false
+	for _, sslConfig := range virtualServiceSslConfigs {
+		if len(sslConfig.SniDomains) == 0 {
+			hasDefault = true
+		}
+		for _, domain := range sslConfig.SniDomains {
+			claimed[strings.ToLower(domain)] = true
+		}
+	}
+
+	var result []*gloov1.SslConfig
+	for _, sslConfig := range sslConfigs {
+		if len(sslConfig.SniDomains) == 0 {
+			if hasDefault {
+				reports.AddWarning(gateway, DuplicateDefaultCertificateErr.Error())
+				continue
+			}
+			hasDefault = true
+			result = append(result, sslConfig)
+			continue
+		}
+
+		var sniDomains []string
+		for _, domain := range sslConfig.SniDomains {
+			if !validSniDomain(domain) {
+				reports.AddWarning(gateway, InvalidSniDomainErr(domain).Error())
+				continue
+			}
+			if claimed[strings.ToLower(domain)] {
+				reports.AddWarning(gateway, SniDomainClaimedErr(domain).Error())
+				continue
+			}
+			claimed[strings.ToLower(domain)] = true
+			sniDomains = append(sniDomains, domain)
+		}
+		if len(sniDomains) == 0 {
+			continue
+		}
+		sslConfigCopy := *sslConfig
+		sslConfigCopy.SniDomains = sniDomains
+		result = append(result, &sslConfigCopy)
+	}
+	return result
+}
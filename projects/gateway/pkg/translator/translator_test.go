@@ -2,6 +2,7 @@ package translator_test
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/solo-io/go-utils/testutils"
@@ -10,6 +11,8 @@ import (
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/waf"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/als"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/cors"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 
 	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
@@ -20,6 +23,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/solo-io/gloo/projects/gateway/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tcp"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/udp"
 
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -505,6 +509,45 @@ var _ = Describe("Translator", func() {
 
 			})
 
+			Context("with GatewaySelector", func() {
+				It("should only serve a virtual service on the gateways it selects", func() {
+					snap.Gateways[0].Metadata.Labels = map[string]string{"exposure": "internal"}
+					snap.VirtualServices[0].GatewaySelector = map[string]string{"exposure": "internal"}
+					snap.VirtualServices[1].GatewaySelector = map[string]string{"exposure": "external"}
+
+					proxy, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+
+					Expect(errs.ValidateStrict()).NotTo(HaveOccurred())
+					Expect(proxy.Listeners).To(HaveLen(1))
+					listener := proxy.Listeners[0].ListenerType.(*gloov1.Listener_HttpListener).HttpListener
+					Expect(listener.VirtualHosts).To(HaveLen(2))
+					Expect(listener.VirtualHosts[0].Name).To(ContainSubstring("name1"))
+					Expect(listener.VirtualHosts[1].Name).To(ContainSubstring("name3"))
+
+					Expect(GatewayContainsVirtualService(snap.Gateways[0], snap.VirtualServices[0])).To(BeTrue())
+					Expect(GatewayContainsVirtualService(snap.Gateways[0], snap.VirtualServices[1])).To(BeFalse())
+				})
+
+				It("should still require the gateway to select the virtual service", func() {
+					snap.Gateways[0].Metadata.Labels = map[string]string{"exposure": "internal"}
+					snap.Gateways[0].GatewayType = &v1.Gateway_HttpGateway{
+						HttpGateway: &v1.HttpGateway{
+							VirtualServiceSelector: labelSet,
+						},
+					}
+					snap.VirtualServices[1].GatewaySelector = map[string]string{"exposure": "internal"}
+
+					proxy, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+
+					Expect(errs.ValidateStrict()).NotTo(HaveOccurred())
+					Expect(proxy.Listeners).To(HaveLen(1))
+					listener := proxy.Listeners[0].ListenerType.(*gloov1.Listener_HttpListener).HttpListener
+					Expect(listener.VirtualHosts).To(HaveLen(2))
+					Expect(listener.VirtualHosts[0].Name).To(ContainSubstring("name1"))
+					Expect(listener.VirtualHosts[1].Name).To(ContainSubstring("name3"))
+				})
+			})
+
 			It("should not have vhosts with ssl", func() {
 				snap.VirtualServices[0].SslConfig = new(gloov1.SslConfig)
 
@@ -593,6 +636,229 @@ var _ = Describe("Translator", func() {
 					Expect(errs.Error()).To(ContainSubstring(NoVirtualHostErr(snap.VirtualServices[0]).Error()))
 				})
 			})
+
+			Context("gateway ssl configurations", func() {
+				var (
+					wildcardCert, exactCert *gloov1.SslConfig
+				)
+
+				sslConfigWithSecret := func(name string, sniDomains ...string) *gloov1.SslConfig {
+					return &gloov1.SslConfig{
+						SslSecrets: &gloov1.SslConfig_SecretRef{
+							SecretRef: &core.ResourceRef{Name: name, Namespace: ns},
+						},
+						SniDomains: sniDomains,
+					}
+				}
+
+				BeforeEach(func() {
+					snap.Gateways[0].Ssl = true
+					wildcardCert = sslConfigWithSecret("wildcard", "*.com")
+					exactCert = sslConfigWithSecret("exact", "d1.com")
+					snap.VirtualServices[1].VirtualHost.Domains = []string{"d2.com", "www.d2.com:443"}
+				})
+
+				It("should serve the virtual services whose domains are covered by the gateway certificates", func() {
+					snap.VirtualServices[2].VirtualHost.Domains = []string{"d3.org"}
+					snap.Gateways[0].GetHttpGateway().SslConfigurations = []*gloov1.SslConfig{exactCert, wildcardCert}
+
+					proxy, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(errs.ValidateStrict()).NotTo(HaveOccurred())
+
+					Expect(proxy.Listeners).To(HaveLen(1))
+					listener := proxy.Listeners[0]
+					Expect(listener.SslConfigurations).To(Equal([]*gloov1.SslConfig{exactCert, wildcardCert}))
+					httpListener := listener.ListenerType.(*gloov1.Listener_HttpListener).HttpListener
+					Expect(httpListener.VirtualHosts).To(HaveLen(2))
+					Expect(httpListener.VirtualHosts[0].Name).To(ContainSubstring("name1"))
+					Expect(httpListener.VirtualHosts[1].Name).To(ContainSubstring("name2"))
+				})
+
+				It("should serve virtual services without domains with the default certificate", func() {
+					snap.VirtualServices[2].VirtualHost.Domains = nil
+					snap.Gateways[0].GetHttpGateway().SslConfigurations = []*gloov1.SslConfig{exactCert}
+
+					proxy, _ := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					httpListener := proxy.Listeners[0].ListenerType.(*gloov1.Listener_HttpListener).HttpListener
+					Expect(httpListener.VirtualHosts).To(HaveLen(1))
+
+					snap.Gateways[0].GetHttpGateway().SslConfigurations = []*gloov1.SslConfig{exactCert, sslConfigWithSecret("default")}
+					proxy, _ = translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					httpListener = proxy.Listeners[0].ListenerType.(*gloov1.Listener_HttpListener).HttpListener
+					Expect(httpListener.VirtualHosts).To(HaveLen(len(snap.VirtualServices)))
+				})
+
+				It("should drop the SNI domains already claimed by previous certificates", func() {
+					snap.VirtualServices[0].SslConfig = sslConfigWithSecret("vs", "d1.com")
+					snap.Gateways[0].GetHttpGateway().SslConfigurations = []*gloov1.SslConfig{
+						sslConfigWithSecret("other", "d1.com", "*.com"),
+						sslConfigWithSecret("shadowed", "*.com"),
+						sslConfigWithSecret("invalid", "d*.com"),
+					}
+
+					proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(reports.Validate()).NotTo(HaveOccurred())
+
+					listener := proxy.Listeners[0]
+					Expect(listener.SslConfigurations).To(Equal([]*gloov1.SslConfig{
+						sslConfigWithSecret("vs", "d1.com"),
+						sslConfigWithSecret("other", "*.com"),
+					}))
+
+					errs := reports.ValidateStrict()
+					Expect(errs).To(HaveOccurred())
+					Expect(errs.Error()).To(ContainSubstring(SniDomainClaimedErr("d1.com").Error()))
+					Expect(errs.Error()).To(ContainSubstring(SniDomainClaimedErr("*.com").Error()))
+					Expect(errs.Error()).To(ContainSubstring(InvalidSniDomainErr("d*.com").Error()))
+				})
+
+				It("should warn when the gateway does not have ssl enabled", func() {
+					snap.Gateways[0].Ssl = false
+					snap.Gateways[0].GetHttpGateway().SslConfigurations = []*gloov1.SslConfig{wildcardCert}
+
+					proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(proxy.Listeners[0].SslConfigurations).To(BeEmpty())
+					errs := reports.ValidateStrict()
+					Expect(errs).To(HaveOccurred())
+					Expect(errs.Error()).To(ContainSubstring(SslConfigurationsWithoutSslErr.Error()))
+				})
+
+				It("should match wildcard SNI domains like envoy", func() {
+					Expect(SniDomainMatches("*.example.com", "a.example.com")).To(BeTrue())
+					Expect(SniDomainMatches("*.example.com", "a.b.EXAMPLE.com")).To(BeTrue())
+					Expect(SniDomainMatches("*.example.com", "*.example.com")).To(BeTrue())
+					Expect(SniDomainMatches("*.example.com", "example.com")).To(BeFalse())
+					Expect(SniDomainMatches("*.example.com", "aexample.com")).To(BeFalse())
+					Expect(SniDomainMatches("a.example.com", "b.example.com")).To(BeFalse())
+				})
+			})
+
+			Context("acme", func() {
+				var solverUpstream core.ResourceRef
+
+				BeforeEach(func() {
+					solverUpstream = core.ResourceRef{Name: AcmeSolverUpstreamName, Namespace: ns}
+					factory.AcmeSolverUpstream = &solverUpstream
+					snap.VirtualServices[0].Metadata.Annotations = map[string]string{AcmeAnnotation: "true"}
+				})
+
+				It("should route the http-01 challenges of the plain http gateway to the solver", func() {
+					proxy, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(errs.ValidateStrict()).NotTo(HaveOccurred())
+
+					listener := proxy.Listeners[0].ListenerType.(*gloov1.Listener_HttpListener).HttpListener
+					Expect(listener.VirtualHosts).To(HaveLen(len(snap.VirtualServices)))
+					for _, vh := range listener.VirtualHosts {
+						if !strings.Contains(vh.Name, "name1") {
+							Expect(vh.Routes).To(HaveLen(1))
+							continue
+						}
+						Expect(vh.Routes).To(HaveLen(2))
+						Expect(vh.Routes[0].Matchers[0].GetPrefix()).To(Equal(AcmeChallengePathPrefix))
+						Expect(vh.Routes[0].GetRouteAction().GetSingle().GetUpstream()).To(Equal(&solverUpstream))
+					}
+				})
+
+				It("should include the virtual service in the ssl gateway with the acme secret", func() {
+					snap.Gateways[0].Ssl = true
+
+					proxy, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(errs.ValidateStrict()).NotTo(HaveOccurred())
+
+					listener := proxy.Listeners[0]
+					Expect(listener.SslConfigurations).To(HaveLen(1))
+					Expect(listener.SslConfigurations[0].GetSecretRef()).To(Equal(&core.ResourceRef{Name: "name1-acme-tls", Namespace: ns}))
+					Expect(listener.SslConfigurations[0].SniDomains).To(Equal([]string{"d1.com"}))
+
+					httpListener := listener.ListenerType.(*gloov1.Listener_HttpListener).HttpListener
+					Expect(httpListener.VirtualHosts).To(HaveLen(1))
+					Expect(httpListener.VirtualHosts[0].Name).To(ContainSubstring("name1"))
+					Expect(httpListener.VirtualHosts[0].Routes).To(HaveLen(1))
+				})
+
+				It("should ignore virtual services with their own ssl config", func() {
+					snap.VirtualServices[0].SslConfig = new(gloov1.SslConfig)
+
+					proxy, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(errs.ValidateStrict()).NotTo(HaveOccurred())
+
+					listener := proxy.Listeners[0].ListenerType.(*gloov1.Listener_HttpListener).HttpListener
+					Expect(listener.VirtualHosts).To(HaveLen(len(snap.VirtualServices) - 1))
+				})
+
+				It("should warn when a domain cannot be validated with http-01 challenges", func() {
+					snap.VirtualServices[0].VirtualHost.Domains = []string{"*.d1.com"}
+
+					_, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(reports.Validate()).NotTo(HaveOccurred())
+
+					errs := reports.ValidateStrict()
+					Expect(errs).To(HaveOccurred())
+					Expect(errs.Error()).To(ContainSubstring(AcmeWildcardDomainErr(snap.VirtualServices[0], "*.d1.com").Error()))
+				})
+			})
+
+			Context("with virtual host options", func() {
+
+				var vhOpts *v1.VirtualHostOption
+
+				BeforeEach(func() {
+					vhOpts = &v1.VirtualHostOption{
+						Metadata: core.Metadata{Namespace: ns, Name: "vh-opts", Labels: map[string]string{"opts": "shared"}},
+						Options: &gloov1.VirtualHostOptions{
+							Retries: &retries.RetryPolicy{NumRetries: 3},
+							Cors:    &cors.CorsPolicy{AllowOrigin: []string{"shared.com"}},
+						},
+					}
+					snap.VirtualHostOptions = v1.VirtualHostOptionList{vhOpts}
+				})
+
+				It("merges the options of the selected virtual host options into the virtual host", func() {
+					snap.VirtualServices[0].VirtualHost.Options = &gloov1.VirtualHostOptions{
+						Cors: &cors.CorsPolicy{AllowOrigin: []string{"d1.com"}},
+					}
+					snap.VirtualServices[0].VirtualHost.OptionsConfigRefs = &v1.DelegateOptionsRefs{
+						Selector: &v1.DelegateOptionsSelector{
+							Labels: map[string]string{"opts": "shared"},
+						},
+					}
+
+					proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(reports.Validate()).NotTo(HaveOccurred())
+
+					listener := proxy.Listeners[0].ListenerType.(*gloov1.Listener_HttpListener).HttpListener
+					vh := listener.VirtualHosts[0]
+					Expect(vh.Options.Retries.GetNumRetries()).To(BeEquivalentTo(3))
+					Expect(vh.Options.Cors.AllowOrigin).To(Equal([]string{"d1.com"}))
+					Expect(snap.VirtualServices[0].VirtualHost.Options.Retries).To(BeNil())
+
+					_, vsReport := reports.Find("*v1.VirtualService", snap.VirtualServices[0].Metadata.Ref())
+					Expect(vsReport.Warnings).To(ConsistOf(
+						DelegateOptionIgnoredWarning("cors", "virtual host option", vhOpts.Metadata.Ref(), "the virtual service").Error(),
+					))
+					_, vhOptsReport := reports.Find("*v1.VirtualHostOption", vhOpts.Metadata.Ref())
+					Expect(vhOptsReport.Errors).NotTo(HaveOccurred())
+				})
+
+				It("does not select virtual host options in other namespaces by default", func() {
+					vs := snap.VirtualServices[2]
+					vs.VirtualHost.OptionsConfigRefs = &v1.DelegateOptionsRefs{
+						Selector: &v1.DelegateOptionsSelector{
+							Labels: map[string]string{"opts": "shared"},
+						},
+					}
+
+					proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+
+					listener := proxy.Listeners[0].ListenerType.(*gloov1.Listener_HttpListener).HttpListener
+					for _, vh := range listener.VirtualHosts {
+						if vh.Name == VirtualHostName(vs) {
+							Expect(vh.Options.GetRetries()).To(BeNil())
+						}
+					}
+				})
+			})
 		})
 
 		Context("using RouteTables and delegation", func() {
@@ -1118,6 +1384,182 @@ var _ = Describe("Translator", func() {
 
 	})
 
+	Context("hybrid", func() {
+		var (
+			factory    *HybridTranslator
+			tcpHost    *gloov1.TcpHost
+			sslConfig  *gloov1.SslConfig
+			tlsMatcher *gloov1.Matcher
+		)
+		BeforeEach(func() {
+			factory = &HybridTranslator{HttpTranslator: &HttpTranslator{}}
+			translator = NewTranslator([]ListenerFactory{factory}, Opts{})
+
+			tcpHost = &gloov1.TcpHost{
+				Name: "host-one",
+				Destination: &gloov1.TcpHost_TcpAction{
+					Destination: &gloov1.TcpHost_TcpAction_UpstreamGroup{
+						UpstreamGroup: &core.ResourceRef{
+							Namespace: ns,
+							Name:      "ug-name",
+						},
+					},
+				},
+			}
+			sslConfig = &gloov1.SslConfig{
+				SslSecrets: &gloov1.SslConfig_SecretRef{
+					SecretRef: &core.ResourceRef{Namespace: ns, Name: "gateway-cert"},
+				},
+			}
+			tlsMatcher = &gloov1.Matcher{SslConfig: sslConfig}
+
+			snap = &v1.ApiSnapshot{
+				Gateways: v1.GatewayList{
+					{
+						Metadata: core.Metadata{Namespace: ns, Name: "name"},
+						GatewayType: &v1.Gateway_HybridGateway{
+							HybridGateway: &v1.HybridGateway{
+								MatchedGateways: []*v1.MatchedGateway{
+									{
+										Matcher: tlsMatcher,
+										GatewayType: &v1.MatchedGateway_HttpGateway{
+											HttpGateway: &v1.HttpGateway{},
+										},
+									},
+									{
+										Matcher: &gloov1.Matcher{},
+										GatewayType: &v1.MatchedGateway_TcpGateway{
+											TcpGateway: &v1.TcpGateway{
+												TcpHosts: []*gloov1.TcpHost{tcpHost},
+											},
+										},
+									},
+								},
+							},
+						},
+						BindPort: 2,
+					},
+				},
+				VirtualServices: v1.VirtualServiceList{
+					{
+						Metadata: core.Metadata{Namespace: ns, Name: "name1"},
+						VirtualHost: &v1.VirtualHost{
+							Domains: []string{"d1.com"},
+						},
+					},
+					{
+						Metadata: core.Metadata{Namespace: ns, Name: "name2"},
+						VirtualHost: &v1.VirtualHost{
+							Domains: []string{"d2.com"},
+						},
+						SslConfig: &gloov1.SslConfig{
+							SslSecrets: &gloov1.SslConfig_SecretRef{
+								SecretRef: &core.ResourceRef{Namespace: ns, Name: "vs-cert"},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("translates the matched gateways to matched listeners", func() {
+			proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+			Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+
+			Expect(proxy.Listeners).To(HaveLen(1))
+			Expect(proxy.Listeners[0].BindPort).To(Equal(uint32(2)))
+			Expect(proxy.Listeners[0].SslConfigurations).To(BeEmpty())
+			matchedListeners := proxy.Listeners[0].GetHybridListener().GetMatchedListeners()
+			Expect(matchedListeners).To(HaveLen(2))
+
+			Expect(matchedListeners[0].Matcher).To(Equal(tlsMatcher))
+			// the virtual services are selected regardless of their ssl config
+			Expect(matchedListeners[0].GetHttpListener().GetVirtualHosts()).To(HaveLen(2))
+
+			Expect(matchedListeners[1].GetTcpListener().GetTcpHosts()).To(ConsistOf(tcpHost))
+		})
+
+		It("reports invalid virtual service refs of the matched http gateways", func() {
+			httpGateway := snap.Gateways[0].GetHybridGateway().MatchedGateways[0].GetHttpGateway()
+			httpGateway.VirtualServices = []core.ResourceRef{{Namespace: ns, Name: "missing"}}
+
+			_, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+			Expect(reports.ValidateStrict()).To(HaveOccurred())
+			Expect(reports.ValidateStrict().Error()).To(ContainSubstring("invalid virtual service ref"))
+		})
+
+		It("considers the virtual services of the matched http gateways part of the gateway", func() {
+			Expect(GatewayContainsVirtualService(snap.Gateways[0], snap.VirtualServices[0])).To(BeTrue())
+			Expect(GatewayContainsVirtualService(snap.Gateways[0], snap.VirtualServices[1])).To(BeTrue())
+		})
+
+		It("only selects the virtual services whose gateway selector matches the hybrid gateway", func() {
+			snap.VirtualServices[0].GatewaySelector = map[string]string{"exposure": "external"}
+
+			proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+			Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+
+			matchedListeners := proxy.Listeners[0].GetHybridListener().GetMatchedListeners()
+			Expect(matchedListeners[0].GetHttpListener().GetVirtualHosts()).To(HaveLen(1))
+			Expect(GatewayContainsVirtualService(snap.Gateways[0], snap.VirtualServices[0])).To(BeFalse())
+		})
+
+	})
+
+	Context("udp", func() {
+		var (
+			factory     *UdpTranslator
+			destination *gloov1.Destination
+			options     *gloov1.UdpListenerOptions
+		)
+		BeforeEach(func() {
+			factory = &UdpTranslator{}
+			translator = NewTranslator([]ListenerFactory{factory}, Opts{})
+
+			sessionTimeout := 10 * time.Second
+			options = &gloov1.UdpListenerOptions{
+				UdpProxySettings: &udp.UdpProxySettings{
+					SessionTimeout: &sessionTimeout,
+				},
+			}
+			destination = &gloov1.Destination{
+				DestinationType: &gloov1.Destination_Upstream{
+					Upstream: &core.ResourceRef{
+						Namespace: ns,
+						Name:      "dns",
+					},
+				},
+			}
+
+			snap = &v1.ApiSnapshot{
+				Gateways: v1.GatewayList{
+					{
+						Metadata: core.Metadata{Namespace: ns, Name: "name"},
+						GatewayType: &v1.Gateway_UdpGateway{
+							UdpGateway: &v1.UdpGateway{
+								Destination: destination,
+								Options:     options,
+							},
+						},
+						BindPort: 53,
+					},
+				},
+			}
+		})
+
+		It("can properly translate a udp proxy", func() {
+			proxy, _ := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+
+			Expect(proxy.Listeners).To(HaveLen(1))
+			Expect(proxy.Listeners[0].BindPort).To(Equal(uint32(53)))
+			listener := proxy.Listeners[0].GetUdpListener()
+			Expect(listener).NotTo(BeNil())
+			Expect(listener.Destination).To(Equal(destination))
+			Expect(listener.Options).To(Equal(options))
+		})
+
+	})
+
 })
 
 var expectedRouteMetadatas = [][]*SourceMetadata{
@@ -0,0 +1,36 @@
+package translator
+
+import (
+	"context"
+
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+)
+
+type UdpTranslator struct{}
+
+func (t *UdpTranslator) GenerateListeners(ctx context.Context, snap *v1.ApiSnapshot, filteredGateways []*v1.Gateway, reports reporter.ResourceReports) []*gloov1.Listener {
+	var result []*gloov1.Listener
+	for _, gateway := range filteredGateways {
+		udpGateway := gateway.GetUdpGateway()
+		if udpGateway == nil {
+			continue
+		}
+		listener := makeListener(gateway)
+
+		if err := appendSource(listener, gateway); err != nil {
+			// should never happen
+			reports.AddError(gateway, err)
+		}
+
+		listener.ListenerType = &gloov1.Listener_UdpListener{
+			UdpListener: &gloov1.UdpListener{
+				Destination: udpGateway.GetDestination(),
+				Options:     udpGateway.GetOptions(),
+			},
+		}
+		result = append(result, listener)
+	}
+	return result
+}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 )
@@ -24,3 +25,32 @@ func GetProxyNamesForGateway(gw *v1.Gateway) []string {
 	}
 	return proxyNames
 }
+
+// GatewaysByProxy assigns each gateway to the proxies it is translated to. The proxies are in the write namespace,
+// unless namespacedGateways is true: then the gateways outside the write namespace are translated to proxies in
+// their own namespace.
+func GatewaysByProxy(gateways v1.GatewayList, writeNamespace string, namespacedGateways bool) map[core.ResourceRef]v1.GatewayList {
+	result := make(map[core.ResourceRef]v1.GatewayList)
+	for _, gw := range gateways {
+		for _, ref := range GetProxyRefsForGateway(gw, writeNamespace, namespacedGateways) {
+			result[ref] = append(result[ref], gw)
+		}
+	}
+	return result
+}
+
+func GetProxyRefsForGateway(gw *v1.Gateway, writeNamespace string, namespacedGateways bool) []core.ResourceRef {
+	namespace := ProxyNamespaceForGateway(gw, writeNamespace, namespacedGateways)
+	var refs []core.ResourceRef
+	for _, name := range GetProxyNamesForGateway(gw) {
+		refs = append(refs, core.ResourceRef{Namespace: namespace, Name: name})
+	}
+	return refs
+}
+
+func ProxyNamespaceForGateway(gw *v1.Gateway, writeNamespace string, namespacedGateways bool) string {
+	if namespacedGateways && gw.GetMetadata().Namespace != writeNamespace {
+		return gw.GetMetadata().Namespace
+	}
+	return writeNamespace
+}
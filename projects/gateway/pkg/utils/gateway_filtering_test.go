@@ -30,4 +30,37 @@ var _ = Describe("gateway util unit tests", func() {
 			}))
 		})
 	})
+
+	Describe("GatewaysByProxy", func() {
+		var gws v1.GatewayList
+
+		BeforeEach(func() {
+			gws = v1.GatewayList{
+				{Metadata: core.Metadata{Name: "gw1", Namespace: "gloo-system"}},
+				{Metadata: core.Metadata{Name: "gw2", Namespace: "team-a"}, ProxyNames: []string{"proxy1"}},
+				{Metadata: core.Metadata{Name: "gw3", Namespace: "team-a"}},
+			}
+		})
+
+		It("assigns the gateways to the proxies of the write namespace", func() {
+			gw1, gw2, gw3 := gws[0], gws[1], gws[2]
+
+			byProxy := GatewaysByProxy(gws, "gloo-system", false)
+			Expect(byProxy).To(Equal(map[core.ResourceRef]v1.GatewayList{
+				{Namespace: "gloo-system", Name: defaults.GatewayProxyName}: {gw1, gw3},
+				{Namespace: "gloo-system", Name: "proxy1"}:                  {gw2},
+			}))
+		})
+
+		It("assigns the gateways outside the write namespace to proxies of their namespace with namespaced gateways", func() {
+			gw1, gw2, gw3 := gws[0], gws[1], gws[2]
+
+			byProxy := GatewaysByProxy(gws, "gloo-system", true)
+			Expect(byProxy).To(Equal(map[core.ResourceRef]v1.GatewayList{
+				{Namespace: "gloo-system", Name: defaults.GatewayProxyName}: {gw1},
+				{Namespace: "team-a", Name: defaults.GatewayProxyName}:      {gw3},
+				{Namespace: "team-a", Name: "proxy1"}:                       {gw2},
+			}))
+		})
+	})
 })
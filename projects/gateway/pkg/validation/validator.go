@@ -3,6 +3,7 @@ package validation
 import (
 	"context"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 
 	"github.com/avast/retry-go"
 	"github.com/solo-io/go-utils/protoutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 
 	"go.uber.org/multierr"
@@ -43,6 +45,21 @@ var (
 	VirtualServiceDeleteErr = func(parentGateways []core.ResourceRef) error {
 		return errors.Errorf("Deletion blocked because active Gateways reference this Virtual Service. Remove refs to this virtual service from the gateways: %v, then try again", parentGateways)
 	}
+	UpstreamDeleteErr = func(parentGateways, parentVirtualServices, parentRouteTables, parentUpstreamGroups []core.ResourceRef) error {
+		return errors.Errorf("Deletion blocked because active Routes send traffic to this Upstream. Remove the destinations to this upstream from the gateways: %v, the virtual services: %v, the route tables: %v and the upstream groups: %v, then try again", parentGateways, parentVirtualServices, parentRouteTables, parentUpstreamGroups)
+	}
+	SecretDeleteErr = func(parentGateways, parentVirtualServices []core.ResourceRef) error {
+		return errors.Errorf("Deletion blocked because active Gateways or Virtual Services serve TLS with this Secret. Remove the ssl configs referencing this secret from the gateways: %v and the virtual services: %v, then try again", parentGateways, parentVirtualServices)
+	}
+	VirtualHostOptionDeleteErr = func(parentVirtualServices []core.ResourceRef) error {
+		return errors.Errorf("Deletion blocked because active Virtual Hosts delegate their options to this Virtual Host Option. Remove the references to this virtual host option from the virtual services: %v, then try again", parentVirtualServices)
+	}
+	RouteOptionDeleteErr = func(parentVirtualServices, parentRouteTables []core.ResourceRef) error {
+		return errors.Errorf("Deletion blocked because active Routes delegate their options to this Route Option. Remove the references to this route option from the virtual services: %v and the route tables: %v, then try again", parentVirtualServices, parentRouteTables)
+	}
+	StrictValidationErr = func(proxyRef core.ResourceRef, translationErrors []string) error {
+		return errors.Errorf("the full translation of Proxy %v failed: %v", proxyRef, strings.Join(translationErrors, "; "))
+	}
 	unmarshalErrMsg     = "could not unmarshal raw object"
 	WrappedUnmarshalErr = func(err error) error {
 		return errors.Wrapf(err, unmarshalErrMsg)
@@ -64,6 +81,12 @@ type Validator interface {
 	ValidateDeleteVirtualService(ctx context.Context, vs core.ResourceRef, dryRun bool) error
 	ValidateRouteTable(ctx context.Context, rt *v1.RouteTable, dryRun bool) (ProxyReports, error)
 	ValidateDeleteRouteTable(ctx context.Context, rt core.ResourceRef, dryRun bool) error
+	ValidateVirtualHostOption(ctx context.Context, vho *v1.VirtualHostOption, dryRun bool) (ProxyReports, error)
+	ValidateDeleteVirtualHostOption(ctx context.Context, vho core.ResourceRef, dryRun bool) error
+	ValidateRouteOption(ctx context.Context, rto *v1.RouteOption, dryRun bool) (ProxyReports, error)
+	ValidateDeleteRouteOption(ctx context.Context, rto core.ResourceRef, dryRun bool) error
+	ValidateDeleteUpstream(ctx context.Context, us core.ResourceRef) error
+	ValidateDeleteSecret(ctx context.Context, secret core.ResourceRef) error
 }
 
 type validator struct {
@@ -72,26 +95,38 @@ type validator struct {
 	latestSnapshotErr            error
 	translator                   translator.Translator
 	validationClient             validation.ProxyValidationServiceClient
+	upstreamGroupClient          gloov1.UpstreamGroupClient
 	ignoreProxyValidationFailure bool
 	allowWarnings                bool
+	strictMode                   gloov1.GatewayOptions_ValidationOptions_StrictValidationMode
 	writeNamespace               string
+	watchNamespaces              []string
+	namespacedGateways           bool
 }
 
 type ValidatorConfig struct {
 	translator                   translator.Translator
 	validationClient             validation.ProxyValidationServiceClient
+	upstreamGroupClient          gloov1.UpstreamGroupClient
 	writeNamespace               string
+	watchNamespaces              []string
+	namespacedGateways           bool
 	ignoreProxyValidationFailure bool
 	allowWarnings                bool
+	strictMode                   gloov1.GatewayOptions_ValidationOptions_StrictValidationMode
 }
 
-func NewValidatorConfig(translator translator.Translator, validationClient validation.ProxyValidationServiceClient, writeNamespace string, ignoreProxyValidationFailure, allowWarnings bool) ValidatorConfig {
+func NewValidatorConfig(translator translator.Translator, validationClient validation.ProxyValidationServiceClient, upstreamGroupClient gloov1.UpstreamGroupClient, writeNamespace string, watchNamespaces []string, namespacedGateways, ignoreProxyValidationFailure, allowWarnings bool, strictMode gloov1.GatewayOptions_ValidationOptions_StrictValidationMode) ValidatorConfig {
 	return ValidatorConfig{
 		translator:                   translator,
 		validationClient:             validationClient,
+		upstreamGroupClient:          upstreamGroupClient,
 		writeNamespace:               writeNamespace,
+		watchNamespaces:              watchNamespaces,
+		namespacedGateways:           namespacedGateways,
 		ignoreProxyValidationFailure: ignoreProxyValidationFailure,
 		allowWarnings:                allowWarnings,
+		strictMode:                   strictMode,
 	}
 }
 
@@ -99,9 +134,13 @@ func NewValidator(cfg ValidatorConfig) *validator {
 	return &validator{
 		translator:                   cfg.translator,
 		validationClient:             cfg.validationClient,
+		upstreamGroupClient:          cfg.upstreamGroupClient,
 		writeNamespace:               cfg.writeNamespace,
+		watchNamespaces:              cfg.watchNamespaces,
+		namespacedGateways:           cfg.namespacedGateways,
 		ignoreProxyValidationFailure: cfg.ignoreProxyValidationFailure,
 		allowWarnings:                cfg.allowWarnings,
+		strictMode:                   cfg.strictMode,
 	}
 }
 
@@ -111,10 +150,10 @@ func (v *validator) ready() bool {
 
 func (v *validator) Sync(ctx context.Context, snap *v1.ApiSnapshot) error {
 	snapCopy := snap.Clone()
-	gatewaysByProxy := utils.GatewaysByProxyName(snap.Gateways)
+	gatewaysByProxy := utils.GatewaysByProxy(snap.Gateways, v.writeNamespace, v.namespacedGateways)
 	var errs error
-	for proxyName, gatewayList := range gatewaysByProxy {
-		_, reports := v.translator.Translate(ctx, proxyName, v.writeNamespace, snap, gatewayList)
+	for proxyRef, gatewayList := range gatewaysByProxy {
+		_, reports := v.translator.Translate(ctx, proxyRef.Name, proxyRef.Namespace, snap, gatewayList)
 		if err := reports.Validate(); err != nil {
 			errs = multierr.Append(errs, err)
 		}
@@ -135,7 +174,7 @@ func (v *validator) Sync(ctx context.Context, snap *v1.ApiSnapshot) error {
 	return nil
 }
 
-type applyResource func(snap *v1.ApiSnapshot) (proxyNames []string, resource resources.Resource, ref core.ResourceRef)
+type applyResource func(snap *v1.ApiSnapshot) (proxyRefs []core.ResourceRef, resource resources.Resource, ref core.ResourceRef)
 
 // update internal snapshot to handle race where a lot of resources may be deleted at once, before syncer updates
 // should be called within a lock
@@ -156,6 +195,20 @@ func (v *validator) deleteFromLocalSnapshot(resource resources.Resource) {
 				break
 			}
 		}
+	case *v1.VirtualHostOption:
+		for i, vho := range v.latestSnapshot.VirtualHostOptions {
+			if vho.Metadata.Ref() == ref {
+				v.latestSnapshot.VirtualHostOptions = append(v.latestSnapshot.VirtualHostOptions[:i], v.latestSnapshot.VirtualHostOptions[i+1:]...)
+				break
+			}
+		}
+	case *v1.RouteOption:
+		for i, rto := range v.latestSnapshot.RouteOptions {
+			if rto.Metadata.Ref() == ref {
+				v.latestSnapshot.RouteOptions = append(v.latestSnapshot.RouteOptions[:i], v.latestSnapshot.RouteOptions[i+1:]...)
+				break
+			}
+		}
 	}
 }
 
@@ -176,17 +229,17 @@ func (v *validator) validateSnapshot(ctx context.Context, apply applyResource, d
 	}
 
 	utils2.MeasureOne(ctx, mValidConfig)
-	proxyNames, resource, ref := apply(&snap)
+	proxyRefs, resource, ref := apply(&snap)
 
-	gatewaysByProxy := utils.GatewaysByProxyName(snap.Gateways)
+	gatewaysByProxy := utils.GatewaysByProxy(snap.Gateways, v.writeNamespace, v.namespacedGateways)
 
 	var (
 		errs         error
 		proxyReports ProxyReports = map[*gloov1.Proxy]*validation.ProxyReport{}
 	)
-	for _, proxyName := range proxyNames {
-		gatewayList := gatewaysByProxy[proxyName]
-		proxy, reports := v.translator.Translate(ctx, proxyName, v.writeNamespace, &snap, gatewayList)
+	for _, proxyRef := range proxyRefs {
+		gatewayList := gatewaysByProxy[proxyRef]
+		proxy, reports := v.translator.Translate(ctx, proxyRef.Name, proxyRef.Namespace, &snap, gatewayList)
 		validate := reports.ValidateStrict
 		if v.allowWarnings {
 			validate = reports.Validate
@@ -197,6 +250,11 @@ func (v *validator) validateSnapshot(ctx context.Context, apply applyResource, d
 		}
 
 		if v.validationClient == nil {
+			if v.strict() {
+				v.appendStrictErr(ctx, &errs, errors.Errorf("the full translation of Proxy %v is not available as the "+
+					"Proxy validation client has not been initialized", proxyRef))
+				continue
+			}
 			contextutils.LoggerFrom(ctx).Warnf("skipping proxy validation check as the " +
 				"Proxy validation client has not been initialized. check to ensure that the gateway and gloo processes " +
 				"are configured to communicate.")
@@ -220,7 +278,10 @@ func (v *validator) validateSnapshot(ctx context.Context, apply applyResource, d
 		)
 		if err != nil {
 			err = errors.Wrapf(err, "failed to communicate with Gloo Proxy validation server")
-			if v.ignoreProxyValidationFailure {
+			if v.strict() {
+				// the full translation is required by the strict validation
+				v.appendStrictErr(ctx, &errs, err)
+			} else if v.ignoreProxyValidationFailure {
 				contextutils.LoggerFrom(ctx).Error(err)
 			} else {
 				errs = multierr.Append(errs, err)
@@ -237,6 +298,10 @@ func (v *validator) validateSnapshot(ctx context.Context, apply applyResource, d
 			errs = multierr.Append(errs, errors.Wrapf(err, "failed to validate Proxy with Gloo validation server"))
 			continue
 		}
+
+		if translationErrors := proxyReport.GetTranslationErrors(); v.strict() && len(translationErrors) > 0 {
+			v.appendStrictErr(ctx, &errs, StrictValidationErr(proxyRef, translationErrors))
+		}
 	}
 
 	if errs != nil {
@@ -260,6 +325,20 @@ func (v *validator) validateSnapshot(ctx context.Context, apply applyResource, d
 	return proxyReports, nil
 }
 
+// strict returns true when the proxies are validated with their full translation
+func (v *validator) strict() bool {
+	return v.strictMode != gloov1.GatewayOptions_ValidationOptions_DISABLED
+}
+
+// appendStrictErr rejects the resource on an error of the strict validation, or only logs it in the warn mode
+func (v *validator) appendStrictErr(ctx context.Context, errs *error, err error) {
+	if v.strictMode == gloov1.GatewayOptions_ValidationOptions_REJECT {
+		*errs = multierr.Append(*errs, err)
+		return
+	}
+	contextutils.LoggerFrom(ctx).Warnf("strict validation: %v", err)
+}
+
 func (v *validator) ValidateList(ctx context.Context, ul *unstructured.UnstructuredList, dryRun bool) (ProxyReports, error) {
 	var (
 		proxyReports = ProxyReports{}
@@ -314,6 +393,22 @@ func (v *validator) ValidateList(ctx context.Context, ul *unstructured.Unstructu
 				return nil, WrappedUnmarshalErr(err)
 			}
 			itemProxyReports, err = v.validateRouteTableInternal(ctx, &rt, false, false)
+		case v1.VirtualHostOptionGVK:
+			var (
+				vho v1.VirtualHostOption
+			)
+			if err := skprotoutils.UnmarshalResource(jsonBytes, &vho); err != nil {
+				return nil, WrappedUnmarshalErr(err)
+			}
+			itemProxyReports, err = v.validateVirtualHostOptionInternal(ctx, &vho, false, false)
+		case v1.RouteOptionGVK:
+			var (
+				rto v1.RouteOption
+			)
+			if err := skprotoutils.UnmarshalResource(jsonBytes, &rto); err != nil {
+				return nil, WrappedUnmarshalErr(err)
+			}
+			itemProxyReports, err = v.validateRouteOptionInternal(ctx, &rto, false, false)
 		}
 
 		errs = multierror.Append(errs, err)
@@ -336,7 +431,7 @@ func (v *validator) ValidateVirtualService(ctx context.Context, vs *v1.VirtualSe
 }
 
 func (v *validator) validateVirtualServiceInternal(ctx context.Context, vs *v1.VirtualService, dryRun, acquireLock bool) (ProxyReports, error) {
-	apply := func(snap *v1.ApiSnapshot) ([]string, resources.Resource, core.ResourceRef) {
+	apply := func(snap *v1.ApiSnapshot) ([]core.ResourceRef, resources.Resource, core.ResourceRef) {
 		vsRef := vs.GetMetadata().Ref()
 
 		// TODO: move this to a function when generics become a thing
@@ -354,7 +449,7 @@ func (v *validator) validateVirtualServiceInternal(ctx context.Context, vs *v1.V
 			snap.VirtualServices.Sort()
 		}
 
-		return proxiesForVirtualService(snap.Gateways, vs), vs, vsRef
+		return v.proxiesForVirtualService(snap.Gateways, vs), vs, vsRef
 	}
 
 	return v.validateSnapshot(ctx, apply, dryRun, acquireLock)
@@ -376,16 +471,18 @@ func (v *validator) ValidateDeleteVirtualService(ctx context.Context, vsRef core
 
 	var parentGateways []core.ResourceRef
 	snap.Gateways.Each(func(element *v1.Gateway) {
-		http, ok := element.GatewayType.(*v1.Gateway_HttpGateway)
-		if !ok {
-			return
+		httpGateways := []*v1.HttpGateway{element.GetHttpGateway()}
+		for _, matchedGateway := range element.GetHybridGateway().GetMatchedGateways() {
+			httpGateways = append(httpGateways, matchedGateway.GetHttpGateway())
 		}
-		for _, ref := range http.HttpGateway.GetVirtualServices() {
-			if ref == vsRef {
-				// this gateway points at this virtual service
-				parentGateways = append(parentGateways, element.Metadata.Ref())
-
-				break
+		for _, httpGateway := range httpGateways {
+			for _, ref := range httpGateway.GetVirtualServices() {
+				if ref == vsRef {
+					// this gateway points at this virtual service
+					parentGateways = append(parentGateways, element.Metadata.Ref())
+
+					return
+				}
 			}
 		}
 	})
@@ -412,7 +509,7 @@ func (v *validator) ValidateRouteTable(ctx context.Context, rt *v1.RouteTable, d
 }
 
 func (v *validator) validateRouteTableInternal(ctx context.Context, rt *v1.RouteTable, dryRun, acquireLock bool) (ProxyReports, error) {
-	apply := func(snap *v1.ApiSnapshot) ([]string, resources.Resource, core.ResourceRef) {
+	apply := func(snap *v1.ApiSnapshot) ([]core.ResourceRef, resources.Resource, core.ResourceRef) {
 		rtRef := rt.GetMetadata().Ref()
 
 		// TODO: move this to a function when generics become a thing
@@ -430,7 +527,7 @@ func (v *validator) validateRouteTableInternal(ctx context.Context, rt *v1.Route
 			snap.RouteTables.Sort()
 		}
 
-		proxiesToConsider := proxiesForRouteTable(snap.Gateways, snap.VirtualServices, snap.RouteTables, rt)
+		proxiesToConsider := v.proxiesForRouteTable(snap.Gateways, snap.VirtualServices, snap.RouteTables, rt)
 
 		return proxiesToConsider, rt, rtRef
 	}
@@ -485,12 +582,268 @@ func (v *validator) ValidateDeleteRouteTable(ctx context.Context, rtRef core.Res
 	return nil
 }
 
+func (v *validator) ValidateVirtualHostOption(ctx context.Context, vho *v1.VirtualHostOption, dryRun bool) (ProxyReports, error) {
+	return v.validateVirtualHostOptionInternal(ctx, vho, dryRun, true)
+}
+
+func (v *validator) validateVirtualHostOptionInternal(ctx context.Context, vho *v1.VirtualHostOption, dryRun, acquireLock bool) (ProxyReports, error) {
+	apply := func(snap *v1.ApiSnapshot) ([]core.ResourceRef, resources.Resource, core.ResourceRef) {
+		vhoRef := vho.GetMetadata().Ref()
+
+		// the virtual services which delegated to the previous version of the option are affected as well
+		affectedVirtualServices := virtualServicesForVirtualHostOption(vho, snap.VirtualServices)
+
+		// TODO: move this to a function when generics become a thing
+		var isUpdate bool
+		for i, existingVho := range snap.VirtualHostOptions {
+			if vhoRef == existingVho.GetMetadata().Ref() {
+				affectedVirtualServices = append(affectedVirtualServices, virtualServicesForVirtualHostOption(existingVho, snap.VirtualServices)...)
+				// replace the existing virtual host option in the snapshot
+				snap.VirtualHostOptions[i] = vho
+				isUpdate = true
+				break
+			}
+		}
+		if !isUpdate {
+			snap.VirtualHostOptions = append(snap.VirtualHostOptions, vho)
+			snap.VirtualHostOptions.Sort()
+		}
+
+		return v.proxiesForVirtualServices(snap.Gateways, affectedVirtualServices), vho, vhoRef
+	}
+
+	return v.validateSnapshot(ctx, apply, dryRun, acquireLock)
+}
+
+// only the explicit references block the deletion, as selectors are not hard referential constraints
+func (v *validator) ValidateDeleteVirtualHostOption(ctx context.Context, vhoRef core.ResourceRef, dryRun bool) error {
+	if !v.ready() {
+		return errors.Errorf("Gateway validation is yet not available. Waiting for first snapshot")
+	}
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	snap := v.latestSnapshot.Clone()
+
+	vho, err := snap.VirtualHostOptions.Find(vhoRef.Strings())
+	if err != nil {
+		// if it's not present in the snapshot, allow deletion
+		return nil
+	}
+
+	var parentVirtualServices []core.ResourceRef
+	snap.VirtualServices.Each(func(element *v1.VirtualService) {
+		if optionRefsContainRef(element.GetVirtualHost().GetOptionsConfigRefs(), vhoRef) {
+			parentVirtualServices = append(parentVirtualServices, element.Metadata.Ref())
+		}
+	})
+
+	if len(parentVirtualServices) > 0 {
+		err := VirtualHostOptionDeleteErr(parentVirtualServices)
+		if !v.allowWarnings {
+			contextutils.LoggerFrom(ctx).Infof("Rejected deletion of Virtual Host Option %v: %v", vhoRef, err)
+			return err
+		}
+		contextutils.LoggerFrom(ctx).Warnf("Allowed deletion of Virtual Host Option %v with warning: %v", vhoRef, err)
+	} else {
+		contextutils.LoggerFrom(ctx).Debugf("Accepted Virtual Host Option deletion %v", vhoRef)
+	}
+
+	if !dryRun {
+		v.deleteFromLocalSnapshot(vho)
+	}
+	return nil
+}
+
+func (v *validator) ValidateRouteOption(ctx context.Context, rto *v1.RouteOption, dryRun bool) (ProxyReports, error) {
+	return v.validateRouteOptionInternal(ctx, rto, dryRun, true)
+}
+
+func (v *validator) validateRouteOptionInternal(ctx context.Context, rto *v1.RouteOption, dryRun, acquireLock bool) (ProxyReports, error) {
+	apply := func(snap *v1.ApiSnapshot) ([]core.ResourceRef, resources.Resource, core.ResourceRef) {
+		rtoRef := rto.GetMetadata().Ref()
+
+		// the virtual services which delegated to the previous version of the option are affected as well
+		affectedVirtualServices := virtualServicesForRouteOption(rto, snap.VirtualServices, snap.RouteTables)
+
+		// TODO: move this to a function when generics become a thing
+		var isUpdate bool
+		for i, existingRto := range snap.RouteOptions {
+			if rtoRef == existingRto.GetMetadata().Ref() {
+				affectedVirtualServices = append(affectedVirtualServices, virtualServicesForRouteOption(existingRto, snap.VirtualServices, snap.RouteTables)...)
+				// replace the existing route option in the snapshot
+				snap.RouteOptions[i] = rto
+				isUpdate = true
+				break
+			}
+		}
+		if !isUpdate {
+			snap.RouteOptions = append(snap.RouteOptions, rto)
+			snap.RouteOptions.Sort()
+		}
+
+		return v.proxiesForVirtualServices(snap.Gateways, affectedVirtualServices), rto, rtoRef
+	}
+
+	return v.validateSnapshot(ctx, apply, dryRun, acquireLock)
+}
+
+// only the explicit references block the deletion, as selectors are not hard referential constraints
+func (v *validator) ValidateDeleteRouteOption(ctx context.Context, rtoRef core.ResourceRef, dryRun bool) error {
+	if !v.ready() {
+		return errors.Errorf("Gateway validation is yet not available. Waiting for first snapshot")
+	}
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	snap := v.latestSnapshot.Clone()
+
+	rto, err := snap.RouteOptions.Find(rtoRef.Strings())
+	if err != nil {
+		// if it's not present in the snapshot, allow deletion
+		return nil
+	}
+
+	var parentVirtualServices []core.ResourceRef
+	snap.VirtualServices.Each(func(element *v1.VirtualService) {
+		if routesContainOptionRef(element.GetVirtualHost().GetRoutes(), rtoRef) {
+			parentVirtualServices = append(parentVirtualServices, element.Metadata.Ref())
+		}
+	})
+
+	var parentRouteTables []core.ResourceRef
+	snap.RouteTables.Each(func(element *v1.RouteTable) {
+		if routesContainOptionRef(element.GetRoutes(), rtoRef) {
+			parentRouteTables = append(parentRouteTables, element.Metadata.Ref())
+		}
+	})
+
+	if len(parentVirtualServices) > 0 || len(parentRouteTables) > 0 {
+		err := RouteOptionDeleteErr(parentVirtualServices, parentRouteTables)
+		if !v.allowWarnings {
+			contextutils.LoggerFrom(ctx).Infof("Rejected deletion of Route Option %v: %v", rtoRef, err)
+			return err
+		}
+		contextutils.LoggerFrom(ctx).Warnf("Allowed deletion of Route Option %v with warning: %v", rtoRef, err)
+	} else {
+		contextutils.LoggerFrom(ctx).Debugf("Accepted Route Option deletion %v", rtoRef)
+	}
+
+	if !dryRun {
+		v.deleteFromLocalSnapshot(rto)
+	}
+	return nil
+}
+
+// upstreams and secrets are not part of the gateway snapshot, so there is nothing to remove from it on deletion
+func (v *validator) ValidateDeleteUpstream(ctx context.Context, usRef core.ResourceRef) error {
+	if !v.ready() {
+		// upstreams are not watched by the gateway, so their deletion is not blocked until the first snapshot
+		return nil
+	}
+	v.lock.RLock()
+	snap := v.latestSnapshot.Clone()
+	v.lock.RUnlock()
+
+	var parentGateways []core.ResourceRef
+	snap.Gateways.Each(func(element *v1.Gateway) {
+		if destinationsContainUpstream(gatewayDestinations(element), usRef) {
+			parentGateways = append(parentGateways, element.Metadata.Ref())
+		}
+	})
+
+	var parentVirtualServices []core.ResourceRef
+	snap.VirtualServices.Each(func(element *v1.VirtualService) {
+		if routesContainUpstream(element.GetVirtualHost().GetRoutes(), usRef) {
+			parentVirtualServices = append(parentVirtualServices, element.Metadata.Ref())
+		}
+	})
+
+	var parentRouteTables []core.ResourceRef
+	snap.RouteTables.Each(func(element *v1.RouteTable) {
+		if routesContainUpstream(element.GetRoutes(), usRef) {
+			parentRouteTables = append(parentRouteTables, element.Metadata.Ref())
+		}
+	})
+
+	var parentUpstreamGroups []core.ResourceRef
+	v.listUpstreamGroups(ctx).Each(func(element *gloov1.UpstreamGroup) {
+		if destinationsContainUpstream(upstreamGroupDestinations(element), usRef) {
+			parentUpstreamGroups = append(parentUpstreamGroups, element.Metadata.Ref())
+		}
+	})
+
+	if len(parentGateways) > 0 || len(parentVirtualServices) > 0 || len(parentRouteTables) > 0 || len(parentUpstreamGroups) > 0 {
+		err := UpstreamDeleteErr(parentGateways, parentVirtualServices, parentRouteTables, parentUpstreamGroups)
+		if !v.allowWarnings {
+			contextutils.LoggerFrom(ctx).Infof("Rejected deletion of Upstream %v: %v", usRef, err)
+			return err
+		}
+		contextutils.LoggerFrom(ctx).Warnf("Allowed deletion of Upstream %v with warning: %v", usRef, err)
+	} else {
+		contextutils.LoggerFrom(ctx).Debugf("Accepted Upstream deletion %v", usRef)
+	}
+	return nil
+}
+
+func (v *validator) ValidateDeleteSecret(ctx context.Context, secretRef core.ResourceRef) error {
+	if !v.ready() {
+		// secrets are not watched by the gateway, so their deletion is not blocked until the first snapshot
+		return nil
+	}
+	v.lock.RLock()
+	snap := v.latestSnapshot.Clone()
+	v.lock.RUnlock()
+
+	var parentGateways []core.ResourceRef
+	snap.Gateways.Each(func(element *v1.Gateway) {
+		if sslConfigsContainSecret(gatewaySslConfigs(element), secretRef) {
+			parentGateways = append(parentGateways, element.Metadata.Ref())
+		}
+	})
+
+	var parentVirtualServices []core.ResourceRef
+	snap.VirtualServices.Each(func(element *v1.VirtualService) {
+		if sslConfigsContainSecret([]*gloov1.SslConfig{element.GetSslConfig()}, secretRef) {
+			parentVirtualServices = append(parentVirtualServices, element.Metadata.Ref())
+		}
+	})
+
+	if len(parentGateways) > 0 || len(parentVirtualServices) > 0 {
+		err := SecretDeleteErr(parentGateways, parentVirtualServices)
+		if !v.allowWarnings {
+			contextutils.LoggerFrom(ctx).Infof("Rejected deletion of Secret %v: %v", secretRef, err)
+			return err
+		}
+		contextutils.LoggerFrom(ctx).Warnf("Allowed deletion of Secret %v with warning: %v", secretRef, err)
+	} else {
+		contextutils.LoggerFrom(ctx).Debugf("Accepted Secret deletion %v", secretRef)
+	}
+	return nil
+}
+
+// upstream groups are not part of the gateway snapshot, they are read from the watched namespaces on demand.
+// listing errors are only logged so that they do not block the deletion.
+func (v *validator) listUpstreamGroups(ctx context.Context) gloov1.UpstreamGroupList {
+	if v.upstreamGroupClient == nil {
+		return nil
+	}
+	var upstreamGroups gloov1.UpstreamGroupList
+	for _, ns := range v.watchNamespaces {
+		list, err := v.upstreamGroupClient.List(ns, clients.ListOpts{Ctx: ctx})
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Warnf("failed to list the upstream groups in namespace %v: %v", ns, err)
+			continue
+		}
+		upstreamGroups = append(upstreamGroups, list...)
+	}
+	return upstreamGroups
+}
+
 func (v *validator) ValidateGateway(ctx context.Context, gw *v1.Gateway, dryRun bool) (ProxyReports, error) {
 	return v.validateGatewayInternal(ctx, gw, dryRun, true)
 }
 
 func (v *validator) validateGatewayInternal(ctx context.Context, gw *v1.Gateway, dryRun, acquireLock bool) (ProxyReports, error) {
-	apply := func(snap *v1.ApiSnapshot) ([]string, resources.Resource, core.ResourceRef) {
+	apply := func(snap *v1.ApiSnapshot) ([]core.ResourceRef, resources.Resource, core.ResourceRef) {
 		gwRef := gw.GetMetadata().Ref()
 
 		// TODO: move this to a function when generics become a thing
@@ -508,7 +861,7 @@ func (v *validator) validateGatewayInternal(ctx context.Context, gw *v1.Gateway,
 			snap.Gateways.Sort()
 		}
 
-		proxiesToConsider := utils.GetProxyNamesForGateway(gw)
+		proxiesToConsider := utils.GetProxyRefsForGateway(gw, v.writeNamespace, v.namespacedGateways)
 
 		return proxiesToConsider, gw, gwRef
 	}
@@ -516,44 +869,55 @@ func (v *validator) validateGatewayInternal(ctx context.Context, gw *v1.Gateway,
 	return v.validateSnapshot(ctx, apply, dryRun, acquireLock)
 }
 
-func proxiesForVirtualService(gwList v1.GatewayList, vs *v1.VirtualService) []string {
+func (v *validator) proxiesForVirtualService(gwList v1.GatewayList, vs *v1.VirtualService) []core.ResourceRef {
 
-	gatewaysByProxy := utils.GatewaysByProxyName(gwList)
+	gatewaysByProxy := utils.GatewaysByProxy(gwList, v.writeNamespace, v.namespacedGateways)
 
-	var proxiesToConsider []string
+	var proxiesToConsider []core.ResourceRef
 
-	for proxyName, gatewayList := range gatewaysByProxy {
+	for proxyRef, gatewayList := range gatewaysByProxy {
 		if gatewayListContainsVirtualService(gatewayList, vs) {
 			// we only care about validating this proxy if it contains this virtual service
-			proxiesToConsider = append(proxiesToConsider, proxyName)
+			proxiesToConsider = append(proxiesToConsider, proxyRef)
 		}
 	}
 
-	sort.Strings(proxiesToConsider)
+	sortRefs(proxiesToConsider)
 
 	return proxiesToConsider
 }
 
-func proxiesForRouteTable(gwList v1.GatewayList, vsList v1.VirtualServiceList, rtList v1.RouteTableList, rt *v1.RouteTable) []string {
-	affectedVirtualServices := virtualServicesForRouteTable(rt, vsList, rtList)
+func (v *validator) proxiesForRouteTable(gwList v1.GatewayList, vsList v1.VirtualServiceList, rtList v1.RouteTableList, rt *v1.RouteTable) []core.ResourceRef {
+	return v.proxiesForVirtualServices(gwList, virtualServicesForRouteTable(rt, vsList, rtList))
+}
 
-	affectedProxies := make(map[string]struct{})
+func (v *validator) proxiesForVirtualServices(gwList v1.GatewayList, affectedVirtualServices v1.VirtualServiceList) []core.ResourceRef {
+	affectedProxies := make(map[core.ResourceRef]struct{})
 	for _, vs := range affectedVirtualServices {
-		proxiesToConsider := proxiesForVirtualService(gwList, vs)
+		proxiesToConsider := v.proxiesForVirtualService(gwList, vs)
 		for _, proxy := range proxiesToConsider {
 			affectedProxies[proxy] = struct{}{}
 		}
 	}
 
-	var proxiesToConsider []string
+	var proxiesToConsider []core.ResourceRef
 	for proxy := range affectedProxies {
 		proxiesToConsider = append(proxiesToConsider, proxy)
 	}
-	sort.Strings(proxiesToConsider)
+	sortRefs(proxiesToConsider)
 
 	return proxiesToConsider
 }
 
+func sortRefs(refs []core.ResourceRef) {
+	sort.SliceStable(refs, func(i, j int) bool {
+		if refs[i].Namespace != refs[j].Namespace {
+			return refs[i].Namespace < refs[j].Namespace
+		}
+		return refs[i].Name < refs[j].Name
+	})
+}
+
 type refSet map[core.ResourceRef]struct{}
 
 func virtualServicesForRouteTable(rt *v1.RouteTable, allVirtualServices v1.VirtualServiceList, allRoutes v1.RouteTableList) v1.VirtualServiceList {
@@ -580,6 +944,70 @@ func virtualServicesForRouteTable(rt *v1.RouteTable, allVirtualServices v1.Virtu
 	return parentVirtualServices
 }
 
+func virtualServicesForVirtualHostOption(vho *v1.VirtualHostOption, allVirtualServices v1.VirtualServiceList) v1.VirtualServiceList {
+	var parentVirtualServices v1.VirtualServiceList
+	allVirtualServices.Each(func(element *v1.VirtualService) {
+		if translator.DelegatesToOption(element.GetVirtualHost().GetOptionsConfigRefs(), element.Metadata.Namespace, vho) {
+			parentVirtualServices = append(parentVirtualServices, element)
+		}
+	})
+	return parentVirtualServices
+}
+
+// returns the virtual services with a route delegating its options to the route option, directly or through route tables
+func virtualServicesForRouteOption(rto *v1.RouteOption, allVirtualServices v1.VirtualServiceList, allRoutes v1.RouteTableList) v1.VirtualServiceList {
+	routesDelegate := func(routes []*v1.Route, ownerNamespace string) bool {
+		for _, route := range routes {
+			if translator.DelegatesToOption(route.GetOptionsConfigRefs(), ownerNamespace, rto) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var parentVirtualServices v1.VirtualServiceList
+	affected := map[core.ResourceRef]bool{}
+	addVirtualServices := func(list v1.VirtualServiceList) {
+		for _, vs := range list {
+			if ref := vs.Metadata.Ref(); !affected[ref] {
+				affected[ref] = true
+				parentVirtualServices = append(parentVirtualServices, vs)
+			}
+		}
+	}
+
+	allVirtualServices.Each(func(element *v1.VirtualService) {
+		if routesDelegate(element.GetVirtualHost().GetRoutes(), element.Metadata.Namespace) {
+			addVirtualServices(v1.VirtualServiceList{element})
+		}
+	})
+	allRoutes.Each(func(element *v1.RouteTable) {
+		if routesDelegate(element.GetRoutes(), element.Metadata.Namespace) {
+			addVirtualServices(virtualServicesForRouteTable(element, allVirtualServices, allRoutes))
+		}
+	})
+	return parentVirtualServices
+}
+
+// optionRefsContainRef returns true when the option resource is referenced explicitly, rather than by a selector
+func optionRefsContainRef(refs *v1.DelegateOptionsRefs, optionRef core.ResourceRef) bool {
+	for _, ref := range refs.GetDelegateOptions() {
+		if ref != nil && *ref == optionRef {
+			return true
+		}
+	}
+	return false
+}
+
+func routesContainOptionRef(list []*v1.Route, optionRef core.ResourceRef) bool {
+	for _, r := range list {
+		if optionRefsContainRef(r.GetOptionsConfigRefs(), optionRef) {
+			return true
+		}
+	}
+	return false
+}
+
 func routesContainRefs(list []*v1.Route, refs refSet) bool {
 	for _, r := range list {
 
@@ -618,6 +1046,85 @@ func routesContainRefs(list []*v1.Route, refs refSet) bool {
 	return false
 }
 
+// routesContainUpstream returns true when one of the routes sends traffic to the upstream
+func routesContainUpstream(list []*v1.Route, usRef core.ResourceRef) bool {
+	for _, r := range list {
+		action := r.GetRouteAction()
+		if action == nil {
+			continue
+		}
+
+		destinations := []*gloov1.Destination{action.GetSingle()}
+		for _, weightedDestination := range action.GetMulti().GetDestinations() {
+			destinations = append(destinations, weightedDestination.GetDestination())
+		}
+		if destinationsContainUpstream(destinations, usRef) {
+			return true
+		}
+	}
+	return false
+}
+
+// gatewayTcpHosts returns the tcp hosts of the tcp gateway or of the tcp gateways of a hybrid gateway
+func gatewayTcpHosts(gw *v1.Gateway) []*gloov1.TcpHost {
+	tcpHosts := append([]*gloov1.TcpHost{}, gw.GetTcpGateway().GetTcpHosts()...)
+	for _, matchedGateway := range gw.GetHybridGateway().GetMatchedGateways() {
+		tcpHosts = append(tcpHosts, matchedGateway.GetTcpGateway().GetTcpHosts()...)
+	}
+	return tcpHosts
+}
+
+// gatewayDestinations returns the destinations of the tcp hosts and of the udp gateway of the gateway
+func gatewayDestinations(gw *v1.Gateway) []*gloov1.Destination {
+	destinations := []*gloov1.Destination{gw.GetUdpGateway().GetDestination()}
+	for _, tcpHost := range gatewayTcpHosts(gw) {
+		destinations = append(destinations, tcpHost.GetDestination().GetSingle())
+		for _, weightedDestination := range tcpHost.GetDestination().GetMulti().GetDestinations() {
+			destinations = append(destinations, weightedDestination.GetDestination())
+		}
+	}
+	return destinations
+}
+
+func upstreamGroupDestinations(ug *gloov1.UpstreamGroup) []*gloov1.Destination {
+	destinations := []*gloov1.Destination{ug.GetRollout().GetCanary()}
+	for _, weightedDestination := range ug.GetDestinations() {
+		destinations = append(destinations, weightedDestination.GetDestination())
+	}
+	return destinations
+}
+
+// gatewaySslConfigs returns the ssl configs of the http gateways, of the tcp hosts and of the hybrid gateway matchers
+func gatewaySslConfigs(gw *v1.Gateway) []*gloov1.SslConfig {
+	sslConfigs := append([]*gloov1.SslConfig{}, gw.GetHttpGateway().GetSslConfigurations()...)
+	for _, matchedGateway := range gw.GetHybridGateway().GetMatchedGateways() {
+		sslConfigs = append(sslConfigs, matchedGateway.GetMatcher().GetSslConfig())
+		sslConfigs = append(sslConfigs, matchedGateway.GetHttpGateway().GetSslConfigurations()...)
+	}
+	for _, tcpHost := range gatewayTcpHosts(gw) {
+		sslConfigs = append(sslConfigs, tcpHost.GetSslConfig())
+	}
+	return sslConfigs
+}
+
+func sslConfigsContainSecret(sslConfigs []*gloov1.SslConfig, secretRef core.ResourceRef) bool {
+	for _, sslConfig := range sslConfigs {
+		if ref := sslConfig.GetSecretRef(); ref != nil && *ref == secretRef {
+			return true
+		}
+	}
+	return false
+}
+
+func destinationsContainUpstream(destinations []*gloov1.Destination, usRef core.ResourceRef) bool {
+	for _, destination := range destinations {
+		if ref := destination.GetUpstream(); ref != nil && *ref == usRef {
+			return true
+		}
+	}
+	return false
+}
+
 func gatewayListContainsVirtualService(gwList v1.GatewayList, vs *v1.VirtualService) bool {
 	for _, gw := range gwList {
 		if translator.GatewayContainsVirtualService(gw, vs) {
@@ -12,7 +12,11 @@ import (
 
 	"github.com/rotisserie/eris"
 
+	"github.com/solo-io/gloo/pkg/utils"
 	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 
 	. "github.com/onsi/ginkgo"
@@ -20,6 +24,7 @@ import (
 	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	validationutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 	"github.com/solo-io/gloo/test/samples"
 	"google.golang.org/grpc"
@@ -36,7 +41,7 @@ var _ = Describe("Validator", func() {
 		t = translator.NewDefaultTranslator(translator.Opts{})
 		vc = &mockValidationClient{}
 		ns = "my-namespace"
-		v = NewValidator(NewValidatorConfig(t, vc, ns, false, false))
+		v = NewValidator(NewValidatorConfig(t, vc, nil, ns, []string{ns}, false, false, false, gloov1.GatewayOptions_ValidationOptions_DISABLED))
 	})
 	It("returns error before sync called", func() {
 		_, err := v.ValidateVirtualService(nil, nil, false)
@@ -116,7 +121,7 @@ var _ = Describe("Validator", func() {
 			Context("ignoreProxyValidation=true", func() {
 				It("accepts the rt", func() {
 					vc.validateProxy = communicationErr
-					v = NewValidator(NewValidatorConfig(t, vc, ns, true, false))
+					v = NewValidator(NewValidatorConfig(t, vc, nil, ns, []string{ns}, false, true, false, gloov1.GatewayOptions_ValidationOptions_DISABLED))
 					us := samples.SimpleUpstream()
 					snap := samples.GatewaySnapshotWithDelegates(us.Metadata.Ref(), ns)
 					err := v.Sync(context.TODO(), snap)
@@ -128,7 +133,7 @@ var _ = Describe("Validator", func() {
 			})
 			Context("allowWarnings=true", func() {
 				BeforeEach(func() {
-					v = NewValidator(NewValidatorConfig(t, vc, ns, true, true))
+					v = NewValidator(NewValidatorConfig(t, vc, nil, ns, []string{ns}, false, true, true, gloov1.GatewayOptions_ValidationOptions_DISABLED))
 				})
 				It("accepts a vs with missing route table ref", func() {
 					vc.validateProxy = communicationErr
@@ -228,6 +233,91 @@ var _ = Describe("Validator", func() {
 		})
 	})
 
+	Context("strict validation", func() {
+		var snap *gatewayv1.ApiSnapshot
+
+		BeforeEach(func() {
+			us := samples.SimpleUpstream()
+			snap = samples.SimpleGatewaySnapshot(us.Metadata.Ref(), ns)
+		})
+
+		Context("strict=REJECT", func() {
+			BeforeEach(func() {
+				v = NewValidator(NewValidatorConfig(t, vc, nil, ns, []string{ns}, false, true, false, gloov1.GatewayOptions_ValidationOptions_REJECT))
+			})
+
+			It("rejects the vs when the full translation of the proxy fails", func() {
+				vc.validateProxy = failTranslation
+				err := v.Sync(context.TODO(), snap)
+				Expect(err).NotTo(HaveOccurred())
+				proxyReports, err := v.ValidateVirtualService(context.TODO(), snap.VirtualServices[0], false)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("the full translation of Proxy {gateway-proxy my-namespace} failed: " +
+					"upstream {test gloo-system}: cluster was configured improperly"))
+				Expect(proxyReports).To(HaveLen(1))
+			})
+
+			It("rejects the vs when gloo cannot be reached, even with ignoreProxyValidation=true", func() {
+				vc.validateProxy = communicationErr
+				err := v.Sync(context.TODO(), snap)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = v.ValidateVirtualService(context.TODO(), snap.VirtualServices[0], false)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("failed to communicate with Gloo Proxy validation server"))
+			})
+
+			It("rejects the vs when there is no validation client", func() {
+				v = NewValidator(NewValidatorConfig(t, nil, nil, ns, []string{ns}, false, true, false, gloov1.GatewayOptions_ValidationOptions_REJECT))
+				err := v.Sync(context.TODO(), snap)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = v.ValidateVirtualService(context.TODO(), snap.VirtualServices[0], false)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("the full translation of Proxy {gateway-proxy my-namespace} is not available"))
+			})
+
+			It("accepts the vs when the full translation of the proxy succeeds", func() {
+				vc.validateProxy = acceptProxy
+				err := v.Sync(context.TODO(), snap)
+				Expect(err).NotTo(HaveOccurred())
+				proxyReports, err := v.ValidateVirtualService(context.TODO(), snap.VirtualServices[0], false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(proxyReports).To(HaveLen(1))
+			})
+		})
+
+		Context("strict=WARN", func() {
+			BeforeEach(func() {
+				v = NewValidator(NewValidatorConfig(t, vc, nil, ns, []string{ns}, false, false, false, gloov1.GatewayOptions_ValidationOptions_WARN))
+			})
+
+			It("accepts the vs when the full translation of the proxy fails", func() {
+				vc.validateProxy = failTranslation
+				err := v.Sync(context.TODO(), snap)
+				Expect(err).NotTo(HaveOccurred())
+				proxyReports, err := v.ValidateVirtualService(context.TODO(), snap.VirtualServices[0], false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(proxyReports).To(HaveLen(1))
+			})
+
+			It("still rejects the vs on the errors of the proxy report", func() {
+				vc.validateProxy = failProxy
+				err := v.Sync(context.TODO(), snap)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = v.ValidateVirtualService(context.TODO(), snap.VirtualServices[0], false)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("failed to validate Proxy with Gloo validation server"))
+			})
+		})
+
+		It("ignores the errors of the full translation when disabled", func() {
+			vc.validateProxy = failTranslation
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = v.ValidateVirtualService(context.TODO(), snap.VirtualServices[0], false)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
 	Context("validating a virtual service", func() {
 
 		Context("proxy validation returns error", func() {
@@ -434,6 +524,296 @@ var _ = Describe("Validator", func() {
 		})
 	})
 
+	Context("delete an upstream", func() {
+		It("accepts deletion before the first snapshot", func() {
+			us := samples.SimpleUpstream()
+			err := v.ValidateDeleteUpstream(context.TODO(), us.Metadata.Ref())
+			Expect(err).NotTo(HaveOccurred())
+		})
+		Context("has parent routes", func() {
+			It("rejects deletion", func() {
+				us := samples.SimpleUpstream()
+				snap := samples.SimpleGatewaySnapshot(us.Metadata.Ref(), ns)
+				err := v.Sync(context.TODO(), snap)
+				Expect(err).NotTo(HaveOccurred())
+				err = v.ValidateDeleteUpstream(context.TODO(), us.Metadata.Ref())
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Deletion blocked because active Routes send traffic to this Upstream. " +
+					"Remove the destinations to this upstream from the gateways: [{tcp-gateway my-namespace}], " +
+					"the virtual services: [{virtualservice my-namespace}], the route tables: [] and the upstream groups: [], then try again"))
+			})
+			It("rejects deletion when a route table routes to the upstream", func() {
+				us := samples.SimpleUpstream()
+				snap := samples.GatewaySnapshotWithDelegates(us.Metadata.Ref(), ns)
+				err := v.Sync(context.TODO(), snap)
+				Expect(err).NotTo(HaveOccurred())
+				err = v.ValidateDeleteUpstream(context.TODO(), us.Metadata.Ref())
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("the route tables: [%v]", snap.RouteTables[0].Metadata.Ref())))
+			})
+			It("rejects deletion when a tcp host of a hybrid gateway routes to the upstream", func() {
+				us := samples.SimpleUpstream()
+				snap := samples.SimpleGatewaySnapshot(core.ResourceRef{Name: "other", Namespace: ns}, ns)
+				hybridGw := samples.SimpleGatewaySnapshot(us.Metadata.Ref(), ns).Gateways[2]
+				hybridGw.Metadata.Name = "hybrid-gateway"
+				hybridGw.BindPort = 12346
+				hybridGw.GatewayType = &gatewayv1.Gateway_HybridGateway{
+					HybridGateway: &gatewayv1.HybridGateway{
+						MatchedGateways: []*gatewayv1.MatchedGateway{{
+							Matcher:     &gloov1.Matcher{ServerNames: []string{"tcp.example.com"}},
+							GatewayType: &gatewayv1.MatchedGateway_TcpGateway{TcpGateway: hybridGw.GetTcpGateway()},
+						}},
+					},
+				}
+				snap.Gateways = append(snap.Gateways, hybridGw)
+				err := v.Sync(context.TODO(), snap)
+				Expect(err).NotTo(HaveOccurred())
+				err = v.ValidateDeleteUpstream(context.TODO(), us.Metadata.Ref())
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("the gateways: [{hybrid-gateway my-namespace}]"))
+			})
+			It("rejects deletion when an upstream group routes to the upstream", func() {
+				upstreamGroupClient, err := gloov1.NewUpstreamGroupClient(&factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
+				Expect(err).NotTo(HaveOccurred())
+				v = NewValidator(NewValidatorConfig(t, vc, upstreamGroupClient, ns, []string{ns}, false, false, false, gloov1.GatewayOptions_ValidationOptions_DISABLED))
+
+				us := samples.SimpleUpstream()
+				ug := &gloov1.UpstreamGroup{
+					Metadata: core.Metadata{Name: "ug", Namespace: ns},
+					Destinations: []*gloov1.WeightedDestination{{
+						Destination: &gloov1.Destination{DestinationType: &gloov1.Destination_Upstream{Upstream: utils.ResourceRefPtr(us.Metadata.Ref())}},
+						Weight:      1,
+					}},
+				}
+				_, err = upstreamGroupClient.Write(ug, clients.WriteOpts{})
+				Expect(err).NotTo(HaveOccurred())
+
+				snap := samples.SimpleGatewaySnapshot(core.ResourceRef{Name: "other", Namespace: ns}, ns)
+				err = v.Sync(context.TODO(), snap)
+				Expect(err).NotTo(HaveOccurred())
+				err = v.ValidateDeleteUpstream(context.TODO(), us.Metadata.Ref())
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("the gateways: [], the virtual services: [], the route tables: [] " +
+					"and the upstream groups: [{ug my-namespace}]"))
+			})
+			It("accepts deletion with allowWarnings=true", func() {
+				v = NewValidator(NewValidatorConfig(t, vc, nil, ns, []string{ns}, false, false, true, gloov1.GatewayOptions_ValidationOptions_DISABLED))
+				us := samples.SimpleUpstream()
+				snap := samples.SimpleGatewaySnapshot(us.Metadata.Ref(), ns)
+				err := v.Sync(context.TODO(), snap)
+				Expect(err).NotTo(HaveOccurred())
+				err = v.ValidateDeleteUpstream(context.TODO(), us.Metadata.Ref())
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+		Context("has no parent routes", func() {
+			It("deletes safely", func() {
+				us := samples.SimpleUpstream()
+				snap := samples.SimpleGatewaySnapshot(us.Metadata.Ref(), ns)
+				err := v.Sync(context.TODO(), snap)
+				Expect(err).NotTo(HaveOccurred())
+				err = v.ValidateDeleteUpstream(context.TODO(), core.ResourceRef{Name: "other", Namespace: us.Metadata.Namespace})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Context("delete a secret", func() {
+		var (
+			snap      *gatewayv1.ApiSnapshot
+			secretRef core.ResourceRef
+			sslConfig *gloov1.SslConfig
+		)
+		BeforeEach(func() {
+			us := samples.SimpleUpstream()
+			snap = samples.SimpleGatewaySnapshot(us.Metadata.Ref(), ns)
+			secretRef = core.ResourceRef{Name: "tls", Namespace: ns}
+			sslConfig = &gloov1.SslConfig{
+				SslSecrets: &gloov1.SslConfig_SecretRef{SecretRef: &secretRef},
+			}
+		})
+		It("accepts deletion before the first snapshot", func() {
+			err := v.ValidateDeleteSecret(context.TODO(), secretRef)
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("rejects deletion when a virtual service serves TLS with it", func() {
+			snap.VirtualServices[0].SslConfig = sslConfig
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+			err = v.ValidateDeleteSecret(context.TODO(), secretRef)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Deletion blocked because active Gateways or Virtual Services serve TLS with this Secret. " +
+				"Remove the ssl configs referencing this secret from the gateways: [] and the virtual services: [{virtualservice my-namespace}], then try again"))
+		})
+		It("rejects deletion when a tcp host serves TLS with it", func() {
+			snap.Gateways[2].GetTcpGateway().GetTcpHosts()[0].SslConfig = sslConfig
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+			err = v.ValidateDeleteSecret(context.TODO(), secretRef)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("the gateways: [{tcp-gateway my-namespace}] and the virtual services: []"))
+		})
+		It("rejects deletion when a hybrid gateway matcher serves TLS with it", func() {
+			snap.Gateways[2].GatewayType = &gatewayv1.Gateway_HybridGateway{
+				HybridGateway: &gatewayv1.HybridGateway{
+					MatchedGateways: []*gatewayv1.MatchedGateway{{
+						Matcher:     &gloov1.Matcher{SslConfig: sslConfig},
+						GatewayType: &gatewayv1.MatchedGateway_HttpGateway{HttpGateway: &gatewayv1.HttpGateway{}},
+					}},
+				},
+			}
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+			err = v.ValidateDeleteSecret(context.TODO(), secretRef)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("the gateways: [{tcp-gateway my-namespace}] and the virtual services: []"))
+		})
+		It("deletes unreferenced secrets safely", func() {
+			snap.VirtualServices[0].SslConfig = sslConfig
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+			err = v.ValidateDeleteSecret(context.TODO(), core.ResourceRef{Name: "other", Namespace: ns})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("delegated options", func() {
+		var (
+			snap *gatewayv1.ApiSnapshot
+			vho  *gatewayv1.VirtualHostOption
+			rto  *gatewayv1.RouteOption
+		)
+		BeforeEach(func() {
+			us := samples.SimpleUpstream()
+			snap = samples.GatewaySnapshotWithDelegates(us.Metadata.Ref(), ns)
+			vho = &gatewayv1.VirtualHostOption{
+				Metadata: core.Metadata{Name: "vho", Namespace: ns},
+				Options:  &gloov1.VirtualHostOptions{},
+			}
+			rto = &gatewayv1.RouteOption{
+				Metadata: core.Metadata{Name: "rto", Namespace: ns},
+				Options:  &gloov1.RouteOptions{},
+			}
+			snap.VirtualHostOptions = gatewayv1.VirtualHostOptionList{vho}
+			snap.RouteOptions = gatewayv1.RouteOptionList{rto}
+		})
+		delegateVirtualHostOptions := func() {
+			vhoRef := vho.Metadata.Ref()
+			snap.VirtualServices[0].VirtualHost.OptionsConfigRefs = &gatewayv1.DelegateOptionsRefs{
+				DelegateOptions: []*core.ResourceRef{&vhoRef},
+			}
+		}
+		delegateRouteOptions := func() {
+			rtoRef := rto.Metadata.Ref()
+			snap.RouteTables[0].Routes[0].OptionsConfigRefs = &gatewayv1.DelegateOptionsRefs{
+				DelegateOptions: []*core.ResourceRef{&rtoRef},
+			}
+		}
+
+		Context("validating a virtual host option", func() {
+			It("validates the proxies of the virtual services delegating to it", func() {
+				vc.validateProxy = acceptProxy
+				delegateVirtualHostOptions()
+				err := v.Sync(context.TODO(), snap)
+				Expect(err).NotTo(HaveOccurred())
+				proxyReports, err := v.ValidateVirtualHostOption(context.TODO(), vho, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(proxyReports).To(HaveLen(1))
+				Expect(proxyReports).To(HaveKey(ContainSubstring("listener-::-8080")))
+			})
+			It("validates the virtual services selecting it", func() {
+				vc.validateProxy = failProxy
+				snap.VirtualServices[0].VirtualHost.OptionsConfigRefs = &gatewayv1.DelegateOptionsRefs{
+					Selector: &gatewayv1.DelegateOptionsSelector{Labels: map[string]string{"team": "a"}},
+				}
+				err := v.Sync(context.TODO(), snap)
+				Expect(err).NotTo(HaveOccurred())
+				vho.Metadata.Labels = map[string]string{"team": "a"}
+				proxyReports, err := v.ValidateVirtualHostOption(context.TODO(), vho, false)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("failed to validate Proxy with Gloo validation server"))
+				Expect(proxyReports).To(HaveLen(1))
+			})
+			It("does not validate any proxy when no virtual service delegates to it", func() {
+				vc.validateProxy = failProxy
+				err := v.Sync(context.TODO(), snap)
+				Expect(err).NotTo(HaveOccurred())
+				proxyReports, err := v.ValidateVirtualHostOption(context.TODO(), vho, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(proxyReports).To(BeEmpty())
+			})
+		})
+
+		Context("validating a route option", func() {
+			It("validates the proxies of the virtual services delegating to it through route tables", func() {
+				vc.validateProxy = failProxy
+				delegateRouteOptions()
+				err := v.Sync(context.TODO(), snap)
+				Expect(err).NotTo(HaveOccurred())
+				proxyReports, err := v.ValidateRouteOption(context.TODO(), rto, false)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("failed to validate Proxy with Gloo validation server"))
+				Expect(proxyReports).To(HaveLen(1))
+			})
+			It("does not validate any proxy when no route delegates to it", func() {
+				vc.validateProxy = failProxy
+				err := v.Sync(context.TODO(), snap)
+				Expect(err).NotTo(HaveOccurred())
+				proxyReports, err := v.ValidateRouteOption(context.TODO(), rto, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(proxyReports).To(BeEmpty())
+			})
+		})
+
+		Context("delete a virtual host option", func() {
+			It("rejects deletion when a virtual service references it", func() {
+				vc.validateProxy = acceptProxy
+				delegateVirtualHostOptions()
+				err := v.Sync(context.TODO(), snap)
+				Expect(err).NotTo(HaveOccurred())
+				err = v.ValidateDeleteVirtualHostOption(context.TODO(), vho.Metadata.Ref(), false)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("Deletion blocked because active Virtual Hosts delegate their options to this Virtual Host Option. "+
+					"Remove the references to this virtual host option from the virtual services: [%v], then try again", snap.VirtualServices[0].Metadata.Ref())))
+			})
+			It("deletes unreferenced virtual host options safely", func() {
+				vc.validateProxy = acceptProxy
+				err := v.Sync(context.TODO(), snap)
+				Expect(err).NotTo(HaveOccurred())
+				err = v.ValidateDeleteVirtualHostOption(context.TODO(), vho.Metadata.Ref(), false)
+				Expect(err).NotTo(HaveOccurred())
+
+				// ensure the option was removed from validator internal snapshot
+				_, err = v.latestSnapshot.VirtualHostOptions.Find(vho.Metadata.Ref().Strings())
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("delete a route option", func() {
+			It("rejects deletion when a route table references it", func() {
+				vc.validateProxy = acceptProxy
+				delegateRouteOptions()
+				err := v.Sync(context.TODO(), snap)
+				Expect(err).NotTo(HaveOccurred())
+				err = v.ValidateDeleteRouteOption(context.TODO(), rto.Metadata.Ref(), false)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("Deletion blocked because active Routes delegate their options to this Route Option. "+
+					"Remove the references to this route option from the virtual services: [] and the route tables: [%v], then try again", snap.RouteTables[0].Metadata.Ref())))
+			})
+			It("deletes unreferenced route options safely", func() {
+				vc.validateProxy = acceptProxy
+				err := v.Sync(context.TODO(), snap)
+				Expect(err).NotTo(HaveOccurred())
+				err = v.ValidateDeleteRouteOption(context.TODO(), rto.Metadata.Ref(), false)
+				Expect(err).NotTo(HaveOccurred())
+
+				// ensure the option was removed from validator internal snapshot
+				_, err = v.latestSnapshot.RouteOptions.Find(rto.Metadata.Ref().Strings())
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
 	Context("validating a gateway", func() {
 
 		Context("proxy validation returns error", func() {
@@ -754,6 +1134,13 @@ func failProxy(ctx context.Context, in *validation.ProxyValidationServiceRequest
 	return &validation.ProxyValidationServiceResponse{ProxyReport: rpt}, nil
 }
 
+func failTranslation(ctx context.Context, in *validation.ProxyValidationServiceRequest, opts ...grpc.CallOption) (*validation.ProxyValidationServiceResponse, error) {
+	return &validation.ProxyValidationServiceResponse{
+		ProxyReport:       validationutils.MakeReport(in.Proxy),
+		TranslationErrors: []string{"upstream {test gloo-system}: cluster was configured improperly"},
+	}, nil
+}
+
 func communicationErr(ctx context.Context, in *validation.ProxyValidationServiceRequest, opts ...grpc.CallOption) (*validation.ProxyValidationServiceResponse, error) {
 	return nil, eris.Errorf("communication no good")
 }
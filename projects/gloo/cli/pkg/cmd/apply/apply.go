@@ -0,0 +1,191 @@
+package apply
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/cliutil"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/prerun"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	MissingFileErr     = eris.New("please provide the manifests with the file flag")
+	NoConfigBackendErr = eris.New("the resources are applied to the Consul or directory config backend: " +
+		"use --use-consul or --config-directory, or kubectl apply on Kubernetes")
+)
+
+func ApplyCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               constants.APPLY_COMMAND.Use,
+		Short:             constants.APPLY_COMMAND.Short,
+		Long:              constants.APPLY_COMMAND.Long,
+		PersistentPreRunE: enableConfigBackend(opts),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWithManifests(opts, func(r io.Reader) error {
+				return Apply(opts, r, os.Stdout)
+			})
+		},
+	}
+	addFlags(cmd, opts)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func DiffCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               constants.DIFF_COMMAND.Use,
+		Short:             constants.DIFF_COMMAND.Short,
+		Long:              constants.DIFF_COMMAND.Long,
+		PersistentPreRunE: enableConfigBackend(opts),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWithManifests(opts, func(r io.Reader) error {
+				return Diff(opts, r, os.Stdout)
+			})
+		},
+	}
+	addFlags(cmd, opts)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func addFlags(cmd *cobra.Command, opts *options.Options) {
+	flagutils.AddFileFlag(cmd.LocalFlags(), &opts.Top.File)
+	flagutils.AddNamespaceFlag(cmd.PersistentFlags(), &opts.Metadata.Namespace)
+}
+
+func enableConfigBackend(opts *options.Options) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if err := prerun.CallParentPrerun(cmd, args); err != nil {
+			return err
+		}
+		if !opts.Top.Consul.UseConsul && opts.Top.ConfigDirectory == "" {
+			return NoConfigBackendErr
+		}
+		return prerun.EnableConsulClients(opts)
+	}
+}
+
+func runWithManifests(opts *options.Options, run func(r io.Reader) error) error {
+	switch opts.Top.File {
+	case "":
+		return MissingFileErr
+	case "-":
+		return run(os.Stdin)
+	default:
+		file, err := cliutil.GetResource(opts.Top.File)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		return run(file)
+	}
+}
+
+// Apply writes the resources of the manifests to the config backend, after printing the changes to the existing
+// resources. The resources that do not change are not written.
+func Apply(opts *options.Options, r io.Reader, w io.Writer) error {
+	resourceDiffs, err := diffManifests(opts, r)
+	if err != nil {
+		return err
+	}
+	printDiffs(resourceDiffs, w)
+
+	var created, configured int
+	for _, resourceDiff := range resourceDiffs {
+		if len(resourceDiff.changes) == 0 {
+			continue
+		}
+		resource := resourceDiff.resource
+		writeOpts := clients.WriteOpts{Ctx: opts.Top.Ctx}
+		if resourceDiff.existing != nil {
+			meta := resource.GetMetadata()
+			meta.ResourceVersion = resourceDiff.existing.GetMetadata().ResourceVersion
+			resource.SetMetadata(meta)
+			writeOpts.OverwriteExisting = true
+		}
+		if _, err := resourceDiff.kind.Client(resource.GetMetadata().Namespace).Write(resource, writeOpts); err != nil {
+			return eris.Wrapf(err, "writing %v %v", resourceDiff.kind.Crd.KindName, resource.GetMetadata().Ref().Key())
+		}
+		if resourceDiff.existing == nil {
+			created++
+		} else {
+			configured++
+		}
+	}
+	fmt.Fprintf(w, "applied %d resources: %d created, %d configured, %d unchanged\n",
+		len(resourceDiffs), created, configured, len(resourceDiffs)-created-configured)
+	return nil
+}
+
+// Diff prints the changes that applying the manifests would make to the resources of the config backend
+func Diff(opts *options.Options, r io.Reader, w io.Writer) error {
+	resourceDiffs, err := diffManifests(opts, r)
+	if err != nil {
+		return err
+	}
+	printDiffs(resourceDiffs, w)
+	return nil
+}
+
+// the changes to the existing resource of a resource of the manifests
+type resourceDiff struct {
+	manifestResource
+	// nil if the resource does not exist
+	existing resources.InputResource
+	changes  []FieldChange
+}
+
+func diffManifests(opts *options.Options, r io.Reader) ([]resourceDiff, error) {
+	manifestResources, err := readManifests(r, opts.Metadata.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	var out []resourceDiff
+	for _, manifestResource := range manifestResources {
+		meta := manifestResource.resource.GetMetadata()
+		var existing resources.InputResource
+		read, err := manifestResource.kind.Client(meta.Namespace).Read(meta.Namespace, meta.Name, clients.ReadOpts{Ctx: opts.Top.Ctx})
+		switch {
+		case err == nil:
+			existing = read.(resources.InputResource)
+		case !errors.IsNotExist(err):
+			return nil, eris.Wrapf(err, "reading %v %v", manifestResource.kind.Crd.KindName, meta.Ref().Key())
+		}
+		changes, err := diffResources(existing, manifestResource.resource)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, resourceDiff{
+			manifestResource: manifestResource,
+			existing:         existing,
+			changes:          changes,
+		})
+	}
+	return out, nil
+}
+
+func printDiffs(resourceDiffs []resourceDiff, w io.Writer) {
+	for _, resourceDiff := range resourceDiffs {
+		state := "changed"
+		switch {
+		case resourceDiff.existing == nil:
+			state = "new"
+		case len(resourceDiff.changes) == 0:
+			state = "unchanged"
+		}
+		fmt.Fprintf(w, "%v %v: %v\n", resourceDiff.kind.Crd.KindName, resourceDiff.resource.GetMetadata().Ref().Key(), state)
+		for _, change := range resourceDiff.changes {
+			fmt.Fprintf(w, "  %v\n", change)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package apply_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/apply"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+)
+
+var _ = Describe("Apply", func() {
+
+	const manifests = `
+apiVersion: gloo.solo.io/v1
+kind: Upstream
+metadata:
+  name: petstore
+  labels:
+    app: petstore
+spec:
+  static:
+    hosts:
+    - addr: petstore.example.com
+      port: 80
+---
+apiVersion: gateway.solo.io/v1
+kind: VirtualService
+metadata:
+  name: petstore
+  namespace: default
+spec:
+  virtualHost:
+    domains:
+    - petstore.example.com
+    routes:
+    - matchers:
+      - prefix: /api
+      routeAction:
+        single:
+          upstream:
+            name: petstore
+            namespace: gloo-system
+`
+
+	var opts *options.Options
+
+	BeforeEach(func() {
+		helpers.UseMemoryClients()
+		opts = &options.Options{
+			Top: options.Top{Ctx: context.Background()},
+		}
+		opts.Metadata.Namespace = "gloo-system"
+	})
+
+	AfterEach(func() {
+		helpers.UseDefaultClients()
+	})
+
+	applyManifests := func(manifests string) string {
+		var out bytes.Buffer
+		err := apply.Apply(opts, strings.NewReader(manifests), &out)
+		Expect(err).NotTo(HaveOccurred())
+		return out.String()
+	}
+
+	It("creates the resources of the manifests", func() {
+		out := applyManifests(manifests)
+		Expect(out).To(ContainSubstring("Upstream gloo-system.petstore: new\n"))
+		Expect(out).To(ContainSubstring(`  + metadata: {"labels":{"app":"petstore"}}`))
+		Expect(out).To(ContainSubstring("VirtualService default.petstore: new\n"))
+		Expect(out).To(HaveSuffix("applied 2 resources: 2 created, 0 configured, 0 unchanged\n"))
+
+		us, err := helpers.MustNamespacedUpstreamClient("gloo-system").Read("gloo-system", "petstore", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(us.GetStatic().GetHosts()[0].GetAddr()).To(Equal("petstore.example.com"))
+		Expect(us.GetMetadata().Labels).To(Equal(map[string]string{"app": "petstore"}))
+
+		vs, err := helpers.MustNamespacedVirtualServiceClient("default").Read("default", "petstore", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vs.GetVirtualHost().GetRoutes()[0].GetMatchers()[0].GetPrefix()).To(Equal("/api"))
+	})
+
+	It("does not write the resources that do not change", func() {
+		applyManifests(manifests)
+		out := applyManifests(manifests)
+		Expect(out).To(Equal("Upstream gloo-system.petstore: unchanged\n" +
+			"VirtualService default.petstore: unchanged\n" +
+			"applied 2 resources: 0 created, 0 configured, 2 unchanged\n"))
+	})
+
+	It("prints the changed fields of the existing resources", func() {
+		applyManifests(manifests)
+		changed := strings.Replace(manifests, "prefix: /api", "prefix: /v2", 1)
+		changed = strings.Replace(changed, "    app: petstore\n", "", 1)
+		changed = strings.Replace(changed, "  labels:\n", "", 1)
+
+		var out bytes.Buffer
+		err := apply.Diff(opts, strings.NewReader(changed), &out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(Equal("Upstream gloo-system.petstore: changed\n" +
+			`  - metadata: {"labels":{"app":"petstore"}}` + "\n" +
+			"VirtualService default.petstore: changed\n" +
+			`  ~ spec.virtualHost.routes[0].matchers[0].prefix: "/api" -> "/v2"` + "\n"))
+
+		// diff does not write the changes
+		vs, err := helpers.MustNamespacedVirtualServiceClient("default").Read("default", "petstore", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vs.GetVirtualHost().GetRoutes()[0].GetMatchers()[0].GetPrefix()).To(Equal("/api"))
+
+		out.Reset()
+		err = apply.Apply(opts, strings.NewReader(changed), &out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(HaveSuffix("applied 2 resources: 0 created, 2 configured, 0 unchanged\n"))
+		vs, err = helpers.MustNamespacedVirtualServiceClient("default").Read("default", "petstore", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vs.GetVirtualHost().GetRoutes()[0].GetMatchers()[0].GetPrefix()).To(Equal("/v2"))
+	})
+
+	It("does not write any resource when a manifest is not supported", func() {
+		err := apply.Apply(opts, strings.NewReader(manifests+"---\napiVersion: v1\nkind: Service\nmetadata:\n  name: petstore\n"), &bytes.Buffer{})
+		Expect(err).To(MatchError(apply.UnsupportedKindErr("v1", "Service")))
+
+		_, err = helpers.MustNamespacedUpstreamClient("gloo-system").Read("gloo-system", "petstore", clients.ReadOpts{})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,167 @@
+package apply
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+)
+
+// ChangeType is the type of the change of a field of a resource
+type ChangeType string
+
+const (
+	FieldAdded   ChangeType = "+"
+	FieldRemoved ChangeType = "-"
+	FieldChanged ChangeType = "~"
+)
+
+// FieldChange is a change of a field of a resource
+type FieldChange struct {
+	Type ChangeType
+	// the path of the field, e.g. `spec.virtualHost.routes[0].matchers[0].prefix`
+	Path     string
+	OldValue interface{}
+	NewValue interface{}
+}
+
+func (c FieldChange) String() string {
+	switch c.Type {
+	case FieldAdded:
+		return fmt.Sprintf("%v %v: %v", c.Type, c.Path, formatValue(c.NewValue))
+	case FieldRemoved:
+		return fmt.Sprintf("%v %v: %v", c.Type, c.Path, formatValue(c.OldValue))
+	default:
+		return fmt.Sprintf("%v %v: %v -> %v", c.Type, c.Path, formatValue(c.OldValue), formatValue(c.NewValue))
+	}
+}
+
+func formatValue(value interface{}) string {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(raw)
+}
+
+// diffResources returns the changes of the fields of the resource that users set: its labels, annotations and spec.
+// The existing resource is nil if the resource does not exist yet.
+func diffResources(existing, desired resources.InputResource) ([]FieldChange, error) {
+	// all the fields of a new resource are added
+	existingFields := map[string]interface{}{}
+	if existing != nil {
+		fields, err := userFields(existing)
+		if err != nil {
+			return nil, err
+		}
+		existingFields = fields
+	}
+	desiredFields, err := userFields(desired)
+	if err != nil {
+		return nil, err
+	}
+	return DiffValues("", existingFields, desiredFields), nil
+}
+
+// userFields returns the fields of the resource in the layout of its Kubernetes manifest
+func userFields(resource resources.InputResource) (map[string]interface{}, error) {
+	clone := resources.Clone(resource).(resources.InputResource)
+	clone.SetMetadata(core.Metadata{})
+	clone.SetStatus(core.Status{})
+	spec, err := protoutils.MarshalMap(clone)
+	if err != nil {
+		return nil, err
+	}
+	delete(spec, "metadata")
+	delete(spec, "status")
+
+	fields := map[string]interface{}{}
+	metadata := map[string]interface{}{}
+	if labels := resource.GetMetadata().Labels; len(labels) > 0 {
+		metadata["labels"] = stringMap(labels)
+	}
+	if annotations := resource.GetMetadata().Annotations; len(annotations) > 0 {
+		metadata["annotations"] = stringMap(annotations)
+	}
+	if len(metadata) > 0 {
+		fields["metadata"] = metadata
+	}
+	if len(spec) > 0 {
+		fields["spec"] = spec
+	}
+	return fields, nil
+}
+
+func stringMap(in map[string]string) map[string]interface{} {
+	out := make(map[string]interface{}, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
+
+// DiffValues returns the changes from the old value to the new value, which are unmarshalled JSON values.
+// Maps are compared by key and lists by index, so that the paths of the changes are as precise as possible.
+func DiffValues(path string, oldValue, newValue interface{}) []FieldChange {
+	if reflect.DeepEqual(oldValue, newValue) {
+		return nil
+	}
+	if oldValue == nil {
+		return []FieldChange{{Type: FieldAdded, Path: path, NewValue: newValue}}
+	}
+	if newValue == nil {
+		return []FieldChange{{Type: FieldRemoved, Path: path, OldValue: oldValue}}
+	}
+
+	switch oldTyped := oldValue.(type) {
+	case map[string]interface{}:
+		newTyped, ok := newValue.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := map[string]bool{}
+		for key := range oldTyped {
+			keys[key] = true
+		}
+		for key := range newTyped {
+			keys[key] = true
+		}
+		sortedKeys := make([]string, 0, len(keys))
+		for key := range keys {
+			sortedKeys = append(sortedKeys, key)
+		}
+		sort.Strings(sortedKeys)
+
+		var changes []FieldChange
+		for _, key := range sortedKeys {
+			keyPath := key
+			if path != "" {
+				keyPath = path + "." + key
+			}
+			changes = append(changes, DiffValues(keyPath, oldTyped[key], newTyped[key])...)
+		}
+		return changes
+	case []interface{}:
+		newTyped, ok := newValue.([]interface{})
+		if !ok {
+			break
+		}
+		var changes []FieldChange
+		for i := 0; i < len(oldTyped) || i < len(newTyped); i++ {
+			var oldItem, newItem interface{}
+			if i < len(oldTyped) {
+				oldItem = oldTyped[i]
+			}
+			if i < len(newTyped) {
+				newItem = newTyped[i]
+			}
+			changes = append(changes, DiffValues(fmt.Sprintf("%v[%d]", path, i), oldItem, newItem)...)
+		}
+		return changes
+	}
+	return []FieldChange{{Type: FieldChanged, Path: path, OldValue: oldValue, NewValue: newValue}}
+}
@@ -0,0 +1,126 @@
+package apply
+
+import (
+	"io"
+
+	"github.com/rotisserie/eris"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	extauth "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/crd"
+	crdv1 "github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/crd/solo.io/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/utils/kubeutils"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	kubeyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+var (
+	UnsupportedKindErr = func(apiVersion, kind string) error {
+		return eris.Errorf("unsupported resource %v %v: expected a Gloo or Gateway resource", apiVersion, kind)
+	}
+	MissingNameErr = func(kind string) error {
+		return eris.Errorf("a %v of the manifests has no name", kind)
+	}
+)
+
+// ResourceKind is a kind of the Gloo resources that can be applied
+type ResourceKind struct {
+	Crd crd.Crd
+	// returns the client of the resources of the kind in the namespace
+	Client func(namespace string) clients.ResourceClient
+}
+
+// ResourceKinds are the kinds of the resources that can be applied, in the order they are exported
+var ResourceKinds = []ResourceKind{
+	{Crd: v1.UpstreamCrd, Client: func(namespace string) clients.ResourceClient {
+		return helpers.MustNamespacedUpstreamClient(namespace).BaseClient()
+	}},
+	{Crd: v1.UpstreamGroupCrd, Client: func(namespace string) clients.ResourceClient {
+		return helpers.MustNamespacedUpstreamGroupClient(namespace).BaseClient()
+	}},
+	{Crd: v1.SettingsCrd, Client: func(namespace string) clients.ResourceClient {
+		return helpers.MustNamespacedSettingsClient(namespace).BaseClient()
+	}},
+	{Crd: v1.ProxyCrd, Client: func(namespace string) clients.ResourceClient {
+		return helpers.MustNamespacedProxyClient(namespace).BaseClient()
+	}},
+	{Crd: gatewayv1.GatewayCrd, Client: func(namespace string) clients.ResourceClient {
+		return helpers.MustNamespacedGatewayClient(namespace).BaseClient()
+	}},
+	{Crd: gatewayv1.VirtualServiceCrd, Client: func(namespace string) clients.ResourceClient {
+		return helpers.MustNamespacedVirtualServiceClient(namespace).BaseClient()
+	}},
+	{Crd: gatewayv1.RouteTableCrd, Client: func(namespace string) clients.ResourceClient {
+		return helpers.MustNamespacedRouteTableClient(namespace).BaseClient()
+	}},
+	{Crd: extauth.AuthConfigCrd, Client: func(namespace string) clients.ResourceClient {
+		return helpers.MustNamespacedAuthConfigClient(namespace).BaseClient()
+	}},
+}
+
+// a resource of the manifests, and the kind it is written with
+type manifestResource struct {
+	kind     ResourceKind
+	resource resources.InputResource
+}
+
+// readManifests reads the Gloo resources of the Kubernetes manifests, which can be YAML or JSON documents.
+// The resources without a namespace are put in the default namespace.
+func readManifests(r io.Reader, defaultNamespace string) ([]manifestResource, error) {
+	var out []manifestResource
+	decoder := kubeyaml.NewYAMLOrJSONDecoder(r, 4096)
+	for {
+		var kubeResource crdv1.Resource
+		if err := decoder.Decode(&kubeResource); err != nil {
+			if err == io.EOF {
+				return out, nil
+			}
+			return nil, eris.Wrapf(err, "parsing the manifests")
+		}
+		if kubeResource.Kind == "" && kubeResource.APIVersion == "" {
+			// empty document
+			continue
+		}
+		resource, err := fromKubeResource(&kubeResource, defaultNamespace)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *resource)
+	}
+}
+
+func fromKubeResource(kubeResource *crdv1.Resource, defaultNamespace string) (*manifestResource, error) {
+	gvk := kubeResource.GroupVersionKind()
+	for _, kind := range ResourceKinds {
+		if kind.Crd.GroupVersionKind() != gvk {
+			continue
+		}
+		if kubeResource.Name == "" {
+			return nil, MissingNameErr(gvk.Kind)
+		}
+		meta := kubeutils.FromKubeMeta(kubeResource.ObjectMeta)
+		if meta.Namespace == "" {
+			meta.Namespace = defaultNamespace
+		}
+		// only the fields that users set are kept, as the other ones are owned by the config backend
+		meta.ResourceVersion = ""
+		meta.Generation = 0
+
+		resource := kind.Client(meta.Namespace).NewResource().(resources.InputResource)
+		if kubeResource.Spec != nil {
+			if customResource, ok := resource.(resources.CustomInputResource); ok {
+				if err := customResource.UnmarshalSpec(*kubeResource.Spec); err != nil {
+					return nil, eris.Wrapf(err, "parsing the spec of %v %v", gvk.Kind, meta.Ref().Key())
+				}
+			} else if err := protoutils.UnmarshalMap(*kubeResource.Spec, resource); err != nil {
+				return nil, eris.Wrapf(err, "parsing the spec of %v %v", gvk.Kind, meta.Ref().Key())
+			}
+		}
+		resource.SetMetadata(meta)
+		return &manifestResource{kind: kind, resource: resource}, nil
+	}
+	return nil, UnsupportedKindErr(kubeResource.APIVersion, kubeResource.Kind)
+}
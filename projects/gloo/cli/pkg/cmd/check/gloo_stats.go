@@ -30,6 +30,11 @@ var (
 )
 
 func ResourcesSyncedOverXds(stats, deploymentName string) bool {
+	return len(outOfSyncResources(stats, deploymentName)) == 0
+}
+
+// outOfSyncResources returns the types of the resources that have not been accepted by all the xDS clients
+func outOfSyncResources(stats, deploymentName string) []string {
 	var outOfSyncResources []string
 	metrics := parseMetrics(stats, []string{glooeTotalEntites, glooeInSyncEntities}, deploymentName)
 	for metric, val := range metrics {
@@ -42,35 +47,28 @@ func ResourcesSyncedOverXds(stats, deploymentName string) bool {
 			}
 		}
 	}
-	if len(outOfSyncResources) > 0 {
-		fmt.Println(resourcesOutOfSyncMessage(outOfSyncResources))
-		return false
-	}
-	return true
+	return outOfSyncResources
 }
 
 func RateLimitIsConnected(stats string) bool {
-	connectedStateErrMessage := "The rate limit server is out of sync with the Gloo control plane and is not receiving valid gloo config.\n" +
-		"You may want to try using the `glooctl debug logs --errors-only` command to find any relevant error logs."
-
 	metrics := parseMetrics(stats, []string{GlooeRateLimitConnectedState}, "gloo")
 
 	if val, ok := metrics[GlooeRateLimitConnectedState]; ok && val == 0 {
-		fmt.Println(connectedStateErrMessage)
 		return false
 	}
 
 	return true
 }
 
-func checkGlooePromStats(ctx context.Context, glooNamespace string, deployments *v1.DeploymentList) (bool, error) {
+func (c *checker) checkGlooePromStats(ctx context.Context, glooNamespace string, deployments *v1.DeploymentList) error {
+	const check = "xds"
 	errMessage := "Problem while checking for gloo xds errors"
 
 	// port-forward proxy deployment and get prometheus metrics
 	freePort, err := cliutil.GetFreePort()
 	if err != nil {
-		fmt.Println(errMessage)
-		return false, err
+		c.printf("%s\n", errMessage)
+		return err
 	}
 	localPort := strconv.Itoa(freePort)
 	adminPort := strconv.Itoa(int(defaults.GlooAdminPort))
@@ -78,8 +76,8 @@ func checkGlooePromStats(ctx context.Context, glooNamespace string, deployments
 	stats, portFwdCmd, err := cliutil.PortForwardGet(ctx, glooNamespace, "deploy/"+glooDeployment,
 		localPort, adminPort, false, glooStatsPath)
 	if err != nil {
-		fmt.Println(errMessage)
-		return false, err
+		c.printf("%s\n", errMessage)
+		return err
 	}
 	if portFwdCmd.Process != nil {
 		defer portFwdCmd.Process.Release()
@@ -87,23 +85,40 @@ func checkGlooePromStats(ctx context.Context, glooNamespace string, deployments
 	}
 
 	if strings.TrimSpace(stats) == "" {
-		fmt.Println(errMessage+": could not find any metrics at", glooStatsPath, "endpoint of the "+glooDeployment+" deployment")
-		return false, nil
+		message := errMessage + ": could not find any metrics at " + glooStatsPath + " endpoint of the " + glooDeployment + " deployment"
+		c.printf("%s\n", message)
+		c.report.add(&Finding{Check: check, Class: ClassDataPlane, Severity: SeverityError, Message: message})
+		return nil
 	}
 
-	if !ResourcesSyncedOverXds(stats, glooDeployment) {
-		return false, nil
+	if resources := outOfSyncResources(stats, glooDeployment); len(resources) > 0 {
+		message := resourcesOutOfSyncMessage(resources)
+		c.printf("%s\n", message)
+		c.report.add(&Finding{Check: check, Class: ClassDataPlane, Severity: SeverityError, Message: message})
+		return nil
 	}
 
 	for _, deployment := range deployments.Items {
 		if deployment.Name == rateLimitDeployment {
-			fmt.Printf("Checking rate limit server... ")
+			c.printf("Checking rate limit server... ")
 			if !RateLimitIsConnected(stats) {
-				return false, nil
+				message := "The rate limit server is out of sync with the Gloo control plane and is not receiving valid gloo config.\n" +
+					"You may want to try using the `glooctl debug logs --errors-only` command to find any relevant error logs."
+				c.printf("%s\n", message)
+				c.report.add(&Finding{
+					Check:     "ratelimit",
+					Class:     ClassDataPlane,
+					Severity:  SeverityError,
+					Kind:      "Deployment",
+					Namespace: deployment.Namespace,
+					Name:      deployment.Name,
+					Message:   message,
+				})
+				return nil
 			}
-			fmt.Printf("OK\n")
+			c.printf("OK\n")
 		}
 	}
 
-	return true, nil
+	return nil
 }
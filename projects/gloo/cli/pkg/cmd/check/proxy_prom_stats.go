@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"os"
 	"reflect"
 	"strconv"
 	"strings"
@@ -20,19 +21,26 @@ const promStatsPath = "/stats/prometheus"
 
 const metricsUpdateInterval = time.Millisecond * 250
 
-func checkProxiesPromStats(ctx context.Context, glooNamespace string, deployments *v1.DeploymentList) (bool, error) {
+func (c *checker) checkProxiesPromStats(ctx context.Context, glooNamespace string, deployments *v1.DeploymentList) error {
+	ok := true
 	for _, deployment := range deployments.Items {
 		if deployment.Name == "gateway-proxy" || deployment.Name == "ingress-proxy" || deployment.Name == "knative-external-proxy" || deployment.Name == "knative-internal-proxy" {
-			if passed, err := checkProxyPromStats(ctx, glooNamespace, deployment.Name); !passed || err != nil {
-				return passed, err
+			passed, err := c.checkProxyPromStats(ctx, glooNamespace, deployment.Name)
+			if err != nil {
+				return err
+			}
+			if !passed {
+				ok = false
 			}
 		}
 	}
-	fmt.Printf("OK\n")
-	return true, nil
+	if ok {
+		c.printf("OK\n")
+	}
+	return nil
 }
 
-func checkProxyPromStats(ctx context.Context, glooNamespace string, deploymentName string) (bool, error) {
+func (c *checker) checkProxyPromStats(ctx context.Context, glooNamespace string, deploymentName string) (bool, error) {
 
 	// check if any proxy instances are out of sync with the Gloo control plane
 	errMessage := "Problem while checking for out of sync proxies"
@@ -40,7 +48,7 @@ func checkProxyPromStats(ctx context.Context, glooNamespace string, deploymentNa
 	// port-forward proxy deployment and get prometheus metrics
 	freePort, err := cliutil.GetFreePort()
 	if err != nil {
-		fmt.Println(errMessage)
+		c.printf("%s\n", errMessage)
 		return false, err
 	}
 	localPort := strconv.Itoa(freePort)
@@ -49,7 +57,7 @@ func checkProxyPromStats(ctx context.Context, glooNamespace string, deploymentNa
 	stats, portFwdCmd, err := cliutil.PortForwardGet(ctx, glooNamespace, "deploy/"+deploymentName,
 		localPort, adminPort, false, promStatsPath)
 	if err != nil {
-		fmt.Println(errMessage)
+		c.printf("%s\n", errMessage)
 		return false, err
 	}
 	if portFwdCmd.Process != nil {
@@ -57,33 +65,55 @@ func checkProxyPromStats(ctx context.Context, glooNamespace string, deploymentNa
 		defer portFwdCmd.Process.Kill()
 	}
 
-	if !checkProxyConnectedState(stats, deploymentName, errMessage,
+	if message := checkProxyConnectedState(stats, deploymentName, errMessage,
 		"Your "+deploymentName+" is out of sync with the Gloo control plane and is not receiving valid gloo config.\n"+
-			"You may want to try using the `glooctl proxy logs` or `glooctl debug logs` commands.") {
+			"You may want to try using the `glooctl proxy logs` or `glooctl debug logs` commands."); message != "" {
+		c.addProxyFinding(glooNamespace, deploymentName, message)
 		return false, nil
 	}
 
-	return checkProxyUpdate(stats, localPort, deploymentName, errMessage)
+	message, err := checkProxyUpdate(stats, localPort, deploymentName, errMessage)
+	if err != nil {
+		c.printf("%s\n", errMessage)
+		return false, err
+	}
+	if message != "" {
+		c.addProxyFinding(glooNamespace, deploymentName, message)
+		return false, nil
+	}
+	return true, nil
+}
+
+func (c *checker) addProxyFinding(namespace, deploymentName, message string) {
+	c.printf("%s\n", message)
+	c.report.add(&Finding{
+		Check:     "proxies",
+		Class:     ClassDataPlane,
+		Severity:  SeverityError,
+		Kind:      "Deployment",
+		Namespace: namespace,
+		Name:      deploymentName,
+		Message:   message,
+	})
 }
 
-// checks that envoy_control_plane_connected_state metric has a value of 1
-func checkProxyConnectedState(stats string, deploymentName string, genericErrMessage string, connectedStateErrMessage string) bool {
+// checks that envoy_control_plane_connected_state metric has a value of 1, and returns the problem otherwise
+func checkProxyConnectedState(stats string, deploymentName string, genericErrMessage string, connectedStateErrMessage string) string {
 
 	if strings.TrimSpace(stats) == "" {
-		fmt.Println(genericErrMessage+": could not find any metrics at", promStatsPath, "endpoint of the "+deploymentName+" deployment")
-		return false
+		return genericErrMessage + ": could not find any metrics at " + promStatsPath + " endpoint of the " + deploymentName + " deployment"
 	}
 
 	if !strings.Contains(stats, "envoy_control_plane_connected_state{} 1") {
-		fmt.Println(connectedStateErrMessage)
-		return false
+		return connectedStateErrMessage
 	}
 
-	return true
+	return ""
 }
 
-// checks that update_rejected and update_failure stats have not increased by getting stats from /stats/prometheus again
-func checkProxyUpdate(stats string, localPort string, deploymentName string, errMessage string) (bool, error) {
+// checks that update_rejected and update_failure stats have not increased by getting stats from /stats/prometheus
+// again, and returns the problem otherwise
+func checkProxyUpdate(stats string, localPort string, deploymentName string, errMessage string) (string, error) {
 
 	// wait for metrics to update
 	time.Sleep(metricsUpdateInterval)
@@ -91,24 +121,20 @@ func checkProxyUpdate(stats string, localPort string, deploymentName string, err
 	// gather metrics again
 	res, err := http.Get("http://localhost:" + localPort + promStatsPath)
 	if err != nil {
-		fmt.Println(errMessage)
-		return false, err
+		return "", err
 	}
 	if res.StatusCode != 200 {
-		fmt.Println(errMessage+": received unexpected status code", res.StatusCode, "from", promStatsPath, "endpoint of the "+deploymentName+" deployment")
-		return false, nil
+		return fmt.Sprintf("%s: received unexpected status code %v from %s endpoint of the %s deployment", errMessage, res.StatusCode, promStatsPath, deploymentName), nil
 	}
 	b, err := ioutil.ReadAll(res.Body)
 	if err != nil {
-		fmt.Println(errMessage)
-		return false, err
+		return "", err
 	}
 	res.Body.Close()
 	newStats := string(b)
 
 	if strings.TrimSpace(newStats) == "" {
-		fmt.Println(errMessage+": could not find any metrics at", promStatsPath, "endpoint of the "+deploymentName+" deployment")
-		return false, nil
+		return errMessage + ": could not find any metrics at " + promStatsPath + " endpoint of the " + deploymentName + " deployment", nil
 	}
 
 	// for example, look for stats like "envoy_http_rds_update_attempt" and "envoy_http_rds_update_rejected"
@@ -118,7 +144,7 @@ func checkProxyUpdate(stats string, localPort string, deploymentName string, err
 	newStatsMap := parseMetrics(newStats, desiredMetricsSegments, deploymentName)
 
 	if reflect.DeepEqual(newStatsMap, statsMap) {
-		return true, nil
+		return "", nil
 	}
 
 	for metricName, oldVal := range statsMap {
@@ -126,17 +152,15 @@ func checkProxyUpdate(stats string, localPort string, deploymentName string, err
 		if ok && strings.Contains(metricName, "rejected") && newVal > oldVal {
 			// for example, if envoy_http_rds_update_rejected{envoy_http_conn_manager_prefix="http",envoy_rds_route_config="listener-__-8080-routes"}
 			// increases, which occurs if envoy cannot parse the config from gloo
-			fmt.Printf("An update to your "+deploymentName+" deployment was rejected due to schema/validation errors. The %v metric increased.\n"+
-				"You may want to try using the `glooctl proxy logs` or `glooctl debug logs` commands.\n", metricName)
-			return false, nil
+			return fmt.Sprintf("An update to your "+deploymentName+" deployment was rejected due to schema/validation errors. The %v metric increased.\n"+
+				"You may want to try using the `glooctl proxy logs` or `glooctl debug logs` commands.", metricName), nil
 		} else if ok && strings.Contains(metricName, "failure") && newVal > oldVal {
-			fmt.Printf("An update to your "+deploymentName+" deployment was rejected due to network errors. The %v metric increased.\n"+
-				"You may want to try using the `glooctl proxy logs` or `glooctl debug logs` commands.\n", metricName)
-			return false, nil
+			return fmt.Sprintf("An update to your "+deploymentName+" deployment was rejected due to network errors. The %v metric increased.\n"+
+				"You may want to try using the `glooctl proxy logs` or `glooctl debug logs` commands.", metricName), nil
 		}
 	}
 
-	return true, nil
+	return "", nil
 }
 
 // parseMetrics parses prometheus metrics and returns a map from the metric name and labels to its value.
@@ -161,7 +185,7 @@ func parseMetrics(stats string, desiredMetricSegments []string, deploymentName s
 			metric := strings.Join(pieces[0:len(pieces)-1], "")   // get all but last piece (as one string)- this is metric name and labels
 			metricVal, err := strconv.Atoi(pieces[len(pieces)-1]) // get last piece (as int)- this is metric value
 			if err != nil {
-				fmt.Printf("Found an unexpected format in metrics at %v endpoint of the "+deploymentName+" deployment. "+
+				fmt.Fprintf(os.Stderr, "Found an unexpected format in metrics at %v endpoint of the "+deploymentName+" deployment. "+
 					"Expected %v metric to have an int value but got value %v.\nContinuing check...", promStatsPath, metric, pieces[len(pieces)-1])
 				continue
 			}
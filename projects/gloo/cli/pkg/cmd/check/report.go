@@ -0,0 +1,149 @@
+package check
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/ghodss/yaml"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// Severity is the severity of a finding of the check
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// FailureClass is the kind of problem a finding reports, which determines the exit code of the check
+type FailureClass string
+
+const (
+	// the check could not be run, e.g. because the cluster is not reachable
+	ClassCheckError FailureClass = "CheckError"
+	// the deployments or pods of Gloo are not healthy
+	ClassInstallation FailureClass = "Installation"
+	// Gloo resources are rejected, or reference resources that do not exist
+	ClassConfiguration FailureClass = "Configuration"
+	// the proxies or Gloo components are out of sync with the control plane
+	ClassDataPlane FailureClass = "DataPlane"
+)
+
+// The exit codes of the check. They are part of the interface of glooctl, and must not change.
+const (
+	ExitCodeOk            = 0
+	ExitCodeCheckError    = 1
+	ExitCodeInstallation  = 2
+	ExitCodeConfiguration = 3
+	ExitCodeDataPlane     = 4
+	// only findings with the warning severity
+	ExitCodeWarnings = 5
+)
+
+// the classes of the errors, in the order their exit codes take precedence
+var exitCodesByClass = []struct {
+	class    FailureClass
+	exitCode int
+}{
+	{ClassCheckError, ExitCodeCheckError},
+	{ClassInstallation, ExitCodeInstallation},
+	{ClassConfiguration, ExitCodeConfiguration},
+	{ClassDataPlane, ExitCodeDataPlane},
+}
+
+// Finding is a problem found by the check
+type Finding struct {
+	// the check that found the problem, e.g. `upstreams`
+	Check    string       `json:"check"`
+	Class    FailureClass `json:"class"`
+	Severity Severity     `json:"severity"`
+	// the resource with the problem, if any
+	Kind      string `json:"kind,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Message   string `json:"message"`
+}
+
+// Report is the result of the check
+type Report struct {
+	Findings []*Finding
+}
+
+func (r *Report) add(finding *Finding) {
+	r.Findings = append(r.Findings, finding)
+}
+
+func (r *Report) addResourceFinding(check string, class FailureClass, severity Severity, kind string, meta core.Metadata, message string) {
+	r.add(&Finding{
+		Check:     check,
+		Class:     class,
+		Severity:  severity,
+		Kind:      kind,
+		Namespace: meta.Namespace,
+		Name:      meta.Name,
+		Message:   message,
+	})
+}
+
+// Ok returns whether the check found no problem
+func (r *Report) Ok() bool {
+	return len(r.Findings) == 0
+}
+
+// ExitCode returns the exit code of the class of the errors of the report, or of its warnings if it has no errors
+func (r *Report) ExitCode() int {
+	for _, class := range exitCodesByClass {
+		for _, finding := range r.Findings {
+			if finding.Class == class.class && finding.Severity == SeverityError {
+				return class.exitCode
+			}
+		}
+	}
+	if len(r.Findings) > 0 {
+		return ExitCodeWarnings
+	}
+	return ExitCodeOk
+}
+
+type reportOutput struct {
+	Ok       bool       `json:"ok"`
+	ExitCode int        `json:"exitCode"`
+	Findings []*Finding `json:"findings"`
+}
+
+// Print writes the report in the machine-readable output format
+func (r *Report) Print(outputType printers.OutputType, w io.Writer) error {
+	out := reportOutput{
+		Ok:       r.Ok(),
+		ExitCode: r.ExitCode(),
+		Findings: r.Findings,
+	}
+	if out.Findings == nil {
+		out.Findings = []*Finding{}
+	}
+	var (
+		raw []byte
+		err error
+	)
+	switch outputType {
+	case printers.JSON:
+		raw, err = json.MarshalIndent(out, "", "  ")
+		raw = append(raw, '\n')
+	case printers.YAML:
+		raw, err = yaml.Marshal(out)
+	default:
+		return UnsupportedOutputErr(outputType)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(raw)
+	return err
+}
+
+var UnsupportedOutputErr = func(outputType printers.OutputType) error {
+	return eris.Errorf("unsupported output format %v: expected one of table, json or yaml", outputType.String())
+}
@@ -0,0 +1,91 @@
+package check_test
+
+import (
+	"bytes"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/check"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+)
+
+var _ = Describe("Report", func() {
+
+	finding := func(class check.FailureClass, severity check.Severity) *check.Finding {
+		return &check.Finding{
+			Check:     "upstreams",
+			Class:     class,
+			Severity:  severity,
+			Kind:      "Upstream",
+			Namespace: "gloo-system",
+			Name:      "us",
+			Message:   "problem",
+		}
+	}
+
+	Context("exit code", func() {
+		It("is 0 when there are no findings", func() {
+			report := &check.Report{}
+			Expect(report.Ok()).To(BeTrue())
+			Expect(report.ExitCode()).To(Equal(check.ExitCodeOk))
+		})
+
+		It("is the exit code of the class of the errors", func() {
+			report := &check.Report{Findings: []*check.Finding{
+				finding(check.ClassDataPlane, check.SeverityError),
+				finding(check.ClassConfiguration, check.SeverityError),
+				finding(check.ClassInstallation, check.SeverityWarning),
+			}}
+			Expect(report.Ok()).To(BeFalse())
+			Expect(report.ExitCode()).To(Equal(check.ExitCodeConfiguration))
+		})
+
+		It("is the warnings exit code when there are only warnings", func() {
+			report := &check.Report{Findings: []*check.Finding{
+				finding(check.ClassConfiguration, check.SeverityWarning),
+			}}
+			Expect(report.ExitCode()).To(Equal(check.ExitCodeWarnings))
+		})
+	})
+
+	Context("output", func() {
+		It("prints the findings as json", func() {
+			report := &check.Report{Findings: []*check.Finding{
+				finding(check.ClassConfiguration, check.SeverityError),
+			}}
+			var b bytes.Buffer
+			err := report.Print(printers.JSON, &b)
+			Expect(err).NotTo(HaveOccurred())
+
+			var out map[string]interface{}
+			err = json.Unmarshal(b.Bytes(), &out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(map[string]interface{}{
+				"ok":       false,
+				"exitCode": float64(check.ExitCodeConfiguration),
+				"findings": []interface{}{map[string]interface{}{
+					"check":     "upstreams",
+					"class":     "Configuration",
+					"severity":  "error",
+					"kind":      "Upstream",
+					"namespace": "gloo-system",
+					"name":      "us",
+					"message":   "problem",
+				}},
+			}))
+		})
+
+		It("prints an empty list of findings as yaml", func() {
+			var b bytes.Buffer
+			err := (&check.Report{}).Print(printers.YAML, &b)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(b.String()).To(Equal("exitCode: 0\nfindings: []\nok: true\n"))
+		})
+
+		It("does not print tables", func() {
+			err := (&check.Report{}).Print(printers.TABLE, &bytes.Buffer{})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
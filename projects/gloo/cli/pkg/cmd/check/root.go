@@ -1,23 +1,31 @@
 package check
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/rotisserie/eris"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
 	ratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/external/solo/ratelimit"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	extauth "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
 	rlopts "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
 	"github.com/solo-io/go-utils/cliutils"
 	"github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/spf13/cobra"
 	appsv1 "k8s.io/api/apps/v1"
@@ -46,124 +54,234 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 	cmd := &cobra.Command{
 		Use:   constants.CHECK_COMMAND.Use,
 		Short: constants.CHECK_COMMAND.Short,
+		Long:  constants.CHECK_COMMAND.Long,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ok, err := CheckResources(opts)
-			if err != nil {
-				// Not returning error here because this shouldn't propagate as a standard CLI error, which prints usage.
-				fmt.Printf("Error!\n")
-				fmt.Printf("%s\n", err.Error())
-				os.Exit(1)
-			} else if !ok {
-				fmt.Printf("Problems detected!\n")
-				os.Exit(1)
+			machineReadable := opts.Top.Output == printers.JSON || opts.Top.Output == printers.YAML
+			if !machineReadable && !opts.Top.Output.IsTable() {
+				return UnsupportedOutputErr(opts.Top.Output)
+			}
+
+			var report *Report
+			if opts.Check.Watch {
+				report = WatchResources(opts, os.Stdout, os.Stderr)
+			} else {
+				var out io.Writer = os.Stdout
+				if machineReadable {
+					out = ioutil.Discard
+				}
+				report = RunChecks(opts, out)
+			}
+
+			if machineReadable {
+				if err := report.Print(opts.Top.Output, os.Stdout); err != nil {
+					return err
+				}
 			} else {
-				fmt.Printf("No problems detected.\n")
+				printSummary(report, os.Stdout)
+				if report.Ok() {
+					CheckMulticlusterResources(opts)
+				}
+			}
+			// Not returning an error here because this shouldn't propagate as a standard CLI error, which prints usage.
+			if exitCode := report.ExitCode(); exitCode != ExitCodeOk {
+				os.Exit(exitCode)
 			}
-			CheckMulticlusterResources(opts)
 			return nil
 		},
 	}
 	pflags := cmd.PersistentFlags()
 	flagutils.AddNamespaceFlag(pflags, &opts.Metadata.Namespace)
 	flagutils.AddExcludecheckFlag(pflags, &opts.Top.CheckName)
+	pflags.VarP(&opts.Top.Output, flagutils.OutputFlag, "o", "output format: (table, json, yaml)")
+	pflags.BoolVarP(&opts.Check.Watch, "watch", "w", false, "run the checks until they pass or the watch timeout expires")
+	pflags.DurationVar(&opts.Check.WatchTimeout, "watch-timeout", 10*time.Minute, "how long to wait for the checks to pass with --watch")
+	pflags.DurationVar(&opts.Check.WatchInterval, "watch-interval", 5*time.Second, "the time between the runs of the checks with --watch")
 	cliutils.ApplyOptions(cmd, optionsFunc)
 	return cmd
 }
 
+func printSummary(report *Report, w io.Writer) {
+	for _, finding := range report.Findings {
+		if finding.Class == ClassCheckError {
+			fmt.Fprintf(w, "Error!\n")
+			fmt.Fprintf(w, "%s\n", finding.Message)
+			return
+		}
+	}
+	if !report.Ok() {
+		fmt.Fprintf(w, "Problems detected!\n")
+	} else {
+		fmt.Fprintf(w, "No problems detected.\n")
+	}
+}
+
+// CheckResources runs the checks and prints their results. It returns an error if the checks could not be run,
+// and whether they found no problem otherwise.
 func CheckResources(opts *options.Options) (bool, error) {
+	c := newChecker(opts, os.Stdout)
+	if err := c.run(); err != nil {
+		return false, err
+	}
+	return c.report.Ok(), nil
+}
+
+// RunChecks runs the checks, writing their progress to the writer, and returns the report of the problems they found.
+// An error that prevents the checks from running is reported as a finding of the CheckError class.
+func RunChecks(opts *options.Options, out io.Writer) *Report {
+	c := newChecker(opts, out)
+	if err := c.run(); err != nil {
+		c.report.add(&Finding{
+			Check:    "check",
+			Class:    ClassCheckError,
+			Severity: SeverityError,
+			Message:  err.Error(),
+		})
+	}
+	return c.report
+}
+
+// WatchResources runs the checks until they pass or the watch timeout of the options expires, and returns the report
+// of the last run. The progress of the last run is written to the writer, and the progress of the watch to the
+// status writer.
+func WatchResources(opts *options.Options, out, status io.Writer) *Report {
+	machineReadable := opts.Top.Output == printers.JSON || opts.Top.Output == printers.YAML
+	ctx := opts.Top.Ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	deadline := time.After(opts.Check.WatchTimeout)
+	for {
+		var progress bytes.Buffer
+		report := RunChecks(opts, &progress)
+		if report.Ok() {
+			if !machineReadable {
+				progress.WriteTo(out)
+			}
+			return report
+		}
+		fmt.Fprintf(status, "Waiting for Gloo to converge: %v problems detected\n", len(report.Findings))
+		select {
+		case <-time.After(opts.Check.WatchInterval):
+			continue
+		case <-deadline:
+		case <-ctx.Done():
+		}
+		fmt.Fprintf(status, "Gloo did not converge within %v\n", opts.Check.WatchTimeout)
+		if !machineReadable {
+			progress.WriteTo(out)
+		}
+		return report
+	}
+}
+
+type checker struct {
+	opts *options.Options
+	// where the progress of the checks is written
+	out    io.Writer
+	report *Report
+}
+
+func newChecker(opts *options.Options, out io.Writer) *checker {
+	return &checker{
+		opts:   opts,
+		out:    out,
+		report: &Report{},
+	}
+}
+
+func (c *checker) printf(format string, a ...interface{}) {
+	fmt.Fprintf(c.out, format, a...)
+}
+
+func (c *checker) run() error {
+	opts := c.opts
 	err := checkConnection(opts.Metadata.Namespace)
 	if err != nil {
-		return false, err
+		return err
 	}
 
-	deployments, ok, err := getAndCheckDeployments(opts)
+	deployments, ok, err := c.getAndCheckDeployments()
 	if !ok || err != nil {
-		return ok, err
+		return err
 	}
 
 	includePods := doesNotContain(opts.Top.CheckName, "pods")
 	if includePods {
-		ok, err := checkPods(opts)
+		ok, err := c.checkPods()
 		if !ok || err != nil {
-			return ok, err
+			return err
 		}
 	}
 
 	settings, err := getSettings(opts)
 	if err != nil {
-		return false, err
+		return err
 	}
 
 	namespaces, err := getNamespaces(settings)
 	if err != nil {
-		return false, err
+		return err
 	}
 
-	knownUpstreams, ok, err := checkUpstreams(namespaces)
-	if !ok || err != nil {
-		return ok, err
+	// the resources are all checked, to report all their problems
+	knownUpstreams, err := c.checkUpstreams(namespaces)
+	if err != nil {
+		return err
 	}
 
 	includeUpstreamGroup := doesNotContain(opts.Top.CheckName, "upstreamgroup")
 	if includeUpstreamGroup {
-		ok, err := checkUpstreamGroups(namespaces)
-		if !ok || err != nil {
-			return ok, err
+		if err := c.checkUpstreamGroups(namespaces); err != nil {
+			return err
 		}
 	}
 
-	knownAuthConfigs, ok, err := checkAuthConfigs(namespaces)
-	if !ok || err != nil {
-		return ok, err
+	knownAuthConfigs, err := c.checkAuthConfigs(namespaces)
+	if err != nil {
+		return err
 	}
 
-	knownRateLimitConfigs, ok, err := checkRateLimitConfigs(namespaces)
-	if !ok || err != nil {
-		return ok, err
+	knownRateLimitConfigs, err := c.checkRateLimitConfigs(namespaces)
+	if err != nil {
+		return err
 	}
 
 	includeSecrets := doesNotContain(opts.Top.CheckName, "secrets")
 	if includeSecrets {
-		ok, err := checkSecrets(namespaces)
-		if !ok || err != nil {
-			return ok, err
+		if err := c.checkSecrets(namespaces); err != nil {
+			return err
 		}
 	}
 
-	ok, err = checkVirtualServices(namespaces, knownUpstreams, knownAuthConfigs, knownRateLimitConfigs)
-	if !ok || err != nil {
-		return ok, err
+	if err := c.checkVirtualServices(namespaces, knownUpstreams, knownAuthConfigs, knownRateLimitConfigs); err != nil {
+		return err
 	}
 
 	includeGateway := doesNotContain(opts.Top.CheckName, "gateways")
 	if includeGateway {
-		ok, err := checkGateways(namespaces)
-		if !ok || err != nil {
-			return ok, err
+		if err := c.checkGateways(namespaces); err != nil {
+			return err
 		}
 	}
 
 	includeProxy := doesNotContain(opts.Top.CheckName, "proxies")
 	if includeProxy {
-		ok, err := checkProxies(opts.Top.Ctx, namespaces, opts.Metadata.Namespace, deployments)
-		if !ok || err != nil {
-			return ok, err
+		if err := c.checkProxies(opts.Top.Ctx, namespaces, opts.Metadata.Namespace, deployments); err != nil {
+			return err
 		}
 	}
 
-	ok, err = checkGlooePromStats(opts.Top.Ctx, opts.Metadata.Namespace, deployments)
-	if !ok || err != nil {
-		return ok, err
-	}
-	return true, nil
+	return c.checkGlooePromStats(opts.Top.Ctx, opts.Metadata.Namespace, deployments)
 }
 
-func getAndCheckDeployments(opts *options.Options) (*appsv1.DeploymentList, bool, error) {
-	fmt.Printf("Checking deployments... ")
+func (c *checker) getAndCheckDeployments() (*appsv1.DeploymentList, bool, error) {
+	const check = "deployments"
+	opts := c.opts
+	c.printf("Checking deployments... ")
 	client := helpers.MustKubeClient()
 	_, err := client.CoreV1().Namespaces().Get(opts.Metadata.Namespace, metav1.GetOptions{})
 	if err != nil {
-		fmt.Printf("Gloo namespace does not exist\n")
+		c.printf("Gloo namespace does not exist\n")
 		return nil, false, err
 	}
 	deployments, err := client.AppsV1().Deployments(opts.Metadata.Namespace).List(metav1.ListOptions{})
@@ -171,76 +289,96 @@ func getAndCheckDeployments(opts *options.Options) (*appsv1.DeploymentList, bool
 		return nil, false, err
 	}
 	if len(deployments.Items) == 0 {
-		fmt.Printf("Gloo is not installed\n")
+		c.printf("Gloo is not installed\n")
+		c.report.add(&Finding{
+			Check:    check,
+			Class:    ClassInstallation,
+			Severity: SeverityError,
+			Message:  fmt.Sprintf("Gloo is not installed in namespace %s", opts.Metadata.Namespace),
+		})
 		return nil, false, nil
 	}
 
-	var errorToPrint string
-	var message string
-	setMessage := func(c appsv1.DeploymentCondition) {
-		if c.Message != "" {
-			message = fmt.Sprintf(" Message: %s", c.Message)
+	ok := true
+	for _, deployment := range deployments.Items {
+		var errorToPrint string
+		var message string
+		setMessage := func(c appsv1.DeploymentCondition) {
+			if c.Message != "" {
+				message = fmt.Sprintf(" Message: %s", c.Message)
+			}
 		}
-	}
 
-	for _, deployment := range deployments.Items {
 		// possible condition types listed at https://godoc.org/k8s.io/api/apps/v1#DeploymentConditionType
 		// check for each condition independently because multiple conditions will be True and DeploymentReplicaFailure
 		// tends to provide the most explicit error message.
 		for _, condition := range deployment.Status.Conditions {
 			setMessage(condition)
 			if condition.Type == appsv1.DeploymentReplicaFailure && condition.Status == corev1.ConditionTrue {
-				errorToPrint = fmt.Sprintf("Deployment %s in namespace %s failed to create pods!%s\n", deployment.Name, deployment.Namespace, message)
-			}
-			if errorToPrint != "" {
-				fmt.Print(errorToPrint)
-				return nil, false, err
+				errorToPrint = fmt.Sprintf("Deployment %s in namespace %s failed to create pods!%s", deployment.Name, deployment.Namespace, message)
+				break
 			}
 		}
 
-		for _, condition := range deployment.Status.Conditions {
-			setMessage(condition)
-			if condition.Type == appsv1.DeploymentProgressing && condition.Status != corev1.ConditionTrue {
-				errorToPrint = fmt.Sprintf("Deployment %s in namespace %s is not progressing!%s\n", deployment.Name, deployment.Namespace, message)
-			}
-
-			if errorToPrint != "" {
-				fmt.Print(errorToPrint)
-				return nil, false, err
+		if errorToPrint == "" {
+			for _, condition := range deployment.Status.Conditions {
+				setMessage(condition)
+				if condition.Type == appsv1.DeploymentProgressing && condition.Status != corev1.ConditionTrue {
+					errorToPrint = fmt.Sprintf("Deployment %s in namespace %s is not progressing!%s", deployment.Name, deployment.Namespace, message)
+					break
+				}
 			}
 		}
 
-		for _, condition := range deployment.Status.Conditions {
-			setMessage(condition)
-			if condition.Type == appsv1.DeploymentAvailable && condition.Status != corev1.ConditionTrue {
-				errorToPrint = fmt.Sprintf("Deployment %s in namespace %s is not available!%s\n", deployment.Name, deployment.Namespace, message)
+		if errorToPrint == "" {
+			for _, condition := range deployment.Status.Conditions {
+				setMessage(condition)
+				if condition.Type == appsv1.DeploymentAvailable && condition.Status != corev1.ConditionTrue {
+					errorToPrint = fmt.Sprintf("Deployment %s in namespace %s is not available!%s", deployment.Name, deployment.Namespace, message)
+					break
+				}
 			}
+		}
 
-			if errorToPrint != "" {
-				fmt.Print(errorToPrint)
-				return nil, false, err
-			}
+		if errorToPrint != "" {
+			c.printf("%s\n", errorToPrint)
+			c.report.add(&Finding{
+				Check:     check,
+				Class:     ClassInstallation,
+				Severity:  SeverityError,
+				Kind:      "Deployment",
+				Namespace: deployment.Namespace,
+				Name:      deployment.Name,
+				Message:   errorToPrint,
+			})
+			ok = false
+			continue
 		}
 
 		for _, condition := range deployment.Status.Conditions {
 			if condition.Type != appsv1.DeploymentAvailable &&
 				condition.Type != appsv1.DeploymentReplicaFailure &&
 				condition.Type != appsv1.DeploymentProgressing {
-				fmt.Printf("Note: Unhandled deployment condition %s", condition.Type)
+				c.printf("Note: Unhandled deployment condition %s", condition.Type)
 			}
 		}
 	}
-	fmt.Printf("OK\n")
+	if !ok {
+		return nil, false, nil
+	}
+	c.printf("OK\n")
 	return deployments, true, nil
 }
 
-func checkPods(opts *options.Options) (bool, error) {
-	fmt.Printf("Checking pods... ")
+func (c *checker) checkPods() (bool, error) {
+	opts := c.opts
+	c.printf("Checking pods... ")
 	client := helpers.MustKubeClient()
 	pods, err := client.CoreV1().Pods(opts.Metadata.Namespace).List(metav1.ListOptions{})
 	if err != nil {
 		return false, err
 	}
+	ok := true
 	for _, pod := range pods.Items {
 		for _, condition := range pod.Status.Conditions {
 			var errorToPrint string
@@ -257,35 +395,49 @@ func checkPods(opts *options.Options) (bool, error) {
 			switch condition.Type {
 			case corev1.PodScheduled:
 				if conditionNotMet {
-					errorToPrint = fmt.Sprintf("Pod %s in namespace %s is not yet scheduled!%s\n", pod.Name, pod.Namespace, message)
+					errorToPrint = fmt.Sprintf("Pod %s in namespace %s is not yet scheduled!%s", pod.Name, pod.Namespace, message)
 				}
 			case corev1.PodReady:
 				if conditionNotMet {
-					errorToPrint = fmt.Sprintf("Pod %s in namespace %s is not ready!%s\n", pod.Name, pod.Namespace, message)
+					errorToPrint = fmt.Sprintf("Pod %s in namespace %s is not ready!%s", pod.Name, pod.Namespace, message)
 				}
 			case corev1.PodInitialized:
 				if conditionNotMet {
-					errorToPrint = fmt.Sprintf("Pod %s in namespace %s is not yet initialized!%s\n", pod.Name, pod.Namespace, message)
+					errorToPrint = fmt.Sprintf("Pod %s in namespace %s is not yet initialized!%s", pod.Name, pod.Namespace, message)
 				}
 			case corev1.PodReasonUnschedulable:
 				if conditionNotMet {
-					errorToPrint = fmt.Sprintf("Pod %s in namespace %s is unschedulable!%s\n", pod.Name, pod.Namespace, message)
+					errorToPrint = fmt.Sprintf("Pod %s in namespace %s is unschedulable!%s", pod.Name, pod.Namespace, message)
 				}
 			case corev1.ContainersReady:
 				if conditionNotMet {
-					errorToPrint = fmt.Sprintf("Not all containers in pod %s in namespace %s are ready!%s\n", pod.Name, pod.Namespace, message)
+					errorToPrint = fmt.Sprintf("Not all containers in pod %s in namespace %s are ready!%s", pod.Name, pod.Namespace, message)
 				}
 			default:
-				fmt.Printf("Note: Unhandled pod condition %s", condition.Type)
+				c.printf("Note: Unhandled pod condition %s", condition.Type)
 			}
 
 			if errorToPrint != "" {
-				fmt.Print(errorToPrint)
-				return false, err
+				c.printf("%s\n", errorToPrint)
+				c.report.add(&Finding{
+					Check:     "pods",
+					Class:     ClassInstallation,
+					Severity:  SeverityError,
+					Kind:      "Pod",
+					Namespace: pod.Namespace,
+					Name:      pod.Name,
+					Message:   errorToPrint,
+				})
+				ok = false
+				// report the first unmet condition of each pod
+				break
 			}
 		}
 	}
-	fmt.Printf("OK\n")
+	if !ok {
+		return false, nil
+	}
+	c.printf("OK\n")
 	return true, nil
 }
 
@@ -302,84 +454,94 @@ func getNamespaces(settings *v1.Settings) ([]string, error) {
 	return helpers.GetNamespaces()
 }
 
-func checkUpstreams(namespaces []string) ([]string, bool, error) {
-	fmt.Printf("Checking upstreams... ")
+// checkStatuses reports the resources that were rejected or have warnings. It returns whether there were none.
+func (c *checker) checkStatuses(check, resourceName, kind string, list resources.InputResourceList) bool {
+	ok := true
+	for _, resource := range list {
+		status := resource.GetStatus()
+		var severity Severity
+		switch status.GetState() {
+		case core.Status_Rejected:
+			severity = SeverityError
+			c.printf("Found rejected %s: %s\n", resourceName, renderMetadata(resource.GetMetadata()))
+		case core.Status_Warning:
+			severity = SeverityWarning
+			c.printf("Found %s with warnings: %s\n", resourceName, renderMetadata(resource.GetMetadata()))
+		default:
+			continue
+		}
+		c.printf("Reason: %s\n", status.GetReason())
+		c.report.addResourceFinding(check, ClassConfiguration, severity, kind, resource.GetMetadata(), status.GetReason())
+		ok = false
+	}
+	return ok
+}
+
+func (c *checker) checkUpstreams(namespaces []string) ([]string, error) {
+	c.printf("Checking upstreams... ")
+	ok := true
 	var knownUpstreams []string
 	for _, ns := range namespaces {
 		upstreams, err := helpers.MustNamespacedUpstreamClient(ns).List(ns, clients.ListOpts{})
 		if err != nil {
-			return nil, false, err
+			return nil, err
+		}
+		if !c.checkStatuses("upstreams", "upstream", v1.UpstreamCrd.KindName, upstreams.AsInputResources()) {
+			ok = false
 		}
 		for _, upstream := range upstreams {
-			if upstream.Status.GetState() == core.Status_Rejected {
-				fmt.Printf("Found rejected upstream: %s\n", renderMetadata(upstream.GetMetadata()))
-				fmt.Printf("Reason: %s\n", upstream.Status.Reason)
-				return nil, false, nil
-			}
-			if upstream.Status.GetState() == core.Status_Warning {
-				fmt.Printf("Found upstream with warnings: %s\n", renderMetadata(upstream.GetMetadata()))
-				fmt.Printf("Reason: %s\n", upstream.Status.Reason)
-				return nil, false, nil
-			}
 			knownUpstreams = append(knownUpstreams, renderMetadata(upstream.GetMetadata()))
 		}
 	}
-	fmt.Printf("OK\n")
-	return knownUpstreams, true, nil
+	if ok {
+		c.printf("OK\n")
+	}
+	return knownUpstreams, nil
 }
 
-func checkUpstreamGroups(namespaces []string) (bool, error) {
-	fmt.Printf("Checking upstream groups... ")
+func (c *checker) checkUpstreamGroups(namespaces []string) error {
+	c.printf("Checking upstream groups... ")
+	ok := true
 	for _, ns := range namespaces {
 		upstreamGroups, err := helpers.MustNamespacedUpstreamGroupClient(ns).List(ns, clients.ListOpts{})
 		if err != nil {
-			return false, err
+			return err
 		}
-		for _, upstreamGroup := range upstreamGroups {
-			if upstreamGroup.Status.GetState() == core.Status_Rejected {
-				fmt.Printf("Found rejected upstream group: %s\n", renderMetadata(upstreamGroup.GetMetadata()))
-				fmt.Printf("Reason: %s\n", upstreamGroup.Status.Reason)
-				return false, nil
-			}
-			if upstreamGroup.Status.GetState() == core.Status_Warning {
-				fmt.Printf("Found upstream group with warnings: %s\n", renderMetadata(upstreamGroup.GetMetadata()))
-				fmt.Printf("Reason: %s\n", upstreamGroup.Status.Reason)
-				return false, nil
-			}
+		if !c.checkStatuses("upstreamgroup", "upstream group", v1.UpstreamGroupCrd.KindName, upstreamGroups.AsInputResources()) {
+			ok = false
 		}
 	}
-	fmt.Printf("OK\n")
-	return true, nil
+	if ok {
+		c.printf("OK\n")
+	}
+	return nil
 }
 
-func checkAuthConfigs(namespaces []string) ([]string, bool, error) {
-	fmt.Printf("Checking auth configs... ")
+func (c *checker) checkAuthConfigs(namespaces []string) ([]string, error) {
+	c.printf("Checking auth configs... ")
+	ok := true
 	var knownAuthConfigs []string
 	for _, ns := range namespaces {
 		authConfigs, err := helpers.MustNamespacedAuthConfigClient(ns).List(ns, clients.ListOpts{})
 		if err != nil {
-			return nil, false, err
+			return nil, err
+		}
+		if !c.checkStatuses("authconfigs", "auth config", extauth.AuthConfigCrd.KindName, authConfigs.AsInputResources()) {
+			ok = false
 		}
 		for _, authConfig := range authConfigs {
-			if authConfig.Status.GetState() == core.Status_Rejected {
-				fmt.Printf("Found rejected auth config: %s\n", renderMetadata(authConfig.GetMetadata()))
-				fmt.Printf("Reason: %s\n", authConfig.Status.Reason)
-				return nil, false, nil
-			}
-			if authConfig.Status.GetState() == core.Status_Warning {
-				fmt.Printf("Found auth config with warnings: %s\n", renderMetadata(authConfig.GetMetadata()))
-				fmt.Printf("Reason: %s\n", authConfig.Status.Reason)
-				return nil, false, nil
-			}
 			knownAuthConfigs = append(knownAuthConfigs, renderMetadata(authConfig.GetMetadata()))
 		}
 	}
-	fmt.Printf("OK\n")
-	return knownAuthConfigs, true, nil
+	if ok {
+		c.printf("OK\n")
+	}
+	return knownAuthConfigs, nil
 }
 
-func checkRateLimitConfigs(namespaces []string) ([]string, bool, error) {
-	fmt.Printf("Checking rate limit configs... ")
+func (c *checker) checkRateLimitConfigs(namespaces []string) ([]string, error) {
+	c.printf("Checking rate limit configs... ")
+	ok := true
 	var knownConfigs []string
 	for _, ns := range namespaces {
 
@@ -387,186 +549,151 @@ func checkRateLimitConfigs(namespaces []string) ([]string, bool, error) {
 		if err != nil {
 			if isCrdNotFoundErr(err) {
 				// Just warn. If the CRD is required, the check would have failed on the crashing gloo/gloo-ee pod.
-				fmt.Printf("WARN: %s\n", CrdNotFoundErr(ratelimit.RateLimitConfigCrd.KindName).Error())
-				return nil, true, nil
+				c.printf("WARN: %s\n", CrdNotFoundErr(ratelimit.RateLimitConfigCrd.KindName).Error())
+				return nil, nil
 			}
-			return nil, false, err
+			return nil, err
 		}
 
 		configs, err := rlcClient.List(ns, clients.ListOpts{})
 		if err != nil {
-			return nil, false, err
+			return nil, err
 		}
 		for _, config := range configs {
 			if config.Status.GetState() == v1alpha1.RateLimitConfigStatus_REJECTED {
-				fmt.Printf("Found rejected rate limit config: %s\n", renderMetadata(config.GetMetadata()))
-				fmt.Printf("Reason: %s\n", config.Status.Message)
-				return nil, false, nil
+				c.printf("Found rejected rate limit config: %s\n", renderMetadata(config.GetMetadata()))
+				c.printf("Reason: %s\n", config.Status.Message)
+				c.report.addResourceFinding("ratelimitconfigs", ClassConfiguration, SeverityError,
+					ratelimit.RateLimitConfigCrd.KindName, config.GetMetadata(), config.Status.Message)
+				ok = false
 			}
 			knownConfigs = append(knownConfigs, renderMetadata(config.GetMetadata()))
 		}
 	}
-	fmt.Printf("OK\n")
-	return knownConfigs, true, nil
+	if ok {
+		c.printf("OK\n")
+	}
+	return knownConfigs, nil
 }
 
-func checkVirtualServices(namespaces, knownUpstreams, knownAuthConfigs, knownRateLimitConfigs []string) (bool, error) {
-	fmt.Printf("Checking virtual services... ")
+func (c *checker) checkVirtualServices(namespaces, knownUpstreams, knownAuthConfigs, knownRateLimitConfigs []string) error {
+	const check = "virtualservices"
+	c.printf("Checking virtual services... ")
+	ok := true
 	for _, ns := range namespaces {
 		virtualServices, err := helpers.MustNamespacedVirtualServiceClient(ns).List(ns, clients.ListOpts{})
 		if err != nil {
-			return false, err
+			return err
+		}
+		if !c.checkStatuses(check, "virtual service", gatewayv1.VirtualServiceCrd.KindName, virtualServices.AsInputResources()) {
+			ok = false
 		}
 		for _, virtualService := range virtualServices {
-			if virtualService.Status.GetState() == core.Status_Rejected {
-				fmt.Printf("Found rejected virtual service: %s\n", renderMetadata(virtualService.GetMetadata()))
-				fmt.Printf("Reason: %s\n", virtualService.Status.GetReason())
-				return false, nil
-			}
-			if virtualService.Status.GetState() == core.Status_Warning {
-				fmt.Printf("Found virtual service with warnings: %s\n", renderMetadata(virtualService.GetMetadata()))
-				fmt.Printf("Reason: %s\n", virtualService.Status.GetReason())
-				return false, nil
+			// reports a reference of the virtual service to a resource that does not exist
+			isRefValid := func(knownRefs []string, refKind string, ref *core.ResourceRef) bool {
+				if ref == nil || cliutils.Contains(knownRefs, renderRef(ref)) {
+					return true
+				}
+				c.printf("Virtual service references unknown %s:\n", strings.ToLower(refKind))
+				c.printf("  Virtual service: %s\n", renderMetadata(virtualService.GetMetadata()))
+				c.printf("  %s: %s\n", refKind, renderRef(ref))
+				c.report.addResourceFinding(check, ClassConfiguration, SeverityError, gatewayv1.VirtualServiceCrd.KindName,
+					virtualService.GetMetadata(), fmt.Sprintf("references unknown %s %s", strings.ToLower(refKind), renderRef(ref)))
+				ok = false
+				return false
 			}
+
 			for _, route := range virtualService.GetVirtualHost().GetRoutes() {
-				if route.GetRouteAction() != nil {
-					if route.GetRouteAction().GetSingle() != nil {
-						us := route.GetRouteAction().GetSingle()
-						if us.GetUpstream() != nil {
-							if !cliutils.Contains(knownUpstreams, renderRef(us.GetUpstream())) {
-								fmt.Printf("Virtual service references unknown upstream:\n")
-								fmt.Printf("  Virtual service: %s\n", renderMetadata(virtualService.GetMetadata()))
-								fmt.Printf("  Upstream: %s\n", renderRef(us.GetUpstream()))
-								return false, nil
-							}
-						}
-					}
-				}
+				isRefValid(knownUpstreams, "Upstream", route.GetRouteAction().GetSingle().GetUpstream())
 			}
 
 			// Check references to auth configs
-			isAuthConfigRefValid := func(knownConfigs []string, ref *core.ResourceRef) bool {
-				// If the virtual service points to a specific, non-existent authconfig, it is not valid.
-				if ref != nil && !cliutils.Contains(knownConfigs, renderRef(ref)) {
-					fmt.Printf("Virtual service references unknown auth config:\n")
-					fmt.Printf("  Virtual service: %s\n", renderMetadata(virtualService.GetMetadata()))
-					fmt.Printf("  Auth Config: %s\n", renderRef(ref))
-					return false
-				}
-				return true
-			}
 			// Check virtual host options
-			if !isAuthConfigRefValid(knownAuthConfigs, virtualService.GetVirtualHost().GetOptions().GetExtauth().GetConfigRef()) {
-				return false, nil
-			}
+			isRefValid(knownAuthConfigs, "Auth Config", virtualService.GetVirtualHost().GetOptions().GetExtauth().GetConfigRef())
 			// Check route options
 			for _, route := range virtualService.GetVirtualHost().GetRoutes() {
-				if !isAuthConfigRefValid(knownAuthConfigs, route.GetOptions().GetExtauth().GetConfigRef()) {
-					return false, nil
-				}
+				isRefValid(knownAuthConfigs, "Auth Config", route.GetOptions().GetExtauth().GetConfigRef())
 				// Check weighted destination options
 				for _, weightedDest := range route.GetRouteAction().GetMulti().GetDestinations() {
-					if !isAuthConfigRefValid(knownAuthConfigs, weightedDest.GetOptions().GetExtauth().GetConfigRef()) {
-						return false, nil
-					}
+					isRefValid(knownAuthConfigs, "Auth Config", weightedDest.GetOptions().GetExtauth().GetConfigRef())
 				}
 			}
 
 			// Check references to rate limit configs
-			isRateLimitConfigRefValid := func(knownConfigs []string, ref *rlopts.RateLimitConfigRef) bool {
-				resourceRef := &core.ResourceRef{
+			isRateLimitConfigRefValid := func(ref *rlopts.RateLimitConfigRef) {
+				isRefValid(knownRateLimitConfigs, "Rate Limit Config", &core.ResourceRef{
 					Name:      ref.Name,
 					Namespace: ref.Namespace,
-				}
-				if !cliutils.Contains(knownConfigs, renderRef(resourceRef)) {
-					fmt.Printf("Virtual service references unknown rate limit config:\n")
-					fmt.Printf("  Virtual service: %s\n", renderMetadata(virtualService.GetMetadata()))
-					fmt.Printf("  Rate Limit Config: %s\n", renderRef(resourceRef))
-					return false
-				}
-				return true
+				})
 			}
 			// Check virtual host options
 			for _, ref := range virtualService.GetVirtualHost().GetOptions().GetRateLimitConfigs().GetRefs() {
-				if !isRateLimitConfigRefValid(knownRateLimitConfigs, ref) {
-					return false, nil
-				}
+				isRateLimitConfigRefValid(ref)
 			}
 			// Check route options
 			for _, route := range virtualService.GetVirtualHost().GetRoutes() {
 				for _, ref := range route.GetOptions().GetRateLimitConfigs().GetRefs() {
-					if !isRateLimitConfigRefValid(knownRateLimitConfigs, ref) {
-						return false, nil
-					}
+					isRateLimitConfigRefValid(ref)
 				}
 			}
 		}
 	}
-	fmt.Printf("OK\n")
-	return true, nil
+	if ok {
+		c.printf("OK\n")
+	}
+	return nil
 }
 
-func checkGateways(namespaces []string) (bool, error) {
-	fmt.Printf("Checking gateways... ")
+func (c *checker) checkGateways(namespaces []string) error {
+	c.printf("Checking gateways... ")
+	ok := true
 	for _, ns := range namespaces {
 		gateways, err := helpers.MustNamespacedGatewayClient(ns).List(ns, clients.ListOpts{})
 		if err != nil {
-			return false, err
+			return err
 		}
-		for _, gateway := range gateways {
-			if gateway.Status.GetState() == core.Status_Rejected {
-				fmt.Printf("Found rejected gateway: %s\n", renderMetadata(gateway.GetMetadata()))
-				fmt.Printf("Reason: %s\n", gateway.Status.Reason)
-				return false, nil
-			}
-			if gateway.Status.GetState() == core.Status_Warning {
-				fmt.Printf("Found gateway with warnings: %s\n", renderMetadata(gateway.GetMetadata()))
-				fmt.Printf("Reason: %s\n", gateway.Status.Reason)
-				return false, nil
-			}
+		if !c.checkStatuses("gateways", "gateway", gatewayv1.GatewayCrd.KindName, gateways.AsInputResources()) {
+			ok = false
 		}
 	}
-	fmt.Printf("OK\n")
-	return true, nil
+	if ok {
+		c.printf("OK\n")
+	}
+	return nil
 }
 
-func checkProxies(ctx context.Context, namespaces []string, glooNamespace string, deployments *appsv1.DeploymentList) (bool, error) {
-	fmt.Printf("Checking proxies... ")
+func (c *checker) checkProxies(ctx context.Context, namespaces []string, glooNamespace string, deployments *appsv1.DeploymentList) error {
+	c.printf("Checking proxies... ")
+	ok := true
 	for _, ns := range namespaces {
 		proxies, err := helpers.MustNamespacedProxyClient(ns).List(ns, clients.ListOpts{})
 		if err != nil {
-			return false, err
+			return err
 		}
-		for _, proxy := range proxies {
-			if proxy.Status.GetState() == core.Status_Rejected {
-				fmt.Printf("Found rejected proxy: %s\n", renderMetadata(proxy.GetMetadata()))
-				fmt.Printf("Reason: %s\n", proxy.Status.Reason)
-				return false, nil
-			}
-			if proxy.Status.GetState() == core.Status_Warning {
-				fmt.Printf("Found proxy with warnings: %s\n", renderMetadata(proxy.GetMetadata()))
-				fmt.Printf("Reason: %s\n", proxy.Status.Reason)
-				return false, nil
-			}
+		if !c.checkStatuses("proxies", "proxy", v1.ProxyCrd.KindName, proxies.AsInputResources()) {
+			ok = false
 		}
 	}
+	if !ok {
+		return nil
+	}
 
-	return checkProxiesPromStats(ctx, glooNamespace, deployments)
+	return c.checkProxiesPromStats(ctx, glooNamespace, deployments)
 }
 
-func checkSecrets(namespaces []string) (bool, error) {
-	fmt.Printf("Checking secrets... ")
+func (c *checker) checkSecrets(namespaces []string) error {
+	c.printf("Checking secrets... ")
 	client := helpers.MustSecretClientWithOptions(5*time.Second, namespaces)
 
 	for _, ns := range namespaces {
 		_, err := client.List(ns, clients.ListOpts{})
 		if err != nil {
-			return false, err
+			return err
 		}
 		// currently this would only find syntax errors
 	}
-	fmt.Printf("OK\n")
-	return true, nil
+	c.printf("OK\n")
+	return nil
 }
 
 func renderMetadata(metadata core.Metadata) string {
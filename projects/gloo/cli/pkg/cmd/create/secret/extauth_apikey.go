@@ -41,6 +41,8 @@ type apiKeySecret struct {
 	ApiKey string
 	// A list of labels (key=value) for the apikey secret.
 	Labels []string
+	// A list of metadata entries (key=value) to store in the apikey secret.
+	Metadata []string
 }
 
 func ExtAuthApiKeyCmd(opts *options.Options) *cobra.Command {
@@ -76,6 +78,7 @@ func ExtAuthApiKeyCmd(opts *options.Options) *cobra.Command {
 	flags.StringVar(&input.ApiKey, "apikey", "", "apikey to be stored in secret")
 	flags.BoolVar(&input.GenerateApiKey, "apikey-generate", false, "generate an apikey")
 	flags.StringSliceVar(&input.Labels, "apikey-labels", []string{}, "comma-separated labels for the apikey secret (key=value)")
+	flags.StringSliceVar(&input.Metadata, "apikey-metadata", []string{}, "comma-separated metadata for the apikey secret, e.g. the user id or tier (key=value)")
 
 	return cmd
 }
@@ -130,11 +133,18 @@ func createApiKeySecret(ctx context.Context, meta core.Metadata, input apiKeySec
 	labels.Entries = input.Labels
 	meta.Labels = labels.MustMap()
 
+	var keyMetadata map[string]string
+	if len(input.Metadata) > 0 {
+		metadata := options.InputMapStringString{Entries: input.Metadata}
+		keyMetadata = metadata.MustMap()
+	}
+
 	secret := &gloov1.Secret{
 		Metadata: meta,
 		Kind: &gloov1.Secret_ApiKey{
 			ApiKey: &v1.ApiKeySecret{
-				ApiKey: input.ApiKey,
+				ApiKey:   input.ApiKey,
+				Metadata: keyMetadata,
 			},
 		},
 	}
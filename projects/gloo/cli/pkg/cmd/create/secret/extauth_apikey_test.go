@@ -51,6 +51,22 @@ var _ = Describe("ExtauthApiKey", func() {
 			}))
 	})
 
+	It("should create secret with metadata", func() {
+		err := testutils.Glooctl("create secret apikey --name user --namespace gloo-system --apikey secretApiKey --apikey-metadata user-id=user1,tier=gold")
+		Expect(err).NotTo(HaveOccurred())
+
+		secret, err := helpers.MustSecretClient().Read("gloo-system", "user", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(secret.GetApiKey()).To(Equal(&extauthpb.ApiKeySecret{
+			ApiKey: "secretApiKey",
+			Metadata: map[string]string{
+				"user-id": "user1",
+				"tier":    "gold",
+			},
+		}))
+	})
+
 	It("should error when no apikey provided", func() {
 		err := testutils.Glooctl("create secret apikey --name user --namespace gloo-system")
 		Expect(err).To(HaveOccurred())
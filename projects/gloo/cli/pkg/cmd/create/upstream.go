@@ -199,10 +199,11 @@ func upstreamFromOpts(opts *options.Options) (*v1.Upstream, error) {
 			return nil, errors.Errorf("%v does not support service spec", input.UpstreamType)
 		}
 		ec2Spec := &ec2.UpstreamSpec{
-			Region:   input.AwsEc2.Region,
-			Port:     input.AwsEc2.Port,
-			PublicIp: input.AwsEc2.PublicIp,
-			RoleArn:  input.AwsEc2.Role,
+			Region:     input.AwsEc2.Region,
+			Port:       input.AwsEc2.Port,
+			PublicIp:   input.AwsEc2.PublicIp,
+			RoleArn:    input.AwsEc2.Role,
+			ExternalId: input.AwsEc2.ExternalId,
 		}
 		// a secret ref is optional for EC2 upstreams (will use environment defaults if not specified)
 		// however if any part of the spec is provided ensure that the full spec is provided
@@ -3,6 +3,7 @@ package debug
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -15,8 +16,13 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 var _ = Describe("Debug", func() {
@@ -120,4 +126,27 @@ var _ = Describe("Debug", func() {
 			Expect(manifests).To(HaveLen(len(cmds)), "Should have written the same number of manifests as commands")
 		})
 	})
+
+	Context("proxy bundle", func() {
+		It("lists the statuses of the resources of the watched namespaces", func() {
+			opts := options.Options{}
+			opts.Metadata.Namespace = "gloo-system"
+			opts.Top.Ctx = context.Background()
+
+			_, err := helpers.MustNamespacedSettingsClient("gloo-system").Write(&gloov1.Settings{
+				Metadata:        core.Metadata{Name: defaults.SettingsName, Namespace: "gloo-system"},
+				WatchNamespaces: []string{"default"},
+			}, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = helpers.MustNamespacedVirtualServiceClient("default").Write(&gatewayv1.VirtualService{
+				Metadata: core.Metadata{Name: "vs", Namespace: "default"},
+				Status:   core.Status{State: core.Status_Rejected, Reason: "invalid route"},
+			}, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+
+			statuses, err := resourceStatuses(&opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statuses).To(MatchRegexp(`VirtualService\s*\|\s*default\s*\|\s*vs\s*\|\s*Rejected\s*\|\s*invalid route`))
+		})
+	})
 })
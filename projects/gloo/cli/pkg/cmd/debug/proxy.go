@@ -0,0 +1,226 @@
+package debug
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/rotisserie/eris"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/gateway"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/xdsinspection"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/go-utils/debugutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/spf13/afero"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ProxyFilename = "/tmp/gloo-proxy-debug.tgz"
+
+	// the pods of the gloo and gateway deployments
+	controlPlaneSelector = "gloo in (gloo,gateway)"
+	// the file of the bundle that lists the parts that could not be collected
+	errorsFile = "errors.txt"
+)
+
+// a part of the debug bundle of a proxy, written to a file of the bundle
+type bundlePart struct {
+	file    string
+	collect func(opts *options.Options) (string, error)
+}
+
+var proxyBundleParts = []bundlePart{
+	{file: "proxy.yaml", collect: proxyYaml},
+	{file: "xds-snapshot.txt", collect: xdsSnapshot},
+	{file: "envoy-config-dump.json", collect: gateway.GetEnvoyCfgDump},
+	{file: "statuses.txt", collect: resourceStatuses},
+}
+
+// DebugProxy writes the state of a proxy to a tarball. The parts that cannot be collected are listed in the
+// errors.txt file of the tarball rather than failing the command, so that a partial bundle is still written.
+func DebugProxy(opts *options.Options, w io.Writer) error {
+	fs := afero.NewOsFs()
+	dir, err := afero.TempDir(fs, "", "")
+	if err != nil {
+		return err
+	}
+	defer fs.RemoveAll(dir)
+	storageClient := debugutils.NewFileStorageClient(fs)
+
+	var failures []string
+	for _, part := range proxyBundleParts {
+		content, err := part.collect(opts)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%v: %v", part.file, err))
+			continue
+		}
+		if err := storageClient.Save(dir, &debugutils.StorageObject{
+			Resource: strings.NewReader(content),
+			Name:     part.file,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := saveControlPlaneLogs(opts, fs, storageClient, filepath.Join(dir, "logs")); err != nil {
+		failures = append(failures, fmt.Sprintf("logs: %v", err))
+	}
+
+	if len(failures) > 0 {
+		if err := storageClient.Save(dir, &debugutils.StorageObject{
+			Resource: strings.NewReader(strings.Join(failures, "\n") + "\n"),
+			Name:     errorsFile,
+		}); err != nil {
+			return err
+		}
+	}
+
+	file := opts.Top.File
+	if file == "" {
+		file = ProxyFilename
+	}
+	if err := zip(fs, dir, file); err != nil {
+		return err
+	}
+	for _, failure := range failures {
+		fmt.Fprintf(w, "could not collect %v\n", failure)
+	}
+	fmt.Fprintf(w, "wrote the debug bundle of proxy %v to %v\n", opts.Proxy.Name, file)
+	return nil
+}
+
+func proxyYaml(opts *options.Options) (string, error) {
+	namespace := opts.Metadata.Namespace
+	proxy, err := helpers.MustNamespacedProxyClient(namespace).Read(namespace, opts.Proxy.Name, clients.ReadOpts{Ctx: opts.Top.Ctx})
+	if err != nil {
+		return "", err
+	}
+	return printers.GenerateKubeCrdString(proxy, v1.ProxyCrd)
+}
+
+func xdsSnapshot(opts *options.Options) (string, error) {
+	dump, err := xdsinspection.GetGlooXdsDump(opts.Top.Ctx, opts.Proxy.Name, opts.Metadata.Namespace, true)
+	if err != nil {
+		return "", err
+	}
+	return dump.String(), nil
+}
+
+// resourceStatuses lists the statuses of the Gloo resources of the namespaces watched by Gloo
+func resourceStatuses(opts *options.Options) (string, error) {
+	namespaces, err := watchNamespaces(opts)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	table := tablewriter.NewWriter(&b)
+	table.SetHeader([]string{"Kind", "Namespace", "Name", "State", "Reason"})
+	table.SetAutoWrapText(false)
+	appendStatuses := func(kind string, list resources.InputResourceList) {
+		for _, resource := range list {
+			meta := resource.GetMetadata()
+			status := resource.GetStatus()
+			table.Append([]string{kind, meta.Namespace, meta.Name, status.GetState().String(), status.GetReason()})
+		}
+	}
+
+	for _, ns := range namespaces {
+		listOpts := clients.ListOpts{Ctx: opts.Top.Ctx}
+		gateways, err := helpers.MustNamespacedGatewayClient(ns).List(ns, listOpts)
+		if err != nil {
+			return "", err
+		}
+		appendStatuses(gatewayv1.GatewayCrd.KindName, gateways.AsInputResources())
+		virtualServices, err := helpers.MustNamespacedVirtualServiceClient(ns).List(ns, listOpts)
+		if err != nil {
+			return "", err
+		}
+		appendStatuses(gatewayv1.VirtualServiceCrd.KindName, virtualServices.AsInputResources())
+		routeTables, err := helpers.MustNamespacedRouteTableClient(ns).List(ns, listOpts)
+		if err != nil {
+			return "", err
+		}
+		appendStatuses(gatewayv1.RouteTableCrd.KindName, routeTables.AsInputResources())
+		upstreams, err := helpers.MustNamespacedUpstreamClient(ns).List(ns, listOpts)
+		if err != nil {
+			return "", err
+		}
+		appendStatuses(v1.UpstreamCrd.KindName, upstreams.AsInputResources())
+		upstreamGroups, err := helpers.MustNamespacedUpstreamGroupClient(ns).List(ns, listOpts)
+		if err != nil {
+			return "", err
+		}
+		appendStatuses(v1.UpstreamGroupCrd.KindName, upstreamGroups.AsInputResources())
+		proxies, err := helpers.MustNamespacedProxyClient(ns).List(ns, listOpts)
+		if err != nil {
+			return "", err
+		}
+		appendStatuses(v1.ProxyCrd.KindName, proxies.AsInputResources())
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+	return b.String(), nil
+}
+
+func watchNamespaces(opts *options.Options) ([]string, error) {
+	namespace := opts.Metadata.Namespace
+	settings, err := helpers.MustNamespacedSettingsClient(namespace).Read(namespace, defaults.SettingsName, clients.ReadOpts{Ctx: opts.Top.Ctx})
+	if err != nil {
+		return nil, eris.Wrapf(err, "reading the settings")
+	}
+	if len(settings.WatchNamespaces) > 0 {
+		return settings.WatchNamespaces, nil
+	}
+	return helpers.GetNamespaces()
+}
+
+// saveControlPlaneLogs saves the logs of the gloo and gateway pods written since the duration of the options
+func saveControlPlaneLogs(opts *options.Options, fs afero.Fs, storageClient debugutils.StorageClient, dir string) error {
+	pods, err := helpers.MustKubeClient().CoreV1().Pods(opts.Metadata.Namespace).List(metav1.ListOptions{
+		LabelSelector: controlPlaneSelector,
+	})
+	if err != nil {
+		return err
+	}
+	logRequestBuilder, err := debugutils.DefaultLogRequestBuilder()
+	if err != nil {
+		return err
+	}
+	var logOpts []debugutils.LogRequestOptions
+	if opts.Debug.LogsSince > 0 {
+		logOpts = append(logOpts, debugutils.LogsSince(time.Now().Add(-opts.Debug.LogsSince)))
+	}
+	responses, err := logRequestBuilder.StreamLogs(logRequestBuilder.RetrieveLogs(pods, logOpts...))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, response := range responses {
+			response.Response.Close()
+		}
+	}()
+	if err := fs.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for _, response := range responses {
+		if err := storageClient.Save(dir, &debugutils.StorageObject{
+			Resource: response.Response,
+			Name:     response.ResourceId(),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,7 +2,9 @@ package debug
 
 import (
 	"os"
+	"time"
 
+	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
@@ -21,6 +23,7 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 
 	cmd.AddCommand(DebugLogCmd(opts))
 	cmd.AddCommand(DebugYamlCmd(opts))
+	cmd.AddCommand(DebugProxyCmd(opts))
 	cliutils.ApplyOptions(cmd, optionsFunc)
 	return cmd
 }
@@ -59,3 +62,22 @@ func DebugYamlCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *c
 
 	return cmd
 }
+
+func DebugProxyCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   constants.DEBUG_PROXY_COMMAND.Use,
+		Short: constants.DEBUG_PROXY_COMMAND.Short,
+		Long:  constants.DEBUG_PROXY_COMMAND.Long,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return DebugProxy(opts, os.Stdout)
+		},
+	}
+
+	pflags := cmd.PersistentFlags()
+	pflags.StringVar(&opts.Proxy.Name, "name", defaults.GatewayProxyName, "the name of the proxy service/deployment to use")
+	pflags.DurationVar(&opts.Debug.LogsSince, "logs-since", time.Hour, "collect the logs written since this long ago, or all the logs if 0")
+	pflags.StringVarP(&opts.Top.File, flagutils.FileFlag, "f", "", "the tarball to write the debug bundle to (default \""+ProxyFilename+"\")")
+	flagutils.AddNamespaceFlag(pflags, &opts.Metadata.Namespace)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
@@ -0,0 +1,142 @@
+package export
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"path"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/rotisserie/eris"
+)
+
+const (
+	// FormatVersion is the version of the layout of the archives written by glooctl export.
+	// It changes when glooctl import can no longer read the archives of the previous version.
+	FormatVersion = "v1"
+
+	metadataFile = "gloo-export.yaml"
+	resourcesDir = "resources"
+	secretsDir   = "secrets"
+)
+
+var (
+	MissingMetadataErr = eris.Errorf("the archive has no %v file: it was not written by glooctl export", metadataFile)
+
+	UnsupportedFormatVersionErr = func(version string) error {
+		return eris.Errorf("unsupported archive format version %q: this version of glooctl reads version %v", version, FormatVersion)
+	}
+)
+
+// ArchiveMetadata describes an archive written by glooctl export
+type ArchiveMetadata struct {
+	FormatVersion  string    `json:"formatVersion"`
+	GlooctlVersion string    `json:"glooctlVersion"`
+	CreatedAt      time.Time `json:"createdAt"`
+	// whether the secrets are in the archive, and whether their values are redacted
+	IncludesSecrets bool `json:"includesSecrets"`
+	SecretsRedacted bool `json:"secretsRedacted"`
+}
+
+// archive holds the files of an archive, as Kubernetes manifests
+type archive struct {
+	metadata ArchiveMetadata
+	// the manifests of the resources, in the order they are written
+	resources []archiveFile
+	secrets   []archiveFile
+}
+
+type archiveFile struct {
+	name     string
+	manifest []byte
+}
+
+func resourceFileName(plural, namespace, name string) string {
+	return path.Join(resourcesDir, plural, namespace, name+".yaml")
+}
+
+func secretFileName(namespace, name string) string {
+	return path.Join(secretsDir, namespace, name+".yaml")
+}
+
+// writeArchive writes the archive as a gzipped tar, with the metadata first
+func writeArchive(a *archive, w io.Writer) error {
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	metadata, err := yaml.Marshal(a.metadata)
+	if err != nil {
+		return err
+	}
+	files := append([]archiveFile{{name: metadataFile, manifest: metadata}}, a.resources...)
+	files = append(files, a.secrets...)
+	for _, file := range files {
+		header := &tar.Header{
+			Name:    file.name,
+			Mode:    0644,
+			Size:    int64(len(file.manifest)),
+			ModTime: a.metadata.CreatedAt,
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := tarWriter.Write(file.manifest); err != nil {
+			return err
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+// readArchive reads an archive written by writeArchive, and checks that its format version is supported
+func readArchive(r io.Reader) (*archive, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, eris.Wrapf(err, "reading the archive")
+	}
+	tarReader := tar.NewReader(gzipReader)
+
+	a := &archive{}
+	hasMetadata := false
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, eris.Wrapf(err, "reading the archive")
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		content, err := ioutil.ReadAll(tarReader)
+		if err != nil {
+			return nil, eris.Wrapf(err, "reading %v", header.Name)
+		}
+		file := archiveFile{name: header.Name, manifest: content}
+		switch {
+		case header.Name == metadataFile:
+			if err := yaml.Unmarshal(content, &a.metadata); err != nil {
+				return nil, eris.Wrapf(err, "parsing %v", metadataFile)
+			}
+			hasMetadata = true
+		case path.Dir(path.Dir(header.Name)) == secretsDir:
+			a.secrets = append(a.secrets, file)
+		default:
+			a.resources = append(a.resources, file)
+		}
+	}
+
+	if !hasMetadata {
+		return nil, MissingMetadataErr
+	}
+	if a.metadata.FormatVersion != FormatVersion {
+		return nil, UnsupportedFormatVersionErr(a.metadata.FormatVersion)
+	}
+	return a, nil
+}
@@ -0,0 +1,199 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/rotisserie/eris"
+	linkedversion "github.com/solo-io/gloo/pkg/version"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/apply"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/prerun"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	crdv1 "github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/crd/solo.io/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/utils/kubeutils"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// RedactedAnnotation marks the secrets of an archive whose values are redacted
+	RedactedAnnotation = "glooctl.solo.io/redacted"
+	redactedValue      = "<redacted>"
+)
+
+var MissingFileErr = eris.New("please provide the path of the archive with the file flag")
+
+func ExportCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   constants.EXPORT_COMMAND.Use,
+		Short: constants.EXPORT_COMMAND.Short,
+		Long:  constants.EXPORT_COMMAND.Long,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := prerun.CallParentPrerun(cmd, args); err != nil {
+				return err
+			}
+			return prerun.EnableConsulClients(opts)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch opts.Top.File {
+			case "":
+				return MissingFileErr
+			case "-":
+				return Export(opts, os.Stdout, os.Stderr)
+			}
+			file, err := os.Create(opts.Top.File)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			return Export(opts, file, os.Stdout)
+		},
+	}
+	pflags := cmd.PersistentFlags()
+	flagutils.AddFileFlag(cmd.LocalFlags(), &opts.Top.File)
+	pflags.BoolVar(&opts.Export.IncludeSecrets, "include-secrets", false, "include the secrets in the archive")
+	pflags.BoolVar(&opts.Export.RedactSecrets, "redact-secrets", true, "replace the values of the secrets with a placeholder. Use with --include-secrets")
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+// Export writes the Gloo resources of all the namespaces into an archive, and a summary to the log writer
+func Export(opts *options.Options, w, log io.Writer) error {
+	a := &archive{
+		metadata: ArchiveMetadata{
+			FormatVersion:   FormatVersion,
+			GlooctlVersion:  linkedversion.Version,
+			CreatedAt:       time.Now().UTC(),
+			IncludesSecrets: opts.Export.IncludeSecrets,
+			SecretsRedacted: opts.Export.IncludeSecrets && opts.Export.RedactSecrets,
+		},
+	}
+	namespaces := helpers.MustGetNamespaces()
+	for _, kind := range apply.ResourceKinds {
+		for _, ns := range namespaces {
+			list, err := kind.Client(ns).List(ns, clients.ListOpts{Ctx: opts.Top.Ctx})
+			if err != nil {
+				return eris.Wrapf(err, "listing %v in %v", kind.Crd.Plural, ns)
+			}
+			for _, resource := range list {
+				manifest, err := resourceManifest(resource.(resources.InputResource), kind)
+				if err != nil {
+					return err
+				}
+				meta := resource.GetMetadata()
+				a.resources = append(a.resources, archiveFile{
+					name:     resourceFileName(kind.Crd.Plural, meta.Namespace, meta.Name),
+					manifest: manifest,
+				})
+			}
+		}
+	}
+
+	if opts.Export.IncludeSecrets {
+		secretClient := helpers.MustSecretClient()
+		for _, ns := range namespaces {
+			secrets, err := secretClient.List(ns, clients.ListOpts{Ctx: opts.Top.Ctx})
+			if err != nil {
+				return eris.Wrapf(err, "listing secrets in %v", ns)
+			}
+			for _, secret := range secrets {
+				manifest, err := secretManifest(secret, opts.Export.RedactSecrets)
+				if err != nil {
+					return err
+				}
+				a.secrets = append(a.secrets, archiveFile{
+					name:     secretFileName(secret.GetMetadata().Namespace, secret.GetMetadata().Name),
+					manifest: manifest,
+				})
+			}
+		}
+	}
+
+	if err := writeArchive(a, w); err != nil {
+		return eris.Wrapf(err, "writing the archive")
+	}
+	fmt.Fprintf(log, "exported %d resources and %d secrets\n", len(a.resources), len(a.secrets))
+	return nil
+}
+
+// resourceManifest returns the Kubernetes manifest of the resource, without the fields owned by the config backend
+func resourceManifest(resource resources.InputResource, kind apply.ResourceKind) ([]byte, error) {
+	clone := resources.Clone(resource).(resources.InputResource)
+	clone.SetMetadata(exportedMetadata(resource.GetMetadata()))
+	kubeResource, err := kind.Crd.KubeResource(clone)
+	if err != nil {
+		return nil, err
+	}
+	kubeResource.Status = nil
+	return yaml.Marshal(kubeResource)
+}
+
+// secretManifest returns the manifest of the secret, in the layout of a Gloo Secret custom resource
+func secretManifest(secret *v1.Secret, redact bool) ([]byte, error) {
+	spec, err := protoutils.MarshalMap(secret)
+	if err != nil {
+		return nil, err
+	}
+	delete(spec, "metadata")
+
+	meta := exportedMetadata(secret.GetMetadata())
+	if redact {
+		redactValues(spec)
+		if meta.Annotations == nil {
+			meta.Annotations = map[string]string{}
+		}
+		meta.Annotations[RedactedAnnotation] = "true"
+	}
+	kubeSecret := crdv1.Resource{
+		TypeMeta:   v1.SecretCrd.TypeMeta(),
+		ObjectMeta: kubeutils.ToKubeMeta(meta),
+		Spec:       (*crdv1.Spec)(&spec),
+	}
+	return yaml.Marshal(kubeSecret)
+}
+
+func exportedMetadata(meta core.Metadata) core.Metadata {
+	meta.ResourceVersion = ""
+	meta.Generation = 0
+	meta.Cluster = ""
+	// the annotations are shared with the resource
+	annotations := make(map[string]string, len(meta.Annotations))
+	for k, v := range meta.Annotations {
+		annotations[k] = v
+	}
+	if len(annotations) > 0 {
+		meta.Annotations = annotations
+	}
+	return meta
+}
+
+// redactValues replaces the strings of the unmarshalled JSON value with a placeholder, keeping its keys
+func redactValues(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for k, v := range typed {
+			typed[k] = redactValues(v)
+		}
+		return typed
+	case []interface{}:
+		for i, v := range typed {
+			typed[i] = redactValues(v)
+		}
+		return typed
+	case string:
+		return redactedValue
+	default:
+		return value
+	}
+}
@@ -0,0 +1,142 @@
+package export_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/export"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Export and Import", func() {
+
+	var opts *options.Options
+
+	BeforeEach(func() {
+		helpers.UseMemoryClients()
+		opts = &options.Options{Top: options.Top{Ctx: context.Background()}}
+		opts.Metadata.Namespace = "gloo-system"
+
+		_, err := helpers.MustUpstreamClient().Write(&gloov1.Upstream{
+			Metadata:     core.Metadata{Name: "petstore", Namespace: "gloo-system", Labels: map[string]string{"app": "petstore"}},
+			UpstreamType: &gloov1.Upstream_Static{Static: &static.UpstreamSpec{Hosts: []*static.Host{{Addr: "petstore.example.com", Port: 80}}}},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = helpers.MustVirtualServiceClient().Write(&gatewayv1.VirtualService{
+			Metadata:    core.Metadata{Name: "petstore", Namespace: "default"},
+			VirtualHost: &gatewayv1.VirtualHost{Domains: []string{"petstore.example.com"}},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = helpers.MustSecretClient().Write(&gloov1.Secret{
+			Metadata: core.Metadata{Name: "aws", Namespace: "gloo-system"},
+			Kind:     &gloov1.Secret_Aws{Aws: &gloov1.AwsSecret{AccessKey: "AKIAEXAMPLE", SecretKey: "s3cr3t"}},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		helpers.UseDefaultClients()
+	})
+
+	exportArchive := func() []byte {
+		var archive bytes.Buffer
+		err := export.Export(opts, &archive, ioutil.Discard)
+		Expect(err).NotTo(HaveOccurred())
+		return archive.Bytes()
+	}
+
+	archiveFiles := func(archive []byte) map[string]string {
+		gzipReader, err := gzip.NewReader(bytes.NewReader(archive))
+		Expect(err).NotTo(HaveOccurred())
+		tarReader := tar.NewReader(gzipReader)
+		files := map[string]string{}
+		for {
+			header, err := tarReader.Next()
+			if err == io.EOF {
+				return files
+			}
+			Expect(err).NotTo(HaveOccurred())
+			content, err := ioutil.ReadAll(tarReader)
+			Expect(err).NotTo(HaveOccurred())
+			files[header.Name] = string(content)
+		}
+	}
+
+	It("writes the resources into a versioned archive", func() {
+		opts.Export.IncludeSecrets = true
+		opts.Export.RedactSecrets = true
+		files := archiveFiles(exportArchive())
+
+		Expect(files).To(HaveLen(4))
+		Expect(files["gloo-export.yaml"]).To(ContainSubstring("formatVersion: v1"))
+		Expect(files["gloo-export.yaml"]).To(ContainSubstring("secretsRedacted: true"))
+		Expect(files["resources/upstreams/gloo-system/petstore.yaml"]).To(ContainSubstring("kind: Upstream"))
+		Expect(files["resources/upstreams/gloo-system/petstore.yaml"]).NotTo(ContainSubstring("resourceVersion"))
+		Expect(files["resources/virtualservices/default/petstore.yaml"]).To(ContainSubstring("kind: VirtualService"))
+		Expect(files["secrets/gloo-system/aws.yaml"]).To(ContainSubstring("glooctl.solo.io/redacted"))
+		Expect(files["secrets/gloo-system/aws.yaml"]).NotTo(ContainSubstring("AKIAEXAMPLE"))
+	})
+
+	It("imports the resources of an archive", func() {
+		opts.Export.IncludeSecrets = true
+		archive := exportArchive()
+		helpers.UseMemoryClients()
+
+		var out bytes.Buffer
+		err := export.Import(opts, bytes.NewReader(archive), &out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring("secret gloo-system.aws: created\n"))
+		Expect(out.String()).To(ContainSubstring("applied 2 resources: 2 created, 0 configured, 0 unchanged\n"))
+
+		us, err := helpers.MustUpstreamClient().Read("gloo-system", "petstore", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(us.GetStatic().GetHosts()[0].GetAddr()).To(Equal("petstore.example.com"))
+		Expect(us.GetMetadata().Labels).To(Equal(map[string]string{"app": "petstore"}))
+		_, err = helpers.MustVirtualServiceClient().Read("default", "petstore", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		secret, err := helpers.MustSecretClient().Read("gloo-system", "aws", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secret.GetAws().GetSecretKey()).To(Equal("s3cr3t"))
+	})
+
+	It("does not import the redacted secrets", func() {
+		opts.Export.IncludeSecrets = true
+		opts.Export.RedactSecrets = true
+		archive := exportArchive()
+		helpers.UseMemoryClients()
+
+		var out bytes.Buffer
+		err := export.Import(opts, bytes.NewReader(archive), &out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring("secret gloo-system.aws: skipped, its values are redacted\n"))
+		_, err = helpers.MustSecretClient().Read("gloo-system", "aws", clients.ReadOpts{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("does not import archives of an unsupported format version", func() {
+		var archive bytes.Buffer
+		gzipWriter := gzip.NewWriter(&archive)
+		tarWriter := tar.NewWriter(gzipWriter)
+		metadata := []byte("formatVersion: v0\n")
+		Expect(tarWriter.WriteHeader(&tar.Header{Name: "gloo-export.yaml", Mode: 0644, Size: int64(len(metadata))})).To(Succeed())
+		_, err := tarWriter.Write(metadata)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tarWriter.Close()).To(Succeed())
+		Expect(gzipWriter.Close()).To(Succeed())
+
+		err = export.Import(opts, &archive, ioutil.Discard)
+		Expect(err).To(MatchError(export.UnsupportedFormatVersionErr("v0")))
+	})
+})
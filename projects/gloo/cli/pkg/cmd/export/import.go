@@ -0,0 +1,129 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ghodss/yaml"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/cliutil"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/apply"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/prerun"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	crdv1 "github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/crd/solo.io/v1"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/solo-io/solo-kit/pkg/utils/kubeutils"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	"github.com/spf13/cobra"
+)
+
+func ImportCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   constants.IMPORT_COMMAND.Use,
+		Short: constants.IMPORT_COMMAND.Short,
+		Long:  constants.IMPORT_COMMAND.Long,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := prerun.CallParentPrerun(cmd, args); err != nil {
+				return err
+			}
+			return prerun.EnableConsulClients(opts)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch opts.Top.File {
+			case "":
+				return MissingFileErr
+			case "-":
+				return Import(opts, os.Stdin, os.Stdout)
+			}
+			file, err := cliutil.GetResource(opts.Top.File)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			return Import(opts, file, os.Stdout)
+		},
+	}
+	flagutils.AddFileFlag(cmd.LocalFlags(), &opts.Top.File)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+// Import writes the secrets, then the resources of the archive. The resources are applied like glooctl apply does,
+// and the redacted secrets are skipped.
+func Import(opts *options.Options, r io.Reader, w io.Writer) error {
+	a, err := readArchive(r)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "importing the archive exported by glooctl %v at %v\n", a.metadata.GlooctlVersion, a.metadata.CreatedAt)
+
+	// all the secrets are parsed before any is written
+	var secrets []*v1.Secret
+	for _, file := range a.secrets {
+		secret, redacted, err := parseSecret(file)
+		if err != nil {
+			return err
+		}
+		if redacted {
+			fmt.Fprintf(w, "secret %v: skipped, its values are redacted\n", secret.GetMetadata().Ref().Key())
+			continue
+		}
+		secrets = append(secrets, secret)
+	}
+	for _, secret := range secrets {
+		if err := writeSecret(opts, secret, w); err != nil {
+			return err
+		}
+	}
+
+	var manifests bytes.Buffer
+	for _, file := range a.resources {
+		manifests.WriteString("---\n")
+		manifests.Write(file.manifest)
+	}
+	return apply.Apply(opts, &manifests, w)
+}
+
+func parseSecret(file archiveFile) (*v1.Secret, bool, error) {
+	var kubeSecret crdv1.Resource
+	if err := yaml.Unmarshal(file.manifest, &kubeSecret); err != nil {
+		return nil, false, eris.Wrapf(err, "parsing %v", file.name)
+	}
+	secret := &v1.Secret{}
+	if kubeSecret.Spec != nil {
+		if err := protoutils.UnmarshalMap(*kubeSecret.Spec, secret); err != nil {
+			return nil, false, eris.Wrapf(err, "parsing %v", file.name)
+		}
+	}
+	secret.SetMetadata(kubeutils.FromKubeMeta(kubeSecret.ObjectMeta))
+	return secret, kubeSecret.Annotations[RedactedAnnotation] == "true", nil
+}
+
+func writeSecret(opts *options.Options, secret *v1.Secret, w io.Writer) error {
+	secretClient := helpers.MustSecretClient()
+	meta := secret.GetMetadata()
+	state := "created"
+	existing, err := secretClient.Read(meta.Namespace, meta.Name, clients.ReadOpts{Ctx: opts.Top.Ctx})
+	switch {
+	case err == nil:
+		meta.ResourceVersion = existing.GetMetadata().ResourceVersion
+		secret.SetMetadata(meta)
+		state = "configured"
+	case !errors.IsNotExist(err):
+		return eris.Wrapf(err, "reading secret %v", meta.Ref().Key())
+	}
+	if _, err := secretClient.Write(secret, clients.WriteOpts{Ctx: opts.Top.Ctx, OverwriteExisting: true}); err != nil {
+		return eris.Wrapf(err, "writing secret %v", meta.Ref().Key())
+	}
+	// the values of the secrets are not printed
+	fmt.Fprintf(w, "secret %v: %v\n", meta.Ref().Key(), state)
+	return nil
+}
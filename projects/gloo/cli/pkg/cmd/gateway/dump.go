@@ -23,7 +23,7 @@ func dumpCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 		Use:   "dump",
 		Short: "dump Envoy config from one of the proxy instances",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			cfgDump, err := getEnvoyCfgDump(opts)
+			cfgDump, err := GetEnvoyCfgDump(opts)
 			if err != nil {
 				return err
 			}
@@ -35,7 +35,8 @@ func dumpCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 	return cmd
 }
 
-func getEnvoyCfgDump(opts *options.Options) (string, error) {
+// GetEnvoyCfgDump returns the config dump of the admin endpoint of one of the instances of the proxy
+func GetEnvoyCfgDump(opts *options.Options) (string, error) {
 	adminPort := strconv.Itoa(int(defaults.EnvoyAdminPort))
 	portFwd := exec.Command("kubectl", "port-forward", "-n", opts.Metadata.Namespace,
 		"deployment/"+opts.Proxy.Name, adminPort)
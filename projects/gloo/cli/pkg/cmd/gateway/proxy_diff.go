@@ -0,0 +1,334 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/apply"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/xdsinspection"
+	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	clusterResource     = "cluster"
+	listenerResource    = "listener"
+	routeConfigResource = "route config"
+)
+
+var DriftFoundErr = eris.New("the config of the proxy differs from the config served by Gloo")
+
+func diffCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "diff the Envoy config of one of the proxy instances against the config served by Gloo",
+		Long: "Compare the clusters, listeners and route configs of the config dump of one of the proxy instances " +
+			"with the ones the Gloo xDS server serves to the proxy. The drift shows updates not applied by Envoy yet, " +
+			"updates rejected by Envoy, and routes replaced by Gloo because their destination is invalid.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			served, err := xdsinspection.GetGlooXdsDump(opts.Top.Ctx, opts.Proxy.Name, opts.Metadata.Namespace, true)
+			if err != nil {
+				return err
+			}
+			configDump, err := GetEnvoyCfgDump(opts)
+			if err != nil {
+				return err
+			}
+			diff, err := DiffProxyConfig(served, []byte(configDump))
+			if err != nil {
+				return err
+			}
+			diff.Print(os.Stdout)
+			if len(diff.Drifts) > 0 {
+				return DriftFoundErr
+			}
+			return nil
+		},
+	}
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+// ConfigDrift is a difference between the config served by Gloo and the config of Envoy
+type ConfigDrift struct {
+	// e.g. `cluster petstore_gloo-system`
+	Resource string
+	Message  string
+	// the changes from the config of Envoy to the config served by Gloo
+	Changes []apply.FieldChange
+}
+
+type ProxyConfigDiff struct {
+	Drifts []ConfigDrift
+}
+
+func (d *ProxyConfigDiff) Print(w io.Writer) {
+	if len(d.Drifts) == 0 {
+		fmt.Fprintln(w, "the config of the proxy matches the config served by Gloo")
+		return
+	}
+	for _, drift := range d.Drifts {
+		fmt.Fprintf(w, "%v: %v\n", drift.Resource, drift.Message)
+		for _, change := range drift.Changes {
+			fmt.Fprintf(w, "  %v\n", change)
+		}
+	}
+}
+
+func (d *ProxyConfigDiff) add(resourceType, name, message string, changes ...apply.FieldChange) {
+	d.Drifts = append(d.Drifts, ConfigDrift{
+		Resource: strings.TrimSpace(resourceType + " " + name),
+		Message:  message,
+		Changes:  changes,
+	})
+}
+
+// envoyResources are the dynamic resources of a type in the config dump of Envoy
+type envoyResources struct {
+	// the version of the last update accepted for the type, or by resource for route configs
+	version  string
+	versions map[string]string
+	active   map[string]interface{}
+	warming  map[string]bool
+	// the details of the last update rejected, by resource
+	rejected map[string]string
+}
+
+func newEnvoyResources() *envoyResources {
+	return &envoyResources{
+		versions: map[string]string{},
+		active:   map[string]interface{}{},
+		warming:  map[string]bool{},
+		rejected: map[string]string{},
+	}
+}
+
+// DiffProxyConfig compares the config served by Gloo with the config dump of the Envoy admin endpoint.
+// The resources of the config dump are compared as JSON, without the type URLs which differ between xDS versions.
+func DiffProxyConfig(served *xdsinspection.XdsDump, configDump []byte) (*ProxyConfigDiff, error) {
+	envoyConfig, err := parseConfigDump(configDump)
+	if err != nil {
+		return nil, err
+	}
+
+	servedClusters := map[string]interface{}{}
+	for i := range served.Clusters {
+		if servedClusters[served.Clusters[i].Name], err = envoyJson(&served.Clusters[i]); err != nil {
+			return nil, err
+		}
+	}
+	servedListeners := map[string]interface{}{}
+	for i := range served.Listeners {
+		if servedListeners[served.Listeners[i].Name], err = envoyJson(&served.Listeners[i]); err != nil {
+			return nil, err
+		}
+	}
+	servedRoutes := map[string]interface{}{}
+	for i := range served.Routes {
+		if servedRoutes[served.Routes[i].Name], err = envoyJson(&served.Routes[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	diff := &ProxyConfigDiff{}
+	clusters := envoyConfig[clusterResource]
+	if clusters.version != served.ClustersVersion {
+		diff.add("clusters", "", fmt.Sprintf("Envoy is at version %v, Gloo serves version %v", clusters.version, served.ClustersVersion))
+	}
+	diffResources(diff, clusterResource, clusters, servedClusters)
+	listeners := envoyConfig[listenerResource]
+	if listeners.version != served.ListenersVersion {
+		diff.add("listeners", "", fmt.Sprintf("Envoy is at version %v, Gloo serves version %v", listeners.version, served.ListenersVersion))
+	}
+	diffResources(diff, listenerResource, listeners, servedListeners)
+	routes := envoyConfig[routeConfigResource]
+	for _, name := range sortedKeys(servedRoutes) {
+		if version, ok := routes.versions[name]; ok && version != served.RoutesVersion {
+			diff.add(routeConfigResource, name, fmt.Sprintf("Envoy is at version %v, Gloo serves version %v", version, served.RoutesVersion))
+		}
+	}
+	diffResources(diff, routeConfigResource, routes, servedRoutes)
+
+	for _, routeConfig := range served.Routes {
+		for _, virtualHost := range routeConfig.GetVirtualHosts() {
+			for _, route := range virtualHost.GetRoutes() {
+				if route.GetRoute().GetCluster() == sanitizer.FallbackClusterName {
+					diff.add(routeConfigResource, routeConfig.Name, fmt.Sprintf("route %q of virtual host %v is replaced by Gloo "+
+						"with a direct response, as its destination is invalid", route.GetName(), virtualHost.GetName()))
+				}
+			}
+		}
+	}
+	return diff, nil
+}
+
+func diffResources(diff *ProxyConfigDiff, resourceType string, envoy *envoyResources, served map[string]interface{}) {
+	for _, name := range sortedKeys(served) {
+		if details, ok := envoy.rejected[name]; ok {
+			diff.add(resourceType, name, "the last update was rejected by Envoy: "+details)
+		}
+		active, ok := envoy.active[name]
+		switch {
+		case !ok && envoy.warming[name]:
+			diff.add(resourceType, name, "warming in Envoy, not active yet")
+		case !ok:
+			diff.add(resourceType, name, "served by Gloo, missing in Envoy")
+		default:
+			if changes := apply.DiffValues("", active, served[name]); len(changes) > 0 {
+				diff.add(resourceType, name, "differs from the config served by Gloo (- Envoy, + Gloo)", changes...)
+			}
+		}
+	}
+	for _, name := range sortedKeys(envoy.active) {
+		if _, ok := served[name]; !ok {
+			diff.add(resourceType, name, "active in Envoy, no longer served by Gloo")
+		}
+	}
+}
+
+// parseConfigDump returns the dynamic clusters, listeners and route configs of the config dump, by resource type.
+// The layouts of the v2 and v3 admin APIs are both supported.
+func parseConfigDump(configDump []byte) (map[string]*envoyResources, error) {
+	var dump struct {
+		Configs []map[string]interface{} `json:"configs"`
+	}
+	if err := json.Unmarshal(configDump, &dump); err != nil {
+		return nil, eris.Wrapf(err, "parsing the config dump of Envoy")
+	}
+	resources := map[string]*envoyResources{
+		clusterResource:     newEnvoyResources(),
+		listenerResource:    newEnvoyResources(),
+		routeConfigResource: newEnvoyResources(),
+	}
+	for _, config := range dump.Configs {
+		typeUrl, _ := config["@type"].(string)
+		switch {
+		case strings.HasSuffix(typeUrl, ".ClustersConfigDump"):
+			clusters := resources[clusterResource]
+			clusters.version, _ = config["version_info"].(string)
+			for _, dynamic := range objects(config["dynamic_active_clusters"]) {
+				addEnvoyResource(clusters.active, dynamic["cluster"])
+			}
+			for _, dynamic := range objects(config["dynamic_warming_clusters"]) {
+				clusters.warming[resourceName(dynamic["cluster"])] = true
+			}
+		case strings.HasSuffix(typeUrl, ".ListenersConfigDump"):
+			listeners := resources[listenerResource]
+			listeners.version, _ = config["version_info"].(string)
+			for _, dynamic := range objects(config["dynamic_listeners"]) {
+				name, _ := dynamic["name"].(string)
+				if active, ok := dynamic["active_state"].(map[string]interface{}); ok {
+					addEnvoyResource(listeners.active, active["listener"])
+				}
+				if _, ok := dynamic["warming_state"]; ok {
+					listeners.warming[name] = true
+				}
+				if errorState, ok := dynamic["error_state"].(map[string]interface{}); ok {
+					listeners.rejected[name], _ = errorState["details"].(string)
+				}
+			}
+			// the layout of the v2 admin API
+			for _, dynamic := range objects(config["dynamic_active_listeners"]) {
+				addEnvoyResource(listeners.active, dynamic["listener"])
+			}
+			for _, dynamic := range objects(config["dynamic_warming_listeners"]) {
+				listeners.warming[resourceName(dynamic["listener"])] = true
+			}
+		case strings.HasSuffix(typeUrl, ".RoutesConfigDump"):
+			routes := resources[routeConfigResource]
+			for _, dynamic := range objects(config["dynamic_route_configs"]) {
+				name := addEnvoyResource(routes.active, dynamic["route_config"])
+				routes.versions[name], _ = dynamic["version_info"].(string)
+			}
+		}
+	}
+	return resources, nil
+}
+
+func addEnvoyResource(active map[string]interface{}, resource interface{}) string {
+	name := resourceName(resource)
+	active[name] = withoutTypeUrls(resource)
+	return name
+}
+
+func resourceName(resource interface{}) string {
+	typed, _ := resource.(map[string]interface{})
+	name, _ := typed["name"].(string)
+	return name
+}
+
+func objects(value interface{}) []map[string]interface{} {
+	list, _ := value.([]interface{})
+	var out []map[string]interface{}
+	for _, item := range list {
+		if typed, ok := item.(map[string]interface{}); ok {
+			out = append(out, typed)
+		}
+	}
+	return out
+}
+
+// withoutTypeUrls removes the type URLs of the unmarshalled JSON value, which name the xDS version of each message
+func withoutTypeUrls(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		delete(typed, "@type")
+		for k, v := range typed {
+			typed[k] = withoutTypeUrls(v)
+		}
+	case []interface{}:
+		for i, v := range typed {
+			typed[i] = withoutTypeUrls(v)
+		}
+	}
+	return value
+}
+
+// envoyJson returns the resource as Envoy prints it in its config dump: with the names of the proto fields.
+func envoyJson(resource proto.Message) (interface{}, error) {
+	var buf bytes.Buffer
+	marshaler := jsonpb.Marshaler{OrigName: true, AnyResolver: anyResolver{}}
+	if err := marshaler.Marshal(&buf, resource); err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(buf.Bytes(), &value); err != nil {
+		return nil, err
+	}
+	return withoutTypeUrls(value), nil
+}
+
+// anyResolver resolves the messages of the envoy API, and the ones of the Gloo filters registered with gogo protobuf
+type anyResolver struct{}
+
+func (anyResolver) Resolve(typeUrl string) (proto.Message, error) {
+	name := typeUrl[strings.LastIndex(typeUrl, "/")+1:]
+	if messageType := proto.MessageType(name); messageType != nil {
+		return reflect.New(messageType.Elem()).Interface().(proto.Message), nil
+	}
+	if messageType := gogoproto.MessageType(name); messageType != nil {
+		return reflect.New(messageType.Elem()).Interface().(proto.Message), nil
+	}
+	return nil, eris.Errorf("unknown message type %q", name)
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
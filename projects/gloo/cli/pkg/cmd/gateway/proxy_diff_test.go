@@ -0,0 +1,192 @@
+package gateway_test
+
+import (
+	"bytes"
+	"time"
+
+	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoyhcm "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/http_connection_manager/v2"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/apply"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/gateway"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/xdsinspection"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
+)
+
+const configDump = `{
+  "configs": [
+    {
+      "@type": "type.googleapis.com/envoy.admin.v3.BootstrapConfigDump",
+      "bootstrap": {}
+    },
+    {
+      "@type": "type.googleapis.com/envoy.admin.v3.ClustersConfigDump",
+      "version_info": "11",
+      "static_clusters": [{"cluster": {"@type": "type.googleapis.com/envoy.config.cluster.v3.Cluster", "name": "xds_cluster"}}],
+      "dynamic_active_clusters": [
+        {
+          "version_info": "11",
+          "cluster": {"@type": "type.googleapis.com/envoy.config.cluster.v3.Cluster", "name": "petstore_gloo-system", "connect_timeout": "1s"}
+        },
+        {
+          "version_info": "11",
+          "cluster": {"@type": "type.googleapis.com/envoy.config.cluster.v3.Cluster", "name": "removed_gloo-system", "connect_timeout": "5s"}
+        }
+      ]
+    },
+    {
+      "@type": "type.googleapis.com/envoy.admin.v3.ListenersConfigDump",
+      "version_info": "7",
+      "dynamic_listeners": [
+        {
+          "name": "listener-::-8080",
+          "active_state": {
+            "version_info": "7",
+            "listener": {
+              "@type": "type.googleapis.com/envoy.config.listener.v3.Listener",
+              "name": "listener-::-8080",
+              "filter_chains": [{
+                "filters": [{
+                  "name": "envoy.http_connection_manager",
+                  "typed_config": {
+                    "@type": "type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager",
+                    "stat_prefix": "http",
+                    "rds": {"route_config_name": "listener-::-8080-routes"},
+                    "http_filters": [{
+                      "name": "io.solo.transformation",
+                      "typed_config": {
+                        "@type": "type.googleapis.com/envoy.api.v2.filter.http.FilterTransformations",
+                        "stage": 1
+                      }
+                    }]
+                  }
+                }]
+              }]
+            }
+          },
+          "error_state": {"details": "invalid listener"}
+        }
+      ]
+    },
+    {
+      "@type": "type.googleapis.com/envoy.admin.v3.RoutesConfigDump",
+      "dynamic_route_configs": [
+        {
+          "version_info": "3",
+          "route_config": {
+            "@type": "type.googleapis.com/envoy.config.route.v3.RouteConfiguration",
+            "name": "listener-::-8080-routes",
+            "virtual_hosts": [{
+              "name": "petstore",
+              "domains": ["*"],
+              "routes": [{"name": "pets", "match": {"prefix": "/"}, "route": {"cluster": "fallback_cluster_for_invalid_routes"}}]
+            }]
+          }
+        }
+      ]
+    }
+  ]
+}`
+
+var _ = Describe("DiffProxyConfig", func() {
+
+	var served *xdsinspection.XdsDump
+
+	BeforeEach(func() {
+		transformations, err := gogoproto.Marshal(&transformation.FilterTransformations{Stage: 1})
+		Expect(err).NotTo(HaveOccurred())
+		hcm, err := ptypes.MarshalAny(&envoyhcm.HttpConnectionManager{
+			StatPrefix: "http",
+			RouteSpecifier: &envoyhcm.HttpConnectionManager_Rds{
+				Rds: &envoyhcm.Rds{RouteConfigName: "listener-::-8080-routes"},
+			},
+			HttpFilters: []*envoyhcm.HttpFilter{{
+				Name: "io.solo.transformation",
+				ConfigType: &envoyhcm.HttpFilter_TypedConfig{TypedConfig: &any.Any{
+					TypeUrl: "type.googleapis.com/envoy.api.v2.filter.http.FilterTransformations",
+					Value:   transformations,
+				}},
+			}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		served = &xdsinspection.XdsDump{
+			Clusters: []v2.Cluster{
+				{Name: "petstore_gloo-system", ConnectTimeout: ptypes.DurationProto(5 * time.Second)},
+				{Name: "new_gloo-system", ConnectTimeout: ptypes.DurationProto(5 * time.Second)},
+			},
+			Listeners: []v2.Listener{{
+				Name: "listener-::-8080",
+				FilterChains: []*envoylistener.FilterChain{{
+					Filters: []*envoylistener.Filter{{
+						Name:       wellknown.HTTPConnectionManager,
+						ConfigType: &envoylistener.Filter_TypedConfig{TypedConfig: hcm},
+					}},
+				}},
+			}},
+			Routes: []v2.RouteConfiguration{{
+				Name: "listener-::-8080-routes",
+				VirtualHosts: []*envoyroute.VirtualHost{{
+					Name:    "petstore",
+					Domains: []string{"*"},
+					Routes: []*envoyroute.Route{{
+						Name:  "pets",
+						Match: &envoyroute.RouteMatch{PathSpecifier: &envoyroute.RouteMatch_Prefix{Prefix: "/"}},
+						Action: &envoyroute.Route_Route{Route: &envoyroute.RouteAction{
+							ClusterSpecifier: &envoyroute.RouteAction_Cluster{Cluster: sanitizer.FallbackClusterName},
+						}},
+					}},
+				}},
+			}},
+			ClustersVersion:  "12",
+			ListenersVersion: "7",
+			RoutesVersion:    "3",
+		}
+	})
+
+	It("reports the drift of the resources", func() {
+		diff, err := gateway.DiffProxyConfig(served, []byte(configDump))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff.Drifts).To(Equal([]gateway.ConfigDrift{
+			{Resource: "clusters", Message: "Envoy is at version 11, Gloo serves version 12"},
+			{Resource: "cluster new_gloo-system", Message: "served by Gloo, missing in Envoy"},
+			{
+				Resource: "cluster petstore_gloo-system",
+				Message:  "differs from the config served by Gloo (- Envoy, + Gloo)",
+				Changes:  []apply.FieldChange{{Type: apply.FieldChanged, Path: "connect_timeout", OldValue: "1s", NewValue: "5s"}},
+			},
+			{Resource: "cluster removed_gloo-system", Message: "active in Envoy, no longer served by Gloo"},
+			{Resource: "listener listener-::-8080", Message: "the last update was rejected by Envoy: invalid listener"},
+			{
+				Resource: "route config listener-::-8080-routes",
+				Message: `route "pets" of virtual host petstore is replaced by Gloo with a direct response, ` +
+					"as its destination is invalid",
+			},
+		}))
+	})
+
+	It("reports no drift when Envoy has the config served by Gloo", func() {
+		served.Clusters[0].ConnectTimeout = ptypes.DurationProto(time.Second)
+		served.Clusters[1].Name = "removed_gloo-system"
+		served.ClustersVersion = "11"
+		served.Routes[0].VirtualHosts[0].Routes[0].GetRoute().ClusterSpecifier = &envoyroute.RouteAction_Cluster{Cluster: "petstore_gloo-system"}
+		dump := bytes.Replace([]byte(configDump), []byte(`"error_state": {"details": "invalid listener"}`), []byte(`"draining_state": null`), 1)
+		dump = bytes.Replace(dump, []byte(`"fallback_cluster_for_invalid_routes"`), []byte(`"petstore_gloo-system"`), 1)
+
+		diff, err := gateway.DiffProxyConfig(served, dump)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff.Drifts).To(BeEmpty())
+
+		var out bytes.Buffer
+		diff.Print(&out)
+		Expect(out.String()).To(Equal("the config of the proxy matches the config served by Gloo\n"))
+	})
+})
@@ -27,6 +27,7 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 	cmd.AddCommand(logsCmd(opts))
 	cmd.AddCommand(statsCmd(opts))
 	cmd.AddCommand(servedConfigCmd(opts))
+	cmd.AddCommand(diffCmd(opts))
 	cliutils.ApplyOptions(cmd, optionsFunc)
 	return cmd
 }
@@ -1,6 +1,8 @@
 package get
 
 import (
+	"os"
+
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/common"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
@@ -14,12 +16,16 @@ func Upstream(opts *options.Options) *cobra.Command {
 		Use:     constants.UPSTREAM_COMMAND.Use,
 		Aliases: constants.UPSTREAM_COMMAND.Aliases,
 		Short:   "read an upstream or list upstreams in a namespace",
-		Long:    "usage: glooctl get upstream [NAME] [--namespace=namespace] [-o FORMAT]",
+		Long: "usage: glooctl get upstream [NAME] [--namespace=namespace] [-o FORMAT]\n\n" +
+			"In interactive mode (-i), search the upstreams and their functions, and generate a route to a function.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			upstreams, err := common.GetUpstreams(common.GetName(args, opts), opts)
 			if err != nil {
 				return err
 			}
+			if opts.Top.Interactive {
+				return browseUpstreamsInteractive(opts, upstreams, os.Stdout)
+			}
 			var xdsDump *xdsinspection.XdsDump
 			if opts.Top.Output == printers.WIDE {
 				xdsDump, err = xdsinspection.GetGlooXdsDump(opts.Top.Ctx, opts.Proxy.Name, opts.Metadata.Namespace, false)
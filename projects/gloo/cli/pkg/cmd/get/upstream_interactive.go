@@ -0,0 +1,243 @@
+package get
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/ghodss/yaml"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/cliutil"
+	"github.com/solo-io/gloo/pkg/utils/selectionutils"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/surveyutils"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/azure"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/rest"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/go-utils/protoutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const (
+	doNotAddRoute = "do not add the route"
+)
+
+var NoUpstreamsErr = eris.New("no upstreams found. create an upstream first or enable discovery.")
+
+// a function of an upstream, discovered or configured, which a route can invoke
+type upstreamFunction struct {
+	// REST, gRPC, AWS Lambda or Azure
+	kind            string
+	name            string
+	destinationSpec *v1.DestinationSpec
+}
+
+func (f upstreamFunction) String() string {
+	return fmt.Sprintf("%v %v", f.kind, f.name)
+}
+
+// browseUpstreamsInteractive lets the user search the upstreams and their functions, prints the
+// details of the selected upstream, and generates a route to the selected function
+func browseUpstreamsInteractive(opts *options.Options, upstreams v1.UpstreamList, w io.Writer) error {
+	if len(upstreams) == 0 {
+		return NoUpstreamsErr
+	}
+	usByKey := make(map[string]*v1.Upstream)
+	var usKeys []string
+	for _, us := range upstreams {
+		functions := upstreamFunctions(us)
+		key := fmt.Sprintf("%v (%v, %v, %d functions)", us.GetMetadata().Ref().Key(), printers.UpstreamType(us),
+			us.Status.State.String(), len(functions))
+		usByKey[key] = us
+		usKeys = append(usKeys, key)
+	}
+	var usKey string
+	if err := cliutil.FuzzyChooseFromList("Choose an upstream (type to search): ", &usKey, usKeys); err != nil {
+		return err
+	}
+	us := usByKey[usKey]
+	printers.UpstreamTable(nil, v1.UpstreamList{us}, w)
+	if reason := us.Status.Reason; reason != "" {
+		fmt.Fprintf(w, "status reason: %v\n", reason)
+	}
+
+	functions := upstreamFunctions(us)
+	if len(functions) == 0 {
+		fmt.Fprintf(w, "upstream %v has no functions\n", us.GetMetadata().Ref().Key())
+		return nil
+	}
+	fnByKey := make(map[string]upstreamFunction)
+	var fnKeys []string
+	for _, fn := range functions {
+		fnByKey[fn.String()] = fn
+		fnKeys = append(fnKeys, fn.String())
+	}
+	fnKeys = append(fnKeys, surveyutils.NoneOfTheAbove)
+	var fnKey string
+	if err := cliutil.FuzzyChooseFromList("Choose a function to generate a route to (type to search): ", &fnKey, fnKeys); err != nil {
+		return err
+	}
+	fn, ok := fnByKey[fnKey]
+	if !ok {
+		return nil
+	}
+
+	var prefix string
+	if err := cliutil.GetStringInputDefault("What path prefix should the route match? ", &prefix, "/"+fn.name); err != nil {
+		return err
+	}
+	route := functionRoute(us.GetMetadata().Ref(), fn, prefix)
+	routeYaml, err := routeYaml(route)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "generated route:\n%v", routeYaml)
+
+	return addRouteInteractive(opts, route, w)
+}
+
+// upstreamFunctions returns the functions of the upstream, in the order they are printed in the upstream table
+func upstreamFunctions(us *v1.Upstream) []upstreamFunction {
+	var functions []upstreamFunction
+	switch usType := us.GetUpstreamType().(type) {
+	case *v1.Upstream_Aws:
+		for _, fn := range usType.Aws.GetLambdaFunctions() {
+			functions = append(functions, upstreamFunction{
+				kind: "AWS Lambda",
+				name: fn.GetLogicalName(),
+				destinationSpec: &v1.DestinationSpec{
+					DestinationType: &v1.DestinationSpec_Aws{Aws: &aws.DestinationSpec{LogicalName: fn.GetLogicalName()}},
+				},
+			})
+		}
+	case *v1.Upstream_Azure:
+		for _, fn := range usType.Azure.GetFunctions() {
+			functions = append(functions, upstreamFunction{
+				kind: "Azure",
+				name: fn.GetFunctionName(),
+				destinationSpec: &v1.DestinationSpec{
+					DestinationType: &v1.DestinationSpec_Azure{Azure: &azure.DestinationSpec{FunctionName: fn.GetFunctionName()}},
+				},
+			})
+		}
+	case v1.ServiceSpecGetter:
+		switch spec := usType.GetServiceSpec().GetPluginType().(type) {
+		case *plugins.ServiceSpec_Rest:
+			var names []string
+			for name := range spec.Rest.GetTransformations() {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				functions = append(functions, upstreamFunction{
+					kind: "REST",
+					name: name,
+					destinationSpec: &v1.DestinationSpec{
+						DestinationType: &v1.DestinationSpec_Rest{Rest: &rest.DestinationSpec{FunctionName: name}},
+					},
+				})
+			}
+		case *plugins.ServiceSpec_Grpc:
+			for _, service := range spec.Grpc.GetGrpcServices() {
+				for _, name := range service.GetFunctionNames() {
+					functions = append(functions, upstreamFunction{
+						kind: "gRPC",
+						name: fmt.Sprintf("%v.%v.%v", service.GetPackageName(), service.GetServiceName(), name),
+						destinationSpec: &v1.DestinationSpec{
+							DestinationType: &v1.DestinationSpec_Grpc{Grpc: &grpc.DestinationSpec{
+								Package:  service.GetPackageName(),
+								Service:  service.GetServiceName(),
+								Function: name,
+							}},
+						},
+					})
+				}
+			}
+		}
+	}
+	return functions
+}
+
+func functionRoute(upstream core.ResourceRef, fn upstreamFunction, prefix string) *gatewayv1.Route {
+	return &gatewayv1.Route{
+		Matchers: []*matchers.Matcher{{
+			PathSpecifier: &matchers.Matcher_Prefix{Prefix: prefix},
+		}},
+		Action: &gatewayv1.Route_RouteAction{
+			RouteAction: &v1.RouteAction{
+				Destination: &v1.RouteAction_Single{
+					Single: &v1.Destination{
+						DestinationType: &v1.Destination_Upstream{Upstream: &upstream},
+						DestinationSpec: fn.destinationSpec,
+					},
+				},
+			},
+		},
+	}
+}
+
+func routeYaml(route *gatewayv1.Route) (string, error) {
+	jsn, err := protoutils.MarshalBytes(route)
+	if err != nil {
+		return "", err
+	}
+	raw, err := yaml.JSONToYAML(jsn)
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+// addRouteInteractive adds the route at the top of the selected virtual service, like glooctl add route does
+func addRouteInteractive(opts *options.Options, route *gatewayv1.Route, w io.Writer) error {
+	vsByKey := make(map[string]core.ResourceRef)
+	vsKeys := []string{doNotAddRoute}
+	for _, ns := range helpers.MustGetNamespaces() {
+		vsList, err := helpers.MustNamespacedVirtualServiceClient(ns).List(ns, clients.ListOpts{Ctx: opts.Top.Ctx})
+		if err != nil {
+			return err
+		}
+		for _, vs := range vsList {
+			ref := vs.GetMetadata().Ref()
+			vsByKey[ref.Key()] = ref
+			vsKeys = append(vsKeys, ref.Key())
+		}
+	}
+	defaultRef := core.ResourceRef{Name: "default", Namespace: defaults.GlooSystem}
+	if _, ok := vsByKey[defaultRef.Key()]; !ok {
+		key := fmt.Sprintf("create the %v virtual service", defaultRef.Key())
+		vsByKey[key] = defaultRef
+		vsKeys = append(vsKeys, key)
+	}
+
+	var vsKey string
+	if err := cliutil.FuzzyChooseFromList("Choose a Virtual Service to add the route to: ", &vsKey, vsKeys); err != nil {
+		return err
+	}
+	vsRef, ok := vsByKey[vsKey]
+	if !ok {
+		return nil
+	}
+
+	vsClient := helpers.MustNamespacedVirtualServiceClient(vsRef.Namespace)
+	selector := selectionutils.NewVirtualServiceSelector(vsClient, helpers.NewProvidedNamespaceLister([]string{vsRef.Namespace}), defaults.GlooSystem)
+	virtualService, err := selector.SelectOrBuildVirtualService(opts.Top.Ctx, &vsRef)
+	if err != nil {
+		return err
+	}
+	virtualService.VirtualHost.Routes = append([]*gatewayv1.Route{route}, virtualService.VirtualHost.Routes...)
+	if _, err := vsClient.Write(virtualService, clients.WriteOpts{Ctx: opts.Top.Ctx, OverwriteExisting: true}); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "added the route to virtual service %v\n", vsRef.Key())
+	return nil
+}
@@ -0,0 +1,98 @@
+package get_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/pkg/cliutil/testutil"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/get"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/rest"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Upstream interactive", func() {
+
+	BeforeEach(func() {
+		helpers.UseMemoryClients()
+		_, err := helpers.MustKubeClient().CoreV1().Namespaces().Create(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "gloo-system"}})
+		Expect(err).NotTo(HaveOccurred())
+
+		upClient := helpers.MustUpstreamClient()
+		_, err = upClient.Write(&gloov1.Upstream{
+			Metadata: core.Metadata{Name: "default-petstore-8080", Namespace: "gloo-system"},
+			UpstreamType: &gloov1.Upstream_Kube{Kube: &kubernetes.UpstreamSpec{
+				ServiceName:      "petstore",
+				ServiceNamespace: "default",
+				ServicePort:      8080,
+				ServiceSpec: &plugins.ServiceSpec{PluginType: &plugins.ServiceSpec_Rest{Rest: &rest.ServiceSpec{
+					Transformations: map[string]*transformation.TransformationTemplate{"addPet": {}, "deletePet": {}, "findPetById": {}},
+				}}},
+			}},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = upClient.Write(&gloov1.Upstream{
+			Metadata:     core.Metadata{Name: "static", Namespace: "gloo-system"},
+			UpstreamType: &gloov1.Upstream_Static{Static: &static.UpstreamSpec{Hosts: []*static.Host{{Addr: "example.com", Port: 80}}}},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		helpers.UseDefaultClients()
+	})
+
+	getUpstreamInteractive := func() error {
+		opts := &options.Options{Top: options.Top{Ctx: context.Background(), Interactive: true}}
+		cmd := get.RootCmd(opts)
+		cmd.SetArgs([]string{"upstream"})
+		return cmd.Execute()
+	}
+
+	It("generates a route to the selected function", func() {
+		testutil.ExpectInteractive(func(c *testutil.Console) {
+			c.ExpectString("Choose an upstream (type to search):")
+			c.SendLine("ptsr")
+			c.ExpectString("Choose a function to generate a route to (type to search):")
+			c.SendLine("fpid")
+			c.ExpectString("What path prefix should the route match?")
+			c.SendLine("")
+			c.ExpectString("Choose a Virtual Service to add the route to:")
+			c.SendLine("create")
+			c.ExpectEOF()
+		}, func() {
+			err := getUpstreamInteractive()
+			Expect(err).NotTo(HaveOccurred())
+
+			vs, err := helpers.MustVirtualServiceClient().Read("gloo-system", "default", clients.ReadOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vs.VirtualHost.Routes).To(HaveLen(1))
+			route := vs.VirtualHost.Routes[0]
+			Expect(route.Matchers[0].GetPrefix()).To(Equal("/findPetById"))
+			single := route.GetRouteAction().GetSingle()
+			Expect(single.GetUpstream().GetName()).To(Equal("default-petstore-8080"))
+			Expect(single.GetDestinationSpec().GetRest().GetFunctionName()).To(Equal("findPetById"))
+		})
+	})
+
+	It("does not ask for a function for the upstreams without functions", func() {
+		testutil.ExpectInteractive(func(c *testutil.Console) {
+			c.ExpectString("Choose an upstream (type to search):")
+			c.SendLine("static")
+			c.ExpectEOF()
+		}, func() {
+			err := getUpstreamInteractive()
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
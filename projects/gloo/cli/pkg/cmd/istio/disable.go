@@ -0,0 +1,90 @@
+package istio
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/spf13/cobra"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Disable is an istio subcommand in glooctl which reverts enable: it removes the istio sslConfig
+// from the upstreams, then the SDS and istio-proxy sidecars from the gateway-proxy pod.
+// The steps which were already reverted are skipped.
+func Disable(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable",
+		Short: "Disable Istio mTLS for upstreams and the Istio integration of the gateway-proxy",
+		Long: "Removes the sslConfig which gets the Istio mTLS certs via SDS from all the upstreams which use it. " +
+			"Then removes the sds and istio-proxy sidecars from the gateway-proxy pod and the gateway_proxy_sds " +
+			"cluster from the gateway-proxy envoy bootstrap ConfigMap, if they are present.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return istioDisable(opts, os.Stdout)
+		},
+	}
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func istioDisable(opts *options.Options, w io.Writer) error {
+	glooNS := opts.Metadata.Namespace
+
+	// the upstreams stop using the certs before the sidecars which serve them are removed
+	upClient := helpers.MustNamespacedUpstreamClient(glooNS)
+	upstreams, err := upClient.List(glooNS, clients.ListOpts{Ctx: opts.Top.Ctx})
+	if err != nil {
+		return eris.Wrapf(err, "listing upstreams in %v", glooNS)
+	}
+	for _, up := range upstreams {
+		if !usesIstioSslConfig(up.GetSslConfig()) {
+			continue
+		}
+		up.SslConfig = nil
+		if _, err := upClient.Write(up, clients.WriteOpts{Ctx: opts.Top.Ctx, OverwriteExisting: true}); err != nil {
+			return eris.Wrapf(err, "writing upstream %v", up.GetMetadata().Ref().Key())
+		}
+		fmt.Fprintf(w, "upstream %v no longer uses Istio mTLS\n", up.GetMetadata().Ref().Key())
+	}
+
+	return disableSidecars(glooNS, w)
+}
+
+// disableSidecars removes the sds and istio-proxy sidecars from the gateway-proxy deployment,
+// and the gateway_proxy_sds cluster from its bootstrap config, if they are present
+func disableSidecars(glooNS string, w io.Writer) error {
+	client := helpers.MustKubeClient()
+
+	deployment, err := client.AppsV1().Deployments(glooNS).Get(gatewayProxyDeployment, metav1.GetOptions{})
+	if err != nil {
+		return eris.Wrapf(err, "reading the %v deployment", gatewayProxyDeployment)
+	}
+	if sdsPresent, istioPresent := removeSidecars(deployment); sdsPresent || istioPresent {
+		removeIstioVolumes(deployment)
+		if _, err := client.AppsV1().Deployments(glooNS).Update(deployment); err != nil {
+			return eris.Wrapf(err, "updating the %v deployment", gatewayProxyDeployment)
+		}
+		fmt.Fprintf(w, "removed the sds and istio-proxy sidecars from the %v deployment\n", gatewayProxyDeployment)
+	}
+
+	configMap, err := client.CoreV1().ConfigMaps(glooNS).Get(gatewayProxyConfigMap, metav1.GetOptions{})
+	if err != nil {
+		return eris.Wrapf(err, "reading the %v configmap", gatewayProxyConfigMap)
+	}
+	if hasSdsCluster(configMap) {
+		if err := removeSdsCluster(configMap); err != nil {
+			return err
+		}
+		if _, err := client.CoreV1().ConfigMaps(glooNS).Update(configMap); err != nil {
+			return eris.Wrapf(err, "updating the %v configmap", gatewayProxyConfigMap)
+		}
+		fmt.Fprintf(w, "removed the %v cluster from the %v configmap\n", sdsClusterName, gatewayProxyConfigMap)
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package istio
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/spf13/cobra"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const gatewayProxyDeployment = "gateway-proxy"
+
+var (
+	// ErrPartialSidecars occurs when only one of the sds and istio-proxy sidecars is present on the gateway-proxy pod
+	ErrPartialSidecars = eris.New("only one of the sds and istio-proxy sidecars is present on the gateway-proxy pod: " +
+		"run glooctl istio disable first")
+	// ErrUpstreamHasSslConfig occurs when enabling istio mTLS for an upstream which already has another sslConfig
+	ErrUpstreamHasSslConfig = func(upstream string) error {
+		return eris.Errorf("upstream %v already has an sslConfig set which does not use the istio mTLS certs", upstream)
+	}
+)
+
+// Enable is an istio subcommand in glooctl which automates the istio integration of the gateway-proxy:
+// it injects the SDS and istio-proxy sidecars, like inject, then makes the given upstreams use the
+// istio mTLS certs, like enable-mtls. The steps which were already done are skipped.
+func Enable(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable",
+		Short: "Enable the Istio integration of the gateway-proxy and Istio mTLS for upstreams",
+		Long: "Adds the sds and istio-proxy sidecars to the gateway-proxy pod and the gateway_proxy_sds cluster " +
+			"to the gateway-proxy envoy bootstrap ConfigMap, unless they are already present. " +
+			"Then adds an sslConfig which gets the Istio mTLS certs via SDS to the given upstreams.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return istioEnable(opts, os.Stdout)
+		},
+	}
+	pflags := cmd.PersistentFlags()
+	addIstioNamespaceFlag(pflags, &opts.Istio.Namespace)
+	pflags.StringSliceVarP(&opts.Istio.Upstreams, "upstreams", "u", nil, "upstreams which use the Istio mTLS certs")
+	pflags.BoolVar(&opts.Istio.AllKubeUpstreams, "all-kube-upstreams", false, "use the Istio mTLS certs for all the Kubernetes upstreams without an sslConfig")
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func istioEnable(opts *options.Options, w io.Writer) error {
+	glooNS := opts.Metadata.Namespace
+	upClient := helpers.MustNamespacedUpstreamClient(glooNS)
+
+	// the upstreams are checked before anything is changed, so that no change is made when one of them can not use istio mTLS
+	upstreams, err := upstreamsToEnable(upClient, glooNS, opts.Istio)
+	if err != nil {
+		return err
+	}
+
+	// the sidecars are added before the upstreams are changed, so that the certs can be served when envoy asks for them
+	if err := enableSidecars(glooNS, opts.Istio.Namespace, w); err != nil {
+		return err
+	}
+
+	for _, up := range upstreams {
+		ref := up.GetMetadata().Ref()
+		if usesIstioSslConfig(up.GetSslConfig()) {
+			fmt.Fprintf(w, "upstream %v already uses Istio mTLS\n", ref.Key())
+			continue
+		}
+		up.SslConfig = istioSslConfig()
+		if _, err := upClient.Write(up, clients.WriteOpts{Ctx: opts.Top.Ctx, OverwriteExisting: true}); err != nil {
+			return eris.Wrapf(err, "writing upstream %v", ref.Key())
+		}
+		fmt.Fprintf(w, "upstream %v now uses Istio mTLS\n", ref.Key())
+	}
+	return nil
+}
+
+// upstreamsToEnable returns the upstreams selected by the options, and checks that they can use the istio sslConfig
+func upstreamsToEnable(upClient gloov1.UpstreamClient, namespace string, opts options.Istio) (gloov1.UpstreamList, error) {
+	var out gloov1.UpstreamList
+	selected := map[string]bool{}
+	for _, name := range opts.Upstreams {
+		up, err := upClient.Read(namespace, name, clients.ReadOpts{})
+		if err != nil {
+			return nil, eris.Wrapf(err, "reading upstream %v.%v", namespace, name)
+		}
+		if up.GetSslConfig() != nil && !usesIstioSslConfig(up.GetSslConfig()) {
+			return nil, ErrUpstreamHasSslConfig(up.GetMetadata().Ref().Key())
+		}
+		selected[name] = true
+		out = append(out, up)
+	}
+	if opts.AllKubeUpstreams {
+		upstreams, err := upClient.List(namespace, clients.ListOpts{})
+		if err != nil {
+			return nil, eris.Wrapf(err, "listing upstreams in %v", namespace)
+		}
+		for _, up := range upstreams {
+			// the kubernetes upstreams with another sslConfig are left as they are
+			if selected[up.GetMetadata().Name] || up.GetKube() == nil || up.GetSslConfig() != nil {
+				continue
+			}
+			out = append(out, up)
+		}
+	}
+	return out, nil
+}
+
+// enableSidecars adds the sds and istio-proxy sidecars to the gateway-proxy deployment,
+// and the gateway_proxy_sds cluster to its bootstrap config, unless they are already present
+func enableSidecars(glooNS, istioNS string, w io.Writer) error {
+	client := helpers.MustKubeClient()
+
+	configMap, err := client.CoreV1().ConfigMaps(glooNS).Get(gatewayProxyConfigMap, metav1.GetOptions{})
+	if err != nil {
+		return eris.Wrapf(err, "reading the %v configmap", gatewayProxyConfigMap)
+	}
+	if hasSdsCluster(configMap) {
+		fmt.Fprintf(w, "the %v cluster is already present in the %v configmap\n", sdsClusterName, gatewayProxyConfigMap)
+	} else {
+		if err := addSdsCluster(configMap); err != nil {
+			return err
+		}
+		if _, err := client.CoreV1().ConfigMaps(glooNS).Update(configMap); err != nil {
+			return eris.Wrapf(err, "updating the %v configmap", gatewayProxyConfigMap)
+		}
+		fmt.Fprintf(w, "added the %v cluster to the %v configmap\n", sdsClusterName, gatewayProxyConfigMap)
+	}
+
+	deployment, err := client.AppsV1().Deployments(glooNS).Get(gatewayProxyDeployment, metav1.GetOptions{})
+	if err != nil {
+		return eris.Wrapf(err, "reading the %v deployment", gatewayProxyDeployment)
+	}
+	sdsPresent, istioPresent := removeSidecars(deployment.DeepCopy())
+	switch {
+	case sdsPresent && istioPresent:
+		fmt.Fprintf(w, "the sds and istio-proxy sidecars are already present in the %v deployment\n", gatewayProxyDeployment)
+		return nil
+	case sdsPresent || istioPresent:
+		return ErrPartialSidecars
+	}
+	if err := addSdsSidecar(deployment, glooNS); err != nil {
+		return err
+	}
+	if err := addIstioSidecar(deployment, istioNS); err != nil {
+		return err
+	}
+	if _, err := client.AppsV1().Deployments(glooNS).Update(deployment); err != nil {
+		return eris.Wrapf(err, "updating the %v deployment", gatewayProxyDeployment)
+	}
+	fmt.Fprintf(w, "added the sds and istio-proxy sidecars to the %v deployment\n", gatewayProxyDeployment)
+	return nil
+}
+
+func hasSdsCluster(configMap *corev1.ConfigMap) bool {
+	return strings.Contains(configMap.Data[envoyDataKey], sdsClusterName)
+}
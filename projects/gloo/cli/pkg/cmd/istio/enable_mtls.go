@@ -57,7 +57,15 @@ func istioEnableMTLS(args []string, opts *options.Options) error {
 		return errors.Wrapf(err, "Error upstream already has an sslConfig set")
 	}
 
-	up.SslConfig = &gloov1.UpstreamSslConfig{
+	up.SslConfig = istioSslConfig()
+
+	_, err = upClient.Write(up, clients.WriteOpts{OverwriteExisting: true})
+	return err
+}
+
+// istioSslConfig returns the sslConfig with which an upstream gets the istio mTLS certs from the SDS sidecar
+func istioSslConfig() *gloov1.UpstreamSslConfig {
+	return &gloov1.UpstreamSslConfig{
 		AlpnProtocols: []string{"istio"},
 		SslSecrets: &gloov1.UpstreamSslConfig_Sds{
 			Sds: &gloov1.SDSConfig{
@@ -70,7 +78,12 @@ func istioEnableMTLS(args []string, opts *options.Options) error {
 			},
 		},
 	}
+}
 
-	_, err = upClient.Write(up, clients.WriteOpts{OverwriteExisting: true})
-	return err
+// usesIstioSslConfig returns whether the sslConfig is the one set by istioSslConfig
+func usesIstioSslConfig(sslConfig *gloov1.UpstreamSslConfig) bool {
+	sds := sslConfig.GetSds()
+	return sds.GetCertificatesSecretName() == istioCertSecret &&
+		sds.GetValidationContextName() == istioValidationContext &&
+		sds.GetClusterName() == sdsClusterName
 }
@@ -0,0 +1,174 @@
+package istio_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/istio"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	kubeupstream "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+var _ = Describe("Enable and Disable", func() {
+
+	const (
+		glooNS  = "gloo-system"
+		istioNS = "istio-system"
+	)
+
+	var (
+		opts       *options.Options
+		kubeClient kubernetes.Interface
+		upClient   gloov1.UpstreamClient
+	)
+
+	deployment := func(namespace, name, container, image string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: container, Image: image}},
+					},
+				},
+			},
+		}
+	}
+
+	writeUpstream := func(up *gloov1.Upstream) {
+		_, err := upClient.Write(up, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	readUpstream := func(name string) *gloov1.Upstream {
+		up, err := upClient.Read(glooNS, name, clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		return up
+	}
+
+	gatewayProxyContainers := func() []string {
+		deploy, err := kubeClient.AppsV1().Deployments(glooNS).Get("gateway-proxy", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		var names []string
+		for _, container := range deploy.Spec.Template.Spec.Containers {
+			names = append(names, container.Name)
+		}
+		return names
+	}
+
+	gatewayProxyBootstrap := func() string {
+		configMap, err := kubeClient.CoreV1().ConfigMaps(glooNS).Get("gateway-proxy-envoy-config", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return configMap.Data["envoy.yaml"]
+	}
+
+	run := func(cmd string, args ...string) error {
+		root := istio.RootCmd(opts)
+		root.SetArgs(append([]string{cmd}, args...))
+		return root.Execute()
+	}
+
+	BeforeEach(func() {
+		helpers.UseMemoryClients()
+		opts = &options.Options{Top: options.Top{Ctx: context.Background()}}
+		kubeClient = helpers.MustKubeClient()
+		upClient = helpers.MustNamespacedUpstreamClient(glooNS)
+
+		for _, ns := range []string{glooNS, istioNS} {
+			_, err := kubeClient.CoreV1().Namespaces().Create(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}})
+			Expect(err).NotTo(HaveOccurred())
+		}
+		for _, deploy := range []*appsv1.Deployment{
+			deployment(glooNS, "gateway-proxy", "gateway-proxy", "quay.io/solo-io/gloo-envoy-wrapper:1.6.0"),
+			deployment(glooNS, "gateway", "gateway", "quay.io/solo-io/gateway:1.6.0"),
+			deployment(istioNS, "istiod", "discovery", "docker.io/istio/pilot:1.7.3"),
+		} {
+			_, err := kubeClient.AppsV1().Deployments(deploy.Namespace).Create(deploy)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		_, err := kubeClient.CoreV1().ConfigMaps(glooNS).Create(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "gateway-proxy-envoy-config", Namespace: glooNS},
+			Data: map[string]string{"envoy.yaml": `
+node:
+  cluster: gateway
+  id: gateway-proxy
+static_resources:
+  clusters:
+  - name: xds_cluster
+    connect_timeout: 5s
+`},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		writeUpstream(&gloov1.Upstream{
+			Metadata:     core.Metadata{Name: "kube", Namespace: glooNS},
+			UpstreamType: &gloov1.Upstream_Kube{Kube: &kubeupstream.UpstreamSpec{ServiceName: "petstore", ServiceNamespace: "default", ServicePort: 8080}},
+		})
+		writeUpstream(&gloov1.Upstream{
+			Metadata:     core.Metadata{Name: "static", Namespace: glooNS},
+			UpstreamType: &gloov1.Upstream_Static{Static: &static.UpstreamSpec{Hosts: []*static.Host{{Addr: "example.com", Port: 80}}}},
+		})
+	})
+
+	AfterEach(func() {
+		helpers.UseDefaultClients()
+	})
+
+	It("injects the sidecars and makes the upstreams use the istio mTLS certs", func() {
+		err := run("enable", "--all-kube-upstreams", "--upstreams", "static")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(gatewayProxyContainers()).To(Equal([]string{"gateway-proxy", "sds", "istio-proxy"}))
+		Expect(gatewayProxyBootstrap()).To(ContainSubstring("gateway_proxy_sds"))
+		for _, name := range []string{"kube", "static"} {
+			sds := readUpstream(name).GetSslConfig().GetSds()
+			Expect(sds.GetCertificatesSecretName()).To(Equal("istio_server_cert"))
+			Expect(sds.GetClusterName()).To(Equal("gateway_proxy_sds"))
+		}
+
+		By("skipping the steps which are already done")
+		err = run("enable", "--upstreams", "kube")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gatewayProxyContainers()).To(HaveLen(3))
+
+		By("reverting all the steps")
+		err = run("disable")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gatewayProxyContainers()).To(Equal([]string{"gateway-proxy"}))
+		Expect(gatewayProxyBootstrap()).NotTo(ContainSubstring("gateway_proxy_sds"))
+		Expect(readUpstream("kube").GetSslConfig()).To(BeNil())
+		Expect(readUpstream("static").GetSslConfig()).To(BeNil())
+
+		err = run("disable")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("does not change anything when an upstream has another sslConfig", func() {
+		up := readUpstream("static")
+		up.SslConfig = &gloov1.UpstreamSslConfig{Sni: "example.com"}
+		writeUpstream(up)
+
+		err := run("enable", "--upstreams", "kube,static")
+		Expect(err).To(MatchError(istio.ErrUpstreamHasSslConfig(glooNS + ".static")))
+		Expect(gatewayProxyContainers()).To(Equal([]string{"gateway-proxy"}))
+		Expect(readUpstream("kube").GetSslConfig()).To(BeNil())
+
+		By("leaving the kubernetes upstreams with another sslConfig as they are")
+		up = readUpstream("kube")
+		up.SslConfig = &gloov1.UpstreamSslConfig{Sni: "petstore"}
+		writeUpstream(up)
+		err = run("enable", "--all-kube-upstreams")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(readUpstream("kube").GetSslConfig().GetSni()).To(Equal("petstore"))
+	})
+})
@@ -35,6 +35,8 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 	cmd.AddCommand(Inject(opts))
 	cmd.AddCommand(Uninject(opts))
 	cmd.AddCommand(EnableMTLS(opts))
+	cmd.AddCommand(Enable(opts))
+	cmd.AddCommand(Disable(opts))
 	cliutils.ApplyOptions(cmd, optionsFunc)
 	return cmd
 }
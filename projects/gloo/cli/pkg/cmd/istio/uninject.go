@@ -81,34 +81,11 @@ func istioUninject(args []string, opts *options.Options) error {
 
 	for _, deployment := range deployments.Items {
 		if deployment.Name == "gateway-proxy" {
-			containers := deployment.Spec.Template.Spec.Containers
-
-			// Remove Sidecars
-			sdsPresent := false
-			istioPresent := false
-			if len(containers) > 1 {
-				for i := len(containers) - 1; i >= 0; i-- {
-					container := containers[i]
-					if container.Name == "sds" {
-						sdsPresent = true
-						copy(containers[i:], containers[i+1:])
-						containers = containers[:len(containers)-1]
-					}
-					if container.Name == "istio-proxy" {
-						istioPresent = true
-
-						copy(containers[i:], containers[i+1:])
-						containers = containers[:len(containers)-1]
-					}
-				}
-			}
-
+			sdsPresent, istioPresent := removeSidecars(&deployment)
 			if !sdsPresent || !istioPresent {
 				return ErrMissingSidecars
 			}
 
-			deployment.Spec.Template.Spec.Containers = containers
-
 			removeIstioVolumes(&deployment)
 			_, err = client.AppsV1().Deployments(glooNS).Update(&deployment)
 			if err != nil {
@@ -121,6 +98,30 @@ func istioUninject(args []string, opts *options.Options) error {
 	return nil
 }
 
+// removeSidecars removes the sds and istio-proxy sidecars from the given deployment's containers,
+// and returns whether each of them was present
+func removeSidecars(deployment *appsv1.Deployment) (sdsPresent, istioPresent bool) {
+	containers := deployment.Spec.Template.Spec.Containers
+	if len(containers) > 1 {
+		for i := len(containers) - 1; i >= 0; i-- {
+			container := containers[i]
+			if container.Name == "sds" {
+				sdsPresent = true
+				copy(containers[i:], containers[i+1:])
+				containers = containers[:len(containers)-1]
+			}
+			if container.Name == "istio-proxy" {
+				istioPresent = true
+
+				copy(containers[i:], containers[i+1:])
+				containers = containers[:len(containers)-1]
+			}
+		}
+	}
+	deployment.Spec.Template.Spec.Containers = containers
+	return sdsPresent, istioPresent
+}
+
 // removeIstioVolumes removes the istio volumes from the given deployment,
 func removeIstioVolumes(deployment *appsv1.Deployment) {
 	volsToRemove := make(map[string]bool)
@@ -3,6 +3,7 @@ package options
 import (
 	"context"
 	"sort"
+	"time"
 
 	rltypes "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
 
@@ -30,6 +31,9 @@ type Options struct {
 	Istio     Istio
 	Remove    Remove
 	Cluster   Cluster
+	Debug     Debug
+	Check     Check
+	Export    Export
 }
 
 type Top struct {
@@ -45,6 +49,7 @@ type Top struct {
 	DisableUsageStatistics bool
 	ConfigFilePath         string
 	Consul                 Consul // use consul as config backend
+	ConfigDirectory        string // use a directory as config backend
 }
 
 type HelmInstall struct {
@@ -100,9 +105,32 @@ type Proxy struct {
 	DebugLogs        bool
 }
 
+type Debug struct {
+	LogsSince time.Duration
+}
+
+type Check struct {
+	Watch         bool
+	WatchTimeout  time.Duration
+	WatchInterval time.Duration
+}
+
+type Export struct {
+	IncludeSecrets bool
+	RedactSecrets  bool
+}
+
 type Upgrade struct {
 	ReleaseTag   string
 	DownloadPath string
+	Prepare      UpgradePrepare
+}
+
+type UpgradePrepare struct {
+	// the version of the Gloo Helm chart to prepare the upgrade to
+	Version           string
+	HelmChartOverride string
+	DryRun            bool
 }
 
 type Get struct {
@@ -118,6 +146,11 @@ type Edit struct {
 }
 
 type Route struct {
+	// the request that is matched against the routes by `glooctl route test`
+	Method  string
+	Host    string
+	Path    string
+	Headers []string
 }
 
 type Consul struct {
@@ -157,8 +190,10 @@ type Add struct {
 }
 
 type Istio struct {
-	Upstream  string // upstream for which we are changing the istio mTLS settings
-	Namespace string // namespace in which istio is installed
+	Upstream         string   // upstream for which we are changing the istio mTLS settings
+	Namespace        string   // namespace in which istio is installed
+	Upstreams        []string // upstreams that use the istio mTLS certificates once istio is enabled
+	AllKubeUpstreams bool     // whether all the kubernetes upstreams use the istio mTLS certificates once istio is enabled
 }
 
 type InputRoute struct {
@@ -296,6 +331,7 @@ type InputAwsEc2Spec struct {
 	Region          string
 	Secret          core.ResourceRef
 	Role            string
+	ExternalId      string
 	PublicIp        bool
 	Port            uint32
 	KeyFilters      []string
@@ -15,10 +15,12 @@ import (
 	"k8s.io/kubernetes/pkg/kubectl/cmd"
 
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/add"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/apply"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/check"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/create"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/del"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/edit"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/export"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/get"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/install"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/remove"
@@ -90,6 +92,7 @@ func GlooCli() *cobra.Command {
 		pflags.BoolVarP(&opts.Top.Interactive, "interactive", "i", false, "use interactive mode")
 		pflags.StringVarP(&opts.Top.ConfigFilePath, "config", "c", DefaultConfigPath, "set the path to the glooctl config file")
 		flagutils.AddConsulConfigFlags(pflags, &opts.Top.Consul)
+		flagutils.AddConfigDirectoryFlag(pflags, &opts.Top.ConfigDirectory)
 
 		app.SuggestionsMinimumDistance = 1
 		app.AddCommand(
@@ -106,6 +109,10 @@ func GlooCli() *cobra.Command {
 			upgrade.RootCmd(opts),
 			gateway.RootCmd(opts),
 			check.RootCmd(opts),
+			apply.ApplyCmd(opts),
+			apply.DiffCmd(opts),
+			export.ExportCmd(opts),
+			export.ImportCmd(opts),
 			debug.RootCmd(opts),
 			versioncmd.RootCmd(opts),
 			dashboard.RootCmd(opts),
@@ -122,6 +129,7 @@ func GlooCli() *cobra.Command {
 		prerun.SetKubeConfigEnv,
 		prerun.ReportUsage,
 		prerun.VersionMismatchWarning,
+		prerun.EnableDirectoryClients,
 	}
 
 	return App(opts, preRunFuncs, optionsFunc)
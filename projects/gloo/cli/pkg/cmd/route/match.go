@@ -0,0 +1,310 @@
+package route
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	errors "github.com/rotisserie/eris"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+)
+
+var (
+	InvalidRegexErr = func(regex string, err error) error {
+		return errors.Wrapf(err, "invalid regex %q", regex)
+	}
+)
+
+var (
+	virtualServiceKind = resources.Kind(&gatewayv1.VirtualService{})
+	routeTableKind     = resources.Kind(&gatewayv1.RouteTable{})
+)
+
+// Request is a simulated http request
+type Request struct {
+	Method string
+	Host   string
+	// the path of the request, which may include a query string
+	Path    string
+	Headers http.Header
+}
+
+// ListenerMatch is the result of matching a request against an http listener of a proxy.
+// The virtual host and the route are nil if none of them match the request.
+type ListenerMatch struct {
+	Listener    string
+	VirtualHost *gloov1.VirtualHost
+	// the virtual service the virtual host was translated from
+	VirtualService string
+	Route          *RouteMatch
+}
+
+// RouteMatch is the route of a virtual host that a request is routed to
+type RouteMatch struct {
+	// the index of the route in the routes of the virtual host
+	Index int
+	Route *gloov1.Route
+	// the matcher of the route that matches the request, nil if the route has no matchers
+	Matcher *matchers.Matcher
+	// the route tables the route was delegated to, from the virtual service to the route table that defines the route
+	RouteTables []string
+}
+
+// MatchRequest matches the request against the http listeners of the proxy, the way Envoy does with the
+// configuration Gloo translates the proxy to: the virtual host is selected by the domains, then the request is
+// routed to the first route with a matching matcher.
+func MatchRequest(proxy *gloov1.Proxy, request Request) ([]*ListenerMatch, error) {
+	var results []*ListenerMatch
+	for _, listener := range proxy.GetListeners() {
+		httpListeners := []*gloov1.HttpListener{listener.GetHttpListener()}
+		for _, matched := range listener.GetHybridListener().GetMatchedListeners() {
+			httpListeners = append(httpListeners, matched.GetHttpListener())
+		}
+		for _, httpListener := range httpListeners {
+			if httpListener == nil {
+				continue
+			}
+			result, err := matchListener(listener.GetName(), httpListener, request)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+func matchListener(name string, listener *gloov1.HttpListener, request Request) (*ListenerMatch, error) {
+	result := &ListenerMatch{Listener: name}
+	virtualHost := selectVirtualHost(listener.GetVirtualHosts(), request.Host)
+	if virtualHost == nil {
+		return result, nil
+	}
+	result.VirtualHost = virtualHost
+	if err := translator.ForEachSource(virtualHost, func(source translator.SourceRef) error {
+		if source.ResourceKind == virtualServiceKind {
+			result.VirtualService = source.Key()
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	for i, route := range virtualHost.GetRoutes() {
+		routeMatchers := route.GetMatchers()
+		if len(routeMatchers) == 0 {
+			// the routes without matchers match all the paths
+			routeMatchers = []*matchers.Matcher{nil}
+		}
+		for _, matcher := range routeMatchers {
+			ok, err := matches(matcher, request)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			routeTables, err := delegatedRouteTables(route)
+			if err != nil {
+				return nil, err
+			}
+			result.Route = &RouteMatch{
+				Index:       i,
+				Route:       route,
+				Matcher:     matcher,
+				RouteTables: routeTables,
+			}
+			return result, nil
+		}
+	}
+	return result, nil
+}
+
+// delegatedRouteTables returns the route tables the route was delegated to. The route tables are appended to the
+// sources of the route from the innermost one, and the virtual service comes last.
+func delegatedRouteTables(route *gloov1.Route) ([]string, error) {
+	var routeTables []string
+	err := translator.ForEachSource(route, func(source translator.SourceRef) error {
+		if source.ResourceKind == routeTableKind {
+			routeTables = append([]string{source.Key()}, routeTables...)
+		}
+		return nil
+	})
+	return routeTables, err
+}
+
+// selectVirtualHost selects the virtual host of the host the way Envoy does: exact domains come first, then the
+// longest suffix wildcards (`*.example.com`), then the longest prefix wildcards (`example.*`), then `*`.
+func selectVirtualHost(virtualHosts []*gloov1.VirtualHost, host string) *gloov1.VirtualHost {
+	host = strings.ToLower(host)
+
+	var (
+		suffixMatches []domainMatch
+		prefixMatches []domainMatch
+		defaultMatch  *gloov1.VirtualHost
+	)
+	for _, virtualHost := range virtualHosts {
+		domains := virtualHost.GetDomains()
+		if len(domains) == 0 || (len(domains) == 1 && domains[0] == "") {
+			domains = []string{"*"}
+		}
+		for _, domain := range domains {
+			domain = strings.ToLower(domain)
+			switch {
+			case domain == "*":
+				if defaultMatch == nil {
+					defaultMatch = virtualHost
+				}
+			case domain == host:
+				return virtualHost
+			case strings.HasPrefix(domain, "*"):
+				// the wildcard must match at least one character
+				if len(host) > len(domain)-1 && strings.HasSuffix(host, domain[1:]) {
+					suffixMatches = append(suffixMatches, domainMatch{domain: domain, virtualHost: virtualHost})
+				}
+			case strings.HasSuffix(domain, "*"):
+				if len(host) > len(domain)-1 && strings.HasPrefix(host, domain[:len(domain)-1]) {
+					prefixMatches = append(prefixMatches, domainMatch{domain: domain, virtualHost: virtualHost})
+				}
+			}
+		}
+	}
+	if match := longestDomain(suffixMatches); match != nil {
+		return match
+	}
+	if match := longestDomain(prefixMatches); match != nil {
+		return match
+	}
+	return defaultMatch
+}
+
+type domainMatch struct {
+	domain      string
+	virtualHost *gloov1.VirtualHost
+}
+
+func longestDomain(domainMatches []domainMatch) *gloov1.VirtualHost {
+	if len(domainMatches) == 0 {
+		return nil
+	}
+	sort.SliceStable(domainMatches, func(i, j int) bool {
+		return len(domainMatches[i].domain) > len(domainMatches[j].domain)
+	})
+	return domainMatches[0].virtualHost
+}
+
+// matches returns whether the request matches the matcher. A nil matcher matches all the requests.
+func matches(matcher *matchers.Matcher, request Request) (bool, error) {
+	path, query := request.Path, ""
+	if i := strings.Index(path, "?"); i >= 0 {
+		path, query = path[:i], path[i+1:]
+	}
+
+	ok, err := pathMatches(matcher, path)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	if methods := matcher.GetMethods(); len(methods) > 0 {
+		ok, err := fullMatch(strings.Join(methods, "|"), request.Method)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	for _, headerMatcher := range matcher.GetHeaders() {
+		ok, err := headerMatches(headerMatcher, request.Headers)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	if len(matcher.GetQueryParameters()) > 0 {
+		params, err := url.ParseQuery(query)
+		if err != nil {
+			return false, errors.Wrapf(err, "parsing the query string of the path")
+		}
+		for _, queryMatcher := range matcher.GetQueryParameters() {
+			ok, err := queryParameterMatches(queryMatcher, params)
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+	}
+
+	return true, nil
+}
+
+func pathMatches(matcher *matchers.Matcher, path string) (bool, error) {
+	// envoy ignores case sensitivity for regex matchers
+	caseSensitive := matcher.GetCaseSensitive() == nil || matcher.GetCaseSensitive().GetValue()
+	equalFold := func(a, b string) bool {
+		if caseSensitive {
+			return a == b
+		}
+		return strings.EqualFold(a, b)
+	}
+
+	switch pathSpecifier := matcher.GetPathSpecifier().(type) {
+	case *matchers.Matcher_Exact:
+		return equalFold(path, pathSpecifier.Exact), nil
+	case *matchers.Matcher_Regex:
+		return fullMatch(pathSpecifier.Regex, path)
+	case *matchers.Matcher_Prefix:
+		return len(path) >= len(pathSpecifier.Prefix) && equalFold(path[:len(pathSpecifier.Prefix)], pathSpecifier.Prefix), nil
+	default:
+		// the routes without a path specifier match all the paths
+		return true, nil
+	}
+}
+
+func headerMatches(matcher *matchers.HeaderMatcher, headers http.Header) (bool, error) {
+	values, present := headers[http.CanonicalHeaderKey(matcher.GetName())]
+	if !present {
+		// a missing header only matches an inverted presence matcher
+		return matcher.GetValue() == "" && matcher.GetInvertMatch(), nil
+	}
+	ok := true
+	if matcher.GetValue() != "" {
+		value := values[0]
+		if matcher.GetRegex() {
+			var err error
+			ok, err = fullMatch(matcher.GetValue(), value)
+			if err != nil {
+				return false, err
+			}
+		} else {
+			ok = value == matcher.GetValue()
+		}
+	}
+	return ok != matcher.GetInvertMatch(), nil
+}
+
+func queryParameterMatches(matcher *matchers.QueryParameterMatcher, params url.Values) (bool, error) {
+	values, present := params[matcher.GetName()]
+	if !present {
+		return false, nil
+	}
+	if matcher.GetValue() == "" {
+		return true, nil
+	}
+	if matcher.GetRegex() {
+		return fullMatch(matcher.GetValue(), values[0])
+	}
+	return values[0] == matcher.GetValue(), nil
+}
+
+// fullMatch returns whether the regex matches the whole value, like the RE2 matchers of Envoy
+func fullMatch(regex, value string) (bool, error) {
+	re, err := regexp.Compile("^(?:" + regex + ")$")
+	if err != nil {
+		return false, InvalidRegexErr(regex, err)
+	}
+	return re.MatchString(value), nil
+}
@@ -0,0 +1,168 @@
+package route_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/route"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("MatchRequest", func() {
+
+	const ns = "gloo-system"
+
+	var proxy *gloov1.Proxy
+
+	upstreamAction := &gatewayv1.Route_RouteAction{
+		RouteAction: &gloov1.RouteAction{
+			Destination: &gloov1.RouteAction_Single{
+				Single: &gloov1.Destination{
+					DestinationType: &gloov1.Destination_Upstream{
+						Upstream: &core.ResourceRef{Name: "us", Namespace: ns},
+					},
+				},
+			},
+		},
+	}
+
+	prefix := func(path string) *matchers.Matcher {
+		return &matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: path}}
+	}
+
+	BeforeEach(func() {
+		timeout := time.Second
+		snap := &gatewayv1.ApiSnapshot{
+			Gateways: gatewayv1.GatewayList{defaults.DefaultGateway(ns)},
+			VirtualServices: gatewayv1.VirtualServiceList{
+				{
+					Metadata: core.Metadata{Name: "catchall", Namespace: ns},
+					VirtualHost: &gatewayv1.VirtualHost{
+						Domains: []string{"*"},
+						Routes:  []*gatewayv1.Route{{Action: upstreamAction}},
+					},
+				},
+				{
+					Metadata: core.Metadata{Name: "example", Namespace: ns},
+					VirtualHost: &gatewayv1.VirtualHost{
+						Domains: []string{"example.com", "*.example.com"},
+						Routes: []*gatewayv1.Route{
+							{
+								Matchers: []*matchers.Matcher{{
+									PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/api"},
+									CaseSensitive: &types.BoolValue{Value: false},
+								}},
+								Options: &gloov1.RouteOptions{PrefixRewrite: &types.StringValue{Value: "/"}},
+								Action: &gatewayv1.Route_DelegateAction{
+									DelegateAction: &gatewayv1.DelegateAction{
+										DelegationType: &gatewayv1.DelegateAction_Ref{
+											Ref: &core.ResourceRef{Name: "api", Namespace: "default"},
+										},
+									},
+								},
+							},
+							{
+								Name: "admin",
+								Matchers: []*matchers.Matcher{{
+									PathSpecifier: &matchers.Matcher_Exact{Exact: "/admin"},
+									Headers:       []*matchers.HeaderMatcher{{Name: "x-user", Value: "admin"}},
+								}},
+								Action: upstreamAction,
+							},
+							{
+								Matchers: []*matchers.Matcher{{
+									PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"},
+									Methods:       []string{"POST"},
+								}},
+								Action: upstreamAction,
+							},
+						},
+					},
+				},
+			},
+			RouteTables: gatewayv1.RouteTableList{{
+				Metadata: core.Metadata{Name: "api", Namespace: "default"},
+				Routes: []*gatewayv1.Route{
+					{
+						Matchers: []*matchers.Matcher{{
+							PathSpecifier:   &matchers.Matcher_Prefix{Prefix: "/api/v1"},
+							QueryParameters: []*matchers.QueryParameterMatcher{{Name: "version", Value: "[0-9]", Regex: true}},
+						}},
+						Action: upstreamAction,
+					},
+					{
+						Matchers: []*matchers.Matcher{{
+							PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/api"},
+							CaseSensitive: &types.BoolValue{Value: false},
+						}},
+						Options: &gloov1.RouteOptions{Timeout: &timeout},
+						Action:  upstreamAction,
+					},
+				},
+			}},
+		}
+		proxy, _ = translator.NewDefaultTranslator(translator.Opts{}).Translate(context.TODO(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+		Expect(proxy).NotTo(BeNil())
+	})
+
+	matchRequest := func(request route.Request) *route.ListenerMatch {
+		results, err := route.MatchRequest(proxy, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(HaveLen(1))
+		return results[0]
+	}
+
+	It("matches the routes of the route tables the virtual service delegates to", func() {
+		result := matchRequest(route.Request{Method: "GET", Host: "example.com", Path: "/api/v1/users?version=2"})
+		Expect(result.VirtualService).To(Equal(ns + ".example"))
+		Expect(result.Route).NotTo(BeNil())
+		Expect(result.Route.Index).To(Equal(0))
+		Expect(result.Route.RouteTables).To(Equal([]string{"default.api"}))
+		Expect(result.Route.Route.GetOptions().GetPrefixRewrite().GetValue()).To(Equal("/"))
+	})
+
+	It("merges the options of the delegating route into the options of the route", func() {
+		result := matchRequest(route.Request{Method: "GET", Host: "www.example.com", Path: "/API/v1/users?version=2"})
+		Expect(result.VirtualService).To(Equal(ns + ".example"))
+		Expect(result.Route.Index).To(Equal(1))
+		Expect(*result.Route.Route.GetOptions().GetTimeout()).To(Equal(time.Second))
+		Expect(result.Route.Route.GetOptions().GetPrefixRewrite().GetValue()).To(Equal("/"))
+	})
+
+	It("matches the headers and methods of the routes", func() {
+		result := matchRequest(route.Request{Method: "GET", Host: "example.com", Path: "/admin"})
+		Expect(result.VirtualHost).NotTo(BeNil())
+		Expect(result.Route).To(BeNil())
+
+		result = matchRequest(route.Request{Method: "GET", Host: "example.com", Path: "/admin", Headers: http.Header{"X-User": []string{"admin"}}})
+		Expect(result.Route.Index).To(Equal(2))
+		Expect(result.Route.Route.GetName()).To(ContainSubstring("admin"))
+
+		result = matchRequest(route.Request{Method: "POST", Host: "example.com", Path: "/admin"})
+		Expect(result.Route.Index).To(Equal(3))
+	})
+
+	It("selects the virtual hosts by domain", func() {
+		result := matchRequest(route.Request{Method: "GET", Host: "example.org", Path: "/api"})
+		Expect(result.VirtualService).To(Equal(ns + ".catchall"))
+		Expect(result.Route.Index).To(Equal(0))
+		Expect(result.Route.Matcher).To(Equal(prefix("/")))
+		Expect(result.Route.RouteTables).To(BeEmpty())
+	})
+
+	It("returns an error for invalid regexes", func() {
+		// the virtual hosts are sorted by the name of their virtual service
+		proxy.Listeners[0].GetHttpListener().VirtualHosts[1].Routes[0].Matchers[0].PathSpecifier = &matchers.Matcher_Regex{Regex: "("}
+		_, err := route.MatchRequest(proxy, route.Request{Host: "example.com", Path: "/"})
+		Expect(err).To(HaveOccurred())
+	})
+})
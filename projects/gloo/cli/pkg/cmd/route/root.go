@@ -27,6 +27,7 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 	flagutils.AddMetadataFlags(pflags, &opts.Metadata)
 
 	cmd.AddCommand(Sort(opts))
+	cmd.AddCommand(Test(opts))
 	cliutils.ApplyOptions(cmd, optionsFunc)
 	return cmd
 }
@@ -0,0 +1,237 @@
+package route
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/gogo/protobuf/proto"
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/go-utils/protoutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/spf13/cobra"
+)
+
+var (
+	InvalidHeaderErr = func(header string) error {
+		return errors.Errorf("invalid header %q: expected the `name: value` format", header)
+	}
+)
+
+func Test(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "test",
+		Aliases: []string{"t"},
+		Short:   "find the route a request would be routed to",
+		Long: "Test matches a request against the routes of a proxy, after the delegation to route tables and the " +
+			"inheritance of matchers and options are resolved, the way the proxy routes it. It prints the " +
+			"virtual service, route tables and route that match the request on each HTTP listener, and the " +
+			"options that apply to it." +
+			"\n\n" +
+			"Usage: `glooctl route test --host example.com --path /api/v1?version=2 [--method GET] " +
+			"[--header 'x-user: admin'] [--proxy gateway-proxy] [--namespace gloo-system]`",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return testRoute(opts, os.Stdout)
+		},
+	}
+	pflags := cmd.PersistentFlags()
+	pflags.StringVar(&opts.Route.Method, "method", http.MethodGet, "the method of the request")
+	pflags.StringVar(&opts.Route.Host, "host", "", "the host of the request")
+	pflags.StringVar(&opts.Route.Path, "path", "/", "the path of the request, including the query string")
+	pflags.StringArrayVarP(&opts.Route.Headers, "header", "H", nil, "a header of the request, in the 'name: value' format. Can be repeated")
+	pflags.StringVar(&opts.Proxy.Name, "proxy", defaults.GatewayProxyName, "the name of the proxy to route the request with")
+	flagutils.AddOutputFlag(pflags, &opts.Top.Output)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func testRoute(opts *options.Options, w io.Writer) error {
+	request, err := requestFromOptions(opts.Route)
+	if err != nil {
+		return err
+	}
+
+	namespace := opts.Metadata.GetNamespace()
+	proxy, err := helpers.MustNamespacedProxyClient(namespace).Read(namespace, opts.Proxy.Name, clients.ReadOpts{Ctx: opts.Top.Ctx})
+	if err != nil {
+		return errors.Wrapf(err, "reading proxy %v.%v", namespace, opts.Proxy.Name)
+	}
+
+	results, err := MatchRequest(proxy, request)
+	if err != nil {
+		return err
+	}
+	if len(results) == 0 {
+		return errors.Errorf("proxy %v.%v has no HTTP listeners", namespace, opts.Proxy.Name)
+	}
+	return printListenerMatches(results, opts.Top.Output, w)
+}
+
+func requestFromOptions(opts options.Route) (Request, error) {
+	request := Request{
+		Method:  strings.ToUpper(opts.Method),
+		Host:    opts.Host,
+		Path:    opts.Path,
+		Headers: http.Header{},
+	}
+	if !strings.HasPrefix(request.Path, "/") {
+		request.Path = "/" + request.Path
+	}
+	for _, header := range opts.Headers {
+		parts := strings.SplitN(header, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return Request{}, InvalidHeaderErr(header)
+		}
+		request.Headers.Add(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]))
+	}
+	return request, nil
+}
+
+func printListenerMatches(results []*ListenerMatch, outputType printers.OutputType, w io.Writer) error {
+	switch outputType {
+	case printers.JSON, printers.YAML:
+		var out []map[string]interface{}
+		for _, result := range results {
+			resultMap, err := listenerMatchMap(result)
+			if err != nil {
+				return err
+			}
+			out = append(out, resultMap)
+		}
+		var raw []byte
+		var err error
+		if outputType == printers.JSON {
+			raw, err = json.MarshalIndent(out, "", "  ")
+			raw = append(raw, '\n')
+		} else {
+			raw, err = yaml.Marshal(out)
+		}
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(raw)
+		return err
+	default:
+		for _, result := range results {
+			if err := printListenerMatch(result, w); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func printListenerMatch(result *ListenerMatch, w io.Writer) error {
+	fmt.Fprintf(w, "listener %v:\n", result.Listener)
+	if result.VirtualHost == nil {
+		fmt.Fprintf(w, "  no virtual host matches the host\n")
+		return nil
+	}
+	fmt.Fprintf(w, "  virtual service: %v\n", result.VirtualService)
+	if result.Route == nil {
+		fmt.Fprintf(w, "  no route matches the request\n")
+		return nil
+	}
+	fmt.Fprintf(w, "  route: %v\n", routeDisplayName(result.Route))
+	if len(result.Route.RouteTables) > 0 {
+		fmt.Fprintf(w, "  route tables: %v\n", strings.Join(result.Route.RouteTables, " -> "))
+	}
+	if result.Route.Matcher != nil {
+		matcher, err := indentedYaml(result.Route.Matcher)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "  matcher:\n%v", matcher)
+	}
+	if result.Route.Route.GetOptions() != nil {
+		routeOptions, err := indentedYaml(result.Route.Route.GetOptions())
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "  route options:\n%v", routeOptions)
+	}
+	if result.VirtualHost.GetOptions() != nil {
+		virtualHostOptions, err := indentedYaml(result.VirtualHost.GetOptions())
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "  virtual host options:\n%v", virtualHostOptions)
+	}
+	return nil
+}
+
+// routeDisplayName returns the position of the route in the virtual host, and its name if it is named
+func routeDisplayName(match *RouteMatch) string {
+	name := fmt.Sprintf("#%d", match.Index+1)
+	if match.Route.GetName() != "" {
+		name += " " + match.Route.GetName()
+	}
+	return name
+}
+
+func indentedYaml(pb proto.Message) (string, error) {
+	jsn, err := protoutils.MarshalBytes(pb)
+	if err != nil {
+		return "", err
+	}
+	raw, err := yaml.JSONToYAML(jsn)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for _, line := range strings.Split(strings.TrimSuffix(string(raw), "\n"), "\n") {
+		b.WriteString("    " + line + "\n")
+	}
+	return b.String(), nil
+}
+
+func listenerMatchMap(result *ListenerMatch) (map[string]interface{}, error) {
+	out := map[string]interface{}{
+		"listener": result.Listener,
+	}
+	if result.VirtualHost == nil {
+		return out, nil
+	}
+	out["virtualService"] = result.VirtualService
+	if result.VirtualHost.GetOptions() != nil {
+		virtualHostOptions, err := protoutils.MarshalMap(result.VirtualHost.GetOptions())
+		if err != nil {
+			return nil, err
+		}
+		out["virtualHostOptions"] = virtualHostOptions
+	}
+	if result.Route == nil {
+		return out, nil
+	}
+	// the sources are reported as the route tables
+	route := proto.Clone(result.Route.Route).(*gloov1.Route)
+	route.Metadata = nil
+	routeMap, err := protoutils.MarshalMap(route)
+	if err != nil {
+		return nil, err
+	}
+	out["routeIndex"] = result.Route.Index
+	out["route"] = routeMap
+	if len(result.Route.RouteTables) > 0 {
+		out["routeTables"] = result.Route.RouteTables
+	}
+	if result.Route.Matcher != nil {
+		matcher, err := protoutils.MarshalMap(result.Route.Matcher)
+		if err != nil {
+			return nil, err
+		}
+		out["matcher"] = matcher
+	}
+	return out, nil
+}
@@ -33,6 +33,7 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 		"to download. Specify a git tag corresponding to the desired version of glooctl.")
 	cmd.PersistentFlags().StringVar(&opts.Upgrade.DownloadPath, "path", "", "Desired path for your "+
 		"upgraded glooctl binary. Defaults to the location of your currently executing binary.")
+	cmd.AddCommand(PrepareCmd(opts))
 	cliutils.ApplyOptions(cmd, optionsFunc)
 	return cmd
 }
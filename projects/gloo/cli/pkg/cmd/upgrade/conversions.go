@@ -0,0 +1,154 @@
+package upgrade
+
+import (
+	"fmt"
+
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	extauth "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/transformation"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// convertResource replaces the deprecated fields of the resource with the fields Gloo translates the same way.
+// It returns a description of each change made; no description means the resource is unchanged.
+func convertResource(resource resources.InputResource) []string {
+	switch typed := resource.(type) {
+	case *v1.Settings:
+		return convertSettings(typed)
+	case *v1.UpstreamGroup:
+		return convertWeightedDestinations("destinations", typed.GetDestinations())
+	case *gatewayv1.VirtualService:
+		if typed.GetVirtualHost() == nil {
+			return nil
+		}
+		changes := convertTransformations("virtualHost.options", typed.GetVirtualHost().GetOptions())
+		return append(changes, convertRoutes("virtualHost.routes", typed.GetVirtualHost().GetRoutes())...)
+	case *gatewayv1.RouteTable:
+		return convertRoutes("routes", typed.GetRoutes())
+	case *extauth.AuthConfig:
+		return convertAuthConfig(typed)
+	}
+	return nil
+}
+
+func convertSettings(settings *v1.Settings) []string {
+	var changes []string
+	if consul := settings.GetConsul(); consul.GetAddress() != "" {
+		// the http address overrides the address when both are set
+		if consul.GetHttpAddress() == "" {
+			consul.HttpAddress = consul.GetAddress()
+			changes = append(changes, "consul.address: moved to consul.httpAddress")
+		} else {
+			changes = append(changes, "consul.address: removed, it is ignored when consul.httpAddress is set")
+		}
+		consul.Address = ""
+	}
+	if gateway := settings.GetGateway(); gateway.GetAlwaysSortRouteTableRoutes() {
+		gateway.AlwaysSortRouteTableRoutes = false
+		changes = append(changes, "gateway.alwaysSortRouteTableRoutes: removed, it is ignored")
+	}
+	return changes
+}
+
+func convertRoutes(path string, routes []*gatewayv1.Route) []string {
+	var changes []string
+	for i, route := range routes {
+		routePath := fmt.Sprintf("%v[%d]", path, i)
+		changes = append(changes, convertTransformations(routePath+".options", route.GetOptions())...)
+		if multi := route.GetRouteAction().GetMulti(); multi != nil {
+			changes = append(changes, convertWeightedDestinations(routePath+".routeAction.multi.destinations", multi.GetDestinations())...)
+		}
+		if delegate := route.GetDelegateAction(); delegate != nil && (delegate.GetName() != "" || delegate.GetNamespace() != "") {
+			// the name and namespace override the ref and the selector
+			delegate.DelegationType = &gatewayv1.DelegateAction_Ref{
+				Ref: &core.ResourceRef{Name: delegate.GetName(), Namespace: delegate.GetNamespace()},
+			}
+			delegate.Name = ""
+			delegate.Namespace = ""
+			changes = append(changes, fmt.Sprintf("%v.delegateAction: name and namespace moved to ref", routePath))
+		}
+	}
+	return changes
+}
+
+func convertWeightedDestinations(path string, destinations []*v1.WeightedDestination) []string {
+	var changes []string
+	for i, destination := range destinations {
+		changes = append(changes, convertTransformations(fmt.Sprintf("%v[%d].options", path, i), destination.GetOptions())...)
+	}
+	return changes
+}
+
+// the options which have both the deprecated transformations and the staged transformations
+type transformationOptions interface {
+	GetTransformations() *transformation.Transformations
+	GetStagedTransformations() *transformation.TransformationStages
+}
+
+// convertTransformations moves the deprecated transformations to the regular stage of the staged transformations,
+// as a request transformation without matcher, like the transformation plugin translates them
+func convertTransformations(path string, options transformationOptions) []string {
+	deprecated := options.GetTransformations()
+	if deprecated == nil {
+		return nil
+	}
+	var staged **transformation.TransformationStages
+	switch typed := options.(type) {
+	case *v1.VirtualHostOptions:
+		typed.Transformations = nil
+		staged = &typed.StagedTransformations
+	case *v1.RouteOptions:
+		typed.Transformations = nil
+		staged = &typed.StagedTransformations
+	case *v1.WeightedDestinationOptions:
+		typed.Transformations = nil
+		staged = &typed.StagedTransformations
+	default:
+		return nil
+	}
+
+	if (*staged).GetRegular() != nil {
+		return []string{fmt.Sprintf("%v.transformations: removed, it is ignored when %v.stagedTransformations.regular is set", path, path)}
+	}
+	if *staged == nil {
+		*staged = &transformation.TransformationStages{}
+	}
+	(*staged).Regular = &transformation.RequestResponseTransformations{
+		RequestTransforms: []*transformation.RequestMatch{{
+			RequestTransformation:  deprecated.GetRequestTransformation(),
+			ClearRouteCache:        deprecated.GetClearRouteCache(),
+			ResponseTransformation: deprecated.GetResponseTransformation(),
+		}},
+	}
+	return []string{fmt.Sprintf("%v.transformations: moved to %v.stagedTransformations.regular", path, path)}
+}
+
+// convertAuthConfig replaces the deprecated oauth configs with the equivalent oauth2 OIDC authorization code configs
+func convertAuthConfig(authConfig *extauth.AuthConfig) []string {
+	var changes []string
+	for i, config := range authConfig.GetConfigs() {
+		oauth := config.GetOauth()
+		if oauth == nil {
+			continue
+		}
+		config.AuthConfig = &extauth.AuthConfig_Config_Oauth2{
+			Oauth2: &extauth.OAuth2{
+				OauthType: &extauth.OAuth2_OidcAuthorizationCode{
+					OidcAuthorizationCode: &extauth.OidcAuthorizationCode{
+						ClientId:                oauth.GetClientId(),
+						ClientSecretRef:         oauth.GetClientSecretRef(),
+						IssuerUrl:               oauth.GetIssuerUrl(),
+						AuthEndpointQueryParams: oauth.GetAuthEndpointQueryParams(),
+						AppUrl:                  oauth.GetAppUrl(),
+						CallbackPath:            oauth.GetCallbackPath(),
+						Scopes:                  oauth.GetScopes(),
+					},
+				},
+			},
+		}
+		changes = append(changes, fmt.Sprintf("configs[%d].oauth: moved to configs[%d].oauth2.oidcAuthorizationCode", i, i))
+	}
+	return changes
+}
@@ -0,0 +1,85 @@
+package upgrade
+
+import (
+	"github.com/ghodss/yaml"
+	"github.com/rotisserie/eris"
+	"helm.sh/helm/v3/pkg/chart"
+	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	apiexts "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	kubeerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// crdUpgrade is the change of a CRD from the cluster to the one of the chart
+type crdUpgrade struct {
+	crd *apiextv1beta1.CustomResourceDefinition
+	// nil when the CRD does not exist yet
+	existing *apiextv1beta1.CustomResourceDefinition
+}
+
+// planCrdUpgrades reads the CRDs of the chart and the cluster, and returns the upgrades along with their findings.
+// A version stored in the cluster which the chart no longer serves is a breaking change: the resources stored in
+// that version could not be read anymore.
+func planCrdUpgrades(client apiexts.Interface, crdFiles []chart.CRD) ([]crdUpgrade, []Finding, error) {
+	var upgrades []crdUpgrade
+	var findings []Finding
+	for _, crdFile := range crdFiles {
+		crd := &apiextv1beta1.CustomResourceDefinition{}
+		if err := yaml.Unmarshal(crdFile.File.Data, crd); err != nil {
+			return nil, nil, eris.Wrapf(err, "parsing the CRD %v of the chart", crdFile.Name)
+		}
+		existing, err := client.ApiextensionsV1beta1().CustomResourceDefinitions().Get(crd.Name, metav1.GetOptions{})
+		switch {
+		case kubeerrors.IsNotFound(err):
+			upgrades = append(upgrades, crdUpgrade{crd: crd})
+			findings = append(findings, Finding{Resource: crdKey(crd), Message: "will be created"})
+			continue
+		case err != nil:
+			return nil, nil, eris.Wrapf(err, "reading the CRD %v", crd.Name)
+		}
+
+		upgrades = append(upgrades, crdUpgrade{crd: crd, existing: existing})
+		for _, storedVersion := range existing.Status.StoredVersions {
+			if !servesVersion(crd, storedVersion) {
+				findings = append(findings, Finding{
+					Resource: crdKey(crd),
+					Message:  "version " + storedVersion + " is stored in the cluster but no longer served",
+					Breaking: true,
+				})
+			}
+		}
+	}
+	return upgrades, findings, nil
+}
+
+func servesVersion(crd *apiextv1beta1.CustomResourceDefinition, version string) bool {
+	if len(crd.Spec.Versions) == 0 {
+		return crd.Spec.Version == version
+	}
+	for _, crdVersion := range crd.Spec.Versions {
+		if crdVersion.Name == version && crdVersion.Served {
+			return true
+		}
+	}
+	return false
+}
+
+// applyCrdUpgrade creates or replaces the CRD
+func applyCrdUpgrade(client apiexts.Interface, upgrade crdUpgrade) error {
+	crds := client.ApiextensionsV1beta1().CustomResourceDefinitions()
+	if upgrade.existing == nil {
+		if _, err := crds.Create(upgrade.crd); err != nil {
+			return eris.Wrapf(err, "creating the CRD %v", upgrade.crd.Name)
+		}
+		return nil
+	}
+	upgrade.crd.ResourceVersion = upgrade.existing.ResourceVersion
+	if _, err := crds.Update(upgrade.crd); err != nil {
+		return eris.Wrapf(err, "updating the CRD %v", upgrade.crd.Name)
+	}
+	return nil
+}
+
+func crdKey(crd *apiextv1beta1.CustomResourceDefinition) string {
+	return "crd " + crd.Name
+}
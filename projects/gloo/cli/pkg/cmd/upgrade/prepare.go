@@ -0,0 +1,163 @@
+package upgrade
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/rotisserie/eris"
+	linkedversion "github.com/solo-io/gloo/pkg/version"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/apply"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/install"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/spf13/cobra"
+	"helm.sh/helm/v3/pkg/chart"
+)
+
+var (
+	BreakingChangesErr = eris.New("breaking changes found: resolve them before upgrading Gloo. Nothing was written")
+
+	UnreleasedWithoutVersionErr = eris.New("you must provide the version to upgrade to with --version, or a Gloo " +
+		"Helm chart with -f, when running an unreleased version of glooctl")
+)
+
+// Finding is a change, or a problem, found while preparing the upgrade
+type Finding struct {
+	Resource string
+	Message  string
+	// the upgrade cannot go on while a breaking finding is unresolved
+	Breaking bool
+}
+
+func (f Finding) String() string {
+	if f.Breaking {
+		return fmt.Sprintf("[breaking] %v: %v", f.Resource, f.Message)
+	}
+	return fmt.Sprintf("%v: %v", f.Resource, f.Message)
+}
+
+// a resource whose deprecated fields are converted, to be written back with its client
+type conversion struct {
+	resource resources.InputResource
+	client   clients.ResourceClient
+	kind     apply.ResourceKind
+}
+
+func PrepareCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   constants.UPGRADE_PREPARE_COMMAND.Use,
+		Short: constants.UPGRADE_PREPARE_COMMAND.Short,
+		Long:  constants.UPGRADE_PREPARE_COMMAND.Long,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chartUri, err := prepareChartUri(opts.Upgrade.Prepare)
+			if err != nil {
+				return err
+			}
+			helmChart, err := install.DefaultHelmClient().DownloadChart(chartUri)
+			if err != nil {
+				return eris.Wrapf(err, "downloading the Helm chart %v", chartUri)
+			}
+			return Prepare(opts, helmChart, os.Stdout)
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVarP(&opts.Upgrade.Prepare.HelmChartOverride, "file", "f", "", "prepare the upgrade to this Helm chart archive file rather than to a release")
+	flags.StringVar(&opts.Upgrade.Prepare.Version, "version", "", "version of Gloo to prepare the upgrade to (e.g. 1.6.0, defaults to the version of glooctl)")
+	flags.BoolVar(&opts.Upgrade.Prepare.DryRun, "dry-run", false, "report the findings without writing the CRDs and the converted resources")
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func prepareChartUri(prepare options.UpgradePrepare) (string, error) {
+	if prepare.HelmChartOverride != "" {
+		if prepare.Version != "" {
+			return "", install.ChartAndReleaseFlagErr(prepare.HelmChartOverride, prepare.Version)
+		}
+		return prepare.HelmChartOverride, nil
+	}
+	version := prepare.Version
+	if version == "" {
+		if !linkedversion.IsReleaseVersion() {
+			return "", UnreleasedWithoutVersionErr
+		}
+		version = linkedversion.Version
+	}
+	return fmt.Sprintf(constants.GlooHelmRepoTemplate, version), nil
+}
+
+// Prepare reports the findings of the upgrade to the chart, then, unless a finding is breaking or this is a
+// dry run, upgrades the CRDs and writes the converted resources back.
+// Nothing is written before all the findings are known.
+func Prepare(opts *options.Options, helmChart *chart.Chart, w io.Writer) error {
+	apiExtsClient := helpers.MustApiExtsClient()
+	crdUpgrades, findings, err := planCrdUpgrades(apiExtsClient, helmChart.CRDObjects())
+	if err != nil {
+		return err
+	}
+	conversions, conversionFindings, err := planConversions(opts)
+	if err != nil {
+		return err
+	}
+	findings = append(findings, conversionFindings...)
+
+	breaking := false
+	for _, finding := range findings {
+		fmt.Fprintln(w, finding.String())
+		breaking = breaking || finding.Breaking
+	}
+	if breaking {
+		return BreakingChangesErr
+	}
+	if opts.Upgrade.Prepare.DryRun {
+		fmt.Fprintln(w, "dry run: nothing was written")
+		return nil
+	}
+
+	for _, upgrade := range crdUpgrades {
+		if err := applyCrdUpgrade(apiExtsClient, upgrade); err != nil {
+			return err
+		}
+	}
+	for _, c := range conversions {
+		if _, err := c.client.Write(c.resource, clients.WriteOpts{Ctx: opts.Top.Ctx, OverwriteExisting: true}); err != nil {
+			return eris.Wrapf(err, "writing %v %v", c.kind.Crd.Plural, c.resource.GetMetadata().Ref().Key())
+		}
+	}
+	fmt.Fprintf(w, "upgraded %d CRDs and converted %d resources: Gloo can now be upgraded to version %v\n",
+		len(crdUpgrades), len(conversions), helmChart.Metadata.Version)
+	return nil
+}
+
+// planConversions converts the resources of all the namespaces, without writing them
+func planConversions(opts *options.Options) ([]conversion, []Finding, error) {
+	var conversions []conversion
+	var findings []Finding
+	for _, kind := range apply.ResourceKinds {
+		for _, ns := range helpers.MustGetNamespaces() {
+			client := kind.Client(ns)
+			list, err := client.List(ns, clients.ListOpts{Ctx: opts.Top.Ctx})
+			if err != nil {
+				return nil, nil, eris.Wrapf(err, "listing %v in %v", kind.Crd.Plural, ns)
+			}
+			for _, resource := range list {
+				converted := resources.Clone(resource).(resources.InputResource)
+				changes := convertResource(converted)
+				if len(changes) == 0 {
+					continue
+				}
+				conversions = append(conversions, conversion{resource: converted, client: client, kind: kind})
+				findings = append(findings, Finding{
+					Resource: fmt.Sprintf("%v %v", kind.Crd.Plural, resource.GetMetadata().Ref().Key()),
+					Message:  "will be converted: " + strings.Join(changes, "; "),
+				})
+			}
+		}
+	}
+	return conversions, findings, nil
+}
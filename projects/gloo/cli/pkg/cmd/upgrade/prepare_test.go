@@ -0,0 +1,204 @@
+package upgrade_test
+
+import (
+	"bytes"
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/upgrade"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	envoytransformation "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	extauth "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/transformation"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"helm.sh/helm/v3/pkg/chart"
+	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const upstreamCrd = `apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: upstreams.gloo.solo.io
+spec:
+  group: gloo.solo.io
+  names:
+    kind: Upstream
+    plural: upstreams
+  scope: Namespaced
+  version: v1
+  versions:
+  - name: v1
+    served: true
+    storage: true
+`
+
+var _ = Describe("Prepare", func() {
+
+	var (
+		opts         *options.Options
+		helmChart    *chart.Chart
+		requestXform *envoytransformation.Transformation
+	)
+
+	BeforeEach(func() {
+		helpers.UseMemoryClients()
+		opts = &options.Options{Top: options.Top{Ctx: context.Background()}}
+		helmChart = &chart.Chart{
+			Metadata: &chart.Metadata{Name: "gloo", Version: "1.6.0"},
+			Files:    []*chart.File{{Name: "crds/upstream.yaml", Data: []byte(upstreamCrd)}},
+		}
+		requestXform = &envoytransformation.Transformation{
+			TransformationType: &envoytransformation.Transformation_HeaderBodyTransform{
+				HeaderBodyTransform: &envoytransformation.HeaderBodyTransform{},
+			},
+		}
+
+		_, err := helpers.MustVirtualServiceClient().Write(&gatewayv1.VirtualService{
+			Metadata: core.Metadata{Name: "petstore", Namespace: "gloo-system"},
+			VirtualHost: &gatewayv1.VirtualHost{
+				Domains: []string{"petstore.example.com"},
+				Routes: []*gatewayv1.Route{
+					{
+						Options: &gloov1.RouteOptions{
+							Transformations: &transformation.Transformations{RequestTransformation: requestXform, ClearRouteCache: true},
+						},
+					},
+					{
+						Action: &gatewayv1.Route_DelegateAction{
+							DelegateAction: &gatewayv1.DelegateAction{Name: "pets", Namespace: "default"},
+						},
+					},
+				},
+			},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		helpers.UseDefaultClients()
+	})
+
+	readVirtualService := func() *gatewayv1.VirtualService {
+		vs, err := helpers.MustVirtualServiceClient().Read("gloo-system", "petstore", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		return vs
+	}
+
+	It("creates the CRDs and converts the deprecated fields", func() {
+		var out bytes.Buffer
+		err := upgrade.Prepare(opts, helmChart, &out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring("crd upstreams.gloo.solo.io: will be created"))
+		Expect(out.String()).To(ContainSubstring("virtualservices gloo-system.petstore: will be converted: " +
+			"virtualHost.routes[0].options.transformations: moved to virtualHost.routes[0].options.stagedTransformations.regular; " +
+			"virtualHost.routes[1].delegateAction: name and namespace moved to ref"))
+
+		_, err = helpers.MustApiExtsClient().ApiextensionsV1beta1().CustomResourceDefinitions().Get("upstreams.gloo.solo.io", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		routes := readVirtualService().GetVirtualHost().GetRoutes()
+		Expect(routes[0].GetOptions().GetTransformations()).To(BeNil())
+		Expect(routes[0].GetOptions().GetStagedTransformations().GetRegular().GetRequestTransforms()).To(Equal([]*transformation.RequestMatch{{
+			RequestTransformation: requestXform,
+			ClearRouteCache:       true,
+		}}))
+		delegate := routes[1].GetDelegateAction()
+		Expect(delegate.GetName()).To(BeEmpty())
+		Expect(delegate.GetRef()).To(Equal(&core.ResourceRef{Name: "pets", Namespace: "default"}))
+	})
+
+	It("drops the deprecated transformations when the staged transformations are set", func() {
+		vs := readVirtualService()
+		staged := &transformation.TransformationStages{Regular: &transformation.RequestResponseTransformations{}}
+		vs.VirtualHost.Options = &gloov1.VirtualHostOptions{
+			Transformations:       &transformation.Transformations{RequestTransformation: requestXform},
+			StagedTransformations: staged,
+		}
+		_, err := helpers.MustVirtualServiceClient().Write(vs, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		var out bytes.Buffer
+		err = upgrade.Prepare(opts, helmChart, &out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring("virtualHost.options.transformations: removed, it is ignored when " +
+			"virtualHost.options.stagedTransformations.regular is set"))
+		options := readVirtualService().GetVirtualHost().GetOptions()
+		Expect(options.GetTransformations()).To(BeNil())
+		Expect(options.GetStagedTransformations()).To(Equal(staged))
+	})
+
+	It("converts the deprecated settings and auth configs", func() {
+		_, err := helpers.MustSettingsClient().Write(&gloov1.Settings{
+			Metadata: core.Metadata{Name: "default", Namespace: "gloo-system"},
+			Consul:   &gloov1.Settings_ConsulConfiguration{Address: "127.0.0.1:8500"},
+			Gateway:  &gloov1.GatewayOptions{AlwaysSortRouteTableRoutes: true},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = helpers.MustAuthConfigClient().Write(&extauth.AuthConfig{
+			Metadata: core.Metadata{Name: "oidc", Namespace: "gloo-system"},
+			Configs: []*extauth.AuthConfig_Config{{
+				AuthConfig: &extauth.AuthConfig_Config_Oauth{Oauth: &extauth.OAuth{
+					ClientId:  "gloo",
+					IssuerUrl: "https://issuer.example.com",
+					AppUrl:    "https://app.example.com",
+				}},
+			}},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		var out bytes.Buffer
+		err = upgrade.Prepare(opts, helmChart, &out)
+		Expect(err).NotTo(HaveOccurred())
+
+		settings, err := helpers.MustSettingsClient().Read("gloo-system", "default", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(settings.GetConsul()).To(Equal(&gloov1.Settings_ConsulConfiguration{HttpAddress: "127.0.0.1:8500"}))
+		Expect(settings.GetGateway().GetAlwaysSortRouteTableRoutes()).To(BeFalse())
+
+		authConfig, err := helpers.MustAuthConfigClient().Read("gloo-system", "oidc", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(authConfig.GetConfigs()[0].GetOauth2().GetOidcAuthorizationCode()).To(Equal(&extauth.OidcAuthorizationCode{
+			ClientId:  "gloo",
+			IssuerUrl: "https://issuer.example.com",
+			AppUrl:    "https://app.example.com",
+		}))
+	})
+
+	It("writes nothing when a stored version is no longer served", func() {
+		_, err := helpers.MustApiExtsClient().ApiextensionsV1beta1().CustomResourceDefinitions().Create(&apiextv1beta1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "upstreams.gloo.solo.io"},
+			Spec:       apiextv1beta1.CustomResourceDefinitionSpec{Group: "gloo.solo.io", Version: "v1alpha1"},
+			Status:     apiextv1beta1.CustomResourceDefinitionStatus{StoredVersions: []string{"v1alpha1", "v1"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		var out bytes.Buffer
+		err = upgrade.Prepare(opts, helmChart, &out)
+		Expect(err).To(MatchError(upgrade.BreakingChangesErr))
+		Expect(out.String()).To(ContainSubstring("[breaking] crd upstreams.gloo.solo.io: version v1alpha1 is stored in the cluster but no longer served"))
+		Expect(out.String()).NotTo(ContainSubstring("[breaking] crd upstreams.gloo.solo.io: version v1 "))
+
+		crd, err := helpers.MustApiExtsClient().ApiextensionsV1beta1().CustomResourceDefinitions().Get("upstreams.gloo.solo.io", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(crd.Spec.Version).To(Equal("v1alpha1"))
+		Expect(readVirtualService().GetVirtualHost().GetRoutes()[0].GetOptions().GetTransformations()).NotTo(BeNil())
+	})
+
+	It("writes nothing on a dry run", func() {
+		opts.Upgrade.Prepare.DryRun = true
+		var out bytes.Buffer
+		err := upgrade.Prepare(opts, helmChart, &out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring("dry run: nothing was written"))
+
+		_, err = helpers.MustApiExtsClient().ApiextensionsV1beta1().CustomResourceDefinitions().Get("upstreams.gloo.solo.io", metav1.GetOptions{})
+		Expect(err).To(HaveOccurred())
+		Expect(readVirtualService().GetVirtualHost().GetRoutes()[0].GetOptions().GetTransformations()).NotTo(BeNil())
+	})
+})
@@ -56,9 +56,21 @@ var (
 		Short:   "Adds configuration to a top-level Gloo resource",
 	}
 
+	APPLY_COMMAND = cobra.Command{
+		Use:   "apply",
+		Short: "Apply Kubernetes manifests of Gloo resources to a Consul or directory config backend",
+		Long: "Write the Gloo resources of the Kubernetes manifests of a file (including stdin) to the config backend " +
+			"selected with --use-consul or --config-directory, after printing the changes to the existing resources. " +
+			"On Kubernetes, use kubectl apply instead.",
+	}
+
 	CHECK_COMMAND = cobra.Command{
 		Use:   "check",
 		Short: "Checks Gloo resources for errors (requires Gloo running on Kubernetes)",
+		Long: "Checks Gloo resources for errors (requires Gloo running on Kubernetes). With the json or yaml output, " +
+			"the problems are printed as findings with their severity and failure class. The exit code is 0 when no " +
+			"problem is found, 1 when the check could not run, 2 for installation problems, 3 for configuration " +
+			"problems, 4 for data plane problems, and 5 when only warnings are found.",
 	}
 
 	CREATE_COMMAND = cobra.Command{
@@ -84,12 +96,26 @@ var (
 		Short: "Dump YAML representing the current Gloo state (requires Gloo running on Kubernetes)",
 	}
 
+	DEBUG_PROXY_COMMAND = cobra.Command{
+		Use:   "proxy",
+		Short: "Collect the state of a proxy into a tarball (requires Gloo running on Kubernetes)",
+		Long: "Collect the Proxy resource, the xDS config served by Gloo, the Envoy config dump, the recent logs of " +
+			"Gloo and the statuses of the Gloo resources into a tarball, to attach to a bug report or support case.",
+	}
+
 	DELETE_COMMAND = cobra.Command{
 		Use:     "delete",
 		Aliases: []string{"d"},
 		Short:   "Delete a Gloo resource",
 	}
 
+	DIFF_COMMAND = cobra.Command{
+		Use:   "diff",
+		Short: "Show the changes that applying Kubernetes manifests of Gloo resources would make",
+		Long: "Print the changes that glooctl apply would make to the resources of the config backend selected with " +
+			"--use-consul or --config-directory, field by field, without writing them.",
+	}
+
 	DEMO_COMMAND = cobra.Command{
 		Use:   "demo",
 		Short: "Demos (requires 4 tools to be installed and accessible via the PATH: glooctl, kubectl, docker, and kind.)",
@@ -104,12 +130,27 @@ var (
 			"Please note that cluster registration will only work on darwin and linux OS.",
 	}
 
+	EXPORT_COMMAND = cobra.Command{
+		Use:   "export",
+		Short: "Export the Gloo resources into a versioned archive",
+		Long: "Write the Gloo resources of all the namespaces, and optionally the secrets with their values redacted, " +
+			"into a gzipped tar archive, for backups, migrations between clusters or bug reports. " +
+			"The archive can be restored with glooctl import.",
+	}
+
 	GET_COMMAND = cobra.Command{
 		Use:     "get",
 		Aliases: []string{"g"},
 		Short:   "Display one or a list of Gloo resources",
 	}
 
+	IMPORT_COMMAND = cobra.Command{
+		Use:   "import",
+		Short: "Import the Gloo resources of an archive written by glooctl export",
+		Long: "Write the resources of an archive written by glooctl export, after printing the changes to the " +
+			"existing resources like glooctl apply. The redacted secrets of the archive are not imported.",
+	}
+
 	INSTALL_COMMAND = cobra.Command{
 		Use:   "install",
 		Short: "install gloo on different platforms",
@@ -132,6 +173,14 @@ var (
 		Short:   "upgrade glooctl binary",
 	}
 
+	UPGRADE_PREPARE_COMMAND = cobra.Command{
+		Use:   "prepare",
+		Short: "Prepare the cluster for an upgrade of Gloo",
+		Long: "Upgrade the Gloo CRDs to the ones of the target Helm chart, and convert the deprecated fields of the " +
+			"Gloo resources to their replacements. The breaking changes found are reported before anything is " +
+			"written: run this command before upgrading the Gloo Helm release.",
+	}
+
 	EDIT_COMMAND = cobra.Command{
 		Use:     "edit",
 		Aliases: []string{"ed"},
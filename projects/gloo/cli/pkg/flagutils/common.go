@@ -59,6 +59,11 @@ func AddConsulConfigFlags(set *pflag.FlagSet, consul *options.Consul) {
 	}
 }
 
+func AddConfigDirectoryFlag(set *pflag.FlagSet, directory *string) {
+	set.StringVar(directory, "config-directory", "", "use the directory of the directory config source of Gloo as the "+
+		"backend for reading and writing config (VirtualServices, Upstreams, and Proxies)")
+}
+
 func AddVaultSecretFlags(set *pflag.FlagSet, vault *options.Vault) {
 	config := vaultapi.DefaultConfig()
 	tlsCfg := &vaultapi.TLSConfig{}
@@ -34,6 +34,8 @@ func AddCreateUpstreamFlags(set *pflag.FlagSet, upstreamType string, upstream *o
 				"for help creating secrets")
 		set.StringVar(&upstream.AwsEc2.Role, "aws-role-arn", "",
 			"Amazon Resource Number (ARN) of role that Gloo should assume on behalf of the upstream")
+		set.StringVar(&upstream.AwsEc2.ExternalId, "aws-role-external-id", "",
+			"external ID to provide when assuming the role given by --aws-role-arn")
 		set.BoolVar(&upstream.AwsEc2.PublicIp, "public-ip", false,
 			"use instance's public IP address")
 		set.Uint32Var(&upstream.AwsEc2.Port, "ec2-port", ec2.DefaultPort,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/solo-io/gloo/pkg/listers"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
 	apiexts "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	fakeapiexts "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
 	"k8s.io/client-go/kubernetes/fake"
 
 	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
@@ -26,6 +28,7 @@ import (
 	"github.com/solo-io/go-utils/log"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/crd"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
 	"github.com/solo-io/solo-kit/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -34,18 +37,20 @@ import (
 )
 
 var (
-	fakeKubeClientset *fake.Clientset
-	memResourceClient *factory.MemoryResourceClientFactory
-	consulClient      *factory.ConsulResourceClientFactory
-	vaultClient       *factory.VaultSecretClientFactory
+	fakeKubeClientset    *fake.Clientset
+	fakeApiExtsClientset *fakeapiexts.Clientset
+	memResourceClient    *factory.MemoryResourceClientFactory
+	consulClient         *factory.ConsulResourceClientFactory
+	configDirectory      string
+	vaultClient          *factory.VaultSecretClientFactory
 
 	lock sync.Mutex
 )
 
 // iterates over all the factory overrides, returning the first non-nil
-// mem > consul
+// mem > consul > directory
 // if none set, return nil (callers will default to Kube CRD)
-func getConfigClientFactory() factory.ResourceClientFactory {
+func getConfigClientFactory(resourceCrd crd.Crd) factory.ResourceClientFactory {
 	lock.Lock()
 	defer lock.Unlock()
 	if memResourceClient != nil {
@@ -54,9 +59,21 @@ func getConfigClientFactory() factory.ResourceClientFactory {
 	if consulClient != nil {
 		return consulClient
 	}
+	if configDirectory != "" {
+		// the same layout as the directory config source of the settings
+		return &factory.FileResourceClientFactory{
+			RootDir: filepath.Join(configDirectory, resourceCrd.Plural),
+		}
+	}
 	return nil
 }
 
+func usesCustomConfigClients() bool {
+	lock.Lock()
+	defer lock.Unlock()
+	return memResourceClient != nil || consulClient != nil || configDirectory != ""
+}
+
 // iterates over all the factory overrides, returning the first non-nil
 // mem > vault
 // if none set, return nil (callers will default to Kube Secret)
@@ -77,8 +94,10 @@ func UseDefaultClients() {
 	lock.Lock()
 	defer lock.Unlock()
 	fakeKubeClientset = nil
+	fakeApiExtsClientset = nil
 	memResourceClient = nil
 	consulClient = nil
+	configDirectory = ""
 	vaultClient = nil
 }
 
@@ -89,6 +108,7 @@ func UseMemoryClients() {
 		Cache: memory.NewInMemoryResourceCache(),
 	}
 	fakeKubeClientset = fake.NewSimpleClientset()
+	fakeApiExtsClientset = fakeapiexts.NewSimpleClientset()
 }
 
 // only applies to Config and Artifact clients
@@ -101,6 +121,13 @@ func UseConsulClients(client *api.Client, rootKey string) {
 	}
 }
 
+// only applies to Config clients
+func UseDirectoryClients(directory string) {
+	lock.Lock()
+	defer lock.Unlock()
+	configDirectory = directory
+}
+
 // only applies to secret clients
 func UseVaultClients(client *vaultapi.Client, rootKey string) {
 	lock.Lock()
@@ -140,8 +167,7 @@ func MustGetNamespaces() []string {
 
 // Note: requires RBAC permission to list namespaces at the cluster level
 func GetNamespaces() ([]string, error) {
-	customFactory := getConfigClientFactory()
-	if customFactory != nil {
+	if usesCustomConfigClients() {
 		return []string{"default", defaults.GlooSystem}, nil
 	}
 
@@ -206,7 +232,7 @@ func MustMultiNamespacedUpstreamClient(namespaces []string) v1.UpstreamClient {
 
 // provide "" (metav1.NamespaceAll) to get a cluster-scoped upstream client
 func UpstreamClient(namespaces []string) (v1.UpstreamClient, error) {
-	customFactory := getConfigClientFactory()
+	customFactory := getConfigClientFactory(v1.UpstreamCrd)
 	if customFactory != nil {
 		return v1.NewUpstreamClient(customFactory)
 	}
@@ -250,7 +276,7 @@ func MustMultiNamespacedUpstreamGroupClient(namespaces []string) v1.UpstreamGrou
 
 // provide "" (metav1.NamespaceAll) to get a cluster-scoped upstream group client
 func UpstreamGroupClient(namespaces []string) (v1.UpstreamGroupClient, error) {
-	customFactory := getConfigClientFactory()
+	customFactory := getConfigClientFactory(v1.UpstreamGroupCrd)
 	if customFactory != nil {
 		return v1.NewUpstreamGroupClient(customFactory)
 	}
@@ -294,7 +320,7 @@ func MustMultiNamespacedProxyClient(namespaces []string) v1.ProxyClient {
 
 // provide "" (metav1.NamespaceAll) to get a cluster-scoped proxy client
 func ProxyClient(namespaces []string) (v1.ProxyClient, error) {
-	customFactory := getConfigClientFactory()
+	customFactory := getConfigClientFactory(v1.ProxyCrd)
 	if customFactory != nil {
 		return v1.NewProxyClient(customFactory)
 	}
@@ -338,7 +364,7 @@ func MustMultiNamespacedGatewayClient(namespaces []string) gatewayv1.GatewayClie
 
 // provide "" (metav1.NamespaceAll) to get a cluster-scoped gateway client
 func GatewayClient(namespaces []string) (gatewayv1.GatewayClient, error) {
-	customFactory := getConfigClientFactory()
+	customFactory := getConfigClientFactory(gatewayv1.GatewayCrd)
 	if customFactory != nil {
 		return gatewayv1.NewGatewayClient(customFactory)
 	}
@@ -382,7 +408,7 @@ func MustMultiNamespacedVirtualServiceClient(namespaces []string) gatewayv1.Virt
 
 // provide "" (metav1.NamespaceAll) to get a cluster-scoped virtual service client
 func VirtualServiceClient(namespaces []string) (gatewayv1.VirtualServiceClient, error) {
-	customFactory := getConfigClientFactory()
+	customFactory := getConfigClientFactory(gatewayv1.VirtualServiceCrd)
 	if customFactory != nil {
 		return gatewayv1.NewVirtualServiceClient(customFactory)
 	}
@@ -426,7 +452,7 @@ func MustMultiNamespacedRouteTableClient(namespaces []string) gatewayv1.RouteTab
 
 // provide "" (metav1.NamespaceAll) to get a cluster-scoped route table client
 func RouteTableClient(namespaces []string) (gatewayv1.RouteTableClient, error) {
-	customFactory := getConfigClientFactory()
+	customFactory := getConfigClientFactory(gatewayv1.RouteTableCrd)
 	if customFactory != nil {
 		return gatewayv1.NewRouteTableClient(customFactory)
 	}
@@ -470,7 +496,7 @@ func MustMultiNamespacedSettingsClient(namespaces []string) v1.SettingsClient {
 
 // provide "" (metav1.NamespaceAll) to get a cluster-scoped settings client
 func SettingsClient(namespaces []string) (v1.SettingsClient, error) {
-	customFactory := getConfigClientFactory()
+	customFactory := getConfigClientFactory(v1.SettingsCrd)
 	if customFactory != nil {
 		return v1.NewSettingsClient(customFactory)
 	}
@@ -571,6 +597,9 @@ func MustApiExtsClient() apiexts.Interface {
 }
 
 func ApiExtsClient() (apiexts.Interface, error) {
+	if fakeApiExtsClientset != nil {
+		return fakeApiExtsClientset, nil
+	}
 	cfg, err := kubeutils.GetConfig("", os.Getenv("KUBECONFIG"))
 	if err != nil {
 		return nil, errors.Wrapf(err, "getting kube config")
@@ -596,7 +625,7 @@ func MustMultiNamespacedAuthConfigClient(namespaces []string) extauth.AuthConfig
 
 // provide "" (metav1.NamespaceAll) to get a cluster-scoped authConfig client
 func AuthConfigClient(namespaces []string) (extauth.AuthConfigClient, error) {
-	customFactory := getConfigClientFactory()
+	customFactory := getConfigClientFactory(extauth.AuthConfigCrd)
 	if customFactory != nil {
 		return extauth.NewAuthConfigClient(customFactory)
 	}
@@ -636,7 +665,7 @@ func MustMultiNamespacedRateLimitConfigClient(namespaces []string) v1alpha1.Rate
 
 // provide "" (metav1.NamespaceAll) to get a cluster-scoped client
 func RateLimitConfigClient(namespaces []string) (v1alpha1.RateLimitConfigClient, error) {
-	customFactory := getConfigClientFactory()
+	customFactory := getConfigClientFactory(v1alpha1.RateLimitConfigCrd)
 	if customFactory != nil {
 		return v1alpha1.NewRateLimitConfigClient(customFactory)
 	}
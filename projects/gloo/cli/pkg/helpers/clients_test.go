@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/consul"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/file"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/vault"
 )
@@ -59,6 +60,29 @@ var _ = Describe("Clients", func() {
 			}
 		})
 	})
+	Describe("UseDirectoryClients", func() {
+		BeforeEach(func() {
+			UseDirectoryClients("/etc/gloo")
+		})
+		AfterEach(func() {
+			UseDefaultClients()
+		})
+		It("returns file-based config clients", func() {
+
+			type BaseClientGetter interface {
+				BaseClient() clients.ResourceClient
+			}
+			for _, client := range []BaseClientGetter{
+				MustProxyClient(),
+				MustSettingsClient(),
+				MustUpstreamClient(),
+				MustUpstreamGroupClient(),
+				MustVirtualServiceClient(),
+			} {
+				Expect(client.BaseClient()).To(BeAssignableToTypeOf(&file.ResourceClient{}))
+			}
+		})
+	})
 	Describe("UseVaultClients", func() {
 		BeforeEach(func() {
 			UseVaultClients(&api2.Client{}, "")
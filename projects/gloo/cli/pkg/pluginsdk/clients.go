@@ -0,0 +1,89 @@
+package pluginsdk
+
+import (
+	"context"
+	"os"
+
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	extauth "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+	"github.com/solo-io/go-utils/kubeutils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// Clients gives the commands of a plugin access to the cluster and to the Gloo resources, with the kubeconfig
+// and the config backend selected by the flags of the root command.
+// The clients of the Gloo resources are scoped to the given namespaces, or to all of them when none is given.
+type Clients struct {
+	opts *options.Options
+}
+
+// Ctx is the context of the command
+func (c *Clients) Ctx() context.Context {
+	return c.opts.Top.Ctx
+}
+
+// KubeConfig returns the rest config of the --kubeconfig flag, of the KUBECONFIG environment variable
+// or of the default kubeconfig, in that order
+func (c *Clients) KubeConfig() (*rest.Config, error) {
+	// the root command sets the KUBECONFIG environment variable to the value of the flag
+	return kubeutils.GetConfig("", os.Getenv("KUBECONFIG"))
+}
+
+func (c *Clients) KubeClient() (kubernetes.Interface, error) {
+	return helpers.KubeClient()
+}
+
+// Namespaces lists the namespaces of the cluster
+func (c *Clients) Namespaces() ([]string, error) {
+	return helpers.GetNamespaces()
+}
+
+func (c *Clients) Upstreams(namespaces ...string) (v1.UpstreamClient, error) {
+	return helpers.UpstreamClient(namespacesOrAll(namespaces))
+}
+
+func (c *Clients) UpstreamGroups(namespaces ...string) (v1.UpstreamGroupClient, error) {
+	return helpers.UpstreamGroupClient(namespacesOrAll(namespaces))
+}
+
+func (c *Clients) Proxies(namespaces ...string) (v1.ProxyClient, error) {
+	return helpers.ProxyClient(namespacesOrAll(namespaces))
+}
+
+func (c *Clients) Settings(namespaces ...string) (v1.SettingsClient, error) {
+	return helpers.SettingsClient(namespacesOrAll(namespaces))
+}
+
+func (c *Clients) Gateways(namespaces ...string) (gatewayv1.GatewayClient, error) {
+	return helpers.GatewayClient(namespacesOrAll(namespaces))
+}
+
+func (c *Clients) VirtualServices(namespaces ...string) (gatewayv1.VirtualServiceClient, error) {
+	return helpers.VirtualServiceClient(namespacesOrAll(namespaces))
+}
+
+func (c *Clients) RouteTables(namespaces ...string) (gatewayv1.RouteTableClient, error) {
+	return helpers.RouteTableClient(namespacesOrAll(namespaces))
+}
+
+func (c *Clients) AuthConfigs(namespaces ...string) (extauth.AuthConfigClient, error) {
+	return helpers.AuthConfigClient(namespacesOrAll(namespaces))
+}
+
+// Secrets returns the client of the secrets of all the namespaces. Like glooctl, it exits when the client cannot be created.
+func (c *Clients) Secrets() v1.SecretClient {
+	return helpers.MustSecretClient()
+}
+
+// namespacesOrAll returns the namespaces, or all of them when none is given
+func namespacesOrAll(namespaces []string) []string {
+	if len(namespaces) == 0 {
+		return []string{metav1.NamespaceAll}
+	}
+	return namespaces
+}
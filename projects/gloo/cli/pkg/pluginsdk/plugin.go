@@ -0,0 +1,48 @@
+// Package pluginsdk helps writing glooctl plugins in Go.
+//
+// A glooctl plugin is an executable on the PATH named glooctl-<name>, which glooctl runs for `glooctl <name>`,
+// relaying the remaining arguments and the environment. The root command returned by NewRootCmd has the flags
+// of glooctl which select the cluster and the config backend, and its Clients read and write the Gloo resources
+// the way glooctl does.
+package pluginsdk
+
+import (
+	"context"
+
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/prerun"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/spf13/cobra"
+)
+
+// NewRootCmd returns the root command of a plugin, along with the clients its commands use once the flags are
+// parsed. Use is the name of the executable, e.g. glooctl-foo; the plugin adds its subcommands or RunE.
+func NewRootCmd(use, short string, optionsFunc ...cliutils.OptionsFunc) (*cobra.Command, *Clients) {
+	opts := &options.Options{
+		Top: options.Top{
+			Ctx: context.Background(),
+		},
+	}
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := prerun.SetKubeConfigEnv(opts, cmd); err != nil {
+				return err
+			}
+			if err := prerun.EnableConsulClients(opts); err != nil {
+				return err
+			}
+			return prerun.EnableDirectoryClients(opts, cmd)
+		},
+		SilenceUsage: true,
+	}
+
+	pflags := cmd.PersistentFlags()
+	flagutils.AddKubeConfigFlag(pflags, &opts.Top.KubeConfig)
+	flagutils.AddConsulConfigFlags(pflags, &opts.Top.Consul)
+	flagutils.AddConfigDirectoryFlag(pflags, &opts.Top.ConfigDirectory)
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd, &Clients{opts: opts}
+}
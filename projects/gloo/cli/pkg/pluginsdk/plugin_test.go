@@ -0,0 +1,60 @@
+package pluginsdk_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/pluginsdk"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/spf13/cobra"
+)
+
+var _ = Describe("Plugin root command", func() {
+
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "glooctl-plugin")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		helpers.UseDefaultClients()
+		os.RemoveAll(dir)
+	})
+
+	It("has the flags of glooctl which select the cluster and the config backend", func() {
+		cmd, _ := pluginsdk.NewRootCmd("glooctl-foo", "a plugin")
+		for _, flag := range []string{"kubeconfig", "use-consul", "consul-address", "config-directory"} {
+			Expect(cmd.PersistentFlags().Lookup(flag)).NotTo(BeNil(), flag)
+		}
+	})
+
+	It("writes the resources to the config backend of the flags", func() {
+		cmd, pluginClients := pluginsdk.NewRootCmd("glooctl-foo", "a plugin")
+		cmd.RunE = func(cmd *cobra.Command, args []string) error {
+			upstreamClient, err := pluginClients.Upstreams("gloo-system")
+			if err != nil {
+				return err
+			}
+			_, err = upstreamClient.Write(&v1.Upstream{
+				Metadata:     core.Metadata{Name: args[0], Namespace: "gloo-system"},
+				UpstreamType: &v1.Upstream_Static{Static: &static.UpstreamSpec{Hosts: []*static.Host{{Addr: "petstore.example.com", Port: 80}}}},
+			}, clients.WriteOpts{Ctx: pluginClients.Ctx()})
+			return err
+		}
+		cmd.SetArgs([]string{"petstore", "--config-directory", dir})
+		Expect(cmd.Execute()).NotTo(HaveOccurred())
+
+		_, err := os.Stat(filepath.Join(dir, "upstreams", "gloo-system", "petstore.yaml"))
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
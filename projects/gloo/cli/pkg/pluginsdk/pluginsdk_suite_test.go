@@ -0,0 +1,13 @@
+package pluginsdk_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestPluginSdk(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Plugin SDK Suite")
+}
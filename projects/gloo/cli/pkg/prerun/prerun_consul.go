@@ -4,6 +4,7 @@ import (
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/spf13/cobra"
 )
 
 func EnableConsulClients(opts *options.Options) error {
@@ -18,6 +19,14 @@ func EnableConsulClients(opts *options.Options) error {
 	return nil
 }
 
+// EnableDirectoryClients reads and writes the config in the directory of the options rather than in Kubernetes
+func EnableDirectoryClients(opts *options.Options, cmd *cobra.Command) error {
+	if opts.Top.ConfigDirectory != "" {
+		helpers.UseDirectoryClients(opts.Top.ConfigDirectory)
+	}
+	return nil
+}
+
 func EnableVaultClients(vault options.Vault) error {
 	if vault.UseVault {
 		client, err := vault.Client()
@@ -25,8 +25,8 @@ const (
 
 func VersionMismatchWarning(opts *options.Options, cmd *cobra.Command) error {
 	// Only Kubernetes provides client/server version information. Only check for a version
-	// mismatch if Kubernetes is enabled (i.e. Consul is not enabled)
-	if opts.Top.Consul.UseConsul {
+	// mismatch if Kubernetes is enabled (i.e. neither Consul nor a config directory is enabled)
+	if opts.Top.Consul.UseConsul || opts.Top.ConfigDirectory != "" {
 		return nil
 	}
 	nsToCheck := opts.Metadata.Namespace
@@ -34,11 +34,10 @@ func ProxyTable(list v1.ProxyList, w io.Writer) {
 		)
 		for _, listener := range proxy.Listeners {
 			listeners = append(listeners, fmt.Sprintf("%v:%v", listener.BindAddress, listener.BindPort))
-			http, ok := listener.ListenerType.(*v1.Listener_HttpListener)
-			if !ok {
-				continue
+			vhCount += len(listener.GetHttpListener().GetVirtualHosts())
+			for _, matchedListener := range listener.GetHybridListener().GetMatchedListeners() {
+				vhCount += len(matchedListener.GetHttpListener().GetVirtualHosts())
 			}
-			vhCount += len(http.HttpListener.VirtualHosts)
 		}
 		name := proxy.GetMetadata().Name
 
@@ -35,7 +35,7 @@ func UpstreamTable(xdsDump *xdsinspection.XdsDump, upstreams []*v1.Upstream, w i
 		name := us.GetMetadata().Name
 		s := us.Status.State.String()
 
-		u := upstreamType(us)
+		u := UpstreamType(us)
 		details := upstreamDetails(us, xdsDump)
 
 		if len(details) == 0 {
@@ -55,7 +55,8 @@ func UpstreamTable(xdsDump *xdsinspection.XdsDump, upstreams []*v1.Upstream, w i
 	table.Render()
 }
 
-func upstreamType(up *v1.Upstream) string {
+// UpstreamType returns the display name of the type of the upstream
+func UpstreamType(up *v1.Upstream) string {
 	if up == nil {
 		return "Invalid"
 	}
@@ -69,6 +70,10 @@ func upstreamType(up *v1.Upstream) string {
 		return "Consul"
 	case *v1.Upstream_AwsEc2:
 		return "AWS EC2"
+	case *v1.Upstream_Gcp:
+		return "GCP"
+	case *v1.Upstream_Dns:
+		return "DNS"
 	case *v1.Upstream_Kube:
 		return "Kubernetes"
 	case *v1.Upstream_Static:
@@ -106,6 +111,7 @@ func upstreamDetails(up *v1.Upstream, xdsDump *xdsinspection.XdsDump) []string {
 	case *v1.Upstream_AwsEc2:
 		add(
 			fmt.Sprintf("role:           %v", usType.AwsEc2.RoleArn),
+			fmt.Sprintf("external id:    %v", usType.AwsEc2.ExternalId),
 			fmt.Sprintf("uses public ip: %v", usType.AwsEc2.PublicIp),
 			fmt.Sprintf("port:           %v", usType.AwsEc2.Port),
 		)
@@ -160,6 +166,19 @@ func upstreamDetails(up *v1.Upstream, xdsDump *xdsinspection.XdsDump) []string {
 		if usType.Static.ServiceSpec != nil {
 			add(linesForServiceSpec(usType.Static.ServiceSpec)...)
 		}
+	case *v1.Upstream_Gcp:
+		add(
+			fmt.Sprintf("host:     %v", usType.Gcp.Host),
+			fmt.Sprintf("audience: %v", usType.Gcp.Audience),
+		)
+	case *v1.Upstream_Dns:
+		add(fmt.Sprintf("discovery type: %v", usType.Dns.DiscoveryType))
+		for i := range usType.Dns.Hosts {
+			if i == 0 {
+				add("hosts:")
+			}
+			add(fmt.Sprintf("- %v:%v", usType.Dns.Hosts[i].Addr, usType.Dns.Hosts[i].Port))
+		}
 	}
 	add("")
 	return details
@@ -100,6 +100,11 @@ type XdsDump struct {
 	Clusters  []v2.Cluster
 	Listeners []v2.Listener
 	Routes    []v2.RouteConfiguration
+	// the versions of the resources served, by type
+	EndpointsVersion string
+	ClustersVersion  string
+	ListenersVersion string
+	RoutesVersion    string
 }
 
 func getXdsDump(ctx context.Context, xdsPort, proxyName, proxyNamespace string) (*XdsDump, error) {
@@ -116,17 +121,17 @@ func getXdsDump(ctx context.Context, xdsPort, proxyName, proxyNamespace string)
 		return nil, err
 	}
 
-	xdsDump.Endpoints, err = listEndpoints(ctx, dr, conn)
+	xdsDump.Endpoints, xdsDump.EndpointsVersion, err = listEndpoints(ctx, dr, conn)
 	if err != nil {
 		return nil, err
 	}
 
-	xdsDump.Clusters, err = listClusters(ctx, dr, conn)
+	xdsDump.Clusters, xdsDump.ClustersVersion, err = listClusters(ctx, dr, conn)
 	if err != nil {
 		return nil, err
 	}
 
-	xdsDump.Listeners, err = listListeners(ctx, dr, conn)
+	xdsDump.Listeners, xdsDump.ListenersVersion, err = listListeners(ctx, dr, conn)
 	if err != nil {
 		return nil, err
 	}
@@ -158,7 +163,7 @@ func getXdsDump(ctx context.Context, xdsPort, proxyName, proxyNamespace string)
 		routes = append(routes, hcm.RouteSpecifier.(*envoyhttp.HttpConnectionManager_Rds).Rds.RouteConfigName)
 	}
 
-	xdsDump.Routes, err = listRoutes(ctx, conn, dr, routes)
+	xdsDump.Routes, xdsDump.RoutesVersion, err = listRoutes(ctx, conn, dr, routes)
 	if err != nil {
 		return nil, err
 	}
@@ -166,31 +171,31 @@ func getXdsDump(ctx context.Context, xdsPort, proxyName, proxyNamespace string)
 	return xdsDump, nil
 }
 
-func listClusters(ctx context.Context, dr *v2.DiscoveryRequest, conn *grpc.ClientConn) ([]v2.Cluster, error) {
+func listClusters(ctx context.Context, dr *v2.DiscoveryRequest, conn *grpc.ClientConn) ([]v2.Cluster, string, error) {
 
 	// clusters
 	cdsc := v2.NewClusterDiscoveryServiceClient(conn)
 	dresp, err := cdsc.FetchClusters(ctx, dr)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	var clusters []v2.Cluster
 	for _, anyCluster := range dresp.Resources {
 
 		var cluster v2.Cluster
 		if err := ptypes.UnmarshalAny(anyCluster, &cluster); err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		clusters = append(clusters, cluster)
 	}
-	return clusters, nil
+	return clusters, dresp.VersionInfo, nil
 }
 
-func listEndpoints(ctx context.Context, dr *v2.DiscoveryRequest, conn *grpc.ClientConn) ([]v2.ClusterLoadAssignment, error) {
+func listEndpoints(ctx context.Context, dr *v2.DiscoveryRequest, conn *grpc.ClientConn) ([]v2.ClusterLoadAssignment, string, error) {
 	eds := v2.NewEndpointDiscoveryServiceClient(conn)
 	dresp, err := eds.FetchEndpoints(ctx, dr)
 	if err != nil {
-		return nil, eris.Errorf("endpoints err: %v", err)
+		return nil, "", eris.Errorf("endpoints err: %v", err)
 	}
 	var class []v2.ClusterLoadAssignment
 
@@ -198,34 +203,34 @@ func listEndpoints(ctx context.Context, dr *v2.DiscoveryRequest, conn *grpc.Clie
 
 		var cla v2.ClusterLoadAssignment
 		if err := ptypes.UnmarshalAny(anyCla, &cla); err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		class = append(class, cla)
 	}
-	return class, nil
+	return class, dresp.VersionInfo, nil
 }
 
-func listListeners(ctx context.Context, dr *v2.DiscoveryRequest, conn *grpc.ClientConn) ([]v2.Listener, error) {
+func listListeners(ctx context.Context, dr *v2.DiscoveryRequest, conn *grpc.ClientConn) ([]v2.Listener, string, error) {
 
 	// listeners
 	ldsc := v2.NewListenerDiscoveryServiceClient(conn)
 	dresp, err := ldsc.FetchListeners(ctx, dr)
 	if err != nil {
-		return nil, eris.Errorf("listeners err: %v", err)
+		return nil, "", eris.Errorf("listeners err: %v", err)
 	}
 	var listeners []v2.Listener
 
 	for _, anylistener := range dresp.Resources {
 		var listener v2.Listener
 		if err := ptypes.UnmarshalAny(anylistener, &listener); err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		listeners = append(listeners, listener)
 	}
-	return listeners, nil
+	return listeners, dresp.VersionInfo, nil
 }
 
-func listRoutes(ctx context.Context, conn *grpc.ClientConn, dr *v2.DiscoveryRequest, routenames []string) ([]v2.RouteConfiguration, error) {
+func listRoutes(ctx context.Context, conn *grpc.ClientConn, dr *v2.DiscoveryRequest, routenames []string) ([]v2.RouteConfiguration, string, error) {
 
 	// routes
 	ldsc := v2.NewRouteDiscoveryServiceClient(conn)
@@ -234,18 +239,18 @@ func listRoutes(ctx context.Context, conn *grpc.ClientConn, dr *v2.DiscoveryRequ
 
 	dresp, err := ldsc.FetchRoutes(ctx, dr)
 	if err != nil {
-		return nil, eris.Errorf("routes err: %v", err)
+		return nil, "", eris.Errorf("routes err: %v", err)
 	}
 	var routes []v2.RouteConfiguration
 
 	for _, anyRoute := range dresp.Resources {
 		var route v2.RouteConfiguration
 		if err := ptypes.UnmarshalAny(anyRoute, &route); err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		routes = append(routes, route)
 	}
-	return routes, nil
+	return routes, dresp.VersionInfo, nil
 }
 
 func (xd *XdsDump) String() string {
@@ -7,4 +7,10 @@ const (
 	// We use these prefixes to avoid shadowing in case a data center name is the same as a tag name
 	ConsulTagKeyPrefix        = "tag_"
 	ConsulDataCenterKeyPrefix = "dc_"
+	ConsulMetadataKeyPrefix   = "meta_"
+
+	// The endpoint label holding the aggregated status of the Consul health checks of the service instance
+	ConsulHealthStatusKey = "health"
+	// The endpoint label holding the Consul weight of the service instance for its current health status
+	ConsulWeightKey = "weight"
 )
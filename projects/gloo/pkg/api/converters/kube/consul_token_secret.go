@@ -0,0 +1,83 @@
+package kubeconverters
+
+import (
+	"context"
+
+	skcore "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kubesecret"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/utils/kubeutils"
+	kubev1 "k8s.io/api/core/v1"
+)
+
+type ConsulTokenSecretConverter struct{}
+
+var _ kubesecret.SecretConverter = &ConsulTokenSecretConverter{}
+
+const (
+	ConsulTokenSecretType = "gloo.solo.io/consul-token"
+	ConsulTokenDataKey    = "token"
+)
+
+func (t *ConsulTokenSecretConverter) FromKubeSecret(ctx context.Context, _ *kubesecret.ResourceClient, secret *kubev1.Secret) (resources.Resource, error) {
+	if secret == nil {
+		contextutils.LoggerFrom(ctx).Warn("unexpected nil secret")
+		return nil, nil
+	}
+
+	if secret.Type == ConsulTokenSecretType {
+		token, ok := secret.Data[ConsulTokenDataKey]
+		if !ok {
+			contextutils.LoggerFrom(ctx).Warnw("skipping consul token secret with no token",
+				zap.String("name", secret.Name), zap.String("namespace", secret.Namespace))
+			return nil, nil
+		}
+
+		skSecret := &v1.Secret{
+			Metadata: skcore.Metadata{
+				Name:        secret.Name,
+				Namespace:   secret.Namespace,
+				Cluster:     secret.ClusterName,
+				Labels:      secret.Labels,
+				Annotations: secret.Annotations,
+			},
+			Kind: &v1.Secret_ConsulToken{
+				ConsulToken: &v1.ConsulTokenSecret{
+					Token: string(token),
+				},
+			},
+		}
+
+		return skSecret, nil
+	}
+	// any unmatched secrets will be handled by subsequent converters
+	return nil, nil
+}
+
+func (t *ConsulTokenSecretConverter) ToKubeSecret(_ context.Context, _ *kubesecret.ResourceClient, resource resources.Resource) (*kubev1.Secret, error) {
+	glooSecret, ok := resource.(*v1.Secret)
+	if !ok {
+		return nil, nil
+	}
+	tokenGlooSecret, ok := glooSecret.Kind.(*v1.Secret_ConsulToken)
+	if !ok {
+		return nil, nil
+	}
+
+	kubeMeta := kubeutils.ToKubeMeta(glooSecret.Metadata)
+
+	kubeSecret := &kubev1.Secret{
+		ObjectMeta: kubeMeta,
+		Type:       ConsulTokenSecretType,
+		StringData: map[string]string{
+			ConsulTokenDataKey: tokenGlooSecret.ConsulToken.GetToken(),
+		},
+	}
+
+	return kubeSecret, nil
+}
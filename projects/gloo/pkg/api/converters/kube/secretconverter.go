@@ -22,6 +22,7 @@ var GlooSecretConverterChain = NewSecretConverterChain(
 	new(TLSSecretConverter),
 	new(AwsSecretConverter),
 	new(HeaderSecretConverter),
+	new(ConsulTokenSecretConverter),
 	new(APIKeySecretConverter),
 )
 
@@ -635,6 +635,55 @@ func (m *RuntimeUInt32) GetRuntimeKey() string {
 	return ""
 }
 
+// Runtime derived percentage with a default when not specified.
+type RuntimePercent struct {
+	// Default value if runtime value is not available.
+	DefaultValue *v3.Percent `protobuf:"bytes,1,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	// Runtime key to get value for comparison. This value is used if defined.
+	RuntimeKey           string   `protobuf:"bytes,2,opt,name=runtime_key,json=runtimeKey,proto3" json:"runtime_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RuntimePercent) Reset()         { *m = RuntimePercent{} }
+func (m *RuntimePercent) String() string { return proto.CompactTextString(m) }
+func (*RuntimePercent) ProtoMessage()    {}
+func (*RuntimePercent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de9a9432f7042a04, []int{6}
+}
+func (m *RuntimePercent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RuntimePercent.Unmarshal(m, b)
+}
+func (m *RuntimePercent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RuntimePercent.Marshal(b, m, deterministic)
+}
+func (m *RuntimePercent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuntimePercent.Merge(m, src)
+}
+func (m *RuntimePercent) XXX_Size() int {
+	return xxx_messageInfo_RuntimePercent.Size(m)
+}
+func (m *RuntimePercent) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuntimePercent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuntimePercent proto.InternalMessageInfo
+
+func (m *RuntimePercent) GetDefaultValue() *v3.Percent {
+	if m != nil {
+		return m.DefaultValue
+	}
+	return nil
+}
+
+func (m *RuntimePercent) GetRuntimeKey() string {
+	if m != nil {
+		return m.RuntimeKey
+	}
+	return ""
+}
+
 // Runtime derived double with a default when not specified.
 type RuntimeDouble struct {
 	// Default value if runtime value is not available.
@@ -650,7 +699,7 @@ func (m *RuntimeDouble) Reset()         { *m = RuntimeDouble{} }
 func (m *RuntimeDouble) String() string { return proto.CompactTextString(m) }
 func (*RuntimeDouble) ProtoMessage()    {}
 func (*RuntimeDouble) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de9a9432f7042a04, []int{6}
+	return fileDescriptor_de9a9432f7042a04, []int{7}
 }
 func (m *RuntimeDouble) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuntimeDouble.Unmarshal(m, b)
@@ -701,7 +750,7 @@ func (m *RuntimeFeatureFlag) Reset()         { *m = RuntimeFeatureFlag{} }
 func (m *RuntimeFeatureFlag) String() string { return proto.CompactTextString(m) }
 func (*RuntimeFeatureFlag) ProtoMessage()    {}
 func (*RuntimeFeatureFlag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de9a9432f7042a04, []int{7}
+	return fileDescriptor_de9a9432f7042a04, []int{8}
 }
 func (m *RuntimeFeatureFlag) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuntimeFeatureFlag.Unmarshal(m, b)
@@ -754,7 +803,7 @@ func (m *HeaderValue) Reset()         { *m = HeaderValue{} }
 func (m *HeaderValue) String() string { return proto.CompactTextString(m) }
 func (*HeaderValue) ProtoMessage()    {}
 func (*HeaderValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de9a9432f7042a04, []int{8}
+	return fileDescriptor_de9a9432f7042a04, []int{9}
 }
 func (m *HeaderValue) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HeaderValue.Unmarshal(m, b)
@@ -804,7 +853,7 @@ func (m *HeaderValueOption) Reset()         { *m = HeaderValueOption{} }
 func (m *HeaderValueOption) String() string { return proto.CompactTextString(m) }
 func (*HeaderValueOption) ProtoMessage()    {}
 func (*HeaderValueOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de9a9432f7042a04, []int{9}
+	return fileDescriptor_de9a9432f7042a04, []int{10}
 }
 func (m *HeaderValueOption) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HeaderValueOption.Unmarshal(m, b)
@@ -850,7 +899,7 @@ func (m *HeaderMap) Reset()         { *m = HeaderMap{} }
 func (m *HeaderMap) String() string { return proto.CompactTextString(m) }
 func (*HeaderMap) ProtoMessage()    {}
 func (*HeaderMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de9a9432f7042a04, []int{10}
+	return fileDescriptor_de9a9432f7042a04, []int{11}
 }
 func (m *HeaderMap) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HeaderMap.Unmarshal(m, b)
@@ -893,7 +942,7 @@ func (m *DataSource) Reset()         { *m = DataSource{} }
 func (m *DataSource) String() string { return proto.CompactTextString(m) }
 func (*DataSource) ProtoMessage()    {}
 func (*DataSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de9a9432f7042a04, []int{11}
+	return fileDescriptor_de9a9432f7042a04, []int{12}
 }
 func (m *DataSource) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataSource.Unmarshal(m, b)
@@ -987,7 +1036,7 @@ func (m *RetryPolicy) Reset()         { *m = RetryPolicy{} }
 func (m *RetryPolicy) String() string { return proto.CompactTextString(m) }
 func (*RetryPolicy) ProtoMessage()    {}
 func (*RetryPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de9a9432f7042a04, []int{12}
+	return fileDescriptor_de9a9432f7042a04, []int{13}
 }
 func (m *RetryPolicy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RetryPolicy.Unmarshal(m, b)
@@ -1038,7 +1087,7 @@ func (m *RemoteDataSource) Reset()         { *m = RemoteDataSource{} }
 func (m *RemoteDataSource) String() string { return proto.CompactTextString(m) }
 func (*RemoteDataSource) ProtoMessage()    {}
 func (*RemoteDataSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de9a9432f7042a04, []int{13}
+	return fileDescriptor_de9a9432f7042a04, []int{14}
 }
 func (m *RemoteDataSource) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoteDataSource.Unmarshal(m, b)
@@ -1094,7 +1143,7 @@ func (m *AsyncDataSource) Reset()         { *m = AsyncDataSource{} }
 func (m *AsyncDataSource) String() string { return proto.CompactTextString(m) }
 func (*AsyncDataSource) ProtoMessage()    {}
 func (*AsyncDataSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de9a9432f7042a04, []int{14}
+	return fileDescriptor_de9a9432f7042a04, []int{15}
 }
 func (m *AsyncDataSource) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AsyncDataSource.Unmarshal(m, b)
@@ -1181,7 +1230,7 @@ func (m *TransportSocket) Reset()         { *m = TransportSocket{} }
 func (m *TransportSocket) String() string { return proto.CompactTextString(m) }
 func (*TransportSocket) ProtoMessage()    {}
 func (*TransportSocket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de9a9432f7042a04, []int{15}
+	return fileDescriptor_de9a9432f7042a04, []int{16}
 }
 func (m *TransportSocket) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransportSocket.Unmarshal(m, b)
@@ -1264,7 +1313,7 @@ func (m *RuntimeFractionalPercent) Reset()         { *m = RuntimeFractionalPerce
 func (m *RuntimeFractionalPercent) String() string { return proto.CompactTextString(m) }
 func (*RuntimeFractionalPercent) ProtoMessage()    {}
 func (*RuntimeFractionalPercent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de9a9432f7042a04, []int{16}
+	return fileDescriptor_de9a9432f7042a04, []int{17}
 }
 func (m *RuntimeFractionalPercent) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuntimeFractionalPercent.Unmarshal(m, b)
@@ -1313,7 +1362,7 @@ func (m *ControlPlane) Reset()         { *m = ControlPlane{} }
 func (m *ControlPlane) String() string { return proto.CompactTextString(m) }
 func (*ControlPlane) ProtoMessage()    {}
 func (*ControlPlane) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de9a9432f7042a04, []int{17}
+	return fileDescriptor_de9a9432f7042a04, []int{18}
 }
 func (m *ControlPlane) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ControlPlane.Unmarshal(m, b)
@@ -1351,6 +1400,7 @@ func init() {
 	proto.RegisterType((*Metadata)(nil), "envoy.config.core.v3.Metadata")
 	proto.RegisterMapType((map[string]*types.Struct)(nil), "envoy.config.core.v3.Metadata.FilterMetadataEntry")
 	proto.RegisterType((*RuntimeUInt32)(nil), "envoy.config.core.v3.RuntimeUInt32")
+	proto.RegisterType((*RuntimePercent)(nil), "envoy.config.core.v3.RuntimePercent")
 	proto.RegisterType((*RuntimeDouble)(nil), "envoy.config.core.v3.RuntimeDouble")
 	proto.RegisterType((*RuntimeFeatureFlag)(nil), "envoy.config.core.v3.RuntimeFeatureFlag")
 	proto.RegisterType((*HeaderValue)(nil), "envoy.config.core.v3.HeaderValue")
@@ -1370,122 +1420,124 @@ func init() {
 }
 
 var fileDescriptor_de9a9432f7042a04 = []byte{
-	// 1840 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcd, 0x73, 0x1b, 0x49,
-	0x15, 0xf7, 0xc8, 0xb6, 0x24, 0x3f, 0xc9, 0xf1, 0x6c, 0x27, 0x24, 0x8a, 0x13, 0x7f, 0x8d, 0xe3,
-	0x60, 0xcc, 0xae, 0x54, 0xc8, 0x05, 0x15, 0x04, 0x05, 0x78, 0x2c, 0x79, 0x65, 0x92, 0x58, 0xaa,
-	0xb1, 0xbc, 0x87, 0x50, 0x94, 0xaa, 0x35, 0xd3, 0x92, 0x9b, 0x8c, 0xa6, 0x87, 0x9e, 0x1e, 0x13,
-	0xed, 0x85, 0x9c, 0x28, 0xd8, 0x0b, 0x57, 0x8a, 0x33, 0x07, 0x8e, 0x9c, 0x28, 0x8a, 0x0b, 0x5c,
-	0xa8, 0x0a, 0x47, 0xaa, 0xf8, 0x07, 0xd8, 0x03, 0xc5, 0x99, 0x13, 0x95, 0x03, 0x45, 0xf5, 0x4c,
-	0x8f, 0x3c, 0xb2, 0x64, 0x27, 0xc5, 0xde, 0xba, 0xdf, 0xc7, 0xbc, 0xdf, 0x7b, 0xef, 0xd7, 0xdd,
-	0x4f, 0x02, 0x6b, 0x40, 0xc5, 0x79, 0xd8, 0x2b, 0xdb, 0x6c, 0x58, 0x09, 0x98, 0xcb, 0x3e, 0xa2,
-	0xac, 0x32, 0x70, 0x19, 0xab, 0xf8, 0x9c, 0xfd, 0x88, 0xd8, 0x22, 0x88, 0x77, 0xd8, 0xa7, 0x15,
-	0xf2, 0x4a, 0x10, 0xee, 0x61, 0xb7, 0x42, 0xbc, 0x0b, 0x36, 0xaa, 0xd8, 0xcc, 0xeb, 0xd3, 0x41,
-	0xc5, 0x66, 0x9c, 0x54, 0x2e, 0xf6, 0x2b, 0x3d, 0x1c, 0x90, 0xb2, 0xcf, 0x99, 0x60, 0xe8, 0x4e,
-	0x64, 0x50, 0x8e, 0x0d, 0xca, 0xd2, 0xa0, 0x7c, 0xb1, 0xbf, 0x6a, 0xcc, 0x74, 0xc3, 0x8e, 0xc3,
-	0x49, 0x10, 0xc4, 0x9e, 0xd7, 0xd8, 0xf4, 0xb0, 0xfd, 0x92, 0xf5, 0xfb, 0xca, 0x66, 0x7b, 0xa6,
-	0xcd, 0xb9, 0x10, 0x7e, 0x37, 0xe4, 0x54, 0x19, 0x3d, 0x88, 0x8d, 0xc4, 0xc8, 0x8f, 0xb4, 0x3e,
-	0xe1, 0x36, 0xf1, 0x84, 0x52, 0x3e, 0x9a, 0x54, 0x06, 0x64, 0x88, 0x3d, 0x41, 0xed, 0xee, 0x05,
-	0xe1, 0x01, 0x65, 0x9e, 0xb2, 0xba, 0x3f, 0x60, 0x6c, 0xe0, 0x92, 0x4a, 0xb4, 0xeb, 0x85, 0xfd,
-	0x0a, 0xf6, 0x46, 0x4a, 0xb5, 0x7e, 0x55, 0xe5, 0x84, 0x1c, 0x8b, 0x4b, 0xd7, 0x87, 0x57, 0xf5,
-	0x81, 0xe0, 0xa1, 0x2d, 0xae, 0xf3, 0xfe, 0x09, 0xc7, 0xbe, 0x4f, 0x78, 0x52, 0x84, 0xf5, 0xd0,
-	0xf1, 0x71, 0x05, 0x7b, 0x1e, 0x13, 0xd1, 0x47, 0x83, 0xca, 0x90, 0x0e, 0x38, 0x16, 0xaa, 0xbc,
-	0xab, 0x6b, 0x53, 0xfa, 0x40, 0x60, 0x11, 0x26, 0xee, 0x5b, 0x53, 0x6a, 0x95, 0x17, 0xf5, 0x06,
-	0xca, 0xe4, 0xde, 0x05, 0x76, 0xa9, 0x83, 0x05, 0xa9, 0x24, 0x0b, 0xa5, 0xb8, 0x33, 0x60, 0x03,
-	0x16, 0x2d, 0x2b, 0x72, 0x15, 0x4b, 0x0d, 0x01, 0xf9, 0x67, 0xcc, 0xc6, 0x2e, 0x15, 0x23, 0x74,
-	0x17, 0xb2, 0x9c, 0x0c, 0x28, 0xf3, 0x4a, 0xda, 0xa6, 0xb6, 0xbb, 0x64, 0xa9, 0x1d, 0x42, 0xb0,
-	0xf0, 0x29, 0xf3, 0x48, 0x29, 0x13, 0x49, 0xa3, 0x35, 0xba, 0x0f, 0xf9, 0x20, 0xec, 0x75, 0x23,
-	0xf9, 0x7c, 0x24, 0xcf, 0x05, 0x61, 0xef, 0x05, 0xf3, 0x48, 0x6d, 0xeb, 0xd7, 0x7f, 0xf9, 0xf9,
-	0xfa, 0x43, 0x58, 0x8d, 0x99, 0x82, 0x7d, 0x5a, 0xbe, 0xa8, 0xc6, 0x4c, 0x49, 0x22, 0x19, 0xbf,
-	0xd1, 0xa0, 0x68, 0x86, 0xd4, 0x75, 0x3e, 0x89, 0xe1, 0xa3, 0x27, 0x90, 0x53, 0x99, 0x44, 0xb1,
-	0x0b, 0xd5, 0xf5, 0x72, 0xec, 0x2e, 0x1b, 0x59, 0xbe, 0xd8, 0x2f, 0x9f, 0xaa, 0x46, 0x2a, 0x07,
-	0x2b, 0x31, 0x47, 0xfb, 0x90, 0x1f, 0x12, 0x81, 0x1d, 0x2c, 0x70, 0x04, 0xb0, 0x50, 0xbd, 0x57,
-	0x8e, 0x9b, 0x50, 0x4e, 0x9a, 0x50, 0x3e, 0x8d, 0x5a, 0x64, 0x8d, 0x0d, 0x6b, 0x3b, 0x12, 0xe2,
-	0x26, 0xac, 0x4f, 0x43, 0x4c, 0xa3, 0x32, 0x3e, 0xd7, 0x60, 0xa9, 0xf1, 0x4a, 0x10, 0x2f, 0x50,
-	0x65, 0xf0, 0xf0, 0x90, 0xa8, 0xe2, 0x44, 0x6b, 0xb4, 0x0a, 0x79, 0x1b, 0x0b, 0x32, 0x60, 0x7c,
-	0xa4, 0xca, 0x33, 0xde, 0xa3, 0x2f, 0xc3, 0x8a, 0x44, 0xdf, 0x75, 0x48, 0x60, 0x73, 0xea, 0x0b,
-	0xc6, 0x55, 0xa5, 0x6e, 0x49, 0x71, 0x7d, 0x2c, 0x45, 0xdf, 0xbe, 0x4c, 0x7e, 0x21, 0xca, 0xc0,
-	0x28, 0xcf, 0x3a, 0x65, 0x13, 0xd8, 0x2e, 0x0b, 0xb0, 0x0a, 0x79, 0x87, 0x06, 0xb8, 0xe7, 0x12,
-	0xa7, 0xb4, 0xb8, 0xa9, 0xed, 0xe6, 0xad, 0xf1, 0xbe, 0x66, 0xc8, 0x3c, 0xd7, 0xe0, 0xc1, 0x74,
-	0x9e, 0xe3, 0xb4, 0x8c, 0x7f, 0x2c, 0xc0, 0xc2, 0x09, 0x73, 0x08, 0xba, 0x05, 0x19, 0xea, 0xa8,
-	0xec, 0x32, 0xd4, 0x41, 0x25, 0xc8, 0xd9, 0x6e, 0x18, 0x08, 0xc2, 0x55, 0x6a, 0xc9, 0x76, 0xa2,
-	0xe6, 0xf3, 0xef, 0x59, 0x73, 0x54, 0x83, 0xbc, 0xab, 0xfa, 0xaf, 0xd2, 0x5c, 0x9f, 0x9d, 0x66,
-	0xc2, 0x12, 0x6b, 0x6c, 0x8f, 0x1e, 0xc3, 0x4a, 0x18, 0x10, 0xde, 0xc5, 0x03, 0xe2, 0x89, 0x6e,
-	0xd4, 0x85, 0x6c, 0x04, 0x69, 0x59, 0x8a, 0x0f, 0xa4, 0xf4, 0x44, 0xb6, 0xa3, 0x0c, 0x28, 0x65,
-	0x97, 0x14, 0x35, 0x27, 0x4d, 0x9b, 0x73, 0x96, 0x3e, 0x36, 0x4e, 0x68, 0xf7, 0x43, 0x28, 0xa5,
-	0xec, 0x7b, 0xb2, 0xbe, 0x63, 0xaf, 0xfc, 0xfb, 0xb6, 0xa2, 0x39, 0x67, 0x7d, 0x69, 0xfc, 0xe5,
-	0x09, 0x56, 0x7f, 0x17, 0x80, 0x24, 0x75, 0x0e, 0x4a, 0x4b, 0x9b, 0xf3, 0xbb, 0x85, 0xea, 0xc6,
-	0xec, 0x0f, 0x8e, 0xfb, 0x61, 0xa5, 0x5c, 0x24, 0x85, 0x6c, 0x97, 0x4a, 0x6c, 0x7d, 0x82, 0x45,
-	0xc8, 0x49, 0x50, 0x82, 0xcd, 0x79, 0x49, 0xa1, 0x58, 0x7c, 0xa4, 0xa4, 0xe8, 0x04, 0x6e, 0xbb,
-	0x34, 0x10, 0x44, 0x5e, 0x04, 0x5d, 0x75, 0xef, 0x92, 0xa0, 0x54, 0x88, 0x42, 0xae, 0xcd, 0x0e,
-	0x79, 0x10, 0x9b, 0x59, 0x68, 0xec, 0x79, 0x90, 0x38, 0xd6, 0xd6, 0x24, 0x71, 0x4a, 0x70, 0x77,
-	0x9a, 0x38, 0x92, 0x2a, 0xe6, 0x7d, 0xb8, 0x37, 0x5d, 0xe7, 0xae, 0xa4, 0xf5, 0xf7, 0x17, 0xf2,
-	0x8b, 0x7a, 0xd6, 0x5a, 0x9e, 0xa8, 0xa5, 0xf1, 0x4f, 0x0d, 0xf2, 0xcf, 0x13, 0x22, 0xfc, 0x00,
-	0x56, 0xfa, 0xd4, 0x15, 0x84, 0x77, 0xc7, 0x24, 0xd2, 0x22, 0x9c, 0xd5, 0xd9, 0x38, 0x13, 0xc7,
-	0xf2, 0x51, 0xe4, 0x95, 0x6c, 0x1b, 0x9e, 0xe0, 0x23, 0xeb, 0x56, 0x7f, 0x42, 0xb8, 0xfa, 0x02,
-	0x6e, 0xcf, 0x30, 0x43, 0x3a, 0xcc, 0xbf, 0x24, 0x23, 0x45, 0x6e, 0xb9, 0x44, 0x1f, 0xc1, 0xe2,
-	0x05, 0x76, 0x43, 0xf2, 0xae, 0x4b, 0x23, 0xb6, 0xaa, 0x65, 0x9e, 0x68, 0x37, 0x5c, 0x6c, 0x49,
-	0x30, 0xe3, 0x67, 0x1a, 0x2c, 0x5b, 0xa1, 0x27, 0xe8, 0x90, 0x9c, 0x1d, 0x7b, 0x62, 0xbf, 0x8a,
-	0xb6, 0x61, 0xd9, 0x21, 0x7d, 0x1c, 0xba, 0xa2, 0x7b, 0x19, 0x6f, 0xd9, 0x2a, 0x2a, 0xe1, 0x27,
-	0x52, 0x86, 0x76, 0xa1, 0xc0, 0x63, 0xaf, 0xae, 0x84, 0x19, 0x5d, 0x13, 0x66, 0xee, 0xad, 0xb9,
-	0xc0, 0x33, 0x9b, 0x9a, 0x05, 0x4a, 0xf7, 0x94, 0x8c, 0x6a, 0x8f, 0x25, 0x86, 0x2d, 0xd8, 0x98,
-	0xc6, 0x30, 0x11, 0x36, 0x0d, 0xa4, 0xce, 0xc2, 0x9e, 0x4b, 0xa6, 0x81, 0xc8, 0x62, 0x68, 0x37,
-	0x03, 0xc9, 0x7c, 0x01, 0x20, 0x71, 0x58, 0xe3, 0xf7, 0x1a, 0x20, 0x25, 0x51, 0x6c, 0x3d, 0x72,
-	0xf1, 0x00, 0x1d, 0xcf, 0x42, 0x53, 0xa8, 0xae, 0x4e, 0xb5, 0xc1, 0x64, 0xcc, 0x8d, 0xb0, 0x99,
-	0xf9, 0xb7, 0xe6, 0xe2, 0x67, 0x5a, 0x46, 0xff, 0xff, 0x31, 0x7f, 0x55, 0x62, 0x7e, 0x0c, 0x8f,
-	0xae, 0xc5, 0x9c, 0x42, 0x68, 0xfc, 0x14, 0x0a, 0x4d, 0x82, 0x1d, 0xc2, 0xe3, 0x28, 0xdb, 0x29,
-	0x06, 0x99, 0x1f, 0xbc, 0x35, 0x6f, 0xf1, 0xe2, 0xa6, 0xb6, 0xfb, 0xfa, 0xb5, 0xf6, 0x67, 0x4d,
-	0x7b, 0xa3, 0xcd, 0xc5, 0xa4, 0xda, 0x49, 0x93, 0x6a, 0xc9, 0x5c, 0x79, 0x6b, 0x16, 0x39, 0xc4,
-	0x46, 0x19, 0x69, 0xa4, 0xc8, 0xf4, 0x48, 0xe2, 0xd8, 0x80, 0xb5, 0x69, 0x1c, 0xa9, 0x88, 0xc6,
-	0x1f, 0x34, 0xf8, 0x20, 0xb5, 0x6f, 0xf9, 0xf2, 0xc5, 0x47, 0x87, 0x90, 0x3d, 0x8f, 0x84, 0xaa,
-	0x62, 0x5b, 0xb3, 0x0f, 0x4d, 0xca, 0x31, 0x55, 0x38, 0xe5, 0x8a, 0xaa, 0x90, 0x95, 0x63, 0x89,
-	0xe7, 0x28, 0xf6, 0xdf, 0x50, 0x76, 0x4b, 0x59, 0xd6, 0xf6, 0x24, 0xe8, 0x1d, 0xd8, 0xbe, 0x11,
-	0x74, 0x0c, 0xd2, 0x70, 0x61, 0x29, 0x16, 0x3e, 0xc7, 0x3e, 0xfa, 0x16, 0xe4, 0xe2, 0xb0, 0x81,
-	0x3a, 0xe7, 0xef, 0x86, 0x6c, 0x25, 0x1e, 0x37, 0xbc, 0x60, 0xe3, 0x00, 0xc6, 0x1b, 0x0d, 0xa0,
-	0x8e, 0x05, 0x3e, 0x65, 0x21, 0xb7, 0x09, 0xda, 0x81, 0x7c, 0x9f, 0xba, 0xe4, 0xf2, 0xad, 0x1e,
-	0x93, 0xa1, 0x39, 0x67, 0x8d, 0x55, 0xe8, 0x43, 0x28, 0x52, 0xcf, 0xa5, 0x1e, 0xe9, 0xf6, 0x46,
-	0x82, 0x04, 0x51, 0x25, 0x8a, 0x91, 0xe9, 0xa7, 0x19, 0x5d, 0x9a, 0x16, 0x62, 0xb5, 0x29, 0xb5,
-	0xa8, 0x0c, 0xcb, 0xca, 0x3a, 0x10, 0x9c, 0x7a, 0x83, 0x2b, 0x67, 0xb4, 0x39, 0x67, 0xa9, 0xaf,
-	0x9d, 0x46, 0xea, 0xda, 0xb6, 0xc4, 0xbd, 0x0e, 0x0f, 0xa7, 0x71, 0x5f, 0x22, 0x35, 0x75, 0x58,
-	0x0a, 0x7c, 0x62, 0xd3, 0x3e, 0x25, 0x1c, 0xcd, 0xff, 0xc7, 0xd4, 0x8c, 0xbf, 0x6b, 0x50, 0xb0,
-	0x88, 0xe0, 0xa3, 0x36, 0x73, 0xa9, 0x3d, 0x42, 0x4f, 0xe1, 0x16, 0x97, 0xdb, 0xae, 0x9c, 0x93,
-	0xbb, 0xac, 0xdf, 0x57, 0x5d, 0xdf, 0xb9, 0xe6, 0x59, 0x8a, 0xa7, 0xe9, 0x53, 0x21, 0x47, 0xca,
-	0xc1, 0xc8, 0x2a, 0x46, 0xce, 0x52, 0xda, 0xea, 0xf7, 0x91, 0x05, 0x05, 0x2f, 0x1c, 0x76, 0xa5,
-	0x8c, 0xaa, 0x84, 0x0b, 0xd5, 0x87, 0x53, 0xad, 0x8f, 0x6f, 0x90, 0x98, 0x3a, 0xb7, 0xff, 0xfd,
-	0xab, 0xff, 0xfe, 0x72, 0x71, 0x19, 0x0a, 0x43, 0xfc, 0x2a, 0x71, 0xb4, 0xc0, 0x0b, 0x87, 0x56,
-	0xbc, 0xbe, 0x81, 0xca, 0xa9, 0x34, 0x8c, 0x7f, 0x69, 0xa0, 0x5b, 0x64, 0xc8, 0x04, 0x49, 0xf5,
-	0xc9, 0x84, 0x7c, 0x32, 0xd9, 0xab, 0xac, 0xae, 0x79, 0xa8, 0x9a, 0x42, 0xf8, 0x67, 0x9c, 0xa6,
-	0x78, 0x9c, 0x3b, 0x8f, 0x45, 0x68, 0x03, 0xb2, 0xc1, 0x39, 0xae, 0x7e, 0xfd, 0x1b, 0x57, 0x8f,
-	0xbd, 0x12, 0xa3, 0x3a, 0xc4, 0x35, 0xe8, 0xfa, 0x11, 0x12, 0x35, 0xae, 0x5c, 0xc3, 0xc0, 0x14,
-	0x64, 0xab, 0xc0, 0x2f, 0x37, 0xb5, 0xaf, 0xc8, 0x2c, 0x1f, 0x81, 0x31, 0x2b, 0xcb, 0xc9, 0xac,
-	0x8c, 0xbf, 0x6a, 0xb0, 0x72, 0x10, 0x8c, 0x3c, 0x3b, 0x95, 0xe9, 0x13, 0x58, 0x8c, 0x46, 0x19,
-	0x95, 0xe6, 0xe6, 0xec, 0xe8, 0x97, 0x0e, 0xcd, 0x39, 0x2b, 0x76, 0x40, 0xdf, 0x93, 0x23, 0xb9,
-	0x8c, 0xa0, 0xba, 0xf5, 0xf8, 0x3a, 0xe0, 0x93, 0x28, 0x9a, 0x73, 0x96, 0xf2, 0xab, 0xed, 0x4a,
-	0xe8, 0xdb, 0xb0, 0x35, 0x0d, 0xfd, 0x0a, 0xca, 0x19, 0x6c, 0xfc, 0x9d, 0x06, 0x2b, 0x1d, 0x8e,
-	0xbd, 0xc0, 0x67, 0x5c, 0x9c, 0x32, 0xfb, 0x25, 0x11, 0xe8, 0x41, 0x7a, 0x0a, 0xbe, 0xac, 0x77,
-	0x3c, 0x0e, 0x7f, 0x13, 0x8a, 0x72, 0x08, 0x70, 0xba, 0x31, 0x3e, 0x55, 0xed, 0x3b, 0x53, 0x14,
-	0x3b, 0xf0, 0x46, 0xf2, 0x80, 0x45, 0xb6, 0x87, 0x91, 0xe9, 0x0d, 0x38, 0xaf, 0x20, 0x30, 0x97,
-	0xa1, 0x10, 0x7f, 0x3e, 0x19, 0x38, 0x32, 0xfa, 0xbc, 0x95, 0x8d, 0x45, 0xc6, 0x9f, 0x34, 0x28,
-	0x25, 0x97, 0x39, 0xc7, 0xb6, 0xbc, 0x8d, 0xb0, 0xdb, 0x8e, 0x7f, 0x22, 0xa2, 0xd6, 0xec, 0x47,
-	0x67, 0xf3, 0xca, 0x6f, 0x8d, 0x29, 0xc7, 0x6b, 0x9f, 0x9e, 0x8d, 0x19, 0x4f, 0xcf, 0xc4, 0x8b,
-	0xf3, 0x35, 0x99, 0xd5, 0x87, 0xb0, 0x77, 0xfd, 0x8b, 0x73, 0x35, 0x96, 0x71, 0x06, 0xc5, 0x43,
-	0xe6, 0x09, 0xce, 0xdc, 0xb6, 0x8b, 0x3d, 0x82, 0xd6, 0x01, 0xa8, 0x43, 0x3c, 0x11, 0xf5, 0x45,
-	0x4d, 0x30, 0x29, 0xc9, 0x0d, 0xbf, 0x65, 0xd2, 0x9f, 0xd9, 0xdb, 0x85, 0x15, 0x8b, 0x85, 0x82,
-	0x7a, 0x83, 0x36, 0xa7, 0x8c, 0xcb, 0xa9, 0xba, 0x00, 0xb9, 0x7a, 0xe3, 0xe8, 0xe0, 0xec, 0x59,
-	0x47, 0x9f, 0x43, 0x79, 0x58, 0x68, 0x1e, 0x7f, 0xdc, 0xd4, 0xb5, 0xbd, 0x5f, 0xc8, 0xd1, 0x81,
-	0xfc, 0x38, 0x24, 0x81, 0x78, 0x4e, 0xc4, 0x39, 0x73, 0xd0, 0x5d, 0x40, 0xcf, 0x1b, 0x9d, 0x66,
-	0xab, 0xde, 0x3d, 0x3b, 0x39, 0x6d, 0x37, 0x0e, 0x8f, 0x8f, 0x8e, 0x1b, 0x75, 0x7d, 0x0e, 0xe5,
-	0x60, 0xfe, 0xe3, 0x46, 0x47, 0xd7, 0x22, 0xe7, 0xc6, 0x41, 0x5d, 0xcf, 0xc8, 0x55, 0xbb, 0x75,
-	0xda, 0xd1, 0xe7, 0xa5, 0xb2, 0x7d, 0xd6, 0xd1, 0x17, 0x10, 0x40, 0xb6, 0xde, 0x78, 0xd6, 0xe8,
-	0x34, 0xf4, 0x45, 0x19, 0xf2, 0xb0, 0x75, 0x72, 0xd2, 0x38, 0xec, 0xe8, 0x59, 0xb9, 0x69, 0xb5,
-	0x3b, 0xc7, 0xad, 0x93, 0x53, 0x3d, 0x87, 0x96, 0x60, 0xb1, 0x63, 0x1d, 0x1c, 0x36, 0xf4, 0xbc,
-	0x5c, 0xb6, 0x0f, 0x3a, 0x87, 0x4d, 0x7d, 0x69, 0xef, 0x3b, 0xa0, 0x77, 0x38, 0xee, 0xf7, 0xa9,
-	0x5d, 0xa7, 0x9c, 0x44, 0x95, 0x42, 0x2b, 0x50, 0x98, 0x84, 0x51, 0x80, 0xdc, 0xf1, 0x89, 0xd9,
-	0x3a, 0x3b, 0xa9, 0xeb, 0x1a, 0x2a, 0x42, 0xbe, 0x75, 0xd6, 0x89, 0x77, 0x19, 0xf3, 0x33, 0xed,
-	0xb7, 0x9f, 0xaf, 0x6b, 0x7f, 0x7c, 0xfd, 0xe6, 0x6f, 0xd9, 0x8c, 0x9e, 0x01, 0x83, 0xb2, 0xb8,
-	0xd7, 0x3e, 0x67, 0xaf, 0x46, 0x33, 0xcf, 0x92, 0xb9, 0x64, 0xe2, 0x80, 0xb4, 0x25, 0x53, 0xdb,
-	0xda, 0x8b, 0xa7, 0xef, 0xf7, 0x17, 0x8a, 0xff, 0x72, 0xf0, 0xee, 0xbf, 0x51, 0x7a, 0xd9, 0x88,
-	0xff, 0xfb, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x67, 0xbc, 0x34, 0x02, 0x98, 0x11, 0x00, 0x00,
+	// 1861 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xcf, 0x6f, 0x23, 0x49,
+	0xf5, 0x4f, 0x3b, 0x89, 0xed, 0x3c, 0x3b, 0x49, 0x6f, 0xcd, 0x7c, 0x67, 0x3c, 0x99, 0xc9, 0xaf,
+	0xce, 0x64, 0xbe, 0x21, 0xec, 0xda, 0x22, 0x11, 0x68, 0xf0, 0x22, 0x20, 0x1d, 0x3b, 0xeb, 0x30,
+	0x33, 0xb1, 0xd5, 0x71, 0xf6, 0x30, 0x08, 0x59, 0xe5, 0x76, 0xd9, 0x29, 0xa6, 0xdd, 0xd5, 0x54,
+	0x57, 0x67, 0xc7, 0x7b, 0x61, 0x4e, 0x08, 0xf6, 0xc2, 0x15, 0x71, 0xe6, 0xc0, 0x91, 0x13, 0x42,
+	0x5c, 0xe0, 0x82, 0x34, 0x1c, 0x91, 0xf8, 0x07, 0xd8, 0x03, 0xe2, 0xcc, 0x09, 0xcd, 0x01, 0xa1,
+	0xea, 0xae, 0x76, 0xda, 0xbf, 0x92, 0xd1, 0xde, 0xaa, 0xde, 0xfb, 0xbc, 0x7e, 0x9f, 0xf7, 0xa3,
+	0xaa, 0x9e, 0x0d, 0x56, 0x8f, 0x8a, 0xcb, 0xa0, 0x5d, 0xb4, 0x59, 0xbf, 0xe4, 0x33, 0x87, 0x7d,
+	0x44, 0x59, 0xa9, 0xe7, 0x30, 0x56, 0xf2, 0x38, 0xfb, 0x31, 0xb1, 0x85, 0x1f, 0xed, 0xb0, 0x47,
+	0x4b, 0xe4, 0xb5, 0x20, 0xdc, 0xc5, 0x4e, 0x89, 0xb8, 0x57, 0x6c, 0x50, 0xb2, 0x99, 0xdb, 0xa5,
+	0xbd, 0x92, 0xcd, 0x38, 0x29, 0x5d, 0x1d, 0x96, 0xda, 0xd8, 0x27, 0x45, 0x8f, 0x33, 0xc1, 0xd0,
+	0xdd, 0x10, 0x50, 0x8c, 0x00, 0x45, 0x09, 0x28, 0x5e, 0x1d, 0xae, 0x19, 0x53, 0xcd, 0x70, 0xa7,
+	0xc3, 0x89, 0xef, 0x47, 0x96, 0x33, 0x30, 0x6d, 0x6c, 0xbf, 0x62, 0xdd, 0xae, 0xc2, 0xec, 0x4c,
+	0xc5, 0x5c, 0x0a, 0xe1, 0xb5, 0x02, 0x4e, 0x15, 0xe8, 0x61, 0x04, 0x12, 0x03, 0x2f, 0xd4, 0x7a,
+	0x84, 0xdb, 0xc4, 0x15, 0x4a, 0xf9, 0x78, 0x54, 0xe9, 0x93, 0x3e, 0x76, 0x05, 0xb5, 0x5b, 0x57,
+	0x84, 0xfb, 0x94, 0xb9, 0x0a, 0xf5, 0xa0, 0xc7, 0x58, 0xcf, 0x21, 0xa5, 0x70, 0xd7, 0x0e, 0xba,
+	0x25, 0xec, 0x0e, 0x94, 0x6a, 0x63, 0x5c, 0xd5, 0x09, 0x38, 0x16, 0xd7, 0xa6, 0x8f, 0xc6, 0xf5,
+	0xbe, 0xe0, 0x81, 0x2d, 0x66, 0x59, 0x7f, 0xc6, 0xb1, 0xe7, 0x11, 0x1e, 0x27, 0x61, 0x23, 0xe8,
+	0x78, 0xb8, 0x84, 0x5d, 0x97, 0x89, 0xf0, 0xa3, 0x7e, 0xa9, 0x4f, 0x7b, 0x1c, 0x0b, 0x95, 0xde,
+	0xb5, 0xf5, 0x09, 0xbd, 0x2f, 0xb0, 0x08, 0x62, 0xf3, 0xed, 0x09, 0xb5, 0x8a, 0x8b, 0xba, 0x3d,
+	0x05, 0xb9, 0x7f, 0x85, 0x1d, 0xda, 0xc1, 0x82, 0x94, 0xe2, 0x85, 0x52, 0xdc, 0xed, 0xb1, 0x1e,
+	0x0b, 0x97, 0x25, 0xb9, 0x8a, 0xa4, 0x86, 0x80, 0xec, 0x73, 0x66, 0x63, 0x87, 0x8a, 0x01, 0xba,
+	0x07, 0x69, 0x4e, 0x7a, 0x94, 0xb9, 0x05, 0x6d, 0x4b, 0xdb, 0x5b, 0xb2, 0xd4, 0x0e, 0x21, 0x58,
+	0xf8, 0x9c, 0xb9, 0xa4, 0x90, 0x0a, 0xa5, 0xe1, 0x1a, 0x3d, 0x80, 0xac, 0x1f, 0xb4, 0x5b, 0xa1,
+	0x7c, 0x3e, 0x94, 0x67, 0xfc, 0xa0, 0xfd, 0x92, 0xb9, 0xa4, 0xbc, 0xfd, 0xeb, 0xbf, 0xfc, 0x7c,
+	0xe3, 0x11, 0xac, 0x45, 0x9d, 0x82, 0x3d, 0x5a, 0xbc, 0x3a, 0x88, 0x3a, 0x25, 0xf6, 0x64, 0xfc,
+	0x46, 0x83, 0xbc, 0x19, 0x50, 0xa7, 0xf3, 0x69, 0x44, 0x1f, 0x3d, 0x85, 0x8c, 0x8a, 0x24, 0xf4,
+	0x9d, 0x3b, 0xd8, 0x28, 0x46, 0xe6, 0xb2, 0x90, 0xc5, 0xab, 0xc3, 0xe2, 0xb9, 0x2a, 0xa4, 0x32,
+	0xb0, 0x62, 0x38, 0x3a, 0x84, 0x6c, 0x9f, 0x08, 0xdc, 0xc1, 0x02, 0x87, 0x04, 0x73, 0x07, 0xf7,
+	0x8b, 0x51, 0x11, 0x8a, 0x71, 0x11, 0x8a, 0xe7, 0x61, 0x89, 0xac, 0x21, 0xb0, 0xbc, 0x2b, 0x29,
+	0x6e, 0xc1, 0xc6, 0x24, 0xc5, 0x24, 0x2b, 0xe3, 0x4b, 0x0d, 0x96, 0xaa, 0xaf, 0x05, 0x71, 0x7d,
+	0x95, 0x06, 0x17, 0xf7, 0x89, 0x4a, 0x4e, 0xb8, 0x46, 0x6b, 0x90, 0xb5, 0xb1, 0x20, 0x3d, 0xc6,
+	0x07, 0x2a, 0x3d, 0xc3, 0x3d, 0xfa, 0x7f, 0x58, 0x95, 0xec, 0x5b, 0x1d, 0xe2, 0xdb, 0x9c, 0x7a,
+	0x82, 0x71, 0x95, 0xa9, 0x15, 0x29, 0xae, 0x0c, 0xa5, 0xe8, 0x3b, 0xd7, 0xc1, 0x2f, 0x84, 0x11,
+	0x18, 0xc5, 0x69, 0xa7, 0x6c, 0x84, 0xdb, 0x75, 0x02, 0xd6, 0x20, 0xdb, 0xa1, 0x3e, 0x6e, 0x3b,
+	0xa4, 0x53, 0x58, 0xdc, 0xd2, 0xf6, 0xb2, 0xd6, 0x70, 0x5f, 0x36, 0x64, 0x9c, 0xeb, 0xf0, 0x70,
+	0x32, 0xce, 0x61, 0x58, 0xc6, 0x3f, 0x16, 0x60, 0xe1, 0x8c, 0x75, 0x08, 0x5a, 0x81, 0x14, 0xed,
+	0xa8, 0xe8, 0x52, 0xb4, 0x83, 0x0a, 0x90, 0xb1, 0x9d, 0xc0, 0x17, 0x84, 0xab, 0xd0, 0xe2, 0xed,
+	0x48, 0xce, 0xe7, 0xdf, 0x33, 0xe7, 0xa8, 0x0c, 0x59, 0x47, 0xd5, 0xbf, 0xb0, 0x30, 0x52, 0xe3,
+	0xb1, 0x30, 0xe3, 0x2e, 0xb1, 0x86, 0x78, 0xf4, 0x04, 0x56, 0x03, 0x9f, 0xf0, 0x16, 0xee, 0x11,
+	0x57, 0xb4, 0xc2, 0x2a, 0xa4, 0x43, 0x4a, 0xcb, 0x52, 0x7c, 0x24, 0xa5, 0x67, 0xb2, 0x1c, 0x45,
+	0x40, 0x09, 0x5c, 0x9c, 0xd4, 0x8c, 0x84, 0xd6, 0xe6, 0x2c, 0x7d, 0x08, 0x8e, 0xdb, 0xee, 0x47,
+	0x50, 0x48, 0xe0, 0xdb, 0x32, 0xbf, 0x43, 0xab, 0xec, 0xfb, 0x96, 0xa2, 0x36, 0x67, 0xfd, 0xdf,
+	0xf0, 0xcb, 0x23, 0x5d, 0xfd, 0x3d, 0x00, 0x12, 0xe7, 0xd9, 0x2f, 0x2c, 0x6d, 0xcd, 0xef, 0xe5,
+	0x0e, 0x36, 0xa7, 0x7f, 0x70, 0x58, 0x0f, 0x2b, 0x61, 0x22, 0x5b, 0xc8, 0x76, 0xa8, 0xe4, 0xd6,
+	0x25, 0x58, 0x04, 0x9c, 0xf8, 0x05, 0xd8, 0x9a, 0x97, 0x2d, 0x14, 0x89, 0x4f, 0x94, 0x14, 0x9d,
+	0xc1, 0x1d, 0x87, 0xfa, 0x82, 0xc8, 0x8b, 0xa0, 0xa5, 0xee, 0x5d, 0xe2, 0x17, 0x72, 0xa1, 0xcb,
+	0xf5, 0xe9, 0x2e, 0x8f, 0x22, 0x98, 0x85, 0x86, 0x96, 0x47, 0xb1, 0x61, 0x79, 0x5d, 0x36, 0x4e,
+	0x01, 0xee, 0x4d, 0x36, 0x8e, 0x6c, 0x15, 0xf3, 0x01, 0xdc, 0x9f, 0xcc, 0x73, 0x4b, 0xb6, 0xf5,
+	0x0f, 0x16, 0xb2, 0x8b, 0x7a, 0xda, 0x5a, 0x1e, 0xc9, 0xa5, 0xf1, 0x4f, 0x0d, 0xb2, 0x2f, 0xe2,
+	0x46, 0xf8, 0x21, 0xac, 0x76, 0xa9, 0x23, 0x08, 0x6f, 0x0d, 0x9b, 0x48, 0x0b, 0x79, 0x1e, 0x4c,
+	0xe7, 0x19, 0x1b, 0x16, 0x4f, 0x42, 0xab, 0x78, 0x5b, 0x75, 0x05, 0x1f, 0x58, 0x2b, 0xdd, 0x11,
+	0xe1, 0xda, 0x4b, 0xb8, 0x33, 0x05, 0x86, 0x74, 0x98, 0x7f, 0x45, 0x06, 0xaa, 0xb9, 0xe5, 0x12,
+	0x7d, 0x04, 0x8b, 0x57, 0xd8, 0x09, 0xc8, 0x6d, 0x97, 0x46, 0x84, 0x2a, 0xa7, 0x9e, 0x6a, 0x37,
+	0x5c, 0x6c, 0xb1, 0x33, 0xe3, 0x67, 0x1a, 0x2c, 0x5b, 0x81, 0x2b, 0x68, 0x9f, 0x5c, 0x9c, 0xba,
+	0xe2, 0xf0, 0x00, 0xed, 0xc0, 0x72, 0x87, 0x74, 0x71, 0xe0, 0x88, 0xd6, 0xb5, 0xbf, 0x65, 0x2b,
+	0xaf, 0x84, 0x9f, 0x4a, 0x19, 0xda, 0x83, 0x1c, 0x8f, 0xac, 0x5a, 0x92, 0x66, 0x78, 0x4d, 0x98,
+	0x99, 0x77, 0xe6, 0x02, 0x4f, 0x6d, 0x69, 0x16, 0x28, 0xdd, 0x33, 0x32, 0x28, 0x3f, 0x91, 0x1c,
+	0xb6, 0x61, 0x73, 0x92, 0xc3, 0x88, 0x5b, 0xe3, 0x33, 0x58, 0x51, 0x82, 0x46, 0xf4, 0x3e, 0xa2,
+	0x8f, 0xc7, 0x89, 0x44, 0x17, 0xed, 0xbd, 0xb1, 0x8b, 0x56, 0xc1, 0x6f, 0x26, 0x98, 0x9a, 0x49,
+	0x30, 0x99, 0x81, 0x0a, 0x0b, 0xda, 0x0e, 0x41, 0x3b, 0xd3, 0x1c, 0x6b, 0x5f, 0xd5, 0xc1, 0xed,
+	0x19, 0x88, 0xdc, 0x1a, 0xbf, 0xd7, 0x00, 0x29, 0x89, 0x3a, 0x26, 0x27, 0x0e, 0xee, 0xa1, 0xd3,
+	0xe9, 0x69, 0x58, 0x9b, 0xa8, 0xbf, 0xc9, 0x98, 0x13, 0x72, 0x33, 0xb3, 0xef, 0xcc, 0xc5, 0x2f,
+	0xb4, 0x94, 0xfe, 0xd5, 0x39, 0x7f, 0x5d, 0x72, 0x7e, 0x02, 0x8f, 0x67, 0x72, 0x4e, 0x30, 0x34,
+	0x7e, 0x0a, 0xb9, 0x1a, 0xc1, 0x1d, 0xc2, 0x23, 0x2f, 0x3b, 0x89, 0xd6, 0x35, 0x3f, 0x78, 0x67,
+	0xae, 0xf0, 0xfc, 0x96, 0xb6, 0xf7, 0xe6, 0x8d, 0xf6, 0x67, 0x4d, 0x7b, 0xab, 0xcd, 0x45, 0xdd,
+	0xbc, 0x9b, 0xec, 0xe6, 0x25, 0x73, 0xf5, 0x9d, 0x99, 0xe7, 0x10, 0x81, 0x52, 0x12, 0xa4, 0xba,
+	0xf8, 0xb1, 0xe4, 0xb1, 0x09, 0xeb, 0x93, 0x3c, 0x12, 0x1e, 0x8d, 0x3f, 0x68, 0xf0, 0x41, 0x62,
+	0x5f, 0xf7, 0xe4, 0xa8, 0x81, 0x8e, 0x21, 0x7d, 0x19, 0x0a, 0x55, 0xc6, 0xb6, 0xa7, 0x9f, 0xd6,
+	0x84, 0x61, 0x22, 0x71, 0xca, 0x14, 0x1d, 0x40, 0x5a, 0xce, 0x43, 0x6e, 0xa7, 0x90, 0xba, 0x2d,
+	0xed, 0x96, 0x42, 0x96, 0xf7, 0x25, 0xe9, 0x5d, 0xd8, 0xb9, 0x91, 0x74, 0x44, 0xd2, 0x70, 0x60,
+	0x29, 0x12, 0xbe, 0xc0, 0x1e, 0xfa, 0x18, 0x32, 0x91, 0x5b, 0x5f, 0x5d, 0x30, 0xb7, 0x53, 0xb6,
+	0x62, 0x8b, 0x1b, 0x9e, 0xce, 0xa1, 0x03, 0xe3, 0xad, 0x06, 0x50, 0xc1, 0x02, 0x9f, 0xb3, 0x80,
+	0xdb, 0x04, 0xed, 0x42, 0xb6, 0x4b, 0x1d, 0x72, 0x3d, 0x24, 0x0c, 0x9b, 0xa1, 0x36, 0x67, 0x0d,
+	0x55, 0xe8, 0x43, 0xc8, 0x53, 0xd7, 0xa1, 0x2e, 0x69, 0xb5, 0x07, 0x82, 0xf8, 0x61, 0x26, 0xf2,
+	0x21, 0xf4, 0xf3, 0x94, 0x2e, 0xa1, 0xb9, 0x48, 0x6d, 0x4a, 0x2d, 0x2a, 0xc2, 0xb2, 0x42, 0xfb,
+	0x82, 0x53, 0xb7, 0x37, 0x76, 0x39, 0xd4, 0xe6, 0x2c, 0xf5, 0xb5, 0xf3, 0x50, 0x5d, 0xde, 0x91,
+	0xbc, 0x37, 0xe0, 0xd1, 0x24, 0xef, 0x6b, 0xa6, 0xa6, 0x0e, 0x4b, 0xbe, 0x47, 0x6c, 0xda, 0xa5,
+	0x84, 0xa3, 0xf9, 0xff, 0x98, 0x9a, 0xf1, 0x77, 0x0d, 0x72, 0x16, 0x11, 0x7c, 0xd0, 0x60, 0x0e,
+	0xb5, 0x07, 0xe8, 0x19, 0xac, 0x70, 0xb9, 0x6d, 0xc9, 0x01, 0xbd, 0xc5, 0xba, 0x5d, 0x55, 0xf5,
+	0xdd, 0x19, 0xef, 0x61, 0x34, 0xc6, 0x9f, 0x0b, 0x2e, 0xa7, 0x9f, 0x81, 0x95, 0x0f, 0x8d, 0xa5,
+	0xb4, 0xde, 0xed, 0x22, 0x0b, 0x72, 0x6e, 0xd0, 0x6f, 0x49, 0x19, 0x55, 0x01, 0xe7, 0x0e, 0x1e,
+	0x4d, 0x94, 0x3e, 0xba, 0xba, 0xa2, 0xd6, 0xb9, 0xf3, 0xef, 0x5f, 0xfd, 0xf7, 0x97, 0x8b, 0xcb,
+	0x90, 0xeb, 0xe3, 0xd7, 0xb1, 0xa1, 0x05, 0x6e, 0xd0, 0xb7, 0xa2, 0xf5, 0x0d, 0xad, 0x9c, 0x08,
+	0xc3, 0xf8, 0x97, 0x06, 0xba, 0x45, 0xfa, 0x4c, 0x90, 0x44, 0x9d, 0x4c, 0xc8, 0xc6, 0x3f, 0x29,
+	0x54, 0x54, 0x33, 0x5e, 0xc8, 0x9a, 0x10, 0xde, 0x05, 0xa7, 0x89, 0x3e, 0xce, 0x5c, 0x46, 0x22,
+	0xb4, 0x09, 0x69, 0xff, 0x12, 0x1f, 0x7c, 0xf3, 0x5b, 0xe3, 0xc7, 0x5e, 0x89, 0x51, 0x05, 0xa2,
+	0x1c, 0xb4, 0xbc, 0x90, 0x89, 0x9a, 0x93, 0x66, 0x74, 0x60, 0x82, 0xb2, 0x95, 0xe3, 0xd7, 0x9b,
+	0xf2, 0xd7, 0x64, 0x94, 0x8f, 0xc1, 0x98, 0x16, 0xe5, 0x68, 0x54, 0xc6, 0x5f, 0x35, 0x58, 0x3d,
+	0xf2, 0x07, 0xae, 0x9d, 0x88, 0xf4, 0x29, 0x2c, 0x86, 0x33, 0x94, 0x0a, 0x73, 0x6b, 0xba, 0xf7,
+	0x6b, 0x83, 0xda, 0x9c, 0x15, 0x19, 0xa0, 0xef, 0xcb, 0xdf, 0x02, 0xd2, 0x83, 0xaa, 0xd6, 0x93,
+	0x59, 0xc4, 0x47, 0x59, 0xd4, 0xe6, 0x2c, 0x65, 0x57, 0xde, 0x93, 0xd4, 0x77, 0x60, 0x7b, 0x92,
+	0xfa, 0x18, 0xcb, 0x29, 0xdd, 0xf8, 0x3b, 0x0d, 0x56, 0x9b, 0x1c, 0xbb, 0xbe, 0xc7, 0xb8, 0x38,
+	0x67, 0xf6, 0x2b, 0x22, 0xd0, 0xc3, 0xe4, 0xf8, 0x7d, 0x9d, 0xef, 0x50, 0x88, 0xbe, 0x0d, 0x79,
+	0xf9, 0x80, 0x75, 0x5a, 0x11, 0x3f, 0x95, 0xed, 0xbb, 0x13, 0x2d, 0x76, 0xe4, 0x0e, 0xe4, 0x01,
+	0x0b, 0xb1, 0xc7, 0x21, 0xf4, 0x06, 0x9e, 0x63, 0x0c, 0xcc, 0x65, 0xc8, 0x45, 0x9f, 0x8f, 0x27,
+	0x9d, 0x94, 0x3e, 0x6f, 0xa5, 0x23, 0x91, 0xf1, 0x27, 0x0d, 0x0a, 0xf1, 0x65, 0xce, 0xb1, 0x2d,
+	0x6f, 0x23, 0xec, 0xc4, 0x6f, 0x6f, 0x7d, 0xfa, 0xa3, 0xb3, 0x35, 0xf6, 0xf6, 0x4e, 0x18, 0xce,
+	0x7c, 0x7a, 0x36, 0xa7, 0x3c, 0x3d, 0x23, 0x2f, 0xce, 0x37, 0x64, 0x54, 0x1f, 0xc2, 0xfe, 0xec,
+	0x17, 0x67, 0xdc, 0x97, 0x71, 0x01, 0xf9, 0x63, 0xe6, 0x0a, 0xce, 0x9c, 0x86, 0x83, 0x5d, 0x82,
+	0x36, 0x00, 0x68, 0x87, 0xb8, 0x22, 0xac, 0x8b, 0x1a, 0x9d, 0x12, 0x92, 0x1b, 0x7e, 0x44, 0x25,
+	0x3f, 0xb3, 0xbf, 0x07, 0xab, 0x16, 0x0b, 0x04, 0x75, 0x7b, 0x0d, 0x4e, 0x19, 0x97, 0xe3, 0x7c,
+	0x0e, 0x32, 0x95, 0xea, 0xc9, 0xd1, 0xc5, 0xf3, 0xa6, 0x3e, 0x87, 0xb2, 0xb0, 0x50, 0x3b, 0xfd,
+	0xa4, 0xa6, 0x6b, 0xfb, 0xbf, 0x90, 0xa3, 0x03, 0xf9, 0x49, 0x40, 0x7c, 0xf1, 0x82, 0x88, 0x4b,
+	0xd6, 0x41, 0xf7, 0x00, 0xbd, 0xa8, 0x36, 0x6b, 0xf5, 0x4a, 0xeb, 0xe2, 0xec, 0xbc, 0x51, 0x3d,
+	0x3e, 0x3d, 0x39, 0xad, 0x56, 0xf4, 0x39, 0x94, 0x81, 0xf9, 0x4f, 0xaa, 0x4d, 0x5d, 0x0b, 0x8d,
+	0xab, 0x47, 0x15, 0x3d, 0x25, 0x57, 0x8d, 0xfa, 0x79, 0x53, 0x9f, 0x97, 0xca, 0xc6, 0x45, 0x53,
+	0x5f, 0x40, 0x00, 0xe9, 0x4a, 0xf5, 0x79, 0xb5, 0x59, 0xd5, 0x17, 0xa5, 0xcb, 0xe3, 0xfa, 0xd9,
+	0x59, 0xf5, 0xb8, 0xa9, 0xa7, 0xe5, 0xa6, 0xde, 0x68, 0x9e, 0xd6, 0xcf, 0xce, 0xf5, 0x0c, 0x5a,
+	0x82, 0xc5, 0xa6, 0x75, 0x74, 0x5c, 0xd5, 0xb3, 0x72, 0xd9, 0x38, 0x6a, 0x1e, 0xd7, 0xf4, 0xa5,
+	0xfd, 0xef, 0x82, 0xde, 0xe4, 0xb8, 0xdb, 0xa5, 0x76, 0x85, 0x72, 0x12, 0x66, 0x0a, 0xad, 0x42,
+	0x6e, 0x94, 0x46, 0x0e, 0x32, 0xa7, 0x67, 0x66, 0xfd, 0xe2, 0xac, 0xa2, 0x6b, 0x28, 0x0f, 0xd9,
+	0xfa, 0x45, 0x33, 0xda, 0xa5, 0xcc, 0x2f, 0xb4, 0xdf, 0x7e, 0xb9, 0xa1, 0xfd, 0xf1, 0xcd, 0xdb,
+	0xbf, 0xa5, 0x53, 0x7a, 0x0a, 0x0c, 0xca, 0xa2, 0x5a, 0x7b, 0x9c, 0xbd, 0x1e, 0x4c, 0x3d, 0x4b,
+	0xe6, 0x92, 0x89, 0x7d, 0xd2, 0x90, 0x9d, 0xda, 0xd0, 0x5e, 0x3e, 0x7b, 0xbf, 0xff, 0x6e, 0xbc,
+	0x57, 0xbd, 0xdb, 0xff, 0xbf, 0x69, 0xa7, 0xc3, 0xfe, 0x3f, 0xfc, 0xdf, 0x00, 0x2c, 0x67, 0x26,
+	0x8f, 0x11, 0x12, 0x00, 0x00,
 }
 
 func (this *Locality) Equal(that interface{}) bool {
@@ -1772,6 +1824,36 @@ func (this *RuntimeUInt32) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RuntimePercent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RuntimePercent)
+	if !ok {
+		that2, ok := that.(RuntimePercent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DefaultValue.Equal(that1.DefaultValue) {
+		return false
+	}
+	if this.RuntimeKey != that1.RuntimeKey {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *RuntimeDouble) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -0,0 +1,532 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/http/adaptive_concurrency/v3/adaptive_concurrency.proto
+
+package v3
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	v31 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/v3"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Configuration parameters for the gradient controller.
+type GradientControllerConfig struct {
+	// The percentile to use when summarizing aggregated samples. Defaults to p50.
+	SampleAggregatePercentile *v3.Percent                                                 `protobuf:"bytes,1,opt,name=sample_aggregate_percentile,json=sampleAggregatePercentile,proto3" json:"sample_aggregate_percentile,omitempty"`
+	ConcurrencyLimitParams    *GradientControllerConfig_ConcurrencyLimitCalculationParams `protobuf:"bytes,2,opt,name=concurrency_limit_params,json=concurrencyLimitParams,proto3" json:"concurrency_limit_params,omitempty"`
+	MinRttCalcParams          *GradientControllerConfig_MinimumRTTCalculationParams       `protobuf:"bytes,3,opt,name=min_rtt_calc_params,json=minRttCalcParams,proto3" json:"min_rtt_calc_params,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}                                                    `json:"-"`
+	XXX_unrecognized          []byte                                                      `json:"-"`
+	XXX_sizecache             int32                                                       `json:"-"`
+}
+
+func (m *GradientControllerConfig) Reset()         { *m = GradientControllerConfig{} }
+func (m *GradientControllerConfig) String() string { return proto.CompactTextString(m) }
+func (*GradientControllerConfig) ProtoMessage()    {}
+func (*GradientControllerConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26f9414c57235436, []int{0}
+}
+func (m *GradientControllerConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GradientControllerConfig.Unmarshal(m, b)
+}
+func (m *GradientControllerConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GradientControllerConfig.Marshal(b, m, deterministic)
+}
+func (m *GradientControllerConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GradientControllerConfig.Merge(m, src)
+}
+func (m *GradientControllerConfig) XXX_Size() int {
+	return xxx_messageInfo_GradientControllerConfig.Size(m)
+}
+func (m *GradientControllerConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_GradientControllerConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GradientControllerConfig proto.InternalMessageInfo
+
+func (m *GradientControllerConfig) GetSampleAggregatePercentile() *v3.Percent {
+	if m != nil {
+		return m.SampleAggregatePercentile
+	}
+	return nil
+}
+
+func (m *GradientControllerConfig) GetConcurrencyLimitParams() *GradientControllerConfig_ConcurrencyLimitCalculationParams {
+	if m != nil {
+		return m.ConcurrencyLimitParams
+	}
+	return nil
+}
+
+func (m *GradientControllerConfig) GetMinRttCalcParams() *GradientControllerConfig_MinimumRTTCalculationParams {
+	if m != nil {
+		return m.MinRttCalcParams
+	}
+	return nil
+}
+
+// Parameters controlling the periodic recalculation of the concurrency limit from sampled request
+// latencies.
+type GradientControllerConfig_ConcurrencyLimitCalculationParams struct {
+	// The allowed upper-bound on the calculated concurrency limit. Defaults to 1000.
+	MaxConcurrencyLimit *types.UInt32Value `protobuf:"bytes,2,opt,name=max_concurrency_limit,json=maxConcurrencyLimit,proto3" json:"max_concurrency_limit,omitempty"`
+	// The period of time samples are taken to recalculate the concurrency limit.
+	ConcurrencyUpdateInterval *types.Duration `protobuf:"bytes,3,opt,name=concurrency_update_interval,json=concurrencyUpdateInterval,proto3" json:"concurrency_update_interval,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}        `json:"-"`
+	XXX_unrecognized          []byte          `json:"-"`
+	XXX_sizecache             int32           `json:"-"`
+}
+
+func (m *GradientControllerConfig_ConcurrencyLimitCalculationParams) Reset() {
+	*m = GradientControllerConfig_ConcurrencyLimitCalculationParams{}
+}
+func (m *GradientControllerConfig_ConcurrencyLimitCalculationParams) String() string {
+	return proto.CompactTextString(m)
+}
+func (*GradientControllerConfig_ConcurrencyLimitCalculationParams) ProtoMessage() {}
+func (*GradientControllerConfig_ConcurrencyLimitCalculationParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26f9414c57235436, []int{0, 0}
+}
+func (m *GradientControllerConfig_ConcurrencyLimitCalculationParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GradientControllerConfig_ConcurrencyLimitCalculationParams.Unmarshal(m, b)
+}
+func (m *GradientControllerConfig_ConcurrencyLimitCalculationParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GradientControllerConfig_ConcurrencyLimitCalculationParams.Marshal(b, m, deterministic)
+}
+func (m *GradientControllerConfig_ConcurrencyLimitCalculationParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GradientControllerConfig_ConcurrencyLimitCalculationParams.Merge(m, src)
+}
+func (m *GradientControllerConfig_ConcurrencyLimitCalculationParams) XXX_Size() int {
+	return xxx_messageInfo_GradientControllerConfig_ConcurrencyLimitCalculationParams.Size(m)
+}
+func (m *GradientControllerConfig_ConcurrencyLimitCalculationParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_GradientControllerConfig_ConcurrencyLimitCalculationParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GradientControllerConfig_ConcurrencyLimitCalculationParams proto.InternalMessageInfo
+
+func (m *GradientControllerConfig_ConcurrencyLimitCalculationParams) GetMaxConcurrencyLimit() *types.UInt32Value {
+	if m != nil {
+		return m.MaxConcurrencyLimit
+	}
+	return nil
+}
+
+func (m *GradientControllerConfig_ConcurrencyLimitCalculationParams) GetConcurrencyUpdateInterval() *types.Duration {
+	if m != nil {
+		return m.ConcurrencyUpdateInterval
+	}
+	return nil
+}
+
+// Parameters controlling the periodic minRTT recalculation.
+// [#next-free-field: 6]
+type GradientControllerConfig_MinimumRTTCalculationParams struct {
+	// The time interval between recalculating the minimum request round-trip time. Has to be
+	// positive.
+	Interval *types.Duration `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// The number of requests to aggregate/sample during the minRTT recalculation window before
+	// updating. Defaults to 50.
+	RequestCount *types.UInt32Value `protobuf:"bytes,2,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	// Randomized time delta that will be introduced to the start of the minRTT calculation window.
+	// This is represented as a percentage of the interval duration. Defaults to 15%.
+	//
+	// Example: If the interval is 10s and the jitter is 15%, the next window will begin
+	// somewhere in the range (10s - 11.5s).
+	Jitter *v3.Percent `protobuf:"bytes,3,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	// The concurrency limit set while measuring the minRTT. Defaults to 3.
+	MinConcurrency *types.UInt32Value `protobuf:"bytes,4,opt,name=min_concurrency,json=minConcurrency,proto3" json:"min_concurrency,omitempty"`
+	// Amount added to the measured minRTT to add stability to the concurrency limit during natural
+	// variability in latency. This is expressed as a percentage of the measured value and can be
+	// adjusted to allow more or less tolerance to the sampled latency values.
+	//
+	// Defaults to 25%.
+	Buffer               *v3.Percent `protobuf:"bytes,5,opt,name=buffer,proto3" json:"buffer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) Reset() {
+	*m = GradientControllerConfig_MinimumRTTCalculationParams{}
+}
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) String() string {
+	return proto.CompactTextString(m)
+}
+func (*GradientControllerConfig_MinimumRTTCalculationParams) ProtoMessage() {}
+func (*GradientControllerConfig_MinimumRTTCalculationParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26f9414c57235436, []int{0, 1}
+}
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GradientControllerConfig_MinimumRTTCalculationParams.Unmarshal(m, b)
+}
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GradientControllerConfig_MinimumRTTCalculationParams.Marshal(b, m, deterministic)
+}
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GradientControllerConfig_MinimumRTTCalculationParams.Merge(m, src)
+}
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) XXX_Size() int {
+	return xxx_messageInfo_GradientControllerConfig_MinimumRTTCalculationParams.Size(m)
+}
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_GradientControllerConfig_MinimumRTTCalculationParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GradientControllerConfig_MinimumRTTCalculationParams proto.InternalMessageInfo
+
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) GetInterval() *types.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) GetRequestCount() *types.UInt32Value {
+	if m != nil {
+		return m.RequestCount
+	}
+	return nil
+}
+
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) GetJitter() *v3.Percent {
+	if m != nil {
+		return m.Jitter
+	}
+	return nil
+}
+
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) GetMinConcurrency() *types.UInt32Value {
+	if m != nil {
+		return m.MinConcurrency
+	}
+	return nil
+}
+
+func (m *GradientControllerConfig_MinimumRTTCalculationParams) GetBuffer() *v3.Percent {
+	if m != nil {
+		return m.Buffer
+	}
+	return nil
+}
+
+type AdaptiveConcurrency struct {
+	// Types that are valid to be assigned to ConcurrencyControllerConfig:
+	//	*AdaptiveConcurrency_GradientControllerConfig
+	ConcurrencyControllerConfig isAdaptiveConcurrency_ConcurrencyControllerConfig `protobuf_oneof:"concurrency_controller_config"`
+	// If set to false, the adaptive concurrency filter will operate as a pass-through filter. If the
+	// message is unspecified, the filter will be enabled.
+	Enabled              *v31.RuntimeFeatureFlag `protobuf:"bytes,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *AdaptiveConcurrency) Reset()         { *m = AdaptiveConcurrency{} }
+func (m *AdaptiveConcurrency) String() string { return proto.CompactTextString(m) }
+func (*AdaptiveConcurrency) ProtoMessage()    {}
+func (*AdaptiveConcurrency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26f9414c57235436, []int{1}
+}
+func (m *AdaptiveConcurrency) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdaptiveConcurrency.Unmarshal(m, b)
+}
+func (m *AdaptiveConcurrency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdaptiveConcurrency.Marshal(b, m, deterministic)
+}
+func (m *AdaptiveConcurrency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdaptiveConcurrency.Merge(m, src)
+}
+func (m *AdaptiveConcurrency) XXX_Size() int {
+	return xxx_messageInfo_AdaptiveConcurrency.Size(m)
+}
+func (m *AdaptiveConcurrency) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdaptiveConcurrency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdaptiveConcurrency proto.InternalMessageInfo
+
+type isAdaptiveConcurrency_ConcurrencyControllerConfig interface {
+	isAdaptiveConcurrency_ConcurrencyControllerConfig()
+	Equal(interface{}) bool
+}
+
+type AdaptiveConcurrency_GradientControllerConfig struct {
+	GradientControllerConfig *GradientControllerConfig `protobuf:"bytes,1,opt,name=gradient_controller_config,json=gradientControllerConfig,proto3,oneof" json:"gradient_controller_config,omitempty"`
+}
+
+func (*AdaptiveConcurrency_GradientControllerConfig) isAdaptiveConcurrency_ConcurrencyControllerConfig() {
+}
+
+func (m *AdaptiveConcurrency) GetConcurrencyControllerConfig() isAdaptiveConcurrency_ConcurrencyControllerConfig {
+	if m != nil {
+		return m.ConcurrencyControllerConfig
+	}
+	return nil
+}
+
+func (m *AdaptiveConcurrency) GetGradientControllerConfig() *GradientControllerConfig {
+	if x, ok := m.GetConcurrencyControllerConfig().(*AdaptiveConcurrency_GradientControllerConfig); ok {
+		return x.GradientControllerConfig
+	}
+	return nil
+}
+
+func (m *AdaptiveConcurrency) GetEnabled() *v31.RuntimeFeatureFlag {
+	if m != nil {
+		return m.Enabled
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*AdaptiveConcurrency) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*AdaptiveConcurrency_GradientControllerConfig)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterType((*GradientControllerConfig)(nil), "envoy.extensions.filters.http.adaptive_concurrency.v3.GradientControllerConfig")
+	proto.RegisterType((*GradientControllerConfig_ConcurrencyLimitCalculationParams)(nil), "envoy.extensions.filters.http.adaptive_concurrency.v3.GradientControllerConfig.ConcurrencyLimitCalculationParams")
+	proto.RegisterType((*GradientControllerConfig_MinimumRTTCalculationParams)(nil), "envoy.extensions.filters.http.adaptive_concurrency.v3.GradientControllerConfig.MinimumRTTCalculationParams")
+	proto.RegisterType((*AdaptiveConcurrency)(nil), "envoy.extensions.filters.http.adaptive_concurrency.v3.AdaptiveConcurrency")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/http/adaptive_concurrency/v3/adaptive_concurrency.proto", fileDescriptor_26f9414c57235436)
+}
+
+var fileDescriptor_26f9414c57235436 = []byte{
+	// 765 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x4f, 0x03, 0x45,
+	0x14, 0x66, 0x4a, 0x81, 0x66, 0x50, 0x6c, 0x16, 0xc5, 0xa5, 0x55, 0x44, 0xe2, 0x81, 0x90, 0x38,
+	0x9b, 0xd0, 0x78, 0xf4, 0xc0, 0x56, 0x51, 0x88, 0x3f, 0x9a, 0x0d, 0x90, 0xe8, 0x65, 0x33, 0xdd,
+	0xbe, 0x2e, 0x03, 0xb3, 0x33, 0xcb, 0xec, 0x6c, 0x6d, 0xcf, 0x7a, 0xf2, 0x3f, 0x30, 0xde, 0x8c,
+	0x07, 0xc3, 0xdd, 0xc4, 0xa3, 0xff, 0x85, 0x57, 0xe3, 0xd5, 0xb3, 0x89, 0xe9, 0xc9, 0xec, 0xce,
+	0xb4, 0x34, 0xfc, 0x28, 0x42, 0xb8, 0xed, 0xcc, 0x7b, 0xef, 0xfb, 0xde, 0xfb, 0xde, 0xb7, 0xbb,
+	0xf8, 0x5b, 0x14, 0x33, 0x7d, 0x9e, 0x77, 0x49, 0x24, 0x13, 0x2f, 0x93, 0x5c, 0xbe, 0xcf, 0xa4,
+	0x17, 0x73, 0x29, 0xbd, 0x54, 0xc9, 0x0b, 0x88, 0x74, 0x66, 0x4e, 0x34, 0x65, 0x1e, 0x0c, 0x35,
+	0x28, 0x41, 0xb9, 0x07, 0x62, 0x20, 0x47, 0xe5, 0x51, 0x64, 0x4c, 0x8a, 0xcc, 0xeb, 0x33, 0xae,
+	0x41, 0x65, 0xde, 0xb9, 0xd6, 0xa9, 0x47, 0x7b, 0x34, 0xd5, 0x6c, 0x00, 0x61, 0x24, 0x45, 0x94,
+	0x2b, 0x05, 0x22, 0x1a, 0x79, 0x83, 0xd6, 0xbd, 0xf7, 0x24, 0x55, 0x52, 0x4b, 0xe7, 0x83, 0x12,
+	0x91, 0xdc, 0x20, 0x12, 0x8b, 0x48, 0x0a, 0x44, 0x72, 0x6f, 0xe5, 0xa0, 0xd5, 0x78, 0xc7, 0x34,
+	0x12, 0x49, 0xd1, 0x67, 0xb1, 0x17, 0x49, 0x05, 0x05, 0x4f, 0x97, 0x66, 0x60, 0x70, 0x1b, 0x4d,
+	0x93, 0xa0, 0x47, 0x69, 0x19, 0x49, 0x41, 0x45, 0x20, 0xb4, 0x0d, 0x6e, 0xc5, 0x52, 0xc6, 0x1c,
+	0xbc, 0xf2, 0xd4, 0xcd, 0xfb, 0x5e, 0x2f, 0x57, 0x54, 0x33, 0x29, 0x1e, 0x8a, 0x7f, 0xa3, 0x68,
+	0x9a, 0x16, 0x4d, 0x99, 0xf8, 0x9b, 0x03, 0xca, 0x59, 0x8f, 0x6a, 0xf0, 0x26, 0x0f, 0x36, 0xf0,
+	0x7a, 0x2c, 0x63, 0x59, 0x3e, 0x7a, 0xc5, 0x93, 0xbd, 0x75, 0x60, 0xa8, 0xcd, 0x25, 0x0c, 0x6d,
+	0x0b, 0x3b, 0x3f, 0xd5, 0xb0, 0xfb, 0x89, 0xa2, 0x3d, 0x06, 0x42, 0xb7, 0xa5, 0xd0, 0x4a, 0x72,
+	0x0e, 0xaa, 0x5d, 0xce, 0xe3, 0x9c, 0xe1, 0x66, 0x46, 0x93, 0x94, 0x43, 0x48, 0xe3, 0x58, 0x41,
+	0x4c, 0x35, 0x84, 0x76, 0x02, 0xc6, 0xc1, 0x45, 0xdb, 0x68, 0x77, 0x75, 0x7f, 0x83, 0x18, 0xe9,
+	0x8a, 0x11, 0xc9, 0xa0, 0x45, 0x3a, 0x26, 0x21, 0xd8, 0x34, 0xa5, 0x07, 0x93, 0xca, 0xce, 0xb4,
+	0xd0, 0xf9, 0x15, 0x61, 0x77, 0x46, 0xc8, 0x90, 0xb3, 0x84, 0xe9, 0x30, 0xa5, 0x8a, 0x26, 0x99,
+	0x5b, 0x29, 0x51, 0xaf, 0xc8, 0xb3, 0x16, 0x42, 0x1e, 0x9a, 0x85, 0xb4, 0x6f, 0xf2, 0x3e, 0x2b,
+	0xe8, 0xda, 0x94, 0x47, 0x39, 0x2f, 0xd5, 0xee, 0x94, 0xc4, 0x7e, 0x6d, 0xec, 0x2f, 0x7d, 0x8f,
+	0x2a, 0x75, 0x14, 0x6c, 0x44, 0xb7, 0x92, 0x4d, 0x86, 0xf3, 0x33, 0xc2, 0xeb, 0x09, 0x13, 0xa1,
+	0xd2, 0x3a, 0x8c, 0x28, 0x8f, 0x26, 0x2d, 0x2f, 0x96, 0x2d, 0x5f, 0xbe, 0x74, 0xcb, 0x9f, 0x33,
+	0xc1, 0x92, 0x3c, 0x09, 0x4e, 0x4e, 0xe6, 0x35, 0x5b, 0x4f, 0x98, 0x08, 0x74, 0x39, 0x8f, 0x89,
+	0x35, 0xfe, 0x46, 0xf8, 0xdd, 0x47, 0xc7, 0x75, 0xbe, 0xc2, 0x6f, 0x24, 0x74, 0x18, 0xde, 0xd9,
+	0x83, 0x5d, 0xc0, 0x5b, 0xc4, 0x98, 0x8f, 0x4c, 0xcc, 0x47, 0x4e, 0x8f, 0x84, 0x6e, 0xed, 0x9f,
+	0x51, 0x9e, 0x83, 0xbf, 0x32, 0xf6, 0xab, 0x7b, 0x95, 0xed, 0x85, 0x60, 0x3d, 0xa1, 0xc3, 0xdb,
+	0x5c, 0x0e, 0xe0, 0xe6, 0x2c, 0x6c, 0x9e, 0x16, 0xd6, 0x0c, 0x99, 0xd0, 0xa0, 0x06, 0x94, 0x5b,
+	0xb9, 0x36, 0xef, 0x10, 0x7c, 0x64, 0xdd, 0xef, 0xe3, 0xb1, 0xbf, 0x72, 0x8d, 0xaa, 0x35, 0xb4,
+	0xb7, 0x10, 0x6c, 0xce, 0x20, 0x9d, 0x96, 0x40, 0x47, 0x16, 0xe7, 0xb8, 0x5a, 0x43, 0xf5, 0x4a,
+	0xe3, 0xcf, 0x0a, 0x6e, 0xce, 0x51, 0xca, 0xf9, 0x18, 0xd7, 0xa6, 0xcc, 0xe8, 0x31, 0xe6, 0xb5,
+	0xb1, 0xbf, 0x7a, 0x8d, 0x6a, 0x35, 0xb4, 0x5f, 0xad, 0xff, 0xfe, 0xdd, 0x87, 0xc1, 0xb4, 0xd4,
+	0x39, 0xc6, 0xaf, 0x2a, 0xb8, 0xca, 0x21, 0xd3, 0x61, 0x24, 0x73, 0xf1, 0x44, 0x99, 0x5e, 0xb1,
+	0xb5, 0xed, 0xa2, 0xd4, 0x21, 0x78, 0xf9, 0x82, 0x69, 0x0d, 0xca, 0x5d, 0x9c, 0xfb, 0x0a, 0xd9,
+	0x2c, 0xe7, 0x0b, 0xfc, 0x5a, 0x61, 0xbb, 0x19, 0x25, 0xdc, 0xea, 0x53, 0xd8, 0xd7, 0x12, 0x26,
+	0x66, 0x96, 0x54, 0xf0, 0x77, 0xf3, 0x7e, 0x1f, 0x94, 0xbb, 0x34, 0x9f, 0xdf, 0x64, 0xed, 0xfc,
+	0x58, 0xc1, 0xeb, 0x07, 0xd6, 0xbd, 0xb3, 0x38, 0x3f, 0x20, 0xdc, 0x88, 0xad, 0x7b, 0xc3, 0x68,
+	0x6a, 0xdf, 0xd0, 0x7c, 0x0e, 0xad, 0xda, 0x5f, 0xbe, 0xf0, 0x6b, 0x71, 0x63, 0xfd, 0x4f, 0x17,
+	0x02, 0x37, 0x7e, 0xe8, 0xdb, 0xe5, 0xe3, 0x15, 0x10, 0xb4, 0xcb, 0xa1, 0x67, 0x37, 0xb5, 0x6b,
+	0xfb, 0x30, 0xcd, 0x91, 0x48, 0xaa, 0x72, 0xd6, 0x20, 0x17, 0x9a, 0x25, 0x70, 0x08, 0x54, 0xe7,
+	0x0a, 0x0e, 0x39, 0x8d, 0x83, 0x49, 0xa1, 0xff, 0x1e, 0x7e, 0x7b, 0xd6, 0xc7, 0x77, 0x26, 0x74,
+	0x16, 0xff, 0xf5, 0x91, 0xff, 0x07, 0xfa, 0xed, 0x9f, 0x2a, 0xfa, 0xe5, 0xaf, 0x2d, 0x84, 0xdb,
+	0x4c, 0x1a, 0x96, 0x54, 0xc9, 0xe1, 0xe8, 0x79, 0x83, 0xfb, 0xee, 0x3d, 0x52, 0x77, 0x8a, 0xfd,
+	0x76, 0xd0, 0xd7, 0xfc, 0xff, 0xfd, 0x2b, 0xd3, 0xcb, 0xf8, 0x05, 0xfe, 0x97, 0xdd, 0xe5, 0xd2,
+	0x56, 0xad, 0xff, 0x06, 0x00, 0x3c, 0xce, 0x8f, 0xb8, 0xa3, 0x07, 0x00, 0x00,
+}
+
+func (this *GradientControllerConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GradientControllerConfig)
+	if !ok {
+		that2, ok := that.(GradientControllerConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SampleAggregatePercentile.Equal(that1.SampleAggregatePercentile) {
+		return false
+	}
+	if !this.ConcurrencyLimitParams.Equal(that1.ConcurrencyLimitParams) {
+		return false
+	}
+	if !this.MinRttCalcParams.Equal(that1.MinRttCalcParams) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *GradientControllerConfig_ConcurrencyLimitCalculationParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GradientControllerConfig_ConcurrencyLimitCalculationParams)
+	if !ok {
+		that2, ok := that.(GradientControllerConfig_ConcurrencyLimitCalculationParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MaxConcurrencyLimit.Equal(that1.MaxConcurrencyLimit) {
+		return false
+	}
+	if !this.ConcurrencyUpdateInterval.Equal(that1.ConcurrencyUpdateInterval) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *GradientControllerConfig_MinimumRTTCalculationParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GradientControllerConfig_MinimumRTTCalculationParams)
+	if !ok {
+		that2, ok := that.(GradientControllerConfig_MinimumRTTCalculationParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Interval.Equal(that1.Interval) {
+		return false
+	}
+	if !this.RequestCount.Equal(that1.RequestCount) {
+		return false
+	}
+	if !this.Jitter.Equal(that1.Jitter) {
+		return false
+	}
+	if !this.MinConcurrency.Equal(that1.MinConcurrency) {
+		return false
+	}
+	if !this.Buffer.Equal(that1.Buffer) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *AdaptiveConcurrency) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdaptiveConcurrency)
+	if !ok {
+		that2, ok := that.(AdaptiveConcurrency)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.ConcurrencyControllerConfig == nil {
+		if this.ConcurrencyControllerConfig != nil {
+			return false
+		}
+	} else if this.ConcurrencyControllerConfig == nil {
+		return false
+	} else if !this.ConcurrencyControllerConfig.Equal(that1.ConcurrencyControllerConfig) {
+		return false
+	}
+	if !this.Enabled.Equal(that1.Enabled) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *AdaptiveConcurrency_GradientControllerConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdaptiveConcurrency_GradientControllerConfig)
+	if !ok {
+		that2, ok := that.(AdaptiveConcurrency_GradientControllerConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GradientControllerConfig.Equal(that1.GradientControllerConfig) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,510 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/http/admission_control/v3alpha/admission_control.proto
+
+package v3alpha
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	v31 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/v3"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// [#next-free-field: 6]
+type AdmissionControl struct {
+	// If set to false, the admission control filter will operate as a pass-through filter. If the
+	// message is unspecified, the filter will be enabled.
+	Enabled *v3.RuntimeFeatureFlag `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Defines how a request is considered a success/failure.
+	//
+	// Types that are valid to be assigned to EvaluationCriteria:
+	//	*AdmissionControl_SuccessCriteria_
+	EvaluationCriteria isAdmissionControl_EvaluationCriteria `protobuf_oneof:"evaluation_criteria"`
+	// The sliding time window over which the success rate is calculated. The window is rounded to the
+	// nearest second. Defaults to 30s.
+	SamplingWindow *types.Duration `protobuf:"bytes,3,opt,name=sampling_window,json=samplingWindow,proto3" json:"sampling_window,omitempty"`
+	// Rejection probability is defined by the formula
+	// `max(0, (rq_count - rq_success_count / sr_threshold) / (rq_count + 1)) ^ (1 / aggression)`.
+	//
+	// The aggression dictates how heavily the admission controller will throttle requests upon SR
+	// dropping at or below the threshold. A higher aggression will result in a higher rejection
+	// rate. Defaults to 1.0.
+	Aggression *v3.RuntimeDouble `protobuf:"bytes,4,opt,name=aggression,proto3" json:"aggression,omitempty"`
+	// Dictates the success rate at which the rejection probability is non-zero. As success rate drops
+	// below this threshold, rejection probability will increase. Any success rate above the threshold
+	// results in a rejection probability of 0. Defaults to 95%.
+	SrThreshold          *v3.RuntimePercent `protobuf:"bytes,5,opt,name=sr_threshold,json=srThreshold,proto3" json:"sr_threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *AdmissionControl) Reset()         { *m = AdmissionControl{} }
+func (m *AdmissionControl) String() string { return proto.CompactTextString(m) }
+func (*AdmissionControl) ProtoMessage()    {}
+func (*AdmissionControl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7382f9affbd9559, []int{0}
+}
+func (m *AdmissionControl) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdmissionControl.Unmarshal(m, b)
+}
+func (m *AdmissionControl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdmissionControl.Marshal(b, m, deterministic)
+}
+func (m *AdmissionControl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdmissionControl.Merge(m, src)
+}
+func (m *AdmissionControl) XXX_Size() int {
+	return xxx_messageInfo_AdmissionControl.Size(m)
+}
+func (m *AdmissionControl) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdmissionControl.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdmissionControl proto.InternalMessageInfo
+
+type isAdmissionControl_EvaluationCriteria interface {
+	isAdmissionControl_EvaluationCriteria()
+	Equal(interface{}) bool
+}
+
+type AdmissionControl_SuccessCriteria_ struct {
+	SuccessCriteria *AdmissionControl_SuccessCriteria `protobuf:"bytes,2,opt,name=success_criteria,json=successCriteria,proto3,oneof" json:"success_criteria,omitempty"`
+}
+
+func (*AdmissionControl_SuccessCriteria_) isAdmissionControl_EvaluationCriteria() {}
+
+func (m *AdmissionControl) GetEvaluationCriteria() isAdmissionControl_EvaluationCriteria {
+	if m != nil {
+		return m.EvaluationCriteria
+	}
+	return nil
+}
+
+func (m *AdmissionControl) GetEnabled() *v3.RuntimeFeatureFlag {
+	if m != nil {
+		return m.Enabled
+	}
+	return nil
+}
+
+func (m *AdmissionControl) GetSuccessCriteria() *AdmissionControl_SuccessCriteria {
+	if x, ok := m.GetEvaluationCriteria().(*AdmissionControl_SuccessCriteria_); ok {
+		return x.SuccessCriteria
+	}
+	return nil
+}
+
+func (m *AdmissionControl) GetSamplingWindow() *types.Duration {
+	if m != nil {
+		return m.SamplingWindow
+	}
+	return nil
+}
+
+func (m *AdmissionControl) GetAggression() *v3.RuntimeDouble {
+	if m != nil {
+		return m.Aggression
+	}
+	return nil
+}
+
+func (m *AdmissionControl) GetSrThreshold() *v3.RuntimePercent {
+	if m != nil {
+		return m.SrThreshold
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*AdmissionControl) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*AdmissionControl_SuccessCriteria_)(nil),
+	}
+}
+
+// Default method of specifying what constitutes a successful request. All status codes that
+// indicate a successful request must be explicitly specified if not relying on the default
+// values.
+type AdmissionControl_SuccessCriteria struct {
+	// If HTTP criteria are unspecified, all HTTP status codes below 500 are treated as successful
+	// responses.
+	//
+	// The default HTTP codes considered successful by the admission controller are done so due
+	// to the unlikelihood that sending fewer requests would change their behavior (for example:
+	// redirects, unauthorized access, or bad requests won't be alleviated by sending less
+	// traffic).
+	HttpCriteria *AdmissionControl_SuccessCriteria_HttpCriteria `protobuf:"bytes,1,opt,name=http_criteria,json=httpCriteria,proto3" json:"http_criteria,omitempty"`
+	// GRPC status codes to consider as request successes. If unspecified, defaults to: Ok,
+	// Cancelled, Unknown, InvalidArgument, NotFound, AlreadyExists, Unauthenticated,
+	// FailedPrecondition, OutOfRange, PermissionDenied, and Unimplemented.
+	//
+	// The default gRPC codes that are considered successful by the admission controller are
+	// chosen because of the unlikelihood that sending fewer requests will change the behavior.
+	GrpcCriteria         *AdmissionControl_SuccessCriteria_GrpcCriteria `protobuf:"bytes,2,opt,name=grpc_criteria,json=grpcCriteria,proto3" json:"grpc_criteria,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_unrecognized     []byte                                         `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
+}
+
+func (m *AdmissionControl_SuccessCriteria) Reset()         { *m = AdmissionControl_SuccessCriteria{} }
+func (m *AdmissionControl_SuccessCriteria) String() string { return proto.CompactTextString(m) }
+func (*AdmissionControl_SuccessCriteria) ProtoMessage()    {}
+func (*AdmissionControl_SuccessCriteria) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7382f9affbd9559, []int{0, 0}
+}
+func (m *AdmissionControl_SuccessCriteria) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdmissionControl_SuccessCriteria.Unmarshal(m, b)
+}
+func (m *AdmissionControl_SuccessCriteria) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdmissionControl_SuccessCriteria.Marshal(b, m, deterministic)
+}
+func (m *AdmissionControl_SuccessCriteria) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdmissionControl_SuccessCriteria.Merge(m, src)
+}
+func (m *AdmissionControl_SuccessCriteria) XXX_Size() int {
+	return xxx_messageInfo_AdmissionControl_SuccessCriteria.Size(m)
+}
+func (m *AdmissionControl_SuccessCriteria) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdmissionControl_SuccessCriteria.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdmissionControl_SuccessCriteria proto.InternalMessageInfo
+
+func (m *AdmissionControl_SuccessCriteria) GetHttpCriteria() *AdmissionControl_SuccessCriteria_HttpCriteria {
+	if m != nil {
+		return m.HttpCriteria
+	}
+	return nil
+}
+
+func (m *AdmissionControl_SuccessCriteria) GetGrpcCriteria() *AdmissionControl_SuccessCriteria_GrpcCriteria {
+	if m != nil {
+		return m.GrpcCriteria
+	}
+	return nil
+}
+
+type AdmissionControl_SuccessCriteria_HttpCriteria struct {
+	// Status code ranges that constitute a successful request. Configurable codes are in the
+	// range [100, 600).
+	HttpSuccessStatus    []*v31.Int32Range `protobuf:"bytes,1,rep,name=http_success_status,json=httpSuccessStatus,proto3" json:"http_success_status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *AdmissionControl_SuccessCriteria_HttpCriteria) Reset() {
+	*m = AdmissionControl_SuccessCriteria_HttpCriteria{}
+}
+func (m *AdmissionControl_SuccessCriteria_HttpCriteria) String() string {
+	return proto.CompactTextString(m)
+}
+func (*AdmissionControl_SuccessCriteria_HttpCriteria) ProtoMessage() {}
+func (*AdmissionControl_SuccessCriteria_HttpCriteria) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7382f9affbd9559, []int{0, 0, 0}
+}
+func (m *AdmissionControl_SuccessCriteria_HttpCriteria) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdmissionControl_SuccessCriteria_HttpCriteria.Unmarshal(m, b)
+}
+func (m *AdmissionControl_SuccessCriteria_HttpCriteria) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdmissionControl_SuccessCriteria_HttpCriteria.Marshal(b, m, deterministic)
+}
+func (m *AdmissionControl_SuccessCriteria_HttpCriteria) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdmissionControl_SuccessCriteria_HttpCriteria.Merge(m, src)
+}
+func (m *AdmissionControl_SuccessCriteria_HttpCriteria) XXX_Size() int {
+	return xxx_messageInfo_AdmissionControl_SuccessCriteria_HttpCriteria.Size(m)
+}
+func (m *AdmissionControl_SuccessCriteria_HttpCriteria) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdmissionControl_SuccessCriteria_HttpCriteria.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdmissionControl_SuccessCriteria_HttpCriteria proto.InternalMessageInfo
+
+func (m *AdmissionControl_SuccessCriteria_HttpCriteria) GetHttpSuccessStatus() []*v31.Int32Range {
+	if m != nil {
+		return m.HttpSuccessStatus
+	}
+	return nil
+}
+
+type AdmissionControl_SuccessCriteria_GrpcCriteria struct {
+	// Status codes that constitute a successful request.
+	// Mappings can be found at: https://github.com/grpc/grpc/blob/master/doc/statuscodes.md.
+	GrpcSuccessStatus    []uint32 `protobuf:"varint,1,rep,packed,name=grpc_success_status,json=grpcSuccessStatus,proto3" json:"grpc_success_status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AdmissionControl_SuccessCriteria_GrpcCriteria) Reset() {
+	*m = AdmissionControl_SuccessCriteria_GrpcCriteria{}
+}
+func (m *AdmissionControl_SuccessCriteria_GrpcCriteria) String() string {
+	return proto.CompactTextString(m)
+}
+func (*AdmissionControl_SuccessCriteria_GrpcCriteria) ProtoMessage() {}
+func (*AdmissionControl_SuccessCriteria_GrpcCriteria) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7382f9affbd9559, []int{0, 0, 1}
+}
+func (m *AdmissionControl_SuccessCriteria_GrpcCriteria) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdmissionControl_SuccessCriteria_GrpcCriteria.Unmarshal(m, b)
+}
+func (m *AdmissionControl_SuccessCriteria_GrpcCriteria) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdmissionControl_SuccessCriteria_GrpcCriteria.Marshal(b, m, deterministic)
+}
+func (m *AdmissionControl_SuccessCriteria_GrpcCriteria) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdmissionControl_SuccessCriteria_GrpcCriteria.Merge(m, src)
+}
+func (m *AdmissionControl_SuccessCriteria_GrpcCriteria) XXX_Size() int {
+	return xxx_messageInfo_AdmissionControl_SuccessCriteria_GrpcCriteria.Size(m)
+}
+func (m *AdmissionControl_SuccessCriteria_GrpcCriteria) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdmissionControl_SuccessCriteria_GrpcCriteria.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdmissionControl_SuccessCriteria_GrpcCriteria proto.InternalMessageInfo
+
+func (m *AdmissionControl_SuccessCriteria_GrpcCriteria) GetGrpcSuccessStatus() []uint32 {
+	if m != nil {
+		return m.GrpcSuccessStatus
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AdmissionControl)(nil), "envoy.extensions.filters.http.admission_control.v3alpha.AdmissionControl")
+	proto.RegisterType((*AdmissionControl_SuccessCriteria)(nil), "envoy.extensions.filters.http.admission_control.v3alpha.AdmissionControl.SuccessCriteria")
+	proto.RegisterType((*AdmissionControl_SuccessCriteria_HttpCriteria)(nil), "envoy.extensions.filters.http.admission_control.v3alpha.AdmissionControl.SuccessCriteria.HttpCriteria")
+	proto.RegisterType((*AdmissionControl_SuccessCriteria_GrpcCriteria)(nil), "envoy.extensions.filters.http.admission_control.v3alpha.AdmissionControl.SuccessCriteria.GrpcCriteria")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/http/admission_control/v3alpha/admission_control.proto", fileDescriptor_b7382f9affbd9559)
+}
+
+var fileDescriptor_b7382f9affbd9559 = []byte{
+	// 619 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xb1, 0x6e, 0x14, 0x3d,
+	0x10, 0xfe, 0x9d, 0x4b, 0xfe, 0x44, 0xce, 0x85, 0x84, 0x0d, 0x88, 0xcb, 0x15, 0x21, 0x02, 0x8a,
+	0x34, 0xd8, 0x52, 0xae, 0x80, 0x96, 0x4d, 0x48, 0x42, 0x17, 0x6d, 0x90, 0x10, 0x34, 0x27, 0xdf,
+	0x9e, 0xcf, 0x67, 0xf0, 0x79, 0x2c, 0xdb, 0x7b, 0xb9, 0xb4, 0x14, 0x34, 0x74, 0x3c, 0x05, 0x05,
+	0x0f, 0xc0, 0xa3, 0x50, 0x50, 0xf1, 0x0e, 0x48, 0x88, 0x0a, 0xad, 0xbd, 0x4b, 0x2e, 0x17, 0x14,
+	0xa1, 0x88, 0x74, 0x9e, 0x99, 0xfd, 0xbe, 0xf9, 0xe6, 0xb3, 0x67, 0xf1, 0x5b, 0x24, 0xa4, 0x1f,
+	0x16, 0x3d, 0x92, 0xc3, 0x88, 0x3a, 0x50, 0xf0, 0x50, 0x02, 0x15, 0x0a, 0x80, 0x1a, 0x0b, 0xaf,
+	0x79, 0xee, 0x5d, 0x8c, 0x98, 0x91, 0x94, 0x4f, 0x3c, 0xb7, 0x9a, 0x29, 0xca, 0xf5, 0x18, 0x4e,
+	0x43, 0xa8, 0x9d, 0x04, 0xed, 0xe8, 0x40, 0x2a, 0xcf, 0xad, 0xa3, 0x43, 0xef, 0x0d, 0x65, 0xfd,
+	0x91, 0x74, 0x65, 0xbe, 0x9b, 0x83, 0xf6, 0x16, 0x14, 0x1d, 0x77, 0x98, 0x32, 0x43, 0x76, 0xb1,
+	0x42, 0x8c, 0x05, 0x0f, 0xc9, 0xa3, 0x40, 0x48, 0xce, 0x08, 0x49, 0x45, 0x48, 0x4a, 0x42, 0x72,
+	0x11, 0x56, 0x11, 0xb6, 0xef, 0x46, 0x25, 0x39, 0xe8, 0x81, 0x14, 0x34, 0x07, 0xcb, 0xe9, 0xb8,
+	0x43, 0x7b, 0xcc, 0xf1, 0xc8, 0xdc, 0xde, 0x88, 0x1f, 0xf8, 0x53, 0x13, 0x2a, 0x96, 0x69, 0x51,
+	0x97, 0x36, 0x05, 0x80, 0x50, 0x9c, 0x86, 0xa8, 0x57, 0x0c, 0x68, 0xbf, 0xb0, 0xcc, 0x4b, 0xd0,
+	0x55, 0xfd, 0xce, 0x98, 0x29, 0xd9, 0x67, 0x9e, 0xd3, 0xfa, 0x50, 0x15, 0x6e, 0x09, 0x10, 0x10,
+	0x8e, 0xb4, 0x3c, 0x55, 0xd9, 0x84, 0x4f, 0x7c, 0x4c, 0xf2, 0x89, 0x8f, 0xb9, 0x7b, 0x9f, 0x16,
+	0xf1, 0xda, 0x93, 0x5a, 0xfc, 0x6e, 0xd4, 0x9e, 0xa4, 0x78, 0x91, 0x6b, 0xd6, 0x53, 0xbc, 0xdf,
+	0x42, 0x5b, 0x68, 0x7b, 0x79, 0x67, 0x9b, 0xc4, 0xf1, 0xe3, 0x14, 0xa4, 0x9c, 0x82, 0x8c, 0x3b,
+	0x24, 0x2b, 0xb4, 0x97, 0x23, 0xbe, 0xcf, 0x99, 0x2f, 0x2c, 0xdf, 0x57, 0x4c, 0x64, 0x35, 0x30,
+	0x79, 0x87, 0xf0, 0x9a, 0x2b, 0xf2, 0x9c, 0x3b, 0xd7, 0xcd, 0xad, 0xf4, 0xdc, 0x4a, 0xd6, 0x9a,
+	0x0b, 0x6c, 0x2f, 0xc9, 0x15, 0xcd, 0x24, 0xb3, 0x4a, 0xc9, 0x71, 0xec, 0xb0, 0x5b, 0x35, 0x38,
+	0xfc, 0x2f, 0x5b, 0x75, 0xe7, 0x53, 0x49, 0x8a, 0x57, 0x1d, 0x1b, 0x19, 0x25, 0xb5, 0xe8, 0x9e,
+	0x48, 0xdd, 0x87, 0x93, 0x56, 0x23, 0xc8, 0xd8, 0x20, 0xd1, 0x5e, 0x52, 0xdb, 0x4b, 0xf6, 0x2a,
+	0x7b, 0xb3, 0x1b, 0x35, 0xe2, 0x45, 0x00, 0x24, 0xbb, 0x18, 0x33, 0x21, 0x2c, 0x0f, 0xbd, 0x5b,
+	0xf3, 0x01, 0x7e, 0xff, 0x52, 0x4f, 0xf6, 0xa0, 0xe8, 0x29, 0x9e, 0x4d, 0xc1, 0x92, 0x03, 0xdc,
+	0x74, 0xb6, 0xeb, 0x87, 0x96, 0xbb, 0x21, 0xa8, 0x7e, 0x6b, 0x21, 0xd0, 0x3c, 0xb8, 0x94, 0xe6,
+	0x88, 0xdb, 0x9c, 0x6b, 0x9f, 0x2d, 0x3b, 0xfb, 0xbc, 0x06, 0xb6, 0xbf, 0x36, 0xf0, 0xea, 0xcc,
+	0xe0, 0xc9, 0x7b, 0x84, 0x57, 0x4a, 0xf3, 0xce, 0xbc, 0x8e, 0x37, 0x37, 0xb8, 0x36, 0xaf, 0xc9,
+	0xa1, 0xf7, 0xa6, 0x0e, 0xb2, 0xe6, 0x70, 0x2a, 0x0a, 0x6a, 0x84, 0x35, 0xf9, 0xec, 0xcd, 0x5f,
+	0xa3, 0x9a, 0x03, 0x6b, 0xf2, 0x33, 0x35, 0x62, 0x2a, 0x6a, 0xe7, 0xb8, 0x39, 0xad, 0x35, 0x39,
+	0xc6, 0xeb, 0xc1, 0xaa, 0xfa, 0x79, 0x3a, 0xcf, 0x7c, 0xe1, 0x5a, 0x68, 0xab, 0x11, 0x5e, 0x45,
+	0x94, 0x58, 0xee, 0x63, 0x79, 0x11, 0xcf, 0xb4, 0xef, 0xec, 0x64, 0xe5, 0x52, 0xa6, 0x4b, 0x3f,
+	0xd3, 0x85, 0x0f, 0x68, 0x6e, 0x09, 0x65, 0x37, 0x4b, 0x7c, 0xa5, 0xe0, 0x38, 0xa0, 0xdb, 0x87,
+	0xb8, 0x39, 0x2d, 0x21, 0x79, 0x8c, 0xd7, 0x83, 0x03, 0x7f, 0x68, 0xb2, 0x32, 0xcd, 0x54, 0x7e,
+	0x74, 0x8e, 0x29, 0x6d, 0xe3, 0x75, 0x3e, 0x66, 0xaa, 0x08, 0x4f, 0xf1, 0xb7, 0x83, 0x49, 0xe3,
+	0x47, 0x8a, 0xd2, 0x2f, 0xe8, 0xf3, 0xf7, 0x79, 0xf4, 0xf1, 0xdb, 0x26, 0xc2, 0x4f, 0x25, 0x44,
+	0xa9, 0xc6, 0xc2, 0xe4, 0xf4, 0xaa, 0xc6, 0xa6, 0xb7, 0x67, 0x9d, 0x3d, 0x2a, 0xb7, 0xe1, 0x08,
+	0xbd, 0xd2, 0x7f, 0xf7, 0xd7, 0x35, 0x6f, 0xc4, 0x3f, 0xf9, 0xf3, 0xf6, 0xfe, 0x0f, 0x6b, 0xd8,
+	0xf9, 0x35, 0x00, 0x60, 0x48, 0x3d, 0xab, 0xef, 0x05, 0x00, 0x00,
+}
+
+func (this *AdmissionControl) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdmissionControl)
+	if !ok {
+		that2, ok := that.(AdmissionControl)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Enabled.Equal(that1.Enabled) {
+		return false
+	}
+	if that1.EvaluationCriteria == nil {
+		if this.EvaluationCriteria != nil {
+			return false
+		}
+	} else if this.EvaluationCriteria == nil {
+		return false
+	} else if !this.EvaluationCriteria.Equal(that1.EvaluationCriteria) {
+		return false
+	}
+	if !this.SamplingWindow.Equal(that1.SamplingWindow) {
+		return false
+	}
+	if !this.Aggression.Equal(that1.Aggression) {
+		return false
+	}
+	if !this.SrThreshold.Equal(that1.SrThreshold) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *AdmissionControl_SuccessCriteria_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdmissionControl_SuccessCriteria_)
+	if !ok {
+		that2, ok := that.(AdmissionControl_SuccessCriteria_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SuccessCriteria.Equal(that1.SuccessCriteria) {
+		return false
+	}
+	return true
+}
+func (this *AdmissionControl_SuccessCriteria) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdmissionControl_SuccessCriteria)
+	if !ok {
+		that2, ok := that.(AdmissionControl_SuccessCriteria)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.HttpCriteria.Equal(that1.HttpCriteria) {
+		return false
+	}
+	if !this.GrpcCriteria.Equal(that1.GrpcCriteria) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *AdmissionControl_SuccessCriteria_HttpCriteria) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdmissionControl_SuccessCriteria_HttpCriteria)
+	if !ok {
+		that2, ok := that.(AdmissionControl_SuccessCriteria_HttpCriteria)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.HttpSuccessStatus) != len(that1.HttpSuccessStatus) {
+		return false
+	}
+	for i := range this.HttpSuccessStatus {
+		if !this.HttpSuccessStatus[i].Equal(that1.HttpSuccessStatus[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *AdmissionControl_SuccessCriteria_GrpcCriteria) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdmissionControl_SuccessCriteria_GrpcCriteria)
+	if !ok {
+		that2, ok := that.(AdmissionControl_SuccessCriteria_GrpcCriteria)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.GrpcSuccessStatus) != len(that1.GrpcSuccessStatus) {
+		return false
+	}
+	for i := range this.GrpcSuccessStatus {
+		if this.GrpcSuccessStatus[i] != that1.GrpcSuccessStatus[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,1746 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/http/jwt_authn/v3/config.proto
+
+package v3
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	v31 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/route/v3"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Please see following for JWT authentication flow:
+//
+// * `JSON Web Token (JWT) <https://tools.ietf.org/html/rfc7519>`_
+// * `The OAuth 2.0 Authorization Framework <https://tools.ietf.org/html/rfc6749>`_
+// * `OpenID Connect <http://openid.net/connect>`_
+//
+// A JwtProvider message specifies how a JSON Web Token (JWT) can be verified. It specifies:
+//
+// * issuer: the principal that issues the JWT. It has to match the one from the token.
+// * allowed audiences: the ones in the token have to be listed here.
+// * how to fetch public key JWKS to verify the token signature.
+// * how to extract JWT token in the request.
+// * how to pass successfully verified token payload.
+//
+// [#next-free-field: 10]
+type JwtProvider struct {
+	// Specify the `principal <https://tools.ietf.org/html/rfc7519#section-4.1.1>`_ that issued
+	// the JWT, usually a URL or an email address.
+	//
+	// It is optional. If specified, it has to match the *iss* field in JWT.
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// The list of JWT `audiences <https://tools.ietf.org/html/rfc7519#section-4.1.3>`_ are
+	// allowed to access. A JWT containing any of these audiences will be accepted. If not specified,
+	// will not check audiences in the token.
+	Audiences []string `protobuf:"bytes,2,rep,name=audiences,proto3" json:"audiences,omitempty"`
+	// `JSON Web Key Set (JWKS) <https://tools.ietf.org/html/rfc7517#appendix-A>`_ is needed to
+	// validate signature of a JWT. This field specifies where to fetch JWKS.
+	//
+	// Types that are valid to be assigned to JwksSourceSpecifier:
+	//	*JwtProvider_RemoteJwks
+	//	*JwtProvider_LocalJwks
+	JwksSourceSpecifier isJwtProvider_JwksSourceSpecifier `protobuf_oneof:"jwks_source_specifier"`
+	// If false, the JWT is removed in the request after a success verification. If true, the JWT is
+	// not removed in the request. Default value is false.
+	Forward bool `protobuf:"varint,5,opt,name=forward,proto3" json:"forward,omitempty"`
+	// Two fields below define where to extract the JWT from an HTTP request.
+	//
+	// If no explicit location is specified, the following default locations are tried in order:
+	//
+	// 1. The Authorization header using the `Bearer schema
+	// <https://tools.ietf.org/html/rfc6750#section-2.1>`_. Example::
+	//
+	//    Authorization: Bearer <token>.
+	//
+	// 2. `access_token <https://tools.ietf.org/html/rfc6750#section-2.3>`_ query parameter.
+	//
+	// Multiple JWTs can be verified for a request. Each JWT has to be extracted from the locations
+	// its provider specified or from the default locations.
+	//
+	// Specify the HTTP headers to extract JWT token. For examples, following config:
+	//
+	// .. code-block:: yaml
+	//
+	//   from_headers:
+	//   - name: x-goog-iap-jwt-assertion
+	//
+	// can be used to extract token from header::
+	//
+	//   ``x-goog-iap-jwt-assertion: <JWT>``.
+	//
+	FromHeaders []*JwtHeader `protobuf:"bytes,6,rep,name=from_headers,json=fromHeaders,proto3" json:"from_headers,omitempty"`
+	// JWT is sent in a query parameter. `jwt_params` represents the query parameter names.
+	//
+	// For example, if config is:
+	//
+	// .. code-block:: yaml
+	//
+	//   from_params:
+	//   - jwt_token
+	//
+	// The JWT format in query parameter is::
+	//
+	//    /path?jwt_token=<JWT>
+	//
+	FromParams []string `protobuf:"bytes,7,rep,name=from_params,json=fromParams,proto3" json:"from_params,omitempty"`
+	// This field specifies the header name to forward a successfully verified JWT payload to the
+	// backend. The forwarded data is::
+	//
+	//    base64url_encoded(jwt_payload_in_JSON)
+	//
+	// If it is not specified, the payload will not be forwarded.
+	ForwardPayloadHeader string `protobuf:"bytes,8,opt,name=forward_payload_header,json=forwardPayloadHeader,proto3" json:"forward_payload_header,omitempty"`
+	// If non empty, successfully verified JWT payloads will be written to StreamInfo DynamicMetadata
+	// in the format as: *namespace* is the jwt_authn filter name as **envoy.filters.http.jwt_authn**
+	// The value is the *protobuf::Struct*. The value of this field will be the key for its *fields*
+	// and the value is the *protobuf::Struct* converted from JWT JSON payload.
+	//
+	// For example, if payload_in_metadata is *my_payload*:
+	//
+	// .. code-block:: yaml
+	//
+	//   envoy.filters.http.jwt_authn:
+	//     my_payload:
+	//       iss: https://example.com
+	//       sub: test@example.com
+	//       aud: https://example.com
+	//       exp: 1501281058
+	//
+	PayloadInMetadata    string   `protobuf:"bytes,9,opt,name=payload_in_metadata,json=payloadInMetadata,proto3" json:"payload_in_metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JwtProvider) Reset()         { *m = JwtProvider{} }
+func (m *JwtProvider) String() string { return proto.CompactTextString(m) }
+func (*JwtProvider) ProtoMessage()    {}
+func (*JwtProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139cc57e0e9ac91, []int{0}
+}
+func (m *JwtProvider) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JwtProvider.Unmarshal(m, b)
+}
+func (m *JwtProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JwtProvider.Marshal(b, m, deterministic)
+}
+func (m *JwtProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JwtProvider.Merge(m, src)
+}
+func (m *JwtProvider) XXX_Size() int {
+	return xxx_messageInfo_JwtProvider.Size(m)
+}
+func (m *JwtProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_JwtProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JwtProvider proto.InternalMessageInfo
+
+type isJwtProvider_JwksSourceSpecifier interface {
+	isJwtProvider_JwksSourceSpecifier()
+	Equal(interface{}) bool
+}
+
+type JwtProvider_RemoteJwks struct {
+	RemoteJwks *RemoteJwks `protobuf:"bytes,3,opt,name=remote_jwks,json=remoteJwks,proto3,oneof" json:"remote_jwks,omitempty"`
+}
+type JwtProvider_LocalJwks struct {
+	LocalJwks *v3.DataSource `protobuf:"bytes,4,opt,name=local_jwks,json=localJwks,proto3,oneof" json:"local_jwks,omitempty"`
+}
+
+func (*JwtProvider_RemoteJwks) isJwtProvider_JwksSourceSpecifier() {}
+func (*JwtProvider_LocalJwks) isJwtProvider_JwksSourceSpecifier()  {}
+
+func (m *JwtProvider) GetJwksSourceSpecifier() isJwtProvider_JwksSourceSpecifier {
+	if m != nil {
+		return m.JwksSourceSpecifier
+	}
+	return nil
+}
+
+func (m *JwtProvider) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *JwtProvider) GetAudiences() []string {
+	if m != nil {
+		return m.Audiences
+	}
+	return nil
+}
+
+func (m *JwtProvider) GetRemoteJwks() *RemoteJwks {
+	if x, ok := m.GetJwksSourceSpecifier().(*JwtProvider_RemoteJwks); ok {
+		return x.RemoteJwks
+	}
+	return nil
+}
+
+func (m *JwtProvider) GetLocalJwks() *v3.DataSource {
+	if x, ok := m.GetJwksSourceSpecifier().(*JwtProvider_LocalJwks); ok {
+		return x.LocalJwks
+	}
+	return nil
+}
+
+func (m *JwtProvider) GetForward() bool {
+	if m != nil {
+		return m.Forward
+	}
+	return false
+}
+
+func (m *JwtProvider) GetFromHeaders() []*JwtHeader {
+	if m != nil {
+		return m.FromHeaders
+	}
+	return nil
+}
+
+func (m *JwtProvider) GetFromParams() []string {
+	if m != nil {
+		return m.FromParams
+	}
+	return nil
+}
+
+func (m *JwtProvider) GetForwardPayloadHeader() string {
+	if m != nil {
+		return m.ForwardPayloadHeader
+	}
+	return ""
+}
+
+func (m *JwtProvider) GetPayloadInMetadata() string {
+	if m != nil {
+		return m.PayloadInMetadata
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*JwtProvider) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*JwtProvider_RemoteJwks)(nil),
+		(*JwtProvider_LocalJwks)(nil),
+	}
+}
+
+// This message specifies how to fetch JWKS from remote and how to cache it.
+type RemoteJwks struct {
+	// The HTTP URI to fetch the JWKS. For example:
+	//
+	// .. code-block:: yaml
+	//
+	//    http_uri:
+	//      uri: https://www.googleapis.com/oauth2/v1/certs
+	//      cluster: jwt.www.googleapis.com|443
+	//      timeout: 1s
+	//
+	HttpUri *v3.HttpUri `protobuf:"bytes,1,opt,name=http_uri,json=httpUri,proto3" json:"http_uri,omitempty"`
+	// Duration after which the cached JWKS should be expired. If not specified, default cache
+	// duration is 5 minutes.
+	CacheDuration *types.Duration `protobuf:"bytes,2,opt,name=cache_duration,json=cacheDuration,proto3" json:"cache_duration,omitempty"`
+	// manually added from https://github.com/envoyproxy/envoy/blob/v1.17.0/api/envoy/extensions/filters/http/jwt_authn/v3/config.proto:
+	// Fetch Jwks asynchronously in the main thread before the listener is activated.
+	// Fetched Jwks can be used by all worker threads.
+	//
+	// If this feature is not enabled:
+	//
+	// * The Jwks is fetched on-demand when the requests come. During the fetching, first
+	//   few requests are paused until the Jwks is fetched.
+	// * Each worker thread fetches its own Jwks since Jwks cache is per worker thread.
+	//
+	// If this feature is enabled:
+	//
+	// * Fetched Jwks is done in the main thread before the listener is activated. Its fetched
+	//   Jwks can be used by all worker threads. Each worker thread doesn't need to fetch its own.
+	// * Jwks is ready when the requests come, not need to wait for the Jwks fetching.
+	//
+	AsyncFetch           *JwksAsyncFetch `protobuf:"bytes,3,opt,name=async_fetch,json=asyncFetch,proto3" json:"async_fetch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *RemoteJwks) Reset()         { *m = RemoteJwks{} }
+func (m *RemoteJwks) String() string { return proto.CompactTextString(m) }
+func (*RemoteJwks) ProtoMessage()    {}
+func (*RemoteJwks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139cc57e0e9ac91, []int{1}
+}
+func (m *RemoteJwks) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoteJwks.Unmarshal(m, b)
+}
+func (m *RemoteJwks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoteJwks.Marshal(b, m, deterministic)
+}
+func (m *RemoteJwks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteJwks.Merge(m, src)
+}
+func (m *RemoteJwks) XXX_Size() int {
+	return xxx_messageInfo_RemoteJwks.Size(m)
+}
+func (m *RemoteJwks) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteJwks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteJwks proto.InternalMessageInfo
+
+func (m *RemoteJwks) GetHttpUri() *v3.HttpUri {
+	if m != nil {
+		return m.HttpUri
+	}
+	return nil
+}
+
+func (m *RemoteJwks) GetCacheDuration() *types.Duration {
+	if m != nil {
+		return m.CacheDuration
+	}
+	return nil
+}
+
+func (m *RemoteJwks) GetAsyncFetch() *JwksAsyncFetch {
+	if m != nil {
+		return m.AsyncFetch
+	}
+	return nil
+}
+
+// manually added from https://github.com/envoyproxy/envoy/blob/v1.17.0/api/envoy/extensions/filters/http/jwt_authn/v3/config.proto:
+// Fetch Jwks asynchronously in the main thread when the filter config is parsed.
+// The listener is activated only after the Jwks is fetched.
+// When the Jwks is expired in the cache, it is fetched again in the main thread.
+// The fetched Jwks from the main thread can be used by all worker threads.
+type JwksAsyncFetch struct {
+	// If false, the listener is activated after the initial fetch is completed.
+	// The initial fetch result can be either successful or failed.
+	// If true, it is activated without waiting for the initial fetch to complete.
+	// Default is false.
+	FastListener         bool     `protobuf:"varint,1,opt,name=fast_listener,json=fastListener,proto3" json:"fast_listener,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JwksAsyncFetch) Reset()         { *m = JwksAsyncFetch{} }
+func (m *JwksAsyncFetch) String() string { return proto.CompactTextString(m) }
+func (*JwksAsyncFetch) ProtoMessage()    {}
+func (*JwksAsyncFetch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139cc57e0e9ac91, []int{2}
+}
+func (m *JwksAsyncFetch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JwksAsyncFetch.Unmarshal(m, b)
+}
+func (m *JwksAsyncFetch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JwksAsyncFetch.Marshal(b, m, deterministic)
+}
+func (m *JwksAsyncFetch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JwksAsyncFetch.Merge(m, src)
+}
+func (m *JwksAsyncFetch) XXX_Size() int {
+	return xxx_messageInfo_JwksAsyncFetch.Size(m)
+}
+func (m *JwksAsyncFetch) XXX_DiscardUnknown() {
+	xxx_messageInfo_JwksAsyncFetch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JwksAsyncFetch proto.InternalMessageInfo
+
+func (m *JwksAsyncFetch) GetFastListener() bool {
+	if m != nil {
+		return m.FastListener
+	}
+	return false
+}
+
+// This message specifies a header location to extract JWT token.
+type JwtHeader struct {
+	// The HTTP header name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The value prefix. The value format is "value_prefix<token>"
+	// For example, for "Authorization: Bearer <token>", value_prefix="Bearer " with a space at the
+	// end.
+	ValuePrefix          string   `protobuf:"bytes,2,opt,name=value_prefix,json=valuePrefix,proto3" json:"value_prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JwtHeader) Reset()         { *m = JwtHeader{} }
+func (m *JwtHeader) String() string { return proto.CompactTextString(m) }
+func (*JwtHeader) ProtoMessage()    {}
+func (*JwtHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139cc57e0e9ac91, []int{3}
+}
+func (m *JwtHeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JwtHeader.Unmarshal(m, b)
+}
+func (m *JwtHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JwtHeader.Marshal(b, m, deterministic)
+}
+func (m *JwtHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JwtHeader.Merge(m, src)
+}
+func (m *JwtHeader) XXX_Size() int {
+	return xxx_messageInfo_JwtHeader.Size(m)
+}
+func (m *JwtHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_JwtHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JwtHeader proto.InternalMessageInfo
+
+func (m *JwtHeader) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *JwtHeader) GetValuePrefix() string {
+	if m != nil {
+		return m.ValuePrefix
+	}
+	return ""
+}
+
+// Specify a required provider with audiences.
+type ProviderWithAudiences struct {
+	// Specify a required provider name.
+	ProviderName string `protobuf:"bytes,1,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
+	// This field overrides the one specified in the JwtProvider.
+	Audiences            []string `protobuf:"bytes,2,rep,name=audiences,proto3" json:"audiences,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProviderWithAudiences) Reset()         { *m = ProviderWithAudiences{} }
+func (m *ProviderWithAudiences) String() string { return proto.CompactTextString(m) }
+func (*ProviderWithAudiences) ProtoMessage()    {}
+func (*ProviderWithAudiences) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139cc57e0e9ac91, []int{4}
+}
+func (m *ProviderWithAudiences) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProviderWithAudiences.Unmarshal(m, b)
+}
+func (m *ProviderWithAudiences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProviderWithAudiences.Marshal(b, m, deterministic)
+}
+func (m *ProviderWithAudiences) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderWithAudiences.Merge(m, src)
+}
+func (m *ProviderWithAudiences) XXX_Size() int {
+	return xxx_messageInfo_ProviderWithAudiences.Size(m)
+}
+func (m *ProviderWithAudiences) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderWithAudiences.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderWithAudiences proto.InternalMessageInfo
+
+func (m *ProviderWithAudiences) GetProviderName() string {
+	if m != nil {
+		return m.ProviderName
+	}
+	return ""
+}
+
+func (m *ProviderWithAudiences) GetAudiences() []string {
+	if m != nil {
+		return m.Audiences
+	}
+	return nil
+}
+
+// This message specifies a Jwt requirement. An empty message means JWT verification is not
+// required. Here are some config examples:
+//
+// .. code-block:: yaml
+//
+//	# Example 1: not required with an empty message
+//
+//	# Example 2: require A
+//	provider_name: provider-A
+//
+//	# Example 3: require A or B
+//	requires_any:
+//	  requirements:
+//	    - provider_name: provider-A
+//	    - provider_name: provider-B
+//
+//	# Example 4: require A and B
+//	requires_all:
+//	  requirements:
+//	    - provider_name: provider-A
+//	    - provider_name: provider-B
+//
+//	# Example 5: require A and (B or C)
+//	requires_all:
+//	  requirements:
+//	    - provider_name: provider-A
+//	    - requires_any:
+//	      requirements:
+//	        - provider_name: provider-B
+//	        - provider_name: provider-C
+//
+//	# Example 6: require A or (B and C)
+//	requires_any:
+//	  requirements:
+//	    - provider_name: provider-A
+//	    - requires_all:
+//	      requirements:
+//	        - provider_name: provider-B
+//	        - provider_name: provider-C
+//
+//	# Example 7: A is optional (if token from A is provided, it must be valid, but also allows
+//	missing token.)
+//	requires_any:
+//	  requirements:
+//	  - provider_name: provider-A
+//	  - allow_missing: {}
+//
+//	# Example 8: A is optional and B is required.
+//	requires_all:
+//	  requirements:
+//	  - requires_any:
+//	      requirements:
+//	      - provider_name: provider-A
+//	      - allow_missing: {}
+//	  - provider_name: provider-B
+//
+// [#next-free-field: 7]
+type JwtRequirement struct {
+	// Types that are valid to be assigned to RequiresType:
+	//	*JwtRequirement_ProviderName
+	//	*JwtRequirement_ProviderAndAudiences
+	//	*JwtRequirement_RequiresAny
+	//	*JwtRequirement_RequiresAll
+	//	*JwtRequirement_AllowMissingOrFailed
+	//	*JwtRequirement_AllowMissing
+	RequiresType         isJwtRequirement_RequiresType `protobuf_oneof:"requires_type"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *JwtRequirement) Reset()         { *m = JwtRequirement{} }
+func (m *JwtRequirement) String() string { return proto.CompactTextString(m) }
+func (*JwtRequirement) ProtoMessage()    {}
+func (*JwtRequirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139cc57e0e9ac91, []int{5}
+}
+func (m *JwtRequirement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JwtRequirement.Unmarshal(m, b)
+}
+func (m *JwtRequirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JwtRequirement.Marshal(b, m, deterministic)
+}
+func (m *JwtRequirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JwtRequirement.Merge(m, src)
+}
+func (m *JwtRequirement) XXX_Size() int {
+	return xxx_messageInfo_JwtRequirement.Size(m)
+}
+func (m *JwtRequirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_JwtRequirement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JwtRequirement proto.InternalMessageInfo
+
+type isJwtRequirement_RequiresType interface {
+	isJwtRequirement_RequiresType()
+	Equal(interface{}) bool
+}
+
+type JwtRequirement_ProviderName struct {
+	ProviderName string `protobuf:"bytes,1,opt,name=provider_name,json=providerName,proto3,oneof" json:"provider_name,omitempty"`
+}
+type JwtRequirement_ProviderAndAudiences struct {
+	ProviderAndAudiences *ProviderWithAudiences `protobuf:"bytes,2,opt,name=provider_and_audiences,json=providerAndAudiences,proto3,oneof" json:"provider_and_audiences,omitempty"`
+}
+type JwtRequirement_RequiresAny struct {
+	RequiresAny *JwtRequirementOrList `protobuf:"bytes,3,opt,name=requires_any,json=requiresAny,proto3,oneof" json:"requires_any,omitempty"`
+}
+type JwtRequirement_RequiresAll struct {
+	RequiresAll *JwtRequirementAndList `protobuf:"bytes,4,opt,name=requires_all,json=requiresAll,proto3,oneof" json:"requires_all,omitempty"`
+}
+type JwtRequirement_AllowMissingOrFailed struct {
+	AllowMissingOrFailed *types.Empty `protobuf:"bytes,5,opt,name=allow_missing_or_failed,json=allowMissingOrFailed,proto3,oneof" json:"allow_missing_or_failed,omitempty"`
+}
+type JwtRequirement_AllowMissing struct {
+	AllowMissing *types.Empty `protobuf:"bytes,6,opt,name=allow_missing,json=allowMissing,proto3,oneof" json:"allow_missing,omitempty"`
+}
+
+func (*JwtRequirement_ProviderName) isJwtRequirement_RequiresType()         {}
+func (*JwtRequirement_ProviderAndAudiences) isJwtRequirement_RequiresType() {}
+func (*JwtRequirement_RequiresAny) isJwtRequirement_RequiresType()          {}
+func (*JwtRequirement_RequiresAll) isJwtRequirement_RequiresType()          {}
+func (*JwtRequirement_AllowMissingOrFailed) isJwtRequirement_RequiresType() {}
+func (*JwtRequirement_AllowMissing) isJwtRequirement_RequiresType()         {}
+
+func (m *JwtRequirement) GetRequiresType() isJwtRequirement_RequiresType {
+	if m != nil {
+		return m.RequiresType
+	}
+	return nil
+}
+
+func (m *JwtRequirement) GetProviderName() string {
+	if x, ok := m.GetRequiresType().(*JwtRequirement_ProviderName); ok {
+		return x.ProviderName
+	}
+	return ""
+}
+
+func (m *JwtRequirement) GetProviderAndAudiences() *ProviderWithAudiences {
+	if x, ok := m.GetRequiresType().(*JwtRequirement_ProviderAndAudiences); ok {
+		return x.ProviderAndAudiences
+	}
+	return nil
+}
+
+func (m *JwtRequirement) GetRequiresAny() *JwtRequirementOrList {
+	if x, ok := m.GetRequiresType().(*JwtRequirement_RequiresAny); ok {
+		return x.RequiresAny
+	}
+	return nil
+}
+
+func (m *JwtRequirement) GetRequiresAll() *JwtRequirementAndList {
+	if x, ok := m.GetRequiresType().(*JwtRequirement_RequiresAll); ok {
+		return x.RequiresAll
+	}
+	return nil
+}
+
+func (m *JwtRequirement) GetAllowMissingOrFailed() *types.Empty {
+	if x, ok := m.GetRequiresType().(*JwtRequirement_AllowMissingOrFailed); ok {
+		return x.AllowMissingOrFailed
+	}
+	return nil
+}
+
+func (m *JwtRequirement) GetAllowMissing() *types.Empty {
+	if x, ok := m.GetRequiresType().(*JwtRequirement_AllowMissing); ok {
+		return x.AllowMissing
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*JwtRequirement) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*JwtRequirement_ProviderName)(nil),
+		(*JwtRequirement_ProviderAndAudiences)(nil),
+		(*JwtRequirement_RequiresAny)(nil),
+		(*JwtRequirement_RequiresAll)(nil),
+		(*JwtRequirement_AllowMissingOrFailed)(nil),
+		(*JwtRequirement_AllowMissing)(nil),
+	}
+}
+
+// This message specifies a list of RequiredProvider.
+// Their results are OR-ed; if any one of them passes, the result is passed
+type JwtRequirementOrList struct {
+	// Specify a list of JwtRequirement.
+	Requirements         []*JwtRequirement `protobuf:"bytes,1,rep,name=requirements,proto3" json:"requirements,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *JwtRequirementOrList) Reset()         { *m = JwtRequirementOrList{} }
+func (m *JwtRequirementOrList) String() string { return proto.CompactTextString(m) }
+func (*JwtRequirementOrList) ProtoMessage()    {}
+func (*JwtRequirementOrList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139cc57e0e9ac91, []int{6}
+}
+func (m *JwtRequirementOrList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JwtRequirementOrList.Unmarshal(m, b)
+}
+func (m *JwtRequirementOrList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JwtRequirementOrList.Marshal(b, m, deterministic)
+}
+func (m *JwtRequirementOrList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JwtRequirementOrList.Merge(m, src)
+}
+func (m *JwtRequirementOrList) XXX_Size() int {
+	return xxx_messageInfo_JwtRequirementOrList.Size(m)
+}
+func (m *JwtRequirementOrList) XXX_DiscardUnknown() {
+	xxx_messageInfo_JwtRequirementOrList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JwtRequirementOrList proto.InternalMessageInfo
+
+func (m *JwtRequirementOrList) GetRequirements() []*JwtRequirement {
+	if m != nil {
+		return m.Requirements
+	}
+	return nil
+}
+
+// This message specifies a list of RequiredProvider.
+// Their results are AND-ed; all of them must pass, if one of them fails or missing, it fails.
+type JwtRequirementAndList struct {
+	// Specify a list of JwtRequirement.
+	Requirements         []*JwtRequirement `protobuf:"bytes,1,rep,name=requirements,proto3" json:"requirements,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *JwtRequirementAndList) Reset()         { *m = JwtRequirementAndList{} }
+func (m *JwtRequirementAndList) String() string { return proto.CompactTextString(m) }
+func (*JwtRequirementAndList) ProtoMessage()    {}
+func (*JwtRequirementAndList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139cc57e0e9ac91, []int{7}
+}
+func (m *JwtRequirementAndList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JwtRequirementAndList.Unmarshal(m, b)
+}
+func (m *JwtRequirementAndList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JwtRequirementAndList.Marshal(b, m, deterministic)
+}
+func (m *JwtRequirementAndList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JwtRequirementAndList.Merge(m, src)
+}
+func (m *JwtRequirementAndList) XXX_Size() int {
+	return xxx_messageInfo_JwtRequirementAndList.Size(m)
+}
+func (m *JwtRequirementAndList) XXX_DiscardUnknown() {
+	xxx_messageInfo_JwtRequirementAndList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JwtRequirementAndList proto.InternalMessageInfo
+
+func (m *JwtRequirementAndList) GetRequirements() []*JwtRequirement {
+	if m != nil {
+		return m.Requirements
+	}
+	return nil
+}
+
+// This message specifies a Jwt requirement for a specific Route condition.
+// Example 1:
+//
+// .. code-block:: yaml
+//
+//   - match:
+//     prefix: /healthz
+//
+// In above example, "requires" field is empty for /healthz prefix match,
+// it means that requests matching the path prefix don't require JWT authentication.
+//
+// Example 2:
+//
+// .. code-block:: yaml
+//
+//   - match:
+//     prefix: /
+//     requires: { provider_name: provider-A }
+//
+// In above example, all requests matched the path prefix require jwt authentication
+// from "provider-A".
+type RequirementRule struct {
+	// The route matching parameter. Only when the match is satisfied, the "requires" field will
+	// apply.
+	//
+	// For example: following match will match all requests.
+	//
+	// .. code-block:: yaml
+	//
+	//    match:
+	//      prefix: /
+	//
+	Match *v31.RouteMatch `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	// Specify a Jwt Requirement. Please detail comment in message JwtRequirement.
+	Requires             *JwtRequirement `protobuf:"bytes,2,opt,name=requires,proto3" json:"requires,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *RequirementRule) Reset()         { *m = RequirementRule{} }
+func (m *RequirementRule) String() string { return proto.CompactTextString(m) }
+func (*RequirementRule) ProtoMessage()    {}
+func (*RequirementRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139cc57e0e9ac91, []int{8}
+}
+func (m *RequirementRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequirementRule.Unmarshal(m, b)
+}
+func (m *RequirementRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequirementRule.Marshal(b, m, deterministic)
+}
+func (m *RequirementRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequirementRule.Merge(m, src)
+}
+func (m *RequirementRule) XXX_Size() int {
+	return xxx_messageInfo_RequirementRule.Size(m)
+}
+func (m *RequirementRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequirementRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequirementRule proto.InternalMessageInfo
+
+func (m *RequirementRule) GetMatch() *v31.RouteMatch {
+	if m != nil {
+		return m.Match
+	}
+	return nil
+}
+
+func (m *RequirementRule) GetRequires() *JwtRequirement {
+	if m != nil {
+		return m.Requires
+	}
+	return nil
+}
+
+// This message specifies Jwt requirements based on stream_info.filterState.
+// This FilterState should use `Router::StringAccessor` object to set a string value.
+// Other HTTP filters can use it to specify Jwt requirements dynamically.
+//
+// Example:
+//
+// .. code-block:: yaml
+//
+//	name: jwt_selector
+//	requires:
+//	  issuer_1:
+//	    provider_name: issuer1
+//	  issuer_2:
+//	    provider_name: issuer2
+//
+// If a filter set "jwt_selector" with "issuer_1" to FilterState for a request,
+// jwt_authn filter will use JwtRequirement{"provider_name": "issuer1"} to verify.
+type FilterStateRule struct {
+	// The filter state name to retrieve the `Router::StringAccessor` object.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// A map of string keys to requirements. The string key is the string value
+	// in the FilterState with the name specified in the *name* field above.
+	Requires             map[string]*JwtRequirement `protobuf:"bytes,3,rep,name=requires,proto3" json:"requires,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *FilterStateRule) Reset()         { *m = FilterStateRule{} }
+func (m *FilterStateRule) String() string { return proto.CompactTextString(m) }
+func (*FilterStateRule) ProtoMessage()    {}
+func (*FilterStateRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139cc57e0e9ac91, []int{9}
+}
+func (m *FilterStateRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilterStateRule.Unmarshal(m, b)
+}
+func (m *FilterStateRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilterStateRule.Marshal(b, m, deterministic)
+}
+func (m *FilterStateRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilterStateRule.Merge(m, src)
+}
+func (m *FilterStateRule) XXX_Size() int {
+	return xxx_messageInfo_FilterStateRule.Size(m)
+}
+func (m *FilterStateRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilterStateRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilterStateRule proto.InternalMessageInfo
+
+func (m *FilterStateRule) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FilterStateRule) GetRequires() map[string]*JwtRequirement {
+	if m != nil {
+		return m.Requires
+	}
+	return nil
+}
+
+// This is the Envoy HTTP filter config for JWT authentication.
+//
+// For example:
+//
+// .. code-block:: yaml
+//
+//	providers:
+//	   provider1:
+//	     issuer: issuer1
+//	     audiences:
+//	     - audience1
+//	     - audience2
+//	     remote_jwks:
+//	       http_uri:
+//	         uri: https://example.com/.well-known/jwks.json
+//	         cluster: example_jwks_cluster
+//	         timeout: 1s
+//	   provider2:
+//	     issuer: issuer2
+//	     local_jwks:
+//	       inline_string: jwks_string
+//
+//	rules:
+//	   # Not jwt verification is required for /health path
+//	   - match:
+//	       prefix: /health
+//
+//	   # Jwt verification for provider1 is required for path prefixed with "prefix"
+//	   - match:
+//	       prefix: /prefix
+//	     requires:
+//	       provider_name: provider1
+//
+//	   # Jwt verification for either provider1 or provider2 is required for all other requests.
+//	   - match:
+//	       prefix: /
+//	     requires:
+//	       requires_any:
+//	         requirements:
+//	           - provider_name: provider1
+//	           - provider_name: provider2
+type JwtAuthentication struct {
+	// Map of provider names to JwtProviders.
+	//
+	// .. code-block:: yaml
+	//
+	//   providers:
+	//     provider1:
+	//        issuer: issuer1
+	//        audiences:
+	//        - audience1
+	//        - audience2
+	//        remote_jwks:
+	//          http_uri:
+	//            uri: https://example.com/.well-known/jwks.json
+	//            cluster: example_jwks_cluster
+	//            timeout: 1s
+	//      provider2:
+	//        issuer: provider2
+	//        local_jwks:
+	//          inline_string: jwks_string
+	//
+	Providers map[string]*JwtProvider `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Specifies requirements based on the route matches. The first matched requirement will be
+	// applied. If there are overlapped match conditions, please put the most specific match first.
+	//
+	// Examples
+	//
+	// .. code-block:: yaml
+	//
+	//   rules:
+	//     - match:
+	//         prefix: /healthz
+	//     - match:
+	//         prefix: /baz
+	//       requires:
+	//         provider_name: provider1
+	//     - match:
+	//         prefix: /foo
+	//       requires:
+	//         requires_any:
+	//           requirements:
+	//             - provider_name: provider1
+	//             - provider_name: provider2
+	//     - match:
+	//         prefix: /bar
+	//       requires:
+	//         requires_all:
+	//           requirements:
+	//             - provider_name: provider1
+	//             - provider_name: provider2
+	//
+	Rules []*RequirementRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	// This message specifies Jwt requirements based on stream_info.filterState.
+	// Other HTTP filters can use it to specify Jwt requirements dynamically.
+	// The *rules* field above is checked first, if it could not find any matches,
+	// check this one.
+	FilterStateRules *FilterStateRule `protobuf:"bytes,3,opt,name=filter_state_rules,json=filterStateRules,proto3" json:"filter_state_rules,omitempty"`
+	// When set to true, bypass the `CORS preflight request
+	// <http://www.w3.org/TR/cors/#cross-origin-request-with-preflight>`_ regardless of JWT
+	// requirements specified in the rules.
+	BypassCorsPreflight  bool     `protobuf:"varint,4,opt,name=bypass_cors_preflight,json=bypassCorsPreflight,proto3" json:"bypass_cors_preflight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JwtAuthentication) Reset()         { *m = JwtAuthentication{} }
+func (m *JwtAuthentication) String() string { return proto.CompactTextString(m) }
+func (*JwtAuthentication) ProtoMessage()    {}
+func (*JwtAuthentication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139cc57e0e9ac91, []int{10}
+}
+func (m *JwtAuthentication) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JwtAuthentication.Unmarshal(m, b)
+}
+func (m *JwtAuthentication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JwtAuthentication.Marshal(b, m, deterministic)
+}
+func (m *JwtAuthentication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JwtAuthentication.Merge(m, src)
+}
+func (m *JwtAuthentication) XXX_Size() int {
+	return xxx_messageInfo_JwtAuthentication.Size(m)
+}
+func (m *JwtAuthentication) XXX_DiscardUnknown() {
+	xxx_messageInfo_JwtAuthentication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JwtAuthentication proto.InternalMessageInfo
+
+func (m *JwtAuthentication) GetProviders() map[string]*JwtProvider {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
+}
+
+func (m *JwtAuthentication) GetRules() []*RequirementRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+func (m *JwtAuthentication) GetFilterStateRules() *FilterStateRule {
+	if m != nil {
+		return m.FilterStateRules
+	}
+	return nil
+}
+
+func (m *JwtAuthentication) GetBypassCorsPreflight() bool {
+	if m != nil {
+		return m.BypassCorsPreflight
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*JwtProvider)(nil), "envoy.extensions.filters.http.jwt_authn.v3.JwtProvider")
+	proto.RegisterType((*RemoteJwks)(nil), "envoy.extensions.filters.http.jwt_authn.v3.RemoteJwks")
+	proto.RegisterType((*JwksAsyncFetch)(nil), "envoy.extensions.filters.http.jwt_authn.v3.JwksAsyncFetch")
+	proto.RegisterType((*JwtHeader)(nil), "envoy.extensions.filters.http.jwt_authn.v3.JwtHeader")
+	proto.RegisterType((*ProviderWithAudiences)(nil), "envoy.extensions.filters.http.jwt_authn.v3.ProviderWithAudiences")
+	proto.RegisterType((*JwtRequirement)(nil), "envoy.extensions.filters.http.jwt_authn.v3.JwtRequirement")
+	proto.RegisterType((*JwtRequirementOrList)(nil), "envoy.extensions.filters.http.jwt_authn.v3.JwtRequirementOrList")
+	proto.RegisterType((*JwtRequirementAndList)(nil), "envoy.extensions.filters.http.jwt_authn.v3.JwtRequirementAndList")
+	proto.RegisterType((*RequirementRule)(nil), "envoy.extensions.filters.http.jwt_authn.v3.RequirementRule")
+	proto.RegisterType((*FilterStateRule)(nil), "envoy.extensions.filters.http.jwt_authn.v3.FilterStateRule")
+	proto.RegisterMapType((map[string]*JwtRequirement)(nil), "envoy.extensions.filters.http.jwt_authn.v3.FilterStateRule.RequiresEntry")
+	proto.RegisterType((*JwtAuthentication)(nil), "envoy.extensions.filters.http.jwt_authn.v3.JwtAuthentication")
+	proto.RegisterMapType((map[string]*JwtProvider)(nil), "envoy.extensions.filters.http.jwt_authn.v3.JwtAuthentication.ProvidersEntry")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/http/jwt_authn/v3/config.proto", fileDescriptor_c139cc57e0e9ac91)
+}
+
+var fileDescriptor_c139cc57e0e9ac91 = []byte{
+	// 1215 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4b, 0x8f, 0x1b, 0x45,
+	0x10, 0xde, 0xb1, 0xf7, 0x61, 0x97, 0x77, 0xf3, 0xe8, 0xec, 0x6e, 0x86, 0x4d, 0x48, 0x1c, 0x47,
+	0x48, 0x2b, 0x04, 0x33, 0xd2, 0x86, 0x40, 0x94, 0x08, 0x29, 0x76, 0x1e, 0x72, 0x96, 0x2c, 0x31,
+	0x13, 0xf1, 0x0a, 0x87, 0x51, 0xef, 0xb8, 0x6d, 0xf7, 0xee, 0x78, 0x7a, 0xe8, 0xee, 0xb1, 0xd7,
+	0x37, 0xb8, 0x72, 0xe0, 0xc0, 0x4f, 0xe0, 0xc4, 0x81, 0x23, 0x07, 0x7e, 0x0f, 0x12, 0x12, 0x7f,
+	0x00, 0x09, 0xe5, 0x84, 0xfa, 0x31, 0x7e, 0x6c, 0xac, 0x10, 0x13, 0xc4, 0xad, 0xba, 0xaa, 0xbe,
+	0xaf, 0xaa, 0xab, 0xba, 0x6a, 0x6c, 0x38, 0xea, 0x52, 0xd9, 0xcb, 0x0e, 0xbd, 0x88, 0xf5, 0x7d,
+	0xc1, 0x62, 0xf6, 0x2e, 0x65, 0x7e, 0x37, 0x66, 0xcc, 0x4f, 0x39, 0x3b, 0x22, 0x91, 0x14, 0xe6,
+	0x84, 0x53, 0xea, 0x93, 0x13, 0x49, 0x78, 0x82, 0x63, 0x9f, 0x24, 0x03, 0x36, 0xd2, 0xc7, 0x44,
+	0x50, 0x96, 0x08, 0xbf, 0x43, 0x63, 0x49, 0xb8, 0xf0, 0x7b, 0x52, 0xa6, 0xfe, 0xd1, 0x50, 0x86,
+	0x38, 0x93, 0xbd, 0xc4, 0x1f, 0xdc, 0xf0, 0x23, 0x96, 0x74, 0x68, 0xd7, 0x4b, 0x39, 0x93, 0x0c,
+	0xbd, 0xad, 0x81, 0xde, 0x04, 0xe8, 0x59, 0xa0, 0xa7, 0x80, 0xde, 0x18, 0xe8, 0x0d, 0x6e, 0xec,
+	0x5c, 0x35, 0x41, 0x0c, 0xde, 0x8f, 0x18, 0x27, 0x8a, 0xee, 0x10, 0x0b, 0x62, 0xc8, 0x76, 0xae,
+	0xcf, 0x75, 0x50, 0x44, 0x61, 0xc6, 0xa9, 0x75, 0x7a, 0x67, 0xc6, 0x89, 0xb3, 0x4c, 0x6a, 0x2f,
+	0x2d, 0x84, 0x11, 0xeb, 0xa7, 0x2c, 0x21, 0x89, 0x14, 0xd6, 0xfb, 0x4a, 0x97, 0xb1, 0x6e, 0x4c,
+	0x7c, 0x7d, 0x3a, 0xcc, 0x3a, 0x7e, 0x3b, 0xe3, 0x58, 0x52, 0x96, 0x58, 0xfb, 0xa5, 0xd3, 0x76,
+	0xd2, 0x4f, 0xe5, 0xc8, 0x1a, 0x2f, 0x0e, 0x70, 0x4c, 0xdb, 0x58, 0x05, 0xb0, 0x82, 0x35, 0x6c,
+	0x76, 0x59, 0x97, 0x69, 0xd1, 0x57, 0x92, 0xd5, 0x22, 0x72, 0x22, 0x8d, 0x92, 0x9c, 0x48, 0xa3,
+	0xab, 0x3d, 0x2f, 0x42, 0x65, 0x7f, 0x28, 0x5b, 0x9c, 0x0d, 0x68, 0x9b, 0x70, 0xb4, 0x0d, 0xab,
+	0x54, 0x88, 0x8c, 0x70, 0xd7, 0xa9, 0x3a, 0xbb, 0xe5, 0xc0, 0x9e, 0xd0, 0x65, 0x28, 0xe3, 0xac,
+	0x4d, 0x49, 0x12, 0x11, 0xe1, 0x16, 0xaa, 0xc5, 0xdd, 0x72, 0x30, 0x51, 0xa0, 0x2f, 0xa1, 0xc2,
+	0x49, 0x9f, 0x49, 0x12, 0x1e, 0x0d, 0x8f, 0x85, 0x5b, 0xac, 0x3a, 0xbb, 0x95, 0xbd, 0xf7, 0xbd,
+	0x57, 0xaf, 0xbd, 0x17, 0x68, 0xf8, 0xfe, 0xf0, 0x58, 0x34, 0x97, 0x02, 0xe0, 0xe3, 0x13, 0xaa,
+	0x03, 0xc4, 0x2c, 0xc2, 0xb1, 0x61, 0x5e, 0xd6, 0xcc, 0x55, 0xcb, 0x6c, 0x3b, 0xad, 0x1a, 0xa1,
+	0x38, 0xee, 0x63, 0x89, 0x9f, 0xb2, 0x8c, 0x47, 0xa4, 0xb9, 0x14, 0x94, 0x35, 0x4a, 0x53, 0xb8,
+	0xb0, 0xd6, 0x61, 0x7c, 0x88, 0x79, 0xdb, 0x5d, 0xa9, 0x3a, 0xbb, 0xa5, 0x20, 0x3f, 0xa2, 0x2f,
+	0x60, 0xbd, 0xc3, 0x59, 0x3f, 0xec, 0x11, 0xdc, 0x26, 0x5c, 0xb8, 0xab, 0xd5, 0xe2, 0x6e, 0x65,
+	0xef, 0xe6, 0x22, 0x89, 0xef, 0x0f, 0x65, 0x53, 0xa3, 0x83, 0x8a, 0xa2, 0x32, 0xb2, 0x40, 0x57,
+	0x41, 0x1f, 0xc3, 0x14, 0x73, 0xdc, 0x17, 0xee, 0x9a, 0xae, 0x18, 0x28, 0x55, 0x4b, 0x6b, 0xd0,
+	0x7b, 0xb0, 0x6d, 0xb3, 0x08, 0x53, 0x3c, 0x8a, 0x19, 0x6e, 0xdb, 0x2c, 0xdc, 0x92, 0x2e, 0xfc,
+	0xa6, 0xb5, 0xb6, 0x8c, 0xd1, 0xf0, 0x22, 0x0f, 0x2e, 0xe4, 0xde, 0x34, 0x09, 0xfb, 0x44, 0xe2,
+	0x36, 0x96, 0xd8, 0x2d, 0x6b, 0xc8, 0x79, 0x6b, 0x7a, 0x94, 0x1c, 0x58, 0x43, 0xe3, 0x32, 0x6c,
+	0xa9, 0xba, 0x85, 0x42, 0x97, 0x25, 0x14, 0x29, 0x89, 0x68, 0x87, 0x12, 0x8e, 0x8a, 0x7f, 0x35,
+	0x9c, 0xda, 0xef, 0x0e, 0xc0, 0xa4, 0xf0, 0xe8, 0x16, 0x94, 0xf2, 0xb7, 0xac, 0xbb, 0x5f, 0xd9,
+	0x7b, 0x73, 0x7e, 0xa1, 0x9b, 0x52, 0xa6, 0x9f, 0x72, 0x1a, 0xac, 0xf5, 0x8c, 0x80, 0xee, 0xc2,
+	0x99, 0x08, 0x47, 0x3d, 0x12, 0xe6, 0xaf, 0xd7, 0x2d, 0x68, 0xfc, 0x1b, 0x9e, 0x79, 0xbe, 0x5e,
+	0xfe, 0x7c, 0xbd, 0xfb, 0xd6, 0x21, 0xd8, 0xd0, 0x80, 0xfc, 0x88, 0xbe, 0x82, 0x0a, 0x16, 0xa3,
+	0x24, 0x0a, 0x3b, 0x44, 0x46, 0x3d, 0xfb, 0x82, 0x6e, 0x2f, 0xd6, 0x88, 0x63, 0x51, 0x57, 0x14,
+	0x0f, 0x15, 0x43, 0x00, 0x78, 0x2c, 0xd7, 0x6e, 0xc2, 0x99, 0x59, 0x2b, 0xba, 0x0e, 0x1b, 0x1d,
+	0x2c, 0x64, 0x18, 0x53, 0x21, 0x49, 0x62, 0x5f, 0x7b, 0x29, 0x58, 0x57, 0xca, 0xc7, 0x56, 0x57,
+	0xfb, 0x08, 0xca, 0xe3, 0xee, 0xa2, 0x4b, 0xb0, 0x9c, 0xe0, 0x3e, 0x31, 0x63, 0xd1, 0x58, 0x7b,
+	0xde, 0x58, 0xe6, 0x85, 0xaa, 0x13, 0x68, 0x25, 0xba, 0x06, 0xeb, 0x03, 0x1c, 0x67, 0x24, 0x4c,
+	0x39, 0xe9, 0xd0, 0x13, 0x7d, 0xfb, 0x72, 0x50, 0xd1, 0xba, 0x96, 0x56, 0xd5, 0x9e, 0xc1, 0x56,
+	0x3e, 0x64, 0x9f, 0x53, 0xd9, 0xab, 0x8f, 0x67, 0xe7, 0x3a, 0x6c, 0xa4, 0xd6, 0x10, 0x4e, 0x22,
+	0x04, 0xeb, 0xb9, 0xf2, 0x63, 0x15, 0xe0, 0xa5, 0xe3, 0x57, 0xfb, 0x71, 0x59, 0x5d, 0x50, 0x06,
+	0xe4, 0xeb, 0x8c, 0x72, 0xd2, 0x27, 0x89, 0x44, 0x6f, 0xcd, 0x65, 0x6d, 0x2e, 0x9d, 0xe2, 0x1d,
+	0xc1, 0xf6, 0xd8, 0x0d, 0x27, 0xed, 0x70, 0x3a, 0x88, 0xea, 0x40, 0x7d, 0x91, 0x0e, 0xcc, 0xbd,
+	0x5f, 0x73, 0x29, 0xd8, 0xcc, 0x43, 0xd4, 0x93, 0xf6, 0xe4, 0xde, 0x04, 0xd6, 0xb9, 0x49, 0x58,
+	0x84, 0x38, 0x19, 0xd9, 0x96, 0xdf, 0x5d, 0x70, 0xf6, 0xa6, 0xee, 0xfc, 0x84, 0xab, 0xce, 0x35,
+	0x97, 0x82, 0x4a, 0xce, 0x5b, 0x4f, 0x46, 0xa8, 0x33, 0x1d, 0x26, 0x8e, 0xdd, 0xe5, 0xc5, 0xef,
+	0x35, 0x1b, 0xa6, 0x9e, 0xb4, 0x5f, 0x88, 0x13, 0xc7, 0xe8, 0x09, 0x5c, 0xc4, 0x71, 0xcc, 0x86,
+	0x61, 0x9f, 0x0a, 0x41, 0x93, 0x6e, 0xc8, 0x78, 0xd8, 0xc1, 0x34, 0x26, 0x66, 0xe9, 0x54, 0xf6,
+	0xb6, 0x5f, 0x98, 0x85, 0x07, 0x6a, 0x95, 0xab, 0xfa, 0x68, 0xe0, 0x81, 0xc1, 0x3d, 0xe1, 0x0f,
+	0x35, 0x0a, 0x7d, 0x08, 0x1b, 0x33, 0x84, 0xee, 0xea, 0x3f, 0xd0, 0xac, 0x4f, 0xd3, 0x34, 0xce,
+	0xc2, 0xc6, 0xf8, 0xde, 0x72, 0x94, 0x92, 0xda, 0x37, 0x0e, 0x6c, 0xce, 0x2b, 0x18, 0xea, 0x8d,
+	0x2b, 0xa4, 0x94, 0xc2, 0x75, 0xaa, 0xc5, 0xc5, 0x67, 0x6f, 0x9a, 0xb7, 0x51, 0x7a, 0xde, 0x58,
+	0xf9, 0xc1, 0x29, 0x94, 0x0a, 0xc1, 0x0c, 0x73, 0xed, 0x5b, 0x07, 0xb6, 0xe6, 0x16, 0xf3, 0x7f,
+	0xcc, 0xe1, 0x67, 0x07, 0xce, 0x4e, 0xf9, 0x05, 0x59, 0x4c, 0x50, 0x1d, 0x56, 0xfa, 0x58, 0xad,
+	0x1d, 0xb3, 0xf5, 0xae, 0xcd, 0x6e, 0x3d, 0xfd, 0xe5, 0xd6, 0xdf, 0x28, 0x25, 0x1c, 0x28, 0x47,
+	0xcd, 0xfe, 0x9d, 0x53, 0x38, 0xe7, 0x04, 0x06, 0x89, 0x3e, 0x83, 0x52, 0x5e, 0x6e, 0x3b, 0x3a,
+	0xaf, 0x91, 0x7c, 0x30, 0xe6, 0xaa, 0x7d, 0x5f, 0x80, 0xb3, 0x0f, 0x35, 0xec, 0xa9, 0xc4, 0x92,
+	0xe8, 0x74, 0x5f, 0xba, 0x8a, 0xc8, 0x54, 0x22, 0x45, 0x5d, 0xc5, 0x47, 0x8b, 0x24, 0x72, 0x2a,
+	0x96, 0x67, 0xb3, 0x12, 0x0f, 0x12, 0xc9, 0x47, 0x93, 0xbc, 0x76, 0x86, 0xb0, 0x31, 0x63, 0x42,
+	0xe7, 0xa0, 0x78, 0x4c, 0x46, 0x76, 0x79, 0x29, 0x11, 0xb5, 0x60, 0x45, 0x2f, 0xc0, 0xff, 0xa0,
+	0x1e, 0x86, 0xe8, 0x76, 0xe1, 0x96, 0x53, 0xfb, 0xa3, 0x08, 0xe7, 0xf7, 0x87, 0xb2, 0x9e, 0xc9,
+	0x1e, 0x49, 0x24, 0x8d, 0xcc, 0xe7, 0xe3, 0x08, 0xca, 0xf9, 0x92, 0xc9, 0x1f, 0xcf, 0xe3, 0x05,
+	0xe3, 0xcd, 0x32, 0x8e, 0x97, 0x99, 0xbd, 0xf9, 0x84, 0x1e, 0x7d, 0x02, 0x2b, 0x3c, 0x8b, 0xed,
+	0x1e, 0xae, 0xec, 0xdd, 0x59, 0xec, 0x67, 0xce, 0xcc, 0xcb, 0x0b, 0x0c, 0x13, 0xa2, 0x80, 0x0c,
+	0x26, 0x14, 0xaa, 0xf2, 0xa1, 0xe1, 0x37, 0x1b, 0xf1, 0xce, 0x6b, 0xb4, 0x2f, 0x38, 0xd7, 0x99,
+	0x55, 0x08, 0xb4, 0x07, 0x5b, 0x87, 0xa3, 0x14, 0x0b, 0x11, 0x46, 0x8c, 0x0b, 0xfd, 0xc1, 0x8a,
+	0x69, 0xb7, 0x27, 0xf5, 0x62, 0x2c, 0x05, 0x17, 0x8c, 0xf1, 0x1e, 0xe3, 0xa2, 0x95, 0x9b, 0x76,
+	0x32, 0x38, 0x33, 0x5b, 0x8e, 0x39, 0xdd, 0x3e, 0x98, 0xed, 0xf6, 0x07, 0x0b, 0x56, 0x3f, 0xe7,
+	0x9f, 0x6a, 0x75, 0xe3, 0x17, 0xe7, 0xd7, 0x3f, 0x97, 0x9d, 0x9f, 0x7e, 0xbb, 0xe2, 0xc0, 0x2d,
+	0xca, 0x0c, 0x61, 0xca, 0xd9, 0xc9, 0x68, 0x01, 0xee, 0x46, 0xe5, 0x9e, 0x9e, 0xe2, 0x16, 0x67,
+	0x92, 0xb5, 0x9c, 0x67, 0xf8, 0xd5, 0xfe, 0x7b, 0xa4, 0xc7, 0xdd, 0x7f, 0xfb, 0xff, 0xe3, 0x70,
+	0x55, 0x6f, 0xe6, 0x1b, 0x7f, 0x0f, 0x00, 0x08, 0xeb, 0x58, 0xfa, 0xe7, 0x0c, 0x00, 0x00,
+}
+
+func (this *JwtProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtProvider)
+	if !ok {
+		that2, ok := that.(JwtProvider)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Issuer != that1.Issuer {
+		return false
+	}
+	if len(this.Audiences) != len(that1.Audiences) {
+		return false
+	}
+	for i := range this.Audiences {
+		if this.Audiences[i] != that1.Audiences[i] {
+			return false
+		}
+	}
+	if that1.JwksSourceSpecifier == nil {
+		if this.JwksSourceSpecifier != nil {
+			return false
+		}
+	} else if this.JwksSourceSpecifier == nil {
+		return false
+	} else if !this.JwksSourceSpecifier.Equal(that1.JwksSourceSpecifier) {
+		return false
+	}
+	if this.Forward != that1.Forward {
+		return false
+	}
+	if len(this.FromHeaders) != len(that1.FromHeaders) {
+		return false
+	}
+	for i := range this.FromHeaders {
+		if !this.FromHeaders[i].Equal(that1.FromHeaders[i]) {
+			return false
+		}
+	}
+	if len(this.FromParams) != len(that1.FromParams) {
+		return false
+	}
+	for i := range this.FromParams {
+		if this.FromParams[i] != that1.FromParams[i] {
+			return false
+		}
+	}
+	if this.ForwardPayloadHeader != that1.ForwardPayloadHeader {
+		return false
+	}
+	if this.PayloadInMetadata != that1.PayloadInMetadata {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *JwtProvider_RemoteJwks) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtProvider_RemoteJwks)
+	if !ok {
+		that2, ok := that.(JwtProvider_RemoteJwks)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RemoteJwks.Equal(that1.RemoteJwks) {
+		return false
+	}
+	return true
+}
+func (this *JwtProvider_LocalJwks) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtProvider_LocalJwks)
+	if !ok {
+		that2, ok := that.(JwtProvider_LocalJwks)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.LocalJwks.Equal(that1.LocalJwks) {
+		return false
+	}
+	return true
+}
+func (this *RemoteJwks) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RemoteJwks)
+	if !ok {
+		that2, ok := that.(RemoteJwks)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.HttpUri.Equal(that1.HttpUri) {
+		return false
+	}
+	if !this.CacheDuration.Equal(that1.CacheDuration) {
+		return false
+	}
+	if !this.AsyncFetch.Equal(that1.AsyncFetch) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *JwksAsyncFetch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwksAsyncFetch)
+	if !ok {
+		that2, ok := that.(JwksAsyncFetch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FastListener != that1.FastListener {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *JwtHeader) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtHeader)
+	if !ok {
+		that2, ok := that.(JwtHeader)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.ValuePrefix != that1.ValuePrefix {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ProviderWithAudiences) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProviderWithAudiences)
+	if !ok {
+		that2, ok := that.(ProviderWithAudiences)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProviderName != that1.ProviderName {
+		return false
+	}
+	if len(this.Audiences) != len(that1.Audiences) {
+		return false
+	}
+	for i := range this.Audiences {
+		if this.Audiences[i] != that1.Audiences[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *JwtRequirement) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtRequirement)
+	if !ok {
+		that2, ok := that.(JwtRequirement)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.RequiresType == nil {
+		if this.RequiresType != nil {
+			return false
+		}
+	} else if this.RequiresType == nil {
+		return false
+	} else if !this.RequiresType.Equal(that1.RequiresType) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *JwtRequirement_ProviderName) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtRequirement_ProviderName)
+	if !ok {
+		that2, ok := that.(JwtRequirement_ProviderName)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProviderName != that1.ProviderName {
+		return false
+	}
+	return true
+}
+func (this *JwtRequirement_ProviderAndAudiences) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtRequirement_ProviderAndAudiences)
+	if !ok {
+		that2, ok := that.(JwtRequirement_ProviderAndAudiences)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ProviderAndAudiences.Equal(that1.ProviderAndAudiences) {
+		return false
+	}
+	return true
+}
+func (this *JwtRequirement_RequiresAny) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtRequirement_RequiresAny)
+	if !ok {
+		that2, ok := that.(JwtRequirement_RequiresAny)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RequiresAny.Equal(that1.RequiresAny) {
+		return false
+	}
+	return true
+}
+func (this *JwtRequirement_RequiresAll) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtRequirement_RequiresAll)
+	if !ok {
+		that2, ok := that.(JwtRequirement_RequiresAll)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RequiresAll.Equal(that1.RequiresAll) {
+		return false
+	}
+	return true
+}
+func (this *JwtRequirement_AllowMissingOrFailed) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtRequirement_AllowMissingOrFailed)
+	if !ok {
+		that2, ok := that.(JwtRequirement_AllowMissingOrFailed)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.AllowMissingOrFailed.Equal(that1.AllowMissingOrFailed) {
+		return false
+	}
+	return true
+}
+func (this *JwtRequirement_AllowMissing) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtRequirement_AllowMissing)
+	if !ok {
+		that2, ok := that.(JwtRequirement_AllowMissing)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.AllowMissing.Equal(that1.AllowMissing) {
+		return false
+	}
+	return true
+}
+func (this *JwtRequirementOrList) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtRequirementOrList)
+	if !ok {
+		that2, ok := that.(JwtRequirementOrList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Requirements) != len(that1.Requirements) {
+		return false
+	}
+	for i := range this.Requirements {
+		if !this.Requirements[i].Equal(that1.Requirements[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *JwtRequirementAndList) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtRequirementAndList)
+	if !ok {
+		that2, ok := that.(JwtRequirementAndList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Requirements) != len(that1.Requirements) {
+		return false
+	}
+	for i := range this.Requirements {
+		if !this.Requirements[i].Equal(that1.Requirements[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *RequirementRule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RequirementRule)
+	if !ok {
+		that2, ok := that.(RequirementRule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Match.Equal(that1.Match) {
+		return false
+	}
+	if !this.Requires.Equal(that1.Requires) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *FilterStateRule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FilterStateRule)
+	if !ok {
+		that2, ok := that.(FilterStateRule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if len(this.Requires) != len(that1.Requires) {
+		return false
+	}
+	for i := range this.Requires {
+		if !this.Requires[i].Equal(that1.Requires[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *JwtAuthentication) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JwtAuthentication)
+	if !ok {
+		that2, ok := that.(JwtAuthentication)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Providers) != len(that1.Providers) {
+		return false
+	}
+	for i := range this.Providers {
+		if !this.Providers[i].Equal(that1.Providers[i]) {
+			return false
+		}
+	}
+	if len(this.Rules) != len(that1.Rules) {
+		return false
+	}
+	for i := range this.Rules {
+		if !this.Rules[i].Equal(that1.Rules[i]) {
+			return false
+		}
+	}
+	if !this.FilterStateRules.Equal(that1.FilterStateRules) {
+		return false
+	}
+	if this.BypassCorsPreflight != that1.BypassCorsPreflight {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,215 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/http/local_ratelimit/v3/local_rate_limit.proto
+
+package v3
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	v31 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/v3"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// [#next-free-field: 7]
+type LocalRateLimit struct {
+	// The human readable prefix to use when emitting stats.
+	StatPrefix string `protobuf:"bytes,1,opt,name=stat_prefix,json=statPrefix,proto3" json:"stat_prefix,omitempty"`
+	// The token bucket configuration to use for rate limiting requests that are processed by this
+	// filter. Each request processed by the filter consumes a single token. If the token is available,
+	// the request will be allowed. If no tokens are available, the request will receive the configured
+	// rate limit status.
+	//
+	// .. note::
+	//   It's fine for the token bucket to be unset for the global configuration since the rate limit
+	//   can be applied at a the virtual host or route level. Thus, the token bucket must be set
+	//   for the per route configuration otherwise the config will be rejected.
+	//
+	// .. note::
+	//   When using per route configuration, the bucket becomes unique to that route.
+	//
+	// .. note::
+	//   In the current implementation the token bucket's :ref:`fill_interval
+	//   <envoy_api_field_type.v3.TokenBucket.fill_interval>` must be >= 50ms to avoid too aggressive
+	//   refills.
+	TokenBucket *v3.TokenBucket `protobuf:"bytes,3,opt,name=token_bucket,json=tokenBucket,proto3" json:"token_bucket,omitempty"`
+	// If set, this will enable -- but not necessarily enforce -- the rate limit for the given
+	// fraction of requests.
+	// Defaults to 0% of requests for safety.
+	FilterEnabled *v31.RuntimeFractionalPercent `protobuf:"bytes,4,opt,name=filter_enabled,json=filterEnabled,proto3" json:"filter_enabled,omitempty"`
+	// If set, this will enforce the rate limit decisions for the given fraction of requests.
+	//
+	// Note: this only applies to the fraction of enabled requests.
+	//
+	// Defaults to 0% of requests for safety.
+	FilterEnforced *v31.RuntimeFractionalPercent `protobuf:"bytes,5,opt,name=filter_enforced,json=filterEnforced,proto3" json:"filter_enforced,omitempty"`
+	// Specifies a list of HTTP headers that should be added to each response for requests that
+	// have been rate limited.
+	ResponseHeadersToAdd []*v31.HeaderValueOption `protobuf:"bytes,6,rep,name=response_headers_to_add,json=responseHeadersToAdd,proto3" json:"response_headers_to_add,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *LocalRateLimit) Reset()         { *m = LocalRateLimit{} }
+func (m *LocalRateLimit) String() string { return proto.CompactTextString(m) }
+func (*LocalRateLimit) ProtoMessage()    {}
+func (*LocalRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6737da28e58ed4, []int{0}
+}
+func (m *LocalRateLimit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LocalRateLimit.Unmarshal(m, b)
+}
+func (m *LocalRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LocalRateLimit.Marshal(b, m, deterministic)
+}
+func (m *LocalRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalRateLimit.Merge(m, src)
+}
+func (m *LocalRateLimit) XXX_Size() int {
+	return xxx_messageInfo_LocalRateLimit.Size(m)
+}
+func (m *LocalRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocalRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocalRateLimit proto.InternalMessageInfo
+
+func (m *LocalRateLimit) GetStatPrefix() string {
+	if m != nil {
+		return m.StatPrefix
+	}
+	return ""
+}
+
+func (m *LocalRateLimit) GetTokenBucket() *v3.TokenBucket {
+	if m != nil {
+		return m.TokenBucket
+	}
+	return nil
+}
+
+func (m *LocalRateLimit) GetFilterEnabled() *v31.RuntimeFractionalPercent {
+	if m != nil {
+		return m.FilterEnabled
+	}
+	return nil
+}
+
+func (m *LocalRateLimit) GetFilterEnforced() *v31.RuntimeFractionalPercent {
+	if m != nil {
+		return m.FilterEnforced
+	}
+	return nil
+}
+
+func (m *LocalRateLimit) GetResponseHeadersToAdd() []*v31.HeaderValueOption {
+	if m != nil {
+		return m.ResponseHeadersToAdd
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*LocalRateLimit)(nil), "envoy.extensions.filters.http.local_ratelimit.v3.LocalRateLimit")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/http/local_ratelimit/v3/local_rate_limit.proto", fileDescriptor_ab6737da28e58ed4)
+}
+
+var fileDescriptor_ab6737da28e58ed4 = []byte{
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xcd, 0x8a, 0x13, 0x41,
+	0x10, 0xc7, 0x99, 0x4d, 0x76, 0x5d, 0x27, 0x1a, 0x97, 0x71, 0x61, 0x43, 0x0e, 0x1a, 0xbc, 0x98,
+	0x8b, 0xdd, 0xb2, 0x39, 0x2b, 0x38, 0xa0, 0x88, 0x2c, 0x18, 0x86, 0x55, 0xc1, 0xcb, 0xd0, 0x99,
+	0xa9, 0x4c, 0xda, 0x74, 0xba, 0x86, 0xee, 0x4a, 0x98, 0xf8, 0x18, 0xde, 0x7c, 0x03, 0x1f, 0xc1,
+	0x47, 0xf0, 0x39, 0x7c, 0x07, 0x2f, 0x7b, 0x92, 0xee, 0x9e, 0xb8, 0xab, 0x78, 0xf0, 0xe3, 0x56,
+	0x1f, 0x7f, 0x7e, 0x55, 0xfc, 0xbb, 0x2b, 0x7e, 0x5f, 0x49, 0x5a, 0xac, 0x67, 0xac, 0xc0, 0x15,
+	0xb7, 0xa8, 0xf0, 0x81, 0x44, 0x5e, 0x29, 0x44, 0x5e, 0x1b, 0x7c, 0x07, 0x05, 0xd9, 0x90, 0x89,
+	0x5a, 0x72, 0x68, 0x08, 0x8c, 0x16, 0x8a, 0x83, 0xde, 0xe0, 0xd6, 0xa7, 0xda, 0x4a, 0xd4, 0x96,
+	0xcf, 0xa5, 0x22, 0x30, 0x96, 0x2f, 0x88, 0x6a, 0xae, 0xb0, 0x10, 0x2a, 0x37, 0x82, 0x40, 0xc9,
+	0x95, 0x24, 0xbe, 0x99, 0x5c, 0x29, 0xe5, 0xbe, 0xc6, 0x6a, 0x83, 0x84, 0xc9, 0x43, 0x0f, 0x62,
+	0x97, 0x20, 0xd6, 0x82, 0x98, 0x03, 0xb1, 0x5f, 0x40, 0x6c, 0x33, 0x19, 0xde, 0x0d, 0xa3, 0x0b,
+	0xd4, 0x73, 0x59, 0xf1, 0x02, 0x0d, 0x38, 0xfc, 0x4c, 0x58, 0x08, 0xc8, 0xe1, 0x28, 0x08, 0x68,
+	0x5b, 0xfb, 0x0e, 0xe1, 0x12, 0x74, 0x3e, 0x5b, 0x17, 0x4b, 0x68, 0x87, 0x0e, 0x4f, 0x36, 0x42,
+	0xc9, 0x52, 0x10, 0xf0, 0x5d, 0xd0, 0x36, 0x8e, 0x2b, 0xac, 0xd0, 0x87, 0xdc, 0x45, 0x6d, 0x35,
+	0x81, 0x86, 0x42, 0x11, 0x9a, 0x16, 0x71, 0xef, 0x63, 0x27, 0xee, 0x9f, 0xb9, 0xe5, 0x32, 0x41,
+	0x70, 0xe6, 0x76, 0x4b, 0xc6, 0x71, 0xcf, 0x92, 0xa0, 0xbc, 0x36, 0x30, 0x97, 0xcd, 0x20, 0x1a,
+	0x45, 0xe3, 0xeb, 0xe9, 0xb5, 0x8b, 0xb4, 0x6b, 0xf6, 0x46, 0x51, 0x16, 0xbb, 0xde, 0xd4, 0xb7,
+	0x92, 0x47, 0xf1, 0x8d, 0xab, 0x5b, 0x0d, 0x3a, 0xa3, 0x68, 0xdc, 0x3b, 0x1d, 0xb2, 0xe0, 0x85,
+	0x5b, 0x9c, 0x6d, 0x26, 0xec, 0xdc, 0x49, 0x52, 0xaf, 0xc8, 0x7a, 0x74, 0x99, 0x24, 0xaf, 0xe2,
+	0x7e, 0x30, 0x29, 0x07, 0x2d, 0x66, 0x0a, 0xca, 0x41, 0xd7, 0x03, 0x58, 0x0b, 0x08, 0xd6, 0x30,
+	0x67, 0x8d, 0xe3, 0x64, 0x6b, 0x4d, 0x72, 0x05, 0xcf, 0x8c, 0x28, 0x48, 0xa2, 0x16, 0x6a, 0x0a,
+	0xa6, 0x00, 0x4d, 0xd9, 0xcd, 0x40, 0x79, 0x1a, 0x20, 0xc9, 0x9b, 0xf8, 0xd6, 0x0f, 0xec, 0x1c,
+	0x4d, 0x01, 0xe5, 0x60, 0xff, 0x9f, 0xb8, 0xfd, 0x1d, 0x37, 0x50, 0x92, 0x45, 0x7c, 0x62, 0xc0,
+	0xd6, 0xa8, 0x2d, 0xe4, 0x0b, 0x10, 0x25, 0x18, 0x9b, 0x13, 0xe6, 0xa2, 0x2c, 0x07, 0x07, 0xa3,
+	0xce, 0xb8, 0x77, 0x7a, 0xff, 0xf7, 0x03, 0x9e, 0x7b, 0xed, 0x6b, 0xa1, 0xd6, 0xf0, 0xb2, 0x76,
+	0x23, 0xd2, 0xc3, 0x8b, 0x74, 0xff, 0x43, 0xb4, 0x77, 0x14, 0x67, 0xc7, 0x3b, 0x62, 0x10, 0xd9,
+	0x73, 0x7c, 0x52, 0x96, 0x2f, 0xba, 0x87, 0x7b, 0x47, 0x9d, 0xf4, 0x4b, 0xf4, 0xf9, 0x5b, 0x37,
+	0xfa, 0xf4, 0xf5, 0x4e, 0x14, 0x3f, 0x96, 0x18, 0xd8, 0xb5, 0xc1, 0x66, 0xcb, 0xfe, 0xf6, 0xb3,
+	0xa5, 0xb7, 0x7f, 0x7e, 0xe3, 0xa9, 0x41, 0xc2, 0x69, 0xf4, 0xb6, 0xfa, 0xb3, 0x8b, 0xa9, 0x97,
+	0xd5, 0xff, 0x5d, 0xcd, 0xec, 0xc0, 0xff, 0xb6, 0xc9, 0xf7, 0x01, 0x00, 0xac, 0x71, 0xf5, 0xd5,
+	0xa3, 0x03, 0x00, 0x00,
+}
+
+func (this *LocalRateLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LocalRateLimit)
+	if !ok {
+		that2, ok := that.(LocalRateLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StatPrefix != that1.StatPrefix {
+		return false
+	}
+	if !this.TokenBucket.Equal(that1.TokenBucket) {
+		return false
+	}
+	if !this.FilterEnabled.Equal(that1.FilterEnabled) {
+		return false
+	}
+	if !this.FilterEnforced.Equal(that1.FilterEnforced) {
+		return false
+	}
+	if len(this.ResponseHeadersToAdd) != len(that1.ResponseHeadersToAdd) {
+		return false
+	}
+	for i := range this.ResponseHeadersToAdd {
+		if !this.ResponseHeadersToAdd[i].Equal(that1.ResponseHeadersToAdd[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,178 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/network/connection_limit/v3/connection_limit.proto
+
+package v3
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ConnectionLimit struct {
+	// The prefix to use when emitting :ref:`statistics
+	// <config_network_filters_connection_limit_stats>`.
+	StatPrefix string `protobuf:"bytes,1,opt,name=stat_prefix,json=statPrefix,proto3" json:"stat_prefix,omitempty"`
+	// The max connections configuration to use for new incoming connections that are processed
+	// by the filter's filter chain. When max_connection is reached, the incoming connection
+	// will be closed after delay duration.
+	MaxConnections *types.UInt64Value `protobuf:"bytes,2,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`
+	// The delay configuration to use for rejecting the connection after some specified time duration
+	// instead of immediately rejecting the connection. That way, a malicious user is not able to
+	// retry as fast as possible which provides a better DoS protection for Envoy. If this is not present,
+	// the connection will be closed immediately.
+	Delay *types.Duration `protobuf:"bytes,3,opt,name=delay,proto3" json:"delay,omitempty"`
+	// Runtime flag that controls whether the filter is enabled or not. If not specified, defaults
+	// to enabled.
+	RuntimeEnabled       *v3.RuntimeFeatureFlag `protobuf:"bytes,4,opt,name=runtime_enabled,json=runtimeEnabled,proto3" json:"runtime_enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ConnectionLimit) Reset()         { *m = ConnectionLimit{} }
+func (m *ConnectionLimit) String() string { return proto.CompactTextString(m) }
+func (*ConnectionLimit) ProtoMessage()    {}
+func (*ConnectionLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30a98bcd930b49f4, []int{0}
+}
+func (m *ConnectionLimit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConnectionLimit.Unmarshal(m, b)
+}
+func (m *ConnectionLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConnectionLimit.Marshal(b, m, deterministic)
+}
+func (m *ConnectionLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionLimit.Merge(m, src)
+}
+func (m *ConnectionLimit) XXX_Size() int {
+	return xxx_messageInfo_ConnectionLimit.Size(m)
+}
+func (m *ConnectionLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectionLimit proto.InternalMessageInfo
+
+func (m *ConnectionLimit) GetStatPrefix() string {
+	if m != nil {
+		return m.StatPrefix
+	}
+	return ""
+}
+
+func (m *ConnectionLimit) GetMaxConnections() *types.UInt64Value {
+	if m != nil {
+		return m.MaxConnections
+	}
+	return nil
+}
+
+func (m *ConnectionLimit) GetDelay() *types.Duration {
+	if m != nil {
+		return m.Delay
+	}
+	return nil
+}
+
+func (m *ConnectionLimit) GetRuntimeEnabled() *v3.RuntimeFeatureFlag {
+	if m != nil {
+		return m.RuntimeEnabled
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ConnectionLimit)(nil), "envoy.extensions.filters.network.connection_limit.v3.ConnectionLimit")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/network/connection_limit/v3/connection_limit.proto", fileDescriptor_30a98bcd930b49f4)
+}
+
+var fileDescriptor_30a98bcd930b49f4 = []byte{
+	// 436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xcd, 0x8a, 0x14, 0x31,
+	0x10, 0xc7, 0xc9, 0x38, 0x2a, 0xf6, 0xc2, 0x0e, 0x34, 0x0b, 0x8e, 0x8b, 0xac, 0x83, 0xa7, 0xbe,
+	0x98, 0xc0, 0xce, 0xe2, 0x03, 0xb4, 0xba, 0x20, 0x88, 0x8c, 0x0d, 0x7a, 0xf0, 0x32, 0xa4, 0x7b,
+	0xaa, 0x63, 0x9c, 0x74, 0xaa, 0x49, 0xd2, 0xbd, 0x3d, 0x17, 0x5f, 0xc1, 0xd7, 0xf0, 0x11, 0x7c,
+	0x0d, 0x5f, 0xc1, 0x77, 0xf0, 0xe2, 0x49, 0x92, 0xf4, 0x3a, 0xe0, 0x20, 0x88, 0x7b, 0xab, 0x8f,
+	0xd4, 0xaf, 0xaa, 0xfe, 0xa9, 0xe4, 0x93, 0x90, 0xee, 0x43, 0x57, 0xd2, 0x0a, 0x1b, 0x66, 0x51,
+	0xe1, 0x13, 0x89, 0x4c, 0x28, 0x44, 0xd6, 0x1a, 0xfc, 0x08, 0x95, 0xb3, 0xd1, 0xe3, 0xad, 0x64,
+	0x30, 0x38, 0x30, 0x9a, 0x2b, 0x06, 0xba, 0xc7, 0x5d, 0x70, 0xb5, 0x95, 0xa8, 0x2d, 0xab, 0xa5,
+	0x72, 0x60, 0x2c, 0xd3, 0xe0, 0xae, 0xd0, 0x6c, 0x59, 0x85, 0x5a, 0x43, 0xe5, 0x24, 0xea, 0xb5,
+	0x92, 0x8d, 0x74, 0xac, 0x5f, 0x1e, 0xc4, 0x68, 0x6b, 0xd0, 0x61, 0x7a, 0x11, 0x60, 0x74, 0x0f,
+	0xa3, 0x23, 0x8c, 0x8e, 0x30, 0x7a, 0x50, 0xd8, 0x2f, 0x4f, 0x1f, 0xc5, 0x11, 0x2a, 0xd4, 0xb5,
+	0x14, 0xac, 0x42, 0x03, 0xbe, 0x45, 0xc9, 0x2d, 0x44, 0xec, 0xe9, 0x99, 0x40, 0x14, 0x0a, 0x58,
+	0xf0, 0xca, 0xae, 0x66, 0x9b, 0xce, 0x70, 0xcf, 0xf8, 0x5b, 0xfe, 0xca, 0xf0, 0xb6, 0xf5, 0x6d,
+	0x63, 0xfe, 0x7e, 0xcf, 0x95, 0xdc, 0x70, 0x07, 0xec, 0xda, 0x18, 0x13, 0x27, 0x02, 0x05, 0x06,
+	0x93, 0x79, 0x6b, 0x8c, 0xa6, 0x30, 0xb8, 0x18, 0x84, 0x61, 0xdc, 0xec, 0xf1, 0xe7, 0x49, 0x32,
+	0x7b, 0xf6, 0x7b, 0xf6, 0x57, 0x7e, 0xf4, 0x34, 0x4b, 0x8e, 0xac, 0xe3, 0x6e, 0xdd, 0x1a, 0xa8,
+	0xe5, 0x30, 0x27, 0x0b, 0x92, 0xdd, 0xcb, 0xef, 0xfe, 0xcc, 0xa7, 0x66, 0xb2, 0x20, 0x45, 0xe2,
+	0x73, 0xab, 0x90, 0x4a, 0x5f, 0x27, 0xb3, 0x86, 0x0f, 0xeb, 0xfd, 0xf2, 0x76, 0x3e, 0x59, 0x90,
+	0xec, 0xe8, 0xfc, 0x21, 0x8d, 0xa3, 0xd3, 0xeb, 0xd1, 0xe9, 0xdb, 0x97, 0xda, 0x3d, 0xbd, 0x78,
+	0xc7, 0x55, 0x07, 0x81, 0x75, 0x3e, 0xc9, 0x48, 0x71, 0xdc, 0xf0, 0x61, 0xdf, 0xdd, 0xa6, 0x2c,
+	0xb9, 0xbd, 0x01, 0xc5, 0x77, 0xf3, 0x5b, 0x81, 0xf2, 0xe0, 0x80, 0xf2, 0x7c, 0x14, 0xa8, 0x88,
+	0xef, 0xd2, 0x37, 0xc9, 0xcc, 0x74, 0xda, 0xc9, 0x06, 0xd6, 0xa0, 0x79, 0xa9, 0x60, 0x33, 0x9f,
+	0x86, 0xd2, 0x8c, 0xc6, 0x2f, 0x8b, 0xe2, 0x53, 0x2f, 0x3e, 0xed, 0x97, 0xb4, 0x88, 0x8f, 0x2f,
+	0x81, 0xbb, 0xce, 0xc0, 0xa5, 0xe2, 0xa2, 0x38, 0x1e, 0x01, 0x2f, 0x62, 0x7d, 0xfe, 0x8d, 0x7c,
+	0xfd, 0x31, 0x25, 0x5f, 0xbe, 0x9f, 0x91, 0x24, 0x97, 0x18, 0x31, 0xad, 0xc1, 0x61, 0x47, 0xff,
+	0xe7, 0x08, 0xf2, 0x93, 0x3f, 0xd4, 0x5d, 0xf9, 0x55, 0x56, 0xe4, 0xfd, 0xf6, 0xdf, 0x4e, 0xba,
+	0xdd, 0x8a, 0x9b, 0x9f, 0x75, 0x79, 0x27, 0x08, 0xb8, 0xfc, 0x35, 0x00, 0x7e, 0x44, 0x3a, 0xa0,
+	0x48, 0x03, 0x00, 0x00,
+}
+
+func (this *ConnectionLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConnectionLimit)
+	if !ok {
+		that2, ok := that.(ConnectionLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StatPrefix != that1.StatPrefix {
+		return false
+	}
+	if !this.MaxConnections.Equal(that1.MaxConnections) {
+		return false
+	}
+	if !this.Delay.Equal(that1.Delay) {
+		return false
+	}
+	if !this.RuntimeEnabled.Equal(that1.RuntimeEnabled) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
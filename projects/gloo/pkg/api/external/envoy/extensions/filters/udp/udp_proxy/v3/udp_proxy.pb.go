@@ -0,0 +1,389 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/udp/udp_proxy/v3/udp_proxy.proto
+
+package v3
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Configuration for the UDP proxy filter.
+// [#next-free-field: 6]
+type UdpProxyConfig struct {
+	// The stat prefix used when emitting UDP proxy filter stats.
+	StatPrefix string `protobuf:"bytes,1,opt,name=stat_prefix,json=statPrefix,proto3" json:"stat_prefix,omitempty"`
+	// Types that are valid to be assigned to RouteSpecifier:
+	//	*UdpProxyConfig_Cluster
+	RouteSpecifier isUdpProxyConfig_RouteSpecifier `protobuf_oneof:"route_specifier"`
+	// The idle timeout for sessions. Idle is defined as no datagrams between received or sent by
+	// the session. The default if not specified is 1 minute.
+	IdleTimeout *types.Duration `protobuf:"bytes,3,opt,name=idle_timeout,json=idleTimeout,proto3" json:"idle_timeout,omitempty"`
+	// Use the remote downstream IP address as the sender IP address when sending packets to upstream hosts.
+	// This option requires Envoy to be run with the *CAP_NET_ADMIN* capability on Linux.
+	// And the IPv6 stack must be enabled on Linux kernel.
+	// This option does not preserve the remote downstream port.
+	// If this option is enabled, the IP address of sent datagrams will be changed to the remote downstream IP address.
+	// This means that Envoy will not receive packets that are sent by upstream hosts because the upstream hosts
+	// will send the packets with the remote downstream IP address as the destination. All packets will be routed
+	// to the remote downstream directly if there are route rules on the upstream host side.
+	// There are two options to return the packets back to the remote downstream.
+	// The first one is to use DSR (Direct Server Return).
+	// The other one is to configure routing rules on the upstream hosts to forward
+	// all packets back to Envoy and configure iptables rules on the host running Envoy to
+	// forward all packets from upstream hosts to the Envoy process so that Envoy can forward the packets to the downstream.
+	// If the platform does not support this option, Envoy will raise a configuration error.
+	UseOriginalSrcIp bool `protobuf:"varint,4,opt,name=use_original_src_ip,json=useOriginalSrcIp,proto3" json:"use_original_src_ip,omitempty"`
+	// Optional configuration for UDP proxy hash policies. If hash_policies is not set, the hash-based
+	// load balancing algorithms will select a host randomly. Currently the number of hash policies is
+	// limited to 1.
+	HashPolicies         []*UdpProxyConfig_HashPolicy `protobuf:"bytes,5,rep,name=hash_policies,json=hashPolicies,proto3" json:"hash_policies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *UdpProxyConfig) Reset()         { *m = UdpProxyConfig{} }
+func (m *UdpProxyConfig) String() string { return proto.CompactTextString(m) }
+func (*UdpProxyConfig) ProtoMessage()    {}
+func (*UdpProxyConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_293e7a333e31b650, []int{0}
+}
+func (m *UdpProxyConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UdpProxyConfig.Unmarshal(m, b)
+}
+func (m *UdpProxyConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UdpProxyConfig.Marshal(b, m, deterministic)
+}
+func (m *UdpProxyConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UdpProxyConfig.Merge(m, src)
+}
+func (m *UdpProxyConfig) XXX_Size() int {
+	return xxx_messageInfo_UdpProxyConfig.Size(m)
+}
+func (m *UdpProxyConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_UdpProxyConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UdpProxyConfig proto.InternalMessageInfo
+
+type isUdpProxyConfig_RouteSpecifier interface {
+	isUdpProxyConfig_RouteSpecifier()
+	Equal(interface{}) bool
+}
+
+type UdpProxyConfig_Cluster struct {
+	Cluster string `protobuf:"bytes,2,opt,name=cluster,proto3,oneof" json:"cluster,omitempty"`
+}
+
+func (*UdpProxyConfig_Cluster) isUdpProxyConfig_RouteSpecifier() {}
+
+func (m *UdpProxyConfig) GetRouteSpecifier() isUdpProxyConfig_RouteSpecifier {
+	if m != nil {
+		return m.RouteSpecifier
+	}
+	return nil
+}
+
+func (m *UdpProxyConfig) GetStatPrefix() string {
+	if m != nil {
+		return m.StatPrefix
+	}
+	return ""
+}
+
+func (m *UdpProxyConfig) GetCluster() string {
+	if x, ok := m.GetRouteSpecifier().(*UdpProxyConfig_Cluster); ok {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (m *UdpProxyConfig) GetIdleTimeout() *types.Duration {
+	if m != nil {
+		return m.IdleTimeout
+	}
+	return nil
+}
+
+func (m *UdpProxyConfig) GetUseOriginalSrcIp() bool {
+	if m != nil {
+		return m.UseOriginalSrcIp
+	}
+	return false
+}
+
+func (m *UdpProxyConfig) GetHashPolicies() []*UdpProxyConfig_HashPolicy {
+	if m != nil {
+		return m.HashPolicies
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*UdpProxyConfig) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*UdpProxyConfig_Cluster)(nil),
+	}
+}
+
+// Specifies the UDP hash policy.
+// The packets can be routed by hash policy.
+type UdpProxyConfig_HashPolicy struct {
+	// Types that are valid to be assigned to PolicySpecifier:
+	//	*UdpProxyConfig_HashPolicy_SourceIp
+	PolicySpecifier      isUdpProxyConfig_HashPolicy_PolicySpecifier `protobuf_oneof:"policy_specifier"`
+	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
+	XXX_unrecognized     []byte                                      `json:"-"`
+	XXX_sizecache        int32                                       `json:"-"`
+}
+
+func (m *UdpProxyConfig_HashPolicy) Reset()         { *m = UdpProxyConfig_HashPolicy{} }
+func (m *UdpProxyConfig_HashPolicy) String() string { return proto.CompactTextString(m) }
+func (*UdpProxyConfig_HashPolicy) ProtoMessage()    {}
+func (*UdpProxyConfig_HashPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_293e7a333e31b650, []int{0, 0}
+}
+func (m *UdpProxyConfig_HashPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UdpProxyConfig_HashPolicy.Unmarshal(m, b)
+}
+func (m *UdpProxyConfig_HashPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UdpProxyConfig_HashPolicy.Marshal(b, m, deterministic)
+}
+func (m *UdpProxyConfig_HashPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UdpProxyConfig_HashPolicy.Merge(m, src)
+}
+func (m *UdpProxyConfig_HashPolicy) XXX_Size() int {
+	return xxx_messageInfo_UdpProxyConfig_HashPolicy.Size(m)
+}
+func (m *UdpProxyConfig_HashPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_UdpProxyConfig_HashPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UdpProxyConfig_HashPolicy proto.InternalMessageInfo
+
+type isUdpProxyConfig_HashPolicy_PolicySpecifier interface {
+	isUdpProxyConfig_HashPolicy_PolicySpecifier()
+	Equal(interface{}) bool
+}
+
+type UdpProxyConfig_HashPolicy_SourceIp struct {
+	SourceIp bool `protobuf:"varint,1,opt,name=source_ip,json=sourceIp,proto3,oneof" json:"source_ip,omitempty"`
+}
+
+func (*UdpProxyConfig_HashPolicy_SourceIp) isUdpProxyConfig_HashPolicy_PolicySpecifier() {}
+
+func (m *UdpProxyConfig_HashPolicy) GetPolicySpecifier() isUdpProxyConfig_HashPolicy_PolicySpecifier {
+	if m != nil {
+		return m.PolicySpecifier
+	}
+	return nil
+}
+
+func (m *UdpProxyConfig_HashPolicy) GetSourceIp() bool {
+	if x, ok := m.GetPolicySpecifier().(*UdpProxyConfig_HashPolicy_SourceIp); ok {
+		return x.SourceIp
+	}
+	return false
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*UdpProxyConfig_HashPolicy) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*UdpProxyConfig_HashPolicy_SourceIp)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterType((*UdpProxyConfig)(nil), "envoy.extensions.filters.udp.udp_proxy.v3.UdpProxyConfig")
+	proto.RegisterType((*UdpProxyConfig_HashPolicy)(nil), "envoy.extensions.filters.udp.udp_proxy.v3.UdpProxyConfig.HashPolicy")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/external/envoy/extensions/filters/udp/udp_proxy/v3/udp_proxy.proto", fileDescriptor_293e7a333e31b650)
+}
+
+var fileDescriptor_293e7a333e31b650 = []byte{
+	// 483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xbd, 0x6e, 0x13, 0x41,
+	0x10, 0x66, 0xed, 0x84, 0x38, 0xeb, 0x04, 0xac, 0x03, 0x11, 0xe3, 0x22, 0xb2, 0x40, 0x42, 0xa6,
+	0xc8, 0xae, 0x14, 0x17, 0x34, 0x54, 0x47, 0x8a, 0xa4, 0x40, 0x58, 0x06, 0x1a, 0x9a, 0xe3, 0x7c,
+	0x37, 0x3e, 0x4f, 0xd8, 0xdc, 0xac, 0xf6, 0xc7, 0x3a, 0xbf, 0x06, 0x4f, 0x91, 0x07, 0xa0, 0xe0,
+	0x79, 0x78, 0x07, 0x24, 0x94, 0x0a, 0xdd, 0x9f, 0xac, 0x50, 0x59, 0x14, 0x27, 0xcd, 0x7c, 0xdf,
+	0x37, 0x7b, 0xf3, 0x7d, 0xbb, 0x5c, 0x65, 0xe8, 0x56, 0x7e, 0x21, 0x12, 0xba, 0x91, 0x96, 0x14,
+	0x9d, 0x21, 0xc9, 0x4c, 0x11, 0x49, 0x6d, 0xe8, 0x1a, 0x12, 0x67, 0xeb, 0x2e, 0xd6, 0x28, 0xa1,
+	0x70, 0x60, 0xf2, 0x58, 0x49, 0xc8, 0xd7, 0xb4, 0xa9, 0xda, 0xdc, 0x22, 0xe5, 0x56, 0x2e, 0x51,
+	0x39, 0x30, 0x56, 0xfa, 0x54, 0x97, 0x5f, 0xa4, 0x0d, 0x15, 0x1b, 0xb9, 0x9e, 0x6e, 0x1b, 0xa1,
+	0x0d, 0x39, 0x0a, 0x5e, 0x57, 0xa3, 0x62, 0x3b, 0x2a, 0x9a, 0x51, 0xe1, 0x53, 0x2d, 0xb6, 0xea,
+	0xf5, 0x74, 0x74, 0x9a, 0x11, 0x65, 0x0a, 0x64, 0x35, 0xb8, 0xf0, 0x4b, 0x99, 0x7a, 0x13, 0x3b,
+	0xa4, 0xbc, 0x3e, 0x6a, 0x74, 0xb2, 0x8e, 0x15, 0xa6, 0xb1, 0x03, 0xd9, 0x16, 0x0d, 0xf1, 0x34,
+	0xa3, 0x8c, 0xaa, 0x52, 0x96, 0x55, 0x83, 0x06, 0x50, 0xb8, 0x1a, 0x84, 0xc2, 0xd5, 0xd8, 0x8b,
+	0xdb, 0x2e, 0x7f, 0xf4, 0x39, 0xd5, 0xb3, 0xf2, 0x97, 0xef, 0x28, 0x5f, 0x62, 0x16, 0x4c, 0x78,
+	0xdf, 0xba, 0xd8, 0x45, 0xda, 0xc0, 0x12, 0x8b, 0x21, 0x1b, 0xb3, 0xc9, 0x61, 0x78, 0x70, 0x17,
+	0xee, 0x99, 0xce, 0x98, 0xcd, 0x79, 0xc9, 0xcd, 0x2a, 0x2a, 0x78, 0xc9, 0x0f, 0x12, 0xe5, 0xad,
+	0x03, 0x33, 0xec, 0xdc, 0x53, 0x5d, 0x3e, 0x98, 0xb7, 0x4c, 0xf0, 0x96, 0x1f, 0x61, 0xaa, 0x20,
+	0x72, 0x78, 0x03, 0xe4, 0xdd, 0xb0, 0x3b, 0x66, 0x93, 0xfe, 0xf9, 0x73, 0x51, 0x7b, 0x13, 0xad,
+	0x37, 0x71, 0xd1, 0x78, 0x9b, 0xf7, 0x4b, 0xf9, 0xa7, 0x5a, 0x1d, 0x9c, 0xf1, 0x27, 0xde, 0x42,
+	0x44, 0x06, 0x33, 0xcc, 0x63, 0x15, 0x59, 0x93, 0x44, 0xa8, 0x87, 0x7b, 0x63, 0x36, 0xe9, 0xcd,
+	0x07, 0xde, 0xc2, 0x87, 0x86, 0xf9, 0x68, 0x92, 0x2b, 0x1d, 0x58, 0x7e, 0xbc, 0x8a, 0xed, 0x2a,
+	0xd2, 0xa4, 0x30, 0x41, 0xb0, 0xc3, 0xfd, 0x71, 0x77, 0xd2, 0x3f, 0xbf, 0x10, 0x3b, 0x87, 0x2e,
+	0xee, 0xa7, 0x21, 0x2e, 0x63, 0xbb, 0x9a, 0x95, 0xa7, 0x6d, 0xc2, 0xde, 0x5d, 0xb8, 0xff, 0x9d,
+	0x75, 0x06, 0x6c, 0x7e, 0xb4, 0x6a, 0x51, 0x04, 0x3b, 0x7a, 0xcf, 0xf9, 0x56, 0x15, 0xbc, 0xe2,
+	0x87, 0x96, 0xbc, 0x49, 0xa0, 0xdc, 0xb3, 0x0c, 0xaf, 0x57, 0xc5, 0x72, 0xdd, 0xe9, 0x95, 0xb1,
+	0xf4, 0x6a, 0xee, 0x4a, 0x87, 0x27, 0x7c, 0x50, 0x6d, 0xb9, 0x89, 0xac, 0x86, 0x04, 0x97, 0x08,
+	0x26, 0xe8, 0xfe, 0x09, 0x59, 0xf8, 0x8c, 0x3f, 0x36, 0xe4, 0x1d, 0xfc, 0x8b, 0xff, 0x60, 0x3f,
+	0x7f, 0xef, 0xb1, 0xdb, 0x5f, 0xa7, 0x8c, 0xbf, 0x41, 0xaa, 0x1d, 0xd5, 0x5b, 0xef, 0x6c, 0x2e,
+	0x3c, 0x6e, 0xdd, 0xcd, 0x0c, 0x39, 0x9a, 0xb1, 0x2f, 0x5f, 0x77, 0x7b, 0xfb, 0xfa, 0x5b, 0xf6,
+	0x9f, 0xef, 0x7f, 0xf1, 0xb0, 0xba, 0xe1, 0xe9, 0xdf, 0x01, 0x00, 0x36, 0xdb, 0x7f, 0xde, 0x66,
+	0x03, 0x00, 0x00,
+}
+
+func (this *UdpProxyConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UdpProxyConfig)
+	if !ok {
+		that2, ok := that.(UdpProxyConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StatPrefix != that1.StatPrefix {
+		return false
+	}
+	if that1.RouteSpecifier == nil {
+		if this.RouteSpecifier != nil {
+			return false
+		}
+	} else if this.RouteSpecifier == nil {
+		return false
+	} else if !this.RouteSpecifier.Equal(that1.RouteSpecifier) {
+		return false
+	}
+	if !this.IdleTimeout.Equal(that1.IdleTimeout) {
+		return false
+	}
+	if this.UseOriginalSrcIp != that1.UseOriginalSrcIp {
+		return false
+	}
+	if len(this.HashPolicies) != len(that1.HashPolicies) {
+		return false
+	}
+	for i := range this.HashPolicies {
+		if !this.HashPolicies[i].Equal(that1.HashPolicies[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *UdpProxyConfig_Cluster) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UdpProxyConfig_Cluster)
+	if !ok {
+		that2, ok := that.(UdpProxyConfig_Cluster)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Cluster != that1.Cluster {
+		return false
+	}
+	return true
+}
+func (this *UdpProxyConfig_HashPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UdpProxyConfig_HashPolicy)
+	if !ok {
+		that2, ok := that.(UdpProxyConfig_HashPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.PolicySpecifier == nil {
+		if this.PolicySpecifier != nil {
+			return false
+		}
+	} else if this.PolicySpecifier == nil {
+		return false
+	} else if !this.PolicySpecifier.Equal(that1.PolicySpecifier) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *UdpProxyConfig_HashPolicy_SourceIp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UdpProxyConfig_HashPolicy_SourceIp)
+	if !ok {
+		that2, ok := that.(UdpProxyConfig_HashPolicy_SourceIp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SourceIp != that1.SourceIp {
+		return false
+	}
+	return true
+}
@@ -126,9 +126,11 @@ type RouteTransformations struct {
 	// clear the route cache if the request transformation was applied
 	ClearRouteCache        bool            `protobuf:"varint,3,opt,name=clear_route_cache,json=clearRouteCache,proto3" json:"clear_route_cache,omitempty"`
 	ResponseTransformation *Transformation `protobuf:"bytes,2,opt,name=response_transformation,json=responseTransformation,proto3" json:"response_transformation,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}        `json:"-"`
-	XXX_unrecognized       []byte          `json:"-"`
-	XXX_sizecache          int32           `json:"-"`
+	// Apply a transformation once the stream completed, before the access logs are written
+	OnStreamCompletionTransformation *Transformation `protobuf:"bytes,4,opt,name=on_stream_completion_transformation,json=onStreamCompletionTransformation,proto3" json:"on_stream_completion_transformation,omitempty"`
+	XXX_NoUnkeyedLiteral             struct{}        `json:"-"`
+	XXX_unrecognized                 []byte          `json:"-"`
+	XXX_sizecache                    int32           `json:"-"`
 }
 
 func (m *RouteTransformations) Reset()         { *m = RouteTransformations{} }
@@ -176,6 +178,13 @@ func (m *RouteTransformations) GetResponseTransformation() *Transformation {
 	return nil
 }
 
+func (m *RouteTransformations) GetOnStreamCompletionTransformation() *Transformation {
+	if m != nil {
+		return m.OnStreamCompletionTransformation
+	}
+	return nil
+}
+
 type Transformation struct {
 	// Template is in the transformed request language domain
 	//
@@ -244,10 +253,14 @@ func (*Transformation) XXX_OneofWrappers() []interface{} {
 
 type DlpTransformation struct {
 	// list of actions to apply
-	Actions              []*Action `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Actions []*Action `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	// If true, the headers will be masked. Should only be set for the on_stream_completion_transformation
+	EnableHeaderTransformation bool `protobuf:"varint,2,opt,name=enable_header_transformation,json=enableHeaderTransformation,proto3" json:"enable_header_transformation,omitempty"`
+	// If true, the dynamic metadata will be masked. Should only be set for the on_stream_completion_transformation
+	EnableDynamicMetadataTransformation bool     `protobuf:"varint,3,opt,name=enable_dynamic_metadata_transformation,json=enableDynamicMetadataTransformation,proto3" json:"enable_dynamic_metadata_transformation,omitempty"`
+	XXX_NoUnkeyedLiteral                struct{} `json:"-"`
+	XXX_unrecognized                    []byte   `json:"-"`
+	XXX_sizecache                       int32    `json:"-"`
 }
 
 func (m *DlpTransformation) Reset()         { *m = DlpTransformation{} }
@@ -281,6 +294,20 @@ func (m *DlpTransformation) GetActions() []*Action {
 	return nil
 }
 
+func (m *DlpTransformation) GetEnableHeaderTransformation() bool {
+	if m != nil {
+		return m.EnableHeaderTransformation
+	}
+	return false
+}
+
+func (m *DlpTransformation) GetEnableDynamicMetadataTransformation() bool {
+	if m != nil {
+		return m.EnableDynamicMetadataTransformation
+	}
+	return false
+}
+
 type Action struct {
 	// Identifier for this action.
 	// Used mostly to help ID specific actions in logs.
@@ -296,10 +323,13 @@ type Action struct {
 	Percent *_type.Percent `protobuf:"bytes,4,opt,name=percent,proto3" json:"percent,omitempty"`
 	// The character which should overwrite the masked data
 	// If left empty, defaults to "X"
-	MaskChar             string   `protobuf:"bytes,5,opt,name=mask_char,json=maskChar,proto3" json:"mask_char,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	MaskChar string `protobuf:"bytes,5,opt,name=mask_char,json=maskChar,proto3" json:"mask_char,omitempty"`
+	// List of regexes to apply, each of which can mask a single capture group of its matches.
+	// They are applied after the regexes of the `regex` field
+	RegexActions         []*RegexAction `protobuf:"bytes,6,rep,name=regex_actions,json=regexActions,proto3" json:"regex_actions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *Action) Reset()         { *m = Action{} }
@@ -361,6 +391,61 @@ func (m *Action) GetMaskChar() string {
 	return ""
 }
 
+func (m *Action) GetRegexActions() []*RegexAction {
+	if m != nil {
+		return m.RegexActions
+	}
+	return nil
+}
+
+type RegexAction struct {
+	// The regex to match for masking
+	Regex string `protobuf:"bytes,1,opt,name=regex,proto3" json:"regex,omitempty"`
+	// If provided and not 0, only this specific subgroup of the regex will be masked
+	Subgroup             uint32   `protobuf:"varint,2,opt,name=subgroup,proto3" json:"subgroup,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegexAction) Reset()         { *m = RegexAction{} }
+func (m *RegexAction) String() string { return proto.CompactTextString(m) }
+func (*RegexAction) ProtoMessage()    {}
+func (*RegexAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73e36764f5fd991d, []int{6}
+}
+func (m *RegexAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegexAction.Unmarshal(m, b)
+}
+func (m *RegexAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegexAction.Marshal(b, m, deterministic)
+}
+func (m *RegexAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegexAction.Merge(m, src)
+}
+func (m *RegexAction) XXX_Size() int {
+	return xxx_messageInfo_RegexAction.Size(m)
+}
+func (m *RegexAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegexAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegexAction proto.InternalMessageInfo
+
+func (m *RegexAction) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *RegexAction) GetSubgroup() uint32 {
+	if m != nil {
+		return m.Subgroup
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*FilterTransformations)(nil), "envoy.config.filter.http.transformation_ee.v2.FilterTransformations")
 	proto.RegisterType((*TransformationRule)(nil), "envoy.config.filter.http.transformation_ee.v2.TransformationRule")
@@ -368,6 +453,7 @@ func init() {
 	proto.RegisterType((*Transformation)(nil), "envoy.config.filter.http.transformation_ee.v2.Transformation")
 	proto.RegisterType((*DlpTransformation)(nil), "envoy.config.filter.http.transformation_ee.v2.DlpTransformation")
 	proto.RegisterType((*Action)(nil), "envoy.config.filter.http.transformation_ee.v2.Action")
+	proto.RegisterType((*RegexAction)(nil), "envoy.config.filter.http.transformation_ee.v2.RegexAction")
 }
 
 func init() {
@@ -375,42 +461,51 @@ func init() {
 }
 
 var fileDescriptor_73e36764f5fd991d = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x6f, 0xd3, 0x3e,
-	0x18, 0xfe, 0x39, 0xeb, 0x5f, 0xef, 0x27, 0x46, 0xbd, 0xb5, 0x8b, 0x86, 0x34, 0x55, 0x11, 0x87,
-	0x0a, 0xa9, 0x89, 0x54, 0xc4, 0x09, 0x81, 0x58, 0xca, 0x10, 0x17, 0x44, 0x65, 0x71, 0x81, 0x4b,
-	0xe5, 0xa5, 0x6e, 0x93, 0x35, 0x8d, 0x3d, 0xdb, 0x2d, 0xed, 0x9d, 0x13, 0x47, 0x8e, 0x7c, 0x07,
-	0x24, 0x6e, 0x7c, 0x0b, 0x3e, 0x50, 0x4f, 0x28, 0x76, 0x2a, 0x91, 0xb4, 0x07, 0xa2, 0x5d, 0x22,
-	0xfb, 0xfd, 0xf3, 0xbc, 0xcf, 0xf3, 0x44, 0x7e, 0xe1, 0xed, 0x2c, 0x52, 0xe1, 0xf2, 0xc6, 0x0d,
-	0xd8, 0xc2, 0x93, 0x2c, 0x66, 0xfd, 0x88, 0x79, 0xb3, 0x98, 0x31, 0x8f, 0x0b, 0x76, 0x4b, 0x03,
-	0x25, 0xcd, 0x8d, 0xf0, 0xc8, 0xa3, 0x6b, 0x45, 0x45, 0x42, 0x62, 0x8f, 0x26, 0x2b, 0xb6, 0xd1,
-	0xd7, 0x44, 0x46, 0x2c, 0x91, 0x9e, 0x12, 0x24, 0x91, 0x53, 0x26, 0x16, 0x44, 0x45, 0x2c, 0x19,
-	0x53, 0x5a, 0x88, 0xb8, 0x5c, 0x30, 0xc5, 0x50, 0x5f, 0x37, 0xba, 0x01, 0x4b, 0xa6, 0xd1, 0xcc,
-	0x9d, 0x46, 0xb1, 0xa2, 0xc2, 0x0d, 0x95, 0xe2, 0xee, 0x1e, 0x80, 0xbb, 0x1a, 0x5c, 0x9c, 0xaf,
-	0x48, 0x1c, 0x4d, 0x88, 0xa2, 0xde, 0xee, 0x60, 0x70, 0x2e, 0x2e, 0x0d, 0x81, 0x94, 0xd3, 0x6a,
-	0xe0, 0x09, 0xb6, 0x54, 0xd4, 0x7c, 0xb3, 0xbc, 0x6d, 0xf2, 0x6a, 0xc3, 0xa9, 0xc7, 0xa9, 0x08,
-	0x68, 0xa2, 0x4c, 0xc6, 0xf9, 0x02, 0x60, 0xfb, 0x8d, 0x9e, 0xfb, 0x21, 0x37, 0x51, 0xa2, 0x39,
-	0x3c, 0xc9, 0x93, 0x90, 0x36, 0xe8, 0x1e, 0xf5, 0x8e, 0x07, 0x57, 0x6e, 0x29, 0xd6, 0x6e, 0x1e,
-	0x18, 0x2f, 0x63, 0x8a, 0x8b, 0xc8, 0xce, 0x6f, 0x00, 0xd1, 0x7e, 0x1d, 0x7a, 0x09, 0xab, 0x0b,
-	0xa2, 0x82, 0xd0, 0x06, 0x5d, 0xd0, 0x3b, 0x1e, 0x5c, 0x66, 0x93, 0x09, 0x8f, 0x52, 0x60, 0xa3,
-	0x10, 0xa7, 0xdf, 0x77, 0x69, 0x95, 0xdf, 0xd8, 0xfa, 0xd5, 0xaf, 0xc0, 0x7a, 0x08, 0xb0, 0x69,
-	0x43, 0x6b, 0xd8, 0xd6, 0x45, 0xe3, 0xa2, 0x12, 0x4b, 0xe3, 0x0d, 0x4b, 0x2a, 0xd1, 0xa3, 0x0a,
-	0x3e, 0xe1, 0x33, 0x71, 0x20, 0xea, 0xfc, 0xb4, 0xe0, 0xd9, 0xa1, 0x72, 0xa4, 0x60, 0x47, 0xd0,
-	0xbb, 0x25, 0x95, 0xaa, 0x40, 0x2a, 0xd3, 0xf8, 0xe2, 0x7e, 0xee, 0xb6, 0x33, 0xf0, 0x7c, 0x18,
-	0x3d, 0x81, 0xad, 0x20, 0xa6, 0x44, 0x8c, 0x8d, 0x1d, 0x01, 0x09, 0x42, 0x6a, 0x1f, 0x75, 0x41,
-	0xaf, 0x81, 0x4f, 0x74, 0x42, 0x73, 0x1d, 0xa6, 0x61, 0xb4, 0x82, 0xe7, 0x82, 0x4a, 0xce, 0x12,
-	0x59, 0xf4, 0x2d, 0xb3, 0xed, 0x9e, 0x14, 0x3b, 0x3b, 0xf4, 0x7c, 0xdc, 0xf9, 0x0e, 0xe0, 0x83,
-	0x02, 0xed, 0x3b, 0x88, 0x26, 0x31, 0x3f, 0x6c, 0xd4, 0xab, 0x92, 0x2c, 0x5e, 0xc7, 0x3c, 0x8f,
-	0xfe, 0xf6, 0x3f, 0xdc, 0x9a, 0x14, 0x83, 0x7e, 0x1b, 0x9e, 0x16, 0xda, 0xd3, 0x57, 0xe3, 0x4c,
-	0x60, 0x6b, 0x0f, 0x00, 0xbd, 0x87, 0x75, 0x12, 0xfc, 0xfd, 0x34, 0x9e, 0x95, 0xe4, 0x74, 0xa5,
-	0xbb, 0xf1, 0x0e, 0xc5, 0xf9, 0x01, 0x60, 0xcd, 0xc4, 0x10, 0x82, 0x95, 0x84, 0x2c, 0xa8, 0x16,
-	0xdb, 0xc4, 0xfa, 0x8c, 0x1c, 0x58, 0x15, 0x74, 0x46, 0xd7, 0xb6, 0xd5, 0x3d, 0xea, 0x35, 0xfd,
-	0xff, 0xb7, 0x7e, 0xf3, 0x1b, 0xa8, 0x39, 0x15, 0x61, 0x75, 0x01, 0x36, 0x29, 0xd4, 0x81, 0x35,
-	0x19, 0x92, 0x09, 0xfb, 0x9c, 0xfd, 0xde, 0xec, 0x86, 0xfa, 0xb0, 0x9e, 0xbd, 0x7c, 0xbb, 0xa2,
-	0xfd, 0x3b, 0xcd, 0xb8, 0xa6, 0xf2, 0xdc, 0x91, 0x49, 0xe1, 0x5d, 0x0d, 0x7a, 0x0c, 0x9b, 0x0b,
-	0x22, 0xe7, 0xe3, 0x20, 0x24, 0xc2, 0xae, 0xa6, 0x1c, 0xfc, 0xfa, 0xd6, 0xaf, 0x08, 0xab, 0x07,
-	0x70, 0x23, 0xcd, 0x0c, 0x43, 0x22, 0xfc, 0x5f, 0x00, 0x3e, 0x8f, 0x98, 0x01, 0xe2, 0x82, 0xad,
-	0x37, 0xe5, 0xf4, 0xfb, 0x8f, 0xf2, 0x86, 0x5e, 0x5f, 0x9b, 0x55, 0x34, 0x4a, 0x57, 0xd3, 0x08,
-	0x7c, 0xfa, 0xf8, 0x6f, 0xab, 0x98, 0xcf, 0x67, 0x65, 0xd7, 0xf1, 0x4d, 0x4d, 0xaf, 0xbf, 0xa7,
-	0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x89, 0x40, 0xa8, 0x4b, 0xee, 0x05, 0x00, 0x00,
+	// 731 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x71, 0xff, 0xad, 0xf5, 0x36, 0xc6, 0xbc, 0x75, 0xab, 0x0a, 0x4c, 0x55, 0x86, 0x50,
+	0x85, 0xb4, 0x44, 0x2a, 0xe2, 0x02, 0x02, 0x6d, 0xe9, 0x86, 0x76, 0x99, 0x98, 0x32, 0x2e, 0x70,
+	0x89, 0xdc, 0xd4, 0x6b, 0xb2, 0x25, 0x71, 0x66, 0xbb, 0xa5, 0xbd, 0x73, 0x42, 0x88, 0x03, 0x47,
+	0xbe, 0x08, 0x47, 0xbe, 0x01, 0x1f, 0x68, 0x5c, 0x50, 0xec, 0x74, 0x5b, 0xbd, 0x1e, 0x88, 0xd8,
+	0x25, 0x8a, 0xfd, 0x3e, 0x7e, 0xde, 0x9f, 0xdf, 0xd7, 0x89, 0xe1, 0xd9, 0x20, 0x10, 0xfe, 0xb0,
+	0x67, 0x7a, 0x34, 0xb2, 0x38, 0x0d, 0xe9, 0x4e, 0x40, 0xad, 0x41, 0x48, 0xa9, 0x95, 0x30, 0x7a,
+	0x46, 0x3c, 0xc1, 0xd5, 0x08, 0x27, 0x81, 0x45, 0xc6, 0x82, 0xb0, 0x18, 0x87, 0x16, 0x89, 0x47,
+	0x74, 0x22, 0x87, 0x31, 0x0f, 0x68, 0xcc, 0x2d, 0xc1, 0x70, 0xcc, 0x4f, 0x29, 0x8b, 0xb0, 0x08,
+	0x68, 0xec, 0x12, 0xa2, 0xcd, 0x98, 0x09, 0xa3, 0x82, 0xa2, 0x1d, 0xb9, 0xd0, 0xf4, 0x68, 0x7c,
+	0x1a, 0x0c, 0xcc, 0xd3, 0x20, 0x14, 0x84, 0x99, 0xbe, 0x10, 0x89, 0x79, 0xcb, 0xc0, 0x1c, 0x75,
+	0x9a, 0x9b, 0x23, 0x1c, 0x06, 0x7d, 0x2c, 0x88, 0x35, 0x7d, 0x51, 0x3e, 0xcd, 0x2d, 0x05, 0x90,
+	0x32, 0x8d, 0x3a, 0x16, 0xa3, 0x43, 0x41, 0xd4, 0x33, 0x8b, 0x37, 0x54, 0x5c, 0x4c, 0x12, 0x62,
+	0x25, 0x84, 0x79, 0x24, 0x16, 0x2a, 0x62, 0x7c, 0x06, 0xb0, 0xfe, 0x56, 0xe6, 0x7d, 0x3f, 0x93,
+	0x91, 0xa3, 0x73, 0xb8, 0x32, 0x0b, 0xc1, 0x1b, 0xa0, 0x55, 0x6c, 0x2f, 0x76, 0xf6, 0xcc, 0x5c,
+	0xd4, 0xe6, 0xac, 0xb1, 0x33, 0x0c, 0x89, 0xa3, 0x3b, 0x1b, 0xbf, 0x01, 0x44, 0xb7, 0x75, 0xe8,
+	0x0d, 0x2c, 0x47, 0x58, 0x78, 0x7e, 0x03, 0xb4, 0x40, 0x7b, 0xb1, 0xb3, 0x95, 0x65, 0xc6, 0x49,
+	0x90, 0x1a, 0xab, 0x1d, 0x3a, 0xe9, 0xf3, 0x28, 0x55, 0xd9, 0xd5, 0x4b, 0xbb, 0xfc, 0x05, 0x14,
+	0x1e, 0x00, 0x47, 0x2d, 0x43, 0x63, 0x58, 0x97, 0x22, 0x57, 0xdf, 0x49, 0x41, 0xfa, 0x75, 0x73,
+	0xee, 0x44, 0xa6, 0xd2, 0xea, 0xe4, 0xac, 0xb3, 0x39, 0xb3, 0xc6, 0xaf, 0x22, 0x5c, 0x9f, 0x27,
+	0x47, 0x02, 0x6e, 0x30, 0x72, 0x31, 0x24, 0x5c, 0x68, 0x50, 0xd9, 0x1e, 0x5f, 0xff, 0x5f, 0x75,
+	0xeb, 0x99, 0xf9, 0xec, 0x34, 0x7a, 0x06, 0x57, 0xbd, 0x90, 0x60, 0xe6, 0xaa, 0x72, 0x78, 0xd8,
+	0xf3, 0x49, 0xa3, 0xd8, 0x02, 0xed, 0xaa, 0xb3, 0x22, 0x03, 0x92, 0xb5, 0x9b, 0x4e, 0xa3, 0x11,
+	0xdc, 0x64, 0x84, 0x27, 0x34, 0xe6, 0x7a, 0xdd, 0x1a, 0x85, 0xbb, 0x40, 0xdc, 0x98, 0xba, 0x6b,
+	0x8c, 0x5f, 0x01, 0xdc, 0xa6, 0xb1, 0xcb, 0x05, 0x23, 0x38, 0x72, 0x3d, 0x1a, 0x25, 0x21, 0x91,
+	0x2e, 0x1a, 0x44, 0xe9, 0x2e, 0x20, 0x5a, 0x34, 0x3e, 0x91, 0x89, 0xba, 0x57, 0x79, 0x66, 0x15,
+	0xc6, 0x0f, 0x00, 0xef, 0x6b, 0x84, 0x17, 0x10, 0xf5, 0xc3, 0x64, 0x7e, 0xdf, 0x76, 0x73, 0xf2,
+	0xec, 0x87, 0xc9, 0xac, 0xfb, 0xe1, 0x3d, 0x67, 0xb5, 0xaf, 0x4f, 0xda, 0x75, 0xb8, 0xa6, 0x2d,
+	0x4f, 0x3f, 0x62, 0xe3, 0x0f, 0x80, 0xab, 0xb7, 0x1c, 0xd0, 0x3b, 0xb8, 0x80, 0xbd, 0x9b, 0x9f,
+	0xea, 0x8b, 0x9c, 0x50, 0x7b, 0x72, 0xb5, 0x33, 0x75, 0x41, 0xbb, 0xf0, 0x11, 0x89, 0x71, 0x2f,
+	0x24, 0xae, 0x4f, 0x70, 0x9f, 0xb0, 0x79, 0xe7, 0xa1, 0xea, 0x34, 0x95, 0xe6, 0x50, 0x4a, 0x34,
+	0xa4, 0x13, 0xf8, 0x34, 0x73, 0xe8, 0x4f, 0x62, 0x1c, 0x05, 0x9e, 0x1b, 0x11, 0x81, 0xfb, 0x58,
+	0x60, 0xdd, 0x4b, 0x9d, 0xc6, 0x6d, 0xa5, 0xde, 0x57, 0xe2, 0xa3, 0x4c, 0xab, 0xb5, 0xe6, 0x5b,
+	0x01, 0x56, 0x14, 0x2a, 0x42, 0xb0, 0x14, 0xe3, 0x88, 0xc8, 0x26, 0xd4, 0x1c, 0xf9, 0x8e, 0x0c,
+	0x58, 0x66, 0x64, 0x40, 0xc6, 0x8d, 0x42, 0xab, 0xd8, 0xae, 0xd9, 0x4b, 0x97, 0x76, 0xed, 0x3b,
+	0xa8, 0x18, 0x25, 0x56, 0x68, 0x01, 0x47, 0x85, 0xd0, 0x06, 0xac, 0x70, 0x1f, 0xf7, 0xe9, 0xa7,
+	0x2c, 0x6f, 0x36, 0x42, 0x3b, 0x70, 0x21, 0xfb, 0x41, 0x66, 0xe7, 0x6c, 0x2d, 0x2b, 0x61, 0x5a,
+	0x76, 0xf3, 0x58, 0x85, 0x9c, 0xa9, 0x06, 0x3d, 0x81, 0xb5, 0x08, 0xf3, 0x73, 0xd7, 0xf3, 0x31,
+	0x6b, 0x94, 0x53, 0x06, 0x7b, 0xe1, 0xd2, 0x2e, 0xb1, 0x42, 0x1b, 0x38, 0xd5, 0x34, 0xd2, 0xf5,
+	0x31, 0x43, 0x2e, 0x5c, 0x96, 0x59, 0xdd, 0x69, 0x77, 0x2a, 0xb2, 0x3b, 0x2f, 0xf3, 0xfe, 0x7e,
+	0x52, 0x8f, 0xac, 0x45, 0x4b, 0xec, 0x7a, 0xc0, 0x8d, 0x43, 0xb8, 0x78, 0x23, 0x88, 0x1e, 0x4f,
+	0x0b, 0x00, 0x6e, 0x10, 0x5d, 0xef, 0xbd, 0x09, 0xab, 0x7c, 0xd8, 0x1b, 0x30, 0x3a, 0x4c, 0x64,
+	0x07, 0x97, 0x9d, 0xab, 0xb1, 0xfd, 0x13, 0xc0, 0x57, 0x01, 0x55, 0x60, 0x09, 0xa3, 0xe3, 0x49,
+	0x3e, 0x46, 0xfb, 0xe1, 0x6c, 0xab, 0x0e, 0x0e, 0xd4, 0xe5, 0x72, 0xcc, 0xa8, 0xa0, 0xc7, 0xe0,
+	0xe3, 0x87, 0x7f, 0xbb, 0x5c, 0x93, 0xf3, 0x41, 0xde, 0x0b, 0xb6, 0x57, 0x91, 0x17, 0xda, 0xf3,
+	0xbf, 0x03, 0x00, 0x5d, 0xda, 0x07, 0xc4, 0xc0, 0x07, 0x00, 0x00,
 }
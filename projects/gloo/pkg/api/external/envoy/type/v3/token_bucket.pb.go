@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/external/envoy/type/v3/token_bucket.proto
+
+package v3
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	_ "github.com/solo-io/gloo/projects/gloo/pkg/api/external/udpa/annotations"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Configures a token bucket, typically used for rate limiting.
+type TokenBucket struct {
+	// The maximum tokens that the bucket can hold. This is also the number of tokens that the bucket
+	// initially contains.
+	MaxTokens uint32 `protobuf:"varint,1,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+	// The number of tokens added to the bucket during each fill interval. If not specified, defaults
+	// to a single token.
+	TokensPerFill *types.UInt32Value `protobuf:"bytes,2,opt,name=tokens_per_fill,json=tokensPerFill,proto3" json:"tokens_per_fill,omitempty"`
+	// The fill interval that tokens are added to the bucket. During each fill interval
+	// `tokens_per_fill` are added to the bucket. The bucket will never contain more than
+	// `max_tokens` tokens.
+	FillInterval         *types.Duration `protobuf:"bytes,3,opt,name=fill_interval,json=fillInterval,proto3" json:"fill_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *TokenBucket) Reset()         { *m = TokenBucket{} }
+func (m *TokenBucket) String() string { return proto.CompactTextString(m) }
+func (*TokenBucket) ProtoMessage()    {}
+func (*TokenBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1670aa5e61ded087, []int{0}
+}
+func (m *TokenBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenBucket.Unmarshal(m, b)
+}
+func (m *TokenBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenBucket.Marshal(b, m, deterministic)
+}
+func (m *TokenBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenBucket.Merge(m, src)
+}
+func (m *TokenBucket) XXX_Size() int {
+	return xxx_messageInfo_TokenBucket.Size(m)
+}
+func (m *TokenBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenBucket proto.InternalMessageInfo
+
+func (m *TokenBucket) GetMaxTokens() uint32 {
+	if m != nil {
+		return m.MaxTokens
+	}
+	return 0
+}
+
+func (m *TokenBucket) GetTokensPerFill() *types.UInt32Value {
+	if m != nil {
+		return m.TokensPerFill
+	}
+	return nil
+}
+
+func (m *TokenBucket) GetFillInterval() *types.Duration {
+	if m != nil {
+		return m.FillInterval
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TokenBucket)(nil), "envoy.type.v3.TokenBucket")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/external/envoy/type/v3/token_bucket.proto", fileDescriptor_1670aa5e61ded087)
+}
+
+var fileDescriptor_1670aa5e61ded087 = []byte{
+	// 424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4d, 0x6b, 0x14, 0x31,
+	0x18, 0xc7, 0x9b, 0x75, 0x69, 0x35, 0x75, 0xb1, 0x0c, 0xa2, 0xeb, 0x4b, 0x97, 0xd5, 0x83, 0x94,
+	0x82, 0x09, 0x74, 0x6e, 0x1e, 0x87, 0x22, 0x54, 0x10, 0x96, 0xfa, 0x72, 0xf0, 0x32, 0x64, 0x76,
+	0x9f, 0xc6, 0xb8, 0xd9, 0x3c, 0x21, 0xc9, 0x8c, 0xb3, 0x37, 0x6f, 0xfa, 0x19, 0xfc, 0x04, 0xe2,
+	0x27, 0x10, 0xef, 0x82, 0x57, 0xbf, 0x82, 0x5f, 0xc0, 0x93, 0x97, 0x9e, 0x64, 0x32, 0xb3, 0xb8,
+	0xb2, 0x20, 0xbd, 0x3d, 0x93, 0xff, 0x0b, 0xf3, 0x4b, 0x1e, 0xfa, 0x4c, 0xaa, 0xf0, 0xba, 0x2c,
+	0xd8, 0x14, 0x17, 0xdc, 0xa3, 0xc6, 0x87, 0x0a, 0xb9, 0xd4, 0x88, 0xdc, 0x3a, 0x7c, 0x03, 0xd3,
+	0xe0, 0xdb, 0x2f, 0x61, 0x15, 0x87, 0x3a, 0x80, 0x33, 0x42, 0x73, 0x30, 0x15, 0x2e, 0x79, 0x58,
+	0x5a, 0xe0, 0x55, 0xca, 0x03, 0xce, 0xc1, 0xe4, 0x45, 0x39, 0x9d, 0x43, 0x60, 0xd6, 0x61, 0xc0,
+	0x64, 0x10, 0x1d, 0xac, 0x71, 0xb0, 0x2a, 0xbd, 0x3d, 0x92, 0x88, 0x52, 0x03, 0x8f, 0x62, 0x51,
+	0x9e, 0xf1, 0x59, 0xe9, 0x44, 0x50, 0x68, 0x5a, 0xfb, 0xa6, 0xfe, 0xd6, 0x09, 0x6b, 0xc1, 0xf9,
+	0x4e, 0xdf, 0x2f, 0x67, 0x56, 0x70, 0x61, 0x0c, 0x86, 0x18, 0xf3, 0xdc, 0x07, 0x11, 0xca, 0x95,
+	0x7c, 0x6f, 0x43, 0xae, 0xc0, 0x79, 0x85, 0x46, 0x19, 0xd9, 0x59, 0x6e, 0x56, 0x42, 0xab, 0x99,
+	0x08, 0xc0, 0x57, 0x43, 0x27, 0x5c, 0x97, 0x28, 0x31, 0x8e, 0xbc, 0x99, 0xba, 0xd3, 0x04, 0xea,
+	0xd0, 0x1e, 0x42, 0xdd, 0x31, 0xdd, 0xff, 0x45, 0xe8, 0xee, 0xf3, 0x06, 0x35, 0x8b, 0xa4, 0xc9,
+	0x03, 0x4a, 0x17, 0xa2, 0xce, 0x23, 0xbd, 0x1f, 0x92, 0x31, 0x39, 0x18, 0x64, 0x3b, 0xe7, 0x59,
+	0xff, 0xb0, 0x37, 0xde, 0x3a, 0xbd, 0xb2, 0x10, 0x75, 0x34, 0xfb, 0xe4, 0x29, 0xbd, 0xd6, 0x7a,
+	0x72, 0x0b, 0x2e, 0x3f, 0x53, 0x5a, 0x0f, 0x7b, 0x63, 0x72, 0xb0, 0x7b, 0x74, 0x97, 0xb5, 0xd8,
+	0x6c, 0x85, 0xcd, 0x5e, 0x9c, 0x98, 0x90, 0x1e, 0xbd, 0x14, 0xba, 0x84, 0xbf, 0x55, 0x83, 0x36,
+	0x3d, 0x01, 0xf7, 0x58, 0x69, 0x9d, 0x3c, 0xa1, 0x83, 0xa6, 0x23, 0x57, 0x26, 0x80, 0xab, 0x84,
+	0x1e, 0x5e, 0x8a, 0x65, 0xb7, 0x36, 0xca, 0x8e, 0xbb, 0x3b, 0xce, 0xe8, 0x79, 0xb6, 0xf3, 0x99,
+	0xf4, 0x2f, 0x93, 0xc3, 0xad, 0xd3, 0xab, 0x4d, 0xf6, 0xa4, 0x8b, 0x3e, 0xda, 0xff, 0xf8, 0xed,
+	0xc3, 0x68, 0x48, 0x6f, 0xac, 0xbd, 0xd6, 0x1a, 0x61, 0xf6, 0x9e, 0x7c, 0xf9, 0xdd, 0x27, 0x9f,
+	0x7e, 0x8e, 0xc8, 0xd7, 0x77, 0xdf, 0x7f, 0x6c, 0xf7, 0xf6, 0x7a, 0xf4, 0x8e, 0x42, 0x16, 0xdd,
+	0xd6, 0x61, 0xbd, 0x64, 0xff, 0x3c, 0x73, 0xb6, 0xb7, 0x16, 0x9e, 0x34, 0xbf, 0x31, 0x21, 0xaf,
+	0x8e, 0x2f, 0xb6, 0x5e, 0x76, 0x2e, 0xff, 0xb3, 0x62, 0xc5, 0x76, 0xa4, 0x4a, 0xff, 0x0c, 0x00,
+	0x65, 0x0c, 0xb7, 0xf6, 0xad, 0x02, 0x00, 0x00,
+}
+
+func (this *TokenBucket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenBucket)
+	if !ok {
+		that2, ok := that.(TokenBucket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxTokens != that1.MaxTokens {
+		return false
+	}
+	if !this.TokensPerFill.Equal(that1.TokensPerFill) {
+		return false
+	}
+	if !this.FillInterval.Equal(that1.FillInterval) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
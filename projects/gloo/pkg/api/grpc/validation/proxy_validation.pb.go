@@ -1,5 +1,5 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: projects/gloo/api/grpc/validation/proxy_validation.proto
+// source: github.com/solo-io/gloo/projects/gloo/api/grpc/validation/proxy_validation.proto
 
 package validation
 
@@ -54,7 +54,7 @@ func (x ListenerReport_Error_Type) String() string {
 }
 
 func (ListenerReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{5, 0, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{5, 0, 0}
 }
 
 type HttpListenerReport_Error_Type int32
@@ -76,7 +76,7 @@ func (x HttpListenerReport_Error_Type) String() string {
 }
 
 func (HttpListenerReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{6, 0, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{8, 0, 0}
 }
 
 type VirtualHostReport_Error_Type int32
@@ -107,7 +107,7 @@ func (x VirtualHostReport_Error_Type) String() string {
 }
 
 func (VirtualHostReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{7, 0, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{9, 0, 0}
 }
 
 type RouteReport_Error_Type int32
@@ -132,7 +132,7 @@ func (x RouteReport_Error_Type) String() string {
 }
 
 func (RouteReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8, 0, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{10, 0, 0}
 }
 
 type RouteReport_Warning_Type int32
@@ -154,7 +154,7 @@ func (x RouteReport_Warning_Type) String() string {
 }
 
 func (RouteReport_Warning_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8, 1, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{10, 1, 0}
 }
 
 type TcpListenerReport_Error_Type int32
@@ -185,7 +185,7 @@ func (x TcpListenerReport_Error_Type) String() string {
 }
 
 func (TcpListenerReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{9, 0, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{11, 0, 0}
 }
 
 type TcpHostReport_Error_Type int32
@@ -213,7 +213,7 @@ func (x TcpHostReport_Error_Type) String() string {
 }
 
 func (TcpHostReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{10, 0, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{12, 0, 0}
 }
 
 type ProxyValidationServiceRequest struct {
@@ -227,7 +227,7 @@ func (m *ProxyValidationServiceRequest) Reset()         { *m = ProxyValidationSe
 func (m *ProxyValidationServiceRequest) String() string { return proto.CompactTextString(m) }
 func (*ProxyValidationServiceRequest) ProtoMessage()    {}
 func (*ProxyValidationServiceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{0}
+	return fileDescriptor_8f4537dae4069b18, []int{0}
 }
 func (m *ProxyValidationServiceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProxyValidationServiceRequest.Unmarshal(m, b)
@@ -255,17 +255,21 @@ func (m *ProxyValidationServiceRequest) GetProxy() *v1.Proxy {
 }
 
 type ProxyValidationServiceResponse struct {
-	ProxyReport          *ProxyReport `protobuf:"bytes,1,opt,name=proxy_report,json=proxyReport,proto3" json:"proxy_report,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	ProxyReport *ProxyReport `protobuf:"bytes,1,opt,name=proxy_report,json=proxyReport,proto3" json:"proxy_report,omitempty"`
+	// the errors of the full translation of the proxy which are not reported on the proxy:
+	// the Envoy resources generated for the proxy which do not pass the Envoy validation,
+	// and the errors of the upstreams the proxy routes to
+	TranslationErrors    []string `protobuf:"bytes,2,rep,name=translation_errors,json=translationErrors,proto3" json:"translation_errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ProxyValidationServiceResponse) Reset()         { *m = ProxyValidationServiceResponse{} }
 func (m *ProxyValidationServiceResponse) String() string { return proto.CompactTextString(m) }
 func (*ProxyValidationServiceResponse) ProtoMessage()    {}
 func (*ProxyValidationServiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{1}
+	return fileDescriptor_8f4537dae4069b18, []int{1}
 }
 func (m *ProxyValidationServiceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProxyValidationServiceResponse.Unmarshal(m, b)
@@ -292,6 +296,13 @@ func (m *ProxyValidationServiceResponse) GetProxyReport() *ProxyReport {
 	return nil
 }
 
+func (m *ProxyValidationServiceResponse) GetTranslationErrors() []string {
+	if m != nil {
+		return m.TranslationErrors
+	}
+	return nil
+}
+
 type NotifyOnResyncRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -302,7 +313,7 @@ func (m *NotifyOnResyncRequest) Reset()         { *m = NotifyOnResyncRequest{} }
 func (m *NotifyOnResyncRequest) String() string { return proto.CompactTextString(m) }
 func (*NotifyOnResyncRequest) ProtoMessage()    {}
 func (*NotifyOnResyncRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{2}
+	return fileDescriptor_8f4537dae4069b18, []int{2}
 }
 func (m *NotifyOnResyncRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NotifyOnResyncRequest.Unmarshal(m, b)
@@ -332,7 +343,7 @@ func (m *NotifyOnResyncResponse) Reset()         { *m = NotifyOnResyncResponse{}
 func (m *NotifyOnResyncResponse) String() string { return proto.CompactTextString(m) }
 func (*NotifyOnResyncResponse) ProtoMessage()    {}
 func (*NotifyOnResyncResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{3}
+	return fileDescriptor_8f4537dae4069b18, []int{3}
 }
 func (m *NotifyOnResyncResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NotifyOnResyncResponse.Unmarshal(m, b)
@@ -369,7 +380,7 @@ func (m *ProxyReport) Reset()         { *m = ProxyReport{} }
 func (m *ProxyReport) String() string { return proto.CompactTextString(m) }
 func (*ProxyReport) ProtoMessage()    {}
 func (*ProxyReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{4}
+	return fileDescriptor_8f4537dae4069b18, []int{4}
 }
 func (m *ProxyReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProxyReport.Unmarshal(m, b)
@@ -402,6 +413,7 @@ type ListenerReport struct {
 	// Types that are valid to be assigned to ListenerTypeReport:
 	//	*ListenerReport_HttpListenerReport
 	//	*ListenerReport_TcpListenerReport
+	//	*ListenerReport_HybridListenerReport
 	ListenerTypeReport   isListenerReport_ListenerTypeReport `protobuf_oneof:"listener_type_report"`
 	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
 	XXX_unrecognized     []byte                              `json:"-"`
@@ -412,7 +424,7 @@ func (m *ListenerReport) Reset()         { *m = ListenerReport{} }
 func (m *ListenerReport) String() string { return proto.CompactTextString(m) }
 func (*ListenerReport) ProtoMessage()    {}
 func (*ListenerReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{5}
+	return fileDescriptor_8f4537dae4069b18, []int{5}
 }
 func (m *ListenerReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListenerReport.Unmarshal(m, b)
@@ -442,9 +454,13 @@ type ListenerReport_HttpListenerReport struct {
 type ListenerReport_TcpListenerReport struct {
 	TcpListenerReport *TcpListenerReport `protobuf:"bytes,4,opt,name=tcp_listener_report,json=tcpListenerReport,proto3,oneof" json:"tcp_listener_report,omitempty"`
 }
+type ListenerReport_HybridListenerReport struct {
+	HybridListenerReport *HybridListenerReport `protobuf:"bytes,5,opt,name=hybrid_listener_report,json=hybridListenerReport,proto3,oneof" json:"hybrid_listener_report,omitempty"`
+}
 
-func (*ListenerReport_HttpListenerReport) isListenerReport_ListenerTypeReport() {}
-func (*ListenerReport_TcpListenerReport) isListenerReport_ListenerTypeReport()  {}
+func (*ListenerReport_HttpListenerReport) isListenerReport_ListenerTypeReport()   {}
+func (*ListenerReport_TcpListenerReport) isListenerReport_ListenerTypeReport()    {}
+func (*ListenerReport_HybridListenerReport) isListenerReport_ListenerTypeReport() {}
 
 func (m *ListenerReport) GetListenerTypeReport() isListenerReport_ListenerTypeReport {
 	if m != nil {
@@ -474,11 +490,19 @@ func (m *ListenerReport) GetTcpListenerReport() *TcpListenerReport {
 	return nil
 }
 
+func (m *ListenerReport) GetHybridListenerReport() *HybridListenerReport {
+	if x, ok := m.GetListenerTypeReport().(*ListenerReport_HybridListenerReport); ok {
+		return x.HybridListenerReport
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ListenerReport) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ListenerReport_HttpListenerReport)(nil),
 		(*ListenerReport_TcpListenerReport)(nil),
+		(*ListenerReport_HybridListenerReport)(nil),
 	}
 }
 
@@ -497,7 +521,7 @@ func (m *ListenerReport_Error) Reset()         { *m = ListenerReport_Error{} }
 func (m *ListenerReport_Error) String() string { return proto.CompactTextString(m) }
 func (*ListenerReport_Error) ProtoMessage()    {}
 func (*ListenerReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{5, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{5, 0}
 }
 func (m *ListenerReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListenerReport_Error.Unmarshal(m, b)
@@ -531,6 +555,122 @@ func (m *ListenerReport_Error) GetReason() string {
 	return ""
 }
 
+type HybridListenerReport struct {
+	// reports for the matched listeners, in the order of the matched listeners of the hybrid listener
+	MatchedListenerReports []*MatchedListenerReport `protobuf:"bytes,1,rep,name=matched_listener_reports,json=matchedListenerReports,proto3" json:"matched_listener_reports,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                 `json:"-"`
+	XXX_unrecognized       []byte                   `json:"-"`
+	XXX_sizecache          int32                    `json:"-"`
+}
+
+func (m *HybridListenerReport) Reset()         { *m = HybridListenerReport{} }
+func (m *HybridListenerReport) String() string { return proto.CompactTextString(m) }
+func (*HybridListenerReport) ProtoMessage()    {}
+func (*HybridListenerReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f4537dae4069b18, []int{6}
+}
+func (m *HybridListenerReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HybridListenerReport.Unmarshal(m, b)
+}
+func (m *HybridListenerReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HybridListenerReport.Marshal(b, m, deterministic)
+}
+func (m *HybridListenerReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HybridListenerReport.Merge(m, src)
+}
+func (m *HybridListenerReport) XXX_Size() int {
+	return xxx_messageInfo_HybridListenerReport.Size(m)
+}
+func (m *HybridListenerReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_HybridListenerReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HybridListenerReport proto.InternalMessageInfo
+
+func (m *HybridListenerReport) GetMatchedListenerReports() []*MatchedListenerReport {
+	if m != nil {
+		return m.MatchedListenerReports
+	}
+	return nil
+}
+
+type MatchedListenerReport struct {
+	// Types that are valid to be assigned to ListenerReportType:
+	//	*MatchedListenerReport_HttpListenerReport
+	//	*MatchedListenerReport_TcpListenerReport
+	ListenerReportType   isMatchedListenerReport_ListenerReportType `protobuf_oneof:"listener_report_type"`
+	XXX_NoUnkeyedLiteral struct{}                                   `json:"-"`
+	XXX_unrecognized     []byte                                     `json:"-"`
+	XXX_sizecache        int32                                      `json:"-"`
+}
+
+func (m *MatchedListenerReport) Reset()         { *m = MatchedListenerReport{} }
+func (m *MatchedListenerReport) String() string { return proto.CompactTextString(m) }
+func (*MatchedListenerReport) ProtoMessage()    {}
+func (*MatchedListenerReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f4537dae4069b18, []int{7}
+}
+func (m *MatchedListenerReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchedListenerReport.Unmarshal(m, b)
+}
+func (m *MatchedListenerReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchedListenerReport.Marshal(b, m, deterministic)
+}
+func (m *MatchedListenerReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchedListenerReport.Merge(m, src)
+}
+func (m *MatchedListenerReport) XXX_Size() int {
+	return xxx_messageInfo_MatchedListenerReport.Size(m)
+}
+func (m *MatchedListenerReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchedListenerReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchedListenerReport proto.InternalMessageInfo
+
+type isMatchedListenerReport_ListenerReportType interface {
+	isMatchedListenerReport_ListenerReportType()
+}
+
+type MatchedListenerReport_HttpListenerReport struct {
+	HttpListenerReport *HttpListenerReport `protobuf:"bytes,1,opt,name=http_listener_report,json=httpListenerReport,proto3,oneof" json:"http_listener_report,omitempty"`
+}
+type MatchedListenerReport_TcpListenerReport struct {
+	TcpListenerReport *TcpListenerReport `protobuf:"bytes,2,opt,name=tcp_listener_report,json=tcpListenerReport,proto3,oneof" json:"tcp_listener_report,omitempty"`
+}
+
+func (*MatchedListenerReport_HttpListenerReport) isMatchedListenerReport_ListenerReportType() {}
+func (*MatchedListenerReport_TcpListenerReport) isMatchedListenerReport_ListenerReportType()  {}
+
+func (m *MatchedListenerReport) GetListenerReportType() isMatchedListenerReport_ListenerReportType {
+	if m != nil {
+		return m.ListenerReportType
+	}
+	return nil
+}
+
+func (m *MatchedListenerReport) GetHttpListenerReport() *HttpListenerReport {
+	if x, ok := m.GetListenerReportType().(*MatchedListenerReport_HttpListenerReport); ok {
+		return x.HttpListenerReport
+	}
+	return nil
+}
+
+func (m *MatchedListenerReport) GetTcpListenerReport() *TcpListenerReport {
+	if x, ok := m.GetListenerReportType().(*MatchedListenerReport_TcpListenerReport); ok {
+		return x.TcpListenerReport
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*MatchedListenerReport) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*MatchedListenerReport_HttpListenerReport)(nil),
+		(*MatchedListenerReport_TcpListenerReport)(nil),
+	}
+}
+
 type HttpListenerReport struct {
 	Errors []*HttpListenerReport_Error `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
 	// report for nested virtual hosts
@@ -544,7 +684,7 @@ func (m *HttpListenerReport) Reset()         { *m = HttpListenerReport{} }
 func (m *HttpListenerReport) String() string { return proto.CompactTextString(m) }
 func (*HttpListenerReport) ProtoMessage()    {}
 func (*HttpListenerReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{6}
+	return fileDescriptor_8f4537dae4069b18, []int{8}
 }
 func (m *HttpListenerReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HttpListenerReport.Unmarshal(m, b)
@@ -593,7 +733,7 @@ func (m *HttpListenerReport_Error) Reset()         { *m = HttpListenerReport_Err
 func (m *HttpListenerReport_Error) String() string { return proto.CompactTextString(m) }
 func (*HttpListenerReport_Error) ProtoMessage()    {}
 func (*HttpListenerReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{6, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{8, 0}
 }
 func (m *HttpListenerReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HttpListenerReport_Error.Unmarshal(m, b)
@@ -640,7 +780,7 @@ func (m *VirtualHostReport) Reset()         { *m = VirtualHostReport{} }
 func (m *VirtualHostReport) String() string { return proto.CompactTextString(m) }
 func (*VirtualHostReport) ProtoMessage()    {}
 func (*VirtualHostReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{7}
+	return fileDescriptor_8f4537dae4069b18, []int{9}
 }
 func (m *VirtualHostReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VirtualHostReport.Unmarshal(m, b)
@@ -689,7 +829,7 @@ func (m *VirtualHostReport_Error) Reset()         { *m = VirtualHostReport_Error
 func (m *VirtualHostReport_Error) String() string { return proto.CompactTextString(m) }
 func (*VirtualHostReport_Error) ProtoMessage()    {}
 func (*VirtualHostReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{7, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{9, 0}
 }
 func (m *VirtualHostReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VirtualHostReport_Error.Unmarshal(m, b)
@@ -737,7 +877,7 @@ func (m *RouteReport) Reset()         { *m = RouteReport{} }
 func (m *RouteReport) String() string { return proto.CompactTextString(m) }
 func (*RouteReport) ProtoMessage()    {}
 func (*RouteReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8}
+	return fileDescriptor_8f4537dae4069b18, []int{10}
 }
 func (m *RouteReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteReport.Unmarshal(m, b)
@@ -786,7 +926,7 @@ func (m *RouteReport_Error) Reset()         { *m = RouteReport_Error{} }
 func (m *RouteReport_Error) String() string { return proto.CompactTextString(m) }
 func (*RouteReport_Error) ProtoMessage()    {}
 func (*RouteReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{10, 0}
 }
 func (m *RouteReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteReport_Error.Unmarshal(m, b)
@@ -835,7 +975,7 @@ func (m *RouteReport_Warning) Reset()         { *m = RouteReport_Warning{} }
 func (m *RouteReport_Warning) String() string { return proto.CompactTextString(m) }
 func (*RouteReport_Warning) ProtoMessage()    {}
 func (*RouteReport_Warning) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8, 1}
+	return fileDescriptor_8f4537dae4069b18, []int{10, 1}
 }
 func (m *RouteReport_Warning) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteReport_Warning.Unmarshal(m, b)
@@ -882,7 +1022,7 @@ func (m *TcpListenerReport) Reset()         { *m = TcpListenerReport{} }
 func (m *TcpListenerReport) String() string { return proto.CompactTextString(m) }
 func (*TcpListenerReport) ProtoMessage()    {}
 func (*TcpListenerReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{9}
+	return fileDescriptor_8f4537dae4069b18, []int{11}
 }
 func (m *TcpListenerReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpListenerReport.Unmarshal(m, b)
@@ -931,7 +1071,7 @@ func (m *TcpListenerReport_Error) Reset()         { *m = TcpListenerReport_Error
 func (m *TcpListenerReport_Error) String() string { return proto.CompactTextString(m) }
 func (*TcpListenerReport_Error) ProtoMessage()    {}
 func (*TcpListenerReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{9, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{11, 0}
 }
 func (m *TcpListenerReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpListenerReport_Error.Unmarshal(m, b)
@@ -977,7 +1117,7 @@ func (m *TcpHostReport) Reset()         { *m = TcpHostReport{} }
 func (m *TcpHostReport) String() string { return proto.CompactTextString(m) }
 func (*TcpHostReport) ProtoMessage()    {}
 func (*TcpHostReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{10}
+	return fileDescriptor_8f4537dae4069b18, []int{12}
 }
 func (m *TcpHostReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpHostReport.Unmarshal(m, b)
@@ -1019,7 +1159,7 @@ func (m *TcpHostReport_Error) Reset()         { *m = TcpHostReport_Error{} }
 func (m *TcpHostReport_Error) String() string { return proto.CompactTextString(m) }
 func (*TcpHostReport_Error) ProtoMessage()    {}
 func (*TcpHostReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{10, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{12, 0}
 }
 func (m *TcpHostReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpHostReport_Error.Unmarshal(m, b)
@@ -1068,6 +1208,8 @@ func init() {
 	proto.RegisterType((*ProxyReport)(nil), "gloo.solo.io.ProxyReport")
 	proto.RegisterType((*ListenerReport)(nil), "gloo.solo.io.ListenerReport")
 	proto.RegisterType((*ListenerReport_Error)(nil), "gloo.solo.io.ListenerReport.Error")
+	proto.RegisterType((*HybridListenerReport)(nil), "gloo.solo.io.HybridListenerReport")
+	proto.RegisterType((*MatchedListenerReport)(nil), "gloo.solo.io.MatchedListenerReport")
 	proto.RegisterType((*HttpListenerReport)(nil), "gloo.solo.io.HttpListenerReport")
 	proto.RegisterType((*HttpListenerReport_Error)(nil), "gloo.solo.io.HttpListenerReport.Error")
 	proto.RegisterType((*VirtualHostReport)(nil), "gloo.solo.io.VirtualHostReport")
@@ -1082,66 +1224,72 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("projects/gloo/api/grpc/validation/proxy_validation.proto", fileDescriptor_aacaf097b496f502)
-}
-
-var fileDescriptor_aacaf097b496f502 = []byte{
-	// 878 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x52, 0x2b, 0x45,
-	0x14, 0xc6, 0x33, 0x09, 0x17, 0xf5, 0x04, 0x72, 0x43, 0x27, 0x37, 0x84, 0xc1, 0xeb, 0x0d, 0x23,
-	0x20, 0x8a, 0x4e, 0x34, 0x5a, 0x25, 0xa2, 0x40, 0x15, 0x42, 0x89, 0x16, 0x62, 0x18, 0x22, 0x56,
-	0xb9, 0x30, 0x35, 0x0c, 0x4d, 0xd2, 0x9a, 0x4c, 0x0f, 0xdd, 0x9d, 0x68, 0x16, 0xee, 0xdc, 0xfb,
-	0x06, 0x2e, 0xdd, 0xf9, 0x10, 0x56, 0xe9, 0x03, 0xb8, 0x75, 0xe5, 0xce, 0x77, 0x70, 0x65, 0x65,
-	0xa6, 0x33, 0x64, 0xfe, 0x24, 0x33, 0xcb, 0xbb, 0xec, 0xce, 0x39, 0x5f, 0x7f, 0xfd, 0x3b, 0x27,
-	0x3d, 0x07, 0xf6, 0x1c, 0x46, 0xbf, 0xc5, 0x96, 0xe0, 0xf5, 0x4e, 0x8f, 0xd2, 0xba, 0xe9, 0x90,
-	0x7a, 0x87, 0x39, 0x56, 0x7d, 0x68, 0xf6, 0xc8, 0xad, 0x29, 0x08, 0xb5, 0xeb, 0x0e, 0xa3, 0x3f,
-	0x8c, 0xda, 0x0f, 0x1b, 0xba, 0xc3, 0xa8, 0xa0, 0x68, 0x69, 0x9c, 0xa0, 0x73, 0xda, 0xa3, 0x3a,
-	0xa1, 0xea, 0x96, 0x9b, 0x1e, 0x15, 0x1b, 0xbe, 0xe3, 0xe5, 0x7b, 0x49, 0xda, 0x67, 0xf0, 0xb4,
-	0x39, 0x5e, 0x5e, 0xfb, 0x6a, 0x57, 0x98, 0x0d, 0x89, 0x85, 0x0d, 0x7c, 0x3f, 0xc0, 0x5c, 0xa0,
-	0xd7, 0xe1, 0x91, 0x1b, 0x5f, 0x55, 0x6a, 0xca, 0x4e, 0xbe, 0x51, 0xd2, 0xa7, 0x4f, 0xd1, 0xdd,
-	0x5c, 0xc3, 0x8b, 0xd0, 0xbe, 0x81, 0x57, 0x66, 0x69, 0x71, 0x87, 0xda, 0x1c, 0xa3, 0x8f, 0x60,
-	0xc9, 0x33, 0xcf, 0xb0, 0x43, 0x99, 0x90, 0x9a, 0x6b, 0x71, 0x9a, 0x6e, 0x80, 0x91, 0x77, 0x1e,
-	0x16, 0xda, 0x2a, 0x3c, 0xb9, 0xa0, 0x82, 0xdc, 0x8d, 0xbe, 0xb0, 0x0d, 0xcc, 0x47, 0xb6, 0x25,
-	0x3d, 0x6a, 0x55, 0xa8, 0x84, 0x7f, 0xf0, 0x0e, 0xd4, 0xae, 0x21, 0x3f, 0x25, 0x87, 0x3e, 0x81,
-	0x62, 0x8f, 0x70, 0x81, 0x6d, 0xcc, 0xa4, 0x05, 0x5e, 0x55, 0x6a, 0xb9, 0x9d, 0x7c, 0xe3, 0xe5,
-	0xa0, 0x87, 0x73, 0x19, 0x25, 0x6d, 0x3c, 0xee, 0x05, 0xd6, 0x5c, 0xfb, 0x2b, 0x07, 0x85, 0x60,
-	0x0c, 0xda, 0x87, 0x45, 0xcc, 0x18, 0x65, 0xbc, 0x9a, 0x75, 0x15, 0xb5, 0x79, 0x8a, 0xfa, 0xe9,
-	0x38, 0xd4, 0x90, 0x19, 0xa8, 0x05, 0xe5, 0xae, 0x10, 0x4e, 0x3b, 0x64, 0xae, 0x9a, 0x73, 0xf9,
-	0xd4, 0x82, 0x4a, 0x67, 0x42, 0x38, 0x41, 0xb5, 0xb3, 0x8c, 0x81, 0xba, 0x91, 0x5d, 0x74, 0x09,
-	0x25, 0x61, 0x45, 0x45, 0x17, 0x5c, 0xd1, 0x67, 0x41, 0xd1, 0x96, 0x15, 0xd5, 0x5c, 0x11, 0xe1,
-	0x4d, 0xf5, 0x77, 0x05, 0x1e, 0xb9, 0xd6, 0xd1, 0x87, 0xb0, 0x20, 0x46, 0x0e, 0x76, 0x4b, 0x58,
-	0x68, 0xbc, 0x96, 0x7c, 0x59, 0xbd, 0x35, 0x72, 0xb0, 0xe1, 0x26, 0xa1, 0x0a, 0x2c, 0x32, 0x6c,
-	0x72, 0x6a, 0x57, 0xb3, 0x35, 0x65, 0xe7, 0x25, 0x43, 0xae, 0x34, 0x0b, 0x16, 0x5a, 0xde, 0xef,
-	0xe8, 0xc2, 0xec, 0xe3, 0x0b, 0x2a, 0xbe, 0xb4, 0xc9, 0xfd, 0x00, 0xbb, 0x02, 0xc5, 0x0c, 0x52,
-	0xa1, 0x72, 0x4c, 0xec, 0xdb, 0x26, 0x65, 0x22, 0xf4, 0x9b, 0x82, 0x10, 0x14, 0xae, 0xae, 0xce,
-	0x3f, 0xa6, 0xf6, 0x1d, 0xe9, 0x78, 0x7b, 0x59, 0x54, 0x82, 0xc7, 0x4d, 0x46, 0x2d, 0xcc, 0x39,
-	0xb1, 0xe5, 0x66, 0xee, 0xb8, 0x02, 0x65, 0x1f, 0xc9, 0xd8, 0x8d, 0xe4, 0xa2, 0xfd, 0x9a, 0x05,
-	0x14, 0x65, 0x8b, 0x0e, 0xfd, 0xba, 0x7a, 0x9d, 0xb2, 0x9d, 0x54, 0x8d, 0x50, 0x6d, 0x2f, 0xa1,
-	0x3c, 0x24, 0x4c, 0x0c, 0xcc, 0x5e, 0xbb, 0x4b, 0xb9, 0xf0, 0xfb, 0xce, 0xeb, 0x92, 0x50, 0x19,
-	0xae, 0xbd, 0xc8, 0x33, 0xca, 0x85, 0x6c, 0x3d, 0x34, 0x0c, 0x6f, 0x71, 0xf5, 0xc7, 0x49, 0x11,
-	0x8e, 0x02, 0x45, 0xd8, 0x4d, 0xe7, 0x2c, 0x4d, 0x21, 0xd6, 0x65, 0x21, 0x62, 0x00, 0x66, 0xb4,
-	0xbf, 0xb3, 0xb0, 0x12, 0x31, 0x8a, 0x0e, 0x42, 0x9c, 0xb6, 0x12, 0x6e, 0x16, 0xc2, 0x74, 0x08,
-	0xcb, 0x8c, 0x0e, 0x04, 0x0e, 0xf1, 0x09, 0xbd, 0x0d, 0xc6, 0x38, 0x44, 0x92, 0x59, 0x62, 0x0f,
-	0x0b, 0xae, 0xfe, 0xe9, 0x77, 0xe6, 0x61, 0x00, 0xca, 0x1b, 0xa9, 0x6c, 0xa4, 0x61, 0x72, 0x9b,
-	0xd0, 0x9c, 0x6b, 0xf0, 0xe4, 0x84, 0xf6, 0x4d, 0x62, 0xf3, 0x48, 0x6f, 0xc6, 0x60, 0xcc, 0xa2,
-	0x32, 0x14, 0x4f, 0xfb, 0x8e, 0x18, 0x79, 0x49, 0xb2, 0x3b, 0xb5, 0x5f, 0x72, 0x90, 0x9f, 0xba,
-	0x25, 0x7a, 0x3f, 0x84, 0xf5, 0xd9, 0x4c, 0x20, 0x21, 0xa0, 0x07, 0xf0, 0xe2, 0xf7, 0x26, 0xb3,
-	0x89, 0xdd, 0x99, 0xb0, 0xdc, 0x98, 0x9d, 0xfa, 0x95, 0x17, 0x69, 0xf8, 0x29, 0xea, 0xcf, 0x3e,
-	0xcf, 0xbd, 0x00, 0xcf, 0xcd, 0x84, 0xf3, 0xd3, 0x90, 0x7c, 0x4f, 0x92, 0x5c, 0x85, 0xd2, 0xa7,
-	0xb6, 0xfb, 0x1d, 0xfb, 0xdc, 0x14, 0x56, 0x17, 0xb3, 0x09, 0xca, 0x18, 0x5e, 0x8a, 0xfa, 0x93,
-	0x02, 0x2f, 0x48, 0x9f, 0x68, 0x3f, 0xe0, 0x69, 0x3b, 0xf1, 0x62, 0x69, 0x5c, 0x6d, 0x49, 0x57,
-	0x4f, 0x61, 0x4d, 0xba, 0x3a, 0xc1, 0x5c, 0x10, 0xdb, 0xfd, 0x92, 0x49, 0x9d, 0x62, 0x46, 0xfb,
-	0x27, 0x0b, 0x2b, 0x91, 0xd7, 0x32, 0xa9, 0xfb, 0x23, 0x09, 0xa1, 0x62, 0x9d, 0x42, 0x71, 0xfc,
-	0x54, 0xc7, 0x3c, 0x10, 0xeb, 0x11, 0xa1, 0xa9, 0xc7, 0xa1, 0x20, 0xa6, 0x97, 0x5c, 0xfd, 0x23,
-	0xdd, 0x9f, 0x60, 0x86, 0x9b, 0xe7, 0xe5, 0x85, 0xd6, 0xfe, 0x53, 0x60, 0x39, 0x70, 0x51, 0xf4,
-	0x41, 0xe8, 0xe3, 0xba, 0x31, 0x87, 0x4a, 0x10, 0xad, 0xfa, 0x9b, 0xcf, 0x64, 0x6e, 0xd3, 0xc4,
-	0x48, 0xa4, 0xe1, 0xd1, 0x4c, 0xe0, 0xb1, 0x0e, 0xab, 0xd1, 0x66, 0x9a, 0xf7, 0x2c, 0x34, 0xfe,
-	0x55, 0xa0, 0x12, 0x3f, 0x46, 0xa1, 0x36, 0x14, 0x82, 0x73, 0x0e, 0x7a, 0x35, 0x78, 0x89, 0xd8,
-	0xf1, 0x48, 0xdd, 0x9c, 0x1f, 0x24, 0x47, 0xa5, 0xcc, 0xdb, 0x0a, 0xea, 0xc1, 0xb2, 0x3c, 0x15,
-	0xbb, 0x16, 0xd0, 0x6e, 0xcc, 0x68, 0x36, 0x6b, 0x54, 0x54, 0xdf, 0x4c, 0x17, 0x3c, 0x39, 0xef,
-	0xf8, 0xe8, 0xeb, 0x83, 0x0e, 0x11, 0xdd, 0xc1, 0x8d, 0x6e, 0xd1, 0x7e, 0x7d, 0x9c, 0xf6, 0x16,
-	0xa1, 0xf5, 0x98, 0xb9, 0xd5, 0xf9, 0xae, 0x13, 0x37, 0x08, 0xdf, 0x2c, 0xba, 0x33, 0xec, 0xbb,
-	0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x49, 0x1d, 0x72, 0x24, 0x34, 0x0b, 0x00, 0x00,
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/grpc/validation/proxy_validation.proto", fileDescriptor_8f4537dae4069b18)
+}
+
+var fileDescriptor_8f4537dae4069b18 = []byte{
+	// 975 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0x49, 0xa0, 0xcf, 0x89, 0xeb, 0x4c, 0x1c, 0xc7, 0xd9, 0x50, 0xea, 0x2e, 0x4d,
+	0x09, 0x94, 0xda, 0x60, 0x90, 0x80, 0x42, 0x52, 0x29, 0x34, 0x22, 0xa0, 0x12, 0xdc, 0x4d, 0x08,
+	0x52, 0x25, 0x64, 0x6d, 0xd6, 0x53, 0x7b, 0xc0, 0xde, 0xd9, 0xce, 0x8c, 0x0d, 0x3e, 0x70, 0xe3,
+	0x8a, 0xf8, 0x07, 0x1c, 0xb9, 0xe5, 0x47, 0x20, 0xc1, 0x7f, 0x40, 0x9c, 0xb8, 0xf1, 0x1f, 0x38,
+	0x21, 0xcf, 0x8e, 0x1d, 0xef, 0xec, 0xda, 0xbb, 0x42, 0x42, 0xea, 0x71, 0xde, 0xbc, 0xf7, 0xcd,
+	0xf7, 0xbe, 0x7d, 0x6f, 0xf6, 0x0d, 0x34, 0x3b, 0x44, 0x74, 0x07, 0x17, 0x35, 0x97, 0xf6, 0xeb,
+	0x9c, 0xf6, 0xe8, 0x3d, 0x42, 0xeb, 0x9d, 0x1e, 0xa5, 0x75, 0x9f, 0xd1, 0xaf, 0xb1, 0x2b, 0x78,
+	0xb0, 0x72, 0x7c, 0x52, 0xef, 0x30, 0xdf, 0xad, 0x0f, 0x9d, 0x1e, 0x69, 0x3b, 0x82, 0x50, 0x6f,
+	0xec, 0xf1, 0xdd, 0xa8, 0x75, 0x65, 0xa8, 0xf9, 0x8c, 0x0a, 0x8a, 0x56, 0xc7, 0x01, 0xb5, 0x31,
+	0x56, 0x8d, 0x50, 0x73, 0x77, 0x0e, 0xd8, 0xf0, 0xad, 0x20, 0x3e, 0x08, 0xb2, 0x3e, 0x85, 0x1b,
+	0xcd, 0xf1, 0xf2, 0x7c, 0x8a, 0x76, 0x8a, 0xd9, 0x90, 0xb8, 0xd8, 0xc6, 0xcf, 0x06, 0x98, 0x0b,
+	0xf4, 0x1a, 0x2c, 0x4b, 0xff, 0x8a, 0x51, 0x35, 0xf6, 0xf2, 0x8d, 0x8d, 0xda, 0xec, 0x29, 0x35,
+	0x19, 0x6b, 0x07, 0x1e, 0xd6, 0x8f, 0x06, 0xbc, 0x3c, 0x0f, 0x8c, 0xfb, 0xd4, 0xe3, 0x18, 0x7d,
+	0x08, 0xab, 0x01, 0x7b, 0x86, 0x7d, 0xca, 0x84, 0x02, 0xdd, 0x8e, 0x03, 0x95, 0x0e, 0x76, 0xde,
+	0xbf, 0x5a, 0xa0, 0x7b, 0x80, 0x04, 0x73, 0x3c, 0xde, 0x93, 0xd8, 0x2d, 0xcc, 0x18, 0x65, 0xbc,
+	0x92, 0xad, 0xe6, 0xf6, 0xae, 0xd9, 0xeb, 0x33, 0x3b, 0x47, 0x72, 0xc3, 0xda, 0x82, 0xcd, 0x13,
+	0x2a, 0xc8, 0xd3, 0xd1, 0xe7, 0x9e, 0x8d, 0xf9, 0xc8, 0x73, 0x55, 0x4e, 0x56, 0x05, 0xca, 0xfa,
+	0x46, 0xc0, 0xcf, 0x3a, 0x87, 0xfc, 0xcc, 0xe9, 0xe8, 0x63, 0x28, 0xf6, 0x08, 0x17, 0xd8, 0xc3,
+	0x4c, 0x31, 0xe6, 0x15, 0xa3, 0x9a, 0xdb, 0xcb, 0x37, 0x5e, 0x0a, 0x53, 0x7e, 0xa4, 0xbc, 0x14,
+	0xeb, 0xeb, 0xbd, 0xd0, 0x9a, 0x5b, 0x97, 0x4b, 0x50, 0x08, 0xfb, 0xa0, 0xfb, 0xb0, 0x32, 0x93,
+	0x40, 0xbe, 0x61, 0x2d, 0x42, 0xac, 0xc9, 0x94, 0x6c, 0x15, 0x81, 0xce, 0xa0, 0xd4, 0x15, 0xc2,
+	0x6f, 0x69, 0xe4, 0x2a, 0x39, 0x29, 0x67, 0x35, 0x8c, 0x74, 0x2c, 0x84, 0x1f, 0x46, 0x3b, 0xce,
+	0xd8, 0xa8, 0x1b, 0xb1, 0xa2, 0xc7, 0xb0, 0x21, 0xdc, 0x28, 0xe8, 0x92, 0x04, 0xbd, 0x19, 0x06,
+	0x3d, 0x73, 0xa3, 0x98, 0xeb, 0x42, 0x37, 0xa2, 0x27, 0x50, 0xee, 0x8e, 0x2e, 0x18, 0x69, 0x47,
+	0x50, 0x97, 0xab, 0x46, 0x34, 0xe9, 0x63, 0xe9, 0x1b, 0x01, 0x2e, 0x75, 0x63, 0xec, 0xe6, 0xaf,
+	0x06, 0x2c, 0x4b, 0x59, 0xd0, 0x07, 0xb0, 0x24, 0x46, 0x3e, 0x96, 0xd5, 0x54, 0x68, 0xbc, 0x9a,
+	0x2c, 0x64, 0xed, 0x6c, 0xe4, 0x63, 0x5b, 0x06, 0xa1, 0x32, 0xac, 0x30, 0xec, 0x70, 0xea, 0x55,
+	0xb2, 0x55, 0x63, 0xef, 0x9a, 0xad, 0x56, 0x96, 0x0b, 0x4b, 0x67, 0xc1, 0x3e, 0x3a, 0x71, 0xfa,
+	0xf8, 0x84, 0x8a, 0x2f, 0x3c, 0xf2, 0x6c, 0x80, 0x25, 0x40, 0x31, 0x83, 0x4c, 0x28, 0x1f, 0x12,
+	0xaf, 0xdd, 0xa4, 0x4c, 0x68, 0x7b, 0x06, 0x42, 0x50, 0x38, 0x3d, 0x7d, 0xf4, 0x11, 0xf5, 0x9e,
+	0x92, 0x4e, 0x60, 0xcb, 0xa2, 0x0d, 0xb8, 0xde, 0x64, 0xd4, 0xc5, 0x9c, 0x13, 0x4f, 0x19, 0x73,
+	0x87, 0x65, 0x28, 0x4d, 0x85, 0x19, 0xb3, 0x51, 0xea, 0x58, 0x03, 0x28, 0xc5, 0x69, 0x81, 0xbe,
+	0x82, 0x4a, 0xdf, 0x11, 0x6e, 0x17, 0xb7, 0x5b, 0x73, 0x0a, 0xf3, 0x95, 0x70, 0xf6, 0x9f, 0x05,
+	0xde, 0x5a, 0x7d, 0x96, 0xfb, 0x71, 0x66, 0x6e, 0xfd, 0x61, 0xc0, 0x66, 0x6c, 0xc4, 0xdc, 0x8a,
+	0x33, 0xfe, 0x8f, 0x8a, 0xcb, 0xfe, 0xf7, 0x8a, 0x0b, 0x29, 0x1a, 0xc0, 0x49, 0x61, 0xad, 0x5f,
+	0xb2, 0x80, 0xa2, 0xbc, 0xd0, 0xc1, 0xb4, 0x0b, 0x03, 0xf9, 0xee, 0x24, 0x65, 0xa2, 0x75, 0xe2,
+	0x63, 0x28, 0x0d, 0x09, 0x13, 0x03, 0xa7, 0xd7, 0xea, 0x52, 0x2e, 0xa6, 0x1f, 0x23, 0xe8, 0x69,
+	0x2d, 0x85, 0xf3, 0xc0, 0xf3, 0x98, 0x72, 0xa1, 0x3e, 0x04, 0x1a, 0xea, 0x26, 0x6e, 0x7e, 0x3f,
+	0x29, 0xeb, 0x07, 0xa1, 0xb2, 0xbe, 0x9b, 0x8e, 0x59, 0x9a, 0xd2, 0xde, 0x51, 0xa5, 0x1d, 0x53,
+	0x92, 0x19, 0xeb, 0xcf, 0x2c, 0xac, 0x47, 0x88, 0xa2, 0x7d, 0x4d, 0xa7, 0xdd, 0x84, 0xcc, 0x34,
+	0x99, 0x0e, 0x60, 0x8d, 0xd1, 0x81, 0xc0, 0x9a, 0x3e, 0xda, 0xc5, 0x6f, 0x8f, 0x5d, 0x94, 0x32,
+	0xab, 0xec, 0x6a, 0xc1, 0xcd, 0xdf, 0xa7, 0xbd, 0x7e, 0x10, 0x12, 0xe5, 0xf5, 0x54, 0x34, 0xd2,
+	0x68, 0xd2, 0x4e, 0x68, 0xf7, 0x6d, 0xd8, 0x7c, 0x48, 0xfb, 0x0e, 0xf1, 0x78, 0xa4, 0xdb, 0x63,
+	0x64, 0xcc, 0xa2, 0x12, 0x14, 0x8f, 0xfa, 0xbe, 0x18, 0x05, 0x41, 0xaa, 0xdf, 0xad, 0x9f, 0x73,
+	0x90, 0x9f, 0xc9, 0x12, 0xbd, 0xab, 0xc9, 0x7a, 0x73, 0xae, 0x20, 0x9a, 0xa0, 0xfb, 0xf0, 0xe2,
+	0xb7, 0x0e, 0xf3, 0x88, 0xd7, 0x99, 0x68, 0x79, 0x6b, 0x7e, 0xe8, 0x97, 0x81, 0xa7, 0x3d, 0x0d,
+	0x31, 0x7f, 0x9a, 0xea, 0xf9, 0x5e, 0x48, 0xcf, 0xdb, 0x09, 0xe7, 0xa7, 0x51, 0xf2, 0x1d, 0xa5,
+	0xe4, 0x16, 0x6c, 0x7c, 0xe2, 0xc9, 0x29, 0x25, 0xb8, 0x52, 0xd8, 0x44, 0xca, 0x18, 0xbd, 0x0c,
+	0xf3, 0x07, 0x03, 0x5e, 0x50, 0x3c, 0xd1, 0xfd, 0x10, 0xa7, 0x3b, 0x89, 0x89, 0xa5, 0x61, 0xb5,
+	0xab, 0x58, 0xdd, 0x80, 0x6d, 0xc5, 0xea, 0x21, 0xe6, 0x82, 0x78, 0x72, 0x60, 0x50, 0x38, 0xc5,
+	0x8c, 0xf5, 0x57, 0x16, 0xd6, 0x23, 0x37, 0x4d, 0x52, 0xf5, 0x47, 0x02, 0xb4, 0x8f, 0x75, 0x04,
+	0xc5, 0xf1, 0x35, 0x17, 0x73, 0x41, 0xec, 0x44, 0x80, 0x66, 0x2e, 0x87, 0x82, 0x98, 0x5d, 0x72,
+	0xf3, 0xb7, 0x74, 0x4d, 0x30, 0x87, 0xcd, 0xf3, 0xf2, 0xcf, 0xb3, 0xfe, 0x31, 0x60, 0x2d, 0x94,
+	0x28, 0x7a, 0x5f, 0x1b, 0x85, 0x6e, 0x2d, 0x50, 0x25, 0x2c, 0xad, 0x79, 0x39, 0xd5, 0x64, 0x61,
+	0xd1, 0xc4, 0x40, 0xa4, 0xd1, 0xa3, 0x99, 0xa0, 0xc7, 0x0e, 0x6c, 0x45, 0x8b, 0x69, 0xd1, 0xb5,
+	0xd0, 0xf8, 0xdb, 0x80, 0x72, 0xfc, 0x8c, 0x8c, 0x5a, 0x50, 0x08, 0x4f, 0xa5, 0x48, 0xfb, 0x97,
+	0xc7, 0x0e, 0xb3, 0xe6, 0xed, 0xc5, 0x4e, 0x6a, 0xb0, 0xcd, 0xbc, 0x69, 0xa0, 0x1e, 0xac, 0xa9,
+	0x53, 0xb1, 0xa4, 0x80, 0xee, 0xc6, 0xcc, 0xdd, 0xf3, 0x1e, 0x02, 0xe6, 0x1b, 0xe9, 0x9c, 0x27,
+	0xe7, 0x1d, 0x3e, 0x78, 0xb2, 0x9f, 0xee, 0x89, 0xe3, 0x7f, 0xd3, 0x89, 0x7b, 0xe6, 0x5c, 0xac,
+	0xc8, 0x17, 0xca, 0xdb, 0xff, 0x0e, 0x00, 0x26, 0xe4, 0xee, 0xd2, 0x2a, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1156,9 +1304,7 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type ProxyValidationServiceClient interface {
-	// Notify the client whenever the Proxy Validation Service resyncs
 	NotifyOnResync(ctx context.Context, in *NotifyOnResyncRequest, opts ...grpc.CallOption) (ProxyValidationService_NotifyOnResyncClient, error)
-	// Submit a proxy for validation
 	ValidateProxy(ctx context.Context, in *ProxyValidationServiceRequest, opts ...grpc.CallOption) (*ProxyValidationServiceResponse, error)
 }
 
@@ -1213,9 +1359,7 @@ func (c *proxyValidationServiceClient) ValidateProxy(ctx context.Context, in *Pr
 
 // ProxyValidationServiceServer is the server API for ProxyValidationService service.
 type ProxyValidationServiceServer interface {
-	// Notify the client whenever the Proxy Validation Service resyncs
 	NotifyOnResync(*NotifyOnResyncRequest, ProxyValidationService_NotifyOnResyncServer) error
-	// Submit a proxy for validation
 	ValidateProxy(context.Context, *ProxyValidationServiceRequest) (*ProxyValidationServiceResponse, error)
 }
 
@@ -1289,5 +1433,5 @@ var _ProxyValidationService_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 		},
 	},
-	Metadata: "projects/gloo/api/grpc/validation/proxy_validation.proto",
+	Metadata: "github.com/solo-io/gloo/projects/gloo/api/grpc/validation/proxy_validation.proto",
 }
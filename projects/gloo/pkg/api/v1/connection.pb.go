@@ -67,6 +67,37 @@ func (ConnectionConfig_HttpProtocolOptions_HeadersWithUnderscoresAction) EnumDes
 	return fileDescriptor_56610fe13cf10c84, []int{0, 1, 0}
 }
 
+type SocketOption_SocketState int32
+
+const (
+	// The option is set after the socket is created, but before it is bound to its port.
+	SocketOption_STATE_PREBIND SocketOption_SocketState = 0
+	// The option is set after the socket is bound to its port, but before listen() is called.
+	SocketOption_STATE_BOUND SocketOption_SocketState = 1
+	// The option is set after listen() is called.
+	SocketOption_STATE_LISTENING SocketOption_SocketState = 2
+)
+
+var SocketOption_SocketState_name = map[int32]string{
+	0: "STATE_PREBIND",
+	1: "STATE_BOUND",
+	2: "STATE_LISTENING",
+}
+
+var SocketOption_SocketState_value = map[string]int32{
+	"STATE_PREBIND":   0,
+	"STATE_BOUND":     1,
+	"STATE_LISTENING": 2,
+}
+
+func (x SocketOption_SocketState) String() string {
+	return proto.EnumName(SocketOption_SocketState_name, int32(x))
+}
+
+func (SocketOption_SocketState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_56610fe13cf10c84, []int{1, 0}
+}
+
 // Fine tune the settings for connections to an upstream
 type ConnectionConfig struct {
 	// Maximum requests for a single upstream connection (unspecified or zero = no limit)
@@ -286,11 +317,129 @@ func (m *ConnectionConfig_HttpProtocolOptions) GetHeadersWithUnderscoresAction()
 	return ConnectionConfig_HttpProtocolOptions_ALLOW
 }
 
+// A socket option to set on the socket of a listener, with setsockopt.
+// The levels and names of the options depend on the operating system of the proxy.
+type SocketOption struct {
+	// An optional name for the option, for debugging.
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// The level passed to setsockopt, such as IPPROTO_TCP.
+	Level int64 `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	// The numeric name of the option, as passed to setsockopt.
+	Name int64 `protobuf:"varint,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//	*SocketOption_IntValue
+	//	*SocketOption_BufValue
+	Value isSocketOption_Value `protobuf_oneof:"value"`
+	// When the option is set.
+	State                SocketOption_SocketState `protobuf:"varint,6,opt,name=state,proto3,enum=gloo.solo.io.SocketOption_SocketState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *SocketOption) Reset()         { *m = SocketOption{} }
+func (m *SocketOption) String() string { return proto.CompactTextString(m) }
+func (*SocketOption) ProtoMessage()    {}
+func (*SocketOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56610fe13cf10c84, []int{1}
+}
+func (m *SocketOption) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SocketOption.Unmarshal(m, b)
+}
+func (m *SocketOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SocketOption.Marshal(b, m, deterministic)
+}
+func (m *SocketOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SocketOption.Merge(m, src)
+}
+func (m *SocketOption) XXX_Size() int {
+	return xxx_messageInfo_SocketOption.Size(m)
+}
+func (m *SocketOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_SocketOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SocketOption proto.InternalMessageInfo
+
+type isSocketOption_Value interface {
+	isSocketOption_Value()
+	Equal(interface{}) bool
+}
+
+type SocketOption_IntValue struct {
+	IntValue int64 `protobuf:"varint,4,opt,name=int_value,json=intValue,proto3,oneof" json:"int_value,omitempty"`
+}
+type SocketOption_BufValue struct {
+	BufValue []byte `protobuf:"bytes,5,opt,name=buf_value,json=bufValue,proto3,oneof" json:"buf_value,omitempty"`
+}
+
+func (*SocketOption_IntValue) isSocketOption_Value() {}
+func (*SocketOption_BufValue) isSocketOption_Value() {}
+
+func (m *SocketOption) GetValue() isSocketOption_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *SocketOption) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SocketOption) GetLevel() int64 {
+	if m != nil {
+		return m.Level
+	}
+	return 0
+}
+
+func (m *SocketOption) GetName() int64 {
+	if m != nil {
+		return m.Name
+	}
+	return 0
+}
+
+func (m *SocketOption) GetIntValue() int64 {
+	if x, ok := m.GetValue().(*SocketOption_IntValue); ok {
+		return x.IntValue
+	}
+	return 0
+}
+
+func (m *SocketOption) GetBufValue() []byte {
+	if x, ok := m.GetValue().(*SocketOption_BufValue); ok {
+		return x.BufValue
+	}
+	return nil
+}
+
+func (m *SocketOption) GetState() SocketOption_SocketState {
+	if m != nil {
+		return m.State
+	}
+	return SocketOption_STATE_PREBIND
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*SocketOption) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*SocketOption_IntValue)(nil),
+		(*SocketOption_BufValue)(nil),
+	}
+}
+
 func init() {
 	proto.RegisterEnum("gloo.solo.io.ConnectionConfig_HttpProtocolOptions_HeadersWithUnderscoresAction", ConnectionConfig_HttpProtocolOptions_HeadersWithUnderscoresAction_name, ConnectionConfig_HttpProtocolOptions_HeadersWithUnderscoresAction_value)
+	proto.RegisterEnum("gloo.solo.io.SocketOption_SocketState", SocketOption_SocketState_name, SocketOption_SocketState_value)
 	proto.RegisterType((*ConnectionConfig)(nil), "gloo.solo.io.ConnectionConfig")
 	proto.RegisterType((*ConnectionConfig_TcpKeepAlive)(nil), "gloo.solo.io.ConnectionConfig.TcpKeepAlive")
 	proto.RegisterType((*ConnectionConfig_HttpProtocolOptions)(nil), "gloo.solo.io.ConnectionConfig.HttpProtocolOptions")
+	proto.RegisterType((*SocketOption)(nil), "gloo.solo.io.SocketOption")
 }
 
 func init() {
@@ -298,47 +447,57 @@ func init() {
 }
 
 var fileDescriptor_56610fe13cf10c84 = []byte{
-	// 642 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x72, 0xd3, 0x30,
-	0x10, 0xc6, 0xfd, 0x61, 0x40, 0xcd, 0x5f, 0x55, 0x0e, 0x69, 0x08, 0x6d, 0xe9, 0xa9, 0xc0, 0x60,
-	0x43, 0x7a, 0x63, 0xa6, 0x87, 0x24, 0x0d, 0x93, 0x42, 0xa7, 0x09, 0x6e, 0x4a, 0x67, 0xb8, 0x68,
-	0x14, 0x77, 0xe3, 0x88, 0xda, 0x96, 0x90, 0xe5, 0x36, 0xbc, 0x08, 0xc3, 0x23, 0xf0, 0x08, 0xdc,
-	0x78, 0x06, 0x9e, 0x00, 0x86, 0x77, 0xe0, 0xce, 0xc8, 0x72, 0x92, 0x42, 0x3b, 0x6d, 0xb8, 0x59,
-	0xbb, 0xfb, 0x7d, 0xfb, 0x7d, 0xda, 0xb5, 0xd0, 0x8e, 0xcf, 0xd4, 0x30, 0xe9, 0xdb, 0x1e, 0x0f,
-	0x9d, 0x98, 0x07, 0xfc, 0x29, 0xe3, 0x8e, 0x1f, 0x70, 0xee, 0x08, 0xc9, 0xdf, 0x83, 0xa7, 0x62,
-	0x73, 0xa2, 0x82, 0x39, 0x67, 0xcf, 0x1d, 0x8f, 0x47, 0x11, 0x78, 0x8a, 0xf1, 0xc8, 0x16, 0x92,
-	0x2b, 0x8e, 0x73, 0x3a, 0x6b, 0x6b, 0xa0, 0xcd, 0x78, 0xe5, 0x9e, 0xcf, 0x7d, 0x9e, 0x26, 0x1c,
-	0xfd, 0x65, 0x6a, 0x2a, 0x6b, 0x3e, 0xe7, 0x7e, 0x00, 0x4e, 0x7a, 0xea, 0x27, 0x03, 0xe7, 0x24,
-	0x91, 0x74, 0xca, 0x71, 0x39, 0x7f, 0x2e, 0xa9, 0x10, 0x20, 0xe3, 0x2c, 0x8f, 0x61, 0xa4, 0x0c,
-	0x29, 0x8c, 0x94, 0x89, 0x6d, 0xfe, 0xbc, 0x83, 0x4a, 0xcd, 0x89, 0x98, 0x26, 0x8f, 0x06, 0xcc,
-	0xc7, 0x3b, 0xe8, 0x7e, 0x48, 0x47, 0x44, 0xc2, 0x87, 0x04, 0x62, 0x15, 0x13, 0x01, 0x92, 0x4c,
-	0x15, 0x97, 0xad, 0x0d, 0x6b, 0x2b, 0xef, 0x96, 0x43, 0x3a, 0x72, 0xb3, 0x8a, 0x2e, 0xc8, 0x29,
-	0x09, 0x6e, 0xa3, 0x62, 0x56, 0x4d, 0x14, 0x0b, 0x81, 0x27, 0xaa, 0x3c, 0xb7, 0x61, 0x6d, 0x2d,
-	0xd5, 0x56, 0x6d, 0xa3, 0xd0, 0x1e, 0x2b, 0xb4, 0x77, 0x33, 0x07, 0x8d, 0x85, 0xcf, 0x3f, 0xd6,
-	0x2d, 0xb7, 0x90, 0xe1, 0x7a, 0x06, 0x86, 0xbb, 0x28, 0xaf, 0x3c, 0x41, 0x4e, 0x01, 0x04, 0x0d,
-	0xd8, 0x19, 0x94, 0xe7, 0x53, 0x9e, 0x27, 0xf6, 0xc5, 0xdb, 0xb2, 0xff, 0xd5, 0x6f, 0xf7, 0x3c,
-	0xf1, 0x1a, 0x40, 0xd4, 0x35, 0xc4, 0xcd, 0x29, 0x73, 0x4a, 0x09, 0xf0, 0x00, 0x3d, 0xfc, 0xdb,
-	0x0d, 0xe9, 0x27, 0x83, 0x01, 0x48, 0x12, 0xb0, 0x90, 0x29, 0xd2, 0xff, 0xa8, 0x20, 0x2e, 0x2f,
-	0xa4, 0x5d, 0xaa, 0x97, 0xd4, 0x1e, 0xed, 0x45, 0x6a, 0xbb, 0xf6, 0x96, 0x06, 0x09, 0xb8, 0x0f,
-	0xc4, 0x45, 0xcf, 0x8d, 0x94, 0x64, 0x5f, 0x73, 0x34, 0x34, 0x05, 0x8e, 0x51, 0xd5, 0xe3, 0x61,
-	0xc8, 0x23, 0x32, 0x54, 0x4a, 0x90, 0x94, 0xc2, 0xe3, 0x01, 0xe1, 0x42, 0x97, 0xc7, 0xe5, 0xc5,
-	0xb4, 0x45, 0xed, 0x06, 0x23, 0x6d, 0xa5, 0x44, 0x37, 0x83, 0x76, 0x0c, 0xd2, 0x5d, 0x35, 0xbc,
-	0x57, 0xa4, 0x2a, 0xdf, 0x2d, 0x94, 0xbb, 0xe8, 0x1d, 0x3f, 0x42, 0xa5, 0xc9, 0xdd, 0x69, 0x0d,
-	0x7d, 0x88, 0xb3, 0xe9, 0x15, 0x27, 0xf1, 0x6e, 0x1a, 0xc6, 0x2f, 0x51, 0x61, 0x5a, 0xaa, 0xc7,
-	0x36, 0xeb, 0xcc, 0xf2, 0x13, 0x98, 0x9e, 0x1a, 0x3e, 0x40, 0x78, 0xca, 0xc3, 0x22, 0x05, 0xf2,
-	0x8c, 0x06, 0xd9, 0xdc, 0x6e, 0xe4, 0x5a, 0x9e, 0x40, 0xf7, 0x32, 0x64, 0xe5, 0xdb, 0x3c, 0x5a,
-	0xb9, 0xc2, 0x2b, 0x6e, 0xa0, 0x1c, 0x3b, 0x09, 0x60, 0xb2, 0x61, 0xd6, 0x6c, 0x1d, 0x96, 0x34,
-	0x68, 0xbc, 0x5e, 0x8f, 0xd1, 0xb2, 0xde, 0xf3, 0x21, 0xd0, 0x13, 0x90, 0x31, 0xf1, 0x78, 0x12,
-	0x99, 0x55, 0xcd, 0xbb, 0xc5, 0x90, 0x8e, 0xda, 0x26, 0xde, 0xd4, 0x61, 0xdc, 0x41, 0x2b, 0xba,
-	0x36, 0x56, 0x12, 0x68, 0x48, 0xc6, 0x7f, 0xde, 0xcc, 0xc6, 0x42, 0x3a, 0x3a, 0x4c, 0xa1, 0xe3,
-	0x04, 0xfe, 0x64, 0xa1, 0xf5, 0x71, 0xe7, 0x73, 0xa6, 0x86, 0x24, 0x89, 0xf4, 0xb7, 0xc7, 0x25,
-	0xc4, 0x84, 0x9a, 0x3f, 0x4d, 0x2f, 0x62, 0xa1, 0xd6, 0xf9, 0xff, 0x2d, 0xb1, 0x33, 0xed, 0xc7,
-	0x4c, 0x0d, 0x8f, 0xa6, 0xbc, 0xf5, 0x14, 0xe6, 0x56, 0x87, 0xd7, 0x64, 0x37, 0x0f, 0x50, 0xf5,
-	0x3a, 0x34, 0xbe, 0x8b, 0x16, 0xeb, 0xfb, 0xfb, 0x9d, 0xe3, 0xd2, 0x2d, 0x8c, 0x51, 0xc1, 0x6d,
-	0xbd, 0x6a, 0x35, 0x7b, 0xc4, 0x6d, 0xbd, 0x39, 0x6a, 0x1d, 0xf6, 0x4a, 0x16, 0x2e, 0xa2, 0xa5,
-	0x5d, 0xb7, 0xd3, 0x25, 0xed, 0x56, 0x7d, 0xb7, 0xe5, 0x96, 0xe6, 0x1a, 0x2f, 0xbe, 0xfe, 0x5e,
-	0xb0, 0xbe, 0xfc, 0x5a, 0xb3, 0xde, 0x3d, 0x9b, 0xed, 0x8d, 0x14, 0xa7, 0x7e, 0xf6, 0x4e, 0xf6,
-	0x6f, 0xa7, 0x17, 0xba, 0xfd, 0x27, 0x00, 0x00, 0xff, 0xff, 0xde, 0x76, 0x3d, 0x3c, 0x5e, 0x05,
+	// 802 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x92, 0xdb, 0x34,
+	0x14, 0x5e, 0x6f, 0x36, 0xa5, 0xab, 0x24, 0x1b, 0xaf, 0xb6, 0x17, 0xee, 0xb2, 0x6d, 0x97, 0xbd,
+	0x60, 0x0a, 0x0c, 0x36, 0xa4, 0x77, 0x0c, 0xbd, 0x88, 0x13, 0x97, 0x04, 0x32, 0x49, 0x50, 0x12,
+	0x3a, 0xc3, 0x8d, 0xc6, 0x76, 0x14, 0x47, 0xac, 0x6d, 0x09, 0x5b, 0x4e, 0xc3, 0x8b, 0x30, 0xbc,
+	0x01, 0x3c, 0x02, 0x77, 0x3c, 0x03, 0x4f, 0x00, 0xc3, 0x3b, 0x70, 0xcf, 0x48, 0x72, 0x7e, 0x4a,
+	0x3b, 0x4b, 0xb8, 0x93, 0xce, 0x77, 0xbe, 0x4f, 0xdf, 0x39, 0x3a, 0xb2, 0xc1, 0xf3, 0x88, 0x8a,
+	0x65, 0x11, 0xd8, 0x21, 0x4b, 0x9c, 0x9c, 0xc5, 0xec, 0x63, 0xca, 0x9c, 0x28, 0x66, 0xcc, 0xe1,
+	0x19, 0xfb, 0x8e, 0x84, 0x22, 0xd7, 0x3b, 0x9f, 0x53, 0x67, 0xf5, 0xa9, 0x13, 0xb2, 0x34, 0x25,
+	0xa1, 0xa0, 0x2c, 0xb5, 0x79, 0xc6, 0x04, 0x83, 0x75, 0x89, 0xda, 0x92, 0x68, 0x53, 0x76, 0xf9,
+	0x20, 0x62, 0x11, 0x53, 0x80, 0x23, 0x57, 0x3a, 0xe7, 0xf2, 0x71, 0xc4, 0x58, 0x14, 0x13, 0x47,
+	0xed, 0x82, 0x62, 0xe1, 0xcc, 0x8b, 0xcc, 0xdf, 0x69, 0xbc, 0x89, 0xbf, 0xca, 0x7c, 0xce, 0x49,
+	0x96, 0x97, 0x38, 0x24, 0x6b, 0xa1, 0x45, 0xc9, 0x5a, 0xe8, 0xd8, 0xcd, 0x9f, 0xf7, 0x81, 0xd9,
+	0xd9, 0x9a, 0xe9, 0xb0, 0x74, 0x41, 0x23, 0xf8, 0x1c, 0xbc, 0x9b, 0xf8, 0x6b, 0x9c, 0x91, 0xef,
+	0x0b, 0x92, 0x8b, 0x1c, 0x73, 0x92, 0xe1, 0x9d, 0x63, 0xcb, 0xb8, 0x36, 0x9e, 0x36, 0x90, 0x95,
+	0xf8, 0x6b, 0x54, 0x66, 0x8c, 0x49, 0xb6, 0x13, 0x81, 0x3d, 0xd0, 0x2c, 0xb3, 0xb1, 0xa0, 0x09,
+	0x61, 0x85, 0xb0, 0x8e, 0xaf, 0x8d, 0xa7, 0xb5, 0xd6, 0x43, 0x5b, 0x3b, 0xb4, 0x37, 0x0e, 0xed,
+	0x6e, 0x59, 0x81, 0x7b, 0xf2, 0xd3, 0x1f, 0x4f, 0x0c, 0x74, 0x56, 0xf2, 0xa6, 0x9a, 0x06, 0xc7,
+	0xa0, 0x21, 0x42, 0x8e, 0x6f, 0x09, 0xe1, 0x7e, 0x4c, 0x57, 0xc4, 0xaa, 0x28, 0x9d, 0x8f, 0xec,
+	0xfd, 0x6e, 0xd9, 0xff, 0xf6, 0x6f, 0x4f, 0x43, 0xfe, 0x15, 0x21, 0xbc, 0x2d, 0x29, 0xa8, 0x2e,
+	0xf4, 0x4e, 0x09, 0xc0, 0x05, 0x78, 0xef, 0xf5, 0x6a, 0x70, 0x50, 0x2c, 0x16, 0x24, 0xc3, 0x31,
+	0x4d, 0xa8, 0xc0, 0xc1, 0x0f, 0x82, 0xe4, 0xd6, 0x89, 0x3a, 0xe5, 0xea, 0x0d, 0xb7, 0xb3, 0x7e,
+	0x2a, 0x9e, 0xb5, 0xbe, 0xf1, 0xe3, 0x82, 0xa0, 0x47, 0x7c, 0xbf, 0x66, 0x57, 0x89, 0x0c, 0xa4,
+	0x86, 0x2b, 0x25, 0x60, 0x0e, 0xae, 0x42, 0x96, 0x24, 0x2c, 0xc5, 0x4b, 0x21, 0x38, 0x56, 0x12,
+	0x21, 0x8b, 0x31, 0xe3, 0x32, 0x3d, 0xb7, 0xaa, 0xea, 0x88, 0xd6, 0x7f, 0x14, 0xd2, 0x13, 0x82,
+	0x8f, 0x4b, 0xea, 0x48, 0x33, 0xd1, 0x43, 0xad, 0xfb, 0x16, 0xe8, 0xf2, 0x77, 0x03, 0xd4, 0xf7,
+	0x6b, 0x87, 0x1f, 0x00, 0x73, 0xdb, 0x3b, 0xe9, 0x21, 0x20, 0x79, 0x79, 0x7b, 0xcd, 0x6d, 0x7c,
+	0xac, 0xc2, 0xf0, 0x05, 0x38, 0xdb, 0xa5, 0xca, 0x6b, 0x3b, 0xf4, 0xce, 0x1a, 0x5b, 0x9a, 0xbc,
+	0x35, 0x38, 0x04, 0x70, 0xa7, 0x43, 0x53, 0x41, 0xb2, 0x95, 0x1f, 0x5b, 0x95, 0xc3, 0xb4, 0xce,
+	0xb7, 0xd4, 0x7e, 0xc9, 0xbc, 0xfc, 0xad, 0x02, 0x2e, 0xde, 0x52, 0x2b, 0x74, 0x41, 0x9d, 0xce,
+	0x63, 0xb2, 0x9d, 0x30, 0xe3, 0xb0, 0x13, 0x6a, 0x92, 0xb4, 0x19, 0xaf, 0x0f, 0xc1, 0xb9, 0x9c,
+	0xf3, 0x25, 0xf1, 0xe7, 0x24, 0xcb, 0x71, 0xc8, 0x8a, 0x54, 0x8f, 0x6a, 0x03, 0x35, 0x13, 0x7f,
+	0xdd, 0xd3, 0xf1, 0x8e, 0x0c, 0xc3, 0x11, 0xb8, 0x90, 0xb9, 0xb9, 0xc8, 0x88, 0x9f, 0xe0, 0xcd,
+	0xcb, 0x3b, 0xb8, 0xb0, 0xc4, 0x5f, 0x4f, 0x14, 0x75, 0x03, 0xc0, 0x1f, 0x0d, 0xf0, 0x64, 0x73,
+	0xf2, 0x2b, 0x2a, 0x96, 0xb8, 0x48, 0xe5, 0x3a, 0x64, 0x19, 0xc9, 0xb1, 0xaf, 0x5f, 0x9a, 0x1c,
+	0xc4, 0xb3, 0xd6, 0xe8, 0xff, 0x4f, 0x89, 0x5d, 0x7a, 0x7f, 0x49, 0xc5, 0x72, 0xb6, 0xd3, 0x6d,
+	0x2b, 0x1a, 0xba, 0x5a, 0xde, 0x81, 0xde, 0x0c, 0xc1, 0xd5, 0x5d, 0x6c, 0x78, 0x0a, 0xaa, 0xed,
+	0xc1, 0x60, 0xf4, 0xd2, 0x3c, 0x82, 0x10, 0x9c, 0x21, 0xef, 0x4b, 0xaf, 0x33, 0xc5, 0xc8, 0xfb,
+	0x7a, 0xe6, 0x4d, 0xa6, 0xa6, 0x01, 0x9b, 0xa0, 0xd6, 0x45, 0xa3, 0x31, 0xee, 0x79, 0xed, 0xae,
+	0x87, 0xcc, 0xe3, 0x9b, 0x9f, 0x8f, 0x41, 0x7d, 0xc2, 0xc2, 0x5b, 0x22, 0xb4, 0x39, 0x78, 0x0d,
+	0x6a, 0x73, 0x92, 0x87, 0x19, 0xe5, 0xdb, 0xcf, 0xc9, 0x29, 0xda, 0x0f, 0xc1, 0x07, 0xa0, 0x1a,
+	0x93, 0x15, 0x89, 0xd5, 0x65, 0x54, 0x90, 0xde, 0x40, 0x08, 0x4e, 0x52, 0x3f, 0xd1, 0x1f, 0x81,
+	0x0a, 0x52, 0x6b, 0xf8, 0x08, 0x9c, 0xd2, 0x54, 0xe0, 0x95, 0x7c, 0x93, 0xaa, 0x5d, 0x95, 0xde,
+	0x11, 0xba, 0x4f, 0x53, 0xa1, 0x5e, 0xa9, 0x84, 0x83, 0x62, 0x51, 0xc2, 0xf2, 0xcd, 0xd5, 0x25,
+	0x1c, 0x14, 0x0b, 0x0d, 0x7f, 0x0e, 0xaa, 0xb9, 0xf0, 0x05, 0xb1, 0xee, 0xa9, 0x46, 0xbf, 0xff,
+	0x7a, 0xa3, 0xf7, 0x4d, 0x97, 0x9b, 0x89, 0xcc, 0x46, 0x9a, 0x74, 0xf3, 0x02, 0xd4, 0xf6, 0xa2,
+	0xf0, 0x1c, 0x34, 0x26, 0xd3, 0xf6, 0xd4, 0xc3, 0x63, 0xe4, 0xb9, 0xfd, 0x61, 0xd7, 0x3c, 0x92,
+	0xbd, 0xd0, 0x21, 0x77, 0x34, 0x1b, 0x76, 0x4d, 0x03, 0x5e, 0x80, 0xa6, 0x0e, 0x0c, 0xfa, 0x93,
+	0xa9, 0x37, 0xec, 0x0f, 0xbf, 0x30, 0x8f, 0xdd, 0x77, 0x40, 0x55, 0x19, 0x74, 0x3f, 0xfb, 0xf5,
+	0xef, 0x13, 0xe3, 0x97, 0xbf, 0x1e, 0x1b, 0xdf, 0x7e, 0x72, 0xd8, 0xdf, 0x84, 0xdf, 0x46, 0xe5,
+	0x1f, 0x25, 0xb8, 0xa7, 0x46, 0xef, 0xd9, 0x3f, 0x03, 0x00, 0x3a, 0xf0, 0x92, 0x80, 0x88, 0x06,
 	0x00, 0x00,
 }
 
@@ -480,3 +639,96 @@ func (this *ConnectionConfig_HttpProtocolOptions) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SocketOption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SocketOption)
+	if !ok {
+		that2, ok := that.(SocketOption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Level != that1.Level {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if that1.Value == nil {
+		if this.Value != nil {
+			return false
+		}
+	} else if this.Value == nil {
+		return false
+	} else if !this.Value.Equal(that1.Value) {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *SocketOption_IntValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SocketOption_IntValue)
+	if !ok {
+		that2, ok := that.(SocketOption_IntValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.IntValue != that1.IntValue {
+		return false
+	}
+	return true
+}
+func (this *SocketOption_BufValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SocketOption_BufValue)
+	if !ok {
+		that2, ok := that.(SocketOption_BufValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.BufValue, that1.BufValue) {
+		return false
+	}
+	return true
+}
@@ -102,6 +102,58 @@ func (m *ConnectionConfig) Hash(hasher hash.Hash64) (uint64, error) {
 	return hasher.Sum64(), nil
 }
 
+// Hash function
+func (m *SocketOption) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.SocketOption")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetDescription())); err != nil {
+		return 0, err
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetLevel())
+	if err != nil {
+		return 0, err
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetName())
+	if err != nil {
+		return 0, err
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetState())
+	if err != nil {
+		return 0, err
+	}
+
+	switch m.Value.(type) {
+
+	case *SocketOption_IntValue:
+
+		err = binary.Write(hasher, binary.LittleEndian, m.GetIntValue())
+		if err != nil {
+			return 0, err
+		}
+
+	case *SocketOption_BufValue:
+
+		if _, err = hasher.Write(m.GetBufValue()); err != nil {
+			return 0, err
+		}
+
+	}
+
+	return hasher.Sum64(), nil
+}
+
 // Hash function
 func (m *ConnectionConfig_TcpKeepAlive) Hash(hasher hash.Hash64) (uint64, error) {
 	if m == nil {
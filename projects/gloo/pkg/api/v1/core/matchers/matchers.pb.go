@@ -10,6 +10,7 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 )
 
@@ -31,6 +32,10 @@ type Matcher struct {
 	//	*Matcher_Exact
 	//	*Matcher_Regex
 	PathSpecifier isMatcher_PathSpecifier `protobuf_oneof:"path_specifier"`
+	// Indicates that prefix/exact path matching should be case sensitive. Defaults to true,
+	// i.e. set it to false to match paths regardless of their case.
+	// It does not apply to regex path matchers, whose patterns can use inline flags instead.
+	CaseSensitive *types.BoolValue `protobuf:"bytes,4,opt,name=case_sensitive,json=caseSensitive,proto3" json:"case_sensitive,omitempty"`
 	// Specifies a set of headers that the route should match on. The router will
 	// check the request’s headers against all the specified headers in the route
 	// config. A match will happen if all the headers in the route are present in
@@ -121,6 +126,13 @@ func (m *Matcher) GetRegex() string {
 	return ""
 }
 
+func (m *Matcher) GetCaseSensitive() *types.BoolValue {
+	if m != nil {
+		return m.CaseSensitive
+	}
+	return nil
+}
+
 func (m *Matcher) GetHeaders() []*HeaderMatcher {
 	if m != nil {
 		return m.Headers
@@ -301,31 +313,34 @@ func init() {
 }
 
 var fileDescriptor_9c5a9085c760cef4 = []byte{
-	// 371 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0x4d, 0x4f, 0x02, 0x31,
-	0x10, 0x75, 0xf9, 0x5a, 0x28, 0x7e, 0x90, 0x06, 0x4d, 0xc3, 0xc1, 0x20, 0x27, 0x3c, 0xb8, 0x1b,
-	0xf4, 0xee, 0x01, 0x2f, 0x78, 0x30, 0xd1, 0xbd, 0x98, 0x18, 0x13, 0x52, 0x96, 0x61, 0xb7, 0xca,
-	0xd2, 0xd2, 0x2d, 0x64, 0xfd, 0x3b, 0x9e, 0xfc, 0x09, 0xfe, 0x1e, 0xff, 0x83, 0x77, 0xd3, 0x96,
-	0xc5, 0x90, 0xa0, 0x31, 0xf1, 0x36, 0xef, 0x75, 0xde, 0xeb, 0xcc, 0xcb, 0xa0, 0xeb, 0x88, 0xa9,
-	0x78, 0x31, 0xf2, 0x42, 0x9e, 0xf8, 0x29, 0x9f, 0xf2, 0x33, 0xc6, 0xfd, 0x68, 0xca, 0xb9, 0x2f,
-	0x24, 0x7f, 0x82, 0x50, 0xa5, 0x16, 0x51, 0xc1, 0xfc, 0x65, 0xcf, 0x0f, 0xb9, 0x04, 0x3f, 0xa1,
-	0x2a, 0x8c, 0x41, 0xa6, 0xeb, 0xc2, 0x13, 0x92, 0x2b, 0x8e, 0x5b, 0x6b, 0xac, 0xdb, 0x3c, 0xad,
-	0xf3, 0xb4, 0xa5, 0xc7, 0x78, 0xab, 0x19, 0xf1, 0x88, 0x9b, 0x36, 0x5f, 0x57, 0x56, 0xd1, 0xc2,
-	0x90, 0x29, 0x4b, 0x42, 0xa6, 0x2c, 0xd7, 0x79, 0x2d, 0x20, 0xf7, 0xc6, 0x1a, 0x61, 0x82, 0x2a,
-	0x42, 0xc2, 0x84, 0x65, 0xc4, 0x69, 0x3b, 0xdd, 0xda, 0x60, 0x27, 0x58, 0x61, 0x7c, 0x84, 0xca,
-	0x90, 0xd1, 0x50, 0x91, 0xc2, 0xea, 0xc1, 0x42, 0xcd, 0x4b, 0x88, 0x20, 0x23, 0xc5, 0x9c, 0x37,
-	0x10, 0x5f, 0x21, 0x37, 0x06, 0x3a, 0x06, 0x99, 0x92, 0x4a, 0xbb, 0xd8, 0xad, 0x9f, 0x9f, 0x7a,
-	0x3f, 0x4f, 0xeb, 0x0d, 0x4c, 0xeb, 0x6a, 0x8a, 0x20, 0x57, 0xe2, 0x47, 0xd4, 0x98, 0x2f, 0x40,
-	0xbe, 0x0c, 0x05, 0x95, 0x34, 0x01, 0xa5, 0xdd, 0x5c, 0xe3, 0xd6, 0xfb, 0xcd, 0xed, 0x4e, 0x6b,
-	0x6e, 0x73, 0x49, 0xee, 0x7a, 0x30, 0xdf, 0xa0, 0x53, 0x4c, 0x90, 0x9b, 0x80, 0x8a, 0xf9, 0x38,
-	0x25, 0xd5, 0x76, 0xb1, 0x5b, 0x0b, 0x72, 0xd8, 0x6f, 0xa0, 0x7d, 0x41, 0x55, 0x3c, 0x4c, 0x05,
-	0x84, 0x6c, 0xc2, 0x40, 0x76, 0x24, 0xda, 0xdb, 0x98, 0x11, 0x63, 0x54, 0x9a, 0xd1, 0x04, 0x6c,
-	0x4e, 0x81, 0xa9, 0x71, 0x13, 0x95, 0x97, 0x74, 0xba, 0x00, 0x9b, 0x51, 0x60, 0x81, 0x66, 0xbf,
-	0x13, 0xaa, 0xe6, 0xf9, 0x9c, 0xa0, 0x5d, 0x36, 0x5b, 0x82, 0x54, 0x43, 0xb3, 0x08, 0x29, 0x99,
-	0xc7, 0xba, 0xe5, 0xcc, 0x27, 0x9d, 0x7b, 0x74, 0xb8, 0x75, 0x93, 0xff, 0xfe, 0xdd, 0x1f, 0xbc,
-	0x7f, 0x96, 0x9c, 0xb7, 0x8f, 0x63, 0xe7, 0xe1, 0xf2, 0x6f, 0xc7, 0x28, 0x9e, 0xa3, 0xad, 0x07,
-	0x39, 0xaa, 0x98, 0x13, 0xba, 0xf8, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x19, 0xdf, 0xd1, 0xd5,
-	0x02, 0x00, 0x00,
+	// 426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0xc1, 0x6e, 0x13, 0x31,
+	0x10, 0x65, 0x9b, 0x34, 0x49, 0x1d, 0x5a, 0x2a, 0xab, 0xa0, 0x55, 0x0e, 0x55, 0xc8, 0x29, 0x1c,
+	0xb0, 0xd5, 0x72, 0x47, 0x22, 0x5c, 0xc2, 0x01, 0x09, 0x16, 0x09, 0x24, 0x84, 0x14, 0x39, 0xdb,
+	0x89, 0xd7, 0xb0, 0x9b, 0x71, 0x6d, 0x27, 0x2c, 0x7f, 0xc4, 0x27, 0xf0, 0x3d, 0x48, 0x7c, 0x02,
+	0x77, 0x64, 0x3b, 0x0e, 0xaa, 0x54, 0x10, 0x12, 0xb7, 0x79, 0xcf, 0x7e, 0x6f, 0x66, 0x9e, 0x86,
+	0xbc, 0x90, 0xca, 0x55, 0x9b, 0x25, 0x2b, 0xb1, 0xe1, 0x16, 0x6b, 0x7c, 0xac, 0x90, 0xcb, 0x1a,
+	0x91, 0x6b, 0x83, 0x1f, 0xa1, 0x74, 0x36, 0x22, 0xa1, 0x15, 0xdf, 0x5e, 0xf0, 0x12, 0x0d, 0xf0,
+	0x46, 0xb8, 0xb2, 0x02, 0x63, 0xf7, 0x05, 0xd3, 0x06, 0x1d, 0xd2, 0xd1, 0x1e, 0xfb, 0x6f, 0xcc,
+	0xeb, 0x98, 0xb7, 0x64, 0x0a, 0x47, 0xe7, 0x12, 0x51, 0xd6, 0xc0, 0xc3, 0xcf, 0xe5, 0x66, 0xc5,
+	0x3f, 0x1b, 0xa1, 0xf5, 0x5e, 0x3b, 0x3a, 0x93, 0x28, 0x31, 0x94, 0xdc, 0x57, 0x3b, 0x96, 0x42,
+	0xeb, 0x22, 0x09, 0xad, 0x8b, 0xdc, 0xe4, 0xc7, 0x01, 0xe9, 0xbf, 0x8c, 0x8d, 0x68, 0x4e, 0x7a,
+	0xda, 0xc0, 0x4a, 0xb5, 0x79, 0x36, 0xce, 0xa6, 0x47, 0xf3, 0x3b, 0xc5, 0x0e, 0xd3, 0x07, 0xe4,
+	0x10, 0x5a, 0x51, 0xba, 0xfc, 0x60, 0xf7, 0x10, 0xa1, 0xe7, 0x0d, 0x48, 0x68, 0xf3, 0x4e, 0xe2,
+	0x03, 0xa4, 0xcf, 0xc8, 0x49, 0x29, 0x2c, 0x2c, 0x2c, 0xac, 0xad, 0x72, 0x6a, 0x0b, 0x79, 0x77,
+	0x9c, 0x4d, 0x87, 0x97, 0x23, 0x16, 0x07, 0x67, 0x69, 0x70, 0x36, 0x43, 0xac, 0xdf, 0x8a, 0x7a,
+	0x03, 0xc5, 0xb1, 0x57, 0xbc, 0x49, 0x02, 0xfa, 0x9c, 0xf4, 0x2b, 0x10, 0x57, 0x60, 0x6c, 0xde,
+	0x1b, 0x77, 0xa6, 0xc3, 0xcb, 0x47, 0xec, 0xcf, 0x81, 0xb0, 0x79, 0xf8, 0xba, 0x5b, 0xa4, 0x48,
+	0x4a, 0xfa, 0x81, 0x9c, 0x5e, 0x6f, 0xc0, 0x7c, 0x59, 0x68, 0x61, 0x44, 0x03, 0xce, 0xbb, 0xf5,
+	0x83, 0xdb, 0xc5, 0xdf, 0xdc, 0x5e, 0x7b, 0xcd, 0xab, 0x24, 0x49, 0xae, 0xf7, 0xae, 0x6f, 0xd0,
+	0x96, 0xe6, 0xa4, 0xdf, 0x80, 0xab, 0xf0, 0xca, 0xe6, 0x83, 0x71, 0x67, 0x7a, 0x54, 0x24, 0x38,
+	0x3b, 0x25, 0x27, 0x5a, 0xb8, 0x6a, 0x61, 0x35, 0x94, 0x6a, 0xa5, 0xc0, 0x4c, 0x0c, 0x39, 0xbe,
+	0x31, 0x23, 0xa5, 0xa4, 0xbb, 0x16, 0x0d, 0xc4, 0xa8, 0x8b, 0x50, 0xd3, 0x33, 0x72, 0xb8, 0xf5,
+	0x59, 0xc4, 0x98, 0x8b, 0x08, 0x3c, 0xfb, 0x3b, 0xe4, 0x41, 0x8a, 0xf8, 0x21, 0xb9, 0xab, 0xd6,
+	0x5b, 0x30, 0x6e, 0x11, 0x16, 0x09, 0x01, 0x0f, 0x8a, 0x61, 0xe4, 0x42, 0x93, 0xc9, 0x3b, 0x72,
+	0xff, 0xd6, 0x4d, 0xfe, 0xb7, 0xf7, 0x6c, 0xfe, 0xed, 0x67, 0x37, 0xfb, 0xfa, 0xfd, 0x3c, 0x7b,
+	0xff, 0xf4, 0xdf, 0xee, 0x5d, 0x7f, 0x92, 0xb7, 0xde, 0xfc, 0xb2, 0x17, 0x0e, 0xe1, 0xc9, 0xaf,
+	0x01, 0x00, 0xb3, 0xc1, 0xb7, 0x4a, 0x38, 0x03, 0x00, 0x00,
 }
 
 func (this *Matcher) Equal(that interface{}) bool {
@@ -356,6 +371,9 @@ func (this *Matcher) Equal(that interface{}) bool {
 	} else if !this.PathSpecifier.Equal(that1.PathSpecifier) {
 		return false
 	}
+	if !this.CaseSensitive.Equal(that1.CaseSensitive) {
+		return false
+	}
 	if len(this.Headers) != len(that1.Headers) {
 		return false
 	}
@@ -25,9 +25,7 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-//
-//
-//Endpoints represent dynamically discovered address/ports where an upstream service is listening
+// Endpoints represent dynamically discovered address/ports where an upstream service is listening
 type Endpoint struct {
 	// List of the upstreams the endpoint belongs to
 	Upstreams []*core.ResourceRef `protobuf:"bytes,1,rep,name=upstreams,proto3" json:"upstreams,omitempty"`
@@ -40,10 +38,12 @@ type Endpoint struct {
 	// configuration for health checking the endpoint.
 	HealthCheck *HealthCheckConfig `protobuf:"bytes,5,opt,name=health_check,json=healthCheck,proto3" json:"health_check,omitempty"`
 	// Metadata contains the object metadata for this resource
-	Metadata             core.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Metadata core.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata"`
+	// The locality of the endpoint, if known. Envoy groups the endpoints of an upstream by their locality.
+	Locality             *Locality `protobuf:"bytes,8,opt,name=locality,proto3" json:"locality,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *Endpoint) Reset()         { *m = Endpoint{} }
@@ -112,6 +112,13 @@ func (m *Endpoint) GetMetadata() core.Metadata {
 	return core.Metadata{}
 }
 
+func (m *Endpoint) GetLocality() *Locality {
+	if m != nil {
+		return m.Locality
+	}
+	return nil
+}
+
 type HealthCheckConfig struct {
 	// hostname to use for the endpoint health checks if provided.
 	Hostname             string   `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
@@ -161,31 +168,32 @@ func init() {
 }
 
 var fileDescriptor_f7969f9617648787 = []byte{
-	// 373 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbd, 0xce, 0xd3, 0x30,
-	0x14, 0xc5, 0xf9, 0x0c, 0x5f, 0xeb, 0x94, 0x01, 0x0b, 0xa1, 0x34, 0x12, 0x6d, 0xd4, 0x29, 0x0b,
-	0x0e, 0x94, 0x01, 0x54, 0xb6, 0x56, 0x48, 0x2c, 0x2c, 0x19, 0x59, 0x90, 0x9b, 0xdc, 0x26, 0xa1,
-	0x4d, 0xae, 0x65, 0xbb, 0xa8, 0x73, 0x9f, 0x06, 0xf1, 0x04, 0x3c, 0x02, 0x4f, 0xc1, 0xc0, 0x1b,
-	0x74, 0x60, 0x47, 0xf9, 0x6b, 0x29, 0x08, 0xe9, 0xdb, 0xee, 0xb9, 0xe7, 0x1c, 0xdf, 0x3f, 0xb3,
-	0x37, 0x59, 0x61, 0xf3, 0xfd, 0x5a, 0x24, 0x58, 0x46, 0x06, 0x77, 0xf8, 0xac, 0xc0, 0x28, 0xdb,
-	0x21, 0x46, 0x4a, 0xe3, 0x27, 0x48, 0xac, 0x69, 0x91, 0x54, 0x45, 0xf4, 0xf9, 0x45, 0x04, 0x55,
-	0xaa, 0xb0, 0xa8, 0xac, 0x50, 0x1a, 0x2d, 0xf2, 0x51, 0xcd, 0x89, 0xda, 0x26, 0x0a, 0xf4, 0x1f,
-	0x67, 0x98, 0x61, 0x43, 0x44, 0x75, 0xd4, 0x6a, 0x7c, 0x0e, 0x07, 0xdb, 0x26, 0xe1, 0xd0, 0xf9,
-	0xfc, 0x49, 0x53, 0x69, 0x5b, 0xd8, 0xfe, 0xdd, 0x12, 0xac, 0x4c, 0xa5, 0x95, 0x1d, 0x3f, 0xfe,
-	0x9b, 0xd7, 0xb0, 0xf9, 0x9f, 0xb5, 0xc7, 0x2d, 0x3f, 0xfb, 0xea, 0xb0, 0xc1, 0xdb, 0xae, 0x4b,
-	0xfe, 0x8a, 0x0d, 0xf7, 0xca, 0x58, 0x0d, 0xb2, 0x34, 0x1e, 0x09, 0x6e, 0x42, 0x77, 0x3e, 0x16,
-	0x09, 0x6a, 0xe8, 0x7b, 0x16, 0x31, 0x18, 0xdc, 0xeb, 0x04, 0x62, 0xd8, 0xc4, 0x17, 0x2d, 0xf7,
-	0xd8, 0xad, 0x4c, 0x53, 0x0d, 0xc6, 0x78, 0x4e, 0x40, 0xc2, 0x61, 0xdc, 0x43, 0xce, 0x19, 0x55,
-	0xa8, 0xad, 0x77, 0x13, 0x90, 0xf0, 0x61, 0xdc, 0xc4, 0xdc, 0x67, 0x83, 0x1c, 0x8d, 0xad, 0x64,
-	0x09, 0x1e, 0x6d, 0xe4, 0x67, 0xcc, 0x97, 0x6c, 0x94, 0x83, 0xdc, 0xd9, 0xfc, 0x63, 0x92, 0x43,
-	0xb2, 0xf5, 0xee, 0x07, 0x24, 0x74, 0xe7, 0x53, 0xf1, 0xe7, 0xe6, 0xc4, 0xbb, 0x46, 0xb1, 0xaa,
-	0x05, 0x2b, 0xac, 0x36, 0x45, 0x16, 0xbb, 0xf9, 0x25, 0xc5, 0x5f, 0xb3, 0x41, 0xbf, 0x20, 0xef,
-	0xb6, 0xf1, 0x3f, 0xb9, 0x9e, 0xe2, 0x7d, 0xc7, 0x2e, 0xe9, 0xf7, 0x1f, 0xd3, 0x7b, 0xf1, 0x59,
-	0xbd, 0x78, 0x7a, 0x3c, 0x51, 0xca, 0x1c, 0x50, 0xc7, 0x13, 0x75, 0xf9, 0xb0, 0x3f, 0x9f, 0x39,
-	0x9e, 0xa8, 0x13, 0x92, 0x59, 0xc4, 0x1e, 0xfd, 0x53, 0xfa, 0x6a, 0x1a, 0x72, 0x3d, 0xcd, 0x72,
-	0xf1, 0xed, 0x17, 0x25, 0x5f, 0x7e, 0x4e, 0xc8, 0x87, 0xe7, 0x77, 0xfb, 0x37, 0x6a, 0x9b, 0x75,
-	0x87, 0x5a, 0x3f, 0x68, 0x0e, 0xf4, 0xf2, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x94, 0x49, 0xb7,
-	0xa1, 0x72, 0x02, 0x00, 0x00,
+	// 396 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xb1, 0x6e, 0xd4, 0x30,
+	0x1c, 0xc6, 0xf1, 0x35, 0xd0, 0x9c, 0xaf, 0x0c, 0xb5, 0x00, 0xb9, 0x37, 0xb4, 0xa7, 0x4e, 0x59,
+	0x70, 0xe0, 0x18, 0x40, 0x65, 0xbb, 0x0a, 0x89, 0x01, 0x16, 0x8f, 0x2c, 0xc8, 0xcd, 0xfd, 0x93,
+	0x98, 0x4b, 0xf2, 0xb7, 0x6c, 0x5f, 0x55, 0x56, 0x9e, 0x86, 0x47, 0xe0, 0x11, 0x78, 0x02, 0x46,
+	0x06, 0xde, 0x80, 0x81, 0x1d, 0x25, 0x71, 0xae, 0x04, 0x74, 0x12, 0x9b, 0x3f, 0x7f, 0xbf, 0xcf,
+	0xf6, 0x67, 0x9b, 0xbe, 0x2c, 0xb4, 0x2f, 0xb7, 0x57, 0x22, 0xc3, 0x3a, 0x75, 0x58, 0xe1, 0x63,
+	0x8d, 0x69, 0x51, 0x21, 0xa6, 0xc6, 0xe2, 0x07, 0xc8, 0xbc, 0xeb, 0x95, 0x32, 0x3a, 0xbd, 0x7e,
+	0x9a, 0x42, 0xb3, 0x36, 0xa8, 0x1b, 0x2f, 0x8c, 0x45, 0x8f, 0xec, 0xa8, 0xf5, 0x44, 0x1b, 0x13,
+	0x1a, 0xe7, 0x0f, 0x0a, 0x2c, 0xb0, 0x33, 0xd2, 0x76, 0xd4, 0x33, 0x73, 0x06, 0x37, 0xbe, 0x9f,
+	0x84, 0x9b, 0x90, 0x9b, 0x9f, 0x76, 0x3b, 0x6d, 0xb4, 0x1f, 0xd6, 0xad, 0xc1, 0xab, 0xb5, 0xf2,
+	0x2a, 0xf8, 0x27, 0x7f, 0xfb, 0x16, 0xf2, 0x7d, 0xd1, 0x41, 0x07, 0x3f, 0xd9, 0x7f, 0xf8, 0x5c,
+	0xe9, 0x0a, 0xaf, 0xc1, 0xf6, 0xe4, 0xf9, 0xb7, 0x09, 0x8d, 0x5f, 0x85, 0x3e, 0xec, 0x39, 0x9d,
+	0x6e, 0x8d, 0xf3, 0x16, 0x54, 0xed, 0x38, 0x59, 0x1c, 0x24, 0xb3, 0xe5, 0x89, 0xc8, 0xd0, 0xc2,
+	0xd0, 0x4e, 0x48, 0x70, 0xb8, 0xb5, 0x19, 0x48, 0xc8, 0xe5, 0x2d, 0xcb, 0x38, 0x3d, 0x54, 0xeb,
+	0xb5, 0x05, 0xe7, 0xf8, 0x64, 0x41, 0x92, 0xa9, 0x1c, 0x24, 0x63, 0x34, 0x32, 0x68, 0x3d, 0x3f,
+	0x58, 0x90, 0xe4, 0xbe, 0xec, 0xc6, 0x6c, 0x4e, 0xe3, 0x12, 0x9d, 0x6f, 0x54, 0x0d, 0x3c, 0xea,
+	0xf0, 0x9d, 0x66, 0x2b, 0x7a, 0x54, 0x82, 0xaa, 0x7c, 0xf9, 0x3e, 0x2b, 0x21, 0xdb, 0xf0, 0xbb,
+	0x0b, 0x92, 0xcc, 0x96, 0x67, 0xe2, 0xcf, 0x3b, 0x16, 0xaf, 0x3b, 0xe2, 0xb2, 0x05, 0x2e, 0xb1,
+	0xc9, 0x75, 0x21, 0x67, 0xe5, 0xed, 0x14, 0x7b, 0x41, 0xe3, 0xe1, 0x2a, 0xf9, 0x61, 0x97, 0x7f,
+	0x34, 0x6e, 0xf1, 0x36, 0xb8, 0xab, 0xe8, 0xeb, 0xf7, 0xb3, 0x3b, 0x72, 0x47, 0xb3, 0x25, 0x8d,
+	0x2b, 0xcc, 0x54, 0xa5, 0xfd, 0x47, 0x1e, 0x87, 0xe4, 0x68, 0xe7, 0x37, 0xc1, 0x95, 0x3b, 0xee,
+	0xe2, 0xe1, 0xa7, 0x9f, 0xd1, 0x31, 0x9d, 0x80, 0x61, 0xd3, 0xe1, 0x63, 0xb8, 0x84, 0x9c, 0xa7,
+	0xf4, 0xf8, 0x9f, 0x63, 0x8e, 0x9a, 0x93, 0x71, 0xf3, 0xd5, 0xc5, 0x97, 0x5f, 0x11, 0xf9, 0xfc,
+	0xe3, 0x94, 0xbc, 0x7b, 0xf2, 0x7f, 0xbf, 0xd1, 0x6c, 0x8a, 0xf0, 0xa8, 0x57, 0xf7, 0xba, 0xc7,
+	0x7c, 0xf6, 0x7b, 0x00, 0xfd, 0xae, 0x0b, 0xb2, 0xc8, 0x02, 0x00, 0x00,
 }
 
 func (this *Endpoint) Equal(that interface{}) bool {
@@ -230,6 +238,9 @@ func (this *Endpoint) Equal(that interface{}) bool {
 	if !this.Metadata.Equal(&that1.Metadata) {
 		return false
 	}
+	if !this.Locality.Equal(that1.Locality) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -26,6 +26,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type Config_EnableFor int32
+
+const (
+	// Mask the response body.
+	Config_RESPONSE_BODY Config_EnableFor = 0
+	// Mask the headers and dynamic metadata before they are written to the access logs.
+	// The response sent to the client is not changed.
+	Config_ACCESS_LOGS Config_EnableFor = 1
+	// Mask both the response body and the access logs.
+	Config_ALL Config_EnableFor = 2
+)
+
+var Config_EnableFor_name = map[int32]string{
+	0: "RESPONSE_BODY",
+	1: "ACCESS_LOGS",
+	2: "ALL",
+}
+
+var Config_EnableFor_value = map[string]int32{
+	"RESPONSE_BODY": 0,
+	"ACCESS_LOGS":   1,
+	"ALL":           2,
+}
+
+func (x Config_EnableFor) String() string {
+	return proto.EnumName(Config_EnableFor_name, int32(x))
+}
+
+func (Config_EnableFor) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_08adca4fdc7c089d, []int{2, 0}
+}
+
 //
 //The following pre-made action types map to the following regex matchers:
 //
@@ -160,10 +192,12 @@ type DlpRule struct {
 	Matcher *matchers.Matcher `protobuf:"bytes,1,opt,name=matcher,proto3" json:"matcher,omitempty"`
 	// List of data loss prevention actions to be applied.
 	// These actions will be applied in order, one at a time.
-	Actions              []*Action `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Actions []*Action `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
+	// Where the actions are applied (default RESPONSE_BODY).
+	EnabledFor           Config_EnableFor `protobuf:"varint,3,opt,name=enabled_for,json=enabledFor,proto3,enum=dlp.options.gloo.solo.io.Config_EnableFor" json:"enabled_for,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *DlpRule) Reset()         { *m = DlpRule{} }
@@ -204,6 +238,13 @@ func (m *DlpRule) GetActions() []*Action {
 	return nil
 }
 
+func (m *DlpRule) GetEnabledFor() Config_EnableFor {
+	if m != nil {
+		return m.EnabledFor
+	}
+	return Config_RESPONSE_BODY
+}
+
 //
 //Route/Vhost level config for dlp filter
 //
@@ -212,10 +253,12 @@ func (m *DlpRule) GetActions() []*Action {
 type Config struct {
 	// List of data loss prevention actions to be applied.
 	// These actions will be applied in order, one at a time.
-	Actions              []*Action `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Actions []*Action `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	// Where the actions are applied (default RESPONSE_BODY).
+	EnabledFor           Config_EnableFor `protobuf:"varint,2,opt,name=enabled_for,json=enabledFor,proto3,enum=dlp.options.gloo.solo.io.Config_EnableFor" json:"enabled_for,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *Config) Reset()         { *m = Config{} }
@@ -249,6 +292,13 @@ func (m *Config) GetActions() []*Action {
 	return nil
 }
 
+func (m *Config) GetEnabledFor() Config_EnableFor {
+	if m != nil {
+		return m.EnabledFor
+	}
+	return Config_RESPONSE_BODY
+}
+
 //
 //A single action meant to mask sensitive data.
 //The action type represents a set of pre configured actions,
@@ -369,7 +419,16 @@ type CustomAction struct {
 	// The percent of the string which will be masked by the mask_char
 	// default value: 75%
 	// rounds ratio (percent/100) by std::round http://www.cplusplus.com/reference/cmath/round/
-	Percent              *_type.Percent `protobuf:"bytes,4,opt,name=percent,proto3" json:"percent,omitempty"`
+	Percent *_type.Percent `protobuf:"bytes,4,opt,name=percent,proto3" json:"percent,omitempty"`
+	// Regexes which only mask one capture group of their matches, applied after the `regex` list.
+	// For instance, the regex action
+	// ``` yaml
+	// regexActions:
+	//   - regex: '"ssn":"([0-9-]+)"'
+	//     subgroup: 1
+	// ```
+	// masks the social security number, but not the name of the json field.
+	RegexActions         []*RegexAction `protobuf:"bytes,5,rep,name=regex_actions,json=regexActions,proto3" json:"regex_actions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -427,13 +486,71 @@ func (m *CustomAction) GetPercent() *_type.Percent {
 	return nil
 }
 
+func (m *CustomAction) GetRegexActions() []*RegexAction {
+	if m != nil {
+		return m.RegexActions
+	}
+	return nil
+}
+
+// A regex whose matches are masked.
+type RegexAction struct {
+	// The regex to match for masking.
+	Regex string `protobuf:"bytes,1,opt,name=regex,proto3" json:"regex,omitempty"`
+	// If provided and not 0, only this capture group of the regex will be masked.
+	Subgroup             uint32   `protobuf:"varint,2,opt,name=subgroup,proto3" json:"subgroup,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegexAction) Reset()         { *m = RegexAction{} }
+func (m *RegexAction) String() string { return proto.CompactTextString(m) }
+func (*RegexAction) ProtoMessage()    {}
+func (*RegexAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08adca4fdc7c089d, []int{5}
+}
+func (m *RegexAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegexAction.Unmarshal(m, b)
+}
+func (m *RegexAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegexAction.Marshal(b, m, deterministic)
+}
+func (m *RegexAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegexAction.Merge(m, src)
+}
+func (m *RegexAction) XXX_Size() int {
+	return xxx_messageInfo_RegexAction.Size(m)
+}
+func (m *RegexAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegexAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegexAction proto.InternalMessageInfo
+
+func (m *RegexAction) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *RegexAction) GetSubgroup() uint32 {
+	if m != nil {
+		return m.Subgroup
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("dlp.options.gloo.solo.io.Config_EnableFor", Config_EnableFor_name, Config_EnableFor_value)
 	proto.RegisterEnum("dlp.options.gloo.solo.io.Action_ActionType", Action_ActionType_name, Action_ActionType_value)
 	proto.RegisterType((*FilterConfig)(nil), "dlp.options.gloo.solo.io.FilterConfig")
 	proto.RegisterType((*DlpRule)(nil), "dlp.options.gloo.solo.io.DlpRule")
 	proto.RegisterType((*Config)(nil), "dlp.options.gloo.solo.io.Config")
 	proto.RegisterType((*Action)(nil), "dlp.options.gloo.solo.io.Action")
 	proto.RegisterType((*CustomAction)(nil), "dlp.options.gloo.solo.io.CustomAction")
+	proto.RegisterType((*RegexAction)(nil), "dlp.options.gloo.solo.io.RegexAction")
 }
 
 func init() {
@@ -441,44 +558,52 @@ func init() {
 }
 
 var fileDescriptor_08adca4fdc7c089d = []byte{
-	// 585 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xfe, 0x39, 0x49, 0xfd, 0x67, 0x92, 0x5f, 0xb1, 0x96, 0x08, 0x59, 0x45, 0x42, 0x21, 0x48,
-	0xa8, 0x12, 0xaa, 0x2d, 0x8a, 0xc4, 0x01, 0x09, 0x24, 0xd7, 0x36, 0x28, 0x34, 0x69, 0x61, 0x9d,
-	0x56, 0x88, 0x8b, 0xe5, 0x3a, 0x8b, 0x63, 0xea, 0x78, 0x57, 0x6b, 0xa7, 0xb4, 0x77, 0x5e, 0x83,
-	0x3b, 0x8f, 0xc0, 0xbb, 0x70, 0xe3, 0x1d, 0xb8, 0xa3, 0x5d, 0xbb, 0x21, 0x1c, 0xc2, 0x9f, 0x83,
-	0xe5, 0x6f, 0xbe, 0x99, 0xf9, 0x76, 0x66, 0x76, 0x07, 0x26, 0x69, 0x56, 0xcd, 0x97, 0x67, 0x76,
-	0x42, 0x17, 0x4e, 0x49, 0x73, 0xba, 0x97, 0x51, 0x27, 0xcd, 0x29, 0x75, 0x18, 0xa7, 0xef, 0x49,
-	0x52, 0x95, 0xb5, 0x15, 0xb3, 0xcc, 0xb9, 0x78, 0xe8, 0x90, 0xa2, 0x22, 0x9c, 0xf1, 0xac, 0x24,
-	0x0e, 0x65, 0x55, 0x46, 0x8b, 0xd2, 0x99, 0xe5, 0x4c, 0x7c, 0x36, 0xe3, 0xb4, 0xa2, 0xc8, 0x12,
-	0xb0, 0x71, 0xd9, 0x22, 0xd3, 0x16, 0xa2, 0x76, 0x46, 0x77, 0x1e, 0x6f, 0x56, 0x4d, 0x28, 0x27,
-	0xce, 0x22, 0xae, 0x92, 0x39, 0xe1, 0xe5, 0x0a, 0xd4, 0x8a, 0x3b, 0x16, 0x29, 0x2e, 0xe8, 0x95,
-	0x53, 0x5d, 0x31, 0xe2, 0x30, 0xc2, 0x13, 0x52, 0x54, 0x8d, 0xa7, 0x9f, 0xd2, 0x94, 0x4a, 0xe8,
-	0x08, 0xd4, 0xb0, 0x88, 0x5c, 0x56, 0x35, 0x49, 0x2e, 0x9b, 0xc8, 0xe1, 0x11, 0xf4, 0x9e, 0x67,
-	0x79, 0x45, 0xb8, 0x47, 0x8b, 0x77, 0x59, 0x8a, 0x9e, 0x81, 0x31, 0xcb, 0x59, 0xc4, 0x97, 0x39,
-	0x29, 0x2d, 0x65, 0xd0, 0xde, 0xed, 0xee, 0xdf, 0xb5, 0x37, 0x55, 0x6e, 0xfb, 0x39, 0xc3, 0xcb,
-	0x9c, 0x60, 0x7d, 0x56, 0x83, 0x72, 0xf8, 0x51, 0x01, 0xad, 0x61, 0xd1, 0x53, 0xd0, 0x9a, 0x8a,
-	0x2d, 0x65, 0xa0, 0xec, 0x76, 0xf7, 0xef, 0xd9, 0xab, 0x0e, 0x44, 0x63, 0xbf, 0x6a, 0x4d, 0x6a,
-	0x17, 0xbe, 0xce, 0x41, 0x4f, 0x40, 0x8b, 0x13, 0x79, 0xa8, 0xd5, 0x92, 0x85, 0x0c, 0x36, 0x17,
-	0xe2, 0xca, 0x40, 0x7c, 0x9d, 0x30, 0xf4, 0x41, 0x6d, 0x1a, 0x5a, 0x53, 0x51, 0xfe, 0x55, 0xe5,
-	0x6b, 0x0b, 0xd4, 0x9a, 0x43, 0x63, 0xe8, 0xd6, 0x6c, 0x24, 0xc6, 0x2d, 0xfb, 0xd9, 0xde, 0x7f,
-	0xf0, 0x27, 0xa9, 0xe6, 0x37, 0xbd, 0x62, 0x04, 0x43, 0xbc, 0xc2, 0xe8, 0x10, 0xfe, 0x4f, 0x96,
-	0x65, 0x45, 0x17, 0x51, 0x4d, 0x5a, 0x2d, 0x39, 0x9f, 0xfb, 0x9b, 0xf5, 0x3c, 0x19, 0xde, 0x14,
-	0xd8, 0x4b, 0xd6, 0x2c, 0x74, 0x0b, 0xd4, 0x72, 0x1e, 0xcf, 0xe8, 0x07, 0xab, 0x3d, 0x50, 0x76,
-	0x75, 0xdc, 0x58, 0xc3, 0x4f, 0x0a, 0xc0, 0xcf, 0xf3, 0x11, 0x80, 0xea, 0x9d, 0x84, 0xd3, 0xe3,
-	0x89, 0xf9, 0x1f, 0xd2, 0xa0, 0x1d, 0x86, 0x47, 0xa6, 0x82, 0xb6, 0x01, 0x26, 0x6e, 0x38, 0x0d,
-	0xb0, 0xe7, 0x62, 0xdf, 0x6c, 0x21, 0x1d, 0x3a, 0xa7, 0xa3, 0xd0, 0x35, 0xdb, 0x02, 0xb9, 0x93,
-	0xe0, 0x8d, 0xd9, 0x41, 0x3d, 0xd0, 0xfd, 0x51, 0xe8, 0x1d, 0x9f, 0x06, 0xd8, 0xdc, 0x12, 0xa9,
-	0x2f, 0xbd, 0x03, 0x53, 0x45, 0x37, 0xa0, 0xeb, 0x8f, 0x8e, 0x02, 0x1c, 0x46, 0xde, 0xf8, 0xe4,
-	0xc0, 0xd4, 0x90, 0x05, 0x7d, 0x0f, 0x07, 0xfe, 0x68, 0x1a, 0x09, 0xb1, 0x68, 0x8a, 0x5d, 0xef,
-	0x30, 0xc0, 0xa1, 0xa9, 0xa3, 0x3e, 0x98, 0xee, 0x78, 0x1c, 0xad, 0x79, 0x43, 0xd3, 0x10, 0x4f,
-	0xa5, 0xb7, 0xde, 0x16, 0x42, 0xd0, 0x29, 0xe2, 0x45, 0x3d, 0x5c, 0x03, 0x4b, 0x8c, 0xfa, 0xb0,
-	0xc5, 0x49, 0x4a, 0x2e, 0xe5, 0x13, 0x30, 0x70, 0x6d, 0xa0, 0xdb, 0x60, 0x2c, 0xe2, 0xf2, 0x3c,
-	0x4a, 0xe6, 0x31, 0x97, 0x5d, 0x1b, 0x58, 0x17, 0x84, 0x37, 0x8f, 0x39, 0xda, 0x03, 0xad, 0xd9,
-	0x06, 0xab, 0x23, 0xc7, 0x7a, 0xd3, 0x96, 0x8b, 0x62, 0x8b, 0x9b, 0xb3, 0x5f, 0xd5, 0x2e, 0x7c,
-	0x1d, 0x73, 0xf0, 0xfa, 0xcb, 0xf7, 0x8e, 0xf2, 0xf9, 0xdb, 0x1d, 0xe5, 0xed, 0x8b, 0xbf, 0x5b,
-	0x78, 0x76, 0x9e, 0xfe, 0x7e, 0xe9, 0xcf, 0x54, 0xb9, 0x5b, 0x8f, 0x7e, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0xb0, 0x2d, 0x92, 0x5d, 0x42, 0x04, 0x00, 0x00,
+	// 710 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0xae, 0x93, 0x34, 0x97, 0x93, 0xa4, 0xbf, 0xff, 0x21, 0x42, 0x56, 0x91, 0x50, 0x08, 0x02,
+	0x55, 0xa0, 0xda, 0x22, 0x48, 0x08, 0x21, 0x01, 0x72, 0x1c, 0xb7, 0x4a, 0x9b, 0x4b, 0x19, 0xa7,
+	0x15, 0xb0, 0xb1, 0x1c, 0x67, 0xea, 0x98, 0x3a, 0x19, 0x6b, 0xec, 0x94, 0xf6, 0x61, 0xd8, 0xf3,
+	0x08, 0xbc, 0x03, 0x1b, 0x58, 0xb3, 0xe3, 0x1d, 0xd8, 0xa3, 0x19, 0x3b, 0x69, 0x58, 0xa4, 0x20,
+	0x75, 0x61, 0xf9, 0xdc, 0xe7, 0xfb, 0xce, 0x39, 0x33, 0xd0, 0xf3, 0xfc, 0x78, 0x32, 0x1f, 0xa9,
+	0x2e, 0x9d, 0x6a, 0x11, 0x0d, 0xe8, 0xae, 0x4f, 0x35, 0x2f, 0xa0, 0x54, 0x0b, 0x19, 0xfd, 0x40,
+	0xdc, 0x38, 0x4a, 0x34, 0x27, 0xf4, 0xb5, 0xf3, 0x27, 0x1a, 0x99, 0xc5, 0x84, 0x85, 0xcc, 0x8f,
+	0x88, 0x46, 0xc3, 0xd8, 0xa7, 0xb3, 0x48, 0x1b, 0x07, 0x21, 0xff, 0xd4, 0x90, 0xd1, 0x98, 0x22,
+	0x85, 0x8b, 0xa9, 0x4b, 0xe5, 0x99, 0x2a, 0x2f, 0xaa, 0xfa, 0x74, 0xfb, 0xd9, 0xfa, 0xaa, 0x2e,
+	0x65, 0x44, 0x9b, 0x3a, 0xb1, 0x3b, 0x21, 0x2c, 0x5a, 0x0a, 0x49, 0xc5, 0x6d, 0x85, 0xcc, 0xce,
+	0xe9, 0xa5, 0x16, 0x5f, 0x86, 0x44, 0x0b, 0x09, 0x73, 0xc9, 0x2c, 0x4e, 0x3d, 0x35, 0x8f, 0x7a,
+	0x54, 0x88, 0x1a, 0x97, 0x52, 0x2b, 0x22, 0x17, 0x71, 0x62, 0x24, 0x17, 0x69, 0x64, 0xa3, 0x0f,
+	0x95, 0x3d, 0x3f, 0x88, 0x09, 0x33, 0xe8, 0xec, 0xd4, 0xf7, 0xd0, 0x2b, 0x28, 0x8d, 0x83, 0xd0,
+	0x66, 0xf3, 0x80, 0x44, 0x8a, 0x54, 0xcf, 0xee, 0x94, 0x9b, 0xf7, 0xd4, 0x75, 0xc8, 0xd5, 0x76,
+	0x10, 0xe2, 0x79, 0x40, 0x70, 0x71, 0x9c, 0x08, 0x51, 0xe3, 0xbb, 0x04, 0x85, 0xd4, 0x8a, 0x5e,
+	0x42, 0x21, 0x45, 0xac, 0x48, 0x75, 0x69, 0xa7, 0xdc, 0xbc, 0xaf, 0x2e, 0x19, 0x70, 0x62, 0x7f,
+	0xd6, 0xea, 0x25, 0x2e, 0xbc, 0xc8, 0x41, 0x2f, 0xa0, 0xe0, 0xb8, 0xe2, 0x50, 0x25, 0x23, 0x80,
+	0xd4, 0xd7, 0x03, 0xd1, 0x45, 0x20, 0x5e, 0x24, 0xa0, 0x43, 0x28, 0x93, 0x99, 0x33, 0x0a, 0xc8,
+	0xd8, 0x3e, 0xa5, 0x4c, 0xc9, 0xd6, 0xa5, 0x9d, 0xad, 0xe6, 0xa3, 0xf5, 0xf9, 0x09, 0x7b, 0xd5,
+	0x14, 0x39, 0x7b, 0x94, 0x61, 0x48, 0xd3, 0xf7, 0x28, 0x6b, 0x7c, 0x95, 0x20, 0x9f, 0xb6, 0x67,
+	0x05, 0x93, 0x74, 0x43, 0x4c, 0x99, 0x1b, 0x61, 0x7a, 0x0e, 0xa5, 0xa5, 0x03, 0xfd, 0x0f, 0x55,
+	0x6c, 0x5a, 0x47, 0x83, 0xbe, 0x65, 0xda, 0xad, 0x41, 0xfb, 0x9d, 0xbc, 0x81, 0xfe, 0x83, 0xb2,
+	0x6e, 0x18, 0xa6, 0x65, 0xd9, 0xdd, 0xc1, 0xbe, 0x25, 0x4b, 0xa8, 0x00, 0x59, 0xbd, 0xdb, 0x95,
+	0x33, 0x8d, 0x1f, 0x19, 0xc8, 0x27, 0xd0, 0x50, 0x17, 0xca, 0x09, 0x38, 0x9b, 0xef, 0x90, 0x18,
+	0xd2, 0x56, 0xf3, 0xf1, 0xdf, 0x18, 0xa5, 0xbf, 0xe1, 0x65, 0x48, 0x30, 0x38, 0x4b, 0x19, 0x1d,
+	0x42, 0xd5, 0x9d, 0x47, 0x31, 0x9d, 0xda, 0x89, 0x51, 0x30, 0x2c, 0x37, 0x1f, 0x5e, 0xc3, 0x50,
+	0x84, 0xa7, 0x7d, 0xaa, 0xb8, 0x2b, 0x1a, 0xba, 0x0d, 0xf9, 0x68, 0xe2, 0x8c, 0xe9, 0x47, 0x31,
+	0xbb, 0x22, 0x4e, 0xb5, 0xc6, 0x27, 0x09, 0xe0, 0xea, 0x7c, 0x04, 0x90, 0x37, 0x8e, 0xad, 0xe1,
+	0xa0, 0x27, 0x6f, 0x70, 0x86, 0x96, 0xd5, 0x97, 0x25, 0xb4, 0x05, 0xd0, 0xd3, 0xad, 0xa1, 0x89,
+	0x0d, 0x1d, 0xb7, 0xe5, 0x0c, 0x2a, 0x42, 0xee, 0xa4, 0x63, 0xe9, 0x72, 0x96, 0x4b, 0x7a, 0xcf,
+	0x7c, 0x2b, 0xe7, 0x50, 0x05, 0x8a, 0xed, 0x8e, 0x65, 0x0c, 0x4e, 0x4c, 0x2c, 0x6f, 0xf2, 0xd4,
+	0x03, 0xa3, 0x25, 0xe7, 0x79, 0xdb, 0xda, 0x9d, 0xbe, 0x89, 0x2d, 0xdb, 0xe8, 0x1e, 0xb7, 0xe4,
+	0x02, 0x52, 0xa0, 0x66, 0x60, 0xb3, 0xdd, 0x19, 0xda, 0xbc, 0x98, 0x3d, 0xc4, 0xba, 0x71, 0x68,
+	0x62, 0x4b, 0x2e, 0xa2, 0x1a, 0xc8, 0x7a, 0xb7, 0x6b, 0xaf, 0x78, 0x2d, 0xb9, 0xd4, 0xf8, 0x26,
+	0x41, 0x65, 0x95, 0x16, 0x42, 0x90, 0x9b, 0x39, 0xd3, 0xa4, 0xb9, 0x25, 0x2c, 0x64, 0x54, 0x83,
+	0x4d, 0x46, 0x3c, 0x72, 0x21, 0xf6, 0xba, 0x84, 0x13, 0x05, 0xdd, 0x81, 0xd2, 0xd4, 0x89, 0xce,
+	0x6c, 0x77, 0xe2, 0x24, 0x1b, 0x5b, 0xc2, 0x45, 0x6e, 0x30, 0x26, 0x0e, 0x43, 0xbb, 0x50, 0x48,
+	0xaf, 0xb8, 0x92, 0x13, 0x6d, 0xbd, 0xa5, 0x8a, 0xdb, 0xaf, 0xf2, 0xc9, 0xa9, 0x47, 0x89, 0x0b,
+	0x2f, 0x62, 0xd0, 0x01, 0x54, 0x45, 0x51, 0x7b, 0xb1, 0xad, 0x9b, 0x62, 0x5b, 0x1f, 0xac, 0x9f,
+	0x05, 0xe6, 0xe1, 0x8b, 0x51, 0xb0, 0x2b, 0x25, 0x6a, 0xbc, 0x86, 0xf2, 0x8a, 0xf3, 0x0a, 0x7c,
+	0xc2, 0x28, 0x05, 0xbf, 0x0d, 0xc5, 0x68, 0x3e, 0xf2, 0x18, 0x9d, 0x87, 0x62, 0xee, 0x55, 0xbc,
+	0xd4, 0x5b, 0x6f, 0xbe, 0xfc, 0xca, 0x49, 0x9f, 0x7f, 0xde, 0x95, 0xde, 0xef, 0xff, 0xdb, 0x93,
+	0x1a, 0x9e, 0x79, 0xd7, 0x3f, 0xab, 0xa3, 0xbc, 0x78, 0xbd, 0x9e, 0xfe, 0x1e, 0x00, 0x73, 0xb9,
+	0x65, 0x73, 0xa4, 0x05, 0x00, 0x00,
 }
 
 func (this *FilterConfig) Equal(that interface{}) bool {
@@ -543,6 +668,9 @@ func (this *DlpRule) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.EnabledFor != that1.EnabledFor {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -575,6 +703,9 @@ func (this *Config) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.EnabledFor != that1.EnabledFor {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -649,6 +780,44 @@ func (this *CustomAction) Equal(that interface{}) bool {
 	if !this.Percent.Equal(that1.Percent) {
 		return false
 	}
+	if len(this.RegexActions) != len(that1.RegexActions) {
+		return false
+	}
+	for i := range this.RegexActions {
+		if !this.RegexActions[i].Equal(that1.RegexActions[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *RegexAction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RegexAction)
+	if !ok {
+		that2, ok := that.(RegexAction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Regex != that1.Regex {
+		return false
+	}
+	if this.Subgroup != that1.Subgroup {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
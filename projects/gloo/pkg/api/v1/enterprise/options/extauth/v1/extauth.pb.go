@@ -125,6 +125,7 @@ type AuthConfig_Config struct {
 	//	*AuthConfig_Config_PluginAuth
 	//	*AuthConfig_Config_OpaAuth
 	//	*AuthConfig_Config_Ldap
+	//	*AuthConfig_Config_PassThroughAuth
 	AuthConfig           isAuthConfig_Config_AuthConfig `protobuf_oneof:"auth_config"`
 	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
 	XXX_unrecognized     []byte                         `json:"-"`
@@ -181,14 +182,18 @@ type AuthConfig_Config_OpaAuth struct {
 type AuthConfig_Config_Ldap struct {
 	Ldap *Ldap `protobuf:"bytes,7,opt,name=ldap,proto3,oneof" json:"ldap,omitempty"`
 }
+type AuthConfig_Config_PassThroughAuth struct {
+	PassThroughAuth *PassThroughAuth `protobuf:"bytes,10,opt,name=pass_through_auth,json=passThroughAuth,proto3,oneof" json:"pass_through_auth,omitempty"`
+}
 
-func (*AuthConfig_Config_BasicAuth) isAuthConfig_Config_AuthConfig()  {}
-func (*AuthConfig_Config_Oauth) isAuthConfig_Config_AuthConfig()      {}
-func (*AuthConfig_Config_Oauth2) isAuthConfig_Config_AuthConfig()     {}
-func (*AuthConfig_Config_ApiKeyAuth) isAuthConfig_Config_AuthConfig() {}
-func (*AuthConfig_Config_PluginAuth) isAuthConfig_Config_AuthConfig() {}
-func (*AuthConfig_Config_OpaAuth) isAuthConfig_Config_AuthConfig()    {}
-func (*AuthConfig_Config_Ldap) isAuthConfig_Config_AuthConfig()       {}
+func (*AuthConfig_Config_BasicAuth) isAuthConfig_Config_AuthConfig()       {}
+func (*AuthConfig_Config_Oauth) isAuthConfig_Config_AuthConfig()           {}
+func (*AuthConfig_Config_Oauth2) isAuthConfig_Config_AuthConfig()          {}
+func (*AuthConfig_Config_ApiKeyAuth) isAuthConfig_Config_AuthConfig()      {}
+func (*AuthConfig_Config_PluginAuth) isAuthConfig_Config_AuthConfig()      {}
+func (*AuthConfig_Config_OpaAuth) isAuthConfig_Config_AuthConfig()         {}
+func (*AuthConfig_Config_Ldap) isAuthConfig_Config_AuthConfig()            {}
+func (*AuthConfig_Config_PassThroughAuth) isAuthConfig_Config_AuthConfig() {}
 
 func (m *AuthConfig_Config) GetAuthConfig() isAuthConfig_Config_AuthConfig {
 	if m != nil {
@@ -254,6 +259,13 @@ func (m *AuthConfig_Config) GetLdap() *Ldap {
 	return nil
 }
 
+func (m *AuthConfig_Config) GetPassThroughAuth() *PassThroughAuth {
+	if x, ok := m.GetAuthConfig().(*AuthConfig_Config_PassThroughAuth); ok {
+		return x.PassThroughAuth
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*AuthConfig_Config) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -264,6 +276,7 @@ func (*AuthConfig_Config) XXX_OneofWrappers() []interface{} {
 		(*AuthConfig_Config_PluginAuth)(nil),
 		(*AuthConfig_Config_OpaAuth)(nil),
 		(*AuthConfig_Config_Ldap)(nil),
+		(*AuthConfig_Config_PassThroughAuth)(nil),
 	}
 }
 
@@ -1138,10 +1151,15 @@ type OidcAuthorizationCode struct {
 	// needs to not be used by the application
 	CallbackPath string `protobuf:"bytes,6,opt,name=callback_path,json=callbackPath,proto3" json:"callback_path,omitempty"`
 	// Scopes to request in addition to openid scope.
-	Scopes               []string `protobuf:"bytes,7,rep,name=scopes,proto3" json:"scopes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Scopes []string `protobuf:"bytes,7,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// a path relative to app url that will be used for logging out from an OIDC session.
+	// needs to not be used by the application. If not provided, logout functionality will be disabled.
+	LogoutPath string `protobuf:"bytes,9,opt,name=logout_path,json=logoutPath,proto3" json:"logout_path,omitempty"`
+	// Configuration related to the user session. Defaults to storing the tokens in the session cookie.
+	Session              *UserSession `protobuf:"bytes,10,opt,name=session,proto3" json:"session,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *OidcAuthorizationCode) Reset()         { *m = OidcAuthorizationCode{} }
@@ -1217,6 +1235,332 @@ func (m *OidcAuthorizationCode) GetScopes() []string {
 	return nil
 }
 
+func (m *OidcAuthorizationCode) GetLogoutPath() string {
+	if m != nil {
+		return m.LogoutPath
+	}
+	return ""
+}
+
+func (m *OidcAuthorizationCode) GetSession() *UserSession {
+	if m != nil {
+		return m.Session
+	}
+	return nil
+}
+
+// Determines how the tokens received at the end of the OIDC flow are kept between requests.
+type UserSession struct {
+	// Whether to fail the request when the session cannot be retrieved from the store. If false (the default), a new
+	// OIDC flow is started, which creates a new session.
+	FailOnFetchFailure bool `protobuf:"varint,1,opt,name=fail_on_fetch_failure,json=failOnFetchFailure,proto3" json:"fail_on_fetch_failure,omitempty"`
+	// Options of the cookies that are set on the response.
+	CookieOptions *UserSession_CookieOptions `protobuf:"bytes,2,opt,name=cookie_options,json=cookieOptions,proto3" json:"cookie_options,omitempty"`
+	// Types that are valid to be assigned to Session:
+	//	*UserSession_Cookie
+	//	*UserSession_Redis
+	Session              isUserSession_Session `protobuf_oneof:"session"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UserSession) Reset()         { *m = UserSession{} }
+func (m *UserSession) String() string { return proto.CompactTextString(m) }
+func (*UserSession) ProtoMessage()    {}
+func (*UserSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_043e68ecbb4b7f5e, []int{11}
+}
+func (m *UserSession) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserSession.Unmarshal(m, b)
+}
+func (m *UserSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserSession.Marshal(b, m, deterministic)
+}
+func (m *UserSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserSession.Merge(m, src)
+}
+func (m *UserSession) XXX_Size() int {
+	return xxx_messageInfo_UserSession.Size(m)
+}
+func (m *UserSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserSession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserSession proto.InternalMessageInfo
+
+type isUserSession_Session interface {
+	isUserSession_Session()
+	Equal(interface{}) bool
+}
+
+type UserSession_Cookie struct {
+	Cookie *UserSession_InternalSession `protobuf:"bytes,3,opt,name=cookie,proto3,oneof" json:"cookie,omitempty"`
+}
+type UserSession_Redis struct {
+	Redis *UserSession_RedisSession `protobuf:"bytes,4,opt,name=redis,proto3,oneof" json:"redis,omitempty"`
+}
+
+func (*UserSession_Cookie) isUserSession_Session() {}
+func (*UserSession_Redis) isUserSession_Session()  {}
+
+func (m *UserSession) GetSession() isUserSession_Session {
+	if m != nil {
+		return m.Session
+	}
+	return nil
+}
+
+func (m *UserSession) GetFailOnFetchFailure() bool {
+	if m != nil {
+		return m.FailOnFetchFailure
+	}
+	return false
+}
+
+func (m *UserSession) GetCookieOptions() *UserSession_CookieOptions {
+	if m != nil {
+		return m.CookieOptions
+	}
+	return nil
+}
+
+func (m *UserSession) GetCookie() *UserSession_InternalSession {
+	if x, ok := m.GetSession().(*UserSession_Cookie); ok {
+		return x.Cookie
+	}
+	return nil
+}
+
+func (m *UserSession) GetRedis() *UserSession_RedisSession {
+	if x, ok := m.GetSession().(*UserSession_Redis); ok {
+		return x.Redis
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*UserSession) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*UserSession_Cookie)(nil),
+		(*UserSession_Redis)(nil),
+	}
+}
+
+// Stores the tokens in the session cookie itself. No server side state is needed.
+type UserSession_InternalSession struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserSession_InternalSession) Reset()         { *m = UserSession_InternalSession{} }
+func (m *UserSession_InternalSession) String() string { return proto.CompactTextString(m) }
+func (*UserSession_InternalSession) ProtoMessage()    {}
+func (*UserSession_InternalSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_043e68ecbb4b7f5e, []int{11, 0}
+}
+func (m *UserSession_InternalSession) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserSession_InternalSession.Unmarshal(m, b)
+}
+func (m *UserSession_InternalSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserSession_InternalSession.Marshal(b, m, deterministic)
+}
+func (m *UserSession_InternalSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserSession_InternalSession.Merge(m, src)
+}
+func (m *UserSession_InternalSession) XXX_Size() int {
+	return xxx_messageInfo_UserSession_InternalSession.Size(m)
+}
+func (m *UserSession_InternalSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserSession_InternalSession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserSession_InternalSession proto.InternalMessageInfo
+
+// Stores the tokens in Redis and keeps only a random session id in the cookie.
+type UserSession_RedisSession struct {
+	// Options to connect to Redis.
+	Options *RedisOptions `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	// Prefix of the keys under which the sessions are stored in Redis.
+	KeyPrefix string `protobuf:"bytes,2,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+	// Name of the cookie that holds the session id. Defaults to `__session`.
+	CookieName string `protobuf:"bytes,3,opt,name=cookie_name,json=cookieName,proto3" json:"cookie_name,omitempty"`
+	// Whether expired ID tokens are refreshed with the refresh token received during the OIDC flow, without
+	// redirecting the user to the identity provider again. Defaults to true.
+	AllowRefreshing      *types.BoolValue `protobuf:"bytes,4,opt,name=allow_refreshing,json=allowRefreshing,proto3" json:"allow_refreshing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *UserSession_RedisSession) Reset()         { *m = UserSession_RedisSession{} }
+func (m *UserSession_RedisSession) String() string { return proto.CompactTextString(m) }
+func (*UserSession_RedisSession) ProtoMessage()    {}
+func (*UserSession_RedisSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_043e68ecbb4b7f5e, []int{11, 1}
+}
+func (m *UserSession_RedisSession) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserSession_RedisSession.Unmarshal(m, b)
+}
+func (m *UserSession_RedisSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserSession_RedisSession.Marshal(b, m, deterministic)
+}
+func (m *UserSession_RedisSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserSession_RedisSession.Merge(m, src)
+}
+func (m *UserSession_RedisSession) XXX_Size() int {
+	return xxx_messageInfo_UserSession_RedisSession.Size(m)
+}
+func (m *UserSession_RedisSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserSession_RedisSession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserSession_RedisSession proto.InternalMessageInfo
+
+func (m *UserSession_RedisSession) GetOptions() *RedisOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *UserSession_RedisSession) GetKeyPrefix() string {
+	if m != nil {
+		return m.KeyPrefix
+	}
+	return ""
+}
+
+func (m *UserSession_RedisSession) GetCookieName() string {
+	if m != nil {
+		return m.CookieName
+	}
+	return ""
+}
+
+func (m *UserSession_RedisSession) GetAllowRefreshing() *types.BoolValue {
+	if m != nil {
+		return m.AllowRefreshing
+	}
+	return nil
+}
+
+type UserSession_CookieOptions struct {
+	// Max age of the cookie, in seconds. Defaults to 30 days (2592000 seconds). Set explicitly to 0 for a cookie
+	// that expires with the browser session.
+	MaxAge *types.UInt32Value `protobuf:"bytes,1,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	// Use a non-secure cookie. This should only be used for testing and in trusted environments.
+	NotSecure bool `protobuf:"varint,2,opt,name=not_secure,json=notSecure,proto3" json:"not_secure,omitempty"`
+	// Path of the cookie. Defaults to "/". Set explicitly to "" to avoid setting a path.
+	Path                 *types.StringValue `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *UserSession_CookieOptions) Reset()         { *m = UserSession_CookieOptions{} }
+func (m *UserSession_CookieOptions) String() string { return proto.CompactTextString(m) }
+func (*UserSession_CookieOptions) ProtoMessage()    {}
+func (*UserSession_CookieOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_043e68ecbb4b7f5e, []int{11, 2}
+}
+func (m *UserSession_CookieOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserSession_CookieOptions.Unmarshal(m, b)
+}
+func (m *UserSession_CookieOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserSession_CookieOptions.Marshal(b, m, deterministic)
+}
+func (m *UserSession_CookieOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserSession_CookieOptions.Merge(m, src)
+}
+func (m *UserSession_CookieOptions) XXX_Size() int {
+	return xxx_messageInfo_UserSession_CookieOptions.Size(m)
+}
+func (m *UserSession_CookieOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserSession_CookieOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserSession_CookieOptions proto.InternalMessageInfo
+
+func (m *UserSession_CookieOptions) GetMaxAge() *types.UInt32Value {
+	if m != nil {
+		return m.MaxAge
+	}
+	return nil
+}
+
+func (m *UserSession_CookieOptions) GetNotSecure() bool {
+	if m != nil {
+		return m.NotSecure
+	}
+	return false
+}
+
+func (m *UserSession_CookieOptions) GetPath() *types.StringValue {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+type RedisOptions struct {
+	// Address of the Redis server. Can be in the form ADDRESS:PORT or `unix:///path/to/unix.sock`.
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// Database to use. Defaults to 0.
+	Db int32 `protobuf:"varint,2,opt,name=db,proto3" json:"db,omitempty"`
+	// Size of the connection pool. Defaults to 10 connections per CPU.
+	PoolSize             int32    `protobuf:"varint,3,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RedisOptions) Reset()         { *m = RedisOptions{} }
+func (m *RedisOptions) String() string { return proto.CompactTextString(m) }
+func (*RedisOptions) ProtoMessage()    {}
+func (*RedisOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_043e68ecbb4b7f5e, []int{12}
+}
+func (m *RedisOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RedisOptions.Unmarshal(m, b)
+}
+func (m *RedisOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RedisOptions.Marshal(b, m, deterministic)
+}
+func (m *RedisOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedisOptions.Merge(m, src)
+}
+func (m *RedisOptions) XXX_Size() int {
+	return xxx_messageInfo_RedisOptions.Size(m)
+}
+func (m *RedisOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedisOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedisOptions proto.InternalMessageInfo
+
+func (m *RedisOptions) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *RedisOptions) GetDb() int32 {
+	if m != nil {
+		return m.Db
+	}
+	return 0
+}
+
+func (m *RedisOptions) GetPoolSize() int32 {
+	if m != nil {
+		return m.PoolSize
+	}
+	return 0
+}
+
 type AccessTokenValidation struct {
 	// Types that are valid to be assigned to ValidationType:
 	//	*AccessTokenValidation_IntrospectionUrl
@@ -1239,7 +1583,7 @@ func (m *AccessTokenValidation) Reset()         { *m = AccessTokenValidation{} }
 func (m *AccessTokenValidation) String() string { return proto.CompactTextString(m) }
 func (*AccessTokenValidation) ProtoMessage()    {}
 func (*AccessTokenValidation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_043e68ecbb4b7f5e, []int{11}
+	return fileDescriptor_043e68ecbb4b7f5e, []int{13}
 }
 func (m *AccessTokenValidation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccessTokenValidation.Unmarshal(m, b)
@@ -1316,7 +1660,7 @@ func (m *OauthSecret) Reset()         { *m = OauthSecret{} }
 func (m *OauthSecret) String() string { return proto.CompactTextString(m) }
 func (*OauthSecret) ProtoMessage()    {}
 func (*OauthSecret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_043e68ecbb4b7f5e, []int{12}
+	return fileDescriptor_043e68ecbb4b7f5e, []int{14}
 }
 func (m *OauthSecret) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OauthSecret.Unmarshal(m, b)
@@ -1358,17 +1702,23 @@ type ApiKeyAuth struct {
 	// This configuration can be used to add this data to the headers of successfully authenticated requests.
 	// Each key in the map represents the name of header to be added; the corresponding value determines the key
 	// in the secret data that will be inspected to determine the value for the header.
-	HeadersFromMetadata  map[string]*ApiKeyAuth_SecretKey `protobuf:"bytes,4,rep,name=headers_from_metadata,json=headersFromMetadata,proto3" json:"headers_from_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
-	XXX_unrecognized     []byte                           `json:"-"`
-	XXX_sizecache        int32                            `json:"-"`
+	HeadersFromMetadata map[string]*ApiKeyAuth_SecretKey `protobuf:"bytes,4,rep,name=headers_from_metadata,json=headersFromMetadata,proto3" json:"headers_from_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Like `headers_from_metadata`, but the data is emitted as dynamic metadata of successfully authenticated requests,
+	// in the `envoy.filters.http.ext_authz` namespace, instead of being added as headers. Dynamic metadata is available to
+	// the filters that run after the external auth filter and to access logs, without being sent to the upstream.
+	// Each key in the map represents the name of the metadata entry; the corresponding value determines the key
+	// in the secret data that will be inspected to determine the value for the entry.
+	DynamicMetadataFromMetadata map[string]*ApiKeyAuth_SecretKey `protobuf:"bytes,5,rep,name=dynamic_metadata_from_metadata,json=dynamicMetadataFromMetadata,proto3" json:"dynamic_metadata_from_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral        struct{}                         `json:"-"`
+	XXX_unrecognized            []byte                           `json:"-"`
+	XXX_sizecache               int32                            `json:"-"`
 }
 
 func (m *ApiKeyAuth) Reset()         { *m = ApiKeyAuth{} }
 func (m *ApiKeyAuth) String() string { return proto.CompactTextString(m) }
 func (*ApiKeyAuth) ProtoMessage()    {}
 func (*ApiKeyAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_043e68ecbb4b7f5e, []int{13}
+	return fileDescriptor_043e68ecbb4b7f5e, []int{15}
 }
 func (m *ApiKeyAuth) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ApiKeyAuth.Unmarshal(m, b)
@@ -1416,6 +1766,13 @@ func (m *ApiKeyAuth) GetHeadersFromMetadata() map[string]*ApiKeyAuth_SecretKey {
 	return nil
 }
 
+func (m *ApiKeyAuth) GetDynamicMetadataFromMetadata() map[string]*ApiKeyAuth_SecretKey {
+	if m != nil {
+		return m.DynamicMetadataFromMetadata
+	}
+	return nil
+}
+
 type ApiKeyAuth_SecretKey struct {
 	// (Required) The key of the secret data entry to inspect.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -1432,7 +1789,7 @@ func (m *ApiKeyAuth_SecretKey) Reset()         { *m = ApiKeyAuth_SecretKey{} }
 func (m *ApiKeyAuth_SecretKey) String() string { return proto.CompactTextString(m) }
 func (*ApiKeyAuth_SecretKey) ProtoMessage()    {}
 func (*ApiKeyAuth_SecretKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_043e68ecbb4b7f5e, []int{13, 2}
+	return fileDescriptor_043e68ecbb4b7f5e, []int{15, 3}
 }
 func (m *ApiKeyAuth_SecretKey) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ApiKeyAuth_SecretKey.Unmarshal(m, b)
@@ -1487,7 +1844,7 @@ func (m *ApiKeySecret) Reset()         { *m = ApiKeySecret{} }
 func (m *ApiKeySecret) String() string { return proto.CompactTextString(m) }
 func (*ApiKeySecret) ProtoMessage()    {}
 func (*ApiKeySecret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_043e68ecbb4b7f5e, []int{14}
+	return fileDescriptor_043e68ecbb4b7f5e, []int{16}
 }
 func (m *ApiKeySecret) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ApiKeySecret.Unmarshal(m, b)
@@ -1553,7 +1910,7 @@ func (m *OpaAuth) Reset()         { *m = OpaAuth{} }
 func (m *OpaAuth) String() string { return proto.CompactTextString(m) }
 func (*OpaAuth) ProtoMessage()    {}
 func (*OpaAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_043e68ecbb4b7f5e, []int{15}
+	return fileDescriptor_043e68ecbb4b7f5e, []int{17}
 }
 func (m *OpaAuth) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OpaAuth.Unmarshal(m, b)
@@ -1618,7 +1975,7 @@ func (m *Ldap) Reset()         { *m = Ldap{} }
 func (m *Ldap) String() string { return proto.CompactTextString(m) }
 func (*Ldap) ProtoMessage()    {}
 func (*Ldap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_043e68ecbb4b7f5e, []int{16}
+	return fileDescriptor_043e68ecbb4b7f5e, []int{18}
 }
 func (m *Ldap) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Ldap.Unmarshal(m, b)
@@ -1689,7 +2046,7 @@ func (m *Ldap_ConnectionPool) Reset()         { *m = Ldap_ConnectionPool{} }
 func (m *Ldap_ConnectionPool) String() string { return proto.CompactTextString(m) }
 func (*Ldap_ConnectionPool) ProtoMessage()    {}
 func (*Ldap_ConnectionPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_043e68ecbb4b7f5e, []int{16, 0}
+	return fileDescriptor_043e68ecbb4b7f5e, []int{18, 0}
 }
 func (m *Ldap_ConnectionPool) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Ldap_ConnectionPool.Unmarshal(m, b)
@@ -1723,6 +2080,154 @@ func (m *Ldap_ConnectionPool) GetInitialSize() *types.UInt32Value {
 	return nil
 }
 
+// Authenticates and authorizes requests by querying an auth service that you operate. The external auth server
+// forwards the Envoy `CheckRequest` it received to the given service and uses the response to make its decision.
+// This allows you to chain your own auth service with the other auth configurations in the same AuthConfig.
+type PassThroughAuth struct {
+	// Types that are valid to be assigned to Protocol:
+	//	*PassThroughAuth_Grpc
+	Protocol isPassThroughAuth_Protocol `protobuf_oneof:"protocol"`
+	// Custom config to be passed with each request to the passthrough auth service. The config is added to the
+	// `CheckRequest` as filter metadata under the `solo.auth.passthrough.config` key.
+	Config *types.Struct `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// Names of the request headers (case-insensitive) that will be forwarded to the passthrough auth service.
+	// If empty, all the headers in the `CheckRequest` are forwarded. Note that the request body is only available to the
+	// service if `requestBody` is set on the extauth settings.
+	AllowedHeaders []string `protobuf:"bytes,3,rep,name=allowed_headers,json=allowedHeaders,proto3" json:"allowed_headers,omitempty"`
+	// Names of the headers (case-insensitive) in the response from the passthrough auth service that will be added to
+	// the request to the upstream when the request is authorized. Coexistent headers will be overridden.
+	// If empty, all the headers returned by the service are added.
+	AllowedUpstreamHeaders []string `protobuf:"bytes,4,rep,name=allowed_upstream_headers,json=allowedUpstreamHeaders,proto3" json:"allowed_upstream_headers,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
+}
+
+func (m *PassThroughAuth) Reset()         { *m = PassThroughAuth{} }
+func (m *PassThroughAuth) String() string { return proto.CompactTextString(m) }
+func (*PassThroughAuth) ProtoMessage()    {}
+func (*PassThroughAuth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_043e68ecbb4b7f5e, []int{19}
+}
+func (m *PassThroughAuth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PassThroughAuth.Unmarshal(m, b)
+}
+func (m *PassThroughAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PassThroughAuth.Marshal(b, m, deterministic)
+}
+func (m *PassThroughAuth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PassThroughAuth.Merge(m, src)
+}
+func (m *PassThroughAuth) XXX_Size() int {
+	return xxx_messageInfo_PassThroughAuth.Size(m)
+}
+func (m *PassThroughAuth) XXX_DiscardUnknown() {
+	xxx_messageInfo_PassThroughAuth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PassThroughAuth proto.InternalMessageInfo
+
+type isPassThroughAuth_Protocol interface {
+	isPassThroughAuth_Protocol()
+	Equal(interface{}) bool
+}
+
+type PassThroughAuth_Grpc struct {
+	Grpc *PassThroughGrpc `protobuf:"bytes,1,opt,name=grpc,proto3,oneof" json:"grpc,omitempty"`
+}
+
+func (*PassThroughAuth_Grpc) isPassThroughAuth_Protocol() {}
+
+func (m *PassThroughAuth) GetProtocol() isPassThroughAuth_Protocol {
+	if m != nil {
+		return m.Protocol
+	}
+	return nil
+}
+
+func (m *PassThroughAuth) GetGrpc() *PassThroughGrpc {
+	if x, ok := m.GetProtocol().(*PassThroughAuth_Grpc); ok {
+		return x.Grpc
+	}
+	return nil
+}
+
+func (m *PassThroughAuth) GetConfig() *types.Struct {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *PassThroughAuth) GetAllowedHeaders() []string {
+	if m != nil {
+		return m.AllowedHeaders
+	}
+	return nil
+}
+
+func (m *PassThroughAuth) GetAllowedUpstreamHeaders() []string {
+	if m != nil {
+		return m.AllowedUpstreamHeaders
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*PassThroughAuth) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*PassThroughAuth_Grpc)(nil),
+	}
+}
+
+type PassThroughGrpc struct {
+	// Address of the auth server to query. Should be in the form ADDRESS:PORT, e.g. `auth.default.svc.cluster.local:9001`.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Timeout for the auth server to respond. Defaults to 5s.
+	ConnectionTimeout    *types.Duration `protobuf:"bytes,2,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PassThroughGrpc) Reset()         { *m = PassThroughGrpc{} }
+func (m *PassThroughGrpc) String() string { return proto.CompactTextString(m) }
+func (*PassThroughGrpc) ProtoMessage()    {}
+func (*PassThroughGrpc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_043e68ecbb4b7f5e, []int{20}
+}
+func (m *PassThroughGrpc) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PassThroughGrpc.Unmarshal(m, b)
+}
+func (m *PassThroughGrpc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PassThroughGrpc.Marshal(b, m, deterministic)
+}
+func (m *PassThroughGrpc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PassThroughGrpc.Merge(m, src)
+}
+func (m *PassThroughGrpc) XXX_Size() int {
+	return xxx_messageInfo_PassThroughGrpc.Size(m)
+}
+func (m *PassThroughGrpc) XXX_DiscardUnknown() {
+	xxx_messageInfo_PassThroughGrpc.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PassThroughGrpc proto.InternalMessageInfo
+
+func (m *PassThroughGrpc) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PassThroughGrpc) GetConnectionTimeout() *types.Duration {
+	if m != nil {
+		return m.ConnectionTimeout
+	}
+	return nil
+}
+
 //
 //@solo-kit:xds-service=ExtAuthDiscoveryService
 //@solo-kit:resource.no_references
@@ -1752,7 +2257,7 @@ func (m *ExtAuthConfig) Reset()         { *m = ExtAuthConfig{} }
 func (m *ExtAuthConfig) String() string { return proto.CompactTextString(m) }
 func (*ExtAuthConfig) ProtoMessage()    {}
 func (*ExtAuthConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_043e68ecbb4b7f5e, []int{17}
+	return fileDescriptor_043e68ecbb4b7f5e, []int{21}
 }
 func (m *ExtAuthConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExtAuthConfig.Unmarshal(m, b)
@@ -1821,7 +2326,7 @@ func (m *ExtAuthConfig_OAuthConfig) Reset()         { *m = ExtAuthConfig_OAuthCo
 func (m *ExtAuthConfig_OAuthConfig) String() string { return proto.CompactTextString(m) }
 func (*ExtAuthConfig_OAuthConfig) ProtoMessage()    {}
 func (*ExtAuthConfig_OAuthConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_043e68ecbb4b7f5e, []int{17, 0}
+	return fileDescriptor_043e68ecbb4b7f5e, []int{21, 0}
 }
 func (m *ExtAuthConfig_OAuthConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExtAuthConfig_OAuthConfig.Unmarshal(m, b)
@@ -1914,10 +2419,15 @@ type ExtAuthConfig_OidcAuthorizationCodeConfig struct {
 	// needs to not be used by the application
 	CallbackPath string `protobuf:"bytes,6,opt,name=callback_path,json=callbackPath,proto3" json:"callback_path,omitempty"`
 	// scopes to request in addition to the openid scope.
-	Scopes               []string `protobuf:"bytes,7,rep,name=scopes,proto3" json:"scopes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Scopes []string `protobuf:"bytes,7,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// a path relative to app url that will be used for logging out from an OIDC session.
+	// needs to not be used by the application. If not provided, logout functionality will be disabled.
+	LogoutPath string `protobuf:"bytes,9,opt,name=logout_path,json=logoutPath,proto3" json:"logout_path,omitempty"`
+	// Configuration related to the user session.
+	Session              *UserSession `protobuf:"bytes,10,opt,name=session,proto3" json:"session,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *ExtAuthConfig_OidcAuthorizationCodeConfig) Reset() {
@@ -1928,7 +2438,7 @@ func (m *ExtAuthConfig_OidcAuthorizationCodeConfig) String() string {
 }
 func (*ExtAuthConfig_OidcAuthorizationCodeConfig) ProtoMessage() {}
 func (*ExtAuthConfig_OidcAuthorizationCodeConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_043e68ecbb4b7f5e, []int{17, 1}
+	return fileDescriptor_043e68ecbb4b7f5e, []int{21, 1}
 }
 func (m *ExtAuthConfig_OidcAuthorizationCodeConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExtAuthConfig_OidcAuthorizationCodeConfig.Unmarshal(m, b)
@@ -1997,6 +2507,20 @@ func (m *ExtAuthConfig_OidcAuthorizationCodeConfig) GetScopes() []string {
 	return nil
 }
 
+func (m *ExtAuthConfig_OidcAuthorizationCodeConfig) GetLogoutPath() string {
+	if m != nil {
+		return m.LogoutPath
+	}
+	return ""
+}
+
+func (m *ExtAuthConfig_OidcAuthorizationCodeConfig) GetSession() *UserSession {
+	if m != nil {
+		return m.Session
+	}
+	return nil
+}
+
 type ExtAuthConfig_OAuth2Config struct {
 	// Types that are valid to be assigned to OauthType:
 	//	*ExtAuthConfig_OAuth2Config_OidcAuthorizationCode
@@ -2011,7 +2535,7 @@ func (m *ExtAuthConfig_OAuth2Config) Reset()         { *m = ExtAuthConfig_OAuth2
 func (m *ExtAuthConfig_OAuth2Config) String() string { return proto.CompactTextString(m) }
 func (*ExtAuthConfig_OAuth2Config) ProtoMessage()    {}
 func (*ExtAuthConfig_OAuth2Config) Descriptor() ([]byte, []int) {
-	return fileDescriptor_043e68ecbb4b7f5e, []int{17, 2}
+	return fileDescriptor_043e68ecbb4b7f5e, []int{21, 2}
 }
 func (m *ExtAuthConfig_OAuth2Config) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExtAuthConfig_OAuth2Config.Unmarshal(m, b)
@@ -2087,16 +2611,20 @@ type ExtAuthConfig_ApiKeyAuthConfig struct {
 	// Each entry represents a header to add: the key is the name of the header, and the
 	// value is the key that will be used to look up the data entry in the key metadata.
 	HeadersFromKeyMetadata map[string]string `protobuf:"bytes,3,rep,name=headers_from_key_metadata,json=headersFromKeyMetadata,proto3" json:"headers_from_key_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral   struct{}          `json:"-"`
-	XXX_unrecognized       []byte            `json:"-"`
-	XXX_sizecache          int32             `json:"-"`
+	// Determines the key metadata that will be emitted as dynamic metadata of authenticated requests.
+	// Each entry represents a metadata entry to emit: the key is the name of the entry, and the
+	// value is the key that will be used to look up the data entry in the key metadata.
+	DynamicMetadataFromKeyMetadata map[string]string `protobuf:"bytes,4,rep,name=dynamic_metadata_from_key_metadata,json=dynamicMetadataFromKeyMetadata,proto3" json:"dynamic_metadata_from_key_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral           struct{}          `json:"-"`
+	XXX_unrecognized               []byte            `json:"-"`
+	XXX_sizecache                  int32             `json:"-"`
 }
 
 func (m *ExtAuthConfig_ApiKeyAuthConfig) Reset()         { *m = ExtAuthConfig_ApiKeyAuthConfig{} }
 func (m *ExtAuthConfig_ApiKeyAuthConfig) String() string { return proto.CompactTextString(m) }
 func (*ExtAuthConfig_ApiKeyAuthConfig) ProtoMessage()    {}
 func (*ExtAuthConfig_ApiKeyAuthConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_043e68ecbb4b7f5e, []int{17, 3}
+	return fileDescriptor_043e68ecbb4b7f5e, []int{21, 3}
 }
 func (m *ExtAuthConfig_ApiKeyAuthConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExtAuthConfig_ApiKeyAuthConfig.Unmarshal(m, b)
@@ -2137,6 +2665,13 @@ func (m *ExtAuthConfig_ApiKeyAuthConfig) GetHeadersFromKeyMetadata() map[string]
 	return nil
 }
 
+func (m *ExtAuthConfig_ApiKeyAuthConfig) GetDynamicMetadataFromKeyMetadata() map[string]string {
+	if m != nil {
+		return m.DynamicMetadataFromKeyMetadata
+	}
+	return nil
+}
+
 type ExtAuthConfig_ApiKeyAuthConfig_KeyMetadata struct {
 	// The user is mapped as the name of `Secret` which contains the `ApiKeySecret`
 	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
@@ -2155,7 +2690,7 @@ func (m *ExtAuthConfig_ApiKeyAuthConfig_KeyMetadata) String() string {
 }
 func (*ExtAuthConfig_ApiKeyAuthConfig_KeyMetadata) ProtoMessage() {}
 func (*ExtAuthConfig_ApiKeyAuthConfig_KeyMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_043e68ecbb4b7f5e, []int{17, 3, 0}
+	return fileDescriptor_043e68ecbb4b7f5e, []int{21, 3, 0}
 }
 func (m *ExtAuthConfig_ApiKeyAuthConfig_KeyMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExtAuthConfig_ApiKeyAuthConfig_KeyMetadata.Unmarshal(m, b)
@@ -2205,7 +2740,7 @@ func (m *ExtAuthConfig_OpaAuthConfig) Reset()         { *m = ExtAuthConfig_OpaAu
 func (m *ExtAuthConfig_OpaAuthConfig) String() string { return proto.CompactTextString(m) }
 func (*ExtAuthConfig_OpaAuthConfig) ProtoMessage()    {}
 func (*ExtAuthConfig_OpaAuthConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_043e68ecbb4b7f5e, []int{17, 4}
+	return fileDescriptor_043e68ecbb4b7f5e, []int{21, 4}
 }
 func (m *ExtAuthConfig_OpaAuthConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExtAuthConfig_OpaAuthConfig.Unmarshal(m, b)
@@ -2253,6 +2788,7 @@ type ExtAuthConfig_Config struct {
 	//	*ExtAuthConfig_Config_PluginAuth
 	//	*ExtAuthConfig_Config_OpaAuth
 	//	*ExtAuthConfig_Config_Ldap
+	//	*ExtAuthConfig_Config_PassThroughAuth
 	AuthConfig           isExtAuthConfig_Config_AuthConfig `protobuf_oneof:"auth_config"`
 	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
 	XXX_unrecognized     []byte                            `json:"-"`
@@ -2263,7 +2799,7 @@ func (m *ExtAuthConfig_Config) Reset()         { *m = ExtAuthConfig_Config{} }
 func (m *ExtAuthConfig_Config) String() string { return proto.CompactTextString(m) }
 func (*ExtAuthConfig_Config) ProtoMessage()    {}
 func (*ExtAuthConfig_Config) Descriptor() ([]byte, []int) {
-	return fileDescriptor_043e68ecbb4b7f5e, []int{17, 5}
+	return fileDescriptor_043e68ecbb4b7f5e, []int{21, 5}
 }
 func (m *ExtAuthConfig_Config) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExtAuthConfig_Config.Unmarshal(m, b)
@@ -2309,14 +2845,18 @@ type ExtAuthConfig_Config_OpaAuth struct {
 type ExtAuthConfig_Config_Ldap struct {
 	Ldap *Ldap `protobuf:"bytes,8,opt,name=ldap,proto3,oneof" json:"ldap,omitempty"`
 }
+type ExtAuthConfig_Config_PassThroughAuth struct {
+	PassThroughAuth *PassThroughAuth `protobuf:"bytes,12,opt,name=pass_through_auth,json=passThroughAuth,proto3,oneof" json:"pass_through_auth,omitempty"`
+}
 
-func (*ExtAuthConfig_Config_Oauth) isExtAuthConfig_Config_AuthConfig()      {}
-func (*ExtAuthConfig_Config_Oauth2) isExtAuthConfig_Config_AuthConfig()     {}
-func (*ExtAuthConfig_Config_BasicAuth) isExtAuthConfig_Config_AuthConfig()  {}
-func (*ExtAuthConfig_Config_ApiKeyAuth) isExtAuthConfig_Config_AuthConfig() {}
-func (*ExtAuthConfig_Config_PluginAuth) isExtAuthConfig_Config_AuthConfig() {}
-func (*ExtAuthConfig_Config_OpaAuth) isExtAuthConfig_Config_AuthConfig()    {}
-func (*ExtAuthConfig_Config_Ldap) isExtAuthConfig_Config_AuthConfig()       {}
+func (*ExtAuthConfig_Config_Oauth) isExtAuthConfig_Config_AuthConfig()           {}
+func (*ExtAuthConfig_Config_Oauth2) isExtAuthConfig_Config_AuthConfig()          {}
+func (*ExtAuthConfig_Config_BasicAuth) isExtAuthConfig_Config_AuthConfig()       {}
+func (*ExtAuthConfig_Config_ApiKeyAuth) isExtAuthConfig_Config_AuthConfig()      {}
+func (*ExtAuthConfig_Config_PluginAuth) isExtAuthConfig_Config_AuthConfig()      {}
+func (*ExtAuthConfig_Config_OpaAuth) isExtAuthConfig_Config_AuthConfig()         {}
+func (*ExtAuthConfig_Config_Ldap) isExtAuthConfig_Config_AuthConfig()            {}
+func (*ExtAuthConfig_Config_PassThroughAuth) isExtAuthConfig_Config_AuthConfig() {}
 
 func (m *ExtAuthConfig_Config) GetAuthConfig() isExtAuthConfig_Config_AuthConfig {
 	if m != nil {
@@ -2382,6 +2922,13 @@ func (m *ExtAuthConfig_Config) GetLdap() *Ldap {
 	return nil
 }
 
+func (m *ExtAuthConfig_Config) GetPassThroughAuth() *PassThroughAuth {
+	if x, ok := m.GetAuthConfig().(*ExtAuthConfig_Config_PassThroughAuth); ok {
+		return x.PassThroughAuth
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ExtAuthConfig_Config) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -2392,6 +2939,7 @@ func (*ExtAuthConfig_Config) XXX_OneofWrappers() []interface{} {
 		(*ExtAuthConfig_Config_PluginAuth)(nil),
 		(*ExtAuthConfig_Config_OpaAuth)(nil),
 		(*ExtAuthConfig_Config_Ldap)(nil),
+		(*ExtAuthConfig_Config_PassThroughAuth)(nil),
 	}
 }
 
@@ -2417,9 +2965,15 @@ func init() {
 	proto.RegisterType((*OAuth2)(nil), "enterprise.gloo.solo.io.OAuth2")
 	proto.RegisterType((*OidcAuthorizationCode)(nil), "enterprise.gloo.solo.io.OidcAuthorizationCode")
 	proto.RegisterMapType((map[string]string)(nil), "enterprise.gloo.solo.io.OidcAuthorizationCode.AuthEndpointQueryParamsEntry")
+	proto.RegisterType((*UserSession)(nil), "enterprise.gloo.solo.io.UserSession")
+	proto.RegisterType((*UserSession_InternalSession)(nil), "enterprise.gloo.solo.io.UserSession.InternalSession")
+	proto.RegisterType((*UserSession_RedisSession)(nil), "enterprise.gloo.solo.io.UserSession.RedisSession")
+	proto.RegisterType((*UserSession_CookieOptions)(nil), "enterprise.gloo.solo.io.UserSession.CookieOptions")
+	proto.RegisterType((*RedisOptions)(nil), "enterprise.gloo.solo.io.RedisOptions")
 	proto.RegisterType((*AccessTokenValidation)(nil), "enterprise.gloo.solo.io.AccessTokenValidation")
 	proto.RegisterType((*OauthSecret)(nil), "enterprise.gloo.solo.io.OauthSecret")
 	proto.RegisterType((*ApiKeyAuth)(nil), "enterprise.gloo.solo.io.ApiKeyAuth")
+	proto.RegisterMapType((map[string]*ApiKeyAuth_SecretKey)(nil), "enterprise.gloo.solo.io.ApiKeyAuth.DynamicMetadataFromMetadataEntry")
 	proto.RegisterMapType((map[string]*ApiKeyAuth_SecretKey)(nil), "enterprise.gloo.solo.io.ApiKeyAuth.HeadersFromMetadataEntry")
 	proto.RegisterMapType((map[string]string)(nil), "enterprise.gloo.solo.io.ApiKeyAuth.LabelSelectorEntry")
 	proto.RegisterType((*ApiKeyAuth_SecretKey)(nil), "enterprise.gloo.solo.io.ApiKeyAuth.SecretKey")
@@ -2428,6 +2982,8 @@ func init() {
 	proto.RegisterType((*OpaAuth)(nil), "enterprise.gloo.solo.io.OpaAuth")
 	proto.RegisterType((*Ldap)(nil), "enterprise.gloo.solo.io.Ldap")
 	proto.RegisterType((*Ldap_ConnectionPool)(nil), "enterprise.gloo.solo.io.Ldap.ConnectionPool")
+	proto.RegisterType((*PassThroughAuth)(nil), "enterprise.gloo.solo.io.PassThroughAuth")
+	proto.RegisterType((*PassThroughGrpc)(nil), "enterprise.gloo.solo.io.PassThroughGrpc")
 	proto.RegisterType((*ExtAuthConfig)(nil), "enterprise.gloo.solo.io.ExtAuthConfig")
 	proto.RegisterType((*ExtAuthConfig_OAuthConfig)(nil), "enterprise.gloo.solo.io.ExtAuthConfig.OAuthConfig")
 	proto.RegisterMapType((map[string]string)(nil), "enterprise.gloo.solo.io.ExtAuthConfig.OAuthConfig.AuthEndpointQueryParamsEntry")
@@ -2435,6 +2991,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "enterprise.gloo.solo.io.ExtAuthConfig.OidcAuthorizationCodeConfig.AuthEndpointQueryParamsEntry")
 	proto.RegisterType((*ExtAuthConfig_OAuth2Config)(nil), "enterprise.gloo.solo.io.ExtAuthConfig.OAuth2Config")
 	proto.RegisterType((*ExtAuthConfig_ApiKeyAuthConfig)(nil), "enterprise.gloo.solo.io.ExtAuthConfig.ApiKeyAuthConfig")
+	proto.RegisterMapType((map[string]string)(nil), "enterprise.gloo.solo.io.ExtAuthConfig.ApiKeyAuthConfig.DynamicMetadataFromKeyMetadataEntry")
 	proto.RegisterMapType((map[string]string)(nil), "enterprise.gloo.solo.io.ExtAuthConfig.ApiKeyAuthConfig.HeadersFromKeyMetadataEntry")
 	proto.RegisterMapType((map[string]*ExtAuthConfig_ApiKeyAuthConfig_KeyMetadata)(nil), "enterprise.gloo.solo.io.ExtAuthConfig.ApiKeyAuthConfig.ValidApiKeysEntry")
 	proto.RegisterType((*ExtAuthConfig_ApiKeyAuthConfig_KeyMetadata)(nil), "enterprise.gloo.solo.io.ExtAuthConfig.ApiKeyAuthConfig.KeyMetadata")
@@ -2449,183 +3006,215 @@ func init() {
 }
 
 var fileDescriptor_043e68ecbb4b7f5e = []byte{
-	// 2816 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x4d, 0x6c, 0x1b, 0xc7,
-	0xf5, 0xd7, 0x52, 0x34, 0x45, 0x3d, 0x52, 0x5f, 0x63, 0xd9, 0xa2, 0xd7, 0x89, 0xe5, 0x30, 0x86,
-	0x63, 0x04, 0x36, 0x95, 0xd0, 0x41, 0xfe, 0x8e, 0xfc, 0x6f, 0x12, 0x51, 0x92, 0x2d, 0xd9, 0x71,
-	0x24, 0xaf, 0xec, 0x20, 0x6d, 0x5a, 0x2c, 0x46, 0xbb, 0x43, 0x72, 0xeb, 0xe5, 0xce, 0x66, 0x76,
-	0x56, 0x11, 0x8d, 0xf4, 0x12, 0xa4, 0x40, 0xd0, 0x43, 0x51, 0xa0, 0x97, 0xb6, 0x28, 0x7a, 0xee,
-	0xa5, 0xf7, 0xa2, 0x40, 0x53, 0x20, 0x97, 0xf6, 0xd6, 0x43, 0x91, 0x43, 0x81, 0x36, 0x05, 0x8a,
-	0xa2, 0x87, 0xde, 0x12, 0x20, 0x45, 0x6f, 0x2d, 0xe6, 0x63, 0x57, 0x4b, 0x89, 0x94, 0x28, 0x35,
-	0x01, 0x8a, 0xf6, 0x24, 0xee, 0x7b, 0xef, 0xf7, 0x66, 0xde, 0xc7, 0xcc, 0xfc, 0x76, 0x47, 0xf0,
-	0x66, 0xcb, 0xe3, 0xed, 0x78, 0xbb, 0xe6, 0xd0, 0xce, 0x42, 0x44, 0x7d, 0x7a, 0xcd, 0xa3, 0x0b,
-	0x2d, 0x9f, 0xd2, 0x85, 0x90, 0xd1, 0x6f, 0x12, 0x87, 0x47, 0xea, 0x09, 0x87, 0xde, 0xc2, 0xce,
-	0xf3, 0x0b, 0x24, 0xe0, 0x84, 0x85, 0xcc, 0x8b, 0xc8, 0x02, 0x0d, 0xb9, 0x47, 0x83, 0x68, 0x81,
-	0xec, 0x72, 0x1c, 0xf3, 0xb6, 0xd4, 0xaa, 0x9f, 0xb5, 0x90, 0x51, 0x4e, 0xd1, 0xdc, 0x9e, 0x71,
-	0x4d, 0xf8, 0xa8, 0x09, 0xf7, 0x35, 0x8f, 0x9a, 0xe7, 0xe4, 0x38, 0x8f, 0x3c, 0x9e, 0x78, 0x65,
-	0xa4, 0xa9, 0x30, 0xe6, 0x6c, 0x8b, 0xb6, 0xa8, 0xfc, 0xb9, 0x20, 0x7e, 0x69, 0x29, 0x22, 0xbb,
-	0x5c, 0x09, 0xc9, 0x2e, 0xd7, 0xb2, 0x0b, 0xfb, 0x9d, 0x74, 0x08, 0xc7, 0x2e, 0xe6, 0x58, 0xeb,
-	0x9f, 0xd8, 0xaf, 0x8f, 0x38, 0xe6, 0x71, 0x34, 0x08, 0x9d, 0x3c, 0x27, 0x68, 0x12, 0xec, 0xd0,
-	0xae, 0x52, 0xd6, 0x17, 0x5c, 0x2f, 0x72, 0xe8, 0x0e, 0x61, 0xdd, 0x44, 0xdb, 0xa2, 0xb4, 0xe5,
-	0x13, 0xa9, 0xc6, 0x41, 0x40, 0x39, 0x96, 0xa9, 0x48, 0x7c, 0x6b, 0xad, 0x7c, 0xda, 0x8e, 0x9b,
-	0x0b, 0x6e, 0xcc, 0xa4, 0xc1, 0x3e, 0x74, 0xaa, 0x8f, 0x38, 0x8b, 0x1d, 0x3e, 0x08, 0xfd, 0x0e,
-	0xc3, 0x61, 0x48, 0x98, 0xf6, 0x5e, 0xfd, 0x55, 0x01, 0x60, 0x29, 0xe6, 0xed, 0x65, 0x1a, 0x34,
-	0xbd, 0x16, 0xba, 0x0d, 0x05, 0x15, 0x58, 0xc5, 0xb8, 0x68, 0x5c, 0x29, 0xd5, 0x67, 0x6b, 0x0e,
-	0x65, 0x24, 0x49, 0x75, 0x6d, 0x4b, 0xea, 0x1a, 0xe7, 0x7e, 0xf3, 0xc9, 0xfc, 0xc8, 0x67, 0x9f,
-	0xcc, 0xcf, 0x70, 0x12, 0x71, 0xd7, 0x6b, 0x36, 0x17, 0xab, 0x5e, 0x2b, 0xa0, 0x8c, 0x54, 0x2d,
-	0x0d, 0x47, 0x37, 0xa0, 0x98, 0x64, 0xb0, 0x92, 0x93, 0xae, 0xce, 0xf6, 0xba, 0xba, 0xa7, 0xb5,
-	0x8d, 0xbc, 0x70, 0x66, 0xa5, 0xd6, 0x68, 0x05, 0xc6, 0x1c, 0x39, 0x99, 0xa8, 0x32, 0x7a, 0x71,
-	0xf4, 0x4a, 0xa9, 0xfe, 0x6c, 0x6d, 0x40, 0xe5, 0x6b, 0x7b, 0x13, 0xaf, 0xa9, 0x3f, 0x56, 0x02,
-	0x45, 0xaf, 0x40, 0x79, 0x9b, 0x52, 0x9f, 0xe0, 0xc0, 0x26, 0xbb, 0x21, 0xab, 0x80, 0x9c, 0xc3,
-	0x13, 0x35, 0x95, 0x8e, 0x5a, 0x92, 0x8e, 0xda, 0x16, 0x67, 0x5e, 0xd0, 0x7a, 0x03, 0xfb, 0x31,
-	0xb1, 0x4a, 0x1a, 0xb1, 0xba, 0x1b, 0x32, 0xf3, 0x3b, 0x79, 0x28, 0xe8, 0xa4, 0x3c, 0x07, 0xf9,
-	0x00, 0x77, 0x48, 0x65, 0x7c, 0x08, 0x1f, 0xd2, 0x12, 0x2d, 0x03, 0x6c, 0xe3, 0xc8, 0x73, 0x6c,
-	0xd1, 0xbf, 0x3a, 0x95, 0xd5, 0x81, 0x61, 0x34, 0x84, 0xa9, 0x88, 0x65, 0x6d, 0xc4, 0x1a, 0xdf,
-	0x4e, 0x1e, 0xd0, 0x22, 0x9c, 0xa2, 0x12, 0xaf, 0xf2, 0x77, 0x61, 0x20, 0x7e, 0x43, 0x98, 0x37,
-	0x72, 0x15, 0x63, 0x6d, 0xc4, 0x52, 0x10, 0xf4, 0x12, 0x14, 0xe4, 0x8f, 0x7a, 0xa5, 0x28, 0xc1,
-	0xf3, 0x87, 0x83, 0xeb, 0x6b, 0x23, 0x96, 0x06, 0xa0, 0xdb, 0x50, 0xc6, 0xa1, 0x67, 0x3f, 0x22,
-	0x5d, 0x35, 0xfb, 0xbc, 0x74, 0xf0, 0xf4, 0xe0, 0x22, 0x84, 0xde, 0x5d, 0xd2, 0xd5, 0xd3, 0x07,
-	0x9c, 0x3e, 0xa1, 0x5b, 0x50, 0x0a, 0xfd, 0xb8, 0xe5, 0x05, 0xca, 0xcf, 0xa9, 0xa3, 0xfc, 0xc4,
-	0xbc, 0xbd, 0x29, 0xed, 0x85, 0x1f, 0x85, 0x94, 0x7e, 0xbe, 0x02, 0x45, 0x1a, 0x62, 0xe5, 0xa4,
-	0x20, 0x9d, 0x5c, 0x1c, 0x1c, 0x4d, 0x88, 0xf5, 0x4c, 0xc6, 0xa8, 0xfa, 0x89, 0xae, 0x43, 0xde,
-	0x77, 0x71, 0x58, 0x19, 0x93, 0xd0, 0x27, 0x07, 0x42, 0x5f, 0x73, 0x71, 0xb8, 0x36, 0x62, 0x49,
-	0xe3, 0xc6, 0x04, 0x94, 0xc4, 0x78, 0xb6, 0x6a, 0xa7, 0x45, 0xf3, 0xbd, 0x4f, 0xf3, 0x79, 0xc8,
-	0x61, 0xe7, 0xbd, 0x4f, 0xf3, 0x93, 0xa8, 0x9c, 0x51, 0x45, 0xd5, 0x5f, 0x18, 0x30, 0xbd, 0xba,
-	0xcb, 0xc5, 0x58, 0xab, 0xbb, 0x9c, 0x04, 0x91, 0x47, 0x03, 0x64, 0xc2, 0x98, 0xeb, 0x45, 0x78,
-	0xdb, 0x27, 0xb2, 0xfa, 0x45, 0x31, 0x21, 0x2d, 0x40, 0x8b, 0x00, 0x0a, 0x6b, 0x33, 0xd2, 0xd4,
-	0xc5, 0x3d, 0xd7, 0xbb, 0x38, 0x2c, 0x12, 0xd1, 0x98, 0x39, 0xc4, 0x22, 0x4d, 0xd1, 0x13, 0xca,
-	0xdc, 0x22, 0x4d, 0x91, 0x53, 0x27, 0x8e, 0x38, 0xed, 0xa8, 0x74, 0x8c, 0x1e, 0x91, 0xd3, 0x65,
-	0x69, 0x9b, 0xd4, 0xc6, 0x49, 0x9f, 0x1a, 0x05, 0xc8, 0x47, 0x21, 0x71, 0xaa, 0x7f, 0x18, 0x85,
-	0xe2, 0x16, 0xe1, 0xdc, 0x0b, 0x5a, 0x11, 0x5a, 0x87, 0xd3, 0x7a, 0xcb, 0x7d, 0x6c, 0x47, 0x84,
-	0xed, 0x10, 0x26, 0x67, 0x68, 0x1c, 0x31, 0x43, 0x6b, 0x26, 0x41, 0x6d, 0x49, 0x90, 0x98, 0xe7,
-	0x6d, 0x28, 0xb7, 0x39, 0x0f, 0xa5, 0x1b, 0xcf, 0x21, 0x3a, 0xca, 0x4b, 0x03, 0x27, 0xba, 0xc6,
-	0x79, 0xb8, 0xa5, 0x6c, 0xad, 0x52, 0x7b, 0xef, 0x01, 0x5d, 0x82, 0xc9, 0x38, 0x22, 0xcc, 0xf6,
-	0x5c, 0xbb, 0x4d, 0xb0, 0x4b, 0x98, 0x8c, 0x79, 0xdc, 0x2a, 0x0b, 0xe9, 0xba, 0xbb, 0x26, 0x65,
-	0x68, 0x0d, 0xa6, 0x18, 0x79, 0x3b, 0x26, 0x11, 0xb7, 0xb9, 0xd7, 0x21, 0x34, 0xe6, 0xba, 0x6d,
-	0xcf, 0x1d, 0x58, 0xac, 0x2b, 0x7a, 0xf7, 0x6c, 0xe4, 0x7f, 0xf0, 0xa7, 0x79, 0xc3, 0x9a, 0xd4,
-	0xb8, 0x07, 0x0a, 0x86, 0xae, 0x02, 0x6a, 0x62, 0xcf, 0x8f, 0x19, 0xb1, 0x3b, 0xd4, 0x25, 0x36,
-	0xf6, 0x7d, 0xfa, 0x8e, 0xec, 0xdd, 0xa2, 0x35, 0xad, 0x35, 0xf7, 0xa8, 0x4b, 0x96, 0x84, 0x1c,
-	0xdd, 0x81, 0x72, 0x32, 0xee, 0x36, 0x75, 0xbb, 0xba, 0x3d, 0x9f, 0x19, 0xbc, 0xd2, 0xe3, 0x66,
-	0x93, 0xb0, 0x24, 0xe1, 0x56, 0x49, 0x83, 0x1b, 0xd4, 0xed, 0xa2, 0x67, 0x61, 0xc6, 0xf1, 0x09,
-	0x66, 0x36, 0xa3, 0x31, 0x27, 0xb6, 0x83, 0x9d, 0x36, 0x91, 0x4d, 0x5b, 0xb4, 0xa6, 0xa4, 0xc2,
-	0x12, 0xf2, 0x65, 0x21, 0x46, 0x97, 0x61, 0x4a, 0xed, 0xb3, 0x36, 0x0d, 0x6c, 0xc2, 0x18, 0x65,
-	0x72, 0x9d, 0x4f, 0x58, 0x13, 0x4a, 0xbc, 0x11, 0xac, 0x0a, 0x61, 0xf5, 0x87, 0x79, 0x28, 0x65,
-	0x52, 0x8b, 0xe6, 0xa1, 0x14, 0x62, 0xde, 0xb6, 0x43, 0x46, 0x9a, 0xde, 0xae, 0xac, 0xec, 0xb8,
-	0x05, 0x42, 0xb4, 0x29, 0x25, 0xe8, 0x16, 0x8c, 0xe9, 0x39, 0xe9, 0x92, 0x5d, 0x1d, 0xa6, 0x64,
-	0x35, 0x4b, 0x61, 0xac, 0x04, 0x8c, 0xd6, 0xa1, 0xc8, 0x48, 0x14, 0xd2, 0x20, 0x22, 0xba, 0x49,
-	0xaf, 0x0d, 0xe9, 0x48, 0x81, 0xac, 0x14, 0x6e, 0xfe, 0xde, 0x80, 0x31, 0xed, 0x1f, 0x3d, 0x03,
-	0x53, 0xb2, 0x20, 0x24, 0xe9, 0x06, 0x71, 0x4e, 0x8d, 0x5e, 0x19, 0xb7, 0x26, 0xb5, 0x58, 0xf5,
-	0x43, 0x84, 0x5c, 0x98, 0xd4, 0x06, 0x36, 0xa7, 0x36, 0x76, 0xdd, 0x4a, 0x4e, 0x9e, 0x25, 0x2f,
-	0x1f, 0x27, 0x9c, 0x9a, 0xf6, 0xf6, 0x80, 0x2e, 0xb9, 0xee, 0x6a, 0xc0, 0x59, 0xd7, 0x2a, 0xb7,
-	0x33, 0x22, 0xf3, 0x15, 0x98, 0x39, 0x60, 0x82, 0xa6, 0x61, 0xf4, 0x11, 0xe9, 0xea, 0xdc, 0x8a,
-	0x9f, 0x68, 0x16, 0x4e, 0xed, 0x88, 0xc3, 0x41, 0xa6, 0x74, 0xdc, 0x52, 0x0f, 0x8b, 0xb9, 0x1b,
-	0x86, 0xf9, 0x18, 0x8a, 0x49, 0xc4, 0xe8, 0x06, 0x54, 0x92, 0xd8, 0xe2, 0x30, 0xe2, 0x8c, 0xe0,
-	0xce, 0xbe, 0x20, 0xcf, 0x6a, 0xfd, 0x43, 0xad, 0x4e, 0x82, 0x7d, 0x01, 0x12, 0x8d, 0xed, 0xf8,
-	0x1e, 0x09, 0x78, 0x8a, 0xcb, 0x49, 0xdc, 0xac, 0xd6, 0x2e, 0x4b, 0xa5, 0x46, 0x55, 0x43, 0x98,
-	0xec, 0x6d, 0x47, 0xd1, 0x81, 0x1d, 0xbc, 0x6b, 0xa7, 0x1d, 0xdd, 0xe5, 0x44, 0xf1, 0x80, 0x09,
-	0x6b, 0xaa, 0x83, 0x77, 0x75, 0x56, 0x1a, 0x42, 0x8c, 0xea, 0x70, 0x46, 0x7a, 0xb5, 0x43, 0xcc,
-	0xb8, 0x87, 0x7d, 0xbb, 0x43, 0xa2, 0x08, 0xb7, 0x54, 0x8c, 0x45, 0xeb, 0xb4, 0x54, 0x6e, 0x2a,
-	0xdd, 0x3d, 0xa5, 0xaa, 0xfe, 0xd2, 0x00, 0xd8, 0xdb, 0x91, 0x90, 0x07, 0xc8, 0xa1, 0x01, 0x27,
-	0xbb, 0xdc, 0x26, 0xc9, 0xc6, 0xa9, 0x42, 0x2d, 0xd5, 0x17, 0x87, 0xd8, 0xd2, 0xc4, 0x99, 0x2f,
-	0xd0, 0xe9, 0xae, 0x1b, 0xa9, 0x1a, 0xcd, 0x38, 0xfb, 0xe5, 0xe6, 0x0a, 0x9c, 0xed, 0x6f, 0x7c,
-	0x9c, 0x6a, 0x55, 0x7f, 0x66, 0x28, 0xae, 0xa4, 0x4e, 0x29, 0x84, 0x34, 0x2d, 0x50, 0x58, 0x75,
-	0xf0, 0x5f, 0x81, 0x69, 0x7d, 0xe6, 0x35, 0x3d, 0x9f, 0xd8, 0x52, 0xaf, 0xfc, 0x4c, 0x2a, 0xf9,
-	0x2d, 0xcf, 0x27, 0xaf, 0x0b, 0xcb, 0xe7, 0x60, 0x96, 0xec, 0x86, 0x94, 0x71, 0xe2, 0xda, 0x51,
-	0xb7, 0xb3, 0x4d, 0x7d, 0x65, 0xad, 0xb6, 0x37, 0x94, 0xe8, 0xb6, 0xa4, 0x4a, 0x22, 0x16, 0xa0,
-	0xa0, 0x0e, 0x02, 0xbd, 0xb7, 0xcd, 0xf5, 0x23, 0x22, 0xb1, 0xc3, 0x2d, 0x6d, 0x56, 0xfd, 0x47,
-	0x0e, 0xc6, 0x53, 0x6e, 0x21, 0xe2, 0x62, 0x04, 0xfb, 0x1d, 0x3d, 0x5f, 0xf5, 0x80, 0x6e, 0xc0,
-	0x28, 0x0e, 0x99, 0x5e, 0xec, 0x97, 0x8f, 0xa6, 0x28, 0xb5, 0xa5, 0x90, 0x59, 0x02, 0x62, 0xfe,
-	0x28, 0x07, 0xa3, 0x4b, 0x21, 0x43, 0xb7, 0xe1, 0x94, 0xd8, 0x8b, 0x23, 0xbd, 0xc2, 0x9e, 0x1f,
-	0xce, 0x47, 0xed, 0xa1, 0xc0, 0xa8, 0x82, 0x29, 0xbc, 0xb9, 0x05, 0xb3, 0x5b, 0xd8, 0xe7, 0xc4,
-	0x5d, 0xc3, 0x51, 0x9b, 0xb8, 0x9b, 0x38, 0x8a, 0xde, 0xa1, 0xcc, 0x15, 0x79, 0x8e, 0xb0, 0xcf,
-	0x93, 0x3c, 0x8b, 0xdf, 0x62, 0x23, 0x68, 0x4b, 0x2b, 0x3b, 0xd4, 0x66, 0x49, 0x9a, 0xdb, 0x3d,
-	0x60, 0x33, 0x06, 0xd8, 0x1b, 0xa9, 0x4f, 0xb5, 0xef, 0x67, 0xab, 0x5d, 0xaa, 0xdf, 0x1c, 0x72,
-	0xf6, 0xfd, 0x26, 0x9a, 0x6d, 0x95, 0x8f, 0x46, 0xe1, 0x94, 0x64, 0x56, 0x68, 0x1e, 0xc6, 0xf5,
-	0xa2, 0xf4, 0x5c, 0x35, 0xb0, 0x60, 0x6a, 0x56, 0x51, 0x09, 0xd7, 0x5d, 0xb4, 0x2e, 0xf6, 0x7d,
-	0x69, 0x10, 0x11, 0x87, 0x11, 0x3e, 0x14, 0x2b, 0x90, 0x3e, 0xa6, 0x14, 0x6e, 0x4b, 0xc2, 0xc4,
-	0xa9, 0xfb, 0x14, 0x80, 0x17, 0x45, 0x31, 0x61, 0x76, 0xcc, 0x7c, 0xd5, 0x49, 0xd2, 0x70, 0x5c,
-	0x49, 0x1f, 0x32, 0x1f, 0xbd, 0x0b, 0xa6, 0x64, 0x2f, 0x24, 0x70, 0x43, 0xea, 0x05, 0xdc, 0x7e,
-	0x3b, 0x26, 0xac, 0x2b, 0x56, 0x31, 0xee, 0x44, 0x95, 0x31, 0x59, 0xc2, 0x9b, 0x87, 0x93, 0x45,
-	0xc9, 0xd4, 0x56, 0x35, 0xfe, 0xbe, 0x80, 0x6f, 0x4a, 0xb4, 0x4c, 0xb1, 0x1c, 0x6f, 0x0e, 0xf7,
-	0xb7, 0x40, 0xe7, 0x61, 0x0c, 0x87, 0xa1, 0x9c, 0x5d, 0x3e, 0x9d, 0x5d, 0x01, 0x87, 0xa1, 0x98,
-	0xda, 0x33, 0x30, 0xe1, 0x60, 0xdf, 0xdf, 0xc6, 0xce, 0x23, 0x5b, 0x1c, 0x49, 0xf2, 0xd4, 0x55,
-	0x26, 0xe5, 0x44, 0xb1, 0x89, 0x79, 0x1b, 0x99, 0x50, 0x88, 0x1c, 0x1a, 0x92, 0xa8, 0x52, 0x10,
-	0xfb, 0x9b, 0x72, 0xa2, 0x24, 0xe6, 0x1d, 0x78, 0xe2, 0xb0, 0xe9, 0x1d, 0x6b, 0xbd, 0xff, 0xcd,
-	0x80, 0x82, 0xa2, 0xc7, 0xa8, 0x0d, 0x73, 0xd4, 0x73, 0x15, 0x9f, 0xa7, 0xcc, 0x7b, 0x2c, 0x29,
-	0x84, 0xed, 0x50, 0x97, 0x68, 0x7a, 0x54, 0x1b, 0x9c, 0x33, 0xcf, 0x95, 0x7d, 0x93, 0xc2, 0x96,
-	0xa9, 0x4b, 0xd6, 0x46, 0xac, 0x33, 0xb4, 0x9f, 0x42, 0x8c, 0x84, 0x1d, 0x87, 0x44, 0xe2, 0xe0,
-	0x7a, 0x44, 0x02, 0x7b, 0x07, 0xfb, 0x9e, 0x2b, 0xd5, 0xba, 0x29, 0x06, 0x8f, 0xb4, 0x24, 0x71,
-	0x0f, 0x04, 0xec, 0x8d, 0x14, 0x25, 0x46, 0xc2, 0xfd, 0x14, 0x8d, 0x32, 0x80, 0xa4, 0xfc, 0x36,
-	0xef, 0x86, 0xa4, 0xfa, 0xeb, 0x51, 0x38, 0xd3, 0x77, 0xaa, 0xe8, 0xfc, 0x81, 0x0e, 0xce, 0x74,
-	0xef, 0xea, 0x49, 0xba, 0xf7, 0x60, 0xe7, 0x3e, 0x79, 0xb0, 0x73, 0xb3, 0x5d, 0xfb, 0x81, 0x71,
-	0x68, 0xdb, 0xe6, 0x65, 0xdb, 0xde, 0x3d, 0x5e, 0x09, 0x0e, 0x6d, 0xe3, 0xc1, 0x2d, 0x3c, 0xb7,
-	0xd7, 0xc2, 0xb2, 0x3f, 0xd3, 0xf6, 0x7d, 0x7a, 0x7f, 0xfb, 0x16, 0x14, 0x51, 0xed, 0x69, 0xdd,
-	0xb3, 0x69, 0xeb, 0x8e, 0xc9, 0xa3, 0xf9, 0xcb, 0x68, 0xdb, 0x8f, 0x0c, 0x38, 0xd3, 0xb7, 0x15,
-	0xd0, 0x35, 0x98, 0xf1, 0x02, 0xce, 0xa8, 0xa0, 0xfe, 0xb2, 0x81, 0x45, 0x14, 0xd2, 0xe7, 0xda,
-	0x88, 0x35, 0xdd, 0xa3, 0x12, 0x11, 0x3d, 0x05, 0x92, 0x65, 0x7b, 0x41, 0x93, 0xee, 0x2d, 0x59,
-	0xab, 0x94, 0xc8, 0x84, 0xc9, 0x8a, 0x08, 0xda, 0x69, 0x93, 0x94, 0x76, 0x9f, 0x1a, 0x8e, 0x76,
-	0x97, 0x25, 0x4a, 0x93, 0xee, 0xc6, 0x0c, 0x4c, 0xed, 0xb5, 0xb9, 0x6a, 0xc7, 0x3a, 0x94, 0x36,
-	0x44, 0x09, 0x54, 0x8b, 0xc8, 0xe4, 0x66, 0xdb, 0x4c, 0x67, 0xa2, 0x9c, 0xed, 0xa3, 0xea, 0x87,
-	0x79, 0x80, 0xbd, 0xb7, 0x51, 0xf4, 0x0d, 0x98, 0xf4, 0xf1, 0x36, 0xf1, 0xed, 0x88, 0xf8, 0xc4,
-	0xe1, 0x94, 0x69, 0x6e, 0xf1, 0xe2, 0x10, 0xaf, 0xb2, 0xb5, 0xd7, 0x04, 0x72, 0x4b, 0x03, 0x55,
-	0x4b, 0x4c, 0xf8, 0x59, 0x19, 0x5a, 0x83, 0xd3, 0xc9, 0x7b, 0xf2, 0x5e, 0xeb, 0x27, 0xa7, 0xe0,
-	0x21, 0xbd, 0x3f, 0xad, 0x5e, 0x91, 0xd3, 0xde, 0x8f, 0x04, 0x2b, 0x57, 0x84, 0x2d, 0xcb, 0x00,
-	0x40, 0x89, 0xe4, 0xc9, 0x1f, 0xc2, 0x99, 0x84, 0xcd, 0x36, 0x19, 0xed, 0xd8, 0xe9, 0x97, 0x15,
-	0xd5, 0xf8, 0xff, 0x3f, 0x4c, 0x40, 0x9a, 0xf6, 0xdd, 0x62, 0xb4, 0x93, 0x7c, 0x7a, 0x51, 0x61,
-	0x9d, 0x6e, 0x1f, 0xd4, 0x98, 0xaf, 0x02, 0x3a, 0x98, 0x81, 0x63, 0x51, 0xdb, 0x18, 0x2a, 0x83,
-	0x86, 0xec, 0xe3, 0x67, 0xb9, 0xf7, 0x18, 0xbe, 0x36, 0x4c, 0x44, 0x2a, 0x83, 0x77, 0x49, 0x37,
-	0x3b, 0xec, 0x4d, 0x18, 0x4f, 0xe5, 0x7d, 0x19, 0x9a, 0x29, 0xde, 0x4c, 0xde, 0x8e, 0x3d, 0x46,
-	0x5c, 0xcd, 0x55, 0xd3, 0xe7, 0xea, 0x3f, 0x0d, 0x28, 0x2f, 0x65, 0xaa, 0x83, 0xae, 0xc2, 0x74,
-	0x8b, 0x04, 0x84, 0x61, 0x4e, 0x6c, 0x5d, 0x6c, 0xf5, 0x3e, 0x2f, 0xcf, 0x9c, 0xc9, 0x44, 0xa7,
-	0x30, 0x6a, 0x6b, 0x50, 0x46, 0xb9, 0x64, 0x6b, 0x90, 0x0a, 0x13, 0x0a, 0xb2, 0x77, 0xd4, 0x17,
-	0x2d, 0x7d, 0x60, 0x29, 0x09, 0xda, 0xc8, 0x7c, 0x28, 0x53, 0xe5, 0xbc, 0x7e, 0x44, 0xf0, 0x6a,
-	0x6e, 0xb5, 0xde, 0x2a, 0xa6, 0x4e, 0xcc, 0x9b, 0x30, 0x71, 0x54, 0xb6, 0x07, 0xef, 0x1d, 0x0f,
-	0x60, 0x6c, 0x23, 0xfd, 0x6e, 0x32, 0xd6, 0xa1, 0x6e, 0xec, 0x93, 0x84, 0x93, 0x1f, 0xd2, 0xd3,
-	0x89, 0xa5, 0xf0, 0x2c, 0x77, 0xe6, 0xc4, 0xb3, 0x7c, 0xa8, 0x7e, 0x9e, 0x83, 0xfc, 0x6b, 0x2e,
-	0x0e, 0x51, 0x05, 0xc6, 0xb0, 0xeb, 0x32, 0x12, 0x45, 0x7a, 0x3a, 0xc9, 0x23, 0xba, 0xac, 0xde,
-	0xf3, 0x57, 0x82, 0x07, 0xa4, 0x13, 0xfa, 0x98, 0xa7, 0xb4, 0xb9, 0x57, 0x8a, 0x6e, 0xc0, 0x5c,
-	0x87, 0x74, 0xb6, 0x09, 0x8b, 0xda, 0x5e, 0xb8, 0xc4, 0x39, 0xf3, 0xb6, 0x63, 0x2e, 0x19, 0xb5,
-	0x5e, 0x37, 0x83, 0xd4, 0xe8, 0x12, 0x4c, 0xe8, 0xf7, 0xa0, 0xdb, 0x8c, 0xc6, 0xa1, 0x3a, 0x35,
-	0xc6, 0xad, 0x5e, 0x21, 0x7a, 0x15, 0xf2, 0x21, 0xa5, 0xbe, 0xde, 0xc7, 0xae, 0x1e, 0xfa, 0xb5,
-	0x48, 0xbc, 0x80, 0x04, 0x6a, 0xbb, 0xdc, 0xa4, 0xd4, 0xb7, 0x24, 0xd2, 0xfc, 0xc0, 0x80, 0xc9,
-	0x5e, 0x05, 0x7a, 0x11, 0xc6, 0x3a, 0x78, 0x77, 0xcb, 0x7b, 0x9c, 0xb0, 0x85, 0x83, 0xdf, 0x10,
-	0x1f, 0xae, 0x07, 0xfc, 0x7a, 0x5d, 0x7d, 0x43, 0x4c, 0x8c, 0xd1, 0xcb, 0x50, 0xf2, 0x02, 0x4f,
-	0xbc, 0x42, 0x49, 0x6c, 0x6e, 0x08, 0x6c, 0x16, 0x50, 0xfd, 0xc9, 0x1c, 0x4c, 0xe8, 0x4f, 0x53,
-	0xfa, 0x53, 0xe6, 0x02, 0xcc, 0x66, 0x3e, 0x5e, 0x89, 0x1d, 0xcb, 0xce, 0xac, 0x90, 0x19, 0x9c,
-	0x5a, 0x5a, 0xa4, 0x29, 0xb3, 0x76, 0x7b, 0xef, 0x6b, 0x6c, 0x51, 0x76, 0xc1, 0xe0, 0xa5, 0xd9,
-	0x33, 0xd2, 0x17, 0xff, 0x41, 0xf6, 0xb7, 0xa3, 0x50, 0xda, 0xc8, 0x84, 0x72, 0x24, 0xb1, 0xbe,
-	0xb9, 0xff, 0xcc, 0x90, 0x1d, 0xd5, 0x38, 0xfb, 0xd9, 0x27, 0xf3, 0xd3, 0x3e, 0x6d, 0xb5, 0xbc,
-	0xa0, 0xb5, 0x58, 0x65, 0xc4, 0xc5, 0x0e, 0xaf, 0x4a, 0x8e, 0x99, 0x39, 0x4b, 0x86, 0xa1, 0xd2,
-	0xdf, 0x33, 0x86, 0xe0, 0xd2, 0x1b, 0x43, 0xa6, 0x2b, 0x13, 0xd9, 0xff, 0x0a, 0xbf, 0x36, 0x3f,
-	0x1e, 0x85, 0xf3, 0x7d, 0xa9, 0x99, 0xae, 0xf0, 0xa1, 0xc4, 0xf3, 0xa5, 0xfe, 0xd5, 0x9d, 0xed,
-	0x57, 0xdd, 0x7d, 0xb5, 0x3d, 0x82, 0x6c, 0xfe, 0x78, 0x18, 0xb2, 0x69, 0x0f, 0x5b, 0xd7, 0xc1,
-	0xf1, 0xfd, 0xb7, 0x12, 0x50, 0xf3, 0xfd, 0x1c, 0x94, 0xd5, 0x7b, 0x93, 0x2e, 0xe4, 0xbb, 0x47,
-	0xbd, 0x3d, 0x35, 0xfe, 0xfd, 0x6c, 0xfe, 0xc7, 0xbd, 0x51, 0x99, 0x7f, 0x3f, 0x05, 0xd3, 0x7b,
-	0x74, 0x45, 0xa7, 0xe2, 0xdb, 0x06, 0x4c, 0xca, 0x09, 0x24, 0x7c, 0x22, 0x39, 0x5d, 0xd7, 0x87,
-	0x4c, 0xc1, 0x7e, 0x8f, 0x35, 0x39, 0xbe, 0x92, 0xea, 0x2d, 0x62, 0xc0, 0x1a, 0xd8, 0xc9, 0x18,
-	0xee, 0xe7, 0x9c, 0xb9, 0x03, 0x9c, 0xf3, 0xbb, 0x06, 0x9c, 0xeb, 0x21, 0x9d, 0x82, 0xe8, 0xa6,
-	0x4c, 0x45, 0xdd, 0xcc, 0x6d, 0x9d, 0x74, 0xce, 0x19, 0x66, 0x78, 0x97, 0x74, 0x7b, 0x99, 0xcc,
-	0xd9, 0x76, 0x5f, 0xa5, 0xf9, 0xb1, 0x01, 0xa5, 0xcc, 0xb3, 0x20, 0x72, 0x82, 0x1b, 0x64, 0x8e,
-	0xaf, 0xf4, 0x19, 0x75, 0x7a, 0x6e, 0x1f, 0xc5, 0x54, 0xef, 0x9f, 0x74, 0xaa, 0x99, 0x21, 0xbf,
-	0x14, 0xca, 0x65, 0xbe, 0x6f, 0xc0, 0xcc, 0x81, 0x1a, 0xf6, 0xf1, 0xf0, 0xd5, 0x5e, 0x8a, 0xbc,
-	0xfc, 0x05, 0x04, 0x94, 0x9d, 0xc6, 0x3a, 0x9c, 0x3f, 0xa4, 0x2a, 0xc7, 0x8a, 0xe8, 0x43, 0x03,
-	0x26, 0x34, 0x8b, 0xd4, 0x5d, 0xff, 0xd6, 0x7e, 0x2e, 0xb9, 0x34, 0xec, 0x82, 0xcf, 0xba, 0xa9,
-	0xdd, 0x53, 0x3e, 0x54, 0xfa, 0x0f, 0xe7, 0x9c, 0xe6, 0x22, 0x94, 0xb3, 0xe6, 0xc7, 0x0a, 0xe0,
-	0xaf, 0x07, 0xef, 0x7e, 0x4b, 0x43, 0xdf, 0xfd, 0xbe, 0x9e, 0x5c, 0xdb, 0xaa, 0x7b, 0x8f, 0xfa,
-	0xf1, 0x09, 0x40, 0xef, 0x55, 0xee, 0xbd, 0xf4, 0x2a, 0x57, 0xdd, 0x3f, 0x5f, 0x3f, 0x8e, 0xc3,
-	0x7a, 0xba, 0x39, 0x26, 0xd7, 0xbb, 0xbd, 0x57, 0xd3, 0xf9, 0x93, 0x5d, 0x4d, 0xbf, 0xb5, 0xef,
-	0x8e, 0x58, 0xb1, 0xe5, 0xff, 0x3b, 0x61, 0x4b, 0x1e, 0x7e, 0x6f, 0x5c, 0x38, 0xe9, 0xbd, 0xf1,
-	0xfd, 0xcc, 0xbd, 0xb1, 0xba, 0xfc, 0x7d, 0xe1, 0x24, 0x5d, 0xd7, 0xef, 0x2e, 0xb9, 0x78, 0xf2,
-	0xbb, 0xe4, 0x3b, 0xf9, 0xa2, 0x31, 0x9d, 0xbb, 0x93, 0x2f, 0xe6, 0xa6, 0x47, 0xeb, 0x7f, 0xcc,
-	0xc1, 0x9c, 0x1e, 0x7a, 0x25, 0xf9, 0xa7, 0x90, 0xe4, 0xae, 0xee, 0xeb, 0x70, 0x7a, 0x4b, 0x5e,
-	0xf3, 0xf4, 0x32, 0xf8, 0x0b, 0x35, 0xf9, 0xbf, 0x24, 0x35, 0x1c, 0x7a, 0xb5, 0x9d, 0x7a, 0x2d,
-	0x85, 0xe9, 0xdb, 0x19, 0x73, 0x7e, 0xa0, 0x5e, 0xdd, 0x33, 0x55, 0x47, 0xae, 0x18, 0xcf, 0x19,
-	0x88, 0x00, 0x5a, 0x21, 0x3e, 0xc7, 0xbd, 0xce, 0x9f, 0xde, 0x07, 0x16, 0x16, 0x07, 0x46, 0xb8,
-	0x74, 0xb8, 0x51, 0xcf, 0x30, 0xdf, 0x02, 0x74, 0x8b, 0x70, 0xa7, 0xfd, 0x05, 0xc7, 0x70, 0xf9,
-	0xbd, 0xdf, 0xfd, 0xe5, 0xfb, 0xb9, 0x8b, 0xd5, 0xf3, 0x3d, 0xff, 0x4e, 0xb3, 0xa8, 0xef, 0xa1,
-	0xf5, 0xa5, 0xbd, 0xf1, 0x6c, 0xe3, 0xcd, 0x9f, 0x7f, 0x9e, 0x37, 0x7e, 0xfa, 0xe7, 0x0b, 0xc6,
-	0xd7, 0x5e, 0x1f, 0xee, 0xff, 0x92, 0xc2, 0x47, 0xad, 0xa1, 0xfe, 0x37, 0x69, 0xbb, 0x20, 0x77,
-	0x80, 0xeb, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x54, 0x96, 0x91, 0x6f, 0xf0, 0x24, 0x00, 0x00,
+	// 3328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4f, 0x6c, 0x1b, 0x47,
+	0x77, 0xd7, 0x92, 0x14, 0x49, 0x3d, 0x52, 0xff, 0xc6, 0xb2, 0x4d, 0xaf, 0xf3, 0x59, 0xfa, 0x68,
+	0xd7, 0x31, 0x82, 0x98, 0xb2, 0xe5, 0xaf, 0x5f, 0xfd, 0xc9, 0xad, 0x13, 0x51, 0xb2, 0x2d, 0xd9,
+	0xb1, 0x65, 0xaf, 0xec, 0x20, 0x69, 0x5a, 0x2c, 0x46, 0xbb, 0x43, 0x72, 0xab, 0xe5, 0xce, 0x66,
+	0x77, 0x56, 0x11, 0x8d, 0xe4, 0x12, 0xa4, 0x40, 0x7a, 0x29, 0x0a, 0xf4, 0x90, 0xb6, 0xe8, 0xbd,
+	0xb9, 0xf4, 0x5a, 0x14, 0x05, 0xda, 0x43, 0x2e, 0x6d, 0x4f, 0x3d, 0xf4, 0x0f, 0x50, 0xa0, 0x4d,
+	0x81, 0xa0, 0xa7, 0xde, 0x92, 0x22, 0x40, 0x6f, 0x2d, 0xe6, 0xcf, 0x2e, 0x97, 0x14, 0xff, 0x49,
+	0x71, 0x80, 0x02, 0xed, 0x49, 0xdc, 0xf7, 0xde, 0xef, 0xcd, 0x9b, 0x99, 0x37, 0xef, 0xbd, 0x79,
+	0x1a, 0x78, 0xaf, 0xe9, 0xb0, 0x56, 0xb4, 0x5f, 0xb3, 0x68, 0x7b, 0x35, 0xa4, 0x2e, 0xbd, 0xee,
+	0xd0, 0xd5, 0xa6, 0x4b, 0xe9, 0xaa, 0x1f, 0xd0, 0xdf, 0x22, 0x16, 0x0b, 0xe5, 0x17, 0xf6, 0x9d,
+	0xd5, 0xc3, 0x9b, 0xab, 0xc4, 0x63, 0x24, 0xf0, 0x03, 0x27, 0x24, 0xab, 0xd4, 0x67, 0x0e, 0xf5,
+	0xc2, 0x55, 0x72, 0xc4, 0x70, 0xc4, 0x5a, 0x82, 0x2b, 0x7f, 0xd6, 0xfc, 0x80, 0x32, 0x8a, 0xce,
+	0x77, 0x85, 0x6b, 0x5c, 0x47, 0x8d, 0xab, 0xaf, 0x39, 0x54, 0xbf, 0x20, 0xc6, 0x39, 0x70, 0x58,
+	0xac, 0x35, 0x20, 0x0d, 0x89, 0xd1, 0x97, 0x9a, 0xb4, 0x49, 0xc5, 0xcf, 0x55, 0xfe, 0x4b, 0x51,
+	0x11, 0x39, 0x62, 0x92, 0x48, 0x8e, 0x98, 0xa2, 0x5d, 0xea, 0x57, 0xd2, 0x26, 0x0c, 0xdb, 0x98,
+	0x61, 0xc5, 0x7f, 0xad, 0x9f, 0x1f, 0x32, 0xcc, 0xa2, 0x70, 0x18, 0x3a, 0xfe, 0x8e, 0xd1, 0xc4,
+	0x3b, 0xa4, 0x1d, 0xc9, 0x5c, 0x5b, 0xb5, 0x9d, 0xd0, 0xa2, 0x87, 0x24, 0xe8, 0xc4, 0xdc, 0x26,
+	0xa5, 0x4d, 0x97, 0x08, 0x36, 0xf6, 0x3c, 0xca, 0xb0, 0x58, 0x8a, 0x58, 0xb7, 0xe2, 0x8a, 0xaf,
+	0xfd, 0xa8, 0xb1, 0x6a, 0x47, 0x81, 0x10, 0xe8, 0x43, 0x27, 0xfc, 0x90, 0x05, 0x91, 0xc5, 0x86,
+	0xa1, 0x3f, 0x0a, 0xb0, 0xef, 0x93, 0x40, 0x69, 0xaf, 0x7e, 0x51, 0x00, 0xd8, 0x88, 0x58, 0x6b,
+	0x93, 0x7a, 0x0d, 0xa7, 0x89, 0x1e, 0x40, 0x5e, 0x4e, 0xac, 0xa2, 0xad, 0x68, 0xd7, 0x4a, 0x6b,
+	0x4b, 0x35, 0x8b, 0x06, 0x24, 0x5e, 0xea, 0xda, 0x9e, 0xe0, 0xd5, 0x2f, 0xfc, 0xcd, 0xd7, 0xcb,
+	0x53, 0xdf, 0x7d, 0xbd, 0xbc, 0xc8, 0x48, 0xc8, 0x6c, 0xa7, 0xd1, 0x58, 0xaf, 0x3a, 0x4d, 0x8f,
+	0x06, 0xa4, 0x6a, 0x28, 0x38, 0xba, 0x0d, 0xc5, 0x78, 0x05, 0x2b, 0x19, 0xa1, 0xea, 0x5c, 0xaf,
+	0xaa, 0xc7, 0x8a, 0x5b, 0xcf, 0x71, 0x65, 0x46, 0x22, 0x8d, 0xb6, 0xa0, 0x60, 0x09, 0x63, 0xc2,
+	0x4a, 0x76, 0x25, 0x7b, 0xad, 0xb4, 0xf6, 0x46, 0x6d, 0xc8, 0xce, 0xd7, 0xba, 0x86, 0xd7, 0xe4,
+	0x1f, 0x23, 0x86, 0xa2, 0xb7, 0xa0, 0xbc, 0x4f, 0xa9, 0x4b, 0xb0, 0x67, 0x92, 0x23, 0x3f, 0xa8,
+	0x80, 0xb0, 0xe1, 0xb5, 0x9a, 0x5c, 0x8e, 0x5a, 0xbc, 0x1c, 0xb5, 0x3d, 0x16, 0x38, 0x5e, 0xf3,
+	0x5d, 0xec, 0x46, 0xc4, 0x28, 0x29, 0xc4, 0xbd, 0x23, 0x3f, 0xd0, 0xbf, 0xc9, 0x41, 0x5e, 0x2d,
+	0xca, 0x0d, 0xc8, 0x79, 0xb8, 0x4d, 0x2a, 0x33, 0x13, 0xe8, 0x10, 0x92, 0x68, 0x13, 0x60, 0x1f,
+	0x87, 0x8e, 0x65, 0x72, 0xff, 0x55, 0x4b, 0x59, 0x1d, 0x3a, 0x8d, 0x3a, 0x17, 0xe5, 0x73, 0xd9,
+	0x9e, 0x32, 0x66, 0xf6, 0xe3, 0x0f, 0xb4, 0x0e, 0xd3, 0x54, 0xe0, 0xe5, 0xfa, 0x5d, 0x1a, 0x8a,
+	0xdf, 0xe5, 0xe2, 0xf5, 0x4c, 0x45, 0xdb, 0x9e, 0x32, 0x24, 0x04, 0xfd, 0x02, 0xf2, 0xe2, 0xc7,
+	0x5a, 0xa5, 0x28, 0xc0, 0xcb, 0xa3, 0xc1, 0x6b, 0xdb, 0x53, 0x86, 0x02, 0xa0, 0x07, 0x50, 0xc6,
+	0xbe, 0x63, 0x1e, 0x90, 0x8e, 0xb4, 0x3e, 0x27, 0x14, 0x5c, 0x1e, 0xbe, 0x09, 0xbe, 0xf3, 0x88,
+	0x74, 0x94, 0xf9, 0x80, 0x93, 0x2f, 0x74, 0x1f, 0x4a, 0xbe, 0x1b, 0x35, 0x1d, 0x4f, 0xea, 0x99,
+	0x1e, 0xa7, 0x27, 0x62, 0xad, 0xa7, 0x42, 0x9e, 0xeb, 0x91, 0x48, 0xa1, 0xe7, 0xd7, 0xa0, 0x48,
+	0x7d, 0x2c, 0x95, 0xe4, 0x85, 0x92, 0x95, 0xe1, 0xb3, 0xf1, 0xb1, 0xb2, 0xa4, 0x40, 0xe5, 0x4f,
+	0x74, 0x0b, 0x72, 0xae, 0x8d, 0xfd, 0x4a, 0x41, 0x40, 0x7f, 0x32, 0x14, 0xfa, 0x8e, 0x8d, 0xfd,
+	0xed, 0x29, 0x43, 0x08, 0xa3, 0x77, 0x61, 0xd1, 0xc7, 0x61, 0x68, 0xb2, 0x56, 0x40, 0xa3, 0x66,
+	0x4b, 0x0e, 0x2e, 0x7d, 0xe8, 0xda, 0x50, 0x0d, 0x4f, 0x71, 0x18, 0x3e, 0x97, 0x00, 0x65, 0xc4,
+	0xbc, 0xdf, 0x4b, 0xaa, 0xcf, 0x42, 0x89, 0xab, 0x32, 0xa5, 0x9b, 0xae, 0xeb, 0x9f, 0x7e, 0x9b,
+	0xcb, 0x41, 0x06, 0x5b, 0x9f, 0x7e, 0x9b, 0x9b, 0x43, 0xe5, 0x14, 0x2b, 0xac, 0xfe, 0x85, 0x06,
+	0x0b, 0xf7, 0x8e, 0x18, 0x87, 0xdd, 0x3b, 0x62, 0xc4, 0x0b, 0x1d, 0xea, 0x21, 0x1d, 0x0a, 0xb6,
+	0x13, 0xe2, 0x7d, 0x97, 0x08, 0xaf, 0x2a, 0xf2, 0x89, 0x2a, 0x02, 0x5a, 0x07, 0x90, 0x58, 0x33,
+	0x20, 0x0d, 0xe5, 0x34, 0x17, 0x7a, 0x0f, 0x9d, 0x41, 0x42, 0x1a, 0x05, 0x16, 0x31, 0x48, 0x83,
+	0xfb, 0x9a, 0x14, 0x37, 0x48, 0x83, 0xef, 0x95, 0x15, 0x85, 0x8c, 0xb6, 0xe5, 0x4c, 0xb3, 0x63,
+	0xf6, 0x6a, 0x53, 0xc8, 0xc6, 0x7b, 0x6e, 0x25, 0x5f, 0xf5, 0x3c, 0xe4, 0x42, 0x9f, 0x58, 0xd5,
+	0x7f, 0xc9, 0x42, 0x71, 0x8f, 0x30, 0xe6, 0x78, 0xcd, 0x10, 0xed, 0xc0, 0x19, 0x15, 0xca, 0x5f,
+	0x9a, 0x21, 0x09, 0x0e, 0x49, 0x20, 0x2c, 0xd4, 0xc6, 0x58, 0x68, 0x2c, 0xc6, 0xa8, 0x3d, 0x01,
+	0xe2, 0x76, 0x3e, 0x80, 0x72, 0x8b, 0x31, 0x5f, 0xa8, 0x71, 0x2c, 0xa2, 0x66, 0x79, 0x65, 0xa8,
+	0xa1, 0xdb, 0x8c, 0xf9, 0x7b, 0x52, 0xd6, 0x28, 0xb5, 0xba, 0x1f, 0xe8, 0x0a, 0xcc, 0x45, 0x21,
+	0x09, 0x4c, 0xc7, 0x36, 0x5b, 0x04, 0xdb, 0x24, 0x10, 0x73, 0x9e, 0x31, 0xca, 0x9c, 0xba, 0x63,
+	0x6f, 0x0b, 0x1a, 0xda, 0x86, 0xf9, 0x80, 0x7c, 0x18, 0x91, 0x90, 0x99, 0xcc, 0x69, 0x13, 0x1a,
+	0x31, 0x75, 0x1c, 0x2e, 0x1c, 0x0b, 0x02, 0x5b, 0x2a, 0x2a, 0xd7, 0x73, 0x7f, 0xf0, 0x6f, 0xcb,
+	0x9a, 0x31, 0xa7, 0x70, 0xcf, 0x25, 0x0c, 0xbd, 0x09, 0xa8, 0x81, 0x1d, 0x37, 0x0a, 0x88, 0xd9,
+	0xa6, 0x36, 0x31, 0xb1, 0xeb, 0xd2, 0x8f, 0xc4, 0x99, 0x28, 0x1a, 0x0b, 0x8a, 0xf3, 0x98, 0xda,
+	0x64, 0x83, 0xd3, 0xd1, 0x43, 0x28, 0xc7, 0xe3, 0xee, 0x53, 0xbb, 0xa3, 0xdc, 0xfe, 0xf5, 0xe1,
+	0x11, 0x24, 0x6a, 0x34, 0x48, 0x10, 0x2f, 0xb8, 0x51, 0x52, 0xe0, 0x3a, 0xb5, 0x3b, 0xe8, 0x0d,
+	0x58, 0xb4, 0x5c, 0x82, 0x03, 0x33, 0xa0, 0x11, 0x23, 0xa6, 0x85, 0xad, 0x16, 0x11, 0x87, 0xa1,
+	0x68, 0xcc, 0x0b, 0x86, 0xc1, 0xe9, 0x9b, 0x9c, 0x8c, 0xae, 0xc2, 0xbc, 0x8c, 0xdf, 0x26, 0xf5,
+	0x4c, 0x12, 0x04, 0x34, 0x10, 0xf1, 0x63, 0xd6, 0x98, 0x95, 0xe4, 0x5d, 0xef, 0x1e, 0x27, 0x56,
+	0xff, 0x30, 0x07, 0xa5, 0xd4, 0xd2, 0xa2, 0x65, 0x28, 0xf9, 0x98, 0xb5, 0x4c, 0x3f, 0x20, 0x0d,
+	0xe7, 0x48, 0xec, 0xec, 0x8c, 0x01, 0x9c, 0xf4, 0x54, 0x50, 0xd0, 0x7d, 0x28, 0x28, 0x9b, 0xd4,
+	0x96, 0xbd, 0x39, 0xc9, 0x96, 0xd5, 0x0c, 0x89, 0x31, 0x62, 0x30, 0xda, 0x81, 0x62, 0x40, 0x42,
+	0x9f, 0x7a, 0x21, 0x51, 0x4e, 0x7a, 0x7d, 0x42, 0x45, 0x12, 0x64, 0x24, 0x70, 0xfd, 0x9f, 0x35,
+	0x28, 0x28, 0xfd, 0xe8, 0x75, 0x98, 0x17, 0x1b, 0x42, 0x62, 0x6f, 0xe0, 0xf9, 0x2f, 0x7b, 0x6d,
+	0xc6, 0x98, 0x53, 0x64, 0xe9, 0x0f, 0x21, 0xb2, 0x61, 0x4e, 0x09, 0x98, 0x8c, 0x9a, 0xd8, 0xb6,
+	0x2b, 0x19, 0x91, 0xa3, 0xee, 0x9e, 0x64, 0x3a, 0x35, 0xa5, 0xed, 0x39, 0xdd, 0xb0, 0xed, 0x7b,
+	0x1e, 0x0b, 0x3a, 0x46, 0xb9, 0x95, 0x22, 0xe9, 0x6f, 0xc1, 0xe2, 0x31, 0x11, 0xb4, 0x00, 0xd9,
+	0x03, 0xd2, 0x51, 0x6b, 0xcb, 0x7f, 0xa2, 0x25, 0x98, 0x3e, 0xe4, 0x49, 0x47, 0x2c, 0xe9, 0x8c,
+	0x21, 0x3f, 0xd6, 0x33, 0xb7, 0x35, 0xfd, 0x25, 0x14, 0xe3, 0x19, 0xa3, 0xdb, 0x50, 0x89, 0xe7,
+	0x16, 0xf9, 0x21, 0x0b, 0x08, 0x6e, 0xf7, 0x4d, 0xf2, 0x9c, 0xe2, 0xbf, 0x50, 0xec, 0x78, 0xb2,
+	0x3f, 0x83, 0x98, 0x63, 0x5a, 0xae, 0x43, 0x3c, 0x96, 0xe0, 0x32, 0x02, 0xb7, 0xa4, 0xb8, 0x9b,
+	0x82, 0xa9, 0x50, 0x55, 0x1f, 0xe6, 0x7a, 0xdd, 0x91, 0x7b, 0x60, 0x1b, 0x1f, 0x99, 0x89, 0x47,
+	0x77, 0x18, 0x91, 0xf5, 0xc5, 0xac, 0x31, 0xdf, 0xc6, 0x47, 0x6a, 0x55, 0xea, 0x9c, 0x8c, 0xd6,
+	0xe0, 0xac, 0xd0, 0x6a, 0xfa, 0x38, 0x60, 0x0e, 0x76, 0xcd, 0x36, 0x09, 0x43, 0xdc, 0x94, 0x73,
+	0x2c, 0x1a, 0x67, 0x04, 0xf3, 0xa9, 0xe4, 0x3d, 0x96, 0xac, 0xea, 0x5f, 0x6a, 0x00, 0xdd, 0x88,
+	0x84, 0x1c, 0x40, 0x16, 0xf5, 0x18, 0x39, 0x62, 0x26, 0x89, 0x03, 0xa7, 0x9c, 0x6a, 0x69, 0x6d,
+	0x7d, 0x82, 0x90, 0x56, 0xdb, 0x94, 0xe8, 0x24, 0xea, 0x86, 0x72, 0x8f, 0x16, 0xad, 0x7e, 0xba,
+	0xbe, 0x05, 0xe7, 0x06, 0x0b, 0x9f, 0x64, 0xb7, 0xaa, 0x7f, 0xaa, 0x01, 0x74, 0xb3, 0x1f, 0x42,
+	0xaa, 0xdc, 0x90, 0x58, 0xf1, 0x1b, 0x5d, 0x83, 0x05, 0x95, 0x4b, 0x1b, 0x8e, 0x4b, 0x4c, 0xc1,
+	0x97, 0x7a, 0xe6, 0x24, 0xfd, 0xbe, 0xe3, 0x92, 0x27, 0x5c, 0xf2, 0x06, 0x2c, 0x91, 0x23, 0x9f,
+	0x06, 0x8c, 0xd8, 0x66, 0xd8, 0x69, 0xef, 0x53, 0x57, 0x4a, 0xcb, 0xf0, 0x86, 0x62, 0xde, 0x9e,
+	0x60, 0x09, 0xc4, 0x2a, 0xe4, 0x65, 0x22, 0x50, 0xb1, 0xed, 0xfc, 0xa0, 0x02, 0x27, 0xb2, 0x98,
+	0xa1, 0xc4, 0xaa, 0xff, 0x95, 0x81, 0x99, 0xa4, 0x66, 0xe1, 0xf3, 0x0a, 0x08, 0x76, 0xdb, 0xca,
+	0x5e, 0xf9, 0x81, 0x6e, 0x43, 0x16, 0xfb, 0x81, 0x3a, 0xec, 0x57, 0xc7, 0x97, 0x3e, 0xb5, 0x0d,
+	0x3f, 0x30, 0x38, 0x44, 0xff, 0xa3, 0x0c, 0x64, 0x37, 0xfc, 0x00, 0x3d, 0x80, 0xe9, 0x28, 0x8c,
+	0x9d, 0xad, 0xb4, 0x76, 0x73, 0x32, 0x1d, 0xb5, 0x17, 0x1c, 0x23, 0x37, 0x4c, 0xe2, 0xf5, 0x3d,
+	0x58, 0xda, 0xc3, 0x2e, 0x23, 0xf6, 0x36, 0x0e, 0x5b, 0xc4, 0xe6, 0x59, 0xfa, 0x23, 0x1a, 0xd8,
+	0x7c, 0x9d, 0x43, 0xec, 0xb2, 0x78, 0x9d, 0xf9, 0x6f, 0x1e, 0x08, 0x5a, 0x42, 0xca, 0xf4, 0x95,
+	0x58, 0xbc, 0xcc, 0xad, 0x1e, 0xb0, 0x1e, 0x01, 0x74, 0x47, 0x1a, 0xb0, 0xdb, 0xcf, 0xd2, 0xbb,
+	0x5d, 0x5a, 0xbb, 0x33, 0xa1, 0xf5, 0x83, 0x0c, 0x4d, 0xbb, 0xca, 0x57, 0x59, 0x98, 0x16, 0x15,
+	0x1b, 0x5a, 0x86, 0x19, 0x75, 0x28, 0x1d, 0x5b, 0x0e, 0xcc, 0x2b, 0x40, 0xa3, 0x28, 0x89, 0x3b,
+	0x36, 0xda, 0x81, 0x45, 0xf9, 0xdb, 0x0c, 0x89, 0x15, 0x10, 0x36, 0x51, 0x55, 0x20, 0x74, 0xcc,
+	0x4b, 0xdc, 0x9e, 0x80, 0xf1, 0xac, 0xfb, 0x53, 0x00, 0x27, 0x0c, 0x23, 0x12, 0x98, 0x51, 0xe0,
+	0x4a, 0x4f, 0x12, 0x82, 0x33, 0x92, 0xfa, 0x22, 0x70, 0xd1, 0xc7, 0xa0, 0x8b, 0xea, 0x85, 0x78,
+	0xb6, 0x4f, 0x1d, 0x8f, 0x99, 0x1f, 0x46, 0x24, 0xe8, 0xf0, 0x53, 0x8c, 0xdb, 0x61, 0xa5, 0xb0,
+	0x92, 0x1d, 0xb9, 0x08, 0xbb, 0x72, 0x01, 0x78, 0xa9, 0xa3, 0xf0, 0xcf, 0x38, 0xfc, 0xa9, 0x40,
+	0x8b, 0x25, 0x16, 0xe3, 0x9d, 0xc7, 0x83, 0x25, 0xd0, 0x45, 0x28, 0x60, 0xdf, 0x17, 0xd6, 0xe5,
+	0x12, 0xeb, 0xf2, 0xd8, 0xf7, 0xb9, 0x69, 0xaf, 0xc3, 0xac, 0x85, 0x5d, 0x77, 0x1f, 0x5b, 0x07,
+	0x26, 0x4f, 0x49, 0x95, 0xe9, 0x44, 0xa4, 0x1c, 0x33, 0x9e, 0x62, 0xd6, 0x42, 0x3a, 0xe4, 0x43,
+	0x8b, 0xfa, 0x24, 0xac, 0xe4, 0x57, 0xb2, 0x4a, 0x42, 0x51, 0xf4, 0x87, 0xf0, 0xda, 0x28, 0xf3,
+	0x4e, 0x74, 0xde, 0xff, 0x43, 0x83, 0xbc, 0x2c, 0xbb, 0x51, 0x0b, 0xce, 0x53, 0xc7, 0x96, 0xf7,
+	0x04, 0x1a, 0x38, 0x2f, 0x45, 0x09, 0x61, 0x5a, 0xd4, 0x26, 0xaa, 0x3c, 0xaa, 0x0d, 0x5f, 0x33,
+	0xc7, 0xb6, 0x36, 0xd2, 0xb0, 0x4d, 0x6a, 0x93, 0xed, 0x29, 0xe3, 0x2c, 0x1d, 0xc4, 0xe0, 0x23,
+	0x61, 0xcb, 0x22, 0xbc, 0xa6, 0xa5, 0x07, 0xc4, 0x33, 0x0f, 0xb1, 0xeb, 0xd8, 0x82, 0x5d, 0xc9,
+	0x8c, 0x19, 0x69, 0x43, 0xe0, 0x9e, 0x73, 0xd8, 0xbb, 0x09, 0x8a, 0x8f, 0x84, 0x07, 0x31, 0xea,
+	0x65, 0x00, 0x71, 0x95, 0x30, 0x59, 0xc7, 0x27, 0xd5, 0x2f, 0x73, 0x70, 0x76, 0xa0, 0xa9, 0xe8,
+	0xe2, 0x31, 0x0f, 0x4e, 0x79, 0xef, 0xbd, 0xd3, 0x78, 0xef, 0x71, 0xcf, 0xfd, 0xc9, 0x71, 0xcf,
+	0x4d, 0x7b, 0xed, 0xe7, 0xda, 0x48, 0xb7, 0xcd, 0x09, 0xb7, 0x7d, 0x74, 0xb2, 0x2d, 0x18, 0xe9,
+	0xc6, 0xc3, 0x5d, 0xf8, 0x7c, 0xd7, 0x85, 0x85, 0x7f, 0x26, 0xee, 0x7b, 0xb9, 0xdf, 0x7d, 0xf3,
+	0xb2, 0x50, 0xed, 0x71, 0xdd, 0x73, 0x89, 0xeb, 0x16, 0x44, 0x6a, 0x56, 0x5f, 0xbc, 0x30, 0x73,
+	0x69, 0x93, 0x46, 0x4c, 0x42, 0x67, 0x04, 0x14, 0x24, 0x49, 0x00, 0xef, 0x42, 0x21, 0x24, 0x21,
+	0x4f, 0x5c, 0x15, 0x18, 0x53, 0x4b, 0xf3, 0x78, 0xb7, 0x27, 0x65, 0x8d, 0x18, 0xf4, 0x4a, 0xcf,
+	0xc5, 0x5f, 0x4f, 0x43, 0x29, 0x35, 0x08, 0xba, 0x09, 0x67, 0x79, 0x65, 0xcc, 0x6b, 0xd1, 0x06,
+	0x61, 0x56, 0xcb, 0x54, 0x75, 0xb2, 0xbc, 0xfa, 0x18, 0xa2, 0xa0, 0xde, 0xf5, 0xee, 0x73, 0xd6,
+	0x7d, 0xc9, 0x41, 0xef, 0xc3, 0x9c, 0x45, 0xe9, 0x81, 0x43, 0x4c, 0xd5, 0x4f, 0x52, 0x3e, 0xb3,
+	0x36, 0xc9, 0xac, 0x6a, 0x9b, 0x02, 0xba, 0x2b, 0x91, 0xc6, 0xac, 0x95, 0xfe, 0x44, 0x4f, 0x20,
+	0x2f, 0x09, 0xaa, 0xf0, 0xfc, 0xd9, 0x44, 0x2a, 0x77, 0xb8, 0x8c, 0x87, 0x5d, 0xf5, 0xcd, 0xef,
+	0xd9, 0x52, 0x0b, 0xda, 0xe1, 0x79, 0xd3, 0x76, 0x42, 0x95, 0x75, 0x6f, 0x4e, 0xa4, 0xce, 0xe0,
+	0x88, 0xae, 0x2e, 0xa9, 0x41, 0x5f, 0x84, 0xf9, 0xbe, 0x71, 0xf4, 0x7f, 0xd2, 0xa0, 0x9c, 0x16,
+	0x46, 0x6f, 0x41, 0x21, 0x5e, 0x12, 0x19, 0x59, 0x7e, 0x69, 0xe8, 0x80, 0x02, 0x17, 0xaf, 0x42,
+	0x8c, 0xe2, 0x47, 0x89, 0xf7, 0x04, 0x54, 0x89, 0x2f, 0x37, 0x6f, 0xe6, 0x80, 0x74, 0x54, 0x85,
+	0xbf, 0x0c, 0x25, 0xb5, 0xf2, 0xa9, 0x72, 0x03, 0x24, 0x49, 0x94, 0x19, 0xf7, 0x60, 0x41, 0x56,
+	0x76, 0x01, 0x69, 0x04, 0x24, 0x6c, 0x39, 0x5e, 0x5c, 0x70, 0xe8, 0xc7, 0x0a, 0x8e, 0x3a, 0xa5,
+	0xae, 0xec, 0xa7, 0xc8, 0xba, 0xdc, 0x48, 0x20, 0xfa, 0x17, 0x1a, 0xcc, 0xf6, 0xec, 0x13, 0xfa,
+	0x65, 0x28, 0xf0, 0xf2, 0x92, 0x17, 0x89, 0xda, 0x90, 0x0e, 0xcd, 0x8b, 0x1d, 0x8f, 0xdd, 0x5a,
+	0x93, 0x1a, 0xf3, 0x6d, 0x7c, 0xb4, 0xd1, 0x24, 0x7c, 0x3e, 0x1e, 0x15, 0xe1, 0x85, 0xbb, 0x94,
+	0x2c, 0x2f, 0x67, 0x3c, 0xca, 0x83, 0x07, 0xf7, 0xa4, 0x1b, 0x90, 0x13, 0x47, 0x26, 0x3b, 0x49,
+	0xd3, 0x87, 0x4b, 0xd6, 0x67, 0x92, 0xa3, 0x54, 0xdd, 0x55, 0x8b, 0x1f, 0x9b, 0x88, 0x20, 0xd7,
+	0xa2, 0x61, 0x52, 0x6a, 0xf0, 0xdf, 0x68, 0x0e, 0x32, 0xf6, 0xbe, 0x18, 0x77, 0xda, 0xc8, 0xd8,
+	0xfb, 0x3c, 0x1c, 0xfa, 0x94, 0xba, 0x66, 0xe8, 0xbc, 0x94, 0xcb, 0x37, 0x6d, 0x14, 0x39, 0x61,
+	0xcf, 0x79, 0x49, 0xaa, 0x5f, 0x69, 0x70, 0x76, 0x60, 0x18, 0x46, 0xd7, 0x61, 0xd1, 0xf1, 0x58,
+	0x40, 0xf9, 0xb5, 0x9b, 0x13, 0x44, 0x04, 0x11, 0xe3, 0x6c, 0x4f, 0x19, 0x0b, 0x3d, 0x2c, 0x1e,
+	0x4d, 0x7e, 0x0a, 0xe2, 0x86, 0xeb, 0x78, 0x0d, 0xda, 0x4d, 0x97, 0x46, 0x29, 0xa6, 0x71, 0x91,
+	0x2d, 0x1e, 0x70, 0xac, 0x16, 0x49, 0xae, 0xbc, 0xd3, 0x93, 0x5d, 0x79, 0xcb, 0x02, 0xa5, 0x2e,
+	0xbc, 0xf5, 0x45, 0x98, 0xef, 0xa6, 0x18, 0x99, 0x0a, 0xd6, 0xa0, 0xb4, 0xcb, 0xc3, 0x9f, 0x0c,
+	0xcf, 0x22, 0xb0, 0xa5, 0x43, 0xbc, 0x5a, 0x9d, 0x72, 0x3a, 0x86, 0x57, 0xff, 0x36, 0x0f, 0xd0,
+	0xed, 0x30, 0xa1, 0xdf, 0x84, 0x39, 0x17, 0xef, 0x13, 0xd7, 0x0c, 0x89, 0x4b, 0x2c, 0x46, 0x03,
+	0x55, 0xd7, 0xff, 0x7c, 0x82, 0xf6, 0x54, 0xed, 0x1d, 0x8e, 0xdc, 0x53, 0x40, 0x19, 0x8e, 0x67,
+	0xdd, 0x34, 0x0d, 0x6d, 0xc3, 0x99, 0xb8, 0xf7, 0xd5, 0x4d, 0x3b, 0x71, 0x05, 0x3a, 0x22, 0xef,
+	0x2c, 0xc8, 0xb6, 0x57, 0x92, 0x77, 0x44, 0xe0, 0x95, 0x97, 0xa5, 0x9e, 0xe3, 0x20, 0x49, 0xe2,
+	0x38, 0xf8, 0x70, 0x36, 0xbe, 0x49, 0x36, 0x02, 0xda, 0x36, 0x93, 0x6e, 0xa9, 0x4c, 0x3a, 0xbf,
+	0x3a, 0xc9, 0x84, 0xd4, 0x95, 0xeb, 0x7e, 0x40, 0xdb, 0x71, 0x3b, 0x55, 0x4e, 0xeb, 0x4c, 0xeb,
+	0x38, 0x07, 0xfd, 0x8e, 0x06, 0x97, 0xec, 0x8e, 0x87, 0xdb, 0x8e, 0x95, 0x8c, 0xd6, 0x37, 0xf6,
+	0xb4, 0x18, 0x7b, 0x6b, 0x92, 0xb1, 0xb7, 0xa4, 0xa6, 0x58, 0xfb, 0x71, 0x1b, 0x2e, 0xda, 0xc3,
+	0x25, 0xf4, 0xb7, 0x01, 0x1d, 0xdf, 0x8d, 0x13, 0x5d, 0x71, 0x23, 0xa8, 0x0c, 0x9b, 0xfe, 0x00,
+	0x3d, 0x9b, 0xbd, 0xe5, 0xf8, 0xf5, 0x49, 0x66, 0x28, 0x77, 0xf3, 0x11, 0xe9, 0xa4, 0x87, 0xfd,
+	0x04, 0x56, 0xc6, 0xcd, 0xfc, 0xc7, 0x1c, 0xfe, 0x0e, 0xcc, 0x24, 0xf4, 0x81, 0x17, 0x45, 0x9d,
+	0x37, 0x48, 0x3e, 0x8c, 0x9c, 0x80, 0xd8, 0x2a, 0xa6, 0x25, 0xdf, 0xd5, 0xff, 0xd6, 0xa0, 0xbc,
+	0x91, 0x72, 0x54, 0xf4, 0x26, 0x2c, 0x34, 0x89, 0x47, 0x02, 0xcc, 0x88, 0xa9, 0xfc, 0x5e, 0xe6,
+	0x56, 0x51, 0xfa, 0xce, 0xc5, 0x3c, 0x89, 0x91, 0x15, 0x8a, 0x14, 0xca, 0xc4, 0x15, 0x8a, 0x60,
+	0xe8, 0x90, 0x17, 0xc7, 0x48, 0x36, 0xec, 0x55, 0xdd, 0x2c, 0x29, 0x68, 0x17, 0x8a, 0x7d, 0x9e,
+	0x7d, 0x6b, 0xcc, 0xe4, 0xa5, 0x6d, 0xb5, 0x5e, 0x67, 0x4a, 0x94, 0xe8, 0x77, 0x60, 0x76, 0xdc,
+	0x6a, 0x0f, 0xaf, 0x30, 0x9e, 0x43, 0x61, 0x37, 0x69, 0x0b, 0x17, 0xda, 0xd4, 0x8e, 0x5c, 0x12,
+	0xb7, 0x06, 0x46, 0x1c, 0xef, 0x58, 0x92, 0x6b, 0x16, 0x05, 0x62, 0xac, 0x59, 0x7c, 0x54, 0xbf,
+	0xcf, 0x40, 0x8e, 0x77, 0x8f, 0x51, 0x05, 0x0a, 0xd8, 0xb6, 0x03, 0x12, 0x86, 0xca, 0x9c, 0xf8,
+	0x13, 0x5d, 0x95, 0xed, 0xc6, 0x2d, 0xef, 0x39, 0x69, 0xfb, 0x2e, 0x66, 0xc9, 0xed, 0xbd, 0x97,
+	0x8a, 0x6e, 0xc3, 0xf9, 0x36, 0x69, 0xef, 0x93, 0x20, 0x6c, 0x39, 0xfe, 0x06, 0x63, 0x81, 0xb3,
+	0x1f, 0x31, 0xf2, 0xa4, 0x1b, 0x42, 0x86, 0xb1, 0xd1, 0x15, 0x98, 0x55, 0xed, 0x98, 0x07, 0x01,
+	0x8d, 0x7c, 0x59, 0xbc, 0xce, 0x18, 0xbd, 0x44, 0xf4, 0x36, 0xe4, 0x78, 0x4e, 0xa9, 0x4c, 0x8f,
+	0x69, 0xc2, 0xf1, 0xe9, 0xf0, 0x3e, 0x88, 0x27, 0x33, 0xc7, 0x53, 0x4a, 0x5d, 0x43, 0x20, 0xf5,
+	0xcf, 0x35, 0x98, 0xeb, 0x65, 0xa0, 0x9f, 0x8b, 0x04, 0xcc, 0xf3, 0xd4, 0x44, 0x09, 0x38, 0x16,
+	0x46, 0x77, 0xa1, 0xe4, 0x78, 0x0e, 0x73, 0xb0, 0xc8, 0x71, 0x95, 0xcc, 0x04, 0xd8, 0x34, 0xa0,
+	0xfa, 0xad, 0x06, 0xf3, 0x7d, 0x3d, 0x77, 0x74, 0x17, 0x72, 0xcd, 0xc0, 0xb7, 0x2a, 0xda, 0xe4,
+	0xbd, 0xfa, 0x07, 0x81, 0x6f, 0xf1, 0xc6, 0x3f, 0xc7, 0xa5, 0x9a, 0x21, 0x99, 0x89, 0x9a, 0x21,
+	0x83, 0x5a, 0x87, 0xd9, 0x81, 0xad, 0xc3, 0x51, 0x7d, 0xb8, 0xdc, 0xa8, 0x3e, 0x5c, 0x1d, 0xa0,
+	0x28, 0x46, 0xb7, 0xa8, 0x5b, 0x8d, 0x60, 0xbe, 0xcf, 0xf4, 0x11, 0x5e, 0xb7, 0x2d, 0x3a, 0x61,
+	0x6a, 0xab, 0x92, 0x74, 0x9e, 0x19, 0x93, 0xce, 0x8d, 0xc5, 0x2e, 0x48, 0x65, 0xf3, 0xea, 0x3f,
+	0xe8, 0x30, 0xab, 0xfe, 0x19, 0xa1, 0xfe, 0x29, 0xb6, 0x0a, 0x4b, 0xa9, 0x7f, 0x57, 0xf0, 0x3c,
+	0x69, 0xa6, 0x82, 0xd1, 0x22, 0x4e, 0x24, 0x0d, 0xd2, 0x10, 0x0e, 0xfa, 0xa0, 0xfb, 0x7f, 0xbd,
+	0xe2, 0x4a, 0x76, 0x64, 0x14, 0xec, 0x19, 0xe9, 0xd5, 0xff, 0x6b, 0xef, 0xef, 0xb2, 0x50, 0xda,
+	0x4d, 0x4d, 0x65, 0x6c, 0x2b, 0xe5, 0x4e, 0x7f, 0xa5, 0x22, 0x0e, 0x6f, 0xfd, 0xdc, 0x77, 0x5f,
+	0x2f, 0x2f, 0xb8, 0xb4, 0xd9, 0x74, 0xbc, 0xe6, 0x7a, 0x35, 0x20, 0x36, 0xb6, 0x58, 0x55, 0x74,
+	0x15, 0x52, 0x15, 0xcc, 0x24, 0xcd, 0x93, 0xdf, 0xd3, 0x26, 0xe8, 0x9e, 0xec, 0x4e, 0xb8, 0x5c,
+	0xa9, 0x99, 0xfd, 0x5f, 0xe9, 0xa8, 0xe8, 0x7f, 0x96, 0x83, 0x8b, 0x03, 0x2f, 0xe3, 0x6a, 0x87,
+	0x47, 0xb6, 0x1a, 0x7e, 0x31, 0x78, 0x77, 0x97, 0x06, 0xed, 0x6e, 0xdf, 0xde, 0x8e, 0x69, 0x2f,
+	0xfc, 0xf1, 0x24, 0xed, 0x05, 0x73, 0xd2, 0x7d, 0x1d, 0x3e, 0xbf, 0xff, 0x6f, 0x39, 0x9c, 0xd6,
+	0x71, 0x3e, 0xcb, 0x40, 0x59, 0xb6, 0xe2, 0x94, 0xa7, 0x7c, 0x3c, 0xae, 0x21, 0x57, 0xff, 0xe1,
+	0xdb, 0xf5, 0xbf, 0xae, 0x49, 0xa7, 0xff, 0x67, 0x01, 0x16, 0xba, 0xa5, 0xa7, 0x5a, 0x8a, 0xdf,
+	0xd6, 0x60, 0x4e, 0x18, 0x10, 0xd7, 0x86, 0x71, 0xa5, 0xb4, 0x33, 0xe1, 0x12, 0xf4, 0x6b, 0xac,
+	0x89, 0xf1, 0x25, 0x55, 0xc5, 0xa0, 0x21, 0x87, 0xec, 0x30, 0x25, 0xd8, 0x7f, 0x95, 0xca, 0x1c,
+	0xbb, 0x4a, 0xfd, 0xae, 0x06, 0x17, 0x7a, 0xee, 0x52, 0xfc, 0xfe, 0x96, 0x54, 0x9d, 0xf2, 0x11,
+	0xc9, 0xde, 0x69, 0x6d, 0x4e, 0x5d, 0x32, 0x1e, 0x91, 0x4e, 0x6f, 0x55, 0x7a, 0xae, 0x35, 0x90,
+	0x89, 0xfe, 0x44, 0x83, 0xea, 0xe0, 0x9b, 0x56, 0x8f, 0x65, 0xf2, 0xfc, 0x7f, 0x70, 0x5a, 0xcb,
+	0x06, 0xdc, 0x43, 0x8e, 0x59, 0x78, 0xc9, 0x1e, 0x29, 0xa4, 0xff, 0xa3, 0x06, 0xa5, 0xb4, 0xe5,
+	0x3a, 0x14, 0x79, 0x45, 0x9a, 0xca, 0xe4, 0xc9, 0x37, 0x6a, 0xf7, 0x3c, 0xe9, 0xe1, 0xa6, 0x3f,
+	0x3b, 0xad, 0xe9, 0xa9, 0x21, 0x7f, 0x94, 0x42, 0x5f, 0xff, 0x4c, 0x83, 0xc5, 0x63, 0xde, 0x36,
+	0x40, 0xc3, 0xfb, 0xbd, 0x17, 0xb3, 0xcd, 0x57, 0x30, 0xa1, 0xb4, 0x19, 0x3b, 0x70, 0x71, 0x84,
+	0xff, 0x9c, 0x68, 0x46, 0xcf, 0xe0, 0xf2, 0x04, 0x1b, 0x7e, 0x22, 0x95, 0x7f, 0xa5, 0xc1, 0xac,
+	0xba, 0x0e, 0xa9, 0x23, 0xff, 0x41, 0xff, 0xa5, 0x68, 0x63, 0xd2, 0x68, 0x97, 0x56, 0x53, 0x7b,
+	0x2c, 0x75, 0xc8, 0x1d, 0x1d, 0x7d, 0x79, 0xd2, 0xd7, 0xa1, 0x9c, 0x16, 0x3f, 0xd9, 0x04, 0xa6,
+	0x8f, 0xbd, 0xd1, 0x2a, 0x4d, 0xfc, 0x46, 0xeb, 0x49, 0xfc, 0xbc, 0x2a, 0x3b, 0xa6, 0x43, 0x3c,
+	0xb4, 0xbc, 0xea, 0x7d, 0x72, 0xf5, 0x38, 0x79, 0x72, 0x25, 0xdf, 0x89, 0xdd, 0x3a, 0x89, 0xc2,
+	0xb5, 0x24, 0x33, 0x28, 0x25, 0x7d, 0x4f, 0xc8, 0x72, 0xa7, 0x7b, 0x42, 0xf6, 0x41, 0xdf, 0x5b,
+	0x2e, 0x79, 0xed, 0xfb, 0x95, 0x53, 0x7a, 0xf9, 0xe8, 0xf7, 0x5d, 0xf9, 0xd3, 0xbe, 0xef, 0x7a,
+	0x96, 0x7a, 0xdf, 0x55, 0x18, 0xd3, 0x5a, 0x1f, 0xe1, 0x75, 0x83, 0xde, 0x7c, 0x15, 0x7f, 0xf0,
+	0x9b, 0xaf, 0xf2, 0xab, 0x7e, 0xf3, 0xf5, 0x30, 0x57, 0xd4, 0x16, 0x32, 0x0f, 0x73, 0xc5, 0xcc,
+	0x42, 0x76, 0xed, 0x5f, 0x33, 0x70, 0x5e, 0x4d, 0x69, 0x2b, 0x7e, 0x14, 0x1a, 0xbf, 0xa9, 0xf9,
+	0x0d, 0x38, 0xb3, 0x27, 0xae, 0x81, 0xbd, 0xf7, 0x2e, 0xfe, 0x0c, 0xf0, 0x90, 0x76, 0x6a, 0xd8,
+	0x77, 0x6a, 0x87, 0x6b, 0xb5, 0x04, 0xa6, 0x5e, 0x51, 0xe8, 0xcb, 0x43, 0xf9, 0xf2, 0x3d, 0x48,
+	0x75, 0xea, 0x9a, 0x76, 0x43, 0x43, 0x04, 0xd0, 0x16, 0x71, 0x19, 0xee, 0x55, 0x7e, 0xb9, 0x0f,
+	0xcc, 0x25, 0x8e, 0x8d, 0x70, 0x65, 0xb4, 0x50, 0xcf, 0x30, 0x9f, 0x00, 0x12, 0xff, 0x9f, 0x79,
+	0xc5, 0x73, 0xb8, 0xfa, 0xe9, 0xdf, 0xff, 0xfb, 0xef, 0x67, 0x56, 0xaa, 0x17, 0x7b, 0x9e, 0xd3,
+	0xae, 0xab, 0xf7, 0x62, 0xea, 0x71, 0x9d, 0xf6, 0x46, 0xfd, 0xbd, 0x3f, 0xff, 0x3e, 0xa7, 0x7d,
+	0xf9, 0xcd, 0x25, 0xed, 0xd7, 0x9f, 0x4c, 0xf6, 0x2e, 0xd9, 0x3f, 0x68, 0x4e, 0xf4, 0x36, 0x79,
+	0x3f, 0x2f, 0x22, 0xcb, 0xad, 0xff, 0x19, 0x00, 0xaf, 0x08, 0x5b, 0x9a, 0xf0, 0x2c, 0x00, 0x00,
 }
 
 func (this *AuthConfig) Equal(that interface{}) bool {
@@ -2873,6 +3462,30 @@ func (this *AuthConfig_Config_Ldap) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *AuthConfig_Config_PassThroughAuth) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AuthConfig_Config_PassThroughAuth)
+	if !ok {
+		that2, ok := that.(AuthConfig_Config_PassThroughAuth)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.PassThroughAuth.Equal(that1.PassThroughAuth) {
+		return false
+	}
+	return true
+}
 func (this *ExtAuthExtension) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -3521,6 +4134,225 @@ func (this *OidcAuthorizationCode) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.LogoutPath != that1.LogoutPath {
+		return false
+	}
+	if !this.Session.Equal(that1.Session) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *UserSession) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UserSession)
+	if !ok {
+		that2, ok := that.(UserSession)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FailOnFetchFailure != that1.FailOnFetchFailure {
+		return false
+	}
+	if !this.CookieOptions.Equal(that1.CookieOptions) {
+		return false
+	}
+	if that1.Session == nil {
+		if this.Session != nil {
+			return false
+		}
+	} else if this.Session == nil {
+		return false
+	} else if !this.Session.Equal(that1.Session) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *UserSession_Cookie) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UserSession_Cookie)
+	if !ok {
+		that2, ok := that.(UserSession_Cookie)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Cookie.Equal(that1.Cookie) {
+		return false
+	}
+	return true
+}
+func (this *UserSession_Redis) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UserSession_Redis)
+	if !ok {
+		that2, ok := that.(UserSession_Redis)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Redis.Equal(that1.Redis) {
+		return false
+	}
+	return true
+}
+func (this *UserSession_InternalSession) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UserSession_InternalSession)
+	if !ok {
+		that2, ok := that.(UserSession_InternalSession)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *UserSession_RedisSession) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UserSession_RedisSession)
+	if !ok {
+		that2, ok := that.(UserSession_RedisSession)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Options.Equal(that1.Options) {
+		return false
+	}
+	if this.KeyPrefix != that1.KeyPrefix {
+		return false
+	}
+	if this.CookieName != that1.CookieName {
+		return false
+	}
+	if !this.AllowRefreshing.Equal(that1.AllowRefreshing) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *UserSession_CookieOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UserSession_CookieOptions)
+	if !ok {
+		that2, ok := that.(UserSession_CookieOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MaxAge.Equal(that1.MaxAge) {
+		return false
+	}
+	if this.NotSecure != that1.NotSecure {
+		return false
+	}
+	if !this.Path.Equal(that1.Path) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *RedisOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RedisOptions)
+	if !ok {
+		that2, ok := that.(RedisOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Host != that1.Host {
+		return false
+	}
+	if this.Db != that1.Db {
+		return false
+	}
+	if this.PoolSize != that1.PoolSize {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -3668,6 +4500,14 @@ func (this *ApiKeyAuth) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.DynamicMetadataFromMetadata) != len(that1.DynamicMetadataFromMetadata) {
+		return false
+	}
+	for i := range this.DynamicMetadataFromMetadata {
+		if !this.DynamicMetadataFromMetadata[i].Equal(that1.DynamicMetadataFromMetadata[i]) {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -3858,6 +4698,112 @@ func (this *Ldap_ConnectionPool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *PassThroughAuth) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PassThroughAuth)
+	if !ok {
+		that2, ok := that.(PassThroughAuth)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.Protocol == nil {
+		if this.Protocol != nil {
+			return false
+		}
+	} else if this.Protocol == nil {
+		return false
+	} else if !this.Protocol.Equal(that1.Protocol) {
+		return false
+	}
+	if !this.Config.Equal(that1.Config) {
+		return false
+	}
+	if len(this.AllowedHeaders) != len(that1.AllowedHeaders) {
+		return false
+	}
+	for i := range this.AllowedHeaders {
+		if this.AllowedHeaders[i] != that1.AllowedHeaders[i] {
+			return false
+		}
+	}
+	if len(this.AllowedUpstreamHeaders) != len(that1.AllowedUpstreamHeaders) {
+		return false
+	}
+	for i := range this.AllowedUpstreamHeaders {
+		if this.AllowedUpstreamHeaders[i] != that1.AllowedUpstreamHeaders[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *PassThroughAuth_Grpc) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PassThroughAuth_Grpc)
+	if !ok {
+		that2, ok := that.(PassThroughAuth_Grpc)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Grpc.Equal(that1.Grpc) {
+		return false
+	}
+	return true
+}
+func (this *PassThroughGrpc) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PassThroughGrpc)
+	if !ok {
+		that2, ok := that.(PassThroughGrpc)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.ConnectionTimeout.Equal(that1.ConnectionTimeout) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *ExtAuthConfig) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -4001,6 +4947,12 @@ func (this *ExtAuthConfig_OidcAuthorizationCodeConfig) Equal(that interface{}) b
 			return false
 		}
 	}
+	if this.LogoutPath != that1.LogoutPath {
+		return false
+	}
+	if !this.Session.Equal(that1.Session) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -4125,6 +5077,14 @@ func (this *ExtAuthConfig_ApiKeyAuthConfig) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.DynamicMetadataFromKeyMetadata) != len(that1.DynamicMetadataFromKeyMetadata) {
+		return false
+	}
+	for i := range this.DynamicMetadataFromKeyMetadata {
+		if this.DynamicMetadataFromKeyMetadata[i] != that1.DynamicMetadataFromKeyMetadata[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -4404,6 +5364,30 @@ func (this *ExtAuthConfig_Config_Ldap) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ExtAuthConfig_Config_PassThroughAuth) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExtAuthConfig_Config_PassThroughAuth)
+	if !ok {
+		that2, ok := that.(ExtAuthConfig_Config_PassThroughAuth)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.PassThroughAuth.Equal(that1.PassThroughAuth) {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
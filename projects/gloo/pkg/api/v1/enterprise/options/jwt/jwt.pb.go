@@ -26,13 +26,50 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Describes what happens to requests that do not carry a JWT that the providers can verify.
+type Requirement_ValidationPolicy int32
+
+const (
+	// Reject requests without a valid JWT.
+	Requirement_REQUIRE_VALID Requirement_ValidationPolicy = 0
+	// Accept requests without a JWT, but reject requests whose JWT cannot be verified.
+	Requirement_ALLOW_MISSING Requirement_ValidationPolicy = 1
+	// Accept requests without a JWT or whose JWT cannot be verified.
+	// The claims of valid JWTs are still copied to headers.
+	Requirement_ALLOW_MISSING_OR_FAILED Requirement_ValidationPolicy = 2
+)
+
+var Requirement_ValidationPolicy_name = map[int32]string{
+	0: "REQUIRE_VALID",
+	1: "ALLOW_MISSING",
+	2: "ALLOW_MISSING_OR_FAILED",
+}
+
+var Requirement_ValidationPolicy_value = map[string]int32{
+	"REQUIRE_VALID":           0,
+	"ALLOW_MISSING":           1,
+	"ALLOW_MISSING_OR_FAILED": 2,
+}
+
+func (x Requirement_ValidationPolicy) String() string {
+	return proto.EnumName(Requirement_ValidationPolicy_name, int32(x))
+}
+
+func (Requirement_ValidationPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3d83f6c4a43394a0, []int{2, 0}
+}
+
 type VhostExtension struct {
 	// Auth providers can be used instead of the fields above where more than one is required.
 	// if this list is provided the fields above are ignored.
-	Providers            map[string]*Provider `protobuf:"bytes,4,rep,name=providers,proto3" json:"providers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Providers map[string]*Provider `protobuf:"bytes,4,rep,name=providers,proto3" json:"providers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Determines which providers can verify the JWTs of requests to this virtual host, and what happens to requests
+	// without a valid JWT. Routes can override it.
+	// If not set, requests must carry a JWT that any of the providers can verify.
+	Requirement          *Requirement `protobuf:"bytes,5,opt,name=requirement,proto3" json:"requirement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *VhostExtension) Reset()         { *m = VhostExtension{} }
@@ -66,12 +103,21 @@ func (m *VhostExtension) GetProviders() map[string]*Provider {
 	return nil
 }
 
+func (m *VhostExtension) GetRequirement() *Requirement {
+	if m != nil {
+		return m.Requirement
+	}
+	return nil
+}
+
 type RouteExtension struct {
 	// Disable JWT checks on this route.
-	Disable              bool     `protobuf:"varint,1,opt,name=disable,proto3" json:"disable,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Disable bool `protobuf:"varint,1,opt,name=disable,proto3" json:"disable,omitempty"`
+	// Overrides the requirement of the virtual host on this route. Ignored if `disable` is set.
+	Requirement          *Requirement `protobuf:"bytes,2,opt,name=requirement,proto3" json:"requirement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *RouteExtension) Reset()         { *m = RouteExtension{} }
@@ -105,6 +151,63 @@ func (m *RouteExtension) GetDisable() bool {
 	return false
 }
 
+func (m *RouteExtension) GetRequirement() *Requirement {
+	if m != nil {
+		return m.Requirement
+	}
+	return nil
+}
+
+// Describes the JWTs that requests must carry.
+type Requirement struct {
+	// The names of the virtual host providers that can verify the JWT. A JWT that any of them verifies satisfies the
+	// requirement. If empty, all the providers of the virtual host can verify the JWT.
+	Providers []string `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	// Defaults to `REQUIRE_VALID`.
+	ValidationPolicy     Requirement_ValidationPolicy `protobuf:"varint,2,opt,name=validation_policy,json=validationPolicy,proto3,enum=jwt.options.gloo.solo.io.Requirement_ValidationPolicy" json:"validation_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *Requirement) Reset()         { *m = Requirement{} }
+func (m *Requirement) String() string { return proto.CompactTextString(m) }
+func (*Requirement) ProtoMessage()    {}
+func (*Requirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d83f6c4a43394a0, []int{2}
+}
+func (m *Requirement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Requirement.Unmarshal(m, b)
+}
+func (m *Requirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Requirement.Marshal(b, m, deterministic)
+}
+func (m *Requirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Requirement.Merge(m, src)
+}
+func (m *Requirement) XXX_Size() int {
+	return xxx_messageInfo_Requirement.Size(m)
+}
+func (m *Requirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_Requirement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Requirement proto.InternalMessageInfo
+
+func (m *Requirement) GetProviders() []string {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
+}
+
+func (m *Requirement) GetValidationPolicy() Requirement_ValidationPolicy {
+	if m != nil {
+		return m.ValidationPolicy
+	}
+	return Requirement_REQUIRE_VALID
+}
+
 type Provider struct {
 	// The source for the keys to validate JWTs.
 	Jwks *Jwks `protobuf:"bytes,1,opt,name=jwks,proto3" json:"jwks,omitempty"`
@@ -127,7 +230,7 @@ func (m *Provider) Reset()         { *m = Provider{} }
 func (m *Provider) String() string { return proto.CompactTextString(m) }
 func (*Provider) ProtoMessage()    {}
 func (*Provider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d83f6c4a43394a0, []int{2}
+	return fileDescriptor_3d83f6c4a43394a0, []int{3}
 }
 func (m *Provider) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Provider.Unmarshal(m, b)
@@ -203,7 +306,7 @@ func (m *Jwks) Reset()         { *m = Jwks{} }
 func (m *Jwks) String() string { return proto.CompactTextString(m) }
 func (*Jwks) ProtoMessage()    {}
 func (*Jwks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d83f6c4a43394a0, []int{3}
+	return fileDescriptor_3d83f6c4a43394a0, []int{4}
 }
 func (m *Jwks) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Jwks.Unmarshal(m, b)
@@ -285,7 +388,7 @@ func (m *RemoteJwks) Reset()         { *m = RemoteJwks{} }
 func (m *RemoteJwks) String() string { return proto.CompactTextString(m) }
 func (*RemoteJwks) ProtoMessage()    {}
 func (*RemoteJwks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d83f6c4a43394a0, []int{4}
+	return fileDescriptor_3d83f6c4a43394a0, []int{5}
 }
 func (m *RemoteJwks) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoteJwks.Unmarshal(m, b)
@@ -338,7 +441,7 @@ func (m *LocalJwks) Reset()         { *m = LocalJwks{} }
 func (m *LocalJwks) String() string { return proto.CompactTextString(m) }
 func (*LocalJwks) ProtoMessage()    {}
 func (*LocalJwks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d83f6c4a43394a0, []int{5}
+	return fileDescriptor_3d83f6c4a43394a0, []int{6}
 }
 func (m *LocalJwks) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LocalJwks.Unmarshal(m, b)
@@ -380,7 +483,7 @@ func (m *TokenSource) Reset()         { *m = TokenSource{} }
 func (m *TokenSource) String() string { return proto.CompactTextString(m) }
 func (*TokenSource) ProtoMessage()    {}
 func (*TokenSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d83f6c4a43394a0, []int{6}
+	return fileDescriptor_3d83f6c4a43394a0, []int{7}
 }
 func (m *TokenSource) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TokenSource.Unmarshal(m, b)
@@ -429,7 +532,7 @@ func (m *TokenSource_HeaderSource) Reset()         { *m = TokenSource_HeaderSour
 func (m *TokenSource_HeaderSource) String() string { return proto.CompactTextString(m) }
 func (*TokenSource_HeaderSource) ProtoMessage()    {}
 func (*TokenSource_HeaderSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d83f6c4a43394a0, []int{6, 0}
+	return fileDescriptor_3d83f6c4a43394a0, []int{7, 0}
 }
 func (m *TokenSource_HeaderSource) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TokenSource_HeaderSource.Unmarshal(m, b)
@@ -480,7 +583,7 @@ func (m *ClaimToHeader) Reset()         { *m = ClaimToHeader{} }
 func (m *ClaimToHeader) String() string { return proto.CompactTextString(m) }
 func (*ClaimToHeader) ProtoMessage()    {}
 func (*ClaimToHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d83f6c4a43394a0, []int{7}
+	return fileDescriptor_3d83f6c4a43394a0, []int{8}
 }
 func (m *ClaimToHeader) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClaimToHeader.Unmarshal(m, b)
@@ -522,9 +625,11 @@ func (m *ClaimToHeader) GetAppend() bool {
 }
 
 func init() {
+	proto.RegisterEnum("jwt.options.gloo.solo.io.Requirement_ValidationPolicy", Requirement_ValidationPolicy_name, Requirement_ValidationPolicy_value)
 	proto.RegisterType((*VhostExtension)(nil), "jwt.options.gloo.solo.io.VhostExtension")
 	proto.RegisterMapType((map[string]*Provider)(nil), "jwt.options.gloo.solo.io.VhostExtension.ProvidersEntry")
 	proto.RegisterType((*RouteExtension)(nil), "jwt.options.gloo.solo.io.RouteExtension")
+	proto.RegisterType((*Requirement)(nil), "jwt.options.gloo.solo.io.Requirement")
 	proto.RegisterType((*Provider)(nil), "jwt.options.gloo.solo.io.Provider")
 	proto.RegisterType((*Jwks)(nil), "jwt.options.gloo.solo.io.Jwks")
 	proto.RegisterType((*RemoteJwks)(nil), "jwt.options.gloo.solo.io.RemoteJwks")
@@ -539,51 +644,60 @@ func init() {
 }
 
 var fileDescriptor_3d83f6c4a43394a0 = []byte{
-	// 700 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0xbe, 0xce, 0x5f, 0x9b, 0x93, 0x34, 0xf7, 0xde, 0x51, 0x85, 0xdc, 0x88, 0x56, 0xc1, 0x80,
-	0x88, 0x90, 0xb0, 0x45, 0x58, 0x50, 0x01, 0xaa, 0x50, 0xa1, 0xa2, 0x42, 0x45, 0x2a, 0xd3, 0x96,
-	0x05, 0x9b, 0xe0, 0x38, 0x27, 0x89, 0x1b, 0xc7, 0x63, 0x66, 0xc6, 0x6d, 0xfa, 0x0c, 0xbc, 0x03,
-	0x6b, 0xd6, 0xac, 0x58, 0xb1, 0xe7, 0x35, 0x78, 0x07, 0xf6, 0x68, 0xc6, 0x63, 0x92, 0x82, 0x8c,
-	0xba, 0xb0, 0x34, 0xdf, 0x99, 0xf3, 0x7d, 0x67, 0xe6, 0x7c, 0x67, 0x0c, 0xaf, 0xc6, 0xa1, 0x9c,
-	0xa4, 0x03, 0x37, 0x60, 0x33, 0x4f, 0xb0, 0x88, 0xdd, 0x0b, 0x99, 0x37, 0x8e, 0x18, 0xf3, 0x12,
-	0xce, 0x4e, 0x31, 0x90, 0x22, 0x43, 0x7e, 0x12, 0x7a, 0x67, 0xf7, 0x3d, 0x8c, 0x25, 0xf2, 0x84,
-	0x87, 0x02, 0x3d, 0x96, 0xc8, 0x90, 0xc5, 0xc2, 0x3b, 0x3d, 0x97, 0xea, 0x73, 0x13, 0xce, 0x24,
-	0x23, 0xb6, 0x5a, 0x9a, 0x2d, 0x57, 0x31, 0x5d, 0x25, 0xea, 0x86, 0xac, 0xbd, 0xa1, 0xd5, 0xa7,
-	0xa1, 0xcc, 0xb5, 0x38, 0x8e, 0x32, 0x52, 0x7b, 0x7d, 0xcc, 0xc6, 0x4c, 0x2f, 0x3d, 0xb5, 0x32,
-	0x51, 0x82, 0x73, 0x99, 0x05, 0x71, 0x6e, 0xe4, 0xdb, 0x5b, 0x63, 0xc6, 0xc6, 0x11, 0x7a, 0x1a,
-	0x0d, 0xd2, 0x91, 0x37, 0x4c, 0xb9, 0xaf, 0x8a, 0x65, 0xfb, 0xce, 0x37, 0x0b, 0x5a, 0x6f, 0x26,
-	0x4c, 0xc8, 0xbd, 0xb9, 0xc4, 0x58, 0x84, 0x2c, 0x26, 0x27, 0x50, 0x4f, 0x38, 0x3b, 0x0b, 0x87,
-	0xc8, 0x85, 0x5d, 0xe9, 0x94, 0xbb, 0x8d, 0xde, 0x43, 0xb7, 0xe8, 0x94, 0xee, 0x65, 0xb2, 0x7b,
-	0x98, 0x33, 0xf7, 0x62, 0xc9, 0x2f, 0xe8, 0x42, 0xa9, 0xfd, 0x0e, 0x5a, 0x97, 0x37, 0xc9, 0x7f,
-	0x50, 0x9e, 0xe2, 0x85, 0x6d, 0x75, 0xac, 0x6e, 0x9d, 0xaa, 0x25, 0xd9, 0x86, 0xea, 0x99, 0x1f,
-	0xa5, 0x68, 0x97, 0x3a, 0x56, 0xb7, 0xd1, 0x73, 0x8a, 0xcb, 0xe6, 0x52, 0x34, 0x23, 0x3c, 0x2a,
-	0x6d, 0x5b, 0xce, 0x5d, 0x68, 0x51, 0x96, 0x4a, 0x5c, 0x5c, 0xc5, 0x86, 0x95, 0x61, 0x28, 0xfc,
-	0x41, 0x84, 0xba, 0xca, 0x2a, 0xcd, 0xa1, 0xf3, 0xb9, 0x04, 0xab, 0xb9, 0x06, 0xe9, 0x41, 0xe5,
-	0xf4, 0x7c, 0x2a, 0x74, 0x4e, 0xa3, 0xb7, 0x55, 0x5c, 0xf5, 0xe5, 0xf9, 0x54, 0x50, 0x9d, 0x4b,
-	0xae, 0x43, 0xdd, 0x4f, 0x87, 0x21, 0xc6, 0x01, 0x0a, 0xbb, 0xd4, 0x29, 0x77, 0xeb, 0x74, 0x11,
-	0x20, 0xd7, 0xa0, 0x16, 0x0a, 0x91, 0x22, 0xb7, 0xcb, 0xfa, 0x76, 0x06, 0x91, 0x7d, 0x68, 0x4a,
-	0x36, 0xc5, 0xb8, 0x2f, 0x58, 0xca, 0x03, 0xb4, 0x2b, 0xba, 0xe2, 0xed, 0xe2, 0x8a, 0xc7, 0x2a,
-	0xfb, 0x48, 0x27, 0xd3, 0x86, 0x5c, 0x00, 0xb2, 0x09, 0x30, 0x45, 0x4c, 0xfa, 0x3a, 0x66, 0x57,
-	0xf5, 0xed, 0xea, 0x2a, 0xa2, 0x19, 0xe4, 0x08, 0xfe, 0x0f, 0x22, 0x3f, 0x9c, 0x89, 0xbe, 0x64,
-	0xfd, 0x09, 0xfa, 0xda, 0xcc, 0x9a, 0x36, 0xf3, 0x4e, 0x71, 0xb5, 0x67, 0x8a, 0x72, 0xcc, 0xf6,
-	0x75, 0x3e, 0xfd, 0x37, 0x53, 0xc8, 0xb1, 0x70, 0x3e, 0x58, 0x50, 0x51, 0x2d, 0x20, 0x3b, 0x50,
-	0xe3, 0x38, 0x63, 0x12, 0x4d, 0xcb, 0x6e, 0x15, 0x4b, 0x52, 0x9d, 0xa7, 0x58, 0xfb, 0xff, 0x50,
-	0xc3, 0x22, 0x8f, 0xa1, 0x1a, 0xb1, 0xc0, 0x8f, 0x8c, 0xcf, 0x37, 0x8b, 0xe9, 0x07, 0x2a, 0xcd,
-	0xb0, 0x33, 0xce, 0x6e, 0x2d, 0x73, 0xcb, 0xf9, 0x68, 0x01, 0x2c, 0xd4, 0xd5, 0x34, 0xa5, 0x3c,
-	0xca, 0xa7, 0x29, 0xe5, 0x11, 0x79, 0x02, 0xcd, 0x34, 0x11, 0x92, 0xa3, 0x3f, 0xeb, 0x73, 0x1c,
-	0x99, 0x62, 0x1b, 0x6e, 0xc0, 0x38, 0x2e, 0x9d, 0x2f, 0xb3, 0x82, 0xe2, 0x88, 0x36, 0xf2, 0x74,
-	0x8a, 0x23, 0xf2, 0x14, 0x5a, 0x81, 0x1f, 0x4c, 0xb0, 0x9f, 0xbf, 0x18, 0x63, 0xd6, 0x86, 0x9b,
-	0x3d, 0x29, 0x37, 0x7f, 0x52, 0xee, 0x73, 0x93, 0x40, 0xd7, 0x34, 0x21, 0x87, 0xce, 0x26, 0xd4,
-	0x7f, 0x1d, 0xff, 0xcf, 0x61, 0x77, 0xbe, 0x5a, 0xd0, 0x58, 0xb2, 0x97, 0x1c, 0xc0, 0x4a, 0x6e,
-	0x94, 0xa5, 0x8d, 0xea, 0x5d, 0x69, 0x2c, 0xdc, 0xcc, 0x1d, 0x33, 0x23, 0xb9, 0x04, 0xb9, 0x01,
-	0xcd, 0xf7, 0x29, 0xf2, 0x8b, 0x7e, 0xe2, 0x73, 0x7f, 0x96, 0x8f, 0x68, 0x43, 0xc7, 0x0e, 0x75,
-	0xa8, 0xbd, 0x03, 0xcd, 0x65, 0xae, 0x1a, 0xda, 0x8c, 0x6d, 0x4e, 0x69, 0x90, 0x8a, 0x27, 0x1c,
-	0x47, 0xe1, 0x5c, 0x77, 0xb0, 0x4e, 0x0d, 0x72, 0x4e, 0x60, 0xed, 0xd2, 0xc0, 0x90, 0x75, 0xa8,
-	0xea, 0x91, 0x31, 0xfc, 0x0c, 0x2c, 0xc9, 0x96, 0x7e, 0x97, 0xf5, 0x93, 0x04, 0xe3, 0xa1, 0x6e,
-	0xec, 0x2a, 0x35, 0x68, 0xf7, 0xf5, 0x97, 0x1f, 0x15, 0xeb, 0xd3, 0xf7, 0x2d, 0xeb, 0xed, 0x8b,
-	0xab, 0xfd, 0x6a, 0x93, 0xe9, 0xf8, 0xef, 0xbf, 0xdb, 0x41, 0x4d, 0x7b, 0xf5, 0xe0, 0x67, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xce, 0x87, 0x5a, 0xbb, 0xbc, 0x05, 0x00, 0x00,
+	// 833 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x5e, 0xa7, 0x69, 0xb6, 0x79, 0x6e, 0x43, 0x3a, 0x5a, 0x81, 0x1b, 0xd8, 0xaa, 0x18, 0x10,
+	0xbd, 0x60, 0x8b, 0x20, 0xc1, 0x0a, 0xd0, 0x8a, 0x2e, 0x0d, 0x6d, 0x50, 0x96, 0xed, 0x4e, 0xb7,
+	0x45, 0xe2, 0x62, 0x5c, 0xe7, 0x25, 0x9d, 0xc6, 0xf1, 0x78, 0x67, 0xc6, 0x69, 0xfb, 0x1b, 0xb8,
+	0xf1, 0x03, 0x38, 0x73, 0xe6, 0xc4, 0x89, 0x1f, 0xc3, 0x85, 0x5f, 0xc0, 0x1d, 0xcd, 0xd8, 0x6e,
+	0xdc, 0xa0, 0x94, 0x8a, 0x43, 0xa4, 0xf9, 0xde, 0xbc, 0xef, 0x7b, 0x93, 0xf7, 0xbd, 0x19, 0xc3,
+	0xf3, 0x31, 0x53, 0xe7, 0xd9, 0x99, 0x17, 0xf1, 0xa9, 0x2f, 0x79, 0xcc, 0x3f, 0x62, 0xdc, 0x1f,
+	0xc7, 0x9c, 0xfb, 0xa9, 0xe0, 0x17, 0x18, 0x29, 0x99, 0xa3, 0x30, 0x65, 0xfe, 0xec, 0x63, 0x1f,
+	0x13, 0x85, 0x22, 0x15, 0x4c, 0xa2, 0xcf, 0x53, 0xc5, 0x78, 0x22, 0xfd, 0x8b, 0x4b, 0xa5, 0x7f,
+	0x5e, 0x2a, 0xb8, 0xe2, 0xc4, 0xd1, 0xcb, 0x62, 0xcb, 0xd3, 0x4c, 0x4f, 0x8b, 0x7a, 0x8c, 0x77,
+	0xb6, 0x8c, 0xfa, 0x84, 0xa9, 0x52, 0x4b, 0xe0, 0x28, 0x27, 0x75, 0x1e, 0x8d, 0xf9, 0x98, 0x9b,
+	0xa5, 0xaf, 0x57, 0x45, 0x94, 0xe0, 0x95, 0xca, 0x83, 0x78, 0x55, 0xc8, 0x77, 0xb6, 0xc7, 0x9c,
+	0x8f, 0x63, 0xf4, 0x0d, 0x3a, 0xcb, 0x46, 0xfe, 0x30, 0x13, 0xa1, 0x2e, 0x96, 0xef, 0xbb, 0x3f,
+	0xd7, 0xa0, 0x75, 0x7a, 0xce, 0xa5, 0xea, 0x5d, 0x29, 0x4c, 0x24, 0xe3, 0x09, 0x39, 0x81, 0x66,
+	0x2a, 0xf8, 0x8c, 0x0d, 0x51, 0x48, 0xa7, 0xbe, 0xb3, 0xb2, 0x6b, 0x77, 0x3f, 0xf3, 0x96, 0x9d,
+	0xd2, 0xbb, 0x4d, 0xf6, 0x8e, 0x4a, 0x66, 0x2f, 0x51, 0xe2, 0x9a, 0xce, 0x95, 0xc8, 0x01, 0xd8,
+	0x02, 0x5f, 0x67, 0x4c, 0xe0, 0x14, 0x13, 0xe5, 0xac, 0xee, 0x58, 0xbb, 0x76, 0xf7, 0x83, 0xe5,
+	0xc2, 0x74, 0x9e, 0x4c, 0xab, 0xcc, 0xce, 0x8f, 0xd0, 0xba, 0x5d, 0x85, 0xb4, 0x61, 0x65, 0x82,
+	0xd7, 0x8e, 0xb5, 0x63, 0xed, 0x36, 0xa9, 0x5e, 0x92, 0x27, 0xb0, 0x3a, 0x0b, 0xe3, 0x0c, 0x9d,
+	0x9a, 0x29, 0xe3, 0x2e, 0x2f, 0x53, 0x4a, 0xd1, 0x9c, 0xf0, 0x79, 0xed, 0x89, 0xe5, 0x4a, 0x68,
+	0x51, 0x9e, 0x29, 0x9c, 0xf7, 0xc4, 0x81, 0x87, 0x43, 0x26, 0xc3, 0xb3, 0x18, 0x4d, 0x95, 0x35,
+	0x5a, 0xc2, 0xc5, 0xbf, 0x55, 0xfb, 0xbf, 0x7f, 0xcb, 0xfd, 0xcb, 0x02, 0xbb, 0xb2, 0x49, 0xde,
+	0xa9, 0xda, 0x60, 0xed, 0xac, 0xec, 0x36, 0xab, 0xdd, 0x8c, 0x60, 0x73, 0x16, 0xc6, 0x6c, 0x68,
+	0xbc, 0x0c, 0x52, 0x1e, 0xb3, 0xe8, 0xda, 0x14, 0x6f, 0x75, 0x3f, 0xbd, 0x57, 0x71, 0xef, 0xf4,
+	0x86, 0x7e, 0x64, 0xd8, 0xb4, 0x3d, 0x5b, 0x88, 0xb8, 0x27, 0xd0, 0x5e, 0xcc, 0x22, 0x9b, 0xb0,
+	0x41, 0x7b, 0x2f, 0x4f, 0xfa, 0xb4, 0x17, 0x9c, 0xee, 0x0d, 0xfa, 0xfb, 0xed, 0x07, 0x3a, 0xb4,
+	0x37, 0x18, 0xbc, 0xf8, 0x3e, 0x78, 0xde, 0x3f, 0x3e, 0xee, 0x7f, 0x77, 0xd0, 0xb6, 0xc8, 0xdb,
+	0xf0, 0xd6, 0xad, 0x50, 0xf0, 0x82, 0x06, 0xdf, 0xec, 0xf5, 0x07, 0xbd, 0xfd, 0x76, 0xcd, 0xfd,
+	0xad, 0x06, 0x6b, 0x65, 0xdb, 0x49, 0x17, 0xea, 0x17, 0x97, 0x13, 0x69, 0xda, 0x6a, 0x77, 0xb7,
+	0x97, 0x9f, 0xfd, 0xdb, 0xcb, 0x89, 0xa4, 0x26, 0x57, 0xb7, 0x26, 0xcc, 0x86, 0x0c, 0x93, 0x08,
+	0xa5, 0x53, 0xcb, 0x5b, 0x73, 0x13, 0x20, 0x6f, 0x42, 0x83, 0x49, 0x99, 0xa1, 0x70, 0x56, 0xcc,
+	0x40, 0x14, 0x88, 0x1c, 0xc2, 0xba, 0xe2, 0x13, 0x4c, 0x02, 0xc9, 0x33, 0x11, 0xa1, 0x53, 0xff,
+	0x2f, 0xab, 0x5e, 0xe9, 0xec, 0x63, 0x93, 0x4c, 0x6d, 0x35, 0x07, 0xe4, 0x31, 0xc0, 0x04, 0x31,
+	0x0d, 0x4c, 0xcc, 0x4c, 0xf2, 0x1a, 0x6d, 0xea, 0x88, 0x61, 0x90, 0x63, 0xd8, 0x8c, 0xe2, 0x90,
+	0x4d, 0x65, 0xa0, 0x78, 0x70, 0x8e, 0xa1, 0x71, 0xb0, 0x61, 0x2e, 0xd2, 0x87, 0xcb, 0xab, 0x7d,
+	0xad, 0x29, 0xaf, 0xf8, 0xa1, 0xc9, 0xa7, 0x6f, 0xe4, 0x0a, 0x25, 0x96, 0xee, 0x4f, 0x16, 0xd4,
+	0x75, 0x0b, 0xc8, 0x53, 0x68, 0x08, 0x9c, 0x72, 0x85, 0x45, 0xcb, 0xde, 0xbf, 0xcb, 0x6e, 0x9d,
+	0xa7, 0x59, 0x87, 0x0f, 0x68, 0xc1, 0x22, 0x5f, 0xc0, 0x6a, 0xcc, 0xa3, 0x30, 0x2e, 0x46, 0xf5,
+	0xbd, 0xe5, 0xf4, 0x81, 0x4e, 0x2b, 0xd8, 0x39, 0xe7, 0x59, 0x23, 0x77, 0xcb, 0xfd, 0xc5, 0x02,
+	0x98, 0xab, 0xeb, 0x0b, 0x98, 0x89, 0xb8, 0xbc, 0x80, 0x99, 0x88, 0xc9, 0x97, 0xb0, 0x9e, 0xa5,
+	0x52, 0x09, 0x0c, 0xa7, 0x81, 0xc0, 0x51, 0x51, 0x6c, 0xcb, 0x8b, 0xb8, 0xc0, 0xca, 0xf9, 0x72,
+	0x2b, 0x28, 0x8e, 0xa8, 0x5d, 0xa6, 0x53, 0x1c, 0x91, 0xaf, 0xa0, 0x15, 0x85, 0xd1, 0x39, 0x06,
+	0xe5, 0x6b, 0x55, 0x98, 0xb5, 0xe5, 0xe5, 0xcf, 0x99, 0x57, 0x3e, 0x67, 0xde, 0x7e, 0x91, 0x40,
+	0x37, 0x0c, 0xa1, 0x84, 0xee, 0x63, 0x68, 0xde, 0x1c, 0xff, 0xdf, 0xef, 0x83, 0xfb, 0x87, 0x05,
+	0x76, 0xc5, 0x5e, 0x32, 0x80, 0x87, 0xa5, 0x51, 0x96, 0x31, 0xaa, 0x7b, 0xaf, 0xb1, 0xf0, 0x72,
+	0x77, 0x72, 0x40, 0x4b, 0x09, 0xf2, 0x2e, 0xac, 0xbf, 0xce, 0x50, 0x5c, 0x07, 0x69, 0x28, 0xc2,
+	0x69, 0x39, 0xa2, 0xb6, 0x89, 0x1d, 0x99, 0x50, 0xe7, 0x29, 0xac, 0x57, 0xb9, 0x7a, 0x68, 0x73,
+	0x76, 0x71, 0xca, 0x02, 0xe9, 0x78, 0x2a, 0x70, 0xc4, 0xae, 0x4c, 0x07, 0x9b, 0xb4, 0x40, 0xee,
+	0x09, 0x6c, 0xdc, 0x1a, 0x18, 0xf2, 0x08, 0x56, 0xcd, 0xc8, 0x14, 0xfc, 0x1c, 0x54, 0x64, 0x6b,
+	0x8b, 0xb2, 0x61, 0x9a, 0x62, 0x32, 0x34, 0x8d, 0x5d, 0xa3, 0x05, 0x7a, 0xf6, 0xf2, 0xf7, 0xbf,
+	0xeb, 0xd6, 0xaf, 0x7f, 0x6e, 0x5b, 0x3f, 0x1c, 0xdc, 0xef, 0x33, 0x97, 0x4e, 0xc6, 0x77, 0x7f,
+	0xea, 0xce, 0x1a, 0xc6, 0xab, 0x4f, 0xfe, 0x19, 0x00, 0xae, 0x6b, 0x79, 0xa9, 0x38, 0x07, 0x00,
+	0x00,
 }
 
 func (this *VhostExtension) Equal(that interface{}) bool {
@@ -613,6 +727,9 @@ func (this *VhostExtension) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.Requirement.Equal(that1.Requirement) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -640,6 +757,44 @@ func (this *RouteExtension) Equal(that interface{}) bool {
 	if this.Disable != that1.Disable {
 		return false
 	}
+	if !this.Requirement.Equal(that1.Requirement) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Requirement) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Requirement)
+	if !ok {
+		that2, ok := that.(Requirement)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Providers) != len(that1.Providers) {
+		return false
+	}
+	for i := range this.Providers {
+		if this.Providers[i] != that1.Providers[i] {
+			return false
+		}
+	}
+	if this.ValidationPolicy != that1.ValidationPolicy {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -288,10 +288,13 @@ func (m *RateLimitConfigRef) GetNamespace() string {
 type RateLimitVhostExtension struct {
 	// Define individual rate limits here. Each rate limit will be evaluated, if any rate limit
 	// would be throttled, the entire request returns a 429 (gets throttled)
-	RateLimits           []*v1alpha1.RateLimitActions `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
-	XXX_unrecognized     []byte                       `json:"-"`
-	XXX_sizecache        int32                        `json:"-"`
+	RateLimits []*v1alpha1.RateLimitActions `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
+	// Rate limits whose actions can generate descriptor entries from the dynamic metadata of the requests as well.
+	// They are evaluated together with `rate_limits`.
+	RateLimitsWithMetadata []*RateLimitActionsWithMetadata `protobuf:"bytes,2,rep,name=rate_limits_with_metadata,json=rateLimitsWithMetadata,proto3" json:"rate_limits_with_metadata,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                        `json:"-"`
+	XXX_unrecognized       []byte                          `json:"-"`
+	XXX_sizecache          int32                           `json:"-"`
 }
 
 func (m *RateLimitVhostExtension) Reset()         { *m = RateLimitVhostExtension{} }
@@ -325,6 +328,13 @@ func (m *RateLimitVhostExtension) GetRateLimits() []*v1alpha1.RateLimitActions {
 	return nil
 }
 
+func (m *RateLimitVhostExtension) GetRateLimitsWithMetadata() []*RateLimitActionsWithMetadata {
+	if m != nil {
+		return m.RateLimitsWithMetadata
+	}
+	return nil
+}
+
 // Use this field if you want to inline the Envoy rate limits for this Route.
 // Note that this does not configure the rate limit server. If you are running Gloo Enterprise, you need to
 // specify the server configuration via the appropriate field in the Gloo `Settings` resource. If you are
@@ -334,10 +344,17 @@ type RateLimitRouteExtension struct {
 	IncludeVhRateLimits bool `protobuf:"varint,1,opt,name=include_vh_rate_limits,json=includeVhRateLimits,proto3" json:"include_vh_rate_limits,omitempty"`
 	// Define individual rate limits here. Each rate limit will be evaluated, if any rate limit
 	// would be throttled, the entire request returns a 429 (gets throttled)
-	RateLimits           []*v1alpha1.RateLimitActions `protobuf:"bytes,2,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
-	XXX_unrecognized     []byte                       `json:"-"`
-	XXX_sizecache        int32                        `json:"-"`
+	RateLimits []*v1alpha1.RateLimitActions `protobuf:"bytes,2,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
+	// Opt this Route out of the rate limits defined on the VirtualHost. When `rate_limits` are defined, they already
+	// replace the rate limits of the VirtualHost unless `include_vh_rate_limits` is set, so this is only needed for
+	// Routes which should not be rate limited at all. Can't be set together with `include_vh_rate_limits`.
+	DisableVhRateLimits bool `protobuf:"varint,3,opt,name=disable_vh_rate_limits,json=disableVhRateLimits,proto3" json:"disable_vh_rate_limits,omitempty"`
+	// Rate limits whose actions can generate descriptor entries from the dynamic metadata of the requests as well.
+	// They are evaluated together with `rate_limits`.
+	RateLimitsWithMetadata []*RateLimitActionsWithMetadata `protobuf:"bytes,4,rep,name=rate_limits_with_metadata,json=rateLimitsWithMetadata,proto3" json:"rate_limits_with_metadata,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                        `json:"-"`
+	XXX_unrecognized       []byte                          `json:"-"`
+	XXX_sizecache          int32                           `json:"-"`
 }
 
 func (m *RateLimitRouteExtension) Reset()         { *m = RateLimitRouteExtension{} }
@@ -378,6 +395,206 @@ func (m *RateLimitRouteExtension) GetRateLimits() []*v1alpha1.RateLimitActions {
 	return nil
 }
 
+func (m *RateLimitRouteExtension) GetDisableVhRateLimits() bool {
+	if m != nil {
+		return m.DisableVhRateLimits
+	}
+	return false
+}
+
+func (m *RateLimitRouteExtension) GetRateLimitsWithMetadata() []*RateLimitActionsWithMetadata {
+	if m != nil {
+		return m.RateLimitsWithMetadata
+	}
+	return nil
+}
+
+// The actions of a rate limit, which compose its descriptor in order.
+// If an action cannot append a descriptor entry, no descriptor is generated for the rate limit.
+type RateLimitActionsWithMetadata struct {
+	Actions              []*ActionWithMetadata `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RateLimitActionsWithMetadata) Reset()         { *m = RateLimitActionsWithMetadata{} }
+func (m *RateLimitActionsWithMetadata) String() string { return proto.CompactTextString(m) }
+func (*RateLimitActionsWithMetadata) ProtoMessage()    {}
+func (*RateLimitActionsWithMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05a2dad65f2418a, []int{7}
+}
+func (m *RateLimitActionsWithMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RateLimitActionsWithMetadata.Unmarshal(m, b)
+}
+func (m *RateLimitActionsWithMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RateLimitActionsWithMetadata.Marshal(b, m, deterministic)
+}
+func (m *RateLimitActionsWithMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitActionsWithMetadata.Merge(m, src)
+}
+func (m *RateLimitActionsWithMetadata) XXX_Size() int {
+	return xxx_messageInfo_RateLimitActionsWithMetadata.Size(m)
+}
+func (m *RateLimitActionsWithMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitActionsWithMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitActionsWithMetadata proto.InternalMessageInfo
+
+func (m *RateLimitActionsWithMetadata) GetActions() []*ActionWithMetadata {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+type ActionWithMetadata struct {
+	// Types that are valid to be assigned to ActionSpecifier:
+	//	*ActionWithMetadata_Action
+	//	*ActionWithMetadata_DynamicMetadata_
+	ActionSpecifier      isActionWithMetadata_ActionSpecifier `protobuf_oneof:"action_specifier"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
+}
+
+func (m *ActionWithMetadata) Reset()         { *m = ActionWithMetadata{} }
+func (m *ActionWithMetadata) String() string { return proto.CompactTextString(m) }
+func (*ActionWithMetadata) ProtoMessage()    {}
+func (*ActionWithMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05a2dad65f2418a, []int{8}
+}
+func (m *ActionWithMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionWithMetadata.Unmarshal(m, b)
+}
+func (m *ActionWithMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionWithMetadata.Marshal(b, m, deterministic)
+}
+func (m *ActionWithMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionWithMetadata.Merge(m, src)
+}
+func (m *ActionWithMetadata) XXX_Size() int {
+	return xxx_messageInfo_ActionWithMetadata.Size(m)
+}
+func (m *ActionWithMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionWithMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionWithMetadata proto.InternalMessageInfo
+
+type isActionWithMetadata_ActionSpecifier interface {
+	isActionWithMetadata_ActionSpecifier()
+	Equal(interface{}) bool
+}
+
+type ActionWithMetadata_Action struct {
+	Action *v1alpha1.Action `protobuf:"bytes,1,opt,name=action,proto3,oneof" json:"action,omitempty"`
+}
+type ActionWithMetadata_DynamicMetadata_ struct {
+	DynamicMetadata *ActionWithMetadata_DynamicMetadata `protobuf:"bytes,2,opt,name=dynamic_metadata,json=dynamicMetadata,proto3,oneof" json:"dynamic_metadata,omitempty"`
+}
+
+func (*ActionWithMetadata_Action) isActionWithMetadata_ActionSpecifier()           {}
+func (*ActionWithMetadata_DynamicMetadata_) isActionWithMetadata_ActionSpecifier() {}
+
+func (m *ActionWithMetadata) GetActionSpecifier() isActionWithMetadata_ActionSpecifier {
+	if m != nil {
+		return m.ActionSpecifier
+	}
+	return nil
+}
+
+func (m *ActionWithMetadata) GetAction() *v1alpha1.Action {
+	if x, ok := m.GetActionSpecifier().(*ActionWithMetadata_Action); ok {
+		return x.Action
+	}
+	return nil
+}
+
+func (m *ActionWithMetadata) GetDynamicMetadata() *ActionWithMetadata_DynamicMetadata {
+	if x, ok := m.GetActionSpecifier().(*ActionWithMetadata_DynamicMetadata_); ok {
+		return x.DynamicMetadata
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ActionWithMetadata) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ActionWithMetadata_Action)(nil),
+		(*ActionWithMetadata_DynamicMetadata_)(nil),
+	}
+}
+
+// Appends the following descriptor entry when the dynamic metadata of the request contains a string value
+// under the given path:
+//
+// ("<descriptor_key>", "<value_queried_from_metadata>")
+//
+// The claims of the JWTs verified by the `envoy.filters.http.jwt_authn` filter are stored under the
+// `payloadInMetadata` of their provider, while the external auth server sets the metadata of the
+// `envoy.filters.http.ext_authz` filter.
+// The rate limit filter must run after the filter which sets the metadata, so `rate_limit_before_auth` must not
+// be set in the rate limit server settings.
+type ActionWithMetadata_DynamicMetadata struct {
+	// The key to use in the descriptor entry.
+	DescriptorKey string `protobuf:"bytes,1,opt,name=descriptor_key,json=descriptorKey,proto3" json:"descriptor_key,omitempty"`
+	// The name of the filter which set the metadata, e.g. `envoy.filters.http.jwt_authn`.
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The keys leading to the value in the metadata of the filter, e.g. the name of a JWT provider followed by
+	// the name of a claim.
+	Path                 []string `protobuf:"bytes,3,rep,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActionWithMetadata_DynamicMetadata) Reset()         { *m = ActionWithMetadata_DynamicMetadata{} }
+func (m *ActionWithMetadata_DynamicMetadata) String() string { return proto.CompactTextString(m) }
+func (*ActionWithMetadata_DynamicMetadata) ProtoMessage()    {}
+func (*ActionWithMetadata_DynamicMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05a2dad65f2418a, []int{8, 0}
+}
+func (m *ActionWithMetadata_DynamicMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionWithMetadata_DynamicMetadata.Unmarshal(m, b)
+}
+func (m *ActionWithMetadata_DynamicMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionWithMetadata_DynamicMetadata.Marshal(b, m, deterministic)
+}
+func (m *ActionWithMetadata_DynamicMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionWithMetadata_DynamicMetadata.Merge(m, src)
+}
+func (m *ActionWithMetadata_DynamicMetadata) XXX_Size() int {
+	return xxx_messageInfo_ActionWithMetadata_DynamicMetadata.Size(m)
+}
+func (m *ActionWithMetadata_DynamicMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionWithMetadata_DynamicMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionWithMetadata_DynamicMetadata proto.InternalMessageInfo
+
+func (m *ActionWithMetadata_DynamicMetadata) GetDescriptorKey() string {
+	if m != nil {
+		return m.DescriptorKey
+	}
+	return ""
+}
+
+func (m *ActionWithMetadata_DynamicMetadata) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
+func (m *ActionWithMetadata_DynamicMetadata) GetPath() []string {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*IngressRateLimit)(nil), "ratelimit.options.gloo.solo.io.IngressRateLimit")
 	proto.RegisterType((*Settings)(nil), "ratelimit.options.gloo.solo.io.Settings")
@@ -386,6 +603,9 @@ func init() {
 	proto.RegisterType((*RateLimitConfigRef)(nil), "ratelimit.options.gloo.solo.io.RateLimitConfigRef")
 	proto.RegisterType((*RateLimitVhostExtension)(nil), "ratelimit.options.gloo.solo.io.RateLimitVhostExtension")
 	proto.RegisterType((*RateLimitRouteExtension)(nil), "ratelimit.options.gloo.solo.io.RateLimitRouteExtension")
+	proto.RegisterType((*RateLimitActionsWithMetadata)(nil), "ratelimit.options.gloo.solo.io.RateLimitActionsWithMetadata")
+	proto.RegisterType((*ActionWithMetadata)(nil), "ratelimit.options.gloo.solo.io.ActionWithMetadata")
+	proto.RegisterType((*ActionWithMetadata_DynamicMetadata)(nil), "ratelimit.options.gloo.solo.io.ActionWithMetadata.DynamicMetadata")
 }
 
 func init() {
@@ -393,47 +613,59 @@ func init() {
 }
 
 var fileDescriptor_e05a2dad65f2418a = []byte{
-	// 626 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xdf, 0x4e, 0x14, 0x3f,
-	0x14, 0xce, 0xc0, 0xe6, 0x17, 0xb6, 0xfb, 0x8b, 0x60, 0x21, 0x08, 0xc4, 0xe0, 0x3a, 0x37, 0x72,
-	0xc3, 0x4c, 0x60, 0x9f, 0x80, 0x3f, 0x12, 0x8c, 0x12, 0x93, 0x62, 0x30, 0x9a, 0x98, 0x49, 0x77,
-	0xf6, 0xcc, 0x4c, 0x65, 0xb6, 0x67, 0x6c, 0x3b, 0x2b, 0xf8, 0x24, 0x3c, 0x82, 0x57, 0x5e, 0xfb,
-	0x36, 0x26, 0xbe, 0x83, 0x89, 0x97, 0xa6, 0xdd, 0xf9, 0x83, 0x10, 0x74, 0xe3, 0xd5, 0x9c, 0x9e,
-	0x73, 0xbe, 0xef, 0x7c, 0xfd, 0xda, 0x29, 0x79, 0x93, 0x0a, 0x93, 0x95, 0xc3, 0x20, 0xc6, 0x71,
-	0xa8, 0x31, 0xc7, 0x6d, 0x81, 0x61, 0x9a, 0x23, 0x86, 0x85, 0xc2, 0xf7, 0x10, 0x1b, 0x3d, 0x5d,
-	0xf1, 0x42, 0x84, 0x93, 0x9d, 0x10, 0xa4, 0x01, 0x55, 0x28, 0xa1, 0x21, 0xc4, 0xc2, 0x08, 0x94,
-	0x3a, 0x54, 0xdc, 0x40, 0x2e, 0xc6, 0xc2, 0xb4, 0x51, 0x50, 0x28, 0x34, 0x48, 0x37, 0xdb, 0x44,
-	0xd5, 0x1c, 0x58, 0xae, 0xc0, 0x8e, 0x09, 0x04, 0x6e, 0x0c, 0xdc, 0x3c, 0x5e, 0x08, 0xed, 0xe8,
-	0x6d, 0xf7, 0xb6, 0x6b, 0x07, 0x15, 0x4e, 0x76, 0x78, 0x5e, 0x64, 0x7c, 0xe7, 0x26, 0xe9, 0xc6,
-	0xba, 0x03, 0x9d, 0x0b, 0x53, 0x4b, 0x52, 0x90, 0x54, 0xa5, 0xcd, 0x14, 0x31, 0xcd, 0x21, 0x74,
-	0xab, 0x61, 0x99, 0x84, 0x1f, 0x15, 0x2f, 0x0a, 0x50, 0xfa, 0xae, 0xfa, 0xa8, 0x54, 0xdc, 0xea,
-	0xaa, 0xea, 0x2b, 0x29, 0xa6, 0xe8, 0xc2, 0xd0, 0x46, 0x55, 0x96, 0xc2, 0x85, 0x99, 0x26, 0xe1,
-	0xa2, 0x12, 0xe1, 0x7f, 0xf1, 0xc8, 0xd2, 0x33, 0x99, 0x2a, 0xd0, 0x9a, 0x71, 0x03, 0x2f, 0xac,
-	0x3e, 0x7a, 0x42, 0xee, 0xf3, 0xd2, 0x64, 0xa8, 0xc4, 0x27, 0x18, 0x45, 0x4e, 0xb3, 0x5e, 0xf3,
-	0xfa, 0xde, 0x56, 0x6f, 0xb7, 0x1f, 0xb4, 0xdb, 0xe0, 0x85, 0xa8, 0x1d, 0x08, 0x1a, 0x30, 0x5b,
-	0x6a, 0xa1, 0x2e, 0xa1, 0xe9, 0x73, 0xb2, 0xc4, 0x25, 0xca, 0xcb, 0x31, 0x96, 0xba, 0x66, 0x9b,
-	0x9b, 0x91, 0x6d, 0xb1, 0x41, 0x4e, 0xc9, 0xfc, 0x9f, 0x1e, 0x59, 0x38, 0x05, 0x63, 0x84, 0x4c,
-	0x2d, 0xf3, 0x4a, 0x43, 0x10, 0x69, 0x50, 0x13, 0x50, 0x91, 0x82, 0xa4, 0xd2, 0xba, 0x1e, 0xc4,
-	0xa8, 0xa0, 0x25, 0x05, 0x8d, 0xa5, 0x8a, 0x81, 0x41, 0xc2, 0x68, 0x03, 0x3b, 0x75, 0x28, 0x06,
-	0x09, 0x3d, 0x26, 0x8b, 0x0a, 0x3e, 0x94, 0xa0, 0x4d, 0x64, 0xc4, 0x18, 0xb0, 0x34, 0x95, 0xca,
-	0xf5, 0x60, 0x6a, 0x77, 0x50, 0xdb, 0x1d, 0x1c, 0x56, 0x76, 0xef, 0x77, 0xae, 0xbe, 0x3d, 0xf2,
-	0xd8, 0xbd, 0x0a, 0xf7, 0x6a, 0x0a, 0xa3, 0x7d, 0xf2, 0xff, 0x08, 0xe4, 0x65, 0x84, 0x32, 0x4a,
-	0xb8, 0xc8, 0xd7, 0xe6, 0xfb, 0xde, 0xd6, 0x02, 0x23, 0x36, 0xf7, 0x52, 0x1e, 0x71, 0x91, 0xd3,
-	0x01, 0x59, 0xb5, 0x0a, 0xa6, 0x6e, 0x44, 0x43, 0x48, 0x50, 0x41, 0x64, 0x8d, 0x5b, 0xeb, 0xba,
-	0xde, 0x65, 0x55, 0x3b, 0xb0, 0xef, 0x6a, 0x7b, 0xa5, 0xc9, 0xfc, 0x33, 0xb2, 0x68, 0xd5, 0x8a,
-	0x18, 0x1a, 0x03, 0x0e, 0x48, 0x6f, 0x04, 0x3a, 0x56, 0xa2, 0x30, 0xa8, 0xec, 0x19, 0xcd, 0x6f,
-	0xf5, 0x76, 0x1f, 0xdf, 0xe1, 0xea, 0x61, 0xd3, 0xc9, 0xae, 0xa3, 0xfc, 0x77, 0x64, 0xb9, 0x31,
-	0xfc, 0x00, 0x65, 0x22, 0x52, 0x06, 0x89, 0xa6, 0x47, 0xa4, 0xa3, 0x20, 0xa9, 0x49, 0x77, 0x83,
-	0x3f, 0xff, 0x03, 0xc1, 0x6d, 0x0a, 0xe6, 0xf0, 0xfe, 0x11, 0xa1, 0xb7, 0x6b, 0x94, 0x92, 0x8e,
-	0xe4, 0x63, 0x70, 0x47, 0xd5, 0x65, 0x2e, 0xa6, 0x0f, 0x49, 0xd7, 0x7e, 0x75, 0xc1, 0x63, 0x70,
-	0xde, 0x77, 0x59, 0x9b, 0xf0, 0x63, 0xf2, 0xa0, 0xe1, 0x39, 0xcb, 0x50, 0x9b, 0xa7, 0x17, 0x06,
-	0xa4, 0x16, 0x28, 0xe9, 0x31, 0xe9, 0xb5, 0x76, 0xd6, 0x8a, 0x9f, 0xfc, 0xed, 0x72, 0xed, 0xc5,
-	0x6e, 0x1b, 0x8c, 0x34, 0x66, 0x6b, 0xff, 0xca, 0xbb, 0x36, 0x85, 0x61, 0x69, 0xa0, 0x9d, 0x32,
-	0x20, 0xab, 0x42, 0xc6, 0x79, 0x39, 0x82, 0x68, 0x92, 0x45, 0xbf, 0x0f, 0x74, 0x87, 0x56, 0x55,
-	0xcf, 0xb2, 0x86, 0x41, 0xdf, 0x94, 0x36, 0xf7, 0xcf, 0xd2, 0xf6, 0x5f, 0x7f, 0xfd, 0xd1, 0xf1,
-	0x3e, 0x7f, 0xdf, 0xf4, 0xde, 0x9e, 0xcc, 0xf6, 0xd2, 0x15, 0xe7, 0xe9, 0x2c, 0xaf, 0xdd, 0xf0,
-	0x3f, 0x77, 0xaf, 0x07, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x33, 0x92, 0x0b, 0x3d, 0x41, 0x05,
-	0x00, 0x00,
+	// 824 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xd1, 0x6e, 0xe3, 0x44,
+	0x14, 0x5d, 0xa7, 0x51, 0xd9, 0x4e, 0x61, 0x13, 0xa6, 0xab, 0x90, 0x56, 0x4b, 0x09, 0x96, 0x10,
+	0x7d, 0x59, 0x5b, 0x6d, 0x1e, 0x78, 0xe1, 0x65, 0xb3, 0xa5, 0x2a, 0xda, 0xad, 0x90, 0x66, 0x51,
+	0x57, 0x20, 0x21, 0x6b, 0x62, 0x5f, 0xdb, 0x43, 0x1d, 0x8f, 0x99, 0x19, 0xa7, 0x0d, 0x5f, 0xc2,
+	0x27, 0xf0, 0xc4, 0x73, 0xbf, 0x80, 0xaf, 0x40, 0x42, 0xe2, 0x1f, 0x90, 0x78, 0x44, 0x33, 0x1e,
+	0xdb, 0x49, 0xa3, 0x96, 0x80, 0xc4, 0x53, 0x66, 0xee, 0xbd, 0xe7, 0xdc, 0x73, 0x4f, 0x66, 0xc6,
+	0xe8, 0x9b, 0x84, 0xa9, 0xb4, 0x9c, 0x7a, 0x21, 0x9f, 0xf9, 0x92, 0x67, 0xfc, 0x39, 0xe3, 0x7e,
+	0x92, 0x71, 0xee, 0x17, 0x82, 0x7f, 0x0f, 0xa1, 0x92, 0xd5, 0x8e, 0x16, 0xcc, 0x9f, 0x1f, 0xfb,
+	0x90, 0x2b, 0x10, 0x85, 0x60, 0x12, 0x7c, 0x5e, 0x28, 0xc6, 0x73, 0xe9, 0x0b, 0xaa, 0x20, 0x63,
+	0x33, 0xa6, 0xda, 0x95, 0x57, 0x08, 0xae, 0x38, 0x3e, 0x6c, 0x03, 0xb6, 0xd8, 0xd3, 0x5c, 0x9e,
+	0x6e, 0xe3, 0x31, 0x7e, 0x30, 0x36, 0xfd, 0x68, 0xc1, 0xa4, 0xa1, 0xd7, 0xd5, 0xcf, 0x4d, 0x39,
+	0x08, 0x7f, 0x7e, 0x4c, 0xb3, 0x22, 0xa5, 0xc7, 0x77, 0x49, 0x0f, 0xf6, 0x0d, 0xe8, 0x8a, 0xa9,
+	0x5a, 0x92, 0x80, 0xd8, 0xa6, 0x0e, 0x13, 0xce, 0x93, 0x0c, 0x7c, 0xb3, 0x9b, 0x96, 0xb1, 0x7f,
+	0x2d, 0x68, 0x51, 0x80, 0x90, 0xf7, 0xe5, 0xa3, 0x52, 0x50, 0xad, 0xcb, 0xe6, 0x9f, 0x26, 0x3c,
+	0xe1, 0x66, 0xe9, 0xeb, 0x95, 0x8d, 0x62, 0xb8, 0x51, 0x55, 0x10, 0x6e, 0xac, 0x08, 0xf7, 0x17,
+	0x07, 0xf5, 0xbf, 0xcc, 0x13, 0x01, 0x52, 0x12, 0xaa, 0xe0, 0xb5, 0xd6, 0x87, 0x2f, 0xd0, 0xfb,
+	0xb4, 0x54, 0x29, 0x17, 0xec, 0x47, 0x88, 0x02, 0xa3, 0x59, 0x0e, 0x9d, 0x91, 0x73, 0xb4, 0x7b,
+	0x32, 0xf2, 0xda, 0x31, 0x68, 0xc1, 0x6a, 0x07, 0xbc, 0x06, 0x4c, 0xfa, 0x2d, 0xd4, 0x04, 0x24,
+	0x7e, 0x85, 0xfa, 0x34, 0xe7, 0xf9, 0x62, 0xc6, 0x4b, 0x59, 0xb3, 0x75, 0x36, 0x64, 0xeb, 0x35,
+	0xc8, 0x8a, 0xcc, 0xfd, 0xcb, 0x41, 0x8f, 0xdf, 0x80, 0x52, 0x2c, 0x4f, 0x34, 0xf3, 0xd3, 0x86,
+	0x20, 0x90, 0x20, 0xe6, 0x20, 0x02, 0x01, 0xb1, 0xd5, 0xba, 0xef, 0x85, 0x5c, 0x40, 0x4b, 0x0a,
+	0x92, 0x97, 0x22, 0x04, 0x02, 0x31, 0xc1, 0x0d, 0xec, 0x8d, 0x41, 0x11, 0x88, 0xf1, 0x39, 0xea,
+	0x09, 0xf8, 0xa1, 0x04, 0xa9, 0x02, 0xc5, 0x66, 0xc0, 0x4b, 0x65, 0x55, 0xee, 0x7b, 0x95, 0xdd,
+	0x5e, 0x6d, 0xb7, 0x77, 0x6a, 0xed, 0x9e, 0x74, 0x7f, 0xfa, 0xfd, 0x23, 0x87, 0x3c, 0xb1, 0xb8,
+	0xaf, 0x2b, 0x18, 0x1e, 0xa1, 0x77, 0x23, 0xc8, 0x17, 0x01, 0xcf, 0x83, 0x98, 0xb2, 0x6c, 0xb8,
+	0x35, 0x72, 0x8e, 0x1e, 0x13, 0xa4, 0x63, 0x5f, 0xe5, 0x67, 0x94, 0x65, 0x78, 0x8c, 0x06, 0x5a,
+	0x41, 0xe5, 0x46, 0x30, 0x85, 0x98, 0x0b, 0x08, 0xb4, 0x71, 0xc3, 0x1d, 0x53, 0xbb, 0x27, 0x6a,
+	0x07, 0x26, 0x26, 0xf7, 0xa2, 0x54, 0xa9, 0x7b, 0x89, 0x7a, 0x5a, 0x2d, 0x0b, 0xa1, 0x31, 0xe0,
+	0x25, 0xda, 0x8d, 0x40, 0x86, 0x82, 0x15, 0x8a, 0x0b, 0xfd, 0x1f, 0x6d, 0x1d, 0xed, 0x9e, 0x7c,
+	0x7c, 0x8f, 0xab, 0xa7, 0x4d, 0x25, 0x59, 0x46, 0xb9, 0xdf, 0xa1, 0xbd, 0xc6, 0xf0, 0x97, 0x3c,
+	0x8f, 0x59, 0x42, 0x20, 0x96, 0xf8, 0x0c, 0x75, 0x05, 0xc4, 0x35, 0xe9, 0x89, 0xf7, 0xf0, 0x1d,
+	0xf0, 0xd6, 0x29, 0x88, 0xc1, 0xbb, 0x67, 0x08, 0xaf, 0xe7, 0x30, 0x46, 0xdd, 0x9c, 0xce, 0xc0,
+	0xfc, 0x55, 0x3b, 0xc4, 0xac, 0xf1, 0x33, 0xb4, 0xa3, 0x7f, 0x65, 0x41, 0x43, 0x30, 0xde, 0xef,
+	0x90, 0x36, 0xe0, 0xfe, 0xe6, 0xa0, 0x0f, 0x1a, 0xa2, 0xcb, 0x94, 0x4b, 0xf5, 0xc5, 0x8d, 0x82,
+	0x5c, 0x32, 0x9e, 0xe3, 0x73, 0xb4, 0xdb, 0xfa, 0x59, 0x4b, 0xfe, 0xf4, 0x9f, 0x4e, 0xd7, 0x8b,
+	0xd0, 0xcc, 0x41, 0x50, 0xe3, 0xb6, 0xc4, 0xd7, 0x68, 0x7f, 0x89, 0x29, 0xb8, 0x66, 0x2a, 0x0d,
+	0x66, 0xa0, 0x68, 0x44, 0x15, 0x1d, 0x76, 0x0c, 0xef, 0xe7, 0x1b, 0x5b, 0x61, 0x1b, 0xbc, 0x65,
+	0x2a, 0xbd, 0xb0, 0x1c, 0x64, 0xd0, 0x36, 0x5b, 0x8e, 0xbb, 0xbf, 0x76, 0x96, 0xc6, 0x23, 0xbc,
+	0x54, 0xd0, 0x8e, 0x37, 0x46, 0x03, 0x96, 0x87, 0x59, 0x19, 0x41, 0x30, 0x4f, 0x83, 0xd5, 0x49,
+	0xcd, 0x71, 0xb1, 0xd9, 0xcb, 0x94, 0xb4, 0x93, 0xdc, 0xf1, 0xa4, 0xf3, 0xdf, 0x3d, 0x19, 0xa3,
+	0x41, 0xc4, 0x24, 0x9d, 0x66, 0x6b, 0xed, 0xab, 0x93, 0xbd, 0x67, 0xb3, 0x2b, 0xed, 0x1f, 0x34,
+	0xb2, 0xfb, 0x3f, 0x1a, 0x99, 0xa1, 0x67, 0x0f, 0xe1, 0xf0, 0x6b, 0xf4, 0x0e, 0xad, 0xc2, 0x9b,
+	0x1e, 0xed, 0x8a, 0x65, 0xa5, 0x79, 0x4d, 0xe1, 0xde, 0x76, 0x10, 0x5e, 0xcf, 0xe3, 0xcf, 0xd0,
+	0x76, 0x55, 0x61, 0xdf, 0xa2, 0x0f, 0xef, 0xf1, 0xbd, 0x82, 0x9e, 0x3f, 0x22, 0xb6, 0x1c, 0x73,
+	0xd4, 0x8f, 0x16, 0x39, 0x9d, 0xb1, 0x70, 0xf9, 0xd8, 0x69, 0x8a, 0xc9, 0xbf, 0x97, 0xe9, 0x9d,
+	0x56, 0x54, 0xf5, 0xfe, 0xfc, 0x11, 0xe9, 0x45, 0xab, 0xa1, 0x83, 0x08, 0xf5, 0xee, 0x54, 0xe1,
+	0x4f, 0xd0, 0x93, 0xf6, 0x7d, 0x08, 0xae, 0x60, 0x61, 0x6f, 0xe9, 0x7b, 0x6d, 0xf4, 0x15, 0x2c,
+	0xf0, 0x00, 0x6d, 0xc7, 0x2c, 0x53, 0x20, 0xec, 0x5d, 0xb5, 0x3b, 0x7d, 0xb5, 0x0b, 0xaa, 0xd2,
+	0xe1, 0xd6, 0x68, 0x4b, 0x5f, 0x6d, 0xbd, 0x9e, 0x60, 0xd4, 0xaf, 0x06, 0x0c, 0x64, 0x01, 0x21,
+	0x8b, 0x19, 0x88, 0xc9, 0xdb, 0xdb, 0x3f, 0xbb, 0xce, 0xcf, 0x7f, 0x1c, 0x3a, 0xdf, 0x5e, 0x6c,
+	0xf6, 0xe9, 0x2e, 0xae, 0x92, 0x4d, 0x3e, 0xdf, 0xd3, 0x6d, 0xf3, 0x50, 0x8f, 0xff, 0x1e, 0x00,
+	0x00, 0x36, 0x27, 0x28, 0x12, 0x08, 0x00, 0x00,
 }
 
 func (this *IngressRateLimit) Equal(that interface{}) bool {
@@ -629,6 +861,14 @@ func (this *RateLimitVhostExtension) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.RateLimitsWithMetadata) != len(that1.RateLimitsWithMetadata) {
+		return false
+	}
+	for i := range this.RateLimitsWithMetadata {
+		if !this.RateLimitsWithMetadata[i].Equal(that1.RateLimitsWithMetadata[i]) {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -664,6 +904,168 @@ func (this *RateLimitRouteExtension) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.DisableVhRateLimits != that1.DisableVhRateLimits {
+		return false
+	}
+	if len(this.RateLimitsWithMetadata) != len(that1.RateLimitsWithMetadata) {
+		return false
+	}
+	for i := range this.RateLimitsWithMetadata {
+		if !this.RateLimitsWithMetadata[i].Equal(that1.RateLimitsWithMetadata[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *RateLimitActionsWithMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RateLimitActionsWithMetadata)
+	if !ok {
+		that2, ok := that.(RateLimitActionsWithMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Actions) != len(that1.Actions) {
+		return false
+	}
+	for i := range this.Actions {
+		if !this.Actions[i].Equal(that1.Actions[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ActionWithMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ActionWithMetadata)
+	if !ok {
+		that2, ok := that.(ActionWithMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.ActionSpecifier == nil {
+		if this.ActionSpecifier != nil {
+			return false
+		}
+	} else if this.ActionSpecifier == nil {
+		return false
+	} else if !this.ActionSpecifier.Equal(that1.ActionSpecifier) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ActionWithMetadata_Action) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ActionWithMetadata_Action)
+	if !ok {
+		that2, ok := that.(ActionWithMetadata_Action)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Action.Equal(that1.Action) {
+		return false
+	}
+	return true
+}
+func (this *ActionWithMetadata_DynamicMetadata_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ActionWithMetadata_DynamicMetadata_)
+	if !ok {
+		that2, ok := that.(ActionWithMetadata_DynamicMetadata_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DynamicMetadata.Equal(that1.DynamicMetadata) {
+		return false
+	}
+	return true
+}
+func (this *ActionWithMetadata_DynamicMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ActionWithMetadata_DynamicMetadata)
+	if !ok {
+		that2, ok := that.(ActionWithMetadata_DynamicMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DescriptorKey != that1.DescriptorKey {
+		return false
+	}
+	if this.Filter != that1.Filter {
+		return false
+	}
+	if len(this.Path) != len(that1.Path) {
+		return false
+	}
+	for i := range this.Path {
+		if this.Path[i] != that1.Path[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -10,6 +10,7 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 )
 
@@ -70,10 +71,14 @@ type ExtensionSettings struct {
 	// If provided on a route, all route settings override any vhost settings
 	Disable bool `protobuf:"varint,1,opt,name=disable,proto3" json:"disable,omitempty"`
 	// Named policies to apply.
-	Policies             map[string]*Policy `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Policies map[string]*Policy `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Only log the decisions of the policies, without enforcing them (default false). Envoy emits the
+	// `shadow_allowed` and `shadow_denied` stats of the RBAC filter and adds the decision to the dynamic metadata of
+	// the request, so new policies can be tested against live traffic before they are enforced.
+	Shadow               bool     `protobuf:"varint,3,opt,name=shadow,proto3" json:"shadow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ExtensionSettings) Reset()         { *m = ExtensionSettings{} }
@@ -114,6 +119,13 @@ func (m *ExtensionSettings) GetPolicies() map[string]*Policy {
 	return nil
 }
 
+func (m *ExtensionSettings) GetShadow() bool {
+	if m != nil {
+		return m.Shadow
+	}
+	return false
+}
+
 type Policy struct {
 	// Principals in this policy.
 	Principals []*Principal `protobuf:"bytes,1,rep,name=principals,proto3" json:"principals,omitempty"`
@@ -163,11 +175,20 @@ func (m *Policy) GetPermissions() *Permissions {
 }
 
 // An RBAC principal - the identity entity (usually a user or a service account).
+// If more than one field is set, all of them need to match.
 type Principal struct {
-	JwtPrincipal         *JWTPrincipal `protobuf:"bytes,1,opt,name=jwt_principal,json=jwtPrincipal,proto3" json:"jwt_principal,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	JwtPrincipal *JWTPrincipal `protobuf:"bytes,1,opt,name=jwt_principal,json=jwtPrincipal,proto3" json:"jwt_principal,omitempty"`
+	// Matches the client certificate of mTLS connections: the URI SAN, DNS SAN or, if the certificate has neither,
+	// the subject. The downstream TLS context of the listener must require client certificates.
+	AuthenticatedSan string `protobuf:"bytes,2,opt,name=authenticated_san,json=authenticatedSan,proto3" json:"authenticated_san,omitempty"`
+	// Matches clients whose address is within this CIDR range, for example "10.0.0.0/8". The address is the one the
+	// HTTP connection manager determined, possibly from the x-forwarded-for header.
+	SourceIp string `protobuf:"bytes,3,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
+	// Matches requests with this header.
+	Header               *matchers.HeaderMatcher `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *Principal) Reset()         { *m = Principal{} }
@@ -201,6 +222,27 @@ func (m *Principal) GetJwtPrincipal() *JWTPrincipal {
 	return nil
 }
 
+func (m *Principal) GetAuthenticatedSan() string {
+	if m != nil {
+		return m.AuthenticatedSan
+	}
+	return ""
+}
+
+func (m *Principal) GetSourceIp() string {
+	if m != nil {
+		return m.SourceIp
+	}
+	return ""
+}
+
+func (m *Principal) GetHeader() *matchers.HeaderMatcher {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
 // A JWT principal. To use this, JWT option MUST be enabled.
 type JWTPrincipal struct {
 	// Set of claims that make up this principal. Commonly, the 'iss' and 'sub' or 'email' claims are used.
@@ -318,38 +360,44 @@ func init() {
 }
 
 var fileDescriptor_b3e839952ea61f0e = []byte{
-	// 489 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xd5, 0xb6, 0x10, 0xe2, 0x71, 0x2a, 0xc1, 0xaa, 0x07, 0xe3, 0x03, 0xa4, 0x16, 0x82, 0x5c,
-	0x6a, 0x8b, 0xf4, 0x00, 0xf4, 0x08, 0x54, 0x8a, 0x00, 0x89, 0x6a, 0x41, 0x20, 0x38, 0x10, 0xd9,
-	0xce, 0xe2, 0x6c, 0xea, 0x78, 0x96, 0xdd, 0x4d, 0x9a, 0xfc, 0x09, 0x9f, 0xc0, 0x99, 0x13, 0xdf,
-	0x83, 0xc4, 0x27, 0x70, 0x47, 0x5e, 0x3b, 0xae, 0x11, 0x10, 0x7a, 0xb1, 0x66, 0xde, 0xcc, 0x7b,
-	0x33, 0xe3, 0xd9, 0x81, 0x97, 0x99, 0x30, 0xd3, 0x45, 0x12, 0xa6, 0x38, 0x8f, 0x34, 0xe6, 0x78,
-	0x28, 0x30, 0xca, 0x72, 0xc4, 0x48, 0x2a, 0x9c, 0xf1, 0xd4, 0xe8, 0xca, 0x8b, 0xa5, 0x88, 0x96,
-	0xf7, 0x23, 0x5e, 0x18, 0xae, 0xa4, 0x12, 0x9a, 0x47, 0x28, 0x8d, 0xc0, 0x42, 0x47, 0x2a, 0x89,
-	0x53, 0xfb, 0x09, 0xa5, 0x42, 0x83, 0xf4, 0xa6, 0xb5, 0xeb, 0x68, 0x58, 0x92, 0xc3, 0x52, 0x37,
-	0x14, 0xe8, 0xef, 0x67, 0x98, 0xa1, 0xcd, 0x8a, 0x4a, 0xab, 0x22, 0xf8, 0x94, 0xaf, 0x4c, 0x05,
-	0xf2, 0x95, 0xa9, 0xb0, 0xe0, 0x10, 0xba, 0xaf, 0xb8, 0x31, 0xa2, 0xc8, 0x34, 0x3d, 0x80, 0x9e,
-	0xe2, 0x9f, 0x16, 0x42, 0xf1, 0x71, 0x29, 0xed, 0x91, 0x3e, 0x19, 0x74, 0x99, 0x5b, 0x63, 0x2c,
-	0x89, 0xd3, 0xe0, 0x07, 0x81, 0x1b, 0x27, 0x2b, 0xc3, 0x0b, 0x2d, 0xb0, 0x68, 0x88, 0x1e, 0x5c,
-	0x9b, 0x08, 0x1d, 0x27, 0x39, 0xaf, 0x39, 0x1b, 0x97, 0xbe, 0x81, 0xae, 0xc4, 0x5c, 0xa4, 0x82,
-	0x6b, 0x6f, 0xa7, 0xbf, 0x3b, 0x70, 0x87, 0xc7, 0xe1, 0x3f, 0xdb, 0x0e, 0xff, 0x50, 0x0e, 0x4f,
-	0x6b, 0xf2, 0x49, 0x61, 0xd4, 0x9a, 0x35, 0x5a, 0xfe, 0x07, 0xd8, 0xfb, 0x2d, 0x44, 0xaf, 0xc3,
-	0xee, 0x19, 0x5f, 0xdb, 0xf2, 0x0e, 0x2b, 0x4d, 0xfa, 0x00, 0xae, 0x2e, 0xe3, 0x7c, 0xc1, 0xbd,
-	0x9d, 0x3e, 0x19, 0xb8, 0xc3, 0x83, 0x2d, 0x75, 0xad, 0xd4, 0x9a, 0x55, 0xf9, 0xc7, 0x3b, 0x0f,
-	0x49, 0xf0, 0x99, 0x40, 0xa7, 0x42, 0xe9, 0x53, 0x00, 0xa9, 0x44, 0x91, 0x0a, 0x19, 0xe7, 0xda,
-	0x23, 0x76, 0x88, 0x3b, 0xdb, 0xc4, 0x36, 0xc9, 0xac, 0xc5, 0xa3, 0x23, 0x70, 0x25, 0x57, 0x73,
-	0xa1, 0xcb, 0xf1, 0x74, 0xdd, 0xd3, 0xdd, 0x6d, 0x32, 0x17, 0xd9, 0xac, 0x4d, 0x0d, 0xde, 0x81,
-	0xd3, 0x94, 0xa0, 0x2f, 0x60, 0x6f, 0x76, 0x6e, 0xc6, 0x4d, 0x21, 0xfb, 0x03, 0xdc, 0xe1, 0xbd,
-	0x2d, 0xc2, 0xcf, 0xde, 0xbe, 0xbe, 0x68, 0xb1, 0x37, 0x3b, 0x37, 0x8d, 0x17, 0x7c, 0x25, 0xd0,
-	0x6b, 0x87, 0xe9, 0x73, 0xe8, 0xa4, 0x79, 0x2c, 0xe6, 0x9b, 0xb9, 0x8f, 0x2e, 0xa9, 0x1b, 0x3e,
-	0xb1, 0xac, 0x6a, 0x6b, 0xb5, 0x04, 0xf5, 0xa1, 0x2b, 0x15, 0x2e, 0xc5, 0x84, 0x2b, 0x3b, 0xbf,
-	0xc3, 0x1a, 0xdf, 0x7f, 0x04, 0x6e, 0x8b, 0xf2, 0x97, 0x6d, 0xee, 0xb7, 0xb7, 0xe9, 0xb4, 0x57,
-	0x35, 0x02, 0xb7, 0xf5, 0xaf, 0xe8, 0x6d, 0x70, 0x65, 0x6c, 0xa6, 0x63, 0xa9, 0xf8, 0x47, 0xb1,
-	0xaa, 0x25, 0xa0, 0x84, 0x4e, 0x2d, 0x52, 0x3e, 0xd6, 0x39, 0x37, 0x53, 0x9c, 0x54, 0x2f, 0xd2,
-	0x61, 0x1b, 0xf7, 0x31, 0xfb, 0xf6, 0xf3, 0x0a, 0xf9, 0xf2, 0xfd, 0x16, 0x79, 0x3f, 0xba, 0xdc,
-	0xad, 0xca, 0xb3, 0xec, 0x3f, 0xf7, 0x9a, 0x74, 0xec, 0x99, 0x1d, 0xfd, 0x0a, 0x00, 0x00, 0xff,
-	0xff, 0xe6, 0x4e, 0x82, 0xa2, 0xfe, 0x03, 0x00, 0x00,
+	// 588 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0x56, 0xb6, 0x51, 0x9a, 0x93, 0x4d, 0xda, 0xac, 0x09, 0x85, 0x22, 0xc1, 0x56, 0x21, 0x18,
+	0x42, 0x4b, 0xc4, 0x26, 0xf1, 0xb3, 0x3b, 0x7e, 0x26, 0x95, 0x3f, 0x31, 0x79, 0x08, 0x24, 0x2e,
+	0xa8, 0xdc, 0xf4, 0x90, 0x78, 0x4b, 0x63, 0x63, 0xbb, 0x6b, 0xfb, 0x26, 0x3c, 0x02, 0xd7, 0x5c,
+	0xf1, 0x3c, 0x5c, 0xf0, 0x06, 0x5c, 0x22, 0xa1, 0x38, 0x69, 0x9a, 0x0a, 0x56, 0x76, 0x13, 0x9d,
+	0xf3, 0xf9, 0x7c, 0x9f, 0xbf, 0x13, 0x1f, 0x1b, 0xde, 0xc4, 0xdc, 0x24, 0xc3, 0x5e, 0x10, 0x89,
+	0x41, 0xa8, 0x45, 0x2a, 0x76, 0xb9, 0x08, 0xe3, 0x54, 0x88, 0x50, 0x2a, 0x71, 0x82, 0x91, 0xd1,
+	0x45, 0xc6, 0x24, 0x0f, 0xcf, 0xee, 0x85, 0x98, 0x19, 0x54, 0x52, 0x71, 0x8d, 0xa1, 0x90, 0x86,
+	0x8b, 0x4c, 0x87, 0xaa, 0xc7, 0x22, 0xfb, 0x09, 0xa4, 0x12, 0x46, 0x90, 0xab, 0x36, 0x2e, 0x57,
+	0x83, 0x9c, 0x1c, 0xe4, 0xba, 0x01, 0x17, 0xad, 0xfb, 0xe7, 0x0b, 0x47, 0x42, 0x61, 0x38, 0x60,
+	0x26, 0x4a, 0x50, 0xe9, 0x2a, 0x28, 0x24, 0x5b, 0x9b, 0xb1, 0x88, 0x85, 0x0d, 0xc3, 0x3c, 0x2a,
+	0x51, 0x82, 0x63, 0x53, 0x80, 0x38, 0x36, 0x05, 0xd6, 0xde, 0x85, 0xe6, 0x31, 0x1a, 0xc3, 0xb3,
+	0x58, 0x93, 0x6d, 0x58, 0x55, 0xf8, 0x79, 0xc8, 0x15, 0x76, 0x73, 0x4b, 0xbe, 0xb3, 0xe5, 0xec,
+	0x34, 0xa9, 0x57, 0x62, 0xb4, 0xc7, 0xa2, 0xf6, 0x6f, 0x07, 0x36, 0x0e, 0xc7, 0x06, 0x33, 0xcd,
+	0x45, 0x56, 0x11, 0x7d, 0xb8, 0xdc, 0xe7, 0x9a, 0xf5, 0x52, 0x2c, 0x39, 0xd3, 0x94, 0xbc, 0x83,
+	0xa6, 0x14, 0x29, 0x8f, 0x38, 0x6a, 0x7f, 0x69, 0x6b, 0x79, 0xc7, 0xdb, 0x3b, 0x08, 0xce, 0x6d,
+	0x37, 0xf8, 0x4b, 0x39, 0x38, 0x2a, 0xc9, 0x87, 0x99, 0x51, 0x13, 0x5a, 0x69, 0x91, 0x2b, 0xd0,
+	0xd0, 0x09, 0xeb, 0x8b, 0x91, 0xbf, 0x6c, 0x37, 0x2c, 0xb3, 0xd6, 0x47, 0x58, 0x9b, 0xa3, 0x90,
+	0x75, 0x58, 0x3e, 0xc5, 0x89, 0xb5, 0xe5, 0xd2, 0x3c, 0x24, 0x0f, 0xe0, 0xd2, 0x19, 0x4b, 0x87,
+	0xe8, 0x2f, 0x6d, 0x39, 0x3b, 0xde, 0xde, 0xf6, 0x02, 0x3f, 0x56, 0x6a, 0x42, 0x8b, 0xfa, 0x83,
+	0xa5, 0x87, 0x4e, 0xfb, 0x8b, 0x03, 0x8d, 0x02, 0x25, 0xcf, 0x00, 0xa4, 0xe2, 0x59, 0xc4, 0x25,
+	0x4b, 0xb5, 0xef, 0xd8, 0xe6, 0x6e, 0x2e, 0x12, 0x9b, 0x16, 0xd3, 0x1a, 0x8f, 0x74, 0xc0, 0x93,
+	0xa8, 0x06, 0x5c, 0xe7, 0x6d, 0xeb, 0xd2, 0xd3, 0xad, 0x45, 0x32, 0xb3, 0x6a, 0x5a, 0xa7, 0xb6,
+	0x7f, 0x3a, 0xe0, 0x56, 0x7b, 0x90, 0x57, 0xb0, 0x76, 0x32, 0x32, 0xdd, 0x6a, 0x27, 0xfb, 0x07,
+	0xbc, 0xbd, 0xdb, 0x0b, 0x94, 0x5f, 0xbc, 0x7f, 0x3b, 0xf3, 0xb8, 0x7a, 0x32, 0x32, 0x33, 0xb5,
+	0xbb, 0xb0, 0xc1, 0x86, 0x26, 0xc1, 0xcc, 0xf0, 0x88, 0x19, 0xec, 0x77, 0x35, 0xcb, 0xac, 0x57,
+	0x97, 0xae, 0xcf, 0x2d, 0x1c, 0xb3, 0x8c, 0x5c, 0x03, 0x57, 0x8b, 0xa1, 0x8a, 0xb0, 0xcb, 0xa5,
+	0x3d, 0x1e, 0x97, 0x36, 0x0b, 0xe0, 0xb9, 0x24, 0x8f, 0xa1, 0x91, 0x20, 0xeb, 0xa3, 0xf2, 0x57,
+	0xac, 0xa1, 0x3b, 0x41, 0x35, 0xba, 0xf9, 0x44, 0xcf, 0x3b, 0xea, 0xd8, 0xca, 0xd7, 0x45, 0x01,
+	0x2d, 0x89, 0xed, 0x6f, 0x0e, 0xac, 0xd6, 0xbd, 0x92, 0x97, 0xd0, 0x88, 0x52, 0xc6, 0x07, 0xd3,
+	0x53, 0xd8, 0xbf, 0x60, 0x93, 0xc1, 0x53, 0xcb, 0x2a, 0x66, 0xab, 0x94, 0x20, 0x2d, 0x68, 0x4a,
+	0x25, 0xce, 0x78, 0x6e, 0xb1, 0xe8, 0xb0, 0xca, 0x5b, 0x8f, 0xc0, 0xab, 0x51, 0xfe, 0x31, 0x5b,
+	0x9b, 0xf5, 0xd9, 0x72, 0xeb, 0x83, 0xd3, 0x01, 0xaf, 0x76, 0x72, 0xe4, 0x06, 0x78, 0x92, 0x99,
+	0xa4, 0x2b, 0x15, 0x7e, 0xe2, 0xe3, 0x52, 0x02, 0x72, 0xe8, 0xc8, 0x22, 0xf9, 0x95, 0x1a, 0xa0,
+	0x49, 0x44, 0xbf, 0xb8, 0x37, 0x2e, 0x9d, 0xa6, 0x4f, 0xe8, 0xf7, 0x5f, 0x2b, 0xce, 0xd7, 0x1f,
+	0xd7, 0x9d, 0x0f, 0x9d, 0x8b, 0xbd, 0x44, 0xf2, 0x34, 0xfe, 0xcf, 0x6b, 0xd4, 0x6b, 0xd8, 0xc7,
+	0x60, 0xff, 0xcf, 0x00, 0x56, 0x39, 0xbe, 0x9b, 0xdc, 0x04, 0x00, 0x00,
 }
 
 func (this *Settings) Equal(that interface{}) bool {
@@ -409,6 +457,9 @@ func (this *ExtensionSettings) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Shadow != that1.Shadow {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -471,6 +522,15 @@ func (this *Principal) Equal(that interface{}) bool {
 	if !this.JwtPrincipal.Equal(that1.JwtPrincipal) {
 		return false
 	}
+	if this.AuthenticatedSan != that1.AuthenticatedSan {
+		return false
+	}
+	if this.SourceIp != that1.SourceIp {
+		return false
+	}
+	if !this.Header.Equal(that1.Header) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
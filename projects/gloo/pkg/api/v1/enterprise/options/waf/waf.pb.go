@@ -12,6 +12,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	waf "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/waf"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -42,10 +43,13 @@ type Settings struct {
 	// Only process request headers, not buffering the request body
 	RequestHeadersOnly bool `protobuf:"varint,6,opt,name=request_headers_only,json=requestHeadersOnly,proto3" json:"request_headers_only,omitempty"`
 	// Only process response headers, not buffering the response body
-	ResponseHeadersOnly  bool     `protobuf:"varint,7,opt,name=response_headers_only,json=responseHeadersOnly,proto3" json:"response_headers_only,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ResponseHeadersOnly bool `protobuf:"varint,7,opt,name=response_headers_only,json=responseHeadersOnly,proto3" json:"response_headers_only,omitempty"`
+	// Rule sets stored in ConfigMaps, which Gloo reads as artifacts. Each key of a ConfigMap holds a rules file.
+	// The files are added in the order of their keys, after the core rule set and before `rule_sets`.
+	ConfigMapRuleSets    []*core.ResourceRef `protobuf:"bytes,8,rep,name=config_map_rule_sets,json=configMapRuleSets,proto3" json:"config_map_rule_sets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *Settings) Reset()         { *m = Settings{} }
@@ -121,6 +125,13 @@ func (m *Settings) GetResponseHeadersOnly() bool {
 	return false
 }
 
+func (m *Settings) GetConfigMapRuleSets() []*core.ResourceRef {
+	if m != nil {
+		return m.ConfigMapRuleSets
+	}
+	return nil
+}
+
 type CoreRuleSet struct {
 	// Optional custom settings for the OWASP core rule set.
 	// For an example on the configuration options see: https://github.com/SpiderLabs/owasp-modsecurity-crs/blob/v3.2/dev/crs-setup.conf.example
@@ -213,38 +224,40 @@ func init() {
 }
 
 var fileDescriptor_0151c80aefddd633 = []byte{
-	// 486 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xa4, 0x94, 0x74, 0x43, 0x2f, 0x4b, 0x40, 0x26, 0x48, 0x28, 0xaa, 0x84, 0x94, 0x0b,
-	0xeb, 0x12, 0x04, 0xc7, 0x4a, 0xb4, 0x12, 0xb4, 0x12, 0x11, 0xc2, 0xe1, 0xd4, 0x8b, 0xe5, 0x38,
-	0x93, 0xcd, 0xc2, 0x66, 0xc7, 0xec, 0x8c, 0x43, 0xf2, 0x14, 0xbc, 0x06, 0x8f, 0xc0, 0x99, 0x47,
-	0xe1, 0x1d, 0xb8, 0x23, 0xff, 0x04, 0x12, 0x50, 0x51, 0x0f, 0x96, 0xe6, 0x9b, 0x6f, 0xbe, 0x4f,
-	0xbb, 0xf3, 0x79, 0xc5, 0x48, 0x1b, 0x9e, 0x17, 0x13, 0x95, 0xe1, 0x22, 0x22, 0xb4, 0xf8, 0xc4,
-	0x60, 0xa4, 0x2d, 0x62, 0x94, 0x7b, 0xfc, 0x00, 0x19, 0x53, 0x8d, 0xd2, 0xdc, 0x44, 0xcb, 0xa7,
-	0x11, 0x38, 0x06, 0x9f, 0x7b, 0x43, 0x10, 0x61, 0xce, 0x06, 0x1d, 0x45, 0x9f, 0xd3, 0x59, 0xf9,
-	0xa9, 0xdc, 0x23, 0xa3, 0x0c, 0xcb, 0xb2, 0xa1, 0x54, 0xa9, 0x54, 0xa5, 0xa9, 0x32, 0xd8, 0x3b,
-	0xb9, 0xc2, 0x15, 0x56, 0x0c, 0xde, 0xa5, 0x36, 0x02, 0xb7, 0xc4, 0x75, 0x05, 0x1d, 0xfd, 0xeb,
-	0xdc, 0xeb, 0x6a, 0xd4, 0x58, 0x95, 0x51, 0x59, 0x35, 0x5d, 0x09, 0x2b, 0xae, 0x9b, 0xb0, 0xe2,
-	0xba, 0x77, 0xf4, 0xbd, 0x25, 0xda, 0x63, 0x60, 0x36, 0x4e, 0x93, 0xec, 0x89, 0xf6, 0xd4, 0x50,
-	0x3a, 0xb1, 0x30, 0x0d, 0x83, 0x7e, 0x30, 0x68, 0xc7, 0xbf, 0xb1, 0x3c, 0x11, 0x0f, 0xb3, 0x82,
-	0x18, 0x17, 0x89, 0x29, 0xef, 0xb5, 0x04, 0x57, 0x9e, 0x3b, 0x59, 0x00, 0x51, 0xaa, 0x21, 0xbc,
-	0xd9, 0x0f, 0x06, 0x07, 0xf1, 0x83, 0x7a, 0xe4, 0x62, 0x6b, 0x62, 0x54, 0x0f, 0xc8, 0x0b, 0x71,
-	0x98, 0xa1, 0x87, 0xc4, 0x17, 0x16, 0x12, 0x02, 0x0e, 0x5b, 0xfd, 0x60, 0xd0, 0x19, 0x3e, 0x56,
-	0x57, 0x2d, 0x41, 0x9d, 0xa1, 0x87, 0xb8, 0xb0, 0x30, 0x06, 0x8e, 0x3b, 0xd9, 0x1f, 0x20, 0x47,
-	0xe2, 0x60, 0xe3, 0x42, 0xe1, 0x5e, 0xbf, 0x35, 0xe8, 0x0c, 0x8f, 0x55, 0xb5, 0x11, 0x95, 0xa1,
-	0x9b, 0x19, 0xad, 0x66, 0xc6, 0x32, 0x78, 0x35, 0x67, 0xce, 0xd5, 0x02, 0xa7, 0x04, 0x59, 0xe1,
-	0x0d, 0xaf, 0xd5, 0x72, 0xa8, 0x36, 0x8e, 0x6d, 0x5f, 0x17, 0x24, 0x2f, 0xc5, 0x61, 0x5a, 0x4c,
-	0x0d, 0x27, 0x16, 0xb5, 0x36, 0x4e, 0x87, 0xb7, 0xaa, 0x93, 0x3d, 0xbf, 0xb6, 0xe5, 0xcb, 0x52,
-	0xfd, 0xa6, 0x16, 0xc7, 0x77, 0xd2, 0x2d, 0x24, 0x8f, 0x45, 0xd7, 0xc3, 0xa7, 0x02, 0x88, 0x93,
-	0x39, 0xa4, 0x53, 0xf0, 0x94, 0xa0, 0xb3, 0xeb, 0x70, 0xbf, 0xda, 0xae, 0x6c, 0xb8, 0xf3, 0x9a,
-	0x7a, 0xeb, 0xec, 0x5a, 0x0e, 0xc5, 0x3d, 0x0f, 0x94, 0xa3, 0x23, 0xd8, 0x95, 0xdc, 0xae, 0x24,
-	0x77, 0x37, 0xe4, 0x96, 0xe6, 0xe8, 0x4b, 0x20, 0x3a, 0x5b, 0xdb, 0x92, 0x2f, 0xc4, 0xfd, 0x26,
-	0x2b, 0x6a, 0xa2, 0x4d, 0x88, 0x7d, 0x79, 0xb5, 0x2a, 0xa6, 0xf3, 0x1b, 0x71, 0xb7, 0xe6, 0x37,
-	0xc9, 0x8f, 0x2b, 0x56, 0x0e, 0x45, 0xf7, 0x6f, 0xdd, 0xcc, 0x58, 0xa8, 0xa2, 0x2a, 0x55, 0x72,
-	0x57, 0xf5, 0xca, 0x58, 0x38, 0xed, 0x0a, 0x79, 0xb6, 0xd3, 0x7d, 0xbf, 0xce, 0xe1, 0xf4, 0xdd,
-	0xb7, 0x9f, 0x7b, 0xc1, 0xd7, 0x1f, 0x8f, 0x82, 0xcb, 0xd7, 0xd7, 0x7b, 0x33, 0xf9, 0x47, 0xfd,
-	0xff, 0x77, 0x33, 0xd9, 0xaf, 0x7e, 0xd8, 0x67, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x21,
-	0xba, 0xfd, 0x85, 0x03, 0x00, 0x00,
+	// 528 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xc5, 0x34, 0x94, 0x74, 0x43, 0x0f, 0x2c, 0x06, 0x39, 0x41, 0x42, 0x51, 0x25, 0xa4, 0x5c,
+	0x58, 0x97, 0x20, 0x38, 0x56, 0xa2, 0x95, 0xa0, 0x45, 0x44, 0x08, 0x87, 0x53, 0x2f, 0x96, 0xe3,
+	0x4c, 0x9c, 0xa5, 0x9b, 0x1d, 0xb3, 0xb3, 0x0e, 0xc9, 0x4f, 0xc0, 0x6f, 0xf0, 0x09, 0x7c, 0x0f,
+	0xff, 0xc0, 0x1d, 0xd9, 0x6b, 0xb7, 0x09, 0xa8, 0xa8, 0x87, 0x95, 0x66, 0xe6, 0xcd, 0x7b, 0x9a,
+	0x7d, 0x3b, 0xcb, 0x46, 0x99, 0xb4, 0xf3, 0x62, 0x22, 0x52, 0x5c, 0x84, 0x84, 0x0a, 0x9f, 0x49,
+	0x0c, 0x33, 0x85, 0x18, 0xe6, 0x06, 0x3f, 0x43, 0x6a, 0xc9, 0x65, 0x49, 0x2e, 0xc3, 0xe5, 0xf3,
+	0x10, 0xb4, 0x05, 0x93, 0x1b, 0x49, 0x10, 0x62, 0x6e, 0x25, 0x6a, 0x0a, 0xbf, 0x26, 0xb3, 0xf2,
+	0x88, 0xdc, 0xa0, 0x45, 0x1e, 0x94, 0x61, 0x0d, 0x89, 0x92, 0x29, 0x4a, 0x51, 0x21, 0xb1, 0x77,
+	0x74, 0x8d, 0x2a, 0xac, 0x2c, 0x18, 0x9d, 0xa8, 0x10, 0xf4, 0x12, 0xd7, 0x55, 0xaa, 0xe9, 0x5f,
+	0xe5, 0x5e, 0xb7, 0x9a, 0xee, 0x42, 0xda, 0x66, 0x16, 0x03, 0x0d, 0xe4, 0x67, 0x98, 0x61, 0x15,
+	0x86, 0x65, 0x54, 0x57, 0x39, 0xac, 0xac, 0x2b, 0xc2, 0xca, 0xba, 0xda, 0xc1, 0xb7, 0x16, 0x6b,
+	0x8f, 0xc1, 0x5a, 0xa9, 0x33, 0xe2, 0x3d, 0xd6, 0x9e, 0x4a, 0x4a, 0x26, 0x0a, 0xa6, 0x81, 0xd7,
+	0xf7, 0x06, 0xed, 0xe8, 0x32, 0xe7, 0x47, 0xec, 0x71, 0x5a, 0x90, 0xc5, 0x45, 0x2c, 0xcb, 0x2b,
+	0x2f, 0x41, 0x97, 0x57, 0x8a, 0x17, 0x40, 0x94, 0x64, 0x10, 0xdc, 0xee, 0x7b, 0x83, 0xbd, 0xa8,
+	0xeb, 0x5a, 0xce, 0x36, 0x3a, 0x46, 0xae, 0x81, 0x9f, 0xb1, 0xfd, 0x14, 0x0d, 0xc4, 0xa6, 0x50,
+	0x10, 0x13, 0xd8, 0x60, 0xa7, 0xef, 0x0d, 0x3a, 0xc3, 0xa7, 0xe2, 0x3a, 0x7f, 0xc4, 0x09, 0x1a,
+	0x88, 0x0a, 0x05, 0x63, 0xb0, 0x51, 0x27, 0xbd, 0x4a, 0xf8, 0x88, 0xed, 0x35, 0x2a, 0x14, 0xb4,
+	0xfa, 0x3b, 0x83, 0xce, 0xf0, 0x50, 0x54, 0x66, 0x89, 0x14, 0xf5, 0x4c, 0x66, 0x62, 0x26, 0x95,
+	0x05, 0x23, 0xe6, 0xd6, 0xe6, 0x62, 0x81, 0x53, 0x82, 0xb4, 0x30, 0xd2, 0xae, 0xc5, 0x72, 0x28,
+	0x1a, 0xc5, 0xb6, 0x71, 0x01, 0xf1, 0x73, 0xb6, 0x9f, 0x14, 0x53, 0x69, 0x63, 0x85, 0x59, 0x26,
+	0x75, 0x16, 0xdc, 0xa9, 0x26, 0x7b, 0x79, 0x63, 0xc9, 0xd7, 0x25, 0xfb, 0xbd, 0x23, 0x47, 0xf7,
+	0x92, 0x8d, 0x8c, 0x1f, 0x32, 0xdf, 0xc0, 0x97, 0x02, 0xc8, 0xc6, 0x73, 0x48, 0xa6, 0x60, 0x28,
+	0x46, 0xad, 0xd6, 0xc1, 0x6e, 0xe5, 0x2e, 0xaf, 0xb1, 0x53, 0x07, 0x7d, 0xd0, 0x6a, 0xcd, 0x87,
+	0xec, 0xa1, 0x01, 0xca, 0x51, 0x13, 0x6c, 0x53, 0xee, 0x56, 0x94, 0x07, 0x0d, 0xb8, 0xc9, 0x79,
+	0xc7, 0x7c, 0x37, 0x65, 0xbc, 0x48, 0xf2, 0xf8, 0xca, 0x9b, 0x76, 0xe5, 0x4d, 0x57, 0x94, 0xe6,
+	0x5d, 0xda, 0x1a, 0x01, 0x61, 0x61, 0x52, 0x88, 0x60, 0x16, 0xdd, 0x77, 0xb4, 0x51, 0x92, 0xd7,
+	0xb6, 0xd0, 0xc1, 0x77, 0x8f, 0x75, 0x36, 0x9c, 0xe7, 0xaf, 0xd8, 0xa3, 0xfa, 0xdd, 0xa9, 0x5e,
+	0x93, 0x98, 0xac, 0x29, 0x6d, 0xaa, 0x9e, 0xfc, 0xf4, 0x56, 0xe4, 0x3b, 0xbc, 0xd9, 0xa2, 0x71,
+	0x85, 0xf2, 0x21, 0xf3, 0xff, 0xe6, 0xcd, 0xa4, 0x82, 0x60, 0xa7, 0x66, 0xf1, 0x6d, 0xd6, 0x1b,
+	0xa9, 0xe0, 0xd8, 0x67, 0xfc, 0x64, 0xab, 0xfa, 0x69, 0x9d, 0xc3, 0xf1, 0xc7, 0x9f, 0xbf, 0x5b,
+	0xde, 0x8f, 0x5f, 0x4f, 0xbc, 0xf3, 0xb7, 0x37, 0xfb, 0x9a, 0xf9, 0x45, 0xf6, 0xff, 0xef, 0x39,
+	0xd9, 0xad, 0x96, 0xff, 0xc5, 0x9f, 0x01, 0x00, 0xce, 0x62, 0x04, 0x2e, 0xec, 0x03, 0x00, 0x00,
 }
 
 func (this *Settings) Equal(that interface{}) bool {
@@ -292,6 +305,14 @@ func (this *Settings) Equal(that interface{}) bool {
 	if this.ResponseHeadersOnly != that1.ResponseHeadersOnly {
 		return false
 	}
+	if len(this.ConfigMapRuleSets) != len(that1.ConfigMapRuleSets) {
+		return false
+	}
+	for i := range this.ConfigMapRuleSets {
+		if !this.ConfigMapRuleSets[i].Equal(that1.ConfigMapRuleSets[i]) {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -7,6 +7,10 @@ import (
 	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 )
 
+// A subset selector is created for every combination of the subset metadata keys of a consul upstream, so their number
+// is limited: consul upstreams with more keys are rejected, and no selectors are created for their keys.
+const MaxConsulSubsetMetadataKeys = 3
+
 /*
 	These interfaces should be implemented by upstreams that support subset load balancing.
 	https://github.com/envoyproxy/envoy/blob/master/source/docs/subset_load_balancer.md
@@ -31,6 +35,14 @@ func (us *Upstream_Kube) SetSubsetSpec(spec *plugins.SubsetSpec) {
 	us.Kube.SubsetSpec = spec
 }
 
+func (us *Upstream_Static) GetSubsetSpec() *plugins.SubsetSpec {
+	return us.Static.SubsetSpec
+}
+
+func (us *Upstream_Static) SetSubsetSpec(spec *plugins.SubsetSpec) {
+	us.Static.SubsetSpec = spec
+}
+
 func (us *Upstream_Consul) GetSubsetSpec() *plugins.SubsetSpec {
 	subsets := &plugins.SubsetSpec{}
 
@@ -51,11 +63,11 @@ func (us *Upstream_Consul) GetSubsetSpec() *plugins.SubsetSpec {
 		tags = us.Consul.ServiceTags
 	}
 
+	var tagMetadataKeys []string
 	if len(tags) > 0 {
 
 		// If any tags are present, create a subset selector with the tags as key set
 		// This will cause Envoy to partition the endpoints (service instances) by their tags
-		var tagMetadataKeys []string
 		for _, tag := range tags {
 			tagMetadataKeys = append(tagMetadataKeys, constants.ConsulTagKeyPrefix+tag)
 		}
@@ -75,5 +87,64 @@ func (us *Upstream_Consul) GetSubsetSpec() *plugins.SubsetSpec {
 		})
 	}
 
+	// Envoy picks the subset whose keys are exactly the ones the route matches on, and routes only need to match on
+	// some of the service metadata keys, so create a subset selector for each combination of them, alone and together
+	// with the data center and tag keys
+	keyPrefixes := [][]string{nil}
+	if len(dataCenterMetadataKeys) > 0 {
+		keyPrefixes = append(keyPrefixes, dataCenterMetadataKeys)
+	}
+	if len(tagMetadataKeys) > 0 {
+		keyPrefixes = append(keyPrefixes, tagMetadataKeys)
+		if len(dataCenterMetadataKeys) > 0 {
+			keyPrefixes = append(keyPrefixes, append(append([]string{}, dataCenterMetadataKeys...), tagMetadataKeys...))
+		}
+	}
+	for _, metadataKeys := range consulMetadataKeyCombinations(us.Consul.SubsetMetadataKeys) {
+		for _, prefix := range keyPrefixes {
+			var allKeys []string
+			allKeys = append(allKeys, prefix...)
+			allKeys = append(allKeys, metadataKeys...)
+			subsets.Selectors = append(subsets.Selectors, &plugins.Selector{
+				Keys: allKeys,
+			})
+		}
+	}
+
 	return subsets
 }
+
+// Returns every non-empty combination of the given service metadata keys, each sorted and prefixed, or none if there
+// are more than MaxConsulSubsetMetadataKeys distinct keys
+func consulMetadataKeyCombinations(keys []string) [][]string {
+	var metadataKeys []string
+	for _, key := range UniqueConsulSubsetMetadataKeys(keys) {
+		metadataKeys = append(metadataKeys, constants.ConsulMetadataKeyPrefix+key)
+	}
+	if len(metadataKeys) > MaxConsulSubsetMetadataKeys {
+		return nil
+	}
+	sort.Strings(metadataKeys)
+
+	var combinations [][]string
+	for _, key := range metadataKeys {
+		for _, combination := range combinations {
+			combinations = append(combinations, append(append([]string{}, combination...), key))
+		}
+		combinations = append(combinations, []string{key})
+	}
+	return combinations
+}
+
+// Returns the given subset metadata keys without duplicates, in their original order
+func UniqueConsulSubsetMetadataKeys(keys []string) []string {
+	unique := make(map[string]bool)
+	var uniqueKeys []string
+	for _, key := range keys {
+		if !unique[key] {
+			unique[key] = true
+			uniqueKeys = append(uniqueKeys, key)
+		}
+	}
+	return uniqueKeys
+}
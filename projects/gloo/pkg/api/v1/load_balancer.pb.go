@@ -39,6 +39,9 @@ type LoadBalancerConfig struct {
 	// this help lower cpu usage when endpoint change rate is high. defaults to 1 second.
 	// Set to 0 to disable and have changes applied immediately.
 	UpdateMergeWindow *time.Duration `protobuf:"bytes,2,opt,name=update_merge_window,json=updateMergeWindow,proto3,stdduration" json:"update_merge_window,omitempty"`
+	// Enables zone aware routing. Requires the endpoints of the upstream to have localities, and envoy to be
+	// configured with its own locality and the cluster it belongs to (`cluster_manager.local_cluster_name`).
+	ZoneAwareLbConfig *LoadBalancerConfig_ZoneAwareLbConfig `protobuf:"bytes,8,opt,name=zone_aware_lb_config,json=zoneAwareLbConfig,proto3" json:"zone_aware_lb_config,omitempty"`
 	// Types that are valid to be assigned to Type:
 	//	*LoadBalancerConfig_RoundRobin_
 	//	*LoadBalancerConfig_LeastRequest_
@@ -123,6 +126,13 @@ func (m *LoadBalancerConfig) GetUpdateMergeWindow() *time.Duration {
 	return nil
 }
 
+func (m *LoadBalancerConfig) GetZoneAwareLbConfig() *LoadBalancerConfig_ZoneAwareLbConfig {
+	if m != nil {
+		return m.ZoneAwareLbConfig
+	}
+	return nil
+}
+
 func (m *LoadBalancerConfig) GetRoundRobin() *LoadBalancerConfig_RoundRobin {
 	if x, ok := m.GetType().(*LoadBalancerConfig_RoundRobin_); ok {
 		return x.RoundRobin
@@ -169,6 +179,65 @@ func (*LoadBalancerConfig) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// Configures envoy's zone aware routing, which prefers the endpoints in the same zone as envoy.
+// see more info [here](https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/upstream/load_balancing/zone_aware).
+type LoadBalancerConfig_ZoneAwareLbConfig struct {
+	// The percentage of requests to route with zone awareness, between 0-100. Defaults to 100.
+	RoutingEnabled *types.DoubleValue `protobuf:"bytes,1,opt,name=routing_enabled,json=routingEnabled,proto3" json:"routing_enabled,omitempty"`
+	// The minimum number of endpoints in the upstream for zone aware routing to be used. Defaults to 6.
+	MinClusterSize *types.UInt64Value `protobuf:"bytes,2,opt,name=min_cluster_size,json=minClusterSize,proto3" json:"min_cluster_size,omitempty"`
+	// Fail requests instead of routing them to any endpoint when the upstream is in panic mode.
+	FailTrafficOnPanic   bool     `protobuf:"varint,3,opt,name=fail_traffic_on_panic,json=failTrafficOnPanic,proto3" json:"fail_traffic_on_panic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) Reset()         { *m = LoadBalancerConfig_ZoneAwareLbConfig{} }
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) String() string { return proto.CompactTextString(m) }
+func (*LoadBalancerConfig_ZoneAwareLbConfig) ProtoMessage()    {}
+func (*LoadBalancerConfig_ZoneAwareLbConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaa1c019b03e4b0f, []int{0, 0}
+}
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadBalancerConfig_ZoneAwareLbConfig.Unmarshal(m, b)
+}
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadBalancerConfig_ZoneAwareLbConfig.Marshal(b, m, deterministic)
+}
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadBalancerConfig_ZoneAwareLbConfig.Merge(m, src)
+}
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) XXX_Size() int {
+	return xxx_messageInfo_LoadBalancerConfig_ZoneAwareLbConfig.Size(m)
+}
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadBalancerConfig_ZoneAwareLbConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadBalancerConfig_ZoneAwareLbConfig proto.InternalMessageInfo
+
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) GetRoutingEnabled() *types.DoubleValue {
+	if m != nil {
+		return m.RoutingEnabled
+	}
+	return nil
+}
+
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) GetMinClusterSize() *types.UInt64Value {
+	if m != nil {
+		return m.MinClusterSize
+	}
+	return nil
+}
+
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) GetFailTrafficOnPanic() bool {
+	if m != nil {
+		return m.FailTrafficOnPanic
+	}
+	return false
+}
+
 type LoadBalancerConfig_RoundRobin struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -179,7 +248,7 @@ func (m *LoadBalancerConfig_RoundRobin) Reset()         { *m = LoadBalancerConfi
 func (m *LoadBalancerConfig_RoundRobin) String() string { return proto.CompactTextString(m) }
 func (*LoadBalancerConfig_RoundRobin) ProtoMessage()    {}
 func (*LoadBalancerConfig_RoundRobin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aaa1c019b03e4b0f, []int{0, 0}
+	return fileDescriptor_aaa1c019b03e4b0f, []int{0, 1}
 }
 func (m *LoadBalancerConfig_RoundRobin) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoadBalancerConfig_RoundRobin.Unmarshal(m, b)
@@ -211,7 +280,7 @@ func (m *LoadBalancerConfig_LeastRequest) Reset()         { *m = LoadBalancerCon
 func (m *LoadBalancerConfig_LeastRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadBalancerConfig_LeastRequest) ProtoMessage()    {}
 func (*LoadBalancerConfig_LeastRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aaa1c019b03e4b0f, []int{0, 1}
+	return fileDescriptor_aaa1c019b03e4b0f, []int{0, 2}
 }
 func (m *LoadBalancerConfig_LeastRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoadBalancerConfig_LeastRequest.Unmarshal(m, b)
@@ -248,7 +317,7 @@ func (m *LoadBalancerConfig_Random) Reset()         { *m = LoadBalancerConfig_Ra
 func (m *LoadBalancerConfig_Random) String() string { return proto.CompactTextString(m) }
 func (*LoadBalancerConfig_Random) ProtoMessage()    {}
 func (*LoadBalancerConfig_Random) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aaa1c019b03e4b0f, []int{0, 2}
+	return fileDescriptor_aaa1c019b03e4b0f, []int{0, 3}
 }
 func (m *LoadBalancerConfig_Random) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoadBalancerConfig_Random.Unmarshal(m, b)
@@ -286,7 +355,7 @@ func (m *LoadBalancerConfig_RingHashConfig) Reset()         { *m = LoadBalancerC
 func (m *LoadBalancerConfig_RingHashConfig) String() string { return proto.CompactTextString(m) }
 func (*LoadBalancerConfig_RingHashConfig) ProtoMessage()    {}
 func (*LoadBalancerConfig_RingHashConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aaa1c019b03e4b0f, []int{0, 3}
+	return fileDescriptor_aaa1c019b03e4b0f, []int{0, 4}
 }
 func (m *LoadBalancerConfig_RingHashConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoadBalancerConfig_RingHashConfig.Unmarshal(m, b)
@@ -332,7 +401,7 @@ func (m *LoadBalancerConfig_RingHash) Reset()         { *m = LoadBalancerConfig_
 func (m *LoadBalancerConfig_RingHash) String() string { return proto.CompactTextString(m) }
 func (*LoadBalancerConfig_RingHash) ProtoMessage()    {}
 func (*LoadBalancerConfig_RingHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aaa1c019b03e4b0f, []int{0, 4}
+	return fileDescriptor_aaa1c019b03e4b0f, []int{0, 5}
 }
 func (m *LoadBalancerConfig_RingHash) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoadBalancerConfig_RingHash.Unmarshal(m, b)
@@ -369,7 +438,7 @@ func (m *LoadBalancerConfig_Maglev) Reset()         { *m = LoadBalancerConfig_Ma
 func (m *LoadBalancerConfig_Maglev) String() string { return proto.CompactTextString(m) }
 func (*LoadBalancerConfig_Maglev) ProtoMessage()    {}
 func (*LoadBalancerConfig_Maglev) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aaa1c019b03e4b0f, []int{0, 5}
+	return fileDescriptor_aaa1c019b03e4b0f, []int{0, 6}
 }
 func (m *LoadBalancerConfig_Maglev) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoadBalancerConfig_Maglev.Unmarshal(m, b)
@@ -391,6 +460,7 @@ var xxx_messageInfo_LoadBalancerConfig_Maglev proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*LoadBalancerConfig)(nil), "gloo.solo.io.LoadBalancerConfig")
+	proto.RegisterType((*LoadBalancerConfig_ZoneAwareLbConfig)(nil), "gloo.solo.io.LoadBalancerConfig.ZoneAwareLbConfig")
 	proto.RegisterType((*LoadBalancerConfig_RoundRobin)(nil), "gloo.solo.io.LoadBalancerConfig.RoundRobin")
 	proto.RegisterType((*LoadBalancerConfig_LeastRequest)(nil), "gloo.solo.io.LoadBalancerConfig.LeastRequest")
 	proto.RegisterType((*LoadBalancerConfig_Random)(nil), "gloo.solo.io.LoadBalancerConfig.Random")
@@ -404,43 +474,51 @@ func init() {
 }
 
 var fileDescriptor_aaa1c019b03e4b0f = []byte{
-	// 570 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcb, 0x6e, 0xd4, 0x3c,
-	0x14, 0xc7, 0x67, 0xfa, 0xcd, 0x17, 0x8a, 0x3b, 0x2d, 0x34, 0x80, 0x08, 0x11, 0x2a, 0x97, 0x0d,
-	0x37, 0x35, 0xa1, 0x20, 0x36, 0xac, 0x60, 0xca, 0x22, 0x8b, 0x16, 0x90, 0xa9, 0x40, 0xb0, 0x89,
-	0x9c, 0xc4, 0x75, 0x0c, 0x8e, 0x4f, 0x70, 0x9c, 0xde, 0x9e, 0x84, 0x47, 0xe0, 0x11, 0x78, 0x9b,
-	0x4a, 0xbc, 0x03, 0x7b, 0xe4, 0xcb, 0x94, 0x42, 0x55, 0xcd, 0xac, 0xc6, 0xc7, 0xe7, 0xfc, 0xfe,
-	0xe7, 0x32, 0x27, 0x46, 0x2f, 0x18, 0xd7, 0x75, 0x5f, 0x24, 0x25, 0x34, 0x69, 0x07, 0x02, 0xd6,
-	0x39, 0xa4, 0x4c, 0x00, 0xa4, 0xad, 0x82, 0xcf, 0xb4, 0xd4, 0x9d, 0xb3, 0x48, 0xcb, 0xd3, 0xbd,
-	0x8d, 0x54, 0x00, 0xa9, 0xf2, 0x82, 0x08, 0x22, 0x4b, 0xaa, 0x92, 0x56, 0x81, 0x86, 0x70, 0x6c,
-	0x02, 0x12, 0xc3, 0x26, 0x1c, 0xe2, 0x67, 0xe7, 0xc3, 0xd0, 0x6a, 0x0e, 0xb2, 0x4b, 0x45, 0x51,
-	0x93, 0xae, 0xf6, 0x3f, 0x4e, 0x24, 0xbe, 0xca, 0x80, 0x81, 0x3d, 0xa6, 0xe6, 0xe4, 0x6f, 0xd7,
-	0x18, 0x00, 0x13, 0x34, 0xb5, 0x56, 0xd1, 0xef, 0xa6, 0x55, 0xaf, 0x88, 0x11, 0x39, 0xcf, 0xbf,
-	0xaf, 0x48, 0xdb, 0x52, 0xd5, 0x79, 0x7f, 0x48, 0x0f, 0xb4, 0x13, 0xa5, 0x07, 0xda, 0xdd, 0xdd,
-	0x3d, 0x0e, 0x50, 0xb8, 0x05, 0xa4, 0x9a, 0xf8, 0x2e, 0x36, 0x41, 0xee, 0x72, 0x16, 0xee, 0xa0,
-	0xeb, 0x35, 0x25, 0x42, 0xd7, 0x87, 0x79, 0x4b, 0x24, 0x2f, 0x73, 0x5d, 0x2b, 0xda, 0xd5, 0x20,
-	0xaa, 0x68, 0x78, 0x7b, 0x78, 0x7f, 0xe9, 0xc9, 0xcd, 0xc4, 0x25, 0x4b, 0xa6, 0xc9, 0x92, 0x57,
-	0xd0, 0x17, 0x82, 0xbe, 0x27, 0xa2, 0xa7, 0xf8, 0x9a, 0x87, 0xdf, 0x1a, 0x76, 0x67, 0x8a, 0x86,
-	0x6f, 0xd0, 0x95, 0xbe, 0xad, 0x88, 0xa6, 0x79, 0x43, 0x15, 0xa3, 0xf9, 0x3e, 0x97, 0x15, 0xec,
-	0x47, 0x0b, 0x56, 0xf1, 0xc6, 0x59, 0x45, 0xdf, 0xde, 0x64, 0xf4, 0xed, 0xf8, 0xd6, 0x10, 0xaf,
-	0x3a, 0x76, 0xdb, 0xa0, 0x1f, 0x2c, 0x19, 0xbe, 0x46, 0x4b, 0x0a, 0x7a, 0x59, 0xe5, 0x0a, 0x0a,
-	0x2e, 0xa3, 0xff, 0xac, 0xd0, 0xa3, 0xe4, 0xf4, 0x5f, 0x90, 0x9c, 0xed, 0x2e, 0xc1, 0x86, 0xc1,
-	0x06, 0xc9, 0x06, 0x18, 0xa9, 0x13, 0x2b, 0xdc, 0x41, 0xcb, 0x82, 0x92, 0x4e, 0xe7, 0x8a, 0x7e,
-	0xed, 0x69, 0xa7, 0xa3, 0x91, 0x55, 0x5c, 0x9f, 0xa9, 0xb8, 0x65, 0x28, 0xec, 0xa0, 0x6c, 0x80,
-	0xc7, 0xe2, 0x94, 0x1d, 0xbe, 0x44, 0x81, 0x22, 0xb2, 0x82, 0x26, 0xfa, 0xdf, 0xca, 0xdd, 0x9b,
-	0x5d, 0xa0, 0x0d, 0xcf, 0x06, 0xd8, 0x83, 0x61, 0x86, 0x2e, 0x2a, 0x2e, 0x59, 0x6e, 0x76, 0x24,
-	0x0a, 0xac, 0xca, 0x83, 0xd9, 0x2a, 0x5c, 0xb2, 0x8c, 0x74, 0x75, 0x36, 0xc0, 0x8b, 0xca, 0x9f,
-	0x4d, 0x31, 0x0d, 0x61, 0x82, 0xee, 0x45, 0x17, 0xe6, 0x2c, 0x66, 0xdb, 0x86, 0x9b, 0x62, 0x1c,
-	0x18, 0x8f, 0x11, 0xfa, 0x33, 0xc1, 0x78, 0x03, 0x8d, 0x4f, 0x77, 0x1f, 0xde, 0x41, 0xe3, 0xb2,
-	0x06, 0x5e, 0xd2, 0xbc, 0x84, 0x5e, 0x6a, 0xbb, 0x2f, 0xcb, 0x78, 0xc9, 0xdd, 0x6d, 0x9a, 0xab,
-	0x78, 0x11, 0x05, 0xae, 0xc3, 0xb8, 0x46, 0x2b, 0xd3, 0x2a, 0xfd, 0xe6, 0x3d, 0x44, 0xab, 0x0d,
-	0x97, 0xbc, 0xe9, 0x9b, 0xdc, 0x76, 0xdc, 0xf1, 0x23, 0x6a, 0x35, 0x46, 0xf8, 0x92, 0x77, 0x18,
-	0xe2, 0x1d, 0x3f, 0xa2, 0x36, 0x96, 0x1c, 0xfc, 0x13, 0xbb, 0xe0, 0x63, 0x9d, 0x63, 0x1a, 0x1b,
-	0x53, 0xb4, 0x38, 0xcd, 0x14, 0x7e, 0x44, 0x97, 0x4f, 0xa6, 0x99, 0x97, 0x36, 0xaf, 0x5f, 0xeb,
-	0x74, 0xee, 0xa1, 0x3a, 0x13, 0xaf, 0xa8, 0xbf, 0x6c, 0xd3, 0x9a, 0x9b, 0xd7, 0x24, 0x40, 0x23,
-	0x7d, 0xd8, 0xd2, 0xc9, 0xf3, 0x1f, 0xbf, 0x46, 0xc3, 0xef, 0x3f, 0xd7, 0x86, 0x9f, 0x1e, 0xcf,
-	0xf7, 0xb8, 0xb4, 0x5f, 0x98, 0x7f, 0x23, 0x8a, 0xc0, 0x7e, 0x0b, 0x4f, 0x7f, 0x07, 0x00, 0x00,
-	0xff, 0xff, 0x0a, 0xc7, 0x09, 0xc0, 0x97, 0x04, 0x00, 0x00,
+	// 697 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcb, 0x72, 0xd3, 0x3a,
+	0x18, 0xc7, 0x93, 0x9e, 0x9c, 0x9c, 0x54, 0x4d, 0x2f, 0xd1, 0x69, 0xe7, 0xf8, 0x78, 0x98, 0x72,
+	0xd9, 0x70, 0x9b, 0xda, 0xb4, 0x5c, 0x16, 0xac, 0x68, 0x4a, 0x99, 0x30, 0xd3, 0x52, 0xc6, 0x04,
+	0x18, 0xba, 0xd1, 0xc8, 0xb6, 0x62, 0x0b, 0x64, 0xc9, 0xc8, 0x72, 0x93, 0xe6, 0x49, 0x58, 0xf0,
+	0x00, 0x3c, 0x02, 0x2f, 0xc2, 0x9a, 0x19, 0xde, 0x81, 0x3d, 0x23, 0xc9, 0x29, 0xa1, 0x9d, 0x4e,
+	0xb2, 0x8a, 0xbe, 0xcb, 0xef, 0xaf, 0xef, 0xcb, 0xf7, 0xc9, 0xe0, 0x49, 0x42, 0x55, 0x5a, 0x86,
+	0x5e, 0x24, 0x32, 0xbf, 0x10, 0x4c, 0x6c, 0x51, 0xe1, 0x27, 0x4c, 0x08, 0x3f, 0x97, 0xe2, 0x3d,
+	0x89, 0x54, 0x61, 0x2d, 0x9c, 0x53, 0xff, 0x64, 0xdb, 0x67, 0x02, 0xc7, 0x28, 0xc4, 0x0c, 0xf3,
+	0x88, 0x48, 0x2f, 0x97, 0x42, 0x09, 0xd8, 0xd6, 0x09, 0x9e, 0x66, 0x3d, 0x2a, 0xdc, 0x87, 0x97,
+	0xc3, 0x22, 0x57, 0x54, 0xf0, 0xc2, 0x67, 0x61, 0x8a, 0x8b, 0xb4, 0xfa, 0xb1, 0x22, 0xee, 0x7a,
+	0x22, 0x12, 0x61, 0x8e, 0xbe, 0x3e, 0x55, 0xde, 0xcd, 0x44, 0x88, 0x84, 0x11, 0xdf, 0x58, 0x61,
+	0x39, 0xf0, 0xe3, 0x52, 0x62, 0x2d, 0x72, 0x59, 0x7c, 0x28, 0x71, 0x9e, 0x13, 0x59, 0x54, 0x71,
+	0x48, 0x46, 0xca, 0x8a, 0x92, 0x91, 0xb2, 0xbe, 0x1b, 0x9f, 0x17, 0x01, 0x3c, 0x10, 0x38, 0xee,
+	0x56, 0x5d, 0xec, 0x09, 0x3e, 0xa0, 0x09, 0xec, 0x83, 0xff, 0x52, 0x82, 0x99, 0x4a, 0x4f, 0x51,
+	0x8e, 0x39, 0x8d, 0x90, 0x4a, 0x25, 0x29, 0x52, 0xc1, 0x62, 0xa7, 0x7e, 0xad, 0x7e, 0x6b, 0x69,
+	0xe7, 0x8a, 0x67, 0x2f, 0xf3, 0x26, 0x97, 0x79, 0x4f, 0x45, 0x19, 0x32, 0xf2, 0x06, 0xb3, 0x92,
+	0x04, 0x1b, 0x15, 0xfc, 0x52, 0xb3, 0xfd, 0x09, 0x0a, 0x8f, 0xc0, 0xbf, 0x65, 0x1e, 0x63, 0x45,
+	0x50, 0x46, 0x64, 0x42, 0xd0, 0x90, 0xf2, 0x58, 0x0c, 0x9d, 0x05, 0xa3, 0xf8, 0xff, 0x45, 0xc5,
+	0xaa, 0xbd, 0x6e, 0xe3, 0xd3, 0xf7, 0xab, 0xf5, 0xa0, 0x63, 0xd9, 0x43, 0x8d, 0xbe, 0x35, 0x24,
+	0x8c, 0xc0, 0xfa, 0x58, 0x70, 0x82, 0xf0, 0x10, 0x4b, 0x82, 0x58, 0x88, 0x22, 0x53, 0xbe, 0xd3,
+	0x32, 0x8a, 0x3b, 0xde, 0xf4, 0x2c, 0xbc, 0x8b, 0x6d, 0x7a, 0xc7, 0x82, 0x93, 0x5d, 0xcd, 0x1e,
+	0x84, 0xd6, 0x13, 0x74, 0xc6, 0xe7, 0x5d, 0xf0, 0x05, 0x58, 0x92, 0xa2, 0xe4, 0x31, 0x92, 0x22,
+	0xa4, 0xdc, 0xf9, 0xcb, 0x68, 0xdf, 0x9d, 0xa9, 0x1d, 0x68, 0x26, 0xd0, 0x48, 0xaf, 0x16, 0x00,
+	0x79, 0x66, 0xc1, 0x3e, 0x58, 0x66, 0x04, 0x17, 0x0a, 0x49, 0xf2, 0xb1, 0x24, 0x85, 0x72, 0x1a,
+	0x46, 0x71, 0x6b, 0xa6, 0xe2, 0x81, 0xa6, 0x02, 0x0b, 0xf5, 0x6a, 0x41, 0x9b, 0x4d, 0xd9, 0x70,
+	0x17, 0x34, 0x25, 0xe6, 0xb1, 0xc8, 0x9c, 0xbf, 0x8d, 0xdc, 0xcd, 0xd9, 0x05, 0x9a, 0xf4, 0x5e,
+	0x2d, 0xa8, 0x40, 0xd8, 0x03, 0x8b, 0x92, 0xf2, 0x04, 0xe9, 0x45, 0x74, 0x9a, 0x46, 0xe5, 0xf6,
+	0x6c, 0x15, 0xca, 0x93, 0x1e, 0x2e, 0xd2, 0x5e, 0x2d, 0x68, 0xc9, 0xea, 0xac, 0x8b, 0xc9, 0x70,
+	0xc2, 0xc8, 0x89, 0xf3, 0xcf, 0x9c, 0xc5, 0x1c, 0x9a, 0x74, 0x5d, 0x8c, 0x05, 0xdd, 0x6f, 0x75,
+	0xd0, 0xb9, 0x30, 0x1e, 0xb8, 0x0f, 0x56, 0xa5, 0x28, 0x95, 0xae, 0x92, 0x70, 0x1c, 0x32, 0x32,
+	0xdf, 0x3e, 0xae, 0x54, 0xd0, 0xbe, 0x65, 0xe0, 0x33, 0xb0, 0x96, 0x51, 0x8e, 0x22, 0x56, 0x16,
+	0x8a, 0x48, 0x54, 0xd0, 0x31, 0x71, 0x16, 0x2e, 0xd1, 0x79, 0xfd, 0x9c, 0xab, 0x47, 0x0f, 0x2a,
+	0x9d, 0x8c, 0xf2, 0x3d, 0x0b, 0xbd, 0xa2, 0x63, 0x02, 0xb7, 0xc1, 0xc6, 0x00, 0x53, 0x86, 0x94,
+	0xc4, 0x83, 0x01, 0x8d, 0x90, 0xe0, 0xf6, 0xb9, 0x98, 0x25, 0x69, 0x05, 0x50, 0x07, 0xfb, 0x36,
+	0x76, 0xc4, 0xcd, 0x63, 0x70, 0xdb, 0x00, 0xfc, 0xde, 0x0c, 0x77, 0x1b, 0xb4, 0xa7, 0xa7, 0x0a,
+	0xaf, 0x83, 0x76, 0x94, 0x0a, 0x1a, 0x11, 0x14, 0x89, 0x92, 0x2b, 0xd3, 0xdc, 0x72, 0xb0, 0x64,
+	0x7d, 0x7b, 0xda, 0xe5, 0xb6, 0x40, 0xd3, 0x4e, 0xce, 0x4d, 0xc1, 0xca, 0xe4, 0xdf, 0xaf, 0xfe,
+	0x9e, 0x3b, 0xa0, 0x93, 0x51, 0x4e, 0xb3, 0x32, 0x43, 0x66, 0x92, 0xa6, 0x31, 0xad, 0xd1, 0x08,
+	0x56, 0xab, 0x80, 0x26, 0x4c, 0xed, 0x3a, 0x17, 0x8f, 0xce, 0xe5, 0x2e, 0x54, 0xb9, 0x78, 0x34,
+	0x9d, 0xeb, 0x12, 0xd0, 0x9a, 0xdc, 0x04, 0xdf, 0x81, 0xb5, 0xb3, 0x2d, 0x99, 0xbc, 0x37, 0x3b,
+	0x03, 0x7f, 0xee, 0x65, 0xb1, 0x66, 0xb0, 0x22, 0xff, 0xb0, 0x75, 0x6b, 0x76, 0x0f, 0xba, 0x4d,
+	0xd0, 0x50, 0xa7, 0x39, 0xe9, 0x3e, 0xfe, 0xfa, 0xb3, 0x51, 0xff, 0xf2, 0x63, 0xb3, 0x7e, 0x7c,
+	0x6f, 0xbe, 0x2f, 0x73, 0xfe, 0x21, 0xa9, 0x3e, 0xb0, 0x61, 0xd3, 0x8c, 0xf0, 0xfe, 0xaf, 0x01,
+	0x00, 0x47, 0xb7, 0x9a, 0xa1, 0xd4, 0x05, 0x00, 0x00,
 }
 
 func (this *LoadBalancerConfig) Equal(that interface{}) bool {
@@ -474,6 +552,9 @@ func (this *LoadBalancerConfig) Equal(that interface{}) bool {
 	} else if that1.UpdateMergeWindow != nil {
 		return false
 	}
+	if !this.ZoneAwareLbConfig.Equal(that1.ZoneAwareLbConfig) {
+		return false
+	}
 	if that1.Type == nil {
 		if this.Type != nil {
 			return false
@@ -608,6 +689,39 @@ func (this *LoadBalancerConfig_Maglev_) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *LoadBalancerConfig_ZoneAwareLbConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LoadBalancerConfig_ZoneAwareLbConfig)
+	if !ok {
+		that2, ok := that.(LoadBalancerConfig_ZoneAwareLbConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RoutingEnabled.Equal(that1.RoutingEnabled) {
+		return false
+	}
+	if !this.MinClusterSize.Equal(that1.MinClusterSize) {
+		return false
+	}
+	if this.FailTrafficOnPanic != that1.FailTrafficOnPanic {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *LoadBalancerConfig_RoundRobin) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
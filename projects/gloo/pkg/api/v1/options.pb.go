@@ -13,6 +13,8 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
 	v2 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/filter/http/gzip/v2"
+	v31 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/adaptive_concurrency/v3"
+	v3alpha "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/admission_control/v3alpha"
 	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/buffer/v3"
 	proxylatency "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/proxylatency"
 	_ "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
@@ -25,16 +27,22 @@ import (
 	als "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/als"
 	aws "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
 	azure "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/azure"
+	connection_limit "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/connection_limit"
 	cors "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/cors"
+	custom_filters "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/custom_filters"
 	faultinjection "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/faultinjection"
+	gcp "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/gcp"
 	grpc "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
 	grpc_json "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json"
 	grpc_web "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_web"
 	hcm "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/hcm"
 	headers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
 	healthcheck "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/healthcheck"
+	http3 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/http3"
 	lbhash "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/lbhash"
+	local_ratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_ratelimit"
 	protocol_upgrade "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
+	proxy_protocol "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/proxy_protocol"
 	rest "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/rest"
 	retries "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 	shadowing "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/shadowing"
@@ -42,6 +50,7 @@ import (
 	tcp "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tcp"
 	tracing "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tracing"
 	transformation "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/transformation"
+	udp "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/udp"
 	wasm "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 )
@@ -75,9 +84,25 @@ type ListenerOptions struct {
 	// Soft limit on size of the listener's new connection read and write buffers. If unspecified, defaults to 1MiB
 	// For more info, check out the [Envoy docs](https://www.envoyproxy.io/docs/envoy/v1.14.1/api-v2/api/v2/listener.proto)
 	PerConnectionBufferLimitBytes *types.UInt32Value `protobuf:"bytes,3,opt,name=per_connection_buffer_limit_bytes,json=perConnectionBufferLimitBytes,proto3" json:"per_connection_buffer_limit_bytes,omitempty"`
-	XXX_NoUnkeyedLiteral          struct{}           `json:"-"`
-	XXX_unrecognized              []byte             `json:"-"`
-	XXX_sizecache                 int32              `json:"-"`
+	// Serve HTTP/3 on a UDP listener with the same address and port. Only supported on listeners that serve TLS.
+	Http3 *http3.Http3 `protobuf:"bytes,4,opt,name=http3,proto3" json:"http3,omitempty"`
+	// Accept the PROXY protocol on the connections of the listener.
+	ProxyProtocol *proxy_protocol.ProxyProtocol `protobuf:"bytes,5,opt,name=proxy_protocol,json=proxyProtocol,proto3" json:"proxy_protocol,omitempty"`
+	// Configure OS-level tcp keepalive checks on the connections accepted by the listener.
+	TcpKeepalive *ConnectionConfig_TcpKeepAlive `protobuf:"bytes,6,opt,name=tcp_keepalive,json=tcpKeepalive,proto3" json:"tcp_keepalive,omitempty"`
+	// Set SO_REUSEPORT on the listener sockets, so that each worker thread of the proxy gets a socket of its own and
+	// new connections are balanced across them by the kernel.
+	ReusePort *types.BoolValue `protobuf:"bytes,7,opt,name=reuse_port,json=reusePort,proto3" json:"reuse_port,omitempty"`
+	// Additional socket options for the sockets of the listener. The options are also set on the sockets of the
+	// connections accepted by the listener.
+	SocketOptions []*SocketOption `protobuf:"bytes,8,rep,name=socket_options,json=socketOptions,proto3" json:"socket_options,omitempty"`
+	// Envoy network filters that Gloo does not have an option for, added to all the filter chains of the listener.
+	CustomNetworkFilters []*custom_filters.CustomNetworkFilter `protobuf:"bytes,9,rep,name=custom_network_filters,json=customNetworkFilters,proto3" json:"custom_network_filters,omitempty"`
+	// Limit the number of concurrent connections of the listener, to protect the proxy from connection floods.
+	ConnectionLimit      *connection_limit.ConnectionLimit `protobuf:"bytes,10,opt,name=connection_limit,json=connectionLimit,proto3" json:"connection_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
 }
 
 func (m *ListenerOptions) Reset()         { *m = ListenerOptions{} }
@@ -125,6 +150,55 @@ func (m *ListenerOptions) GetPerConnectionBufferLimitBytes() *types.UInt32Value
 	return nil
 }
 
+func (m *ListenerOptions) GetHttp3() *http3.Http3 {
+	if m != nil {
+		return m.Http3
+	}
+	return nil
+}
+
+func (m *ListenerOptions) GetProxyProtocol() *proxy_protocol.ProxyProtocol {
+	if m != nil {
+		return m.ProxyProtocol
+	}
+	return nil
+}
+
+func (m *ListenerOptions) GetTcpKeepalive() *ConnectionConfig_TcpKeepAlive {
+	if m != nil {
+		return m.TcpKeepalive
+	}
+	return nil
+}
+
+func (m *ListenerOptions) GetReusePort() *types.BoolValue {
+	if m != nil {
+		return m.ReusePort
+	}
+	return nil
+}
+
+func (m *ListenerOptions) GetSocketOptions() []*SocketOption {
+	if m != nil {
+		return m.SocketOptions
+	}
+	return nil
+}
+
+func (m *ListenerOptions) GetCustomNetworkFilters() []*custom_filters.CustomNetworkFilter {
+	if m != nil {
+		return m.CustomNetworkFilters
+	}
+	return nil
+}
+
+func (m *ListenerOptions) GetConnectionLimit() *connection_limit.ConnectionLimit {
+	if m != nil {
+		return m.ConnectionLimit
+	}
+	return nil
+}
+
 // Optional, feature-specific configuration that lives on http listeners
 type HttpListenerOptions struct {
 	GrpcWeb                       *grpc_web.GrpcWeb                  `protobuf:"bytes,1,opt,name=grpc_web,json=grpcWeb,proto3" json:"grpc_web,omitempty"`
@@ -172,10 +246,29 @@ type HttpListenerOptions struct {
 	// Exposed envoy config for the gRPC to JSON transcoding filter,
 	// envoy.filters.http.grpc_json_transcoder.
 	// For more, see https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/http/grpc_json_transcoder/v3/transcoder.proto
-	GrpcJsonTranscoder   *grpc_json.GrpcJsonTranscoder `protobuf:"bytes,13,opt,name=grpc_json_transcoder,json=grpcJsonTranscoder,proto3" json:"grpc_json_transcoder,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+	GrpcJsonTranscoder *grpc_json.GrpcJsonTranscoder `protobuf:"bytes,13,opt,name=grpc_json_transcoder,json=grpcJsonTranscoder,proto3" json:"grpc_json_transcoder,omitempty"`
+	// Transformations matched against every request on this listener before a route is selected.
+	// Combined with `clear_route_cache`, headers derived from the body (or from JWT claims, in the `regular` stage)
+	// can then be used by route matchers. Only `request_transforms` are supported at this level.
+	StagedTransformations *transformation.TransformationStages `protobuf:"bytes,14,opt,name=staged_transformations,json=stagedTransformations,proto3" json:"staged_transformations,omitempty"`
+	// Exposed envoy config for the adaptive concurrency filter, envoy.filters.http.adaptive_concurrency.
+	// It limits the number of outstanding requests to the upstreams of this listener based on their sampled latencies,
+	// rejecting the excess requests with a 503.
+	// The concurrency limit is shared by all the routes of the listener, which cannot opt out of it.
+	// For more, see https://www.envoyproxy.io/docs/envoy/v1.16.0/api-v3/extensions/filters/http/adaptive_concurrency/v3/adaptive_concurrency.proto
+	AdaptiveConcurrency *v31.AdaptiveConcurrency `protobuf:"bytes,15,opt,name=adaptive_concurrency,json=adaptiveConcurrency,proto3" json:"adaptive_concurrency,omitempty"`
+	// Exposed envoy config for the admission control filter, envoy.filters.http.admission_control.
+	// It probabilistically rejects requests when the success rate of the upstreams of this listener drops,
+	// shedding load before they are overwhelmed.
+	// The success rate is measured across all the routes of the listener, so failing requests on one route raise the
+	// rejection probability on the others as well.
+	// For more, see https://www.envoyproxy.io/docs/envoy/v1.16.0/api-v3/extensions/filters/http/admission_control/v3alpha/admission_control.proto
+	AdmissionControl *v3alpha.AdmissionControl `protobuf:"bytes,16,opt,name=admission_control,json=admissionControl,proto3" json:"admission_control,omitempty"`
+	// Envoy http filters that Gloo does not have an option for, added to the http filters of the listener.
+	CustomHttpFilters    []*custom_filters.CustomHttpFilter `protobuf:"bytes,17,rep,name=custom_http_filters,json=customHttpFilters,proto3" json:"custom_http_filters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
 }
 
 func (m *HttpListenerOptions) Reset()         { *m = HttpListenerOptions{} }
@@ -293,6 +386,34 @@ func (m *HttpListenerOptions) GetGrpcJsonTranscoder() *grpc_json.GrpcJsonTransco
 	return nil
 }
 
+func (m *HttpListenerOptions) GetStagedTransformations() *transformation.TransformationStages {
+	if m != nil {
+		return m.StagedTransformations
+	}
+	return nil
+}
+
+func (m *HttpListenerOptions) GetAdaptiveConcurrency() *v31.AdaptiveConcurrency {
+	if m != nil {
+		return m.AdaptiveConcurrency
+	}
+	return nil
+}
+
+func (m *HttpListenerOptions) GetAdmissionControl() *v3alpha.AdmissionControl {
+	if m != nil {
+		return m.AdmissionControl
+	}
+	return nil
+}
+
+func (m *HttpListenerOptions) GetCustomHttpFilters() []*custom_filters.CustomHttpFilter {
+	if m != nil {
+		return m.CustomHttpFilters
+	}
+	return nil
+}
+
 // Optional, feature-specific configuration that lives on tcp listeners
 type TcpListenerOptions struct {
 	TcpProxySettings     *tcp.TcpProxySettings `protobuf:"bytes,3,opt,name=tcp_proxy_settings,json=tcpProxySettings,proto3" json:"tcp_proxy_settings,omitempty"`
@@ -332,6 +453,96 @@ func (m *TcpListenerOptions) GetTcpProxySettings() *tcp.TcpProxySettings {
 	return nil
 }
 
+// Optional, feature-specific configuration that lives on tcp hosts.
+// It applies to the connections of the host, in addition to the options of its listener.
+type TcpHostOptions struct {
+	// Overrides the settings of the listener's tcp proxy for the connections of this host.
+	// Each setting that is not specified here is taken from the listener.
+	TcpProxySettings *tcp.TcpProxySettings `protobuf:"bytes,1,opt,name=tcp_proxy_settings,json=tcpProxySettings,proto3" json:"tcp_proxy_settings,omitempty"`
+	// Access logs for the connections of this host. They are written in addition to the access logs of the listener.
+	AccessLoggingService *als.AccessLoggingService `protobuf:"bytes,2,opt,name=access_logging_service,json=accessLoggingService,proto3" json:"access_logging_service,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *TcpHostOptions) Reset()         { *m = TcpHostOptions{} }
+func (m *TcpHostOptions) String() string { return proto.CompactTextString(m) }
+func (*TcpHostOptions) ProtoMessage()    {}
+func (*TcpHostOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94dcee4f7557dfdc, []int{3}
+}
+func (m *TcpHostOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TcpHostOptions.Unmarshal(m, b)
+}
+func (m *TcpHostOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TcpHostOptions.Marshal(b, m, deterministic)
+}
+func (m *TcpHostOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TcpHostOptions.Merge(m, src)
+}
+func (m *TcpHostOptions) XXX_Size() int {
+	return xxx_messageInfo_TcpHostOptions.Size(m)
+}
+func (m *TcpHostOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_TcpHostOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TcpHostOptions proto.InternalMessageInfo
+
+func (m *TcpHostOptions) GetTcpProxySettings() *tcp.TcpProxySettings {
+	if m != nil {
+		return m.TcpProxySettings
+	}
+	return nil
+}
+
+func (m *TcpHostOptions) GetAccessLoggingService() *als.AccessLoggingService {
+	if m != nil {
+		return m.AccessLoggingService
+	}
+	return nil
+}
+
+// Optional, feature-specific configuration that lives on udp listeners
+type UdpListenerOptions struct {
+	UdpProxySettings     *udp.UdpProxySettings `protobuf:"bytes,1,opt,name=udp_proxy_settings,json=udpProxySettings,proto3" json:"udp_proxy_settings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UdpListenerOptions) Reset()         { *m = UdpListenerOptions{} }
+func (m *UdpListenerOptions) String() string { return proto.CompactTextString(m) }
+func (*UdpListenerOptions) ProtoMessage()    {}
+func (*UdpListenerOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94dcee4f7557dfdc, []int{4}
+}
+func (m *UdpListenerOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UdpListenerOptions.Unmarshal(m, b)
+}
+func (m *UdpListenerOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UdpListenerOptions.Marshal(b, m, deterministic)
+}
+func (m *UdpListenerOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UdpListenerOptions.Merge(m, src)
+}
+func (m *UdpListenerOptions) XXX_Size() int {
+	return xxx_messageInfo_UdpListenerOptions.Size(m)
+}
+func (m *UdpListenerOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_UdpListenerOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UdpListenerOptions proto.InternalMessageInfo
+
+func (m *UdpListenerOptions) GetUdpProxySettings() *udp.UdpProxySettings {
+	if m != nil {
+		return m.UdpProxySettings
+	}
+	return nil
+}
+
 // Optional, feature-specific configuration that lives on virtual hosts.
 // Each VirtualHostPlugin object contains configuration for a specific feature.
 // Note to developers: new Virtual Host plugins must be added to this struct
@@ -396,16 +607,23 @@ type VirtualHostOptions struct {
 	// Early transformations stage. These transformations run before most other options are processed.
 	// If the `regular` field is set in here, the `transformations` field is ignored.
 	StagedTransformations *transformation.TransformationStages `protobuf:"bytes,17,opt,name=staged_transformations,json=stagedTransformations,proto3" json:"staged_transformations,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}                             `json:"-"`
-	XXX_unrecognized      []byte                               `json:"-"`
-	XXX_sizecache         int32                                `json:"-"`
+	// Customize the response returned by routes on this virtual host which point to a missing or invalid destination.
+	// Only applies when `replaceInvalidRoutes` is enabled in the Gloo Settings' `invalidConfigPolicy`.
+	// Unset fields fall back to the values configured in the `invalidConfigPolicy`.
+	InvalidRouteResponse *InvalidRouteResponse `protobuf:"bytes,18,opt,name=invalid_route_response,json=invalidRouteResponse,proto3" json:"invalid_route_response,omitempty"`
+	// Rate limits the requests to this virtual host in Envoy, without a rate limit server.
+	// Routes with their own `local_ratelimit` are limited by it instead.
+	LocalRatelimit       *local_ratelimit.TokenBucket `protobuf:"bytes,19,opt,name=local_ratelimit,json=localRatelimit,proto3" json:"local_ratelimit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
 }
 
 func (m *VirtualHostOptions) Reset()         { *m = VirtualHostOptions{} }
 func (m *VirtualHostOptions) String() string { return proto.CompactTextString(m) }
 func (*VirtualHostOptions) ProtoMessage()    {}
 func (*VirtualHostOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_94dcee4f7557dfdc, []int{3}
+	return fileDescriptor_94dcee4f7557dfdc, []int{5}
 }
 func (m *VirtualHostOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VirtualHostOptions.Unmarshal(m, b)
@@ -574,6 +792,20 @@ func (m *VirtualHostOptions) GetStagedTransformations() *transformation.Transfor
 	return nil
 }
 
+func (m *VirtualHostOptions) GetInvalidRouteResponse() *InvalidRouteResponse {
+	if m != nil {
+		return m.InvalidRouteResponse
+	}
+	return nil
+}
+
+func (m *VirtualHostOptions) GetLocalRatelimit() *local_ratelimit.TokenBucket {
+	if m != nil {
+		return m.LocalRatelimit
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*VirtualHostOptions) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -582,6 +814,68 @@ func (*VirtualHostOptions) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// The direct response returned in place of routes which point to a missing or invalid destination.
+// Unlike the global fallback configured in the Gloo Settings, this response is served directly
+// by the replaced routes, so it can be customized per virtual host.
+type InvalidRouteResponse struct {
+	// The HTTP status code of the response.
+	// If unset, the `invalidRouteResponseCode` from the Gloo Settings is used.
+	StatusCode uint32 `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// The body of the response.
+	// If unset, the `invalidRouteResponseBody` from the Gloo Settings is used.
+	Body string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	// Headers to add to the response.
+	ResponseHeadersToAdd []*headers.HeaderValueOption `protobuf:"bytes,3,rep,name=response_headers_to_add,json=responseHeadersToAdd,proto3" json:"response_headers_to_add,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *InvalidRouteResponse) Reset()         { *m = InvalidRouteResponse{} }
+func (m *InvalidRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*InvalidRouteResponse) ProtoMessage()    {}
+func (*InvalidRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94dcee4f7557dfdc, []int{6}
+}
+func (m *InvalidRouteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvalidRouteResponse.Unmarshal(m, b)
+}
+func (m *InvalidRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvalidRouteResponse.Marshal(b, m, deterministic)
+}
+func (m *InvalidRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvalidRouteResponse.Merge(m, src)
+}
+func (m *InvalidRouteResponse) XXX_Size() int {
+	return xxx_messageInfo_InvalidRouteResponse.Size(m)
+}
+func (m *InvalidRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvalidRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvalidRouteResponse proto.InternalMessageInfo
+
+func (m *InvalidRouteResponse) GetStatusCode() uint32 {
+	if m != nil {
+		return m.StatusCode
+	}
+	return 0
+}
+
+func (m *InvalidRouteResponse) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *InvalidRouteResponse) GetResponseHeadersToAdd() []*headers.HeaderValueOption {
+	if m != nil {
+		return m.ResponseHeadersToAdd
+	}
+	return nil
+}
+
 // Optional, feature-specific configuration that lives on routes.
 // Each RouteOption object contains configuration for a specific feature.
 // Note to developers: new Route plugins must be added to this struct
@@ -657,16 +951,35 @@ type RouteOptions struct {
 	// Early transformations stage. These transformations run before most other options are processed.
 	// If the `regular` field is set in here, the `transformations` field is ignored.
 	StagedTransformations *transformation.TransformationStages `protobuf:"bytes,23,opt,name=staged_transformations,json=stagedTransformations,proto3" json:"staged_transformations,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}                             `json:"-"`
-	XXX_unrecognized      []byte                               `json:"-"`
-	XXX_sizecache         int32                                `json:"-"`
+	// Specifies the idle timeout for the route. If not specified, the stream idle timeout of the http connection manager
+	// applies. The timeout is reset whenever data is sent on the stream in either direction. A value of 0 will disable
+	// the route's idle timeout.
+	IdleTimeout *time.Duration `protobuf:"bytes,24,opt,name=idle_timeout,json=idleTimeout,proto3,stdduration" json:"idle_timeout,omitempty"`
+	// If set, the `grpc-timeout` header of gRPC requests is used as the upstream timeout of the route instead of `timeout`,
+	// capped at this value. A value of 0 means the `grpc-timeout` header is not capped. Requests without the header
+	// use `timeout`.
+	MaxGrpcTimeout *time.Duration `protobuf:"bytes,25,opt,name=max_grpc_timeout,json=maxGrpcTimeout,proto3,stdduration" json:"max_grpc_timeout,omitempty"`
+	// If set, this is subtracted from the `grpc-timeout` header of gRPC requests, so the proxy times out before the
+	// client does and can respond with a gRPC status. Only applies if `max_grpc_timeout` is set.
+	GrpcTimeoutOffset *time.Duration `protobuf:"bytes,26,opt,name=grpc_timeout_offset,json=grpcTimeoutOffset,proto3,stdduration" json:"grpc_timeout_offset,omitempty"`
+	// Sends hedged requests to the upstream, to reduce the latency of slow upstream hosts.
+	HedgePolicy *retries.HedgePolicy `protobuf:"bytes,27,opt,name=hedge_policy,json=hedgePolicy,proto3" json:"hedge_policy,omitempty"`
+	// For requests matched on this route, rewrite the portions of the path matched by a regular expression before
+	// forwarding upstream. Can't be set together with `prefix_rewrite`.
+	RegexRewrite *RegexRewrite `protobuf:"bytes,28,opt,name=regex_rewrite,json=regexRewrite,proto3" json:"regex_rewrite,omitempty"`
+	// Rate limits the requests to this route in Envoy, without a rate limit server.
+	// Overrides the `local_ratelimit` of the virtual host.
+	LocalRatelimit       *local_ratelimit.TokenBucket `protobuf:"bytes,29,opt,name=local_ratelimit,json=localRatelimit,proto3" json:"local_ratelimit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
 }
 
 func (m *RouteOptions) Reset()         { *m = RouteOptions{} }
 func (m *RouteOptions) String() string { return proto.CompactTextString(m) }
 func (*RouteOptions) ProtoMessage()    {}
 func (*RouteOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_94dcee4f7557dfdc, []int{4}
+	return fileDescriptor_94dcee4f7557dfdc, []int{7}
 }
 func (m *RouteOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteOptions.Unmarshal(m, b)
@@ -895,6 +1208,48 @@ func (m *RouteOptions) GetStagedTransformations() *transformation.Transformation
 	return nil
 }
 
+func (m *RouteOptions) GetIdleTimeout() *time.Duration {
+	if m != nil {
+		return m.IdleTimeout
+	}
+	return nil
+}
+
+func (m *RouteOptions) GetMaxGrpcTimeout() *time.Duration {
+	if m != nil {
+		return m.MaxGrpcTimeout
+	}
+	return nil
+}
+
+func (m *RouteOptions) GetGrpcTimeoutOffset() *time.Duration {
+	if m != nil {
+		return m.GrpcTimeoutOffset
+	}
+	return nil
+}
+
+func (m *RouteOptions) GetHedgePolicy() *retries.HedgePolicy {
+	if m != nil {
+		return m.HedgePolicy
+	}
+	return nil
+}
+
+func (m *RouteOptions) GetRegexRewrite() *RegexRewrite {
+	if m != nil {
+		return m.RegexRewrite
+	}
+	return nil
+}
+
+func (m *RouteOptions) GetLocalRatelimit() *local_ratelimit.TokenBucket {
+	if m != nil {
+		return m.LocalRatelimit
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*RouteOptions) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -915,6 +1270,7 @@ type DestinationSpec struct {
 	//	*DestinationSpec_Azure
 	//	*DestinationSpec_Rest
 	//	*DestinationSpec_Grpc
+	//	*DestinationSpec_Gcp
 	DestinationType      isDestinationSpec_DestinationType `protobuf_oneof:"destination_type"`
 	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
 	XXX_unrecognized     []byte                            `json:"-"`
@@ -925,7 +1281,7 @@ func (m *DestinationSpec) Reset()         { *m = DestinationSpec{} }
 func (m *DestinationSpec) String() string { return proto.CompactTextString(m) }
 func (*DestinationSpec) ProtoMessage()    {}
 func (*DestinationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_94dcee4f7557dfdc, []int{5}
+	return fileDescriptor_94dcee4f7557dfdc, []int{8}
 }
 func (m *DestinationSpec) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DestinationSpec.Unmarshal(m, b)
@@ -962,11 +1318,15 @@ type DestinationSpec_Rest struct {
 type DestinationSpec_Grpc struct {
 	Grpc *grpc.DestinationSpec `protobuf:"bytes,4,opt,name=grpc,proto3,oneof" json:"grpc,omitempty"`
 }
+type DestinationSpec_Gcp struct {
+	Gcp *gcp.DestinationSpec `protobuf:"bytes,5,opt,name=gcp,proto3,oneof" json:"gcp,omitempty"`
+}
 
 func (*DestinationSpec_Aws) isDestinationSpec_DestinationType()   {}
 func (*DestinationSpec_Azure) isDestinationSpec_DestinationType() {}
 func (*DestinationSpec_Rest) isDestinationSpec_DestinationType()  {}
 func (*DestinationSpec_Grpc) isDestinationSpec_DestinationType()  {}
+func (*DestinationSpec_Gcp) isDestinationSpec_DestinationType()   {}
 
 func (m *DestinationSpec) GetDestinationType() isDestinationSpec_DestinationType {
 	if m != nil {
@@ -1003,6 +1363,13 @@ func (m *DestinationSpec) GetGrpc() *grpc.DestinationSpec {
 	return nil
 }
 
+func (m *DestinationSpec) GetGcp() *gcp.DestinationSpec {
+	if x, ok := m.GetDestinationType().(*DestinationSpec_Gcp); ok {
+		return x.Gcp
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*DestinationSpec) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1010,6 +1377,7 @@ func (*DestinationSpec) XXX_OneofWrappers() []interface{} {
 		(*DestinationSpec_Azure)(nil),
 		(*DestinationSpec_Rest)(nil),
 		(*DestinationSpec_Grpc)(nil),
+		(*DestinationSpec_Gcp)(nil),
 	}
 }
 
@@ -1049,7 +1417,7 @@ func (m *WeightedDestinationOptions) Reset()         { *m = WeightedDestinationO
 func (m *WeightedDestinationOptions) String() string { return proto.CompactTextString(m) }
 func (*WeightedDestinationOptions) ProtoMessage()    {}
 func (*WeightedDestinationOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_94dcee4f7557dfdc, []int{6}
+	return fileDescriptor_94dcee4f7557dfdc, []int{9}
 }
 func (m *WeightedDestinationOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WeightedDestinationOptions.Unmarshal(m, b)
@@ -1112,14 +1480,69 @@ func (m *WeightedDestinationOptions) GetStagedTransformations() *transformation.
 	return nil
 }
 
+// Rewrites the portions of a string matched by a regular expression with a substitution string.
+type RegexRewrite struct {
+	// The RE2 regular expression matched against the path. All its matches are replaced, anchors can be used to
+	// only replace a single one.
+	Pattern string `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// The string the matches of the pattern are replaced with. Capture groups of the pattern can be referenced
+	// with `\1`, `\2`, etc.
+	Substitution         string   `protobuf:"bytes,2,opt,name=substitution,proto3" json:"substitution,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegexRewrite) Reset()         { *m = RegexRewrite{} }
+func (m *RegexRewrite) String() string { return proto.CompactTextString(m) }
+func (*RegexRewrite) ProtoMessage()    {}
+func (*RegexRewrite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94dcee4f7557dfdc, []int{10}
+}
+func (m *RegexRewrite) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegexRewrite.Unmarshal(m, b)
+}
+func (m *RegexRewrite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegexRewrite.Marshal(b, m, deterministic)
+}
+func (m *RegexRewrite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegexRewrite.Merge(m, src)
+}
+func (m *RegexRewrite) XXX_Size() int {
+	return xxx_messageInfo_RegexRewrite.Size(m)
+}
+func (m *RegexRewrite) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegexRewrite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegexRewrite proto.InternalMessageInfo
+
+func (m *RegexRewrite) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *RegexRewrite) GetSubstitution() string {
+	if m != nil {
+		return m.Substitution
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ListenerOptions)(nil), "gloo.solo.io.ListenerOptions")
 	proto.RegisterType((*HttpListenerOptions)(nil), "gloo.solo.io.HttpListenerOptions")
 	proto.RegisterType((*TcpListenerOptions)(nil), "gloo.solo.io.TcpListenerOptions")
+	proto.RegisterType((*TcpHostOptions)(nil), "gloo.solo.io.TcpHostOptions")
+	proto.RegisterType((*UdpListenerOptions)(nil), "gloo.solo.io.UdpListenerOptions")
 	proto.RegisterType((*VirtualHostOptions)(nil), "gloo.solo.io.VirtualHostOptions")
+	proto.RegisterType((*InvalidRouteResponse)(nil), "gloo.solo.io.InvalidRouteResponse")
 	proto.RegisterType((*RouteOptions)(nil), "gloo.solo.io.RouteOptions")
 	proto.RegisterType((*DestinationSpec)(nil), "gloo.solo.io.DestinationSpec")
 	proto.RegisterType((*WeightedDestinationOptions)(nil), "gloo.solo.io.WeightedDestinationOptions")
+	proto.RegisterType((*RegexRewrite)(nil), "gloo.solo.io.RegexRewrite")
 }
 
 func init() {
@@ -1127,133 +1550,177 @@ func init() {
 }
 
 var fileDescriptor_94dcee4f7557dfdc = []byte{
-	// 2002 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xdb, 0x72, 0x1c, 0x47,
-	0x19, 0xd6, 0x4a, 0x6b, 0xc9, 0x6a, 0xc9, 0x92, 0xdc, 0x76, 0xcc, 0xa0, 0x8a, 0x83, 0x2d, 0x0a,
-	0xe2, 0x18, 0xd2, 0xeb, 0xac, 0x02, 0x8e, 0xe5, 0x50, 0x41, 0x52, 0x6c, 0xaf, 0x89, 0x52, 0xa8,
-	0x5a, 0x8a, 0x6d, 0xa0, 0xa8, 0xa9, 0xde, 0xd9, 0xde, 0xd9, 0x51, 0x46, 0xd3, 0x43, 0x77, 0x8f,
-	0x56, 0xf2, 0x15, 0x0f, 0x00, 0xf7, 0xf0, 0x06, 0xdc, 0x70, 0x0d, 0x8f, 0xc0, 0x15, 0xaf, 0x40,
-	0x15, 0x0f, 0xc0, 0x1d, 0xf7, 0x54, 0x1f, 0xe6, 0xb0, 0xab, 0x19, 0xed, 0xac, 0xa2, 0x70, 0x31,
-	0xb3, 0x73, 0xe8, 0xef, 0xeb, 0xe3, 0xff, 0x7d, 0xff, 0xf4, 0x82, 0x2d, 0x3f, 0x90, 0x83, 0xa4,
-	0x8b, 0x3c, 0x76, 0xdc, 0x12, 0x2c, 0x64, 0x1f, 0x06, 0xac, 0xe5, 0x87, 0x8c, 0xb5, 0x62, 0xce,
-	0x8e, 0xa8, 0x27, 0x85, 0xb9, 0x23, 0x71, 0xd0, 0x3a, 0xf9, 0xa8, 0xc5, 0x62, 0x19, 0xb0, 0x48,
-	0xa0, 0x98, 0x33, 0xc9, 0xe0, 0xb2, 0x7a, 0x85, 0x14, 0x0a, 0x05, 0x6c, 0xfd, 0x5d, 0x9f, 0x31,
-	0x3f, 0xa4, 0x2d, 0xfd, 0xae, 0x9b, 0xf4, 0x5b, 0x42, 0xf2, 0xc4, 0x93, 0xa6, 0xec, 0xfa, 0x6d,
-	0x9f, 0xf9, 0x4c, 0x5f, 0xb6, 0xd4, 0x95, 0x7d, 0x0a, 0xe9, 0xa9, 0x34, 0x0f, 0xe9, 0x69, 0x5a,
-	0xf2, 0x61, 0x75, 0xf5, 0xf4, 0x54, 0xd2, 0x48, 0xe4, 0x2d, 0x58, 0xff, 0x68, 0x62, 0x53, 0x5b,
-	0x1e, 0xe3, 0xe6, 0x54, 0x1f, 0xc2, 0xa9, 0x90, 0xfa, 0x54, 0x1f, 0xe2, 0xf3, 0xd8, 0xd3, 0x27,
-	0x0b, 0x99, 0x3c, 0x86, 0x2d, 0x12, 0xea, 0xc3, 0x02, 0x9e, 0xd4, 0xab, 0xc3, 0x1d, 0xd2, 0x6e,
-	0x76, 0x61, 0xa1, 0x4f, 0x6b, 0x42, 0x8f, 0x04, 0x8b, 0xf2, 0xab, 0xfa, 0x0d, 0x1d, 0x78, 0xc7,
-	0xea, 0xb0, 0x80, 0x9f, 0x4c, 0x06, 0x84, 0xdd, 0x01, 0x11, 0x03, 0xfb, 0x53, 0xbf, 0x91, 0x62,
-	0x40, 0x7a, 0x6c, 0x18, 0x44, 0x7e, 0x7e, 0x55, 0xbf, 0x91, 0xd2, 0x8b, 0xd5, 0x61, 0x01, 0x8f,
-	0x6b, 0x00, 0x38, 0xf1, 0x54, 0x5d, 0xf6, 0xb7, 0x3e, 0x90, 0x53, 0xc9, 0x03, 0x9a, 0xfd, 0x5a,
-	0xe0, 0x66, 0x8d, 0xfe, 0x49, 0x22, 0xed, 0xd9, 0x82, 0x3e, 0x9d, 0x0c, 0xea, 0x93, 0x24, 0x94,
-	0x41, 0xa4, 0x0a, 0x04, 0x2c, 0x32, 0xb7, 0xf5, 0xdb, 0x3a, 0xa0, 0xa4, 0x47, 0x79, 0xf6, 0x3b,
-	0xc5, 0xe2, 0x1c, 0xea, 0xa3, 0x7e, 0x00, 0x0c, 0x89, 0x38, 0xd6, 0xa7, 0xfa, 0xe3, 0x41, 0xde,
-	0x26, 0x9c, 0x9a, 0xb3, 0x05, 0x7d, 0x56, 0xab, 0x47, 0xa1, 0x1c, 0x78, 0x03, 0xea, 0x7d, 0x5d,
-	0xbc, 0xb6, 0x04, 0x2f, 0x27, 0x13, 0xe8, 0x82, 0x1e, 0x0b, 0xdd, 0x24, 0xf6, 0x39, 0xe9, 0xd1,
-	0x73, 0x0f, 0x2c, 0xd5, 0x61, 0x05, 0x95, 0xd2, 0x20, 0x1e, 0x91, 0xb0, 0x45, 0xa3, 0x13, 0x76,
-	0x56, 0x90, 0x24, 0xb5, 0x92, 0x22, 0xd1, 0x67, 0xfc, 0x98, 0xe8, 0xa9, 0x1a, 0xbd, 0xb5, 0xac,
-	0xfb, 0x53, 0xb3, 0xc6, 0x9c, 0x9d, 0x9e, 0x85, 0x44, 0xd2, 0xc8, 0x3b, 0x1b, 0xb9, 0xb9, 0x74,
-	0x3b, 0xfb, 0x41, 0x28, 0xf5, 0xa2, 0x90, 0x32, 0x6e, 0x75, 0x93, 0x7e, 0x9f, 0xf2, 0xd6, 0xc9,
-	0xa6, 0xbd, 0xb2, 0xac, 0x5f, 0xd4, 0x63, 0xf5, 0x58, 0xd4, 0x0f, 0x7c, 0xcb, 0x68, 0x08, 0xfd,
-	0xb7, 0x41, 0xdc, 0x3a, 0x69, 0xeb, 0x5f, 0x4b, 0xf6, 0xec, 0x02, 0x45, 0x8f, 0x24, 0xe5, 0x31,
-	0x0f, 0x04, 0xcd, 0x26, 0x88, 0x9e, 0x4a, 0x92, 0xc8, 0x81, 0xd5, 0x7b, 0x75, 0x69, 0x69, 0xb6,
-	0xa6, 0xa2, 0x39, 0x1a, 0x4a, 0x75, 0x58, 0xec, 0xf3, 0xa9, 0xb0, 0x9c, 0x48, 0x1a, 0x06, 0xc7,
-	0x81, 0xcc, 0xaf, 0x26, 0x47, 0x6c, 0x19, 0x4f, 0x97, 0x78, 0xfa, 0x74, 0xa9, 0x1e, 0x0c, 0x49,
-	0x5f, 0x1d, 0x97, 0xc2, 0xf6, 0xc2, 0x58, 0x1d, 0x93, 0x27, 0xa0, 0x20, 0x87, 0x13, 0x17, 0xef,
-	0x7b, 0xe3, 0x0e, 0xdf, 0x4b, 0xf8, 0x85, 0xef, 0x87, 0x9c, 0xc4, 0x71, 0xa6, 0x3b, 0x1b, 0x7f,
-	0x9e, 0x05, 0xab, 0x7b, 0x81, 0x90, 0x34, 0xa2, 0xfc, 0x97, 0xa6, 0x5e, 0xd8, 0x03, 0x77, 0x88,
-	0xe7, 0x51, 0x21, 0xdc, 0x90, 0xf9, 0x7e, 0x10, 0xf9, 0xae, 0xa0, 0xfc, 0x24, 0xf0, 0xa8, 0xd3,
-	0xb8, 0xd7, 0x78, 0xb0, 0xd4, 0x46, 0x48, 0x79, 0x64, 0x9a, 0x77, 0x14, 0x13, 0x0e, 0xb4, 0xad,
-	0x71, 0x7b, 0x06, 0x76, 0x60, 0x50, 0xf8, 0x36, 0x29, 0x79, 0x0a, 0x3f, 0x01, 0x20, 0x0f, 0x00,
-	0x67, 0x56, 0x33, 0x3b, 0xa3, 0x6c, 0xcf, 0xb2, 0xf7, 0xb8, 0x50, 0x16, 0xf6, 0xc1, 0xfd, 0x98,
-	0x72, 0xd7, 0x63, 0x51, 0x64, 0x24, 0xd8, 0x35, 0x71, 0xe2, 0xea, 0x55, 0xe1, 0x76, 0xcf, 0x24,
-	0x15, 0xce, 0x9c, 0x26, 0x7c, 0x17, 0x99, 0xfe, 0xa3, 0xb4, 0xff, 0xe8, 0xab, 0x97, 0x91, 0xdc,
-	0x6c, 0xbf, 0x22, 0x61, 0x42, 0xf1, 0xdd, 0x98, 0xf2, 0xdd, 0x8c, 0x65, 0x47, 0x93, 0xec, 0x29,
-	0x8e, 0x1d, 0x45, 0xb1, 0xf1, 0x9f, 0x05, 0x70, 0xab, 0x23, 0x65, 0x3c, 0x3e, 0x3e, 0xdb, 0xe0,
-	0x7a, 0x6a, 0xf7, 0x76, 0x44, 0x7e, 0x88, 0x32, 0xff, 0x2f, 0x1d, 0x96, 0x17, 0x3c, 0xf6, 0x5e,
-	0xd3, 0x2e, 0x5e, 0xf0, 0xcd, 0x05, 0xfc, 0x7d, 0x03, 0xdc, 0x53, 0xa1, 0x59, 0xec, 0xc4, 0x31,
-	0x89, 0x88, 0x4f, 0xb9, 0x2b, 0xa8, 0x94, 0x41, 0xe4, 0xa7, 0x63, 0xf2, 0x18, 0x29, 0xa3, 0x2f,
-	0xa5, 0x55, 0x8d, 0xcb, 0xdb, 0xff, 0xa5, 0xc1, 0x1f, 0x58, 0x38, 0xbe, 0x3b, 0xb8, 0xe8, 0x35,
-	0xdc, 0x07, 0xcb, 0x46, 0xac, 0x5d, 0xad, 0xd6, 0x4e, 0x53, 0xd7, 0xf6, 0x21, 0x2a, 0x2a, 0x78,
-	0x79, 0xad, 0xba, 0xc0, 0xae, 0x2a, 0x80, 0x97, 0x06, 0xf9, 0xcd, 0xd8, 0x8c, 0xce, 0x4d, 0x31,
-	0xa3, 0x1f, 0x83, 0xb9, 0x21, 0xe9, 0x3b, 0xd7, 0x34, 0x64, 0x03, 0xa9, 0x08, 0x2b, 0xad, 0x3a,
-	0xeb, 0x9b, 0x2a, 0x0e, 0x3f, 0x01, 0x73, 0xbd, 0x30, 0x76, 0xe6, 0xed, 0x14, 0xa8, 0xd8, 0x2a,
-	0x45, 0x3d, 0xd7, 0x52, 0xb8, 0xab, 0x75, 0x11, 0x2b, 0x08, 0x7c, 0x0a, 0x9a, 0xca, 0x17, 0x9d,
-	0x05, 0x0d, 0x7d, 0x1f, 0x69, 0x93, 0x2c, 0xc5, 0xee, 0x87, 0x89, 0x1f, 0x44, 0x07, 0x2c, 0xe1,
-	0x1e, 0xc5, 0x1a, 0x04, 0x9f, 0x82, 0x05, 0x2b, 0x82, 0x0e, 0xd0, 0xf8, 0xfb, 0x28, 0x8f, 0xf6,
-	0x8a, 0xf6, 0xa6, 0x08, 0x78, 0x00, 0xd6, 0x32, 0xfd, 0xd2, 0x61, 0x45, 0xb9, 0xb3, 0xa4, 0x59,
-	0x1e, 0xa0, 0x5c, 0xd8, 0x2e, 0xee, 0xfc, 0x6a, 0x56, 0xf0, 0x40, 0x13, 0xc0, 0x2d, 0xd0, 0x54,
-	0xd2, 0xee, 0x5c, 0xb7, 0x23, 0xa1, 0x8d, 0x00, 0x19, 0x23, 0x40, 0xc6, 0x08, 0x90, 0x5a, 0x0c,
-	0x48, 0x1b, 0xc0, 0x49, 0x1b, 0xbd, 0x78, 0x1b, 0xc4, 0x58, 0x63, 0xe0, 0x6f, 0xc0, 0x0d, 0xed,
-	0x60, 0xae, 0xb5, 0x30, 0x67, 0x51, 0x93, 0xfc, 0xb4, 0x9a, 0x64, 0xc4, 0xf0, 0x4e, 0xda, 0x68,
-	0x5f, 0xdd, 0xef, 0x99, 0x7b, 0xbc, 0x1c, 0x17, 0xee, 0xe0, 0x0b, 0x30, 0x6f, 0x42, 0xd3, 0x59,
-	0xd6, 0xac, 0x2d, 0xcb, 0x5a, 0xf8, 0x68, 0xb0, 0xce, 0x67, 0xa8, 0xad, 0xdf, 0x9d, 0x6c, 0x22,
-	0x13, 0x8c, 0xd8, 0xc2, 0x61, 0x0f, 0xdc, 0xce, 0xb2, 0x64, 0x57, 0x0b, 0xa1, 0xc7, 0x7a, 0x94,
-	0x3b, 0x37, 0x34, 0x6d, 0x1b, 0xe5, 0x29, 0x74, 0x65, 0xfc, 0xfd, 0x42, 0xb0, 0xe8, 0x30, 0x43,
-	0x62, 0xe8, 0x9f, 0x7b, 0xb6, 0x11, 0x01, 0x78, 0xe8, 0x9d, 0x0b, 0xf7, 0x37, 0x00, 0x4a, 0x2f,
-	0x76, 0xcd, 0x28, 0x65, 0xc1, 0x69, 0x96, 0xf7, 0x43, 0xa4, 0x12, 0xdc, 0xd2, 0x3a, 0x0f, 0xbd,
-	0x58, 0x8f, 0x4c, 0x36, 0x6d, 0x6b, 0x72, 0xec, 0xc9, 0xc6, 0x3f, 0x96, 0x00, 0x7c, 0x15, 0x70,
-	0x99, 0x90, 0xb0, 0xc3, 0x84, 0x4c, 0x2b, 0x1c, 0x8d, 0xa3, 0xc6, 0x14, 0x71, 0xb4, 0x0b, 0x16,
-	0x6c, 0x0a, 0x6c, 0x63, 0xe9, 0x03, 0x94, 0xa6, 0xc4, 0xa5, 0x6d, 0xc4, 0x54, 0xf2, 0xb3, 0x7d,
-	0x16, 0x06, 0xde, 0x19, 0x4e, 0x91, 0xf0, 0x31, 0xb8, 0xa6, 0x13, 0xe2, 0x6c, 0x75, 0x9b, 0xf4,
-	0xb8, 0x7c, 0x4d, 0xaa, 0x57, 0xd8, 0x94, 0x87, 0x04, 0xdc, 0x32, 0x49, 0xad, 0x92, 0xb2, 0x20,
-	0x4e, 0x42, 0x6d, 0x44, 0x56, 0xc6, 0x1e, 0xa1, 0x34, 0xe1, 0xad, 0x12, 0x95, 0x1e, 0xe5, 0x5f,
-	0x16, 0x70, 0x18, 0x0e, 0xce, 0x3d, 0x83, 0x4f, 0x40, 0x53, 0x7d, 0x37, 0xda, 0xd1, 0xff, 0x01,
-	0xd2, 0x1f, 0x91, 0xa5, 0x84, 0xbb, 0x8c, 0x0b, 0xdb, 0x33, 0x0d, 0x81, 0x5d, 0xb0, 0x3a, 0xea,
-	0xa0, 0xc2, 0x4a, 0xde, 0xc7, 0x68, 0xcc, 0x59, 0xcb, 0xa7, 0x73, 0x14, 0xbb, 0x33, 0xeb, 0x34,
-	0xf0, 0x38, 0x21, 0xfc, 0x15, 0xc8, 0x63, 0xd3, 0xed, 0x12, 0x11, 0x78, 0x56, 0x9d, 0x1e, 0x4d,
-	0x0a, 0xee, 0x97, 0x91, 0xcf, 0xa9, 0x10, 0x98, 0x48, 0xaa, 0x1d, 0x08, 0xaf, 0x64, 0x80, 0x1d,
-	0xc5, 0x03, 0x5f, 0x83, 0xc5, 0xec, 0x89, 0xf3, 0xdc, 0x3a, 0xc3, 0x04, 0xd2, 0x8c, 0xed, 0xd5,
-	0x80, 0x09, 0x99, 0xad, 0x99, 0xce, 0x0c, 0xce, 0xb9, 0xa0, 0x07, 0xa0, 0xba, 0xb1, 0xe6, 0x69,
-	0xe2, 0x5d, 0x38, 0x2f, 0x74, 0x0d, 0x9b, 0xb5, 0x6b, 0xb0, 0xea, 0x4a, 0xfb, 0xa2, 0x33, 0x83,
-	0xb5, 0xc4, 0x15, 0x1e, 0x67, 0x02, 0x7f, 0x7d, 0x3a, 0x81, 0xdf, 0x02, 0x73, 0x47, 0x43, 0x69,
-	0x15, 0xe9, 0x01, 0x52, 0xa9, 0x63, 0x29, 0x6a, 0xb4, 0x7b, 0x58, 0x81, 0xe0, 0xcf, 0x41, 0x53,
-	0x65, 0x79, 0x56, 0x5c, 0x7f, 0x8c, 0x74, 0xca, 0x57, 0x8a, 0xce, 0x80, 0x59, 0xe5, 0x1a, 0xa9,
-	0x82, 0x29, 0xd5, 0xf9, 0x65, 0x1b, 0x4c, 0x55, 0x3a, 0xff, 0xec, 0x54, 0x6e, 0x27, 0x72, 0x90,
-	0x37, 0x21, 0xd3, 0xfb, 0xb6, 0xf1, 0x28, 0xa3, 0x53, 0xf7, 0xaa, 0x3d, 0xaa, 0xe8, 0x4e, 0x04,
-	0xac, 0xd9, 0x84, 0x46, 0xa5, 0x39, 0x9c, 0x25, 0x92, 0x3a, 0x2b, 0x76, 0xc6, 0xa7, 0xd3, 0xcf,
-	0x7d, 0xca, 0xb1, 0x82, 0xe3, 0x95, 0xee, 0xc8, 0x3d, 0xfc, 0x2d, 0xb8, 0x1b, 0x44, 0x5e, 0x98,
-	0xf4, 0xa8, 0xcb, 0xe9, 0xef, 0x12, 0x2a, 0xa4, 0x4b, 0xa4, 0xa4, 0xc7, 0xb1, 0x5a, 0x01, 0x49,
-	0x24, 0x9d, 0x55, 0x5d, 0xdf, 0xfa, 0xb9, 0xf4, 0x69, 0x87, 0xb1, 0xd0, 0x24, 0x4f, 0xeb, 0x96,
-	0x00, 0x1b, 0xfc, 0xb6, 0x81, 0xef, 0x2a, 0x34, 0xec, 0x81, 0xfb, 0x29, 0xfd, 0x08, 0xad, 0x1b,
-	0x44, 0x2e, 0xa7, 0x22, 0x66, 0x91, 0xa0, 0xce, 0xda, 0xc4, 0x2a, 0xd2, 0x36, 0x16, 0xb9, 0x5f,
-	0x46, 0xd8, 0x12, 0xc0, 0x18, 0xdc, 0x11, 0x92, 0xf8, 0xb4, 0xe7, 0x8e, 0x07, 0xf6, 0x4d, 0x4d,
-	0xfd, 0xe4, 0x12, 0x81, 0x7d, 0xa0, 0x08, 0x05, 0x7e, 0xc7, 0x10, 0x8f, 0x07, 0xbd, 0x03, 0xee,
-	0x9c, 0x8b, 0x15, 0x57, 0x9e, 0xc5, 0x74, 0xe3, 0x9f, 0x2b, 0x60, 0x59, 0x0f, 0x6d, 0x2a, 0xe2,
-	0x25, 0x72, 0xd3, 0xb8, 0x6a, 0xb9, 0xf9, 0x0c, 0xcc, 0xeb, 0xcd, 0x87, 0x34, 0x55, 0x7c, 0x1f,
-	0x99, 0xbd, 0x88, 0xf2, 0x50, 0x55, 0xad, 0x7b, 0xae, 0x8b, 0x63, 0x0b, 0x83, 0xbb, 0x60, 0x25,
-	0xe6, 0xb4, 0x1f, 0x9c, 0xba, 0x9c, 0x0e, 0x79, 0x20, 0x69, 0x65, 0xda, 0x7c, 0x20, 0x79, 0x10,
-	0xf9, 0x66, 0x5a, 0x6e, 0x18, 0x0c, 0x36, 0x10, 0xf8, 0x04, 0x2c, 0xc8, 0xe0, 0x98, 0xb2, 0x44,
-	0x5a, 0x41, 0xfd, 0xee, 0x39, 0xf4, 0xe7, 0xf6, 0xa3, 0x64, 0xa7, 0xf9, 0xa7, 0x7f, 0x7d, 0xaf,
-	0x81, 0xd3, 0xf2, 0x57, 0xe3, 0x57, 0xa3, 0x76, 0x39, 0x3f, 0x85, 0x5d, 0xee, 0x81, 0x05, 0xbb,
-	0xd5, 0x64, 0x33, 0xc1, 0x36, 0x4a, 0xb7, 0x9e, 0xaa, 0x87, 0xf0, 0xd0, 0x94, 0xc8, 0x53, 0x3b,
-	0x0b, 0x81, 0x7b, 0x60, 0x31, 0xdb, 0x24, 0xb3, 0x4a, 0x87, 0x50, 0xbe, 0x6d, 0x56, 0xcd, 0x78,
-	0x90, 0x96, 0xc1, 0x39, 0x41, 0x95, 0x99, 0x2e, 0x5e, 0xa1, 0x99, 0x7e, 0x1f, 0x2c, 0x2b, 0xe1,
-	0xcc, 0xe6, 0x5e, 0xf9, 0xfd, 0x62, 0x67, 0x06, 0x2f, 0xa9, 0xa7, 0xe9, 0xec, 0x76, 0xc0, 0x4d,
-	0x92, 0x48, 0xe6, 0x8e, 0x94, 0xbc, 0x35, 0x29, 0x74, 0x3b, 0x33, 0x78, 0x55, 0xc1, 0x3a, 0x05,
-	0xa6, 0xd4, 0xbb, 0x97, 0xa6, 0xf7, 0xee, 0x2f, 0xc0, 0x42, 0xd8, 0x75, 0x07, 0x44, 0xa4, 0x52,
-	0xdc, 0x46, 0x76, 0x27, 0xb3, 0x7a, 0x54, 0xb7, 0xf5, 0x57, 0x4f, 0x87, 0x88, 0x81, 0xd5, 0xd6,
-	0xf9, 0xb0, 0xab, 0xee, 0xe0, 0x1b, 0x70, 0xdd, 0x6e, 0x2b, 0x09, 0xe7, 0x9d, 0x7b, 0x73, 0x0f,
-	0x96, 0xda, 0x9f, 0xa2, 0x73, 0x1b, 0x4e, 0xe5, 0x1f, 0x03, 0xb6, 0xd4, 0x57, 0xa6, 0x90, 0xe5,
-	0xcd, 0xd8, 0xca, 0xec, 0xff, 0xc6, 0x15, 0xd9, 0xff, 0x9b, 0xa2, 0xfd, 0xff, 0xa1, 0x31, 0xa5,
-	0xff, 0xeb, 0x01, 0xc9, 0xfd, 0xbf, 0x51, 0xf4, 0xff, 0x5e, 0xa9, 0xff, 0xff, 0xb1, 0x71, 0xf9,
-	0x04, 0xa0, 0x51, 0x9d, 0x00, 0xac, 0x5e, 0x2a, 0x01, 0x58, 0x9b, 0x94, 0x00, 0x8c, 0xf6, 0x6f,
-	0x34, 0x01, 0xb8, 0x79, 0x15, 0x09, 0x00, 0xfc, 0xa6, 0x09, 0xc0, 0xed, 0x6f, 0x9a, 0x00, 0xdc,
-	0xb9, 0xda, 0x04, 0xa0, 0xda, 0x3b, 0xbf, 0xf3, 0x2d, 0x79, 0xe7, 0x2d, 0x70, 0xb3, 0xa8, 0x21,
-	0xda, 0x36, 0x2f, 0x30, 0xd4, 0xbf, 0xce, 0x82, 0xd5, 0xcf, 0xa9, 0x90, 0x41, 0x64, 0xb8, 0x63,
-	0xea, 0xc1, 0x9f, 0x81, 0x39, 0x32, 0x4c, 0x7d, 0xf4, 0x03, 0x44, 0x86, 0x15, 0xfa, 0x31, 0x86,
-	0xeb, 0xcc, 0x60, 0x85, 0x83, 0xbb, 0xe0, 0x9a, 0xde, 0xd9, 0xb6, 0x6e, 0xf9, 0x23, 0x64, 0xf6,
-	0xb9, 0x6b, 0x52, 0x18, 0xac, 0x5e, 0x56, 0x54, 0xc8, 0xec, 0xfb, 0x4f, 0xff, 0x27, 0x55, 0x93,
-	0x42, 0x23, 0x15, 0x83, 0xfa, 0xf6, 0xb4, 0x66, 0xf9, 0x50, 0x7f, 0xbb, 0xd6, 0x66, 0x50, 0x85,
-	0x77, 0x20, 0x58, 0xeb, 0xe5, 0xaf, 0xcc, 0x78, 0xfd, 0xad, 0x09, 0xd6, 0x5f, 0xd3, 0xc0, 0x1f,
-	0x48, 0xda, 0x2b, 0xe0, 0xd2, 0x74, 0xa4, 0xc2, 0x4e, 0x1a, 0x57, 0x68, 0x27, 0x25, 0x19, 0xcf,
-	0xec, 0x55, 0x67, 0x3c, 0x97, 0xdf, 0x62, 0x2a, 0x04, 0x73, 0xf3, 0xd2, 0xc1, 0x5c, 0x16, 0x98,
-	0xd7, 0xfe, 0x5f, 0x81, 0x39, 0xff, 0x2d, 0x05, 0xe6, 0xd6, 0xdf, 0xff, 0xdb, 0x6c, 0xfc, 0xe5,
-	0xdf, 0xef, 0x35, 0x7e, 0xfd, 0xa8, 0xde, 0x1f, 0xcf, 0xf1, 0xd7, 0xbe, 0xdd, 0xaa, 0xee, 0xce,
-	0x6b, 0xe3, 0xdc, 0xfc, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x36, 0x57, 0x1a, 0x66, 0xb3, 0x1e,
-	0x00, 0x00,
+	// 2714 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xdd, 0x72, 0xdc, 0xb6,
+	0xd9, 0xf6, 0x5a, 0xb2, 0x64, 0x41, 0xab, 0x3f, 0x48, 0x71, 0x18, 0x7f, 0x71, 0x62, 0xeb, 0x9b,
+	0xe6, 0xb7, 0xc1, 0x26, 0x52, 0x52, 0xc7, 0x4e, 0x3a, 0xa9, 0xa4, 0xc4, 0x96, 0x13, 0xa5, 0xd1,
+	0x40, 0x72, 0xe2, 0xa4, 0xd3, 0xe1, 0x60, 0x49, 0x2c, 0x97, 0x36, 0x45, 0xb0, 0x00, 0xb8, 0x2b,
+	0x65, 0xa6, 0x33, 0xbd, 0x80, 0xf6, 0xbc, 0xbd, 0x83, 0x9e, 0xf7, 0xa0, 0x3d, 0xea, 0x35, 0xe4,
+	0x0e, 0x3a, 0xd3, 0xe3, 0x9e, 0xf6, 0xb4, 0xd3, 0xc1, 0x0f, 0xb9, 0xdc, 0x5d, 0x52, 0xcb, 0x95,
+	0x95, 0x1e, 0x90, 0x4b, 0x00, 0xef, 0xf3, 0xe0, 0x87, 0xc0, 0xfb, 0x3e, 0x00, 0x17, 0xdc, 0x0f,
+	0x42, 0xd9, 0x4d, 0xdb, 0xc8, 0x63, 0x27, 0x2d, 0xc1, 0x22, 0xf6, 0x4e, 0xc8, 0x5a, 0x41, 0xc4,
+	0x58, 0x2b, 0xe1, 0xec, 0x29, 0xf5, 0xa4, 0x30, 0x29, 0x92, 0x84, 0xad, 0xde, 0x7b, 0x2d, 0x96,
+	0xc8, 0x90, 0xc5, 0x02, 0x25, 0x9c, 0x49, 0x06, 0x9b, 0xaa, 0x08, 0x29, 0x14, 0x0a, 0xd9, 0xcd,
+	0x97, 0x03, 0xc6, 0x82, 0x88, 0xb6, 0x74, 0x59, 0x3b, 0xed, 0xb4, 0x84, 0xe4, 0xa9, 0x27, 0x8d,
+	0xed, 0xcd, 0x8d, 0x80, 0x05, 0x4c, 0x3f, 0xb6, 0xd4, 0x93, 0xcd, 0x85, 0xf4, 0x54, 0x9a, 0x4c,
+	0x7a, 0x9a, 0x59, 0xbe, 0x55, 0x5d, 0x3d, 0x3d, 0x95, 0x34, 0x16, 0x83, 0x16, 0x9c, 0x67, 0xeb,
+	0xb1, 0x38, 0xa6, 0x9e, 0x6a, 0xae, 0xb5, 0x7d, 0x6f, 0x62, 0xb7, 0x5a, 0x1e, 0xe3, 0xe6, 0x66,
+	0x21, 0x9f, 0xd5, 0x80, 0xa4, 0x42, 0xb2, 0x13, 0xb7, 0x13, 0x46, 0x92, 0xf2, 0xd1, 0xa4, 0xa5,
+	0x79, 0x54, 0xa7, 0xe6, 0xac, 0xb5, 0x6e, 0x14, 0x9e, 0x84, 0x72, 0x2c, 0xa3, 0x7e, 0x27, 0x38,
+	0x15, 0x52, 0xdf, 0xea, 0x43, 0x02, 0x9e, 0x78, 0xfa, 0x66, 0x21, 0x93, 0x67, 0x40, 0x8b, 0x44,
+	0xfa, 0xb2, 0x80, 0x7b, 0xf5, 0xea, 0x70, 0xfb, 0xb4, 0x9d, 0x3f, 0x58, 0xe8, 0x47, 0x35, 0xa1,
+	0x4f, 0x05, 0x8b, 0x07, 0x4f, 0xf5, 0x1b, 0xda, 0xf5, 0x4e, 0xd4, 0x65, 0x01, 0xdb, 0x35, 0x00,
+	0x52, 0x26, 0xdb, 0xe6, 0x6e, 0x41, 0x1f, 0x4c, 0x06, 0x45, 0xed, 0x2e, 0x11, 0x5d, 0xfb, 0x53,
+	0x7f, 0xf6, 0x24, 0x9c, 0x9d, 0x9e, 0xb9, 0xda, 0xdc, 0x63, 0xd1, 0x48, 0xb2, 0xfe, 0x00, 0x89,
+	0x2e, 0xf1, 0x59, 0x3f, 0x8c, 0x83, 0xc1, 0x53, 0xfd, 0x01, 0x92, 0x5e, 0xa2, 0xae, 0xfa, 0x80,
+	0xd4, 0x4f, 0xd4, 0x65, 0x01, 0x77, 0x6b, 0xd4, 0xc0, 0x89, 0xa7, 0x1a, 0x67, 0x7f, 0xeb, 0x03,
+	0x39, 0x95, 0x3c, 0xa4, 0xf9, 0x6f, 0xfd, 0x77, 0x28, 0x24, 0x91, 0xf6, 0x6e, 0x41, 0x1f, 0x4f,
+	0x06, 0x75, 0x48, 0x1a, 0xc9, 0x30, 0x7e, 0x6a, 0x96, 0x9d, 0x49, 0xd6, 0x6f, 0x6b, 0x97, 0x12,
+	0x9f, 0xf2, 0xfc, 0x77, 0x8a, 0x95, 0xd4, 0xd7, 0x57, 0xfd, 0xd5, 0xda, 0x27, 0xe2, 0x44, 0xdf,
+	0xea, 0x8f, 0x07, 0xf9, 0x3e, 0xe5, 0xd4, 0xdc, 0xeb, 0x37, 0x2c, 0xf0, 0x12, 0x75, 0x59, 0xc0,
+	0x27, 0xb5, 0x86, 0x20, 0x92, 0x5d, 0xaf, 0x4b, 0xbd, 0x67, 0xc5, 0xe7, 0xfa, 0x5e, 0x30, 0x9b,
+	0xf9, 0x6e, 0x9a, 0x04, 0x9c, 0xf8, 0x74, 0x2c, 0xc3, 0x52, 0x3d, 0xac, 0xb1, 0x20, 0x99, 0x47,
+	0x22, 0x97, 0x13, 0x49, 0x8d, 0x3f, 0x1d, 0x49, 0x5b, 0xa2, 0xe3, 0x0a, 0x22, 0x15, 0x68, 0x78,
+	0x4c, 0xa2, 0x16, 0x8d, 0x7b, 0xec, 0xac, 0x10, 0x77, 0xd4, 0x1c, 0x8e, 0x45, 0x87, 0xf1, 0x13,
+	0xa2, 0x27, 0xc9, 0x70, 0xd2, 0xb2, 0x1e, 0x4e, 0xcd, 0xaa, 0x17, 0x7e, 0x44, 0x24, 0x8d, 0xbd,
+	0xb3, 0xa1, 0xc4, 0x85, 0xdb, 0x99, 0x05, 0x24, 0xe5, 0xc7, 0x5a, 0xed, 0xb4, 0xd3, 0xa1, 0xbc,
+	0xd5, 0xdb, 0xb6, 0x4f, 0x96, 0x35, 0x79, 0x3e, 0x56, 0xe2, 0x93, 0x44, 0x86, 0x3d, 0xea, 0x7a,
+	0x2c, 0xf6, 0x52, 0xce, 0x75, 0xe3, 0x7b, 0xdb, 0xa5, 0xf9, 0xb6, 0x46, 0xf6, 0xbc, 0x35, 0x9e,
+	0x84, 0x42, 0xe5, 0x2b, 0x6a, 0xc9, 0x59, 0xd4, 0xea, 0x6d, 0x93, 0x28, 0xe9, 0x92, 0xf1, 0x12,
+	0x5b, 0xe1, 0x17, 0xf5, 0x2a, 0xf4, 0x58, 0xdc, 0x09, 0x03, 0x5b, 0x99, 0xa9, 0x2b, 0xf8, 0x3e,
+	0x4c, 0x5a, 0xbd, 0x2d, 0xfd, 0x3b, 0xd9, 0xa1, 0xd3, 0x58, 0x52, 0x9e, 0xf0, 0x50, 0xd0, 0x7c,
+	0x06, 0xd2, 0x53, 0x49, 0x52, 0xd9, 0xb5, 0xba, 0x45, 0x3d, 0x5a, 0x9a, 0xfb, 0x53, 0xd1, 0x3c,
+	0xed, 0x4b, 0x75, 0x59, 0xec, 0x83, 0xa9, 0xb0, 0x83, 0xe9, 0x3f, 0x3a, 0xf1, 0x3f, 0x9e, 0x8e,
+	0xa7, 0x4d, 0x3c, 0x7d, 0xbb, 0x50, 0x0f, 0xfa, 0xa4, 0xa3, 0xae, 0x0b, 0x61, 0xfd, 0x28, 0x51,
+	0x57, 0xfd, 0x88, 0x5a, 0x67, 0x7d, 0xbe, 0x32, 0xaa, 0x54, 0xfd, 0x94, 0x9f, 0x5b, 0xde, 0xe7,
+	0x24, 0x49, 0x72, 0xa7, 0xbe, 0xf9, 0xc3, 0x1c, 0x58, 0x39, 0x08, 0x85, 0xa4, 0x31, 0xe5, 0x5f,
+	0x99, 0x7a, 0xa1, 0x0f, 0x6e, 0x10, 0xcf, 0xa3, 0x42, 0xb8, 0x11, 0x0b, 0x82, 0x30, 0x0e, 0x5c,
+	0x41, 0x79, 0x2f, 0xf4, 0xa8, 0xd3, 0xb8, 0xdd, 0x78, 0x63, 0x71, 0x0b, 0x21, 0xa5, 0x96, 0x6c,
+	0x2b, 0x51, 0x51, 0x38, 0xa3, 0x1d, 0x8d, 0x3b, 0x30, 0xb0, 0x23, 0x83, 0xc2, 0x1b, 0xa4, 0x24,
+	0x17, 0x7e, 0x08, 0xc0, 0x60, 0x6d, 0x38, 0x57, 0x35, 0xb3, 0x33, 0xcc, 0xf6, 0x59, 0x5e, 0x8e,
+	0x0b, 0xb6, 0xb0, 0x03, 0xee, 0x24, 0x94, 0xbb, 0x05, 0x59, 0x69, 0x5c, 0x81, 0x51, 0x97, 0x6e,
+	0xfb, 0x4c, 0x52, 0xe1, 0xcc, 0x68, 0xc2, 0x97, 0x91, 0xe9, 0x3f, 0xca, 0xfa, 0x8f, 0x1e, 0x3f,
+	0x8a, 0xe5, 0xf6, 0xd6, 0xd7, 0x24, 0x4a, 0x29, 0xbe, 0x95, 0x50, 0xbe, 0x97, 0xb3, 0xec, 0x6a,
+	0x92, 0x03, 0xc5, 0xb1, 0xab, 0x28, 0xe0, 0x5d, 0x70, 0x4d, 0x4b, 0x27, 0x67, 0x56, 0x73, 0xdd,
+	0x41, 0x3a, 0x55, 0xde, 0xf1, 0x7d, 0x55, 0x84, 0x8d, 0x3d, 0xfc, 0x16, 0x2c, 0x0f, 0xcb, 0x1f,
+	0xe7, 0x9a, 0x66, 0xd8, 0x42, 0xc3, 0xd9, 0xe5, 0x54, 0x87, 0xca, 0xe6, 0xd0, 0x9a, 0xe0, 0xa5,
+	0xa4, 0x98, 0x84, 0x87, 0x60, 0x49, 0x7a, 0x89, 0xfb, 0x8c, 0xd2, 0x84, 0x44, 0x61, 0x8f, 0x3a,
+	0x73, 0x9a, 0xf9, 0xed, 0x61, 0x8a, 0x41, 0xa7, 0xf6, 0xb4, 0x37, 0x40, 0xc7, 0x5e, 0xf2, 0x05,
+	0xa5, 0xc9, 0x8e, 0x82, 0xe0, 0xa6, 0x34, 0x29, 0x4d, 0x00, 0xef, 0x01, 0xc0, 0x69, 0x2a, 0xa8,
+	0x9b, 0x30, 0x2e, 0x9d, 0x79, 0x4d, 0x77, 0x73, 0x6c, 0xd8, 0x76, 0x19, 0x8b, 0xcc, 0xa0, 0x2d,
+	0x68, 0xeb, 0x43, 0xc6, 0x25, 0xdc, 0x01, 0xcb, 0x82, 0x79, 0xcf, 0xa8, 0x74, 0x6d, 0x47, 0x9c,
+	0xeb, 0xb7, 0x67, 0x0c, 0xbc, 0xd8, 0x9a, 0x23, 0x6d, 0x63, 0x66, 0x17, 0x5e, 0x12, 0x85, 0x94,
+	0x80, 0x31, 0xb8, 0x61, 0xf7, 0x19, 0x31, 0x95, 0x7d, 0xc6, 0x9f, 0x65, 0xfb, 0x0d, 0x67, 0x41,
+	0x53, 0x7d, 0x88, 0x46, 0xb6, 0x21, 0xa5, 0x43, 0xb6, 0xa7, 0x6d, 0x7e, 0x69, 0x18, 0x1e, 0x68,
+	0x4b, 0xbc, 0xe1, 0x8d, 0x67, 0x0a, 0x48, 0xc0, 0xea, 0xe8, 0x76, 0xc4, 0x01, 0xba, 0xcf, 0x3f,
+	0x43, 0xa3, 0x05, 0x15, 0x75, 0xe5, 0x56, 0x7a, 0xaa, 0xe0, 0x15, 0x6f, 0x38, 0x63, 0xf3, 0xef,
+	0x8b, 0x60, 0x5d, 0x4d, 0x87, 0xd1, 0x65, 0xb5, 0x03, 0xae, 0x67, 0xfb, 0x05, 0xbb, 0x90, 0x5e,
+	0x43, 0x59, 0x46, 0x79, 0x55, 0x0f, 0x79, 0xe2, 0x7d, 0x43, 0xdb, 0x78, 0x3e, 0x30, 0x0f, 0xf0,
+	0x77, 0x0d, 0x70, 0x5b, 0x4d, 0xb1, 0xe2, 0xdc, 0x3f, 0x21, 0x31, 0x09, 0x28, 0x77, 0x05, 0x95,
+	0x32, 0x8c, 0x83, 0x6c, 0x29, 0xdd, 0x45, 0x6a, 0xa7, 0x50, 0x39, 0x57, 0x07, 0xbd, 0xf8, 0xd2,
+	0xe0, 0x8f, 0x2c, 0x1c, 0xdf, 0xea, 0x9e, 0x57, 0x0c, 0x0f, 0x41, 0xd3, 0xe8, 0x21, 0x57, 0x0b,
+	0x22, 0xbb, 0x36, 0xde, 0x41, 0x45, 0x91, 0x54, 0x5e, 0xab, 0x36, 0xd8, 0x53, 0x06, 0x78, 0xb1,
+	0x3b, 0x48, 0x8c, 0x38, 0x82, 0x99, 0x29, 0x1c, 0xc1, 0xfb, 0x60, 0xa6, 0x4f, 0x3a, 0x76, 0x71,
+	0x6d, 0x22, 0xe5, 0x98, 0x4b, 0xab, 0xce, 0xfb, 0xa6, 0xcc, 0xe1, 0x87, 0x60, 0xc6, 0x8f, 0x12,
+	0xbb, 0x70, 0x5e, 0x43, 0xca, 0x25, 0x97, 0xa2, 0xcc, 0x94, 0x31, 0x0b, 0x08, 0x2b, 0x08, 0xfc,
+	0x08, 0xcc, 0x2a, 0xad, 0x6a, 0x17, 0xc9, 0xeb, 0x48, 0x25, 0x2a, 0xd6, 0x70, 0x94, 0x06, 0x61,
+	0x7c, 0xc4, 0x52, 0xee, 0x51, 0xac, 0x41, 0xf0, 0x23, 0x30, 0x6f, 0x63, 0xa7, 0x9d, 0x70, 0x77,
+	0xd0, 0x20, 0x48, 0x54, 0xb4, 0x37, 0x43, 0xc0, 0x23, 0xb0, 0x9a, 0x87, 0x3d, 0xed, 0x8d, 0x29,
+	0x77, 0x16, 0x35, 0xcb, 0x1b, 0x28, 0x2f, 0x98, 0xd0, 0xf9, 0x95, 0xdc, 0xf0, 0x48, 0x13, 0xc0,
+	0xfb, 0x60, 0x56, 0x29, 0x02, 0xe7, 0xba, 0x1d, 0x09, 0xad, 0x1f, 0x90, 0xd1, 0x0f, 0xc8, 0xac,
+	0x37, 0xed, 0xf2, 0x90, 0xb2, 0x42, 0xbd, 0x2d, 0xf4, 0xf0, 0xfb, 0x30, 0xc1, 0x1a, 0x03, 0x7f,
+	0x05, 0x8c, 0x63, 0x72, 0xad, 0xb8, 0x73, 0x16, 0xec, 0x22, 0xaa, 0x24, 0x19, 0x92, 0x82, 0xbd,
+	0x2d, 0xe3, 0xe6, 0x0e, 0x4c, 0x1a, 0x37, 0x93, 0x42, 0x0a, 0x3e, 0x04, 0x73, 0xc6, 0xa3, 0x3b,
+	0x4d, 0xcd, 0xda, 0xb2, 0xac, 0x83, 0x57, 0x8f, 0x32, 0x77, 0xa0, 0xa9, 0x8d, 0x31, 0xea, 0x6d,
+	0x23, 0xe3, 0xc3, 0xb1, 0x85, 0x43, 0x1f, 0x6c, 0xe4, 0xdb, 0x6c, 0x57, 0xc7, 0x4f, 0x8f, 0xf9,
+	0x94, 0x3b, 0x4b, 0xd6, 0x1d, 0xe7, 0x85, 0xd5, 0xeb, 0xef, 0x73, 0xc1, 0xe2, 0xe3, 0x1c, 0x89,
+	0x61, 0x30, 0x96, 0x07, 0x13, 0x70, 0x43, 0x48, 0x12, 0x50, 0xdf, 0x1d, 0x0e, 0xd1, 0xc2, 0x59,
+	0xd6, 0xf5, 0xdc, 0x43, 0xc3, 0xf9, 0xe5, 0x95, 0x1d, 0x0f, 0xd9, 0x1c, 0x29, 0x42, 0x81, 0x5f,
+	0x30, 0xc4, 0xc3, 0x65, 0x02, 0xfe, 0x16, 0x6c, 0x94, 0x29, 0x53, 0x67, 0x45, 0xd7, 0xf7, 0xf9,
+	0x84, 0xe1, 0x2a, 0x83, 0xaa, 0xc1, 0xdb, 0xb1, 0xf9, 0x7b, 0x83, 0x6c, 0xbc, 0x4e, 0xc6, 0x33,
+	0x61, 0x0f, 0xac, 0x8d, 0x89, 0x54, 0x67, 0x55, 0xd7, 0xfd, 0x68, 0x62, 0xdd, 0x23, 0x38, 0x64,
+	0x65, 0x2f, 0xda, 0xc9, 0x4a, 0xf6, 0x4c, 0x01, 0x5e, 0x25, 0x23, 0x39, 0x90, 0x82, 0x75, 0x1b,
+	0x0d, 0xb4, 0x13, 0xcc, 0x22, 0xc5, 0x9a, 0x8e, 0x14, 0x1f, 0x4c, 0x11, 0x29, 0x94, 0x07, 0xb4,
+	0x61, 0x62, 0xcd, 0x1b, 0xc9, 0x11, 0x9b, 0x31, 0x80, 0xc7, 0xde, 0x98, 0xfb, 0x7e, 0x02, 0xa0,
+	0x8a, 0xbc, 0x66, 0xd6, 0xe7, 0xce, 0xd6, 0xb8, 0xab, 0xb7, 0x90, 0xf4, 0x2a, 0xbc, 0xc8, 0xb1,
+	0x97, 0xe8, 0x99, 0x9e, 0x2f, 0xc3, 0x55, 0x39, 0x92, 0xb3, 0xf9, 0x43, 0x03, 0x2c, 0x1f, 0x7b,
+	0xc9, 0x3e, 0x13, 0xf2, 0xfc, 0xca, 0x1a, 0xcf, 0x5f, 0xd9, 0x39, 0xe2, 0xee, 0xea, 0xe5, 0x89,
+	0x3b, 0x35, 0x84, 0x8f, 0xfd, 0xb2, 0x21, 0x4c, 0xfd, 0xca, 0x5e, 0xa9, 0x73, 0x98, 0xd2, 0x7a,
+	0x1f, 0xfb, 0xa3, 0xbd, 0x4a, 0x47, 0x72, 0x36, 0xff, 0xd3, 0x04, 0xf0, 0xeb, 0x90, 0xcb, 0x94,
+	0x44, 0xc5, 0x61, 0x1c, 0x0e, 0x2d, 0x8d, 0x29, 0x42, 0xcb, 0x1e, 0x98, 0xb7, 0x27, 0x35, 0x36,
+	0xbc, 0xbc, 0x89, 0x6c, 0xba, 0xbc, 0x8d, 0x98, 0x4a, 0x7e, 0x76, 0xc8, 0xa2, 0xd0, 0x3b, 0xc3,
+	0x19, 0x52, 0x09, 0x48, 0x7d, 0x6e, 0x93, 0x3b, 0x7c, 0x9d, 0xaa, 0x70, 0xd3, 0xaa, 0x08, 0x1b,
+	0x7b, 0x48, 0xc0, 0xba, 0x39, 0x7b, 0x51, 0xd1, 0x3d, 0x4c, 0xd2, 0x48, 0xaf, 0x7b, 0xfb, 0x86,
+	0xde, 0x45, 0xa6, 0x4c, 0x54, 0xc6, 0x59, 0x9f, 0xf2, 0x2f, 0x0b, 0x38, 0x0c, 0xbb, 0x63, 0x79,
+	0xf0, 0x1e, 0x98, 0xf5, 0x18, 0xcf, 0x26, 0xf0, 0x4f, 0x90, 0xc7, 0xaa, 0x08, 0xf7, 0x18, 0x17,
+	0xb6, 0x67, 0x1a, 0x02, 0xdb, 0x60, 0x65, 0xd4, 0xd1, 0x19, 0x15, 0xf0, 0xfe, 0x05, 0x1c, 0x9d,
+	0xd8, 0xbd, 0xea, 0x34, 0xf0, 0x28, 0x21, 0xfc, 0x16, 0x0c, 0xc2, 0x95, 0xdb, 0x26, 0x22, 0xf4,
+	0x6c, 0xc0, 0x7e, 0x77, 0x52, 0xbc, 0x7b, 0x14, 0x07, 0x9c, 0x0a, 0x81, 0x89, 0xa4, 0x46, 0xa0,
+	0x2d, 0xe7, 0x80, 0x5d, 0xc5, 0x03, 0xbf, 0x01, 0x0b, 0x79, 0x8e, 0xf3, 0xc0, 0x8a, 0xa5, 0x09,
+	0xa4, 0x39, 0xdb, 0xd7, 0x5d, 0x26, 0x64, 0x3e, 0x67, 0xf6, 0xaf, 0xe0, 0x01, 0x17, 0xf4, 0x00,
+	0x54, 0x09, 0xbb, 0x0d, 0x31, 0x21, 0x50, 0x38, 0x0f, 0x75, 0x0d, 0xdb, 0xb5, 0x6b, 0xb0, 0x82,
+	0x83, 0x76, 0xc4, 0xfe, 0x15, 0xbc, 0xca, 0x87, 0xb3, 0x73, 0xcd, 0x73, 0x7d, 0x3a, 0xcd, 0x73,
+	0x1f, 0xcc, 0x3c, 0xed, 0x4b, 0x1b, 0xa4, 0xdf, 0x40, 0x6a, 0x13, 0x5e, 0x8a, 0x1a, 0xee, 0x1e,
+	0x56, 0x20, 0xf8, 0x0b, 0x30, 0xab, 0xf6, 0xcb, 0x56, 0x6f, 0xfc, 0x14, 0xa9, 0x44, 0x39, 0x3a,
+	0x07, 0xe6, 0x95, 0x6b, 0xa4, 0x5a, 0x4c, 0x99, 0xf4, 0x69, 0xda, 0xc5, 0x54, 0x25, 0x7d, 0x3e,
+	0x3b, 0x95, 0x3b, 0xa9, 0xec, 0x0e, 0x9a, 0x90, 0x4b, 0xa0, 0x2d, 0x23, 0xdb, 0x4c, 0xe8, 0xbe,
+	0x5d, 0x2d, 0xdb, 0x8a, 0x82, 0x8d, 0x80, 0x55, 0xbb, 0x35, 0x54, 0x1b, 0x46, 0xce, 0x52, 0x49,
+	0x6d, 0x4c, 0xbe, 0x3b, 0xa5, 0xa4, 0x38, 0xa4, 0x1c, 0x2b, 0x38, 0x5e, 0x6e, 0x0f, 0xa5, 0xe1,
+	0xaf, 0xc1, 0xad, 0x30, 0xf6, 0xa2, 0xd4, 0xa7, 0x2e, 0xa7, 0xbf, 0x49, 0xa9, 0x90, 0x2e, 0x91,
+	0x92, 0x9e, 0x24, 0x6a, 0x06, 0xa4, 0xb1, 0x74, 0x56, 0x26, 0xee, 0xa8, 0x6e, 0x5a, 0x02, 0x6c,
+	0xf0, 0x3b, 0x06, 0xbe, 0xa7, 0xd0, 0xd0, 0x07, 0x77, 0x32, 0xfa, 0x21, 0x5a, 0x37, 0x8c, 0x5d,
+	0x4e, 0x45, 0xc2, 0x62, 0x41, 0x9d, 0xd5, 0x89, 0x55, 0x64, 0x6d, 0x2c, 0x72, 0x3f, 0x8a, 0xb1,
+	0x25, 0x38, 0x47, 0xc1, 0xac, 0xfd, 0x48, 0x0a, 0xe6, 0x09, 0xb8, 0x11, 0xc6, 0x3d, 0x12, 0x85,
+	0xbe, 0x79, 0x2d, 0x83, 0xce, 0x40, 0x3b, 0xb3, 0x47, 0x16, 0xb5, 0xb6, 0x35, 0xaf, 0xc0, 0x5a,
+	0xe2, 0x8d, 0xb0, 0x24, 0x17, 0x7e, 0x07, 0x56, 0x46, 0x0e, 0x48, 0x9d, 0x75, 0x4d, 0xf9, 0x1e,
+	0x1a, 0xc9, 0xaf, 0xe8, 0x05, 0x7b, 0x46, 0xe3, 0xdd, 0x54, 0x6d, 0x52, 0xf1, 0xb2, 0x46, 0xe0,
+	0xdc, 0x7f, 0x38, 0xe0, 0xc6, 0xd8, 0x0a, 0x77, 0xe5, 0x59, 0x42, 0x37, 0xff, 0xd2, 0x00, 0x1b,
+	0x65, 0x8d, 0x84, 0xaf, 0x82, 0x45, 0xe5, 0xd3, 0x53, 0xe1, 0x2a, 0xb1, 0xa8, 0x63, 0xd0, 0x12,
+	0x06, 0x26, 0x6b, 0x8f, 0xf9, 0x14, 0x42, 0x30, 0xdb, 0x66, 0xfe, 0x99, 0x76, 0xee, 0x0b, 0x58,
+	0x3f, 0xc3, 0x0e, 0x78, 0x31, 0x1b, 0x0f, 0xd7, 0x3a, 0x7b, 0x57, 0x32, 0x97, 0xf8, 0xbe, 0x33,
+	0xa3, 0xc5, 0x4e, 0xab, 0x4e, 0x0c, 0xd0, 0xaf, 0xde, 0x6e, 0xbb, 0x37, 0x32, 0x3e, 0x53, 0x24,
+	0x8e, 0xd9, 0x8e, 0xef, 0x6f, 0xfe, 0x09, 0x82, 0xa6, 0x6e, 0x6e, 0x16, 0x30, 0x4b, 0x5c, 0x7b,
+	0xe3, 0xb2, 0x5d, 0xfb, 0x27, 0x60, 0x4e, 0x7f, 0x8f, 0xc8, 0x76, 0xaa, 0xaf, 0x23, 0x9d, 0xac,
+	0x70, 0x8b, 0xaa, 0x75, 0x0f, 0xb4, 0x39, 0xb6, 0x30, 0xb8, 0xa7, 0x8e, 0x57, 0x68, 0x27, 0x3c,
+	0x75, 0x39, 0xed, 0xf3, 0x50, 0xd2, 0xca, 0xc3, 0x9e, 0x23, 0xc9, 0xc3, 0x38, 0x30, 0x4b, 0x60,
+	0xc9, 0x60, 0xb0, 0x81, 0xc0, 0x7b, 0x60, 0x5e, 0x86, 0x27, 0x94, 0xa5, 0xd2, 0x06, 0xaf, 0x97,
+	0xc6, 0xd0, 0x9f, 0xda, 0xa3, 0xb4, 0xdd, 0xd9, 0x3f, 0xfe, 0xe3, 0xd5, 0x06, 0xce, 0xec, 0x2f,
+	0x47, 0x1b, 0x0c, 0x4b, 0x93, 0xb9, 0x29, 0xa4, 0xc9, 0x01, 0x98, 0xb7, 0x5f, 0x9f, 0xec, 0x46,
+	0x74, 0x0b, 0xd9, 0xf4, 0x39, 0x43, 0x78, 0x6c, 0x2c, 0x06, 0x3b, 0x4b, 0x0b, 0x81, 0x07, 0x60,
+	0x21, 0xff, 0xd0, 0x66, 0xa3, 0x0a, 0x42, 0x79, 0xce, 0x39, 0x8c, 0x47, 0x99, 0x0d, 0x1e, 0x10,
+	0x54, 0x09, 0x97, 0x85, 0x4b, 0x14, 0x2e, 0xff, 0x0f, 0x9a, 0x2a, 0x48, 0xe5, 0xef, 0x5e, 0x69,
+	0xab, 0x85, 0xfd, 0x2b, 0x78, 0x51, 0xe5, 0x66, 0x6f, 0x77, 0x1f, 0xac, 0x91, 0x54, 0x32, 0x77,
+	0xc8, 0x72, 0x7d, 0x92, 0x9b, 0xdc, 0xbf, 0x82, 0x57, 0x14, 0x6c, 0xbf, 0xc0, 0x94, 0xe9, 0xa4,
+	0xc5, 0xe9, 0x75, 0xd2, 0x17, 0x60, 0x3e, 0x6a, 0xbb, 0xea, 0x2b, 0xaa, 0x0d, 0x7b, 0x5b, 0xc8,
+	0x7e, 0x54, 0xad, 0x1e, 0xd5, 0x1d, 0x7d, 0xe8, 0xb2, 0x4f, 0x44, 0xd7, 0xc6, 0xb1, 0xb9, 0xa8,
+	0xad, 0x52, 0xf0, 0x09, 0xb8, 0x6e, 0x3f, 0x1c, 0x09, 0xe7, 0x05, 0xed, 0x03, 0x3e, 0x46, 0x63,
+	0x9f, 0x94, 0xaa, 0xce, 0x13, 0xb5, 0xd5, 0x63, 0x63, 0x64, 0x79, 0x73, 0xb6, 0x32, 0xa9, 0xb5,
+	0x74, 0x49, 0x52, 0xeb, 0x49, 0x51, 0x6a, 0xfd, 0xbe, 0x31, 0xa5, 0xd6, 0xd2, 0x03, 0x32, 0xd0,
+	0x5a, 0x8d, 0xa2, 0xd6, 0xf2, 0x4b, 0xb5, 0xd6, 0x1f, 0x1a, 0x17, 0x17, 0x5b, 0x8d, 0x6a, 0xb1,
+	0xb5, 0x72, 0x21, 0xb1, 0xb5, 0x3a, 0x49, 0x6c, 0x0d, 0xf7, 0x6f, 0x58, 0x6c, 0xad, 0x5d, 0x86,
+	0xd8, 0x82, 0xcf, 0x2b, 0xb6, 0x36, 0x9e, 0x57, 0x6c, 0xdd, 0xb8, 0x5c, 0xb1, 0x55, 0xad, 0x53,
+	0x5e, 0xfc, 0x91, 0x74, 0xca, 0x2e, 0x68, 0x86, 0x7e, 0x44, 0xdd, 0x2c, 0x56, 0x38, 0xf5, 0x62,
+	0xc5, 0xa2, 0x02, 0x1d, 0xdb, 0x78, 0xf1, 0x08, 0xac, 0x9e, 0x90, 0x53, 0x57, 0x1f, 0x36, 0x65,
+	0x3c, 0x2f, 0xd5, 0xe3, 0x59, 0x3e, 0x21, 0xa7, 0xea, 0x14, 0x2a, 0xa3, 0xfa, 0x0a, 0xac, 0x17,
+	0x69, 0x5c, 0xd6, 0xe9, 0x08, 0x2a, 0x9d, 0x9b, 0xf5, 0xd8, 0xd6, 0x82, 0x01, 0xd5, 0x57, 0x1a,
+	0x09, 0x0f, 0xd4, 0x71, 0xae, 0x1f, 0xa8, 0xd3, 0x7f, 0xe5, 0xb9, 0x9c, 0xff, 0xab, 0x13, 0xd0,
+	0xf6, 0x15, 0xc2, 0xba, 0xba, 0xc5, 0xee, 0x20, 0x01, 0x3f, 0x01, 0x4b, 0x9c, 0x06, 0x74, 0x10,
+	0x98, 0x5f, 0xce, 0x5c, 0xee, 0x70, 0x3c, 0x0c, 0x68, 0x16, 0x87, 0x71, 0x93, 0x17, 0x52, 0x65,
+	0xe2, 0xed, 0xd6, 0x65, 0x89, 0xb7, 0x75, 0xb0, 0x56, 0x0c, 0x07, 0x5a, 0xb7, 0x9d, 0xa3, 0xe8,
+	0xfe, 0x75, 0x15, 0xac, 0x7c, 0x4a, 0x85, 0x0c, 0x63, 0x33, 0x4d, 0x12, 0xea, 0xc1, 0x9f, 0x83,
+	0x19, 0xd2, 0xcf, 0x24, 0xd1, 0x9b, 0x48, 0xfd, 0xd5, 0xa1, 0xb4, 0x19, 0x23, 0xb8, 0xfd, 0x2b,
+	0x58, 0xe1, 0xe0, 0x1e, 0xb8, 0xa6, 0xff, 0xb7, 0x60, 0x85, 0xcf, 0xdb, 0x48, 0xa7, 0xea, 0x52,
+	0x18, 0xac, 0xf6, 0x10, 0x54, 0xc8, 0xfc, 0xe4, 0x49, 0x25, 0xea, 0x52, 0x68, 0xa4, 0x62, 0x50,
+	0x13, 0xc1, 0xea, 0x9e, 0xb7, 0xf4, 0x29, 0x68, 0x6d, 0x06, 0x65, 0xac, 0xc6, 0x21, 0xf0, 0x92,
+	0x5c, 0xfd, 0x04, 0x5e, 0x52, 0x17, 0xaf, 0x70, 0xbb, 0x10, 0xac, 0xfa, 0x83, 0x12, 0x33, 0xdc,
+	0x7f, 0x9d, 0x05, 0x37, 0xbf, 0xa1, 0x61, 0xd0, 0x95, 0xd4, 0x2f, 0xc0, 0x32, 0x61, 0x5a, 0x21,
+	0x2c, 0x1a, 0x97, 0x28, 0x2c, 0x4a, 0xb4, 0xef, 0xd5, 0xcb, 0xd6, 0xbe, 0x17, 0xff, 0xd6, 0x51,
+	0x70, 0xeb, 0xb3, 0x17, 0x76, 0xeb, 0x65, 0x2e, 0xfa, 0xda, 0xff, 0xca, 0x45, 0xcf, 0xfd, 0x38,
+	0x2e, 0x7a, 0xf3, 0x00, 0x34, 0x8b, 0x1e, 0x05, 0x3a, 0x60, 0x3e, 0x21, 0x52, 0x52, 0x6e, 0xa6,
+	0xc7, 0x02, 0xce, 0x92, 0x70, 0x13, 0x34, 0x45, 0xda, 0x16, 0x32, 0x94, 0x69, 0x7e, 0x9e, 0xb6,
+	0x80, 0x87, 0xf2, 0x76, 0xef, 0xff, 0xed, 0xdf, 0xb3, 0x8d, 0x3f, 0xff, 0xf3, 0x95, 0xc6, 0x77,
+	0xef, 0xd6, 0xfb, 0x3b, 0x69, 0xf2, 0x2c, 0xb0, 0x1f, 0xee, 0xdb, 0x73, 0xda, 0xf1, 0x6e, 0xff,
+	0x77, 0x00, 0xfa, 0xa4, 0x3f, 0xfd, 0x89, 0x2a, 0x00, 0x00,
 }
 
 func (this *ListenerOptions) Equal(that interface{}) bool {
@@ -1284,6 +1751,37 @@ func (this *ListenerOptions) Equal(that interface{}) bool {
 	if !this.PerConnectionBufferLimitBytes.Equal(that1.PerConnectionBufferLimitBytes) {
 		return false
 	}
+	if !this.Http3.Equal(that1.Http3) {
+		return false
+	}
+	if !this.ProxyProtocol.Equal(that1.ProxyProtocol) {
+		return false
+	}
+	if !this.TcpKeepalive.Equal(that1.TcpKeepalive) {
+		return false
+	}
+	if !this.ReusePort.Equal(that1.ReusePort) {
+		return false
+	}
+	if len(this.SocketOptions) != len(that1.SocketOptions) {
+		return false
+	}
+	for i := range this.SocketOptions {
+		if !this.SocketOptions[i].Equal(that1.SocketOptions[i]) {
+			return false
+		}
+	}
+	if len(this.CustomNetworkFilters) != len(that1.CustomNetworkFilters) {
+		return false
+	}
+	for i := range this.CustomNetworkFilters {
+		if !this.CustomNetworkFilters[i].Equal(that1.CustomNetworkFilters[i]) {
+			return false
+		}
+	}
+	if !this.ConnectionLimit.Equal(that1.ConnectionLimit) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1347,6 +1845,23 @@ func (this *HttpListenerOptions) Equal(that interface{}) bool {
 	if !this.GrpcJsonTranscoder.Equal(that1.GrpcJsonTranscoder) {
 		return false
 	}
+	if !this.StagedTransformations.Equal(that1.StagedTransformations) {
+		return false
+	}
+	if !this.AdaptiveConcurrency.Equal(that1.AdaptiveConcurrency) {
+		return false
+	}
+	if !this.AdmissionControl.Equal(that1.AdmissionControl) {
+		return false
+	}
+	if len(this.CustomHttpFilters) != len(that1.CustomHttpFilters) {
+		return false
+	}
+	for i := range this.CustomHttpFilters {
+		if !this.CustomHttpFilters[i].Equal(that1.CustomHttpFilters[i]) {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1379,6 +1894,63 @@ func (this *TcpListenerOptions) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TcpHostOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TcpHostOptions)
+	if !ok {
+		that2, ok := that.(TcpHostOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.TcpProxySettings.Equal(that1.TcpProxySettings) {
+		return false
+	}
+	if !this.AccessLoggingService.Equal(that1.AccessLoggingService) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *UdpListenerOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UdpListenerOptions)
+	if !ok {
+		that2, ok := that.(UdpListenerOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.UdpProxySettings.Equal(that1.UdpProxySettings) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *VirtualHostOptions) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1455,6 +2027,12 @@ func (this *VirtualHostOptions) Equal(that interface{}) bool {
 	if !this.StagedTransformations.Equal(that1.StagedTransformations) {
 		return false
 	}
+	if !this.InvalidRouteResponse.Equal(that1.InvalidRouteResponse) {
+		return false
+	}
+	if !this.LocalRatelimit.Equal(that1.LocalRatelimit) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1508,6 +2086,44 @@ func (this *VirtualHostOptions_RateLimitConfigs) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *InvalidRouteResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InvalidRouteResponse)
+	if !ok {
+		that2, ok := that.(InvalidRouteResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StatusCode != that1.StatusCode {
+		return false
+	}
+	if this.Body != that1.Body {
+		return false
+	}
+	if len(this.ResponseHeadersToAdd) != len(that1.ResponseHeadersToAdd) {
+		return false
+	}
+	for i := range this.ResponseHeadersToAdd {
+		if !this.ResponseHeadersToAdd[i].Equal(that1.ResponseHeadersToAdd[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *RouteOptions) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1616,6 +2232,42 @@ func (this *RouteOptions) Equal(that interface{}) bool {
 	if !this.StagedTransformations.Equal(that1.StagedTransformations) {
 		return false
 	}
+	if this.IdleTimeout != nil && that1.IdleTimeout != nil {
+		if *this.IdleTimeout != *that1.IdleTimeout {
+			return false
+		}
+	} else if this.IdleTimeout != nil {
+		return false
+	} else if that1.IdleTimeout != nil {
+		return false
+	}
+	if this.MaxGrpcTimeout != nil && that1.MaxGrpcTimeout != nil {
+		if *this.MaxGrpcTimeout != *that1.MaxGrpcTimeout {
+			return false
+		}
+	} else if this.MaxGrpcTimeout != nil {
+		return false
+	} else if that1.MaxGrpcTimeout != nil {
+		return false
+	}
+	if this.GrpcTimeoutOffset != nil && that1.GrpcTimeoutOffset != nil {
+		if *this.GrpcTimeoutOffset != *that1.GrpcTimeoutOffset {
+			return false
+		}
+	} else if this.GrpcTimeoutOffset != nil {
+		return false
+	} else if that1.GrpcTimeoutOffset != nil {
+		return false
+	}
+	if !this.HedgePolicy.Equal(that1.HedgePolicy) {
+		return false
+	}
+	if !this.RegexRewrite.Equal(that1.RegexRewrite) {
+		return false
+	}
+	if !this.LocalRatelimit.Equal(that1.LocalRatelimit) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1846,6 +2498,30 @@ func (this *DestinationSpec_Grpc) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DestinationSpec_Gcp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DestinationSpec_Gcp)
+	if !ok {
+		that2, ok := that.(DestinationSpec_Gcp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Gcp.Equal(that1.Gcp) {
+		return false
+	}
+	return true
+}
 func (this *WeightedDestinationOptions) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1888,3 +2564,33 @@ func (this *WeightedDestinationOptions) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RegexRewrite) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RegexRewrite)
+	if !ok {
+		that2, ok := that.(RegexRewrite)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Pattern != that1.Pattern {
+		return false
+	}
+	if this.Substitution != that1.Substitution {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -199,10 +199,20 @@ type DestinationSpec struct {
 	// Can be either Sync or Async.
 	InvocationStyle DestinationSpec_InvocationStyle `protobuf:"varint,2,opt,name=invocation_style,json=invocationStyle,proto3,enum=aws.options.gloo.solo.io.DestinationSpec_InvocationStyle" json:"invocation_style,omitempty"`
 	// de-jsonify response bodies returned from aws lambda
-	ResponseTransformation bool     `protobuf:"varint,5,opt,name=response_transformation,json=responseTransformation,proto3" json:"response_transformation,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_unrecognized       []byte   `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	ResponseTransformation bool `protobuf:"varint,5,opt,name=response_transformation,json=responseTransformation,proto3" json:"response_transformation,omitempty"`
+	// Unwrap the responses of Lambda functions written for the API Gateway proxy integration, so that they can be
+	// used behind Gloo unchanged. The response returned by the function is expected to be a JSON object of the form:
+	//  ```
+	//  {"statusCode": 200, "headers": {"Content-Type": "text/plain"}, "body": "..."}
+	//  ```
+	// The `statusCode` becomes the status of the response (200 if it is not set), the `body` becomes its body, and
+	// the `Content-Type` header is set from `headers`. Other headers, `multiValueHeaders` and base64 encoded bodies
+	// are not supported.
+	// Cannot be set together with `responseTransformation`.
+	UnwrapAsApiGateway   bool     `protobuf:"varint,6,opt,name=unwrap_as_api_gateway,json=unwrapAsApiGateway,proto3" json:"unwrap_as_api_gateway,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *DestinationSpec) Reset()         { *m = DestinationSpec{} }
@@ -250,6 +260,13 @@ func (m *DestinationSpec) GetResponseTransformation() bool {
 	return false
 }
 
+func (m *DestinationSpec) GetUnwrapAsApiGateway() bool {
+	if m != nil {
+		return m.UnwrapAsApiGateway
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("aws.options.gloo.solo.io.DestinationSpec_InvocationStyle", DestinationSpec_InvocationStyle_name, DestinationSpec_InvocationStyle_value)
 	proto.RegisterType((*UpstreamSpec)(nil), "aws.options.gloo.solo.io.UpstreamSpec")
@@ -262,37 +279,38 @@ func init() {
 }
 
 var fileDescriptor_7e8a9525eed72921 = []byte{
-	// 465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x6e, 0x13, 0x31,
-	0x10, 0xc5, 0x4d, 0x5a, 0x12, 0xa7, 0x22, 0x91, 0x55, 0x95, 0x4d, 0x85, 0x50, 0xc8, 0x01, 0xe5,
-	0x00, 0x5e, 0x08, 0x07, 0x40, 0xe2, 0xd2, 0x82, 0x2a, 0x21, 0xa1, 0x1e, 0x36, 0x20, 0x04, 0x97,
-	0x95, 0xe3, 0xce, 0x2e, 0xa6, 0x5e, 0xcf, 0x62, 0x3b, 0xa5, 0x7c, 0x01, 0xbf, 0xc2, 0x27, 0xf0,
-	0x21, 0x7c, 0x01, 0xff, 0xc0, 0x89, 0x0b, 0x5a, 0xef, 0x06, 0x94, 0x40, 0x24, 0x7a, 0x88, 0x34,
-	0xf3, 0xde, 0x9b, 0x37, 0x6f, 0xa2, 0x35, 0x3d, 0xca, 0x95, 0x7f, 0xb7, 0x98, 0x73, 0x89, 0x45,
-	0xec, 0x50, 0xe3, 0x5d, 0x85, 0x71, 0xae, 0x11, 0xe3, 0xd2, 0xe2, 0x7b, 0x90, 0xde, 0xd5, 0x9d,
-	0x28, 0x55, 0x7c, 0x7e, 0x3f, 0xc6, 0xd2, 0x2b, 0x34, 0x2e, 0x16, 0x1f, 0xc3, 0x8f, 0x97, 0x16,
-	0x3d, 0xb2, 0xa8, 0x2a, 0x1b, 0x8a, 0x57, 0x72, 0x5e, 0x39, 0x71, 0x85, 0x07, 0x7b, 0x39, 0xe6,
-	0x18, 0x44, 0x71, 0x55, 0xd5, 0xfa, 0x03, 0x06, 0x17, 0xbe, 0x06, 0xe1, 0xc2, 0x37, 0xd8, 0x30,
-	0x2c, 0x3f, 0x53, 0x7e, 0xb9, 0xca, 0x42, 0x56, 0x53, 0xe3, 0x6f, 0x84, 0xee, 0xbe, 0x2a, 0x9d,
-	0xb7, 0x20, 0x8a, 0x59, 0x09, 0x92, 0xed, 0xd3, 0x1d, 0x0b, 0xb9, 0x42, 0x13, 0x91, 0x11, 0x99,
-	0x74, 0x93, 0xa6, 0x63, 0x8f, 0x28, 0x75, 0x20, 0x2d, 0xf8, 0xd4, 0x42, 0x16, 0x6d, 0x8d, 0xc8,
-	0xa4, 0x37, 0x1d, 0x72, 0x89, 0x16, 0x96, 0x81, 0x78, 0x02, 0x0e, 0x17, 0x56, 0x42, 0x02, 0x59,
-	0xd2, 0xad, 0xc5, 0x09, 0x64, 0xec, 0x35, 0x1d, 0x68, 0x51, 0xcc, 0x4f, 0x45, 0x9a, 0x2d, 0x8c,
-	0x0c, 0x87, 0x44, 0xad, 0x51, 0x6b, 0xd2, 0x9b, 0xde, 0xe1, 0x9b, 0x8e, 0xe3, 0x2f, 0xc2, 0xc4,
-	0x71, 0x33, 0x50, 0x25, 0x4b, 0xfa, 0x7a, 0x05, 0x73, 0x6c, 0x48, 0x3b, 0x16, 0x35, 0xa4, 0xc2,
-	0x9a, 0xa8, 0x1d, 0xc2, 0x5e, 0xad, 0xfa, 0x43, 0x6b, 0xc6, 0x9f, 0x09, 0x65, 0x7f, 0x5b, 0xb0,
-	0x5b, 0x74, 0x57, 0x63, 0xae, 0xa4, 0xd0, 0xa9, 0x11, 0x05, 0x34, 0x27, 0xf6, 0x1a, 0xec, 0x44,
-	0x14, 0xc0, 0xee, 0xd1, 0xbd, 0xb5, 0xb4, 0xb5, 0x74, 0x2b, 0x48, 0xd9, 0x6a, 0x86, 0x30, 0x71,
-	0x83, 0x76, 0x3f, 0x2c, 0x84, 0x56, 0x99, 0x02, 0x1b, 0xb5, 0x82, 0xec, 0x0f, 0x30, 0xfe, 0x49,
-	0x68, 0xff, 0x19, 0x38, 0xaf, 0x8c, 0xb8, 0x4c, 0x8c, 0x53, 0x3a, 0x50, 0xe6, 0x1c, 0x65, 0x18,
-	0x4a, 0x9d, 0xff, 0xa4, 0xeb, 0x08, 0xd7, 0xa6, 0x8f, 0x37, 0xff, 0x69, 0x6b, 0x7b, 0xf8, 0xf3,
-	0xdf, 0x0e, 0xb3, 0xca, 0x20, 0xe9, 0xab, 0x55, 0x80, 0x3d, 0xa4, 0xd7, 0x2d, 0xb8, 0x12, 0x8d,
-	0x83, 0xd4, 0x5b, 0x61, 0x5c, 0x86, 0xb6, 0x08, 0x7c, 0xb4, 0x3d, 0x22, 0x93, 0x4e, 0xb2, 0xbf,
-	0xa4, 0x5f, 0xae, 0xb0, 0xe3, 0xdb, 0xb4, 0xbf, 0x66, 0xce, 0x3a, 0xb4, 0x3d, 0x7b, 0x73, 0xf2,
-	0x74, 0x70, 0x85, 0x75, 0xe9, 0xf6, 0x61, 0x28, 0xc9, 0xd1, 0xf1, 0xd7, 0x1f, 0x6d, 0xf2, 0xe5,
-	0xfb, 0x4d, 0xf2, 0xf6, 0xc9, 0xff, 0xbd, 0x85, 0xf2, 0x2c, 0xff, 0xc7, 0x7b, 0x98, 0xef, 0x84,
-	0xaf, 0xf5, 0xc1, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x93, 0x10, 0x68, 0xd0, 0x52, 0x03, 0x00,
-	0x00,
+	// 491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x49, 0x1a, 0x92, 0x4d, 0x45, 0xa2, 0x55, 0x29, 0x4e, 0x85, 0x50, 0xc8, 0x01, 0xe5,
+	0x00, 0x6b, 0x1a, 0x0e, 0x80, 0xc4, 0x25, 0x05, 0x15, 0x21, 0xa1, 0x1e, 0x1c, 0x10, 0x82, 0x8b,
+	0xb5, 0x71, 0xc7, 0x66, 0xa9, 0xbd, 0xb3, 0xec, 0xae, 0x9b, 0xf6, 0x09, 0x78, 0x15, 0x4e, 0x9c,
+	0x79, 0x10, 0x9e, 0x80, 0x77, 0xe0, 0x8e, 0xbc, 0x76, 0x40, 0x09, 0x44, 0x82, 0x43, 0xa4, 0x99,
+	0xef, 0x67, 0xe6, 0x9b, 0xc8, 0x4b, 0x8e, 0x52, 0x61, 0xdf, 0x17, 0x0b, 0x16, 0x63, 0x1e, 0x18,
+	0xcc, 0xf0, 0x9e, 0xc0, 0x20, 0xcd, 0x10, 0x03, 0xa5, 0xf1, 0x03, 0xc4, 0xd6, 0x54, 0x1d, 0x57,
+	0x22, 0x38, 0x3f, 0x0c, 0x50, 0x59, 0x81, 0xd2, 0x04, 0x7c, 0xe9, 0x7e, 0x4c, 0x69, 0xb4, 0x48,
+	0xfd, 0xb2, 0xac, 0x29, 0x56, 0xca, 0x59, 0x39, 0x89, 0x09, 0x3c, 0xd8, 0x4b, 0x31, 0x45, 0x27,
+	0x0a, 0xca, 0xaa, 0xd2, 0x1f, 0x50, 0xb8, 0xb0, 0x15, 0x08, 0x17, 0xb6, 0xc6, 0x86, 0x6e, 0xf9,
+	0x99, 0xb0, 0xab, 0x55, 0x1a, 0x92, 0x8a, 0x1a, 0x7f, 0xf3, 0xc8, 0xee, 0x6b, 0x65, 0xac, 0x06,
+	0x9e, 0xcf, 0x15, 0xc4, 0x74, 0x9f, 0xb4, 0x35, 0xa4, 0x02, 0xa5, 0xef, 0x8d, 0xbc, 0x49, 0x37,
+	0xac, 0x3b, 0xfa, 0x88, 0x10, 0x03, 0xb1, 0x06, 0x1b, 0x69, 0x48, 0xfc, 0xc6, 0xc8, 0x9b, 0xf4,
+	0xa6, 0x43, 0x16, 0xa3, 0x86, 0x55, 0x20, 0x16, 0x82, 0xc1, 0x42, 0xc7, 0x10, 0x42, 0x12, 0x76,
+	0x2b, 0x71, 0x08, 0x09, 0x7d, 0x43, 0x06, 0x19, 0xcf, 0x17, 0xa7, 0x3c, 0x4a, 0x0a, 0x19, 0xbb,
+	0x43, 0xfc, 0xe6, 0xa8, 0x39, 0xe9, 0x4d, 0xef, 0xb2, 0x6d, 0xc7, 0xb1, 0x97, 0xce, 0x71, 0x5c,
+	0x1b, 0xca, 0x64, 0x61, 0x3f, 0x5b, 0xc3, 0x0c, 0x1d, 0x92, 0x8e, 0xc6, 0x0c, 0x22, 0xae, 0xa5,
+	0xdf, 0x72, 0x61, 0xaf, 0x96, 0xfd, 0x4c, 0xcb, 0xf1, 0x27, 0x8f, 0xd0, 0x3f, 0x47, 0xd0, 0xdb,
+	0x64, 0x37, 0xc3, 0x54, 0xc4, 0x3c, 0x8b, 0x24, 0xcf, 0xa1, 0x3e, 0xb1, 0x57, 0x63, 0x27, 0x3c,
+	0x07, 0x7a, 0x9f, 0xec, 0x6d, 0xa4, 0xad, 0xa4, 0x0d, 0x27, 0xa5, 0xeb, 0x19, 0x9c, 0xe3, 0x26,
+	0xe9, 0x7e, 0x2c, 0x78, 0x26, 0x12, 0x01, 0xda, 0x6f, 0x3a, 0xd9, 0x6f, 0x60, 0xfc, 0xa5, 0x41,
+	0xfa, 0xcf, 0xc0, 0x58, 0x21, 0xf9, 0xff, 0xc4, 0x38, 0x25, 0x03, 0x21, 0xcf, 0x31, 0x76, 0xa6,
+	0xc8, 0xd8, 0xcb, 0xac, 0x8a, 0x70, 0x6d, 0xfa, 0x78, 0xfb, 0x9f, 0xb6, 0xb1, 0x87, 0xbd, 0xf8,
+	0x35, 0x61, 0x5e, 0x0e, 0x08, 0xfb, 0x62, 0x1d, 0xa0, 0x0f, 0xc9, 0x0d, 0x0d, 0x46, 0xa1, 0x34,
+	0x10, 0x59, 0xcd, 0xa5, 0x49, 0x50, 0xe7, 0x8e, 0xf7, 0x77, 0x46, 0xde, 0xa4, 0x13, 0xee, 0xaf,
+	0xe8, 0x57, 0x6b, 0x2c, 0x3d, 0x24, 0xd7, 0x0b, 0xb9, 0xd4, 0x5c, 0x45, 0xdc, 0x44, 0x5c, 0x89,
+	0x28, 0xe5, 0x16, 0x96, 0xfc, 0xd2, 0x6f, 0x3b, 0x1b, 0xad, 0xc8, 0x99, 0x99, 0x29, 0xf1, 0xbc,
+	0x62, 0xc6, 0x77, 0x48, 0x7f, 0x23, 0x0f, 0xed, 0x90, 0xd6, 0xfc, 0xed, 0xc9, 0xd3, 0xc1, 0x15,
+	0xda, 0x25, 0x3b, 0x33, 0x57, 0x7a, 0x47, 0xc7, 0x5f, 0x7f, 0xb4, 0xbc, 0xcf, 0xdf, 0x6f, 0x79,
+	0xef, 0x9e, 0xfc, 0xdb, 0xf3, 0x51, 0x67, 0xe9, 0x5f, 0x9e, 0xd0, 0xa2, 0xed, 0x3e, 0xf0, 0x07,
+	0x3f, 0x07, 0x00, 0x41, 0x9a, 0x9e, 0x9a, 0x85, 0x03, 0x00, 0x00,
 }
 
 func (this *UpstreamSpec) Equal(that interface{}) bool {
@@ -397,6 +415,9 @@ func (this *DestinationSpec) Equal(that interface{}) bool {
 	if this.ResponseTransformation != that1.ResponseTransformation {
 		return false
 	}
+	if this.UnwrapAsApiGateway != that1.UnwrapAsApiGateway {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -136,5 +136,10 @@ func (m *DestinationSpec) Hash(hasher hash.Hash64) (uint64, error) {
 		return 0, err
 	}
 
+	err = binary.Write(hasher, binary.LittleEndian, m.GetUnwrapAsApiGateway())
+	if err != nil {
+		return 0, err
+	}
+
 	return hasher.Sum64(), nil
 }
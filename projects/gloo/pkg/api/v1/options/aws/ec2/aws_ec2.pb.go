@@ -51,6 +51,11 @@ type UpstreamSpec struct {
 	// If provided, Gloo will create an EC2 API client with the provided role. If not provided, Gloo will not assume
 	// a role.
 	RoleArn string `protobuf:"bytes,7,opt,name=role_arn,json=roleArn,proto3" json:"role_arn,omitempty"`
+	// Optional, the external ID to pass when assuming the role referenced by `roleArn`.
+	// Roles in other AWS accounts commonly require an external ID in their trust policy, so that a single Gloo
+	// installation can discover instances across accounts by assuming a role in each of them, instead of being
+	// given long-lived credentials for every account. Ignored if `roleArn` is not set.
+	ExternalId string `protobuf:"bytes,8,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 	// List of tag filters for selecting instances
 	// An instance must match all the filters in order to be selected
 	// Filter keys are not case-sensitive
@@ -109,6 +114,13 @@ func (m *UpstreamSpec) GetRoleArn() string {
 	return ""
 }
 
+func (m *UpstreamSpec) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
 func (m *UpstreamSpec) GetFilters() []*TagFilter {
 	if m != nil {
 		return m.Filters
@@ -267,33 +279,34 @@ func init() {
 }
 
 var fileDescriptor_b14583d3ecc23381 = []byte{
-	// 407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xb1, 0x6e, 0xd4, 0x40,
-	0x10, 0xcd, 0x72, 0x17, 0xdf, 0x79, 0x03, 0x12, 0x5a, 0x45, 0xc8, 0x77, 0x20, 0x64, 0xa5, 0xc1,
-	0x0d, 0x6b, 0x38, 0x1a, 0x4a, 0x92, 0x02, 0x25, 0xa1, 0x41, 0x0b, 0x34, 0x34, 0x96, 0x6f, 0x35,
-	0x36, 0x8b, 0x9d, 0x9b, 0xd5, 0xec, 0xde, 0x11, 0xfe, 0x87, 0x82, 0x4f, 0xe0, 0x7b, 0xf8, 0x01,
-	0x2a, 0x7a, 0xe4, 0xdd, 0x33, 0xa2, 0x21, 0x4a, 0xe5, 0x79, 0xcf, 0x6f, 0xe6, 0xcd, 0xd3, 0x0e,
-	0xbf, 0x6c, 0x8d, 0xff, 0xb4, 0x5d, 0x4b, 0x8d, 0x57, 0xa5, 0xc3, 0x1e, 0x9f, 0x1a, 0x2c, 0xdb,
-	0x1e, 0xb1, 0xb4, 0x84, 0x9f, 0x41, 0x7b, 0x17, 0x51, 0x6d, 0x4d, 0xb9, 0x7b, 0x5e, 0xa2, 0xf5,
-	0x06, 0x37, 0xae, 0xac, 0xbf, 0xb8, 0x12, 0xf4, 0x6a, 0xf8, 0x56, 0xa0, 0x57, 0xd2, 0x12, 0x7a,
-	0x14, 0x8f, 0x46, 0xb8, 0x97, 0xc9, 0xa1, 0x55, 0x0e, 0x53, 0xa5, 0xc1, 0xe5, 0x71, 0x8b, 0x2d,
-	0x06, 0x61, 0x39, 0x54, 0xb1, 0x67, 0x29, 0xe0, 0xda, 0x47, 0x12, 0xae, 0xfd, 0x9e, 0x5b, 0x84,
-	0x45, 0x3a, 0xe3, 0x47, 0x5b, 0x82, 0x26, 0xfe, 0x3a, 0xf9, 0xc5, 0xf8, 0xdd, 0x0f, 0xd6, 0x79,
-	0x82, 0xfa, 0xea, 0x9d, 0x05, 0x2d, 0x1e, 0xf0, 0x84, 0xa0, 0x35, 0xb8, 0xc9, 0x58, 0xce, 0x8a,
-	0x54, 0xed, 0x91, 0x78, 0xc9, 0xb9, 0x03, 0x4d, 0xe0, 0x2b, 0x82, 0x26, 0xbb, 0x93, 0xb3, 0xe2,
-	0x68, 0xb5, 0x90, 0x1a, 0x09, 0xc6, 0x85, 0xa4, 0x02, 0x87, 0x5b, 0xd2, 0xa0, 0xa0, 0x51, 0x69,
-	0x14, 0x2b, 0x68, 0xc4, 0x82, 0xcf, 0x09, 0x7b, 0xa8, 0x6a, 0xda, 0x64, 0xb3, 0x30, 0x73, 0x36,
-	0xe0, 0x53, 0xda, 0x88, 0x53, 0x3e, 0x6b, 0x4c, 0xef, 0x81, 0x5c, 0x36, 0xc9, 0x27, 0xc5, 0xd1,
-	0xea, 0x89, 0xbc, 0x29, 0xb2, 0x7c, 0x5f, 0xb7, 0xaf, 0x83, 0x5e, 0x8d, 0x7d, 0xe2, 0x21, 0x4f,
-	0xed, 0x76, 0xdd, 0x1b, 0x5d, 0x19, 0x9b, 0x4d, 0x73, 0x56, 0xcc, 0xd5, 0x3c, 0x12, 0x17, 0x56,
-	0x08, 0x3e, 0xb5, 0x48, 0x3e, 0x3b, 0xcc, 0x59, 0x71, 0x4f, 0x85, 0xfa, 0xe4, 0x1b, 0xe3, 0xe9,
-	0xdf, 0x39, 0x42, 0xf0, 0x49, 0x07, 0x5f, 0x63, 0xd6, 0xf3, 0x03, 0x35, 0x00, 0x71, 0xc1, 0x67,
-	0xdd, 0xae, 0xb2, 0xb5, 0xa1, 0x7d, 0x4e, 0x79, 0xcb, 0xad, 0xe4, 0x9b, 0xdd, 0xdb, 0xda, 0xd0,
-	0xf9, 0x81, 0x4a, 0xba, 0x50, 0x2d, 0x9f, 0xf1, 0x24, 0x72, 0xe2, 0xfe, 0x3f, 0x46, 0xd1, 0xe6,
-	0x98, 0x1f, 0xee, 0xea, 0x7e, 0x0b, 0xc1, 0x24, 0x55, 0x11, 0x9c, 0x25, 0x7c, 0xea, 0x2c, 0xe8,
-	0xb3, 0xcb, 0x1f, 0xbf, 0xa7, 0xec, 0xfb, 0xcf, 0xc7, 0xec, 0xe3, 0xab, 0xdb, 0x5d, 0x94, 0xed,
-	0xda, 0xff, 0x5c, 0xd5, 0x3a, 0x09, 0x6f, 0xfd, 0xe2, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x37,
-	0x4d, 0xe4, 0xa9, 0x9c, 0x02, 0x00, 0x00,
+	// 423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xc1, 0x8e, 0xd3, 0x30,
+	0x10, 0xdd, 0x6c, 0xbb, 0x69, 0xe3, 0x82, 0x84, 0xac, 0x15, 0x4a, 0x0b, 0x82, 0x68, 0x2f, 0xe4,
+	0x82, 0x03, 0xe5, 0xc2, 0x91, 0xdd, 0x03, 0xda, 0x2e, 0x17, 0x64, 0xe0, 0xc2, 0x25, 0x72, 0xbd,
+	0x93, 0x60, 0x92, 0xcd, 0x58, 0xb6, 0x5b, 0xca, 0x5f, 0xf0, 0x11, 0x1c, 0xf8, 0x04, 0xbe, 0x87,
+	0x7f, 0xe0, 0x8e, 0x62, 0x37, 0x88, 0x0b, 0xab, 0x9e, 0x3c, 0xef, 0xf9, 0xcd, 0xbc, 0xd1, 0xe8,
+	0x91, 0xab, 0x5a, 0xb9, 0x4f, 0x9b, 0x35, 0x93, 0x78, 0x53, 0x58, 0x6c, 0xf1, 0xa9, 0xc2, 0xa2,
+	0x6e, 0x11, 0x0b, 0x6d, 0xf0, 0x33, 0x48, 0x67, 0x03, 0x12, 0x5a, 0x15, 0xdb, 0xe7, 0x05, 0x6a,
+	0xa7, 0xb0, 0xb3, 0x85, 0xf8, 0x62, 0x0b, 0x90, 0xcb, 0xfe, 0x2d, 0x41, 0x2e, 0x99, 0x36, 0xe8,
+	0x90, 0x3e, 0x1c, 0xe0, 0x5e, 0xc6, 0xfa, 0x56, 0xd6, 0x4f, 0x65, 0x0a, 0x17, 0xa7, 0x35, 0xd6,
+	0xe8, 0x85, 0x45, 0x5f, 0x85, 0x9e, 0x05, 0x85, 0x9d, 0x0b, 0x24, 0xec, 0xdc, 0x9e, 0x9b, 0xfb,
+	0x45, 0x1a, 0xe5, 0x06, 0x5b, 0x03, 0x55, 0xf8, 0x3a, 0xfb, 0x76, 0x4c, 0xee, 0x7c, 0xd0, 0xd6,
+	0x19, 0x10, 0x37, 0xef, 0x34, 0x48, 0x7a, 0x9f, 0xc4, 0x06, 0x6a, 0x85, 0x5d, 0x1a, 0x65, 0x51,
+	0x9e, 0xf0, 0x3d, 0xa2, 0x2f, 0x09, 0xb1, 0x20, 0x0d, 0xb8, 0xd2, 0x40, 0x95, 0x1e, 0x67, 0x51,
+	0x3e, 0x5b, 0xce, 0x99, 0x44, 0x03, 0xc3, 0x42, 0x8c, 0x83, 0xc5, 0x8d, 0x91, 0xc0, 0xa1, 0xe2,
+	0x49, 0x10, 0x73, 0xa8, 0xe8, 0x9c, 0x4c, 0x0d, 0xb6, 0x50, 0x0a, 0xd3, 0xa5, 0x13, 0x3f, 0x73,
+	0xd2, 0xe3, 0x73, 0xd3, 0xd1, 0xc7, 0x64, 0x06, 0x3b, 0x07, 0xa6, 0x13, 0x6d, 0xa9, 0xae, 0xd3,
+	0xa9, 0xff, 0x25, 0x03, 0xb5, 0xba, 0xa6, 0xe7, 0x64, 0x52, 0xa9, 0xd6, 0x81, 0xb1, 0xe9, 0x28,
+	0x1b, 0xe5, 0xb3, 0xe5, 0x13, 0x76, 0xdb, 0x4d, 0xd8, 0x7b, 0x51, 0xbf, 0xf6, 0x7a, 0x3e, 0xf4,
+	0xd1, 0x07, 0x24, 0xd1, 0x9b, 0x75, 0xab, 0x64, 0xa9, 0x74, 0x3a, 0xce, 0xa2, 0x7c, 0xca, 0xa7,
+	0x81, 0x58, 0x69, 0x4a, 0xc9, 0x58, 0xa3, 0x71, 0xe9, 0x49, 0x16, 0xe5, 0x77, 0xb9, 0xaf, 0xcf,
+	0xbe, 0x47, 0x24, 0xf9, 0x3b, 0x87, 0x52, 0x32, 0x6a, 0xe0, 0x6b, 0x38, 0xc6, 0xe5, 0x11, 0xef,
+	0x01, 0x5d, 0x91, 0x49, 0xb3, 0x2d, 0xb5, 0x50, 0x66, 0x7f, 0x08, 0x76, 0xe0, 0x56, 0xec, 0xcd,
+	0xf6, 0xad, 0x50, 0xe6, 0xf2, 0x88, 0xc7, 0x8d, 0xaf, 0x16, 0xcf, 0x48, 0x1c, 0x38, 0x7a, 0xef,
+	0x1f, 0xa3, 0x60, 0x73, 0x4a, 0x4e, 0xb6, 0xa2, 0xdd, 0x80, 0x37, 0x49, 0x78, 0x00, 0x17, 0x31,
+	0x19, 0x5b, 0x0d, 0xf2, 0xe2, 0xea, 0xe7, 0xef, 0x71, 0xf4, 0xe3, 0xd7, 0xa3, 0xe8, 0xe3, 0xab,
+	0xc3, 0x22, 0xa7, 0x9b, 0xfa, 0x3f, 0xb1, 0x5b, 0xc7, 0x3e, 0x0c, 0x2f, 0xfe, 0x0c, 0x00, 0x80,
+	0x54, 0x74, 0x61, 0xbd, 0x02, 0x00, 0x00,
 }
 
 func (this *UpstreamSpec) Equal(that interface{}) bool {
@@ -324,6 +337,9 @@ func (this *UpstreamSpec) Equal(that interface{}) bool {
 	if this.RoleArn != that1.RoleArn {
 		return false
 	}
+	if this.ExternalId != that1.ExternalId {
+		return false
+	}
 	if len(this.Filters) != len(that1.Filters) {
 		return false
 	}
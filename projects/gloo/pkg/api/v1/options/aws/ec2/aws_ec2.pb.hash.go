@@ -60,6 +60,10 @@ func (m *UpstreamSpec) Hash(hasher hash.Hash64) (uint64, error) {
 		return 0, err
 	}
 
+	if _, err = hasher.Write([]byte(m.GetExternalId())); err != nil {
+		return 0, err
+	}
+
 	for _, v := range m.GetFilters() {
 
 		if h, ok := interface{}(v).(safe_hasher.SafeHasher); ok {
@@ -62,11 +62,18 @@ type UpstreamSpec struct {
 	// A [Gloo Secret Ref](https://gloo.solo.io/introduction/concepts/#Secrets) to an [Azure Publish Profile JSON file](https://azure.microsoft.com/en-us/downloads/publishing-profile-overview/).
 	// {{ hide_not_implemented "Azure Secrets can be created with `glooctl secret create azure ...`" }}
 	// Note that this secret is not required unless Function Discovery is enabled
-	SecretRef            core.ResourceRef             `protobuf:"bytes,2,opt,name=secret_ref,json=secretRef,proto3" json:"secret_ref"`
-	Functions            []*UpstreamSpec_FunctionSpec `protobuf:"bytes,3,rep,name=functions,proto3" json:"functions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
-	XXX_unrecognized     []byte                       `json:"-"`
-	XXX_sizecache        int32                        `json:"-"`
+	SecretRef core.ResourceRef             `protobuf:"bytes,2,opt,name=secret_ref,json=secretRef,proto3" json:"secret_ref"`
+	Functions []*UpstreamSpec_FunctionSpec `protobuf:"bytes,3,rep,name=functions,proto3" json:"functions,omitempty"`
+	// Authenticate requests to the Function App with an Azure Active Directory access token
+	// of the managed identity assigned to the Gloo pod, e.g. with AAD Pod Identity.
+	// Gloo obtains the tokens from the Azure Instance Metadata Service, and updates the Envoy configuration with new
+	// tokens shortly before they expire. The token is sent in the `Authorization` header, for the App Service authentication of the Function
+	// App to validate. Function keys are still added to the requests if the secret provides them, but are not
+	// required when a managed identity is used.
+	ManagedIdentity      *UpstreamSpec_ManagedIdentity `protobuf:"bytes,4,opt,name=managed_identity,json=managedIdentity,proto3" json:"managed_identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
 func (m *UpstreamSpec) Reset()         { *m = UpstreamSpec{} }
@@ -114,6 +121,13 @@ func (m *UpstreamSpec) GetFunctions() []*UpstreamSpec_FunctionSpec {
 	return nil
 }
 
+func (m *UpstreamSpec) GetManagedIdentity() *UpstreamSpec_ManagedIdentity {
+	if m != nil {
+		return m.ManagedIdentity
+	}
+	return nil
+}
+
 // Function Spec for Functions on Azure Functions Upstreams
 // The Function Spec contains data necessary for Gloo to invoke Azure functions
 type UpstreamSpec_FunctionSpec struct {
@@ -165,6 +179,56 @@ func (m *UpstreamSpec_FunctionSpec) GetAuthLevel() UpstreamSpec_FunctionSpec_Aut
 	return UpstreamSpec_FunctionSpec_Anonymous
 }
 
+type UpstreamSpec_ManagedIdentity struct {
+	// The resource the tokens are requested for, which is the Application ID URI (or client ID)
+	// of the Azure Active Directory application protecting the Function App.
+	Resource string `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	// Optional, the client ID of the user-assigned identity to request the tokens for. Defaults to the
+	// system-assigned identity, or to the only user-assigned identity if there is no system-assigned one.
+	ClientId             string   `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpstreamSpec_ManagedIdentity) Reset()         { *m = UpstreamSpec_ManagedIdentity{} }
+func (m *UpstreamSpec_ManagedIdentity) String() string { return proto.CompactTextString(m) }
+func (*UpstreamSpec_ManagedIdentity) ProtoMessage()    {}
+func (*UpstreamSpec_ManagedIdentity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19a130dd400496e3, []int{0, 1}
+}
+func (m *UpstreamSpec_ManagedIdentity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpstreamSpec_ManagedIdentity.Unmarshal(m, b)
+}
+func (m *UpstreamSpec_ManagedIdentity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpstreamSpec_ManagedIdentity.Marshal(b, m, deterministic)
+}
+func (m *UpstreamSpec_ManagedIdentity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpstreamSpec_ManagedIdentity.Merge(m, src)
+}
+func (m *UpstreamSpec_ManagedIdentity) XXX_Size() int {
+	return xxx_messageInfo_UpstreamSpec_ManagedIdentity.Size(m)
+}
+func (m *UpstreamSpec_ManagedIdentity) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpstreamSpec_ManagedIdentity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpstreamSpec_ManagedIdentity proto.InternalMessageInfo
+
+func (m *UpstreamSpec_ManagedIdentity) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+func (m *UpstreamSpec_ManagedIdentity) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
 type DestinationSpec struct {
 	// The Function Name of the FunctionSpec to be invoked.
 	FunctionName         string   `protobuf:"bytes,1,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
@@ -208,6 +272,7 @@ func init() {
 	proto.RegisterEnum("azure.options.gloo.solo.io.UpstreamSpec_FunctionSpec_AuthLevel", UpstreamSpec_FunctionSpec_AuthLevel_name, UpstreamSpec_FunctionSpec_AuthLevel_value)
 	proto.RegisterType((*UpstreamSpec)(nil), "azure.options.gloo.solo.io.UpstreamSpec")
 	proto.RegisterType((*UpstreamSpec_FunctionSpec)(nil), "azure.options.gloo.solo.io.UpstreamSpec.FunctionSpec")
+	proto.RegisterType((*UpstreamSpec_ManagedIdentity)(nil), "azure.options.gloo.solo.io.UpstreamSpec.ManagedIdentity")
 	proto.RegisterType((*DestinationSpec)(nil), "azure.options.gloo.solo.io.DestinationSpec")
 }
 
@@ -216,32 +281,36 @@ func init() {
 }
 
 var fileDescriptor_19a130dd400496e3 = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xc1, 0xce, 0xd2, 0x40,
-	0x10, 0xa6, 0x80, 0xc6, 0x2e, 0x45, 0x70, 0xe3, 0x01, 0x7a, 0x50, 0x82, 0x17, 0x62, 0xe2, 0x36,
-	0x42, 0xf4, 0x88, 0x29, 0x31, 0xc4, 0x83, 0xf1, 0x50, 0xe2, 0xc5, 0x83, 0xcd, 0x52, 0xa7, 0x65,
-	0xa5, 0xed, 0x6c, 0x76, 0xb7, 0x04, 0x7d, 0x14, 0x9f, 0xc0, 0x47, 0xf0, 0x11, 0x78, 0x0a, 0x0f,
-	0xbe, 0x83, 0x77, 0xd3, 0x96, 0x22, 0x07, 0x4d, 0xf4, 0xbf, 0x6c, 0x76, 0xbe, 0x99, 0xef, 0x9b,
-	0xef, 0x4b, 0x86, 0xac, 0x13, 0x61, 0x76, 0xc5, 0x96, 0x45, 0x98, 0x79, 0x1a, 0x53, 0x7c, 0x22,
-	0xd0, 0x4b, 0x52, 0x44, 0x4f, 0x2a, 0xfc, 0x08, 0x91, 0xd1, 0x75, 0xc5, 0xa5, 0xf0, 0x0e, 0x4f,
-	0x3d, 0x94, 0x46, 0x60, 0xae, 0x3d, 0xfe, 0xb9, 0x50, 0x50, 0xbf, 0x4c, 0x2a, 0x34, 0x48, 0xdd,
-	0xba, 0x38, 0x0f, 0xb0, 0x92, 0xc4, 0x4a, 0x3d, 0x26, 0xd0, 0xbd, 0x9f, 0x60, 0x82, 0xd5, 0x98,
-	0x57, 0xfe, 0x6a, 0x86, 0x4b, 0xe1, 0x68, 0x6a, 0x10, 0x8e, 0xe6, 0x8c, 0x8d, 0x2b, 0x0b, 0x7b,
-	0x61, 0x9a, 0x85, 0x0a, 0xe2, 0xba, 0x35, 0xfd, 0xd2, 0x21, 0xce, 0x5b, 0xa9, 0x8d, 0x02, 0x9e,
-	0x6d, 0x24, 0x44, 0xf4, 0x31, 0xb9, 0x17, 0x17, 0x79, 0x54, 0xee, 0x0b, 0xb9, 0x94, 0x61, 0xce,
-	0x33, 0x18, 0x59, 0x13, 0x6b, 0x66, 0x07, 0x83, 0xa6, 0xe1, 0x4b, 0xf9, 0x86, 0x67, 0x40, 0x97,
-	0x84, 0x68, 0x88, 0x14, 0x98, 0x50, 0x41, 0x3c, 0x6a, 0x4f, 0xac, 0x59, 0x6f, 0x3e, 0x66, 0x11,
-	0x2a, 0x68, 0x4c, 0xb2, 0x00, 0x34, 0x16, 0x2a, 0x82, 0x00, 0xe2, 0x55, 0xf7, 0xf4, 0xfd, 0x61,
-	0x2b, 0xb0, 0x6b, 0x4a, 0x00, 0x31, 0xdd, 0x10, 0xbb, 0x91, 0xd4, 0xa3, 0xce, 0xa4, 0x33, 0xeb,
-	0xcd, 0x9f, 0xb1, 0xbf, 0x27, 0x66, 0xd7, 0x46, 0xd9, 0xfa, 0xcc, 0x2c, 0x8b, 0xe0, 0xb7, 0x8e,
-	0x7b, 0xb2, 0x88, 0x73, 0xdd, 0xa3, 0x8f, 0x48, 0xff, 0x92, 0xe8, 0x2a, 0x8d, 0xd3, 0x80, 0x55,
-	0x94, 0xf7, 0x84, 0xf0, 0xc2, 0xec, 0xc2, 0x14, 0x0e, 0x90, 0x56, 0x51, 0xee, 0xce, 0x5f, 0xdc,
-	0xc8, 0x0b, 0xf3, 0x0b, 0xb3, 0x7b, 0x5d, 0xca, 0x04, 0x36, 0x6f, 0xbe, 0xd3, 0x05, 0xb1, 0x2f,
-	0x38, 0xed, 0x13, 0xdb, 0xcf, 0x31, 0xff, 0x94, 0x61, 0xa1, 0x87, 0x2d, 0xea, 0x90, 0x3b, 0x8d,
-	0xc0, 0xd0, 0xa2, 0x36, 0xb9, 0xe5, 0x7f, 0xc8, 0x44, 0x3e, 0x6c, 0x4f, 0x9f, 0x93, 0xc1, 0x4b,
-	0xd0, 0x46, 0xe4, 0xfc, 0xbf, 0xc2, 0xac, 0x5e, 0x7d, 0xfb, 0xd9, 0xb5, 0xbe, 0xfe, 0x78, 0x60,
-	0xbd, 0x5b, 0xfe, 0xdb, 0x1d, 0xca, 0x7d, 0xf2, 0xc7, 0x5b, 0xdc, 0xde, 0xae, 0xae, 0x64, 0xf1,
-	0x2b, 0x00, 0x00, 0xff, 0xff, 0xb2, 0xb5, 0xf3, 0x61, 0xd0, 0x02, 0x00, 0x00,
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x18, 0xac, 0xdb, 0x80, 0xe2, 0xaf, 0x29, 0x09, 0x2b, 0x0e, 0xa9, 0x91, 0x20, 0x0a, 0x97, 0x08,
+	0x89, 0xb5, 0x48, 0x05, 0xe2, 0x54, 0x94, 0x0a, 0x55, 0x14, 0x01, 0x07, 0x57, 0x5c, 0x38, 0x60,
+	0x6d, 0xed, 0xcf, 0xce, 0x52, 0x7b, 0xbf, 0xd5, 0x7a, 0x5d, 0xb5, 0x3c, 0x11, 0x8f, 0xc0, 0x23,
+	0x54, 0xe2, 0x1d, 0x38, 0xf0, 0x0e, 0xdc, 0x91, 0xff, 0x42, 0x40, 0x20, 0x95, 0x5e, 0xa2, 0xfd,
+	0x66, 0x77, 0x66, 0x67, 0x26, 0x5e, 0x38, 0x4c, 0xa5, 0x5d, 0x96, 0x27, 0x3c, 0xa2, 0xdc, 0x2f,
+	0x28, 0xa3, 0x47, 0x92, 0xfc, 0x34, 0x23, 0xf2, 0xb5, 0xa1, 0x8f, 0x18, 0xd9, 0xa2, 0x99, 0x84,
+	0x96, 0xfe, 0xd9, 0x63, 0x9f, 0xb4, 0x95, 0xa4, 0x0a, 0x5f, 0x7c, 0x2a, 0x0d, 0x36, 0xbf, 0x5c,
+	0x1b, 0xb2, 0xc4, 0xbc, 0x66, 0x68, 0x0f, 0xf0, 0x8a, 0xc4, 0x2b, 0x3d, 0x2e, 0xc9, 0xbb, 0x93,
+	0x52, 0x4a, 0xf5, 0x31, 0xbf, 0x5a, 0x35, 0x0c, 0x8f, 0xe1, 0xb9, 0x6d, 0x40, 0x3c, 0xb7, 0x2d,
+	0xb6, 0x5b, 0x5b, 0x38, 0x95, 0xb6, 0xbb, 0xd0, 0x60, 0xd2, 0x6c, 0x4d, 0xbf, 0xf6, 0x60, 0xf0,
+	0x4e, 0x17, 0xd6, 0xa0, 0xc8, 0x8f, 0x35, 0x46, 0xec, 0x21, 0xdc, 0x4e, 0x4a, 0x15, 0x55, 0xf7,
+	0x85, 0x42, 0xeb, 0x50, 0x89, 0x1c, 0xc7, 0xce, 0xc4, 0x99, 0xb9, 0xc1, 0xb0, 0xdb, 0x58, 0x68,
+	0xfd, 0x56, 0xe4, 0xc8, 0xf6, 0x01, 0x0a, 0x8c, 0x0c, 0xda, 0xd0, 0x60, 0x32, 0xde, 0x9c, 0x38,
+	0xb3, 0xed, 0xf9, 0x2e, 0x8f, 0xc8, 0x60, 0x67, 0x92, 0x07, 0x58, 0x50, 0x69, 0x22, 0x0c, 0x30,
+	0x39, 0xe8, 0x5d, 0x7e, 0xbb, 0xbf, 0x11, 0xb8, 0x0d, 0x25, 0xc0, 0x84, 0x1d, 0x83, 0xdb, 0x49,
+	0x16, 0xe3, 0xad, 0xc9, 0xd6, 0x6c, 0x7b, 0xfe, 0x84, 0xff, 0x3b, 0x31, 0x5f, 0x37, 0xca, 0x0f,
+	0x5b, 0x66, 0x35, 0x04, 0xbf, 0x74, 0x58, 0x04, 0xa3, 0x5c, 0x28, 0x91, 0x62, 0x1c, 0xca, 0x18,
+	0x95, 0x95, 0xf6, 0x62, 0xdc, 0xab, 0xad, 0x3d, 0xbb, 0xb2, 0xf6, 0x9b, 0x46, 0xe0, 0xa8, 0xe5,
+	0x07, 0xc3, 0xfc, 0x77, 0xc0, 0xbb, 0x74, 0x60, 0xb0, 0x6e, 0x80, 0x3d, 0x80, 0x9d, 0x55, 0x6d,
+	0x6b, 0x95, 0x0d, 0x3a, 0xb0, 0xee, 0xeb, 0x03, 0x80, 0x28, 0xed, 0x32, 0xcc, 0xf0, 0x0c, 0xb3,
+	0xba, 0xaf, 0x5b, 0xf3, 0xe7, 0xd7, 0x0a, 0xcc, 0x17, 0xa5, 0x5d, 0xbe, 0xae, 0x64, 0x02, 0x57,
+	0x74, 0xcb, 0xe9, 0x1e, 0xb8, 0x2b, 0x9c, 0xed, 0x80, 0xbb, 0x50, 0xa4, 0x2e, 0x72, 0x2a, 0x8b,
+	0xd1, 0x06, 0x1b, 0x40, 0xbf, 0x13, 0x18, 0x39, 0xcc, 0x85, 0x1b, 0x8b, 0x38, 0x97, 0x6a, 0xb4,
+	0xe9, 0xbd, 0x82, 0xe1, 0x1f, 0x71, 0x99, 0x07, 0x7d, 0xd3, 0xfe, 0x6f, 0x6d, 0x8e, 0xd5, 0xcc,
+	0xee, 0x82, 0x1b, 0x65, 0x12, 0x95, 0x0d, 0x65, 0x5c, 0x47, 0x70, 0x83, 0x7e, 0x03, 0x1c, 0xc5,
+	0xd3, 0xa7, 0x30, 0x7c, 0x81, 0x85, 0x95, 0x4a, 0xfc, 0x57, 0x31, 0x07, 0x2f, 0xbf, 0xfc, 0xe8,
+	0x39, 0x9f, 0xbf, 0xdf, 0x73, 0xde, 0xef, 0x5f, 0xed, 0xe1, 0xe8, 0xd3, 0xf4, 0xaf, 0x8f, 0xe7,
+	0xe4, 0x66, 0xfd, 0x59, 0xef, 0xfd, 0x1c, 0x00, 0xe7, 0x24, 0x5d, 0x23, 0x81, 0x03, 0x00, 0x00,
 }
 
 func (this *UpstreamSpec) Equal(that interface{}) bool {
@@ -277,6 +346,9 @@ func (this *UpstreamSpec) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.ManagedIdentity.Equal(that1.ManagedIdentity) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -312,6 +384,36 @@ func (this *UpstreamSpec_FunctionSpec) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *UpstreamSpec_ManagedIdentity) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSpec_ManagedIdentity)
+	if !ok {
+		that2, ok := that.(UpstreamSpec_ManagedIdentity)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Resource != that1.Resource {
+		return false
+	}
+	if this.ClientId != that1.ClientId {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *DestinationSpec) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
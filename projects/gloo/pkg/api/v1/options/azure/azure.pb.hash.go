@@ -74,6 +74,20 @@ func (m *UpstreamSpec) Hash(hasher hash.Hash64) (uint64, error) {
 
 	}
 
+	if h, ok := interface{}(m.GetManagedIdentity()).(safe_hasher.SafeHasher); ok {
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if val, err := hashstructure.Hash(m.GetManagedIdentity(), nil); err != nil {
+			return 0, err
+		} else {
+			if err := binary.Write(hasher, binary.LittleEndian, val); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	return hasher.Sum64(), nil
 }
 
@@ -121,3 +135,27 @@ func (m *UpstreamSpec_FunctionSpec) Hash(hasher hash.Hash64) (uint64, error) {
 
 	return hasher.Sum64(), nil
 }
+
+// Hash function
+func (m *UpstreamSpec_ManagedIdentity) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("azure.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/azure.UpstreamSpec_ManagedIdentity")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetResource())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetClientId())); err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
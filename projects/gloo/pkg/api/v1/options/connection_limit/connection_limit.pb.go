@@ -0,0 +1,148 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/connection_limit/connection_limit.proto
+
+package connection_limit
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Limits the number of connections that each Envoy instance accepts on a listener at the same time. Connections
+// over the limit are closed, and counted in the `connection_limit.<listener name>.limited_connections` stat.
+// Requires an Envoy build with the connection limit network filter.
+type ConnectionLimit struct {
+	// The maximum number of active connections of the listener. Must be greater than 0.
+	MaxActiveConnections *types.UInt32Value `protobuf:"bytes,1,opt,name=max_active_connections,json=maxActiveConnections,proto3" json:"max_active_connections,omitempty"`
+	// Wait this long before closing the connections over the limit, rather than closing them immediately, to slow
+	// down the clients that retry right away.
+	DelayBeforeClose     *time.Duration `protobuf:"bytes,2,opt,name=delay_before_close,json=delayBeforeClose,proto3,stdduration" json:"delay_before_close,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ConnectionLimit) Reset()         { *m = ConnectionLimit{} }
+func (m *ConnectionLimit) String() string { return proto.CompactTextString(m) }
+func (*ConnectionLimit) ProtoMessage()    {}
+func (*ConnectionLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af0bb9c21fcaff28, []int{0}
+}
+func (m *ConnectionLimit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConnectionLimit.Unmarshal(m, b)
+}
+func (m *ConnectionLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConnectionLimit.Marshal(b, m, deterministic)
+}
+func (m *ConnectionLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionLimit.Merge(m, src)
+}
+func (m *ConnectionLimit) XXX_Size() int {
+	return xxx_messageInfo_ConnectionLimit.Size(m)
+}
+func (m *ConnectionLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectionLimit proto.InternalMessageInfo
+
+func (m *ConnectionLimit) GetMaxActiveConnections() *types.UInt32Value {
+	if m != nil {
+		return m.MaxActiveConnections
+	}
+	return nil
+}
+
+func (m *ConnectionLimit) GetDelayBeforeClose() *time.Duration {
+	if m != nil {
+		return m.DelayBeforeClose
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ConnectionLimit)(nil), "connection_limit.options.gloo.solo.io.ConnectionLimit")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/options/connection_limit/connection_limit.proto", fileDescriptor_af0bb9c21fcaff28)
+}
+
+var fileDescriptor_af0bb9c21fcaff28 = []byte{
+	// 302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x41, 0x4a, 0xc4, 0x30,
+	0x14, 0x86, 0xa9, 0x0c, 0x2e, 0xea, 0x42, 0x29, 0x83, 0x8c, 0x22, 0xa3, 0x08, 0x82, 0x1b, 0x13,
+	0x9c, 0x39, 0x81, 0x33, 0x6e, 0x06, 0x74, 0x53, 0xd1, 0x85, 0x08, 0x25, 0xcd, 0xbc, 0x89, 0xd1,
+	0xb4, 0x2f, 0x24, 0xe9, 0x58, 0x6f, 0xe2, 0x11, 0x3c, 0x80, 0x0b, 0x6f, 0x23, 0x78, 0x07, 0xf7,
+	0x92, 0xb4, 0x3a, 0x8b, 0x2e, 0x74, 0xf7, 0xfa, 0xbf, 0xfe, 0xdf, 0xff, 0x87, 0x17, 0xdf, 0x09,
+	0xe9, 0xee, 0xab, 0x9c, 0x70, 0x2c, 0xa8, 0x45, 0x85, 0x27, 0x12, 0xa9, 0x50, 0x88, 0x54, 0x1b,
+	0x7c, 0x00, 0xee, 0x6c, 0xf3, 0xc5, 0xb4, 0xa4, 0xcb, 0x53, 0x8a, 0xda, 0x49, 0x2c, 0x2d, 0xe5,
+	0x58, 0x96, 0xc0, 0xfd, 0x9c, 0x29, 0x59, 0x48, 0xd7, 0x11, 0x88, 0x36, 0xe8, 0x30, 0x39, 0xea,
+	0xe8, 0x2d, 0x81, 0x78, 0x2a, 0xf1, 0x81, 0x44, 0xe2, 0xee, 0x50, 0x20, 0x0a, 0x05, 0x34, 0x98,
+	0xf2, 0x6a, 0x41, 0xe7, 0x95, 0x61, 0xfe, 0xbf, 0x06, 0xd3, 0xdd, 0x3f, 0x19, 0xa6, 0x35, 0x18,
+	0xdb, 0xee, 0xfb, 0x02, 0x05, 0x86, 0x91, 0xfa, 0xa9, 0x55, 0x13, 0xa8, 0x5d, 0x23, 0x42, 0xdd,
+	0x16, 0x3a, 0x7c, 0x8b, 0xe2, 0xcd, 0xe9, 0x6f, 0xa7, 0x0b, 0x5f, 0x29, 0x49, 0xe3, 0xed, 0x82,
+	0xd5, 0x19, 0xe3, 0x4e, 0x2e, 0x21, 0x5b, 0x35, 0xb6, 0x83, 0xe8, 0x20, 0x3a, 0xde, 0x18, 0xed,
+	0x91, 0x26, 0x9e, 0xfc, 0xc4, 0x93, 0xeb, 0x59, 0xe9, 0xc6, 0xa3, 0x1b, 0xa6, 0x2a, 0x48, 0xfb,
+	0x05, 0xab, 0xcf, 0x82, 0x75, 0xc5, 0xb5, 0xc9, 0x65, 0x9c, 0xcc, 0x41, 0xb1, 0xe7, 0x2c, 0x87,
+	0x05, 0x1a, 0xc8, 0xb8, 0x42, 0x0b, 0x83, 0xb5, 0xc0, 0xdb, 0xe9, 0xf0, 0xce, 0xdb, 0xe7, 0x4e,
+	0x7a, 0x2f, 0x1f, 0xfb, 0x51, 0xba, 0x15, 0xac, 0x93, 0xe0, 0x9c, 0x7a, 0xe3, 0xe4, 0xea, 0xfd,
+	0xab, 0x17, 0xbd, 0x7e, 0x0e, 0xa3, 0xdb, 0xd9, 0xff, 0xee, 0xa5, 0x1f, 0xc5, 0x5f, 0x37, 0xcb,
+	0xd7, 0x43, 0xfe, 0xf8, 0x7b, 0x00, 0x7d, 0xaa, 0x0e, 0x05, 0x03, 0x02, 0x00, 0x00,
+}
+
+func (this *ConnectionLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConnectionLimit)
+	if !ok {
+		that2, ok := that.(ConnectionLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MaxActiveConnections.Equal(that1.MaxActiveConnections) {
+		return false
+	}
+	if this.DelayBeforeClose != nil && that1.DelayBeforeClose != nil {
+		if *this.DelayBeforeClose != *that1.DelayBeforeClose {
+			return false
+		}
+	} else if this.DelayBeforeClose != nil {
+		return false
+	} else if that1.DelayBeforeClose != nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
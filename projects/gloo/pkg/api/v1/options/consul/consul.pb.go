@@ -12,6 +12,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	options "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -25,11 +26,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Filters the instances of the service by the aggregated status of their Consul health checks.
+type UpstreamSpec_HealthFilter int32
+
+const (
+	// Include every instance, regardless of its health.
+	UpstreamSpec_ANY UpstreamSpec_HealthFilter = 0
+	// Include only the instances whose checks are all passing.
+	UpstreamSpec_PASSING UpstreamSpec_HealthFilter = 1
+	// Include the instances whose checks are passing or warning.
+	UpstreamSpec_WARNING UpstreamSpec_HealthFilter = 2
+)
+
+var UpstreamSpec_HealthFilter_name = map[int32]string{
+	0: "ANY",
+	1: "PASSING",
+	2: "WARNING",
+}
+
+var UpstreamSpec_HealthFilter_value = map[string]int32{
+	"ANY":     0,
+	"PASSING": 1,
+	"WARNING": 2,
+}
+
+func (x UpstreamSpec_HealthFilter) String() string {
+	return proto.EnumName(UpstreamSpec_HealthFilter_name, int32(x))
+}
+
+func (UpstreamSpec_HealthFilter) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3c5077911f8bc0ad, []int{0, 0}
+}
+
 // Upstream Spec for Consul Upstreams
 // consul Upstreams represent a set of one or more addressable pods for a consul Service
 // the Gloo consul Upstream maps to a single service port. Because consul Services support multiple ports,
 // Gloo requires that a different upstream be created for each port
-// consul Upstreams are typically generated automatically by Gloo from the consul API
+// consul Upstreams are typically generated automatically by Gloo from the consul API.
+// When discovery updates such an upstream, it only changes the fields it derives from the consul API, and preserves
+// the fields it can't know about: `service_spec`, `aggregate_data_centers`, `subset_metadata_keys`, `token_secret_ref`,
+// `health_filter`, `prepared_query` and `dns_nameservers`.
 type UpstreamSpec struct {
 	// The name of the Consul Service
 	ServiceName string `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
@@ -50,7 +86,36 @@ type UpstreamSpec struct {
 	// Is this consul service connect enabled.
 	ConnectEnabled bool `protobuf:"varint,4,opt,name=connect_enabled,json=connectEnabled,proto3" json:"connect_enabled,omitempty"`
 	// The data centers in which the service instance represented by this upstream is registered.
-	DataCenters          []string `protobuf:"bytes,5,rep,name=data_centers,json=dataCenters,proto3" json:"data_centers,omitempty"`
+	DataCenters []string `protobuf:"bytes,5,rep,name=data_centers,json=dataCenters,proto3" json:"data_centers,omitempty"`
+	// If set to true, the instances of the service from every data center listed in `data_centers` are aggregated into
+	// a single EDS cluster, grouped into one Envoy locality per data center (the locality's zone is the data center name).
+	// This allows Envoy to prefer the instances in its own data center through zone-aware routing.
+	// Instances registered in data centers which are not listed are excluded from the upstream.
+	AggregateDataCenters bool `protobuf:"varint,8,opt,name=aggregate_data_centers,json=aggregateDataCenters,proto3" json:"aggregate_data_centers,omitempty"`
+	// Gloo will segment instances based off of the values of these keys in their
+	// [service metadata](https://www.consul.io/docs/agent/services.html#meta). This allows you to set routes that route
+	// to the instances whose metadata match the given values (e.g. to send canary traffic to the instances of a given
+	// `version`), by setting the `subset` of the route destination to the `meta_`-prefixed keys and their values,
+	// e.g. `meta_version: v2`. A subset is created for every combination of these keys, so at most 3 keys are allowed.
+	SubsetMetadataKeys []string `protobuf:"bytes,9,rep,name=subset_metadata_keys,json=subsetMetadataKeys,proto3" json:"subset_metadata_keys,omitempty"`
+	// A reference to a secret of the `consul_token` kind holding the ACL token used to query the instances of the
+	// service. If not set, the token configured for the service in the Consul settings is used, falling back to
+	// the default token.
+	TokenSecretRef *core.ResourceRef `protobuf:"bytes,10,opt,name=token_secret_ref,json=tokenSecretRef,proto3" json:"token_secret_ref,omitempty"`
+	// Which instances of the service are included in the upstream, based on their health. Defaults to `ANY`.
+	// Independently of this filter, the health of each instance is reported to Envoy: instances with passing checks
+	// are healthy, instances with warning checks are degraded (only used when there are not enough healthy ones),
+	// critical instances are unhealthy and instances in maintenance are draining.
+	HealthFilter UpstreamSpec_HealthFilter `protobuf:"varint,11,opt,name=health_filter,json=healthFilter,proto3,enum=consul.options.gloo.solo.io.UpstreamSpec_HealthFilter" json:"health_filter,omitempty"`
+	// The name or ID of a Consul [prepared query](https://www.consul.io/api-docs/query) used to resolve the address
+	// of the service for function discovery, instead of the catalog. Consul then picks the instance, following the
+	// failover policy of the query. The endpoints Envoy routes to are not affected by this field.
+	PreparedQuery string `protobuf:"bytes,12,opt,name=prepared_query,json=preparedQuery,proto3" json:"prepared_query,omitempty"`
+	// The nameservers used to resolve the instances of the service which are registered with a hostname, as
+	// addresses with an optional port (defaulting to 53). Defaults to the `dnsAddress` of the Consul settings.
+	// When several upstreams of the same service set nameservers, the instances are resolved with the nameservers of
+	// the first one.
+	DnsNameservers       []string `protobuf:"bytes,13,rep,name=dns_nameservers,json=dnsNameservers,proto3" json:"dns_nameservers,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -129,7 +194,50 @@ func (m *UpstreamSpec) GetDataCenters() []string {
 	return nil
 }
 
+func (m *UpstreamSpec) GetAggregateDataCenters() bool {
+	if m != nil {
+		return m.AggregateDataCenters
+	}
+	return false
+}
+
+func (m *UpstreamSpec) GetSubsetMetadataKeys() []string {
+	if m != nil {
+		return m.SubsetMetadataKeys
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetTokenSecretRef() *core.ResourceRef {
+	if m != nil {
+		return m.TokenSecretRef
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetHealthFilter() UpstreamSpec_HealthFilter {
+	if m != nil {
+		return m.HealthFilter
+	}
+	return UpstreamSpec_ANY
+}
+
+func (m *UpstreamSpec) GetPreparedQuery() string {
+	if m != nil {
+		return m.PreparedQuery
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetDnsNameservers() []string {
+	if m != nil {
+		return m.DnsNameservers
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("consul.options.gloo.solo.io.UpstreamSpec_HealthFilter", UpstreamSpec_HealthFilter_name, UpstreamSpec_HealthFilter_value)
 	proto.RegisterType((*UpstreamSpec)(nil), "consul.options.gloo.solo.io.UpstreamSpec")
 }
 
@@ -138,28 +246,41 @@ func init() {
 }
 
 var fileDescriptor_3c5077911f8bc0ad = []byte{
-	// 334 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbd, 0x4e, 0xf3, 0x30,
-	0x14, 0x86, 0x95, 0xb6, 0x5f, 0x3f, 0x70, 0x0b, 0x48, 0x11, 0x43, 0x54, 0x24, 0x48, 0x61, 0xa0,
-	0x0b, 0xb1, 0xf8, 0xd9, 0x91, 0xf8, 0x91, 0x60, 0x61, 0x68, 0x61, 0x61, 0xa9, 0x1c, 0xf7, 0xc8,
-	0x18, 0x12, 0x1f, 0x2b, 0x76, 0xab, 0x5e, 0x12, 0x03, 0x17, 0xc0, 0xf5, 0x70, 0x0f, 0xec, 0xc8,
-	0x76, 0x0a, 0x1d, 0x2a, 0xc1, 0x94, 0xf8, 0xd1, 0x73, 0x7c, 0xce, 0x9b, 0x13, 0x72, 0x23, 0xa4,
-	0x7d, 0x9a, 0xe6, 0x19, 0xc7, 0x92, 0x1a, 0x2c, 0xf0, 0x48, 0x22, 0x15, 0x05, 0x22, 0xd5, 0x15,
-	0x3e, 0x03, 0xb7, 0x26, 0x9c, 0x98, 0x96, 0x74, 0x76, 0x4c, 0x51, 0x5b, 0x89, 0xca, 0x50, 0x8e,
-	0xca, 0x4c, 0x8b, 0xfa, 0x91, 0xe9, 0x0a, 0x2d, 0xc6, 0x3b, 0xf5, 0xa9, 0x76, 0x32, 0x57, 0x97,
-	0xb9, 0x2b, 0x33, 0x89, 0xbd, 0x6d, 0x81, 0x02, 0xbd, 0x47, 0xdd, 0x5b, 0x28, 0xe9, 0xc5, 0x30,
-	0xb7, 0x01, 0xc2, 0xdc, 0xd6, 0xec, 0xec, 0xf7, 0xee, 0x06, 0xaa, 0x99, 0xe4, 0x30, 0x36, 0x1a,
-	0x78, 0xa8, 0xda, 0x7f, 0x6b, 0x90, 0xee, 0x83, 0x36, 0xb6, 0x02, 0x56, 0x8e, 0x34, 0xf0, 0xb8,
-	0x4f, 0xba, 0x0b, 0x4d, 0xb1, 0x12, 0x92, 0x28, 0x8d, 0x06, 0xeb, 0xc3, 0x4e, 0xcd, 0xee, 0x58,
-	0x09, 0xcb, 0x8a, 0x65, 0xc2, 0x24, 0x8d, 0xb4, 0xb9, 0xa4, 0xdc, 0x33, 0x61, 0xe2, 0x3d, 0xd2,
-	0x31, 0xd3, 0xdc, 0x80, 0x0d, 0x46, 0xdb, 0x1b, 0x24, 0x20, 0x2f, 0x1c, 0x90, 0x0d, 0xa9, 0x8c,
-	0x65, 0x6a, 0x71, 0xc9, 0x7f, 0xaf, 0x74, 0x17, 0xd0, 0x4b, 0x57, 0x3f, 0x8d, 0xdc, 0xc8, 0x49,
-	0x33, 0x8d, 0x06, 0x9d, 0x93, 0xfe, 0xca, 0x2f, 0x95, 0x8d, 0x82, 0xe9, 0x42, 0x7c, 0xcf, 0xe2,
-	0x13, 0x1d, 0x92, 0x2d, 0x8e, 0x4a, 0x01, 0xb7, 0x63, 0x50, 0x2c, 0x2f, 0x60, 0x92, 0xb4, 0xd2,
-	0x68, 0xb0, 0x36, 0xdc, 0xac, 0xf1, 0x75, 0xa0, 0x2e, 0xd7, 0x84, 0x59, 0x36, 0xe6, 0xa0, 0x2c,
-	0x54, 0x26, 0xf9, 0x17, 0x72, 0x39, 0x76, 0x19, 0xd0, 0xc5, 0xed, 0xfb, 0x67, 0x2b, 0x7a, 0xfd,
-	0xd8, 0x8d, 0x1e, 0xcf, 0xff, 0xb6, 0x7f, 0xfd, 0x22, 0x56, 0xff, 0x03, 0x79, 0xdb, 0x2f, 0xe0,
-	0xf4, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x35, 0x9e, 0xf1, 0x87, 0x49, 0x02, 0x00, 0x00,
+	// 544 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xd1, 0x6e, 0xd3, 0x4a,
+	0x10, 0xbd, 0x6e, 0xda, 0xa6, 0xdd, 0x38, 0xb9, 0xd1, 0x2a, 0x42, 0x6e, 0x91, 0x20, 0x2d, 0x42,
+	0xe4, 0x05, 0x9b, 0x96, 0x8a, 0x57, 0x14, 0x5a, 0xa0, 0x15, 0x22, 0x02, 0x07, 0x84, 0x80, 0x07,
+	0x6b, 0xb3, 0x99, 0x38, 0x26, 0xce, 0xae, 0xd9, 0x9d, 0x54, 0xcd, 0x1f, 0xf1, 0x09, 0xf0, 0x3b,
+	0xfc, 0x03, 0xef, 0x68, 0x77, 0x9d, 0x60, 0xa4, 0x0a, 0x78, 0x4a, 0xe6, 0xcc, 0x39, 0x33, 0x7b,
+	0xc6, 0x33, 0xe4, 0x3c, 0xcd, 0x70, 0xba, 0x18, 0x85, 0x5c, 0xce, 0x23, 0x2d, 0x73, 0x79, 0x3f,
+	0x93, 0x51, 0x9a, 0x4b, 0x19, 0x15, 0x4a, 0x7e, 0x02, 0x8e, 0xda, 0x45, 0xac, 0xc8, 0xa2, 0xcb,
+	0xa3, 0x48, 0x16, 0x98, 0x49, 0xa1, 0x23, 0x2e, 0x85, 0x5e, 0xe4, 0xe5, 0x4f, 0x58, 0x28, 0x89,
+	0x92, 0xde, 0x2c, 0xa3, 0x92, 0x13, 0x1a, 0x5d, 0x68, 0x4a, 0x86, 0x99, 0xdc, 0xef, 0xa4, 0x32,
+	0x95, 0x96, 0x17, 0x99, 0x7f, 0x4e, 0xb2, 0x4f, 0xe1, 0x0a, 0x1d, 0x08, 0x57, 0x58, 0x62, 0x27,
+	0x7f, 0xef, 0xae, 0x41, 0x5d, 0x66, 0x1c, 0x12, 0x5d, 0x00, 0x2f, 0x55, 0x7b, 0xf6, 0xed, 0xb3,
+	0x0c, 0x57, 0x5c, 0x05, 0x13, 0x97, 0x3a, 0xfc, 0xb6, 0x45, 0xfc, 0xb7, 0x85, 0x46, 0x05, 0x6c,
+	0x3e, 0x2c, 0x80, 0xd3, 0x03, 0xe2, 0xaf, 0x2a, 0x08, 0x36, 0x87, 0xc0, 0xeb, 0x7a, 0xbd, 0xdd,
+	0xb8, 0x51, 0x62, 0x03, 0x36, 0x87, 0x2a, 0x05, 0x59, 0xaa, 0x83, 0x8d, 0x6e, 0xad, 0x42, 0x79,
+	0xc3, 0x52, 0x4d, 0x6f, 0x93, 0x86, 0x5e, 0x8c, 0x34, 0xa0, 0x63, 0x6c, 0x5b, 0x06, 0x71, 0x90,
+	0x25, 0xdc, 0x21, 0xcd, 0x4c, 0x68, 0x64, 0x62, 0x55, 0xa4, 0x6e, 0x29, 0xfe, 0x0a, 0xb4, 0xa4,
+	0x33, 0xe2, 0x57, 0xdd, 0x04, 0xb5, 0xae, 0xd7, 0x6b, 0x1c, 0x1f, 0x5c, 0x3b, 0xc4, 0x70, 0xe8,
+	0x98, 0xc6, 0xc4, 0xfa, 0x2d, 0xd6, 0xd1, 0x3d, 0xf2, 0x3f, 0x97, 0x42, 0x00, 0xc7, 0x04, 0x04,
+	0x1b, 0xe5, 0x30, 0x0e, 0x36, 0xbb, 0x5e, 0x6f, 0x27, 0x6e, 0x95, 0xf0, 0x53, 0x87, 0x1a, 0x5f,
+	0x63, 0x86, 0x2c, 0xe1, 0x20, 0x10, 0x94, 0x0e, 0xb6, 0x9c, 0x2f, 0x83, 0x9d, 0x3a, 0x88, 0x9e,
+	0x90, 0x1b, 0x2c, 0x4d, 0x15, 0xa4, 0x0c, 0x21, 0xf9, 0x8d, 0xbc, 0x63, 0x4b, 0x76, 0xd6, 0xd9,
+	0xb3, 0x8a, 0xea, 0x01, 0xe9, 0x94, 0xd3, 0x98, 0x03, 0x32, 0x2b, 0x9b, 0xc1, 0x52, 0x07, 0xbb,
+	0xb6, 0x01, 0x75, 0xb9, 0x97, 0x65, 0xea, 0x05, 0x2c, 0x35, 0x3d, 0x25, 0x6d, 0x94, 0x33, 0x10,
+	0x89, 0x06, 0xae, 0x00, 0x13, 0x05, 0x93, 0x80, 0x58, 0xf7, 0x7b, 0x21, 0x97, 0x0a, 0xd6, 0xae,
+	0x63, 0xd0, 0x72, 0xa1, 0x38, 0xc4, 0x30, 0x89, 0x5b, 0x56, 0x32, 0xb4, 0x8a, 0x18, 0x26, 0xf4,
+	0x23, 0x69, 0x4e, 0x81, 0xe5, 0x38, 0x4d, 0x26, 0x59, 0x8e, 0xa0, 0x82, 0x46, 0xd7, 0xeb, 0xb5,
+	0x8e, 0x1f, 0x85, 0x7f, 0xd8, 0xc5, 0xb0, 0xba, 0x0c, 0xe1, 0xb9, 0x95, 0x3f, 0xb3, 0xea, 0xd8,
+	0x9f, 0x56, 0x22, 0x7a, 0x97, 0xb4, 0x0a, 0x05, 0x05, 0x53, 0x30, 0x4e, 0x3e, 0x2f, 0x40, 0x2d,
+	0x03, 0xdf, 0x6e, 0x4a, 0x73, 0x85, 0xbe, 0x36, 0xa0, 0x19, 0xfe, 0x58, 0x68, 0xbb, 0x4a, 0xe6,
+	0x9b, 0x98, 0x49, 0x35, 0xad, 0xeb, 0xd6, 0x58, 0xe8, 0xc1, 0x2f, 0xf4, 0xf0, 0x88, 0xf8, 0xd5,
+	0x6e, 0xb4, 0x4e, 0x6a, 0xfd, 0xc1, 0xfb, 0xf6, 0x7f, 0xb4, 0x41, 0xea, 0xaf, 0xfa, 0xc3, 0xe1,
+	0xc5, 0xe0, 0x79, 0xdb, 0x33, 0xc1, 0xbb, 0x7e, 0x3c, 0x30, 0xc1, 0xc6, 0x93, 0x8b, 0xaf, 0x3f,
+	0x36, 0xbd, 0x2f, 0xdf, 0x6f, 0x79, 0x1f, 0x1e, 0xff, 0xdb, 0x9d, 0x16, 0xb3, 0xf4, 0xfa, 0x5b,
+	0x1d, 0x6d, 0xdb, 0x6b, 0x78, 0xf8, 0x73, 0x00, 0x54, 0x64, 0x08, 0x3e, 0xf1, 0x03, 0x00, 0x00,
 }
 
 func (this *UpstreamSpec) Equal(that interface{}) bool {
@@ -222,6 +343,34 @@ func (this *UpstreamSpec) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.AggregateDataCenters != that1.AggregateDataCenters {
+		return false
+	}
+	if len(this.SubsetMetadataKeys) != len(that1.SubsetMetadataKeys) {
+		return false
+	}
+	for i := range this.SubsetMetadataKeys {
+		if this.SubsetMetadataKeys[i] != that1.SubsetMetadataKeys[i] {
+			return false
+		}
+	}
+	if !this.TokenSecretRef.Equal(that1.TokenSecretRef) {
+		return false
+	}
+	if this.HealthFilter != that1.HealthFilter {
+		return false
+	}
+	if this.PreparedQuery != that1.PreparedQuery {
+		return false
+	}
+	if len(this.DnsNameservers) != len(that1.DnsNameservers) {
+		return false
+	}
+	for i := range this.DnsNameservers {
+		if this.DnsNameservers[i] != that1.DnsNameservers[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
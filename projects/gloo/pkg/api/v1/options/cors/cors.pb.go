@@ -26,6 +26,7 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // CorsPolicy defines Cross-Origin Resource Sharing for a virtual service.
+// When set on a route, the policy of the virtual host provides the values that the route policy does not set.
 type CorsPolicy struct {
 	// Specifies the origins that will be allowed to make CORS requests.
 	//
@@ -41,6 +42,7 @@ type CorsPolicy struct {
 	// Specifies the content for the *access-control-allow-headers* header.
 	AllowHeaders []string `protobuf:"bytes,4,rep,name=allow_headers,json=allowHeaders,proto3" json:"allow_headers,omitempty"`
 	// Specifies the content for the *access-control-expose-headers* header.
+	// On a route, these are added to the headers exposed by the policy of the virtual host.
 	ExposeHeaders []string `protobuf:"bytes,5,rep,name=expose_headers,json=exposeHeaders,proto3" json:"expose_headers,omitempty"`
 	// Specifies the content for the *access-control-max-age* header.
 	MaxAge string `protobuf:"bytes,6,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
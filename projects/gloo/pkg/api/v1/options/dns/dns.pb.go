@@ -0,0 +1,361 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/dns/dns.proto
+
+package dns
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type UpstreamSpec_DiscoveryType int32
+
+const (
+	// Envoy continuously resolves the hosts, and load balances between all the addresses they resolve to.
+	UpstreamSpec_STRICT_DNS UpstreamSpec_DiscoveryType = 0
+	// Envoy only connects to the first address a host resolves to, and resolves it again for new connections.
+	// This suits large web services that are served from many addresses, see the
+	// [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/upstream/service_discovery#logical-dns).
+	UpstreamSpec_LOGICAL_DNS UpstreamSpec_DiscoveryType = 1
+)
+
+var UpstreamSpec_DiscoveryType_name = map[int32]string{
+	0: "STRICT_DNS",
+	1: "LOGICAL_DNS",
+}
+
+var UpstreamSpec_DiscoveryType_value = map[string]int32{
+	"STRICT_DNS":  0,
+	"LOGICAL_DNS": 1,
+}
+
+func (x UpstreamSpec_DiscoveryType) String() string {
+	return proto.EnumName(UpstreamSpec_DiscoveryType_name, int32(x))
+}
+
+func (UpstreamSpec_DiscoveryType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5a1effc9586eb26a, []int{0, 0}
+}
+
+type UpstreamSpec_DnsLookupFamily int32
+
+const (
+	// Only resolve IPv4 addresses
+	UpstreamSpec_V4_ONLY UpstreamSpec_DnsLookupFamily = 0
+	// Only resolve IPv6 addresses
+	UpstreamSpec_V6_ONLY UpstreamSpec_DnsLookupFamily = 1
+	// Resolve IPv6 addresses, and IPv4 addresses for the hosts without any
+	UpstreamSpec_AUTO UpstreamSpec_DnsLookupFamily = 2
+)
+
+var UpstreamSpec_DnsLookupFamily_name = map[int32]string{
+	0: "V4_ONLY",
+	1: "V6_ONLY",
+	2: "AUTO",
+}
+
+var UpstreamSpec_DnsLookupFamily_value = map[string]int32{
+	"V4_ONLY": 0,
+	"V6_ONLY": 1,
+	"AUTO":    2,
+}
+
+func (x UpstreamSpec_DnsLookupFamily) String() string {
+	return proto.EnumName(UpstreamSpec_DnsLookupFamily_name, int32(x))
+}
+
+func (UpstreamSpec_DnsLookupFamily) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5a1effc9586eb26a, []int{0, 1}
+}
+
+// DNS Upstreams are used to route requests to services whose hostnames Envoy resolves with DNS.
+// Unlike static upstreams, the way Envoy resolves the hosts is configured explicitly.
+// Use the `sslConfig` of the upstream to connect to the hosts with TLS.
+type UpstreamSpec struct {
+	// The hosts of the upstream. At least one must be specified, and exactly one for LOGICAL_DNS upstreams.
+	Hosts []*Host `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	// How Envoy resolves the hosts. Defaults to STRICT_DNS.
+	DiscoveryType UpstreamSpec_DiscoveryType `protobuf:"varint,2,opt,name=discovery_type,json=discoveryType,proto3,enum=dns.options.gloo.solo.io.UpstreamSpec_DiscoveryType" json:"discovery_type,omitempty"`
+	// Resolve the hosts again when their records expire, rather than at the DNS refresh rate.
+	RespectDnsTtl bool `protobuf:"varint,3,opt,name=respect_dns_ttl,json=respectDnsTtl,proto3" json:"respect_dns_ttl,omitempty"`
+	// The interval at which Envoy resolves the hosts. Defaults to Envoy's default of 5 seconds.
+	DnsRefreshRate *time.Duration `protobuf:"bytes,4,opt,name=dns_refresh_rate,json=dnsRefreshRate,proto3,stdduration" json:"dns_refresh_rate,omitempty"`
+	// The addresses the hosts are resolved to. Defaults to V4_ONLY.
+	DnsLookupFamily UpstreamSpec_DnsLookupFamily `protobuf:"varint,5,opt,name=dns_lookup_family,json=dnsLookupFamily,proto3,enum=dns.options.gloo.solo.io.UpstreamSpec_DnsLookupFamily" json:"dns_lookup_family,omitempty"`
+	// The nameservers used to resolve the hosts, as IP addresses with an optional port (defaulting to 53).
+	// Envoy queries these nameservers instead of the ones of its host, and so does Gloo when it resolves the
+	// upstream for function discovery.
+	DnsResolvers         []string `protobuf:"bytes,6,rep,name=dns_resolvers,json=dnsResolvers,proto3" json:"dns_resolvers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpstreamSpec) Reset()         { *m = UpstreamSpec{} }
+func (m *UpstreamSpec) String() string { return proto.CompactTextString(m) }
+func (*UpstreamSpec) ProtoMessage()    {}
+func (*UpstreamSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a1effc9586eb26a, []int{0}
+}
+func (m *UpstreamSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpstreamSpec.Unmarshal(m, b)
+}
+func (m *UpstreamSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpstreamSpec.Marshal(b, m, deterministic)
+}
+func (m *UpstreamSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpstreamSpec.Merge(m, src)
+}
+func (m *UpstreamSpec) XXX_Size() int {
+	return xxx_messageInfo_UpstreamSpec.Size(m)
+}
+func (m *UpstreamSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpstreamSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpstreamSpec proto.InternalMessageInfo
+
+func (m *UpstreamSpec) GetHosts() []*Host {
+	if m != nil {
+		return m.Hosts
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetDiscoveryType() UpstreamSpec_DiscoveryType {
+	if m != nil {
+		return m.DiscoveryType
+	}
+	return UpstreamSpec_STRICT_DNS
+}
+
+func (m *UpstreamSpec) GetRespectDnsTtl() bool {
+	if m != nil {
+		return m.RespectDnsTtl
+	}
+	return false
+}
+
+func (m *UpstreamSpec) GetDnsRefreshRate() *time.Duration {
+	if m != nil {
+		return m.DnsRefreshRate
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetDnsLookupFamily() UpstreamSpec_DnsLookupFamily {
+	if m != nil {
+		return m.DnsLookupFamily
+	}
+	return UpstreamSpec_V4_ONLY
+}
+
+func (m *UpstreamSpec) GetDnsResolvers() []string {
+	if m != nil {
+		return m.DnsResolvers
+	}
+	return nil
+}
+
+// A host Envoy resolves with DNS
+type Host struct {
+	// The hostname
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// The port the host is listening on
+	Port                 uint32   `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Host) Reset()         { *m = Host{} }
+func (m *Host) String() string { return proto.CompactTextString(m) }
+func (*Host) ProtoMessage()    {}
+func (*Host) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a1effc9586eb26a, []int{1}
+}
+func (m *Host) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Host.Unmarshal(m, b)
+}
+func (m *Host) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Host.Marshal(b, m, deterministic)
+}
+func (m *Host) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Host.Merge(m, src)
+}
+func (m *Host) XXX_Size() int {
+	return xxx_messageInfo_Host.Size(m)
+}
+func (m *Host) XXX_DiscardUnknown() {
+	xxx_messageInfo_Host.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Host proto.InternalMessageInfo
+
+func (m *Host) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *Host) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("dns.options.gloo.solo.io.UpstreamSpec_DiscoveryType", UpstreamSpec_DiscoveryType_name, UpstreamSpec_DiscoveryType_value)
+	proto.RegisterEnum("dns.options.gloo.solo.io.UpstreamSpec_DnsLookupFamily", UpstreamSpec_DnsLookupFamily_name, UpstreamSpec_DnsLookupFamily_value)
+	proto.RegisterType((*UpstreamSpec)(nil), "dns.options.gloo.solo.io.UpstreamSpec")
+	proto.RegisterType((*Host)(nil), "dns.options.gloo.solo.io.Host")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/options/dns/dns.proto", fileDescriptor_5a1effc9586eb26a)
+}
+
+var fileDescriptor_5a1effc9586eb26a = []byte{
+	// 488 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0x9e, 0xd7, 0x6c, 0x6c, 0xee, 0xfa, 0x83, 0xc5, 0x45, 0xd8, 0x45, 0x89, 0x8a, 0x84, 0x72,
+	0x83, 0x03, 0xa5, 0xec, 0x8a, 0x9b, 0x75, 0xd5, 0xa0, 0x52, 0xb5, 0x4a, 0x6e, 0x87, 0x04, 0x5c,
+	0x44, 0x69, 0xe2, 0xa6, 0x61, 0x69, 0x8e, 0x65, 0xbb, 0xd5, 0xfa, 0x02, 0x3c, 0x03, 0x8f, 0xc0,
+	0x23, 0xf0, 0x36, 0x48, 0xbc, 0x03, 0xf7, 0x28, 0x76, 0x26, 0x6d, 0x88, 0x49, 0xbb, 0xb0, 0x74,
+	0xbe, 0xef, 0x9c, 0xef, 0xf8, 0xf8, 0x3b, 0xc6, 0x83, 0x34, 0xd3, 0xcb, 0xf5, 0x9c, 0xc6, 0xb0,
+	0x0a, 0x14, 0xe4, 0xf0, 0x32, 0x83, 0x20, 0xcd, 0x01, 0x02, 0x21, 0xe1, 0x2b, 0x8f, 0xb5, 0xb2,
+	0x28, 0x12, 0x59, 0xb0, 0x79, 0x1d, 0x80, 0xd0, 0x19, 0x14, 0x2a, 0x48, 0xec, 0xa1, 0x42, 0x82,
+	0x06, 0xe2, 0x96, 0x61, 0x95, 0xa2, 0x65, 0x39, 0x2d, 0x3b, 0xd1, 0x0c, 0x8e, 0x9f, 0xa4, 0x90,
+	0x82, 0x29, 0x0a, 0xca, 0xc8, 0xd6, 0x1f, 0x13, 0x7e, 0xad, 0x2d, 0xc9, 0xaf, 0x75, 0xc5, 0x75,
+	0x52, 0x80, 0x34, 0xe7, 0x81, 0x41, 0xf3, 0xf5, 0x22, 0x48, 0xd6, 0x32, 0x2a, 0x3b, 0xda, 0x7c,
+	0xf7, 0x9b, 0x83, 0x8f, 0x2e, 0x85, 0xd2, 0x92, 0x47, 0xab, 0xa9, 0xe0, 0x31, 0xe9, 0xe3, 0xbd,
+	0x25, 0x28, 0xad, 0x5c, 0xe4, 0xd5, 0xfc, 0x7a, 0xaf, 0x43, 0xef, 0x1b, 0x82, 0x7e, 0x00, 0xa5,
+	0x99, 0x2d, 0x26, 0x5f, 0x70, 0x33, 0xc9, 0x54, 0x0c, 0x1b, 0x2e, 0xb7, 0xa1, 0xde, 0x0a, 0xee,
+	0xee, 0x7a, 0xc8, 0x6f, 0xf6, 0xfa, 0xf7, 0xcb, 0x6f, 0xdf, 0x4a, 0x87, 0x37, 0xe2, 0xd9, 0x56,
+	0x70, 0xd6, 0x48, 0x6e, 0x43, 0xf2, 0x02, 0xb7, 0x24, 0x57, 0x82, 0xc7, 0x3a, 0x4c, 0x0a, 0x15,
+	0x6a, 0x9d, 0xbb, 0x35, 0x0f, 0xf9, 0x07, 0xac, 0x51, 0xd1, 0xc3, 0x42, 0xcd, 0x74, 0x4e, 0x46,
+	0xb8, 0x5d, 0xe6, 0x25, 0x5f, 0x48, 0xae, 0x96, 0xa1, 0x8c, 0x34, 0x77, 0x1d, 0x0f, 0xf9, 0xf5,
+	0xde, 0x53, 0x6a, 0x6d, 0xa0, 0x37, 0x36, 0xd0, 0x61, 0x65, 0xc3, 0xc0, 0xf9, 0xfe, 0xeb, 0x19,
+	0x62, 0xcd, 0xa4, 0x50, 0xcc, 0xea, 0x58, 0xa4, 0x39, 0x99, 0xe3, 0xc7, 0x65, 0xab, 0x1c, 0xe0,
+	0x6a, 0x2d, 0xc2, 0x45, 0xb4, 0xca, 0xf2, 0xad, 0xbb, 0x67, 0x9e, 0x74, 0xf2, 0xd0, 0x27, 0x15,
+	0x6a, 0x6c, 0xe4, 0xe7, 0x46, 0xcd, 0x5a, 0xc9, 0x5d, 0x82, 0x3c, 0xc7, 0x0d, 0x3b, 0xae, 0x82,
+	0x7c, 0xc3, 0xa5, 0x72, 0xf7, 0xbd, 0x9a, 0x7f, 0xc8, 0x8e, 0xcc, 0x28, 0x15, 0xd7, 0x7d, 0x85,
+	0x1b, 0x77, 0xbc, 0x21, 0x4d, 0x8c, 0xa7, 0x33, 0x36, 0x3a, 0x9b, 0x85, 0xc3, 0x8b, 0x69, 0x7b,
+	0x87, 0xb4, 0x70, 0x7d, 0x3c, 0x79, 0x3f, 0x3a, 0x3b, 0x1d, 0x1b, 0x02, 0x75, 0xdf, 0xe2, 0xd6,
+	0x3f, 0x57, 0x93, 0x3a, 0x7e, 0xf4, 0xb1, 0x1f, 0x4e, 0x2e, 0xc6, 0x9f, 0xda, 0x3b, 0x06, 0x9c,
+	0x58, 0x80, 0xc8, 0x01, 0x76, 0x4e, 0x2f, 0x67, 0x93, 0xf6, 0x6e, 0x97, 0x62, 0xa7, 0x5c, 0x28,
+	0x21, 0xd8, 0x89, 0x92, 0x44, 0xba, 0xc8, 0x43, 0xfe, 0x21, 0x33, 0x71, 0xc9, 0x09, 0x90, 0xda,
+	0xec, 0xb4, 0xc1, 0x4c, 0x3c, 0x38, 0xff, 0xf9, 0xc7, 0x41, 0x3f, 0x7e, 0x77, 0xd0, 0xe7, 0x77,
+	0x0f, 0xfb, 0xea, 0xe2, 0x2a, 0xfd, 0xcf, 0x77, 0x9f, 0xef, 0x9b, 0x95, 0xbc, 0xf9, 0x3b, 0x00,
+	0x5f, 0x6e, 0x5c, 0x52, 0x31, 0x03, 0x00, 0x00,
+}
+
+func (this *UpstreamSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSpec)
+	if !ok {
+		that2, ok := that.(UpstreamSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Hosts) != len(that1.Hosts) {
+		return false
+	}
+	for i := range this.Hosts {
+		if !this.Hosts[i].Equal(that1.Hosts[i]) {
+			return false
+		}
+	}
+	if this.DiscoveryType != that1.DiscoveryType {
+		return false
+	}
+	if this.RespectDnsTtl != that1.RespectDnsTtl {
+		return false
+	}
+	if this.DnsRefreshRate != nil && that1.DnsRefreshRate != nil {
+		if *this.DnsRefreshRate != *that1.DnsRefreshRate {
+			return false
+		}
+	} else if this.DnsRefreshRate != nil {
+		return false
+	} else if that1.DnsRefreshRate != nil {
+		return false
+	}
+	if this.DnsLookupFamily != that1.DnsLookupFamily {
+		return false
+	}
+	if len(this.DnsResolvers) != len(that1.DnsResolvers) {
+		return false
+	}
+	for i := range this.DnsResolvers {
+		if this.DnsResolvers[i] != that1.DnsResolvers[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Host) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Host)
+	if !ok {
+		that2, ok := that.(Host)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Addr != that1.Addr {
+		return false
+	}
+	if this.Port != that1.Port {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
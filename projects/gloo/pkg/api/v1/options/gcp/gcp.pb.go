@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/gcp/gcp.proto
+
+package gcp
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Upstream Spec for Google Cloud serverless Upstreams
+// GCP Upstreams represent a Cloud Run service, or the Cloud Functions of a project in a particular region.
+// Requests to these Upstreams are authenticated with a Google-signed ID token of the service account Gloo runs as,
+// which Gloo obtains from the metadata server of the GCE or GKE instance it runs on.
+type UpstreamSpec struct {
+	// The host of the service, e.g. `my-service-abcdefghij-uc.a.run.app` for a Cloud Run service, or
+	// `us-central1-my-project.cloudfunctions.net` for the Cloud Functions of a project.
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// Optional, the audience of the ID tokens. Defaults to `https://<host>`, and for the routes to a Cloud
+	// Function, to the URL of the function (`https://<host>/<function_name>`), as expected by Cloud Run and
+	// Cloud Functions.
+	Audience             string   `protobuf:"bytes,2,opt,name=audience,proto3" json:"audience,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpstreamSpec) Reset()         { *m = UpstreamSpec{} }
+func (m *UpstreamSpec) String() string { return proto.CompactTextString(m) }
+func (*UpstreamSpec) ProtoMessage()    {}
+func (*UpstreamSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd13ba4b9bc58889, []int{0}
+}
+func (m *UpstreamSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpstreamSpec.Unmarshal(m, b)
+}
+func (m *UpstreamSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpstreamSpec.Marshal(b, m, deterministic)
+}
+func (m *UpstreamSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpstreamSpec.Merge(m, src)
+}
+func (m *UpstreamSpec) XXX_Size() int {
+	return xxx_messageInfo_UpstreamSpec.Size(m)
+}
+func (m *UpstreamSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpstreamSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpstreamSpec proto.InternalMessageInfo
+
+func (m *UpstreamSpec) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *UpstreamSpec) GetAudience() string {
+	if m != nil {
+		return m.Audience
+	}
+	return ""
+}
+
+type DestinationSpec struct {
+	// The name of the Cloud Function to invoke. Requests are sent to the `/<function_name>` path of the Upstream
+	// host. Routes to a Cloud Run service do not need a destination spec.
+	FunctionName         string   `protobuf:"bytes,1,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DestinationSpec) Reset()         { *m = DestinationSpec{} }
+func (m *DestinationSpec) String() string { return proto.CompactTextString(m) }
+func (*DestinationSpec) ProtoMessage()    {}
+func (*DestinationSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd13ba4b9bc58889, []int{1}
+}
+func (m *DestinationSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DestinationSpec.Unmarshal(m, b)
+}
+func (m *DestinationSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DestinationSpec.Marshal(b, m, deterministic)
+}
+func (m *DestinationSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DestinationSpec.Merge(m, src)
+}
+func (m *DestinationSpec) XXX_Size() int {
+	return xxx_messageInfo_DestinationSpec.Size(m)
+}
+func (m *DestinationSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_DestinationSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DestinationSpec proto.InternalMessageInfo
+
+func (m *DestinationSpec) GetFunctionName() string {
+	if m != nil {
+		return m.FunctionName
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*UpstreamSpec)(nil), "gcp.options.gloo.solo.io.UpstreamSpec")
+	proto.RegisterType((*DestinationSpec)(nil), "gcp.options.gloo.solo.io.DestinationSpec")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/options/gcp/gcp.proto", fileDescriptor_fd13ba4b9bc58889)
+}
+
+var fileDescriptor_fd13ba4b9bc58889 = []byte{
+	// 236 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x72, 0x4a, 0xcf, 0x2c, 0xc9,
+	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x2f, 0xce, 0xcf, 0xc9, 0xd7, 0xcd, 0xcc, 0xd7, 0x4f,
+	0xcf, 0xc9, 0xcf, 0xd7, 0x2f, 0x28, 0xca, 0xcf, 0x4a, 0x4d, 0x2e, 0x29, 0x86, 0xf0, 0x12, 0x0b,
+	0x32, 0xf5, 0xcb, 0x0c, 0xf5, 0xf3, 0x0b, 0x4a, 0x32, 0xf3, 0xf3, 0x8a, 0xf5, 0xd3, 0x93, 0x0b,
+	0x40, 0x58, 0xaf, 0xa0, 0x28, 0xbf, 0x24, 0x5f, 0x48, 0x02, 0xc4, 0x84, 0x4a, 0xe9, 0x81, 0x94,
+	0xeb, 0x81, 0x4c, 0xd2, 0xcb, 0xcc, 0x97, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd2, 0x07,
+	0xb1, 0x20, 0xea, 0xa5, 0x84, 0x52, 0x2b, 0x4a, 0x20, 0x82, 0xa9, 0x15, 0x25, 0x10, 0x31, 0x25,
+	0x3b, 0x2e, 0x9e, 0xd0, 0x82, 0xe2, 0x92, 0xa2, 0xd4, 0xc4, 0xdc, 0xe0, 0x82, 0xd4, 0x64, 0x21,
+	0x21, 0x2e, 0x96, 0x8c, 0xfc, 0xe2, 0x12, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x30, 0x5b,
+	0x48, 0x8a, 0x8b, 0x23, 0xb1, 0x34, 0x25, 0x33, 0x35, 0x2f, 0x39, 0x55, 0x82, 0x09, 0x2c, 0x0e,
+	0xe7, 0x2b, 0x99, 0x71, 0xf1, 0xbb, 0xa4, 0x16, 0x97, 0x64, 0xe6, 0x25, 0x82, 0x9c, 0x01, 0x36,
+	0x42, 0x99, 0x8b, 0x37, 0xad, 0x34, 0x2f, 0x19, 0xc4, 0x8f, 0xcf, 0x4b, 0xcc, 0x4d, 0x85, 0x9a,
+	0xc5, 0x03, 0x13, 0xf4, 0x4b, 0xcc, 0x4d, 0x75, 0x72, 0xdb, 0xf1, 0x95, 0x85, 0x71, 0xc5, 0x23,
+	0x39, 0xc6, 0x28, 0x1b, 0xe2, 0x42, 0xa2, 0x20, 0x3b, 0x1d, 0x4b, 0x68, 0x24, 0xb1, 0x81, 0xbd,
+	0x61, 0x0c, 0x18, 0x00, 0x24, 0xf3, 0x89, 0x68, 0x50, 0x01, 0x00, 0x00,
+}
+
+func (this *UpstreamSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSpec)
+	if !ok {
+		that2, ok := that.(UpstreamSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Host != that1.Host {
+		return false
+	}
+	if this.Audience != that1.Audience {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *DestinationSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DestinationSpec)
+	if !ok {
+		that2, ok := that.(DestinationSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FunctionName != that1.FunctionName {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,135 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/graphql/graphql.proto
+
+package graphql
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Service spec describing GraphQL upstreams. This will usually be filled
+// automatically via function discovery (if the upstream supports introspection).
+// The schema is not used to route requests by Gloo itself, but is available to the
+// options which resolve or validate GraphQL requests to the upstream.
+type ServiceSpec struct {
+	// The path of the GraphQL endpoint of the upstream. Function discovery looks for the
+	// endpoint at `/graphql` and `/query` when this is not set.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// The schema of the GraphQL API of the upstream, in the GraphQL schema definition language (SDL).
+	// Function discovery sets it from the result of an introspection query to the endpoint.
+	Schema               string   `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceSpec) Reset()         { *m = ServiceSpec{} }
+func (m *ServiceSpec) String() string { return proto.CompactTextString(m) }
+func (*ServiceSpec) ProtoMessage()    {}
+func (*ServiceSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f14efca2460931c5, []int{0}
+}
+func (m *ServiceSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceSpec.Unmarshal(m, b)
+}
+func (m *ServiceSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceSpec.Marshal(b, m, deterministic)
+}
+func (m *ServiceSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceSpec.Merge(m, src)
+}
+func (m *ServiceSpec) XXX_Size() int {
+	return xxx_messageInfo_ServiceSpec.Size(m)
+}
+func (m *ServiceSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceSpec proto.InternalMessageInfo
+
+func (m *ServiceSpec) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *ServiceSpec) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ServiceSpec)(nil), "graphql.options.gloo.solo.io.ServiceSpec")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/options/graphql/graphql.proto", fileDescriptor_f14efca2460931c5)
+}
+
+var fileDescriptor_f14efca2460931c5 = []byte{
+	// 203 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xf2, 0x4a, 0xcf, 0x2c, 0xc9,
+	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x2f, 0xce, 0xcf, 0xc9, 0xd7, 0xcd, 0xcc, 0xd7, 0x4f,
+	0xcf, 0xc9, 0xcf, 0xd7, 0x2f, 0x28, 0xca, 0xcf, 0x4a, 0x4d, 0x2e, 0x29, 0x86, 0xf0, 0x12, 0x0b,
+	0x32, 0xf5, 0xcb, 0x0c, 0xf5, 0xf3, 0x0b, 0x4a, 0x32, 0xf3, 0xf3, 0x8a, 0xf5, 0xd3, 0x8b, 0x12,
+	0x0b, 0x32, 0x0a, 0x73, 0x60, 0xb4, 0x5e, 0x41, 0x51, 0x7e, 0x49, 0xbe, 0x90, 0x0c, 0x8c, 0x0b,
+	0x55, 0xa6, 0x07, 0xd2, 0xaa, 0x07, 0x32, 0x55, 0x2f, 0x33, 0x5f, 0x4a, 0x24, 0x3d, 0x3f, 0x3d,
+	0x1f, 0xac, 0x50, 0x1f, 0xc4, 0x82, 0xe8, 0x91, 0x12, 0x4a, 0xad, 0x28, 0x81, 0x08, 0xa6, 0x56,
+	0x94, 0x40, 0xc4, 0x94, 0x1c, 0xb9, 0xb8, 0x83, 0x53, 0x8b, 0xca, 0x32, 0x93, 0x53, 0x83, 0x0b,
+	0x52, 0x93, 0x85, 0xa4, 0xb8, 0x38, 0x52, 0xf3, 0x52, 0x0a, 0xf2, 0x33, 0xf3, 0x4a, 0x24, 0x18,
+	0x15, 0x18, 0x35, 0x38, 0x83, 0xe0, 0x7c, 0x21, 0x31, 0x2e, 0xb6, 0xe2, 0xe4, 0x8c, 0xd4, 0xdc,
+	0x44, 0x09, 0x26, 0xb0, 0x0c, 0x94, 0xe7, 0xe4, 0xb5, 0xe3, 0x2b, 0x0b, 0xe3, 0x8a, 0x47, 0x72,
+	0x8c, 0x51, 0x0e, 0xc4, 0x79, 0xb0, 0x20, 0x3b, 0x1d, 0x87, 0x27, 0x93, 0xd8, 0xc0, 0xae, 0x32,
+	0x06, 0x0c, 0x00, 0xb2, 0x18, 0x54, 0x9b, 0x2b, 0x01, 0x00, 0x00,
+}
+
+func (this *ServiceSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceSpec)
+	if !ok {
+		that2, ok := that.(ServiceSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Endpoint != that1.Endpoint {
+		return false
+	}
+	if this.Schema != that1.Schema {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
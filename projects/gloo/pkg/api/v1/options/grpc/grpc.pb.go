@@ -36,10 +36,13 @@ type ServiceSpec struct {
 	// List of services used by this upstream. For a grpc upstream where you don't
 	// need to use Gloo's function routing, this can be an empty list. These
 	// services must be present in the descriptors.
-	GrpcServices         []*ServiceSpec_GrpcService `protobuf:"bytes,2,rep,name=grpc_services,json=grpcServices,proto3" json:"grpc_services,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	GrpcServices []*ServiceSpec_GrpcService `protobuf:"bytes,2,rep,name=grpc_services,json=grpcServices,proto3" json:"grpc_services,omitempty"`
+	// Metadata sent with the requests function discovery makes to the reflection service of the upstream,
+	// e.g. an `authorization` header required by the server.
+	ReflectionMetadata   map[string]string `protobuf:"bytes,3,rep,name=reflection_metadata,json=reflectionMetadata,proto3" json:"reflection_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *ServiceSpec) Reset()         { *m = ServiceSpec{} }
@@ -80,6 +83,13 @@ func (m *ServiceSpec) GetGrpcServices() []*ServiceSpec_GrpcService {
 	return nil
 }
 
+func (m *ServiceSpec) GetReflectionMetadata() map[string]string {
+	if m != nil {
+		return m.ReflectionMetadata
+	}
+	return nil
+}
+
 // Describes a grpc service
 type ServiceSpec_GrpcService struct {
 	// The package of this service.
@@ -148,10 +158,18 @@ type DestinationSpec struct {
 	Function string `protobuf:"bytes,3,opt,name=function,proto3" json:"function,omitempty"`
 	// Parameters describe how to extract the function parameters from the
 	// request.
-	Parameters           *transformation.Parameters `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	Parameters *transformation.Parameters `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	// Pass the request body to the function as is, instead of parsing it as JSON. Use this for functions that
+	// receive binary payloads, such as file uploads.
+	// The value is the name of a field of type `google.api.HttpBody` of the request message: its `data` bytes field
+	// is set to the request body and its `content_type` to the `Content-Type` header of the request. Use `*` if
+	// the request message is itself a `google.api.HttpBody`.
+	// Other fields of the request message can still be set from query parameters. Parameters cannot be used along
+	// with this option.
+	BodyField            string   `protobuf:"bytes,5,opt,name=body_field,json=bodyField,proto3" json:"body_field,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *DestinationSpec) Reset()         { *m = DestinationSpec{} }
@@ -206,9 +224,17 @@ func (m *DestinationSpec) GetParameters() *transformation.Parameters {
 	return nil
 }
 
+func (m *DestinationSpec) GetBodyField() string {
+	if m != nil {
+		return m.BodyField
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ServiceSpec)(nil), "grpc.options.gloo.solo.io.ServiceSpec")
 	proto.RegisterType((*ServiceSpec_GrpcService)(nil), "grpc.options.gloo.solo.io.ServiceSpec.GrpcService")
+	proto.RegisterMapType((map[string]string)(nil), "grpc.options.gloo.solo.io.ServiceSpec.ReflectionMetadataEntry")
 	proto.RegisterType((*DestinationSpec)(nil), "grpc.options.gloo.solo.io.DestinationSpec")
 }
 
@@ -217,31 +243,37 @@ func init() {
 }
 
 var fileDescriptor_3bddd1d7957d358a = []byte{
-	// 383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xc1, 0x8e, 0xd3, 0x30,
-	0x10, 0x55, 0x9a, 0x0a, 0xa8, 0xd3, 0x82, 0x14, 0x71, 0x08, 0x39, 0xa0, 0x50, 0x09, 0x29, 0x17,
-	0x6c, 0x51, 0xce, 0x1c, 0x40, 0x95, 0x7a, 0x03, 0x94, 0x1e, 0x90, 0xb8, 0x54, 0xae, 0x71, 0x8d,
-	0x69, 0x93, 0xb1, 0x6c, 0xb7, 0xaa, 0x38, 0xf3, 0x31, 0x9c, 0x39, 0xed, 0xf7, 0xec, 0x3f, 0xec,
-	0x7d, 0x65, 0xc7, 0x69, 0xb3, 0x52, 0x57, 0xbb, 0x97, 0x68, 0xde, 0x9b, 0x37, 0xf3, 0x26, 0xe3,
-	0x41, 0x73, 0x21, 0xed, 0xaf, 0xfd, 0x1a, 0x33, 0xa8, 0x89, 0x81, 0x1d, 0xbc, 0x93, 0x40, 0xc4,
-	0x0e, 0x80, 0x28, 0x0d, 0xbf, 0x39, 0xb3, 0xa6, 0x45, 0x54, 0x49, 0x72, 0x78, 0x4f, 0x40, 0x59,
-	0x09, 0x8d, 0x21, 0x42, 0x2b, 0xe6, 0x3f, 0x58, 0x69, 0xb0, 0x90, 0xbe, 0xf2, 0x71, 0xc8, 0x62,
-	0x57, 0x81, 0x5d, 0x33, 0x2c, 0x21, 0x7f, 0x29, 0x40, 0x80, 0x57, 0x11, 0x17, 0xb5, 0x05, 0x79,
-	0xca, 0x8f, 0xb6, 0x25, 0xf9, 0xd1, 0x06, 0xee, 0xd3, 0xc3, 0xbe, 0x56, 0xd3, 0xc6, 0x6c, 0x40,
-	0xd7, 0xd4, 0x61, 0xa2, 0xa8, 0xa6, 0x35, 0xb7, 0x5c, 0x9b, 0xb6, 0xc5, 0xf4, 0xef, 0x00, 0x25,
-	0x4b, 0xae, 0x0f, 0x92, 0xf1, 0xa5, 0xe2, 0x2c, 0x2d, 0x50, 0xf2, 0x93, 0x1b, 0xa6, 0xa5, 0xb2,
-	0xa0, 0x4d, 0x16, 0x15, 0x51, 0x39, 0xae, 0xfa, 0x54, 0xfa, 0x1d, 0x4d, 0xdc, 0xec, 0x2b, 0xd3,
-	0x56, 0x99, 0x6c, 0x50, 0xc4, 0x65, 0x32, 0x9b, 0xe1, 0x7b, 0xff, 0x08, 0xf7, 0x0c, 0xf0, 0x42,
-	0x2b, 0x16, 0x70, 0x35, 0x16, 0x67, 0x60, 0xf2, 0x3f, 0x28, 0xe9, 0x25, 0xd3, 0x37, 0x68, 0xac,
-	0x28, 0xdb, 0x52, 0xc1, 0x57, 0x0d, 0xad, 0xb9, 0x1f, 0x65, 0x54, 0x25, 0x81, 0xfb, 0x42, 0x6b,
-	0x2f, 0x09, 0x53, 0xb4, 0x92, 0x41, 0x2b, 0x09, 0x9c, 0x97, 0xbc, 0x45, 0xcf, 0x37, 0xfb, 0x86,
-	0xb9, 0xa1, 0xbc, 0xc6, 0x64, 0x71, 0x11, 0x97, 0xa3, 0x6a, 0xd2, 0xb1, 0x4e, 0x65, 0xa6, 0xff,
-	0x23, 0xf4, 0x62, 0xce, 0x8d, 0x95, 0x8d, 0xdf, 0x93, 0x5f, 0x45, 0x86, 0x9e, 0x06, 0xb3, 0xe0,
-	0xdd, 0x41, 0x97, 0x09, 0x1e, 0xc1, 0xb2, 0x83, 0x69, 0x8e, 0x9e, 0x75, 0x8d, 0xb3, 0xd8, 0xa7,
-	0x4e, 0x38, 0xfd, 0x8a, 0xd0, 0x79, 0xfd, 0xd9, 0xb0, 0x88, 0xca, 0x64, 0x46, 0xf0, 0xdd, 0x07,
-	0xba, 0xbc, 0xbf, 0x6f, 0xa7, 0xb2, 0xaa, 0xd7, 0xe2, 0xf3, 0xe2, 0xea, 0x66, 0x18, 0xfd, 0xbb,
-	0x7e, 0x1d, 0xfd, 0xf8, 0xf8, 0xb8, 0x9b, 0x54, 0x5b, 0x71, 0xe9, 0x2e, 0xd7, 0x4f, 0xfc, 0x2d,
-	0x7c, 0xb8, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x2e, 0x57, 0xeb, 0xa3, 0xdb, 0x02, 0x00, 0x00,
+	// 468 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xdd, 0x8a, 0xd3, 0x40,
+	0x14, 0x26, 0xcd, 0xae, 0xda, 0x93, 0xae, 0xca, 0xb8, 0x60, 0x0c, 0x28, 0x75, 0x41, 0xe8, 0x8d,
+	0x09, 0xd6, 0x1b, 0x11, 0x14, 0x94, 0x5d, 0xf7, 0xca, 0x1f, 0xb2, 0x17, 0x82, 0x37, 0x65, 0x3a,
+	0x9d, 0xc6, 0xb1, 0x49, 0xce, 0x30, 0x33, 0x2d, 0x5b, 0x9f, 0xc8, 0x47, 0xf0, 0xc2, 0x97, 0xf0,
+	0x15, 0x7c, 0x07, 0xef, 0x65, 0x7e, 0xd2, 0xad, 0xb8, 0x8b, 0xbd, 0x09, 0xf3, 0x7d, 0xe7, 0x3b,
+	0xdf, 0xf9, 0x0e, 0x33, 0x81, 0xe3, 0x4a, 0x98, 0xcf, 0xcb, 0x69, 0xce, 0xb0, 0x29, 0x34, 0xd6,
+	0xf8, 0x58, 0x60, 0x51, 0xd5, 0x88, 0x85, 0x54, 0xf8, 0x85, 0x33, 0xa3, 0x3d, 0xa2, 0x52, 0x14,
+	0xab, 0x27, 0x05, 0x4a, 0x23, 0xb0, 0xd5, 0x45, 0xa5, 0x24, 0x73, 0x9f, 0x5c, 0x2a, 0x34, 0x48,
+	0xee, 0xb9, 0x73, 0xa8, 0xe6, 0xb6, 0x23, 0xb7, 0x66, 0xb9, 0xc0, 0xec, 0xb0, 0xc2, 0x0a, 0x9d,
+	0xaa, 0xb0, 0x27, 0xdf, 0x90, 0x11, 0x7e, 0x6e, 0x3c, 0xc9, 0xcf, 0x4d, 0xe0, 0x5e, 0xfd, 0x7f,
+	0xae, 0x51, 0xb4, 0xd5, 0x73, 0x54, 0x0d, 0xb5, 0xb8, 0x90, 0x54, 0xd1, 0x86, 0x1b, 0xae, 0xb4,
+	0xb7, 0x38, 0xfa, 0x11, 0x43, 0x72, 0xc6, 0xd5, 0x4a, 0x30, 0x7e, 0x26, 0x39, 0x23, 0x43, 0x48,
+	0x66, 0x5c, 0x33, 0x25, 0xa4, 0x41, 0xa5, 0xd3, 0x68, 0x18, 0x8d, 0x06, 0xe5, 0x36, 0x45, 0x3e,
+	0xc2, 0x81, 0xcd, 0x3e, 0xd1, 0xbe, 0x4b, 0xa7, 0xbd, 0x61, 0x3c, 0x4a, 0xc6, 0xe3, 0xfc, 0xca,
+	0x8d, 0xf2, 0xad, 0x01, 0xf9, 0xa9, 0x92, 0x2c, 0xe0, 0x72, 0x50, 0x5d, 0x00, 0x4d, 0x10, 0xee,
+	0x28, 0x3e, 0xaf, 0x39, 0xb3, 0x0e, 0x93, 0x86, 0x1b, 0x3a, 0xa3, 0x86, 0xa6, 0xb1, 0xb3, 0x7f,
+	0xb9, 0xa3, 0x7d, 0xb9, 0x71, 0x78, 0x1b, 0x0c, 0x4e, 0x5a, 0xa3, 0xd6, 0x25, 0x51, 0xff, 0x14,
+	0xb2, 0xaf, 0x90, 0x6c, 0xa5, 0x21, 0x0f, 0x61, 0x20, 0x29, 0x5b, 0xd0, 0x8a, 0x4f, 0x5a, 0xda,
+	0x70, 0xb7, 0x7b, 0xbf, 0x4c, 0x02, 0xf7, 0x8e, 0x36, 0x4e, 0x12, 0xd6, 0xf6, 0x92, 0x9e, 0x97,
+	0x04, 0xce, 0x49, 0x1e, 0xc1, 0xcd, 0xf9, 0xb2, 0xf5, 0x3b, 0x58, 0x8d, 0x76, 0x0b, 0xf4, 0xcb,
+	0x83, 0x8e, 0xb5, 0x2a, 0x9d, 0x9d, 0xc0, 0xdd, 0x2b, 0xa2, 0x92, 0xdb, 0x10, 0x2f, 0xf8, 0x3a,
+	0x8c, 0xb7, 0x47, 0x72, 0x08, 0xfb, 0x2b, 0x5a, 0x2f, 0xbb, 0x79, 0x1e, 0x3c, 0xef, 0x3d, 0x8b,
+	0x8e, 0x7e, 0x46, 0x70, 0xeb, 0x98, 0x6b, 0x23, 0x5a, 0x77, 0xbf, 0xee, 0x0a, 0x53, 0xb8, 0x1e,
+	0x32, 0x07, 0x8f, 0x0e, 0xda, 0x4a, 0x88, 0x1a, 0x9c, 0x3a, 0x48, 0x32, 0xb8, 0xd1, 0xe5, 0x4b,
+	0x63, 0x57, 0xda, 0x60, 0xf2, 0x1e, 0xe0, 0xe2, 0xd9, 0xa4, 0x7b, 0xc3, 0x68, 0x94, 0x8c, 0x8b,
+	0xfc, 0xef, 0x87, 0x75, 0xf9, 0xc5, 0x7c, 0xd8, 0xb4, 0x95, 0x5b, 0x16, 0xe4, 0x3e, 0xc0, 0x14,
+	0x67, 0xeb, 0xc9, 0x5c, 0xf0, 0x7a, 0x96, 0xee, 0xbb, 0x71, 0x7d, 0xcb, 0xbc, 0xb1, 0xc4, 0xeb,
+	0xd3, 0xef, 0xbf, 0xf7, 0xa2, 0x6f, 0xbf, 0x1e, 0x44, 0x9f, 0x5e, 0xec, 0xf6, 0xab, 0xc9, 0x45,
+	0x75, 0xd9, 0xef, 0x36, 0xbd, 0xe6, 0x9e, 0xf8, 0xd3, 0x3f, 0x03, 0x00, 0xe7, 0xa0, 0x69, 0x64,
+	0xb2, 0x03, 0x00, 0x00,
 }
 
 func (this *ServiceSpec) Equal(that interface{}) bool {
@@ -274,6 +306,14 @@ func (this *ServiceSpec) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.ReflectionMetadata) != len(that1.ReflectionMetadata) {
+		return false
+	}
+	for i := range this.ReflectionMetadata {
+		if this.ReflectionMetadata[i] != that1.ReflectionMetadata[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -348,6 +388,9 @@ func (this *DestinationSpec) Equal(that interface{}) bool {
 	if !this.Parameters.Equal(that1.Parameters) {
 		return false
 	}
+	if this.BodyField != that1.BodyField {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
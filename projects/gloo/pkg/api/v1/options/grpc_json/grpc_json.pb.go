@@ -12,6 +12,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -25,11 +26,12 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// [#next-free-field: 10]
+// [#next-free-field: 11]
 type GrpcJsonTranscoder struct {
 	// Types that are valid to be assigned to DescriptorSet:
 	//	*GrpcJsonTranscoder_ProtoDescriptor
 	//	*GrpcJsonTranscoder_ProtoDescriptorBin
+	//	*GrpcJsonTranscoder_UpstreamRef
 	DescriptorSet isGrpcJsonTranscoder_DescriptorSet `protobuf_oneof:"descriptor_set"`
 	// A list of strings that
 	// supplies the fully qualified service names (i.e. "package_name.service_name") that
@@ -166,9 +168,13 @@ type GrpcJsonTranscoder_ProtoDescriptor struct {
 type GrpcJsonTranscoder_ProtoDescriptorBin struct {
 	ProtoDescriptorBin []byte `protobuf:"bytes,4,opt,name=proto_descriptor_bin,json=protoDescriptorBin,proto3,oneof" json:"proto_descriptor_bin,omitempty"`
 }
+type GrpcJsonTranscoder_UpstreamRef struct {
+	UpstreamRef *core.ResourceRef `protobuf:"bytes,10,opt,name=upstream_ref,json=upstreamRef,proto3,oneof" json:"upstream_ref,omitempty"`
+}
 
 func (*GrpcJsonTranscoder_ProtoDescriptor) isGrpcJsonTranscoder_DescriptorSet()    {}
 func (*GrpcJsonTranscoder_ProtoDescriptorBin) isGrpcJsonTranscoder_DescriptorSet() {}
+func (*GrpcJsonTranscoder_UpstreamRef) isGrpcJsonTranscoder_DescriptorSet()        {}
 
 func (m *GrpcJsonTranscoder) GetDescriptorSet() isGrpcJsonTranscoder_DescriptorSet {
 	if m != nil {
@@ -191,6 +197,13 @@ func (m *GrpcJsonTranscoder) GetProtoDescriptorBin() []byte {
 	return nil
 }
 
+func (m *GrpcJsonTranscoder) GetUpstreamRef() *core.ResourceRef {
+	if x, ok := m.GetDescriptorSet().(*GrpcJsonTranscoder_UpstreamRef); ok {
+		return x.UpstreamRef
+	}
+	return nil
+}
+
 func (m *GrpcJsonTranscoder) GetServices() []string {
 	if m != nil {
 		return m.Services
@@ -245,6 +258,7 @@ func (*GrpcJsonTranscoder) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*GrpcJsonTranscoder_ProtoDescriptor)(nil),
 		(*GrpcJsonTranscoder_ProtoDescriptorBin)(nil),
+		(*GrpcJsonTranscoder_UpstreamRef)(nil),
 	}
 }
 
@@ -332,45 +346,48 @@ func init() {
 }
 
 var fileDescriptor_caa94c5eabc74996 = []byte{
-	// 601 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xdf, 0x4e, 0x14, 0x31,
-	0x14, 0xc6, 0x19, 0xfe, 0x39, 0x94, 0x05, 0xb5, 0x22, 0x8c, 0x1b, 0xc4, 0xd5, 0x68, 0xb2, 0x89,
-	0x71, 0x26, 0xe2, 0x8d, 0xd1, 0x0b, 0xc2, 0x44, 0x14, 0x8c, 0xe2, 0x3a, 0x6a, 0x4c, 0xbc, 0x69,
-	0xca, 0xcc, 0x71, 0x28, 0xec, 0xb4, 0xa5, 0xed, 0x2c, 0xf0, 0x1a, 0xbe, 0x81, 0x77, 0x3e, 0x82,
-	0xcf, 0xe3, 0x1b, 0x78, 0x61, 0x62, 0xbc, 0x32, 0x6d, 0x87, 0x65, 0x85, 0x68, 0xbc, 0xeb, 0x9c,
-	0xef, 0xf7, 0x9d, 0x33, 0xe7, 0xf4, 0x14, 0x6d, 0x97, 0xcc, 0xec, 0xd6, 0x3b, 0x71, 0x2e, 0xaa,
-	0x44, 0x8b, 0xbe, 0xb8, 0xc7, 0x44, 0x52, 0xf6, 0x85, 0x48, 0xa4, 0x12, 0x7b, 0x90, 0x1b, 0xed,
-	0xbf, 0xa8, 0x64, 0xc9, 0xe0, 0x7e, 0x22, 0xa4, 0x61, 0x82, 0xeb, 0xa4, 0x54, 0x32, 0x27, 0x7b,
-	0x5a, 0xf0, 0xd3, 0x53, 0x2c, 0x95, 0x30, 0x02, 0xaf, 0x9c, 0x06, 0x1a, 0x38, 0xb6, 0x09, 0x62,
-	0x9b, 0x3b, 0x66, 0xa2, 0xbd, 0x50, 0x8a, 0x52, 0x38, 0x34, 0xb1, 0x27, 0xef, 0x6a, 0x63, 0x38,
-	0x32, 0x3e, 0x08, 0x47, 0xa6, 0x89, 0x2d, 0x0d, 0x68, 0x9f, 0x15, 0xd4, 0x40, 0x72, 0x72, 0xf0,
-	0xc2, 0xad, 0xcf, 0xd3, 0x08, 0x3f, 0x53, 0x32, 0x7f, 0xae, 0x05, 0x7f, 0xab, 0x28, 0xd7, 0xb9,
-	0x28, 0x40, 0xe1, 0xbb, 0xe8, 0x92, 0xd3, 0x49, 0x01, 0x3a, 0x57, 0x4c, 0x1a, 0xa1, 0xa2, 0xa0,
-	0x13, 0x74, 0x67, 0x36, 0xc7, 0xb2, 0x8b, 0x4e, 0x79, 0x32, 0x14, 0xf0, 0x2a, 0x5a, 0x38, 0x0b,
-	0x93, 0x1d, 0xc6, 0xa3, 0xc9, 0x4e, 0xd0, 0x6d, 0x6d, 0x8e, 0x65, 0xf8, 0x8c, 0x21, 0x65, 0x1c,
-	0xdf, 0x46, 0xa1, 0x06, 0x35, 0x60, 0x39, 0xe8, 0x68, 0xbc, 0x33, 0xd1, 0x9d, 0x49, 0xc3, 0x5f,
-	0xe9, 0xd4, 0xa7, 0x60, 0x3c, 0x0c, 0xb2, 0xa1, 0x82, 0x0b, 0x34, 0x27, 0x15, 0xe3, 0x86, 0x34,
-	0xed, 0x47, 0x13, 0x9d, 0xa0, 0x3b, 0xbb, 0xba, 0x16, 0xff, 0x7b, 0x30, 0xf1, 0xf9, 0x8e, 0xe2,
-	0x9e, 0xcd, 0xf3, 0xca, 0xc3, 0x59, 0x4b, 0x8e, 0x7c, 0xe1, 0x35, 0xb4, 0x5c, 0x51, 0x93, 0xef,
-	0x12, 0xc6, 0x73, 0x51, 0x31, 0x5e, 0x12, 0x05, 0x07, 0x35, 0x68, 0x43, 0x94, 0xa8, 0x0d, 0x44,
-	0x53, 0x9d, 0xa0, 0x1b, 0x66, 0xd7, 0x1c, 0xb3, 0xd5, 0x20, 0x99, 0x27, 0x32, 0x0b, 0xe0, 0x87,
-	0x28, 0x62, 0x25, 0x17, 0x0a, 0x0a, 0x72, 0x50, 0x83, 0x3a, 0x26, 0x92, 0x2a, 0x5a, 0x81, 0x01,
-	0xa5, 0xa3, 0x69, 0xdb, 0x5c, 0xb6, 0xd8, 0xe8, 0xaf, 0xad, 0xdc, 0x1b, 0xaa, 0xf8, 0x26, 0x6a,
-	0xd1, 0xda, 0x08, 0x52, 0x51, 0x29, 0x19, 0x2f, 0xa3, 0x0b, 0xae, 0xd4, 0xac, 0x8d, 0xbd, 0xf4,
-	0x21, 0xbc, 0x81, 0x6e, 0x78, 0x33, 0xa9, 0xf9, 0x3e, 0x17, 0x87, 0xfc, 0x7c, 0x8d, 0xd0, 0xb9,
-	0x96, 0x3d, 0xf6, 0xce, 0x53, 0x67, 0x2b, 0xc5, 0xe8, 0x4a, 0x2e, 0xf8, 0x00, 0x94, 0x21, 0x6e,
-	0x78, 0xda, 0x50, 0x53, 0xeb, 0x68, 0xc6, 0x59, 0x2f, 0x37, 0x92, 0x9d, 0xdb, 0x1b, 0x27, 0xb4,
-	0xbf, 0x07, 0xa8, 0x35, 0x3a, 0x33, 0x7c, 0x07, 0xcd, 0xd3, 0xa2, 0x20, 0x87, 0xbb, 0xcc, 0x80,
-	0x96, 0x34, 0x07, 0xb7, 0x10, 0x61, 0x36, 0x47, 0x8b, 0xe2, 0xfd, 0x30, 0x88, 0xd7, 0xd1, 0x75,
-	0xda, 0x3f, 0xa4, 0xc7, 0x9a, 0xf8, 0x9b, 0x93, 0x8a, 0x55, 0xcc, 0xb0, 0x01, 0x90, 0x8f, 0x0c,
-	0xfa, 0x85, 0xbd, 0x6d, 0xeb, 0x6a, 0x7b, 0xc8, 0x55, 0xe8, 0x9d, 0x20, 0x4f, 0x1d, 0x81, 0x1f,
-	0xa1, 0xf6, 0x1f, 0x29, 0x80, 0xd7, 0x95, 0x26, 0x54, 0x13, 0xc6, 0x8d, 0x5f, 0x81, 0x30, 0x5b,
-	0x1c, 0xf1, 0x6f, 0x58, 0x7d, 0x5d, 0x6f, 0x71, 0xa3, 0xf1, 0x63, 0xd4, 0x96, 0x0a, 0xec, 0x02,
-	0x01, 0xf1, 0x4b, 0xe9, 0xca, 0x12, 0x4e, 0x2b, 0xd0, 0x6e, 0x23, 0xc3, 0x6c, 0xe9, 0x84, 0xe8,
-	0x59, 0xc0, 0x15, 0xdd, 0xb6, 0x72, 0x7a, 0x15, 0xcd, 0x8f, 0xac, 0xb0, 0x06, 0x83, 0x27, 0x7e,
-	0xa6, 0x41, 0xfa, 0xe2, 0xeb, 0x8f, 0xc9, 0xe0, 0xcb, 0xb7, 0x95, 0xe0, 0x43, 0xfa, 0x7f, 0x0f,
-	0x5c, 0xee, 0x97, 0x7f, 0x7d, 0xe4, 0x3b, 0xd3, 0xee, 0xb7, 0x1e, 0xfc, 0x0e, 0x00, 0x00, 0xff,
-	0xff, 0x98, 0xbd, 0xc2, 0x89, 0x2d, 0x04, 0x00, 0x00,
+	// 650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x6f, 0x4f, 0x14, 0x3b,
+	0x14, 0xc6, 0x19, 0xfe, 0xdd, 0xa1, 0x2c, 0xdc, 0x7b, 0x7b, 0xb9, 0x30, 0x6c, 0x10, 0x57, 0xa3,
+	0xc9, 0x26, 0xc6, 0x99, 0x88, 0x6f, 0x8c, 0x26, 0x12, 0x36, 0xa2, 0x60, 0x14, 0xd7, 0xaa, 0x31,
+	0xf1, 0x4d, 0x53, 0x66, 0xce, 0x0e, 0x85, 0x9d, 0xb6, 0xb4, 0x9d, 0x05, 0xbe, 0x86, 0x9f, 0xc2,
+	0x8f, 0xe0, 0xd7, 0xd1, 0x6f, 0xe0, 0x0b, 0x13, 0xe3, 0x2b, 0xd3, 0xce, 0xec, 0xb2, 0x42, 0x34,
+	0xbe, 0xeb, 0x9c, 0xe7, 0xf7, 0x9c, 0xd3, 0x9e, 0x9e, 0x29, 0xda, 0xcb, 0xb9, 0x3d, 0x28, 0xf7,
+	0xe3, 0x54, 0x16, 0x89, 0x91, 0x7d, 0x79, 0x9b, 0xcb, 0x24, 0xef, 0x4b, 0x99, 0x28, 0x2d, 0x0f,
+	0x21, 0xb5, 0xa6, 0xfa, 0x62, 0x8a, 0x27, 0x83, 0x3b, 0x89, 0x54, 0x96, 0x4b, 0x61, 0x92, 0x5c,
+	0xab, 0x94, 0x1e, 0x1a, 0x29, 0xce, 0x57, 0xb1, 0xd2, 0xd2, 0x4a, 0xbc, 0x7e, 0x1e, 0xa8, 0xe1,
+	0xd8, 0x25, 0x88, 0x5d, 0xee, 0x98, 0xcb, 0xe6, 0x52, 0x2e, 0x73, 0xe9, 0xd1, 0xc4, 0xad, 0x2a,
+	0x57, 0x13, 0xc3, 0xa9, 0xad, 0x82, 0x70, 0x6a, 0xeb, 0xd8, 0xca, 0x80, 0xf5, 0x79, 0xc6, 0x2c,
+	0x24, 0xc3, 0x45, 0x2d, 0xac, 0xfa, 0x7d, 0x1e, 0x71, 0x3b, 0xdc, 0x95, 0x86, 0x5e, 0x25, 0x5d,
+	0xff, 0x34, 0x8b, 0xf0, 0x13, 0xad, 0xd2, 0xa7, 0x46, 0x8a, 0xd7, 0x9a, 0x09, 0x93, 0xca, 0x0c,
+	0x34, 0xbe, 0x85, 0xfe, 0xf1, 0x3a, 0xcd, 0xc0, 0xa4, 0x9a, 0x2b, 0x2b, 0x75, 0x14, 0xb4, 0x82,
+	0xf6, 0xdc, 0xce, 0x04, 0xf9, 0xdb, 0x2b, 0x8f, 0x46, 0x02, 0xde, 0x40, 0x4b, 0x17, 0x61, 0xba,
+	0xcf, 0x45, 0x34, 0xdd, 0x0a, 0xda, 0x8d, 0x9d, 0x09, 0x82, 0x2f, 0x18, 0x3a, 0x5c, 0xe0, 0x87,
+	0xa8, 0x51, 0x2a, 0x63, 0x35, 0xb0, 0x82, 0x6a, 0xe8, 0x45, 0xa8, 0x15, 0xb4, 0xe7, 0x37, 0x56,
+	0xe3, 0x54, 0x6a, 0x18, 0x1e, 0x3d, 0x26, 0x60, 0x64, 0xa9, 0x53, 0x20, 0xd0, 0xdb, 0x99, 0x20,
+	0xf3, 0x43, 0x03, 0x81, 0x1e, 0xbe, 0x81, 0x42, 0x03, 0x7a, 0xc0, 0x53, 0x30, 0xd1, 0x64, 0x6b,
+	0xaa, 0x3d, 0xd7, 0x09, 0xbf, 0x77, 0x66, 0xde, 0x07, 0x93, 0x61, 0x40, 0x46, 0x0a, 0xce, 0xd0,
+	0x82, 0xd2, 0x5c, 0x58, 0x5a, 0x77, 0x36, 0x9a, 0xf2, 0x65, 0x36, 0xe3, 0xdf, 0xf7, 0x3c, 0xbe,
+	0xdc, 0x91, 0xb8, 0xeb, 0xf2, 0xbc, 0xa8, 0x60, 0xd2, 0x50, 0x63, 0x5f, 0x78, 0x13, 0xad, 0x15,
+	0xcc, 0xa6, 0x07, 0x94, 0x8b, 0x54, 0x16, 0x5c, 0xe4, 0x54, 0xc3, 0x71, 0x09, 0xc6, 0x52, 0x2d,
+	0x4b, 0x0b, 0xd1, 0x4c, 0x2b, 0x68, 0x87, 0x64, 0xd5, 0x33, 0xbb, 0x35, 0x42, 0x2a, 0x82, 0x38,
+	0x00, 0xdf, 0x43, 0x11, 0xcf, 0x85, 0xd4, 0x90, 0xd1, 0xe3, 0x12, 0xf4, 0x19, 0x55, 0x4c, 0xb3,
+	0x02, 0x2c, 0x68, 0x13, 0xcd, 0xba, 0xc3, 0x91, 0xe5, 0x5a, 0x7f, 0xe9, 0xe4, 0xee, 0x48, 0xc5,
+	0xd7, 0x50, 0x83, 0x95, 0x56, 0xd2, 0x82, 0x29, 0xc5, 0x45, 0x1e, 0xfd, 0xe5, 0x4b, 0xcd, 0xbb,
+	0xd8, 0xf3, 0x2a, 0x84, 0xb7, 0xd1, 0xd5, 0xca, 0x4c, 0x4b, 0x71, 0x24, 0xe4, 0x89, 0xb8, 0x5c,
+	0x23, 0xf4, 0xae, 0xb5, 0x0a, 0x7b, 0x53, 0x51, 0x17, 0x2b, 0xc5, 0xe8, 0xbf, 0x54, 0x8a, 0x01,
+	0x68, 0x4b, 0x7d, 0xf3, 0x8c, 0x65, 0xb6, 0x34, 0xd1, 0x9c, 0xb7, 0xfe, 0x5b, 0x4b, 0xae, 0x6f,
+	0xaf, 0xbc, 0xd0, 0xfc, 0x12, 0xa0, 0xc6, 0x78, 0xcf, 0xf0, 0x4d, 0xb4, 0xc8, 0xb2, 0x8c, 0x9e,
+	0x1c, 0x70, 0x0b, 0x46, 0xb1, 0x14, 0xfc, 0x40, 0x85, 0x64, 0x81, 0x65, 0xd9, 0xdb, 0x51, 0x10,
+	0x6f, 0xa1, 0x2b, 0xac, 0x7f, 0xc2, 0xce, 0x0c, 0xad, 0x6e, 0x4e, 0x69, 0x5e, 0x70, 0xcb, 0x07,
+	0x40, 0x7b, 0x1c, 0xfa, 0x99, 0xbb, 0x6d, 0xe7, 0x6a, 0x56, 0x90, 0xaf, 0xd0, 0x1d, 0x22, 0x8f,
+	0x3d, 0x81, 0xef, 0xa3, 0xe6, 0x4f, 0x29, 0x40, 0x94, 0x85, 0xa1, 0xcc, 0x50, 0x2e, 0x6c, 0x35,
+	0x02, 0x21, 0x59, 0x1e, 0xf3, 0x6f, 0x3b, 0x7d, 0xcb, 0xec, 0x0a, 0x6b, 0xf0, 0x03, 0xd4, 0x54,
+	0x1a, 0xdc, 0x00, 0x01, 0xad, 0x86, 0xda, 0x97, 0xa5, 0x82, 0x15, 0x60, 0xfc, 0x44, 0x87, 0x64,
+	0x65, 0x48, 0x74, 0x1d, 0xe0, 0x8b, 0xee, 0x39, 0xb9, 0xf3, 0x3f, 0x5a, 0x1c, 0xfb, 0x05, 0x0c,
+	0x58, 0x3c, 0xf5, 0xad, 0x13, 0x74, 0x9e, 0x7d, 0xfc, 0x3a, 0x1d, 0x7c, 0xf8, 0xbc, 0x1e, 0xbc,
+	0xeb, 0xfc, 0xd9, 0xdb, 0xa1, 0x8e, 0xf2, 0x5f, 0xbe, 0x1f, 0xfb, 0xb3, 0x7e, 0x5b, 0x77, 0x7f,
+	0x0c, 0x00, 0xc4, 0xb2, 0xd0, 0x6c, 0x88, 0x04, 0x00, 0x00,
 }
 
 func (this *GrpcJsonTranscoder) Equal(that interface{}) bool {
@@ -485,6 +502,30 @@ func (this *GrpcJsonTranscoder_ProtoDescriptorBin) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *GrpcJsonTranscoder_UpstreamRef) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GrpcJsonTranscoder_UpstreamRef)
+	if !ok {
+		that2, ok := that.(GrpcJsonTranscoder_UpstreamRef)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.UpstreamRef.Equal(that1.UpstreamRef) {
+		return false
+	}
+	return true
+}
 func (this *GrpcJsonTranscoder_PrintOptions) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -89,10 +89,13 @@ type HttpConnectionManagerSettings struct {
 	PreserveExternalRequestId   bool                                                       `protobuf:"varint,20,opt,name=preserve_external_request_id,json=preserveExternalRequestId,proto3" json:"preserve_external_request_id,omitempty"`
 	// HttpConnectionManager configuration for protocol upgrade requests.
 	// Note: WebSocket upgrades are enabled by default on the HTTP Connection Manager and must be explicitly disabled.
-	Upgrades             []*protocol_upgrade.ProtocolUpgradeConfig `protobuf:"bytes,21,rep,name=upgrades,proto3" json:"upgrades,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
-	XXX_unrecognized     []byte                                    `json:"-"`
-	XXX_sizecache        int32                                     `json:"-"`
+	Upgrades []*protocol_upgrade.ProtocolUpgradeConfig `protobuf:"bytes,21,rep,name=upgrades,proto3" json:"upgrades,omitempty"`
+	// The maximum duration of a stream, after which it is reset regardless of activity. Envoy has no per-route
+	// equivalent of this setting, so it applies to all the routes of the listener. Not set by default.
+	MaxStreamDuration    *time.Duration `protobuf:"bytes,23,opt,name=max_stream_duration,json=maxStreamDuration,proto3,stdduration" json:"max_stream_duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *HttpConnectionManagerSettings) Reset()         { *m = HttpConnectionManagerSettings{} }
@@ -266,6 +269,13 @@ func (m *HttpConnectionManagerSettings) GetUpgrades() []*protocol_upgrade.Protoc
 	return nil
 }
 
+func (m *HttpConnectionManagerSettings) GetMaxStreamDuration() *time.Duration {
+	if m != nil {
+		return m.MaxStreamDuration
+	}
+	return nil
+}
+
 type HttpConnectionManagerSettings_SetCurrentClientCertDetails struct {
 	Subject              *types.BoolValue `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
 	Cert                 bool             `protobuf:"varint,2,opt,name=cert,proto3" json:"cert,omitempty"`
@@ -351,71 +361,72 @@ func init() {
 }
 
 var fileDescriptor_08263ad65d35164d = []byte{
-	// 1010 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xdd, 0x6e, 0xdb, 0x36,
-	0x14, 0x9e, 0x9a, 0xb4, 0x75, 0x19, 0xc7, 0x75, 0xe8, 0xb4, 0x53, 0x93, 0xb6, 0x31, 0x8a, 0x61,
-	0xf0, 0xc5, 0x26, 0x3b, 0xe9, 0x7e, 0x6e, 0x0a, 0x6c, 0x8e, 0x93, 0xc0, 0x5e, 0xb3, 0x24, 0x93,
-	0xd3, 0xf5, 0xe7, 0x86, 0xa0, 0xa5, 0x23, 0x99, 0x8b, 0x24, 0x6a, 0x24, 0x95, 0x3a, 0x4f, 0xb1,
-	0xab, 0x01, 0xdb, 0xe5, 0xee, 0xf6, 0x08, 0x7b, 0x9b, 0x01, 0x7b, 0x87, 0xdd, 0x0f, 0x24, 0xa5,
-	0xb4, 0x45, 0x7e, 0xb1, 0x0b, 0xc3, 0x47, 0xe7, 0x7c, 0xdf, 0xa7, 0xc3, 0x23, 0xea, 0xa3, 0xd0,
-	0x66, 0xcc, 0xd4, 0xb4, 0x98, 0x78, 0x01, 0x4f, 0xbb, 0x92, 0x27, 0xfc, 0x73, 0xc6, 0xbb, 0x71,
-	0xc2, 0x79, 0x37, 0x17, 0xfc, 0x27, 0x08, 0x94, 0xb4, 0x57, 0x34, 0x67, 0xdd, 0xe3, 0xf5, 0x2e,
-	0xcf, 0x15, 0xe3, 0x99, 0xec, 0x4e, 0x83, 0x54, 0xff, 0xbc, 0x5c, 0x70, 0xc5, 0xb1, 0xab, 0xc3,
-	0xb2, 0xe4, 0x69, 0xb8, 0xa7, 0x95, 0x3c, 0xc6, 0x57, 0x96, 0x63, 0x1e, 0x73, 0x03, 0xea, 0xea,
-	0xc8, 0xe2, 0x57, 0x1e, 0xc7, 0x9c, 0xc7, 0x09, 0x74, 0xcd, 0xd5, 0xa4, 0x88, 0xba, 0x6f, 0x05,
-	0xcd, 0x73, 0x10, 0xf2, 0xa2, 0x7a, 0x58, 0x08, 0xaa, 0xd5, 0xcb, 0xfa, 0xd7, 0x57, 0x37, 0xa8,
-	0x04, 0x0d, 0x58, 0x16, 0x57, 0xff, 0x25, 0x71, 0x74, 0x35, 0xd1, 0x00, 0x03, 0x9e, 0x90, 0x22,
-	0x8f, 0x05, 0x0d, 0xe1, 0x4c, 0xa2, 0x94, 0xc2, 0x30, 0x53, 0x76, 0x61, 0x30, 0x53, 0x36, 0xf7,
-	0xe4, 0xd7, 0x06, 0x7a, 0x34, 0x54, 0x2a, 0x1f, 0xf0, 0x2c, 0x83, 0x40, 0xeb, 0x7d, 0x4f, 0x33,
-	0x1a, 0x83, 0x18, 0x83, 0x52, 0x2c, 0x8b, 0x25, 0xfe, 0x14, 0xdd, 0x95, 0x47, 0x2c, 0x27, 0xb3,
-	0x28, 0x22, 0x7a, 0xc9, 0x59, 0xe8, 0x3a, 0x6d, 0xa7, 0x53, 0xf3, 0x17, 0x75, 0xfa, 0x55, 0x14,
-	0xf5, 0x4d, 0x12, 0x37, 0xd1, 0xdc, 0x31, 0xa3, 0xee, 0x8d, 0xb6, 0xd3, 0xb9, 0xe3, 0xeb, 0x10,
-	0x77, 0xd1, 0xb2, 0x26, 0x65, 0x45, 0x4a, 0x94, 0x28, 0xa4, 0x82, 0x90, 0x4c, 0x79, 0x2e, 0xdd,
-	0xb9, 0xb6, 0xd3, 0x59, 0xf4, 0x97, 0x66, 0x51, 0xb4, 0x57, 0xa4, 0x87, 0xb6, 0x32, 0xe4, 0xb9,
-	0xc4, 0x43, 0x84, 0x0b, 0x09, 0x44, 0x40, 0xca, 0x15, 0x10, 0x1a, 0x86, 0x02, 0xa4, 0x74, 0xe7,
-	0xdb, 0x4e, 0x67, 0x61, 0x63, 0xc5, 0xb3, 0x13, 0xf6, 0xaa, 0x09, 0x7b, 0x9b, 0x9c, 0x27, 0x3f,
-	0xd2, 0xa4, 0x00, 0xbf, 0x59, 0x48, 0xf0, 0x0d, 0xa9, 0x6f, 0x39, 0xf8, 0x3b, 0xd4, 0x8a, 0x21,
-	0x03, 0x41, 0x95, 0x96, 0xfb, 0xb9, 0x00, 0xa9, 0x08, 0x0b, 0xdd, 0x9b, 0x57, 0x4a, 0x2d, 0x55,
-	0x34, 0xdf, 0xb2, 0x46, 0x21, 0xfe, 0x0c, 0xe1, 0x5c, 0xf0, 0xd9, 0x09, 0x59, 0xef, 0xf5, 0x48,
-	0xc0, 0x33, 0xc5, 0xb2, 0x02, 0xdc, 0x5b, 0x66, 0x06, 0x4d, 0x53, 0x59, 0xef, 0xf5, 0x06, 0x65,
-	0x1e, 0xef, 0xa3, 0x96, 0x54, 0x02, 0x68, 0x4a, 0x58, 0x98, 0x00, 0x51, 0x2c, 0x05, 0x5e, 0x28,
-	0xf7, 0xb6, 0xb9, 0xf3, 0x83, 0x33, 0x77, 0xde, 0x2a, 0xb7, 0xc9, 0xe6, 0xfc, 0x6f, 0x7f, 0xaf,
-	0x39, 0xfe, 0x92, 0xe5, 0x8e, 0xc2, 0x04, 0x0e, 0x2d, 0x13, 0x6f, 0xa2, 0xfa, 0x07, 0x4a, 0xb5,
-	0xeb, 0x29, 0x2d, 0xb0, 0xf7, 0x34, 0x7e, 0x40, 0xf7, 0x53, 0x3a, 0x3b, 0x9d, 0xc4, 0x14, 0x68,
-	0x08, 0x42, 0x92, 0xa3, 0x89, 0x7b, 0xc7, 0xa8, 0x3d, 0x3c, 0xa3, 0xf6, 0x62, 0x94, 0xa9, 0xa7,
-	0x1b, 0x76, 0x26, 0xad, 0x94, 0xce, 0xca, 0x71, 0x0c, 0x2d, 0xf3, 0xf9, 0x04, 0x0f, 0xd1, 0xdd,
-	0x4a, 0xae, 0xea, 0x0c, 0x5d, 0xaf, 0xb3, 0x46, 0xc9, 0xab, 0x9a, 0xdb, 0x42, 0x8b, 0xa1, 0xa0,
-	0x2c, 0x3b, 0xd5, 0xa9, 0x5f, 0x4f, 0xa7, 0x6e, 0x58, 0x95, 0xca, 0x18, 0xdd, 0x0b, 0x21, 0xa1,
-	0x27, 0x10, 0x92, 0x20, 0xe1, 0xf2, 0xdd, 0xbc, 0x16, 0xaf, 0xa7, 0xd6, 0x2a, 0xd9, 0x03, 0x4d,
-	0xae, 0x44, 0xd7, 0xd0, 0x82, 0x04, 0x71, 0x0c, 0x82, 0x64, 0x34, 0x05, 0xb7, 0x61, 0xf6, 0x36,
-	0xb2, 0xa9, 0x3d, 0x9a, 0x02, 0xfe, 0x04, 0x35, 0x68, 0x10, 0x40, 0xae, 0xc8, 0x54, 0xa9, 0x9c,
-	0xac, 0xf7, 0xdc, 0xbb, 0x66, 0x5f, 0xd4, 0x6d, 0x56, 0xbf, 0x59, 0xeb, 0x3d, 0xfc, 0x15, 0x72,
-	0x43, 0x88, 0x68, 0x91, 0x28, 0x32, 0xe5, 0x52, 0x91, 0x88, 0x8b, 0x53, 0x7c, 0xd3, 0x68, 0x2e,
-	0x97, 0xf5, 0x21, 0x97, 0x6a, 0x87, 0x8b, 0x92, 0xf7, 0x2d, 0x7a, 0x94, 0x0b, 0x9e, 0x83, 0x20,
-	0x01, 0x95, 0x50, 0x3e, 0x36, 0x72, 0x04, 0x27, 0x5a, 0x21, 0xa5, 0xca, 0xbd, 0x6f, 0x6e, 0xf6,
-	0xc0, 0x82, 0x06, 0x54, 0x82, 0x7d, 0x3e, 0xcf, 0xe1, 0x64, 0xc7, 0x00, 0xf0, 0x3e, 0xba, 0x5d,
-	0xda, 0x89, 0xbb, 0x64, 0xe6, 0xf0, 0xa5, 0x57, 0xd9, 0xcb, 0x79, 0xe6, 0xe7, 0xed, 0x32, 0xa9,
-	0xf4, 0x0b, 0x70, 0x68, 0x41, 0x95, 0x09, 0xf8, 0x95, 0x0a, 0xfe, 0xc5, 0x41, 0xab, 0x11, 0x17,
-	0x6f, 0xa9, 0xd0, 0x73, 0x66, 0x90, 0x29, 0x12, 0x80, 0x50, 0x24, 0x04, 0x45, 0x59, 0x22, 0x5d,
-	0xdc, 0x76, 0x3a, 0x8d, 0x8d, 0x03, 0xef, 0x22, 0x7b, 0xf5, 0x2e, 0x35, 0x1b, 0x6f, 0xc7, 0x4a,
-	0x0f, 0x8c, 0xf2, 0x00, 0x84, 0xda, 0xb2, 0xba, 0xbe, 0x1b, 0x5d, 0x50, 0xc1, 0xbf, 0x3b, 0x68,
-	0x4d, 0x82, 0x22, 0x41, 0x21, 0x84, 0x69, 0xe7, 0x9c, 0xae, 0x5a, 0x66, 0xed, 0xe3, 0xff, 0xdb,
-	0xd5, 0x18, 0xd4, 0xc0, 0xaa, 0x9f, 0x6d, 0x6c, 0x55, 0x5e, 0x5c, 0xc4, 0xdf, 0xa0, 0x87, 0xb9,
-	0x00, 0xb3, 0x5f, 0x08, 0xcc, 0x14, 0x88, 0x8c, 0x26, 0xef, 0xfb, 0xd1, 0x72, 0xf5, 0xfc, 0x2c,
-	0x66, 0xbb, 0x84, 0xbc, 0xf3, 0x9e, 0x57, 0xa8, 0x56, 0x7a, 0xb8, 0x74, 0xef, 0xb5, 0xe7, 0x3a,
-	0x0b, 0x1b, 0xcf, 0xbc, 0x33, 0xee, 0x7e, 0xee, 0x8a, 0x0e, 0x4a, 0xd4, 0x0b, 0x0b, 0x1a, 0xf0,
-	0x2c, 0x62, 0xb1, 0x7f, 0xaa, 0xb6, 0xf2, 0x87, 0x83, 0x56, 0x2f, 0x59, 0x17, 0xfe, 0x02, 0xdd,
-	0x96, 0xc5, 0x44, 0x1f, 0x3a, 0xc6, 0xee, 0x2f, 0x77, 0xcd, 0x0a, 0x8a, 0x31, 0x9a, 0xd7, 0x83,
-	0x37, 0xa7, 0x40, 0xcd, 0x37, 0x31, 0x5e, 0x46, 0x37, 0x83, 0x29, 0x65, 0x99, 0xf1, 0xfd, 0x9a,
-	0x6f, 0x2f, 0xf4, 0x71, 0x11, 0x66, 0xd6, 0xdc, 0x6b, 0xbe, 0x0e, 0x75, 0xa6, 0x10, 0xcc, 0x78,
-	0x74, 0xcd, 0xd7, 0xe1, 0x93, 0x13, 0xe4, 0x5e, 0xb4, 0x21, 0x70, 0x1d, 0xd5, 0xc6, 0xfd, 0xbd,
-	0xd1, 0xe1, 0xe8, 0xcd, 0x76, 0xf3, 0x23, 0xdc, 0x44, 0xf5, 0x9d, 0x7d, 0xff, 0x65, 0xdf, 0xdf,
-	0x22, 0xfb, 0x7b, 0xbb, 0xaf, 0x9b, 0x0e, 0xc6, 0xa8, 0xd1, 0x3f, 0x38, 0xd8, 0xde, 0xdb, 0x22,
-	0x65, 0xa1, 0x79, 0x43, 0xa3, 0x2a, 0x0e, 0x19, 0x6f, 0x1f, 0x36, 0xe7, 0xf0, 0xc7, 0xa8, 0xd5,
-	0xdf, 0x7d, 0xd9, 0x7f, 0x3d, 0x26, 0x1f, 0xd0, 0xe7, 0x37, 0x77, 0xfe, 0xfa, 0x77, 0xde, 0xf9,
-	0xf3, 0x9f, 0xc7, 0xce, 0x9b, 0x67, 0xd7, 0xfb, 0xda, 0xc8, 0x8f, 0xe2, 0x73, 0xbe, 0x38, 0x26,
-	0xb7, 0xcc, 0xb4, 0x9e, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0x45, 0xb0, 0x19, 0x35, 0xb4, 0x08,
-	0x00, 0x00,
+	// 1027 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcb, 0x72, 0xdb, 0x36,
+	0x14, 0x2d, 0x63, 0x27, 0x56, 0x60, 0x59, 0x96, 0x21, 0x27, 0x61, 0xec, 0x24, 0xd6, 0x64, 0x3a,
+	0x1d, 0x2d, 0x5a, 0x52, 0x76, 0xfa, 0xd8, 0x64, 0xa6, 0x95, 0x65, 0x7b, 0xa4, 0xc6, 0xb5, 0x5d,
+	0xca, 0x69, 0x1e, 0x1b, 0x0c, 0x44, 0x5e, 0x52, 0xac, 0x49, 0x82, 0x05, 0x40, 0x47, 0xfe, 0x8a,
+	0x6e, 0xdb, 0x65, 0x77, 0xfd, 0x84, 0xfe, 0x4d, 0x67, 0xba, 0xe8, 0x1f, 0x74, 0xdf, 0x01, 0x40,
+	0x3a, 0xc9, 0xd8, 0x8e, 0x35, 0x59, 0x68, 0x04, 0xdc, 0x7b, 0xce, 0xe1, 0xc5, 0x05, 0x78, 0x40,
+	0xb4, 0x1d, 0xc5, 0x72, 0x52, 0x8c, 0x1d, 0x9f, 0xa5, 0xae, 0x60, 0x09, 0xfb, 0x22, 0x66, 0x6e,
+	0x94, 0x30, 0xe6, 0xe6, 0x9c, 0xfd, 0x0c, 0xbe, 0x14, 0x66, 0x46, 0xf3, 0xd8, 0x3d, 0xdd, 0x74,
+	0x59, 0x2e, 0x63, 0x96, 0x09, 0x77, 0xe2, 0xa7, 0xea, 0xe7, 0xe4, 0x9c, 0x49, 0x86, 0x6d, 0x35,
+	0x2c, 0x53, 0x8e, 0x82, 0x3b, 0x4a, 0xc9, 0x89, 0xd9, 0xda, 0x6a, 0xc4, 0x22, 0xa6, 0x41, 0xae,
+	0x1a, 0x19, 0xfc, 0xda, 0xa3, 0x88, 0xb1, 0x28, 0x01, 0x57, 0xcf, 0xc6, 0x45, 0xe8, 0xbe, 0xe1,
+	0x34, 0xcf, 0x81, 0x8b, 0xab, 0xf2, 0x41, 0xc1, 0xa9, 0x52, 0x2f, 0xf3, 0xdf, 0x5c, 0x5f, 0xa0,
+	0xe4, 0xd4, 0x8f, 0xb3, 0xa8, 0xfa, 0x2f, 0x89, 0xc3, 0xeb, 0x89, 0x1a, 0xe8, 0xb3, 0x84, 0x14,
+	0x79, 0xc4, 0x69, 0x00, 0x17, 0x02, 0xa5, 0x14, 0x86, 0xa9, 0x34, 0x0b, 0x83, 0xa9, 0x34, 0xb1,
+	0xc7, 0xff, 0x36, 0xd0, 0xc3, 0x81, 0x94, 0x79, 0x9f, 0x65, 0x19, 0xf8, 0x4a, 0xef, 0x07, 0x9a,
+	0xd1, 0x08, 0xf8, 0x08, 0xa4, 0x8c, 0xb3, 0x48, 0xe0, 0xcf, 0xd0, 0xb2, 0x38, 0x89, 0x73, 0x32,
+	0x0d, 0x43, 0xa2, 0x96, 0x9c, 0x05, 0xb6, 0xd5, 0xb6, 0x3a, 0x35, 0x6f, 0x49, 0x85, 0x5f, 0x86,
+	0x61, 0x4f, 0x07, 0x71, 0x13, 0xcd, 0x9d, 0xc6, 0xd4, 0xbe, 0xd1, 0xb6, 0x3a, 0xb7, 0x3d, 0x35,
+	0xc4, 0x2e, 0x5a, 0x55, 0xa4, 0xac, 0x48, 0x89, 0xe4, 0x85, 0x90, 0x10, 0x90, 0x09, 0xcb, 0x85,
+	0x3d, 0xd7, 0xb6, 0x3a, 0x4b, 0xde, 0xca, 0x34, 0x0c, 0x0f, 0x8a, 0xf4, 0xd8, 0x64, 0x06, 0x2c,
+	0x17, 0x78, 0x80, 0x70, 0x21, 0x80, 0x70, 0x48, 0x99, 0x04, 0x42, 0x83, 0x80, 0x83, 0x10, 0xf6,
+	0x7c, 0xdb, 0xea, 0x2c, 0x6e, 0xad, 0x39, 0xa6, 0xc3, 0x4e, 0xd5, 0x61, 0x67, 0x9b, 0xb1, 0xe4,
+	0x27, 0x9a, 0x14, 0xe0, 0x35, 0x0b, 0x01, 0x9e, 0x26, 0xf5, 0x0c, 0x07, 0x7f, 0x8f, 0x5a, 0x11,
+	0x64, 0xc0, 0xa9, 0x54, 0x72, 0xbf, 0x14, 0x20, 0x24, 0x89, 0x03, 0xfb, 0xe6, 0xb5, 0x52, 0x2b,
+	0x15, 0xcd, 0x33, 0xac, 0x61, 0x80, 0x3f, 0x47, 0x38, 0xe7, 0x6c, 0x7a, 0x46, 0x36, 0xbb, 0x5d,
+	0xe2, 0xb3, 0x4c, 0xc6, 0x59, 0x01, 0xf6, 0x2d, 0xdd, 0x83, 0xa6, 0xce, 0x6c, 0x76, 0xbb, 0xfd,
+	0x32, 0x8e, 0x0f, 0x51, 0x4b, 0x48, 0x0e, 0x34, 0x25, 0x71, 0x90, 0x00, 0x91, 0x71, 0x0a, 0xac,
+	0x90, 0xf6, 0x82, 0x7e, 0xf2, 0xfd, 0x0b, 0x4f, 0xde, 0x29, 0x8f, 0xc9, 0xf6, 0xfc, 0x6f, 0x7f,
+	0x6f, 0x58, 0xde, 0x8a, 0xe1, 0x0e, 0x83, 0x04, 0x8e, 0x0d, 0x13, 0x6f, 0xa3, 0xfa, 0x7b, 0x4a,
+	0xb5, 0xd9, 0x94, 0x16, 0xe3, 0x77, 0x34, 0x7e, 0x44, 0x77, 0x53, 0x3a, 0x3d, 0xef, 0xc4, 0x04,
+	0x68, 0x00, 0x5c, 0x90, 0x93, 0xb1, 0x7d, 0x5b, 0xab, 0x3d, 0xb8, 0xa0, 0xf6, 0x7c, 0x98, 0xc9,
+	0x27, 0x5b, 0xa6, 0x27, 0xad, 0x94, 0x4e, 0xcb, 0x76, 0x0c, 0x0c, 0xf3, 0xd9, 0x18, 0x0f, 0xd0,
+	0x72, 0x25, 0x57, 0x55, 0x86, 0x66, 0xab, 0xac, 0x51, 0xf2, 0xaa, 0xe2, 0x76, 0xd0, 0x52, 0xc0,
+	0x69, 0x9c, 0x9d, 0xeb, 0xd4, 0x67, 0xd3, 0xa9, 0x6b, 0x56, 0xa5, 0x32, 0x42, 0x77, 0x02, 0x48,
+	0xe8, 0x19, 0x04, 0xc4, 0x4f, 0x98, 0x78, 0xdb, 0xaf, 0xa5, 0xd9, 0xd4, 0x5a, 0x25, 0xbb, 0xaf,
+	0xc8, 0x95, 0xe8, 0x06, 0x5a, 0x14, 0xc0, 0x4f, 0x81, 0x93, 0x8c, 0xa6, 0x60, 0x37, 0xf4, 0xd9,
+	0x46, 0x26, 0x74, 0x40, 0x53, 0xc0, 0x9f, 0xa2, 0x06, 0xf5, 0x7d, 0xc8, 0x25, 0x99, 0x48, 0x99,
+	0x93, 0xcd, 0xae, 0xbd, 0xac, 0xcf, 0x45, 0xdd, 0x44, 0xd5, 0x9b, 0xb5, 0xd9, 0xc5, 0x5f, 0x23,
+	0x3b, 0x80, 0x90, 0x16, 0x89, 0x24, 0x13, 0x26, 0x24, 0x09, 0x19, 0x3f, 0xc7, 0x37, 0xb5, 0xe6,
+	0x6a, 0x99, 0x1f, 0x30, 0x21, 0xf7, 0x18, 0x2f, 0x79, 0xdf, 0xa1, 0x87, 0x39, 0x67, 0x39, 0x70,
+	0xe2, 0x53, 0x01, 0xe5, 0xb6, 0x91, 0x13, 0x38, 0x53, 0x0a, 0x29, 0x95, 0xf6, 0x5d, 0xfd, 0xb0,
+	0xfb, 0x06, 0xd4, 0xa7, 0x02, 0xcc, 0xfe, 0x3c, 0x83, 0xb3, 0x3d, 0x0d, 0xc0, 0x87, 0x68, 0xa1,
+	0xb4, 0x13, 0x7b, 0x45, 0xf7, 0xe1, 0x2b, 0xa7, 0x9c, 0x5f, 0x6a, 0x7e, 0xce, 0x7e, 0x2c, 0xa4,
+	0x7a, 0x01, 0x8e, 0x0d, 0xa8, 0x32, 0x01, 0xaf, 0x52, 0xc1, 0xbf, 0x5a, 0x68, 0x3d, 0x64, 0xfc,
+	0x0d, 0xe5, 0xaa, 0xcf, 0x31, 0x64, 0x92, 0xf8, 0xc0, 0x25, 0x09, 0x40, 0xd2, 0x38, 0x11, 0x36,
+	0x6e, 0x5b, 0x9d, 0xc6, 0xd6, 0x91, 0x73, 0x95, 0xbd, 0x3a, 0x1f, 0x34, 0x1b, 0x67, 0xcf, 0x48,
+	0xf7, 0xb5, 0x72, 0x1f, 0xb8, 0xdc, 0x31, 0xba, 0x9e, 0x1d, 0x5e, 0x91, 0xc1, 0xbf, 0x5b, 0x68,
+	0x43, 0x80, 0x24, 0x7e, 0xc1, 0xb9, 0x2e, 0xe7, 0x92, 0xaa, 0x5a, 0x7a, 0xed, 0xa3, 0x8f, 0xad,
+	0x6a, 0x04, 0xb2, 0x6f, 0xd4, 0x2f, 0x16, 0xb6, 0x2e, 0xae, 0x4e, 0xe2, 0x6f, 0xd1, 0x83, 0x9c,
+	0x83, 0x3e, 0x2f, 0x04, 0xa6, 0x12, 0x78, 0x46, 0x93, 0x77, 0xfd, 0x68, 0xb5, 0xda, 0x3f, 0x83,
+	0xd9, 0x2d, 0x21, 0x6f, 0xbd, 0xe7, 0x25, 0xaa, 0x95, 0x1e, 0x2e, 0xec, 0x3b, 0xed, 0xb9, 0xce,
+	0xe2, 0xd6, 0x53, 0xe7, 0x82, 0xbb, 0x5f, 0xba, 0xa2, 0xa3, 0x12, 0xf5, 0xdc, 0x80, 0xfa, 0x2c,
+	0x0b, 0xe3, 0xc8, 0x3b, 0x57, 0x53, 0x3e, 0xa5, 0x2c, 0xa1, 0xf4, 0xaa, 0xea, 0xb6, 0xb2, 0xef,
+	0xcd, 0xe8, 0x53, 0x29, 0x9d, 0x8e, 0x34, 0xb5, 0x4a, 0xac, 0xfd, 0x61, 0xa1, 0xf5, 0x0f, 0x34,
+	0x0a, 0x7f, 0x89, 0x16, 0x44, 0x31, 0x56, 0xb7, 0x98, 0x6d, 0x5d, 0x6b, 0xc3, 0x15, 0x14, 0x63,
+	0x34, 0xaf, 0x76, 0x52, 0x5f, 0x2b, 0x35, 0x4f, 0x8f, 0xf1, 0x2a, 0xba, 0xe9, 0x4f, 0x68, 0x9c,
+	0xe9, 0x8b, 0xa4, 0xe6, 0x99, 0x89, 0xba, 0x7f, 0x82, 0xcc, 0xdc, 0x16, 0x35, 0x4f, 0x0d, 0x55,
+	0xa4, 0xe0, 0xb1, 0x36, 0xfd, 0x9a, 0xa7, 0x86, 0x8f, 0xcf, 0x90, 0x7d, 0xd5, 0x09, 0xc3, 0x75,
+	0x54, 0x1b, 0xf5, 0x0e, 0x86, 0xc7, 0xc3, 0xd7, 0xbb, 0xcd, 0x4f, 0x70, 0x13, 0xd5, 0xf7, 0x0e,
+	0xbd, 0x17, 0x3d, 0x6f, 0x87, 0x1c, 0x1e, 0xec, 0xbf, 0x6a, 0x5a, 0x18, 0xa3, 0x46, 0xef, 0xe8,
+	0x68, 0xf7, 0x60, 0x87, 0x94, 0x89, 0xe6, 0x0d, 0x85, 0xaa, 0x38, 0x64, 0xb4, 0x7b, 0xdc, 0x9c,
+	0xc3, 0xf7, 0x50, 0xab, 0xb7, 0xff, 0xa2, 0xf7, 0x6a, 0x44, 0xde, 0xa3, 0xcf, 0x6f, 0xef, 0xfd,
+	0xf5, 0xdf, 0xbc, 0xf5, 0xe7, 0x3f, 0x8f, 0xac, 0xd7, 0x4f, 0x67, 0xfb, 0x7c, 0xc9, 0x4f, 0xa2,
+	0x4b, 0x3e, 0x61, 0xc6, 0xb7, 0x74, 0xb7, 0x9e, 0xfc, 0x3f, 0x00, 0xe7, 0x63, 0x2c, 0xeb, 0x05,
+	0x09, 0x00, 0x00,
 }
 
 func (this *HttpConnectionManagerSettings) Equal(that interface{}) bool {
@@ -535,6 +546,15 @@ func (this *HttpConnectionManagerSettings) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.MaxStreamDuration != nil && that1.MaxStreamDuration != nil {
+		if *this.MaxStreamDuration != *that1.MaxStreamDuration {
+			return false
+		}
+	} else if this.MaxStreamDuration != nil {
+		return false
+	} else if that1.MaxStreamDuration != nil {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -0,0 +1,162 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/local_ratelimit/local_ratelimit.proto
+
+package local_ratelimit
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Rate limits the requests of a virtual host or route with a token bucket kept in the memory of each Envoy instance,
+// without calling out to a rate limit server. Each request consumes a token, and requests that find the bucket empty
+// are rejected with a 429.
+// Every virtual host and route gets its own bucket, which is shared by all the connections to an Envoy instance.
+type TokenBucket struct {
+	// The maximum number of tokens that the bucket can hold, which is also the number of tokens it initially contains.
+	// Must be greater than 0.
+	MaxTokens uint32 `protobuf:"varint,1,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+	// The number of tokens added to the bucket every `fill_interval`. Defaults to 1.
+	TokensPerFill *types.UInt32Value `protobuf:"bytes,2,opt,name=tokens_per_fill,json=tokensPerFill,proto3" json:"tokens_per_fill,omitempty"`
+	// The interval at which tokens are added to the bucket. Must be at least 50ms.
+	FillInterval         *time.Duration `protobuf:"bytes,3,opt,name=fill_interval,json=fillInterval,proto3,stdduration" json:"fill_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TokenBucket) Reset()         { *m = TokenBucket{} }
+func (m *TokenBucket) String() string { return proto.CompactTextString(m) }
+func (*TokenBucket) ProtoMessage()    {}
+func (*TokenBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e4ccbc4ef07400f, []int{0}
+}
+func (m *TokenBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenBucket.Unmarshal(m, b)
+}
+func (m *TokenBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenBucket.Marshal(b, m, deterministic)
+}
+func (m *TokenBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenBucket.Merge(m, src)
+}
+func (m *TokenBucket) XXX_Size() int {
+	return xxx_messageInfo_TokenBucket.Size(m)
+}
+func (m *TokenBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenBucket proto.InternalMessageInfo
+
+func (m *TokenBucket) GetMaxTokens() uint32 {
+	if m != nil {
+		return m.MaxTokens
+	}
+	return 0
+}
+
+func (m *TokenBucket) GetTokensPerFill() *types.UInt32Value {
+	if m != nil {
+		return m.TokensPerFill
+	}
+	return nil
+}
+
+func (m *TokenBucket) GetFillInterval() *time.Duration {
+	if m != nil {
+		return m.FillInterval
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TokenBucket)(nil), "local_ratelimit.options.gloo.solo.io.TokenBucket")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/options/local_ratelimit/local_ratelimit.proto", fileDescriptor_6e4ccbc4ef07400f)
+}
+
+var fileDescriptor_6e4ccbc4ef07400f = []byte{
+	// 314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x41, 0x4e, 0xf3, 0x30,
+	0x10, 0x85, 0xe5, 0xff, 0xaf, 0x90, 0x48, 0xa9, 0x90, 0x22, 0x16, 0x05, 0x41, 0xa9, 0x10, 0x8b,
+	0x6e, 0xb0, 0x45, 0x7b, 0x83, 0xaa, 0x42, 0x74, 0x87, 0x22, 0x60, 0xd1, 0x4d, 0xe4, 0x86, 0xa9,
+	0x31, 0x99, 0x64, 0x2c, 0xc7, 0x29, 0x39, 0x0a, 0x47, 0xe0, 0x08, 0xbd, 0x0d, 0x12, 0x77, 0x60,
+	0x8f, 0x9c, 0x84, 0x4d, 0xbb, 0x80, 0x9d, 0xe7, 0xcd, 0x7c, 0x6f, 0x9e, 0x35, 0xc1, 0x42, 0x69,
+	0xf7, 0x5c, 0x2e, 0x79, 0x42, 0x99, 0x28, 0x08, 0xe9, 0x4a, 0x93, 0x50, 0x48, 0x24, 0x8c, 0xa5,
+	0x17, 0x48, 0x5c, 0xd1, 0x54, 0xd2, 0x68, 0xb1, 0xbe, 0x16, 0x64, 0x9c, 0xa6, 0xbc, 0x10, 0x48,
+	0x89, 0xc4, 0xd8, 0x4a, 0x07, 0xa8, 0x33, 0xed, 0xb6, 0x6b, 0x6e, 0x2c, 0x39, 0x0a, 0x2f, 0xb7,
+	0xe5, 0x16, 0xe7, 0xde, 0x92, 0xfb, 0x6d, 0x5c, 0xd3, 0xc9, 0x40, 0x11, 0x29, 0x04, 0x51, 0x33,
+	0xcb, 0x72, 0x25, 0x9e, 0x4a, 0x2b, 0xfd, 0x5c, 0xe3, 0xb2, 0xdb, 0x7f, 0xb5, 0xd2, 0x18, 0xb0,
+	0x45, 0xdb, 0x3f, 0x52, 0xa4, 0xa8, 0x7e, 0x0a, 0xff, 0x6a, 0xd5, 0x10, 0x2a, 0xd7, 0x88, 0x50,
+	0xb5, 0x79, 0x2e, 0x36, 0x2c, 0xe8, 0xde, 0x53, 0x0a, 0xf9, 0xb4, 0x4c, 0x52, 0x70, 0xe1, 0x59,
+	0x10, 0x64, 0xb2, 0x8a, 0x9d, 0x97, 0x8a, 0x3e, 0x1b, 0xb2, 0x51, 0x2f, 0xda, 0xcf, 0x64, 0x55,
+	0xcf, 0x14, 0xe1, 0x2c, 0x38, 0x6c, 0x5a, 0xb1, 0x01, 0x1b, 0xaf, 0x34, 0x62, 0xff, 0xdf, 0x90,
+	0x8d, 0xba, 0xe3, 0x53, 0xde, 0x44, 0xe2, 0x3f, 0x91, 0xf8, 0xc3, 0x3c, 0x77, 0x93, 0xf1, 0xa3,
+	0xc4, 0x12, 0xa2, 0x5e, 0x03, 0xdd, 0x81, 0xbd, 0xd1, 0x88, 0xe1, 0x2c, 0xe8, 0x79, 0x34, 0xd6,
+	0xb9, 0x03, 0xbb, 0x96, 0xd8, 0xff, 0x5f, 0x7b, 0x1c, 0xef, 0x78, 0xcc, 0xda, 0x6f, 0x4f, 0x3b,
+	0x6f, 0x1f, 0xe7, 0x2c, 0x3a, 0xf0, 0xd4, 0xbc, 0x85, 0xa6, 0xd1, 0xe6, 0xab, 0xc3, 0xde, 0x3f,
+	0x07, 0x6c, 0x71, 0xfb, 0xb7, 0x83, 0x99, 0x54, 0xfd, 0x72, 0xb4, 0xe5, 0x5e, 0xbd, 0x7a, 0xf2,
+	0x3d, 0x00, 0x60, 0x4b, 0x71, 0x7b, 0x03, 0x02, 0x00, 0x00,
+}
+
+func (this *TokenBucket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenBucket)
+	if !ok {
+		that2, ok := that.(TokenBucket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxTokens != that1.MaxTokens {
+		return false
+	}
+	if !this.TokensPerFill.Equal(that1.TokensPerFill) {
+		return false
+	}
+	if this.FillInterval != nil && that1.FillInterval != nil {
+		if *this.FillInterval != *that1.FillInterval {
+			return false
+		}
+	} else if this.FillInterval != nil {
+		return false
+	} else if that1.FillInterval != nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -11,7 +11,8 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
-	_ "github.com/gogo/protobuf/types"
+	types "github.com/gogo/protobuf/types"
+	matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 )
 
@@ -36,10 +37,21 @@ type RetryPolicy struct {
 	// defaults to 1. These are the same conditions [documented for Envoy](https://www.envoyproxy.io/docs/envoy/v1.14.1/configuration/http/http_filters/router_filter#config-http-filters-router-x-envoy-retry-on)
 	NumRetries uint32 `protobuf:"varint,2,opt,name=num_retries,json=numRetries,proto3" json:"num_retries,omitempty"`
 	// Specifies a non-zero upstream timeout per retry attempt. This parameter is optional.
-	PerTryTimeout        *time.Duration `protobuf:"bytes,3,opt,name=per_try_timeout,json=perTryTimeout,proto3,stdduration" json:"per_try_timeout,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	PerTryTimeout *time.Duration `protobuf:"bytes,3,opt,name=per_try_timeout,json=perTryTimeout,proto3,stdduration" json:"per_try_timeout,omitempty"`
+	// HTTP status codes that should trigger a retry. These are only retried if `retry_on` contains
+	// `retriable-status-codes`.
+	RetriableStatusCodes []uint32 `protobuf:"varint,4,rep,packed,name=retriable_status_codes,json=retriableStatusCodes,proto3" json:"retriable_status_codes,omitempty"`
+	// Headers of upstream responses that should trigger a retry. These are only retried if `retry_on` contains
+	// `retriable-headers`.
+	RetriableHeaders []*matchers.HeaderMatcher `protobuf:"bytes,5,rep,name=retriable_headers,json=retriableHeaders,proto3" json:"retriable_headers,omitempty"`
+	// Headers which requests must match to be retried. If not set, any request can be retried.
+	RetriableRequestHeaders []*matchers.HeaderMatcher `protobuf:"bytes,6,rep,name=retriable_request_headers,json=retriableRequestHeaders,proto3" json:"retriable_request_headers,omitempty"`
+	// Specifies the exponential back-off between retries. If not set, Envoy's default back-off is used, which has a base
+	// interval of 25ms.
+	RetryBackOff         *RetryBackOff `protobuf:"bytes,7,opt,name=retry_back_off,json=retryBackOff,proto3" json:"retry_back_off,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *RetryPolicy) Reset()         { *m = RetryPolicy{} }
@@ -87,8 +99,151 @@ func (m *RetryPolicy) GetPerTryTimeout() *time.Duration {
 	return nil
 }
 
+func (m *RetryPolicy) GetRetriableStatusCodes() []uint32 {
+	if m != nil {
+		return m.RetriableStatusCodes
+	}
+	return nil
+}
+
+func (m *RetryPolicy) GetRetriableHeaders() []*matchers.HeaderMatcher {
+	if m != nil {
+		return m.RetriableHeaders
+	}
+	return nil
+}
+
+func (m *RetryPolicy) GetRetriableRequestHeaders() []*matchers.HeaderMatcher {
+	if m != nil {
+		return m.RetriableRequestHeaders
+	}
+	return nil
+}
+
+func (m *RetryPolicy) GetRetryBackOff() *RetryBackOff {
+	if m != nil {
+		return m.RetryBackOff
+	}
+	return nil
+}
+
+// The exponential back-off between retries. The back-off before each retry is chosen randomly between 0 and
+// (2^N - 1) times the base interval, where N is the number of the retry, capped at the max interval.
+type RetryBackOff struct {
+	// The base interval of the back-off. Required, and must be greater than 0.
+	BaseInterval *time.Duration `protobuf:"bytes,1,opt,name=base_interval,json=baseInterval,proto3,stdduration" json:"base_interval,omitempty"`
+	// The maximum interval between retries. Must be greater than or equal to the base interval.
+	// Defaults to 10 times the base interval.
+	MaxInterval          *time.Duration `protobuf:"bytes,2,opt,name=max_interval,json=maxInterval,proto3,stdduration" json:"max_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RetryBackOff) Reset()         { *m = RetryBackOff{} }
+func (m *RetryBackOff) String() string { return proto.CompactTextString(m) }
+func (*RetryBackOff) ProtoMessage()    {}
+func (*RetryBackOff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c06018876f3ed3e, []int{1}
+}
+func (m *RetryBackOff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetryBackOff.Unmarshal(m, b)
+}
+func (m *RetryBackOff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetryBackOff.Marshal(b, m, deterministic)
+}
+func (m *RetryBackOff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryBackOff.Merge(m, src)
+}
+func (m *RetryBackOff) XXX_Size() int {
+	return xxx_messageInfo_RetryBackOff.Size(m)
+}
+func (m *RetryBackOff) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryBackOff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryBackOff proto.InternalMessageInfo
+
+func (m *RetryBackOff) GetBaseInterval() *time.Duration {
+	if m != nil {
+		return m.BaseInterval
+	}
+	return nil
+}
+
+func (m *RetryBackOff) GetMaxInterval() *time.Duration {
+	if m != nil {
+		return m.MaxInterval
+	}
+	return nil
+}
+
+// Hedging policy applied at the Route level. Hedged requests are sent to several upstream hosts, and the first response
+// is returned downstream. This reduces the latency of requests to slow upstreams, at the cost of additional load.
+// See here for additional information on Envoy's hedging: https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/http/http_routing#request-hedging
+type HedgePolicy struct {
+	// The number of requests sent upstream initially. Defaults to 1, i.e. no hedging.
+	InitialRequests *types.UInt32Value `protobuf:"bytes,1,opt,name=initial_requests,json=initialRequests,proto3" json:"initial_requests,omitempty"`
+	// The chance that an additional request is sent upstream, in addition to the initial ones.
+	// This should be a value between 0.0 and 100.0, with up to 6 significant digits. Defaults to 0.
+	AdditionalRequestChance float32 `protobuf:"fixed32,2,opt,name=additional_request_chance,json=additionalRequestChance,proto3" json:"additional_request_chance,omitempty"`
+	// If set, a retry is sent when the per try timeout of the retry policy expires, without canceling the
+	// requests which are still in flight. The first response of any of them is returned downstream.
+	HedgeOnPerTryTimeout bool     `protobuf:"varint,3,opt,name=hedge_on_per_try_timeout,json=hedgeOnPerTryTimeout,proto3" json:"hedge_on_per_try_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HedgePolicy) Reset()         { *m = HedgePolicy{} }
+func (m *HedgePolicy) String() string { return proto.CompactTextString(m) }
+func (*HedgePolicy) ProtoMessage()    {}
+func (*HedgePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c06018876f3ed3e, []int{2}
+}
+func (m *HedgePolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HedgePolicy.Unmarshal(m, b)
+}
+func (m *HedgePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HedgePolicy.Marshal(b, m, deterministic)
+}
+func (m *HedgePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HedgePolicy.Merge(m, src)
+}
+func (m *HedgePolicy) XXX_Size() int {
+	return xxx_messageInfo_HedgePolicy.Size(m)
+}
+func (m *HedgePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_HedgePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HedgePolicy proto.InternalMessageInfo
+
+func (m *HedgePolicy) GetInitialRequests() *types.UInt32Value {
+	if m != nil {
+		return m.InitialRequests
+	}
+	return nil
+}
+
+func (m *HedgePolicy) GetAdditionalRequestChance() float32 {
+	if m != nil {
+		return m.AdditionalRequestChance
+	}
+	return 0
+}
+
+func (m *HedgePolicy) GetHedgeOnPerTryTimeout() bool {
+	if m != nil {
+		return m.HedgeOnPerTryTimeout
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*RetryPolicy)(nil), "retries.options.gloo.solo.io.RetryPolicy")
+	proto.RegisterType((*RetryBackOff)(nil), "retries.options.gloo.solo.io.RetryBackOff")
+	proto.RegisterType((*HedgePolicy)(nil), "retries.options.gloo.solo.io.HedgePolicy")
 }
 
 func init() {
@@ -96,25 +251,44 @@ func init() {
 }
 
 var fileDescriptor_3c06018876f3ed3e = []byte{
-	// 288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xcf, 0x4a, 0xf4, 0x30,
-	0x14, 0xc5, 0xc9, 0xf7, 0x0d, 0xfe, 0x49, 0x19, 0x84, 0xe2, 0xa2, 0x33, 0xc8, 0x4c, 0x71, 0xd5,
-	0x8d, 0x09, 0xea, 0x0b, 0xc8, 0x20, 0x08, 0xb3, 0x51, 0xca, 0xac, 0xdc, 0x94, 0x69, 0x8d, 0x31,
-	0xda, 0xe6, 0x86, 0xf4, 0x46, 0xa6, 0xaf, 0xe1, 0xca, 0x47, 0xf0, 0x11, 0x7c, 0x1b, 0xc1, 0x77,
-	0x70, 0x2f, 0x69, 0x3a, 0xee, 0x04, 0x57, 0xb9, 0xe7, 0x70, 0x4e, 0xee, 0x8f, 0x4b, 0x97, 0x52,
-	0xe1, 0x83, 0x2b, 0x59, 0x05, 0x0d, 0x6f, 0xa1, 0x86, 0x13, 0x05, 0x5c, 0xd6, 0x00, 0xdc, 0x58,
-	0x78, 0x14, 0x15, 0xb6, 0x41, 0xad, 0x8d, 0xe2, 0xcf, 0xa7, 0x1c, 0x0c, 0x2a, 0xd0, 0x2d, 0xb7,
-	0x02, 0xad, 0x12, 0x3f, 0x2f, 0x33, 0x16, 0x10, 0xe2, 0xa3, 0xad, 0x1c, 0x62, 0xcc, 0x57, 0x99,
-	0xff, 0x95, 0x29, 0x98, 0xce, 0x24, 0x80, 0xac, 0x05, 0xef, 0xb3, 0xa5, 0xbb, 0xe7, 0x77, 0xce,
-	0xae, 0x7d, 0x2e, 0xb4, 0xa7, 0x87, 0x12, 0x24, 0xf4, 0x23, 0xf7, 0xd3, 0xe0, 0xc6, 0x62, 0x83,
-	0xc1, 0x14, 0x1b, 0x0c, 0xde, 0xf1, 0x0b, 0xa1, 0x51, 0x2e, 0xd0, 0x76, 0x37, 0x50, 0xab, 0xaa,
-	0x8b, 0x27, 0x74, 0xcf, 0x6f, 0xee, 0x0a, 0xd0, 0x09, 0x49, 0x49, 0xb6, 0x9f, 0xef, 0xf6, 0xfa,
-	0x5a, 0xc7, 0x73, 0x1a, 0x69, 0xd7, 0x14, 0x03, 0x58, 0xf2, 0x2f, 0x25, 0xd9, 0x38, 0xa7, 0xda,
-	0x35, 0x79, 0x70, 0xe2, 0x2b, 0x7a, 0x60, 0x84, 0x2d, 0x7c, 0x1b, 0x55, 0x23, 0xc0, 0x61, 0xf2,
-	0x3f, 0x25, 0x59, 0x74, 0x36, 0x61, 0x81, 0x97, 0x6d, 0x79, 0xd9, 0xe5, 0xc0, 0xbb, 0x18, 0xbd,
-	0x7e, 0xcc, 0x49, 0x3e, 0x36, 0xc2, 0xae, 0x6c, 0xb7, 0x0a, 0xad, 0xc5, 0xf2, 0xfd, 0x6b, 0x44,
-	0xde, 0x3e, 0x67, 0xe4, 0xf6, 0xe2, 0x6f, 0x27, 0x35, 0x4f, 0xf2, 0x97, 0xb3, 0x96, 0x3b, 0xfd,
-	0xce, 0xf3, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x5d, 0xe3, 0x4f, 0x9d, 0x01, 0x00, 0x00,
+	// 582 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xdf, 0x4e, 0xd4, 0x4e,
+	0x14, 0xc7, 0x53, 0x96, 0x1f, 0xf0, 0x9b, 0xee, 0x0a, 0x4e, 0x88, 0x74, 0x09, 0x81, 0x0d, 0x57,
+	0xab, 0x89, 0xd3, 0x08, 0x86, 0x0b, 0xaf, 0xcc, 0x42, 0xc2, 0x9f, 0xc4, 0x40, 0x46, 0xe4, 0xc2,
+	0x9b, 0x66, 0xda, 0x9e, 0xed, 0x8e, 0xb4, 0x9d, 0x3a, 0x33, 0xc5, 0xdd, 0x37, 0xe1, 0x11, 0x7c,
+	0x04, 0x9f, 0xc1, 0x37, 0xf0, 0xca, 0xc4, 0x77, 0xf0, 0xde, 0x74, 0x3a, 0xdb, 0xc5, 0x20, 0x66,
+	0xbd, 0xea, 0x9c, 0x3f, 0xdf, 0xcf, 0x9c, 0x73, 0xe6, 0xa4, 0xe8, 0x2c, 0xe1, 0x7a, 0x54, 0x86,
+	0x24, 0x12, 0x99, 0xaf, 0x44, 0x2a, 0x9e, 0x73, 0xe1, 0x27, 0xa9, 0x10, 0x7e, 0x21, 0xc5, 0x07,
+	0x88, 0xb4, 0xaa, 0x2d, 0x56, 0x70, 0xff, 0xe6, 0x85, 0x2f, 0x0a, 0xcd, 0x45, 0xae, 0x7c, 0x09,
+	0x5a, 0x72, 0x68, 0xbe, 0xa4, 0x90, 0x42, 0x0b, 0xbc, 0x35, 0x35, 0x6d, 0x1a, 0xa9, 0xa4, 0xa4,
+	0xa2, 0x12, 0x2e, 0x36, 0xb7, 0x13, 0x21, 0x92, 0x14, 0x7c, 0x93, 0x1b, 0x96, 0x43, 0x3f, 0x2e,
+	0x25, 0xab, 0xf2, 0x6a, 0xf5, 0xfd, 0xf8, 0x27, 0xc9, 0x8a, 0x02, 0xa4, 0xa5, 0x6f, 0xae, 0x27,
+	0x22, 0x11, 0xe6, 0xe8, 0x57, 0x27, 0xeb, 0x3d, 0x78, 0xb8, 0xd8, 0x48, 0x48, 0xf0, 0x33, 0xa6,
+	0xa3, 0x11, 0x48, 0xd5, 0x1c, 0xac, 0x0e, 0xc3, 0x58, 0xd7, 0x30, 0x18, 0xeb, 0xda, 0xb7, 0xfb,
+	0xad, 0x85, 0x5c, 0x0a, 0x5a, 0x4e, 0x2e, 0x44, 0xca, 0xa3, 0x09, 0xee, 0xa2, 0x95, 0xaa, 0xa3,
+	0x49, 0x20, 0x72, 0xcf, 0xe9, 0x39, 0xfd, 0xff, 0xe9, 0xb2, 0xb1, 0xcf, 0x73, 0xbc, 0x83, 0xdc,
+	0xbc, 0xcc, 0x02, 0xdb, 0xb0, 0xb7, 0xd0, 0x73, 0xfa, 0x1d, 0x8a, 0xf2, 0x32, 0xa3, 0xb5, 0x07,
+	0x1f, 0xa3, 0xd5, 0x02, 0x64, 0x50, 0xa9, 0x35, 0xcf, 0x40, 0x94, 0xda, 0x6b, 0xf5, 0x9c, 0xbe,
+	0xbb, 0xd7, 0x25, 0x75, 0x9f, 0x64, 0xda, 0x27, 0x39, 0xb2, 0x73, 0x18, 0x2c, 0xde, 0x7e, 0xdf,
+	0x71, 0x68, 0xa7, 0x00, 0x79, 0x29, 0x27, 0x97, 0xb5, 0x0a, 0xbf, 0x44, 0x4f, 0xcc, 0x2d, 0x2c,
+	0x4c, 0x21, 0x50, 0x9a, 0xe9, 0x52, 0x05, 0x91, 0x88, 0x41, 0x79, 0x8b, 0xbd, 0x56, 0xbf, 0x43,
+	0xd7, 0x9b, 0xe8, 0x5b, 0x13, 0x3c, 0xac, 0x62, 0xf8, 0x0a, 0x3d, 0x9e, 0xa9, 0x46, 0xc0, 0x62,
+	0x90, 0xca, 0xfb, 0xaf, 0xd7, 0xea, 0xbb, 0x7b, 0x4f, 0x49, 0x33, 0x8a, 0x6a, 0x42, 0xbf, 0x3d,
+	0x12, 0x39, 0x31, 0xa9, 0x6f, 0xea, 0x04, 0xba, 0xd6, 0x30, 0x6a, 0xbf, 0xc2, 0x80, 0xba, 0x33,
+	0xae, 0x84, 0x8f, 0x25, 0x28, 0xdd, 0xf0, 0x97, 0xfe, 0x95, 0xbf, 0xd1, 0xb0, 0x68, 0x8d, 0x9a,
+	0x5e, 0x73, 0x81, 0x1e, 0xd5, 0x93, 0x0f, 0x59, 0x74, 0x1d, 0x88, 0xe1, 0xd0, 0x5b, 0x36, 0xc3,
+	0x7b, 0x46, 0xfe, 0xb6, 0x62, 0xc4, 0x3c, 0xde, 0x80, 0x45, 0xd7, 0xe7, 0xc3, 0x21, 0x6d, 0xcb,
+	0x3b, 0xd6, 0xee, 0xad, 0x83, 0xda, 0x77, 0xc3, 0xf8, 0x08, 0x75, 0x42, 0xa6, 0x20, 0xe0, 0xb9,
+	0x06, 0x79, 0xc3, 0x52, 0xcf, 0x99, 0xef, 0x79, 0xda, 0x95, 0xea, 0xd4, 0x8a, 0xf0, 0x00, 0xb5,
+	0x33, 0x36, 0x9e, 0x41, 0x16, 0xe6, 0x83, 0xb8, 0x19, 0x1b, 0x4f, 0x19, 0xbb, 0x5f, 0x1d, 0xe4,
+	0x9e, 0x40, 0x9c, 0x80, 0x5d, 0xbb, 0x63, 0xb4, 0xc6, 0x73, 0xae, 0x39, 0x4b, 0xa7, 0x13, 0x56,
+	0xb6, 0xb8, 0xad, 0x7b, 0xdc, 0x77, 0xa7, 0xb9, 0xde, 0xdf, 0xbb, 0x62, 0x69, 0x09, 0x74, 0xd5,
+	0xaa, 0xec, 0x2c, 0x15, 0x7e, 0x85, 0xba, 0x2c, 0x8e, 0x79, 0x75, 0xef, 0x8c, 0x15, 0x44, 0x23,
+	0x96, 0x47, 0x60, 0x2a, 0x5d, 0xa0, 0x1b, 0xb3, 0x04, 0x2b, 0x3b, 0x34, 0x61, 0x7c, 0x80, 0xbc,
+	0x51, 0x55, 0x53, 0x20, 0xf2, 0xe0, 0x4f, 0x8b, 0xbc, 0x42, 0xd7, 0x4d, 0xfc, 0x3c, 0xbf, 0xb8,
+	0xbb, 0xae, 0x83, 0xb3, 0x2f, 0x3f, 0x17, 0x9d, 0xcf, 0x3f, 0xb6, 0x9d, 0xf7, 0xaf, 0xe7, 0xfb,
+	0xb3, 0x14, 0xd7, 0xc9, 0x03, 0x7f, 0x97, 0x70, 0xc9, 0xb4, 0xb9, 0xff, 0x6b, 0x00, 0x8c, 0x13,
+	0x73, 0x21, 0xa4, 0x04, 0x00, 0x00,
 }
 
 func (this *RetryPolicy) Equal(that interface{}) bool {
@@ -151,6 +325,108 @@ func (this *RetryPolicy) Equal(that interface{}) bool {
 	} else if that1.PerTryTimeout != nil {
 		return false
 	}
+	if len(this.RetriableStatusCodes) != len(that1.RetriableStatusCodes) {
+		return false
+	}
+	for i := range this.RetriableStatusCodes {
+		if this.RetriableStatusCodes[i] != that1.RetriableStatusCodes[i] {
+			return false
+		}
+	}
+	if len(this.RetriableHeaders) != len(that1.RetriableHeaders) {
+		return false
+	}
+	for i := range this.RetriableHeaders {
+		if !this.RetriableHeaders[i].Equal(that1.RetriableHeaders[i]) {
+			return false
+		}
+	}
+	if len(this.RetriableRequestHeaders) != len(that1.RetriableRequestHeaders) {
+		return false
+	}
+	for i := range this.RetriableRequestHeaders {
+		if !this.RetriableRequestHeaders[i].Equal(that1.RetriableRequestHeaders[i]) {
+			return false
+		}
+	}
+	if !this.RetryBackOff.Equal(that1.RetryBackOff) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *RetryBackOff) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RetryBackOff)
+	if !ok {
+		that2, ok := that.(RetryBackOff)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BaseInterval != nil && that1.BaseInterval != nil {
+		if *this.BaseInterval != *that1.BaseInterval {
+			return false
+		}
+	} else if this.BaseInterval != nil {
+		return false
+	} else if that1.BaseInterval != nil {
+		return false
+	}
+	if this.MaxInterval != nil && that1.MaxInterval != nil {
+		if *this.MaxInterval != *that1.MaxInterval {
+			return false
+		}
+	} else if this.MaxInterval != nil {
+		return false
+	} else if that1.MaxInterval != nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *HedgePolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HedgePolicy)
+	if !ok {
+		that2, ok := that.(HedgePolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.InitialRequests.Equal(that1.InitialRequests) {
+		return false
+	}
+	if this.AdditionalRequestChance != that1.AdditionalRequestChance {
+		return false
+	}
+	if this.HedgeOnPerTryTimeout != that1.HedgeOnPerTryTimeout {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -10,6 +10,7 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	graphql "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/graphql"
 	grpc "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
 	rest "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/rest"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
@@ -37,6 +38,7 @@ type ServiceSpec struct {
 	// Types that are valid to be assigned to PluginType:
 	//	*ServiceSpec_Rest
 	//	*ServiceSpec_Grpc
+	//	*ServiceSpec_Graphql
 	PluginType           isServiceSpec_PluginType `protobuf_oneof:"plugin_type"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
@@ -78,9 +80,13 @@ type ServiceSpec_Rest struct {
 type ServiceSpec_Grpc struct {
 	Grpc *grpc.ServiceSpec `protobuf:"bytes,2,opt,name=grpc,proto3,oneof" json:"grpc,omitempty"`
 }
+type ServiceSpec_Graphql struct {
+	Graphql *graphql.ServiceSpec `protobuf:"bytes,3,opt,name=graphql,proto3,oneof" json:"graphql,omitempty"`
+}
 
-func (*ServiceSpec_Rest) isServiceSpec_PluginType() {}
-func (*ServiceSpec_Grpc) isServiceSpec_PluginType() {}
+func (*ServiceSpec_Rest) isServiceSpec_PluginType()    {}
+func (*ServiceSpec_Grpc) isServiceSpec_PluginType()    {}
+func (*ServiceSpec_Graphql) isServiceSpec_PluginType() {}
 
 func (m *ServiceSpec) GetPluginType() isServiceSpec_PluginType {
 	if m != nil {
@@ -103,11 +109,19 @@ func (m *ServiceSpec) GetGrpc() *grpc.ServiceSpec {
 	return nil
 }
 
+func (m *ServiceSpec) GetGraphql() *graphql.ServiceSpec {
+	if x, ok := m.GetPluginType().(*ServiceSpec_Graphql); ok {
+		return x.Graphql
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ServiceSpec) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ServiceSpec_Rest)(nil),
 		(*ServiceSpec_Grpc)(nil),
+		(*ServiceSpec_Graphql)(nil),
 	}
 }
 
@@ -120,23 +134,25 @@ func init() {
 }
 
 var fileDescriptor_b6a57914a6750dcd = []byte{
-	// 245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x72, 0x4f, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x2f, 0xce, 0xcf, 0xc9, 0xd7, 0xcd, 0xcc, 0xd7, 0x4f,
-	0xcf, 0xc9, 0xcf, 0xd7, 0x2f, 0x28, 0xca, 0xcf, 0x4a, 0x4d, 0x2e, 0x29, 0x86, 0xf0, 0x12, 0x0b,
-	0x32, 0xf5, 0xcb, 0x0c, 0xf5, 0xf3, 0x0b, 0x4a, 0x32, 0xf3, 0xf3, 0x8a, 0xf5, 0x8b, 0x53, 0x8b,
-	0xca, 0x32, 0x93, 0x53, 0xe3, 0x8b, 0x0b, 0x52, 0x93, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85,
-	0x44, 0xa0, 0x72, 0x7a, 0x20, 0xf5, 0x7a, 0x20, 0xa3, 0xf4, 0x32, 0xf3, 0xa5, 0x44, 0xd2, 0xf3,
-	0xd3, 0xf3, 0xc1, 0x0a, 0xf4, 0x41, 0x2c, 0x88, 0x5a, 0x29, 0x43, 0xc2, 0x36, 0x14, 0xa5, 0x16,
-	0x97, 0x80, 0x09, 0xe2, 0xb5, 0xa4, 0x17, 0x15, 0x24, 0x83, 0x09, 0xa8, 0x16, 0xa1, 0xd4, 0x8a,
-	0x12, 0x88, 0xd5, 0xa9, 0x15, 0x50, 0x63, 0x94, 0x66, 0x30, 0x72, 0x71, 0x07, 0x43, 0x1c, 0x1f,
-	0x5c, 0x90, 0x9a, 0x2c, 0x64, 0xc3, 0xc5, 0x02, 0xb2, 0x44, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb,
-	0x48, 0x4d, 0x0f, 0x6c, 0x23, 0x36, 0x9f, 0xe8, 0x21, 0xe9, 0xf2, 0x60, 0x08, 0x02, 0xeb, 0x02,
-	0xe9, 0x06, 0xd9, 0x27, 0xc1, 0x04, 0xd5, 0x0d, 0xb6, 0x9c, 0x18, 0xdd, 0x20, 0x85, 0x4e, 0xbc,
-	0x5c, 0xdc, 0x05, 0x39, 0xa5, 0xe9, 0x99, 0x79, 0xf1, 0x25, 0x95, 0x05, 0xa9, 0x4e, 0x4e, 0x3b,
-	0xbe, 0xb2, 0x30, 0xae, 0x78, 0x24, 0xc7, 0x18, 0x65, 0x41, 0x5c, 0x9c, 0x14, 0x64, 0xa7, 0xa3,
-	0x05, 0x41, 0x12, 0x1b, 0xd8, 0x97, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc9, 0xf7, 0x52,
-	0xbd, 0xd6, 0x01, 0x00, 0x00,
+	// 274 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0x4a, 0x03, 0x41,
+	0x10, 0xc6, 0x3d, 0x0d, 0x0a, 0x7b, 0xd8, 0x1c, 0x29, 0x8e, 0x14, 0x22, 0x16, 0xa2, 0x85, 0xbb,
+	0x44, 0x0b, 0x2d, 0xac, 0x0e, 0x44, 0x6b, 0xd3, 0xd9, 0x84, 0x64, 0x19, 0x36, 0xab, 0x67, 0x66,
+	0xdc, 0xdd, 0x84, 0xf8, 0x46, 0x3e, 0x82, 0x6f, 0x23, 0xf8, 0x0e, 0xf6, 0x32, 0x7b, 0x7b, 0x20,
+	0x22, 0xb8, 0xcd, 0xdc, 0x0c, 0xf7, 0xfd, 0xe6, 0xcf, 0xc7, 0x8a, 0x5b, 0x63, 0xc3, 0x62, 0x35,
+	0x97, 0x1a, 0x9f, 0x95, 0xc7, 0x16, 0xcf, 0x2c, 0x2a, 0xd3, 0x22, 0x2a, 0x72, 0xf8, 0x08, 0x3a,
+	0xf8, 0xae, 0x9a, 0x91, 0x55, 0xeb, 0xb1, 0x42, 0x0a, 0x16, 0x97, 0x5e, 0x79, 0x70, 0x6b, 0xab,
+	0x61, 0xea, 0x09, 0xb4, 0x24, 0x87, 0x01, 0xab, 0x61, 0xfa, 0x27, 0x59, 0x2f, 0xb9, 0x95, 0xb4,
+	0x38, 0x1a, 0x1a, 0x34, 0x18, 0x05, 0x8a, 0xb3, 0x4e, 0x3b, 0x1a, 0xff, 0x3f, 0xc1, 0x81, 0x0f,
+	0x31, 0xe4, 0x23, 0xc6, 0x91, 0x8e, 0x21, 0x21, 0x97, 0x39, 0xc8, 0x8c, 0x16, 0x2f, 0x6d, 0xff,
+	0x4d, 0x60, 0x05, 0x9b, 0x10, 0x33, 0x05, 0x9b, 0x34, 0xff, 0xe8, 0xa3, 0x10, 0xe5, 0xa4, 0xbb,
+	0x7a, 0x42, 0xa0, 0xab, 0x6b, 0x31, 0xe0, 0xed, 0xea, 0xe2, 0xb0, 0x38, 0x29, 0xcf, 0x8f, 0x25,
+	0x17, 0xf2, 0x2f, 0x0b, 0xe4, 0x0f, 0xea, 0x6e, 0xeb, 0x3e, 0x52, 0x4c, 0xf3, 0xa2, 0xf5, 0x76,
+	0xa2, 0xb9, 0xc8, 0xa2, 0x59, 0x58, 0xdd, 0x88, 0xbd, 0xb4, 0x70, 0xbd, 0x13, 0x1b, 0x9c, 0xca,
+	0xfe, 0x80, 0x8c, 0x1e, 0x3d, 0xdb, 0xec, 0x8b, 0x92, 0xda, 0x95, 0xb1, 0xcb, 0x69, 0x78, 0x25,
+	0x68, 0x9a, 0xf7, 0xaf, 0x41, 0xf1, 0xf6, 0x79, 0x50, 0x3c, 0x5c, 0xe5, 0xbd, 0x09, 0x7a, 0x32,
+	0xbf, 0xfc, 0x9c, 0xef, 0x46, 0xb3, 0x2e, 0xbe, 0x07, 0x00, 0x7a, 0x08, 0x9e, 0x4e, 0x56, 0x02,
+	0x00, 0x00,
 }
 
 func (this *ServiceSpec) Equal(that interface{}) bool {
@@ -220,3 +236,27 @@ func (this *ServiceSpec_Grpc) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ServiceSpec_Graphql) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceSpec_Graphql)
+	if !ok {
+		that2, ok := that.(ServiceSpec_Graphql)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Graphql.Equal(that1.Graphql) {
+		return false
+	}
+	return true
+}
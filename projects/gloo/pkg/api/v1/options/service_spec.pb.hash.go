@@ -72,6 +72,22 @@ func (m *ServiceSpec) Hash(hasher hash.Hash64) (uint64, error) {
 			}
 		}
 
+	case *ServiceSpec_Graphql:
+
+		if h, ok := interface{}(m.GetGraphql()).(safe_hasher.SafeHasher); ok {
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if val, err := hashstructure.Hash(m.GetGraphql(), nil); err != nil {
+				return 0, err
+			} else {
+				if err := binary.Write(hasher, binary.LittleEndian, val); err != nil {
+					return 0, err
+				}
+			}
+		}
+
 	}
 
 	return hasher.Sum64(), nil
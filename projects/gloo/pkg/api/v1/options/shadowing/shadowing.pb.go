@@ -32,13 +32,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Note that this plugin is only applicable to routes with upstream destinations (not redirect or direct response routes).
 // See here for additional information on Envoy's shadowing capabilities: https://www.envoyproxy.io/docs/envoy/latest/api-v2/api/v2/route/route.proto#envoy-api-msg-route-routeaction-requestmirrorpolicy
 type RouteShadowing struct {
-	// The upstream to which the shadowed traffic should be sent.
+	// The upstream to which the shadowed traffic should be sent. Optional if `mirrors` are set.
 	Upstream *core.ResourceRef `protobuf:"bytes,1,opt,name=upstream,proto3" json:"upstream,omitempty"`
 	// This should be a value between 0.0 and 100.0, with up to 6 significant digits.
-	Percentage           float32  `protobuf:"fixed32,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Percentage float32 `protobuf:"fixed32,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// Additional upstreams to which the traffic should be shadowed, each with its own percentage.
+	// The traffic is shadowed to each upstream independently of the others.
+	Mirrors              []*RouteMirror `protobuf:"bytes,3,rep,name=mirrors,proto3" json:"mirrors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *RouteShadowing) Reset()         { *m = RouteShadowing{} }
@@ -79,8 +82,65 @@ func (m *RouteShadowing) GetPercentage() float32 {
 	return 0
 }
 
+func (m *RouteShadowing) GetMirrors() []*RouteMirror {
+	if m != nil {
+		return m.Mirrors
+	}
+	return nil
+}
+
+// An upstream to which a portion of the traffic of a route is shadowed.
+type RouteMirror struct {
+	// The upstream to which the shadowed traffic should be sent.
+	Upstream *core.ResourceRef `protobuf:"bytes,1,opt,name=upstream,proto3" json:"upstream,omitempty"`
+	// This should be a value between 0.0 and 100.0, with up to 6 significant digits.
+	Percentage           float32  `protobuf:"fixed32,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RouteMirror) Reset()         { *m = RouteMirror{} }
+func (m *RouteMirror) String() string { return proto.CompactTextString(m) }
+func (*RouteMirror) ProtoMessage()    {}
+func (*RouteMirror) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74006d9a50a86d32, []int{1}
+}
+func (m *RouteMirror) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RouteMirror.Unmarshal(m, b)
+}
+func (m *RouteMirror) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RouteMirror.Marshal(b, m, deterministic)
+}
+func (m *RouteMirror) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteMirror.Merge(m, src)
+}
+func (m *RouteMirror) XXX_Size() int {
+	return xxx_messageInfo_RouteMirror.Size(m)
+}
+func (m *RouteMirror) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteMirror.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteMirror proto.InternalMessageInfo
+
+func (m *RouteMirror) GetUpstream() *core.ResourceRef {
+	if m != nil {
+		return m.Upstream
+	}
+	return nil
+}
+
+func (m *RouteMirror) GetPercentage() float32 {
+	if m != nil {
+		return m.Percentage
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*RouteShadowing)(nil), "shadowing.options.gloo.solo.io.RouteShadowing")
+	proto.RegisterType((*RouteMirror)(nil), "shadowing.options.gloo.solo.io.RouteMirror")
 }
 
 func init() {
@@ -88,24 +148,27 @@ func init() {
 }
 
 var fileDescriptor_74006d9a50a86d32 = []byte{
-	// 266 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xbd, 0x4e, 0xc3, 0x30,
-	0x14, 0x85, 0x95, 0x0a, 0x21, 0x14, 0x24, 0x86, 0x88, 0xa1, 0xed, 0x10, 0x55, 0x4c, 0x5d, 0xb0,
-	0x05, 0x88, 0x17, 0xe8, 0x8c, 0x18, 0xc2, 0xc6, 0xe6, 0xb8, 0x37, 0xae, 0x69, 0x9a, 0x63, 0xd9,
-	0xd7, 0xb4, 0x8f, 0xc4, 0x23, 0xf0, 0x3c, 0xbc, 0x03, 0x3b, 0xca, 0x1f, 0x65, 0xa9, 0xc4, 0x76,
-	0xef, 0x39, 0xc7, 0xc7, 0x9f, 0x6e, 0xfa, 0x6c, 0x2c, 0x6f, 0x62, 0x29, 0x34, 0x76, 0x32, 0xa0,
-	0xc6, 0xad, 0x85, 0x34, 0x35, 0x20, 0x9d, 0xc7, 0x1b, 0x69, 0x0e, 0xfd, 0xa6, 0x9c, 0x95, 0xef,
-	0x77, 0x12, 0x8e, 0x2d, 0x9a, 0x20, 0xc3, 0x46, 0xad, 0xb1, 0xb7, 0x8d, 0x39, 0x4e, 0xc2, 0x79,
-	0x30, 0xb2, 0xfc, 0x28, 0x0c, 0x61, 0xd1, 0x16, 0x88, 0xb6, 0x5b, 0x58, 0xcc, 0xaf, 0x0d, 0x0c,
-	0xba, 0xa8, 0x6c, 0xa7, 0xfe, 0xd5, 0x3c, 0x37, 0x80, 0xa9, 0x49, 0x76, 0x5b, 0x19, 0x2b, 0xb9,
-	0xf7, 0xca, 0x39, 0xf2, 0xe1, 0x94, 0xbf, 0x8e, 0x5e, 0xb5, 0xed, 0x83, 0x3f, 0xeb, 0xd0, 0xb7,
-	0x96, 0x47, 0x50, 0x4f, 0xd5, 0x60, 0x65, 0x74, 0xe0, 0xfe, 0x3f, 0x3a, 0x70, 0xaf, 0xdd, 0x98,
-	0xf4, 0xaa, 0x40, 0x64, 0x7a, 0x19, 0x59, 0xb3, 0xc7, 0xf4, 0x22, 0xba, 0xc0, 0x9e, 0xd4, 0x6e,
-	0x9a, 0x2c, 0x92, 0xe5, 0xe5, 0xfd, 0x4c, 0x68, 0x78, 0x1a, 0xb9, 0x45, 0x41, 0x01, 0xd1, 0x6b,
-	0x2a, 0xa8, 0x2a, 0x7e, 0xa3, 0x59, 0x9e, 0xa6, 0x8e, 0xbc, 0xa6, 0x86, 0x95, 0xa1, 0xe9, 0x64,
-	0x91, 0x2c, 0x27, 0xc5, 0x1f, 0x65, 0xf5, 0xf4, 0xf9, 0x7d, 0x96, 0x7c, 0x7c, 0xe5, 0xc9, 0xeb,
-	0xea, 0x7f, 0x77, 0x76, 0x5b, 0x73, 0xf2, 0xd6, 0xe5, 0x79, 0x47, 0xff, 0xf0, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x3a, 0xed, 0x6a, 0xf4, 0xb4, 0x01, 0x00, 0x00,
+	// 305 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0x3f, 0x4e, 0x33, 0x31,
+	0x10, 0xc5, 0xe5, 0xe4, 0xd3, 0x07, 0x72, 0x24, 0x8a, 0x15, 0x45, 0x92, 0x62, 0x15, 0xa5, 0x8a,
+	0x84, 0xb0, 0x45, 0x10, 0x17, 0x88, 0x44, 0x07, 0x14, 0xa6, 0xa3, 0x73, 0x36, 0x13, 0xc7, 0xe4,
+	0xcf, 0x58, 0x63, 0x9b, 0xe4, 0x48, 0x54, 0xd4, 0x9c, 0x87, 0x3b, 0xd0, 0xa3, 0xf5, 0xee, 0x92,
+	0x34, 0x41, 0x34, 0x74, 0x9e, 0x37, 0xf3, 0x9e, 0x7f, 0x1a, 0x0d, 0x7f, 0x30, 0x36, 0x2c, 0xe2,
+	0x54, 0x14, 0xb8, 0x96, 0x1e, 0x57, 0x78, 0x69, 0x51, 0x9a, 0x15, 0xa2, 0x74, 0x84, 0xcf, 0x50,
+	0x04, 0x5f, 0x55, 0xda, 0x59, 0xf9, 0x72, 0x25, 0xd1, 0x05, 0x8b, 0x1b, 0x2f, 0xfd, 0x42, 0xcf,
+	0x70, 0x6b, 0x37, 0x66, 0xff, 0x12, 0x8e, 0x30, 0x60, 0x96, 0xef, 0x85, 0x7a, 0x58, 0x94, 0x01,
+	0xa2, 0xcc, 0x16, 0x16, 0xfb, 0xe7, 0x06, 0x0d, 0xa6, 0x51, 0x59, 0xbe, 0x2a, 0x57, 0x3f, 0x37,
+	0x88, 0x66, 0x05, 0x32, 0x55, 0xd3, 0x38, 0x97, 0x5b, 0xd2, 0xce, 0x01, 0xf9, 0x63, 0xfd, 0x59,
+	0x24, 0x5d, 0xa6, 0xd7, 0xfd, 0x5e, 0x42, 0x5f, 0xda, 0xd0, 0x80, 0x12, 0xcc, 0xeb, 0x56, 0x06,
+	0xbb, 0x50, 0xfd, 0x07, 0xbb, 0x50, 0x69, 0xc3, 0x37, 0xc6, 0xcf, 0x14, 0xc6, 0x00, 0x8f, 0x0d,
+	0x6c, 0x76, 0xc3, 0x4f, 0xa3, 0xf3, 0x81, 0x40, 0xaf, 0xbb, 0x6c, 0xc0, 0x46, 0x9d, 0x71, 0x4f,
+	0x14, 0x48, 0xd0, 0x80, 0x0b, 0x05, 0x1e, 0x23, 0x15, 0xa0, 0x60, 0xae, 0xbe, 0x47, 0xb3, 0x9c,
+	0x73, 0x07, 0x54, 0xc0, 0x26, 0x68, 0x03, 0xdd, 0xd6, 0x80, 0x8d, 0x5a, 0xea, 0x40, 0xc9, 0x6e,
+	0xf9, 0xc9, 0xda, 0x12, 0x21, 0xf9, 0x6e, 0x7b, 0xd0, 0x1e, 0x75, 0xc6, 0x17, 0xe2, 0xe7, 0x05,
+	0x89, 0xc4, 0x75, 0x9f, 0x3c, 0xaa, 0xf1, 0x0e, 0x67, 0xbc, 0x73, 0xa0, 0xff, 0x11, 0xec, 0xe4,
+	0xee, 0xfd, 0xf3, 0x1f, 0x7b, 0xfd, 0xc8, 0xd9, 0xd3, 0xe4, 0x77, 0x57, 0xe1, 0x96, 0xe6, 0xe8,
+	0x65, 0x4c, 0xff, 0xa7, 0x5d, 0x5f, 0x7f, 0x0d, 0x00, 0xb1, 0x07, 0x58, 0xe1, 0x62, 0x02, 0x00,
+	0x00,
 }
 
 func (this *RouteShadowing) Equal(that interface{}) bool {
@@ -133,6 +196,44 @@ func (this *RouteShadowing) Equal(that interface{}) bool {
 	if this.Percentage != that1.Percentage {
 		return false
 	}
+	if len(this.Mirrors) != len(that1.Mirrors) {
+		return false
+	}
+	for i := range this.Mirrors {
+		if !this.Mirrors[i].Equal(that1.Mirrors[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *RouteMirror) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RouteMirror)
+	if !ok {
+		that2, ok := that.(RouteMirror)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Upstream.Equal(that1.Upstream) {
+		return false
+	}
+	if this.Percentage != that1.Percentage {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
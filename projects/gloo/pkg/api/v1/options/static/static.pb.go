@@ -10,7 +10,7 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
-	_ "github.com/gogo/protobuf/types"
+	types "github.com/gogo/protobuf/types"
 	options "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 )
@@ -38,10 +38,17 @@ type UpstreamSpec struct {
 	// Gloo will automatically set this to true for port 443
 	UseTls bool `protobuf:"varint,3,opt,name=use_tls,json=useTls,proto3" json:"use_tls,omitempty"`
 	// An optional Service Spec describing the service listening at this address
-	ServiceSpec          *options.ServiceSpec `protobuf:"bytes,5,opt,name=service_spec,json=serviceSpec,proto3" json:"service_spec,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	ServiceSpec *options.ServiceSpec `protobuf:"bytes,5,opt,name=service_spec,json=serviceSpec,proto3" json:"service_spec,omitempty"`
+	// The nameservers used to resolve the hostnames of the hosts, as IP addresses with an optional port (defaulting
+	// to 53). Envoy queries these nameservers instead of the ones of its host, and so does Gloo when it resolves the
+	// upstream for function discovery.
+	DnsNameservers []string `protobuf:"bytes,6,rep,name=dns_nameservers,json=dnsNameservers,proto3" json:"dns_nameservers,omitempty"`
+	// Subset configuration. The hosts are partitioned into subsets by the values of the given keys in their
+	// `envoy.lb` metadata, so that routes can select some of them.
+	SubsetSpec           *options.SubsetSpec `protobuf:"bytes,7,opt,name=subset_spec,json=subsetSpec,proto3" json:"subset_spec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *UpstreamSpec) Reset()         { *m = UpstreamSpec{} }
@@ -89,6 +96,20 @@ func (m *UpstreamSpec) GetServiceSpec() *options.ServiceSpec {
 	return nil
 }
 
+func (m *UpstreamSpec) GetDnsNameservers() []string {
+	if m != nil {
+		return m.DnsNameservers
+	}
+	return nil
+}
+
+func (m *UpstreamSpec) GetSubsetSpec() *options.SubsetSpec {
+	if m != nil {
+		return m.SubsetSpec
+	}
+	return nil
+}
+
 // Represents a single instance of an upstream
 type Host struct {
 	// Address (hostname or IP)
@@ -96,10 +117,13 @@ type Host struct {
 	// Port the instance is listening on
 	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
 	// (Enterprise Only): Host specific health checking configuration.
-	HealthCheckConfig    *Host_HealthCheckConfig `protobuf:"bytes,3,opt,name=health_check_config,json=healthCheckConfig,proto3" json:"health_check_config,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	HealthCheckConfig *Host_HealthCheckConfig `protobuf:"bytes,3,opt,name=health_check_config,json=healthCheckConfig,proto3" json:"health_check_config,omitempty"`
+	// Metadata of the endpoint of this host in Envoy, by filter name. The keys under `envoy.lb` are used for subset
+	// load balancing, e.g. `envoy.lb: {version: v2}`.
+	Metadata             map[string]*types.Struct `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *Host) Reset()         { *m = Host{} }
@@ -147,6 +171,13 @@ func (m *Host) GetHealthCheckConfig() *Host_HealthCheckConfig {
 	return nil
 }
 
+func (m *Host) GetMetadata() map[string]*types.Struct {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 type Host_HealthCheckConfig struct {
 	// (Enterprise Only): Path to use when health checking this specific host.
 	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
@@ -189,6 +220,7 @@ func (m *Host_HealthCheckConfig) GetPath() string {
 func init() {
 	proto.RegisterType((*UpstreamSpec)(nil), "static.options.gloo.solo.io.UpstreamSpec")
 	proto.RegisterType((*Host)(nil), "static.options.gloo.solo.io.Host")
+	proto.RegisterMapType((map[string]*types.Struct)(nil), "static.options.gloo.solo.io.Host.MetadataEntry")
 	proto.RegisterType((*Host_HealthCheckConfig)(nil), "static.options.gloo.solo.io.Host.HealthCheckConfig")
 }
 
@@ -197,31 +229,39 @@ func init() {
 }
 
 var fileDescriptor_c08b3c87c0f36512 = []byte{
-	// 370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbd, 0x6e, 0xdb, 0x30,
-	0x18, 0x04, 0xeb, 0x9f, 0xb6, 0xb4, 0x3b, 0x98, 0x2d, 0x50, 0xc1, 0x05, 0x0c, 0xd5, 0x4b, 0xb5,
-	0x94, 0x44, 0xed, 0x02, 0x1d, 0x0b, 0xd4, 0x1d, 0xdc, 0x55, 0x6e, 0x97, 0x2e, 0x82, 0x4c, 0xd3,
-	0x14, 0x6b, 0xd9, 0x1f, 0x41, 0x52, 0xae, 0x9f, 0x28, 0xc8, 0x23, 0x64, 0xc8, 0xd3, 0xe4, 0x1d,
-	0xb2, 0x07, 0xa4, 0x14, 0x24, 0x48, 0x8c, 0xc4, 0x93, 0xee, 0x4e, 0x77, 0xdf, 0x77, 0x82, 0x3e,
-	0x3c, 0x97, 0xca, 0x15, 0xd5, 0x92, 0x72, 0xd8, 0x32, 0x0b, 0x25, 0x7c, 0x56, 0xc0, 0x64, 0x09,
-	0xc0, 0xb4, 0x81, 0x7f, 0x82, 0x3b, 0x5b, 0xb3, 0x5c, 0x2b, 0xb6, 0xff, 0xc2, 0x40, 0x3b, 0x05,
-	0x3b, 0xcb, 0xac, 0xcb, 0x9d, 0xe2, 0xcd, 0x83, 0x6a, 0x03, 0x0e, 0xc8, 0x87, 0x86, 0x35, 0x1e,
-	0xea, 0x73, 0xd4, 0x8f, 0xa4, 0x0a, 0x86, 0xef, 0x24, 0x48, 0x08, 0x3e, 0xe6, 0x51, 0x1d, 0x19,
-	0x12, 0x71, 0x70, 0xb5, 0x28, 0x0e, 0xae, 0xd1, 0x46, 0x12, 0x40, 0x96, 0x82, 0x05, 0xb6, 0xac,
-	0xd6, 0xec, 0xbf, 0xc9, 0xb5, 0x16, 0xc6, 0x36, 0xef, 0xbf, 0x9e, 0xd0, 0x4e, 0x98, 0xbd, 0xe2,
-	0x22, 0xb3, 0x5a, 0x34, 0xe5, 0xc6, 0x67, 0x08, 0xf7, 0xff, 0x68, 0xeb, 0x8c, 0xc8, 0xb7, 0x0b,
-	0x2d, 0x38, 0xf9, 0x86, 0x3b, 0x05, 0x58, 0x67, 0x23, 0x14, 0xb7, 0x92, 0xde, 0xe4, 0x23, 0x7d,
-	0xa2, 0x3d, 0x9d, 0x83, 0x75, 0x69, 0xed, 0x27, 0xef, 0xf1, 0xcb, 0xca, 0x8a, 0xcc, 0x95, 0x36,
-	0x6a, 0xc5, 0x28, 0x79, 0x95, 0x76, 0x2b, 0x2b, 0x7e, 0x97, 0x96, 0xfc, 0xc4, 0xfd, 0xfb, 0x8b,
-	0xa3, 0x4e, 0x8c, 0xc2, 0xe0, 0xa3, 0x13, 0x17, 0xb5, 0xd3, 0x57, 0x49, 0x7b, 0xf6, 0x8e, 0x8c,
-	0x2f, 0x11, 0x6e, 0xfb, 0x75, 0x84, 0xe0, 0x76, 0xbe, 0x5a, 0x99, 0x08, 0xc5, 0x28, 0x79, 0x9d,
-	0x06, 0xec, 0x35, 0x0d, 0xc6, 0x45, 0x2f, 0x62, 0x94, 0xbc, 0x49, 0x03, 0x26, 0x1c, 0xbf, 0x2d,
-	0x44, 0x5e, 0xba, 0x22, 0xe3, 0x85, 0xe0, 0x9b, 0x8c, 0xc3, 0x6e, 0xad, 0x64, 0xe8, 0xd6, 0x9b,
-	0x4c, 0x9f, 0xfd, 0x2c, 0x3a, 0x0f, 0xe1, 0x99, 0xcf, 0xce, 0x42, 0x34, 0x1d, 0x14, 0x0f, 0xa5,
-	0xe1, 0x27, 0x3c, 0x78, 0xe4, 0x0b, 0x6d, 0x72, 0x57, 0xdc, 0x36, 0xf4, 0xf8, 0xc7, 0xaf, 0x8b,
-	0xeb, 0x36, 0x3a, 0xbf, 0x1a, 0xa1, 0xbf, 0xdf, 0x4f, 0x3b, 0x2c, 0xbd, 0x91, 0xc7, 0x8f, 0x6b,
-	0xd9, 0x0d, 0x7f, 0x6e, 0x7a, 0x13, 0x00, 0x00, 0xff, 0xff, 0xf9, 0xda, 0x24, 0x90, 0xa2, 0x02,
-	0x00, 0x00,
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x96, 0xf3, 0xd7, 0x76, 0xd3, 0x02, 0x5d, 0x90, 0x6a, 0x05, 0x54, 0x99, 0x5e, 0x9a, 0x4b,
+	0xd7, 0x22, 0x41, 0x02, 0x71, 0x41, 0x50, 0x90, 0x82, 0x10, 0x1c, 0x9c, 0x72, 0xe1, 0x12, 0x6d,
+	0xec, 0xad, 0x6d, 0xe2, 0x78, 0x56, 0x3b, 0xe3, 0xd0, 0xbe, 0x06, 0x4f, 0xc1, 0x23, 0xf0, 0x36,
+	0x48, 0xbc, 0x03, 0x77, 0xe4, 0xb5, 0xd3, 0x86, 0x52, 0xda, 0x9c, 0x3c, 0xf3, 0xed, 0xf7, 0x7d,
+	0xf3, 0xe3, 0x5d, 0x36, 0x8a, 0x53, 0x4a, 0x8a, 0xa9, 0x08, 0x61, 0xee, 0x23, 0x64, 0x70, 0x94,
+	0x82, 0x1f, 0x67, 0x00, 0xbe, 0x36, 0xf0, 0x45, 0x85, 0x84, 0x55, 0x26, 0x75, 0xea, 0x2f, 0x9e,
+	0xf8, 0xa0, 0x29, 0x85, 0x1c, 0x7d, 0x24, 0x49, 0x69, 0x58, 0x7f, 0x84, 0x36, 0x40, 0xc0, 0x1f,
+	0xd6, 0x59, 0xcd, 0x11, 0xa5, 0x4e, 0x94, 0x96, 0x22, 0x85, 0xde, 0x83, 0x18, 0x62, 0xb0, 0x3c,
+	0xbf, 0x8c, 0x2a, 0x49, 0x8f, 0xab, 0x33, 0xaa, 0x40, 0x75, 0x46, 0x35, 0xb6, 0x1f, 0x03, 0xc4,
+	0x99, 0xf2, 0x6d, 0x36, 0x2d, 0x4e, 0xfd, 0xaf, 0x46, 0x6a, 0xad, 0x0c, 0xd6, 0xe7, 0x8f, 0xae,
+	0x9e, 0x23, 0x99, 0x22, 0x5c, 0xaa, 0x9f, 0xae, 0xd1, 0xbb, 0x32, 0x8b, 0x34, 0x54, 0x13, 0xd4,
+	0xaa, 0x6e, 0xbd, 0x37, 0x5c, 0x43, 0x55, 0x4c, 0x51, 0xd1, 0x8a, 0xe8, 0xe0, 0x5b, 0x83, 0x6d,
+	0x7f, 0xd2, 0x48, 0x46, 0xc9, 0xf9, 0x58, 0xab, 0x90, 0x3f, 0x63, 0xed, 0x04, 0x90, 0xd0, 0x75,
+	0xbc, 0x66, 0xbf, 0x3b, 0x78, 0x2c, 0x6e, 0x58, 0x88, 0x18, 0x01, 0x52, 0x50, 0xf1, 0xf9, 0x1e,
+	0xdb, 0x28, 0x50, 0x4d, 0x28, 0x43, 0xb7, 0xe9, 0x39, 0xfd, 0xcd, 0xa0, 0x53, 0xa0, 0x3a, 0xc9,
+	0x90, 0xbf, 0x61, 0xdb, 0xab, 0xdd, 0xba, 0x6d, 0xcf, 0xb1, 0xc6, 0xd7, 0x3a, 0x8e, 0x2b, 0x66,
+	0xd9, 0x4a, 0xd0, 0xc5, 0xcb, 0x84, 0x1f, 0xb2, 0xbb, 0x51, 0x8e, 0x93, 0x5c, 0xce, 0x55, 0x09,
+	0x2b, 0x83, 0x6e, 0xc7, 0x6b, 0xf6, 0xb7, 0x82, 0x3b, 0x51, 0x8e, 0x1f, 0x2f, 0x51, 0xfe, 0x8a,
+	0x75, 0x57, 0xc6, 0x74, 0x37, 0x6c, 0x35, 0xef, 0x3f, 0xd5, 0x2c, 0xd1, 0x16, 0x63, 0x78, 0x11,
+	0x1f, 0xfc, 0x6c, 0xb0, 0x56, 0x39, 0x1a, 0xe7, 0xac, 0x25, 0xa3, 0xc8, 0xb8, 0x8e, 0xe7, 0xf4,
+	0xb7, 0x02, 0x1b, 0x97, 0x98, 0x06, 0x43, 0x6e, 0xc3, 0x73, 0xfa, 0x3b, 0x81, 0x8d, 0x79, 0xc8,
+	0xee, 0x27, 0x4a, 0x66, 0x94, 0x4c, 0xc2, 0x44, 0x85, 0xb3, 0x49, 0x08, 0xf9, 0x69, 0x1a, 0xdb,
+	0x3d, 0x74, 0x07, 0xc3, 0x5b, 0x57, 0x28, 0x46, 0x56, 0x7c, 0x5c, 0x6a, 0x8f, 0xad, 0x34, 0xd8,
+	0x4d, 0xae, 0x42, 0xfc, 0x3d, 0xdb, 0x9c, 0x2b, 0x92, 0x91, 0x24, 0xe9, 0xb6, 0xec, 0xcf, 0xf1,
+	0x6f, 0x77, 0xfe, 0x50, 0x2b, 0xde, 0xe6, 0x64, 0xce, 0x83, 0x0b, 0x83, 0xde, 0x21, 0xdb, 0xfd,
+	0xa7, 0xa8, 0x1d, 0x4d, 0x52, 0xb2, 0x1c, 0xb7, 0x8c, 0x7b, 0x27, 0x6c, 0xe7, 0x2f, 0x0f, 0x7e,
+	0x8f, 0x35, 0x67, 0xea, 0xbc, 0xe6, 0x94, 0x21, 0x3f, 0x62, 0xed, 0x85, 0xcc, 0x0a, 0x65, 0x57,
+	0xd2, 0x1d, 0xec, 0x89, 0xea, 0x72, 0x8b, 0xe5, 0xe5, 0x16, 0x63, 0x7b, 0xb9, 0x83, 0x8a, 0xf5,
+	0xa2, 0xf1, 0xdc, 0x79, 0xfd, 0xee, 0xc7, 0xef, 0x96, 0xf3, 0xfd, 0xd7, 0xbe, 0xf3, 0xf9, 0xe5,
+	0x7a, 0x4f, 0x57, 0xcf, 0xe2, 0xeb, 0x9f, 0xef, 0xb4, 0x63, 0xcb, 0x0c, 0xff, 0x0c, 0x00, 0x86,
+	0x72, 0xdc, 0x3d, 0x04, 0x04, 0x00, 0x00,
 }
 
 func (this *UpstreamSpec) Equal(that interface{}) bool {
@@ -257,6 +297,17 @@ func (this *UpstreamSpec) Equal(that interface{}) bool {
 	if !this.ServiceSpec.Equal(that1.ServiceSpec) {
 		return false
 	}
+	if len(this.DnsNameservers) != len(that1.DnsNameservers) {
+		return false
+	}
+	for i := range this.DnsNameservers {
+		if this.DnsNameservers[i] != that1.DnsNameservers[i] {
+			return false
+		}
+	}
+	if !this.SubsetSpec.Equal(that1.SubsetSpec) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -290,6 +341,14 @@ func (this *Host) Equal(that interface{}) bool {
 	if !this.HealthCheckConfig.Equal(that1.HealthCheckConfig) {
 		return false
 	}
+	if len(this.Metadata) != len(that1.Metadata) {
+		return false
+	}
+	for i := range this.Metadata {
+		if !this.Metadata[i].Equal(that1.Metadata[i]) {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -30,11 +30,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Contains various settings for Envoy's tcp proxy filter.
 // See here for more information: https://www.envoyproxy.io/docs/envoy/v1.10.0/api-v2/config/filter/network/tcp_proxy/v2/tcp_proxy.proto#envoy-api-msg-config-filter-network-tcp-proxy-v2-tcpproxy
 type TcpProxySettings struct {
-	MaxConnectAttempts   *types.UInt32Value `protobuf:"bytes,1,opt,name=max_connect_attempts,json=maxConnectAttempts,proto3" json:"max_connect_attempts,omitempty"`
-	IdleTimeout          *time.Duration     `protobuf:"bytes,2,opt,name=idle_timeout,json=idleTimeout,proto3,stdduration" json:"idle_timeout,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	// The maximum number of unsuccessful connection attempts to the upstream before the connection is closed.
+	// Defaults to 1.
+	MaxConnectAttempts *types.UInt32Value `protobuf:"bytes,1,opt,name=max_connect_attempts,json=maxConnectAttempts,proto3" json:"max_connect_attempts,omitempty"`
+	// How long the connection can be idle, without bytes sent or received by either side, before it is closed.
+	// Defaults to 1 hour; a zero duration disables the timeout.
+	IdleTimeout          *time.Duration `protobuf:"bytes,2,opt,name=idle_timeout,json=idleTimeout,proto3,stdduration" json:"idle_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *TcpProxySettings) Reset()         { *m = TcpProxySettings{} }
@@ -84,7 +88,7 @@ func init() {
 }
 
 var fileDescriptor_7eab2eea37fe83e7 = []byte{
-	// 297 bytes of a gzipped FileDescriptorProto
+	// 294 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xb1, 0x4a, 0xc4, 0x40,
 	0x10, 0x86, 0x59, 0x39, 0x2c, 0x72, 0x16, 0x12, 0xae, 0x38, 0x0f, 0x39, 0xc5, 0xca, 0xc6, 0x5d,
 	0xbc, 0x6b, 0x6d, 0x8c, 0x22, 0xd8, 0x88, 0x9c, 0xa7, 0x85, 0xcd, 0xb1, 0xd9, 0x1b, 0xd7, 0xd5,
@@ -102,8 +106,8 @@ var fileDescriptor_7eab2eea37fe83e7 = []byte{
 	0x28, 0x06, 0x0f, 0xbb, 0x5c, 0x9a, 0x25, 0x6b, 0x66, 0x59, 0xc0, 0x82, 0x4c, 0x09, 0x58, 0xd3,
 	0x70, 0xa5, 0xe5, 0x6c, 0xfc, 0xe0, 0x1c, 0x77, 0xef, 0xcd, 0x7a, 0xcf, 0xef, 0x5b, 0x6c, 0xd6,
 	0x0f, 0xa1, 0x79, 0xcc, 0x64, 0x27, 0x6f, 0x9f, 0x3d, 0xf6, 0xfa, 0x31, 0x66, 0xd7, 0x07, 0xff,
-	0x3b, 0xbd, 0xbd, 0xd7, 0xbf, 0x9c, 0x3f, 0x5f, 0x6d, 0xdb, 0xa6, 0x5f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x09, 0x13, 0x7f, 0x40, 0xc1, 0x01, 0x00, 0x00,
+	0x3b, 0xbd, 0xbd, 0xd7, 0xbf, 0x9c, 0x3f, 0x5f, 0x6d, 0xdb, 0xa6, 0x5f, 0x03, 0x00, 0x09, 0x13,
+	0x7f, 0x40, 0xc1, 0x01, 0x00, 0x00,
 }
 
 func (this *TcpProxySettings) Equal(that interface{}) bool {
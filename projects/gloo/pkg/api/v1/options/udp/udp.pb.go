@@ -0,0 +1,272 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/udp/udp.proto
+
+package udp
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Contains various settings for Envoy's udp proxy filter.
+// See here for more information: https://www.envoyproxy.io/docs/envoy/v1.16.0/api-v3/extensions/filters/udp/udp_proxy/v3/udp_proxy.proto
+type UdpProxySettings struct {
+	// The idle timeout of the sessions. Each client address gets its own upstream session,
+	// which is closed when no datagrams are sent or received for this long. Defaults to 1 minute.
+	SessionTimeout *time.Duration `protobuf:"bytes,1,opt,name=session_timeout,json=sessionTimeout,proto3,stdduration" json:"session_timeout,omitempty"`
+	// Selects the upstream host of each session for upstreams with a consistent hashing load balancer,
+	// e.g. ring hash or maglev. Without it, the sessions are load balanced like connections.
+	HashPolicy           *UdpProxySettings_HashPolicy `protobuf:"bytes,2,opt,name=hash_policy,json=hashPolicy,proto3" json:"hash_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *UdpProxySettings) Reset()         { *m = UdpProxySettings{} }
+func (m *UdpProxySettings) String() string { return proto.CompactTextString(m) }
+func (*UdpProxySettings) ProtoMessage()    {}
+func (*UdpProxySettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cd68d51776d9c8a, []int{0}
+}
+func (m *UdpProxySettings) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UdpProxySettings.Unmarshal(m, b)
+}
+func (m *UdpProxySettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UdpProxySettings.Marshal(b, m, deterministic)
+}
+func (m *UdpProxySettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UdpProxySettings.Merge(m, src)
+}
+func (m *UdpProxySettings) XXX_Size() int {
+	return xxx_messageInfo_UdpProxySettings.Size(m)
+}
+func (m *UdpProxySettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_UdpProxySettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UdpProxySettings proto.InternalMessageInfo
+
+func (m *UdpProxySettings) GetSessionTimeout() *time.Duration {
+	if m != nil {
+		return m.SessionTimeout
+	}
+	return nil
+}
+
+func (m *UdpProxySettings) GetHashPolicy() *UdpProxySettings_HashPolicy {
+	if m != nil {
+		return m.HashPolicy
+	}
+	return nil
+}
+
+type UdpProxySettings_HashPolicy struct {
+	// Types that are valid to be assigned to PolicySpecifier:
+	//	*UdpProxySettings_HashPolicy_SourceIp
+	PolicySpecifier      isUdpProxySettings_HashPolicy_PolicySpecifier `protobuf_oneof:"policy_specifier"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *UdpProxySettings_HashPolicy) Reset()         { *m = UdpProxySettings_HashPolicy{} }
+func (m *UdpProxySettings_HashPolicy) String() string { return proto.CompactTextString(m) }
+func (*UdpProxySettings_HashPolicy) ProtoMessage()    {}
+func (*UdpProxySettings_HashPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cd68d51776d9c8a, []int{0, 0}
+}
+func (m *UdpProxySettings_HashPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UdpProxySettings_HashPolicy.Unmarshal(m, b)
+}
+func (m *UdpProxySettings_HashPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UdpProxySettings_HashPolicy.Marshal(b, m, deterministic)
+}
+func (m *UdpProxySettings_HashPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UdpProxySettings_HashPolicy.Merge(m, src)
+}
+func (m *UdpProxySettings_HashPolicy) XXX_Size() int {
+	return xxx_messageInfo_UdpProxySettings_HashPolicy.Size(m)
+}
+func (m *UdpProxySettings_HashPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_UdpProxySettings_HashPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UdpProxySettings_HashPolicy proto.InternalMessageInfo
+
+type isUdpProxySettings_HashPolicy_PolicySpecifier interface {
+	isUdpProxySettings_HashPolicy_PolicySpecifier()
+	Equal(interface{}) bool
+}
+
+type UdpProxySettings_HashPolicy_SourceIp struct {
+	SourceIp bool `protobuf:"varint,1,opt,name=source_ip,json=sourceIp,proto3,oneof" json:"source_ip,omitempty"`
+}
+
+func (*UdpProxySettings_HashPolicy_SourceIp) isUdpProxySettings_HashPolicy_PolicySpecifier() {}
+
+func (m *UdpProxySettings_HashPolicy) GetPolicySpecifier() isUdpProxySettings_HashPolicy_PolicySpecifier {
+	if m != nil {
+		return m.PolicySpecifier
+	}
+	return nil
+}
+
+func (m *UdpProxySettings_HashPolicy) GetSourceIp() bool {
+	if x, ok := m.GetPolicySpecifier().(*UdpProxySettings_HashPolicy_SourceIp); ok {
+		return x.SourceIp
+	}
+	return false
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*UdpProxySettings_HashPolicy) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*UdpProxySettings_HashPolicy_SourceIp)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterType((*UdpProxySettings)(nil), "udp.options.gloo.solo.io.UdpProxySettings")
+	proto.RegisterType((*UdpProxySettings_HashPolicy)(nil), "udp.options.gloo.solo.io.UdpProxySettings.HashPolicy")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/options/udp/udp.proto", fileDescriptor_1cd68d51776d9c8a)
+}
+
+var fileDescriptor_1cd68d51776d9c8a = []byte{
+	// 316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xcd, 0x4a, 0x03, 0x31,
+	0x10, 0x76, 0xa5, 0x48, 0x4d, 0x41, 0x4b, 0xf0, 0x50, 0x0b, 0x56, 0xf1, 0xe4, 0xc5, 0x04, 0x15,
+	0x6f, 0x82, 0xb0, 0x88, 0xd4, 0x5b, 0xa9, 0x3f, 0x07, 0x2f, 0xcb, 0x76, 0x9b, 0x66, 0x47, 0xb7,
+	0x9d, 0x90, 0x1f, 0x69, 0xdf, 0xc4, 0x47, 0xf0, 0x11, 0x7c, 0x1b, 0xc1, 0x77, 0xe8, 0x5d, 0x92,
+	0xac, 0x0a, 0xa2, 0xe0, 0x61, 0xe1, 0x9b, 0xf9, 0xbe, 0x6f, 0xbe, 0xd9, 0x0c, 0x49, 0x25, 0xd8,
+	0xd2, 0x8d, 0x58, 0x81, 0x53, 0x6e, 0xb0, 0xc2, 0x43, 0x40, 0x2e, 0x2b, 0x44, 0xae, 0x34, 0x3e,
+	0x88, 0xc2, 0x9a, 0x58, 0xe5, 0x0a, 0xf8, 0xd3, 0x11, 0x47, 0x65, 0x01, 0x67, 0x86, 0xbb, 0xb1,
+	0xf2, 0x1f, 0x53, 0x1a, 0x2d, 0xd2, 0x8e, 0x87, 0x35, 0xc5, 0xbc, 0x9c, 0xf9, 0x49, 0x0c, 0xb0,
+	0xbb, 0x25, 0x51, 0x62, 0x10, 0x71, 0x8f, 0xa2, 0xbe, 0xdb, 0x93, 0x88, 0xb2, 0x12, 0x3c, 0x54,
+	0x23, 0x37, 0xe1, 0x63, 0xa7, 0x73, 0xef, 0xae, 0x79, 0x2a, 0xe6, 0x36, 0x9a, 0xc4, 0xdc, 0xc6,
+	0xde, 0xfe, 0x32, 0x21, 0xed, 0xdb, 0xb1, 0x1a, 0x68, 0x9c, 0x2f, 0xae, 0x85, 0xb5, 0x30, 0x93,
+	0x86, 0xf6, 0xc9, 0xa6, 0x11, 0xc6, 0x00, 0xce, 0x32, 0x0b, 0x53, 0x81, 0xce, 0x76, 0x92, 0xbd,
+	0xe4, 0xa0, 0x75, 0xbc, 0xcd, 0x62, 0x04, 0xfb, 0x8c, 0x60, 0x17, 0x75, 0x44, 0xda, 0x78, 0x7e,
+	0xdb, 0x4d, 0x86, 0x1b, 0xb5, 0xef, 0x26, 0xda, 0xe8, 0x1d, 0x69, 0x95, 0xb9, 0x29, 0x33, 0x85,
+	0x15, 0x14, 0x8b, 0xce, 0x6a, 0x98, 0x72, 0xca, 0xfe, 0xfa, 0x31, 0xf6, 0x73, 0x15, 0xd6, 0xcf,
+	0x4d, 0x39, 0x08, 0xe6, 0x21, 0x29, 0xbf, 0x70, 0xf7, 0x9c, 0x90, 0x6f, 0x86, 0xee, 0x90, 0x75,
+	0x83, 0x4e, 0x17, 0x22, 0x03, 0x15, 0x36, 0x6d, 0xf6, 0x57, 0x86, 0xcd, 0xd8, 0xba, 0x52, 0x29,
+	0x25, 0xed, 0x98, 0x9f, 0x19, 0x25, 0x0a, 0x98, 0x80, 0xd0, 0xe9, 0xe5, 0xeb, 0xb2, 0x91, 0xbc,
+	0xbc, 0xf7, 0x92, 0xfb, 0xb3, 0xff, 0x5d, 0x4a, 0x3d, 0xca, 0x5f, 0xae, 0x35, 0x5a, 0x0b, 0x2f,
+	0x71, 0xf2, 0x31, 0x00, 0x0f, 0xd2, 0xb4, 0x50, 0xf0, 0x01, 0x00, 0x00,
+}
+
+func (this *UdpProxySettings) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UdpProxySettings)
+	if !ok {
+		that2, ok := that.(UdpProxySettings)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SessionTimeout != nil && that1.SessionTimeout != nil {
+		if *this.SessionTimeout != *that1.SessionTimeout {
+			return false
+		}
+	} else if this.SessionTimeout != nil {
+		return false
+	} else if that1.SessionTimeout != nil {
+		return false
+	}
+	if !this.HashPolicy.Equal(that1.HashPolicy) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *UdpProxySettings_HashPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UdpProxySettings_HashPolicy)
+	if !ok {
+		that2, ok := that.(UdpProxySettings_HashPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.PolicySpecifier == nil {
+		if this.PolicySpecifier != nil {
+			return false
+		}
+	} else if this.PolicySpecifier == nil {
+		return false
+	} else if !this.PolicySpecifier.Equal(that1.PolicySpecifier) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *UdpProxySettings_HashPolicy_SourceIp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UdpProxySettings_HashPolicy_SourceIp)
+	if !ok {
+		that2, ok := that.(UdpProxySettings_HashPolicy_SourceIp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SourceIp != that1.SourceIp {
+		return false
+	}
+	return true
+}
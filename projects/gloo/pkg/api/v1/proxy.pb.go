@@ -63,7 +63,7 @@ func (x RedirectAction_RedirectResponseCode) String() string {
 }
 
 func (RedirectAction_RedirectResponseCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{14, 0}
+	return fileDescriptor_c6a47f72e9923590, []int{19, 0}
 }
 
 //
@@ -155,6 +155,8 @@ type Listener struct {
 	// Types that are valid to be assigned to ListenerType:
 	//	*Listener_HttpListener
 	//	*Listener_TcpListener
+	//	*Listener_HybridListener
+	//	*Listener_UdpListener
 	ListenerType isListener_ListenerType `protobuf_oneof:"ListenerType"`
 	// SSL Config is optional for the listener. If provided, the listener will serve TLS for connections on this port.
 	// Multiple SslConfigs are supported for the purpose of SNI. Be aware that the SNI domain provided in the SSL Config
@@ -208,9 +210,17 @@ type Listener_HttpListener struct {
 type Listener_TcpListener struct {
 	TcpListener *TcpListener `protobuf:"bytes,5,opt,name=tcp_listener,json=tcpListener,proto3,oneof" json:"tcp_listener,omitempty"`
 }
+type Listener_HybridListener struct {
+	HybridListener *HybridListener `protobuf:"bytes,10,opt,name=hybrid_listener,json=hybridListener,proto3,oneof" json:"hybrid_listener,omitempty"`
+}
+type Listener_UdpListener struct {
+	UdpListener *UdpListener `protobuf:"bytes,11,opt,name=udp_listener,json=udpListener,proto3,oneof" json:"udp_listener,omitempty"`
+}
 
-func (*Listener_HttpListener) isListener_ListenerType() {}
-func (*Listener_TcpListener) isListener_ListenerType()  {}
+func (*Listener_HttpListener) isListener_ListenerType()   {}
+func (*Listener_TcpListener) isListener_ListenerType()    {}
+func (*Listener_HybridListener) isListener_ListenerType() {}
+func (*Listener_UdpListener) isListener_ListenerType()    {}
 
 func (m *Listener) GetListenerType() isListener_ListenerType {
 	if m != nil {
@@ -254,6 +264,20 @@ func (m *Listener) GetTcpListener() *TcpListener {
 	return nil
 }
 
+func (m *Listener) GetHybridListener() *HybridListener {
+	if x, ok := m.GetListenerType().(*Listener_HybridListener); ok {
+		return x.HybridListener
+	}
+	return nil
+}
+
+func (m *Listener) GetUdpListener() *UdpListener {
+	if x, ok := m.GetListenerType().(*Listener_UdpListener); ok {
+		return x.UdpListener
+	}
+	return nil
+}
+
 func (m *Listener) GetSslConfigurations() []*SslConfig {
 	if m != nil {
 		return m.SslConfigurations
@@ -287,7 +311,256 @@ func (*Listener) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Listener_HttpListener)(nil),
 		(*Listener_TcpListener)(nil),
+		(*Listener_HybridListener)(nil),
+		(*Listener_UdpListener)(nil),
+	}
+}
+
+type HybridListener struct {
+	// the listeners served on the port of this listener, with the matchers that select them.
+	// the matchers of the listeners must be distinct.
+	MatchedListeners     []*MatchedListener `protobuf:"bytes,1,rep,name=matched_listeners,json=matchedListeners,proto3" json:"matched_listeners,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *HybridListener) Reset()         { *m = HybridListener{} }
+func (m *HybridListener) String() string { return proto.CompactTextString(m) }
+func (*HybridListener) ProtoMessage()    {}
+func (*HybridListener) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a47f72e9923590, []int{2}
+}
+func (m *HybridListener) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HybridListener.Unmarshal(m, b)
+}
+func (m *HybridListener) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HybridListener.Marshal(b, m, deterministic)
+}
+func (m *HybridListener) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HybridListener.Merge(m, src)
+}
+func (m *HybridListener) XXX_Size() int {
+	return xxx_messageInfo_HybridListener.Size(m)
+}
+func (m *HybridListener) XXX_DiscardUnknown() {
+	xxx_messageInfo_HybridListener.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HybridListener proto.InternalMessageInfo
+
+func (m *HybridListener) GetMatchedListeners() []*MatchedListener {
+	if m != nil {
+		return m.MatchedListeners
+	}
+	return nil
+}
+
+type MatchedListener struct {
+	// the connections handled by this listener
+	Matcher *Matcher `protobuf:"bytes,1,opt,name=matcher,proto3" json:"matcher,omitempty"`
+	// Types that are valid to be assigned to ListenerType:
+	//	*MatchedListener_HttpListener
+	//	*MatchedListener_TcpListener
+	ListenerType         isMatchedListener_ListenerType `protobuf_oneof:"ListenerType"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *MatchedListener) Reset()         { *m = MatchedListener{} }
+func (m *MatchedListener) String() string { return proto.CompactTextString(m) }
+func (*MatchedListener) ProtoMessage()    {}
+func (*MatchedListener) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a47f72e9923590, []int{3}
+}
+func (m *MatchedListener) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchedListener.Unmarshal(m, b)
+}
+func (m *MatchedListener) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchedListener.Marshal(b, m, deterministic)
+}
+func (m *MatchedListener) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchedListener.Merge(m, src)
+}
+func (m *MatchedListener) XXX_Size() int {
+	return xxx_messageInfo_MatchedListener.Size(m)
+}
+func (m *MatchedListener) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchedListener.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchedListener proto.InternalMessageInfo
+
+type isMatchedListener_ListenerType interface {
+	isMatchedListener_ListenerType()
+	Equal(interface{}) bool
+}
+
+type MatchedListener_HttpListener struct {
+	HttpListener *HttpListener `protobuf:"bytes,2,opt,name=http_listener,json=httpListener,proto3,oneof" json:"http_listener,omitempty"`
+}
+type MatchedListener_TcpListener struct {
+	TcpListener *TcpListener `protobuf:"bytes,3,opt,name=tcp_listener,json=tcpListener,proto3,oneof" json:"tcp_listener,omitempty"`
+}
+
+func (*MatchedListener_HttpListener) isMatchedListener_ListenerType() {}
+func (*MatchedListener_TcpListener) isMatchedListener_ListenerType()  {}
+
+func (m *MatchedListener) GetListenerType() isMatchedListener_ListenerType {
+	if m != nil {
+		return m.ListenerType
+	}
+	return nil
+}
+
+func (m *MatchedListener) GetMatcher() *Matcher {
+	if m != nil {
+		return m.Matcher
+	}
+	return nil
+}
+
+func (m *MatchedListener) GetHttpListener() *HttpListener {
+	if x, ok := m.GetListenerType().(*MatchedListener_HttpListener); ok {
+		return x.HttpListener
+	}
+	return nil
+}
+
+func (m *MatchedListener) GetTcpListener() *TcpListener {
+	if x, ok := m.GetListenerType().(*MatchedListener_TcpListener); ok {
+		return x.TcpListener
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*MatchedListener) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*MatchedListener_HttpListener)(nil),
+		(*MatchedListener_TcpListener)(nil),
+	}
+}
+
+// Selects connections by their properties. Envoy hands each connection to the listener with the most specific
+// matcher: the server names are compared first, then the transport protocol, the application protocols and the
+// source prefix ranges. An empty matcher matches all connections.
+type Matcher struct {
+	// If provided, the listener terminates TLS for the matched connections.
+	// The SNI domains of the ssl config select the connections, and `server_names` is ignored.
+	SslConfig *SslConfig `protobuf:"bytes,1,opt,name=ssl_config,json=sslConfig,proto3" json:"ssl_config,omitempty"`
+	// Match the connections from these source addresses.
+	SourcePrefixRanges []*CidrRange `protobuf:"bytes,2,rep,name=source_prefix_ranges,json=sourcePrefixRanges,proto3" json:"source_prefix_ranges,omitempty"`
+	// Match TLS connections by their SNI without terminating TLS, e.g. to pass them through to a TCP upstream.
+	// Wildcards are supported as the first label, e.g. `*.example.com`.
+	ServerNames []string `protobuf:"bytes,3,rep,name=server_names,json=serverNames,proto3" json:"server_names,omitempty"`
+	// Match TLS connections by the protocols they negotiate with ALPN, e.g. `h2` or `http/1.1`.
+	ApplicationProtocols []string `protobuf:"bytes,4,rep,name=application_protocols,json=applicationProtocols,proto3" json:"application_protocols,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Matcher) Reset()         { *m = Matcher{} }
+func (m *Matcher) String() string { return proto.CompactTextString(m) }
+func (*Matcher) ProtoMessage()    {}
+func (*Matcher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a47f72e9923590, []int{4}
+}
+func (m *Matcher) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Matcher.Unmarshal(m, b)
+}
+func (m *Matcher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Matcher.Marshal(b, m, deterministic)
+}
+func (m *Matcher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Matcher.Merge(m, src)
+}
+func (m *Matcher) XXX_Size() int {
+	return xxx_messageInfo_Matcher.Size(m)
+}
+func (m *Matcher) XXX_DiscardUnknown() {
+	xxx_messageInfo_Matcher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Matcher proto.InternalMessageInfo
+
+func (m *Matcher) GetSslConfig() *SslConfig {
+	if m != nil {
+		return m.SslConfig
+	}
+	return nil
+}
+
+func (m *Matcher) GetSourcePrefixRanges() []*CidrRange {
+	if m != nil {
+		return m.SourcePrefixRanges
+	}
+	return nil
+}
+
+func (m *Matcher) GetServerNames() []string {
+	if m != nil {
+		return m.ServerNames
+	}
+	return nil
+}
+
+func (m *Matcher) GetApplicationProtocols() []string {
+	if m != nil {
+		return m.ApplicationProtocols
+	}
+	return nil
+}
+
+// An IP address range in CIDR notation
+type CidrRange struct {
+	// IPv4 or IPv6 address, e.g. `192.0.0.0` or `2001:db8::`
+	AddressPrefix string `protobuf:"bytes,1,opt,name=address_prefix,json=addressPrefix,proto3" json:"address_prefix,omitempty"`
+	// Length of the prefix, e.g. 0, 32. Defaults to the full length of the address.
+	PrefixLen            *types.UInt32Value `protobuf:"bytes,2,opt,name=prefix_len,json=prefixLen,proto3" json:"prefix_len,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CidrRange) Reset()         { *m = CidrRange{} }
+func (m *CidrRange) String() string { return proto.CompactTextString(m) }
+func (*CidrRange) ProtoMessage()    {}
+func (*CidrRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a47f72e9923590, []int{5}
+}
+func (m *CidrRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CidrRange.Unmarshal(m, b)
+}
+func (m *CidrRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CidrRange.Marshal(b, m, deterministic)
+}
+func (m *CidrRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CidrRange.Merge(m, src)
+}
+func (m *CidrRange) XXX_Size() int {
+	return xxx_messageInfo_CidrRange.Size(m)
+}
+func (m *CidrRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_CidrRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CidrRange proto.InternalMessageInfo
+
+func (m *CidrRange) GetAddressPrefix() string {
+	if m != nil {
+		return m.AddressPrefix
+	}
+	return ""
+}
+
+func (m *CidrRange) GetPrefixLen() *types.UInt32Value {
+	if m != nil {
+		return m.PrefixLen
 	}
+	return nil
 }
 
 type TcpListener struct {
@@ -309,7 +582,7 @@ func (m *TcpListener) Reset()         { *m = TcpListener{} }
 func (m *TcpListener) String() string { return proto.CompactTextString(m) }
 func (*TcpListener) ProtoMessage()    {}
 func (*TcpListener) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{2}
+	return fileDescriptor_c6a47f72e9923590, []int{6}
 }
 func (m *TcpListener) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpListener.Unmarshal(m, b)
@@ -350,22 +623,86 @@ func (m *TcpListener) GetStatPrefix() string {
 	return ""
 }
 
+// A UDP listener forwards the datagrams it receives to a single upstream. Each client address gets its own
+// upstream session, so that the replies of the upstream are sent back to the client.
+type UdpListener struct {
+	// The upstream to forward the datagrams to.
+	// Note: the destination spec and subsets are not supported in this context and will be ignored.
+	Destination *Destination `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	// Options contains top-level configuration to be applied to a listener.
+	Options *UdpListenerOptions `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	// prefix for addressing envoy stats for the udp proxy
+	StatPrefix           string   `protobuf:"bytes,3,opt,name=stat_prefix,json=statPrefix,proto3" json:"stat_prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UdpListener) Reset()         { *m = UdpListener{} }
+func (m *UdpListener) String() string { return proto.CompactTextString(m) }
+func (*UdpListener) ProtoMessage()    {}
+func (*UdpListener) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a47f72e9923590, []int{7}
+}
+func (m *UdpListener) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UdpListener.Unmarshal(m, b)
+}
+func (m *UdpListener) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UdpListener.Marshal(b, m, deterministic)
+}
+func (m *UdpListener) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UdpListener.Merge(m, src)
+}
+func (m *UdpListener) XXX_Size() int {
+	return xxx_messageInfo_UdpListener.Size(m)
+}
+func (m *UdpListener) XXX_DiscardUnknown() {
+	xxx_messageInfo_UdpListener.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UdpListener proto.InternalMessageInfo
+
+func (m *UdpListener) GetDestination() *Destination {
+	if m != nil {
+		return m.Destination
+	}
+	return nil
+}
+
+func (m *UdpListener) GetOptions() *UdpListenerOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *UdpListener) GetStatPrefix() string {
+	if m != nil {
+		return m.StatPrefix
+	}
+	return ""
+}
+
 type TcpHost struct {
 	// the logical name of the tcp host. names must be unique for each tcp host within a listener
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// If provided, the Gateway will serve TLS/SSL traffic for this set of routes
-	SslConfig            *SslConfig         `protobuf:"bytes,3,opt,name=ssl_config,json=sslConfig,proto3" json:"ssl_config,omitempty"`
-	Destination          *TcpHost_TcpAction `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	// If provided, the Gateway will serve TLS/SSL traffic for this set of routes.
+	// If the ssl config has no secrets, TLS is not terminated: the TLS connections whose SNI matches the `sniDomains`
+	// (or all TLS connections, without `sniDomains`) are passed through to the destination as-is.
+	SslConfig   *SslConfig         `protobuf:"bytes,3,opt,name=ssl_config,json=sslConfig,proto3" json:"ssl_config,omitempty"`
+	Destination *TcpHost_TcpAction `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+	// Options that apply to the connections of this tcp host.
+	Options              *TcpHostOptions `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *TcpHost) Reset()         { *m = TcpHost{} }
 func (m *TcpHost) String() string { return proto.CompactTextString(m) }
 func (*TcpHost) ProtoMessage()    {}
 func (*TcpHost) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{3}
+	return fileDescriptor_c6a47f72e9923590, []int{8}
 }
 func (m *TcpHost) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpHost.Unmarshal(m, b)
@@ -406,6 +743,13 @@ func (m *TcpHost) GetDestination() *TcpHost_TcpAction {
 	return nil
 }
 
+func (m *TcpHost) GetOptions() *TcpHostOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
 // Name of the destinations the gateway can route to.
 // Note: the destination spec and subsets are not supported in this context and will be ignored.
 type TcpHost_TcpAction struct {
@@ -424,7 +768,7 @@ func (m *TcpHost_TcpAction) Reset()         { *m = TcpHost_TcpAction{} }
 func (m *TcpHost_TcpAction) String() string { return proto.CompactTextString(m) }
 func (*TcpHost_TcpAction) ProtoMessage()    {}
 func (*TcpHost_TcpAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{3, 0}
+	return fileDescriptor_c6a47f72e9923590, []int{8, 0}
 }
 func (m *TcpHost_TcpAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpHost_TcpAction.Unmarshal(m, b)
@@ -536,7 +880,7 @@ func (m *HttpListener) Reset()         { *m = HttpListener{} }
 func (m *HttpListener) String() string { return proto.CompactTextString(m) }
 func (*HttpListener) ProtoMessage()    {}
 func (*HttpListener) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{4}
+	return fileDescriptor_c6a47f72e9923590, []int{9}
 }
 func (m *HttpListener) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HttpListener.Unmarshal(m, b)
@@ -613,7 +957,7 @@ func (m *VirtualHost) Reset()         { *m = VirtualHost{} }
 func (m *VirtualHost) String() string { return proto.CompactTextString(m) }
 func (*VirtualHost) ProtoMessage()    {}
 func (*VirtualHost) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{5}
+	return fileDescriptor_c6a47f72e9923590, []int{10}
 }
 func (m *VirtualHost) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VirtualHost.Unmarshal(m, b)
@@ -701,7 +1045,7 @@ func (m *Route) Reset()         { *m = Route{} }
 func (m *Route) String() string { return proto.CompactTextString(m) }
 func (*Route) ProtoMessage()    {}
 func (*Route) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{6}
+	return fileDescriptor_c6a47f72e9923590, []int{11}
 }
 func (m *Route) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Route.Unmarshal(m, b)
@@ -825,7 +1169,7 @@ func (m *RouteAction) Reset()         { *m = RouteAction{} }
 func (m *RouteAction) String() string { return proto.CompactTextString(m) }
 func (*RouteAction) ProtoMessage()    {}
 func (*RouteAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{7}
+	return fileDescriptor_c6a47f72e9923590, []int{12}
 }
 func (m *RouteAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteAction.Unmarshal(m, b)
@@ -927,7 +1271,7 @@ func (m *Destination) Reset()         { *m = Destination{} }
 func (m *Destination) String() string { return proto.CompactTextString(m) }
 func (*Destination) ProtoMessage()    {}
 func (*Destination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{8}
+	return fileDescriptor_c6a47f72e9923590, []int{13}
 }
 func (m *Destination) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Destination.Unmarshal(m, b)
@@ -1032,7 +1376,7 @@ func (m *KubernetesServiceDestination) Reset()         { *m = KubernetesServiceD
 func (m *KubernetesServiceDestination) String() string { return proto.CompactTextString(m) }
 func (*KubernetesServiceDestination) ProtoMessage()    {}
 func (*KubernetesServiceDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{9}
+	return fileDescriptor_c6a47f72e9923590, []int{14}
 }
 func (m *KubernetesServiceDestination) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KubernetesServiceDestination.Unmarshal(m, b)
@@ -1088,7 +1432,7 @@ func (m *ConsulServiceDestination) Reset()         { *m = ConsulServiceDestinati
 func (m *ConsulServiceDestination) String() string { return proto.CompactTextString(m) }
 func (*ConsulServiceDestination) ProtoMessage()    {}
 func (*ConsulServiceDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{10}
+	return fileDescriptor_c6a47f72e9923590, []int{15}
 }
 func (m *ConsulServiceDestination) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConsulServiceDestination.Unmarshal(m, b)
@@ -1132,6 +1476,9 @@ func (m *ConsulServiceDestination) GetDataCenters() []string {
 type UpstreamGroup struct {
 	// The destinations that are part of this upstream group.
 	Destinations []*WeightedDestination `protobuf:"bytes,1,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	// Progressively shifts the traffic of this upstream group to one of its destinations.
+	// Gloo updates the weights of the destinations as the rollout progresses.
+	Rollout *UpstreamGroupRollout `protobuf:"bytes,2,opt,name=rollout,proto3" json:"rollout,omitempty"`
 	// Status indicates the validation status of this resource.
 	// Status is read-only by clients, and set by gloo during validation
 	Status core.Status `protobuf:"bytes,6,opt,name=status,proto3" json:"status" testdiff:"ignore"`
@@ -1146,7 +1493,7 @@ func (m *UpstreamGroup) Reset()         { *m = UpstreamGroup{} }
 func (m *UpstreamGroup) String() string { return proto.CompactTextString(m) }
 func (*UpstreamGroup) ProtoMessage()    {}
 func (*UpstreamGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{11}
+	return fileDescriptor_c6a47f72e9923590, []int{16}
 }
 func (m *UpstreamGroup) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpstreamGroup.Unmarshal(m, b)
@@ -1173,6 +1520,13 @@ func (m *UpstreamGroup) GetDestinations() []*WeightedDestination {
 	return nil
 }
 
+func (m *UpstreamGroup) GetRollout() *UpstreamGroupRollout {
+	if m != nil {
+		return m.Rollout
+	}
+	return nil
+}
+
 func (m *UpstreamGroup) GetStatus() core.Status {
 	if m != nil {
 		return m.Status
@@ -1202,7 +1556,7 @@ func (m *MultiDestination) Reset()         { *m = MultiDestination{} }
 func (m *MultiDestination) String() string { return proto.CompactTextString(m) }
 func (*MultiDestination) ProtoMessage()    {}
 func (*MultiDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{12}
+	return fileDescriptor_c6a47f72e9923590, []int{17}
 }
 func (m *MultiDestination) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MultiDestination.Unmarshal(m, b)
@@ -1246,7 +1600,7 @@ func (m *WeightedDestination) Reset()         { *m = WeightedDestination{} }
 func (m *WeightedDestination) String() string { return proto.CompactTextString(m) }
 func (*WeightedDestination) ProtoMessage()    {}
 func (*WeightedDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{13}
+	return fileDescriptor_c6a47f72e9923590, []int{18}
 }
 func (m *WeightedDestination) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WeightedDestination.Unmarshal(m, b)
@@ -1302,7 +1656,12 @@ type RedirectAction struct {
 	HttpsRedirect bool `protobuf:"varint,4,opt,name=https_redirect,json=httpsRedirect,proto3" json:"https_redirect,omitempty"`
 	// Indicates that during redirection, the query portion of the URL will
 	// be removed. Default value is false.
-	StripQuery           bool     `protobuf:"varint,6,opt,name=strip_query,json=stripQuery,proto3" json:"strip_query,omitempty"`
+	StripQuery bool `protobuf:"varint,6,opt,name=strip_query,json=stripQuery,proto3" json:"strip_query,omitempty"`
+	// The scheme portion of the URL will be swapped with this value, e.g. "http".
+	// Can't be set together with `https_redirect`.
+	SchemeRedirect string `protobuf:"bytes,7,opt,name=scheme_redirect,json=schemeRedirect,proto3" json:"scheme_redirect,omitempty"`
+	// The port portion of the URL will be swapped with this value.
+	PortRedirect         uint32   `protobuf:"varint,8,opt,name=port_redirect,json=portRedirect,proto3" json:"port_redirect,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1312,7 +1671,7 @@ func (m *RedirectAction) Reset()         { *m = RedirectAction{} }
 func (m *RedirectAction) String() string { return proto.CompactTextString(m) }
 func (*RedirectAction) ProtoMessage()    {}
 func (*RedirectAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{14}
+	return fileDescriptor_c6a47f72e9923590, []int{19}
 }
 func (m *RedirectAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RedirectAction.Unmarshal(m, b)
@@ -1396,6 +1755,20 @@ func (m *RedirectAction) GetStripQuery() bool {
 	return false
 }
 
+func (m *RedirectAction) GetSchemeRedirect() string {
+	if m != nil {
+		return m.SchemeRedirect
+	}
+	return ""
+}
+
+func (m *RedirectAction) GetPortRedirect() uint32 {
+	if m != nil {
+		return m.PortRedirect
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*RedirectAction) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1423,7 +1796,7 @@ func (m *DirectResponseAction) Reset()         { *m = DirectResponseAction{} }
 func (m *DirectResponseAction) String() string { return proto.CompactTextString(m) }
 func (*DirectResponseAction) ProtoMessage()    {}
 func (*DirectResponseAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a47f72e9923590, []int{15}
+	return fileDescriptor_c6a47f72e9923590, []int{20}
 }
 func (m *DirectResponseAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DirectResponseAction.Unmarshal(m, b)
@@ -1457,154 +1830,330 @@ func (m *DirectResponseAction) GetBody() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("gloo.solo.io.RedirectAction_RedirectResponseCode", RedirectAction_RedirectResponseCode_name, RedirectAction_RedirectResponseCode_value)
-	proto.RegisterType((*Proxy)(nil), "gloo.solo.io.Proxy")
-	proto.RegisterType((*Listener)(nil), "gloo.solo.io.Listener")
-	proto.RegisterType((*TcpListener)(nil), "gloo.solo.io.TcpListener")
-	proto.RegisterType((*TcpHost)(nil), "gloo.solo.io.TcpHost")
-	proto.RegisterType((*TcpHost_TcpAction)(nil), "gloo.solo.io.TcpHost.TcpAction")
-	proto.RegisterType((*HttpListener)(nil), "gloo.solo.io.HttpListener")
-	proto.RegisterType((*VirtualHost)(nil), "gloo.solo.io.VirtualHost")
-	proto.RegisterType((*Route)(nil), "gloo.solo.io.Route")
-	proto.RegisterType((*RouteAction)(nil), "gloo.solo.io.RouteAction")
-	proto.RegisterType((*Destination)(nil), "gloo.solo.io.Destination")
-	proto.RegisterType((*KubernetesServiceDestination)(nil), "gloo.solo.io.KubernetesServiceDestination")
-	proto.RegisterType((*ConsulServiceDestination)(nil), "gloo.solo.io.ConsulServiceDestination")
-	proto.RegisterType((*UpstreamGroup)(nil), "gloo.solo.io.UpstreamGroup")
-	proto.RegisterType((*MultiDestination)(nil), "gloo.solo.io.MultiDestination")
-	proto.RegisterType((*WeightedDestination)(nil), "gloo.solo.io.WeightedDestination")
-	proto.RegisterType((*RedirectAction)(nil), "gloo.solo.io.RedirectAction")
-	proto.RegisterType((*DirectResponseAction)(nil), "gloo.solo.io.DirectResponseAction")
+// An UpstreamGroupRollout turns an upstream group into a canary: Gloo progressively shifts its traffic to one of its
+// destinations, the canary. On every step, the share of the traffic of the canary is increased by `stepWeight` percent,
+// and the weights of the other destinations are decreased in proportion to each other, until the canary receives
+// `maxWeight` percent of the traffic. If the metric guard fails, the rollout is aborted and all the traffic is shifted
+// back to the other destinations.
+//
+// The progress of the rollout is recorded in the `rollout.gloo.solo.io/phase` annotation of the upstream group.
+// Changing the rollout starts it over.
+type UpstreamGroupRollout struct {
+	// The destination traffic is shifted to. It must be one of the destinations of the upstream group.
+	Canary *Destination `protobuf:"bytes,1,opt,name=canary,proto3" json:"canary,omitempty"`
+	// The percentage of the traffic which is shifted to the canary on every step. Defaults to 10.
+	StepWeight uint32 `protobuf:"varint,2,opt,name=step_weight,json=stepWeight,proto3" json:"step_weight,omitempty"`
+	// The time between steps. Defaults to 1 minute.
+	Interval *types.Duration `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// The percentage of the traffic the canary receives when the rollout is done. Defaults to 100.
+	MaxWeight uint32 `protobuf:"varint,4,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
+	// If set, the rollout only continues while the metric of the guard is within its bounds.
+	MetricGuard          *UpstreamGroupRollout_MetricGuard `protobuf:"bytes,5,opt,name=metric_guard,json=metricGuard,proto3" json:"metric_guard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/proxy.proto", fileDescriptor_c6a47f72e9923590)
+func (m *UpstreamGroupRollout) Reset()         { *m = UpstreamGroupRollout{} }
+func (m *UpstreamGroupRollout) String() string { return proto.CompactTextString(m) }
+func (*UpstreamGroupRollout) ProtoMessage()    {}
+func (*UpstreamGroupRollout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a47f72e9923590, []int{21}
 }
-
-var fileDescriptor_c6a47f72e9923590 = []byte{
-	// 1637 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4b, 0x6f, 0x1b, 0xc9,
-	0x11, 0xd6, 0x88, 0x14, 0x45, 0x16, 0x49, 0x3d, 0x7a, 0x25, 0xed, 0x48, 0x6b, 0x3d, 0x76, 0x8c,
-	0xdd, 0x15, 0xf2, 0x20, 0x63, 0xed, 0xc2, 0xeb, 0xc8, 0x40, 0x62, 0x51, 0xa2, 0xcd, 0xc4, 0xd6,
-	0xc3, 0x2d, 0x59, 0x81, 0x7d, 0x19, 0x0c, 0x87, 0x4d, 0x6a, 0x62, 0x92, 0x3d, 0xe9, 0xee, 0xd1,
-	0xe3, 0xea, 0x1f, 0xe2, 0x73, 0x0e, 0x41, 0xce, 0x3e, 0x04, 0xc8, 0x35, 0x97, 0x5c, 0x73, 0x09,
-	0xe0, 0x00, 0xf9, 0x07, 0x0a, 0x90, 0x5b, 0x0e, 0x41, 0xf7, 0xf4, 0x0c, 0x67, 0x28, 0x52, 0x8e,
-	0x01, 0x1f, 0xb2, 0x27, 0x76, 0xd7, 0xab, 0xbb, 0xaa, 0xbe, 0xaa, 0xea, 0x21, 0x3c, 0xe8, 0x78,
-	0xe2, 0x2c, 0x68, 0x56, 0x5c, 0xda, 0xab, 0x72, 0xda, 0xa5, 0x3f, 0xf5, 0x68, 0xb5, 0xd3, 0xa5,
-	0xb4, 0xea, 0x33, 0xfa, 0x5b, 0xe2, 0x0a, 0x1e, 0xee, 0x1c, 0xdf, 0xab, 0x9e, 0xdf, 0x93, 0xc4,
-	0xcb, 0xab, 0x8a, 0xcf, 0xa8, 0xa0, 0xa8, 0x24, 0x19, 0x15, 0xa9, 0x53, 0xf1, 0xe8, 0xca, 0x5a,
-	0x87, 0xd2, 0x4e, 0x97, 0x54, 0x15, 0xaf, 0x19, 0xb4, 0xab, 0x17, 0xcc, 0xf1, 0x7d, 0xc2, 0x78,
-	0x28, 0xbd, 0xf2, 0xc5, 0x30, 0x9f, 0xf4, 0x7c, 0xa1, 0x4d, 0xad, 0xdc, 0x19, 0x66, 0x72, 0xc1,
-	0x02, 0x57, 0x68, 0xee, 0x42, 0x87, 0x76, 0xa8, 0x5a, 0x56, 0xe5, 0x4a, 0x53, 0x11, 0xb9, 0x14,
-	0x21, 0x91, 0x5c, 0x46, 0x92, 0x6b, 0xca, 0x83, 0xd7, 0x9e, 0x88, 0xee, 0xdb, 0x23, 0xc2, 0x69,
-	0x39, 0xc2, 0x89, 0xce, 0x19, 0xe6, 0x73, 0xe1, 0x88, 0x20, 0xba, 0xe2, 0xf2, 0x30, 0x97, 0x91,
-	0xf6, 0x38, 0xc3, 0xd1, 0x5e, 0xf3, 0xef, 0x8e, 0x0f, 0x19, 0xe7, 0x5d, 0x2d, 0xf4, 0xf5, 0x2d,
-	0x42, 0x41, 0x93, 0x93, 0xc8, 0xd8, 0x37, 0xe3, 0xe5, 0xa8, 0x2f, 0x3c, 0xda, 0x8f, 0x2e, 0x7c,
-	0x7f, 0xbc, 0xa0, 0x4b, 0x19, 0xa9, 0xf6, 0x1c, 0xe1, 0x9e, 0x11, 0xc6, 0xe3, 0x45, 0xa8, 0x67,
-	0xfd, 0xdd, 0x80, 0xa9, 0x23, 0x99, 0x49, 0xf4, 0x1d, 0x14, 0xba, 0x1e, 0x17, 0xa4, 0x4f, 0x18,
-	0x37, 0x27, 0x37, 0x32, 0x9b, 0xc5, 0xad, 0xa5, 0x4a, 0x32, 0xaf, 0x95, 0x67, 0x9a, 0x8d, 0x07,
-	0x82, 0xe8, 0x29, 0xe4, 0xc2, 0xc0, 0x99, 0xb9, 0x0d, 0x63, 0xb3, 0xb8, 0xb5, 0x50, 0x91, 0xc7,
-	0xc5, 0x2a, 0xc7, 0x8a, 0x57, 0x5b, 0x7d, 0xf7, 0xef, 0xac, 0xf1, 0x97, 0xf7, 0xeb, 0x13, 0xff,
-	0x7a, 0xbf, 0x3e, 0x2f, 0x08, 0x17, 0x2d, 0xaf, 0xdd, 0xde, 0xb6, 0xbc, 0x4e, 0x9f, 0x32, 0x62,
-	0x61, 0x6d, 0x02, 0x3d, 0x80, 0x7c, 0x94, 0x25, 0x73, 0x5a, 0x99, 0x5b, 0x4a, 0x9b, 0xdb, 0xd7,
-	0xdc, 0x5a, 0x56, 0x1a, 0xc3, 0xb1, 0xf4, 0xf6, 0xe2, 0x9b, 0xeb, 0x6c, 0x16, 0x26, 0xfd, 0xcb,
-	0x37, 0xd7, 0xd9, 0x02, 0x9a, 0x96, 0xd8, 0xf4, 0x08, 0xb7, 0xae, 0x33, 0x90, 0x8f, 0x6e, 0x8d,
-	0x10, 0x64, 0xfb, 0x4e, 0x8f, 0x98, 0xc6, 0x86, 0xb1, 0x59, 0xc0, 0x6a, 0x8d, 0xbe, 0x84, 0x52,
-	0xd3, 0xeb, 0xb7, 0x6c, 0xa7, 0xd5, 0x62, 0x84, 0x4b, 0xbf, 0x25, 0xaf, 0x28, 0x69, 0x3b, 0x21,
-	0x09, 0x7d, 0x01, 0x05, 0x25, 0xe2, 0x53, 0x26, 0xcc, 0xcc, 0x86, 0xb1, 0x59, 0xc6, 0x79, 0x49,
-	0x38, 0xa2, 0x4c, 0xa0, 0x1d, 0x28, 0x9f, 0x09, 0xe1, 0xdb, 0x51, 0x40, 0xcc, 0xac, 0xba, 0xf6,
-	0x4a, 0x3a, 0x70, 0x0d, 0x21, 0xfc, 0xe8, 0x1a, 0x8d, 0x09, 0x5c, 0x3a, 0x4b, 0xec, 0xd1, 0x2f,
-	0xa0, 0x24, 0xdc, 0x84, 0x85, 0x29, 0x65, 0x61, 0x39, 0x6d, 0xe1, 0xc4, 0x4d, 0x1a, 0x28, 0x8a,
-	0xc1, 0x16, 0x3d, 0x06, 0xc4, 0x79, 0xd7, 0x76, 0x69, 0xbf, 0xed, 0x75, 0x02, 0xe6, 0x28, 0x54,
-	0x98, 0x39, 0x95, 0xc0, 0xcf, 0xd3, 0x56, 0x8e, 0x79, 0x77, 0x57, 0x89, 0xe1, 0x79, 0x1e, 0x2d,
-	0x23, 0x0d, 0x54, 0x83, 0xd9, 0x80, 0x13, 0x5b, 0x95, 0xb5, 0xad, 0xc0, 0xa1, 0x73, 0xb0, 0x52,
-	0x09, 0x4b, 0xb2, 0x12, 0x95, 0x64, 0xa5, 0x46, 0x69, 0xf7, 0xd4, 0xe9, 0x06, 0x04, 0x97, 0x03,
-	0x4e, 0x14, 0x7c, 0x8e, 0x54, 0x1f, 0xf8, 0x1e, 0xa6, 0x35, 0x2c, 0xcd, 0xbc, 0xd2, 0x5d, 0x1d,
-	0x8d, 0xa0, 0xc3, 0x50, 0x08, 0x47, 0xd2, 0xe8, 0xe7, 0x89, 0xcc, 0x17, 0x94, 0xe6, 0xe7, 0x37,
-	0x4e, 0x3d, 0x56, 0x8d, 0xa0, 0x96, 0x95, 0x58, 0x1a, 0xa4, 0xbe, 0x36, 0x03, 0xa5, 0xc8, 0xec,
-	0xc9, 0x95, 0x4f, 0xac, 0xb7, 0x06, 0x14, 0x13, 0xe1, 0x42, 0x5b, 0x50, 0x90, 0xf1, 0x3d, 0xa3,
-	0x5c, 0x70, 0xd3, 0x50, 0x61, 0x59, 0xbc, 0x11, 0xdc, 0x06, 0xe5, 0x02, 0xe7, 0x45, 0xb8, 0xe0,
-	0x68, 0x7b, 0xd8, 0x8f, 0x8d, 0xb1, 0xe9, 0xb8, 0xe1, 0xca, 0x3a, 0x14, 0x25, 0x9c, 0x6d, 0x9f,
-	0x91, 0xb6, 0x77, 0xa9, 0x10, 0x53, 0xc0, 0x20, 0x49, 0x47, 0x8a, 0x62, 0xfd, 0x39, 0x03, 0xd3,
-	0xfa, 0xc8, 0x91, 0x98, 0xbc, 0x0f, 0x30, 0x48, 0xa8, 0xd2, 0xbf, 0x25, 0x91, 0x85, 0x38, 0x91,
-	0x68, 0x07, 0x8a, 0x2d, 0xc2, 0x85, 0xd7, 0x57, 0x09, 0xd5, 0x48, 0x5c, 0x1f, 0xe9, 0xaa, 0xfc,
-	0xdd, 0x71, 0xa5, 0x18, 0x4e, 0xea, 0xac, 0xbc, 0x9d, 0x84, 0x42, 0xcc, 0x42, 0xdf, 0x42, 0x8e,
-	0x7b, 0xfd, 0x4e, 0x37, 0xbc, 0xde, 0x0d, 0x4c, 0xee, 0x0d, 0x14, 0x1b, 0x13, 0x58, 0x8b, 0xa2,
-	0xfb, 0x30, 0xd5, 0x0b, 0xba, 0xc2, 0x53, 0xa5, 0x54, 0xdc, 0x5a, 0x4b, 0xeb, 0xec, 0x4b, 0x56,
-	0x5a, 0x31, 0x14, 0x47, 0x35, 0x98, 0x09, 0x7c, 0x2e, 0x18, 0x71, 0x7a, 0x76, 0x87, 0xd1, 0xc0,
-	0xd7, 0x9e, 0x2f, 0xa7, 0x3b, 0x00, 0x26, 0x9c, 0x06, 0xcc, 0x25, 0x98, 0xb4, 0x1b, 0x13, 0xb8,
-	0x1c, 0xa9, 0x3c, 0x91, 0x1a, 0xe8, 0x39, 0x98, 0x6d, 0xca, 0x2e, 0x1c, 0xd6, 0xb2, 0x79, 0xdf,
-	0xb3, 0xdd, 0x6e, 0xc0, 0x05, 0x61, 0xb6, 0x8a, 0x70, 0x56, 0xf7, 0x93, 0x61, 0x54, 0xd5, 0xe5,
-	0xec, 0x69, 0x4c, 0xe0, 0x45, 0xad, 0x79, 0xdc, 0xf7, 0x76, 0x43, 0xbd, 0x03, 0xa7, 0x47, 0x6a,
-	0xe5, 0x54, 0x50, 0x7f, 0x9d, 0xcd, 0x4f, 0xce, 0x65, 0xac, 0x3f, 0x18, 0x50, 0x6a, 0xa4, 0x6b,
-	0xb8, 0x7c, 0xee, 0x31, 0x11, 0x38, 0xdd, 0x14, 0xce, 0x86, 0x02, 0x76, 0x1a, 0x8a, 0x28, 0xac,
-	0x95, 0xce, 0x07, 0x1b, 0x8e, 0x1e, 0x0e, 0xf0, 0x16, 0x86, 0xed, 0xcb, 0xf1, 0x0d, 0xe4, 0xe3,
-	0x01, 0xf7, 0x0f, 0x03, 0x8a, 0x89, 0xb3, 0x47, 0x82, 0xce, 0x84, 0xe9, 0x16, 0xed, 0x39, 0x5e,
-	0x3f, 0xec, 0xfd, 0x05, 0x1c, 0x6d, 0xd1, 0x8f, 0x21, 0xc7, 0x68, 0x20, 0x08, 0x37, 0x33, 0xca,
-	0xa9, 0xcf, 0xd2, 0x57, 0xc3, 0x92, 0x87, 0xb5, 0x48, 0xb2, 0x70, 0xb2, 0xa3, 0x0a, 0x27, 0x71,
-	0x8d, 0x5b, 0x7b, 0x40, 0xee, 0xa3, 0x7a, 0x80, 0xf5, 0xa7, 0x0c, 0x4c, 0xa9, 0x8b, 0xa0, 0x5f,
-	0x42, 0x3e, 0x9a, 0x70, 0x3a, 0x09, 0x77, 0x2b, 0xf1, 0xc8, 0x53, 0x48, 0x4a, 0xe3, 0x31, 0x64,
-	0xe1, 0x58, 0x49, 0xb6, 0x63, 0xe5, 0x8b, 0xed, 0xa8, 0x22, 0xd0, 0xf9, 0x58, 0x1e, 0xe1, 0x74,
-	0x58, 0x25, 0xb2, 0x1d, 0xb3, 0xc1, 0x16, 0x3d, 0x81, 0x59, 0x46, 0x5a, 0x1e, 0x23, 0xae, 0x88,
-	0x4c, 0x84, 0x40, 0xbe, 0x33, 0x64, 0x42, 0x0b, 0xc5, 0x56, 0x66, 0x58, 0x8a, 0x82, 0x5e, 0xc1,
-	0x92, 0x36, 0xc3, 0x08, 0xf7, 0x69, 0x9f, 0xc7, 0x57, 0x0a, 0x23, 0x6b, 0x0d, 0x55, 0xa3, 0x92,
-	0xc5, 0x5a, 0x34, 0xb6, 0xba, 0xd0, 0x1a, 0x41, 0x47, 0xdf, 0x0d, 0xd2, 0x34, 0x35, 0x6a, 0x60,
-	0x29, 0xff, 0x3e, 0x61, 0x82, 0x62, 0xc8, 0x4d, 0x0f, 0x20, 0x57, 0xcb, 0x43, 0x2e, 0x74, 0xc8,
-	0xfa, 0xab, 0x01, 0xc5, 0x44, 0x48, 0x7f, 0x70, 0x8d, 0x67, 0xa8, 0x4b, 0x58, 0x7f, 0x9b, 0x84,
-	0x62, 0xe2, 0x2c, 0xf4, 0x3d, 0xe4, 0x23, 0x79, 0x13, 0x3e, 0x6c, 0x3c, 0x16, 0x46, 0x8f, 0x20,
-	0xfb, 0x3a, 0x68, 0x12, 0xb3, 0xa8, 0x94, 0x7e, 0x94, 0x76, 0xe9, 0x69, 0xd0, 0x24, 0xac, 0x4f,
-	0x04, 0xe1, 0xc7, 0x84, 0x9d, 0x7b, 0x2e, 0x49, 0xbb, 0xa7, 0x34, 0xd1, 0x23, 0xc8, 0xb9, 0xb4,
-	0xcf, 0x83, 0xae, 0x59, 0x52, 0x36, 0xbe, 0x4e, 0xdb, 0xd8, 0x55, 0xbc, 0x91, 0xfa, 0x5a, 0x0f,
-	0x35, 0x60, 0x2e, 0xe1, 0x9b, 0xcd, 0x7d, 0xe2, 0xea, 0x10, 0xaf, 0x8e, 0x4d, 0xcb, 0xb1, 0x4f,
-	0x5c, 0x3c, 0xdb, 0x4a, 0x13, 0xd0, 0x4f, 0x20, 0x17, 0x3e, 0x6e, 0x75, 0x84, 0x17, 0x86, 0x86,
-	0x9a, 0xe2, 0x61, 0x2d, 0x53, 0x43, 0xe9, 0x73, 0x85, 0x9c, 0xed, 0x04, 0xee, 0xdc, 0xe6, 0x35,
-	0xba, 0x07, 0x19, 0x46, 0xda, 0x31, 0x6a, 0xc6, 0xc5, 0x58, 0x3f, 0x1f, 0xa5, 0xac, 0x44, 0xa6,
-	0x7a, 0xd9, 0x4d, 0xaa, 0x97, 0x9d, 0x5a, 0x5b, 0x02, 0xcc, 0x71, 0x81, 0x91, 0x2f, 0x46, 0x1e,
-	0x52, 0xed, 0x44, 0x13, 0x2d, 0x6a, 0x9a, 0x9c, 0x19, 0xd2, 0xa4, 0x70, 0x3a, 0x51, 0x23, 0x55,
-	0x6b, 0xa9, 0x26, 0x0b, 0xc1, 0x76, 0x49, 0x5f, 0xc8, 0xde, 0x94, 0x51, 0xbc, 0xa2, 0xa4, 0xed,
-	0x86, 0x24, 0xeb, 0x3f, 0x06, 0x94, 0x5f, 0xa4, 0xe6, 0x59, 0x1d, 0x4a, 0x89, 0x10, 0x44, 0x0d,
-	0x6d, 0x68, 0x36, 0xfc, 0x86, 0x78, 0x9d, 0x33, 0x41, 0x5a, 0x89, 0x4b, 0xe2, 0x94, 0xda, 0xff,
-	0xcb, 0x1b, 0x7d, 0x35, 0x7c, 0xa3, 0x07, 0x9d, 0x37, 0xd7, 0xd9, 0x79, 0x34, 0x9b, 0x2e, 0x3a,
-	0x6e, 0xbd, 0x84, 0xb9, 0xe1, 0x22, 0xfd, 0x44, 0x01, 0xb0, 0xfe, 0x68, 0xc0, 0x67, 0x23, 0xa4,
-	0xd0, 0xc3, 0xf4, 0x8b, 0xe9, 0x43, 0xcd, 0x26, 0xf5, 0x56, 0x42, 0x4b, 0x90, 0xbb, 0x50, 0x36,
-	0x35, 0x74, 0xf4, 0x0e, 0xd5, 0x06, 0xbd, 0x35, 0x84, 0xf9, 0xe6, 0x07, 0xaf, 0x3b, 0xdc, 0x69,
-	0xad, 0x77, 0x19, 0x98, 0x49, 0x0f, 0x08, 0x74, 0x17, 0xca, 0xf2, 0x69, 0x61, 0x47, 0x53, 0x42,
-	0x03, 0xaf, 0x24, 0x89, 0x91, 0x28, 0xfa, 0x0a, 0xca, 0xbe, 0x23, 0xce, 0x06, 0x42, 0xea, 0x7b,
-	0x46, 0x7e, 0x72, 0x48, 0x72, 0x2c, 0xf6, 0x0d, 0xcc, 0x84, 0x8f, 0x05, 0x9b, 0x91, 0x0b, 0xe6,
-	0x09, 0xa2, 0xa6, 0x80, 0x94, 0x2b, 0x87, 0x74, 0x1c, 0x92, 0xd1, 0x29, 0x94, 0xe3, 0xe1, 0xe3,
-	0xd2, 0x16, 0x51, 0x1e, 0xcd, 0x6c, 0xdd, 0xbb, 0x6d, 0x94, 0xc5, 0xdb, 0x68, 0xe6, 0xec, 0xd2,
-	0x16, 0xc1, 0x25, 0x96, 0xd8, 0xa1, 0xaf, 0x60, 0x46, 0x7e, 0x03, 0xf1, 0xc1, 0x45, 0xe5, 0x4c,
-	0xcb, 0x63, 0xf5, 0x31, 0xc5, 0xe3, 0x7b, 0xaa, 0x97, 0x0d, 0xf3, 0x7c, 0xfb, 0x77, 0x01, 0x61,
-	0x57, 0x0a, 0xbd, 0x79, 0xf9, 0xb2, 0x61, 0x9e, 0xff, 0x5c, 0x52, 0xac, 0x0b, 0x58, 0x18, 0x75,
-	0x1a, 0x5a, 0x84, 0xf9, 0xfd, 0xc3, 0xd3, 0xfa, 0x9e, 0x7d, 0x54, 0xc7, 0xfb, 0x3b, 0x07, 0xf5,
-	0x83, 0x93, 0x67, 0x2f, 0xe7, 0x26, 0x50, 0x01, 0xa6, 0x1e, 0x1f, 0xbe, 0x38, 0xd8, 0x9b, 0x33,
-	0x50, 0x19, 0x0a, 0xc7, 0xf5, 0xba, 0x7d, 0x78, 0xd2, 0xa8, 0xe3, 0xb9, 0x49, 0xb4, 0x04, 0xe8,
-	0xa4, 0xbe, 0x7f, 0x74, 0x88, 0x77, 0xf0, 0x4b, 0x1b, 0xd7, 0xf7, 0x7e, 0x85, 0xeb, 0xbb, 0x27,
-	0x73, 0x19, 0x49, 0x8f, 0x4d, 0x0c, 0xe8, 0xd9, 0x9a, 0x09, 0x4b, 0x3a, 0xd0, 0x2a, 0x50, 0xaa,
-	0x2b, 0x7a, 0x6d, 0x8f, 0x30, 0xab, 0x06, 0x0b, 0xa3, 0x46, 0xb1, 0x84, 0x8b, 0x2e, 0x42, 0x23,
-	0x84, 0x8b, 0xae, 0x27, 0x04, 0xd9, 0x26, 0x6d, 0x5d, 0xe9, 0x2f, 0x4f, 0xb5, 0xae, 0x6d, 0xcb,
-	0x52, 0xfc, 0xfd, 0x3f, 0xd7, 0x8c, 0x57, 0x3f, 0xfb, 0xdf, 0xfe, 0x92, 0xf1, 0x5f, 0x77, 0xf4,
-	0xd7, 0x7e, 0x33, 0xa7, 0x46, 0xf1, 0xb7, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xdd, 0xf9, 0xff,
-	0x67, 0xcd, 0x11, 0x00, 0x00,
+func (m *UpstreamGroupRollout) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpstreamGroupRollout.Unmarshal(m, b)
+}
+func (m *UpstreamGroupRollout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpstreamGroupRollout.Marshal(b, m, deterministic)
+}
+func (m *UpstreamGroupRollout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpstreamGroupRollout.Merge(m, src)
+}
+func (m *UpstreamGroupRollout) XXX_Size() int {
+	return xxx_messageInfo_UpstreamGroupRollout.Size(m)
+}
+func (m *UpstreamGroupRollout) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpstreamGroupRollout.DiscardUnknown(m)
 }
 
-func (this *Proxy) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+var xxx_messageInfo_UpstreamGroupRollout proto.InternalMessageInfo
+
+func (m *UpstreamGroupRollout) GetCanary() *Destination {
+	if m != nil {
+		return m.Canary
 	}
+	return nil
+}
 
-	that1, ok := that.(*Proxy)
-	if !ok {
-		that2, ok := that.(Proxy)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *UpstreamGroupRollout) GetStepWeight() uint32 {
+	if m != nil {
+		return m.StepWeight
 	}
-	if that1 == nil {
-		return this == nil
+	return 0
+}
+
+func (m *UpstreamGroupRollout) GetInterval() *types.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *UpstreamGroupRollout) GetMaxWeight() uint32 {
+	if m != nil {
+		return m.MaxWeight
+	}
+	return 0
+}
+
+func (m *UpstreamGroupRollout) GetMetricGuard() *UpstreamGroupRollout_MetricGuard {
+	if m != nil {
+		return m.MetricGuard
+	}
+	return nil
+}
+
+// A MetricGuard checks a metric of the canary before every step.
+type UpstreamGroupRollout_MetricGuard struct {
+	// The address of the Prometheus server to query, e.g. `http://glooe-prometheus-server.gloo-system:80`.
+	PrometheusAddress string `protobuf:"bytes,1,opt,name=prometheus_address,json=prometheusAddress,proto3" json:"prometheus_address,omitempty"`
+	// A PromQL query which evaluates to a single number, e.g. the rate of 5xx responses of the canary.
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// The rollout is aborted if the result of the query is greater than this value.
+	MaxValue             float64  `protobuf:"fixed64,3,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpstreamGroupRollout_MetricGuard) Reset()         { *m = UpstreamGroupRollout_MetricGuard{} }
+func (m *UpstreamGroupRollout_MetricGuard) String() string { return proto.CompactTextString(m) }
+func (*UpstreamGroupRollout_MetricGuard) ProtoMessage()    {}
+func (*UpstreamGroupRollout_MetricGuard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a47f72e9923590, []int{21, 0}
+}
+func (m *UpstreamGroupRollout_MetricGuard) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpstreamGroupRollout_MetricGuard.Unmarshal(m, b)
+}
+func (m *UpstreamGroupRollout_MetricGuard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpstreamGroupRollout_MetricGuard.Marshal(b, m, deterministic)
+}
+func (m *UpstreamGroupRollout_MetricGuard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpstreamGroupRollout_MetricGuard.Merge(m, src)
+}
+func (m *UpstreamGroupRollout_MetricGuard) XXX_Size() int {
+	return xxx_messageInfo_UpstreamGroupRollout_MetricGuard.Size(m)
+}
+func (m *UpstreamGroupRollout_MetricGuard) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpstreamGroupRollout_MetricGuard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpstreamGroupRollout_MetricGuard proto.InternalMessageInfo
+
+func (m *UpstreamGroupRollout_MetricGuard) GetPrometheusAddress() string {
+	if m != nil {
+		return m.PrometheusAddress
+	}
+	return ""
+}
+
+func (m *UpstreamGroupRollout_MetricGuard) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *UpstreamGroupRollout_MetricGuard) GetMaxValue() float64 {
+	if m != nil {
+		return m.MaxValue
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("gloo.solo.io.RedirectAction_RedirectResponseCode", RedirectAction_RedirectResponseCode_name, RedirectAction_RedirectResponseCode_value)
+	proto.RegisterType((*Proxy)(nil), "gloo.solo.io.Proxy")
+	proto.RegisterType((*Listener)(nil), "gloo.solo.io.Listener")
+	proto.RegisterType((*HybridListener)(nil), "gloo.solo.io.HybridListener")
+	proto.RegisterType((*MatchedListener)(nil), "gloo.solo.io.MatchedListener")
+	proto.RegisterType((*Matcher)(nil), "gloo.solo.io.Matcher")
+	proto.RegisterType((*CidrRange)(nil), "gloo.solo.io.CidrRange")
+	proto.RegisterType((*TcpListener)(nil), "gloo.solo.io.TcpListener")
+	proto.RegisterType((*UdpListener)(nil), "gloo.solo.io.UdpListener")
+	proto.RegisterType((*TcpHost)(nil), "gloo.solo.io.TcpHost")
+	proto.RegisterType((*TcpHost_TcpAction)(nil), "gloo.solo.io.TcpHost.TcpAction")
+	proto.RegisterType((*HttpListener)(nil), "gloo.solo.io.HttpListener")
+	proto.RegisterType((*VirtualHost)(nil), "gloo.solo.io.VirtualHost")
+	proto.RegisterType((*Route)(nil), "gloo.solo.io.Route")
+	proto.RegisterType((*RouteAction)(nil), "gloo.solo.io.RouteAction")
+	proto.RegisterType((*Destination)(nil), "gloo.solo.io.Destination")
+	proto.RegisterType((*KubernetesServiceDestination)(nil), "gloo.solo.io.KubernetesServiceDestination")
+	proto.RegisterType((*ConsulServiceDestination)(nil), "gloo.solo.io.ConsulServiceDestination")
+	proto.RegisterType((*UpstreamGroup)(nil), "gloo.solo.io.UpstreamGroup")
+	proto.RegisterType((*MultiDestination)(nil), "gloo.solo.io.MultiDestination")
+	proto.RegisterType((*WeightedDestination)(nil), "gloo.solo.io.WeightedDestination")
+	proto.RegisterType((*RedirectAction)(nil), "gloo.solo.io.RedirectAction")
+	proto.RegisterType((*DirectResponseAction)(nil), "gloo.solo.io.DirectResponseAction")
+	proto.RegisterType((*UpstreamGroupRollout)(nil), "gloo.solo.io.UpstreamGroupRollout")
+	proto.RegisterType((*UpstreamGroupRollout_MetricGuard)(nil), "gloo.solo.io.UpstreamGroupRollout.MetricGuard")
+}
+
+func init() {
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/v1/proxy.proto", fileDescriptor_c6a47f72e9923590)
+}
+
+var fileDescriptor_c6a47f72e9923590 = []byte{
+	// 2081 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x5b, 0x6f, 0x1b, 0xc7,
+	0x15, 0x16, 0x2f, 0xa2, 0xc8, 0x43, 0x52, 0x97, 0x89, 0xa4, 0xd0, 0x8a, 0x2f, 0xca, 0x1a, 0x89,
+	0x8d, 0xb6, 0xa1, 0x6a, 0x39, 0x75, 0x52, 0xbb, 0x68, 0x23, 0xca, 0x8c, 0xe5, 0xc4, 0x17, 0x79,
+	0x24, 0xbb, 0x70, 0x50, 0x60, 0xb1, 0xda, 0x1d, 0x91, 0x5b, 0x93, 0x9c, 0xed, 0xcc, 0xac, 0x2c,
+	0xbd, 0x1a, 0x45, 0x7f, 0x46, 0x5e, 0xdb, 0x87, 0xa2, 0xcf, 0x45, 0xd1, 0x1f, 0xd0, 0x97, 0x3e,
+	0xb6, 0x2f, 0x05, 0x52, 0xa0, 0xe8, 0x1f, 0x70, 0x81, 0xa2, 0x40, 0x9f, 0x8a, 0xb9, 0xec, 0x95,
+	0x2b, 0x2b, 0x86, 0xf3, 0xd0, 0x3c, 0x71, 0xe7, 0xdc, 0x78, 0xce, 0x99, 0xef, 0x9c, 0x33, 0x33,
+	0xf0, 0xf1, 0xc0, 0x17, 0xc3, 0xf0, 0xa0, 0xeb, 0xd2, 0xf1, 0x06, 0xa7, 0x23, 0xfa, 0x81, 0x4f,
+	0x37, 0x06, 0x23, 0x4a, 0x37, 0x02, 0x46, 0x7f, 0x4e, 0x5c, 0xc1, 0xf5, 0xca, 0x09, 0xfc, 0x8d,
+	0xa3, 0x6b, 0x92, 0x78, 0x7c, 0xd2, 0x0d, 0x18, 0x15, 0x14, 0xb5, 0x24, 0xa3, 0x2b, 0x75, 0xba,
+	0x3e, 0x5d, 0xbb, 0x38, 0xa0, 0x74, 0x30, 0x22, 0x1b, 0x8a, 0x77, 0x10, 0x1e, 0x6e, 0x3c, 0x67,
+	0x4e, 0x10, 0x10, 0xc6, 0xb5, 0xf4, 0xda, 0x3b, 0x79, 0x3e, 0x19, 0x07, 0xc2, 0x98, 0x5a, 0x3b,
+	0x9f, 0x67, 0x72, 0xc1, 0x42, 0x57, 0x18, 0xee, 0x94, 0x69, 0x2f, 0x64, 0x8e, 0xf0, 0xe9, 0xc4,
+	0xf0, 0x97, 0x07, 0x74, 0x40, 0xd5, 0xe7, 0x86, 0xfc, 0x32, 0x54, 0x44, 0x8e, 0x85, 0x26, 0x92,
+	0xe3, 0xd8, 0x92, 0x8a, 0xf0, 0x99, 0x2f, 0xa2, 0x78, 0xc6, 0x44, 0x38, 0x9e, 0x23, 0x9c, 0xc8,
+	0x8f, 0x3c, 0x9f, 0x0b, 0x47, 0x84, 0x51, 0x08, 0xe7, 0xf2, 0x5c, 0x46, 0x0e, 0x4f, 0x33, 0x1c,
+	0xad, 0x0d, 0xff, 0xf2, 0xe9, 0x29, 0xe5, 0x7c, 0x64, 0x84, 0xde, 0x7f, 0x85, 0x50, 0x78, 0xc0,
+	0x49, 0x64, 0xec, 0xca, 0xe9, 0x72, 0x34, 0x90, 0x79, 0x89, 0x1c, 0xbe, 0x71, 0xba, 0xa0, 0x4b,
+	0x19, 0xd9, 0x18, 0x3b, 0xc2, 0x1d, 0x12, 0xc6, 0xe3, 0x0f, 0xad, 0x67, 0xfd, 0xad, 0x04, 0xb3,
+	0xbb, 0x72, 0xa7, 0xd1, 0x87, 0xd0, 0x18, 0xf9, 0x5c, 0x90, 0x09, 0x61, 0xbc, 0x53, 0x5e, 0xaf,
+	0x5c, 0x6d, 0x6e, 0xae, 0x76, 0xd3, 0xfb, 0xde, 0xbd, 0x67, 0xd8, 0x38, 0x11, 0x44, 0x9f, 0x43,
+	0x4d, 0x27, 0xae, 0x53, 0x5b, 0x2f, 0x5d, 0x6d, 0x6e, 0x2e, 0x77, 0xe5, 0xdf, 0xc5, 0x2a, 0x7b,
+	0x8a, 0xd7, 0xbb, 0xf0, 0xfb, 0x7f, 0x57, 0x4b, 0x7f, 0xfa, 0xea, 0xd2, 0xcc, 0xbf, 0xbe, 0xba,
+	0xb4, 0x24, 0x08, 0x17, 0x9e, 0x7f, 0x78, 0x78, 0xd3, 0xf2, 0x07, 0x13, 0xca, 0x88, 0x85, 0x8d,
+	0x09, 0xf4, 0x31, 0xd4, 0xa3, 0x5d, 0xea, 0xcc, 0x29, 0x73, 0xab, 0x59, 0x73, 0xf7, 0x0d, 0xb7,
+	0x57, 0x95, 0xc6, 0x70, 0x2c, 0x7d, 0x73, 0xe5, 0xc5, 0xcb, 0x6a, 0x15, 0xca, 0xc1, 0xf1, 0x8b,
+	0x97, 0xd5, 0x06, 0x9a, 0x93, 0xd8, 0xf5, 0x09, 0xb7, 0xfe, 0x5b, 0x85, 0x7a, 0xe4, 0x35, 0x42,
+	0x50, 0x9d, 0x38, 0x63, 0xd2, 0x29, 0xad, 0x97, 0xae, 0x36, 0xb0, 0xfa, 0x46, 0xef, 0x42, 0xeb,
+	0xc0, 0x9f, 0x78, 0xb6, 0xe3, 0x79, 0x8c, 0x70, 0x19, 0xb7, 0xe4, 0x35, 0x25, 0x6d, 0x4b, 0x93,
+	0xd0, 0x3b, 0xd0, 0x50, 0x22, 0x01, 0x65, 0xa2, 0x53, 0x59, 0x2f, 0x5d, 0x6d, 0xe3, 0xba, 0x24,
+	0xec, 0x52, 0x26, 0xd0, 0x16, 0xb4, 0x87, 0x42, 0x04, 0x76, 0x94, 0x90, 0x4e, 0x55, 0xb9, 0xbd,
+	0x96, 0x4d, 0xdc, 0x8e, 0x10, 0x41, 0xe4, 0xc6, 0xce, 0x0c, 0x6e, 0x0d, 0x53, 0x6b, 0xf4, 0x63,
+	0x68, 0x09, 0x37, 0x65, 0x61, 0x56, 0x59, 0x38, 0x97, 0xb5, 0xb0, 0xef, 0xa6, 0x0d, 0x34, 0x45,
+	0xb2, 0x44, 0x77, 0x60, 0x61, 0x78, 0x72, 0xc0, 0x7c, 0x2f, 0x31, 0x01, 0xca, 0xc4, 0xf9, 0x9c,
+	0x13, 0x4a, 0x28, 0x65, 0x65, 0x7e, 0x98, 0xa1, 0x48, 0x47, 0x42, 0x2f, 0xe5, 0x48, 0xb3, 0xc8,
+	0x91, 0xc7, 0x5e, 0xc6, 0x91, 0x30, 0x59, 0xa2, 0x4f, 0x01, 0x71, 0x3e, 0xb2, 0x5d, 0x3a, 0x39,
+	0xf4, 0x07, 0xa6, 0x6c, 0x25, 0x2c, 0x24, 0x92, 0xde, 0xce, 0x5a, 0xd9, 0xe3, 0xa3, 0x6d, 0x25,
+	0x86, 0x97, 0x78, 0xf4, 0x19, 0x69, 0xa0, 0x1e, 0x2c, 0x84, 0x9c, 0xd8, 0xaa, 0xff, 0xd8, 0x0a,
+	0xa5, 0x06, 0x0c, 0x6b, 0x5d, 0xdd, 0x1d, 0xba, 0x51, 0x77, 0xe8, 0xf6, 0x28, 0x1d, 0x3d, 0x71,
+	0x46, 0x21, 0xc1, 0xed, 0x90, 0x13, 0x85, 0xe3, 0x5d, 0xc9, 0x43, 0x1f, 0xc1, 0x9c, 0xa9, 0x8f,
+	0x4e, 0x5d, 0xe9, 0x5e, 0x28, 0x86, 0xf2, 0x43, 0x2d, 0x84, 0x23, 0x69, 0xf4, 0xc3, 0x14, 0x04,
+	0x1b, 0x4a, 0xf3, 0xed, 0xa9, 0x7f, 0xdd, 0x53, 0x1d, 0xab, 0x57, 0x95, 0xa0, 0x4e, 0x30, 0xd8,
+	0x9b, 0x87, 0x56, 0x64, 0x76, 0xff, 0x24, 0x20, 0xd6, 0xcf, 0x60, 0x3e, 0x9b, 0x73, 0xf4, 0x19,
+	0x2c, 0xe9, 0xf2, 0x4b, 0xf6, 0x8a, 0x77, 0x4a, 0xeb, 0x95, 0x69, 0xff, 0xee, 0x6b, 0xb1, 0xb8,
+	0xe2, 0x16, 0xc7, 0x59, 0x02, 0xb7, 0xfe, 0x52, 0x82, 0x85, 0x9c, 0x14, 0xda, 0x80, 0x39, 0x53,
+	0xde, 0x0a, 0xe4, 0xcd, 0xcd, 0x95, 0x22, 0xab, 0x0c, 0x47, 0x52, 0xd3, 0xf0, 0x2d, 0xbf, 0x31,
+	0x7c, 0x2b, 0xaf, 0x07, 0xdf, 0xa9, 0xac, 0xfd, 0xb3, 0x04, 0x73, 0xc6, 0x4f, 0x74, 0x03, 0x20,
+	0x41, 0x94, 0x09, 0xe9, 0x54, 0x24, 0x35, 0x62, 0x24, 0xa1, 0xbb, 0xb0, 0xcc, 0x69, 0xc8, 0x5c,
+	0x09, 0x22, 0x72, 0xe8, 0x1f, 0xdb, 0xcc, 0x99, 0x0c, 0x48, 0xd4, 0xd5, 0x72, 0x16, 0xb6, 0x7d,
+	0x8f, 0x61, 0xc9, 0xc7, 0x48, 0x2b, 0xed, 0x2a, 0x1d, 0x45, 0xe2, 0xb2, 0x41, 0x70, 0xc2, 0x8e,
+	0x08, 0xb3, 0x65, 0xbf, 0xe0, 0x9d, 0xca, 0x7a, 0x45, 0x36, 0x08, 0x4d, 0x7b, 0x20, 0x49, 0xe8,
+	0x3a, 0xac, 0x38, 0x41, 0x30, 0xf2, 0x5d, 0x85, 0x5f, 0x8d, 0x58, 0x97, 0x8e, 0x78, 0xa7, 0xaa,
+	0x64, 0x97, 0x53, 0xcc, 0xdd, 0x88, 0x67, 0x51, 0x68, 0xc4, 0x7f, 0x8c, 0xde, 0x83, 0x79, 0xd3,
+	0x80, 0x8c, 0xc3, 0xa6, 0x47, 0xb5, 0x0d, 0x55, 0x7b, 0x84, 0x6e, 0x01, 0x98, 0x78, 0x46, 0x64,
+	0x62, 0xb6, 0xea, 0xfc, 0x14, 0x3a, 0x1f, 0xdf, 0x9d, 0x88, 0xeb, 0x9b, 0xba, 0x2a, 0x1a, 0x5a,
+	0xfe, 0x1e, 0x99, 0x58, 0x5f, 0x96, 0xa0, 0x99, 0xda, 0x06, 0xb4, 0x09, 0x0d, 0xb9, 0x6f, 0x43,
+	0xca, 0x45, 0x84, 0xc1, 0x95, 0xa9, 0x4d, 0xdb, 0xa1, 0x5c, 0xe0, 0xba, 0xd0, 0x1f, 0x1c, 0xdd,
+	0xcc, 0x57, 0xd5, 0xfa, 0xa9, 0xdb, 0x3c, 0x55, 0x58, 0x97, 0xa0, 0x29, 0xbb, 0x7c, 0x14, 0x60,
+	0x45, 0x05, 0x08, 0x92, 0xa4, 0xa3, 0xb3, 0x7e, 0x5d, 0x82, 0x66, 0xaa, 0xbb, 0xa0, 0x5b, 0xd0,
+	0xf4, 0x08, 0x17, 0xfe, 0x44, 0x65, 0xae, 0x53, 0x2a, 0xc2, 0xd5, 0xed, 0x44, 0x00, 0xa7, 0xa5,
+	0xd3, 0x9e, 0x96, 0x8b, 0x3c, 0x7d, 0xec, 0xbd, 0x81, 0xa7, 0xff, 0xa9, 0xc0, 0x9c, 0x49, 0x4e,
+	0xe1, 0x50, 0xc9, 0xc2, 0xb6, 0xf2, 0xb5, 0x61, 0xbb, 0x95, 0x8d, 0x58, 0x8f, 0x92, 0x4b, 0x85,
+	0x9b, 0x22, 0x7f, 0xb7, 0xdc, 0xe9, 0xb8, 0x6f, 0x24, 0x71, 0xcf, 0x16, 0x0d, 0x01, 0xa3, 0x9e,
+	0x8f, 0x79, 0xed, 0xcb, 0x32, 0x34, 0x62, 0x93, 0xe8, 0x3a, 0xd4, 0xb8, 0x3f, 0x19, 0x8c, 0xc8,
+	0x99, 0x59, 0xdf, 0x99, 0xc1, 0x46, 0x14, 0xdd, 0x80, 0xd9, 0x71, 0x38, 0x12, 0xbe, 0x49, 0xf8,
+	0xc5, 0x5c, 0xeb, 0x91, 0xac, 0xac, 0xa2, 0x16, 0x47, 0x3d, 0x98, 0x0f, 0x03, 0x2e, 0x18, 0x71,
+	0xc6, 0xf6, 0x80, 0xd1, 0x30, 0x88, 0x5b, 0x48, 0x66, 0xf4, 0x63, 0xa2, 0xab, 0x13, 0x93, 0xc3,
+	0x9d, 0x19, 0xdc, 0x8e, 0x54, 0xee, 0x48, 0x0d, 0xf4, 0x08, 0x3a, 0x87, 0x94, 0x3d, 0x77, 0x98,
+	0x67, 0xf3, 0x89, 0x6f, 0xbb, 0xa3, 0x90, 0x0b, 0x53, 0xb2, 0x26, 0x8d, 0xab, 0x53, 0x75, 0xd2,
+	0x97, 0x87, 0xd2, 0x9d, 0x19, 0xbc, 0x62, 0x34, 0xf7, 0x26, 0xfe, 0xb6, 0xd6, 0x93, 0x65, 0xdd,
+	0x6b, 0x67, 0x36, 0xe3, 0xb3, 0x6a, 0xbd, 0xbc, 0x58, 0xb1, 0x7e, 0x5b, 0x82, 0xd6, 0x4e, 0xb6,
+	0xfb, 0xb5, 0x8f, 0x7c, 0x26, 0x42, 0x67, 0x94, 0xa9, 0xa4, 0x5c, 0xc2, 0x9e, 0x68, 0x11, 0x55,
+	0x4d, 0xad, 0xa3, 0x64, 0xc1, 0xd1, 0xad, 0x3c, 0x4e, 0xdf, 0x3d, 0xbd, 0xf5, 0xbe, 0x3e, 0x50,
+	0xff, 0x5e, 0x82, 0x66, 0xea, 0xbf, 0x0b, 0xc1, 0xda, 0x81, 0x39, 0x8f, 0x8e, 0x1d, 0x7f, 0xa2,
+	0xdb, 0x63, 0x03, 0x47, 0x4b, 0xf4, 0x5d, 0xa8, 0x31, 0x1a, 0x0a, 0xd3, 0xf4, 0x9a, 0x9b, 0x6f,
+	0x65, 0x5d, 0xc3, 0x92, 0x87, 0x8d, 0x48, 0xba, 0xe0, 0xaa, 0x45, 0x05, 0x97, 0x72, 0xe3, 0x95,
+	0x33, 0xb7, 0xf6, 0x5a, 0x33, 0xd7, 0xfa, 0x63, 0x05, 0x66, 0x95, 0x23, 0xe8, 0x27, 0x50, 0x8f,
+	0x8e, 0xb6, 0x66, 0x13, 0x2e, 0x77, 0x23, 0x82, 0x46, 0x52, 0xe1, 0x28, 0x8c, 0x95, 0xe4, 0x20,
+	0x53, 0xb1, 0xd8, 0x8e, 0x2a, 0x02, 0xb3, 0x1f, 0xe7, 0x0a, 0x82, 0xd6, 0x55, 0x22, 0x07, 0x19,
+	0x4b, 0x96, 0xf2, 0x1c, 0xc6, 0x88, 0xe7, 0x33, 0xe2, 0x8a, 0xc8, 0x44, 0xa5, 0xa8, 0x04, 0xb1,
+	0x11, 0x8a, 0xad, 0xcc, 0xb3, 0x0c, 0x05, 0x7d, 0x01, 0xab, 0xc6, 0x0c, 0x23, 0x3c, 0xa0, 0x13,
+	0x1e, 0xbb, 0xa4, 0x33, 0x6b, 0xe5, 0xaa, 0x51, 0xc9, 0x62, 0x23, 0x1a, 0x5b, 0x5d, 0xf6, 0x0a,
+	0xe8, 0xe8, 0xc3, 0x7c, 0x7f, 0x58, 0x2b, 0x88, 0xef, 0x1b, 0xdc, 0xa0, 0x18, 0x72, 0x73, 0x09,
+	0xe4, 0x7a, 0x75, 0xa8, 0xe9, 0x80, 0xac, 0x3f, 0x97, 0xa0, 0x99, 0x4a, 0xe9, 0xb7, 0xae, 0xf1,
+	0xe4, 0xba, 0x84, 0xf5, 0xd7, 0x32, 0x34, 0x53, 0xff, 0x85, 0x3e, 0x82, 0x7a, 0x24, 0xdf, 0x81,
+	0xb3, 0x8d, 0xc7, 0xc2, 0xe8, 0x13, 0xa8, 0x3e, 0x0b, 0x0f, 0x88, 0x39, 0x83, 0x7f, 0x27, 0x1b,
+	0xd2, 0xe7, 0xe1, 0x01, 0x61, 0x13, 0x22, 0x08, 0xdf, 0x23, 0xec, 0xc8, 0x77, 0x49, 0x36, 0x3c,
+	0xa5, 0x89, 0x3e, 0x81, 0x9a, 0x4b, 0x27, 0x3c, 0x1c, 0x75, 0x5a, 0xca, 0xc6, 0xfb, 0xb9, 0x53,
+	0x8f, 0xe2, 0x15, 0xea, 0x1b, 0x3d, 0xb4, 0x03, 0x8b, 0xa9, 0xd8, 0x6c, 0x1e, 0x10, 0xd7, 0xa4,
+	0xf8, 0xc2, 0xa9, 0xdb, 0xb2, 0x17, 0x10, 0x17, 0x2f, 0x78, 0x59, 0x02, 0xfa, 0x1e, 0xd4, 0xf4,
+	0xad, 0xd6, 0x64, 0x78, 0x39, 0x37, 0x0c, 0x15, 0x0f, 0x1b, 0x99, 0x1e, 0xca, 0xfe, 0xaf, 0x90,
+	0xa7, 0x42, 0x02, 0xe7, 0x5f, 0x15, 0x35, 0xba, 0x06, 0x15, 0x46, 0x0e, 0x3b, 0xa5, 0x33, 0x72,
+	0x6c, 0xee, 0x8d, 0x52, 0x56, 0x22, 0x53, 0x5d, 0xe9, 0xca, 0xea, 0x4a, 0xa7, 0xbe, 0x2d, 0x01,
+	0x9d, 0xd3, 0x12, 0x13, 0x9d, 0x04, 0x7d, 0x97, 0xd8, 0xa9, 0x26, 0xda, 0x34, 0x34, 0x39, 0x33,
+	0xa4, 0x49, 0xe1, 0x0c, 0xa2, 0x46, 0xaa, 0xbe, 0xa5, 0x9a, 0x2c, 0x04, 0xdb, 0x25, 0x13, 0x41,
+	0x98, 0xee, 0xa5, 0x0d, 0xdc, 0x94, 0xb4, 0x6d, 0x4d, 0xb2, 0xfe, 0x50, 0x86, 0xf6, 0xe3, 0xcc,
+	0x3c, 0xeb, 0x43, 0x2b, 0x95, 0x82, 0xa8, 0xa1, 0xe5, 0x66, 0xc3, 0x4f, 0x89, 0x3f, 0x18, 0x0a,
+	0xe2, 0xa5, 0x0f, 0x41, 0x19, 0x35, 0xf4, 0x23, 0x98, 0x63, 0x74, 0x34, 0xa2, 0xa1, 0xe8, 0x94,
+	0x8b, 0x5a, 0x47, 0xe6, 0x4f, 0xb1, 0x96, 0xc4, 0x91, 0xca, 0xff, 0xcb, 0xd5, 0xfe, 0x82, 0xbe,
+	0xda, 0x87, 0x83, 0x17, 0x2f, 0xab, 0x4b, 0x68, 0x21, 0x5b, 0xb2, 0xdc, 0x7a, 0x0a, 0x8b, 0xf9,
+	0x12, 0xff, 0x86, 0xd2, 0x67, 0xfd, 0xae, 0x04, 0x6f, 0x15, 0x48, 0xbd, 0xd9, 0xc9, 0x74, 0x15,
+	0x6a, 0xcf, 0x95, 0x4d, 0x03, 0x3c, 0xb3, 0x42, 0xbd, 0xa4, 0x33, 0xeb, 0x22, 0xb9, 0x7a, 0xa6,
+	0xbb, 0xf9, 0x3e, 0x6d, 0xfd, 0xaa, 0x0a, 0xf3, 0xd9, 0xf1, 0x82, 0x2e, 0x43, 0x5b, 0x1e, 0x4c,
+	0xec, 0x68, 0xc6, 0x18, 0xd8, 0xb6, 0x24, 0x31, 0x12, 0x45, 0xef, 0x41, 0x3b, 0x70, 0xc4, 0x30,
+	0x11, 0x52, 0xcf, 0x20, 0xf2, 0xaa, 0x27, 0xc9, 0xb1, 0xd8, 0x15, 0x98, 0x8f, 0xee, 0x53, 0xe4,
+	0x39, 0xf3, 0x05, 0xe9, 0xcc, 0x1a, 0xb9, 0xb6, 0xa6, 0x63, 0x4d, 0x46, 0x4f, 0xa0, 0x1d, 0x8f,
+	0x2e, 0x97, 0x7a, 0x44, 0x45, 0x34, 0xbf, 0x79, 0xed, 0x55, 0x83, 0x30, 0x5e, 0x46, 0x13, 0x6b,
+	0x9b, 0x7a, 0x04, 0xb7, 0x58, 0x6a, 0x25, 0xef, 0x49, 0xf2, 0xee, 0xc9, 0x13, 0x47, 0xe5, 0x44,
+	0xac, 0x63, 0x75, 0x89, 0xe5, 0xb1, 0x9f, 0xea, 0x5c, 0xc4, 0xfc, 0xc0, 0xfe, 0x45, 0x48, 0xd8,
+	0x89, 0x42, 0x6f, 0x5d, 0x9e, 0x8b, 0x98, 0x1f, 0x3c, 0x92, 0x14, 0x74, 0x05, 0x16, 0xb8, 0x3b,
+	0x24, 0x63, 0x92, 0x18, 0xd2, 0xf3, 0x69, 0x5e, 0x93, 0x63, 0x4b, 0x97, 0xa1, 0x2d, 0xfb, 0x42,
+	0x22, 0x56, 0x57, 0x7b, 0xd6, 0x92, 0xc4, 0x48, 0xc8, 0x7a, 0x0e, 0xcb, 0x45, 0xbe, 0xa3, 0x15,
+	0x58, 0xba, 0xff, 0xf0, 0x49, 0xff, 0xb6, 0xbd, 0xdb, 0xc7, 0xf7, 0xb7, 0x1e, 0xf4, 0x1f, 0xec,
+	0xdf, 0x7b, 0xba, 0x38, 0x83, 0x1a, 0x30, 0xfb, 0xe9, 0xc3, 0xc7, 0x0f, 0x6e, 0x2f, 0x96, 0x50,
+	0x1b, 0x1a, 0x7b, 0xfd, 0xbe, 0xfd, 0x70, 0x7f, 0xa7, 0x8f, 0x17, 0xcb, 0x68, 0x15, 0xd0, 0x7e,
+	0xff, 0xfe, 0xee, 0x43, 0xbc, 0x85, 0x9f, 0xda, 0xb8, 0x7f, 0xfb, 0x2e, 0xee, 0x6f, 0xef, 0x2f,
+	0x56, 0x24, 0x3d, 0x36, 0x91, 0xd0, 0xab, 0xbd, 0x0e, 0xac, 0x9a, 0x6d, 0x53, 0x69, 0x57, 0x1d,
+	0xda, 0x3f, 0xf4, 0x09, 0xb3, 0x7a, 0xb0, 0x5c, 0x74, 0x2c, 0x90, 0xe0, 0x33, 0x25, 0x5d, 0xd2,
+	0xe0, 0xd3, 0x2b, 0xd9, 0xb8, 0x0e, 0xa8, 0x77, 0x62, 0x9e, 0xbf, 0xd4, 0xb7, 0xf5, 0xcb, 0x0a,
+	0x2c, 0x17, 0x35, 0x08, 0x74, 0x0d, 0x6a, 0xae, 0x33, 0x71, 0xd8, 0xc9, 0xd9, 0xc8, 0x37, 0x82,
+	0x7a, 0x47, 0x48, 0x60, 0x67, 0x90, 0x0f, 0x92, 0xa4, 0x61, 0x8d, 0x7e, 0x00, 0x75, 0x5f, 0x36,
+	0xc3, 0x23, 0x67, 0x94, 0xbc, 0x20, 0xe4, 0x4e, 0x18, 0xb7, 0xcd, 0x0b, 0x11, 0x8e, 0x45, 0xd1,
+	0x05, 0x80, 0xb1, 0x73, 0x1c, 0x99, 0xad, 0x2a, 0xb3, 0x8d, 0xb1, 0x73, 0x6c, 0xac, 0x3e, 0x82,
+	0xd6, 0x98, 0x08, 0xe6, 0xbb, 0xf6, 0x20, 0x74, 0x98, 0x67, 0x8e, 0x3c, 0xdd, 0xb3, 0x9b, 0xa0,
+	0xec, 0x46, 0xcc, 0x77, 0xef, 0x48, 0x2d, 0xdc, 0x1c, 0x27, 0x8b, 0x35, 0x0a, 0xcd, 0x14, 0x0f,
+	0x7d, 0x00, 0x28, 0x60, 0x74, 0x4c, 0xc4, 0x90, 0x84, 0x3c, 0x7e, 0x45, 0xd4, 0x35, 0xb6, 0x94,
+	0x70, 0xa2, 0xb7, 0xc4, 0x65, 0x98, 0xd5, 0x98, 0xd4, 0x89, 0xd6, 0x0b, 0xf9, 0xc2, 0x28, 0xa3,
+	0x38, 0x92, 0x57, 0x76, 0x15, 0xbd, 0x3c, 0x40, 0x39, 0xc7, 0xea, 0x0a, 0xdf, 0xbb, 0x29, 0xfb,
+	0xeb, 0x6f, 0xfe, 0x71, 0xb1, 0xf4, 0xc5, 0xf7, 0xbf, 0xde, 0xf3, 0x7d, 0xf0, 0x6c, 0x60, 0x5e,
+	0x7e, 0x0f, 0x6a, 0x2a, 0x77, 0xd7, 0xff, 0x37, 0x00, 0xaa, 0x18, 0x80, 0x2d, 0xf9, 0x17, 0x00,
+	0x00,
+}
+
+func (this *Proxy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Proxy)
+	if !ok {
+		that2, ok := that.(Proxy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
 	} else if this == nil {
 		return false
 	}
@@ -1734,6 +2283,251 @@ func (this *Listener_TcpListener) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Listener_HybridListener) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Listener_HybridListener)
+	if !ok {
+		that2, ok := that.(Listener_HybridListener)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.HybridListener.Equal(that1.HybridListener) {
+		return false
+	}
+	return true
+}
+func (this *Listener_UdpListener) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Listener_UdpListener)
+	if !ok {
+		that2, ok := that.(Listener_UdpListener)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.UdpListener.Equal(that1.UdpListener) {
+		return false
+	}
+	return true
+}
+func (this *HybridListener) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HybridListener)
+	if !ok {
+		that2, ok := that.(HybridListener)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.MatchedListeners) != len(that1.MatchedListeners) {
+		return false
+	}
+	for i := range this.MatchedListeners {
+		if !this.MatchedListeners[i].Equal(that1.MatchedListeners[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MatchedListener) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MatchedListener)
+	if !ok {
+		that2, ok := that.(MatchedListener)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Matcher.Equal(that1.Matcher) {
+		return false
+	}
+	if that1.ListenerType == nil {
+		if this.ListenerType != nil {
+			return false
+		}
+	} else if this.ListenerType == nil {
+		return false
+	} else if !this.ListenerType.Equal(that1.ListenerType) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MatchedListener_HttpListener) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MatchedListener_HttpListener)
+	if !ok {
+		that2, ok := that.(MatchedListener_HttpListener)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.HttpListener.Equal(that1.HttpListener) {
+		return false
+	}
+	return true
+}
+func (this *MatchedListener_TcpListener) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MatchedListener_TcpListener)
+	if !ok {
+		that2, ok := that.(MatchedListener_TcpListener)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.TcpListener.Equal(that1.TcpListener) {
+		return false
+	}
+	return true
+}
+func (this *Matcher) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Matcher)
+	if !ok {
+		that2, ok := that.(Matcher)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SslConfig.Equal(that1.SslConfig) {
+		return false
+	}
+	if len(this.SourcePrefixRanges) != len(that1.SourcePrefixRanges) {
+		return false
+	}
+	for i := range this.SourcePrefixRanges {
+		if !this.SourcePrefixRanges[i].Equal(that1.SourcePrefixRanges[i]) {
+			return false
+		}
+	}
+	if len(this.ServerNames) != len(that1.ServerNames) {
+		return false
+	}
+	for i := range this.ServerNames {
+		if this.ServerNames[i] != that1.ServerNames[i] {
+			return false
+		}
+	}
+	if len(this.ApplicationProtocols) != len(that1.ApplicationProtocols) {
+		return false
+	}
+	for i := range this.ApplicationProtocols {
+		if this.ApplicationProtocols[i] != that1.ApplicationProtocols[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *CidrRange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CidrRange)
+	if !ok {
+		that2, ok := that.(CidrRange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.AddressPrefix != that1.AddressPrefix {
+		return false
+	}
+	if !this.PrefixLen.Equal(that1.PrefixLen) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *TcpListener) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1772,6 +2566,39 @@ func (this *TcpListener) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *UdpListener) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UdpListener)
+	if !ok {
+		that2, ok := that.(UdpListener)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Destination.Equal(that1.Destination) {
+		return false
+	}
+	if !this.Options.Equal(that1.Options) {
+		return false
+	}
+	if this.StatPrefix != that1.StatPrefix {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *TcpHost) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1800,6 +2627,9 @@ func (this *TcpHost) Equal(that interface{}) bool {
 	if !this.Destination.Equal(that1.Destination) {
 		return false
 	}
+	if !this.Options.Equal(that1.Options) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -2459,6 +3289,9 @@ func (this *UpstreamGroup) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.Rollout.Equal(that1.Rollout) {
+		return false
+	}
 	if !this.Status.Equal(&that1.Status) {
 		return false
 	}
@@ -2575,6 +3408,12 @@ func (this *RedirectAction) Equal(that interface{}) bool {
 	if this.StripQuery != that1.StripQuery {
 		return false
 	}
+	if this.SchemeRedirect != that1.SchemeRedirect {
+		return false
+	}
+	if this.PortRedirect != that1.PortRedirect {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -2658,3 +3497,75 @@ func (this *DirectResponseAction) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *UpstreamGroupRollout) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamGroupRollout)
+	if !ok {
+		that2, ok := that.(UpstreamGroupRollout)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Canary.Equal(that1.Canary) {
+		return false
+	}
+	if this.StepWeight != that1.StepWeight {
+		return false
+	}
+	if !this.Interval.Equal(that1.Interval) {
+		return false
+	}
+	if this.MaxWeight != that1.MaxWeight {
+		return false
+	}
+	if !this.MetricGuard.Equal(that1.MetricGuard) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *UpstreamGroupRollout_MetricGuard) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamGroupRollout_MetricGuard)
+	if !ok {
+		that2, ok := that.(UpstreamGroupRollout_MetricGuard)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PrometheusAddress != that1.PrometheusAddress {
+		return false
+	}
+	if this.Query != that1.Query {
+		return false
+	}
+	if this.MaxValue != that1.MaxValue {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
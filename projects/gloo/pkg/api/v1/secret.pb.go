@@ -26,17 +26,16 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Certain features such as the AWS Lambda option require the use of secrets for authentication, configuration of SSL Certificates, and other data that should not be stored in plaintext configuration.
 //
-//Certain features such as the AWS Lambda option require the use of secrets for authentication, configuration of SSL Certificates, and other data that should not be stored in plaintext configuration.
+// Gloo runs an independent (goroutine) controller to monitor secrets. Secrets are stored in their own secret storage layer. Gloo can monitor secrets stored in the following secret storage services:
 //
-//Gloo runs an independent (goroutine) controller to monitor secrets. Secrets are stored in their own secret storage layer. Gloo can monitor secrets stored in the following secret storage services:
+// - Kubernetes Secrets
+// - Hashicorp Vault
+// - Plaintext files (recommended only for testing)
+// - Secrets must adhere to a structure, specified by the option that requires them.
 //
-//- Kubernetes Secrets
-//- Hashicorp Vault
-//- Plaintext files (recommended only for testing)
-//- Secrets must adhere to a structure, specified by the option that requires them.
-//
-//Gloo's secret backend can be configured in Gloo's bootstrap options
+// Gloo's secret backend can be configured in Gloo's bootstrap options
 type Secret struct {
 	// Types that are valid to be assigned to Kind:
 	//	*Secret_Aws
@@ -45,6 +44,7 @@ type Secret struct {
 	//	*Secret_Oauth
 	//	*Secret_ApiKey
 	//	*Secret_Header
+	//	*Secret_ConsulToken
 	//	*Secret_Extensions
 	Kind isSecret_Kind `protobuf_oneof:"kind"`
 	// Metadata contains the object metadata for this resource
@@ -101,17 +101,21 @@ type Secret_ApiKey struct {
 type Secret_Header struct {
 	Header *HeaderSecret `protobuf:"bytes,8,opt,name=header,proto3,oneof" json:"header,omitempty"`
 }
+type Secret_ConsulToken struct {
+	ConsulToken *ConsulTokenSecret `protobuf:"bytes,9,opt,name=consul_token,json=consulToken,proto3,oneof" json:"consul_token,omitempty"`
+}
 type Secret_Extensions struct {
 	Extensions *Extensions `protobuf:"bytes,4,opt,name=extensions,proto3,oneof" json:"extensions,omitempty"`
 }
 
-func (*Secret_Aws) isSecret_Kind()        {}
-func (*Secret_Azure) isSecret_Kind()      {}
-func (*Secret_Tls) isSecret_Kind()        {}
-func (*Secret_Oauth) isSecret_Kind()      {}
-func (*Secret_ApiKey) isSecret_Kind()     {}
-func (*Secret_Header) isSecret_Kind()     {}
-func (*Secret_Extensions) isSecret_Kind() {}
+func (*Secret_Aws) isSecret_Kind()         {}
+func (*Secret_Azure) isSecret_Kind()       {}
+func (*Secret_Tls) isSecret_Kind()         {}
+func (*Secret_Oauth) isSecret_Kind()       {}
+func (*Secret_ApiKey) isSecret_Kind()      {}
+func (*Secret_Header) isSecret_Kind()      {}
+func (*Secret_ConsulToken) isSecret_Kind() {}
+func (*Secret_Extensions) isSecret_Kind()  {}
 
 func (m *Secret) GetKind() isSecret_Kind {
 	if m != nil {
@@ -162,6 +166,13 @@ func (m *Secret) GetHeader() *HeaderSecret {
 	return nil
 }
 
+func (m *Secret) GetConsulToken() *ConsulTokenSecret {
+	if x, ok := m.GetKind().(*Secret_ConsulToken); ok {
+		return x.ConsulToken
+	}
+	return nil
+}
+
 func (m *Secret) GetExtensions() *Extensions {
 	if x, ok := m.GetKind().(*Secret_Extensions); ok {
 		return x.Extensions
@@ -185,60 +196,68 @@ func (*Secret) XXX_OneofWrappers() []interface{} {
 		(*Secret_Oauth)(nil),
 		(*Secret_ApiKey)(nil),
 		(*Secret_Header)(nil),
+		(*Secret_ConsulToken)(nil),
 		(*Secret_Extensions)(nil),
 	}
 }
 
+// There are two ways of providing AWS secrets:
 //
+// - Method 1: `glooctl create secret aws`
 //
-//There are two ways of providing AWS secrets:
+// ```
 //
-//- Method 1: `glooctl create secret aws`
+//	glooctl create secret aws --name aws-secret-from-glooctl \
+//	    --namespace default \
+//	    --access-key $ACC \
+//	    --secret-key $SEC
 //
 // ```
-// glooctl create secret aws --name aws-secret-from-glooctl \
-//     --namespace default \
-//     --access-key $ACC \
-//     --secret-key $SEC
-// ```
 //
-//will produce a Kubernetes resource similar to this (note the `aws` field and `resource_kind` annotation):
+// will produce a Kubernetes resource similar to this (note the `aws` field and `resource_kind` annotation):
 //
 // ```
 // apiVersion: v1
 // data:
-//   aws: base64EncodedStringForMachineConsumption
+//
+//	aws: base64EncodedStringForMachineConsumption
+//
 // kind: Secret
 // metadata:
-//   annotations:
-//     resource_kind: '*v1.Secret'
-//   creationTimestamp: "2019-08-23T15:10:20Z"
-//   name: aws-secret-from-glooctl
-//   namespace: default
-//   resourceVersion: "592637"
-//   selfLink: /api/v1/namespaces/default/secrets/secret-e2e
-//   uid: 1f8c147f-c5b8-11e9-bbf3-42010a8001bc
+//
+//	annotations:
+//	  resource_kind: '*v1.Secret'
+//	creationTimestamp: "2019-08-23T15:10:20Z"
+//	name: aws-secret-from-glooctl
+//	namespace: default
+//	resourceVersion: "592637"
+//	selfLink: /api/v1/namespaces/default/secrets/secret-e2e
+//	uid: 1f8c147f-c5b8-11e9-bbf3-42010a8001bc
+//
 // type: Opaque
 // ```
 //
 // - Method 2: `kubectl apply -f resource-file.yaml`
 //   - If using a git-ops flow, or otherwise creating secrets from yaml files, you may prefer to provide AWS credentials
-//   using the format below, with `aws_access_key_id` and `aws_secret_access_key` fields.
+//     using the format below, with `aws_access_key_id` and `aws_secret_access_key` fields.
 //   - This circumvents the need for the annotation, which are not supported by some tools such as
-//   [godaddy/kubernetes-external-secrets](https://github.com/godaddy/kubernetes-external-secrets)
+//     [godaddy/kubernetes-external-secrets](https://github.com/godaddy/kubernetes-external-secrets)
 //
 // ```yaml
 // # a sample aws secret resource-file.yaml
 // apiVersion: v1
 // data:
-//   aws_access_key_id: some-id
-//   aws_secret_access_key: some-secret
+//
+//	aws_access_key_id: some-id
+//	aws_secret_access_key: some-secret
+//
 // kind: Secret
 // metadata:
-//   name: aws-secret-abcd
-//   namespace: default
-// ```
 //
+//	name: aws-secret-abcd
+//	namespace: default
+//
+// ```
 type AwsSecret struct {
 	// provided by `glooctl create secret aws`
 	AccessKey string `protobuf:"bytes,1,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
@@ -335,9 +354,8 @@ func (m *AzureSecret) GetApiKeys() map[string]string {
 	return nil
 }
 
-//
-//Note that the annotation `resource_kind: '*v1.Secret'` is needed for Gloo to find this secret.
-//Glooctl adds it by default when the tls secret is created via `glooctl create secret tls`.
+// Note that the annotation `resource_kind: '*v1.Secret'` is needed for Gloo to find this secret.
+// Glooctl adds it by default when the tls secret is created via `glooctl create secret tls`.
 type TlsSecret struct {
 	// provided by `glooctl create secret tls`
 	CertChain string `protobuf:"bytes,1,opt,name=cert_chain,json=certChain,proto3" json:"cert_chain,omitempty"`
@@ -435,6 +453,47 @@ func (m *HeaderSecret) GetHeaders() map[string]string {
 	return nil
 }
 
+// A Consul [ACL token](https://www.consul.io/docs/security/acl/acl-system#acl-tokens). In Kubernetes, it is provided
+// by a secret of type `gloo.solo.io/consul-token` with the token in its `token` key.
+type ConsulTokenSecret struct {
+	// The secret ID of the ACL token
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConsulTokenSecret) Reset()         { *m = ConsulTokenSecret{} }
+func (m *ConsulTokenSecret) String() string { return proto.CompactTextString(m) }
+func (*ConsulTokenSecret) ProtoMessage()    {}
+func (*ConsulTokenSecret) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2f79c35f1213791, []int{5}
+}
+func (m *ConsulTokenSecret) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsulTokenSecret.Unmarshal(m, b)
+}
+func (m *ConsulTokenSecret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsulTokenSecret.Marshal(b, m, deterministic)
+}
+func (m *ConsulTokenSecret) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsulTokenSecret.Merge(m, src)
+}
+func (m *ConsulTokenSecret) XXX_Size() int {
+	return xxx_messageInfo_ConsulTokenSecret.Size(m)
+}
+func (m *ConsulTokenSecret) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsulTokenSecret.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsulTokenSecret proto.InternalMessageInfo
+
+func (m *ConsulTokenSecret) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Secret)(nil), "gloo.solo.io.Secret")
 	proto.RegisterType((*AwsSecret)(nil), "gloo.solo.io.AwsSecret")
@@ -443,6 +502,7 @@ func init() {
 	proto.RegisterType((*TlsSecret)(nil), "gloo.solo.io.TlsSecret")
 	proto.RegisterType((*HeaderSecret)(nil), "gloo.solo.io.HeaderSecret")
 	proto.RegisterMapType((map[string]string)(nil), "gloo.solo.io.HeaderSecret.HeadersEntry")
+	proto.RegisterType((*ConsulTokenSecret)(nil), "gloo.solo.io.ConsulTokenSecret")
 }
 
 func init() {
@@ -450,46 +510,48 @@ func init() {
 }
 
 var fileDescriptor_c2f79c35f1213791 = []byte{
-	// 622 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0xad, 0x9b, 0xc4, 0x69, 0x6e, 0x82, 0x84, 0x46, 0x55, 0x6b, 0x22, 0xb5, 0x45, 0xe1, 0x55,
-	0x81, 0xb0, 0x69, 0x61, 0x51, 0x22, 0x16, 0xa4, 0x55, 0xa5, 0x4a, 0x08, 0x21, 0x85, 0xae, 0xd8,
-	0x44, 0x53, 0x67, 0x94, 0x0c, 0x71, 0x3d, 0xd6, 0xcc, 0xa4, 0x0f, 0x96, 0x5d, 0xc3, 0x7f, 0xf0,
-	0x09, 0x7c, 0x02, 0x5f, 0x81, 0x04, 0x7f, 0xd0, 0x05, 0x7b, 0x74, 0x67, 0xc6, 0x8e, 0x83, 0x08,
-	0x82, 0x9d, 0xef, 0x3d, 0xe7, 0x5c, 0xcf, 0x99, 0x7b, 0x34, 0xf0, 0x7c, 0xc4, 0xf5, 0x78, 0x7a,
-	0x12, 0xc6, 0xe2, 0x34, 0x52, 0x22, 0x11, 0x8f, 0xb9, 0x88, 0x46, 0x89, 0x10, 0x51, 0x26, 0xc5,
-	0x7b, 0x16, 0x6b, 0x65, 0x2b, 0x9a, 0xf1, 0xe8, 0x6c, 0x27, 0x52, 0x2c, 0x96, 0x4c, 0x87, 0x99,
-	0x14, 0x5a, 0x90, 0x16, 0x22, 0x21, 0x8a, 0x42, 0x2e, 0xda, 0xab, 0x23, 0x31, 0x12, 0x06, 0x88,
-	0xf0, 0xcb, 0x72, 0xda, 0x84, 0x5d, 0x68, 0xdb, 0x64, 0x17, 0x4e, 0xd7, 0x7e, 0xb8, 0x78, 0x3e,
-	0xbb, 0xd0, 0x2c, 0x55, 0x5c, 0xa4, 0xca, 0x71, 0x0f, 0xff, 0xc2, 0x4d, 0x35, 0x93, 0x99, 0xe4,
-	0x8a, 0x45, 0x22, 0xd3, 0xa8, 0x41, 0x39, 0x9d, 0xea, 0xb1, 0x9b, 0x84, 0x9f, 0x6e, 0xcc, 0xa6,
-	0xb1, 0x36, 0xe1, 0x3a, 0x17, 0x9f, 0x32, 0x4d, 0x87, 0x54, 0xd3, 0x45, 0x78, 0x5e, 0x5b, 0xbc,
-	0xf3, 0xbd, 0x02, 0xfe, 0x5b, 0xe3, 0x9d, 0x3c, 0x82, 0x0a, 0x3d, 0x57, 0x81, 0x77, 0xdb, 0xdb,
-	0x6e, 0xee, 0xae, 0x87, 0xe5, 0x3b, 0x08, 0x7b, 0xe7, 0xca, 0xb2, 0x8e, 0x96, 0xfa, 0xc8, 0x22,
-	0x3b, 0x50, 0xa3, 0x1f, 0xa6, 0x92, 0x05, 0xcb, 0x86, 0x7e, 0xeb, 0x37, 0x3a, 0x42, 0x85, 0xc0,
-	0x32, 0x71, 0xbe, 0x4e, 0x54, 0x50, 0xf9, 0xd3, 0xfc, 0xe3, 0xa4, 0x34, 0x5f, 0x27, 0x8a, 0xbc,
-	0x80, 0x9a, 0x40, 0x9b, 0x41, 0xcd, 0xd0, 0xef, 0x86, 0xb3, 0x4b, 0x99, 0x57, 0xbe, 0x41, 0xd6,
-	0xec, 0x57, 0x46, 0x44, 0x5e, 0x42, 0x9d, 0x66, 0x7c, 0x30, 0x61, 0x97, 0x81, 0x6f, 0xf4, 0xf7,
-	0x16, 0xea, 0x7b, 0x19, 0x7f, 0xc5, 0x2e, 0x8b, 0x01, 0x3e, 0x35, 0x35, 0x79, 0x06, 0xfe, 0x98,
-	0xd1, 0x21, 0x93, 0xc1, 0x8a, 0x19, 0xd0, 0x9e, 0x57, 0x1d, 0x19, 0x6c, 0xa6, 0xb2, 0x5c, 0xd2,
-	0x05, 0x98, 0x2d, 0x3a, 0xa8, 0x1a, 0x65, 0x30, 0xaf, 0x3c, 0x2c, 0xf0, 0xa3, 0xa5, 0x7e, 0x89,
-	0x4d, 0xf6, 0x60, 0x25, 0xdf, 0x5d, 0x50, 0x37, 0xca, 0xb5, 0x30, 0x16, 0x92, 0x15, 0xca, 0xd7,
-	0x0e, 0xdd, 0xaf, 0x7e, 0xfd, 0xb6, 0xb5, 0xd4, 0x2f, 0xd8, 0xdd, 0xb5, 0xab, 0xeb, 0x6a, 0x0d,
-	0x2a, 0x8a, 0xc5, 0x57, 0xd7, 0xd5, 0x06, 0xa9, 0xdb, 0x2c, 0xab, 0x7d, 0x1f, 0xaa, 0x13, 0x9e,
-	0x0e, 0x3b, 0x29, 0x34, 0x8a, 0xfd, 0x91, 0x0d, 0x00, 0x1a, 0xc7, 0x4c, 0x29, 0x73, 0x3b, 0xb8,
-	0xec, 0x46, 0xbf, 0x61, 0x3b, 0xe8, 0x7b, 0x03, 0xc0, 0xca, 0x0d, 0xbc, 0x6c, 0x61, 0xdb, 0x41,
-	0xf8, 0x0e, 0xdc, 0x50, 0x4c, 0xe1, 0x81, 0x07, 0x5a, 0x4c, 0x58, 0x6a, 0xb6, 0xd9, 0xe8, 0xb7,
-	0x5c, 0xf3, 0x18, 0x7b, 0x9d, 0x8f, 0x1e, 0x34, 0x4b, 0x09, 0x20, 0x3d, 0x58, 0x71, 0xdb, 0xc0,
-	0x74, 0x55, 0xb6, 0x9b, 0xbb, 0xf7, 0x17, 0xc6, 0xc5, 0xed, 0x43, 0x1d, 0xa6, 0x5a, 0x5e, 0xf6,
-	0xeb, 0x76, 0x1b, 0xaa, 0xdd, 0x85, 0x56, 0x19, 0x20, 0x37, 0xa1, 0x32, 0x3b, 0x3e, 0x7e, 0x92,
-	0x55, 0xa8, 0x9d, 0xd1, 0x64, 0xca, 0xdc, 0x99, 0x6d, 0xd1, 0x5d, 0xde, 0xf3, 0x3a, 0x43, 0x68,
-	0x14, 0xf1, 0x42, 0x7f, 0x31, 0x93, 0x7a, 0x10, 0x8f, 0x29, 0x4f, 0x73, 0xfb, 0xd8, 0x39, 0xc0,
-	0x06, 0xd9, 0x82, 0x66, 0x26, 0xf9, 0x19, 0xd5, 0xac, 0xe4, 0x1f, 0x5c, 0x0b, 0x2f, 0x60, 0x1d,
-	0xea, 0x52, 0x08, 0x3d, 0x88, 0xa9, 0xb3, 0xee, 0x63, 0x79, 0x40, 0x3b, 0x9f, 0x3c, 0x68, 0x95,
-	0x53, 0x41, 0x7a, 0x50, 0xb7, 0xa9, 0xc8, 0x4d, 0x3f, 0x58, 0x1c, 0x21, 0x57, 0xe4, 0xae, 0x9d,
-	0x0e, 0x5d, 0x97, 0x81, 0xff, 0x71, 0xbd, 0xdf, 0xfd, 0xf2, 0xb3, 0xea, 0x7d, 0xfe, 0xb1, 0xe9,
-	0xbd, 0x7b, 0xf2, 0x6f, 0x0f, 0x61, 0x36, 0x19, 0xb9, 0x37, 0xe2, 0xc4, 0x37, 0x6f, 0xc3, 0xd3,
-	0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6f, 0xfe, 0x86, 0x1a, 0x43, 0x05, 0x00, 0x00,
+	// 651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0x13, 0x31,
+	0x10, 0xee, 0x36, 0xc9, 0xa6, 0x99, 0x04, 0x04, 0x56, 0x45, 0x97, 0x48, 0x6d, 0x51, 0xf8, 0x2b,
+	0x20, 0x76, 0x69, 0xe1, 0x50, 0x22, 0x0e, 0xa4, 0xa5, 0x52, 0x25, 0x84, 0x90, 0x42, 0x4f, 0x5c,
+	0x22, 0x77, 0x63, 0x25, 0x26, 0xdb, 0xf5, 0xca, 0x76, 0xda, 0x86, 0x23, 0x67, 0x78, 0x0a, 0x2e,
+	0x3c, 0x02, 0x8f, 0xc0, 0x53, 0x70, 0xe0, 0x0d, 0x38, 0x70, 0x47, 0x63, 0x3b, 0x9b, 0x0d, 0x10,
+	0x04, 0xb7, 0x9d, 0xf9, 0x7e, 0xe2, 0x19, 0x7f, 0x31, 0x3c, 0x1e, 0x70, 0x3d, 0x1c, 0x1f, 0x87,
+	0xb1, 0x38, 0x89, 0x94, 0x48, 0xc4, 0x7d, 0x2e, 0xa2, 0x41, 0x22, 0x44, 0x94, 0x49, 0xf1, 0x86,
+	0xc5, 0x5a, 0xd9, 0x8a, 0x66, 0x3c, 0x3a, 0xdd, 0x8e, 0x14, 0x8b, 0x25, 0xd3, 0x61, 0x26, 0x85,
+	0x16, 0xa4, 0x81, 0x48, 0x88, 0xa2, 0x90, 0x8b, 0xe6, 0xea, 0x40, 0x0c, 0x84, 0x01, 0x22, 0xfc,
+	0xb2, 0x9c, 0x26, 0x61, 0xe7, 0xda, 0x36, 0xd9, 0xb9, 0xd3, 0x35, 0xef, 0x2e, 0xf6, 0x67, 0xe7,
+	0x9a, 0xa5, 0x8a, 0x8b, 0x54, 0x39, 0xee, 0xc1, 0x5f, 0xb8, 0xa9, 0x66, 0x32, 0x93, 0x5c, 0xb1,
+	0x48, 0x64, 0x1a, 0x35, 0x28, 0xa7, 0x63, 0x3d, 0x74, 0x4e, 0xf8, 0xe9, 0x6c, 0x36, 0xcc, 0x68,
+	0x23, 0xae, 0xa7, 0xe2, 0x13, 0xa6, 0x69, 0x9f, 0x6a, 0xba, 0x08, 0x9f, 0xd6, 0x16, 0x6f, 0x7d,
+	0x2c, 0x83, 0xff, 0xca, 0xcc, 0x4e, 0xee, 0x41, 0x89, 0x9e, 0xa9, 0xc0, 0xbb, 0xe6, 0x6d, 0xd5,
+	0x77, 0xd6, 0xc2, 0xe2, 0x0e, 0xc2, 0xce, 0x99, 0xb2, 0xac, 0xc3, 0xa5, 0x2e, 0xb2, 0xc8, 0x36,
+	0x54, 0xe8, 0xdb, 0xb1, 0x64, 0xc1, 0xb2, 0xa1, 0x5f, 0xfd, 0x85, 0x8e, 0x50, 0x2e, 0xb0, 0x4c,
+	0xf4, 0xd7, 0x89, 0x0a, 0x4a, 0x7f, 0xf2, 0x3f, 0x4a, 0x0a, 0xfe, 0x3a, 0x51, 0xe4, 0x09, 0x54,
+	0x04, 0x8e, 0x19, 0x54, 0x0c, 0xfd, 0x46, 0x38, 0x5b, 0xca, 0xbc, 0xf2, 0x25, 0xb2, 0x66, 0x3f,
+	0x65, 0x44, 0xe4, 0x29, 0x54, 0x69, 0xc6, 0x7b, 0x23, 0x36, 0x09, 0x7c, 0xa3, 0xbf, 0xb9, 0x50,
+	0xdf, 0xc9, 0xf8, 0x73, 0x36, 0xc9, 0x0d, 0x7c, 0x6a, 0x6a, 0xf2, 0x08, 0xfc, 0x21, 0xa3, 0x7d,
+	0x26, 0x83, 0x15, 0x63, 0xd0, 0x9c, 0x57, 0x1d, 0x1a, 0x6c, 0xa6, 0xb2, 0x5c, 0xf2, 0x0c, 0x1a,
+	0xb1, 0x48, 0xd5, 0x38, 0xe9, 0x69, 0x31, 0x62, 0x69, 0x50, 0x33, 0xda, 0xcd, 0x79, 0xed, 0xbe,
+	0x61, 0x1c, 0x21, 0x21, 0x37, 0xa8, 0xc7, 0xb3, 0x26, 0x69, 0x03, 0xcc, 0xe2, 0x12, 0x94, 0x8d,
+	0x47, 0x30, 0xef, 0x71, 0x90, 0xe3, 0x87, 0x4b, 0xdd, 0x02, 0x9b, 0xec, 0xc2, 0xca, 0x34, 0x01,
+	0x41, 0xd5, 0x28, 0xaf, 0x84, 0xb1, 0x90, 0x2c, 0x57, 0xbe, 0x70, 0xe8, 0x5e, 0xf9, 0xcb, 0xd7,
+	0xcd, 0xa5, 0x6e, 0xce, 0x6e, 0x93, 0x77, 0xdf, 0xcb, 0x17, 0xa1, 0xa4, 0x58, 0x4c, 0xaa, 0xf6,
+	0xdf, 0xa0, 0xf6, 0x7c, 0x28, 0x8f, 0x78, 0xda, 0x6f, 0xa5, 0x50, 0xcb, 0x13, 0x40, 0xd6, 0x01,
+	0x68, 0x1c, 0x33, 0xa5, 0xcc, 0x7e, 0x31, 0x2e, 0xb5, 0x6e, 0xcd, 0x76, 0x70, 0x73, 0xeb, 0x00,
+	0x56, 0x6e, 0xe0, 0x65, 0x0b, 0xdb, 0x0e, 0xc2, 0xd7, 0xe1, 0x82, 0x62, 0x0a, 0x0f, 0xeb, 0x76,
+	0x54, 0x32, 0x8c, 0x86, 0x6b, 0x9a, 0x0d, 0xb4, 0xde, 0x7b, 0x50, 0x2f, 0x64, 0x88, 0x74, 0x60,
+	0xc5, 0xdd, 0x27, 0xe6, 0xb3, 0xb4, 0x55, 0xdf, 0xb9, 0xb5, 0x30, 0x70, 0xee, 0x46, 0xd5, 0x41,
+	0xaa, 0xe5, 0xa4, 0x5b, 0xb5, 0xf7, 0xa9, 0x9a, 0x6d, 0x68, 0x14, 0x01, 0x72, 0x09, 0x4a, 0xb3,
+	0xe3, 0xe3, 0x27, 0x59, 0x85, 0xca, 0x29, 0x4d, 0xc6, 0xcc, 0x9d, 0xd9, 0x16, 0xed, 0xe5, 0x5d,
+	0xaf, 0xd5, 0x87, 0x5a, 0x1e, 0x50, 0x9c, 0x2f, 0x66, 0x52, 0xf7, 0xe2, 0x21, 0xe5, 0xe9, 0x74,
+	0x7c, 0xec, 0xec, 0x63, 0x83, 0x6c, 0x42, 0x3d, 0x93, 0xfc, 0x94, 0x6a, 0x56, 0x98, 0x1f, 0x5c,
+	0x0b, 0x17, 0xb0, 0x06, 0x55, 0x29, 0x84, 0xee, 0xc5, 0xd4, 0x8d, 0xee, 0x63, 0xb9, 0x4f, 0x5b,
+	0x1f, 0x3c, 0x68, 0x14, 0x73, 0x45, 0x3a, 0x50, 0xb5, 0xb9, 0x9a, 0x0e, 0x7d, 0x7b, 0x71, 0x08,
+	0x5d, 0x31, 0x9d, 0xda, 0xe9, 0x70, 0xea, 0x22, 0xf0, 0x5f, 0x53, 0xdf, 0x81, 0xcb, 0xbf, 0x45,
+	0x15, 0xe9, 0xf6, 0xda, 0xac, 0x85, 0x2d, 0xf6, 0xda, 0x9f, 0x7f, 0x94, 0xbd, 0x4f, 0xdf, 0x36,
+	0xbc, 0xd7, 0x0f, 0xfe, 0xed, 0xd5, 0xcd, 0x46, 0x03, 0xf7, 0x20, 0x1d, 0xfb, 0xe6, 0x21, 0x7a,
+	0xf8, 0x73, 0x00, 0x8d, 0x51, 0x9b, 0x8d, 0xb0, 0x05, 0x00, 0x00,
 }
 
 func (this *Secret) Equal(that interface{}) bool {
@@ -672,6 +734,30 @@ func (this *Secret_Header) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Secret_ConsulToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Secret_ConsulToken)
+	if !ok {
+		that2, ok := that.(Secret_ConsulToken)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ConsulToken.Equal(that1.ConsulToken) {
+		return false
+	}
+	return true
+}
 func (this *Secret_Extensions) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -826,3 +912,30 @@ func (this *HeaderSecret) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ConsulTokenSecret) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConsulTokenSecret)
+	if !ok {
+		that2, ok := that.(ConsulTokenSecret)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -150,6 +150,22 @@ func (m *Secret) Hash(hasher hash.Hash64) (uint64, error) {
 			}
 		}
 
+	case *Secret_ConsulToken:
+
+		if h, ok := interface{}(m.GetConsulToken()).(safe_hasher.SafeHasher); ok {
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if val, err := hashstructure.Hash(m.GetConsulToken(), nil); err != nil {
+				return 0, err
+			} else {
+				if err := binary.Write(hasher, binary.LittleEndian, val); err != nil {
+					return 0, err
+				}
+			}
+		}
+
 	case *Secret_Extensions:
 
 		if h, ok := interface{}(m.GetExtensions()).(safe_hasher.SafeHasher); ok {
@@ -304,3 +320,23 @@ func (m *HeaderSecret) Hash(hasher hash.Hash64) (uint64, error) {
 
 	return hasher.Sum64(), nil
 }
+
+// Hash function
+func (m *ConsulTokenSecret) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.ConsulTokenSecret")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetToken())); err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
@@ -69,6 +69,38 @@ func (Settings_DiscoveryOptions_FdsMode) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_bd7533c2495e1752, []int{0, 7, 0}
 }
 
+// How the errors of the full translation of the Proxies are handled by the strict validation
+type GatewayOptions_ValidationOptions_StrictValidationMode int32
+
+const (
+	// Strict validation is disabled. Only the errors reported on the Proxies by Gloo are validated.
+	GatewayOptions_ValidationOptions_DISABLED GatewayOptions_ValidationOptions_StrictValidationMode = 0
+	// The errors found by the strict validation are logged as warnings, and the resources are accepted.
+	GatewayOptions_ValidationOptions_WARN GatewayOptions_ValidationOptions_StrictValidationMode = 1
+	// Resources are rejected on any error found by the strict validation.
+	GatewayOptions_ValidationOptions_REJECT GatewayOptions_ValidationOptions_StrictValidationMode = 2
+)
+
+var GatewayOptions_ValidationOptions_StrictValidationMode_name = map[int32]string{
+	0: "DISABLED",
+	1: "WARN",
+	2: "REJECT",
+}
+
+var GatewayOptions_ValidationOptions_StrictValidationMode_value = map[string]int32{
+	"DISABLED": 0,
+	"WARN":     1,
+	"REJECT":   2,
+}
+
+func (x GatewayOptions_ValidationOptions_StrictValidationMode) String() string {
+	return proto.EnumName(GatewayOptions_ValidationOptions_StrictValidationMode_name, int32(x))
+}
+
+func (GatewayOptions_ValidationOptions_StrictValidationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{2, 0, 0}
+}
+
 // Represents global settings for all the Gloo components.
 type Settings struct {
 	// This is the namespace to which Gloo controllers will write their own resources, e.g. discovered Upstreams or default Gateways.
@@ -122,6 +154,8 @@ type Settings struct {
 	Consul *Settings_ConsulConfiguration `protobuf:"bytes,20,opt,name=consul,proto3" json:"consul,omitempty"`
 	// Options to configure Gloo's integration with [Kubernetes](https://www.kubernetes.io/).
 	Kubernetes *Settings_KubernetesConfiguration `protobuf:"bytes,22,opt,name=kubernetes,proto3" json:"kubernetes,omitempty"`
+	// Options to configure Gloo's integration with [Docker](https://www.docker.com/).
+	Docker *Settings_DockerConfiguration `protobuf:"bytes,30,opt,name=docker,proto3" json:"docker,omitempty"`
 	// Extensions will be passed along from Listeners, Gateways, VirtualServices, Routes, and Route tables to the
 	// underlying Proxy, making them useful for controllers, validation tools, etc. which interact with kubernetes yaml.
 	//
@@ -386,6 +420,13 @@ func (m *Settings) GetKubernetes() *Settings_KubernetesConfiguration {
 	return nil
 }
 
+func (m *Settings) GetDocker() *Settings_DockerConfiguration {
+	if m != nil {
+		return m.Docker
+	}
+	return nil
+}
+
 func (m *Settings) GetExtensions() *Extensions {
 	if m != nil {
 		return m.Extensions
@@ -809,10 +850,11 @@ func (m *Settings_KnativeOptions) GetKnativeInternalProxyAddress() string {
 }
 
 type Settings_DiscoveryOptions struct {
-	FdsMode              Settings_DiscoveryOptions_FdsMode `protobuf:"varint,1,opt,name=fds_mode,json=fdsMode,proto3,enum=gloo.solo.io.Settings_DiscoveryOptions_FdsMode" json:"fds_mode,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
-	XXX_unrecognized     []byte                            `json:"-"`
-	XXX_sizecache        int32                             `json:"-"`
+	FdsMode              Settings_DiscoveryOptions_FdsMode     `protobuf:"varint,1,opt,name=fds_mode,json=fdsMode,proto3,enum=gloo.solo.io.Settings_DiscoveryOptions_FdsMode" json:"fds_mode,omitempty"`
+	UdsOptions           *Settings_DiscoveryOptions_UdsOptions `protobuf:"bytes,2,opt,name=uds_options,json=udsOptions,proto3" json:"uds_options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
 }
 
 func (m *Settings_DiscoveryOptions) Reset()         { *m = Settings_DiscoveryOptions{} }
@@ -846,6 +888,98 @@ func (m *Settings_DiscoveryOptions) GetFdsMode() Settings_DiscoveryOptions_FdsMo
 	return Settings_DiscoveryOptions_BLACKLIST
 }
 
+func (m *Settings_DiscoveryOptions) GetUdsOptions() *Settings_DiscoveryOptions_UdsOptions {
+	if m != nil {
+		return m.UdsOptions
+	}
+	return nil
+}
+
+// Filters the Kubernetes services the upstream discovery service (UDS) creates upstreams for, so large
+// clusters don't end up with an upstream for every service.
+// Services annotated with `discovery.solo.io/enabled: "false"` are never discovered, and services annotated
+// with `discovery.solo.io/enabled: "true"` are discovered regardless of these filters.
+type Settings_DiscoveryOptions_UdsOptions struct {
+	// If set, only the services in these namespaces are discovered.
+	IncludedNamespaces []string `protobuf:"bytes,1,rep,name=included_namespaces,json=includedNamespaces,proto3" json:"included_namespaces,omitempty"`
+	// The services in these namespaces are not discovered.
+	ExcludedNamespaces []string `protobuf:"bytes,2,rep,name=excluded_namespaces,json=excludedNamespaces,proto3" json:"excluded_namespaces,omitempty"`
+	// If set, only the services matching this [label selector](https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors)
+	// are discovered, e.g. `app,tier notin (cache)`.
+	LabelSelector string `protobuf:"bytes,3,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	// A [Go template](https://golang.org/pkg/text/template/) for the names of the discovered upstreams,
+	// which defaults to `{{.Namespace}}-{{.Name}}-{{.Port}}`. The template is executed with the `Namespace`,
+	// `Name`, `Labels` and `Annotations` of the service, and the `Port` number and `PortName` of the service port.
+	// The result is sanitized into a valid resource name. The default name is used instead if the result is
+	// empty or collides with the name of another discovered upstream.
+	UpstreamNameTemplate string `protobuf:"bytes,4,opt,name=upstream_name_template,json=upstreamNameTemplate,proto3" json:"upstream_name_template,omitempty"`
+	// Labels to add to the discovered upstreams. The values are Go templates, executed with the same data as
+	// the `upstreamNameTemplate`.
+	UpstreamLabels       map[string]string `protobuf:"bytes,5,rep,name=upstream_labels,json=upstreamLabels,proto3" json:"upstream_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Settings_DiscoveryOptions_UdsOptions) Reset()         { *m = Settings_DiscoveryOptions_UdsOptions{} }
+func (m *Settings_DiscoveryOptions_UdsOptions) String() string { return proto.CompactTextString(m) }
+func (*Settings_DiscoveryOptions_UdsOptions) ProtoMessage()    {}
+func (*Settings_DiscoveryOptions_UdsOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{0, 7, 0}
+}
+func (m *Settings_DiscoveryOptions_UdsOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Settings_DiscoveryOptions_UdsOptions.Unmarshal(m, b)
+}
+func (m *Settings_DiscoveryOptions_UdsOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Settings_DiscoveryOptions_UdsOptions.Marshal(b, m, deterministic)
+}
+func (m *Settings_DiscoveryOptions_UdsOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Settings_DiscoveryOptions_UdsOptions.Merge(m, src)
+}
+func (m *Settings_DiscoveryOptions_UdsOptions) XXX_Size() int {
+	return xxx_messageInfo_Settings_DiscoveryOptions_UdsOptions.Size(m)
+}
+func (m *Settings_DiscoveryOptions_UdsOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_Settings_DiscoveryOptions_UdsOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Settings_DiscoveryOptions_UdsOptions proto.InternalMessageInfo
+
+func (m *Settings_DiscoveryOptions_UdsOptions) GetIncludedNamespaces() []string {
+	if m != nil {
+		return m.IncludedNamespaces
+	}
+	return nil
+}
+
+func (m *Settings_DiscoveryOptions_UdsOptions) GetExcludedNamespaces() []string {
+	if m != nil {
+		return m.ExcludedNamespaces
+	}
+	return nil
+}
+
+func (m *Settings_DiscoveryOptions_UdsOptions) GetLabelSelector() string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return ""
+}
+
+func (m *Settings_DiscoveryOptions_UdsOptions) GetUpstreamNameTemplate() string {
+	if m != nil {
+		return m.UpstreamNameTemplate
+	}
+	return ""
+}
+
+func (m *Settings_DiscoveryOptions_UdsOptions) GetUpstreamLabels() map[string]string {
+	if m != nil {
+		return m.UpstreamLabels
+	}
+	return nil
+}
+
 // Provides overrides for the default configuration parameters used to connect to Consul.
 //
 // Note: It is also possible to configure the Consul client Gloo uses via the environment variables
@@ -868,6 +1002,8 @@ type Settings_ConsulConfiguration struct {
 	// The polling interval for the DNS server.
 	// If there is a Consul service address with a hostname instead of an IP, Gloo will resolve the
 	// hostname with the configured frequency to update endpoints with any changes to DNS resolution.
+	// Gloo watches the service instances with Consul blocking queries; while those fail, the catalog is
+	// polled with the same frequency instead.
 	// Defaults to 5s.
 	DnsPollingInterval *types.Duration `protobuf:"bytes,15,opt,name=dns_polling_interval,json=dnsPollingInterval,proto3" json:"dns_polling_interval,omitempty"`
 	// Datacenter to use. If not provided, the default agent datacenter is used.
@@ -898,10 +1034,19 @@ type Settings_ConsulConfiguration struct {
 	WaitTime *types.Duration `protobuf:"bytes,11,opt,name=wait_time,json=waitTime,proto3" json:"wait_time,omitempty"`
 	// Enable Service Discovery via Consul with this field
 	// set to empty struct `{}` to enable with defaults
-	ServiceDiscovery     *Settings_ConsulConfiguration_ServiceDiscoveryOptions `protobuf:"bytes,12,opt,name=service_discovery,json=serviceDiscovery,proto3" json:"service_discovery,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                              `json:"-"`
-	XXX_unrecognized     []byte                                                `json:"-"`
-	XXX_sizecache        int32                                                 `json:"-"`
+	ServiceDiscovery *Settings_ConsulConfiguration_ServiceDiscoveryOptions `protobuf:"bytes,12,opt,name=service_discovery,json=serviceDiscovery,proto3" json:"service_discovery,omitempty"`
+	// If set, requests to upstreams discovered from Consul services with a Connect sidecar are routed to the
+	// sidecar proxies over mutual TLS, using the Connect leaf certificate and CA roots served by the local Consul
+	// agent. Requires `service_discovery` to be enabled.
+	Connect *Settings_ConsulConfiguration_ConnectOptions `protobuf:"bytes,16,opt,name=connect,proto3" json:"connect,omitempty"`
+	// ACL tokens for individual Consul services, by service name. Each entry references a secret of the
+	// `consul_token` kind. Gloo uses the token instead of `token` to discover the service and its instances, so
+	// services which the default token is not allowed to read can be discovered as well.
+	// The `token_secret_ref` of a Consul upstream takes precedence over this setting.
+	ServiceTokenSecretRefs map[string]*core.ResourceRef `protobuf:"bytes,17,rep,name=service_token_secret_refs,json=serviceTokenSecretRefs,proto3" json:"service_token_secret_refs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral   struct{}                     `json:"-"`
+	XXX_unrecognized       []byte                       `json:"-"`
+	XXX_sizecache          int32                        `json:"-"`
 }
 
 func (m *Settings_ConsulConfiguration) Reset()         { *m = Settings_ConsulConfiguration{} }
@@ -1034,6 +1179,20 @@ func (m *Settings_ConsulConfiguration) GetServiceDiscovery() *Settings_ConsulCon
 	return nil
 }
 
+func (m *Settings_ConsulConfiguration) GetConnect() *Settings_ConsulConfiguration_ConnectOptions {
+	if m != nil {
+		return m.Connect
+	}
+	return nil
+}
+
+func (m *Settings_ConsulConfiguration) GetServiceTokenSecretRefs() map[string]*core.ResourceRef {
+	if m != nil {
+		return m.ServiceTokenSecretRefs
+	}
+	return nil
+}
+
 // service discovery options for Consul
 type Settings_ConsulConfiguration_ServiceDiscoveryOptions struct {
 	// Use this parameter to restrict the data centers that will be considered when discovering and routing to
@@ -1079,13 +1238,70 @@ func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions) GetDataCenters()
 	return nil
 }
 
+// Options for routing to services in a [Consul Connect](https://www.consul.io/docs/connect) service mesh.
+type Settings_ConsulConfiguration_ConnectOptions struct {
+	// The name of the Consul service Gloo uses as its identity in the mesh. Gloo requests the Connect leaf
+	// certificate for this service from the local Consul agent, so Connect intentions must allow this
+	// service to reach the destination services.
+	// Defaults to "gloo".
+	ServiceName          string   `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Settings_ConsulConfiguration_ConnectOptions) Reset() {
+	*m = Settings_ConsulConfiguration_ConnectOptions{}
+}
+func (m *Settings_ConsulConfiguration_ConnectOptions) String() string {
+	return proto.CompactTextString(m)
+}
+func (*Settings_ConsulConfiguration_ConnectOptions) ProtoMessage() {}
+func (*Settings_ConsulConfiguration_ConnectOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{0, 8, 1}
+}
+func (m *Settings_ConsulConfiguration_ConnectOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Settings_ConsulConfiguration_ConnectOptions.Unmarshal(m, b)
+}
+func (m *Settings_ConsulConfiguration_ConnectOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Settings_ConsulConfiguration_ConnectOptions.Marshal(b, m, deterministic)
+}
+func (m *Settings_ConsulConfiguration_ConnectOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Settings_ConsulConfiguration_ConnectOptions.Merge(m, src)
+}
+func (m *Settings_ConsulConfiguration_ConnectOptions) XXX_Size() int {
+	return xxx_messageInfo_Settings_ConsulConfiguration_ConnectOptions.Size(m)
+}
+func (m *Settings_ConsulConfiguration_ConnectOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_Settings_ConsulConfiguration_ConnectOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Settings_ConsulConfiguration_ConnectOptions proto.InternalMessageInfo
+
+func (m *Settings_ConsulConfiguration_ConnectOptions) GetServiceName() string {
+	if m != nil {
+		return m.ServiceName
+	}
+	return ""
+}
+
 // Provides overrides for the default configuration parameters used to interact with Kubernetes.
 type Settings_KubernetesConfiguration struct {
 	// Rate limits for the kubernetes clients
-	RateLimits           *Settings_KubernetesConfiguration_RateLimits `protobuf:"bytes,1,opt,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
-	XXX_unrecognized     []byte                                       `json:"-"`
-	XXX_sizecache        int32                                        `json:"-"`
+	RateLimits *Settings_KubernetesConfiguration_RateLimits `protobuf:"bytes,1,opt,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
+	// Discover the endpoints of Kubernetes upstreams from the EndpointSlices (`discovery.k8s.io/v1beta1`)
+	// of their services, rather than from their Endpoints. EndpointSlices scale to services with many endpoints,
+	// and carry the zone and region of the endpoints, which Gloo passes on to Envoy as their locality.
+	// Requires Kubernetes 1.17 or later.
+	UseEndpointSlices bool `protobuf:"varint,2,opt,name=use_endpoint_slices,json=useEndpointSlices,proto3" json:"use_endpoint_slices,omitempty"`
+	// Set the locality of the endpoints of Kubernetes upstreams from the labels of the nodes their pods run on:
+	// the region and zone from the `topology.kubernetes.io/region` and `topology.kubernetes.io/zone` labels
+	// (or their deprecated `failure-domain.beta.kubernetes.io` counterparts), and the sub-zone from the
+	// `topology.gloo.solo.io/subzone` label. Requires permission to read the nodes of the cluster.
+	UseNodeLocalities    bool     `protobuf:"varint,3,opt,name=use_node_localities,json=useNodeLocalities,proto3" json:"use_node_localities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Settings_KubernetesConfiguration) Reset()         { *m = Settings_KubernetesConfiguration{} }
@@ -1119,6 +1335,20 @@ func (m *Settings_KubernetesConfiguration) GetRateLimits() *Settings_KubernetesC
 	return nil
 }
 
+func (m *Settings_KubernetesConfiguration) GetUseEndpointSlices() bool {
+	if m != nil {
+		return m.UseEndpointSlices
+	}
+	return false
+}
+
+func (m *Settings_KubernetesConfiguration) GetUseNodeLocalities() bool {
+	if m != nil {
+		return m.UseNodeLocalities
+	}
+	return false
+}
+
 type Settings_KubernetesConfiguration_RateLimits struct {
 	// The maximum queries-per-second Gloo can make to the Kubernetes API Server.
 	QPS float32 `protobuf:"fixed32,1,opt,name=QPS,proto3" json:"QPS,omitempty"`
@@ -1172,6 +1402,100 @@ func (m *Settings_KubernetesConfiguration_RateLimits) GetBurst() uint32 {
 	return 0
 }
 
+// Provides overrides for the default configuration parameters used to interact with Docker.
+type Settings_DockerConfiguration struct {
+	// The address of the Docker daemon API, e.g. `unix:///var/run/docker.sock` or `tcp://127.0.0.1:2375`.
+	// Defaults to the value of the standard DOCKER_HOST env if set, otherwise to unix:///var/run/docker.sock.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Enables the discovery of upstreams from the running containers with the `gloo.discovery=true` label.
+	ServiceDiscovery     *Settings_DockerConfiguration_ServiceDiscoveryOptions `protobuf:"bytes,2,opt,name=service_discovery,json=serviceDiscovery,proto3" json:"service_discovery,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                              `json:"-"`
+	XXX_unrecognized     []byte                                                `json:"-"`
+	XXX_sizecache        int32                                                 `json:"-"`
+}
+
+func (m *Settings_DockerConfiguration) Reset()         { *m = Settings_DockerConfiguration{} }
+func (m *Settings_DockerConfiguration) String() string { return proto.CompactTextString(m) }
+func (*Settings_DockerConfiguration) ProtoMessage()    {}
+func (*Settings_DockerConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{0, 10}
+}
+func (m *Settings_DockerConfiguration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Settings_DockerConfiguration.Unmarshal(m, b)
+}
+func (m *Settings_DockerConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Settings_DockerConfiguration.Marshal(b, m, deterministic)
+}
+func (m *Settings_DockerConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Settings_DockerConfiguration.Merge(m, src)
+}
+func (m *Settings_DockerConfiguration) XXX_Size() int {
+	return xxx_messageInfo_Settings_DockerConfiguration.Size(m)
+}
+func (m *Settings_DockerConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_Settings_DockerConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Settings_DockerConfiguration proto.InternalMessageInfo
+
+func (m *Settings_DockerConfiguration) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Settings_DockerConfiguration) GetServiceDiscovery() *Settings_DockerConfiguration_ServiceDiscoveryOptions {
+	if m != nil {
+		return m.ServiceDiscovery
+	}
+	return nil
+}
+
+type Settings_DockerConfiguration_ServiceDiscoveryOptions struct {
+	// The Docker network the addresses of discovered containers are taken from, for containers without a
+	// `gloo.discovery.network` label. If not set, the first network of each container (by name) is used.
+	Network              string   `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Settings_DockerConfiguration_ServiceDiscoveryOptions) Reset() {
+	*m = Settings_DockerConfiguration_ServiceDiscoveryOptions{}
+}
+func (m *Settings_DockerConfiguration_ServiceDiscoveryOptions) String() string {
+	return proto.CompactTextString(m)
+}
+func (*Settings_DockerConfiguration_ServiceDiscoveryOptions) ProtoMessage() {}
+func (*Settings_DockerConfiguration_ServiceDiscoveryOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{0, 10, 0}
+}
+func (m *Settings_DockerConfiguration_ServiceDiscoveryOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Settings_DockerConfiguration_ServiceDiscoveryOptions.Unmarshal(m, b)
+}
+func (m *Settings_DockerConfiguration_ServiceDiscoveryOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Settings_DockerConfiguration_ServiceDiscoveryOptions.Marshal(b, m, deterministic)
+}
+func (m *Settings_DockerConfiguration_ServiceDiscoveryOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Settings_DockerConfiguration_ServiceDiscoveryOptions.Merge(m, src)
+}
+func (m *Settings_DockerConfiguration_ServiceDiscoveryOptions) XXX_Size() int {
+	return xxx_messageInfo_Settings_DockerConfiguration_ServiceDiscoveryOptions.Size(m)
+}
+func (m *Settings_DockerConfiguration_ServiceDiscoveryOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_Settings_DockerConfiguration_ServiceDiscoveryOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Settings_DockerConfiguration_ServiceDiscoveryOptions proto.InternalMessageInfo
+
+func (m *Settings_DockerConfiguration_ServiceDiscoveryOptions) GetNetwork() string {
+	if m != nil {
+		return m.Network
+	}
+	return ""
+}
+
 // Settings specific to the gloo (Envoy xDS server) controller
 type GlooOptions struct {
 	// Where the `gloo` xDS server should bind. Defaults to `0.0.0.0:9977`
@@ -1209,10 +1533,14 @@ type GlooOptions struct {
 	RegexMaxProgramSize *types.UInt32Value `protobuf:"bytes,10,opt,name=regex_max_program_size,json=regexMaxProgramSize,proto3" json:"regex_max_program_size,omitempty"`
 	// (Enterprise Only): Where the `gloo` REST xDS server should bind. Used by Gloo Federation.
 	// Defaults to `0.0.0.0:9976`
-	RestXdsBindAddr      string   `protobuf:"bytes,11,opt,name=rest_xds_bind_addr,json=restXdsBindAddr,proto3" json:"rest_xds_bind_addr,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	RestXdsBindAddr string `protobuf:"bytes,11,opt,name=rest_xds_bind_addr,json=restXdsBindAddr,proto3" json:"rest_xds_bind_addr,omitempty"`
+	// The number of proxies Gloo translates to Envoy configuration concurrently. Proxies are independent of each
+	// other, so with multiple gateway proxies, translating them concurrently reduces the time it takes for changes
+	// to reach all of them. If not specified, defaults to the number of CPUs available to Gloo.
+	ProxyTranslationConcurrency *types.UInt32Value `protobuf:"bytes,12,opt,name=proxy_translation_concurrency,json=proxyTranslationConcurrency,proto3" json:"proxy_translation_concurrency,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{}           `json:"-"`
+	XXX_unrecognized            []byte             `json:"-"`
+	XXX_sizecache               int32              `json:"-"`
 }
 
 func (m *GlooOptions) Reset()         { *m = GlooOptions{} }
@@ -1316,6 +1644,13 @@ func (m *GlooOptions) GetRestXdsBindAddr() string {
 	return ""
 }
 
+func (m *GlooOptions) GetProxyTranslationConcurrency() *types.UInt32Value {
+	if m != nil {
+		return m.ProxyTranslationConcurrency
+	}
+	return nil
+}
+
 type GlooOptions_AWSOptions struct {
 	// Types that are valid to be assigned to CredentialsFetcher:
 	//	*GlooOptions_AWSOptions_EnableCredentialsDiscovey
@@ -1410,10 +1745,21 @@ type GlooOptions_InvalidConfigPolicy struct {
 	InvalidRouteResponseCode uint32 `protobuf:"varint,2,opt,name=invalid_route_response_code,json=invalidRouteResponseCode,proto3" json:"invalid_route_response_code,omitempty"`
 	// replaced routes reply to clients with this response body.
 	// default is 'Gloo Gateway has invalid configuration. Administrators should run `glooctl check` to find and fix config errors.'
-	InvalidRouteResponseBody string   `protobuf:"bytes,3,opt,name=invalid_route_response_body,json=invalidRouteResponseBody,proto3" json:"invalid_route_response_body,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
-	XXX_unrecognized         []byte   `json:"-"`
-	XXX_sizecache            int32    `json:"-"`
+	InvalidRouteResponseBody string `protobuf:"bytes,3,opt,name=invalid_route_response_body,json=invalidRouteResponseBody,proto3" json:"invalid_route_response_body,omitempty"`
+	// if set to `true` along with `replaceInvalidRoutes`, routes with multiple destinations only drop
+	// the destinations which are missing, rather than sending their share of traffic to the invalid route response.
+	// The weights of the remaining destinations are re-normalized so that healthy destinations keep serving all traffic.
+	// Routes where every destination is missing are still replaced.
+	PruneInvalidWeightedDestinations bool `protobuf:"varint,4,opt,name=prune_invalid_weighted_destinations,json=pruneInvalidWeightedDestinations,proto3" json:"prune_invalid_weighted_destinations,omitempty"`
+	// if set to `true`, Gloo computes the changes its xDS sanitizers would make to the configuration sent to Envoy
+	// (e.g. routes replaced and clusters dropped) without applying them. Invalid routes are computed as if
+	// `replaceInvalidRoutes` were enabled. The changes are logged and reported as warnings on the Proxy status,
+	// while the configuration is validated as if no sanitizers were enabled.
+	// Use this to audit the effect of `replaceInvalidRoutes` before enabling it.
+	SanitizerDryRun      bool     `protobuf:"varint,5,opt,name=sanitizer_dry_run,json=sanitizerDryRun,proto3" json:"sanitizer_dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GlooOptions_InvalidConfigPolicy) Reset()         { *m = GlooOptions_InvalidConfigPolicy{} }
@@ -1461,6 +1807,20 @@ func (m *GlooOptions_InvalidConfigPolicy) GetInvalidRouteResponseBody() string {
 	return ""
 }
 
+func (m *GlooOptions_InvalidConfigPolicy) GetPruneInvalidWeightedDestinations() bool {
+	if m != nil {
+		return m.PruneInvalidWeightedDestinations
+	}
+	return false
+}
+
+func (m *GlooOptions_InvalidConfigPolicy) GetSanitizerDryRun() bool {
+	if m != nil {
+		return m.SanitizerDryRun
+	}
+	return false
+}
+
 // Settings specific to the Gateway controller
 type GatewayOptions struct {
 	// Address of the `gloo` config validation server. Defaults to `gloo:9988`.
@@ -1476,10 +1836,18 @@ type GatewayOptions struct {
 	AlwaysSortRouteTableRoutes bool `protobuf:"varint,5,opt,name=always_sort_route_table_routes,json=alwaysSortRouteTableRoutes,proto3" json:"always_sort_route_table_routes,omitempty"` // Deprecated: Do not use.
 	// If set, compresses proxy space. This can help make the Proxy CRD smaller to fit in etcd.
 	// This is an advanced option. Use with care.
-	CompressedProxySpec  bool     `protobuf:"varint,6,opt,name=compressed_proxy_spec,json=compressedProxySpec,proto3" json:"compressed_proxy_spec,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	CompressedProxySpec bool `protobuf:"varint,6,opt,name=compressed_proxy_spec,json=compressedProxySpec,proto3" json:"compressed_proxy_spec,omitempty"`
+	// If provided, the Gateway will request certificates from an ACME server for the domains of virtual services
+	// with the `gateway.solo.io/acme: "true"` annotation, solving HTTP-01 challenges on the plain HTTP gateways.
+	// The certificates are stored as TLS secrets next to the virtual services, and the virtual services are
+	// served by the SSL gateways with them.
+	Acme *GatewayOptions_AcmeOptions `protobuf:"bytes,7,opt,name=acme,proto3" json:"acme,omitempty"`
+	// If provided, teams can run isolated gateways by creating Gateways in their own namespace, without changing
+	// the Gateways and Proxies of the write namespace.
+	NamespacedGateways   *GatewayOptions_NamespacedGatewaysOptions `protobuf:"bytes,8,opt,name=namespaced_gateways,json=namespacedGateways,proto3" json:"namespaced_gateways,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
 }
 
 func (m *GatewayOptions) Reset()         { *m = GatewayOptions{} }
@@ -1542,6 +1910,20 @@ func (m *GatewayOptions) GetCompressedProxySpec() bool {
 	return false
 }
 
+func (m *GatewayOptions) GetAcme() *GatewayOptions_AcmeOptions {
+	if m != nil {
+		return m.Acme
+	}
+	return nil
+}
+
+func (m *GatewayOptions) GetNamespacedGateways() *GatewayOptions_NamespacedGatewaysOptions {
+	if m != nil {
+		return m.NamespacedGateways
+	}
+	return nil
+}
+
 // options for configuring admission control / validation
 type GatewayOptions_ValidationOptions struct {
 	// Address of the `gloo` proxy validation grpc server. Defaults to `gloo:9988`.
@@ -1563,10 +1945,17 @@ type GatewayOptions_ValidationOptions struct {
 	// Accept resources if validation produced a warning (defaults to true).
 	// By settings to false, this means that validation will start rejecting resources that would result
 	// in warnings, rather than just those that would result in errors.
-	AllowWarnings        *types.BoolValue `protobuf:"bytes,7,opt,name=allow_warnings,json=allowWarnings,proto3" json:"allow_warnings,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	AllowWarnings *types.BoolValue `protobuf:"bytes,7,opt,name=allow_warnings,json=allowWarnings,proto3" json:"allow_warnings,omitempty"`
+	// When enabled, every admitted Gateway, Virtual Service and Route Table is validated with a full translation
+	// of the Proxies it belongs to, including the plugins: besides the errors reported on the Proxies,
+	// the Envoy validation of the listeners, route configurations and clusters generated for the Proxies,
+	// and the errors of the Upstreams they route to, are validated.
+	// Strict validation requires Gloo to be reachable: `ignoreGlooValidationFailure` does not apply to it.
+	// Defaults to `DISABLED`.
+	Strict               GatewayOptions_ValidationOptions_StrictValidationMode `protobuf:"varint,8,opt,name=strict,proto3,enum=gloo.solo.io.GatewayOptions_ValidationOptions_StrictValidationMode" json:"strict,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                              `json:"-"`
+	XXX_unrecognized     []byte                                                `json:"-"`
+	XXX_sizecache        int32                                                 `json:"-"`
 }
 
 func (m *GatewayOptions_ValidationOptions) Reset()         { *m = GatewayOptions_ValidationOptions{} }
@@ -1635,8 +2024,174 @@ func (m *GatewayOptions_ValidationOptions) GetAllowWarnings() *types.BoolValue {
 	return nil
 }
 
+func (m *GatewayOptions_ValidationOptions) GetStrict() GatewayOptions_ValidationOptions_StrictValidationMode {
+	if m != nil {
+		return m.Strict
+	}
+	return GatewayOptions_ValidationOptions_DISABLED
+}
+
+// options for provisioning certificates from an ACME server, e.g. Let's Encrypt
+type GatewayOptions_AcmeOptions struct {
+	// URL of the ACME directory. Defaults to Let's Encrypt, `https://acme-v02.api.letsencrypt.org/directory`.
+	DirectoryUrl string `protobuf:"bytes,1,opt,name=directory_url,json=directoryUrl,proto3" json:"directory_url,omitempty"`
+	// Contact email for the ACME account. Optional.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// Must be set to true to agree to the terms of service of the ACME server.
+	AcceptTermsOfService bool `protobuf:"varint,3,opt,name=accept_terms_of_service,json=acceptTermsOfService,proto3" json:"accept_terms_of_service,omitempty"`
+	// Certificates are renewed when they expire within this duration. Defaults to 30 days.
+	RenewBefore *types.Duration `protobuf:"bytes,4,opt,name=renew_before,json=renewBefore,proto3" json:"renew_before,omitempty"`
+	// Port of the HTTP-01 challenge solver in the gateway pod. Defaults to `8089`.
+	SolverPort           uint32   `protobuf:"varint,5,opt,name=solver_port,json=solverPort,proto3" json:"solver_port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayOptions_AcmeOptions) Reset()         { *m = GatewayOptions_AcmeOptions{} }
+func (m *GatewayOptions_AcmeOptions) String() string { return proto.CompactTextString(m) }
+func (*GatewayOptions_AcmeOptions) ProtoMessage()    {}
+func (*GatewayOptions_AcmeOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{2, 1}
+}
+func (m *GatewayOptions_AcmeOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewayOptions_AcmeOptions.Unmarshal(m, b)
+}
+func (m *GatewayOptions_AcmeOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewayOptions_AcmeOptions.Marshal(b, m, deterministic)
+}
+func (m *GatewayOptions_AcmeOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayOptions_AcmeOptions.Merge(m, src)
+}
+func (m *GatewayOptions_AcmeOptions) XXX_Size() int {
+	return xxx_messageInfo_GatewayOptions_AcmeOptions.Size(m)
+}
+func (m *GatewayOptions_AcmeOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayOptions_AcmeOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayOptions_AcmeOptions proto.InternalMessageInfo
+
+func (m *GatewayOptions_AcmeOptions) GetDirectoryUrl() string {
+	if m != nil {
+		return m.DirectoryUrl
+	}
+	return ""
+}
+
+func (m *GatewayOptions_AcmeOptions) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *GatewayOptions_AcmeOptions) GetAcceptTermsOfService() bool {
+	if m != nil {
+		return m.AcceptTermsOfService
+	}
+	return false
+}
+
+func (m *GatewayOptions_AcmeOptions) GetRenewBefore() *types.Duration {
+	if m != nil {
+		return m.RenewBefore
+	}
+	return nil
+}
+
+func (m *GatewayOptions_AcmeOptions) GetSolverPort() uint32 {
+	if m != nil {
+		return m.SolverPort
+	}
+	return 0
+}
+
+// options for the self-service gateway-per-namespace mode
+type GatewayOptions_NamespacedGatewaysOptions struct {
+	// When true, the Gateways outside the write namespace are translated to Proxies in their own namespace,
+	// named after their `proxyNames`, rather than to the Proxies of the write namespace.
+	// The watch namespaces of the Gateways must be watched by Gloo as well.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// When true, the Gateway controller provisions an Envoy Deployment, Service and bootstrap ConfigMap for each
+	// of these Proxies, in their namespace, and deletes them when the Proxy has no Gateways left.
+	ProvisionDeployments bool `protobuf:"varint,2,opt,name=provision_deployments,json=provisionDeployments,proto3" json:"provision_deployments,omitempty"`
+	// Image of the provisioned Envoy Deployments. Defaults to `quay.io/solo-io/gloo-envoy-wrapper:<gloo version>`.
+	ProxyImage string `protobuf:"bytes,3,opt,name=proxy_image,json=proxyImage,proto3" json:"proxy_image,omitempty"`
+	// Address of the xDS server of Gloo that the provisioned Envoys connect to.
+	// Defaults to `gloo.<gloo namespace>.svc.cluster.local:9977`.
+	XdsAddress string `protobuf:"bytes,4,opt,name=xds_address,json=xdsAddress,proto3" json:"xds_address,omitempty"`
+	// Type of the provisioned Services. Defaults to `LoadBalancer`.
+	ServiceType          string   `protobuf:"bytes,5,opt,name=service_type,json=serviceType,proto3" json:"service_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayOptions_NamespacedGatewaysOptions) Reset() {
+	*m = GatewayOptions_NamespacedGatewaysOptions{}
+}
+func (m *GatewayOptions_NamespacedGatewaysOptions) String() string { return proto.CompactTextString(m) }
+func (*GatewayOptions_NamespacedGatewaysOptions) ProtoMessage()    {}
+func (*GatewayOptions_NamespacedGatewaysOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7533c2495e1752, []int{2, 2}
+}
+func (m *GatewayOptions_NamespacedGatewaysOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewayOptions_NamespacedGatewaysOptions.Unmarshal(m, b)
+}
+func (m *GatewayOptions_NamespacedGatewaysOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewayOptions_NamespacedGatewaysOptions.Marshal(b, m, deterministic)
+}
+func (m *GatewayOptions_NamespacedGatewaysOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayOptions_NamespacedGatewaysOptions.Merge(m, src)
+}
+func (m *GatewayOptions_NamespacedGatewaysOptions) XXX_Size() int {
+	return xxx_messageInfo_GatewayOptions_NamespacedGatewaysOptions.Size(m)
+}
+func (m *GatewayOptions_NamespacedGatewaysOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayOptions_NamespacedGatewaysOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayOptions_NamespacedGatewaysOptions proto.InternalMessageInfo
+
+func (m *GatewayOptions_NamespacedGatewaysOptions) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *GatewayOptions_NamespacedGatewaysOptions) GetProvisionDeployments() bool {
+	if m != nil {
+		return m.ProvisionDeployments
+	}
+	return false
+}
+
+func (m *GatewayOptions_NamespacedGatewaysOptions) GetProxyImage() string {
+	if m != nil {
+		return m.ProxyImage
+	}
+	return ""
+}
+
+func (m *GatewayOptions_NamespacedGatewaysOptions) GetXdsAddress() string {
+	if m != nil {
+		return m.XdsAddress
+	}
+	return ""
+}
+
+func (m *GatewayOptions_NamespacedGatewaysOptions) GetServiceType() string {
+	if m != nil {
+		return m.ServiceType
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("gloo.solo.io.Settings_DiscoveryOptions_FdsMode", Settings_DiscoveryOptions_FdsMode_name, Settings_DiscoveryOptions_FdsMode_value)
+	proto.RegisterEnum("gloo.solo.io.GatewayOptions_ValidationOptions_StrictValidationMode", GatewayOptions_ValidationOptions_StrictValidationMode_name, GatewayOptions_ValidationOptions_StrictValidationMode_value)
 	proto.RegisterType((*Settings)(nil), "gloo.solo.io.Settings")
 	proto.RegisterType((*Settings_KubernetesCrds)(nil), "gloo.solo.io.Settings.KubernetesCrds")
 	proto.RegisterType((*Settings_KubernetesSecrets)(nil), "gloo.solo.io.Settings.KubernetesSecrets")
@@ -1646,15 +2201,23 @@ func init() {
 	proto.RegisterType((*Settings_Directory)(nil), "gloo.solo.io.Settings.Directory")
 	proto.RegisterType((*Settings_KnativeOptions)(nil), "gloo.solo.io.Settings.KnativeOptions")
 	proto.RegisterType((*Settings_DiscoveryOptions)(nil), "gloo.solo.io.Settings.DiscoveryOptions")
+	proto.RegisterType((*Settings_DiscoveryOptions_UdsOptions)(nil), "gloo.solo.io.Settings.DiscoveryOptions.UdsOptions")
+	proto.RegisterMapType((map[string]string)(nil), "gloo.solo.io.Settings.DiscoveryOptions.UdsOptions.UpstreamLabelsEntry")
 	proto.RegisterType((*Settings_ConsulConfiguration)(nil), "gloo.solo.io.Settings.ConsulConfiguration")
+	proto.RegisterMapType((map[string]*core.ResourceRef)(nil), "gloo.solo.io.Settings.ConsulConfiguration.ServiceTokenSecretRefsEntry")
 	proto.RegisterType((*Settings_ConsulConfiguration_ServiceDiscoveryOptions)(nil), "gloo.solo.io.Settings.ConsulConfiguration.ServiceDiscoveryOptions")
+	proto.RegisterType((*Settings_ConsulConfiguration_ConnectOptions)(nil), "gloo.solo.io.Settings.ConsulConfiguration.ConnectOptions")
 	proto.RegisterType((*Settings_KubernetesConfiguration)(nil), "gloo.solo.io.Settings.KubernetesConfiguration")
 	proto.RegisterType((*Settings_KubernetesConfiguration_RateLimits)(nil), "gloo.solo.io.Settings.KubernetesConfiguration.RateLimits")
+	proto.RegisterType((*Settings_DockerConfiguration)(nil), "gloo.solo.io.Settings.DockerConfiguration")
+	proto.RegisterType((*Settings_DockerConfiguration_ServiceDiscoveryOptions)(nil), "gloo.solo.io.Settings.DockerConfiguration.ServiceDiscoveryOptions")
 	proto.RegisterType((*GlooOptions)(nil), "gloo.solo.io.GlooOptions")
 	proto.RegisterType((*GlooOptions_AWSOptions)(nil), "gloo.solo.io.GlooOptions.AWSOptions")
 	proto.RegisterType((*GlooOptions_InvalidConfigPolicy)(nil), "gloo.solo.io.GlooOptions.InvalidConfigPolicy")
 	proto.RegisterType((*GatewayOptions)(nil), "gloo.solo.io.GatewayOptions")
 	proto.RegisterType((*GatewayOptions_ValidationOptions)(nil), "gloo.solo.io.GatewayOptions.ValidationOptions")
+	proto.RegisterType((*GatewayOptions_AcmeOptions)(nil), "gloo.solo.io.GatewayOptions.AcmeOptions")
+	proto.RegisterType((*GatewayOptions_NamespacedGatewaysOptions)(nil), "gloo.solo.io.GatewayOptions.NamespacedGatewaysOptions")
 }
 
 func init() {
@@ -1662,160 +2225,206 @@ func init() {
 }
 
 var fileDescriptor_bd7533c2495e1752 = []byte{
-	// 2441 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x59, 0xdd, 0x6e, 0x1b, 0xc7,
-	0x15, 0x36, 0x65, 0xd9, 0x22, 0x0f, 0xf5, 0x3b, 0x92, 0xa5, 0x15, 0x25, 0xcb, 0x8e, 0xda, 0xb4,
-	0x4e, 0x82, 0x2c, 0x53, 0x25, 0x4d, 0xd3, 0xfc, 0x20, 0x15, 0x65, 0x29, 0x52, 0x65, 0xa7, 0xce,
-	0xd2, 0xb1, 0x8a, 0xa0, 0xe8, 0x62, 0xb8, 0x3b, 0xa4, 0xa6, 0x5c, 0xee, 0x2c, 0x66, 0x86, 0x94,
-	0x98, 0xcb, 0xdc, 0xf7, 0xaa, 0xe8, 0x45, 0xdf, 0xa0, 0x40, 0x5e, 0xa0, 0x8f, 0xd0, 0x5e, 0xf6,
-	0x01, 0x9a, 0x8b, 0x3e, 0x41, 0x53, 0xa0, 0x40, 0x81, 0xde, 0x14, 0xf3, 0xb3, 0x3f, 0xa4, 0x44,
-	0x5a, 0xbe, 0x11, 0x38, 0x73, 0xce, 0xf7, 0xcd, 0xcc, 0x99, 0x33, 0xe7, 0x67, 0x05, 0x1f, 0x75,
-	0xa8, 0x3c, 0xef, 0xb7, 0xdc, 0x80, 0xf5, 0xea, 0x82, 0x45, 0xec, 0x6d, 0xca, 0xea, 0x9d, 0x88,
-	0xb1, 0x7a, 0xc2, 0xd9, 0xef, 0x48, 0x20, 0x85, 0x19, 0xe1, 0x84, 0xd6, 0x07, 0x3f, 0xa9, 0x0b,
-	0x22, 0x25, 0x8d, 0x3b, 0xc2, 0x4d, 0x38, 0x93, 0x0c, 0xcd, 0x2b, 0x99, 0xab, 0x60, 0x2e, 0x65,
-	0xb5, 0xb5, 0x0e, 0xeb, 0x30, 0x2d, 0xa8, 0xab, 0x5f, 0x46, 0xa7, 0x86, 0xc8, 0xa5, 0x34, 0x93,
-	0xe4, 0x52, 0xda, 0xb9, 0x1d, 0xbd, 0x52, 0x97, 0xca, 0x94, 0xb7, 0x47, 0x24, 0x0e, 0xb1, 0xc4,
-	0x56, 0xbe, 0x3d, 0x2e, 0x17, 0x12, 0xcb, 0xbe, 0x98, 0x84, 0x4e, 0xc7, 0x56, 0xfe, 0xe6, 0xe4,
-	0xfd, 0x93, 0x4b, 0x49, 0x62, 0x41, 0x59, 0x9c, 0x72, 0x1d, 0x4d, 0xd1, 0x8d, 0x25, 0xe1, 0x09,
-	0xa7, 0x82, 0xd4, 0x59, 0x22, 0x15, 0xa6, 0xce, 0xb1, 0x24, 0x11, 0xed, 0x51, 0x99, 0xff, 0xb2,
-	0x3c, 0x87, 0xaf, 0xc4, 0x43, 0x2e, 0x25, 0xee, 0xcb, 0x73, 0xbb, 0x23, 0xf5, 0xd3, 0xd2, 0x7c,
-	0xfc, 0x6a, 0xdb, 0x69, 0xe1, 0x40, 0xff, 0xb1, 0xe8, 0x29, 0x17, 0x17, 0x50, 0x1e, 0xf4, 0xa9,
-	0xf4, 0x5b, 0x9c, 0xe0, 0x2e, 0xe1, 0x16, 0xb0, 0x3f, 0x01, 0xa0, 0xcc, 0xc4, 0x63, 0x1c, 0xd5,
-	0x49, 0x3c, 0x60, 0xc3, 0x82, 0xd5, 0xea, 0xf8, 0x42, 0xd4, 0xdb, 0x34, 0x92, 0x19, 0xc5, 0x4e,
-	0x87, 0xb1, 0x4e, 0x44, 0xea, 0x7a, 0xd4, 0xea, 0xb7, 0xeb, 0x61, 0x9f, 0x63, 0xb5, 0xbd, 0x49,
-	0xf2, 0x0b, 0x8e, 0x93, 0x84, 0x70, 0x7b, 0x01, 0xbb, 0xbf, 0xbf, 0x0f, 0xe5, 0xa6, 0xf5, 0x2a,
-	0x54, 0x87, 0xd5, 0x90, 0x8a, 0x80, 0x0d, 0x08, 0x1f, 0xfa, 0x31, 0xee, 0x11, 0x91, 0xe0, 0x80,
-	0x38, 0xa5, 0x87, 0xa5, 0x47, 0x15, 0x0f, 0x65, 0xa2, 0xcf, 0x53, 0x09, 0x7a, 0x03, 0x96, 0x2f,
-	0xb0, 0x0c, 0xce, 0x73, 0x65, 0xe1, 0xcc, 0x3c, 0xbc, 0xfd, 0xa8, 0xe2, 0x2d, 0xe9, 0xf9, 0x4c,
-	0x53, 0x20, 0x0c, 0x4e, 0xb7, 0xdf, 0x22, 0x3c, 0x26, 0x92, 0x08, 0x3f, 0x60, 0x71, 0x9b, 0x76,
-	0x7c, 0xc1, 0xfa, 0x3c, 0x20, 0xce, 0xec, 0xc3, 0xd2, 0xa3, 0xea, 0xde, 0xeb, 0x6e, 0xd1, 0x9d,
-	0xdd, 0x74, 0x57, 0xee, 0x69, 0x06, 0x3b, 0xe0, 0xa1, 0x38, 0xbe, 0xe5, 0xad, 0xe7, 0x44, 0x07,
-	0x9a, 0xa7, 0xa9, 0x69, 0xd0, 0x57, 0xb0, 0x11, 0x52, 0x4e, 0x02, 0xc9, 0xf8, 0x70, 0x6c, 0x85,
-	0x3b, 0x7a, 0x85, 0x87, 0x13, 0x56, 0x78, 0x9c, 0xa2, 0x8e, 0x6f, 0x79, 0xf7, 0x32, 0x8a, 0x11,
-	0xee, 0x53, 0x58, 0x0e, 0x58, 0x2c, 0xfa, 0x91, 0xdf, 0x1d, 0xa4, 0xa4, 0xf7, 0x34, 0xe9, 0x83,
-	0x09, 0xa4, 0x07, 0x5a, 0xfd, 0x74, 0x70, 0x7c, 0xcb, 0x5b, 0x0c, 0xec, 0x6f, 0x4b, 0x16, 0x8e,
-	0xd8, 0x42, 0x90, 0x80, 0x13, 0x99, 0x92, 0xde, 0xd5, 0xa4, 0x8f, 0x5e, 0x6a, 0x8b, 0xa6, 0x46,
-	0x89, 0xe3, 0x52, 0xd1, 0x1c, 0x66, 0xd2, 0xae, 0xf2, 0x25, 0xac, 0x0e, 0x70, 0x3f, 0x92, 0x63,
-	0x0b, 0xcc, 0xe9, 0x05, 0x7e, 0x30, 0x61, 0x81, 0x17, 0x0a, 0x91, 0x73, 0xaf, 0x0c, 0xf2, 0xf1,
-	0x75, 0x56, 0x1e, 0xa5, 0x2e, 0xdf, 0xd0, 0xca, 0xa5, 0x82, 0x95, 0x47, 0xb8, 0xbb, 0x50, 0x2b,
-	0x18, 0x06, 0x73, 0x49, 0xdb, 0x38, 0xc8, 0xe8, 0x2b, 0x9a, 0xfe, 0xad, 0x97, 0xbb, 0x89, 0xbe,
-	0xb8, 0x1e, 0x4e, 0xc4, 0xf1, 0x8c, 0x57, 0xb0, 0xf4, 0xbe, 0xe5, 0xb3, 0x8b, 0xfd, 0x16, 0x36,
-	0xf3, 0x83, 0x8c, 0xaf, 0x05, 0x37, 0x3c, 0xca, 0x8c, 0x97, 0x5b, 0x63, 0x8c, 0xff, 0x37, 0xb0,
-	0x99, 0xbb, 0xcc, 0x38, 0xff, 0xc6, 0xcd, 0x7c, 0x67, 0xc6, 0x5b, 0x4f, 0x7d, 0x67, 0x8c, 0xfd,
-	0x63, 0x98, 0xe7, 0xa4, 0xcd, 0x89, 0x38, 0xf7, 0x55, 0x30, 0x74, 0xe6, 0x35, 0xe1, 0xa6, 0x6b,
-	0xde, 0xbb, 0x9b, 0xbe, 0x77, 0xf7, 0xb1, 0x8d, 0x07, 0x5e, 0xd5, 0xaa, 0x7b, 0x58, 0x12, 0xb4,
-	0x09, 0xe5, 0x90, 0x0c, 0xfc, 0x1e, 0x0b, 0x89, 0xb3, 0xf0, 0xb0, 0xf4, 0xa8, 0xec, 0xcd, 0x85,
-	0x64, 0xf0, 0x94, 0x85, 0x04, 0x39, 0x30, 0x17, 0xd1, 0xb8, 0x4b, 0x78, 0xe8, 0xac, 0x18, 0x89,
-	0x1d, 0xa2, 0x4f, 0x61, 0xae, 0x1b, 0x63, 0x49, 0x07, 0xc4, 0x41, 0xd3, 0x5f, 0xac, 0xd1, 0xfa,
-	0x95, 0x89, 0x93, 0x5e, 0x8a, 0x42, 0x87, 0x50, 0xc9, 0x82, 0x88, 0xb3, 0xaa, 0x29, 0x7e, 0x3c,
-	0xd1, 0xc2, 0x56, 0x2f, 0x25, 0xc9, 0x91, 0xe8, 0x6d, 0x98, 0x55, 0x20, 0xc7, 0x49, 0x8f, 0x5c,
-	0x64, 0xf8, 0x2c, 0x62, 0x2c, 0xc5, 0x68, 0x35, 0xf4, 0x3e, 0xcc, 0x75, 0xb0, 0x24, 0x17, 0x78,
-	0xe8, 0x6c, 0x6a, 0xc4, 0xf6, 0x18, 0xc2, 0x08, 0xb3, 0xdd, 0x5a, 0x65, 0xd4, 0x80, 0xbb, 0xc6,
-	0xf6, 0xce, 0x9a, 0x86, 0xbd, 0x39, 0xf5, 0xb2, 0x8c, 0xd3, 0xa5, 0xc6, 0xb6, 0x48, 0xf4, 0x39,
-	0x40, 0xee, 0x7f, 0xce, 0xba, 0xe6, 0x71, 0x6f, 0xe8, 0xc0, 0x29, 0x57, 0x81, 0x01, 0x7d, 0x00,
-	0x90, 0x67, 0x03, 0x67, 0x59, 0xf3, 0x39, 0xa3, 0x7c, 0x87, 0x99, 0xdc, 0x2b, 0xe8, 0xa2, 0xa7,
-	0x50, 0xc9, 0x92, 0xa6, 0x53, 0xd3, 0xc0, 0xba, 0x9b, 0xa7, 0x51, 0x9b, 0xd3, 0xc6, 0xb7, 0xc6,
-	0x07, 0x34, 0x20, 0xe9, 0x0e, 0xbd, 0x9c, 0x01, 0x35, 0x61, 0x39, 0x1b, 0xf8, 0x82, 0xf0, 0x01,
-	0xe1, 0xce, 0x96, 0x0d, 0x5d, 0x2f, 0x65, 0xb5, 0x74, 0x4b, 0x99, 0x62, 0x53, 0x13, 0xa0, 0x9f,
-	0xc1, 0xac, 0x4a, 0xa7, 0xce, 0xb6, 0x0d, 0x51, 0x3a, 0xb7, 0x4e, 0xe7, 0xd0, 0x00, 0xf4, 0x11,
-	0xcc, 0xd9, 0x44, 0xee, 0xdc, 0xd7, 0xd8, 0xd7, 0xdc, 0x3c, 0x5f, 0x4f, 0x40, 0xa6, 0x08, 0xf4,
-	0x01, 0x94, 0xd3, 0xfa, 0xc7, 0x59, 0xd4, 0xe8, 0x75, 0x37, 0x60, 0x9c, 0x64, 0x90, 0xa7, 0x56,
-	0xda, 0x98, 0xfd, 0xeb, 0x77, 0x0f, 0x6e, 0x79, 0x99, 0x36, 0x3a, 0x85, 0xbb, 0xa6, 0x32, 0x72,
-	0x96, 0x34, 0x6e, 0x6d, 0x14, 0xd7, 0xd4, 0xb2, 0xc6, 0xfd, 0xbf, 0xfc, 0x67, 0xb6, 0xa4, 0x90,
-	0xff, 0xfe, 0xee, 0xc1, 0x8a, 0x24, 0x42, 0x86, 0xb4, 0xdd, 0xfe, 0x70, 0x97, 0x76, 0x62, 0xc6,
-	0xc9, 0xae, 0x67, 0x29, 0x6a, 0xcb, 0xb0, 0x38, 0x9a, 0xe9, 0x6a, 0xab, 0xb0, 0x72, 0x25, 0xde,
-	0xd7, 0xbe, 0x9d, 0x81, 0xf9, 0x62, 0x90, 0x46, 0x6b, 0x70, 0x47, 0xb2, 0x2e, 0x89, 0x6d, 0x9a,
-	0x36, 0x03, 0xf5, 0x8a, 0x71, 0x18, 0x72, 0x22, 0x54, 0x42, 0x56, 0xf3, 0xe9, 0x10, 0x6d, 0xc0,
-	0x5c, 0x80, 0xfd, 0x80, 0x70, 0xe9, 0xdc, 0xd6, 0x92, 0xbb, 0x01, 0x3e, 0x20, 0x5c, 0x5a, 0x41,
-	0x82, 0xe5, 0xb9, 0x4e, 0xc8, 0x5a, 0xf0, 0x0c, 0xcb, 0x73, 0xf4, 0x00, 0xaa, 0x41, 0x44, 0x49,
-	0x2c, 0x0d, 0xea, 0x8e, 0x16, 0x82, 0x99, 0xd2, 0xc8, 0xfb, 0x60, 0x47, 0x7e, 0x97, 0x0c, 0x75,
-	0x06, 0xab, 0x78, 0x15, 0x33, 0x73, 0x4a, 0x86, 0xe8, 0x47, 0xb0, 0x24, 0x23, 0x61, 0xbd, 0x44,
-	0x97, 0x0a, 0x3a, 0x09, 0x55, 0xbc, 0x05, 0x19, 0x09, 0x73, 0xf5, 0xaa, 0x50, 0x40, 0xef, 0x43,
-	0x99, 0xc6, 0x82, 0x04, 0x7d, 0x9e, 0xa6, 0x92, 0xda, 0x95, 0x70, 0xd6, 0x60, 0x2c, 0x7a, 0x81,
-	0xa3, 0x3e, 0xf1, 0x32, 0x5d, 0x15, 0xcc, 0x38, 0x63, 0x66, 0xf1, 0x8a, 0x39, 0xac, 0x1a, 0x9f,
-	0x92, 0x61, 0xed, 0x75, 0x28, 0xa7, 0xb1, 0x74, 0x44, 0xad, 0x34, 0xaa, 0xb6, 0x0e, 0x6b, 0xd7,
-	0xa5, 0x8f, 0xda, 0x1b, 0x50, 0xc9, 0x42, 0x3d, 0xda, 0x56, 0xd1, 0xcb, 0x0e, 0x2c, 0x41, 0x3e,
-	0x51, 0xfb, 0x47, 0x09, 0x16, 0x47, 0xe3, 0x1e, 0xda, 0x87, 0xfb, 0x41, 0xd4, 0x17, 0x92, 0x70,
-	0x9f, 0xc6, 0x1d, 0x65, 0x7c, 0x3f, 0xe1, 0xec, 0x72, 0xe8, 0xa7, 0x37, 0x63, 0x48, 0x6a, 0x56,
-	0xe9, 0xc4, 0xe8, 0x3c, 0x53, 0x2a, 0xfb, 0xf6, 0xb2, 0x0e, 0x60, 0xc7, 0x06, 0x4f, 0x3f, 0x2d,
-	0x0a, 0xc7, 0x38, 0xcc, 0xed, 0x6e, 0x59, 0xad, 0x43, 0xab, 0x34, 0x89, 0x84, 0xc6, 0xd7, 0x92,
-	0xdc, 0x1e, 0x21, 0x39, 0x89, 0xaf, 0x92, 0xd4, 0xfe, 0x58, 0x82, 0xe5, 0xf1, 0xa0, 0x8c, 0x7e,
-	0x09, 0xe5, 0x76, 0x28, 0x4c, 0x1a, 0x51, 0x87, 0x59, 0xdc, 0xab, 0xdf, 0x30, 0x9e, 0xbb, 0x47,
-	0xa1, 0x50, 0xe9, 0xc6, 0x9b, 0x6b, 0x9b, 0x1f, 0xbb, 0x3f, 0x85, 0x39, 0x3b, 0x87, 0x16, 0xa0,
-	0xd2, 0x78, 0xb2, 0x7f, 0x70, 0xfa, 0xe4, 0xa4, 0xf9, 0x7c, 0xf9, 0x96, 0x1a, 0x9e, 0x1d, 0x9f,
-	0x3c, 0x3f, 0xd4, 0xc3, 0x12, 0x9a, 0x87, 0xf2, 0xe3, 0x93, 0xe6, 0x7e, 0xe3, 0xc9, 0xe1, 0xe3,
-	0xe5, 0x99, 0xda, 0xdf, 0xef, 0xc0, 0xea, 0x35, 0x11, 0x18, 0x6d, 0xe7, 0x0f, 0x40, 0x9b, 0xb9,
-	0x31, 0xe3, 0x94, 0xf2, 0x47, 0xf0, 0x1a, 0xcc, 0x9f, 0x4b, 0x99, 0x64, 0x06, 0x58, 0xd0, 0x06,
-	0xa8, 0xaa, 0xb9, 0xd4, 0x6a, 0x0f, 0xa0, 0x1a, 0xc6, 0x22, 0xd3, 0x58, 0x34, 0x5e, 0x1f, 0xc6,
-	0x22, 0x55, 0x38, 0x85, 0x35, 0xa5, 0x90, 0xb0, 0x28, 0xa2, 0x71, 0xc7, 0x98, 0x76, 0x80, 0x23,
-	0x1b, 0x0b, 0xa6, 0x64, 0x62, 0x14, 0xc6, 0xe2, 0x99, 0x41, 0x9d, 0x58, 0x10, 0xda, 0x01, 0x50,
-	0x21, 0x25, 0xd0, 0x61, 0xcb, 0x5e, 0x6a, 0x61, 0x06, 0xd5, 0xa0, 0xdc, 0x17, 0xea, 0x56, 0x7a,
-	0xc4, 0xde, 0x56, 0x36, 0x56, 0xb2, 0x04, 0x0b, 0x71, 0xc1, 0x78, 0x68, 0x5f, 0x6e, 0x36, 0xce,
-	0xa3, 0xc3, 0x9d, 0x62, 0x74, 0x30, 0x4f, 0xbd, 0x4d, 0x23, 0x62, 0x5f, 0xeb, 0xdd, 0x00, 0x1f,
-	0xd1, 0x88, 0x14, 0x63, 0xc0, 0xdc, 0x48, 0x0c, 0xd8, 0x82, 0x8a, 0x7a, 0xfc, 0x06, 0x53, 0x36,
-	0x8b, 0xa8, 0x09, 0x8d, 0xda, 0x84, 0x72, 0x97, 0x0c, 0x8d, 0xcc, 0x3e, 0xc0, 0x2e, 0x19, 0x6a,
-	0xd1, 0x13, 0x58, 0x4b, 0xdf, 0xa9, 0x2f, 0xba, 0x34, 0xf1, 0x07, 0x84, 0xd3, 0xf6, 0xd0, 0xd6,
-	0x57, 0xd3, 0xde, 0x37, 0x4a, 0x71, 0xcd, 0x2e, 0x4d, 0x5e, 0x68, 0x14, 0x7a, 0x1f, 0x2a, 0x17,
-	0x98, 0x4a, 0x5f, 0xd2, 0x1e, 0x71, 0xaa, 0x2f, 0xb3, 0x73, 0x59, 0xe9, 0x3e, 0xa7, 0x3d, 0x82,
-	0x18, 0xac, 0x08, 0x93, 0xcb, 0xfc, 0xbc, 0x00, 0x31, 0x15, 0x53, 0xe3, 0xe6, 0x59, 0x3d, 0xcd,
-	0x87, 0x57, 0x6a, 0x93, 0x65, 0x31, 0x26, 0xa8, 0x7d, 0x0c, 0x1b, 0x13, 0x94, 0x95, 0xeb, 0xa9,
-	0x7b, 0xf5, 0xcd, 0xc5, 0x2a, 0xef, 0x54, 0xfd, 0x52, 0x55, 0xcd, 0x1d, 0x98, 0xa9, 0xda, 0xb7,
-	0x25, 0xd8, 0x98, 0x50, 0x0d, 0xa0, 0xaf, 0xa0, 0xaa, 0xd2, 0xa6, 0xaf, 0xf3, 0xa6, 0xf1, 0xed,
-	0xea, 0xde, 0xcf, 0x5f, 0xad, 0xa4, 0x70, 0x55, 0x0d, 0xf8, 0x44, 0x13, 0x78, 0xc0, 0xb3, 0xdf,
-	0xb5, 0xf7, 0x00, 0x72, 0x09, 0x5a, 0x86, 0xdb, 0x5f, 0x3c, 0x6b, 0xea, 0x15, 0x66, 0x3c, 0xf5,
-	0x53, 0x39, 0x53, 0xab, 0xcf, 0x85, 0xd4, 0xfe, 0xb9, 0xe0, 0x99, 0xc1, 0x87, 0xeb, 0xdf, 0x7c,
-	0x3f, 0x3b, 0x0b, 0x33, 0x42, 0x7e, 0xf3, 0xfd, 0x2c, 0xa0, 0x72, 0xfa, 0x8d, 0xa2, 0xb1, 0x04,
-	0x0b, 0x23, 0x4d, 0x98, 0x9a, 0x18, 0xe9, 0x17, 0x1a, 0x2b, 0xb0, 0x34, 0x56, 0x17, 0xef, 0xfe,
-	0xab, 0x02, 0xd5, 0x42, 0x09, 0x87, 0x76, 0x61, 0xe1, 0x32, 0x14, 0x7e, 0x8b, 0xc6, 0xa1, 0x7e,
-	0x8a, 0x36, 0x66, 0x56, 0x2f, 0x43, 0xd1, 0xa0, 0x71, 0xa8, 0xde, 0x22, 0x7a, 0x07, 0xd6, 0x06,
-	0x38, 0xa2, 0xa1, 0x3e, 0x5b, 0x41, 0xd5, 0xbc, 0x22, 0x94, 0xcb, 0x32, 0xc4, 0x53, 0x58, 0x1e,
-	0xeb, 0xc8, 0x4d, 0x0c, 0xac, 0xee, 0xed, 0x8e, 0x5a, 0xf2, 0xc0, 0x68, 0x35, 0x8c, 0x92, 0x31,
-	0xa2, 0xb7, 0x14, 0x8c, 0xcc, 0x0a, 0xf4, 0x25, 0x6c, 0x92, 0x38, 0x4c, 0x18, 0x8d, 0xa5, 0xf0,
-	0x2f, 0x30, 0xef, 0xa9, 0x78, 0xa0, 0x7c, 0x94, 0xf5, 0xa5, 0x6d, 0x6e, 0xa7, 0xb8, 0xe9, 0x46,
-	0x86, 0x3d, 0x33, 0xd0, 0xe7, 0x06, 0x89, 0x0e, 0xa1, 0x8a, 0x2f, 0x84, 0x6f, 0x0b, 0x20, 0xdb,
-	0xc3, 0xfe, 0x70, 0x62, 0xb9, 0xeb, 0xee, 0x9f, 0x35, 0x53, 0x8f, 0x04, 0x7c, 0x21, 0x52, 0x13,
-	0x62, 0xb8, 0x47, 0x63, 0x6d, 0x84, 0xb4, 0x29, 0x4e, 0x58, 0x44, 0x83, 0xa1, 0x6d, 0x35, 0xdf,
-	0x9e, 0x4c, 0x78, 0x62, 0x60, 0xe6, 0xd8, 0xcf, 0x34, 0xc8, 0x5b, 0xa5, 0x57, 0x27, 0xd1, 0x11,
-	0x3c, 0x08, 0xa9, 0xc0, 0xad, 0x88, 0xf8, 0x85, 0xfe, 0x2d, 0x24, 0x42, 0xd2, 0x18, 0x9b, 0xdd,
-	0xcf, 0xe9, 0x5e, 0xe2, 0xbe, 0x55, 0xcb, 0x1d, 0xf3, 0x71, 0x41, 0x09, 0x3d, 0x86, 0xe5, 0x94,
-	0xa7, 0xc3, 0x93, 0xc0, 0xbf, 0x20, 0xad, 0x1b, 0x54, 0x02, 0x8b, 0x16, 0xf3, 0x19, 0x4f, 0x82,
-	0x33, 0xd2, 0x42, 0x01, 0x3c, 0x4c, 0x59, 0x4c, 0x9a, 0xeb, 0x60, 0xde, 0xc2, 0x1d, 0xe2, 0x07,
-	0x2c, 0x8a, 0x48, 0xa0, 0x96, 0xb2, 0xbd, 0xe4, 0x34, 0xd6, 0x74, 0xab, 0x3a, 0x0b, 0x7e, 0x66,
-	0x18, 0x0e, 0x32, 0x02, 0xf4, 0x05, 0xac, 0x73, 0xd2, 0x21, 0x97, 0x7e, 0x0f, 0x5f, 0xaa, 0x65,
-	0x3a, 0x1c, 0xf7, 0x7c, 0x41, 0xbf, 0x4e, 0x5b, 0xc7, 0xed, 0x2b, 0xd4, 0x5f, 0x9e, 0xc4, 0xf2,
-	0xdd, 0x3d, 0x43, 0xbe, 0xaa, 0xb1, 0x4f, 0xf1, 0xe5, 0x33, 0x83, 0x6c, 0xd2, 0xaf, 0x09, 0x7a,
-	0x0b, 0x10, 0x27, 0x42, 0xfa, 0xa3, 0x0e, 0x5f, 0xd5, 0x5e, 0xbc, 0xa4, 0x24, 0xbf, 0xce, 0x9d,
-	0xbe, 0xf6, 0xbf, 0x12, 0x40, 0x7e, 0xe1, 0xe8, 0x17, 0xb0, 0x45, 0x62, 0x7d, 0xe4, 0x80, 0x93,
-	0x90, 0xc4, 0x92, 0xe2, 0x48, 0xa4, 0xc1, 0xce, 0x94, 0x2b, 0xe5, 0xe3, 0x5b, 0xde, 0xa6, 0x51,
-	0x3a, 0xc8, 0x75, 0x6c, 0x7c, 0x1a, 0xa2, 0x3f, 0x94, 0x60, 0x2b, 0x0d, 0x92, 0x38, 0x08, 0x58,
-	0x5f, 0xd5, 0x7b, 0xb9, 0x9e, 0x7e, 0x4d, 0xd5, 0xbd, 0x2f, 0x5c, 0xfd, 0x4d, 0xca, 0x35, 0x9e,
-	0xe4, 0xda, 0x6f, 0x51, 0x2a, 0x6f, 0xba, 0xca, 0x57, 0x23, 0xdc, 0x6b, 0x85, 0xd8, 0x1d, 0xec,
-	0x29, 0x67, 0x7c, 0xa2, 0x07, 0xc6, 0x51, 0xd2, 0xd8, 0xb9, 0x6f, 0x98, 0x0b, 0x1b, 0x50, 0xbb,
-	0x12, 0x93, 0x84, 0x8d, 0x7b, 0xb0, 0x5a, 0x3c, 0x50, 0x9b, 0xc8, 0xe0, 0x9c, 0xf0, 0xda, 0xdf,
-	0x4a, 0xb0, 0x7a, 0x8d, 0x77, 0xa2, 0xf7, 0xd4, 0xad, 0x24, 0x11, 0x0e, 0x54, 0xa9, 0x63, 0x7c,
-	0x9e, 0xb3, 0xbe, 0xea, 0xbd, 0xb4, 0x05, 0xbc, 0x35, 0x2b, 0xb5, 0x58, 0x4f, 0xcb, 0xd0, 0x27,
-	0xb0, 0x35, 0xa2, 0xed, 0x73, 0x22, 0x12, 0x16, 0x0b, 0xe5, 0x31, 0x21, 0xb1, 0xd1, 0xce, 0xa1,
-	0x05, 0x8c, 0x67, 0x15, 0x0e, 0x54, 0xb9, 0x32, 0x19, 0xde, 0x62, 0xe1, 0xd0, 0xa6, 0xeb, 0x6b,
-	0xe1, 0x0d, 0x16, 0x0e, 0x77, 0xff, 0x7b, 0x07, 0x16, 0x47, 0x7b, 0x50, 0x75, 0x8c, 0x42, 0x44,
-	0xb3, 0x85, 0x73, 0x21, 0xfc, 0x15, 0xe2, 0x9d, 0xa9, 0x9f, 0x75, 0x54, 0xfb, 0x1c, 0x20, 0x9f,
-	0xb7, 0xf1, 0xcc, 0x9d, 0xd6, 0xeb, 0xba, 0x2f, 0x32, 0xf5, 0x2c, 0x70, 0xe4, 0x0c, 0xe8, 0x18,
-	0x5e, 0xe3, 0x04, 0x87, 0xbe, 0x6d, 0x88, 0x85, 0xdf, 0xe6, 0xac, 0xe7, 0xe3, 0x28, 0x2a, 0x7e,
-	0xee, 0x9b, 0x35, 0xef, 0x5a, 0x29, 0x5a, 0x72, 0x71, 0xc4, 0x59, 0x6f, 0x3f, 0x8a, 0x0a, 0x1f,
-	0xff, 0x8e, 0x60, 0x07, 0x47, 0x9a, 0x42, 0x30, 0x2e, 0xad, 0x95, 0xa4, 0x76, 0x57, 0x7b, 0x3d,
-	0x2a, 0xb8, 0x95, 0x75, 0x8d, 0x56, 0x33, 0x9a, 0x4d, 0xc6, 0xa5, 0xb6, 0xd5, 0x73, 0xa5, 0x66,
-	0x2f, 0x6a, 0x0f, 0xee, 0x05, 0xac, 0x97, 0xa8, 0xf2, 0x8b, 0x84, 0xf6, 0x71, 0x8b, 0x84, 0x04,
-	0x3a, 0x94, 0x95, 0xbd, 0xd5, 0x5c, 0xa8, 0x5f, 0x6d, 0x33, 0x21, 0x41, 0xed, 0x4f, 0xb7, 0x61,
-	0xe5, 0xca, 0x39, 0xd1, 0xa7, 0xb0, 0x6d, 0xe0, 0x13, 0xec, 0x6c, 0x72, 0xc7, 0xa6, 0xd6, 0x79,
-	0x71, 0x9d, 0xb1, 0x3f, 0x81, 0xad, 0x02, 0xf4, 0x82, 0xb4, 0xce, 0x19, 0xeb, 0xfa, 0xaa, 0xcf,
-	0x29, 0xb4, 0x56, 0x4e, 0xae, 0x72, 0x66, 0x34, 0x9e, 0x47, 0x42, 0xb7, 0x4c, 0x1f, 0x41, 0x6d,
-	0x02, 0x5c, 0xb5, 0x27, 0xa6, 0x8a, 0xdb, 0xb8, 0x0e, 0xad, 0x1a, 0xaa, 0x03, 0xd8, 0x31, 0xdd,
-	0xa3, 0xaf, 0x2e, 0xb7, 0x78, 0x84, 0x36, 0xa6, 0x91, 0x6a, 0x9f, 0xb4, 0x39, 0xbd, 0x2d, 0xa3,
-	0xa5, 0x42, 0x7a, 0x7e, 0x86, 0x23, 0xa3, 0x82, 0x3e, 0x85, 0x05, 0x7b, 0x27, 0x38, 0x08, 0x48,
-	0x22, 0x6d, 0x3a, 0x98, 0x16, 0x12, 0xe7, 0x0d, 0x60, 0x5f, 0xeb, 0xa3, 0x7d, 0x58, 0xc4, 0x51,
-	0xc4, 0x2e, 0x54, 0xc6, 0x8b, 0x55, 0xc6, 0xb7, 0x9f, 0x16, 0xa7, 0x31, 0x2c, 0x68, 0xc4, 0x99,
-	0x05, 0x34, 0x3e, 0x54, 0xad, 0xf1, 0x9f, 0xff, 0xb9, 0x53, 0xfa, 0xea, 0x9d, 0x9b, 0xfd, 0x1f,
-	0x24, 0xe9, 0x76, 0xec, 0x27, 0xf5, 0xd6, 0x5d, 0x4d, 0xff, 0xee, 0xff, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0xa6, 0xa1, 0x92, 0xe8, 0x42, 0x19, 0x00, 0x00,
+	// 3177 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x5a, 0xcd, 0x73, 0x1b, 0x47,
+	0x76, 0x17, 0x28, 0x8a, 0x04, 0x1f, 0xf8, 0xd9, 0xa4, 0xc8, 0xe1, 0x50, 0xa2, 0x68, 0x3a, 0x76,
+	0x64, 0xbb, 0x0c, 0x38, 0x94, 0xad, 0x38, 0xb2, 0x5c, 0x0e, 0x01, 0x92, 0x26, 0x4d, 0x4a, 0x96,
+	0x07, 0x94, 0x98, 0x72, 0x52, 0x99, 0x34, 0x66, 0x1a, 0xe0, 0x04, 0x83, 0xe9, 0xa9, 0xee, 0x06,
+	0x48, 0xf8, 0x16, 0x5f, 0x73, 0xcc, 0x3f, 0x91, 0xaa, 0xdc, 0x72, 0xca, 0x1f, 0x90, 0x54, 0xe5,
+	0xb2, 0xb5, 0x97, 0xdd, 0xe3, 0xfa, 0xb0, 0xd7, 0xdd, 0x8b, 0xb7, 0x6a, 0x4f, 0x7b, 0xd8, 0xad,
+	0xfe, 0x98, 0x0f, 0x80, 0x00, 0x49, 0x5d, 0x54, 0xe8, 0x7e, 0xef, 0xf7, 0xeb, 0xee, 0x37, 0xaf,
+	0xdf, 0x7b, 0xfd, 0x28, 0xf8, 0xa2, 0x15, 0x88, 0xf3, 0x6e, 0xa3, 0xec, 0xd1, 0x4e, 0x85, 0xd3,
+	0x90, 0x7e, 0x1c, 0xd0, 0x4a, 0x2b, 0xa4, 0xb4, 0x12, 0x33, 0xfa, 0xaf, 0xc4, 0x13, 0x5c, 0x8f,
+	0x70, 0x1c, 0x54, 0x7a, 0x7f, 0x53, 0xe1, 0x44, 0x88, 0x20, 0x6a, 0xf1, 0x72, 0xcc, 0xa8, 0xa0,
+	0x68, 0x56, 0xca, 0xca, 0x12, 0x56, 0x0e, 0xa8, 0xbd, 0xd2, 0xa2, 0x2d, 0xaa, 0x04, 0x15, 0xf9,
+	0x4b, 0xeb, 0xd8, 0x88, 0x5c, 0x0a, 0x3d, 0x49, 0x2e, 0x85, 0x99, 0xdb, 0x54, 0x2b, 0xb5, 0x03,
+	0x91, 0xf0, 0x76, 0x88, 0xc0, 0x3e, 0x16, 0xd8, 0xc8, 0x1f, 0x0c, 0xcb, 0xb9, 0xc0, 0xa2, 0xcb,
+	0xc7, 0xa1, 0x93, 0xb1, 0x91, 0xaf, 0x0f, 0xcb, 0x19, 0x69, 0x1a, 0xd1, 0x87, 0xe3, 0x8f, 0x46,
+	0x2e, 0x05, 0x89, 0x78, 0x40, 0xa3, 0x64, 0x99, 0x83, 0x6b, 0x74, 0x23, 0x41, 0x58, 0xcc, 0x02,
+	0x4e, 0x2a, 0x34, 0x16, 0x12, 0x53, 0x61, 0x58, 0x90, 0x30, 0xe8, 0x04, 0x22, 0xfb, 0x65, 0x78,
+	0xf6, 0xdf, 0x8a, 0x87, 0x5c, 0x0a, 0xdc, 0x15, 0xe7, 0x66, 0x47, 0xf2, 0xa7, 0xa1, 0x79, 0xfe,
+	0x76, 0xdb, 0x69, 0x60, 0x4f, 0xfd, 0x63, 0xd0, 0xd7, 0x7c, 0x53, 0x2f, 0x60, 0x5e, 0x37, 0x10,
+	0x6e, 0x83, 0x11, 0xdc, 0x26, 0xcc, 0x00, 0x76, 0xc7, 0x00, 0xa4, 0x99, 0x58, 0x84, 0xc3, 0x0a,
+	0x89, 0x7a, 0xb4, 0x9f, 0xb3, 0x5a, 0x05, 0x5f, 0xf0, 0x4a, 0x33, 0x08, 0x45, 0x4a, 0xb1, 0xd9,
+	0xa2, 0xb4, 0x15, 0x92, 0x8a, 0x1a, 0x35, 0xba, 0xcd, 0x8a, 0xdf, 0x65, 0x58, 0x6e, 0x6f, 0x9c,
+	0xfc, 0x82, 0xe1, 0x38, 0x26, 0xcc, 0x7c, 0x80, 0xed, 0x5f, 0xbd, 0x0f, 0xc5, 0xba, 0x71, 0x38,
+	0x54, 0x81, 0x65, 0x3f, 0xe0, 0x1e, 0xed, 0x11, 0xd6, 0x77, 0x23, 0xdc, 0x21, 0x3c, 0xc6, 0x1e,
+	0xb1, 0x0a, 0x5b, 0x85, 0xc7, 0x33, 0x0e, 0x4a, 0x45, 0x2f, 0x13, 0x09, 0xfa, 0x00, 0x16, 0x2f,
+	0xb0, 0xf0, 0xce, 0x33, 0x65, 0x6e, 0x4d, 0x6c, 0xdd, 0x7d, 0x3c, 0xe3, 0x2c, 0xa8, 0xf9, 0x54,
+	0x93, 0x23, 0x0c, 0x56, 0xbb, 0xdb, 0x20, 0x2c, 0x22, 0x82, 0x70, 0xd7, 0xa3, 0x51, 0x33, 0x68,
+	0xb9, 0x9c, 0x76, 0x99, 0x47, 0xac, 0xc9, 0xad, 0xc2, 0xe3, 0xd2, 0xce, 0x7b, 0xe5, 0xbc, 0xa7,
+	0x97, 0x93, 0x5d, 0x95, 0x8f, 0x53, 0x58, 0x8d, 0xf9, 0xfc, 0xf0, 0x8e, 0xb3, 0x9a, 0x11, 0xd5,
+	0x14, 0x4f, 0x5d, 0xd1, 0xa0, 0xef, 0x61, 0xcd, 0x0f, 0x18, 0xf1, 0x04, 0x65, 0xfd, 0xa1, 0x15,
+	0xee, 0xa9, 0x15, 0xb6, 0xc6, 0xac, 0xb0, 0x97, 0xa0, 0x0e, 0xef, 0x38, 0xf7, 0x53, 0x8a, 0x01,
+	0xee, 0x63, 0x58, 0xf4, 0x68, 0xc4, 0xbb, 0xa1, 0xdb, 0xee, 0x25, 0xa4, 0xf7, 0x15, 0xe9, 0xa3,
+	0x31, 0xa4, 0x35, 0xa5, 0x7e, 0xdc, 0x3b, 0xbc, 0xe3, 0xcc, 0x7b, 0xe6, 0xb7, 0x21, 0xf3, 0x07,
+	0x6c, 0xc1, 0x89, 0xc7, 0x88, 0x48, 0x48, 0xa7, 0x14, 0xe9, 0xe3, 0x1b, 0x6d, 0x51, 0x57, 0x28,
+	0x7e, 0x58, 0xc8, 0x9b, 0x43, 0x4f, 0x9a, 0x55, 0x5e, 0xc3, 0x72, 0x0f, 0x77, 0x43, 0x31, 0xb4,
+	0xc0, 0xb4, 0x5a, 0xe0, 0xdd, 0x31, 0x0b, 0xbc, 0x91, 0x88, 0x8c, 0x7b, 0xa9, 0x97, 0x8d, 0x47,
+	0x59, 0x79, 0x90, 0xba, 0x78, 0x4b, 0x2b, 0x17, 0x72, 0x56, 0x1e, 0xe0, 0x6e, 0x83, 0x9d, 0x33,
+	0x0c, 0x66, 0x22, 0x68, 0x62, 0x2f, 0xa5, 0x9f, 0x51, 0xf4, 0x1f, 0xdd, 0xec, 0x26, 0xea, 0xc3,
+	0x75, 0x70, 0xcc, 0x0f, 0x27, 0x9c, 0x9c, 0xa5, 0x77, 0x0d, 0x9f, 0x59, 0xec, 0x9f, 0x61, 0x3d,
+	0x3b, 0xc8, 0xf0, 0x5a, 0x70, 0xcb, 0xa3, 0x4c, 0x38, 0x99, 0x35, 0x86, 0xf8, 0xff, 0x09, 0xd6,
+	0x33, 0x97, 0x19, 0xe6, 0x5f, 0xbb, 0x9d, 0xef, 0x4c, 0x38, 0xab, 0x89, 0xef, 0x0c, 0xb1, 0x3f,
+	0x87, 0x59, 0x46, 0x9a, 0x8c, 0xf0, 0x73, 0x57, 0x06, 0x43, 0x6b, 0x56, 0x11, 0xae, 0x97, 0xf5,
+	0x7d, 0x2f, 0x27, 0xf7, 0xbd, 0xbc, 0x67, 0xe2, 0x81, 0x53, 0x32, 0xea, 0x0e, 0x16, 0x04, 0xad,
+	0x43, 0xd1, 0x27, 0x3d, 0xb7, 0x43, 0x7d, 0x62, 0xcd, 0x6d, 0x15, 0x1e, 0x17, 0x9d, 0x69, 0x9f,
+	0xf4, 0x5e, 0x50, 0x9f, 0x20, 0x0b, 0xa6, 0xc3, 0x20, 0x6a, 0x13, 0xe6, 0x5b, 0x4b, 0x5a, 0x62,
+	0x86, 0xe8, 0x2b, 0x98, 0x6e, 0x47, 0x58, 0x04, 0x3d, 0x62, 0xa1, 0xeb, 0x6f, 0xac, 0xd6, 0xfa,
+	0x56, 0xc7, 0x49, 0x27, 0x41, 0xa1, 0x7d, 0x98, 0x49, 0x83, 0x88, 0xb5, 0xac, 0x28, 0xfe, 0x7a,
+	0xac, 0x85, 0x8d, 0x5e, 0x42, 0x92, 0x21, 0xd1, 0xc7, 0x30, 0x29, 0x41, 0x96, 0x95, 0x1c, 0x39,
+	0xcf, 0xf0, 0x75, 0x48, 0x69, 0x82, 0x51, 0x6a, 0xe8, 0x29, 0x4c, 0xb7, 0xb0, 0x20, 0x17, 0xb8,
+	0x6f, 0xad, 0x2b, 0xc4, 0x83, 0x21, 0x84, 0x16, 0xa6, 0xbb, 0x35, 0xca, 0xa8, 0x0a, 0x53, 0xda,
+	0xf6, 0xd6, 0x8a, 0x82, 0x7d, 0x78, 0xed, 0xc7, 0xd2, 0x4e, 0x97, 0x18, 0xdb, 0x20, 0xd1, 0x4b,
+	0x80, 0xcc, 0xff, 0xac, 0x55, 0xc5, 0x53, 0xbe, 0xa5, 0x03, 0x27, 0x5c, 0x39, 0x06, 0xb9, 0x27,
+	0x9f, 0x7a, 0x6d, 0xc2, 0xac, 0xcd, 0x6b, 0xf7, 0xb4, 0xa7, 0x94, 0x86, 0xf6, 0xa4, 0x91, 0xe8,
+	0x73, 0x80, 0x2c, 0xa3, 0x58, 0x8b, 0x8a, 0xc7, 0x1a, 0xe4, 0xd9, 0x4f, 0xe5, 0x4e, 0x4e, 0x17,
+	0xbd, 0x80, 0x99, 0x34, 0xf1, 0x5a, 0xb6, 0x02, 0x56, 0xca, 0xe9, 0x4c, 0xd9, 0xe4, 0xc5, 0xe1,
+	0x2d, 0xb1, 0x5e, 0xe0, 0x91, 0x64, 0x67, 0x4e, 0xc6, 0x80, 0xea, 0xb0, 0x98, 0x0e, 0x5c, 0x4e,
+	0x58, 0x8f, 0x30, 0x6b, 0xc3, 0x84, 0xbf, 0x1b, 0x59, 0x0d, 0xdd, 0x42, 0xaa, 0x58, 0x57, 0x04,
+	0xe8, 0x6f, 0x61, 0x52, 0xa6, 0x64, 0xeb, 0x81, 0x09, 0x73, 0x72, 0x70, 0x03, 0x87, 0x02, 0xa0,
+	0x2f, 0x60, 0xda, 0x14, 0x03, 0xd6, 0x43, 0x85, 0x7d, 0xa7, 0x9c, 0xe5, 0xfc, 0x31, 0xc8, 0x04,
+	0x81, 0x3e, 0x87, 0x62, 0x52, 0x5e, 0x59, 0xf3, 0x0a, 0xbd, 0x5a, 0xf6, 0x28, 0x23, 0x29, 0xe4,
+	0x85, 0x91, 0x56, 0x27, 0xff, 0xff, 0xa7, 0x47, 0x77, 0x9c, 0x54, 0x1b, 0x1d, 0xc3, 0x94, 0x2e,
+	0xbc, 0xac, 0x05, 0x85, 0x5b, 0x19, 0xc4, 0xd5, 0x95, 0xac, 0xfa, 0xf0, 0x7f, 0xfe, 0x38, 0x59,
+	0x90, 0xc8, 0x3f, 0xfc, 0xf4, 0x68, 0x49, 0x10, 0x2e, 0xfc, 0xa0, 0xd9, 0x7c, 0xb6, 0x1d, 0xb4,
+	0x22, 0xca, 0xc8, 0xb6, 0x63, 0x28, 0xec, 0x45, 0x98, 0x1f, 0xcc, 0x96, 0xf6, 0x32, 0x2c, 0x5d,
+	0xc9, 0x19, 0xf6, 0x7f, 0x4d, 0xc0, 0x6c, 0x3e, 0xd0, 0xa3, 0x15, 0xb8, 0x27, 0x68, 0x9b, 0x44,
+	0x26, 0xd5, 0xeb, 0x81, 0x8c, 0x04, 0xd8, 0xf7, 0x19, 0xe1, 0x32, 0xa9, 0xcb, 0xf9, 0x64, 0x88,
+	0xd6, 0x60, 0xda, 0xc3, 0xae, 0x47, 0x98, 0xb0, 0xee, 0x2a, 0xc9, 0x94, 0x87, 0x6b, 0x84, 0x09,
+	0x23, 0x88, 0xb1, 0x38, 0xb7, 0x26, 0x13, 0xc1, 0x2b, 0x2c, 0xce, 0xd1, 0x23, 0x28, 0x79, 0x61,
+	0x40, 0x22, 0xa1, 0x51, 0xf7, 0x94, 0x10, 0xf4, 0x94, 0x42, 0x3e, 0x04, 0x33, 0x72, 0xdb, 0xa4,
+	0xaf, 0xb2, 0xe0, 0x8c, 0x33, 0xa3, 0x67, 0x8e, 0x49, 0x1f, 0xbd, 0x0f, 0x0b, 0x22, 0xe4, 0xc6,
+	0x4b, 0x54, 0xb9, 0xa1, 0x12, 0xd9, 0x8c, 0x33, 0x27, 0x42, 0xae, 0x3f, 0xbd, 0x2c, 0x36, 0xd0,
+	0x53, 0x28, 0x06, 0x11, 0x27, 0x5e, 0x97, 0x25, 0xe9, 0xc8, 0xbe, 0x12, 0x12, 0xab, 0x94, 0x86,
+	0x6f, 0x70, 0xd8, 0x25, 0x4e, 0xaa, 0x2b, 0x03, 0x22, 0xa3, 0x54, 0x2f, 0x3e, 0xa3, 0x0f, 0x2b,
+	0xc7, 0xc7, 0xa4, 0x6f, 0xbf, 0x07, 0xc5, 0x24, 0x1e, 0x0f, 0xa8, 0x15, 0x06, 0xd5, 0x56, 0x61,
+	0x65, 0x54, 0x0a, 0xb2, 0x3f, 0x80, 0x99, 0x34, 0x5d, 0xa0, 0x07, 0x32, 0x02, 0x9a, 0x81, 0x21,
+	0xc8, 0x26, 0xec, 0xdf, 0x14, 0x60, 0x7e, 0x30, 0x76, 0xa2, 0x5d, 0x78, 0xe8, 0x85, 0x5d, 0x2e,
+	0x08, 0x73, 0x83, 0xa8, 0x25, 0x8d, 0xef, 0xc6, 0x8c, 0x5e, 0xf6, 0xdd, 0xe4, 0xcb, 0x68, 0x12,
+	0xdb, 0x28, 0x1d, 0x69, 0x9d, 0x57, 0x52, 0x65, 0xd7, 0x7c, 0xac, 0x1a, 0x6c, 0x9a, 0x00, 0xec,
+	0x26, 0x85, 0xe5, 0x10, 0x87, 0xfe, 0xba, 0x1b, 0x46, 0x6b, 0xdf, 0x28, 0x8d, 0x23, 0x09, 0xa2,
+	0x91, 0x24, 0x77, 0x07, 0x48, 0x8e, 0xa2, 0xab, 0x24, 0xf6, 0xef, 0x27, 0x61, 0x71, 0x38, 0xb0,
+	0xa3, 0x6f, 0xa0, 0xd8, 0xf4, 0xb9, 0x4e, 0x45, 0xf2, 0x30, 0xf3, 0x3b, 0x95, 0x5b, 0xe6, 0x84,
+	0xf2, 0x81, 0xcf, 0x65, 0xca, 0x72, 0xa6, 0x9b, 0xfa, 0x07, 0xaa, 0x43, 0xa9, 0xeb, 0x73, 0xd7,
+	0x5c, 0x77, 0x75, 0xae, 0xd2, 0xce, 0xce, 0x6d, 0xe9, 0x5e, 0xfb, 0xdc, 0xfc, 0x74, 0xa0, 0x9b,
+	0xfe, 0xb6, 0xff, 0x3c, 0x01, 0x90, 0x89, 0x64, 0x91, 0x1c, 0x44, 0x5e, 0xd8, 0xf5, 0x89, 0x9f,
+	0x2f, 0x7b, 0x0b, 0xaa, 0xec, 0x45, 0x89, 0x28, 0x57, 0xf9, 0x56, 0x60, 0x99, 0x5c, 0x5e, 0x05,
+	0xe8, 0x3a, 0x19, 0x91, 0xcb, 0x2b, 0x80, 0xf7, 0x60, 0x3e, 0xc4, 0x0d, 0x12, 0xba, 0x9c, 0x84,
+	0xca, 0x33, 0x8c, 0x6d, 0xe7, 0xd4, 0x6c, 0xdd, 0x4c, 0xa2, 0x4f, 0x61, 0xb5, 0x1b, 0x73, 0xc1,
+	0x08, 0xee, 0x28, 0x5e, 0x57, 0x90, 0x4e, 0x1c, 0xca, 0x5a, 0x40, 0x5f, 0xbd, 0x95, 0x44, 0x2a,
+	0xa9, 0x4f, 0x8d, 0x0c, 0x51, 0x58, 0x48, 0x51, 0x8a, 0x8f, 0x5b, 0xf7, 0xb6, 0xee, 0x3e, 0x2e,
+	0xed, 0x1c, 0xbc, 0xbd, 0x99, 0xca, 0xaf, 0x0d, 0xd3, 0x89, 0x22, 0xda, 0x8f, 0x04, 0xeb, 0x3b,
+	0xf3, 0xdd, 0x81, 0x49, 0x7b, 0x17, 0x96, 0x47, 0xa8, 0xa1, 0x45, 0xb8, 0x9b, 0x5d, 0x22, 0xf9,
+	0x53, 0x06, 0xa1, 0x9e, 0xbc, 0x95, 0xc6, 0x1d, 0xf5, 0xe0, 0xd9, 0xc4, 0xe7, 0x85, 0xed, 0xcf,
+	0x60, 0xda, 0x7c, 0x6a, 0x34, 0x07, 0x33, 0xd5, 0x93, 0xdd, 0xda, 0xf1, 0xc9, 0x51, 0xfd, 0x74,
+	0xf1, 0x8e, 0x1c, 0x9e, 0x1d, 0x1e, 0x9d, 0xee, 0xab, 0x61, 0x01, 0xcd, 0x42, 0x71, 0xef, 0xa8,
+	0xbe, 0x5b, 0x3d, 0xd9, 0xdf, 0x5b, 0x9c, 0xb0, 0xff, 0xaf, 0x08, 0xcb, 0x23, 0x92, 0x33, 0x7a,
+	0x90, 0xc5, 0x35, 0xb5, 0x7c, 0x75, 0xc2, 0x2a, 0x64, 0xb1, 0xed, 0x1d, 0x98, 0x3d, 0x17, 0x22,
+	0x4e, 0xfd, 0x7a, 0x4e, 0xed, 0xa6, 0x24, 0xe7, 0x92, 0xcb, 0xf0, 0x08, 0x4a, 0x7e, 0xc4, 0x53,
+	0x8d, 0x79, 0xa5, 0x01, 0x7e, 0xc4, 0x13, 0x85, 0x63, 0x58, 0x91, 0x0a, 0x31, 0x0d, 0xc3, 0x20,
+	0x6a, 0xe9, 0x1b, 0xd3, 0xc3, 0xa1, 0xb5, 0x70, 0x53, 0x91, 0x86, 0xfc, 0x88, 0xbf, 0xd2, 0xa8,
+	0x23, 0x03, 0x42, 0x9b, 0x00, 0x32, 0x53, 0x78, 0x2a, 0x1b, 0x19, 0xe3, 0xe4, 0x66, 0x90, 0x0d,
+	0xc5, 0x2e, 0x97, 0x97, 0xad, 0x43, 0x8c, 0xa3, 0xa4, 0x63, 0x29, 0x8b, 0x31, 0xe7, 0x17, 0x94,
+	0xf9, 0xc6, 0x2b, 0xd2, 0x71, 0x16, 0xf4, 0xef, 0xe5, 0x83, 0xbe, 0x8e, 0xe0, 0xcd, 0x20, 0x24,
+	0x26, 0x08, 0x4f, 0x79, 0xf8, 0x20, 0x08, 0x49, 0x3e, 0xb4, 0x4f, 0x0f, 0x84, 0xf6, 0x0d, 0x98,
+	0x91, 0x31, 0x5d, 0x63, 0x8a, 0x7a, 0x11, 0x39, 0xa1, 0x50, 0xeb, 0x50, 0x6c, 0x93, 0xbe, 0x96,
+	0x99, 0xb8, 0xda, 0x26, 0x7d, 0x25, 0x3a, 0x81, 0x95, 0x24, 0xfc, 0xba, 0xbc, 0x1d, 0xc4, 0x6e,
+	0x8f, 0xb0, 0xa0, 0xd9, 0xb7, 0xe0, 0xc6, 0xb0, 0x8d, 0x12, 0x5c, 0xbd, 0x1d, 0xc4, 0x6f, 0x14,
+	0x0a, 0x3d, 0x85, 0x99, 0x0b, 0x1c, 0x08, 0x57, 0x04, 0x1d, 0x62, 0x95, 0x6e, 0xb2, 0x73, 0x51,
+	0xea, 0x9e, 0x06, 0x1d, 0x79, 0x1f, 0x96, 0xb8, 0x2e, 0x51, 0xdc, 0xac, 0x36, 0xd5, 0xc5, 0x74,
+	0xf5, 0xf6, 0x05, 0x5f, 0x52, 0xe6, 0x5c, 0x29, 0x5b, 0x17, 0xf9, 0x90, 0x00, 0xd5, 0x61, 0xda,
+	0xa3, 0x51, 0x44, 0x3c, 0x61, 0x6a, 0xaf, 0xbf, 0x7b, 0x8b, 0x65, 0x6a, 0x1a, 0x99, 0xd6, 0xaa,
+	0x86, 0x09, 0xfd, 0x5b, 0x01, 0xd6, 0x93, 0x63, 0xa8, 0xef, 0x98, 0xbc, 0xcc, 0x18, 0x69, 0x72,
+	0x6b, 0xe9, 0xda, 0x0b, 0x7e, 0xcd, 0x71, 0x4e, 0x25, 0x95, 0x2e, 0x12, 0x1c, 0xd2, 0x34, 0x17,
+	0x7c, 0x95, 0x8f, 0x14, 0xda, 0xcf, 0x61, 0x6d, 0x8c, 0x15, 0xe4, 0x9d, 0x92, 0x0e, 0xeb, 0x6a,
+	0x8f, 0x4d, 0x82, 0x65, 0x49, 0xce, 0xd5, 0xf4, 0x94, 0xfd, 0x04, 0xe6, 0x07, 0x0f, 0x27, 0x41,
+	0xc9, 0x91, 0x94, 0x6f, 0xeb, 0x50, 0x51, 0x32, 0x73, 0x32, 0xa8, 0xd9, 0x3e, 0x6c, 0x5c, 0xb3,
+	0xd3, 0x11, 0x31, 0xa6, 0x92, 0x8f, 0x31, 0xd2, 0x43, 0x06, 0x8a, 0x2d, 0x87, 0xe8, 0xd7, 0x99,
+	0x43, 0x9a, 0xb9, 0xf0, 0x63, 0xff, 0xfb, 0x04, 0xac, 0x8d, 0x29, 0xce, 0xd1, 0xf7, 0x50, 0x62,
+	0x58, 0x10, 0x57, 0x95, 0xa0, 0x3a, 0x9e, 0x8c, 0xff, 0xa2, 0x63, 0x48, 0xca, 0xf2, 0x49, 0x76,
+	0xa2, 0x08, 0x1c, 0x60, 0xe9, 0x6f, 0x54, 0x86, 0xe5, 0x2e, 0x27, 0x2e, 0x89, 0xfc, 0x98, 0x06,
+	0x91, 0x70, 0x79, 0x18, 0xe8, 0xc4, 0x21, 0x5f, 0x65, 0x4b, 0x5d, 0x4e, 0xf6, 0x8d, 0xa4, 0xae,
+	0x04, 0x89, 0x7e, 0x44, 0x7d, 0xe2, 0x86, 0xd4, 0xc3, 0x61, 0x20, 0x02, 0xa2, 0x13, 0xb3, 0xd6,
+	0x7f, 0x49, 0x7d, 0x72, 0x92, 0x0a, 0xec, 0x4f, 0x01, 0xb2, 0x95, 0xa5, 0xb1, 0xbe, 0x7b, 0x55,
+	0x57, 0x27, 0x98, 0x70, 0xe4, 0x4f, 0x19, 0x20, 0x1a, 0x5d, 0xc6, 0x85, 0x5a, 0x71, 0xce, 0xd1,
+	0x03, 0xfb, 0xd7, 0x05, 0x58, 0x1e, 0xf1, 0xbc, 0xc8, 0x57, 0x8b, 0x85, 0xc1, 0x6a, 0x71, 0xe4,
+	0x15, 0x9b, 0xb8, 0xf6, 0x8a, 0x8d, 0x58, 0xe0, 0xf6, 0x57, 0xcc, 0x7e, 0x32, 0xde, 0x13, 0x2d,
+	0x98, 0x8e, 0x88, 0xb8, 0xa0, 0xac, 0x9d, 0xec, 0xd2, 0x0c, 0x9f, 0xad, 0xfe, 0xf8, 0xf3, 0xe4,
+	0x24, 0x4c, 0x70, 0xf1, 0xe3, 0xcf, 0x93, 0x80, 0x8a, 0x49, 0x17, 0xb6, 0xba, 0x00, 0x73, 0x03,
+	0xbd, 0x24, 0x39, 0x31, 0xd0, 0xf6, 0xa8, 0x2e, 0xc1, 0xc2, 0xd0, 0xf3, 0x7e, 0xfb, 0x17, 0x25,
+	0x28, 0xe5, 0x5e, 0xa2, 0x68, 0x1b, 0xe6, 0x2e, 0x7d, 0xee, 0x36, 0x82, 0xc8, 0x57, 0x69, 0x23,
+	0x71, 0xe6, 0x4b, 0x9f, 0x57, 0x83, 0xc8, 0x97, 0x79, 0x03, 0x7d, 0x02, 0x2b, 0x3d, 0x1c, 0x06,
+	0xbe, 0x3a, 0x6d, 0x4e, 0x55, 0x47, 0x7c, 0x94, 0xc9, 0x52, 0xc4, 0x0b, 0x58, 0x1c, 0x6a, 0x2c,
+	0xea, 0xaf, 0x5d, 0xda, 0xd9, 0x1e, 0xb4, 0x6b, 0x4d, 0x6b, 0x55, 0xb5, 0x92, 0x36, 0xab, 0xb3,
+	0xe0, 0x0d, 0xcc, 0x72, 0xf4, 0x1a, 0xd6, 0x13, 0x5f, 0xe3, 0xee, 0x05, 0x66, 0x1d, 0x99, 0xbb,
+	0x64, 0x3c, 0xa5, 0x5d, 0x61, 0x4d, 0xde, 0x14, 0x52, 0xd7, 0x52, 0xec, 0x99, 0x86, 0x9e, 0x6a,
+	0x24, 0xda, 0x87, 0x12, 0xbe, 0xc8, 0x8a, 0x32, 0xdd, 0x8a, 0xfb, 0xab, 0xb1, 0xaf, 0xf6, 0xf2,
+	0xee, 0x59, 0x3d, 0x2d, 0xc3, 0xf0, 0x45, 0x5a, 0x77, 0x61, 0xb8, 0x1f, 0x44, 0xca, 0x08, 0x49,
+	0x6f, 0x2f, 0xa6, 0x61, 0xe0, 0xf5, 0x4d, 0xc7, 0xec, 0xe3, 0xf1, 0x84, 0x47, 0x1a, 0xa6, 0x8f,
+	0xfd, 0x4a, 0x81, 0x9c, 0xe5, 0xe0, 0xea, 0x24, 0x3a, 0x80, 0x47, 0x7e, 0xc0, 0x71, 0x23, 0x24,
+	0x6e, 0xae, 0x0d, 0xe5, 0x13, 0x2e, 0x82, 0x08, 0xeb, 0xdd, 0x4f, 0xab, 0xcb, 0xf4, 0xd0, 0xa8,
+	0x65, 0x17, 0x7a, 0x2f, 0xa7, 0x84, 0xf6, 0x60, 0x31, 0xe1, 0x69, 0xb1, 0xd8, 0x73, 0x2f, 0x48,
+	0xe3, 0x16, 0x8f, 0x91, 0x79, 0x83, 0xf9, 0x9a, 0xc5, 0xde, 0x19, 0x69, 0x20, 0x0f, 0xb6, 0x12,
+	0x16, 0x5d, 0x69, 0xb7, 0x30, 0x6b, 0xe0, 0x16, 0x71, 0x3d, 0x1a, 0xca, 0x12, 0x30, 0xa0, 0x91,
+	0x35, 0x73, 0x23, 0x6b, 0xb2, 0x55, 0x55, 0x88, 0x7f, 0xad, 0x19, 0x6a, 0x29, 0x01, 0xfa, 0x0e,
+	0x56, 0x19, 0x69, 0x91, 0x4b, 0xb7, 0x83, 0x2f, 0xe5, 0x32, 0x2d, 0x86, 0x3b, 0x2e, 0x0f, 0x7e,
+	0x48, 0x3a, 0x60, 0x0f, 0xae, 0x50, 0xbf, 0x3e, 0x8a, 0xc4, 0x93, 0x1d, 0x4d, 0xbe, 0xac, 0xb0,
+	0x2f, 0xf0, 0xe5, 0x2b, 0x8d, 0xac, 0x07, 0x3f, 0x10, 0xf4, 0x11, 0x20, 0x46, 0xb8, 0x70, 0x07,
+	0x1d, 0xbe, 0xa4, 0xbc, 0x78, 0x41, 0x4a, 0xfe, 0x21, 0xe7, 0xf4, 0xff, 0x02, 0x0f, 0xf5, 0xe1,
+	0x04, 0xc3, 0x11, 0x0f, 0xb5, 0xef, 0x7b, 0x34, 0xf2, 0xba, 0x8c, 0x91, 0xc8, 0x4b, 0x52, 0xf1,
+	0xf5, 0xdb, 0xd8, 0x50, 0x14, 0xa7, 0x19, 0x43, 0x2d, 0x23, 0xb0, 0xff, 0x54, 0x00, 0xc8, 0x5c,
+	0x0a, 0xfd, 0x3d, 0x6c, 0x90, 0x48, 0x19, 0xd5, 0x63, 0xc4, 0x27, 0x91, 0x08, 0x70, 0xc8, 0x93,
+	0xb8, 0xa4, 0x73, 0x45, 0xf1, 0xf0, 0x8e, 0xb3, 0xae, 0x95, 0x6a, 0x99, 0x8e, 0x09, 0x25, 0x7d,
+	0xf4, 0x1f, 0x05, 0xd8, 0x48, 0xe2, 0x19, 0xf6, 0x3c, 0xda, 0x95, 0x8f, 0xda, 0x4c, 0xcf, 0x44,
+	0xb6, 0xef, 0xca, 0xaa, 0x79, 0x5f, 0xd6, 0xbe, 0x5a, 0x36, 0x4d, 0x7b, 0x59, 0x45, 0x96, 0xe5,
+	0x6d, 0x08, 0x71, 0xa7, 0xe1, 0xe3, 0x72, 0x6f, 0x47, 0xba, 0xfb, 0x89, 0x1a, 0x68, 0x57, 0x4c,
+	0xc2, 0xdc, 0xae, 0x66, 0xce, 0x6d, 0x40, 0xee, 0x8a, 0x8f, 0x13, 0x56, 0xef, 0xc3, 0x72, 0xfe,
+	0x40, 0x4d, 0x22, 0xbc, 0x73, 0xc2, 0xec, 0xff, 0x9d, 0x80, 0xe5, 0x11, 0xfe, 0x2f, 0x1f, 0x0f,
+	0x8c, 0xc4, 0x21, 0xf6, 0xe4, 0x7b, 0x4e, 0xdf, 0x2a, 0x46, 0xbb, 0x82, 0xe8, 0xe0, 0x5d, 0x74,
+	0x56, 0x8c, 0xd4, 0x60, 0x1d, 0x25, 0x43, 0x5f, 0xc2, 0xc6, 0x80, 0xb6, 0xcb, 0x08, 0x8f, 0x69,
+	0xc4, 0xa5, 0x4f, 0xfa, 0xc4, 0xe4, 0x09, 0x2b, 0xc8, 0x61, 0x1c, 0xa3, 0x50, 0x93, 0xc5, 0xfb,
+	0x78, 0x78, 0x83, 0xfa, 0x7d, 0x53, 0xbc, 0x8e, 0x84, 0x57, 0xa9, 0xdf, 0x47, 0x2f, 0xe0, 0xdd,
+	0x98, 0x75, 0xa3, 0x6c, 0xc7, 0x17, 0x24, 0x68, 0x9d, 0x0b, 0xe2, 0x0f, 0x5e, 0xd1, 0x49, 0x75,
+	0x80, 0x2d, 0xa5, 0x6a, 0xb6, 0x7f, 0x66, 0x14, 0x07, 0x6e, 0xe9, 0x87, 0xb0, 0xc4, 0x71, 0x14,
+	0x88, 0xe0, 0x07, 0xc2, 0x5c, 0x9f, 0xf5, 0x5d, 0xd6, 0xd5, 0xb5, 0x70, 0xd1, 0x59, 0x48, 0x05,
+	0x7b, 0xac, 0xef, 0x74, 0xa3, 0xed, 0xdf, 0x95, 0x60, 0x7e, 0xb0, 0x4f, 0x28, 0x2d, 0x98, 0x0b,
+	0xd7, 0xa6, 0x31, 0x91, 0x8b, 0xed, 0xb9, 0x60, 0xae, 0xfb, 0x13, 0xca, 0xdf, 0x5f, 0x02, 0x64,
+	0xf3, 0xd6, 0xdd, 0x51, 0x0d, 0xc1, 0xc1, 0x75, 0xca, 0x6f, 0x52, 0xf5, 0x34, 0x2a, 0x66, 0x0c,
+	0xe8, 0x10, 0xde, 0x61, 0x04, 0xfb, 0xae, 0x69, 0x5a, 0x72, 0xb7, 0xc9, 0x68, 0xc7, 0xc5, 0x61,
+	0x98, 0x7f, 0x6a, 0x6a, 0x8b, 0x3c, 0x94, 0x8a, 0x86, 0x9c, 0x1f, 0x30, 0xda, 0xd9, 0x0d, 0xc3,
+	0xdc, 0xab, 0xf3, 0x00, 0x36, 0x71, 0xa8, 0x28, 0x38, 0x65, 0xc2, 0x7c, 0x20, 0xa1, 0x6e, 0x8a,
+	0xf1, 0x0c, 0x65, 0x1b, 0xf5, 0x58, 0xb2, 0xb5, 0x66, 0x9d, 0x32, 0xa1, 0x3e, 0xd3, 0xa9, 0x54,
+	0x33, 0x3e, 0xb2, 0x03, 0xf7, 0x3d, 0xda, 0x89, 0x19, 0xe1, 0x9c, 0xf8, 0x26, 0x72, 0xf1, 0x98,
+	0x78, 0x2a, 0x4e, 0x17, 0x9d, 0xe5, 0x4c, 0xa8, 0x42, 0x52, 0x3d, 0x26, 0x1e, 0x7a, 0x0e, 0x93,
+	0xd8, 0xeb, 0x24, 0x7f, 0x9b, 0x78, 0x7c, 0xad, 0x3d, 0x76, 0xbd, 0x4e, 0xda, 0x59, 0x56, 0x28,
+	0xd4, 0x82, 0xe5, 0xf4, 0xb0, 0x99, 0x25, 0x4c, 0xc4, 0x7d, 0x7a, 0x2d, 0x59, 0x7a, 0xfe, 0xd4,
+	0x30, 0x09, 0x35, 0x8a, 0xae, 0x88, 0xec, 0xff, 0x9e, 0x84, 0xa5, 0x2b, 0x9f, 0x03, 0x7d, 0x05,
+	0x0f, 0xf4, 0x29, 0xc7, 0xb8, 0x83, 0xce, 0xdf, 0xeb, 0x4a, 0xe7, 0xcd, 0x28, 0x9f, 0xf8, 0x12,
+	0x36, 0x72, 0xd0, 0x0b, 0xd2, 0x38, 0xa7, 0xb4, 0xed, 0xca, 0x76, 0x57, 0xae, 0xc3, 0x66, 0x65,
+	0x2a, 0x67, 0x5a, 0xe3, 0x34, 0xe4, 0xaa, 0x73, 0xf6, 0x05, 0xd8, 0x63, 0xe0, 0xb2, 0xf8, 0xd5,
+	0xaf, 0xbe, 0xb5, 0x51, 0x68, 0xd9, 0x57, 0xab, 0xc1, 0xa6, 0x6e, 0x22, 0xba, 0xd2, 0x4c, 0xf9,
+	0x23, 0x34, 0x71, 0x10, 0xca, 0x2e, 0x9a, 0xbe, 0x11, 0x1b, 0x5a, 0x4b, 0xa6, 0xd5, 0xec, 0x0c,
+	0x07, 0x5a, 0x05, 0x7d, 0x05, 0x73, 0xc6, 0x75, 0xb0, 0xe7, 0x91, 0x58, 0x58, 0x53, 0x37, 0xa6,
+	0xa5, 0x59, 0x0d, 0xd8, 0x55, 0xfa, 0x68, 0x17, 0xe6, 0x71, 0x18, 0xd2, 0x0b, 0x59, 0x75, 0x44,
+	0xb2, 0xea, 0xb2, 0xa6, 0x6f, 0x64, 0x98, 0x53, 0x88, 0x33, 0x03, 0x40, 0xff, 0x28, 0xfb, 0xa8,
+	0x2c, 0xf0, 0x84, 0xfa, 0xee, 0xf3, 0x3b, 0xb5, 0xb7, 0xbb, 0x54, 0xe5, 0xba, 0x02, 0x67, 0xf3,
+	0xaa, 0xb1, 0x64, 0x28, 0xb7, 0x9f, 0xc1, 0xca, 0x28, 0xf9, 0x40, 0xbf, 0xe1, 0x0e, 0x2a, 0xc2,
+	0xe4, 0xd9, 0xae, 0xf3, 0x72, 0xb1, 0x80, 0x00, 0xa6, 0x9c, 0xfd, 0x6f, 0xf6, 0x6b, 0xa7, 0x8b,
+	0x13, 0xf6, 0x4f, 0x05, 0x28, 0xe5, 0x7c, 0x16, 0xbd, 0x0b, 0x73, 0xd9, 0x9f, 0x9d, 0xba, 0x2c,
+	0x34, 0xe1, 0x62, 0x36, 0x9d, 0x7c, 0xcd, 0x42, 0x59, 0x7a, 0x93, 0x0e, 0x0e, 0xc2, 0xa4, 0x17,
+	0xa2, 0x06, 0xe8, 0x33, 0x58, 0xd3, 0x06, 0x76, 0x05, 0x61, 0x1d, 0xee, 0xd2, 0xa6, 0x6b, 0x12,
+	0x82, 0x29, 0xf2, 0x57, 0xb4, 0xf8, 0x54, 0x4a, 0xbf, 0x6d, 0x9a, 0x4c, 0xa2, 0xff, 0x54, 0x14,
+	0x91, 0x0b, 0xb7, 0x41, 0x9a, 0x94, 0x91, 0x9b, 0x4b, 0xb9, 0x92, 0x52, 0xaf, 0x2a, 0x6d, 0xd9,
+	0xec, 0xe0, 0x34, 0x94, 0xde, 0x1c, 0x53, 0xd3, 0xb9, 0x9d, 0x73, 0x40, 0x4f, 0xbd, 0xa2, 0x4c,
+	0xd8, 0xbf, 0x2c, 0xc0, 0xfa, 0xd8, 0x7b, 0x24, 0x0b, 0x6e, 0x9d, 0x4a, 0x7d, 0x93, 0x59, 0x92,
+	0x21, 0x7a, 0x02, 0xf7, 0x63, 0x46, 0x7b, 0x01, 0x97, 0xde, 0xe6, 0x93, 0x38, 0xa4, 0xfd, 0x0e,
+	0x89, 0x44, 0xf2, 0xc0, 0x59, 0x49, 0x85, 0x7b, 0x99, 0x4c, 0xee, 0x46, 0x5f, 0xb6, 0xa0, 0x83,
+	0x5b, 0x49, 0xbf, 0x03, 0xd4, 0xd4, 0x91, 0x9c, 0x91, 0x0a, 0xb2, 0xf0, 0x48, 0x9e, 0x22, 0xda,
+	0xfd, 0xe1, 0xd2, 0x4f, 0x7b, 0x33, 0xb9, 0x67, 0xa5, 0xe8, 0xc7, 0xc4, 0xba, 0x37, 0xf0, 0xac,
+	0x3c, 0xed, 0xc7, 0xa4, 0xfa, 0x4c, 0x76, 0xdb, 0xff, 0xf3, 0xb7, 0x9b, 0x85, 0xef, 0x3f, 0xb9,
+	0xdd, 0xff, 0xdc, 0x88, 0xdb, 0x2d, 0xf3, 0x97, 0xfe, 0xc6, 0x94, 0x32, 0xe7, 0x93, 0xbf, 0x0c,
+	0x00, 0xcc, 0xaf, 0xdc, 0x93, 0xf4, 0x21, 0x00, 0x00,
 }
 
 func (this *Settings) Equal(that interface{}) bool {
@@ -1902,6 +2511,9 @@ func (this *Settings) Equal(that interface{}) bool {
 	if !this.Kubernetes.Equal(that1.Kubernetes) {
 		return false
 	}
+	if !this.Docker.Equal(that1.Docker) {
+		return false
+	}
 	if !this.Extensions.Equal(that1.Extensions) {
 		return false
 	}
@@ -2376,6 +2988,63 @@ func (this *Settings_DiscoveryOptions) Equal(that interface{}) bool {
 	if this.FdsMode != that1.FdsMode {
 		return false
 	}
+	if !this.UdsOptions.Equal(that1.UdsOptions) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Settings_DiscoveryOptions_UdsOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_DiscoveryOptions_UdsOptions)
+	if !ok {
+		that2, ok := that.(Settings_DiscoveryOptions_UdsOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.IncludedNamespaces) != len(that1.IncludedNamespaces) {
+		return false
+	}
+	for i := range this.IncludedNamespaces {
+		if this.IncludedNamespaces[i] != that1.IncludedNamespaces[i] {
+			return false
+		}
+	}
+	if len(this.ExcludedNamespaces) != len(that1.ExcludedNamespaces) {
+		return false
+	}
+	for i := range this.ExcludedNamespaces {
+		if this.ExcludedNamespaces[i] != that1.ExcludedNamespaces[i] {
+			return false
+		}
+	}
+	if this.LabelSelector != that1.LabelSelector {
+		return false
+	}
+	if this.UpstreamNameTemplate != that1.UpstreamNameTemplate {
+		return false
+	}
+	if len(this.UpstreamLabels) != len(that1.UpstreamLabels) {
+		return false
+	}
+	for i := range this.UpstreamLabels {
+		if this.UpstreamLabels[i] != that1.UpstreamLabels[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -2445,6 +3114,17 @@ func (this *Settings_ConsulConfiguration) Equal(that interface{}) bool {
 	if !this.ServiceDiscovery.Equal(that1.ServiceDiscovery) {
 		return false
 	}
+	if !this.Connect.Equal(that1.Connect) {
+		return false
+	}
+	if len(this.ServiceTokenSecretRefs) != len(that1.ServiceTokenSecretRefs) {
+		return false
+	}
+	for i := range this.ServiceTokenSecretRefs {
+		if !this.ServiceTokenSecretRefs[i].Equal(that1.ServiceTokenSecretRefs[i]) {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -2482,6 +3162,33 @@ func (this *Settings_ConsulConfiguration_ServiceDiscoveryOptions) Equal(that int
 	}
 	return true
 }
+func (this *Settings_ConsulConfiguration_ConnectOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_ConsulConfiguration_ConnectOptions)
+	if !ok {
+		that2, ok := that.(Settings_ConsulConfiguration_ConnectOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ServiceName != that1.ServiceName {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *Settings_KubernetesConfiguration) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2504,6 +3211,12 @@ func (this *Settings_KubernetesConfiguration) Equal(that interface{}) bool {
 	if !this.RateLimits.Equal(that1.RateLimits) {
 		return false
 	}
+	if this.UseEndpointSlices != that1.UseEndpointSlices {
+		return false
+	}
+	if this.UseNodeLocalities != that1.UseNodeLocalities {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -2539,6 +3252,63 @@ func (this *Settings_KubernetesConfiguration_RateLimits) Equal(that interface{})
 	}
 	return true
 }
+func (this *Settings_DockerConfiguration) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_DockerConfiguration)
+	if !ok {
+		that2, ok := that.(Settings_DockerConfiguration)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.ServiceDiscovery.Equal(that1.ServiceDiscovery) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Settings_DockerConfiguration_ServiceDiscoveryOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Settings_DockerConfiguration_ServiceDiscoveryOptions)
+	if !ok {
+		that2, ok := that.(Settings_DockerConfiguration_ServiceDiscoveryOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Network != that1.Network {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *GlooOptions) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2591,6 +3361,9 @@ func (this *GlooOptions) Equal(that interface{}) bool {
 	if this.RestXdsBindAddr != that1.RestXdsBindAddr {
 		return false
 	}
+	if !this.ProxyTranslationConcurrency.Equal(that1.ProxyTranslationConcurrency) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -2705,6 +3478,12 @@ func (this *GlooOptions_InvalidConfigPolicy) Equal(that interface{}) bool {
 	if this.InvalidRouteResponseBody != that1.InvalidRouteResponseBody {
 		return false
 	}
+	if this.PruneInvalidWeightedDestinations != that1.PruneInvalidWeightedDestinations {
+		return false
+	}
+	if this.SanitizerDryRun != that1.SanitizerDryRun {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -2744,6 +3523,12 @@ func (this *GatewayOptions) Equal(that interface{}) bool {
 	if this.CompressedProxySpec != that1.CompressedProxySpec {
 		return false
 	}
+	if !this.Acme.Equal(that1.Acme) {
+		return false
+	}
+	if !this.NamespacedGateways.Equal(that1.NamespacedGateways) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -2786,6 +3571,87 @@ func (this *GatewayOptions_ValidationOptions) Equal(that interface{}) bool {
 	if !this.AllowWarnings.Equal(that1.AllowWarnings) {
 		return false
 	}
+	if this.Strict != that1.Strict {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *GatewayOptions_AcmeOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayOptions_AcmeOptions)
+	if !ok {
+		that2, ok := that.(GatewayOptions_AcmeOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DirectoryUrl != that1.DirectoryUrl {
+		return false
+	}
+	if this.Email != that1.Email {
+		return false
+	}
+	if this.AcceptTermsOfService != that1.AcceptTermsOfService {
+		return false
+	}
+	if !this.RenewBefore.Equal(that1.RenewBefore) {
+		return false
+	}
+	if this.SolverPort != that1.SolverPort {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *GatewayOptions_NamespacedGatewaysOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayOptions_NamespacedGatewaysOptions)
+	if !ok {
+		that2, ok := that.(GatewayOptions_NamespacedGatewaysOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.ProvisionDeployments != that1.ProvisionDeployments {
+		return false
+	}
+	if this.ProxyImage != that1.ProxyImage {
+		return false
+	}
+	if this.XdsAddress != that1.XdsAddress {
+		return false
+	}
+	if this.ServiceType != that1.ServiceType {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -61,7 +61,7 @@ func (x SslParameters_ProtocolVersion) String() string {
 }
 
 func (SslParameters_ProtocolVersion) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_c4a65e8067d81add, []int{5, 0}
+	return fileDescriptor_c4a65e8067d81add, []int{6, 0}
 }
 
 // SslConfig contains the options necessary to configure a virtual host or listener to use TLS
@@ -79,10 +79,28 @@ type SslConfig struct {
 	Parameters           *SslParameters `protobuf:"bytes,6,opt,name=parameters,proto3" json:"parameters,omitempty"`
 	// Set Application Level Protocol Negotiation
 	// If empty, defaults to ["h2", "http/1.1"].
-	AlpnProtocols        []string `protobuf:"bytes,7,rep,name=alpn_protocols,json=alpnProtocols,proto3" json:"alpn_protocols,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	AlpnProtocols []string `protobuf:"bytes,7,rep,name=alpn_protocols,json=alpnProtocols,proto3" json:"alpn_protocols,omitempty"`
+	// Verify that a Subject Alternative Name in the client certificate matches one of these matchers.
+	// They are added to the exact matches of verify_subject_alt_name.
+	// note that a root_ca must be provided if this option is used.
+	MatchSubjectAltNames []*SubjectAltNameMatcher `protobuf:"bytes,8,rep,name=match_subject_alt_names,json=matchSubjectAltNames,proto3" json:"match_subject_alt_names,omitempty"`
+	// Verify that the SHA-256 of the Subject Public Key Information of the client certificate, in base64, is one of
+	// the specified values. This pins the key of the client instead of its certificate.
+	// note that a root_ca must be provided if this option is used.
+	VerifyCertificateSpki []string `protobuf:"bytes,9,rep,name=verify_certificate_spki,json=verifyCertificateSpki,proto3" json:"verify_certificate_spki,omitempty"`
+	// Verify that the SHA-256 of the DER-encoded client certificate, in hex, is one of the specified values.
+	// note that a root_ca must be provided if this option is used.
+	VerifyCertificateHash []string `protobuf:"bytes,10,rep,name=verify_certificate_hash,json=verifyCertificateHash,proto3" json:"verify_certificate_hash,omitempty"`
+	// Reject client certificates revoked by a PEM-encoded certificate revocation list.
+	// note that a root_ca must be provided if this option is used.
+	//
+	// Types that are valid to be assigned to CrlSource:
+	//	*SslConfig_CrlFile
+	//	*SslConfig_CrlInline
+	CrlSource            isSslConfig_CrlSource `protobuf_oneof:"crl_source"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *SslConfig) Reset()         { *m = SslConfig{} }
@@ -113,6 +131,10 @@ type isSslConfig_SslSecrets interface {
 	isSslConfig_SslSecrets()
 	Equal(interface{}) bool
 }
+type isSslConfig_CrlSource interface {
+	isSslConfig_CrlSource()
+	Equal(interface{}) bool
+}
 
 type SslConfig_SecretRef struct {
 	SecretRef *core.ResourceRef `protobuf:"bytes,1,opt,name=secret_ref,json=secretRef,proto3,oneof" json:"secret_ref,omitempty"`
@@ -123,10 +145,18 @@ type SslConfig_SslFiles struct {
 type SslConfig_Sds struct {
 	Sds *SDSConfig `protobuf:"bytes,4,opt,name=sds,proto3,oneof" json:"sds,omitempty"`
 }
+type SslConfig_CrlFile struct {
+	CrlFile string `protobuf:"bytes,11,opt,name=crl_file,json=crlFile,proto3,oneof" json:"crl_file,omitempty"`
+}
+type SslConfig_CrlInline struct {
+	CrlInline string `protobuf:"bytes,12,opt,name=crl_inline,json=crlInline,proto3,oneof" json:"crl_inline,omitempty"`
+}
 
 func (*SslConfig_SecretRef) isSslConfig_SslSecrets() {}
 func (*SslConfig_SslFiles) isSslConfig_SslSecrets()  {}
 func (*SslConfig_Sds) isSslConfig_SslSecrets()       {}
+func (*SslConfig_CrlFile) isSslConfig_CrlSource()    {}
+func (*SslConfig_CrlInline) isSslConfig_CrlSource()  {}
 
 func (m *SslConfig) GetSslSecrets() isSslConfig_SslSecrets {
 	if m != nil {
@@ -134,6 +164,12 @@ func (m *SslConfig) GetSslSecrets() isSslConfig_SslSecrets {
 	}
 	return nil
 }
+func (m *SslConfig) GetCrlSource() isSslConfig_CrlSource {
+	if m != nil {
+		return m.CrlSource
+	}
+	return nil
+}
 
 func (m *SslConfig) GetSecretRef() *core.ResourceRef {
 	if x, ok := m.GetSslSecrets().(*SslConfig_SecretRef); ok {
@@ -184,12 +220,141 @@ func (m *SslConfig) GetAlpnProtocols() []string {
 	return nil
 }
 
+func (m *SslConfig) GetMatchSubjectAltNames() []*SubjectAltNameMatcher {
+	if m != nil {
+		return m.MatchSubjectAltNames
+	}
+	return nil
+}
+
+func (m *SslConfig) GetVerifyCertificateSpki() []string {
+	if m != nil {
+		return m.VerifyCertificateSpki
+	}
+	return nil
+}
+
+func (m *SslConfig) GetVerifyCertificateHash() []string {
+	if m != nil {
+		return m.VerifyCertificateHash
+	}
+	return nil
+}
+
+func (m *SslConfig) GetCrlFile() string {
+	if x, ok := m.GetCrlSource().(*SslConfig_CrlFile); ok {
+		return x.CrlFile
+	}
+	return ""
+}
+
+func (m *SslConfig) GetCrlInline() string {
+	if x, ok := m.GetCrlSource().(*SslConfig_CrlInline); ok {
+		return x.CrlInline
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*SslConfig) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*SslConfig_SecretRef)(nil),
 		(*SslConfig_SslFiles)(nil),
 		(*SslConfig_Sds)(nil),
+		(*SslConfig_CrlFile)(nil),
+		(*SslConfig_CrlInline)(nil),
+	}
+}
+
+// Matches a Subject Alternative Name of a certificate.
+type SubjectAltNameMatcher struct {
+	// Types that are valid to be assigned to MatchPattern:
+	//	*SubjectAltNameMatcher_Exact
+	//	*SubjectAltNameMatcher_Prefix
+	//	*SubjectAltNameMatcher_Regex
+	MatchPattern         isSubjectAltNameMatcher_MatchPattern `protobuf_oneof:"match_pattern"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
+}
+
+func (m *SubjectAltNameMatcher) Reset()         { *m = SubjectAltNameMatcher{} }
+func (m *SubjectAltNameMatcher) String() string { return proto.CompactTextString(m) }
+func (*SubjectAltNameMatcher) ProtoMessage()    {}
+func (*SubjectAltNameMatcher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4a65e8067d81add, []int{1}
+}
+func (m *SubjectAltNameMatcher) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubjectAltNameMatcher.Unmarshal(m, b)
+}
+func (m *SubjectAltNameMatcher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubjectAltNameMatcher.Marshal(b, m, deterministic)
+}
+func (m *SubjectAltNameMatcher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubjectAltNameMatcher.Merge(m, src)
+}
+func (m *SubjectAltNameMatcher) XXX_Size() int {
+	return xxx_messageInfo_SubjectAltNameMatcher.Size(m)
+}
+func (m *SubjectAltNameMatcher) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubjectAltNameMatcher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubjectAltNameMatcher proto.InternalMessageInfo
+
+type isSubjectAltNameMatcher_MatchPattern interface {
+	isSubjectAltNameMatcher_MatchPattern()
+	Equal(interface{}) bool
+}
+
+type SubjectAltNameMatcher_Exact struct {
+	Exact string `protobuf:"bytes,1,opt,name=exact,proto3,oneof" json:"exact,omitempty"`
+}
+type SubjectAltNameMatcher_Prefix struct {
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3,oneof" json:"prefix,omitempty"`
+}
+type SubjectAltNameMatcher_Regex struct {
+	Regex string `protobuf:"bytes,3,opt,name=regex,proto3,oneof" json:"regex,omitempty"`
+}
+
+func (*SubjectAltNameMatcher_Exact) isSubjectAltNameMatcher_MatchPattern()  {}
+func (*SubjectAltNameMatcher_Prefix) isSubjectAltNameMatcher_MatchPattern() {}
+func (*SubjectAltNameMatcher_Regex) isSubjectAltNameMatcher_MatchPattern()  {}
+
+func (m *SubjectAltNameMatcher) GetMatchPattern() isSubjectAltNameMatcher_MatchPattern {
+	if m != nil {
+		return m.MatchPattern
+	}
+	return nil
+}
+
+func (m *SubjectAltNameMatcher) GetExact() string {
+	if x, ok := m.GetMatchPattern().(*SubjectAltNameMatcher_Exact); ok {
+		return x.Exact
+	}
+	return ""
+}
+
+func (m *SubjectAltNameMatcher) GetPrefix() string {
+	if x, ok := m.GetMatchPattern().(*SubjectAltNameMatcher_Prefix); ok {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (m *SubjectAltNameMatcher) GetRegex() string {
+	if x, ok := m.GetMatchPattern().(*SubjectAltNameMatcher_Regex); ok {
+		return x.Regex
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*SubjectAltNameMatcher) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*SubjectAltNameMatcher_Exact)(nil),
+		(*SubjectAltNameMatcher_Prefix)(nil),
+		(*SubjectAltNameMatcher_Regex)(nil),
 	}
 }
 
@@ -208,7 +373,7 @@ func (m *SSLFiles) Reset()         { *m = SSLFiles{} }
 func (m *SSLFiles) String() string { return proto.CompactTextString(m) }
 func (*SSLFiles) ProtoMessage()    {}
 func (*SSLFiles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4a65e8067d81add, []int{1}
+	return fileDescriptor_c4a65e8067d81add, []int{2}
 }
 func (m *SSLFiles) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SSLFiles.Unmarshal(m, b)
@@ -274,7 +439,7 @@ func (m *UpstreamSslConfig) Reset()         { *m = UpstreamSslConfig{} }
 func (m *UpstreamSslConfig) String() string { return proto.CompactTextString(m) }
 func (*UpstreamSslConfig) ProtoMessage()    {}
 func (*UpstreamSslConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4a65e8067d81add, []int{2}
+	return fileDescriptor_c4a65e8067d81add, []int{3}
 }
 func (m *UpstreamSslConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpstreamSslConfig.Unmarshal(m, b)
@@ -398,7 +563,7 @@ func (m *SDSConfig) Reset()         { *m = SDSConfig{} }
 func (m *SDSConfig) String() string { return proto.CompactTextString(m) }
 func (*SDSConfig) ProtoMessage()    {}
 func (*SDSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4a65e8067d81add, []int{3}
+	return fileDescriptor_c4a65e8067d81add, []int{4}
 }
 func (m *SDSConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SDSConfig.Unmarshal(m, b)
@@ -495,7 +660,7 @@ func (m *CallCredentials) Reset()         { *m = CallCredentials{} }
 func (m *CallCredentials) String() string { return proto.CompactTextString(m) }
 func (*CallCredentials) ProtoMessage()    {}
 func (*CallCredentials) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4a65e8067d81add, []int{4}
+	return fileDescriptor_c4a65e8067d81add, []int{5}
 }
 func (m *CallCredentials) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CallCredentials.Unmarshal(m, b)
@@ -536,7 +701,7 @@ func (m *CallCredentials_FileCredentialSource) Reset()         { *m = CallCreden
 func (m *CallCredentials_FileCredentialSource) String() string { return proto.CompactTextString(m) }
 func (*CallCredentials_FileCredentialSource) ProtoMessage()    {}
 func (*CallCredentials_FileCredentialSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4a65e8067d81add, []int{4, 0}
+	return fileDescriptor_c4a65e8067d81add, []int{5, 0}
 }
 func (m *CallCredentials_FileCredentialSource) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CallCredentials_FileCredentialSource.Unmarshal(m, b)
@@ -586,7 +751,7 @@ func (m *SslParameters) Reset()         { *m = SslParameters{} }
 func (m *SslParameters) String() string { return proto.CompactTextString(m) }
 func (*SslParameters) ProtoMessage()    {}
 func (*SslParameters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4a65e8067d81add, []int{5}
+	return fileDescriptor_c4a65e8067d81add, []int{6}
 }
 func (m *SslParameters) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SslParameters.Unmarshal(m, b)
@@ -637,6 +802,7 @@ func (m *SslParameters) GetEcdhCurves() []string {
 func init() {
 	proto.RegisterEnum("gloo.solo.io.SslParameters_ProtocolVersion", SslParameters_ProtocolVersion_name, SslParameters_ProtocolVersion_value)
 	proto.RegisterType((*SslConfig)(nil), "gloo.solo.io.SslConfig")
+	proto.RegisterType((*SubjectAltNameMatcher)(nil), "gloo.solo.io.SubjectAltNameMatcher")
 	proto.RegisterType((*SSLFiles)(nil), "gloo.solo.io.SSLFiles")
 	proto.RegisterType((*UpstreamSslConfig)(nil), "gloo.solo.io.UpstreamSslConfig")
 	proto.RegisterType((*SDSConfig)(nil), "gloo.solo.io.SDSConfig")
@@ -650,59 +816,68 @@ func init() {
 }
 
 var fileDescriptor_c4a65e8067d81add = []byte{
-	// 822 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0xf6, 0x4f, 0x48, 0xec, 0xe3, 0xb8, 0x31, 0xa3, 0xe0, 0x6c, 0x82, 0x0a, 0x95, 0x2b, 0x50,
-	0xa4, 0x8a, 0x75, 0x93, 0xaa, 0x15, 0x2a, 0x57, 0xad, 0x2b, 0x64, 0x41, 0x04, 0xd5, 0x6e, 0x52,
-	0x24, 0x6e, 0x46, 0x93, 0xf1, 0xb1, 0x3d, 0x64, 0x76, 0xc7, 0x9a, 0x99, 0xb5, 0x92, 0x77, 0xe1,
-	0x01, 0x78, 0x04, 0xde, 0x83, 0x37, 0xe0, 0x05, 0xb8, 0x02, 0x71, 0x89, 0x66, 0x66, 0xb7, 0x4e,
-	0xac, 0xa8, 0x8a, 0x80, 0x9b, 0xde, 0xcd, 0xf9, 0xce, 0xf9, 0xe6, 0xcc, 0x7c, 0xe7, 0xdb, 0x59,
-	0x78, 0x36, 0x13, 0x76, 0x5e, 0x9c, 0xc7, 0x5c, 0x65, 0x43, 0xa3, 0xa4, 0xfa, 0x42, 0xa8, 0xe1,
-	0x4c, 0x2a, 0x35, 0x5c, 0x68, 0xf5, 0x13, 0x72, 0x6b, 0x42, 0xc4, 0x16, 0x62, 0xb8, 0x3c, 0x1a,
-	0x1a, 0x23, 0xe3, 0x85, 0x56, 0x56, 0x91, 0x6d, 0x07, 0xc7, 0x8e, 0x11, 0x0b, 0x75, 0xb0, 0x3b,
-	0x53, 0x33, 0xe5, 0x13, 0x43, 0xb7, 0x0a, 0x35, 0x07, 0x04, 0x2f, 0x6d, 0x00, 0xf1, 0xd2, 0x96,
-	0xd8, 0xbe, 0x6f, 0x72, 0x21, 0x6c, 0xb5, 0xa5, 0xc6, 0x69, 0x48, 0x0d, 0xfe, 0x6a, 0x40, 0x3b,
-	0x35, 0x72, 0xa4, 0xf2, 0xa9, 0x98, 0x91, 0xe7, 0x00, 0x06, 0xb9, 0x46, 0x4b, 0x35, 0x4e, 0xa3,
-	0xfa, 0x83, 0xfa, 0x61, 0xe7, 0x78, 0x3f, 0xe6, 0x4a, 0x63, 0xd5, 0x35, 0x4e, 0xd0, 0xa8, 0x42,
-	0x73, 0x4c, 0x70, 0x3a, 0xae, 0x25, 0xed, 0x50, 0x9e, 0xe0, 0x94, 0x3c, 0x85, 0xb6, 0x31, 0x92,
-	0x4e, 0x85, 0x44, 0x13, 0x35, 0x3c, 0xb5, 0x1f, 0x5f, 0x3f, 0x70, 0x9c, 0xa6, 0x27, 0x5f, 0xbb,
-	0xec, 0xb8, 0x96, 0xb4, 0x8c, 0x91, 0x7e, 0x4d, 0x1e, 0x41, 0xd3, 0x4c, 0x4c, 0xb4, 0xe1, 0x09,
-	0x7b, 0x6b, 0x84, 0x57, 0x69, 0x38, 0xd8, 0xb8, 0x96, 0xb8, 0x2a, 0xf2, 0x29, 0x74, 0x4c, 0x2e,
-	0xe8, 0x44, 0x65, 0x4c, 0xe4, 0x26, 0x6a, 0x3e, 0x68, 0x1e, 0xb6, 0x13, 0x30, 0xb9, 0x78, 0x15,
-	0x10, 0xf2, 0x14, 0xf6, 0x96, 0xa8, 0xc5, 0xf4, 0x8a, 0x9a, 0xe2, 0xdc, 0x49, 0x49, 0x99, 0xb4,
-	0x34, 0x67, 0x19, 0x46, 0x1f, 0xf8, 0xe2, 0xdd, 0x90, 0x4e, 0x43, 0xf6, 0x85, 0xb4, 0xdf, 0xb1,
-	0x0c, 0xc9, 0x57, 0x00, 0x0b, 0xa6, 0x59, 0x86, 0x16, 0xb5, 0x89, 0x36, 0xfd, 0x59, 0x3e, 0x5e,
-	0x3b, 0x8b, 0x91, 0xaf, 0xdf, 0x96, 0x24, 0xd7, 0xca, 0xc9, 0x67, 0x70, 0x8f, 0xc9, 0x45, 0x4e,
-	0xbd, 0xa0, 0x5c, 0x49, 0x13, 0x6d, 0xf9, 0x56, 0x5d, 0x87, 0xbe, 0xae, 0xc0, 0x97, 0x5d, 0xe8,
-	0x38, 0x7d, 0x82, 0x60, 0x66, 0xf0, 0x03, 0xb4, 0x2a, 0x3d, 0xc8, 0x3e, 0xb4, 0xac, 0x34, 0x94,
-	0xa3, 0xb6, 0x5e, 0xf4, 0x76, 0xb2, 0x65, 0xa5, 0x19, 0xa1, 0xb6, 0x64, 0x0f, 0xdc, 0x92, 0x5e,
-	0xe0, 0x95, 0xd7, 0xb4, 0x9d, 0x6c, 0x5a, 0x69, 0xbe, 0xc5, 0x2b, 0x97, 0xd0, 0x4a, 0x59, 0xca,
-	0x59, 0xd4, 0x0c, 0x09, 0x17, 0x8e, 0xd8, 0xe0, 0x8f, 0x06, 0x7c, 0x78, 0xb6, 0x30, 0x56, 0x23,
-	0xcb, 0xde, 0x9f, 0xc9, 0xf6, 0xa0, 0x69, 0x72, 0x51, 0x5e, 0xc5, 0x2d, 0xff, 0x9f, 0x51, 0x6e,
-	0xfd, 0xd7, 0x51, 0xb6, 0xee, 0x30, 0xca, 0x9f, 0xdd, 0x37, 0x54, 0x5d, 0x88, 0xdc, 0x07, 0xb0,
-	0x4c, 0xcf, 0xd0, 0xd2, 0x42, 0x8b, 0x72, 0x9c, 0xed, 0x80, 0x9c, 0x69, 0x41, 0xbe, 0x81, 0x1e,
-	0x67, 0x52, 0x52, 0xae, 0x71, 0x82, 0xb9, 0x15, 0x4c, 0x56, 0x9a, 0xde, 0xbf, 0x79, 0xca, 0x11,
-	0x93, 0x72, 0xb4, 0x2a, 0x1a, 0xd7, 0x92, 0x1d, 0x7e, 0x13, 0x22, 0x0f, 0x61, 0x9b, 0xcb, 0xc2,
-	0x58, 0xd4, 0x95, 0x2e, 0xf5, 0xc3, 0xf6, 0xb8, 0x96, 0x74, 0x4a, 0xd4, 0x0b, 0xf2, 0x25, 0x44,
-	0xce, 0x58, 0x62, 0x2a, 0x38, 0xb3, 0x68, 0xca, 0x53, 0x07, 0x42, 0x90, 0xbb, 0x7f, 0x3d, 0x9f,
-	0xfa, 0xb4, 0x67, 0x3e, 0x83, 0xbd, 0x25, 0x93, 0x62, 0xc2, 0xac, 0x50, 0x39, 0xe5, 0x2a, 0xb7,
-	0x78, 0x59, 0x12, 0x37, 0x3c, 0xf1, 0xa3, 0x55, 0x7a, 0x14, 0xb2, 0x8e, 0xe7, 0xe5, 0x99, 0x18,
-	0x7a, 0x5e, 0x08, 0x39, 0x41, 0x3d, 0xf8, 0xad, 0x0e, 0x3b, 0x6b, 0x97, 0x21, 0x73, 0xe8, 0x3b,
-	0x3b, 0x5d, 0x53, 0x81, 0x06, 0xf3, 0x95, 0xd6, 0x3c, 0x7e, 0xa7, 0x16, 0xb1, 0x33, 0xd8, 0x2a,
-	0x4e, 0x83, 0x6d, 0x77, 0xa7, 0xb7, 0xa0, 0x07, 0x6f, 0x60, 0xf7, 0xb6, 0x6a, 0xf2, 0x39, 0xec,
-	0x58, 0x75, 0x81, 0xb9, 0xb7, 0x75, 0xb8, 0x54, 0x98, 0x55, 0xd7, 0xc3, 0x8e, 0xe3, 0x45, 0xe8,
-	0xc3, 0xe6, 0x1c, 0xd9, 0x04, 0x75, 0xf5, 0xfd, 0x85, 0x68, 0xf0, 0x77, 0x03, 0xba, 0x37, 0x8c,
-	0x44, 0x10, 0xa2, 0x4c, 0xe4, 0x22, 0x2b, 0xb2, 0xb7, 0xfe, 0xa1, 0x4b, 0xd4, 0x46, 0xa8, 0xdc,
-	0x6f, 0x7d, 0xef, 0xf8, 0xd1, 0x3b, 0x7c, 0x18, 0x57, 0xf6, 0x7a, 0x13, 0x28, 0x49, 0xbf, 0xdc,
-	0x6c, 0x0d, 0xf7, 0x6d, 0xd8, 0xe5, 0xed, 0x6d, 0x1a, 0xff, 0xa6, 0x4d, 0xd8, 0x6c, 0xbd, 0xcd,
-	0x43, 0xe8, 0x72, 0xb1, 0x98, 0xa3, 0xa6, 0xa6, 0x10, 0x16, 0xab, 0xc7, 0x76, 0x3b, 0x80, 0xa9,
-	0xc7, 0xdc, 0x7b, 0x8c, 0x7c, 0x32, 0xa7, 0xbc, 0xd0, 0x4b, 0x74, 0x9f, 0xba, 0x7f, 0x8f, 0x1d,
-	0x34, 0xf2, 0xc8, 0x20, 0x85, 0x9d, 0xf5, 0x8d, 0xb7, 0xa1, 0x75, 0x7a, 0x92, 0xd2, 0x17, 0x67,
-	0xa7, 0xdf, 0xf7, 0x6a, 0xa4, 0x03, 0x5b, 0xa7, 0x27, 0xe9, 0xf2, 0x88, 0x3e, 0xee, 0xd5, 0x57,
-	0xc1, 0x51, 0xaf, 0xb1, 0x0a, 0x8e, 0x7b, 0xcd, 0x55, 0xf0, 0xa4, 0xb7, 0xf1, 0xf2, 0xf9, 0xaf,
-	0x7f, 0x6e, 0xd4, 0x7f, 0xf9, 0xfd, 0x93, 0xfa, 0x8f, 0x8f, 0xef, 0xf6, 0x23, 0x5d, 0x5c, 0xcc,
-	0xca, 0x3f, 0xdf, 0xf9, 0xa6, 0xd7, 0xec, 0xc9, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x84,
-	0x4e, 0x50, 0x83, 0x07, 0x00, 0x00,
+	// 975 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xf6, 0x4f, 0xea, 0xd8, 0xc7, 0x76, 0x63, 0x46, 0x89, 0xb3, 0x49, 0x55, 0x1a, 0x39, 0x02,
+	0x45, 0xaa, 0xb0, 0x9b, 0x54, 0xad, 0x50, 0xb9, 0x6a, 0x5c, 0x21, 0x03, 0x01, 0xaa, 0xdd, 0xa4,
+	0x48, 0xbd, 0x59, 0x4d, 0xc6, 0xc7, 0xf6, 0xe0, 0xf1, 0xce, 0x6a, 0x66, 0x1c, 0x39, 0x2f, 0xc1,
+	0x13, 0xf0, 0x00, 0x3c, 0x02, 0xef, 0xc1, 0x1b, 0xf0, 0x02, 0x5c, 0x21, 0x71, 0x89, 0x66, 0x66,
+	0x37, 0x4e, 0x8c, 0x5b, 0x21, 0xe0, 0x86, 0xbb, 0x3d, 0xdf, 0x77, 0xbe, 0x39, 0x67, 0xce, 0x7c,
+	0x47, 0x36, 0x3c, 0x1f, 0x73, 0x33, 0x99, 0x5f, 0x76, 0x99, 0x9c, 0xf5, 0xb4, 0x14, 0xf2, 0x13,
+	0x2e, 0x7b, 0x63, 0x21, 0x65, 0x2f, 0x55, 0xf2, 0x7b, 0x64, 0x46, 0xfb, 0x88, 0xa6, 0xbc, 0x77,
+	0x75, 0xdc, 0xd3, 0x5a, 0x74, 0x53, 0x25, 0x8d, 0x24, 0x0d, 0x0b, 0x77, 0xad, 0xa2, 0xcb, 0xe5,
+	0xfe, 0xf6, 0x58, 0x8e, 0xa5, 0x23, 0x7a, 0xf6, 0xcb, 0xe7, 0xec, 0x13, 0x5c, 0x18, 0x0f, 0xe2,
+	0xc2, 0x64, 0xd8, 0x9e, 0x2b, 0x32, 0xe5, 0x26, 0x3f, 0x52, 0xe1, 0xc8, 0x53, 0x9d, 0x1f, 0xee,
+	0x41, 0x2d, 0xd2, 0xa2, 0x2f, 0x93, 0x11, 0x1f, 0x93, 0x17, 0x00, 0x1a, 0x99, 0x42, 0x13, 0x2b,
+	0x1c, 0x05, 0xc5, 0x83, 0xe2, 0x51, 0xfd, 0x64, 0xaf, 0xcb, 0xa4, 0xc2, 0xbc, 0x6a, 0x37, 0x44,
+	0x2d, 0xe7, 0x8a, 0x61, 0x88, 0xa3, 0x41, 0x21, 0xac, 0xf9, 0xf4, 0x10, 0x47, 0xe4, 0x19, 0xd4,
+	0xb4, 0x16, 0xf1, 0x88, 0x0b, 0xd4, 0x41, 0xc9, 0x49, 0xdb, 0xdd, 0xdb, 0x0d, 0x77, 0xa3, 0xe8,
+	0xec, 0x73, 0xcb, 0x0e, 0x0a, 0x61, 0x55, 0x6b, 0xe1, 0xbe, 0xc9, 0x63, 0x28, 0xeb, 0xa1, 0x0e,
+	0x36, 0x9c, 0x60, 0x77, 0x45, 0xf0, 0x2a, 0xf2, 0x8d, 0x0d, 0x0a, 0xa1, 0xcd, 0x22, 0x8f, 0xa0,
+	0xae, 0x13, 0x1e, 0x0f, 0xe5, 0x8c, 0xf2, 0x44, 0x07, 0xe5, 0x83, 0xf2, 0x51, 0x2d, 0x04, 0x9d,
+	0xf0, 0x57, 0x1e, 0x21, 0xcf, 0x60, 0xf7, 0x0a, 0x15, 0x1f, 0x5d, 0xc7, 0x7a, 0x7e, 0x69, 0x47,
+	0x19, 0x53, 0x61, 0xe2, 0x84, 0xce, 0x30, 0xb8, 0xe7, 0x92, 0xb7, 0x3d, 0x1d, 0x79, 0xf6, 0xa5,
+	0x30, 0xdf, 0xd0, 0x19, 0x92, 0xcf, 0x00, 0x52, 0xaa, 0xe8, 0x0c, 0x0d, 0x2a, 0x1d, 0x54, 0x5c,
+	0x2f, 0x0f, 0x56, 0x7a, 0xd1, 0xe2, 0xf5, 0x4d, 0x4a, 0x78, 0x2b, 0x9d, 0x7c, 0x04, 0xf7, 0xa9,
+	0x48, 0x93, 0xd8, 0x0d, 0x94, 0x49, 0xa1, 0x83, 0x4d, 0x57, 0xaa, 0x69, 0xd1, 0xd7, 0x39, 0x48,
+	0xde, 0xc2, 0xee, 0x8c, 0x1a, 0x36, 0xf9, 0x4b, 0x67, 0x3a, 0xa8, 0x1e, 0x94, 0x8f, 0xea, 0x27,
+	0x87, 0x2b, 0x05, 0xef, 0xb4, 0xf8, 0xb5, 0x95, 0xa2, 0x0a, 0xb7, 0xdd, 0x19, 0x77, 0x39, 0x4d,
+	0x9e, 0xdf, 0x5c, 0x9b, 0xa1, 0x32, 0x7c, 0xc4, 0x19, 0x35, 0x18, 0xeb, 0x74, 0xca, 0x83, 0x9a,
+	0xeb, 0x65, 0xc7, 0xd3, 0xfd, 0x25, 0x1b, 0xa5, 0x53, 0xfe, 0x0e, 0xdd, 0x84, 0xea, 0x49, 0x00,
+	0xef, 0xd0, 0x0d, 0xa8, 0x9e, 0x90, 0x07, 0x50, 0x65, 0xca, 0xbf, 0x75, 0x50, 0x3f, 0x28, 0x1e,
+	0xd5, 0x06, 0xc5, 0x70, 0x93, 0x29, 0xf7, 0xa4, 0xe4, 0x11, 0x80, 0x25, 0x79, 0x22, 0x78, 0x82,
+	0x41, 0x23, 0xa3, 0x6b, 0x4c, 0x89, 0x2f, 0x1c, 0x74, 0xda, 0x84, 0xba, 0x75, 0x8a, 0xb7, 0x8e,
+	0x3e, 0x6d, 0xf8, 0x7c, 0x6f, 0xab, 0x8e, 0x82, 0x9d, 0xb5, 0x37, 0x27, 0x6d, 0xb8, 0x87, 0x0b,
+	0xca, 0x8c, 0xb3, 0x65, 0x6d, 0x50, 0x08, 0x7d, 0x48, 0x02, 0xa8, 0xa4, 0x0a, 0x47, 0x7c, 0x11,
+	0x94, 0x32, 0x22, 0x8b, 0xad, 0x42, 0xe1, 0x18, 0x17, 0x41, 0x39, 0x57, 0xb8, 0xf0, 0x74, 0x0b,
+	0x9a, 0xfe, 0x25, 0x52, 0x6a, 0x0c, 0xaa, 0xa4, 0xf3, 0x1d, 0x54, 0x73, 0x6f, 0x92, 0x3d, 0xa8,
+	0x1a, 0xa1, 0xdd, 0x3c, 0x7c, 0xa5, 0x70, 0xd3, 0x08, 0x6d, 0x07, 0x40, 0x76, 0xc1, 0x7e, 0xc6,
+	0x53, 0xbc, 0xf6, 0xa5, 0xc2, 0x8a, 0x11, 0xfa, 0x2b, 0xbc, 0xb6, 0x84, 0x92, 0xd2, 0xc4, 0x8c,
+	0xfa, 0x52, 0x61, 0xc5, 0x86, 0x7d, 0xda, 0xf9, 0xad, 0x04, 0x1f, 0x5c, 0xa4, 0xda, 0x28, 0xa4,
+	0xb3, 0xff, 0xcf, 0x96, 0xb5, 0xa0, 0xac, 0x13, 0x9e, 0x5d, 0xc5, 0x7e, 0xfe, 0x37, 0x6b, 0xb5,
+	0xf9, 0x6f, 0xd7, 0xaa, 0xba, 0x66, 0xad, 0x56, 0xcc, 0xd4, 0xf9, 0xb1, 0x04, 0xb5, 0x9b, 0x0b,
+	0x91, 0x87, 0x00, 0x86, 0xaa, 0x31, 0x9a, 0x78, 0xae, 0x78, 0xf6, 0x9c, 0x35, 0x8f, 0x5c, 0x28,
+	0x4e, 0xbe, 0x84, 0x16, 0xa3, 0x42, 0xc4, 0x4c, 0xe1, 0x10, 0x13, 0xc3, 0xa9, 0xc8, 0x67, 0xfa,
+	0xf0, 0x6e, 0x97, 0x7d, 0x2a, 0x44, 0x7f, 0x99, 0x34, 0x28, 0x84, 0x5b, 0xec, 0x2e, 0x44, 0x0e,
+	0xa1, 0xc1, 0xc4, 0x5c, 0x1b, 0x54, 0xf9, 0x5c, 0xbc, 0xe7, 0xea, 0x19, 0xea, 0x06, 0xf2, 0x29,
+	0x04, 0xb7, 0x16, 0x4d, 0x67, 0x5d, 0x7b, 0x81, 0x1f, 0x77, 0xfb, 0x36, 0x1f, 0x39, 0xda, 0x29,
+	0xed, 0xa6, 0x52, 0xc1, 0x87, 0xd4, 0x70, 0x99, 0xc4, 0x4c, 0x26, 0x06, 0x17, 0x99, 0x70, 0xc3,
+	0x09, 0x77, 0x96, 0x74, 0xdf, 0xb3, 0x56, 0xe7, 0xc6, 0x33, 0xd4, 0xf1, 0xe5, 0x9c, 0x8b, 0x21,
+	0xaa, 0xce, 0x2f, 0x45, 0xd8, 0x5a, 0xb9, 0x0c, 0x99, 0x40, 0xdb, 0xda, 0xe9, 0xd6, 0x14, 0xb2,
+	0x5d, 0xcc, 0xac, 0x79, 0xf2, 0xde, 0x59, 0x74, 0xad, 0xc1, 0x96, 0x71, 0xe4, 0x6d, 0xbb, 0x3d,
+	0x5a, 0x83, 0xee, 0xbf, 0x81, 0xed, 0x75, 0xd9, 0xe4, 0x63, 0xd8, 0x32, 0x72, 0x8a, 0x89, 0xb3,
+	0xb5, 0xbf, 0x94, 0x7f, 0xab, 0xa6, 0x83, 0xad, 0xc6, 0x0d, 0xa1, 0x0d, 0x95, 0x09, 0xd2, 0x21,
+	0xaa, 0x7c, 0xff, 0x7c, 0xd4, 0xf9, 0xa3, 0x04, 0xcd, 0x3b, 0x46, 0x22, 0x08, 0xc1, 0x8c, 0x27,
+	0x7c, 0x36, 0x9f, 0xdd, 0xf8, 0x27, 0xbe, 0x42, 0xa5, 0xb9, 0x4c, 0xdc, 0xd1, 0xf7, 0x4f, 0x1e,
+	0xbf, 0xc7, 0x87, 0xdd, 0xdc, 0x5e, 0x6f, 0xbc, 0x24, 0x6c, 0x67, 0x87, 0xad, 0xe0, 0xae, 0x0c,
+	0x5d, 0xac, 0x2f, 0x53, 0xfa, 0x27, 0x65, 0xfc, 0x61, 0xab, 0x65, 0x0e, 0xa1, 0xc9, 0x78, 0x3a,
+	0x41, 0x15, 0xeb, 0x39, 0x37, 0x98, 0xff, 0xf0, 0x35, 0x3c, 0x18, 0x39, 0xcc, 0xfe, 0x36, 0x22,
+	0x1b, 0x4e, 0x62, 0x36, 0x57, 0x57, 0x68, 0x57, 0xdd, 0xa6, 0x80, 0x85, 0xfa, 0x0e, 0xe9, 0x44,
+	0xb0, 0xb5, 0x7a, 0x70, 0x03, 0xaa, 0xe7, 0x67, 0x51, 0xfc, 0xf2, 0xe2, 0xfc, 0xdb, 0x56, 0x81,
+	0xd4, 0x61, 0xf3, 0xfc, 0x2c, 0xba, 0x3a, 0x8e, 0x9f, 0xb4, 0x8a, 0xcb, 0xe0, 0xb8, 0x55, 0x5a,
+	0x06, 0x27, 0xad, 0xf2, 0x32, 0x78, 0xda, 0xda, 0x38, 0x7d, 0xf1, 0xf3, 0xef, 0x1b, 0xc5, 0x9f,
+	0x7e, 0xfd, 0xb0, 0xf8, 0xf6, 0xc9, 0xdf, 0xfb, 0x53, 0x93, 0x4e, 0xc7, 0xd9, 0xbf, 0x90, 0xcb,
+	0x8a, 0x9b, 0xd9, 0xd3, 0x3f, 0x07, 0x00, 0x0d, 0xcd, 0xb0, 0x7a, 0x0f, 0x09, 0x00, 0x00,
 }
 
 func (this *SslConfig) Equal(that interface{}) bool {
@@ -760,6 +935,39 @@ func (this *SslConfig) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.MatchSubjectAltNames) != len(that1.MatchSubjectAltNames) {
+		return false
+	}
+	for i := range this.MatchSubjectAltNames {
+		if !this.MatchSubjectAltNames[i].Equal(that1.MatchSubjectAltNames[i]) {
+			return false
+		}
+	}
+	if len(this.VerifyCertificateSpki) != len(that1.VerifyCertificateSpki) {
+		return false
+	}
+	for i := range this.VerifyCertificateSpki {
+		if this.VerifyCertificateSpki[i] != that1.VerifyCertificateSpki[i] {
+			return false
+		}
+	}
+	if len(this.VerifyCertificateHash) != len(that1.VerifyCertificateHash) {
+		return false
+	}
+	for i := range this.VerifyCertificateHash {
+		if this.VerifyCertificateHash[i] != that1.VerifyCertificateHash[i] {
+			return false
+		}
+	}
+	if that1.CrlSource == nil {
+		if this.CrlSource != nil {
+			return false
+		}
+	} else if this.CrlSource == nil {
+		return false
+	} else if !this.CrlSource.Equal(that1.CrlSource) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -837,6 +1045,159 @@ func (this *SslConfig_Sds) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SslConfig_CrlFile) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SslConfig_CrlFile)
+	if !ok {
+		that2, ok := that.(SslConfig_CrlFile)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CrlFile != that1.CrlFile {
+		return false
+	}
+	return true
+}
+func (this *SslConfig_CrlInline) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SslConfig_CrlInline)
+	if !ok {
+		that2, ok := that.(SslConfig_CrlInline)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CrlInline != that1.CrlInline {
+		return false
+	}
+	return true
+}
+func (this *SubjectAltNameMatcher) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SubjectAltNameMatcher)
+	if !ok {
+		that2, ok := that.(SubjectAltNameMatcher)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.MatchPattern == nil {
+		if this.MatchPattern != nil {
+			return false
+		}
+	} else if this.MatchPattern == nil {
+		return false
+	} else if !this.MatchPattern.Equal(that1.MatchPattern) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *SubjectAltNameMatcher_Exact) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SubjectAltNameMatcher_Exact)
+	if !ok {
+		that2, ok := that.(SubjectAltNameMatcher_Exact)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Exact != that1.Exact {
+		return false
+	}
+	return true
+}
+func (this *SubjectAltNameMatcher_Prefix) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SubjectAltNameMatcher_Prefix)
+	if !ok {
+		that2, ok := that.(SubjectAltNameMatcher_Prefix)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Prefix != that1.Prefix {
+		return false
+	}
+	return true
+}
+func (this *SubjectAltNameMatcher_Regex) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SubjectAltNameMatcher_Regex)
+	if !ok {
+		that2, ok := that.(SubjectAltNameMatcher_Regex)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Regex != that1.Regex {
+		return false
+	}
+	return true
+}
 func (this *SSLFiles) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -76,6 +76,40 @@ func (m *SslConfig) Hash(hasher hash.Hash64) (uint64, error) {
 
 	}
 
+	for _, v := range m.GetMatchSubjectAltNames() {
+
+		if h, ok := interface{}(v).(safe_hasher.SafeHasher); ok {
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if val, err := hashstructure.Hash(v, nil); err != nil {
+				return 0, err
+			} else {
+				if err := binary.Write(hasher, binary.LittleEndian, val); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	}
+
+	for _, v := range m.GetVerifyCertificateSpki() {
+
+		if _, err = hasher.Write([]byte(v)); err != nil {
+			return 0, err
+		}
+
+	}
+
+	for _, v := range m.GetVerifyCertificateHash() {
+
+		if _, err = hasher.Write([]byte(v)); err != nil {
+			return 0, err
+		}
+
+	}
+
 	switch m.SslSecrets.(type) {
 
 	case *SslConfig_SecretRef:
@@ -128,6 +162,60 @@ func (m *SslConfig) Hash(hasher hash.Hash64) (uint64, error) {
 
 	}
 
+	switch m.CrlSource.(type) {
+
+	case *SslConfig_CrlFile:
+
+		if _, err = hasher.Write([]byte(m.GetCrlFile())); err != nil {
+			return 0, err
+		}
+
+	case *SslConfig_CrlInline:
+
+		if _, err = hasher.Write([]byte(m.GetCrlInline())); err != nil {
+			return 0, err
+		}
+
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *SubjectAltNameMatcher) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.SubjectAltNameMatcher")); err != nil {
+		return 0, err
+	}
+
+	switch m.MatchPattern.(type) {
+
+	case *SubjectAltNameMatcher_Exact:
+
+		if _, err = hasher.Write([]byte(m.GetExact())); err != nil {
+			return 0, err
+		}
+
+	case *SubjectAltNameMatcher_Prefix:
+
+		if _, err = hasher.Write([]byte(m.GetPrefix())); err != nil {
+			return 0, err
+		}
+
+	case *SubjectAltNameMatcher_Regex:
+
+		if _, err = hasher.Write([]byte(m.GetRegex())); err != nil {
+			return 0, err
+		}
+
+	}
+
 	return hasher.Sum64(), nil
 }
 
@@ -17,6 +17,8 @@ import (
 	ec2 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws/ec2"
 	azure "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/azure"
 	consul "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/consul"
+	dns "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dns"
+	gcp "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/gcp"
 	kubernetes "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
 	pipe "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/pipe"
 	static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
@@ -35,7 +37,6 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-//
 // Upstreams represent destination for routing HTTP requests. Upstreams can be compared to
 // [clusters](https://www.envoyproxy.io/docs/envoy/latest/api-v2/api/v2/cds.proto) in Envoy terminology.
 // Each upstream in Gloo has a type. Supported types include `static`, `kubernetes`, `aws`, `consul`, and more.
@@ -72,6 +73,8 @@ type Upstream struct {
 	//	*Upstream_Azure
 	//	*Upstream_Consul
 	//	*Upstream_AwsEc2
+	//	*Upstream_Gcp
+	//	*Upstream_Dns
 	UpstreamType isUpstream_UpstreamType `protobuf_oneof:"upstream_type"`
 	// Failover endpoints for this upstream. If omitted (the default) no failovers will be applied.
 	Failover *Failover `protobuf:"bytes,18,opt,name=failover,proto3" json:"failover,omitempty"`
@@ -89,9 +92,13 @@ type Upstream struct {
 	// Currently, this has the same minimum/maximum/default as initial_stream_window_size.
 	// Requires UseHttp2 to be true to be acknowledged.
 	InitialConnectionWindowSize *types.UInt32Value `protobuf:"bytes,20,opt,name=initial_connection_window_size,json=initialConnectionWindowSize,proto3" json:"initial_connection_window_size,omitempty"`
-	XXX_NoUnkeyedLiteral        struct{}           `json:"-"`
-	XXX_unrecognized            []byte             `json:"-"`
-	XXX_sizecache               int32              `json:"-"`
+	// Send a PROXY protocol header with the address of the client at the start of the connections to this upstream,
+	// for backends that need the original client address. Supported versions are "V1" and "V2".
+	// The header is sent before the TLS handshake when the upstream also has an ssl config.
+	ProxyProtocolVersion *types.StringValue `protobuf:"bytes,23,opt,name=proxy_protocol_version,json=proxyProtocolVersion,proto3" json:"proxy_protocol_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *Upstream) Reset()         { *m = Upstream{} }
@@ -144,6 +151,12 @@ type Upstream_Consul struct {
 type Upstream_AwsEc2 struct {
 	AwsEc2 *ec2.UpstreamSpec `protobuf:"bytes,17,opt,name=aws_ec2,json=awsEc2,proto3,oneof" json:"aws_ec2,omitempty"`
 }
+type Upstream_Gcp struct {
+	Gcp *gcp.UpstreamSpec `protobuf:"bytes,21,opt,name=gcp,proto3,oneof" json:"gcp,omitempty"`
+}
+type Upstream_Dns struct {
+	Dns *dns.UpstreamSpec `protobuf:"bytes,22,opt,name=dns,proto3,oneof" json:"dns,omitempty"`
+}
 
 func (*Upstream_Kube) isUpstream_UpstreamType()   {}
 func (*Upstream_Static) isUpstream_UpstreamType() {}
@@ -152,6 +165,8 @@ func (*Upstream_Aws) isUpstream_UpstreamType()    {}
 func (*Upstream_Azure) isUpstream_UpstreamType()  {}
 func (*Upstream_Consul) isUpstream_UpstreamType() {}
 func (*Upstream_AwsEc2) isUpstream_UpstreamType() {}
+func (*Upstream_Gcp) isUpstream_UpstreamType()    {}
+func (*Upstream_Dns) isUpstream_UpstreamType()    {}
 
 func (m *Upstream) GetUpstreamType() isUpstream_UpstreamType {
 	if m != nil {
@@ -279,6 +294,20 @@ func (m *Upstream) GetAwsEc2() *ec2.UpstreamSpec {
 	return nil
 }
 
+func (m *Upstream) GetGcp() *gcp.UpstreamSpec {
+	if x, ok := m.GetUpstreamType().(*Upstream_Gcp); ok {
+		return x.Gcp
+	}
+	return nil
+}
+
+func (m *Upstream) GetDns() *dns.UpstreamSpec {
+	if x, ok := m.GetUpstreamType().(*Upstream_Dns); ok {
+		return x.Dns
+	}
+	return nil
+}
+
 func (m *Upstream) GetFailover() *Failover {
 	if m != nil {
 		return m.Failover
@@ -300,6 +329,13 @@ func (m *Upstream) GetInitialConnectionWindowSize() *types.UInt32Value {
 	return nil
 }
 
+func (m *Upstream) GetProxyProtocolVersion() *types.StringValue {
+	if m != nil {
+		return m.ProxyProtocolVersion
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Upstream) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -310,6 +346,8 @@ func (*Upstream) XXX_OneofWrappers() []interface{} {
 		(*Upstream_Azure)(nil),
 		(*Upstream_Consul)(nil),
 		(*Upstream_AwsEc2)(nil),
+		(*Upstream_Gcp)(nil),
+		(*Upstream_Dns)(nil),
 	}
 }
 
@@ -364,71 +402,75 @@ func init() {
 }
 
 var fileDescriptor_b74df493149f644d = []byte{
-	// 1010 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xdd, 0x6e, 0xdb, 0x36,
-	0x1c, 0xc5, 0xeb, 0xc6, 0x49, 0x63, 0x26, 0x59, 0x62, 0x2e, 0x58, 0x85, 0xac, 0x73, 0x02, 0x0f,
-	0x58, 0xb3, 0x0e, 0xa1, 0x56, 0x07, 0x43, 0x3b, 0x0f, 0x1d, 0x06, 0x3b, 0x19, 0x32, 0x34, 0xdd,
-	0x00, 0x19, 0xdd, 0xb0, 0xdd, 0x08, 0x34, 0x4d, 0xdb, 0x9c, 0x19, 0x51, 0x10, 0x29, 0x3b, 0xce,
-	0x65, 0x5f, 0x61, 0x2f, 0xb1, 0x47, 0xd8, 0x23, 0xec, 0x7e, 0xf7, 0xbd, 0xd8, 0x1b, 0x74, 0xc0,
-	0xee, 0x07, 0x7e, 0xc8, 0xf1, 0x47, 0x5d, 0x6b, 0x17, 0xb6, 0x44, 0xf2, 0x9c, 0x9f, 0x28, 0xea,
-	0xcf, 0x23, 0x81, 0xaf, 0x7a, 0x4c, 0xf5, 0xd3, 0x36, 0x22, 0xe2, 0xca, 0x97, 0x82, 0x8b, 0x13,
-	0x26, 0xfc, 0x1e, 0x17, 0xc2, 0x8f, 0x13, 0xf1, 0x2b, 0x25, 0x4a, 0xda, 0x16, 0x8e, 0x99, 0x3f,
-	0x7c, 0xec, 0xa7, 0xb1, 0x54, 0x09, 0xc5, 0x57, 0x28, 0x4e, 0x84, 0x12, 0x70, 0x5b, 0x8f, 0x21,
-	0x6d, 0x43, 0x4c, 0x1c, 0xec, 0xf7, 0x44, 0x4f, 0x98, 0x01, 0x5f, 0x9f, 0x59, 0xcd, 0x01, 0xa4,
-	0xd7, 0xca, 0x76, 0xd2, 0x6b, 0xe5, 0xfa, 0x2a, 0xe6, 0x4a, 0x03, 0xa6, 0x32, 0xee, 0x15, 0x55,
-	0xb8, 0x83, 0x15, 0x76, 0xe3, 0x1f, 0x2f, 0x9f, 0x81, 0x94, 0xdc, 0x89, 0xde, 0x31, 0x4d, 0xc2,
-	0x12, 0x92, 0x32, 0x15, 0xb6, 0x13, 0x8a, 0x07, 0x34, 0x71, 0x86, 0x93, 0xe5, 0x06, 0x2e, 0x70,
-	0x27, 0x6c, 0x63, 0x8e, 0x23, 0x32, 0x91, 0x3f, 0x7a, 0x07, 0x5f, 0x44, 0x11, 0x25, 0x8a, 0x89,
-	0xc8, 0x69, 0xcf, 0x96, 0x68, 0xe9, 0xb5, 0xa2, 0x49, 0x84, 0xb9, 0x4f, 0xa3, 0xa1, 0x18, 0x5b,
-	0x7b, 0xcd, 0x27, 0x22, 0xa1, 0x7e, 0x9f, 0x62, 0xae, 0xfa, 0x21, 0xe9, 0x53, 0x32, 0x70, 0x94,
-	0x07, 0xf3, 0xcb, 0x22, 0x15, 0x56, 0xa9, 0x74, 0xa3, 0x97, 0xff, 0xef, 0x1a, 0x3c, 0x95, 0x8a,
-	0x26, 0xbe, 0x48, 0x15, 0x67, 0x34, 0x09, 0x3b, 0x54, 0xcd, 0xcc, 0x78, 0xe1, 0x11, 0x64, 0x6d,
-	0x37, 0xfe, 0xc5, 0xf2, 0xbb, 0x17, 0xb1, 0xe6, 0x48, 0x33, 0x3b, 0x46, 0xdc, 0xc1, 0xd9, 0x1e,
-	0xaf, 0xb6, 0xc5, 0x2c, 0xa6, 0xe6, 0xcf, 0x59, 0x9e, 0xad, 0xb6, 0x0c, 0xd2, 0x36, 0x4d, 0x22,
-	0xaa, 0xe8, 0xf4, 0xe9, 0xea, 0x32, 0xc8, 0xec, 0x78, 0x64, 0x7e, 0xce, 0x70, 0x9a, 0xc3, 0x70,
-	0x93, 0x26, 0xd4, 0xfe, 0xe7, 0x5f, 0x0e, 0x22, 0x22, 0x99, 0x72, 0x77, 0x70, 0xb6, 0x27, 0xf9,
-	0x26, 0x47, 0x49, 0x4d, 0x1f, 0x43, 0x4a, 0x6a, 0xce, 0xf8, 0x70, 0xa5, 0xd1, 0x09, 0x8f, 0x97,
-	0x0b, 0xbb, 0x98, 0x71, 0x31, 0x9c, 0xd4, 0x73, 0xa5, 0x27, 0x44, 0x8f, 0x53, 0xdf, 0xb4, 0xda,
-	0x69, 0xd7, 0x1f, 0x25, 0x38, 0x8e, 0x69, 0xe2, 0x48, 0xd5, 0xbf, 0xb6, 0xc0, 0xe6, 0x4b, 0xb7,
-	0xbf, 0xe1, 0x73, 0xb0, 0x61, 0x8b, 0xcf, 0x2b, 0x1c, 0x15, 0x8e, 0xb7, 0x6a, 0xfb, 0x48, 0x17,
-	0x6d, 0xb6, 0xd5, 0x51, 0xcb, 0x8c, 0x35, 0x3e, 0xfa, 0xe3, 0xdf, 0x62, 0xe1, 0xcf, 0xd7, 0x87,
-	0x77, 0xfe, 0x79, 0x7d, 0x58, 0x56, 0x54, 0xaa, 0x0e, 0xeb, 0x76, 0xeb, 0x55, 0xd6, 0x8b, 0x44,
-	0x42, 0xab, 0x81, 0x43, 0xc0, 0xa7, 0x60, 0x33, 0xdb, 0xe0, 0xde, 0x5d, 0x83, 0xfb, 0x60, 0x16,
-	0xf7, 0xc2, 0x8d, 0x36, 0x8a, 0x1a, 0x16, 0x4c, 0xd4, 0xf0, 0x7b, 0x00, 0x3b, 0x4c, 0x12, 0x7d,
-	0x17, 0xe3, 0x70, 0xc2, 0x58, 0x33, 0x8c, 0x43, 0x34, 0x9d, 0x3e, 0xe8, 0x2c, 0xd3, 0x65, 0xb0,
-	0xa0, 0xdc, 0x99, 0xef, 0x82, 0x5f, 0x03, 0x20, 0x25, 0x0f, 0x89, 0x88, 0xba, 0xac, 0xe7, 0x15,
-	0xdf, 0xc6, 0xc9, 0x96, 0xa0, 0x25, 0x79, 0xd3, 0xc8, 0x82, 0x92, 0xcc, 0x4e, 0xe1, 0x0b, 0xb0,
-	0x37, 0x97, 0x2d, 0xd2, 0x5b, 0x37, 0x94, 0xea, 0x2c, 0xa5, 0x69, 0x55, 0x0d, 0x2b, 0x72, 0xa0,
-	0x5d, 0x32, 0xd3, 0x2b, 0x61, 0x00, 0xf6, 0x67, 0x92, 0x27, 0x9b, 0xd8, 0x86, 0x41, 0x1e, 0xcd,
-	0x22, 0x2f, 0x05, 0xee, 0x34, 0x9c, 0xd0, 0x01, 0x21, 0x5f, 0xe8, 0x83, 0xcf, 0x41, 0xf9, 0x36,
-	0x9e, 0x32, 0xe0, 0x3d, 0x03, 0xac, 0xcc, 0xcd, 0x71, 0x22, 0x73, 0xb8, 0x3d, 0x32, 0xd7, 0x03,
-	0x9b, 0x60, 0x67, 0x3a, 0xa7, 0xa4, 0xb7, 0x79, 0xb4, 0x66, 0x40, 0x26, 0x6b, 0x10, 0x8e, 0x19,
-	0x1a, 0xd6, 0xec, 0xb3, 0xbc, 0x30, 0xba, 0xa6, 0x96, 0x05, 0xdb, 0xfd, 0xdb, 0x86, 0x84, 0x2d,
-	0x50, 0x5e, 0x48, 0x21, 0xaf, 0x64, 0x66, 0xf4, 0xc9, 0x1c, 0xc8, 0x86, 0x16, 0xfa, 0xc1, 0xca,
-	0xcf, 0x32, 0x75, 0xb0, 0x27, 0xe6, 0x7a, 0xe0, 0x13, 0x50, 0x4a, 0x25, 0x0d, 0xfb, 0x4a, 0xc5,
-	0x35, 0x0f, 0x18, 0xd8, 0x01, 0xb2, 0x15, 0x8e, 0xb2, 0x0a, 0x47, 0x0d, 0x21, 0xf8, 0x8f, 0x98,
-	0xa7, 0x34, 0xd8, 0x4c, 0x25, 0xbd, 0xd0, 0x5a, 0xd8, 0x04, 0x45, 0x9d, 0x21, 0xde, 0x96, 0xf1,
-	0x9c, 0xa0, 0xa9, 0x40, 0xc9, 0x76, 0xd6, 0xdb, 0xeb, 0x21, 0xa6, 0xe4, 0xe2, 0x4e, 0x60, 0xcc,
-	0xb0, 0x69, 0xb7, 0x07, 0x23, 0xde, 0xb6, 0xc1, 0x7c, 0x8a, 0x5c, 0x0a, 0xe6, 0x41, 0x38, 0x2b,
-	0x7c, 0x06, 0x8a, 0x3a, 0x06, 0xbd, 0x1d, 0x83, 0x78, 0x88, 0x4c, 0x26, 0xe6, 0x9a, 0x83, 0x56,
-	0xc2, 0x3a, 0x58, 0xc3, 0x23, 0xe9, 0xbd, 0xe7, 0x16, 0x52, 0x07, 0x5c, 0x1e, 0xb3, 0x36, 0xc1,
-	0x6f, 0xc0, 0xba, 0x49, 0x37, 0x6f, 0xd7, 0xb8, 0x8f, 0x91, 0xcd, 0xba, 0x3c, 0x7e, 0x6b, 0xd4,
-	0x2b, 0x60, 0x93, 0xce, 0xdb, 0x73, 0x2b, 0xe0, 0x82, 0x2f, 0xd7, 0x0a, 0x58, 0x2d, 0x3c, 0x07,
-	0xf7, 0x5c, 0xec, 0x79, 0x65, 0x43, 0x79, 0x84, 0xb2, 0x18, 0xcc, 0x85, 0xc1, 0x23, 0x79, 0x4e,
-	0x6a, 0xb0, 0x06, 0x36, 0xb3, 0xac, 0xf3, 0xa0, 0xcb, 0x97, 0x19, 0xdf, 0xb7, 0x6e, 0x34, 0x98,
-	0xe8, 0xe0, 0xcf, 0xe0, 0x80, 0x45, 0x4c, 0x31, 0xcc, 0x43, 0xcb, 0x0c, 0x47, 0x2c, 0xea, 0x88,
-	0x51, 0x28, 0xd9, 0x0d, 0xf5, 0xde, 0x37, 0x94, 0x07, 0x0b, 0x05, 0xf5, 0xf2, 0xbb, 0x48, 0x9d,
-	0xd6, 0x6c, 0x49, 0xdd, 0x77, 0xfe, 0x96, 0xb1, 0xff, 0x64, 0xdc, 0x2d, 0x76, 0x43, 0x21, 0x06,
-	0x95, 0x0c, 0x3d, 0xb5, 0x13, 0xa7, 0xf1, 0xfb, 0x39, 0xf0, 0x1f, 0x3a, 0xc6, 0xed, 0x2e, 0xbd,
-	0xbd, 0x44, 0xfd, 0xfe, 0xab, 0x37, 0xc5, 0x22, 0xb8, 0x9b, 0xca, 0x57, 0x6f, 0x8a, 0x5b, 0xb0,
-	0x94, 0x7d, 0x96, 0xc9, 0xc6, 0x2e, 0xd8, 0xc9, 0x1a, 0xa1, 0x1a, 0xc7, 0xb4, 0xfa, 0x5b, 0x01,
-	0x94, 0x17, 0xa2, 0x51, 0x3f, 0x3d, 0x8e, 0xdb, 0x94, 0xeb, 0x78, 0xd7, 0x1b, 0xfa, 0xb3, 0x15,
-	0x59, 0x8a, 0x2e, 0x8d, 0xfa, 0x3c, 0x52, 0xc9, 0x38, 0x70, 0xd6, 0x83, 0x2f, 0xc1, 0xd6, 0x54,
-	0x37, 0xdc, 0x03, 0x6b, 0x03, 0x3a, 0x36, 0xef, 0x8b, 0x52, 0xa0, 0x4f, 0xe1, 0x3e, 0x58, 0x1f,
-	0xea, 0x7b, 0x31, 0xa1, 0x5f, 0x0a, 0x6c, 0xa3, 0x7e, 0xf7, 0x69, 0xa1, 0x51, 0xd7, 0x2f, 0x8e,
-	0xdf, 0xff, 0xae, 0x14, 0x7e, 0xf9, 0x3c, 0xdf, 0xf7, 0x67, 0x3c, 0xe8, 0xb9, 0xd7, 0x5a, 0x7b,
-	0xc3, 0x2c, 0xd7, 0xe9, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x17, 0x11, 0x0e, 0x9f, 0xba, 0x0a,
-	0x00, 0x00,
+	// 1078 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xdd, 0x6e, 0x1b, 0x45,
+	0x1c, 0xc5, 0xeb, 0xc6, 0x49, 0xe3, 0x49, 0x42, 0xe2, 0xc1, 0x24, 0xab, 0x50, 0x92, 0x28, 0x48,
+	0x34, 0x14, 0x65, 0x97, 0x3a, 0x42, 0x2d, 0x41, 0x45, 0xc8, 0x4e, 0x50, 0x50, 0x53, 0x40, 0x6b,
+	0xb5, 0x08, 0x6e, 0x56, 0xe3, 0xd9, 0xf1, 0x7a, 0xf0, 0x64, 0x67, 0xb5, 0x33, 0x6b, 0xc7, 0xb9,
+	0xec, 0x2b, 0xf0, 0x00, 0xdc, 0xf2, 0x08, 0x3c, 0x02, 0x4f, 0xd1, 0x0b, 0xde, 0xa0, 0x48, 0xdc,
+	0xa3, 0xf9, 0x58, 0xc7, 0x1f, 0x75, 0xbd, 0x5c, 0xd8, 0xde, 0xff, 0xcc, 0x39, 0x3f, 0xcf, 0xfe,
+	0x3d, 0x7b, 0xc6, 0xe0, 0xab, 0x88, 0xca, 0x6e, 0xd6, 0x76, 0x31, 0xbf, 0xf2, 0x04, 0x67, 0xfc,
+	0x98, 0x72, 0x2f, 0x62, 0x9c, 0x7b, 0x49, 0xca, 0x7f, 0x25, 0x58, 0x0a, 0x53, 0xa1, 0x84, 0x7a,
+	0xfd, 0x47, 0x5e, 0x96, 0x08, 0x99, 0x12, 0x74, 0xe5, 0x26, 0x29, 0x97, 0x1c, 0xae, 0xab, 0x39,
+	0x57, 0xd9, 0x5c, 0xca, 0x77, 0x6b, 0x11, 0x8f, 0xb8, 0x9e, 0xf0, 0xd4, 0x95, 0xd1, 0xec, 0x42,
+	0x72, 0x2d, 0xcd, 0x20, 0xb9, 0x96, 0x76, 0x6c, 0x4f, 0x7f, 0x53, 0x8f, 0xca, 0x9c, 0x7b, 0x45,
+	0x24, 0x0a, 0x91, 0x44, 0x76, 0xfe, 0xe3, 0xf9, 0x2b, 0x10, 0x82, 0x59, 0xd1, 0x3b, 0x96, 0x89,
+	0x69, 0x8a, 0x33, 0x2a, 0x83, 0x76, 0x4a, 0x50, 0x8f, 0xa4, 0xd6, 0x70, 0x3c, 0xdf, 0xc0, 0x38,
+	0x0a, 0x83, 0x36, 0x62, 0x28, 0xc6, 0x23, 0xf9, 0xc3, 0x77, 0xf0, 0x79, 0x1c, 0x13, 0x2c, 0x29,
+	0x8f, 0xad, 0xf6, 0x6c, 0x8e, 0x96, 0x5c, 0x4b, 0x92, 0xc6, 0x88, 0x79, 0x24, 0xee, 0xf3, 0xa1,
+	0xb1, 0xd7, 0x3d, 0xcc, 0x53, 0xe2, 0x75, 0x09, 0x62, 0xb2, 0x1b, 0xe0, 0x2e, 0xc1, 0x3d, 0x4b,
+	0xb9, 0x3f, 0xdd, 0x16, 0x21, 0x91, 0xcc, 0x84, 0x9d, 0xbd, 0xfc, 0x7f, 0xdf, 0xc1, 0x32, 0x21,
+	0x49, 0xea, 0xf1, 0x4c, 0x32, 0x4a, 0xd2, 0x20, 0x24, 0x72, 0x62, 0xc5, 0x33, 0x3f, 0x41, 0x5e,
+	0xdb, 0xf9, 0x2f, 0xe6, 0xdf, 0x3d, 0x4f, 0x14, 0x47, 0xe8, 0xd5, 0x51, 0x6c, 0x3f, 0xac, 0xed,
+	0xd1, 0x62, 0x5b, 0x42, 0x13, 0xa2, 0xdf, 0xac, 0xe5, 0xe9, 0x62, 0x4b, 0x2f, 0x6b, 0x93, 0x34,
+	0x26, 0x92, 0x8c, 0x5f, 0x2e, 0xde, 0x06, 0xb9, 0x1d, 0x0d, 0xf4, 0xcb, 0x1a, 0x4e, 0x0a, 0x18,
+	0x6e, 0xb2, 0x94, 0x98, 0xf7, 0xe2, 0xed, 0xc0, 0x3c, 0x16, 0x19, 0xb3, 0x1f, 0xd6, 0xf6, 0xb8,
+	0xd8, 0xe2, 0x08, 0xae, 0xab, 0xcf, 0x80, 0xe0, 0x7a, 0xf1, 0xbb, 0x8a, 0x70, 0xa2, 0x5e, 0xc5,
+	0x0d, 0xa1, 0x79, 0x59, 0xc3, 0x83, 0x85, 0x06, 0x2b, 0x3c, 0x9a, 0x2f, 0xec, 0x20, 0xca, 0x78,
+	0x7f, 0xf4, 0xc4, 0xec, 0x45, 0x9c, 0x47, 0x8c, 0x78, 0xba, 0x6a, 0x67, 0x1d, 0x6f, 0x90, 0xa2,
+	0x24, 0x21, 0xa9, 0x25, 0x1d, 0xfe, 0xbe, 0x01, 0x56, 0x5f, 0xd8, 0x04, 0x81, 0xcf, 0xc0, 0x8a,
+	0xd9, 0xde, 0x4e, 0xe9, 0xa0, 0x74, 0xb4, 0x56, 0xaf, 0xb9, 0xea, 0xb1, 0xc8, 0xc3, 0xc4, 0x6d,
+	0xe9, 0xb9, 0xc6, 0x47, 0x7f, 0xfe, 0x5b, 0x2e, 0xfd, 0xf5, 0x7a, 0xff, 0xce, 0x3f, 0xaf, 0xf7,
+	0xab, 0x92, 0x08, 0x19, 0xd2, 0x4e, 0xe7, 0xf4, 0x90, 0x46, 0x31, 0x4f, 0xc9, 0xa1, 0x6f, 0x11,
+	0xf0, 0x09, 0x58, 0xcd, 0x23, 0xc4, 0xb9, 0xab, 0x71, 0xdb, 0x93, 0xb8, 0xe7, 0x76, 0xb6, 0x51,
+	0x56, 0x30, 0x7f, 0xa4, 0x86, 0xdf, 0x03, 0x18, 0x52, 0x81, 0xd5, 0x5d, 0x0c, 0x83, 0x11, 0x63,
+	0x49, 0x33, 0xf6, 0xdd, 0xf1, 0x7c, 0x73, 0xcf, 0x72, 0x5d, 0x0e, 0xf3, 0xab, 0xe1, 0xf4, 0x10,
+	0xfc, 0x1a, 0x00, 0x21, 0x58, 0x80, 0x79, 0xdc, 0xa1, 0x91, 0x53, 0x7e, 0x1b, 0x27, 0x6f, 0x41,
+	0x4b, 0xb0, 0xa6, 0x96, 0xf9, 0x15, 0x91, 0x5f, 0xc2, 0xe7, 0x60, 0x6b, 0x2a, 0xbd, 0x84, 0xb3,
+	0xac, 0x29, 0x87, 0x93, 0x94, 0xa6, 0x51, 0x35, 0x8c, 0xc8, 0x82, 0x36, 0xf1, 0xc4, 0xa8, 0x80,
+	0x3e, 0xa8, 0x4d, 0x64, 0x5b, 0xbe, 0xb0, 0x15, 0x8d, 0x3c, 0x98, 0x44, 0x5e, 0x72, 0x14, 0x36,
+	0xac, 0xd0, 0x02, 0x21, 0x9b, 0x19, 0x83, 0xcf, 0x40, 0xf5, 0x36, 0x00, 0x73, 0xe0, 0x3d, 0x0d,
+	0xdc, 0x9b, 0x5a, 0xe3, 0x48, 0x66, 0x71, 0x5b, 0x78, 0x6a, 0x04, 0x36, 0xc1, 0xc6, 0x78, 0x12,
+	0x0a, 0x67, 0xf5, 0x60, 0x49, 0x83, 0x74, 0x9a, 0xb9, 0x28, 0xa1, 0x6e, 0xbf, 0x6e, 0x7e, 0xcb,
+	0x0b, 0xad, 0x6b, 0x2a, 0x99, 0xbf, 0xde, 0xbd, 0x2d, 0x04, 0x6c, 0x81, 0xea, 0x4c, 0xce, 0x39,
+	0x15, 0xbd, 0xa2, 0x4f, 0xa6, 0x40, 0x26, 0x16, 0xdd, 0x1f, 0x8c, 0xfc, 0x2c, 0x57, 0xfb, 0x5b,
+	0x7c, 0x6a, 0x04, 0x3e, 0x06, 0x95, 0x4c, 0x90, 0xa0, 0x2b, 0x65, 0x52, 0x77, 0x80, 0x86, 0xed,
+	0xba, 0x66, 0x87, 0xbb, 0xf9, 0x0e, 0x77, 0x1b, 0x9c, 0xb3, 0x97, 0x88, 0x65, 0xc4, 0x5f, 0xcd,
+	0x04, 0xb9, 0x50, 0x5a, 0xd8, 0x04, 0x65, 0x95, 0x52, 0xce, 0x9a, 0xf6, 0x1c, 0xbb, 0x63, 0x91,
+	0x95, 0x3f, 0x59, 0x6f, 0xdf, 0x0f, 0x09, 0xc1, 0x17, 0x77, 0x7c, 0x6d, 0x86, 0x4d, 0xf3, 0x78,
+	0x50, 0xec, 0xac, 0x6b, 0xcc, 0xa7, 0xae, 0x29, 0x0b, 0x21, 0xac, 0x15, 0x3e, 0x05, 0xe5, 0x84,
+	0x26, 0xc4, 0xd9, 0xd0, 0x88, 0x07, 0xae, 0x2a, 0x8a, 0xad, 0x41, 0x29, 0xe1, 0x29, 0x58, 0x42,
+	0x03, 0xe1, 0xbc, 0x67, 0x1b, 0xa9, 0x22, 0xb4, 0x88, 0x59, 0x99, 0xe0, 0x37, 0x60, 0x59, 0xe7,
+	0xa7, 0xb3, 0xa9, 0xdd, 0x47, 0xae, 0xae, 0x0a, 0xf9, 0x8d, 0x51, 0x75, 0xc0, 0x64, 0xa9, 0xb3,
+	0x65, 0x3b, 0x60, 0xca, 0x62, 0x1d, 0x30, 0x5a, 0x78, 0x0e, 0xee, 0xd9, 0x60, 0x75, 0xaa, 0x9a,
+	0xf2, 0xd0, 0xb5, 0x75, 0x31, 0x0c, 0x1a, 0x88, 0x73, 0x5c, 0x57, 0x9d, 0x88, 0x70, 0xe2, 0x7c,
+	0x60, 0x3b, 0xa1, 0x62, 0xb7, 0x50, 0x27, 0x22, 0x9c, 0x28, 0x6f, 0x18, 0x0b, 0x67, 0xdb, 0x7a,
+	0x55, 0x02, 0x17, 0xf2, 0x86, 0xb1, 0x80, 0x75, 0xb0, 0x9a, 0x67, 0xac, 0x03, 0x6d, 0xae, 0x4d,
+	0x98, 0xbe, 0xb5, 0xb3, 0xfe, 0x48, 0x07, 0x7f, 0x06, 0xbb, 0x34, 0xa6, 0x92, 0x22, 0x16, 0x18,
+	0x60, 0x30, 0xa0, 0x71, 0xc8, 0x07, 0x81, 0xa0, 0x37, 0xc4, 0x79, 0x5f, 0x53, 0xee, 0xcf, 0x6c,
+	0xe4, 0x17, 0xdf, 0xc5, 0xf2, 0xa4, 0x6e, 0xb6, 0xf2, 0x8e, 0xf5, 0xb7, 0xb4, 0xfd, 0x27, 0xed,
+	0x6e, 0xd1, 0x1b, 0x02, 0x11, 0xd8, 0xcb, 0xd1, 0x63, 0x09, 0x30, 0x8e, 0xaf, 0x15, 0xc0, 0x7f,
+	0x68, 0x19, 0xb7, 0xe9, 0x30, 0xf6, 0x15, 0x3e, 0xd8, 0x4e, 0x52, 0x7e, 0x3d, 0x0c, 0xb4, 0x15,
+	0x73, 0x16, 0xf4, 0x49, 0x2a, 0xd4, 0xf3, 0xbc, 0x33, 0x07, 0xdd, 0x92, 0x29, 0x8d, 0x23, 0x83,
+	0xae, 0x69, 0xef, 0x8f, 0xd6, 0xfa, 0xd2, 0x38, 0x4f, 0x77, 0x5e, 0xbd, 0x29, 0x97, 0xc1, 0xdd,
+	0x4c, 0xbc, 0x7a, 0x53, 0x5e, 0x83, 0x95, 0xfc, 0x4f, 0xac, 0x68, 0x6c, 0x82, 0x8d, 0xbc, 0x08,
+	0xe4, 0x30, 0x21, 0x87, 0xbf, 0x95, 0x40, 0x75, 0x26, 0xe6, 0xd5, 0x4e, 0x64, 0xa8, 0x4d, 0x98,
+	0x3a, 0xaa, 0x54, 0x38, 0x7d, 0xb6, 0xe0, 0x5c, 0x70, 0x2f, 0xb5, 0xfa, 0x3c, 0x96, 0xe9, 0xd0,
+	0xb7, 0xd6, 0xdd, 0x2f, 0xc1, 0xda, 0xd8, 0x30, 0xdc, 0x02, 0x4b, 0x3d, 0x32, 0xd4, 0x67, 0x5f,
+	0xc5, 0x57, 0x97, 0xb0, 0x06, 0x96, 0xfb, 0xea, 0x26, 0xf4, 0x01, 0x56, 0xf1, 0x4d, 0x71, 0x7a,
+	0xf7, 0x49, 0xa9, 0x71, 0xaa, 0x0e, 0xc1, 0x3f, 0xfe, 0xde, 0x2b, 0xfd, 0xf2, 0x79, 0xb1, 0x7f,
+	0xeb, 0x49, 0x2f, 0xb2, 0x47, 0x74, 0x7b, 0x45, 0xf7, 0xe9, 0xe4, 0xbf, 0x01, 0x00, 0x14, 0x19,
+	0xa5, 0x2f, 0xe8, 0x0b, 0x00, 0x00,
 }
 
 func (this *Upstream) Equal(that interface{}) bool {
@@ -503,6 +545,9 @@ func (this *Upstream) Equal(that interface{}) bool {
 	if !this.InitialConnectionWindowSize.Equal(that1.InitialConnectionWindowSize) {
 		return false
 	}
+	if !this.ProxyProtocolVersion.Equal(that1.ProxyProtocolVersion) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -676,6 +721,54 @@ func (this *Upstream_AwsEc2) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Upstream_Gcp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Upstream_Gcp)
+	if !ok {
+		that2, ok := that.(Upstream_Gcp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Gcp.Equal(that1.Gcp) {
+		return false
+	}
+	return true
+}
+func (this *Upstream_Dns) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Upstream_Dns)
+	if !ok {
+		that2, ok := that.(Upstream_Dns)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Dns.Equal(that1.Dns) {
+		return false
+	}
+	return true
+}
 func (this *DiscoveryMetadata) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
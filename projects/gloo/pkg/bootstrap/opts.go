@@ -5,8 +5,10 @@ import (
 	"net"
 	"time"
 
+	"github.com/solo-io/gloo/projects/gloo/pkg/dns"
 	"github.com/solo-io/gloo/projects/gloo/pkg/validation"
 
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/docker"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -16,6 +18,7 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/server"
 	skkube "github.com/solo-io/solo-kit/pkg/api/v1/resources/common/kubernetes"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"google.golang.org/grpc"
 	"k8s.io/client-go/kubernetes"
 )
@@ -33,6 +36,7 @@ type Opts struct {
 	RateLimitConfigs  factory.ResourceClientFactory
 	KubeClient        kubernetes.Interface
 	Consul            Consul
+	Docker            Docker
 	WatchOpts         clients.WatchOpts
 	DevMode           bool
 	ControlPlane      ControlPlane
@@ -45,6 +49,27 @@ type Consul struct {
 	ConsulWatcher      consul.ConsulWatcher
 	DnsServer          string
 	DnsPollingInterval *time.Duration
+	// resolve the addresses of service instances; created once, so that their caches outlive a translation
+	DnsResolvers *dns.Resolvers
+	// if set, requests to upstreams with a Connect sidecar are routed to the sidecar proxies
+	Connect *ConsulConnect
+	// resolves the ACL tokens referenced by Consul upstreams
+	TokenResolver consul.TokenResolver
+}
+
+type Docker struct {
+	// if nil, upstreams are not discovered from Docker containers
+	Client docker.Client
+	// the network the addresses of discovered containers are taken from
+	Network string
+}
+
+type ConsulConnect struct {
+	Agent consul.ConnectAgent
+	// the service Gloo requests its Connect leaf certificate for
+	ServiceName string
+	// the in-memory secret holding the Connect certificates of Gloo
+	SecretRef core.ResourceRef
 }
 
 type ControlPlane struct {
@@ -1,7 +1,23 @@
 package discovery
 
+import (
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
 type Opts struct {
 	KubeOpts struct {
 		IgnoredServices []string
+		// if set, only the services in these namespaces are discovered
+		IncludedNamespaces []string
+		// the services in these namespaces are not discovered
+		ExcludedNamespaces []string
+		// if set, only the services matching the selector are discovered
+		Selector labels.Selector
+		// if set, the names of the discovered upstreams are rendered with this template
+		UpstreamNameTemplate *template.Template
+		// labels added to the discovered upstreams, whose values are rendered with these templates
+		UpstreamLabelTemplates map[string]*template.Template
 	}
 }
@@ -0,0 +1,159 @@
+package dns
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	// How long the plugins cache names which have no records
+	DefaultNegativeTtl = 5 * time.Second
+	// Expired entries are only removed from the cache once it holds this many entries
+	sweepThreshold = 1024
+)
+
+type CacheOptions struct {
+	// How long results are cached when the resolver does not report the TTLs of their records.
+	// If zero, these results are not cached.
+	DefaultTtl time.Duration
+	// Upper bound for the TTLs reported by the resolver. If zero, the reported TTLs are used as is.
+	MaxTtl time.Duration
+	// How long names without records of the requested type are cached. If zero, these results are not cached.
+	// Errors other than missing records are never cached.
+	NegativeTtl time.Duration
+}
+
+// Returns a resolver which caches the results of the given resolver. When the resolver reports the TTLs of the
+// records (see TtlResolver), results are cached for as long as their records are valid.
+func NewCachingResolver(resolver Resolver, opts CacheOptions) Resolver {
+	return &cachingResolver{
+		resolver: resolver,
+		opts:     opts,
+		now:      time.Now,
+		entries:  make(map[cacheKey]cacheEntry),
+	}
+}
+
+type cacheKey struct {
+	name string
+	srv  bool
+}
+
+type cacheEntry struct {
+	ipAddrs []net.IPAddr
+	srvs    []*net.SRV
+	err     error
+	expires time.Time
+}
+
+type cachingResolver struct {
+	resolver Resolver
+	opts     CacheOptions
+	now      func() time.Time
+
+	lock    sync.Mutex
+	entries map[cacheKey]cacheEntry
+}
+
+func (r *cachingResolver) Resolve(ctx context.Context, host string) ([]net.IPAddr, error) {
+	key := cacheKey{name: host}
+	if entry, ok := r.get(key); ok {
+		return append([]net.IPAddr(nil), entry.ipAddrs...), entry.err
+	}
+
+	var (
+		ipAddrs []net.IPAddr
+		ttl     = r.opts.DefaultTtl
+		err     error
+	)
+	if ttlResolver, ok := r.resolver.(TtlResolver); ok {
+		ipAddrs, ttl, err = ttlResolver.ResolveWithTtl(ctx, host)
+		ttl = r.capTtl(ttl)
+	} else {
+		ipAddrs, err = r.resolver.Resolve(ctx, host)
+	}
+	r.store(key, cacheEntry{ipAddrs: ipAddrs, err: err}, ttl)
+	return append([]net.IPAddr(nil), ipAddrs...), err
+}
+
+func (r *cachingResolver) ResolveSRV(ctx context.Context, name string) ([]*net.SRV, error) {
+	key := cacheKey{name: name, srv: true}
+	if entry, ok := r.get(key); ok {
+		return copySRV(entry.srvs), entry.err
+	}
+
+	var (
+		srvs []*net.SRV
+		ttl  = r.opts.DefaultTtl
+		err  error
+	)
+	if ttlResolver, ok := r.resolver.(TtlResolver); ok {
+		srvs, ttl, err = ttlResolver.ResolveSRVWithTtl(ctx, name)
+		ttl = r.capTtl(ttl)
+	} else {
+		srvs, err = r.resolver.ResolveSRV(ctx, name)
+	}
+	r.store(key, cacheEntry{srvs: copySRV(srvs), err: err}, ttl)
+	return srvs, err
+}
+
+func (r *cachingResolver) capTtl(ttl time.Duration) time.Duration {
+	if r.opts.MaxTtl > 0 && ttl > r.opts.MaxTtl {
+		return r.opts.MaxTtl
+	}
+	return ttl
+}
+
+func (r *cachingResolver) get(key cacheKey) (cacheEntry, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	entry, ok := r.entries[key]
+	if !ok {
+		return cacheEntry{}, false
+	}
+	if !r.now().Before(entry.expires) {
+		delete(r.entries, key)
+		return cacheEntry{}, false
+	}
+	return entry, true
+}
+
+func (r *cachingResolver) store(key cacheKey, entry cacheEntry, ttl time.Duration) {
+	if entry.err != nil {
+		if !IsNotFound(entry.err) {
+			return
+		}
+		ttl = r.opts.NegativeTtl
+	}
+	if ttl <= 0 {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	now := r.now()
+	if len(r.entries) >= sweepThreshold {
+		for k, e := range r.entries {
+			if !now.Before(e.expires) {
+				delete(r.entries, k)
+			}
+		}
+	}
+	entry.expires = now.Add(ttl)
+	r.entries[key] = entry
+}
+
+// the records are copied, so that callers can't modify the cached ones
+func copySRV(srvs []*net.SRV) []*net.SRV {
+	if srvs == nil {
+		return nil
+	}
+	out := make([]*net.SRV, 0, len(srvs))
+	for _, srv := range srvs {
+		srvCopy := *srv
+		out = append(out, &srvCopy)
+	}
+	return out
+}
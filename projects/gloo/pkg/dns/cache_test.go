@@ -0,0 +1,124 @@
+package dns
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	mock_dns "github.com/solo-io/gloo/projects/gloo/pkg/dns/mocks"
+)
+
+var _ = Describe("Caching resolver", func() {
+
+	var (
+		ctx  context.Context
+		ctrl *gomock.Controller
+		now  time.Time
+
+		ipAddrs  = []net.IPAddr{{IP: net.IPv4(10, 0, 0, 1)}}
+		notFound = &net.DNSError{Err: "no such host", Name: "missing.example.com", IsNotFound: true}
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(T)
+		now = time.Now()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newCachingResolver := func(resolver Resolver, opts CacheOptions) Resolver {
+		cache := NewCachingResolver(resolver, opts)
+		cache.(*cachingResolver).now = func() time.Time { return now }
+		return cache
+	}
+
+	It("caches results for the TTL of their records", func() {
+		resolver := mock_dns.NewMockTtlResolver(ctrl)
+		resolver.EXPECT().ResolveWithTtl(ctx, "my-host.example.com").Return(ipAddrs, 30*time.Second, nil).Times(2)
+		cache := newCachingResolver(resolver, CacheOptions{DefaultTtl: time.Hour})
+
+		for i := 0; i < 2; i++ {
+			result, err := cache.Resolve(ctx, "my-host.example.com")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ipAddrs))
+		}
+
+		now = now.Add(30 * time.Second)
+		_, err := cache.Resolve(ctx, "my-host.example.com")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("caps the TTL of the records", func() {
+		resolver := mock_dns.NewMockTtlResolver(ctrl)
+		resolver.EXPECT().ResolveSRVWithTtl(ctx, "my-svc.service.consul").Return([]*net.SRV{{Target: "node-a.", Port: 1234}}, time.Hour, nil).Times(2)
+		cache := newCachingResolver(resolver, CacheOptions{MaxTtl: time.Minute})
+
+		_, err := cache.ResolveSRV(ctx, "my-svc.service.consul")
+		Expect(err).NotTo(HaveOccurred())
+		now = now.Add(time.Minute)
+		_, err = cache.ResolveSRV(ctx, "my-svc.service.consul")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("caches results without TTLs for the default TTL", func() {
+		resolver := mock_dns.NewMockResolver(ctrl)
+		resolver.EXPECT().Resolve(ctx, "my-host.example.com").Return(ipAddrs, nil).Times(1)
+		cache := newCachingResolver(resolver, CacheOptions{DefaultTtl: time.Minute})
+
+		for i := 0; i < 2; i++ {
+			_, err := cache.Resolve(ctx, "my-host.example.com")
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	It("does not cache records without a TTL", func() {
+		resolver := mock_dns.NewMockTtlResolver(ctrl)
+		resolver.EXPECT().ResolveWithTtl(ctx, "my-host.example.com").Return(ipAddrs, time.Duration(0), nil).Times(2)
+		cache := newCachingResolver(resolver, CacheOptions{DefaultTtl: time.Minute})
+
+		for i := 0; i < 2; i++ {
+			_, err := cache.Resolve(ctx, "my-host.example.com")
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	It("caches names without records for the negative TTL", func() {
+		resolver := mock_dns.NewMockResolver(ctrl)
+		resolver.EXPECT().Resolve(ctx, "missing.example.com").Return(nil, notFound).Times(2)
+		cache := newCachingResolver(resolver, CacheOptions{NegativeTtl: 5 * time.Second})
+
+		for i := 0; i < 2; i++ {
+			_, err := cache.Resolve(ctx, "missing.example.com")
+			Expect(IsNotFound(err)).To(BeTrue())
+		}
+
+		now = now.Add(5 * time.Second)
+		_, err := cache.Resolve(ctx, "missing.example.com")
+		Expect(IsNotFound(err)).To(BeTrue())
+	})
+
+	It("does not cache other errors", func() {
+		resolver := mock_dns.NewMockResolver(ctrl)
+		resolver.EXPECT().Resolve(ctx, "my-host.example.com").Return(nil, eris.New("timeout")).Times(2)
+		cache := newCachingResolver(resolver, CacheOptions{DefaultTtl: time.Minute, NegativeTtl: time.Minute})
+
+		for i := 0; i < 2; i++ {
+			_, err := cache.Resolve(ctx, "my-host.example.com")
+			Expect(err).To(MatchError("timeout"))
+		}
+	})
+
+	It("shares a cache between the upstreams with the same nameservers", func() {
+		resolvers := NewResolvers(nil, CacheOptions{})
+		Expect(resolvers.ForNameservers(nil)).To(BeNil())
+		Expect(resolvers.ForNameservers([]string{"10.0.0.53"})).To(BeIdenticalTo(resolvers.ForNameservers([]string{"10.0.0.53"})))
+		Expect(resolvers.ForNameservers([]string{"10.0.0.53"})).NotTo(BeIdenticalTo(resolvers.ForNameservers([]string{"10.0.0.54"})))
+	})
+})
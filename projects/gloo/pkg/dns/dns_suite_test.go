@@ -0,0 +1,16 @@
+package dns
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var T *testing.T
+
+func TestDns(t *testing.T) {
+	RegisterFailHandler(Fail)
+	T = t
+	RunSpecs(t, "DNS Suite")
+}
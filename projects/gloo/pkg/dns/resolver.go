@@ -0,0 +1,328 @@
+package dns
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+//go:generate mockgen -destination ./mocks/resolver_mock.go -source resolver.go
+
+const (
+	DefaultPort = "53"
+	// how long to wait for a nameserver to answer a query, unless the context of the query expires earlier
+	queryTimeout = 5 * time.Second
+)
+
+var (
+	NoNameserversRespondedErr = func(name string, errs []error) error {
+		return eris.Errorf("none of the nameservers could resolve %s: %v", name, errs)
+	}
+	InvalidNameserverErr = func(nameserver string) error {
+		return eris.Errorf("invalid nameserver %s, expected an IP address with an optional port", nameserver)
+	}
+	UnexpectedResponseCodeErr = func(name string, code dnsmessage.RCode) error {
+		return eris.Errorf("nameserver responded to the query for %s with %v", name, code)
+	}
+)
+
+// Resolves the hostnames of upstream endpoints
+type Resolver interface {
+	// Resolve returns the IP addresses of the given host
+	Resolve(ctx context.Context, host string) ([]net.IPAddr, error)
+	// ResolveSRV returns the SRV records for the given name, sorted by priority and randomized by weight
+	ResolveSRV(ctx context.Context, name string) ([]*net.SRV, error)
+}
+
+// Resolvers which know how long their results are valid for, as set by the TTLs of the DNS records.
+// The caching resolver uses these TTLs when they are available.
+type TtlResolver interface {
+	Resolver
+	// ResolveWithTtl returns the IP addresses of the given host, and the lowest TTL of their records
+	ResolveWithTtl(ctx context.Context, host string) ([]net.IPAddr, time.Duration, error)
+	// ResolveSRVWithTtl returns the SRV records for the given name, and the lowest TTL of the records
+	ResolveSRVWithTtl(ctx context.Context, name string) ([]*net.SRV, time.Duration, error)
+}
+
+// Returns a resolver which queries the given nameservers, in order, until one of them answers. Nameservers are
+// addresses with an optional port, which defaults to 53. Names are resolved as fully qualified names.
+// Without nameservers, the resolver of the system is used, which does not report TTLs.
+func NewResolver(nameservers ...string) Resolver {
+	if len(nameservers) == 0 {
+		return &systemResolver{}
+	}
+	var addresses []string
+	for _, nameserver := range nameservers {
+		addresses = append(addresses, nameserverAddress(nameserver))
+	}
+	return &nameserverResolver{nameservers: addresses}
+}
+
+// IsNotFound returns true if the error is due to the name not having any records of the requested type
+func IsNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
+
+// SplitNameserver returns the IP address and the port of a nameserver, given as an IP address with an optional port
+func SplitNameserver(nameserver string) (net.IP, uint32, error) {
+	host, port, err := net.SplitHostPort(nameserverAddress(nameserver))
+	if err != nil {
+		return nil, 0, err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return nil, 0, InvalidNameserverErr(nameserver)
+	}
+	portNumber, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return nil, 0, InvalidNameserverErr(nameserver)
+	}
+	return ip, uint32(portNumber), nil
+}
+
+func nameserverAddress(nameserver string) string {
+	if _, _, err := net.SplitHostPort(nameserver); err == nil {
+		return nameserver
+	}
+	return net.JoinHostPort(strings.Trim(nameserver, "[]"), DefaultPort)
+}
+
+type systemResolver struct{}
+
+func (r *systemResolver) Resolve(ctx context.Context, host string) ([]net.IPAddr, error) {
+	return net.DefaultResolver.LookupIPAddr(ctx, host)
+}
+
+func (r *systemResolver) ResolveSRV(ctx context.Context, name string) ([]*net.SRV, error) {
+	// the name is looked up as is, as it is already the full name of the record (e.g. my-svc.service.consul)
+	_, srvs, err := net.DefaultResolver.LookupSRV(ctx, "", "", name)
+	return srvs, err
+}
+
+type nameserverResolver struct {
+	nameservers []string
+}
+
+func (r *nameserverResolver) Resolve(ctx context.Context, host string) ([]net.IPAddr, error) {
+	ipAddrs, _, err := r.ResolveWithTtl(ctx, host)
+	return ipAddrs, err
+}
+
+func (r *nameserverResolver) ResolveSRV(ctx context.Context, name string) ([]*net.SRV, error) {
+	srvs, _, err := r.ResolveSRVWithTtl(ctx, name)
+	return srvs, err
+}
+
+func (r *nameserverResolver) ResolveWithTtl(ctx context.Context, host string) ([]net.IPAddr, time.Duration, error) {
+	if ip := net.ParseIP(host); ip != nil {
+		return []net.IPAddr{{IP: ip}}, 0, nil
+	}
+
+	var (
+		ipAddrs []net.IPAddr
+		ttl     = noTtl
+	)
+	for _, queryType := range []dnsmessage.Type{dnsmessage.TypeA, dnsmessage.TypeAAAA} {
+		answers, err := r.query(ctx, host, queryType)
+		if IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, answer := range answers {
+			switch body := answer.Body.(type) {
+			case *dnsmessage.AResource:
+				ipAddrs = append(ipAddrs, net.IPAddr{IP: net.IP(body.A[:])})
+			case *dnsmessage.AAAAResource:
+				ipAddrs = append(ipAddrs, net.IPAddr{IP: net.IP(body.AAAA[:])})
+			default:
+				continue
+			}
+			ttl = minTtl(ttl, answer.Header.TTL)
+		}
+	}
+	if len(ipAddrs) == 0 {
+		return nil, 0, notFoundErr(host)
+	}
+	return ipAddrs, ttl, nil
+}
+
+func (r *nameserverResolver) ResolveSRVWithTtl(ctx context.Context, name string) ([]*net.SRV, time.Duration, error) {
+	answers, err := r.query(ctx, name, dnsmessage.TypeSRV)
+	if err != nil {
+		return nil, 0, err
+	}
+	var srvs []*net.SRV
+	ttl := noTtl
+	for _, answer := range answers {
+		body, ok := answer.Body.(*dnsmessage.SRVResource)
+		if !ok {
+			continue
+		}
+		srvs = append(srvs, &net.SRV{
+			Target:   body.Target.String(),
+			Port:     body.Port,
+			Priority: body.Priority,
+			Weight:   body.Weight,
+		})
+		ttl = minTtl(ttl, answer.Header.TTL)
+	}
+	if len(srvs) == 0 {
+		return nil, 0, notFoundErr(name)
+	}
+	sortSRV(srvs)
+	return srvs, ttl, nil
+}
+
+// Queries the nameservers in order, and returns the answers of the first one which responds
+func (r *nameserverResolver) query(ctx context.Context, name string, queryType dnsmessage.Type) ([]dnsmessage.Resource, error) {
+	fqdn := name
+	if !strings.HasSuffix(fqdn, ".") {
+		fqdn += "."
+	}
+	question, err := dnsmessage.NewName(fqdn)
+	if err != nil {
+		return nil, eris.Wrapf(err, "invalid name %s", name)
+	}
+
+	var errs []error
+	for _, nameserver := range r.nameservers {
+		answers, err := exchange(ctx, nameserver, question, queryType)
+		if err == nil || IsNotFound(err) {
+			return answers, err
+		}
+		errs = append(errs, err)
+	}
+	return nil, NoNameserversRespondedErr(name, errs)
+}
+
+// Sends a single query to the nameserver. DNS typically uses UDP and falls back to TCP if the response size is greater
+// than one packet (originally 512 bytes). we use TCP to ensure we receive all the records in a large DNS response
+func exchange(ctx context.Context, nameserver string, question dnsmessage.Name, queryType dnsmessage.Type) ([]dnsmessage.Resource, error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	id := uint16(rand.Uint32())
+	builder := dnsmessage.NewBuilder(make([]byte, 2, 514), dnsmessage.Header{ID: id, RecursionDesired: true})
+	builder.EnableCompression()
+	if err := builder.StartQuestions(); err != nil {
+		return nil, err
+	}
+	if err := builder.Question(dnsmessage.Question{Name: question, Type: queryType, Class: dnsmessage.ClassINET}); err != nil {
+		return nil, err
+	}
+	query, err := builder.Finish()
+	if err != nil {
+		return nil, err
+	}
+	// messages sent over TCP are prefixed with their length
+	binary.BigEndian.PutUint16(query, uint16(len(query)-2))
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", nameserver)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+	if _, err := conn.Write(query); err != nil {
+		return nil, err
+	}
+	var length [2]byte
+	if _, err := io.ReadFull(conn, length[:]); err != nil {
+		return nil, err
+	}
+	response := make([]byte, binary.BigEndian.Uint16(length[:]))
+	if _, err := io.ReadFull(conn, response); err != nil {
+		return nil, err
+	}
+
+	var msg dnsmessage.Message
+	if err := msg.Unpack(response); err != nil {
+		return nil, err
+	}
+	if msg.Header.ID != id {
+		return nil, eris.Errorf("nameserver %s responded to another query", nameserver)
+	}
+	switch msg.Header.RCode {
+	case dnsmessage.RCodeSuccess:
+	case dnsmessage.RCodeNameError:
+		return nil, notFoundErr(question.String())
+	default:
+		return nil, UnexpectedResponseCodeErr(question.String(), msg.Header.RCode)
+	}
+	if len(msg.Answers) == 0 {
+		return nil, notFoundErr(question.String())
+	}
+	return msg.Answers, nil
+}
+
+func notFoundErr(name string) error {
+	return &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+// used as the TTL of results without records
+const noTtl = time.Duration(-1)
+
+func minTtl(current time.Duration, ttlSeconds uint32) time.Duration {
+	ttl := time.Duration(ttlSeconds) * time.Second
+	if current == noTtl || ttl < current {
+		return ttl
+	}
+	return current
+}
+
+// Sorts the records by priority, and orders the records of the same priority randomly, giving records with a higher
+// weight a higher chance to come first, as described in RFC 2782.
+func sortSRV(srvs []*net.SRV) {
+	sort.SliceStable(srvs, func(i, j int) bool {
+		return srvs[i].Priority < srvs[j].Priority
+	})
+	for start := 0; start < len(srvs); {
+		end := start + 1
+		for end < len(srvs) && srvs[end].Priority == srvs[start].Priority {
+			end++
+		}
+		shuffleByWeight(srvs[start:end])
+		start = end
+	}
+}
+
+func shuffleByWeight(srvs []*net.SRV) {
+	total := 0
+	for _, srv := range srvs {
+		total += int(srv.Weight)
+	}
+	for i := range srvs {
+		if total == 0 {
+			return
+		}
+		pick := rand.Intn(total + 1)
+		sum := 0
+		for j := i; j < len(srvs); j++ {
+			sum += int(srvs[j].Weight)
+			if sum >= pick {
+				if j != i {
+					srvs[i], srvs[j] = srvs[j], srvs[i]
+				}
+				break
+			}
+		}
+		total -= int(srvs[i].Weight)
+	}
+}
@@ -0,0 +1,154 @@
+package dns
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// A nameserver answering the queries for its records over TCP, and with NXDOMAIN for other names
+type fakeNameserver struct {
+	listener net.Listener
+	records  map[dnsmessage.Type]map[string][]dnsmessage.Resource
+}
+
+func newFakeNameserver() *fakeNameserver {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+	ns := &fakeNameserver{listener: listener, records: make(map[dnsmessage.Type]map[string][]dnsmessage.Resource)}
+	go ns.serve()
+	return ns
+}
+
+func (ns *fakeNameserver) addr() string {
+	return ns.listener.Addr().String()
+}
+
+func (ns *fakeNameserver) add(name string, recordType dnsmessage.Type, ttl uint32, body dnsmessage.ResourceBody) {
+	header := dnsmessage.ResourceHeader{Name: dnsmessage.MustNewName(name), Type: recordType, Class: dnsmessage.ClassINET, TTL: ttl}
+	byName, ok := ns.records[recordType]
+	if !ok {
+		byName = make(map[string][]dnsmessage.Resource)
+		ns.records[recordType] = byName
+	}
+	byName[name] = append(byName[name], dnsmessage.Resource{Header: header, Body: body})
+}
+
+func (ns *fakeNameserver) serve() {
+	defer GinkgoRecover()
+	for {
+		conn, err := ns.listener.Accept()
+		if err != nil {
+			return
+		}
+		ns.answer(conn)
+	}
+}
+
+func (ns *fakeNameserver) answer(conn net.Conn) {
+	defer conn.Close()
+	var length [2]byte
+	if _, err := io.ReadFull(conn, length[:]); err != nil {
+		return
+	}
+	query := make([]byte, binary.BigEndian.Uint16(length[:]))
+	_, err := io.ReadFull(conn, query)
+	Expect(err).NotTo(HaveOccurred())
+
+	var msg dnsmessage.Message
+	Expect(msg.Unpack(query)).To(Succeed())
+	question := msg.Questions[0]
+	response := dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: msg.Header.ID, Response: true},
+		Questions: msg.Questions,
+	}
+	answers, ok := ns.records[question.Type][question.Name.String()]
+	if !ok {
+		response.Header.RCode = dnsmessage.RCodeNameError
+	}
+	response.Answers = answers
+
+	packed, err := response.AppendPack(make([]byte, 2, 514))
+	Expect(err).NotTo(HaveOccurred())
+	binary.BigEndian.PutUint16(packed, uint16(len(packed)-2))
+	_, err = conn.Write(packed)
+	Expect(err).NotTo(HaveOccurred())
+}
+
+var _ = Describe("Resolver", func() {
+
+	var (
+		ctx        context.Context
+		nameserver *fakeNameserver
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		nameserver = newFakeNameserver()
+	})
+
+	AfterEach(func() {
+		nameserver.listener.Close()
+	})
+
+	It("resolves the addresses of a host with their lowest TTL", func() {
+		nameserver.add("my-host.example.com.", dnsmessage.TypeA, 30, &dnsmessage.AResource{A: [4]byte{10, 0, 0, 1}})
+		nameserver.add("my-host.example.com.", dnsmessage.TypeA, 10, &dnsmessage.AResource{A: [4]byte{10, 0, 0, 2}})
+
+		resolver := NewResolver(nameserver.addr()).(TtlResolver)
+		ipAddrs, ttl, err := resolver.ResolveWithTtl(ctx, "my-host.example.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ipAddrs).To(Equal([]net.IPAddr{{IP: net.IP{10, 0, 0, 1}}, {IP: net.IP{10, 0, 0, 2}}}))
+		Expect(ttl).To(Equal(10 * time.Second))
+	})
+
+	It("resolves SRV records in the order of their priority", func() {
+		target := dnsmessage.MustNewName("node-a.node.dc1.consul.")
+		nameserver.add("my-svc.service.consul.", dnsmessage.TypeSRV, 0, &dnsmessage.SRVResource{Target: target, Port: 21346, Priority: 2, Weight: 1})
+		nameserver.add("my-svc.service.consul.", dnsmessage.TypeSRV, 0, &dnsmessage.SRVResource{Target: target, Port: 21345, Priority: 1, Weight: 1})
+
+		srvs, err := NewResolver(nameserver.addr()).ResolveSRV(ctx, "my-svc.service.consul")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(srvs).To(Equal([]*net.SRV{
+			{Target: "node-a.node.dc1.consul.", Port: 21345, Priority: 1, Weight: 1},
+			{Target: "node-a.node.dc1.consul.", Port: 21346, Priority: 2, Weight: 1},
+		}))
+	})
+
+	It("reports names without records as not found", func() {
+		_, err := NewResolver(nameserver.addr()).Resolve(ctx, "missing.example.com")
+		Expect(IsNotFound(err)).To(BeTrue())
+	})
+
+	It("falls back to the next nameserver when one does not respond", func() {
+		nameserver.add("my-host.example.com.", dnsmessage.TypeA, 30, &dnsmessage.AResource{A: [4]byte{10, 0, 0, 1}})
+		unavailable, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unavailable.Close()).To(Succeed())
+
+		ipAddrs, err := NewResolver(unavailable.Addr().String(), nameserver.addr()).Resolve(ctx, "my-host.example.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ipAddrs).To(Equal([]net.IPAddr{{IP: net.IP{10, 0, 0, 1}}}))
+	})
+
+	It("splits nameservers into their IP address and port", func() {
+		ip, port, err := SplitNameserver("10.0.0.1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ip.String()).To(Equal("10.0.0.1"))
+		Expect(port).To(BeEquivalentTo(53))
+
+		ip, port, err = SplitNameserver("[::1]:8600")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ip.String()).To(Equal("::1"))
+		Expect(port).To(BeEquivalentTo(8600))
+
+		_, _, err = SplitNameserver("nameserver.example.com")
+		Expect(err).To(MatchError(InvalidNameserverErr("nameserver.example.com")))
+	})
+})
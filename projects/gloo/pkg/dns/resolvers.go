@@ -0,0 +1,42 @@
+package dns
+
+import (
+	"strings"
+	"sync"
+)
+
+// Provides the resolvers for the nameservers configured on upstreams. A caching resolver is created for every distinct
+// list of nameservers, so that upstreams with the same nameservers share their cache.
+type Resolvers struct {
+	defaultResolver Resolver
+	opts            CacheOptions
+
+	lock          sync.Mutex
+	byNameservers map[string]Resolver
+}
+
+// The default resolver is used for upstreams without nameservers, and is not wrapped in a cache.
+func NewResolvers(defaultResolver Resolver, opts CacheOptions) *Resolvers {
+	return &Resolvers{
+		defaultResolver: defaultResolver,
+		opts:            opts,
+		byNameservers:   make(map[string]Resolver),
+	}
+}
+
+// Returns the resolver querying the given nameservers, or the default resolver if there are none
+func (r *Resolvers) ForNameservers(nameservers []string) Resolver {
+	if len(nameservers) == 0 {
+		return r.defaultResolver
+	}
+
+	key := strings.Join(nameservers, ",")
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	resolver, ok := r.byNameservers[key]
+	if !ok {
+		resolver = NewCachingResolver(NewResolver(nameservers...), r.opts)
+		r.byNameservers[key] = resolver
+	}
+	return resolver
+}
@@ -0,0 +1,13 @@
+package adaptiveconcurrency_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestAdaptiveConcurrency(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Adaptive Concurrency Suite")
+}
@@ -0,0 +1,69 @@
+package adaptiveconcurrency
+
+import (
+	"github.com/rotisserie/eris"
+
+	adaptiveconcurrency "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/adaptive_concurrency/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+)
+
+const FilterName = "envoy.filters.http.adaptive_concurrency"
+
+// shed load once the request has passed all the checks and is about to be forwarded upstream
+var pluginStage = plugins.DuringStage(plugins.AcceptedStage)
+
+var (
+	MissingGradientControllerConfigError = eris.New("adaptive concurrency requires a gradient_controller_config")
+	MissingConcurrencyLimitParamsError   = eris.New("adaptive concurrency gradient_controller_config requires concurrency_limit_params")
+	MissingMinRttCalcParamsError         = eris.New("adaptive concurrency gradient_controller_config requires min_rtt_calc_params")
+)
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+var _ plugins.Plugin = new(Plugin)
+var _ plugins.HttpFilterPlugin = new(Plugin)
+
+type Plugin struct {
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *Plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+
+	adaptiveConcurrencyConfig := listener.GetOptions().GetAdaptiveConcurrency()
+
+	if adaptiveConcurrencyConfig == nil {
+		return nil, nil
+	}
+
+	if err := validateAdaptiveConcurrency(adaptiveConcurrencyConfig); err != nil {
+		return nil, err
+	}
+
+	adaptiveConcurrencyFilter, err := plugins.NewStagedFilterWithConfig(FilterName, adaptiveConcurrencyConfig, pluginStage)
+	if err != nil {
+		return nil, eris.Wrapf(err, "generating filter config")
+	}
+
+	return []plugins.StagedHttpFilter{adaptiveConcurrencyFilter}, nil
+}
+
+// envoy nacks gradient controllers without their calculation params, so catch these before they reach it
+func validateAdaptiveConcurrency(adaptiveConcurrencyConfig *adaptiveconcurrency.AdaptiveConcurrency) error {
+	gradientControllerConfig := adaptiveConcurrencyConfig.GetGradientControllerConfig()
+	if gradientControllerConfig == nil {
+		return MissingGradientControllerConfigError
+	}
+	if gradientControllerConfig.GetConcurrencyLimitParams() == nil {
+		return MissingConcurrencyLimitParamsError
+	}
+	if gradientControllerConfig.GetMinRttCalcParams() == nil {
+		return MissingMinRttCalcParamsError
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package adaptiveconcurrency_test
+
+import (
+	envoyadaptiveconcurrency "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/adaptive_concurrency/v3"
+	envoyhcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/adaptive_concurrency/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/adaptiveconcurrency"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		gradientControllerConfig *v3.GradientControllerConfig
+	)
+
+	BeforeEach(func() {
+		gradientControllerConfig = &v3.GradientControllerConfig{
+			ConcurrencyLimitParams: &v3.GradientControllerConfig_ConcurrencyLimitCalculationParams{
+				ConcurrencyUpdateInterval: &types.Duration{Nanos: 100000000},
+			},
+			MinRttCalcParams: &v3.GradientControllerConfig_MinimumRTTCalculationParams{
+				Interval:     &types.Duration{Seconds: 60},
+				RequestCount: &types.UInt32Value{Value: 50},
+			},
+		}
+	})
+
+	httpFilters := func(config *v3.AdaptiveConcurrency) ([]plugins.StagedHttpFilter, error) {
+		return NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{
+				AdaptiveConcurrency: config,
+			},
+		})
+	}
+
+	It("does not add the filter when the listener has no adaptive concurrency config", func() {
+		filters, err := httpFilters(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("copies the adaptive concurrency config from the listener to the filter", func() {
+		filters, err := httpFilters(&v3.AdaptiveConcurrency{
+			ConcurrencyControllerConfig: &v3.AdaptiveConcurrency_GradientControllerConfig{
+				GradientControllerConfig: gradientControllerConfig,
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(Equal([]plugins.StagedHttpFilter{
+			{
+				HttpFilter: &envoyhcm.HttpFilter{
+					Name: FilterName,
+					ConfigType: &envoyhcm.HttpFilter_TypedConfig{
+						TypedConfig: utils.MustMessageToAny(&envoyadaptiveconcurrency.AdaptiveConcurrency{
+							ConcurrencyControllerConfig: &envoyadaptiveconcurrency.AdaptiveConcurrency_GradientControllerConfig{
+								GradientControllerConfig: &envoyadaptiveconcurrency.GradientControllerConfig{
+									ConcurrencyLimitParams: &envoyadaptiveconcurrency.GradientControllerConfig_ConcurrencyLimitCalculationParams{
+										ConcurrencyUpdateInterval: &duration.Duration{Nanos: 100000000},
+									},
+									MinRttCalcParams: &envoyadaptiveconcurrency.GradientControllerConfig_MinimumRTTCalculationParams{
+										Interval:     &duration.Duration{Seconds: 60},
+										RequestCount: &wrappers.UInt32Value{Value: 50},
+									},
+								},
+							},
+						}),
+					},
+				},
+				Stage: plugins.DuringStage(plugins.AcceptedStage),
+			},
+		}))
+	})
+
+	It("errors when the gradient controller config is missing", func() {
+		_, err := httpFilters(&v3.AdaptiveConcurrency{})
+		Expect(err).To(Equal(MissingGradientControllerConfigError))
+	})
+
+	It("errors when the gradient controller config is missing its calculation params", func() {
+		gradientControllerConfig.MinRttCalcParams = nil
+		_, err := httpFilters(&v3.AdaptiveConcurrency{
+			ConcurrencyControllerConfig: &v3.AdaptiveConcurrency_GradientControllerConfig{
+				GradientControllerConfig: gradientControllerConfig,
+			},
+		})
+		Expect(err).To(Equal(MissingMinRttCalcParamsError))
+
+		gradientControllerConfig.ConcurrencyLimitParams = nil
+		_, err = httpFilters(&v3.AdaptiveConcurrency{
+			ConcurrencyControllerConfig: &v3.AdaptiveConcurrency_GradientControllerConfig{
+				GradientControllerConfig: gradientControllerConfig,
+			},
+		})
+		Expect(err).To(Equal(MissingConcurrencyLimitParamsError))
+	})
+})
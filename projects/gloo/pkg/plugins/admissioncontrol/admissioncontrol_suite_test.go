@@ -0,0 +1,13 @@
+package admissioncontrol_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestAdmissionControl(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Admission Control Suite")
+}
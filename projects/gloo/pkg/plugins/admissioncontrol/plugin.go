@@ -0,0 +1,60 @@
+package admissioncontrol
+
+import (
+	"github.com/rotisserie/eris"
+
+	admissioncontrol "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/admission_control/v3alpha"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+)
+
+const FilterName = "envoy.filters.http.admission_control"
+
+// shed load once the request has passed all the checks and is about to be forwarded upstream
+var pluginStage = plugins.DuringStage(plugins.AcceptedStage)
+
+var (
+	MissingSuccessCriteriaError = eris.New("admission control requires success_criteria")
+)
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+var _ plugins.Plugin = new(Plugin)
+var _ plugins.HttpFilterPlugin = new(Plugin)
+
+type Plugin struct {
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *Plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+
+	admissionControlConfig := listener.GetOptions().GetAdmissionControl()
+
+	if admissionControlConfig == nil {
+		return nil, nil
+	}
+
+	if err := validateAdmissionControl(admissionControlConfig); err != nil {
+		return nil, err
+	}
+
+	admissionControlFilter, err := plugins.NewStagedFilterWithConfig(FilterName, admissionControlConfig, pluginStage)
+	if err != nil {
+		return nil, eris.Wrapf(err, "generating filter config")
+	}
+
+	return []plugins.StagedHttpFilter{admissionControlFilter}, nil
+}
+
+// envoy nacks admission control configs without an evaluation criteria, so catch these before they reach it
+func validateAdmissionControl(admissionControlConfig *admissioncontrol.AdmissionControl) error {
+	if admissionControlConfig.GetSuccessCriteria() == nil {
+		return MissingSuccessCriteriaError
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package admissioncontrol_test
+
+import (
+	envoyhcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v3alpha "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/admission_control/v3alpha"
+	envoytype "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/admissioncontrol"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+var _ = Describe("Plugin", func() {
+
+	httpFilters := func(config *v3alpha.AdmissionControl) ([]plugins.StagedHttpFilter, error) {
+		return NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{
+				AdmissionControl: config,
+			},
+		})
+	}
+
+	It("does not add the filter when the listener has no admission control config", func() {
+		filters, err := httpFilters(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("copies the admission control config from the listener to the filter", func() {
+		config := &v3alpha.AdmissionControl{
+			EvaluationCriteria: &v3alpha.AdmissionControl_SuccessCriteria_{
+				SuccessCriteria: &v3alpha.AdmissionControl_SuccessCriteria{
+					HttpCriteria: &v3alpha.AdmissionControl_SuccessCriteria_HttpCriteria{
+						HttpSuccessStatus: []*envoytype.Int32Range{{Start: 100, End: 500}},
+					},
+				},
+			},
+			SamplingWindow: &types.Duration{Seconds: 30},
+		}
+		filters, err := httpFilters(config)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(Equal([]plugins.StagedHttpFilter{
+			{
+				HttpFilter: &envoyhcm.HttpFilter{
+					Name: FilterName,
+					ConfigType: &envoyhcm.HttpFilter_TypedConfig{
+						TypedConfig: utils.MustMessageToAny(config),
+					},
+				},
+				Stage: plugins.DuringStage(plugins.AcceptedStage),
+			},
+		}))
+		Expect(filters[0].HttpFilter.GetTypedConfig().GetTypeUrl()).To(Equal("type.googleapis.com/envoy.extensions.filters.http.admission_control.v3alpha.AdmissionControl"))
+	})
+
+	It("errors when the success criteria are missing", func() {
+		_, err := httpFilters(&v3alpha.AdmissionControl{})
+		Expect(err).To(Equal(MissingSuccessCriteriaError))
+	})
+})
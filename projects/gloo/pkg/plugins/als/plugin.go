@@ -2,6 +2,7 @@ package als
 
 import (
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
 	envoyal "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyalfile "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
@@ -48,57 +49,76 @@ func (p *Plugin) ProcessListener(params plugins.Params, in *v1.Listener, out *en
 		if listenerType.HttpListener == nil {
 			return nil
 		}
-		for _, f := range out.FilterChains {
-			for i, filter := range f.Filters {
-				if filter.Name == wellknown.HTTPConnectionManager {
-					// get config
-					var hcmCfg envoyhttp.HttpConnectionManager
-					err := translatorutil.ParseTypedConfig(filter, &hcmCfg)
-					// this should never error
-					if err != nil {
-						return err
-					}
-
-					accessLogs := hcmCfg.GetAccessLog()
-					hcmCfg.AccessLog, err = handleAccessLogPlugins(alSettings.AccessLoggingService, accessLogs, params)
-					if err != nil {
-						return err
-					}
-
-					f.Filters[i], err = translatorutil.NewFilterWithTypedConfig(wellknown.HTTPConnectionManager, &hcmCfg)
-					// this should never error
-					if err != nil {
-						return err
-					}
-				}
-			}
-		}
+		return processHcmAccessLogs(params, alSettings.AccessLoggingService, out.FilterChains)
 	case *v1.Listener_TcpListener:
 		if listenerType.TcpListener == nil {
 			return nil
 		}
-		for _, f := range out.FilterChains {
-			for i, filter := range f.Filters {
-				if filter.Name == wellknown.TCPProxy {
-					// get config
-					var tcpCfg envoytcp.TcpProxy
-					err := translatorutil.ParseTypedConfig(filter, &tcpCfg)
-					// this should never error
-					if err != nil {
-						return err
-					}
-
-					accessLogs := tcpCfg.GetAccessLog()
-					tcpCfg.AccessLog, err = handleAccessLogPlugins(alSettings.AccessLoggingService, accessLogs, params)
-					if err != nil {
-						return err
-					}
-
-					f.Filters[i], err = translatorutil.NewFilterWithTypedConfig(wellknown.TCPProxy, &tcpCfg)
-					// this should never error
-					if err != nil {
-						return err
-					}
+		return processTcpProxyAccessLogs(params, alSettings.AccessLoggingService, out.FilterChains)
+	case *v1.Listener_HybridListener:
+		if listenerType.HybridListener == nil {
+			return nil
+		}
+		// the filter chains of a hybrid listener contain http connection managers and tcp proxies
+		if err := processHcmAccessLogs(params, alSettings.AccessLoggingService, out.FilterChains); err != nil {
+			return err
+		}
+		return processTcpProxyAccessLogs(params, alSettings.AccessLoggingService, out.FilterChains)
+	}
+	return nil
+}
+
+func processHcmAccessLogs(params plugins.Params, service *als.AccessLoggingService, filterChains []*envoylistener.FilterChain) error {
+	for _, f := range filterChains {
+		for i, filter := range f.Filters {
+			if filter.Name == wellknown.HTTPConnectionManager {
+				// get config
+				var hcmCfg envoyhttp.HttpConnectionManager
+				err := translatorutil.ParseTypedConfig(filter, &hcmCfg)
+				// this should never error
+				if err != nil {
+					return err
+				}
+
+				accessLogs := hcmCfg.GetAccessLog()
+				hcmCfg.AccessLog, err = handleAccessLogPlugins(service, accessLogs, params)
+				if err != nil {
+					return err
+				}
+
+				f.Filters[i], err = translatorutil.NewFilterWithTypedConfig(wellknown.HTTPConnectionManager, &hcmCfg)
+				// this should never error
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func processTcpProxyAccessLogs(params plugins.Params, service *als.AccessLoggingService, filterChains []*envoylistener.FilterChain) error {
+	for _, f := range filterChains {
+		for i, filter := range f.Filters {
+			if filter.Name == wellknown.TCPProxy {
+				// get config
+				var tcpCfg envoytcp.TcpProxy
+				err := translatorutil.ParseTypedConfig(filter, &tcpCfg)
+				// this should never error
+				if err != nil {
+					return err
+				}
+
+				accessLogs := tcpCfg.GetAccessLog()
+				tcpCfg.AccessLog, err = handleAccessLogPlugins(service, accessLogs, params)
+				if err != nil {
+					return err
+				}
+
+				f.Filters[i], err = translatorutil.NewFilterWithTypedConfig(wellknown.TCPProxy, &tcpCfg)
+				// this should never error
+				if err != nil {
+					return err
 				}
 			}
 		}
@@ -106,6 +126,12 @@ func (p *Plugin) ProcessListener(params plugins.Params, in *v1.Listener, out *en
 	return nil
 }
 
+// AccessLogs converts the access logs of the service to envoy access logs, for the filters that are not
+// configured by this plugin, e.g. the tcp proxies of tcp hosts
+func AccessLogs(params plugins.Params, service *als.AccessLoggingService) ([]*envoyal.AccessLog, error) {
+	return handleAccessLogPlugins(service, nil, params)
+}
+
 func handleAccessLogPlugins(service *als.AccessLoggingService, logCfg []*envoyal.AccessLog, params plugins.Params) ([]*envoyal.AccessLog, error) {
 	results := make([]*envoyal.AccessLog, 0, len(service.GetAccessLog()))
 	for _, al := range service.GetAccessLog() {
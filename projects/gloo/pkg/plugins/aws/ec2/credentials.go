@@ -15,6 +15,8 @@ type CredentialSpec struct {
 	// roleArn is an AWS Roles (specified by its Amazon Resource Number (ARN)) which should be assumed when
 	// querying for instances available to the upstream
 	roleArn string
+	// externalId is passed when assuming the role, as required by the trust policies of roles in other accounts
+	externalId string
 }
 
 // https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html#arn-syntax-ec2
@@ -22,9 +24,10 @@ const arnSegmentDelimiter = ":"
 
 func (cs *CredentialSpec) GetKey() CredentialKey {
 	return CredentialKey{
-		secretRef: cs.SecretRef().String(),
-		region:    cs.Region(),
-		roleArn:   cs.Arn(),
+		secretRef:  cs.SecretRef().String(),
+		region:     cs.Region(),
+		roleArn:    cs.Arn(),
+		externalId: cs.ExternalId(),
 	}
 }
 
@@ -40,24 +43,31 @@ func (cs *CredentialSpec) Arn() string {
 	return cs.roleArn
 }
 
+func (cs *CredentialSpec) ExternalId() string {
+	return cs.externalId
+}
+
 func (cs *CredentialSpec) Clone() *CredentialSpec {
 	return &CredentialSpec{
-		secretRef: cs.secretRef,
-		region:    cs.region,
-		roleArn:   cs.roleArn,
+		secretRef:  cs.secretRef,
+		region:     cs.region,
+		roleArn:    cs.roleArn,
+		externalId: cs.externalId,
 	}
 }
 
 func NewCredentialSpecFromEc2UpstreamSpec(spec *glooec2.UpstreamSpec) *CredentialSpec {
 	return &CredentialSpec{
-		secretRef: spec.SecretRef,
-		region:    spec.Region,
-		roleArn:   spec.GetRoleArn(),
+		secretRef:  spec.SecretRef,
+		region:     spec.Region,
+		roleArn:    spec.GetRoleArn(),
+		externalId: spec.GetExternalId(),
 	}
 }
 
 type CredentialKey struct {
-	secretRef string
-	region    string
-	roleArn   string
+	secretRef  string
+	region     string
+	roleArn    string
+	externalId string
 }
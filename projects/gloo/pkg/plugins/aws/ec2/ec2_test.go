@@ -6,6 +6,7 @@ import (
 	"github.com/solo-io/go-utils/testutils"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -203,4 +204,29 @@ var _ = Describe("Plugin", func() {
 				nil,
 			))
 	})
+
+	Context("assume role credentials", func() {
+		spec := func(externalId string) *CredentialSpec {
+			return NewCredentialSpecFromEc2UpstreamSpec(&glooec2.UpstreamSpec{
+				Region:     "us-east-1",
+				RoleArn:    "arn:aws:iam::123456789012:role/gloo-discovery",
+				ExternalId: externalId,
+			})
+		}
+
+		It("passes the external id when assuming the role", func() {
+			provider := &stscreds.AssumeRoleProvider{}
+			assumeRoleOptions(spec("my-external-id"))(provider)
+			Expect(provider.ExternalID).To(Equal(aws.String("my-external-id")))
+
+			provider = &stscreds.AssumeRoleProvider{}
+			assumeRoleOptions(spec(""))(provider)
+			Expect(provider.ExternalID).To(BeNil())
+		})
+
+		It("does not share clients between roles assumed with different external ids", func() {
+			Expect(spec("id-1").GetKey()).NotTo(Equal(spec("id-2").GetKey()))
+			Expect(spec("id-1").Clone().GetKey()).To(Equal(spec("id-1").GetKey()))
+		})
+	})
 })
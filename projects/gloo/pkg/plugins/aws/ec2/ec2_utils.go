@@ -25,13 +25,22 @@ func GetEc2Client(cred *CredentialSpec, secrets v1.SecretList) (*ec2.EC2, error)
 		return nil, CreateSessionFromSecretError(err)
 	}
 	if cred.Arn() != "" {
-		cred := stscreds.NewCredentials(sess, cred.Arn())
-		config := &aws.Config{Credentials: cred}
+		roleCreds := stscreds.NewCredentials(sess, cred.Arn(), assumeRoleOptions(cred))
+		config := &aws.Config{Credentials: roleCreds}
 		return ec2.New(sess, config), nil
 	}
 	return ec2.New(sess), nil
 }
 
+// roles in other accounts usually require the external ID agreed upon with their owner when they are assumed
+func assumeRoleOptions(cred *CredentialSpec) func(*stscreds.AssumeRoleProvider) {
+	return func(provider *stscreds.AssumeRoleProvider) {
+		if cred.ExternalId() != "" {
+			provider.ExternalID = aws.String(cred.ExternalId())
+		}
+	}
+}
+
 func GetInstancesFromDescription(desc *ec2.DescribeInstancesOutput) []*ec2.Instance {
 	var instances []*ec2.Instance
 	for _, reservation := range desc.Reservations {
@@ -204,30 +204,66 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 			return nil, nil
 		}
 
-		repsonsetransform := awsDestinationSpec.Aws.ResponseTransformation
-		if !repsonsetransform {
-			return nil, nil
+		switch {
+		case awsDestinationSpec.Aws.GetResponseTransformation() && awsDestinationSpec.Aws.GetUnwrapAsApiGateway():
+			return nil, errors.Errorf("responseTransformation and unwrapAsApiGateway cannot both be set")
+		case awsDestinationSpec.Aws.GetResponseTransformation():
+			*p.transformsAdded = true
+			return responseTransformation(), nil
+		case awsDestinationSpec.Aws.GetUnwrapAsApiGateway():
+			*p.transformsAdded = true
+			return apiGatewayResponseTransformation(), nil
 		}
-		*p.transformsAdded = true
-		return &envoy_transform.RouteTransformations{
-			ResponseTransformation: &envoy_transform.Transformation{
-				TransformationType: &envoy_transform.Transformation_TransformationTemplate{
-					TransformationTemplate: &envoy_transform.TransformationTemplate{
-						BodyTransformation: &envoy_transform.TransformationTemplate_Body{
-							Body: &envoy_transform.InjaTemplate{
-								Text: "{{body}}",
-							},
+		return nil, nil
+	})
+}
+
+func responseTransformation() *envoy_transform.RouteTransformations {
+	return &envoy_transform.RouteTransformations{
+		ResponseTransformation: &envoy_transform.Transformation{
+			TransformationType: &envoy_transform.Transformation_TransformationTemplate{
+				TransformationTemplate: &envoy_transform.TransformationTemplate{
+					BodyTransformation: &envoy_transform.TransformationTemplate_Body{
+						Body: &envoy_transform.InjaTemplate{
+							Text: "{{body}}",
 						},
-						Headers: map[string]*envoy_transform.InjaTemplate{
-							"content-type": {
-								Text: "text/html",
-							},
+					},
+					Headers: map[string]*envoy_transform.InjaTemplate{
+						"content-type": {
+							Text: "text/html",
 						},
 					},
 				},
 			},
-		}, nil
-	})
+		},
+	}
+}
+
+// Lambda functions behind the API Gateway proxy integration return their response as a JSON object, e.g.
+// {"statusCode": 200, "headers": {"Content-Type": "text/plain"}, "body": "hello"}
+// this transformation turns the fields of the object into the status, content type and body of the response.
+func apiGatewayResponseTransformation() *envoy_transform.RouteTransformations {
+	return &envoy_transform.RouteTransformations{
+		ResponseTransformation: &envoy_transform.Transformation{
+			TransformationType: &envoy_transform.Transformation_TransformationTemplate{
+				TransformationTemplate: &envoy_transform.TransformationTemplate{
+					BodyTransformation: &envoy_transform.TransformationTemplate_Body{
+						Body: &envoy_transform.InjaTemplate{
+							Text: `{{ default(body, "") }}`,
+						},
+					},
+					Headers: map[string]*envoy_transform.InjaTemplate{
+						":status": {
+							Text: "{{ default(statusCode, 200) }}",
+						},
+						"content-type": {
+							Text: `{{ default(headers.Content-Type, default(headers.content-type, "application/json")) }}`,
+						},
+					},
+				},
+			},
+		},
+	}
 }
 
 func (p *plugin) HttpFilters(_ plugins.Params, _ *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/aws"
+	envoy_transform "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
 	awsapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
@@ -224,6 +225,29 @@ var _ = Describe("Plugin", func() {
 			Expect(outroute.TypedPerFilterConfig).To(HaveKey(FilterName))
 			Expect(outroute.TypedPerFilterConfig).To(HaveKey(transformation.FilterName))
 		})
+
+		It("should process route unwrapping api gateway responses", func() {
+			route.GetRouteAction().GetSingle().GetDestinationSpec().GetAws().UnwrapAsApiGateway = true
+			err := awsPlugin.(plugins.RoutePlugin).ProcessRoute(plugins.RouteParams{VirtualHostParams: vhostParams}, route, outroute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outroute.TypedPerFilterConfig).To(HaveKey(FilterName))
+			Expect(outroute.TypedPerFilterConfig).To(HaveKey(transformation.FilterName))
+
+			var transformations envoy_transform.RouteTransformations
+			err = gogoproto.Unmarshal(outroute.TypedPerFilterConfig[transformation.FilterName].Value, &transformations)
+			Expect(err).NotTo(HaveOccurred())
+			template := transformations.GetResponseTransformation().GetTransformationTemplate()
+			Expect(template.GetBody().GetText()).To(Equal(`{{ default(body, "") }}`))
+			Expect(template.GetHeaders()).To(HaveKey(":status"))
+			Expect(template.GetHeaders()).To(HaveKey("content-type"))
+		})
+
+		It("should error when both response transformations are set", func() {
+			route.GetRouteAction().GetSingle().GetDestinationSpec().GetAws().ResponseTransformation = true
+			route.GetRouteAction().GetSingle().GetDestinationSpec().GetAws().UnwrapAsApiGateway = true
+			err := awsPlugin.(plugins.RoutePlugin).ProcessRoute(plugins.RouteParams{VirtualHostParams: vhostParams}, route, outroute)
+			Expect(err).To(MatchError(ContainSubstring("cannot both be set")))
+		})
 	})
 
 	Context("filters", func() {
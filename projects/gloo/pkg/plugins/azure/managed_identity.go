@@ -0,0 +1,123 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	// the token endpoint of the Azure Instance Metadata Service
+	// see https://docs.microsoft.com/en-us/azure/active-directory/managed-identities-azure-resources/how-to-use-vm-token
+	imdsTokenEndpoint = "http://169.254.169.254/metadata/identity/oauth2/token"
+	imdsApiVersion    = "2018-02-01"
+
+	// tokens are requested again once they expire within this margin, so that envoy is not sent expiring tokens
+	tokenRefreshMargin  = 5 * time.Minute
+	tokenRequestTimeout = 10 * time.Second
+)
+
+// Provides the access tokens of the managed identity of Gloo
+type tokenSource interface {
+	Token(ctx context.Context, resource, clientId string) (string, error)
+}
+
+type tokenKey struct {
+	resource string
+	clientId string
+}
+
+type cachedToken struct {
+	accessToken string
+	expiresOn   time.Time
+}
+
+type managedIdentityTokens struct {
+	endpoint string
+	client   *http.Client
+	now      func() time.Time
+
+	lock   sync.Mutex
+	tokens map[tokenKey]cachedToken
+}
+
+func newManagedIdentityTokens(endpoint string) *managedIdentityTokens {
+	return &managedIdentityTokens{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: tokenRequestTimeout},
+		now:      time.Now,
+		tokens:   make(map[tokenKey]cachedToken),
+	}
+}
+
+func (m *managedIdentityTokens) Token(ctx context.Context, resource, clientId string) (string, error) {
+	key := tokenKey{resource: resource, clientId: clientId}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if token, ok := m.tokens[key]; ok && m.now().Add(tokenRefreshMargin).Before(token.expiresOn) {
+		return token.accessToken, nil
+	}
+
+	token, err := m.requestToken(ctx, key)
+	if err != nil {
+		return "", errors.Wrapf(err, "requesting managed identity token for %v", resource)
+	}
+	m.tokens[key] = token
+	// envoy is configured with the token, so it has to be replaced before it expires
+	plugins.RequestResyncAfter(token.expiresOn.Sub(m.now()) - tokenRefreshMargin)
+	return token.accessToken, nil
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	// the number of seconds the token is valid for, as a string
+	ExpiresIn string `json:"expires_in"`
+}
+
+func (m *managedIdentityTokens) requestToken(ctx context.Context, key tokenKey) (cachedToken, error) {
+	query := url.Values{}
+	query.Set("api-version", imdsApiVersion)
+	query.Set("resource", key.resource)
+	if key.clientId != "" {
+		query.Set("client_id", key.clientId)
+	}
+	req, err := http.NewRequest(http.MethodGet, m.endpoint+"?"+query.Encode(), nil)
+	if err != nil {
+		return cachedToken{}, err
+	}
+	req.Header.Set("Metadata", "true")
+
+	requested := m.now()
+	resp, err := m.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return cachedToken{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return cachedToken{}, errors.Errorf("token endpoint responded with status %v", resp.StatusCode)
+	}
+
+	var body tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return cachedToken{}, errors.Wrapf(err, "decoding token response")
+	}
+	if body.AccessToken == "" {
+		return cachedToken{}, errors.Errorf("token response has no access token")
+	}
+	expiresIn, err := strconv.Atoi(body.ExpiresIn)
+	if err != nil {
+		return cachedToken{}, errors.Wrapf(err, "invalid token expiry %q", body.ExpiresIn)
+	}
+	return cachedToken{
+		accessToken: body.AccessToken,
+		expiresOn:   requested.Add(time.Duration(expiresIn) * time.Second),
+	}, nil
+}
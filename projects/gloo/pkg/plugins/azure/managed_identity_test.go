@@ -0,0 +1,154 @@
+package azure
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/proto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	transformationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/azure"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+type fakeTokenSource map[tokenKey]string
+
+func (f fakeTokenSource) Token(_ context.Context, resource, clientId string) (string, error) {
+	return f[tokenKey{resource: resource, clientId: clientId}], nil
+}
+
+var _ = Describe("Managed identity", func() {
+
+	Context("tokens", func() {
+
+		var (
+			server   *httptest.Server
+			tokens   *managedIdentityTokens
+			now      time.Time
+			requests []*http.Request
+		)
+
+		BeforeEach(func() {
+			requests = nil
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r)
+				w.Write([]byte(`{"access_token": "token-` + r.URL.Query().Get("resource") + `", "expires_in": "3600", "token_type": "Bearer"}`))
+			}))
+			now = time.Now()
+			tokens = newManagedIdentityTokens(server.URL)
+			tokens.now = func() time.Time { return now }
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("requests tokens from the metadata service", func() {
+			token, err := tokens.Token(context.TODO(), "api://my-app", "my-client-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(token).To(Equal("token-api://my-app"))
+
+			Expect(requests).To(HaveLen(1))
+			Expect(requests[0].Header.Get("Metadata")).To(Equal("true"))
+			Expect(requests[0].URL.Query().Get("api-version")).To(Equal(imdsApiVersion))
+			Expect(requests[0].URL.Query().Get("client_id")).To(Equal("my-client-id"))
+		})
+
+		It("caches tokens until shortly before they expire", func() {
+			for i := 0; i < 2; i++ {
+				_, err := tokens.Token(context.TODO(), "api://my-app", "")
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(requests).To(HaveLen(1))
+
+			now = now.Add(time.Hour - tokenRefreshMargin)
+			_, err := tokens.Token(context.TODO(), "api://my-app", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requests).To(HaveLen(2))
+		})
+
+		It("errors when the metadata service does not return a token", func() {
+			server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+			})
+			_, err := tokens.Token(context.TODO(), "api://my-app", "")
+			Expect(err).To(MatchError(ContainSubstring("token endpoint responded with status 400")))
+		})
+	})
+
+	Context("routes", func() {
+
+		var (
+			p        *plugin
+			upstream *v1.Upstream
+			route    *v1.Route
+			outroute *envoyroute.Route
+		)
+
+		BeforeEach(func() {
+			var b bool
+			p = NewPlugin(&b).(*plugin)
+			Expect(p.Init(plugins.InitParams{Ctx: context.TODO()})).To(Succeed())
+			p.tokens = fakeTokenSource{{resource: "api://my-app"}: "my-token"}
+
+			upstream = &v1.Upstream{
+				Metadata: core.Metadata{Name: "my-app", Namespace: "default"},
+				UpstreamType: &v1.Upstream_Azure{
+					Azure: &azure.UpstreamSpec{
+						FunctionAppName: "my-app",
+						Functions: []*azure.UpstreamSpec_FunctionSpec{{
+							FunctionName: "my-function",
+							AuthLevel:    azure.UpstreamSpec_FunctionSpec_Function,
+						}},
+						ManagedIdentity: &azure.UpstreamSpec_ManagedIdentity{Resource: "api://my-app"},
+					},
+				},
+			}
+			route = &v1.Route{
+				Action: &v1.Route_RouteAction{
+					RouteAction: &v1.RouteAction{
+						Destination: &v1.RouteAction_Single{
+							Single: &v1.Destination{
+								DestinationType: &v1.Destination_Upstream{
+									Upstream: &core.ResourceRef{Name: "my-app", Namespace: "default"},
+								},
+								DestinationSpec: &v1.DestinationSpec{
+									DestinationType: &v1.DestinationSpec_Azure{
+										Azure: &azure.DestinationSpec{FunctionName: "my-function"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			outroute = &envoyroute.Route{
+				Action: &envoyroute.Route_Route{
+					Route: &envoyroute.RouteAction{
+						ClusterSpecifier: &envoyroute.RouteAction_Cluster{Cluster: "my-app"},
+					},
+				},
+			}
+		})
+
+		It("adds the token to the requests without requiring function keys", func() {
+			p.recordedUpstreams[upstream.Metadata.Ref()] = upstream.GetAzure()
+
+			err := p.ProcessRoute(plugins.RouteParams{VirtualHostParams: plugins.VirtualHostParams{Params: plugins.Params{Snapshot: &v1.ApiSnapshot{}}}}, route, outroute)
+			Expect(err).NotTo(HaveOccurred())
+
+			var transformations transformationapi.RouteTransformations
+			Expect(proto.Unmarshal(outroute.TypedPerFilterConfig[transformation.FilterName].Value, &transformations)).To(Succeed())
+			headers := transformations.GetRequestTransformation().GetTransformationTemplate().GetHeaders()
+			Expect(headers["authorization"].GetText()).To(Equal("Bearer my-token"))
+			Expect(headers[":path"].GetText()).To(Equal("/api/my-function"))
+		})
+	})
+})
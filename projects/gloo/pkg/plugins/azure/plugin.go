@@ -35,10 +35,17 @@ type plugin struct {
 	apiKeys           map[string]string
 	ctx               context.Context
 	transformsAdded   *bool
+	tokens            tokenSource
 }
 
+// plugins are created for every translation, so the tokens are cached across them
+var managedIdentityTokenCache = newManagedIdentityTokens(imdsTokenEndpoint)
+
 func NewPlugin(transformsAdded *bool) plugins.Plugin {
-	return &plugin{transformsAdded: transformsAdded}
+	return &plugin{
+		transformsAdded: transformsAdded,
+		tokens:          managedIdentityTokenCache,
+	}
 }
 
 func (p *plugin) Init(params plugins.InitParams) error {
@@ -116,7 +123,8 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 		functionName := azureDestinationSpec.Azure.FunctionName
 		for _, functionSpec := range upstreamSpec.Functions {
 			if functionSpec.FunctionName == functionName {
-				path, err := getPath(functionSpec, p.apiKeys)
+				managedIdentity := upstreamSpec.GetManagedIdentity()
+				path, err := getPath(functionSpec, p.apiKeys, managedIdentity != nil)
 				if err != nil {
 					return nil, err
 				}
@@ -124,19 +132,29 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 				*p.transformsAdded = true
 
 				hostname := GetHostname(upstreamSpec)
+				headers := map[string]*transformationapi.InjaTemplate{
+					":path": {
+						Text: path,
+					},
+					":authority": {
+						Text: hostname,
+					},
+				}
+				if managedIdentity != nil {
+					token, err := p.tokens.Token(p.ctx, managedIdentity.GetResource(), managedIdentity.GetClientId())
+					if err != nil {
+						return nil, err
+					}
+					headers["authorization"] = &transformationapi.InjaTemplate{
+						Text: "Bearer " + token,
+					}
+				}
 				// TODO: consider adding a new add headers transformation allow adding headers with no templates to improve performance.
 				ret := &transformationapi.RouteTransformations{
 					RequestTransformation: &transformationapi.Transformation{
 						TransformationType: &transformationapi.Transformation_TransformationTemplate{
 							TransformationTemplate: &transformationapi.TransformationTemplate{
-								Headers: map[string]*transformationapi.InjaTemplate{
-									":path": {
-										Text: path,
-									},
-									":authority": {
-										Text: hostname,
-									},
-								},
+								Headers: headers,
 								BodyTransformation: &transformationapi.TransformationTemplate_Passthrough{
 									Passthrough: &transformationapi.Passthrough{},
 								},
@@ -152,10 +170,14 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 	})
 }
 
-func getPath(functionSpec *azure.UpstreamSpec_FunctionSpec, apiKeys map[string]string) (string, error) {
+// with a managed identity, the requests are authenticated by their token, so function keys are optional
+func getPath(functionSpec *azure.UpstreamSpec_FunctionSpec, apiKeys map[string]string, keysOptional bool) (string, error) {
 	functionName := functionSpec.FunctionName
 
 	pathParameters, err := getPathParameters(functionSpec, apiKeys)
+	if err != nil && keysOptional {
+		pathParameters, err = "", nil
+	}
 	if err != nil {
 		return "", err
 	}
@@ -1,13 +1,20 @@
 package basicroute
 
 import (
+	"context"
+	"regexp"
+
 	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/solo-io/gloo/pkg/utils/gogoutils"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/internal/common"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils/upgradeconfig"
 	"github.com/solo-io/solo-kit/pkg/errors"
 )
@@ -30,7 +37,7 @@ func (p *Plugin) ProcessVirtualHost(params plugins.VirtualHostParams, in *v1.Vir
 	if in.Options == nil {
 		return nil
 	}
-	return applyRetriesVhost(in, out)
+	return applyRetriesVhost(params.Ctx, in, out)
 }
 
 func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoyroute.Route) error {
@@ -40,10 +47,22 @@ func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 	if err := applyPrefixRewrite(in, out); err != nil {
 		return err
 	}
+	if err := applyRegexRewrite(params.Ctx, in, out); err != nil {
+		return err
+	}
 	if err := applyTimeout(in, out); err != nil {
 		return err
 	}
-	if err := applyRetries(in, out); err != nil {
+	if err := applyIdleTimeout(in, out); err != nil {
+		return err
+	}
+	if err := applyGrpcTimeout(in, out); err != nil {
+		return err
+	}
+	if err := applyRetries(params.Ctx, in, out); err != nil {
+		return err
+	}
+	if err := applyHedgePolicy(in, out); err != nil {
 		return err
 	}
 	if err := applyHostRewrite(in, out); err != nil {
@@ -72,6 +91,32 @@ func applyPrefixRewrite(in *v1.Route, out *envoyroute.Route) error {
 	return nil
 }
 
+func applyRegexRewrite(ctx context.Context, in *v1.Route, out *envoyroute.Route) error {
+	regexRewrite := in.GetOptions().GetRegexRewrite()
+	if regexRewrite == nil {
+		return nil
+	}
+	routeAction, ok := out.Action.(*envoyroute.Route_Route)
+	if !ok {
+		return errors.Errorf("regex rewrite is only available for Route Actions")
+	}
+	if routeAction.Route == nil {
+		return errors.Errorf("internal error: route %v specified a regex rewrite, but output Envoy object "+
+			"had nil route", in.Action)
+	}
+	if in.GetOptions().GetPrefixRewrite() != nil {
+		return errors.Errorf("prefix rewrite and regex rewrite can't both be set on a route")
+	}
+	if _, err := regexp.Compile(regexRewrite.GetPattern()); err != nil || regexRewrite.GetPattern() == "" {
+		return errors.Errorf("the pattern of the regex rewrite must be a valid non-empty regular expression, received %q", regexRewrite.GetPattern())
+	}
+	routeAction.Route.RegexRewrite = &envoy_type_matcher.RegexMatchAndSubstitute{
+		Pattern:      regexutils.NewRegex(ctx, regexRewrite.GetPattern()),
+		Substitution: regexRewrite.GetSubstitution(),
+	}
+	return nil
+}
+
 func applyTimeout(in *v1.Route, out *envoyroute.Route) error {
 	if in.Options.Timeout == nil {
 		return nil
@@ -89,7 +134,47 @@ func applyTimeout(in *v1.Route, out *envoyroute.Route) error {
 	return nil
 }
 
-func applyRetries(in *v1.Route, out *envoyroute.Route) error {
+func applyIdleTimeout(in *v1.Route, out *envoyroute.Route) error {
+	if in.Options.IdleTimeout == nil {
+		return nil
+	}
+	routeAction, ok := out.Action.(*envoyroute.Route_Route)
+	if !ok {
+		return errors.Errorf("idle timeout is only available for Route Actions")
+	}
+	if routeAction.Route == nil {
+		return errors.Errorf("internal error: route %v specified an idle timeout, but output Envoy object "+
+			"had nil route", in.Action)
+	}
+
+	routeAction.Route.IdleTimeout = gogoutils.DurationStdToProto(in.Options.IdleTimeout)
+	return nil
+}
+
+func applyGrpcTimeout(in *v1.Route, out *envoyroute.Route) error {
+	if in.Options.MaxGrpcTimeout == nil {
+		if in.Options.GrpcTimeoutOffset != nil {
+			return errors.Errorf("grpc timeout offset is only available with a max grpc timeout")
+		}
+		return nil
+	}
+	routeAction, ok := out.Action.(*envoyroute.Route_Route)
+	if !ok {
+		return errors.Errorf("max grpc timeout is only available for Route Actions")
+	}
+	if routeAction.Route == nil {
+		return errors.Errorf("internal error: route %v specified a max grpc timeout, but output Envoy object "+
+			"had nil route", in.Action)
+	}
+
+	routeAction.Route.MaxGrpcTimeout = gogoutils.DurationStdToProto(in.Options.MaxGrpcTimeout)
+	if in.Options.GrpcTimeoutOffset != nil {
+		routeAction.Route.GrpcTimeoutOffset = gogoutils.DurationStdToProto(in.Options.GrpcTimeoutOffset)
+	}
+	return nil
+}
+
+func applyRetries(ctx context.Context, in *v1.Route, out *envoyroute.Route) error {
 	policy := in.Options.Retries
 	if policy == nil {
 		return nil
@@ -103,7 +188,42 @@ func applyRetries(in *v1.Route, out *envoyroute.Route) error {
 			"had nil route", in.Action)
 	}
 
-	routeAction.Route.RetryPolicy = convertPolicy(policy)
+	retryPolicy, err := convertPolicy(ctx, policy)
+	if err != nil {
+		return err
+	}
+	routeAction.Route.RetryPolicy = retryPolicy
+	return nil
+}
+
+func applyHedgePolicy(in *v1.Route, out *envoyroute.Route) error {
+	policy := in.GetOptions().GetHedgePolicy()
+	if policy == nil {
+		return nil
+	}
+	routeAction, ok := out.Action.(*envoyroute.Route_Route)
+	if !ok {
+		return errors.Errorf("hedge policy is only available for Route Actions")
+	}
+	if routeAction.Route == nil {
+		return errors.Errorf("internal error: route %v specified a hedge policy, but output Envoy object "+
+			"had nil route", in.Action)
+	}
+	if chance := policy.GetAdditionalRequestChance(); chance < 0 || chance > 100 {
+		return errors.Errorf("the additional request chance of the hedge policy must be between 0 and 100, received %v", chance)
+	}
+	if initialRequests := policy.GetInitialRequests(); initialRequests != nil && initialRequests.GetValue() == 0 {
+		return errors.Errorf("the initial requests of the hedge policy must be at least 1")
+	}
+
+	hedgePolicy := &envoyroute.HedgePolicy{
+		InitialRequests:      gogoutils.UInt32GogoToProto(policy.GetInitialRequests()),
+		HedgeOnPerTryTimeout: policy.GetHedgeOnPerTryTimeout(),
+	}
+	if policy.GetAdditionalRequestChance() > 0 {
+		hedgePolicy.AdditionalRequestChance = common.ToEnvoyv2Percentage(policy.GetAdditionalRequestChance())
+	}
+	routeAction.Route.HedgePolicy = hedgePolicy
 	return nil
 }
 
@@ -167,14 +287,18 @@ func applyUpgrades(in *v1.Route, out *envoyroute.Route) error {
 	return upgradeconfig.ValidateRouteUpgradeConfigs(routeAction.Route.UpgradeConfigs)
 }
 
-func applyRetriesVhost(in *v1.VirtualHost, out *envoyroute.VirtualHost) error {
-	out.RetryPolicy = convertPolicy(in.Options.Retries)
+func applyRetriesVhost(ctx context.Context, in *v1.VirtualHost, out *envoyroute.VirtualHost) error {
+	retryPolicy, err := convertPolicy(ctx, in.Options.Retries)
+	if err != nil {
+		return err
+	}
+	out.RetryPolicy = retryPolicy
 	return nil
 }
 
-func convertPolicy(policy *retries.RetryPolicy) *envoyroute.RetryPolicy {
+func convertPolicy(ctx context.Context, policy *retries.RetryPolicy) (*envoyroute.RetryPolicy, error) {
 	if policy == nil {
-		return nil
+		return nil, nil
 	}
 
 	numRetries := policy.NumRetries
@@ -182,9 +306,61 @@ func convertPolicy(policy *retries.RetryPolicy) *envoyroute.RetryPolicy {
 		numRetries = 1
 	}
 
+	retryBackOff, err := convertBackOff(policy.GetRetryBackOff())
+	if err != nil {
+		return nil, err
+	}
+
 	return &envoyroute.RetryPolicy{
-		RetryOn:       policy.RetryOn,
-		NumRetries:    &wrappers.UInt32Value{Value: numRetries},
-		PerTryTimeout: gogoutils.DurationStdToProto(policy.PerTryTimeout),
+		RetryOn:                 policy.RetryOn,
+		NumRetries:              &wrappers.UInt32Value{Value: numRetries},
+		PerTryTimeout:           gogoutils.DurationStdToProto(policy.PerTryTimeout),
+		RetriableStatusCodes:    policy.GetRetriableStatusCodes(),
+		RetriableHeaders:        convertHeaderMatchers(ctx, policy.GetRetriableHeaders()),
+		RetriableRequestHeaders: convertHeaderMatchers(ctx, policy.GetRetriableRequestHeaders()),
+		RetryBackOff:            retryBackOff,
+	}, nil
+}
+
+func convertBackOff(backOff *retries.RetryBackOff) (*envoyroute.RetryPolicy_RetryBackOff, error) {
+	if backOff == nil {
+		return nil, nil
+	}
+	baseInterval := backOff.GetBaseInterval()
+	if baseInterval == nil || *baseInterval <= 0 {
+		return nil, errors.Errorf("the base interval of the retry back-off must be greater than 0")
+	}
+	if maxInterval := backOff.GetMaxInterval(); maxInterval != nil && *maxInterval < *baseInterval {
+		return nil, errors.Errorf("the max interval of the retry back-off must be greater than or equal to its base interval")
+	}
+	return &envoyroute.RetryPolicy_RetryBackOff{
+		BaseInterval: gogoutils.DurationStdToProto(baseInterval),
+		MaxInterval:  gogoutils.DurationStdToProto(backOff.GetMaxInterval()),
+	}, nil
+}
+
+func convertHeaderMatchers(ctx context.Context, in []*matchers.HeaderMatcher) []*envoyroute.HeaderMatcher {
+	var out []*envoyroute.HeaderMatcher
+	for _, matcher := range in {
+		envoyMatch := &envoyroute.HeaderMatcher{
+			Name:        matcher.GetName(),
+			InvertMatch: matcher.GetInvertMatch(),
+		}
+		switch {
+		case matcher.GetValue() == "":
+			envoyMatch.HeaderMatchSpecifier = &envoyroute.HeaderMatcher_PresentMatch{
+				PresentMatch: true,
+			}
+		case matcher.GetRegex():
+			envoyMatch.HeaderMatchSpecifier = &envoyroute.HeaderMatcher_SafeRegexMatch{
+				SafeRegexMatch: regexutils.NewRegex(ctx, matcher.GetValue()),
+			}
+		default:
+			envoyMatch.HeaderMatchSpecifier = &envoyroute.HeaderMatcher_ExactMatch{
+				ExactMatch: matcher.GetValue(),
+			}
+		}
+		out = append(out, envoyMatch)
 	}
+	return out
 }
@@ -1,17 +1,22 @@
 package basicroute_test
 
 import (
+	"context"
 	"time"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
 
 	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoytype "github.com/envoyproxy/go-control-plane/envoy/type"
+	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher"
 	"github.com/gogo/protobuf/types"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/solo-io/gloo/pkg/utils/gogoutils"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/basicroute"
@@ -66,6 +71,84 @@ var _ = Describe("prefix rewrite", func() {
 	})
 })
 
+var _ = Describe("regex rewrite", func() {
+
+	var (
+		plugin      *Plugin
+		routeAction *envoyroute.RouteAction
+		out         *envoyroute.Route
+	)
+
+	BeforeEach(func() {
+		plugin = NewPlugin()
+		routeAction = &envoyroute.RouteAction{}
+		out = &envoyroute.Route{
+			Action: &envoyroute.Route_Route{
+				Route: routeAction,
+			},
+		}
+	})
+
+	It("works", func() {
+		err := plugin.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				RegexRewrite: &v1.RegexRewrite{
+					Pattern:      "^/api/v1/users/([^/]+)$",
+					Substitution: "/users/\\1",
+				},
+			},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(routeAction.RegexRewrite).To(Equal(&envoy_type_matcher.RegexMatchAndSubstitute{
+			Pattern:      regexutils.NewRegex(context.Background(), "^/api/v1/users/([^/]+)$"),
+			Substitution: "/users/\\1",
+		}))
+	})
+
+	It("rejects invalid patterns", func() {
+		err := plugin.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				RegexRewrite: &v1.RegexRewrite{
+					Pattern: "(",
+				},
+			},
+		}, out)
+		Expect(err).To(HaveOccurred())
+
+		err = plugin.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				RegexRewrite: &v1.RegexRewrite{},
+			},
+		}, out)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("can't be set together with a prefix rewrite", func() {
+		err := plugin.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				PrefixRewrite: &types.StringValue{Value: "/foo"},
+				RegexRewrite: &v1.RegexRewrite{
+					Pattern: "^/bar",
+				},
+			},
+		}, out)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("is only available for route actions", func() {
+		err := plugin.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				RegexRewrite: &v1.RegexRewrite{
+					Pattern: "^/bar",
+				},
+			},
+		}, &envoyroute.Route{
+			Action: &envoyroute.Route_Redirect{},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+})
+
 var _ = Describe("timeout", func() {
 	It("works", func() {
 		t := time.Minute
@@ -85,6 +168,60 @@ var _ = Describe("timeout", func() {
 		Expect(routeAction.Timeout).NotTo(BeNil())
 		Expect(routeAction.Timeout).To(Equal(gogoutils.DurationStdToProto(&t)))
 	})
+
+	It("sets the idle timeout", func() {
+		t := time.Minute
+		p := NewPlugin()
+		routeAction := &envoyroute.RouteAction{}
+		out := &envoyroute.Route{
+			Action: &envoyroute.Route_Route{
+				Route: routeAction,
+			},
+		}
+		err := p.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				IdleTimeout: &t,
+			},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(routeAction.IdleTimeout).To(Equal(gogoutils.DurationStdToProto(&t)))
+	})
+
+	It("sets the max grpc timeout and its offset", func() {
+		max, offset := time.Minute, 50*time.Millisecond
+		p := NewPlugin()
+		routeAction := &envoyroute.RouteAction{}
+		out := &envoyroute.Route{
+			Action: &envoyroute.Route_Route{
+				Route: routeAction,
+			},
+		}
+		err := p.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				MaxGrpcTimeout:    &max,
+				GrpcTimeoutOffset: &offset,
+			},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(routeAction.MaxGrpcTimeout).To(Equal(gogoutils.DurationStdToProto(&max)))
+		Expect(routeAction.GrpcTimeoutOffset).To(Equal(gogoutils.DurationStdToProto(&offset)))
+	})
+
+	It("rejects a grpc timeout offset without a max grpc timeout", func() {
+		offset := time.Second
+		p := NewPlugin()
+		out := &envoyroute.Route{
+			Action: &envoyroute.Route_Route{
+				Route: &envoyroute.RouteAction{},
+			},
+		}
+		err := p.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				GrpcTimeoutOffset: &offset,
+			},
+		}, out)
+		Expect(err).To(HaveOccurred())
+	})
 })
 
 var _ = Describe("retries", func() {
@@ -137,6 +274,140 @@ var _ = Describe("retries", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(out.RetryPolicy).To(Equal(expectedRetryPolicy))
 	})
+
+	It("translates the retriable status codes, headers and back-off", func() {
+		base, max := 100*time.Millisecond, time.Second
+		retryPolicy.RetriableStatusCodes = []uint32{503, 504}
+		retryPolicy.RetriableHeaders = []*matchers.HeaderMatcher{
+			{Name: "x-retry", Value: "true"},
+			{Name: "x-upstream-status", Value: "5..", Regex: true},
+		}
+		retryPolicy.RetriableRequestHeaders = []*matchers.HeaderMatcher{
+			{Name: "x-idempotent"},
+		}
+		retryPolicy.RetryBackOff = &retries.RetryBackOff{
+			BaseInterval: &base,
+			MaxInterval:  &max,
+		}
+		expectedRetryPolicy.RetriableStatusCodes = []uint32{503, 504}
+		expectedRetryPolicy.RetriableHeaders = []*envoyroute.HeaderMatcher{
+			{
+				Name:                 "x-retry",
+				HeaderMatchSpecifier: &envoyroute.HeaderMatcher_ExactMatch{ExactMatch: "true"},
+			},
+			{
+				Name:                 "x-upstream-status",
+				HeaderMatchSpecifier: &envoyroute.HeaderMatcher_SafeRegexMatch{SafeRegexMatch: regexutils.NewRegex(context.Background(), "5..")},
+			},
+		}
+		expectedRetryPolicy.RetriableRequestHeaders = []*envoyroute.HeaderMatcher{
+			{
+				Name:                 "x-idempotent",
+				HeaderMatchSpecifier: &envoyroute.HeaderMatcher_PresentMatch{PresentMatch: true},
+			},
+		}
+		expectedRetryPolicy.RetryBackOff = &envoyroute.RetryPolicy_RetryBackOff{
+			BaseInterval: gogoutils.DurationStdToProto(&base),
+			MaxInterval:  gogoutils.DurationStdToProto(&max),
+		}
+
+		out := &envoyroute.VirtualHost{}
+		err := plugin.ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{
+			Options: &v1.VirtualHostOptions{
+				Retries: retryPolicy,
+			},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.RetryPolicy).To(Equal(expectedRetryPolicy))
+	})
+
+	It("rejects a back-off without a base interval", func() {
+		retryPolicy.RetryBackOff = &retries.RetryBackOff{}
+		out := &envoyroute.VirtualHost{}
+		err := plugin.ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{
+			Options: &v1.VirtualHostOptions{
+				Retries: retryPolicy,
+			},
+		}, out)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects a back-off with a max interval less than its base interval", func() {
+		base, max := time.Second, 100*time.Millisecond
+		retryPolicy.RetryBackOff = &retries.RetryBackOff{
+			BaseInterval: &base,
+			MaxInterval:  &max,
+		}
+		out := &envoyroute.VirtualHost{}
+		err := plugin.ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{
+			Options: &v1.VirtualHostOptions{
+				Retries: retryPolicy,
+			},
+		}, out)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("hedge policy", func() {
+
+	var (
+		plugin      *Plugin
+		routeAction *envoyroute.RouteAction
+		out         *envoyroute.Route
+	)
+
+	BeforeEach(func() {
+		plugin = NewPlugin()
+		routeAction = &envoyroute.RouteAction{}
+		out = &envoyroute.Route{
+			Action: &envoyroute.Route_Route{
+				Route: routeAction,
+			},
+		}
+	})
+
+	It("works", func() {
+		err := plugin.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				HedgePolicy: &retries.HedgePolicy{
+					InitialRequests:         &types.UInt32Value{Value: 2},
+					AdditionalRequestChance: 12.5,
+					HedgeOnPerTryTimeout:    true,
+				},
+			},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(routeAction.HedgePolicy).To(Equal(&envoyroute.HedgePolicy{
+			InitialRequests: &wrappers.UInt32Value{Value: 2},
+			AdditionalRequestChance: &envoytype.FractionalPercent{
+				Numerator:   125000,
+				Denominator: envoytype.FractionalPercent_MILLION,
+			},
+			HedgeOnPerTryTimeout: true,
+		}))
+	})
+
+	It("rejects an additional request chance over 100", func() {
+		err := plugin.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				HedgePolicy: &retries.HedgePolicy{
+					AdditionalRequestChance: 101,
+				},
+			},
+		}, out)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects 0 initial requests", func() {
+		err := plugin.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				HedgePolicy: &retries.HedgePolicy{
+					InitialRequests: &types.UInt32Value{Value: 0},
+				},
+			},
+		}, out)
+		Expect(err).To(HaveOccurred())
+	})
 })
 
 var _ = Describe("host rewrite", func() {
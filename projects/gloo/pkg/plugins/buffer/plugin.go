@@ -17,6 +17,10 @@ import (
 // filter should be called after routing decision has been made
 var pluginStage = plugins.DuringStage(plugins.RouteStage)
 
+var (
+	MissingMaxRequestBytesError = eris.New("buffer max_request_bytes must be greater than 0")
+)
+
 func NewPlugin() *Plugin {
 	return &Plugin{}
 }
@@ -39,6 +43,10 @@ func (p *Plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plu
 		return nil, nil
 	}
 
+	if err := validateBuffer(bufferConfig); err != nil {
+		return nil, err
+	}
+
 	bufferFilter, err := plugins.NewStagedFilterWithConfig(wellknown.Buffer, bufferConfig, pluginStage)
 	if err != nil {
 		return nil, eris.Wrapf(err, "generating filter config")
@@ -58,6 +66,9 @@ func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 	}
 
 	if bufPerRoute.GetBuffer() != nil {
+		if err := validateBuffer(bufPerRoute.GetBuffer()); err != nil {
+			return err
+		}
 		config := getBufferConfig(bufPerRoute)
 		return pluginutils.SetRoutePerFilterConfig(out, wellknown.Buffer, config)
 	}
@@ -76,6 +87,9 @@ func (p *Plugin) ProcessVirtualHost(params plugins.VirtualHostParams, in *v1.Vir
 	}
 
 	if bufPerRoute.GetBuffer() != nil {
+		if err := validateBuffer(bufPerRoute.GetBuffer()); err != nil {
+			return err
+		}
 		config := getBufferConfig(bufPerRoute)
 		return pluginutils.SetVhostPerFilterConfig(out, wellknown.Buffer, config)
 	}
@@ -94,6 +108,9 @@ func (p *Plugin) ProcessWeightedDestination(params plugins.RouteParams, in *v1.W
 	}
 
 	if bufPerRoute.GetBuffer() != nil {
+		if err := validateBuffer(bufPerRoute.GetBuffer()); err != nil {
+			return err
+		}
 		config := getBufferConfig(bufPerRoute)
 		return pluginutils.SetWeightedClusterPerFilterConfig(out, wellknown.Buffer, config)
 	}
@@ -101,6 +118,14 @@ func (p *Plugin) ProcessWeightedDestination(params plugins.RouteParams, in *v1.W
 	return nil
 }
 
+// envoy rejects buffer configs without a limit, so catch these before they reach it
+func validateBuffer(bufferConfig *buffer.Buffer) error {
+	if bufferConfig.GetMaxRequestBytes().GetValue() == 0 {
+		return MissingMaxRequestBytesError
+	}
+	return nil
+}
+
 func getNoBufferConfig() *envoybuffer.BufferPerRoute {
 	return &envoybuffer.BufferPerRoute{
 		Override: &envoybuffer.BufferPerRoute_Disabled{
@@ -179,4 +179,31 @@ var _ = Describe("Plugin", func() {
 		Expect(cfg.GetBuffer().GetMaxRequestBytes().GetValue()).To(Equal(uint32(4098)))
 	})
 
+	It("errors if the listener buffer config has no limit", func() {
+		_, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{
+				Buffer: &v3.Buffer{},
+			},
+		})
+		Expect(err).To(MatchError(MissingMaxRequestBytesError))
+	})
+
+	It("errors if the route specific buffer config has no limit", func() {
+		p := NewPlugin()
+		out := &envoyroute.Route{}
+		err := p.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				BufferPerRoute: &v3.BufferPerRoute{
+					Override: &v3.BufferPerRoute_Buffer{
+						Buffer: &v3.Buffer{
+							MaxRequestBytes: &types.UInt32Value{},
+						},
+					},
+				},
+			},
+		}, out)
+		Expect(err).To(MatchError(MissingMaxRequestBytesError))
+		Expect(out.GetTypedPerFilterConfig()).To(BeEmpty())
+	})
+
 })
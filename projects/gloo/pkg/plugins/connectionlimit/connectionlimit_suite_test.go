@@ -0,0 +1,13 @@
+package connectionlimit_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestConnectionLimit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Connection Limit Suite")
+}
@@ -0,0 +1,68 @@
+package connectionlimit
+
+import (
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	"github.com/gogo/protobuf/types"
+	"github.com/rotisserie/eris"
+	envoyconnectionlimit "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/network/connection_limit/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+const (
+	FilterName = "envoy.filters.network.connection_limit"
+)
+
+var (
+	_ plugins.Plugin         = new(Plugin)
+	_ plugins.ListenerPlugin = new(Plugin)
+
+	MissingMaxActiveConnectionsErr = eris.New("connection limit max_active_connections must be greater than 0")
+)
+
+// The plugin adds the connection limit filter to the filter chains of the listeners with a connection limit
+type Plugin struct{}
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+func (p *Plugin) Init(_ plugins.InitParams) error {
+	return nil
+}
+
+// ProcessListener adds the connection limit filter as the first filter of each filter chain, so that the connections
+// over the limit are closed before the other filters process them. The filter chains of a listener share the limit.
+func (p *Plugin) ProcessListener(_ plugins.Params, in *v1.Listener, out *envoyapi.Listener) error {
+	connectionLimit := in.GetOptions().GetConnectionLimit()
+	if connectionLimit == nil {
+		return nil
+	}
+	if connectionLimit.GetMaxActiveConnections().GetValue() == 0 {
+		return MissingMaxActiveConnectionsErr
+	}
+
+	config := &envoyconnectionlimit.ConnectionLimit{
+		StatPrefix:     in.GetName(),
+		MaxConnections: &types.UInt64Value{Value: uint64(connectionLimit.GetMaxActiveConnections().GetValue())},
+	}
+	if delay := connectionLimit.GetDelayBeforeClose(); delay != nil {
+		config.Delay = types.DurationProto(*delay)
+	}
+	typedConfig, err := utils.MessageToAny(config)
+	if err != nil {
+		return err
+	}
+
+	for _, filterChain := range out.GetFilterChains() {
+		filterChain.Filters = append([]*envoylistener.Filter{{
+			Name: FilterName,
+			ConfigType: &envoylistener.Filter_TypedConfig{
+				TypedConfig: typedConfig,
+			},
+		}}, filterChain.Filters...)
+	}
+	return nil
+}
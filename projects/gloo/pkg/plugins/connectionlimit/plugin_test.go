@@ -0,0 +1,83 @@
+package connectionlimit_test
+
+import (
+	"time"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	envoyconnectionlimit "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/network/connection_limit/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/connection_limit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/connectionlimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		plugin *Plugin
+		out    *envoyapi.Listener
+	)
+
+	BeforeEach(func() {
+		plugin = NewPlugin()
+		out = &envoyapi.Listener{
+			FilterChains: []*envoylistener.FilterChain{
+				{Filters: []*envoylistener.Filter{{Name: wellknown.HTTPConnectionManager}}},
+				{Filters: []*envoylistener.Filter{{Name: wellknown.TCPProxy}}},
+			},
+		}
+	})
+
+	It("does nothing without a connection limit", func() {
+		err := plugin.ProcessListener(plugins.Params{}, &v1.Listener{}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.FilterChains[0].Filters).To(HaveLen(1))
+		Expect(out.FilterChains[1].Filters).To(HaveLen(1))
+	})
+
+	It("adds the connection limit filter first to each filter chain", func() {
+		delay := time.Second
+		in := &v1.Listener{
+			Name: "listener",
+			Options: &v1.ListenerOptions{
+				ConnectionLimit: &connection_limit.ConnectionLimit{
+					MaxActiveConnections: &types.UInt32Value{Value: 100},
+					DelayBeforeClose:     &delay,
+				},
+			},
+		}
+
+		err := plugin.ProcessListener(plugins.Params{}, in, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		expectedConfig := utils.MustMessageToAny(&envoyconnectionlimit.ConnectionLimit{
+			StatPrefix:     "listener",
+			MaxConnections: &types.UInt64Value{Value: 100},
+			Delay:          &types.Duration{Seconds: 1},
+		})
+		for _, filterChain := range out.FilterChains {
+			Expect(filterChain.Filters).To(HaveLen(2))
+			Expect(filterChain.Filters[0].Name).To(Equal(FilterName))
+			Expect(filterChain.Filters[0].GetTypedConfig()).To(Equal(expectedConfig))
+		}
+		Expect(out.FilterChains[0].Filters[1].Name).To(Equal(wellknown.HTTPConnectionManager))
+		Expect(out.FilterChains[1].Filters[1].Name).To(Equal(wellknown.TCPProxy))
+	})
+
+	It("rejects a connection limit without max active connections", func() {
+		in := &v1.Listener{
+			Options: &v1.ListenerOptions{
+				ConnectionLimit: &connection_limit.ConnectionLimit{},
+			},
+		}
+
+		err := plugin.ProcessListener(plugins.Params{}, in, out)
+		Expect(err).To(MatchError(MissingMaxActiveConnectionsErr))
+	})
+})
@@ -0,0 +1,301 @@
+package consul
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	consulapi "github.com/hashicorp/consul/api"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/go-utils/errutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const (
+	// The name of the in-memory secret which holds the Connect certificates of Gloo
+	ConnectSecretName = "consul-connect"
+	// The service Gloo requests its Connect leaf certificate for, if none is configured
+	DefaultConnectServiceName = "gloo"
+)
+
+// Routes the requests to connect enabled upstreams through their Connect sidecar proxies, presenting the
+// Connect leaf certificate of Gloo. Upstreams with an explicit SSL configuration are left untouched.
+func (p *plugin) processConnectUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
+	if p.connectSecretRef == nil || !in.GetConsul().GetConnectEnabled() || in.GetSslConfig() != nil {
+		return nil
+	}
+
+	sslConfig := &v1.UpstreamSslConfig{
+		SslSecrets: &v1.UpstreamSslConfig_SecretRef{SecretRef: p.connectSecretRef},
+	}
+	cfg, err := utils.NewSslConfigTranslator().ResolveUpstreamSslConfig(params.Snapshot.Secrets, sslConfig)
+	if err != nil {
+		return err
+	}
+	out.TransportSocket = &envoycore.TransportSocket{
+		Name:       wellknown.TransportSocketTls,
+		ConfigType: &envoycore.TransportSocket_TypedConfig{TypedConfig: utils.MustMessageToAny(cfg)},
+	}
+	return nil
+}
+
+// NewConnectSecretClient returns a secret client which adds to the secrets of the given client a TLS secret
+// with the Connect leaf certificate of the given service and the Connect CA roots, as served by the Consul agent.
+// The secret is updated whenever Consul rotates the certificates, so that the upstreams using it get re-translated.
+//
+// NOTE: any method except List and Watch is delegated to the given client.
+func NewConnectSecretClient(secretClient v1.SecretClient, agent consul.ConnectAgent, serviceName string, secretRef core.ResourceRef, retryInterval time.Duration) v1.SecretClient {
+	return &connectSecretClient{
+		SecretClient:  secretClient,
+		agent:         agent,
+		serviceName:   serviceName,
+		secretRef:     secretRef,
+		retryInterval: retryInterval,
+	}
+}
+
+type connectSecretClient struct {
+	v1.SecretClient
+	agent         consul.ConnectAgent
+	serviceName   string
+	secretRef     core.ResourceRef
+	retryInterval time.Duration
+}
+
+func (c *connectSecretClient) List(namespace string, opts clients.ListOpts) (v1.SecretList, error) {
+	secrets, err := c.SecretClient.List(namespace, opts)
+	if err != nil || !c.watchesSecret(namespace) {
+		return secrets, err
+	}
+
+	queryOpts := (&consulapi.QueryOptions{}).WithContext(opts.Ctx)
+	leaf, _, err := c.agent.ConnectCALeaf(c.serviceName, queryOpts)
+	if err != nil {
+		return nil, err
+	}
+	roots, _, err := c.agent.ConnectCARoots(queryOpts)
+	if err != nil {
+		return nil, err
+	}
+	return append(secrets, c.connectSecret(leaf, roots)).Sort(), nil
+}
+
+func (c *connectSecretClient) Watch(namespace string, opts clients.WatchOpts) (<-chan v1.SecretList, <-chan error, error) {
+	secretsChan, secretErrs, err := c.SecretClient.Watch(namespace, opts)
+	if err != nil || !c.watchesSecret(namespace) {
+		return secretsChan, secretErrs, err
+	}
+
+	opts = opts.WithDefaults()
+	connectSecretChan, connectErrs := c.watchConnectSecret(opts.Ctx)
+
+	errs := make(chan error)
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		errutils.AggregateErrs(opts.Ctx, errs, secretErrs, "secrets")
+	}()
+	go func() {
+		defer wg.Done()
+		errutils.AggregateErrs(opts.Ctx, errs, connectErrs, "consul connect certificates")
+	}()
+	go func() {
+		wg.Wait()
+		close(errs)
+	}()
+
+	secretsOut := make(chan v1.SecretList)
+	go func() {
+		defer close(secretsOut)
+		var (
+			secrets       v1.SecretList
+			connectSecret *v1.Secret
+			secretsRead   bool
+		)
+		for {
+			select {
+			case list, ok := <-secretsChan:
+				if !ok {
+					return
+				}
+				secrets, secretsRead = list, true
+			case secret, ok := <-connectSecretChan:
+				if !ok {
+					return
+				}
+				connectSecret = secret
+			case <-opts.Ctx.Done():
+				return
+			}
+
+			// Honor the contract of Watch functions to open with the complete list of secrets
+			if !secretsRead {
+				continue
+			}
+			result := secrets.Clone()
+			if connectSecret != nil {
+				result = append(result, connectSecret).Sort()
+			}
+			select {
+			case secretsOut <- result:
+			case <-opts.Ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return secretsOut, errs, nil
+}
+
+func (c *connectSecretClient) watchesSecret(namespace string) bool {
+	return namespace == "" || namespace == c.secretRef.Namespace
+}
+
+// Watches the Connect leaf certificate and CA roots with blocking queries, and sends the secret holding them
+// whenever either of them changes.
+func (c *connectSecretClient) watchConnectSecret(ctx context.Context) (<-chan *v1.Secret, <-chan error) {
+	var (
+		leafChan  = make(chan *consulapi.LeafCert)
+		rootsChan = make(chan *consulapi.CARootList)
+		errs      = make(chan error)
+		wg        sync.WaitGroup
+	)
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		c.watchBlockingQuery(ctx, errs, func(q *consulapi.QueryOptions) (*consulapi.QueryMeta, error) {
+			leaf, queryMeta, err := c.agent.ConnectCALeaf(c.serviceName, q)
+			if err == nil {
+				select {
+				case leafChan <- leaf:
+				case <-ctx.Done():
+				}
+			}
+			return queryMeta, err
+		})
+	}()
+	go func() {
+		defer wg.Done()
+		c.watchBlockingQuery(ctx, errs, func(q *consulapi.QueryOptions) (*consulapi.QueryMeta, error) {
+			roots, queryMeta, err := c.agent.ConnectCARoots(q)
+			if err == nil {
+				select {
+				case rootsChan <- roots:
+				case <-ctx.Done():
+				}
+			}
+			return queryMeta, err
+		})
+	}()
+	go func() {
+		wg.Wait()
+		close(errs)
+	}()
+
+	secretChan := make(chan *v1.Secret)
+	go func() {
+		defer close(secretChan)
+		var (
+			leaf     *consulapi.LeafCert
+			roots    *consulapi.CARootList
+			previous *v1.Secret
+		)
+		for {
+			select {
+			case leaf = <-leafChan:
+			case roots = <-rootsChan:
+			case <-ctx.Done():
+				return
+			}
+			if leaf == nil || roots == nil {
+				continue
+			}
+
+			secret := c.connectSecret(leaf, roots)
+			if previous.Equal(secret) {
+				continue
+			}
+			previous = secret
+
+			select {
+			case secretChan <- secret:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return secretChan, errs
+}
+
+// Runs the given blocking query (see [here](https://www.consul.io/api/features/blocking.html) for more info) until the
+// context is cancelled. If the query fails, the error is sent on the error channel and the query is retried with the
+// retry interval.
+func (c *connectSecretClient) watchBlockingQuery(ctx context.Context, errs chan<- error, query func(q *consulapi.QueryOptions) (*consulapi.QueryMeta, error)) {
+	var lastIndex uint64
+	for {
+		queryMeta, err := query((&consulapi.QueryOptions{WaitIndex: lastIndex}).WithContext(ctx))
+		if ctx.Err() != nil {
+			return
+		}
+
+		var index uint64
+		if err == nil && queryMeta != nil {
+			index = queryMeta.LastIndex
+		}
+		if err != nil {
+			select {
+			case errs <- err:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		// The index went backwards, start over
+		if index < lastIndex {
+			index = 0
+		}
+		lastIndex = index
+
+		// The query failed or can't be blocked on
+		if index == 0 {
+			select {
+			case <-time.After(c.retryInterval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+func (c *connectSecretClient) connectSecret(leaf *consulapi.LeafCert, roots *consulapi.CARootList) *v1.Secret {
+	// Trust every root, so that certificates signed by the previous root stay valid while the CA is rotated
+	var rootCas []string
+	for _, root := range roots.Roots {
+		rootCas = append(rootCas, root.RootCertPEM)
+	}
+
+	return &v1.Secret{
+		Metadata: core.Metadata{
+			Name:      c.secretRef.Name,
+			Namespace: c.secretRef.Namespace,
+		},
+		Kind: &v1.Secret_Tls{
+			Tls: &v1.TlsSecret{
+				CertChain:  leaf.CertPEM,
+				PrivateKey: leaf.PrivateKeyPEM,
+				RootCa:     strings.Join(rootCas, "\n"),
+			},
+		},
+	}
+}
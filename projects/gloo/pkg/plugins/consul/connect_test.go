@@ -0,0 +1,184 @@
+package consul
+
+import (
+	"context"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes"
+	consulapi "github.com/hashicorp/consul/api"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	mock_consul "github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul/mocks"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Consul Connect", func() {
+
+	var (
+		ctrl      *gomock.Controller
+		secretRef = core.ResourceRef{Name: ConnectSecretName, Namespace: "gloo-system"}
+
+		leaf  = &consulapi.LeafCert{CertPEM: "leaf-cert", PrivateKeyPEM: "leaf-key"}
+		roots = &consulapi.CARootList{Roots: []*consulapi.CARoot{
+			{RootCertPEM: "root-1"},
+			{RootCertPEM: "root-2"},
+		}}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(T)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("ProcessUpstream", func() {
+
+		var (
+			params   plugins.Params
+			upstream *v1.Upstream
+			out      *envoyapi.Cluster
+		)
+
+		BeforeEach(func() {
+			params = plugins.Params{Snapshot: &v1.ApiSnapshot{Secrets: v1.SecretList{{
+				Metadata: core.Metadata{Name: secretRef.Name, Namespace: secretRef.Namespace},
+				Kind: &v1.Secret_Tls{Tls: &v1.TlsSecret{
+					CertChain:  "leaf-cert",
+					PrivateKey: "leaf-key",
+					RootCa:     "root-1",
+				}},
+			}}}}
+			upstream = createTestUpstream("svc", "svc", nil, []string{"dc1"})
+			upstream.GetConsul().ConnectEnabled = true
+			out = &envoyapi.Cluster{}
+		})
+
+		It("presents the Connect certificates to the sidecars of connect enabled upstreams", func() {
+			err := NewPlugin(nil, nil, nil, &secretRef, nil).ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.TransportSocket).NotTo(BeNil())
+
+			var tlsContext envoyauth.UpstreamTlsContext
+			err = ptypes.UnmarshalAny(out.TransportSocket.GetTypedConfig(), &tlsContext)
+			Expect(err).NotTo(HaveOccurred())
+			certificate := tlsContext.GetCommonTlsContext().GetTlsCertificates()[0]
+			Expect(certificate.GetCertificateChain().GetInlineString()).To(Equal("leaf-cert"))
+			Expect(certificate.GetPrivateKey().GetInlineString()).To(Equal("leaf-key"))
+			Expect(tlsContext.GetCommonTlsContext().GetValidationContext().GetTrustedCa().GetInlineString()).To(Equal("root-1"))
+		})
+
+		It("does not change upstreams when Connect is not configured", func() {
+			err := NewPlugin(nil, nil, nil, nil, nil).ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.TransportSocket).To(BeNil())
+		})
+
+		It("does not change upstreams which are not connect enabled", func() {
+			upstream.GetConsul().ConnectEnabled = false
+			err := NewPlugin(nil, nil, nil, &secretRef, nil).ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.TransportSocket).To(BeNil())
+		})
+
+		It("does not override the SSL configuration of upstreams", func() {
+			upstream.SslConfig = &v1.UpstreamSslConfig{Sni: "custom"}
+			err := NewPlugin(nil, nil, nil, &secretRef, nil).ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.TransportSocket).To(BeNil())
+		})
+	})
+
+	Describe("ConnectSecretClient", func() {
+
+		var (
+			ctx          context.Context
+			cancel       context.CancelFunc
+			agent        *mock_consul.MockConnectAgent
+			secretClient v1.SecretClient
+			otherSecret  *v1.Secret
+		)
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+			agent = mock_consul.NewMockConnectAgent(ctrl)
+
+			var err error
+			secretClient, err = v1.NewSecretClient(&factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
+			Expect(err).NotTo(HaveOccurred())
+			otherSecret, err = secretClient.Write(&v1.Secret{
+				Metadata: core.Metadata{Name: "other", Namespace: secretRef.Namespace},
+			}, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			cancel()
+		})
+
+		expectedSecret := func(certChain string) *v1.Secret {
+			return &v1.Secret{
+				Metadata: core.Metadata{Name: secretRef.Name, Namespace: secretRef.Namespace},
+				Kind: &v1.Secret_Tls{Tls: &v1.TlsSecret{
+					CertChain:  certChain,
+					PrivateKey: "leaf-key",
+					RootCa:     "root-1\nroot-2",
+				}},
+			}
+		}
+
+		It("lists the Connect certificates along with the other secrets", func() {
+			agent.EXPECT().ConnectCALeaf("gloo", gomock.Any()).Return(leaf, &consulapi.QueryMeta{LastIndex: 1}, nil)
+			agent.EXPECT().ConnectCARoots(gomock.Any()).Return(roots, &consulapi.QueryMeta{LastIndex: 1}, nil)
+
+			client := NewConnectSecretClient(secretClient, agent, "gloo", secretRef, DefaultDnsPollingInterval)
+			secrets, err := client.List(secretRef.Namespace, clients.ListOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secrets).To(Equal(v1.SecretList{expectedSecret("leaf-cert"), otherSecret}))
+		})
+
+		It("does not add the Connect certificates to other namespaces", func() {
+			client := NewConnectSecretClient(secretClient, agent, "gloo", secretRef, DefaultDnsPollingInterval)
+			secrets, err := client.List("other-namespace", clients.ListOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secrets).To(BeEmpty())
+		})
+
+		It("updates the secret when the leaf certificate is rotated", func() {
+			rotated := &consulapi.LeafCert{CertPEM: "rotated-cert", PrivateKeyPEM: "leaf-key"}
+			agent.EXPECT().ConnectCALeaf("gloo", gomock.Any()).DoAndReturn(
+				func(service string, q *consulapi.QueryOptions) (*consulapi.LeafCert, *consulapi.QueryMeta, error) {
+					switch q.WaitIndex {
+					case 0:
+						return leaf, &consulapi.QueryMeta{LastIndex: 1}, nil
+					case 1:
+						return rotated, &consulapi.QueryMeta{LastIndex: 2}, nil
+					}
+					<-q.Context().Done()
+					return nil, nil, q.Context().Err()
+				}).MinTimes(2)
+			agent.EXPECT().ConnectCARoots(gomock.Any()).DoAndReturn(
+				func(q *consulapi.QueryOptions) (*consulapi.CARootList, *consulapi.QueryMeta, error) {
+					if q.WaitIndex == 0 {
+						return roots, &consulapi.QueryMeta{LastIndex: 1}, nil
+					}
+					<-q.Context().Done()
+					return nil, nil, q.Context().Err()
+				}).MinTimes(1)
+
+			client := NewConnectSecretClient(secretClient, agent, "gloo", secretRef, DefaultDnsPollingInterval)
+			secretsChan, _, err := client.Watch(secretRef.Namespace, clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(secretsChan).Should(Receive(ContainElement(expectedSecret("rotated-cert"))))
+		})
+	})
+})
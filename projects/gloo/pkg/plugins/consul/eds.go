@@ -17,6 +17,8 @@ import (
 	"github.com/solo-io/go-utils/kubeutils"
 
 	"github.com/solo-io/gloo/projects/gloo/constants"
+	consulplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/consul"
+	"github.com/solo-io/gloo/projects/gloo/pkg/dns"
 
 	"github.com/solo-io/go-utils/contextutils"
 
@@ -27,7 +29,6 @@ import (
 	"github.com/solo-io/go-utils/errutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
-	"golang.org/x/sync/errgroup"
 )
 
 // Starts a watch on the Consul service metadata endpoint for all the services associated with the tracked upstreams.
@@ -37,12 +38,23 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 
 	// Filter out non-consul upstreams
 	trackedServiceToUpstreams := make(map[string][]*v1.Upstream)
+	// How the instances of each tracked service are queried
+	serviceQueries := make(map[string]serviceQuery)
 	var previousSpecs []*consulapi.CatalogService
 	var previousHash uint64
 	for _, us := range upstreamsToTrack {
 		if consulUsSpec := us.GetConsul(); consulUsSpec != nil {
 			// We generate one upstream for every Consul service name, so this should never happen.
 			trackedServiceToUpstreams[consulUsSpec.ServiceName] = append(trackedServiceToUpstreams[consulUsSpec.ServiceName], us)
+			query := serviceQueries[consulUsSpec.ServiceName]
+			if p.connectSecretRef != nil && consulUsSpec.ConnectEnabled {
+				query.connect = true
+			}
+			// If several upstreams of the service reference a token, the first one wins
+			if ref := consulUsSpec.TokenSecretRef; ref != nil && query.tokenSecret == (core.ResourceRef{}) {
+				query.tokenSecret = *ref
+			}
+			serviceQueries[consulUsSpec.ServiceName] = query
 		}
 	}
 
@@ -53,7 +65,8 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 
 	serviceMetaChan, servicesWatchErrChan := p.client.WatchServices(opts.Ctx, dataCenters)
 
-	errChan := make(chan error)
+	// buffered, as the errors of the instance watches are dropped rather than waiting for the consumer
+	errChan := make(chan error, 10)
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
@@ -72,7 +85,7 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 		// Use closure to allow cancel function to be updated as context changes
 		defer func() { cancel() }()
 
-		timer := time.NewTicker(DefaultDnsPollingInterval)
+		timer := time.NewTicker(p.dnsPollingInterval)
 
 		publishEndpoints := func(endpoints v1.EndpointList) bool {
 			if opts.Ctx.Err() != nil {
@@ -86,6 +99,9 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 			return true
 		}
 
+		// Receives the specs of the tracked services whenever they change; nil until the first service metadata is received
+		var specsChan <-chan []*consulapi.CatalogService
+
 		for {
 			// don't leak the timer.
 			defer timer.Stop()
@@ -95,24 +111,35 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 					return
 				}
 
-				// Cancel any running requests from previous iteration and set new context/cancel
+				// Cancel the watches from previous iteration and set new context/cancel
 				cancel()
 				ctx, newCancel := context.WithCancel(opts.Ctx)
 				cancel = newCancel
 
-				specs := refreshSpecs(ctx, p.client, serviceMeta, errChan)
-				endpoints := buildEndpointsFromSpecs(opts.Ctx, writeNamespace, p.resolver, specs, trackedServiceToUpstreams)
+				specsChan = watchSpecs(ctx, &wg, p.client, p.tokenResolver, serviceMeta, serviceQueries, p.dnsPollingInterval, errChan)
+
+			case specs, ok := <-specsChan:
+				if !ok {
+					specsChan = nil
+					continue
+				}
 
-				previousHash = hashutils.MustHash(endpoints)
+				endpoints := buildEndpointsFromSpecs(opts.Ctx, writeNamespace, p.resolvers, specs, trackedServiceToUpstreams)
 				previousSpecs = specs
 
+				currentHash := hashutils.MustHash(endpoints)
+				if previousHash == currentHash {
+					continue
+				}
+
+				previousHash = currentHash
 				if !publishEndpoints(endpoints) {
 					return
 				}
 
 			case <-timer.C:
 				// Poll to ensure any DNS updates get picked up in endpoints for EDS
-				endpoints := buildEndpointsFromSpecs(opts.Ctx, writeNamespace, p.resolver, previousSpecs, trackedServiceToUpstreams)
+				endpoints := buildEndpointsFromSpecs(opts.Ctx, writeNamespace, p.resolvers, previousSpecs, trackedServiceToUpstreams)
 
 				currentHash := hashutils.MustHash(endpoints)
 				if previousHash == currentHash {
@@ -137,56 +164,224 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 	return endpointsChan, errChan, nil
 }
 
-func refreshSpecs(ctx context.Context, client consul.ConsulWatcher, serviceMeta []*consul.ServiceMeta, errChan chan error) []*consulapi.CatalogService {
-	logger := contextutils.LoggerFrom(contextutils.WithLogger(ctx, "consul_eds"))
+// How the catalog entries of a service are queried
+type serviceQuery struct {
+	// if true, the entries are the Connect sidecar proxies of the service instead of the service itself
+	connect bool
+	// if set, the secret holding the ACL token used to query the entries
+	tokenSecret core.ResourceRef
+}
 
-	specs := newSpecCollector()
+// Identifies the catalog entries of a service in a data center
+type instancesKey struct {
+	dataCenter string
+	service    string
+	serviceQuery
+}
 
-	// Get complete service information for every dataCenter:service tuple in separate goroutines
-	var eg errgroup.Group
+type serviceInstances struct {
+	key   instancesKey
+	specs []*consulapi.CatalogService
+}
+
+// Watches the complete specs of every dataCenter:service tuple in separate goroutines, which are added to the given
+// wait group. The specs of all tuples are sent on the returned channel once each tuple has been read, then again
+// whenever one of them changes. The channel is closed when the context is cancelled.
+func watchSpecs(ctx context.Context, wg *sync.WaitGroup, client consul.ConsulWatcher, tokenResolver consul.TokenResolver, serviceMeta []*consul.ServiceMeta, serviceQueries map[string]serviceQuery, pollingInterval time.Duration, errChan chan error) <-chan []*consulapi.CatalogService {
+	// Don't stop watching if an error occurred. We still want to propagate the endpoints for the requests that
+	// succeeded. Any inconsistencies will be caught by the Gloo translator.
+	// Errors are sent without blocking, so that a slow consumer of the error channel doesn't stall the watches.
+	reportErr := func(err error) {
+		select {
+		case errChan <- err:
+		default:
+			contextutils.LoggerFrom(ctx).Errorf("write error channel is full! could not propagate err: %v", err)
+		}
+	}
+
+	keys := make(map[instancesKey]bool)
 	for _, service := range serviceMeta {
 		for _, dataCenter := range service.DataCenters {
+			keys[instancesKey{dataCenter: dataCenter, service: service.Name, serviceQuery: serviceQueries[service.Name]}] = true
+		}
+	}
+
+	updates := make(chan serviceInstances)
+	var watches sync.WaitGroup
+	for key := range keys {
+		// Copy iterator variables before passing them to goroutines!
+		key := key
+		wg.Add(1)
+		watches.Add(1)
+		go func() {
+			defer wg.Done()
+			defer watches.Done()
+			watchServiceInstances(ctx, client, tokenResolver, key, pollingInterval, updates, reportErr)
+		}()
+	}
+	go func() {
+		watches.Wait()
+		close(updates)
+	}()
 
-			// Copy iterator variables before passing them to goroutines!
-			svc := service
-			dcName := dataCenter
+	specsChan := make(chan []*consulapi.CatalogService)
+	go func() {
+		defer close(specsChan)
+		instances := make(map[instancesKey][]*consulapi.CatalogService)
+		for update := range updates {
+			instances[update.key] = update.specs
+
+			// Wait for the initial read of every tuple, so that we don't publish a partial list of endpoints
+			if len(instances) < len(keys) {
+				continue
+			}
 
-			// Get complete spec for each service in parallel
-			eg.Go(func() error {
-				queryOpts := &consulapi.QueryOptions{Datacenter: dcName, RequireConsistent: true}
+			var specs []*consulapi.CatalogService
+			for _, tupleSpecs := range instances {
+				specs = append(specs, tupleSpecs...)
+			}
 
-				services, _, err := client.Service(svc.Name, "", queryOpts.WithContext(ctx))
-				if err != nil {
-					return err
-				}
+			select {
+			case specsChan <- specs:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return specsChan
+}
 
-				specs.Add(services)
+// Watches the catalog entries of a service in a data center and sends them on the updates channel whenever they change.
+// We use blocking queries (see [here](https://www.consul.io/api/features/blocking.html) for more info) so that changes
+// are propagated as soon as Consul commits them. While the queries fail, we fall back to polling the catalog with the
+// given interval.
+func watchServiceInstances(ctx context.Context, client consul.ConsulWatcher, tokenResolver consul.TokenResolver, key instancesKey, pollingInterval time.Duration, updates chan<- serviceInstances, reportErr func(error)) {
+	var (
+		lastIndex uint64
+		read      bool
+	)
+	for {
+		// The first invocation (with lastIndex equal to zero) will return immediately
+		queryOpts := &consulapi.QueryOptions{Datacenter: key.dataCenter, RequireConsistent: true, WaitIndex: lastIndex}
+		specs, queryMeta, err := queryServiceInstances(client, tokenResolver, key, queryOpts.WithContext(ctx))
+		if ctx.Err() != nil {
+			return
+		}
+
+		var index uint64
+		if queryMeta != nil {
+			index = queryMeta.LastIndex
+		}
 
-				return nil
-			})
+		changed, poll := true, false
+		switch {
+		case err != nil:
+			reportErr(err)
+			// Keep the specs from the last successful query. If there is none, publish the tuple as empty
+			// so that the endpoints of the other tuples still get published.
+			specs, changed, poll = nil, !read, true
+			index = 0
+		case index == 0:
+			// The response can't be used to block on, so poll instead
+			poll = true
+		case index < lastIndex:
+			// The index went backwards (e.g. the Consul servers were restored from a snapshot), start over
+			index = 0
+		case index == lastIndex:
+			// The query timed out without any change
+			changed = false
+		}
+
+		if changed {
+			read = true
+			select {
+			case updates <- serviceInstances{key: key, specs: specs}:
+			case <-ctx.Done():
+				return
+			}
+		}
+		lastIndex = index
+
+		if poll {
+			select {
+			case <-time.After(pollingInterval):
+			case <-ctx.Done():
+				return
+			}
 		}
 	}
+}
 
-	// Wait for all requests to complete, an error to occur, or for the underlying context to be cancelled.
-	//
-	// Don't return if an error occurred. We still want to propagate the endpoints for the requests that
-	// succeeded. Any inconsistencies will be caught by the Gloo translator.
-	if err := eg.Wait(); err != nil {
-		select {
-		case errChan <- err:
-		default:
-			logger.Errorf("write error channel is full! could not propagate err: %v", err)
+// The instances are queried along with their health checks, which are carried over to the returned catalog entries.
+// Connect enabled services are reached through their sidecar proxies, which are registered as separate services.
+// We attribute the proxies to the service they front, so that they are added to the upstreams of that service.
+func queryServiceInstances(client consul.ConsulWatcher, tokenResolver consul.TokenResolver, key instancesKey, q *consulapi.QueryOptions) ([]*consulapi.CatalogService, *consulapi.QueryMeta, error) {
+	// The token is resolved for every query, so that rotated tokens are picked up
+	if key.tokenSecret != (core.ResourceRef{}) {
+		token, err := resolveToken(q.Context(), tokenResolver, key.tokenSecret)
+		if err != nil {
+			return nil, nil, err
 		}
+		q.Token = token
+	}
+
+	// Every instance is queried, as the upstreams of the service filter them by their own criteria
+	query := client.HealthService
+	if key.connect {
+		query = client.HealthConnect
+	}
+	entries, queryMeta, err := query(key.service, "", false, q)
+	if err != nil {
+		return nil, queryMeta, err
+	}
+	specs := make([]*consulapi.CatalogService, 0, len(entries))
+	for _, entry := range entries {
+		spec := toCatalogService(entry, key.dataCenter)
+		spec.ServiceName = key.service
+		specs = append(specs, spec)
 	}
-	return specs.Get()
+	return specs, queryMeta, nil
 }
 
-func buildEndpointsFromSpecs(ctx context.Context, writeNamespace string, resolver DnsResolver, specs []*consulapi.CatalogService, trackedServiceToUpstreams map[string][]*v1.Upstream) v1.EndpointList {
+func toCatalogService(entry *consulapi.ServiceEntry, dataCenter string) *consulapi.CatalogService {
+	spec := &consulapi.CatalogService{
+		Datacenter: dataCenter,
+		Checks:     entry.Checks,
+	}
+	if node := entry.Node; node != nil {
+		spec.ID = node.ID
+		spec.Node = node.Node
+		spec.Address = node.Address
+		if node.Datacenter != "" {
+			spec.Datacenter = node.Datacenter
+		}
+		spec.TaggedAddresses = node.TaggedAddresses
+		spec.NodeMeta = node.Meta
+	}
+	if service := entry.Service; service != nil {
+		spec.ServiceID = service.ID
+		spec.ServiceName = service.Service
+		spec.ServiceAddress = service.Address
+		spec.ServiceTaggedAddresses = service.TaggedAddresses
+		spec.ServiceTags = service.Tags
+		spec.ServiceMeta = service.Meta
+		spec.ServicePort = service.Port
+		spec.ServiceWeights = consulapi.Weights(service.Weights)
+		spec.ServiceEnableTagOverride = service.EnableTagOverride
+		spec.ServiceProxy = service.Proxy
+		spec.CreateIndex = service.CreateIndex
+		spec.ModifyIndex = service.ModifyIndex
+	}
+	return spec
+}
+
+func buildEndpointsFromSpecs(ctx context.Context, writeNamespace string, resolvers *dns.Resolvers, specs []*consulapi.CatalogService, trackedServiceToUpstreams map[string][]*v1.Upstream) v1.EndpointList {
 	var endpoints v1.EndpointList
 	for _, spec := range specs {
 		if upstreams, ok := trackedServiceToUpstreams[spec.ServiceName]; ok {
 			// TODO if buildEndpoints fails temporarily due to dns failure, we will remove it from eds.
 			// tracking issue: https://github.com/solo-io/gloo/issues/2576
+			resolver := resolvers.ForNameservers(serviceNameservers(upstreams))
 			if eps, err := buildEndpoints(ctx, writeNamespace, resolver, spec, upstreams); err != nil {
 				contextutils.LoggerFrom(ctx).Warnf("consul eds plugin encountered error resolving DNS for consul service %v", spec, err)
 			} else {
@@ -202,6 +397,16 @@ func buildEndpointsFromSpecs(ctx context.Context, writeNamespace string, resolve
 	return endpoints
 }
 
+// The instances of a service are resolved with the nameservers of the first of its upstreams which sets any
+func serviceNameservers(upstreams []*v1.Upstream) []string {
+	for _, us := range upstreams {
+		if nameservers := us.GetConsul().GetDnsNameservers(); len(nameservers) > 0 {
+			return nameservers
+		}
+	}
+	return nil
+}
+
 // The ServiceTags on the Consul Upstream(s) represent all tags for Consul services with the given ServiceName across
 // data centers. We create an endpoint label for each of these tags, where the label key is the name of the tag and
 // the label value is "1" if the current service contains the same tag, else "0".
@@ -254,7 +459,20 @@ func BuildDataCenterMetadata(dataCenters []string, upstreams []*v1.Upstream) map
 	return labels
 }
 
-func buildEndpoints(ctx context.Context, namespace string, resolver DnsResolver, service *consulapi.CatalogService, upstreams []*v1.Upstream) ([]*v1.Endpoint, error) {
+// Create a label for each of the service metadata keys the upstreams segment their instances by, and set it to
+// the value of that key in the metadata of the service instance. Keys which are missing from the instance metadata
+// are not labeled, so that the instance is excluded from the subsets matching on them.
+func BuildServiceMetadata(serviceMeta map[string]string, upstreams []*v1.Upstream) map[string]string {
+	labels := make(map[string]string)
+	for _, key := range getUniqueUpstreamMetadataKeys(upstreams) {
+		if value, ok := serviceMeta[key]; ok {
+			labels[constants.ConsulMetadataKeyPrefix+key] = value
+		}
+	}
+	return labels
+}
+
+func buildEndpoints(ctx context.Context, namespace string, resolver dns.Resolver, service *consulapi.CatalogService, upstreams []*v1.Upstream) ([]*v1.Endpoint, error) {
 
 	// Address is the IP address of the Consul node on which the service is registered.
 	// ServiceAddress is the IP address of the service host — if empty, node address should be used
@@ -276,7 +494,7 @@ func buildEndpoints(ctx context.Context, namespace string, resolver DnsResolver,
 }
 
 // only returns an error if the consul service address is a hostname and we can't resolve it
-func getIpAddresses(ctx context.Context, address string, resolver DnsResolver) ([]string, error) {
+func getIpAddresses(ctx context.Context, address string, resolver dns.Resolver) ([]string, error) {
 	addr := net.ParseIP(address)
 	if addr != nil {
 		// the consul service address is an IP address, no need to resolve it!
@@ -311,14 +529,19 @@ func buildEndpoint(namespace, address, ipAddress string, service *consulapi.Cata
 			Hostname: hostname,
 		}
 	}
+	healthStatus := service.Checks.AggregatedStatus()
+	labels := buildLabels(service.ServiceTags, []string{service.Datacenter}, service.ServiceMeta, healthStatus, upstreams)
+	if weight := instanceWeight(service.ServiceWeights, healthStatus); weight > 0 {
+		labels[constants.ConsulWeightKey] = strconv.Itoa(weight)
+	}
 	return &v1.Endpoint{
 		Metadata: core.Metadata{
 			Namespace:       namespace,
 			Name:            buildEndpointName(ipAddress, service),
-			Labels:          buildLabels(service.ServiceTags, []string{service.Datacenter}, upstreams),
+			Labels:          labels,
 			ResourceVersion: strconv.FormatUint(service.ModifyIndex, 10),
 		},
-		Upstreams:   toResourceRefs(upstreams, service.ServiceTags),
+		Upstreams:   toResourceRefs(upstreams, service.ServiceTags, service.Datacenter, healthStatus),
 		Address:     ipAddress,
 		Port:        uint32(service.ServicePort),
 		Hostname:    hostname,
@@ -326,6 +549,18 @@ func buildEndpoint(namespace, address, ipAddress string, service *consulapi.Cata
 	}
 }
 
+// Consul weighs the instances by the status of their health checks. Instances with critical checks or without
+// weights (registered by agents that predate them) are not weighted.
+func instanceWeight(weights consulapi.Weights, healthStatus string) int {
+	switch healthStatus {
+	case consulapi.HealthPassing:
+		return weights.Passing
+	case consulapi.HealthWarning:
+		return weights.Warning
+	}
+	return 0
+}
+
 func buildEndpointName(address string, service *consulapi.CatalogService) string {
 	parts := []string{address, service.ServiceName}
 	if service.ServiceID != "" {
@@ -337,24 +572,51 @@ func buildEndpointName(address string, service *consulapi.CatalogService) string
 }
 
 // The labels will be used by to match the endpoint to the subsets of the cluster represented by the upstream.
-func buildLabels(tags, dataCenters []string, upstreams []*v1.Upstream) map[string]string {
+func buildLabels(tags, dataCenters []string, serviceMeta map[string]string, healthStatus string, upstreams []*v1.Upstream) map[string]string {
 	labels := BuildTagMetadata(tags, upstreams)
 	for dcLabelKey, dcLabelValue := range BuildDataCenterMetadata(dataCenters, upstreams) {
 		labels[dcLabelKey] = dcLabelValue
 	}
+	for metaLabelKey, metaLabelValue := range BuildServiceMetadata(serviceMeta, upstreams) {
+		labels[metaLabelKey] = metaLabelValue
+	}
+	labels[constants.ConsulHealthStatusKey] = healthStatus
 	return labels
 }
 
-func toResourceRefs(upstreams []*v1.Upstream, endpointTags []string) (out []*core.ResourceRef) {
+func toResourceRefs(upstreams []*v1.Upstream, endpointTags []string, dataCenter, healthStatus string) (out []*core.ResourceRef) {
 	for _, us := range upstreams {
 		upstreamTags := us.GetConsul().GetInstanceTags()
-		if shouldAddToUpstream(endpointTags, upstreamTags) {
+		if shouldAddToUpstream(endpointTags, upstreamTags) && inAggregatedDataCenter(us, dataCenter) && passesHealthFilter(us, healthStatus) {
 			out = append(out, utils.ResourceRefPtr(us.Metadata.Ref()))
 		}
 	}
 	return
 }
 
+// upstreams which aggregate their data centers only include the instances from the data centers they list
+func inAggregatedDataCenter(upstream *v1.Upstream, dataCenter string) bool {
+	if !upstream.GetConsul().GetAggregateDataCenters() {
+		return true
+	}
+	for _, dc := range upstream.GetConsul().GetDataCenters() {
+		if dc == dataCenter {
+			return true
+		}
+	}
+	return false
+}
+
+func passesHealthFilter(upstream *v1.Upstream, healthStatus string) bool {
+	switch upstream.GetConsul().GetHealthFilter() {
+	case consulplugin.UpstreamSpec_PASSING:
+		return healthStatus == consulapi.HealthPassing
+	case consulplugin.UpstreamSpec_WARNING:
+		return healthStatus == consulapi.HealthPassing || healthStatus == consulapi.HealthWarning
+	}
+	return true
+}
+
 func shouldAddToUpstream(endpointTags, upstreamTags []string) bool {
 	if len(upstreamTags) == 0 {
 		return true
@@ -398,6 +660,19 @@ func getUniqueUpstreamTags(upstreams []*v1.Upstream) (tags []string) {
 	return
 }
 
+func getUniqueUpstreamMetadataKeys(upstreams []*v1.Upstream) (keys []string) {
+	keyMap := make(map[string]bool)
+	for _, us := range upstreams {
+		for _, key := range us.GetConsul().GetSubsetMetadataKeys() {
+			keyMap[key] = true
+		}
+	}
+	for key := range keyMap {
+		keys = append(keys, key)
+	}
+	return
+}
+
 func getUniqueUpstreamDataCenters(upstreams []*v1.Upstream) (dataCenters []string) {
 	dcMap := make(map[string]bool)
 	for _, us := range upstreams {
@@ -410,29 +685,3 @@ func getUniqueUpstreamDataCenters(upstreams []*v1.Upstream) (dataCenters []strin
 	}
 	return
 }
-
-func newSpecCollector() specCollector {
-	return &collector{}
-}
-
-type specCollector interface {
-	Add([]*consulapi.CatalogService)
-	Get() []*consulapi.CatalogService
-}
-
-type collector struct {
-	mutex sync.RWMutex
-	specs []*consulapi.CatalogService
-}
-
-func (c *collector) Add(specs []*consulapi.CatalogService) {
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
-	c.specs = append(c.specs, specs...)
-}
-
-func (c *collector) Get() []*consulapi.CatalogService {
-	c.mutex.RLock()
-	defer c.mutex.RUnlock()
-	return c.specs
-}
@@ -9,7 +9,8 @@ import (
 	"sync/atomic"
 	"time"
 
-	mock_consul2 "github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul/mocks"
+	"github.com/solo-io/gloo/projects/gloo/pkg/dns"
+	mock_dns "github.com/solo-io/gloo/projects/gloo/pkg/dns/mocks"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
 	mock_consul "github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul/mocks"
@@ -100,13 +101,18 @@ var _ = Describe("Consul EDS", func() {
 			consulWatcherMock.EXPECT().DataCenters().Return(dataCenters, nil).Times(1)
 			consulWatcherMock.EXPECT().WatchServices(gomock.Any(), dataCenters).Return(serviceMetaProducer, errorProducer).Times(1)
 			testService := createTestService(buildHostname(svc1, dc2), dc2, svc1, "c", []string{primary, secondary, canary}, 3456, 100)
-			consulWatcherMock.EXPECT().Service(svc1, gomock.Any(), gomock.Any()).DoAndReturn(
+			consulWatcherMock.EXPECT().HealthService(svc1, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(healthEntries(
 				func(service, tag string, q *consulapi.QueryOptions) ([]*consulapi.CatalogService, *consulapi.QueryMeta, error) {
+					if q.WaitIndex == 100 {
+						// the services never change, so the blocking query only returns when the watch is cancelled
+						<-q.Context().Done()
+						return nil, nil, q.Context().Err()
+					}
 					if q.Datacenter == dc2 {
-						return []*consulapi.CatalogService{testService}, nil, nil
+						return []*consulapi.CatalogService{testService}, &consulapi.QueryMeta{LastIndex: 100}, nil
 					}
-					return nil, nil, nil
-				}).Times(3) // once for each datacenter
+					return nil, &consulapi.QueryMeta{LastIndex: 100}, nil
+				})).MinTimes(3) // once for each datacenter, then once more for each blocking query
 
 			expectedEndpointsFirstAttempt = v1.EndpointList{
 				createExpectedEndpoint(buildEndpointName("2.1.0.10", testService), svc1, testService.Address, "2.1.0.10", "100", writeNamespace, 3456, map[string]string{
@@ -116,6 +122,7 @@ var _ = Describe("Consul EDS", func() {
 					ConsulDataCenterKeyPrefix + dc1: no,
 					ConsulDataCenterKeyPrefix + dc2: yes,
 					ConsulDataCenterKeyPrefix + dc3: no,
+					ConsulHealthStatusKey:           consulapi.HealthPassing,
 				}),
 			}
 
@@ -127,6 +134,7 @@ var _ = Describe("Consul EDS", func() {
 					ConsulDataCenterKeyPrefix + dc1: no,
 					ConsulDataCenterKeyPrefix + dc2: yes,
 					ConsulDataCenterKeyPrefix + dc3: no,
+					ConsulHealthStatusKey:           consulapi.HealthPassing,
 				}),
 			}
 		})
@@ -145,7 +153,7 @@ var _ = Describe("Consul EDS", func() {
 
 			// we have to put all the mock expects before the test starts or else the test may have data races
 			initialIps := []net.IPAddr{{IP: net.IPv4(2, 1, 0, 10)}}
-			mockDnsResolver := mock_consul2.NewMockDnsResolver(ctrl)
+			mockDnsResolver := mock_dns.NewMockResolver(ctrl)
 			mockDnsResolver.EXPECT().Resolve(gomock.Any(), gomock.Any()).Do(func(context.Context, string) {
 				fmt.Fprint(GinkgoWriter, "Initial resolve called.")
 			}).Return(initialIps, nil).Times(1) // once for each consul service
@@ -158,7 +166,7 @@ var _ = Describe("Consul EDS", func() {
 				fmt.Fprint(GinkgoWriter, "Updated resolve called.")
 			}).Return(updatedIps, nil).Times(2)
 
-			eds := NewPlugin(consulWatcherMock, mockDnsResolver, nil)
+			eds := NewPlugin(consulWatcherMock, dns.NewResolvers(mockDnsResolver, dns.CacheOptions{}), nil, nil, nil)
 
 			endpointsChan, errorChan, err := eds.WatchEndpoints(writeNamespace, upstreamsToTrack, clients.WatchOpts{Ctx: ctx})
 
@@ -203,6 +211,195 @@ var _ = Describe("Consul EDS", func() {
 
 	})
 
+	Describe("endpoints watch with blocking queries", func() {
+
+		var (
+			ctx               context.Context
+			cancel            context.CancelFunc
+			consulWatcherMock *mock_consul.MockConsulWatcher
+
+			dc1         = "dc-1"
+			dataCenters = []string{dc1}
+			svc1        = "svc-1"
+
+			upstreamsToTrack    v1.UpstreamList
+			serviceMetaProducer chan []*consul.ServiceMeta
+			errorProducer       chan error
+			pollingInterval     = 100 * time.Millisecond
+
+			firstInstance  = createTestService("1.1.0.1", dc1, svc1, "a", nil, 1234, 100)
+			secondInstance = createTestService("1.1.0.2", dc1, svc1, "b", nil, 1234, 101)
+		)
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+
+			serviceMetaProducer = make(chan []*consul.ServiceMeta)
+			errorProducer = make(chan error)
+
+			upstreamsToTrack = v1.UpstreamList{createTestUpstream(svc1, svc1, nil, dataCenters)}
+
+			consulWatcherMock = mock_consul.NewMockConsulWatcher(ctrl)
+			consulWatcherMock.EXPECT().DataCenters().Return(dataCenters, nil).Times(1)
+			consulWatcherMock.EXPECT().WatchServices(gomock.Any(), dataCenters).Return(serviceMetaProducer, errorProducer).Times(1)
+		})
+
+		AfterEach(func() {
+			cancel()
+			close(serviceMetaProducer)
+			close(errorProducer)
+		})
+
+		// blocks until the watch is cancelled, as a blocking query on services which don't change
+		blockUntilCancelled := func(q *consulapi.QueryOptions) ([]*consulapi.CatalogService, *consulapi.QueryMeta, error) {
+			<-q.Context().Done()
+			return nil, nil, q.Context().Err()
+		}
+
+		endpointNames := func(endpoints v1.EndpointList) []string {
+			var names []string
+			for _, endpoint := range endpoints {
+				names = append(names, endpoint.Metadata.Name)
+			}
+			return names
+		}
+
+		startWatch := func() (<-chan v1.EndpointList, <-chan error) {
+			eds := NewPlugin(consulWatcherMock, nil, &pollingInterval, nil, nil)
+			endpointsChan, errorChan, err := eds.WatchEndpoints(writeNamespace, upstreamsToTrack, clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+
+			// Simulate the initial read when starting watch
+			serviceMetaProducer <- []*consul.ServiceMeta{{Name: svc1, DataCenters: dataCenters}}
+			return endpointsChan, errorChan
+		}
+
+		It("propagates changes to the service instances without new service metadata", func() {
+			consulWatcherMock.EXPECT().HealthService(svc1, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(healthEntries(
+				func(service, tag string, q *consulapi.QueryOptions) ([]*consulapi.CatalogService, *consulapi.QueryMeta, error) {
+					switch q.WaitIndex {
+					case 0:
+						return []*consulapi.CatalogService{firstInstance}, &consulapi.QueryMeta{LastIndex: 100}, nil
+					case 100:
+						// a new instance is registered while the query blocks
+						return []*consulapi.CatalogService{firstInstance, secondInstance}, &consulapi.QueryMeta{LastIndex: 101}, nil
+					}
+					return blockUntilCancelled(q)
+				})).MinTimes(2)
+
+			endpointsChan, _ := startWatch()
+
+			var endpoints v1.EndpointList
+			Eventually(endpointsChan).Should(Receive(&endpoints))
+			Expect(endpointNames(endpoints)).To(Equal([]string{"1-1-0-1-svc-1-a-1234"}))
+			Eventually(endpointsChan).Should(Receive(&endpoints))
+			Expect(endpointNames(endpoints)).To(Equal([]string{"1-1-0-1-svc-1-a-1234", "1-1-0-2-svc-1-b-1234"}))
+			Consistently(endpointsChan).ShouldNot(Receive())
+		})
+
+		It("discovers the Connect sidecar proxies of connect enabled upstreams", func() {
+			upstreamsToTrack[0].GetConsul().ConnectEnabled = true
+			sidecar := createTestService("1.1.0.1", dc1, svc1+consul.ConnectSidecarSuffix, "a-sidecar", nil, 21000, 100)
+			consulWatcherMock.EXPECT().HealthConnect(svc1, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(healthEntries(
+				func(service, tag string, q *consulapi.QueryOptions) ([]*consulapi.CatalogService, *consulapi.QueryMeta, error) {
+					if q.WaitIndex == 0 {
+						return []*consulapi.CatalogService{sidecar}, &consulapi.QueryMeta{LastIndex: 100}, nil
+					}
+					return blockUntilCancelled(q)
+				})).MinTimes(1)
+
+			eds := NewPlugin(consulWatcherMock, nil, &pollingInterval, &core.ResourceRef{Name: ConnectSecretName, Namespace: writeNamespace}, nil)
+			endpointsChan, _, err := eds.WatchEndpoints(writeNamespace, upstreamsToTrack, clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			serviceMetaProducer <- []*consul.ServiceMeta{{Name: svc1, DataCenters: dataCenters}}
+
+			var endpoints v1.EndpointList
+			Eventually(endpointsChan).Should(Receive(&endpoints))
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].Port).To(BeEquivalentTo(21000))
+			Expect(endpoints[0].Upstreams).To(ConsistOf(utils.ResourceRefPtr(upstreamsToTrack[0].Metadata.Ref())))
+			// the sidecar proxy is not modified
+			Expect(sidecar.ServiceName).To(Equal(svc1 + consul.ConnectSidecarSuffix))
+		})
+
+		It("queries the instances of upstreams with their ACL token", func() {
+			tokenRef := core.ResourceRef{Name: "svc-1-token", Namespace: writeNamespace}
+			upstreamsToTrack[0].GetConsul().TokenSecretRef = &tokenRef
+			tokenResolver := mock_consul.NewMockTokenResolver(ctrl)
+			tokenResolver.EXPECT().ResolveToken(gomock.Any(), tokenRef).Return("svc-1-secret", nil).MinTimes(1)
+			consulWatcherMock.EXPECT().HealthService(svc1, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(healthEntries(
+				func(service, tag string, q *consulapi.QueryOptions) ([]*consulapi.CatalogService, *consulapi.QueryMeta, error) {
+					Expect(q.Token).To(Equal("svc-1-secret"))
+					if q.WaitIndex == 0 {
+						return []*consulapi.CatalogService{firstInstance}, &consulapi.QueryMeta{LastIndex: 100}, nil
+					}
+					return blockUntilCancelled(q)
+				})).MinTimes(1)
+
+			eds := NewPlugin(consulWatcherMock, nil, &pollingInterval, nil, tokenResolver)
+			endpointsChan, _, err := eds.WatchEndpoints(writeNamespace, upstreamsToTrack, clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			serviceMetaProducer <- []*consul.ServiceMeta{{Name: svc1, DataCenters: dataCenters}}
+
+			var endpoints v1.EndpointList
+			Eventually(endpointsChan).Should(Receive(&endpoints))
+			Expect(endpointNames(endpoints)).To(Equal([]string{"1-1-0-1-svc-1-a-1234"}))
+		})
+
+		It("falls back to polling when the blocking queries fail", func() {
+			var attempt uint32
+			consulWatcherMock.EXPECT().HealthService(svc1, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(healthEntries(
+				func(service, tag string, q *consulapi.QueryOptions) ([]*consulapi.CatalogService, *consulapi.QueryMeta, error) {
+					switch atomic.AddUint32(&attempt, 1) {
+					case 1:
+						return []*consulapi.CatalogService{firstInstance}, &consulapi.QueryMeta{LastIndex: 100}, nil
+					case 2:
+						Expect(q.WaitIndex).To(BeEquivalentTo(100))
+						return nil, nil, eris.New("blocking query failed")
+					case 3:
+						// after a failure, the catalog is polled with a non-blocking query
+						Expect(q.WaitIndex).To(BeZero())
+						return []*consulapi.CatalogService{firstInstance, secondInstance}, &consulapi.QueryMeta{LastIndex: 101}, nil
+					}
+					return blockUntilCancelled(q)
+				})).MinTimes(3)
+
+			endpointsChan, errorChan := startWatch()
+
+			var endpoints v1.EndpointList
+			Eventually(endpointsChan).Should(Receive(&endpoints))
+			Expect(endpointNames(endpoints)).To(Equal([]string{"1-1-0-1-svc-1-a-1234"}))
+			Eventually(errorChan).Should(Receive(MatchError(ContainSubstring("blocking query failed"))))
+			Eventually(endpointsChan).Should(Receive(&endpoints))
+			Expect(endpointNames(endpoints)).To(Equal([]string{"1-1-0-1-svc-1-a-1234", "1-1-0-2-svc-1-b-1234"}))
+		})
+
+		It("keeps watching when the errors are not consumed", func() {
+			var attempt uint32
+			consulWatcherMock.EXPECT().HealthService(svc1, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(healthEntries(
+				func(service, tag string, q *consulapi.QueryOptions) ([]*consulapi.CatalogService, *consulapi.QueryMeta, error) {
+					switch n := atomic.AddUint32(&attempt, 1); {
+					case n == 1:
+						return []*consulapi.CatalogService{firstInstance}, &consulapi.QueryMeta{LastIndex: 100}, nil
+					case n <= 15:
+						// more errors than the error channel buffers
+						return nil, nil, eris.New("query failed")
+					case n == 16:
+						return []*consulapi.CatalogService{firstInstance, secondInstance}, &consulapi.QueryMeta{LastIndex: 101}, nil
+					}
+					return blockUntilCancelled(q)
+				})).MinTimes(16)
+
+			endpointsChan, _ := startWatch()
+
+			var endpoints v1.EndpointList
+			Eventually(endpointsChan).Should(Receive(&endpoints))
+			Expect(endpointNames(endpoints)).To(Equal([]string{"1-1-0-1-svc-1-a-1234"}))
+			Eventually(endpointsChan, 5*time.Second).Should(Receive(&endpoints))
+			Expect(endpointNames(endpoints)).To(Equal([]string{"1-1-0-1-svc-1-a-1234", "1-1-0-2-svc-1-b-1234"}))
+		})
+	})
+
 	Describe("endpoints watch - not idiomatic (do not copy)", func() {
 
 		var (
@@ -277,7 +474,7 @@ var _ = Describe("Consul EDS", func() {
 			// The above is not true, the service name and query params (with datacenter) are different, we can rewrite
 			// this in a more idiomatic way in the future.
 			attempt := uint32(0)
-			consulWatcherMock.EXPECT().Service(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			consulWatcherMock.EXPECT().HealthService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(healthEntries(
 				func(service, tag string, q *consulapi.QueryOptions) ([]*consulapi.CatalogService, *consulapi.QueryMeta, error) {
 					currentAttempt := atomic.AddUint32(&attempt, 1)
 					switch service {
@@ -319,7 +516,7 @@ var _ = Describe("Consul EDS", func() {
 						}
 					}
 					return nil, &consulapi.QueryMeta{}, eris.New("you screwed up the test")
-				},
+				}),
 			).AnyTimes()
 
 			expectedEndpointsFirstAttempt = v1.EndpointList{
@@ -331,6 +528,7 @@ var _ = Describe("Consul EDS", func() {
 					ConsulDataCenterKeyPrefix + dc1: yes,
 					ConsulDataCenterKeyPrefix + dc2: no,
 					ConsulDataCenterKeyPrefix + dc3: no,
+					ConsulHealthStatusKey:           consulapi.HealthPassing,
 				}),
 				createExpectedEndpoint("1-1-0-2-svc-1-b-1234", "svc-1,svc-1primary", "", "1.1.0.2", "100", writeNamespace, 1234, map[string]string{
 					ConsulTagKeyPrefix + primary:    yes,
@@ -339,6 +537,7 @@ var _ = Describe("Consul EDS", func() {
 					ConsulDataCenterKeyPrefix + dc1: yes,
 					ConsulDataCenterKeyPrefix + dc2: no,
 					ConsulDataCenterKeyPrefix + dc3: no,
+					ConsulHealthStatusKey:           consulapi.HealthPassing,
 				}),
 				createExpectedEndpoint("2-1-0-10-svc-1-c-3456", "svc-1,svc-1secondary", "", "2.1.0.10", "100", writeNamespace, 3456, map[string]string{
 					ConsulTagKeyPrefix + primary:    no,
@@ -347,6 +546,7 @@ var _ = Describe("Consul EDS", func() {
 					ConsulDataCenterKeyPrefix + dc1: no,
 					ConsulDataCenterKeyPrefix + dc2: yes,
 					ConsulDataCenterKeyPrefix + dc3: no,
+					ConsulHealthStatusKey:           consulapi.HealthPassing,
 				}),
 				createExpectedEndpoint("2-1-0-11-svc-1-d-4567", "svc-1,svc-1secondary", "", "2.1.0.11", "100", writeNamespace, 4567, map[string]string{
 					ConsulTagKeyPrefix + primary:    no,
@@ -355,6 +555,7 @@ var _ = Describe("Consul EDS", func() {
 					ConsulDataCenterKeyPrefix + dc1: no,
 					ConsulDataCenterKeyPrefix + dc2: yes,
 					ConsulDataCenterKeyPrefix + dc3: no,
+					ConsulHealthStatusKey:           consulapi.HealthPassing,
 				}),
 				createExpectedEndpoint("3-1-0-99-svc-1-e-9999", "svc-1,svc-1secondary,svc-1canary", "", "3.1.0.99", "100", writeNamespace, 9999, map[string]string{
 					ConsulTagKeyPrefix + primary:    no,
@@ -363,6 +564,7 @@ var _ = Describe("Consul EDS", func() {
 					ConsulDataCenterKeyPrefix + dc1: no,
 					ConsulDataCenterKeyPrefix + dc2: no,
 					ConsulDataCenterKeyPrefix + dc3: yes,
+					ConsulHealthStatusKey:           consulapi.HealthPassing,
 				}),
 
 				// 4 endpoints for service 2
@@ -371,24 +573,28 @@ var _ = Describe("Consul EDS", func() {
 					ConsulTagKeyPrefix + secondary:  no,
 					ConsulDataCenterKeyPrefix + dc1: yes,
 					ConsulDataCenterKeyPrefix + dc2: no,
+					ConsulHealthStatusKey:           consulapi.HealthPassing,
 				}),
 				createExpectedEndpoint("1-2-0-2-svc-2-b2-8080", "svc-2primary", "", "1.2.0.2", "100", writeNamespace, 8080, map[string]string{
 					ConsulTagKeyPrefix + primary:    yes,
 					ConsulTagKeyPrefix + secondary:  no,
 					ConsulDataCenterKeyPrefix + dc1: yes,
 					ConsulDataCenterKeyPrefix + dc2: no,
+					ConsulHealthStatusKey:           consulapi.HealthPassing,
 				}),
 				createExpectedEndpoint("2-2-0-10-svc-2-c2-8088", "svc-2secondary", "", "2.2.0.10", "100", writeNamespace, 8088, map[string]string{
 					ConsulTagKeyPrefix + primary:    no,
 					ConsulTagKeyPrefix + secondary:  yes,
 					ConsulDataCenterKeyPrefix + dc1: no,
 					ConsulDataCenterKeyPrefix + dc2: yes,
+					ConsulHealthStatusKey:           consulapi.HealthPassing,
 				}),
 				createExpectedEndpoint("2-2-0-11-svc-2-d2-8088", "svc-2secondary", "", "2.2.0.11", "100", writeNamespace, 8088, map[string]string{
 					ConsulTagKeyPrefix + primary:    no,
 					ConsulTagKeyPrefix + secondary:  yes,
 					ConsulDataCenterKeyPrefix + dc1: no,
 					ConsulDataCenterKeyPrefix + dc2: yes,
+					ConsulHealthStatusKey:           consulapi.HealthPassing,
 				}),
 			}
 
@@ -406,6 +612,7 @@ var _ = Describe("Consul EDS", func() {
 					ConsulDataCenterKeyPrefix + dc1: no,
 					ConsulDataCenterKeyPrefix + dc2: no,
 					ConsulDataCenterKeyPrefix + dc3: yes,
+					ConsulHealthStatusKey:           consulapi.HealthPassing,
 				}),
 			)
 			sort.SliceStable(expectedEndpointsSecondAttempt, func(i, j int) bool {
@@ -425,7 +632,7 @@ var _ = Describe("Consul EDS", func() {
 		})
 
 		It("works as expected", func() {
-			eds := NewPlugin(consulWatcherMock, nil, nil)
+			eds := NewPlugin(consulWatcherMock, nil, nil, nil, nil)
 
 			endpointsChan, errorChan, err := eds.WatchEndpoints(writeNamespace, upstreamsToTrack, clients.WatchOpts{Ctx: ctx})
 
@@ -535,7 +742,7 @@ var _ = Describe("Consul EDS", func() {
 			}
 
 			twoIps := []net.IPAddr{{IP: net.IPv4(2, 1, 0, 10)}, {IP: net.IPv4(2, 1, 0, 11)}}
-			mockDnsResolver := mock_consul2.NewMockDnsResolver(ctrl)
+			mockDnsResolver := mock_dns.NewMockResolver(ctrl)
 			mockDnsResolver.EXPECT().Resolve(gomock.Any(), gomock.Any()).Return(twoIps, nil).Times(1)
 
 			trackedServiceToUpstreams := make(map[string][]*v1.Upstream)
@@ -579,7 +786,7 @@ var _ = Describe("Consul EDS", func() {
 
 			// make sure the we have a correct number of generated endpoints:
 
-			endpoints := buildEndpointsFromSpecs(context.TODO(), writeNamespace, mockDnsResolver, svcs, trackedServiceToUpstreams)
+			endpoints := buildEndpointsFromSpecs(context.TODO(), writeNamespace, dns.NewResolvers(mockDnsResolver, dns.CacheOptions{}), svcs, trackedServiceToUpstreams)
 			endpontNames := map[string]bool{}
 			for _, endpoint := range endpoints {
 				fmt.Fprintf(GinkgoWriter, "%s%v\n", "endpoint: ", endpoint)
@@ -644,6 +851,7 @@ var _ = Describe("Consul EDS", func() {
 						ConsulTagKeyPrefix + "tag-3":       ConsulEndpointMetadataMatchTrue,
 						ConsulDataCenterKeyPrefix + "dc-1": ConsulEndpointMetadataMatchTrue,
 						ConsulDataCenterKeyPrefix + "dc-2": ConsulEndpointMetadataMatchFalse,
+						ConsulHealthStatusKey:              consulapi.HealthPassing,
 					},
 					ResourceVersion: "9876",
 				},
@@ -653,6 +861,125 @@ var _ = Describe("Consul EDS", func() {
 			}))
 		})
 
+		It("does not add the endpoint to upstreams which aggregate other data centers", func() {
+			consulService := &consulapi.CatalogService{
+				ServiceID:   "my-svc-0",
+				ServiceName: "my-svc",
+				Address:     "127.0.0.1",
+				ServicePort: 1234,
+				Datacenter:  "dc-3",
+				ModifyIndex: 9876,
+			}
+			upstream := createTestUpstream("my-svc", "my-svc", nil, []string{"dc-1", "dc-2"})
+			aggregatedUpstream := createTestUpstream("my-svc-aggregated", "my-svc", nil, []string{"dc-1", "dc-2"})
+			aggregatedUpstream.GetConsul().AggregateDataCenters = true
+
+			endpoints, err := buildEndpoints(context.TODO(), writeNamespace, nil, consulService, v1.UpstreamList{upstream, aggregatedUpstream})
+			Expect(err).To(BeNil())
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].Upstreams).To(Equal([]*core.ResourceRef{utils.ResourceRefPtr(upstream.Metadata.Ref())}))
+		})
+
+		It("adds the endpoint to the upstreams whose health filter its health checks pass", func() {
+			upstream := createTestUpstream("my-svc", "my-svc", nil, []string{"dc-1"})
+			passingUpstream := createTestUpstream("my-svc-passing", "my-svc", nil, []string{"dc-1"})
+			passingUpstream.GetConsul().HealthFilter = consulplugin.UpstreamSpec_PASSING
+			warningUpstream := createTestUpstream("my-svc-warning", "my-svc", nil, []string{"dc-1"})
+			warningUpstream.GetConsul().HealthFilter = consulplugin.UpstreamSpec_WARNING
+			upstreams := v1.UpstreamList{upstream, passingUpstream, warningUpstream}
+
+			endpointWithChecks := func(statuses ...string) *v1.Endpoint {
+				consulService := &consulapi.CatalogService{
+					ServiceID:   "my-svc-0",
+					ServiceName: "my-svc",
+					Address:     "127.0.0.1",
+					ServicePort: 1234,
+					Datacenter:  "dc-1",
+				}
+				for i, status := range statuses {
+					consulService.Checks = append(consulService.Checks, &consulapi.HealthCheck{
+						CheckID: fmt.Sprintf("check-%d", i),
+						Status:  status,
+					})
+				}
+				endpoints, err := buildEndpoints(context.TODO(), writeNamespace, nil, consulService, upstreams)
+				Expect(err).To(BeNil())
+				Expect(endpoints).To(HaveLen(1))
+				return endpoints[0]
+			}
+			refs := func(upstreams ...*v1.Upstream) []*core.ResourceRef {
+				var out []*core.ResourceRef
+				for _, us := range upstreams {
+					out = append(out, utils.ResourceRefPtr(us.Metadata.Ref()))
+				}
+				return out
+			}
+
+			passing := endpointWithChecks(consulapi.HealthPassing)
+			Expect(passing.Upstreams).To(Equal(refs(upstream, passingUpstream, warningUpstream)))
+			Expect(passing.Metadata.Labels).To(HaveKeyWithValue(ConsulHealthStatusKey, consulapi.HealthPassing))
+
+			warning := endpointWithChecks(consulapi.HealthPassing, consulapi.HealthWarning)
+			Expect(warning.Upstreams).To(Equal(refs(upstream, warningUpstream)))
+			Expect(warning.Metadata.Labels).To(HaveKeyWithValue(ConsulHealthStatusKey, consulapi.HealthWarning))
+
+			critical := endpointWithChecks(consulapi.HealthWarning, consulapi.HealthCritical)
+			Expect(critical.Upstreams).To(Equal(refs(upstream)))
+			Expect(critical.Metadata.Labels).To(HaveKeyWithValue(ConsulHealthStatusKey, consulapi.HealthCritical))
+		})
+
+		It("labels the endpoint with the weight of the instance for its health", func() {
+			upstream := createTestUpstream("my-svc", "my-svc", nil, []string{"dc-1"})
+			consulService := &consulapi.CatalogService{
+				ServiceID:      "my-svc-0",
+				ServiceName:    "my-svc",
+				Address:        "127.0.0.1",
+				ServicePort:    1234,
+				Datacenter:     "dc-1",
+				ServiceWeights: consulapi.Weights{Passing: 10, Warning: 2},
+			}
+
+			endpoints, err := buildEndpoints(context.TODO(), writeNamespace, nil, consulService, v1.UpstreamList{upstream})
+			Expect(err).To(BeNil())
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].Metadata.Labels).To(HaveKeyWithValue(ConsulWeightKey, "10"))
+
+			consulService.Checks = consulapi.HealthChecks{{CheckID: "check", Status: consulapi.HealthWarning}}
+			endpoints, err = buildEndpoints(context.TODO(), writeNamespace, nil, consulService, v1.UpstreamList{upstream})
+			Expect(err).To(BeNil())
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].Metadata.Labels).To(HaveKeyWithValue(ConsulWeightKey, "2"))
+
+			consulService.Checks = consulapi.HealthChecks{{CheckID: "check", Status: consulapi.HealthCritical}}
+			endpoints, err = buildEndpoints(context.TODO(), writeNamespace, nil, consulService, v1.UpstreamList{upstream})
+			Expect(err).To(BeNil())
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].Metadata.Labels).NotTo(HaveKey(ConsulWeightKey))
+		})
+
+		It("labels the endpoint with the service metadata the upstreams segment their instances by", func() {
+			consulService := &consulapi.CatalogService{
+				ServiceID:   "my-svc-0",
+				ServiceName: "my-svc",
+				Address:     "127.0.0.1",
+				ServicePort: 1234,
+				Datacenter:  "dc-1",
+				ServiceMeta: map[string]string{"version": "v2", "owner": "team-a"},
+				ModifyIndex: 9876,
+			}
+			upstream := createTestUpstream("my-svc", "my-svc", nil, []string{"dc-1"})
+			upstream.GetConsul().SubsetMetadataKeys = []string{"version", "stage"}
+
+			endpoints, err := buildEndpoints(context.TODO(), writeNamespace, nil, consulService, v1.UpstreamList{upstream})
+			Expect(err).To(BeNil())
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].Metadata.Labels).To(Equal(map[string]string{
+				ConsulDataCenterKeyPrefix + "dc-1":  ConsulEndpointMetadataMatchTrue,
+				ConsulMetadataKeyPrefix + "version": "v2",
+				ConsulHealthStatusKey:               consulapi.HealthPassing,
+			}))
+		})
+
 		It("generates the correct endpoint for a given Consul service -- propagates hostname", func() {
 			consulService := &consulapi.CatalogService{
 				ServiceID:   "my-svc-0",
@@ -667,7 +994,7 @@ var _ = Describe("Consul EDS", func() {
 
 			// we have to put all the mock expects before the test starts or else the test may have data races
 			initialIps := []net.IPAddr{{IP: net.IPv4(127, 0, 0, 1)}}
-			mockDnsResolver := mock_consul2.NewMockDnsResolver(ctrl)
+			mockDnsResolver := mock_dns.NewMockResolver(ctrl)
 			mockDnsResolver.EXPECT().Resolve(gomock.Any(), gomock.Any()).Do(func(context.Context, string) {
 				fmt.Fprint(GinkgoWriter, "Initial resolve called.")
 			}).Return(initialIps, nil).Times(1) // once for each consul service
@@ -683,6 +1010,7 @@ var _ = Describe("Consul EDS", func() {
 						ConsulTagKeyPrefix + "tag-3":       ConsulEndpointMetadataMatchTrue,
 						ConsulDataCenterKeyPrefix + "dc-1": ConsulEndpointMetadataMatchTrue,
 						ConsulDataCenterKeyPrefix + "dc-2": ConsulEndpointMetadataMatchFalse,
+						ConsulHealthStatusKey:              consulapi.HealthPassing,
 					},
 					ResourceVersion: "9876",
 				},
@@ -717,6 +1045,36 @@ func createTestFilteredUpstream(usptreamName, svcName string, tags, instancetags
 	}
 }
 
+// Adapts the catalog instances returned by a mocked query to the health entries of the instances
+func healthEntries(query func(service, tag string, q *consulapi.QueryOptions) ([]*consulapi.CatalogService, *consulapi.QueryMeta, error)) func(service, tag string, passingOnly bool, q *consulapi.QueryOptions) ([]*consulapi.ServiceEntry, *consulapi.QueryMeta, error) {
+	return func(service, tag string, passingOnly bool, q *consulapi.QueryOptions) ([]*consulapi.ServiceEntry, *consulapi.QueryMeta, error) {
+		Expect(passingOnly).To(BeFalse())
+		instances, queryMeta, err := query(service, tag, q)
+		var entries []*consulapi.ServiceEntry
+		for _, instance := range instances {
+			entries = append(entries, &consulapi.ServiceEntry{
+				Node: &consulapi.Node{
+					Node:       instance.Node,
+					Address:    instance.Address,
+					Datacenter: instance.Datacenter,
+				},
+				Service: &consulapi.AgentService{
+					ID:          instance.ServiceID,
+					Service:     instance.ServiceName,
+					Address:     instance.ServiceAddress,
+					Tags:        instance.ServiceTags,
+					Meta:        instance.ServiceMeta,
+					Port:        instance.ServicePort,
+					Weights:     consulapi.AgentWeights(instance.ServiceWeights),
+					ModifyIndex: instance.ModifyIndex,
+				},
+				Checks: instance.Checks,
+			})
+		}
+		return entries, queryMeta, err
+	}
+}
+
 func createTestService(address, dc, name, id string, tags []string, port int, lastIndex uint64) *consulapi.CatalogService {
 	return &consulapi.CatalogService{
 		ServiceName: name,
@@ -0,0 +1,33 @@
+package consul
+
+import (
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyendpoint "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/solo-io/gloo/projects/gloo/constants"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+)
+
+// Maps the aggregated status of the consul health checks of a service instance to the envoy health status
+var healthStatuses = map[string]envoycore.HealthStatus{
+	consulapi.HealthPassing:  envoycore.HealthStatus_HEALTHY,
+	consulapi.HealthWarning:  envoycore.HealthStatus_DEGRADED,
+	consulapi.HealthCritical: envoycore.HealthStatus_UNHEALTHY,
+	consulapi.HealthMaint:    envoycore.HealthStatus_DRAINING,
+}
+
+// EDS labels each consul endpoint with the aggregated status of its health checks. Endpoints without a known status
+// are left with the UNKNOWN health status, which envoy treats as healthy.
+func setHealthStatus(out *envoyapi.ClusterLoadAssignment) {
+	for _, localityEndpoints := range out.GetEndpoints() {
+		for _, lbEndpoint := range localityEndpoints.GetLbEndpoints() {
+			lbEndpoint.HealthStatus = endpointHealthStatus(lbEndpoint)
+		}
+	}
+}
+
+func endpointHealthStatus(lbEndpoint *envoyendpoint.LbEndpoint) envoycore.HealthStatus {
+	labels := lbEndpoint.GetMetadata().GetFilterMetadata()[translator.EnvoyLb].GetFields()
+	return healthStatuses[labels[constants.ConsulHealthStatusKey].GetStringValue()]
+}
@@ -0,0 +1,74 @@
+package consul
+
+import (
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyendpoint "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
+	"github.com/solo-io/gloo/projects/gloo/constants"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+)
+
+var _ plugins.EndpointPlugin = new(plugin)
+
+// Reports the consul health and weights of the endpoints of consul upstreams to envoy, and groups the endpoints of the
+// upstreams which aggregate their data centers into one locality per data center.
+func (p *plugin) ProcessEndpoints(params plugins.Params, in *v1.Upstream, out *envoyapi.ClusterLoadAssignment) error {
+	spec := in.GetConsul()
+	if spec == nil {
+		return nil
+	}
+
+	setHealthStatus(out)
+	setLoadBalancingWeights(out)
+
+	if !spec.GetAggregateDataCenters() {
+		return nil
+	}
+
+	localities := make(map[string]*envoyendpoint.LocalityLbEndpoints)
+	var unknownDataCenter []*envoyendpoint.LbEndpoint
+	for _, localityEndpoints := range out.GetEndpoints() {
+		for _, lbEndpoint := range localityEndpoints.GetLbEndpoints() {
+			dataCenter, ok := endpointDataCenter(lbEndpoint, spec.GetDataCenters())
+			if !ok {
+				unknownDataCenter = append(unknownDataCenter, lbEndpoint)
+				continue
+			}
+			locality, ok := localities[dataCenter]
+			if !ok {
+				locality = &envoyendpoint.LocalityLbEndpoints{
+					Locality: &envoycore.Locality{Zone: dataCenter},
+				}
+				localities[dataCenter] = locality
+			}
+			locality.LbEndpoints = append(locality.LbEndpoints, lbEndpoint)
+		}
+	}
+
+	// order the localities as the data centers are listed on the upstream, so the output is stable
+	var grouped []*envoyendpoint.LocalityLbEndpoints
+	for _, dataCenter := range spec.GetDataCenters() {
+		if locality, ok := localities[dataCenter]; ok {
+			grouped = append(grouped, locality)
+		}
+	}
+	if len(unknownDataCenter) > 0 {
+		grouped = append(grouped, &envoyendpoint.LocalityLbEndpoints{LbEndpoints: unknownDataCenter})
+	}
+	out.Endpoints = grouped
+
+	return nil
+}
+
+// EDS labels each consul endpoint with every data center of its upstream, marking the one it is registered in
+func endpointDataCenter(lbEndpoint *envoyendpoint.LbEndpoint, dataCenters []string) (string, bool) {
+	labels := lbEndpoint.GetMetadata().GetFilterMetadata()[translator.EnvoyLb].GetFields()
+	for _, dataCenter := range dataCenters {
+		if labels[constants.ConsulDataCenterKeyPrefix+dataCenter].GetStringValue() == constants.ConsulEndpointMetadataMatchTrue {
+			return dataCenter, true
+		}
+	}
+	return "", false
+}
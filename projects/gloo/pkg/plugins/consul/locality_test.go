@@ -0,0 +1,152 @@
+package consul
+
+import (
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyendpoint "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	consulapi "github.com/hashicorp/consul/api"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/constants"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+)
+
+var _ = Describe("ProcessEndpoints", func() {
+
+	var (
+		plug     plugins.EndpointPlugin
+		upstream *v1.Upstream
+	)
+
+	BeforeEach(func() {
+		plug = NewPlugin(nil, nil, nil, nil, nil)
+		upstream = createTestUpstream("my-svc", "my-svc", nil, []string{"dc-1", "dc-2"})
+	})
+
+	lbEndpoint := func(address, dataCenter string) *envoyendpoint.LbEndpoint {
+		fields := map[string]*structpb.Value{}
+		for _, dc := range []string{"dc-1", "dc-2"} {
+			match := constants.ConsulEndpointMetadataMatchFalse
+			if dc == dataCenter {
+				match = constants.ConsulEndpointMetadataMatchTrue
+			}
+			fields[constants.ConsulDataCenterKeyPrefix+dc] = &structpb.Value{Kind: &structpb.Value_StringValue{StringValue: match}}
+		}
+		return &envoyendpoint.LbEndpoint{
+			HostIdentifier: &envoyendpoint.LbEndpoint_Endpoint{
+				Endpoint: &envoyendpoint.Endpoint{
+					Address: &envoycore.Address{
+						Address: &envoycore.Address_SocketAddress{
+							SocketAddress: &envoycore.SocketAddress{Address: address},
+						},
+					},
+				},
+			},
+			Metadata: &envoycore.Metadata{
+				FilterMetadata: map[string]*structpb.Struct{translator.EnvoyLb: {Fields: fields}},
+			},
+		}
+	}
+
+	var (
+		dc1Endpoint     = lbEndpoint("1.1.1.1", "dc-1")
+		dc2Endpoint     = lbEndpoint("2.2.2.2", "dc-2")
+		otherDcEndpoint = lbEndpoint("3.3.3.3", "dc-3")
+	)
+
+	loadAssignment := func() *envoyapi.ClusterLoadAssignment {
+		return &envoyapi.ClusterLoadAssignment{
+			ClusterName: "my-svc",
+			Endpoints: []*envoyendpoint.LocalityLbEndpoints{{
+				LbEndpoints: []*envoyendpoint.LbEndpoint{dc2Endpoint, otherDcEndpoint, dc1Endpoint},
+			}},
+		}
+	}
+
+	It("does not change the endpoints of upstreams which do not aggregate their data centers", func() {
+		out := loadAssignment()
+		err := plug.ProcessEndpoints(plugins.Params{}, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(Equal(loadAssignment()))
+	})
+
+	It("groups the endpoints into a locality per data center", func() {
+		upstream.GetConsul().AggregateDataCenters = true
+
+		out := loadAssignment()
+		err := plug.ProcessEndpoints(plugins.Params{}, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.Endpoints).To(Equal([]*envoyendpoint.LocalityLbEndpoints{
+			{
+				Locality:    &envoycore.Locality{Zone: "dc-1"},
+				LbEndpoints: []*envoyendpoint.LbEndpoint{dc1Endpoint},
+			},
+			{
+				Locality:    &envoycore.Locality{Zone: "dc-2"},
+				LbEndpoints: []*envoyendpoint.LbEndpoint{dc2Endpoint},
+			},
+			{
+				LbEndpoints: []*envoyendpoint.LbEndpoint{otherDcEndpoint},
+			},
+		}))
+	})
+
+	It("reports the consul health of the endpoints to envoy", func() {
+		withHealth := func(address, health string) *envoyendpoint.LbEndpoint {
+			endpoint := lbEndpoint(address, "dc-1")
+			endpoint.Metadata.FilterMetadata[translator.EnvoyLb].Fields[constants.ConsulHealthStatusKey] = &structpb.Value{
+				Kind: &structpb.Value_StringValue{StringValue: health},
+			}
+			return endpoint
+		}
+		out := &envoyapi.ClusterLoadAssignment{
+			ClusterName: "my-svc",
+			Endpoints: []*envoyendpoint.LocalityLbEndpoints{{
+				LbEndpoints: []*envoyendpoint.LbEndpoint{
+					withHealth("1.1.1.1", consulapi.HealthPassing),
+					withHealth("1.1.1.2", consulapi.HealthWarning),
+					withHealth("1.1.1.3", consulapi.HealthCritical),
+					withHealth("1.1.1.4", consulapi.HealthMaint),
+					lbEndpoint("1.1.1.5", "dc-1"),
+				},
+			}},
+		}
+		err := plug.ProcessEndpoints(plugins.Params{}, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		var statuses []envoycore.HealthStatus
+		for _, lbEndpoint := range out.Endpoints[0].LbEndpoints {
+			statuses = append(statuses, lbEndpoint.HealthStatus)
+		}
+		Expect(statuses).To(Equal([]envoycore.HealthStatus{
+			envoycore.HealthStatus_HEALTHY,
+			envoycore.HealthStatus_DEGRADED,
+			envoycore.HealthStatus_UNHEALTHY,
+			envoycore.HealthStatus_DRAINING,
+			envoycore.HealthStatus_UNKNOWN,
+		}))
+	})
+
+	It("sets the load balancing weights of the endpoints to their consul weights", func() {
+		weighted := lbEndpoint("1.1.1.1", "dc-1")
+		weighted.Metadata.FilterMetadata[translator.EnvoyLb].Fields[constants.ConsulWeightKey] = &structpb.Value{
+			Kind: &structpb.Value_StringValue{StringValue: "10"},
+		}
+		unweighted := lbEndpoint("1.1.1.2", "dc-1")
+		out := &envoyapi.ClusterLoadAssignment{
+			ClusterName: "my-svc",
+			Endpoints: []*envoyendpoint.LocalityLbEndpoints{{
+				LbEndpoints: []*envoyendpoint.LbEndpoint{weighted, unweighted},
+			}},
+		}
+		err := plug.ProcessEndpoints(plugins.Params{}, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(weighted.LoadBalancingWeight).To(Equal(&wrappers.UInt32Value{Value: 10}))
+		Expect(unweighted.LoadBalancingWeight).To(BeNil())
+	})
+})
@@ -3,20 +3,25 @@ package consul
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/consul/api"
 	"github.com/rotisserie/eris"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/dns"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
 
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	consulplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/consul"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 var _ discovery.DiscoveryPlugin = new(plugin)
@@ -24,12 +29,26 @@ var _ discovery.DiscoveryPlugin = new(plugin)
 var (
 	DefaultDnsAddress         = "127.0.0.1:8600"
 	DefaultDnsPollingInterval = 5 * time.Second
+
+	NoTokenResolverErr = func(ref core.ResourceRef) error {
+		return eris.Errorf("cannot resolve the Consul ACL token in secret [%s], "+
+			"the Consul plugin was not configured with a token resolver", ref.Key())
+	}
+	TooManySubsetMetadataKeysErr = func(count int) error {
+		return eris.Errorf("consul upstream has %d subset metadata keys, at most %d are allowed as a subset is "+
+			"created for every combination of them", count, v1.MaxConsulSubsetMetadataKeys)
+	}
 )
 
 type plugin struct {
 	client             consul.ConsulWatcher
-	resolver           DnsResolver
 	dnsPollingInterval time.Duration
+	// resolves the hostnames of service instances, with the nameservers of their upstreams
+	resolvers *dns.Resolvers
+	// if set, connect enabled upstreams are routed to through their Connect sidecar proxies
+	connectSecretRef *core.ResourceRef
+	// resolves the ACL tokens referenced by upstreams
+	tokenResolver consul.TokenResolver
 }
 
 func (p *plugin) Resolve(u *v1.Upstream) (*url.URL, error) {
@@ -46,9 +65,18 @@ func (p *plugin) Resolve(u *v1.Upstream) (*url.URL, error) {
 		dc = spec.DataCenters[0]
 	}
 
-	instances, _, err := p.client.Service(spec.ServiceName, "", &api.QueryOptions{Datacenter: dc, RequireConsistent: true})
+	queryOpts := &api.QueryOptions{Datacenter: dc, RequireConsistent: true}
+	if spec.TokenSecretRef != nil {
+		token, err := resolveToken(context.TODO(), p.tokenResolver, *spec.TokenSecretRef)
+		if err != nil {
+			return nil, err
+		}
+		queryOpts.Token = token
+	}
+
+	instances, err := p.queryInstances(spec, queryOpts)
 	if err != nil {
-		return nil, eris.Wrapf(err, "getting service from catalog")
+		return nil, err
 	}
 
 	scheme := "http"
@@ -57,29 +85,134 @@ func (p *plugin) Resolve(u *v1.Upstream) (*url.URL, error) {
 	}
 
 	for _, inst := range instances {
-		if (len(spec.InstanceTags) == 0) || matchTags(spec.InstanceTags, inst.ServiceTags) {
-			ipAddresses, err := getIpAddresses(context.TODO(), inst.ServiceAddress, p.resolver)
+		if (len(spec.InstanceTags) == 0) || matchTags(spec.InstanceTags, inst.tags) {
+			ipAddr, port, err := resolveInstanceAddress(context.TODO(), p.resolvers.ForNameservers(spec.DnsNameservers), serviceRecordName(spec, dc), inst.address, inst.port)
 			if err != nil {
 				return nil, err
 			}
-			if len(ipAddresses) == 0 {
-				return nil, eris.Errorf("DNS result for %s returned an empty list of IPs", inst.ServiceAddress)
-			}
-			// arbitrarily default to the first result
-			ipAddr := ipAddresses[0]
-			return url.Parse(fmt.Sprintf("%v://%v:%v", scheme, ipAddr, inst.ServicePort))
+			return url.Parse(fmt.Sprintf("%v://%v:%v", scheme, ipAddr, port))
 		}
 	}
 
 	return nil, eris.Errorf("service with name %s and tags %v not found", spec.ServiceName, spec.InstanceTags)
 }
 
-func NewPlugin(client consul.ConsulWatcher, resolver DnsResolver, dnsPollingInterval *time.Duration) *plugin {
+// The parts of a service instance needed to resolve the URL of an upstream
+type resolvableInstance struct {
+	address string
+	port    int
+	tags    []string
+}
+
+// Queries the instances of the service of the upstream, either from the catalog or by executing the prepared query of
+// the upstream. Consul orders the results of prepared queries itself, after applying their failover policy.
+func (p *plugin) queryInstances(spec *consulplugin.UpstreamSpec, queryOpts *api.QueryOptions) ([]resolvableInstance, error) {
+	var instances []resolvableInstance
+	if spec.PreparedQuery != "" {
+		response, _, err := p.client.ExecutePreparedQuery(spec.PreparedQuery, queryOpts)
+		if err != nil {
+			return nil, eris.Wrapf(err, "executing prepared query %s", spec.PreparedQuery)
+		}
+		for _, entry := range response.Nodes {
+			if entry.Service == nil || entry.Node == nil {
+				continue
+			}
+			instances = append(instances, resolvableInstance{
+				address: instanceAddress(entry.Service.Address, entry.Node.Address),
+				port:    entry.Service.Port,
+				tags:    entry.Service.Tags,
+			})
+		}
+		return instances, nil
+	}
+
+	catalogServices, _, err := p.client.Service(spec.ServiceName, "", queryOpts)
+	if err != nil {
+		return nil, eris.Wrapf(err, "getting service from catalog")
+	}
+	for _, inst := range catalogServices {
+		instances = append(instances, resolvableInstance{
+			address: instanceAddress(inst.ServiceAddress, inst.Address),
+			port:    inst.ServicePort,
+			tags:    inst.ServiceTags,
+		})
+	}
+	return instances, nil
+}
+
+// The service address is the IP address of the service host, if empty the node address should be used
+func instanceAddress(serviceAddress, nodeAddress string) string {
+	if serviceAddress == "" {
+		return nodeAddress
+	}
+	return serviceAddress
+}
+
+// The name of the DNS record Consul serves the instances of the upstream with: the record of its prepared query if it
+// has one, or else the record of its service, in the given data center.
+func serviceRecordName(spec *consulplugin.UpstreamSpec, dataCenter string) string {
+	name := spec.GetServiceName() + ".service"
+	if spec.GetPreparedQuery() != "" {
+		name = spec.GetPreparedQuery() + ".query"
+	}
+	if dataCenter != "" {
+		name += "." + dataCenter
+	}
+	return name + ".consul"
+}
+
+// Resolves the address of a service instance to an IP address and a port. If the address is a hostname, the SRV
+// records of the service (or prepared query) are looked up first, so that the port advertised in the record of the
+// instance takes precedence over the registered one.
+func resolveInstanceAddress(ctx context.Context, resolver dns.Resolver, recordName, address string, port int) (string, int, error) {
+	if net.ParseIP(address) == nil && resolver != nil {
+		srvs, err := resolver.ResolveSRV(ctx, recordName)
+		if err != nil && !dns.IsNotFound(err) {
+			return "", 0, err
+		}
+		for _, srv := range srvs {
+			if strings.TrimSuffix(srv.Target, ".") == address {
+				port = int(srv.Port)
+				break
+			}
+		}
+	}
+
+	ipAddresses, err := getIpAddresses(ctx, address, resolver)
+	if err != nil {
+		return "", 0, err
+	}
+	if len(ipAddresses) == 0 {
+		return "", 0, eris.Errorf("DNS result for %s returned an empty list of IPs", address)
+	}
+	// arbitrarily default to the first result
+	return ipAddresses[0], port, nil
+}
+
+// Returns the resolvers for the addresses of service instances, which query the consul agent DNS at the given address
+// unless their upstream has its own nameservers. Records are cached for as long as their TTLs allow, which by default
+// is not at all, so the resolvers are meant to be created once and shared by every instance of the plugin.
+func NewDnsResolvers(dnsServer string) *dns.Resolvers {
+	cacheOpts := dns.CacheOptions{NegativeTtl: dns.DefaultNegativeTtl}
+	return dns.NewResolvers(dns.NewCachingResolver(dns.NewResolver(dnsServer), cacheOpts), cacheOpts)
+}
+
+// If resolvers is nil, only the hostnames of instances whose upstreams have nameservers are resolved.
+func NewPlugin(client consul.ConsulWatcher, resolvers *dns.Resolvers, dnsPollingInterval *time.Duration, connectSecretRef *core.ResourceRef, tokenResolver consul.TokenResolver) *plugin {
 	pollingInterval := DefaultDnsPollingInterval
 	if dnsPollingInterval != nil {
 		pollingInterval = *dnsPollingInterval
 	}
-	return &plugin{client: client, resolver: resolver, dnsPollingInterval: pollingInterval}
+	if resolvers == nil {
+		resolvers = dns.NewResolvers(nil, dns.CacheOptions{NegativeTtl: dns.DefaultNegativeTtl})
+	}
+	return &plugin{
+		client:             client,
+		resolvers:          resolvers,
+		dnsPollingInterval: pollingInterval,
+		connectSecretRef:   connectSecretRef,
+		tokenResolver:      tokenResolver,
+	}
 }
 
 func (p *plugin) Init(params plugins.InitParams) error {
@@ -87,15 +220,26 @@ func (p *plugin) Init(params plugins.InitParams) error {
 }
 
 func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
-	_, ok := in.UpstreamType.(*v1.Upstream_Consul)
+	consulSpec, ok := in.UpstreamType.(*v1.Upstream_Consul)
 	if !ok {
 		return nil
 	}
 
+	if keys := v1.UniqueConsulSubsetMetadataKeys(consulSpec.Consul.GetSubsetMetadataKeys()); len(keys) > v1.MaxConsulSubsetMetadataKeys {
+		return TooManySubsetMetadataKeysErr(len(keys))
+	}
+
 	// consul upstreams use EDS
 	xds.SetEdsOnCluster(out)
 
-	return nil
+	return p.processConnectUpstream(params, in, out)
+}
+
+func resolveToken(ctx context.Context, tokenResolver consul.TokenResolver, ref core.ResourceRef) (string, error) {
+	if tokenResolver == nil {
+		return "", NoTokenResolverErr(ref)
+	}
+	return tokenResolver.ResolveToken(ctx, ref)
 }
 
 func matchTags(t1, t2 []string) bool {
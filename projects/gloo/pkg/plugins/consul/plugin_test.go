@@ -4,7 +4,8 @@ import (
 	"net"
 	"net/url"
 
-	mock_consul2 "github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul/mocks"
+	"github.com/solo-io/gloo/projects/gloo/pkg/dns"
+	mock_dns "github.com/solo-io/gloo/projects/gloo/pkg/dns/mocks"
 
 	"github.com/golang/mock/gomock"
 	consulapi "github.com/hashicorp/consul/api"
@@ -31,7 +32,7 @@ var _ = Describe("Resolve", func() {
 	})
 
 	It("can resolve consul service addresses that are IPs", func() {
-		plug := NewPlugin(consulWatcherMock, nil, nil)
+		plug := NewPlugin(consulWatcherMock, nil, nil, nil, nil)
 
 		svcName := "my-svc"
 		tag := "tag"
@@ -65,10 +66,11 @@ var _ = Describe("Resolve", func() {
 			{IP: net.IPv4(2, 1, 0, 10)}, // we will arbitrarily default to the first DNS response
 			{IP: net.IPv4(2, 1, 0, 11)},
 		}
-		mockDnsResolver := mock_consul2.NewMockDnsResolver(ctrl)
+		mockDnsResolver := mock_dns.NewMockResolver(ctrl)
+		mockDnsResolver.EXPECT().ResolveSRV(gomock.Any(), "my-svc.service.dc1.consul").Return(nil, &net.DNSError{IsNotFound: true}).Times(1)
 		mockDnsResolver.EXPECT().Resolve(gomock.Any(), "test.service.consul").Return(ips, nil).Times(1)
 
-		plug := NewPlugin(consulWatcherMock, mockDnsResolver, nil)
+		plug := NewPlugin(consulWatcherMock, dns.NewResolvers(mockDnsResolver, dns.CacheOptions{}), nil, nil, nil)
 
 		svcName := "my-svc"
 		tag := "tag"
@@ -98,7 +100,7 @@ var _ = Describe("Resolve", func() {
 
 	It("can resolve consul service addresses in an unfiltered upstream", func() {
 
-		plug := NewPlugin(consulWatcherMock, nil, nil)
+		plug := NewPlugin(consulWatcherMock, nil, nil, nil, nil)
 
 		svcName := "my-svc"
 		dc := "dc1"
@@ -119,4 +121,103 @@ var _ = Describe("Resolve", func() {
 
 		Expect(u).To(Equal(&url.URL{Scheme: "http", Host: "5.6.7.8:1234"}))
 	})
+
+	It("uses the port of the instance in the SRV records of the consul service", func() {
+		mockDnsResolver := mock_dns.NewMockResolver(ctrl)
+		mockDnsResolver.EXPECT().ResolveSRV(gomock.Any(), "my-svc.service.dc1.consul").Return([]*net.SRV{
+			{Target: "node-b.node.dc1.consul.", Port: 21346},
+			{Target: "node-a.node.dc1.consul.", Port: 21345},
+		}, nil).Times(1)
+		mockDnsResolver.EXPECT().Resolve(gomock.Any(), "node-a.node.dc1.consul").Return([]net.IPAddr{{IP: net.IPv4(2, 1, 0, 10)}}, nil).Times(1)
+
+		plug := NewPlugin(consulWatcherMock, dns.NewResolvers(mockDnsResolver, dns.CacheOptions{}), nil, nil, nil)
+
+		svcName := "my-svc"
+		dc := "dc1"
+
+		us := createTestFilteredUpstream(svcName, svcName, nil, nil, []string{dc})
+
+		queryOpts := &consulapi.QueryOptions{Datacenter: dc, RequireConsistent: true}
+
+		consulWatcherMock.EXPECT().Service(svcName, "", queryOpts).Return([]*consulapi.CatalogService{
+			{
+				ServiceAddress: "node-a.node.dc1.consul",
+				ServicePort:    1234,
+			},
+		}, nil, nil)
+
+		u, err := plug.Resolve(us)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(u).To(Equal(&url.URL{Scheme: "http", Host: "2.1.0.10:21345"}))
+	})
+
+	It("looks up the SRV records of the prepared query of the upstream", func() {
+		mockDnsResolver := mock_dns.NewMockResolver(ctrl)
+		mockDnsResolver.EXPECT().ResolveSRV(gomock.Any(), "my-svc-failover.query.dc1.consul").Return([]*net.SRV{
+			{Target: "node-b.node.dc2.consul.", Port: 21346},
+		}, nil).Times(1)
+		mockDnsResolver.EXPECT().Resolve(gomock.Any(), "node-b.node.dc2.consul").Return([]net.IPAddr{{IP: net.IPv4(2, 1, 0, 11)}}, nil).Times(1)
+
+		plug := NewPlugin(consulWatcherMock, dns.NewResolvers(mockDnsResolver, dns.CacheOptions{}), nil, nil, nil)
+
+		svcName := "my-svc"
+		dc := "dc1"
+
+		us := createTestFilteredUpstream(svcName, svcName, nil, nil, []string{dc})
+		us.GetConsul().PreparedQuery = "my-svc-failover"
+
+		queryOpts := &consulapi.QueryOptions{Datacenter: dc, RequireConsistent: true}
+
+		consulWatcherMock.EXPECT().ExecutePreparedQuery("my-svc-failover", queryOpts).Return(&consulapi.PreparedQueryExecuteResponse{
+			Service:    svcName,
+			Datacenter: "dc2",
+			Nodes: []consulapi.ServiceEntry{
+				{
+					Node:    &consulapi.Node{Address: "node-b.node.dc2.consul"},
+					Service: &consulapi.AgentService{Port: 1234},
+				},
+			},
+		}, nil, nil)
+
+		u, err := plug.Resolve(us)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(u).To(Equal(&url.URL{Scheme: "http", Host: "2.1.0.11:21346"}))
+	})
+
+	It("can resolve consul service addresses with a prepared query", func() {
+		plug := NewPlugin(consulWatcherMock, nil, nil, nil, nil)
+
+		svcName := "my-svc"
+		tag := "tag"
+		dc := "dc1"
+
+		us := createTestFilteredUpstream(svcName, svcName, nil, []string{tag}, []string{dc})
+		us.GetConsul().PreparedQuery = "my-svc-failover"
+
+		queryOpts := &consulapi.QueryOptions{Datacenter: dc, RequireConsistent: true}
+
+		// the local data center has no healthy instances, so consul fails over to another one
+		consulWatcherMock.EXPECT().ExecutePreparedQuery("my-svc-failover", queryOpts).Return(&consulapi.PreparedQueryExecuteResponse{
+			Service:    svcName,
+			Datacenter: "dc2",
+			Failovers:  1,
+			Nodes: []consulapi.ServiceEntry{
+				{
+					Node:    &consulapi.Node{Address: "5.6.7.8"},
+					Service: &consulapi.AgentService{Port: 1234},
+				},
+				{
+					Node:    &consulapi.Node{Address: "5.6.7.9"},
+					Service: &consulapi.AgentService{Address: "1.2.3.4", Port: 4321, Tags: []string{tag}},
+				},
+			},
+		}, nil, nil)
+
+		u, err := plug.Resolve(us)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(u).To(Equal(&url.URL{Scheme: "http", Host: "1.2.3.4:4321"}))
+	})
 })
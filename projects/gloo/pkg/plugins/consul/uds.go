@@ -66,6 +66,8 @@ func (p *plugin) UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
 	return UpdateUpstream(original, desired)
 }
 
+// UpdateUpstream preserves the fields of the original upstream which discovery can't know about, as listed in the
+// documentation of the consul UpstreamSpec.
 func UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
 	originalSpec, ok := original.UpstreamType.(*v1.Upstream_Consul)
 	if !ok {
@@ -78,6 +80,13 @@ func UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
 
 	// copy service spec, we don't want to overwrite that
 	desiredSpec.Consul.ServiceSpec = originalSpec.Consul.ServiceSpec
+	// same for the other fields which discovery can't know about
+	desiredSpec.Consul.SubsetMetadataKeys = originalSpec.Consul.SubsetMetadataKeys
+	desiredSpec.Consul.TokenSecretRef = originalSpec.Consul.TokenSecretRef
+	desiredSpec.Consul.HealthFilter = originalSpec.Consul.HealthFilter
+	desiredSpec.Consul.PreparedQuery = originalSpec.Consul.PreparedQuery
+	desiredSpec.Consul.DnsNameservers = originalSpec.Consul.DnsNameservers
+	desiredSpec.Consul.AggregateDataCenters = originalSpec.Consul.AggregateDataCenters
 
 	utils.UpdateUpstream(original, desired)
 
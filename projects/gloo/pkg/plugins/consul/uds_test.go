@@ -0,0 +1,63 @@
+package consul
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	consulplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/consul"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("UpdateUpstream", func() {
+
+	consulUpstream := func(spec *consulplugin.UpstreamSpec) *v1.Upstream {
+		return &v1.Upstream{
+			Metadata:     core.Metadata{Name: "my-svc", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Consul{Consul: spec},
+		}
+	}
+
+	It("preserves the fields which discovery can't know about", func() {
+		original := consulUpstream(&consulplugin.UpstreamSpec{
+			ServiceName:          "my-svc",
+			DataCenters:          []string{"dc-1"},
+			AggregateDataCenters: true,
+			SubsetMetadataKeys:   []string{"version"},
+			HealthFilter:         consulplugin.UpstreamSpec_PASSING,
+			PreparedQuery:        "my-query",
+			DnsNameservers:       []string{"10.0.0.53"},
+		})
+		desired := consulUpstream(&consulplugin.UpstreamSpec{
+			ServiceName: "my-svc",
+			DataCenters: []string{"dc-1", "dc-2"},
+		})
+
+		updated, err := UpdateUpstream(original, desired)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated).To(BeTrue())
+
+		spec := desired.GetConsul()
+		Expect(spec.GetDataCenters()).To(Equal([]string{"dc-1", "dc-2"}))
+		Expect(spec.GetAggregateDataCenters()).To(BeTrue())
+		Expect(spec.GetSubsetMetadataKeys()).To(Equal([]string{"version"}))
+		Expect(spec.GetHealthFilter()).To(Equal(consulplugin.UpstreamSpec_PASSING))
+		Expect(spec.GetPreparedQuery()).To(Equal("my-query"))
+		Expect(spec.GetDnsNameservers()).To(Equal([]string{"10.0.0.53"}))
+	})
+
+	It("does not update the upstream if only those fields differ", func() {
+		original := consulUpstream(&consulplugin.UpstreamSpec{
+			ServiceName:          "my-svc",
+			DataCenters:          []string{"dc-1", "dc-2"},
+			AggregateDataCenters: true,
+		})
+		desired := consulUpstream(&consulplugin.UpstreamSpec{
+			ServiceName: "my-svc",
+			DataCenters: []string{"dc-1", "dc-2"},
+		})
+
+		updated, err := UpdateUpstream(original, desired)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated).To(BeFalse())
+	})
+})
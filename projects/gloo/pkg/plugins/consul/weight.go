@@ -0,0 +1,25 @@
+package consul
+
+import (
+	"strconv"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/solo-io/gloo/projects/gloo/constants"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+)
+
+// EDS labels the consul endpoints with the weight of their service instance. Endpoints without a weight keep the
+// default weight of 1.
+func setLoadBalancingWeights(out *envoyapi.ClusterLoadAssignment) {
+	for _, localityEndpoints := range out.GetEndpoints() {
+		for _, lbEndpoint := range localityEndpoints.GetLbEndpoints() {
+			labels := lbEndpoint.GetMetadata().GetFilterMetadata()[translator.EnvoyLb].GetFields()
+			weight, err := strconv.ParseUint(labels[constants.ConsulWeightKey].GetStringValue(), 10, 32)
+			if err != nil || weight == 0 {
+				continue
+			}
+			lbEndpoint.LoadBalancingWeight = &wrappers.UInt32Value{Value: uint32(weight)}
+		}
+	}
+}
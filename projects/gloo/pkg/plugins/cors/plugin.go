@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
@@ -31,6 +32,10 @@ var _ plugins.RoutePlugin = new(plugin)
 
 var (
 	InvalidRouteActionError = errors.New("cannot use cors plugin on non-Route_Route route actions")
+
+	InvalidAllowOriginRegexError = func(regex string, err error) error {
+		return fmt.Errorf("invalid allowOriginRegex %q: %v", regex, err)
+	}
 )
 
 var pluginStage = plugins.DuringStage(plugins.CorsStage)
@@ -74,14 +79,51 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 		}
 		outRa = out.GetRoute()
 	}
+	// envoy uses the most specific policy as is, so the route policy is completed with the virtual host one here
+	corsPolicy := mergeCorsPolicies(params.VirtualHost.GetOptions().GetCors(), corsPlugin)
 	outRa.Cors = &envoyroute.CorsPolicy{}
-	if err := p.translateCommonUserCorsConfig(params.Ctx, in.Options.Cors, outRa.Cors); err != nil {
+	if err := p.translateCommonUserCorsConfig(params.Ctx, corsPolicy, outRa.Cors); err != nil {
 		return err
 	}
-	p.translateRouteSpecificCorsConfig(in.Options.Cors, outRa.Cors)
+	p.translateRouteSpecificCorsConfig(corsPolicy, outRa.Cors)
 	return nil
 }
 
+// mergeCorsPolicies returns the route policy, with the values it does not set taken from the virtual host policy.
+// The allowed origins are taken as a whole, while the exposed headers of both policies are combined.
+func mergeCorsPolicies(vhostPolicy, routePolicy *cors.CorsPolicy) *cors.CorsPolicy {
+	if vhostPolicy == nil {
+		return routePolicy
+	}
+	merged := *routePolicy
+	if len(merged.AllowOrigin) == 0 && len(merged.AllowOriginRegex) == 0 {
+		merged.AllowOrigin = vhostPolicy.AllowOrigin
+		merged.AllowOriginRegex = vhostPolicy.AllowOriginRegex
+	}
+	if len(merged.AllowMethods) == 0 {
+		merged.AllowMethods = vhostPolicy.AllowMethods
+	}
+	if len(merged.AllowHeaders) == 0 {
+		merged.AllowHeaders = vhostPolicy.AllowHeaders
+	}
+	merged.ExposeHeaders = nil
+	seen := map[string]bool{}
+	for _, header := range append(append([]string{}, vhostPolicy.ExposeHeaders...), routePolicy.ExposeHeaders...) {
+		// header names are case insensitive
+		if key := strings.ToLower(header); !seen[key] {
+			seen[key] = true
+			merged.ExposeHeaders = append(merged.ExposeHeaders, header)
+		}
+	}
+	if merged.MaxAge == "" {
+		merged.MaxAge = vhostPolicy.MaxAge
+	}
+	if !merged.AllowCredentials {
+		merged.AllowCredentials = vhostPolicy.AllowCredentials
+	}
+	return &merged
+}
+
 func (p *plugin) translateCommonUserCorsConfig(ctx context.Context, in *cors.CorsPolicy, out *envoyroute.CorsPolicy) error {
 	if len(in.AllowOrigin) == 0 && len(in.AllowOriginRegex) == 0 {
 		return fmt.Errorf("must provide at least one of AllowOrigin or AllowOriginRegex")
@@ -92,6 +134,10 @@ func (p *plugin) translateCommonUserCorsConfig(ctx context.Context, in *cors.Cor
 		})
 	}
 	for _, ao := range in.AllowOriginRegex {
+		// envoy matches origins with RE2, which go regular expressions implement
+		if _, err := regexp.Compile(ao); err != nil {
+			return InvalidAllowOriginRegexError(ao, err)
+		}
 		out.AllowOriginStringMatch = append(out.AllowOriginStringMatch, &envoymatcher.StringMatcher{
 			MatchPattern: &envoymatcher.StringMatcher_SafeRegex{SafeRegex: regexutils.NewRegex(ctx, ao)},
 		})
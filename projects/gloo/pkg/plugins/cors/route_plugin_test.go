@@ -28,7 +28,7 @@ var _ = Describe("Route Plugin", func() {
 
 		// values used in first example
 		allowOrigin1      = []string{"solo.io", "github.com"}
-		allowOriginRegex1 = []string{`*\.solo\.io`, `git.*\.com`}
+		allowOriginRegex1 = []string{`.*\.solo\.io`, `git.*\.com`}
 		allowMethods1     = []string{"GET", "POST"}
 		allowHeaders1     = []string{"allowH1", "allow2"}
 		exposeHeaders1    = []string{"exHeader", "eh2"}
@@ -146,6 +146,76 @@ var _ = Describe("Route Plugin", func() {
 			expected := basicEnvoyRoute()
 			Expect(outRoute).To(Equal(expected))
 		})
+		It("should error on invalid origin regexes", func() {
+			inRoute := routeWithCors(&cors.CorsPolicy{
+				AllowOriginRegex: []string{`*\.solo\.io`},
+			})
+			outRoute := basicEnvoyRoute()
+			err := plugin.(plugins.RoutePlugin).ProcessRoute(params, inRoute, outRoute)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid allowOriginRegex"))
+		})
+	})
+
+	Context("CORS with a virtual host policy", func() {
+		BeforeEach(func() {
+			params.VirtualHost = &v1.VirtualHost{
+				Options: &v1.VirtualHostOptions{
+					Cors: &cors.CorsPolicy{
+						AllowOrigin:      allowOrigin1,
+						AllowMethods:     allowMethods1,
+						AllowHeaders:     allowHeaders1,
+						ExposeHeaders:    exposeHeaders1,
+						MaxAge:           maxAge1,
+						AllowCredentials: true,
+					},
+				},
+			}
+		})
+
+		It("should use the virtual host policy as defaults", func() {
+			inRoute := routeWithCors(&cors.CorsPolicy{
+				MaxAge: "60",
+			})
+			outRoute := basicEnvoyRoute()
+			err := plugin.(plugins.RoutePlugin).ProcessRoute(params, inRoute, outRoute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outRoute.GetRoute().GetCors()).To(Equal(&envoyroute.CorsPolicy{
+				AllowOriginStringMatch: []*envoymatcher.StringMatcher{
+					{MatchPattern: &envoymatcher.StringMatcher_Exact{Exact: allowOrigin1[0]}},
+					{MatchPattern: &envoymatcher.StringMatcher_Exact{Exact: allowOrigin1[1]}},
+				},
+				AllowMethods:     strings.Join(allowMethods1, ","),
+				AllowHeaders:     strings.Join(allowHeaders1, ","),
+				ExposeHeaders:    strings.Join(exposeHeaders1, ","),
+				MaxAge:           "60",
+				AllowCredentials: &wrappers.BoolValue{Value: true},
+			}))
+		})
+
+		It("should override the allowed origins and add the exposed headers", func() {
+			inRoute := routeWithCors(&cors.CorsPolicy{
+				AllowOriginRegex: []string{`.*\.example\.com`},
+				ExposeHeaders:    []string{"EH2", "route-header"},
+			})
+			outRoute := basicEnvoyRoute()
+			err := plugin.(plugins.RoutePlugin).ProcessRoute(params, inRoute, outRoute)
+			Expect(err).NotTo(HaveOccurred())
+			outCors := outRoute.GetRoute().GetCors()
+			Expect(outCors.GetAllowOriginStringMatch()).To(HaveLen(1))
+			Expect(outCors.GetAllowOriginStringMatch()[0].GetSafeRegex().GetRegex()).To(Equal(`.*\.example\.com`))
+			Expect(outCors.GetExposeHeaders()).To(Equal("exHeader,eh2,route-header"))
+		})
+
+		It("should disable the virtual host policy", func() {
+			inRoute := routeWithCors(&cors.CorsPolicy{
+				DisableForRoute: true,
+			})
+			outRoute := basicEnvoyRoute()
+			err := plugin.(plugins.RoutePlugin).ProcessRoute(params, inRoute, outRoute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outRoute.GetRoute().GetCors().GetFilterEnabled().GetDefaultValue().GetNumerator()).To(BeZero())
+		})
 	})
 
 })
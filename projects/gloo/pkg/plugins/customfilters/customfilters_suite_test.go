@@ -0,0 +1,13 @@
+package customfilters_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCustomFilters(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Custom Filters Suite")
+}
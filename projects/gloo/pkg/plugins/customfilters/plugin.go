@@ -0,0 +1,141 @@
+package customfilters
+
+import (
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm"
+)
+
+var (
+	_ plugins.Plugin           = new(Plugin)
+	_ plugins.HttpFilterPlugin = new(Plugin)
+	_ plugins.ListenerPlugin   = new(Plugin)
+
+	MissingFilterNameErr = eris.New("custom filters must have a name")
+
+	InvalidTypedConfigErr = func(name string, err error) error {
+		return eris.Wrapf(err, "invalid typed config for custom filter %v", name)
+	}
+)
+
+// The plugin adds the custom http and network filters of the listeners. Their typed configs are only validated
+// against the envoy types known to Gloo, it is up to the user to place them correctly.
+type Plugin struct{}
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+func (p *Plugin) Init(_ plugins.InitParams) error {
+	return nil
+}
+
+func (p *Plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	var filters []plugins.StagedHttpFilter
+	for _, customFilter := range listener.GetOptions().GetCustomHttpFilters() {
+		typedConfig, err := convertTypedConfig(customFilter.GetName(), customFilter.GetTypedConfig())
+		if err != nil {
+			return nil, err
+		}
+		httpFilter := &envoyhttp.HttpFilter{
+			Name: customFilter.GetName(),
+		}
+		if typedConfig != nil {
+			httpFilter.ConfigType = &envoyhttp.HttpFilter_TypedConfig{
+				TypedConfig: typedConfig,
+			}
+		}
+		filters = append(filters, plugins.StagedHttpFilter{
+			HttpFilter: httpFilter,
+			Stage:      wasm.TransformWasmFilterStage(customFilter.GetFilterStage()),
+		})
+	}
+	return filters, nil
+}
+
+// ProcessListener adds the custom network filters before the last filter of each filter chain, which terminates
+// the connections, e.g. the http connection manager or the tcp proxy
+func (p *Plugin) ProcessListener(_ plugins.Params, in *v1.Listener, out *envoyapi.Listener) error {
+	customFilters := in.GetOptions().GetCustomNetworkFilters()
+	if len(customFilters) == 0 {
+		return nil
+	}
+
+	var networkFilters []*envoylistener.Filter
+	for _, customFilter := range customFilters {
+		typedConfig, err := convertTypedConfig(customFilter.GetName(), customFilter.GetTypedConfig())
+		if err != nil {
+			return err
+		}
+		networkFilter := &envoylistener.Filter{
+			Name: customFilter.GetName(),
+		}
+		if typedConfig != nil {
+			networkFilter.ConfigType = &envoylistener.Filter_TypedConfig{
+				TypedConfig: typedConfig,
+			}
+		}
+		networkFilters = append(networkFilters, networkFilter)
+	}
+
+	for _, filterChain := range out.GetFilterChains() {
+		if len(filterChain.Filters) == 0 {
+			continue
+		}
+		last := len(filterChain.Filters) - 1
+		filters := make([]*envoylistener.Filter, 0, len(filterChain.Filters)+len(networkFilters))
+		filters = append(filters, filterChain.Filters[:last]...)
+		filters = append(filters, networkFilters...)
+		filters = append(filters, filterChain.Filters[last])
+		filterChain.Filters = filters
+	}
+	return nil
+}
+
+// convertTypedConfig returns the typed config of the filter if it is a valid envoy config. Filters without
+// config have a nil typed config.
+func convertTypedConfig(name string, typedConfig *types.Any) (*any.Any, error) {
+	if name == "" {
+		return nil, MissingFilterNameErr
+	}
+	if typedConfig == nil {
+		return nil, nil
+	}
+
+	out := &any.Any{
+		TypeUrl: typedConfig.GetTypeUrl(),
+		Value:   typedConfig.GetValue(),
+	}
+	config, err := unmarshalTypedConfig(typedConfig, out)
+	if err != nil {
+		return nil, InvalidTypedConfigErr(name, err)
+	}
+	if validator, ok := config.(interface{ Validate() error }); ok {
+		if err := validator.Validate(); err != nil {
+			return nil, InvalidTypedConfigErr(name, err)
+		}
+	}
+	return out, nil
+}
+
+// unmarshalTypedConfig resolves the type of the config among the envoy types of go-control-plane, and the envoy
+// types copied to Gloo, which are registered with gogo
+func unmarshalTypedConfig(typedConfig *types.Any, out *any.Any) (interface{}, error) {
+	var config ptypes.DynamicAny
+	err := ptypes.UnmarshalAny(out, &config)
+	if err == nil {
+		return config.Message, nil
+	}
+	var gogoConfig types.DynamicAny
+	if gogoErr := types.UnmarshalAny(typedConfig, &gogoConfig); gogoErr != nil {
+		return nil, err
+	}
+	return gogoConfig.Message, nil
+}
@@ -0,0 +1,173 @@
+package customfilters_test
+
+import (
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoybuffer "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/buffer/v3"
+	envoyrbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/custom_filters"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/customfilters"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var plugin *Plugin
+
+	toAny := func(msg proto.Message) *types.Any {
+		out := utils.MustMessageToAny(msg)
+		return &types.Any{
+			TypeUrl: out.GetTypeUrl(),
+			Value:   out.GetValue(),
+		}
+	}
+
+	BeforeEach(func() {
+		plugin = NewPlugin()
+	})
+
+	Context("http filters", func() {
+
+		It("adds the custom http filters at their stage", func() {
+			listener := &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					CustomHttpFilters: []*custom_filters.CustomHttpFilter{
+						{
+							Name: wellknown.Buffer,
+							TypedConfig: toAny(&envoybuffer.Buffer{
+								MaxRequestBytes: &wrappers.UInt32Value{Value: 1024},
+							}),
+							FilterStage: &wasm.FilterStage{
+								Stage:     wasm.FilterStage_AuthZStage,
+								Predicate: wasm.FilterStage_After,
+							},
+						},
+						{
+							Name: "envoy.filters.http.dynamo",
+						},
+					},
+				},
+			}
+
+			filters, err := plugin.HttpFilters(plugins.Params{}, listener)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(2))
+
+			Expect(filters[0].HttpFilter.GetName()).To(Equal(wellknown.Buffer))
+			Expect(filters[0].HttpFilter.GetTypedConfig().GetTypeUrl()).To(Equal("type.googleapis.com/envoy.extensions.filters.http.buffer.v3.Buffer"))
+			Expect(filters[0].Stage).To(Equal(plugins.AfterStage(plugins.AuthZStage)))
+
+			Expect(filters[1].HttpFilter.GetName()).To(Equal("envoy.filters.http.dynamo"))
+			Expect(filters[1].HttpFilter.GetConfigType()).To(BeNil())
+			Expect(filters[1].Stage).To(Equal(plugins.BeforeStage(plugins.AcceptedStage)))
+		})
+
+		It("rejects invalid typed configs", func() {
+			listener := &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					CustomHttpFilters: []*custom_filters.CustomHttpFilter{{
+						Name:        wellknown.Buffer,
+						TypedConfig: toAny(&envoybuffer.Buffer{}),
+					}},
+				},
+			}
+
+			_, err := plugin.HttpFilters(plugins.Params{}, listener)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid typed config for custom filter " + wellknown.Buffer))
+		})
+
+		It("rejects typed configs of unknown types", func() {
+			listener := &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					CustomHttpFilters: []*custom_filters.CustomHttpFilter{{
+						Name: "unknown",
+						TypedConfig: &types.Any{
+							TypeUrl: "type.googleapis.com/unknown.Filter",
+						},
+					}},
+				},
+			}
+
+			_, err := plugin.HttpFilters(plugins.Params{}, listener)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("rejects filters without name", func() {
+			listener := &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					CustomHttpFilters: []*custom_filters.CustomHttpFilter{{}},
+				},
+			}
+
+			_, err := plugin.HttpFilters(plugins.Params{}, listener)
+			Expect(err).To(MatchError(MissingFilterNameErr))
+		})
+	})
+
+	Context("network filters", func() {
+
+		var out *envoyapi.Listener
+
+		BeforeEach(func() {
+			out = &envoyapi.Listener{
+				FilterChains: []*envoylistener.FilterChain{
+					{Filters: []*envoylistener.Filter{{Name: wellknown.HTTPConnectionManager}}},
+					{Filters: []*envoylistener.Filter{{Name: "envoy.filters.network.sni_cluster"}, {Name: wellknown.TCPProxy}}},
+				},
+			}
+		})
+
+		It("adds the custom network filters before the last filter of each filter chain", func() {
+			in := &v1.Listener{
+				Options: &v1.ListenerOptions{
+					CustomNetworkFilters: []*custom_filters.CustomNetworkFilter{{
+						Name:        wellknown.RoleBasedAccessControl,
+						TypedConfig: toAny(&envoyrbac.RBAC{StatPrefix: "custom"}),
+					}},
+				},
+			}
+
+			err := plugin.ProcessListener(plugins.Params{}, in, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			var names [][]string
+			for _, filterChain := range out.FilterChains {
+				var chainNames []string
+				for _, filter := range filterChain.Filters {
+					chainNames = append(chainNames, filter.Name)
+				}
+				names = append(names, chainNames)
+			}
+			Expect(names).To(Equal([][]string{
+				{wellknown.RoleBasedAccessControl, wellknown.HTTPConnectionManager},
+				{"envoy.filters.network.sni_cluster", wellknown.RoleBasedAccessControl, wellknown.TCPProxy},
+			}))
+			Expect(out.FilterChains[0].Filters[0].GetTypedConfig().GetTypeUrl()).To(Equal("type.googleapis.com/envoy.extensions.filters.network.rbac.v3.RBAC"))
+		})
+
+		It("rejects invalid typed configs", func() {
+			in := &v1.Listener{
+				Options: &v1.ListenerOptions{
+					CustomNetworkFilters: []*custom_filters.CustomNetworkFilter{{
+						Name:        wellknown.RoleBasedAccessControl,
+						TypedConfig: toAny(&envoyrbac.RBAC{}),
+					}},
+				},
+			}
+
+			err := plugin.ProcessListener(plugins.Params{}, in, out)
+			Expect(err).To(HaveOccurred())
+			Expect(out.FilterChains[0].Filters).To(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,204 @@
+package dlp
+
+import (
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/proto"
+	"github.com/rotisserie/eris"
+
+	"github.com/solo-io/gloo/pkg/utils/gogoutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation_ee"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/dlp"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+)
+
+const FilterName = "io.solo.filters.http.transformation_ee"
+
+var pluginStage = plugins.DuringStage(plugins.CorsStage)
+
+var (
+	EmptyCustomActionError = func(name string) error {
+		return eris.Errorf("custom dlp action %s must define at least one regex", name)
+	}
+	InvalidMaskCharError = func(name, maskChar string) error {
+		return eris.Errorf("mask char %q of custom dlp action %s must be a single character", maskChar, name)
+	}
+	EmptyRegexActionError = func(name string) error {
+		return eris.Errorf("regex actions of custom dlp action %s must set a regex", name)
+	}
+)
+
+// The enterprise transformation filter is only built into the enterprise gateway proxy, so this plugin is not part of
+// the open source plugin registry: it must be added explicitly, as a plugin extension of the gloo syncer.
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+var _ plugins.Plugin = new(Plugin)
+var _ plugins.HttpFilterPlugin = new(Plugin)
+var _ plugins.VirtualHostPlugin = new(Plugin)
+var _ plugins.RoutePlugin = new(Plugin)
+
+type Plugin struct{}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *Plugin) ProcessVirtualHost(params plugins.VirtualHostParams, in *v1.VirtualHost, out *envoyroute.VirtualHost) error {
+	dlpConfig := in.GetOptions().GetDlp()
+	if dlpConfig == nil {
+		return nil
+	}
+
+	routeTransformations, err := translateRouteTransformations(dlpConfig.GetActions(), dlpConfig.GetEnabledFor())
+	if err != nil {
+		return err
+	}
+	return pluginutils.SetVhostPerFilterConfig(out, FilterName, routeTransformations)
+}
+
+func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoyroute.Route) error {
+	dlpConfig := in.GetOptions().GetDlp()
+	if dlpConfig == nil {
+		return nil
+	}
+
+	routeTransformations, err := translateRouteTransformations(dlpConfig.GetActions(), dlpConfig.GetEnabledFor())
+	if err != nil {
+		return err
+	}
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, routeTransformations)
+}
+
+func (p *Plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	filterConfig := listener.GetOptions().GetDlp()
+	if filterConfig == nil && !usesDlp(listener) {
+		return nil, nil
+	}
+
+	// without listener rules, the filter only applies the actions of the virtual hosts and routes
+	config := &transformation_ee.FilterTransformations{}
+	for _, rule := range filterConfig.GetDlpRules() {
+		routeTransformations, err := translateRouteTransformations(rule.GetActions(), rule.GetEnabledFor())
+		if err != nil {
+			return nil, err
+		}
+		match := translator.GlooMatcherToEnvoyMatcher(params, ruleMatcher(rule))
+		config.Transformations = append(config.Transformations, &transformation_ee.TransformationRule{
+			Match:                gogoutils.ToGlooRouteMatch(&match),
+			RouteTransformations: routeTransformations,
+		})
+	}
+
+	dlpFilter, err := plugins.NewStagedFilterWithConfig(FilterName, config, pluginStage)
+	if err != nil {
+		return nil, eris.Wrapf(err, "generating filter config")
+	}
+
+	return []plugins.StagedHttpFilter{dlpFilter}, nil
+}
+
+func usesDlp(listener *v1.HttpListener) bool {
+	for _, virtualHost := range listener.GetVirtualHosts() {
+		if virtualHost.GetOptions().GetDlp() != nil {
+			return true
+		}
+		for _, route := range virtualHost.GetRoutes() {
+			if route.GetOptions().GetDlp() != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// rules without a path match all the requests
+func ruleMatcher(rule *dlp.DlpRule) *matchers.Matcher {
+	if rule.GetMatcher().GetPathSpecifier() != nil {
+		return rule.GetMatcher()
+	}
+	matcher := &matchers.Matcher{}
+	if rule.GetMatcher() != nil {
+		matcher = proto.Clone(rule.GetMatcher()).(*matchers.Matcher)
+	}
+	matcher.PathSpecifier = &matchers.Matcher_Prefix{Prefix: "/"}
+	return matcher
+}
+
+func translateRouteTransformations(actions []*dlp.Action, enabledFor dlp.Config_EnableFor) (*transformation_ee.RouteTransformations, error) {
+	var envoyActions []*transformation_ee.Action
+	for _, action := range actions {
+		envoyAction, err := translateAction(action)
+		if err != nil {
+			return nil, err
+		}
+		envoyActions = append(envoyActions, envoyAction)
+	}
+
+	routeTransformations := &transformation_ee.RouteTransformations{}
+	if enabledFor == dlp.Config_RESPONSE_BODY || enabledFor == dlp.Config_ALL {
+		routeTransformations.ResponseTransformation = &transformation_ee.Transformation{
+			TransformationType: &transformation_ee.Transformation_DlpTransformation{
+				DlpTransformation: &transformation_ee.DlpTransformation{
+					Actions: envoyActions,
+				},
+			},
+		}
+	}
+	if enabledFor == dlp.Config_ACCESS_LOGS || enabledFor == dlp.Config_ALL {
+		// the access logs read the headers and dynamic metadata once the stream completed
+		routeTransformations.OnStreamCompletionTransformation = &transformation_ee.Transformation{
+			TransformationType: &transformation_ee.Transformation_DlpTransformation{
+				DlpTransformation: &transformation_ee.DlpTransformation{
+					Actions:                             envoyActions,
+					EnableHeaderTransformation:          true,
+					EnableDynamicMetadataTransformation: true,
+				},
+			},
+		}
+	}
+	return routeTransformations, nil
+}
+
+func translateAction(action *dlp.Action) (*transformation_ee.Action, error) {
+	if action.GetActionType() != dlp.Action_CUSTOM {
+		return &transformation_ee.Action{
+			Name:   action.GetActionType().String(),
+			Regex:  predefinedRegexes(action.GetActionType()),
+			Shadow: action.GetShadow(),
+		}, nil
+	}
+
+	customAction := action.GetCustomAction()
+	name := customAction.GetName()
+	if len(customAction.GetRegex()) == 0 && len(customAction.GetRegexActions()) == 0 {
+		return nil, EmptyCustomActionError(name)
+	}
+	if len(customAction.GetMaskChar()) > 1 {
+		return nil, InvalidMaskCharError(name, customAction.GetMaskChar())
+	}
+
+	var regexActions []*transformation_ee.RegexAction
+	for _, regexAction := range customAction.GetRegexActions() {
+		if regexAction.GetRegex() == "" {
+			return nil, EmptyRegexActionError(name)
+		}
+		regexActions = append(regexActions, &transformation_ee.RegexAction{
+			Regex:    regexAction.GetRegex(),
+			Subgroup: regexAction.GetSubgroup(),
+		})
+	}
+
+	return &transformation_ee.Action{
+		Name:         name,
+		Regex:        customAction.GetRegex(),
+		RegexActions: regexActions,
+		Shadow:       action.GetShadow(),
+		Percent:      customAction.GetPercent(),
+		MaskChar:     customAction.GetMaskChar(),
+	}, nil
+}
@@ -0,0 +1,233 @@
+package dlp_test
+
+import (
+	"context"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/proto"
+	pany "github.com/golang/protobuf/ptypes/any"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	envoyroute_gloo "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/route"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation_ee"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/dlp"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/dlp"
+	_type "github.com/solo-io/solo-kit/pkg/api/external/envoy/type"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		params    plugins.Params
+		dlpConfig *dlp.Config
+	)
+
+	BeforeEach(func() {
+		params = plugins.Params{Ctx: context.Background()}
+
+		dlpConfig = &dlp.Config{
+			Actions: []*dlp.Action{{
+				ActionType: dlp.Action_SSN,
+			}, {
+				CustomAction: &dlp.CustomAction{
+					Name:     "names",
+					Regex:    []string{`"name":"[^"]+"`},
+					MaskChar: "#",
+					Percent:  &_type.Percent{Value: 60},
+					RegexActions: []*dlp.RegexAction{{
+						Regex:    `"email":"([^"]+)"`,
+						Subgroup: 1,
+					}},
+				},
+				Shadow: true,
+			}},
+		}
+	})
+
+	expectedActions := func() []*transformation_ee.Action {
+		return []*transformation_ee.Action{{
+			Name: "SSN",
+			Regex: []string{
+				`(?!\D)[0-9]{9}(?=\D|$)`,
+				`(?!\D)[0-9]{3}\-[0-9]{2}\-[0-9]{4}(?=\D|$)`,
+				`(?!\D)[0-9]{3}\ [0-9]{2}\ [0-9]{4}(?=\D|$)`,
+			},
+		}, {
+			Name:     "names",
+			Regex:    []string{`"name":"[^"]+"`},
+			MaskChar: "#",
+			Percent:  &_type.Percent{Value: 60},
+			RegexActions: []*transformation_ee.RegexAction{{
+				Regex:    `"email":"([^"]+)"`,
+				Subgroup: 1,
+			}},
+			Shadow: true,
+		}}
+	}
+
+	dlpTransformation := func(accessLogs bool) *transformation_ee.Transformation {
+		return &transformation_ee.Transformation{
+			TransformationType: &transformation_ee.Transformation_DlpTransformation{
+				DlpTransformation: &transformation_ee.DlpTransformation{
+					Actions:                             expectedActions(),
+					EnableHeaderTransformation:          accessLogs,
+					EnableDynamicMetadataTransformation: accessLogs,
+				},
+			},
+		}
+	}
+
+	filterConfig := func(filters []plugins.StagedHttpFilter) *transformation_ee.FilterTransformations {
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].Stage).To(Equal(plugins.DuringStage(plugins.CorsStage)))
+		Expect(filters[0].HttpFilter.Name).To(Equal(FilterName))
+		var config transformation_ee.FilterTransformations
+		Expect(proto.Unmarshal(filters[0].HttpFilter.GetTypedConfig().GetValue(), &config)).To(Succeed())
+		return &config
+	}
+
+	perFilterConfig := func(typedPerFilterConfig map[string]*pany.Any) *transformation_ee.RouteTransformations {
+		Expect(typedPerFilterConfig).To(HaveKey(FilterName))
+		var config transformation_ee.RouteTransformations
+		Expect(proto.Unmarshal(typedPerFilterConfig[FilterName].GetValue(), &config)).To(Succeed())
+		return &config
+	}
+
+	Context("filter", func() {
+
+		It("is not added without dlp config", func() {
+			filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{{
+					Routes: []*v1.Route{{}},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+
+		It("is added without rules when only a route uses dlp", func() {
+			filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{{
+					Routes: []*v1.Route{{
+						Options: &v1.RouteOptions{Dlp: dlpConfig},
+					}},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filterConfig(filters)).To(Equal(&transformation_ee.FilterTransformations{}))
+		})
+
+		It("translates the listener rules", func() {
+			filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					Dlp: &dlp.FilterConfig{
+						DlpRules: []*dlp.DlpRule{{
+							Matcher: &matchers.Matcher{
+								PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/users"},
+							},
+							Actions:    dlpConfig.GetActions(),
+							EnabledFor: dlp.Config_ALL,
+						}, {
+							Actions:    dlpConfig.GetActions(),
+							EnabledFor: dlp.Config_ACCESS_LOGS,
+						}},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filterConfig(filters)).To(Equal(&transformation_ee.FilterTransformations{
+				Transformations: []*transformation_ee.TransformationRule{{
+					Match: &envoyroute_gloo.RouteMatch{
+						PathSpecifier: &envoyroute_gloo.RouteMatch_Prefix{Prefix: "/users"},
+					},
+					RouteTransformations: &transformation_ee.RouteTransformations{
+						ResponseTransformation:           dlpTransformation(false),
+						OnStreamCompletionTransformation: dlpTransformation(true),
+					},
+				}, {
+					Match: &envoyroute_gloo.RouteMatch{
+						PathSpecifier: &envoyroute_gloo.RouteMatch_Prefix{Prefix: "/"},
+					},
+					RouteTransformations: &transformation_ee.RouteTransformations{
+						OnStreamCompletionTransformation: dlpTransformation(true),
+					},
+				}},
+			}))
+		})
+
+		It("uses all the credit card regexes for ALL_CREDIT_CARDS", func() {
+			filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					Dlp: &dlp.FilterConfig{
+						DlpRules: []*dlp.DlpRule{{
+							Actions: []*dlp.Action{{ActionType: dlp.Action_ALL_CREDIT_CARDS}},
+						}},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			action := filterConfig(filters).GetTransformations()[0].GetRouteTransformations().
+				GetResponseTransformation().GetDlpTransformation().GetActions()[0]
+			Expect(action.GetName()).To(Equal("ALL_CREDIT_CARDS"))
+			Expect(action.GetRegex()).To(HaveLen(11))
+		})
+	})
+
+	Context("per filter config", func() {
+
+		It("masks the response body of a virtual host by default", func() {
+			out := &envoyroute.VirtualHost{}
+			err := NewPlugin().ProcessVirtualHost(plugins.VirtualHostParams{Params: params}, &v1.VirtualHost{
+				Options: &v1.VirtualHostOptions{Dlp: dlpConfig},
+			}, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(perFilterConfig(out.GetTypedPerFilterConfig())).To(Equal(&transformation_ee.RouteTransformations{
+				ResponseTransformation: dlpTransformation(false),
+			}))
+		})
+
+		It("masks the access logs of a route", func() {
+			dlpConfig.EnabledFor = dlp.Config_ACCESS_LOGS
+
+			out := &envoyroute.Route{}
+			err := NewPlugin().ProcessRoute(plugins.RouteParams{}, &v1.Route{
+				Options: &v1.RouteOptions{Dlp: dlpConfig},
+			}, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(perFilterConfig(out.GetTypedPerFilterConfig())).To(Equal(&transformation_ee.RouteTransformations{
+				OnStreamCompletionTransformation: dlpTransformation(true),
+			}))
+		})
+
+		It("errors on custom actions without regexes", func() {
+			dlpConfig.Actions[1].CustomAction.Regex = nil
+			dlpConfig.Actions[1].CustomAction.RegexActions = nil
+
+			err := NewPlugin().ProcessRoute(plugins.RouteParams{}, &v1.Route{
+				Options: &v1.RouteOptions{Dlp: dlpConfig},
+			}, &envoyroute.Route{})
+			Expect(err).To(MatchError(EmptyCustomActionError("names")))
+		})
+
+		It("errors on mask chars longer than one character", func() {
+			dlpConfig.Actions[1].CustomAction.MaskChar = "##"
+
+			err := NewPlugin().ProcessRoute(plugins.RouteParams{}, &v1.Route{
+				Options: &v1.RouteOptions{Dlp: dlpConfig},
+			}, &envoyroute.Route{})
+			Expect(err).To(MatchError(InvalidMaskCharError("names", "##")))
+		})
+
+		It("errors on regex actions without a regex", func() {
+			dlpConfig.Actions[1].CustomAction.RegexActions = []*dlp.RegexAction{{Subgroup: 1}}
+
+			err := NewPlugin().ProcessRoute(plugins.RouteParams{}, &v1.Route{
+				Options: &v1.RouteOptions{Dlp: dlpConfig},
+			}, &envoyroute.Route{})
+			Expect(err).To(MatchError(EmptyRegexActionError("names")))
+		})
+	})
+})
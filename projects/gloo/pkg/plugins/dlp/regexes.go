@@ -0,0 +1,75 @@
+package dlp
+
+import (
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/dlp"
+)
+
+// these are evaluated by envoy, so they use lookarounds that go's regexp does not support
+var (
+	ssnRegexes = []string{
+		`(?!\D)[0-9]{9}(?=\D|$)`,
+		`(?!\D)[0-9]{3}\-[0-9]{2}\-[0-9]{4}(?=\D|$)`,
+		`(?!\D)[0-9]{3}\ [0-9]{2}\ [0-9]{4}(?=\D|$)`,
+	}
+	mastercardRegexes = []string{
+		`(?!\D)5[1-5][0-9]{2}(\ |\-|)[0-9]{4}(\ |\-|)[0-9]{4}(\ |\-|)[0-9]{4}(?=\D|$)`,
+	}
+	visaRegexes = []string{
+		`(?!\D)4[0-9]{3}(\ |\-|)[0-9]{4}(\ |\-|)[0-9]{4}(\ |\-|)[0-9]{4}(?=\D|$)`,
+	}
+	amexRegexes = []string{
+		`(?!\D)(34|37)[0-9]{2}(\ |\-|)[0-9]{6}(\ |\-|)[0-9]{5}(?=\D|$)`,
+	}
+	discoverRegexes = []string{
+		`(?!\D)6011(\ |\-|)[0-9]{4}(\ |\-|)[0-9]{4}(\ |\-|)[0-9]{4}(?=\D|$)`,
+	}
+	jcbRegexes = []string{
+		`(?!\D)3[0-9]{3}(\ |\-|)[0-9]{4}(\ |\-|)[0-9]{4}(\ |\-|)[0-9]{4}(?=\D|$)`,
+		`(?!\D)(2131|1800)[0-9]{11}(?=\D|$)`,
+	}
+	dinersClubRegexes = []string{
+		`(?!\D)30[0-5][0-9](\ |\-|)[0-9]{6}(\ |\-|)[0-9]{4}(?=\D|$)`,
+		`(?!\D)(36|38)[0-9]{2}(\ |\-|)[0-9]{6}(\ |\-|)[0-9]{4}(?=\D|$)`,
+	}
+	creditCardTrackersRegexes = []string{
+		`[1-9][0-9]{2}\-[0-9]{2}\-[0-9]{4}\^\d`,
+		`(?!\D)\%?[Bb]\d{13,19}\^[\-\/\.\w\s]{2,26}\^[0-9][0-9][01][0-9][0-9]{3}`,
+		`(?!\D)\;\d{13,19}\=(\d{3}|)(\d{4}|\=)`,
+	}
+)
+
+func predefinedRegexes(actionType dlp.Action_ActionType) []string {
+	switch actionType {
+	case dlp.Action_SSN:
+		return ssnRegexes
+	case dlp.Action_MASTERCARD:
+		return mastercardRegexes
+	case dlp.Action_VISA:
+		return visaRegexes
+	case dlp.Action_AMEX:
+		return amexRegexes
+	case dlp.Action_DISCOVER:
+		return discoverRegexes
+	case dlp.Action_JCB:
+		return jcbRegexes
+	case dlp.Action_DINERS_CLUB:
+		return dinersClubRegexes
+	case dlp.Action_CREDIT_CARD_TRACKERS:
+		return creditCardTrackersRegexes
+	case dlp.Action_ALL_CREDIT_CARDS:
+		var regexes []string
+		for _, cardRegexes := range [][]string{
+			mastercardRegexes,
+			visaRegexes,
+			amexRegexes,
+			discoverRegexes,
+			jcbRegexes,
+			dinersClubRegexes,
+			creditCardTrackersRegexes,
+		} {
+			regexes = append(regexes, cardRegexes...)
+		}
+		return regexes
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyendpoint "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
+	"github.com/solo-io/gloo/pkg/utils/gogoutils"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1dns "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dns"
+	dnsresolver "github.com/solo-io/gloo/projects/gloo/pkg/dns"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+var (
+	NoHostsErr = func(ref core.ResourceRef) error {
+		return errors.Errorf("no hosts provided for DNS upstream %v", ref)
+	}
+	LogicalDnsHostsErr = func(ref core.ResourceRef) error {
+		return errors.Errorf("LOGICAL_DNS upstream %v must have exactly one host", ref)
+	}
+)
+
+type plugin struct {
+	// resolves the hostnames of upstreams with resolvers for function discovery
+	resolvers *dnsresolver.Resolvers
+}
+
+func NewPlugin() plugins.Plugin {
+	return &plugin{
+		resolvers: dnsresolver.NewResolvers(nil, dnsresolver.CacheOptions{NegativeTtl: dnsresolver.DefaultNegativeTtl}),
+	}
+}
+
+func (p *plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *plugin) Resolve(u *v1.Upstream) (*url.URL, error) {
+	dnsSpec, ok := u.UpstreamType.(*v1.Upstream_Dns)
+	if !ok {
+		return nil, nil
+	}
+	if len(dnsSpec.Dns.GetHosts()) == 0 {
+		return nil, NoHostsErr(u.Metadata.Ref())
+	}
+
+	host := dnsSpec.Dns.GetHosts()[0]
+	addr := host.GetAddr()
+	// the resolvers of the upstream can't be used to resolve the URL, so we resolve the host beforehand
+	if nameservers := dnsSpec.Dns.GetDnsResolvers(); len(nameservers) > 0 && net.ParseIP(addr) == nil {
+		ipAddrs, err := p.resolvers.ForNameservers(nameservers).Resolve(context.TODO(), addr)
+		if err != nil {
+			return nil, err
+		}
+		// arbitrarily default to the first result
+		addr = ipAddrs[0].String()
+	}
+
+	return url.Parse(fmt.Sprintf("tcp://%v:%v", addr, host.GetPort()))
+}
+
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
+	dnsSpec, ok := in.UpstreamType.(*v1.Upstream_Dns)
+	if !ok {
+		// not ours
+		return nil
+	}
+	spec := dnsSpec.Dns
+	if len(spec.GetHosts()) == 0 {
+		return NoHostsErr(in.Metadata.Ref())
+	}
+
+	switch spec.GetDiscoveryType() {
+	case v1dns.UpstreamSpec_STRICT_DNS:
+		out.ClusterDiscoveryType = &envoyapi.Cluster_Type{Type: envoyapi.Cluster_STRICT_DNS}
+	case v1dns.UpstreamSpec_LOGICAL_DNS:
+		if len(spec.GetHosts()) != 1 {
+			return LogicalDnsHostsErr(in.Metadata.Ref())
+		}
+		out.ClusterDiscoveryType = &envoyapi.Cluster_Type{Type: envoyapi.Cluster_LOGICAL_DNS}
+	default:
+		return errors.Errorf("unknown discovery type %v", spec.GetDiscoveryType())
+	}
+
+	switch spec.GetDnsLookupFamily() {
+	case v1dns.UpstreamSpec_V4_ONLY:
+		out.DnsLookupFamily = envoyapi.Cluster_V4_ONLY
+	case v1dns.UpstreamSpec_V6_ONLY:
+		out.DnsLookupFamily = envoyapi.Cluster_V6_ONLY
+	case v1dns.UpstreamSpec_AUTO:
+		out.DnsLookupFamily = envoyapi.Cluster_AUTO
+	default:
+		return errors.Errorf("unknown DNS lookup family %v", spec.GetDnsLookupFamily())
+	}
+
+	out.RespectDnsTtl = spec.GetRespectDnsTtl()
+	if spec.GetDnsRefreshRate() != nil {
+		out.DnsRefreshRate = gogoutils.DurationStdToProto(spec.GetDnsRefreshRate())
+	}
+	dnsResolvers, err := pluginutils.EnvoyDnsResolvers(spec.GetDnsResolvers())
+	if err != nil {
+		return err
+	}
+	out.DnsResolvers = dnsResolvers
+
+	out.LoadAssignment = &envoyapi.ClusterLoadAssignment{
+		ClusterName: out.Name,
+		Endpoints:   []*envoyendpoint.LocalityLbEndpoints{{}},
+	}
+	for _, host := range spec.GetHosts() {
+		if host.GetAddr() == "" {
+			return errors.Errorf("addr cannot be empty for host")
+		}
+		if host.GetPort() == 0 {
+			return errors.Errorf("port cannot be empty for host")
+		}
+		endpoint := pluginutils.EnvoyEndpoint(host.GetAddr(), host.GetPort())
+		endpoint.Hostname = host.GetAddr()
+		out.LoadAssignment.Endpoints[0].LbEndpoints = append(out.LoadAssignment.Endpoints[0].LbEndpoints,
+			&envoyendpoint.LbEndpoint{
+				HostIdentifier: &envoyendpoint.LbEndpoint_Endpoint{
+					Endpoint: endpoint,
+				},
+			})
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package dns
+
+import (
+	"time"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	"github.com/golang/protobuf/ptypes/duration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1dns "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dns"
+	"github.com/solo-io/gloo/projects/gloo/pkg/dns"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		p            plugins.UpstreamPlugin
+		params       plugins.Params
+		upstream     *v1.Upstream
+		upstreamSpec *v1dns.UpstreamSpec
+		out          *envoyapi.Cluster
+	)
+
+	BeforeEach(func() {
+		p = NewPlugin().(plugins.UpstreamPlugin)
+		Expect(p.Init(plugins.InitParams{})).To(Succeed())
+		out = &envoyapi.Cluster{Name: "my-backend_default"}
+
+		upstreamSpec = &v1dns.UpstreamSpec{
+			Hosts: []*v1dns.Host{{
+				Addr: "backend.example.com",
+				Port: 8080,
+			}},
+		}
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{Name: "my-backend", Namespace: "default"},
+			UpstreamType: &v1.Upstream_Dns{
+				Dns: upstreamSpec,
+			},
+		}
+	})
+
+	It("uses a strict dns cluster resolving IPv4 addresses by default", func() {
+		err := p.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(out.GetType()).To(Equal(envoyapi.Cluster_STRICT_DNS))
+		Expect(out.DnsLookupFamily).To(Equal(envoyapi.Cluster_V4_ONLY))
+		Expect(out.RespectDnsTtl).To(BeFalse())
+		Expect(out.DnsRefreshRate).To(BeNil())
+		Expect(out.DnsResolvers).To(BeEmpty())
+
+		Expect(out.LoadAssignment.ClusterName).To(Equal("my-backend_default"))
+		lbEndpoints := out.LoadAssignment.Endpoints[0].LbEndpoints
+		Expect(lbEndpoints).To(HaveLen(1))
+		Expect(lbEndpoints[0].GetEndpoint().Hostname).To(Equal("backend.example.com"))
+		Expect(lbEndpoints[0].GetEndpoint().Address.GetSocketAddress().Address).To(Equal("backend.example.com"))
+		Expect(lbEndpoints[0].GetEndpoint().Address.GetSocketAddress().GetPortValue()).To(BeEquivalentTo(8080))
+	})
+
+	It("configures how envoy resolves the hosts", func() {
+		refreshRate := 30 * time.Second
+		upstreamSpec.DiscoveryType = v1dns.UpstreamSpec_LOGICAL_DNS
+		upstreamSpec.DnsLookupFamily = v1dns.UpstreamSpec_AUTO
+		upstreamSpec.RespectDnsTtl = true
+		upstreamSpec.DnsRefreshRate = &refreshRate
+		upstreamSpec.DnsResolvers = []string{"10.0.0.53", "10.0.0.54:5353"}
+
+		err := p.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(out.GetType()).To(Equal(envoyapi.Cluster_LOGICAL_DNS))
+		Expect(out.DnsLookupFamily).To(Equal(envoyapi.Cluster_AUTO))
+		Expect(out.RespectDnsTtl).To(BeTrue())
+		Expect(out.DnsRefreshRate).To(Equal(&duration.Duration{Seconds: 30}))
+		Expect(out.DnsResolvers).To(HaveLen(2))
+		Expect(out.DnsResolvers[0].GetSocketAddress().Protocol).To(Equal(envoycore.SocketAddress_UDP))
+		Expect(out.DnsResolvers[0].GetSocketAddress().Address).To(Equal("10.0.0.53"))
+		Expect(out.DnsResolvers[0].GetSocketAddress().GetPortValue()).To(BeEquivalentTo(53))
+		Expect(out.DnsResolvers[1].GetSocketAddress().Address).To(Equal("10.0.0.54"))
+		Expect(out.DnsResolvers[1].GetSocketAddress().GetPortValue()).To(BeEquivalentTo(5353))
+	})
+
+	It("requires hosts", func() {
+		upstreamSpec.Hosts = nil
+		err := p.ProcessUpstream(params, upstream, out)
+		Expect(err).To(MatchError(NoHostsErr(upstream.Metadata.Ref()).Error()))
+	})
+
+	It("requires exactly one host for logical dns upstreams", func() {
+		upstreamSpec.DiscoveryType = v1dns.UpstreamSpec_LOGICAL_DNS
+		upstreamSpec.Hosts = append(upstreamSpec.Hosts, &v1dns.Host{Addr: "backend-2.example.com", Port: 8080})
+		err := p.ProcessUpstream(params, upstream, out)
+		Expect(err).To(MatchError(LogicalDnsHostsErr(upstream.Metadata.Ref()).Error()))
+	})
+
+	It("rejects resolvers which are not IP addresses", func() {
+		upstreamSpec.DnsResolvers = []string{"nameserver.example.com"}
+		err := p.ProcessUpstream(params, upstream, out)
+		Expect(err).To(MatchError(dns.InvalidNameserverErr("nameserver.example.com")))
+	})
+
+	It("resolves the url of the first host for function discovery", func() {
+		u, err := p.(*plugin).Resolve(upstream)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(u.String()).To(Equal("tcp://backend.example.com:8080"))
+	})
+})
@@ -0,0 +1,182 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/rotisserie/eris"
+)
+
+const (
+	DefaultAddress = "unix:///var/run/docker.sock"
+
+	// the standard env var used by the Docker CLI to locate the daemon
+	dockerHostEnv = "DOCKER_HOST"
+)
+
+var (
+	UnsupportedAddressError = func(address string) error {
+		return eris.Errorf("unsupported Docker daemon address %s, "+
+			"expected an address with the unix, tcp, http or https scheme", address)
+	}
+
+	UnexpectedStatusError = func(path string, status string) error {
+		return eris.Errorf("unexpected response from the Docker daemon for %s: %s", path, status)
+	}
+)
+
+// Client lists and watches the containers of a Docker daemon, with the Docker Engine API
+type Client interface {
+	// lists the running containers with the given label
+	ListContainers(ctx context.Context, label string) ([]Container, error)
+	// sends a signal whenever a container is started or stopped, until the context is
+	// done or the stream of events is interrupted, in which case an error is sent and both channels are closed
+	WatchContainerEvents(ctx context.Context) (<-chan struct{}, <-chan error, error)
+}
+
+// The parts of a container of the Engine API needed to discover upstreams
+type Container struct {
+	ID              string            `json:"Id"`
+	Names           []string          `json:"Names"`
+	Labels          map[string]string `json:"Labels"`
+	Ports           []ContainerPort   `json:"Ports"`
+	NetworkSettings struct {
+		Networks map[string]ContainerNetwork `json:"Networks"`
+	} `json:"NetworkSettings"`
+}
+
+type ContainerPort struct {
+	PrivatePort uint32 `json:"PrivatePort"`
+	Type        string `json:"Type"`
+}
+
+type ContainerNetwork struct {
+	IPAddress string `json:"IPAddress"`
+}
+
+type client struct {
+	httpClient *http.Client
+	baseUrl    string
+}
+
+// Returns a client of the Docker daemon at the given address, or of the daemon at DOCKER_HOST if empty
+func NewClient(address string) (Client, error) {
+	if address == "" {
+		address = os.Getenv(dockerHostEnv)
+	}
+	if address == "" {
+		address = DefaultAddress
+	}
+	parsed, err := url.Parse(address)
+	if err != nil {
+		return nil, eris.Wrapf(err, "parsing Docker daemon address %s", address)
+	}
+
+	switch parsed.Scheme {
+	case "unix":
+		socket := parsed.Path
+		dialer := &net.Dialer{}
+		return &client{
+			httpClient: &http.Client{Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					return dialer.DialContext(ctx, "unix", socket)
+				},
+			}},
+			// the host is ignored when dialing the socket
+			baseUrl: "http://docker",
+		}, nil
+	case "tcp", "http":
+		return &client{httpClient: &http.Client{}, baseUrl: "http://" + parsed.Host}, nil
+	case "https":
+		return &client{httpClient: &http.Client{}, baseUrl: "https://" + parsed.Host}, nil
+	}
+	return nil, UnsupportedAddressError(address)
+}
+
+func (c *client) ListContainers(ctx context.Context, label string) ([]Container, error) {
+	filters, err := json.Marshal(map[string][]string{"label": {label}})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.get(ctx, "/containers/json", url.Values{"filters": {string(filters)}})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var containers []Container
+	if err := json.NewDecoder(resp.Body).Decode(&containers); err != nil {
+		return nil, eris.Wrapf(err, "decoding containers")
+	}
+	return containers, nil
+}
+
+func (c *client) WatchContainerEvents(ctx context.Context) (<-chan struct{}, <-chan error, error) {
+	filters, err := json.Marshal(map[string][]string{"type": {"container"}, "event": {"start", "die"}})
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := c.get(ctx, "/events", url.Values{"filters": {string(filters)}})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	events := make(chan struct{})
+	errs := make(chan error, 1)
+	go func() {
+		defer close(events)
+		defer close(errs)
+		defer resp.Body.Close()
+		// the events are streamed as a sequence of JSON objects, only their arrival matters
+		decoder := json.NewDecoder(resp.Body)
+		for {
+			var event json.RawMessage
+			if err := decoder.Decode(&event); err != nil {
+				if ctx.Err() == nil {
+					errs <- eris.Wrapf(err, "reading Docker events")
+				}
+				return
+			}
+			select {
+			case events <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, errs, nil
+}
+
+func (c *client) get(ctx context.Context, path string, query url.Values) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s%s?%s", c.baseUrl, path, query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, eris.Wrapf(err, "requesting %s from the Docker daemon", path)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, UnexpectedStatusError(path, resp.Status)
+	}
+	return resp, nil
+}
+
+// the name of a container, without the leading slash of the Engine API
+func containerName(container Container) string {
+	for _, name := range container.Names {
+		// containers can have more names, from legacy links, which contain further slashes
+		name = strings.TrimPrefix(name, "/")
+		if !strings.Contains(name, "/") {
+			return name
+		}
+	}
+	return container.ID
+}
@@ -0,0 +1,48 @@
+package docker
+
+import (
+	"time"
+
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+)
+
+var _ discovery.DiscoveryPlugin = new(plugin)
+
+const (
+	// containers with this label set to `true` are discovered
+	DiscoveryLabel = "gloo.discovery"
+	// the name of the upstream of the container, defaults to the docker-compose service or the name of the container
+	NameLabel = "gloo.discovery.name"
+	// the port of the container to route to, required unless the container exposes a single TCP port
+	PortLabel = "gloo.discovery.port"
+	// the network to take the address of the container from
+	NetworkLabel = "gloo.discovery.network"
+
+	// the label docker-compose sets on the containers of a service
+	composeServiceLabel = "com.docker.compose.service"
+)
+
+// containers are listed again on this interval if the stream of events of the daemon is interrupted
+var DefaultRetryInterval = 5 * time.Second
+
+// Discovers static upstreams from the labels of the containers of a Docker daemon, e.g. of a docker-compose
+// installation. The containers with the same upstream name are load balanced in a single upstream.
+type plugin struct {
+	client Client
+	// the network the addresses of containers are taken from, unless they have a network label
+	network       string
+	retryInterval time.Duration
+}
+
+func NewPlugin(client Client, network string) plugins.Plugin {
+	return &plugin{
+		client:        client,
+		network:       network,
+		retryInterval: DefaultRetryInterval,
+	}
+}
+
+func (p *plugin) Init(params plugins.InitParams) error {
+	return nil
+}
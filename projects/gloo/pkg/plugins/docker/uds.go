@@ -0,0 +1,250 @@
+package docker
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils"
+	"github.com/solo-io/go-utils/contextutils"
+	sanitizer "github.com/solo-io/go-utils/kubeutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+)
+
+var (
+	InvalidSpecTypeError = func(us *v1.Upstream, name string) error {
+		return eris.Errorf("internal error: invalid %s spec, "+
+			"expected *v1.Upstream_Static, got  %T", name, us)
+	}
+
+	InvalidPortLabelError = func(value string) error {
+		return eris.Errorf("invalid %s label %s, expected a port number", PortLabel, value)
+	}
+
+	NoPortError = func(ports []uint32) error {
+		return eris.Errorf("the port to route to can't be determined from the exposed TCP ports %v, "+
+			"it must be set with the %s label", ports, PortLabel)
+	}
+
+	NetworkNotFoundError = func(network string) error {
+		return eris.Errorf("the container is not connected to the network %s", network)
+	}
+
+	NoAddressError = eris.New("the container has no IP address, containers using the host network are not supported")
+)
+
+func (p *plugin) DiscoverUpstreams(_ []string, writeNamespace string, opts clients.WatchOpts, _ discovery.Opts) (chan v1.UpstreamList, chan error, error) {
+	ctx := contextutils.WithLogger(opts.Ctx, "docker-uds")
+	logger := contextutils.LoggerFrom(ctx)
+
+	logger.Infow("started", "writens", writeNamespace)
+
+	upstreamsChan := make(chan v1.UpstreamList)
+	errs := make(chan error)
+	sendErr := func(err error) {
+		select {
+		case errs <- err:
+		case <-ctx.Done():
+		}
+	}
+	discoverUpstreams := func() {
+		containers, err := p.client.ListContainers(ctx, DiscoveryLabel+"=true")
+		if err != nil {
+			sendErr(err)
+			return
+		}
+		upstreams := p.convertContainers(ctx, containers, writeNamespace)
+		logger.Debugw("discovered containers", "num", len(upstreams))
+		select {
+		case upstreamsChan <- upstreams:
+		case <-ctx.Done():
+		}
+	}
+
+	go func() {
+		defer logger.Info("ended")
+		defer close(upstreamsChan)
+		defer close(errs)
+		for {
+			// the events are subscribed to before listing the containers, so no change is missed in between
+			events, eventErrs, err := p.client.WatchContainerEvents(ctx)
+			if err != nil {
+				sendErr(err)
+			}
+			discoverUpstreams()
+			for events != nil {
+				select {
+				case _, ok := <-events:
+					if !ok {
+						events = nil
+						continue
+					}
+					discoverUpstreams()
+				case <-ctx.Done():
+					return
+				}
+			}
+			if eventErrs != nil {
+				if err, ok := <-eventErrs; ok {
+					sendErr(err)
+				}
+			}
+
+			// the stream of events was interrupted, it is opened again after a while
+			select {
+			case <-time.After(p.retryInterval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return upstreamsChan, errs, nil
+}
+
+// converts the containers to static upstreams, with a host for each container with the same upstream name
+func (p *plugin) convertContainers(ctx context.Context, containers []Container, writeNamespace string) v1.UpstreamList {
+	logger := contextutils.LoggerFrom(ctx)
+
+	hostsByName := make(map[string][]*static.Host)
+	for _, container := range containers {
+		host, err := p.containerHost(container)
+		if err != nil {
+			logger.Warnw("not discovering container", "container", containerName(container), "error", err)
+			continue
+		}
+		name := upstreamName(container)
+		hostsByName[name] = append(hostsByName[name], host)
+	}
+
+	var upstreams v1.UpstreamList
+	for name, hosts := range hostsByName {
+		// sort hosts for idempotency
+		sort.Slice(hosts, func(i, j int) bool {
+			if hosts[i].Addr != hosts[j].Addr {
+				return hosts[i].Addr < hosts[j].Addr
+			}
+			return hosts[i].Port < hosts[j].Port
+		})
+		us := v1.NewUpstream(writeNamespace, name)
+		us.UpstreamType = &v1.Upstream_Static{
+			Static: &static.UpstreamSpec{
+				Hosts: hosts,
+			},
+		}
+		upstreams = append(upstreams, us)
+	}
+	return upstreams.Sort()
+}
+
+func upstreamName(container Container) string {
+	name := container.Labels[NameLabel]
+	if name == "" {
+		name = container.Labels[composeServiceLabel]
+	}
+	if name == "" {
+		name = containerName(container)
+	}
+	// underscores are common in the names of containers, but not valid in the names of resources
+	return sanitizer.SanitizeNameV2(strings.Replace(name, "_", "-", -1))
+}
+
+func (p *plugin) containerHost(container Container) (*static.Host, error) {
+	port, err := containerPort(container)
+	if err != nil {
+		return nil, err
+	}
+
+	network := container.Labels[NetworkLabel]
+	if network == "" {
+		network = p.network
+	}
+	var address string
+	if network != "" {
+		settings, ok := container.NetworkSettings.Networks[network]
+		if !ok {
+			return nil, NetworkNotFoundError(network)
+		}
+		address = settings.IPAddress
+	} else {
+		// default to the first network by name, for determinism
+		var networks []string
+		for name := range container.NetworkSettings.Networks {
+			networks = append(networks, name)
+		}
+		sort.Strings(networks)
+		for _, name := range networks {
+			if address = container.NetworkSettings.Networks[name].IPAddress; address != "" {
+				break
+			}
+		}
+	}
+	if address == "" {
+		return nil, NoAddressError
+	}
+
+	return &static.Host{
+		Addr: address,
+		Port: port,
+	}, nil
+}
+
+// the port of the port label, or the only TCP port exposed by the container
+func containerPort(container Container) (uint32, error) {
+	if value, ok := container.Labels[PortLabel]; ok {
+		port, err := strconv.ParseUint(value, 10, 16)
+		if err != nil || port == 0 {
+			return 0, InvalidPortLabelError(value)
+		}
+		return uint32(port), nil
+	}
+
+	var ports []uint32
+	seen := make(map[uint32]bool)
+	for _, port := range container.Ports {
+		// published ports are listed once for each of the addresses of the host they are bound to
+		if port.Type != "tcp" || seen[port.PrivatePort] {
+			continue
+		}
+		seen[port.PrivatePort] = true
+		ports = append(ports, port.PrivatePort)
+	}
+	if len(ports) != 1 {
+		return 0, NoPortError(ports)
+	}
+	return ports[0], nil
+}
+
+func (p *plugin) UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
+	return UpdateUpstream(original, desired)
+}
+
+func UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
+	originalSpec, ok := original.UpstreamType.(*v1.Upstream_Static)
+	if !ok {
+		return false, InvalidSpecTypeError(original, "original")
+	}
+	desiredSpec, ok := desired.UpstreamType.(*v1.Upstream_Static)
+	if !ok {
+		return false, InvalidSpecTypeError(desired, "desired")
+	}
+
+	// copy service spec, we don't want to overwrite that
+	desiredSpec.Static.ServiceSpec = originalSpec.Static.ServiceSpec
+	// same for the other fields which discovery can't know about
+	desiredSpec.Static.UseTls = originalSpec.Static.UseTls
+	desiredSpec.Static.DnsNameservers = originalSpec.Static.DnsNameservers
+
+	utils.UpdateUpstream(original, desired)
+
+	if originalSpec.Equal(desiredSpec) {
+		return false, nil
+	}
+
+	return true, nil
+}
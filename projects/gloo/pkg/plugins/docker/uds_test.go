@@ -0,0 +1,205 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Docker UDS", func() {
+
+	newContainer := func(name string, labels map[string]string, networks map[string]string, ports ...uint32) Container {
+		container := Container{
+			ID:     name + "-id",
+			Names:  []string{"/" + name},
+			Labels: labels,
+		}
+		container.NetworkSettings.Networks = map[string]ContainerNetwork{}
+		for network, ip := range networks {
+			container.NetworkSettings.Networks[network] = ContainerNetwork{IPAddress: ip}
+		}
+		for _, port := range ports {
+			container.Ports = append(container.Ports, ContainerPort{PrivatePort: port, Type: "tcp"})
+		}
+		return container
+	}
+
+	staticHosts := func(us *v1.Upstream) []*static.Host {
+		return us.GetStatic().GetHosts()
+	}
+
+	Context("converting containers", func() {
+		var p *plugin
+
+		BeforeEach(func() {
+			p = NewPlugin(nil, "").(*plugin)
+		})
+
+		It("should create an upstream for the containers of each docker-compose service", func() {
+			upstreams := p.convertContainers(context.TODO(), []Container{
+				newContainer("app_petstore_1", map[string]string{composeServiceLabel: "petstore"}, map[string]string{"app_default": "172.18.0.3"}, 8080),
+				newContainer("app_petstore_2", map[string]string{composeServiceLabel: "petstore"}, map[string]string{"app_default": "172.18.0.2"}, 8080),
+				newContainer("echo", nil, map[string]string{"bridge": "172.17.0.2"}, 5678),
+			}, "gloo-system")
+
+			Expect(upstreams).To(HaveLen(2))
+			Expect(upstreams[0].Metadata.Name).To(Equal("echo"))
+			Expect(upstreams[0].Metadata.Namespace).To(Equal("gloo-system"))
+			Expect(staticHosts(upstreams[0])).To(Equal([]*static.Host{{Addr: "172.17.0.2", Port: 5678}}))
+			Expect(upstreams[1].Metadata.Name).To(Equal("petstore"))
+			Expect(staticHosts(upstreams[1])).To(Equal([]*static.Host{
+				{Addr: "172.18.0.2", Port: 8080},
+				{Addr: "172.18.0.3", Port: 8080},
+			}))
+		})
+
+		It("should take the name, port and network of the upstream from the labels of the container", func() {
+			upstreams := p.convertContainers(context.TODO(), []Container{
+				newContainer("api", map[string]string{
+					NameLabel:    "Orders_API",
+					PortLabel:    "9090",
+					NetworkLabel: "backend",
+				}, map[string]string{"backend": "10.0.1.5", "frontend": "10.0.0.5"}, 8080, 9090),
+			}, "gloo-system")
+
+			Expect(upstreams).To(HaveLen(1))
+			Expect(upstreams[0].Metadata.Name).To(Equal("orders-api"))
+			Expect(staticHosts(upstreams[0])).To(Equal([]*static.Host{{Addr: "10.0.1.5", Port: 9090}}))
+		})
+
+		It("should take the address of containers from the network of the settings", func() {
+			p.network = "frontend"
+			upstreams := p.convertContainers(context.TODO(), []Container{
+				newContainer("api", nil, map[string]string{"backend": "10.0.1.5", "frontend": "10.0.0.5"}, 8080),
+				newContainer("worker", nil, map[string]string{"backend": "10.0.1.6"}, 8080),
+			}, "gloo-system")
+
+			Expect(upstreams).To(HaveLen(1))
+			Expect(staticHosts(upstreams[0])).To(Equal([]*static.Host{{Addr: "10.0.0.5", Port: 8080}}))
+		})
+
+		It("should skip the containers whose port or address can't be determined", func() {
+			upstreams := p.convertContainers(context.TODO(), []Container{
+				newContainer("no-ports", nil, map[string]string{"bridge": "172.17.0.2"}),
+				newContainer("many-ports", nil, map[string]string{"bridge": "172.17.0.3"}, 80, 443),
+				newContainer("invalid-port", map[string]string{PortLabel: "http"}, map[string]string{"bridge": "172.17.0.4"}, 80),
+				newContainer("host-network", nil, map[string]string{"host": ""}, 80),
+			}, "gloo-system")
+
+			Expect(upstreams).To(BeEmpty())
+		})
+	})
+
+	Context("updating upstreams", func() {
+		It("should keep the fields of the upstream discovery does not set", func() {
+			serviceSpec := &options.ServiceSpec{}
+			subsetSpec := &options.SubsetSpec{Selectors: []*options.Selector{{Keys: []string{"version"}}}}
+			original := v1.NewUpstream("gloo-system", "petstore")
+			original.UseHttp2 = &types.BoolValue{Value: true}
+			original.UpstreamType = &v1.Upstream_Static{Static: &static.UpstreamSpec{
+				Hosts:       []*static.Host{{Addr: "172.18.0.2", Port: 8080}},
+				ServiceSpec: serviceSpec,
+				SubsetSpec:  subsetSpec,
+				UseTls:      true,
+			}}
+			desired := v1.NewUpstream("gloo-system", "petstore")
+			desired.UpstreamType = &v1.Upstream_Static{Static: &static.UpstreamSpec{
+				Hosts: []*static.Host{{Addr: "172.18.0.2", Port: 8080}},
+			}}
+
+			changed, err := UpdateUpstream(original, desired)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeFalse())
+			Expect(desired.GetStatic().GetServiceSpec()).To(Equal(serviceSpec))
+			Expect(desired.GetStatic().GetSubsetSpec()).To(Equal(subsetSpec))
+			Expect(desired.GetUseHttp2().GetValue()).To(BeTrue())
+
+			desired.GetStatic().Hosts = []*static.Host{{Addr: "172.18.0.3", Port: 8080}}
+			changed, err = UpdateUpstream(original, desired)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeTrue())
+		})
+	})
+
+	Context("watching the docker daemon", func() {
+		var (
+			ctx       context.Context
+			cancel    context.CancelFunc
+			server    *httptest.Server
+			events    chan string
+			listed    chan string
+			container Container
+		)
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+			events = make(chan string)
+			listed = make(chan string, 10)
+			container = newContainer("petstore", map[string]string{DiscoveryLabel: "true"}, map[string]string{"bridge": "172.17.0.2"}, 8080)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/containers/json":
+					listed <- r.URL.Query().Get("filters")
+					Expect(json.NewEncoder(w).Encode([]Container{container})).To(Succeed())
+				case "/events":
+					w.WriteHeader(http.StatusOK)
+					w.(http.Flusher).Flush()
+					for {
+						select {
+						case event := <-events:
+							_, _ = w.Write([]byte(event))
+							w.(http.Flusher).Flush()
+						case <-r.Context().Done():
+							return
+						}
+					}
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+		})
+
+		AfterEach(func() {
+			cancel()
+			server.Close()
+		})
+
+		It("should discover upstreams again when containers are started or stopped", func() {
+			client, err := NewClient(strings.Replace(server.URL, "http://", "tcp://", 1))
+			Expect(err).NotTo(HaveOccurred())
+			p := NewPlugin(client, "").(*plugin)
+
+			upstreams, errs, err := p.DiscoverUpstreams(nil, "gloo-system", clients.WatchOpts{Ctx: ctx}, discovery.Opts{})
+			Expect(err).NotTo(HaveOccurred())
+			Consistently(errs).ShouldNot(Receive())
+
+			var list v1.UpstreamList
+			Eventually(upstreams).Should(Receive(&list))
+			Expect(list).To(HaveLen(1))
+			Expect(list[0].Metadata.Name).To(Equal("petstore"))
+			Expect(staticHosts(list[0])).To(Equal([]*static.Host{{Addr: "172.17.0.2", Port: 8080}}))
+			Expect(<-listed).To(Equal(`{"label":["gloo.discovery=true"]}`))
+
+			events <- `{"Type":"container","Action":"stop","Actor":{"ID":"petstore-id"}}`
+			Eventually(upstreams).Should(Receive(&list))
+			Expect(list).To(HaveLen(1))
+		})
+	})
+
+	It("should not accept unsupported addresses of the docker daemon", func() {
+		_, err := NewClient("ssh://docker-host")
+		Expect(err).To(HaveOccurred())
+	})
+})
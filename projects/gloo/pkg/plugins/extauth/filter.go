@@ -15,6 +15,7 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
 	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
@@ -57,6 +58,10 @@ func BuildHttpFilters(globalSettings *extauthv1.Settings, listener *v1.HttpListe
 	if err != nil {
 		return nil, err
 	}
+	// the JWT filter runs first, so auth configs (e.g. OPA policies) can use the claims of verified JWTs
+	if usesJwt(listener) {
+		extAuthCfg.MetadataContextNamespaces = append(extAuthCfg.MetadataContextNamespaces, jwt.MetadataNamespace)
+	}
 
 	stagedFilter, err := plugins.NewStagedFilterWithConfig(wellknown.HTTPExternalAuthorization, extAuthCfg, FilterStage)
 	if err != nil {
@@ -66,6 +71,15 @@ func BuildHttpFilters(globalSettings *extauthv1.Settings, listener *v1.HttpListe
 	return filters, nil
 }
 
+func usesJwt(listener *v1.HttpListener) bool {
+	for _, virtualHost := range listener.GetVirtualHosts() {
+		if len(virtualHost.GetOptions().GetJwt().GetProviders()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func generateEnvoyConfigForFilter(settings *extauthv1.Settings, extauthUpstreamRef core.ResourceRef) (*envoyauth.ExtAuthz, error) {
 	cfg := &envoyauth.ExtAuthz{}
 	httpService := settings.GetHttpService()
@@ -15,6 +15,7 @@ import (
 	"github.com/solo-io/gloo/pkg/utils/gogoutils"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/extauth"
@@ -181,6 +182,24 @@ var _ = Describe("Extauth Http filter builder function", func() {
 				actualFilterConfig := getExtAuthz(filters[0])
 				Expect(actualFilterConfig).To(Equal(expectedConfig))
 			})
+
+			It("forwards the claims of verified JWTs when virtual hosts define JWT providers", func() {
+				filters, err := BuildHttpFilters(settings, &gloov1.HttpListener{
+					VirtualHosts: []*gloov1.VirtualHost{{
+						Options: &gloov1.VirtualHostOptions{
+							Jwt: &jwt.VhostExtension{
+								Providers: map[string]*jwt.Provider{"provider": {}},
+							},
+						},
+					}},
+				}, gloov1.UpstreamList{upstream})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(filters).To(HaveLen(1))
+
+				expectedConfig.MetadataContextNamespaces = []string{"envoy.filters.http.jwt_authn"}
+				actualFilterConfig := getExtAuthz(filters[0])
+				Expect(actualFilterConfig).To(Equal(expectedConfig))
+			})
 		})
 
 		When("complete settings are provided", func() {
@@ -0,0 +1,13 @@
+package gcp
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestGcp(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Gcp Suite")
+}
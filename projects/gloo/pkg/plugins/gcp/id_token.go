@@ -0,0 +1,124 @@
+package gcp
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+const (
+	// the identity endpoint of the metadata server, which returns ID tokens of the default service account of the instance
+	// see https://cloud.google.com/run/docs/authenticating/service-to-service
+	metadataIdentityEndpoint = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/identity"
+
+	// tokens are requested again once they expire within this margin, so that envoy is not sent expiring tokens
+	tokenRefreshMargin  = 5 * time.Minute
+	tokenRequestTimeout = 10 * time.Second
+)
+
+// Provides the ID tokens of the service account of Gloo
+type tokenSource interface {
+	IdToken(ctx context.Context, audience string) (string, error)
+}
+
+type cachedToken struct {
+	idToken   string
+	expiresOn time.Time
+}
+
+type metadataServerTokens struct {
+	endpoint string
+	client   *http.Client
+	now      func() time.Time
+
+	lock   sync.Mutex
+	tokens map[string]cachedToken
+}
+
+func newMetadataServerTokens(endpoint string) *metadataServerTokens {
+	return &metadataServerTokens{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: tokenRequestTimeout},
+		now:      time.Now,
+		tokens:   make(map[string]cachedToken),
+	}
+}
+
+func (m *metadataServerTokens) IdToken(ctx context.Context, audience string) (string, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if token, ok := m.tokens[audience]; ok && m.now().Add(tokenRefreshMargin).Before(token.expiresOn) {
+		return token.idToken, nil
+	}
+
+	token, err := m.requestToken(ctx, audience)
+	if err != nil {
+		return "", errors.Wrapf(err, "requesting ID token for audience %v", audience)
+	}
+	m.tokens[audience] = token
+	// envoy is configured with the token, so it has to be replaced before it expires
+	plugins.RequestResyncAfter(token.expiresOn.Sub(m.now()) - tokenRefreshMargin)
+	return token.idToken, nil
+}
+
+func (m *metadataServerTokens) requestToken(ctx context.Context, audience string) (cachedToken, error) {
+	query := url.Values{}
+	query.Set("audience", audience)
+	query.Set("format", "full")
+	req, err := http.NewRequest(http.MethodGet, m.endpoint+"?"+query.Encode(), nil)
+	if err != nil {
+		return cachedToken{}, err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+
+	resp, err := m.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return cachedToken{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return cachedToken{}, errors.Errorf("metadata server responded with status %v", resp.StatusCode)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return cachedToken{}, err
+	}
+
+	idToken := strings.TrimSpace(string(body))
+	expiresOn, err := tokenExpiry(idToken)
+	if err != nil {
+		return cachedToken{}, err
+	}
+	return cachedToken{idToken: idToken, expiresOn: expiresOn}, nil
+}
+
+// reads the expiry from the claims of the token, which is a JWT
+func tokenExpiry(idToken string) (time.Time, error) {
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return time.Time{}, errors.Errorf("ID token is not a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "decoding ID token claims")
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, errors.Wrapf(err, "decoding ID token claims")
+	}
+	if claims.Exp == 0 {
+		return time.Time{}, errors.Errorf("ID token has no expiry")
+	}
+	return time.Unix(claims.Exp, 0), nil
+}
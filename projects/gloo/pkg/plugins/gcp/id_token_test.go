@@ -0,0 +1,74 @@
+package gcp
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func fakeIdToken(audience string, expiresOn time.Time) string {
+	claims := fmt.Sprintf(`{"aud": %q, "exp": %d}`, audience, expiresOn.Unix())
+	return "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + ".signature"
+}
+
+var _ = Describe("ID tokens", func() {
+
+	var (
+		server   *httptest.Server
+		tokens   *metadataServerTokens
+		now      time.Time
+		requests []*http.Request
+	)
+
+	BeforeEach(func() {
+		requests = nil
+		now = time.Now()
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r)
+			w.Write([]byte(fakeIdToken(r.URL.Query().Get("audience"), now.Add(time.Hour))))
+		}))
+		tokens = newMetadataServerTokens(server.URL)
+		tokens.now = func() time.Time { return now }
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("requests tokens for the audience from the metadata server", func() {
+		token, err := tokens.IdToken(context.TODO(), "https://my-service-abc-uc.a.run.app")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token).To(Equal(fakeIdToken("https://my-service-abc-uc.a.run.app", now.Add(time.Hour))))
+
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Header.Get("Metadata-Flavor")).To(Equal("Google"))
+		Expect(requests[0].URL.Query().Get("audience")).To(Equal("https://my-service-abc-uc.a.run.app"))
+	})
+
+	It("caches tokens until shortly before they expire", func() {
+		for i := 0; i < 2; i++ {
+			_, err := tokens.IdToken(context.TODO(), "https://my-service-abc-uc.a.run.app")
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(requests).To(HaveLen(1))
+
+		now = now.Add(time.Hour - tokenRefreshMargin)
+		_, err := tokens.IdToken(context.TODO(), "https://my-service-abc-uc.a.run.app")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requests).To(HaveLen(2))
+	})
+
+	It("errors on tokens which are not JWTs", func() {
+		server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("not-a-jwt"))
+		})
+		_, err := tokens.IdToken(context.TODO(), "https://my-service-abc-uc.a.run.app")
+		Expect(err).To(MatchError(ContainSubstring("ID token is not a JWT")))
+	})
+})
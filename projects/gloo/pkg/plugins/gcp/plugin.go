@@ -0,0 +1,137 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/gogo/protobuf/proto"
+	transformationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/gcp"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+// plugins are created for every translation, so the tokens are cached across them
+var metadataServerTokenCache = newMetadataServerTokens(metadataIdentityEndpoint)
+
+type plugin struct {
+	recordedUpstreams map[core.ResourceRef]*gcp.UpstreamSpec
+	ctx               context.Context
+	transformsAdded   *bool
+	tokens            tokenSource
+}
+
+func NewPlugin(transformsAdded *bool) plugins.Plugin {
+	return &plugin{
+		transformsAdded: transformsAdded,
+		tokens:          metadataServerTokenCache,
+	}
+}
+
+func (p *plugin) Init(params plugins.InitParams) error {
+	p.ctx = params.Ctx
+	p.recordedUpstreams = make(map[core.ResourceRef]*gcp.UpstreamSpec)
+	return nil
+}
+
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
+	upstreamSpec, ok := in.UpstreamType.(*v1.Upstream_Gcp)
+	if !ok {
+		// not ours
+		return nil
+	}
+	gcpUpstream := upstreamSpec.Gcp
+	if gcpUpstream.GetHost() == "" {
+		return errors.Errorf("no host provided for GCP upstream %v", in.Metadata.Ref())
+	}
+	p.recordedUpstreams[in.Metadata.Ref()] = gcpUpstream
+
+	// configure Envoy cluster routing info
+	out.ClusterDiscoveryType = &envoyapi.Cluster_Type{
+		Type: envoyapi.Cluster_LOGICAL_DNS,
+	}
+	out.DnsLookupFamily = envoyapi.Cluster_V4_ONLY
+	pluginutils.EnvoySingleEndpointLoadAssignment(out, gcpUpstream.GetHost(), 443)
+
+	tlsContext := &envoyauth.UpstreamTlsContext{
+		Sni: gcpUpstream.GetHost(),
+	}
+	out.TransportSocket = &envoycore.TransportSocket{
+		Name:       wellknown.TransportSocketTls,
+		ConfigType: &envoycore.TransportSocket_TypedConfig{TypedConfig: utils.MustMessageToAny(tlsContext)},
+	}
+	return nil
+}
+
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoyroute.Route) error {
+	return pluginutils.MarkPerFilterConfig(p.ctx, params.Snapshot, in, out, transformation.FilterName, func(spec *v1.Destination) (proto.Message, error) {
+		// routes to Cloud Run services have no destination spec, so all the destinations are checked for gcp upstreams
+		_, isGcpDestination := spec.GetDestinationSpec().GetDestinationType().(*v1.DestinationSpec_Gcp)
+		upstreamRef, err := upstreams.DestinationToUpstreamRef(spec)
+		if err != nil {
+			if !isGcpDestination {
+				return nil, nil
+			}
+			contextutils.LoggerFrom(p.ctx).Error(err)
+			return nil, err
+		}
+		upstreamSpec, ok := p.recordedUpstreams[*upstreamRef]
+		if !ok {
+			if isGcpDestination {
+				return nil, errors.Errorf("%v is not a GCP upstream", *upstreamRef)
+			}
+			return nil, nil
+		}
+
+		headers := map[string]*transformationapi.InjaTemplate{
+			// Cloud Run routes requests to services by their host
+			":authority": {
+				Text: upstreamSpec.GetHost(),
+			},
+		}
+		audience := fmt.Sprintf("https://%s", upstreamSpec.GetHost())
+		if functionName := spec.GetDestinationSpec().GetGcp().GetFunctionName(); functionName != "" {
+			headers[":path"] = &transformationapi.InjaTemplate{
+				Text: "/" + functionName,
+			}
+			audience = fmt.Sprintf("https://%s/%s", upstreamSpec.GetHost(), functionName)
+		}
+		if upstreamSpec.GetAudience() != "" {
+			audience = upstreamSpec.GetAudience()
+		}
+
+		idToken, err := p.tokens.IdToken(p.ctx, audience)
+		if err != nil {
+			return nil, err
+		}
+		headers["authorization"] = &transformationapi.InjaTemplate{
+			Text: "Bearer " + idToken,
+		}
+
+		*p.transformsAdded = true
+		return &transformationapi.RouteTransformations{
+			RequestTransformation: &transformationapi.Transformation{
+				TransformationType: &transformationapi.Transformation_TransformationTemplate{
+					TransformationTemplate: &transformationapi.TransformationTemplate{
+						Headers: headers,
+						BodyTransformation: &transformationapi.TransformationTemplate_Passthrough{
+							Passthrough: &transformationapi.Passthrough{},
+						},
+					},
+				},
+			},
+		}, nil
+	})
+}
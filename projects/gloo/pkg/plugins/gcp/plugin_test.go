@@ -0,0 +1,135 @@
+package gcp
+
+import (
+	"context"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/gogo/protobuf/proto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	transformationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/gcp"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// returns the audience as the token, so that tests can check which audience was requested
+type fakeTokenSource struct{}
+
+func (fakeTokenSource) IdToken(_ context.Context, audience string) (string, error) {
+	return audience, nil
+}
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		p               *plugin
+		transformsAdded bool
+		params          plugins.Params
+		upstream        *v1.Upstream
+		route           *v1.Route
+		outroute        *envoyroute.Route
+	)
+
+	BeforeEach(func() {
+		transformsAdded = false
+		p = NewPlugin(&transformsAdded).(*plugin)
+		Expect(p.Init(plugins.InitParams{Ctx: context.TODO()})).To(Succeed())
+		p.tokens = fakeTokenSource{}
+		params = plugins.Params{Ctx: context.TODO(), Snapshot: &v1.ApiSnapshot{}}
+
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{Name: "my-service", Namespace: "default"},
+			UpstreamType: &v1.Upstream_Gcp{
+				Gcp: &gcp.UpstreamSpec{Host: "my-service-abc-uc.a.run.app"},
+			},
+		}
+		route = &v1.Route{
+			Action: &v1.Route_RouteAction{
+				RouteAction: &v1.RouteAction{
+					Destination: &v1.RouteAction_Single{
+						Single: &v1.Destination{
+							DestinationType: &v1.Destination_Upstream{
+								Upstream: &core.ResourceRef{Name: "my-service", Namespace: "default"},
+							},
+						},
+					},
+				},
+			},
+		}
+		outroute = &envoyroute.Route{
+			Action: &envoyroute.Route_Route{
+				Route: &envoyroute.RouteAction{
+					ClusterSpecifier: &envoyroute.RouteAction_Cluster{Cluster: "my-service_default"},
+				},
+			},
+		}
+	})
+
+	requestHeaders := func() map[string]*transformationapi.InjaTemplate {
+		err := p.ProcessRoute(plugins.RouteParams{VirtualHostParams: plugins.VirtualHostParams{Params: params}}, route, outroute)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outroute.TypedPerFilterConfig).To(HaveKey(transformation.FilterName))
+
+		var transformations transformationapi.RouteTransformations
+		Expect(proto.Unmarshal(outroute.TypedPerFilterConfig[transformation.FilterName].Value, &transformations)).To(Succeed())
+		return transformations.GetRequestTransformation().GetTransformationTemplate().GetHeaders()
+	}
+
+	It("routes to the host of the upstream over TLS", func() {
+		out := &envoyapi.Cluster{}
+		Expect(p.ProcessUpstream(params, upstream, out)).To(Succeed())
+		Expect(out.GetType()).To(Equal(envoyapi.Cluster_LOGICAL_DNS))
+		Expect(out.LoadAssignment.Endpoints).To(HaveLen(1))
+		tlsContext := utils.MustAnyToMessage(out.TransportSocket.GetTypedConfig()).(*envoyauth.UpstreamTlsContext)
+		Expect(tlsContext.Sni).To(Equal("my-service-abc-uc.a.run.app"))
+	})
+
+	It("errors on upstreams without a host", func() {
+		upstream.GetGcp().Host = ""
+		Expect(p.ProcessUpstream(params, upstream, &envoyapi.Cluster{})).To(HaveOccurred())
+	})
+
+	It("authenticates requests to cloud run services with an ID token for their URL", func() {
+		Expect(p.ProcessUpstream(params, upstream, &envoyapi.Cluster{})).To(Succeed())
+
+		headers := requestHeaders()
+		Expect(headers["authorization"].GetText()).To(Equal("Bearer https://my-service-abc-uc.a.run.app"))
+		Expect(headers[":authority"].GetText()).To(Equal("my-service-abc-uc.a.run.app"))
+		Expect(headers).NotTo(HaveKey(":path"))
+		Expect(transformsAdded).To(BeTrue())
+	})
+
+	It("sends requests to cloud functions to the path of the function", func() {
+		upstream.GetGcp().Host = "us-central1-my-project.cloudfunctions.net"
+		Expect(p.ProcessUpstream(params, upstream, &envoyapi.Cluster{})).To(Succeed())
+		route.GetRouteAction().GetSingle().DestinationSpec = &v1.DestinationSpec{
+			DestinationType: &v1.DestinationSpec_Gcp{
+				Gcp: &gcp.DestinationSpec{FunctionName: "my-function"},
+			},
+		}
+
+		headers := requestHeaders()
+		Expect(headers[":path"].GetText()).To(Equal("/my-function"))
+		Expect(headers["authorization"].GetText()).To(Equal("Bearer https://us-central1-my-project.cloudfunctions.net/my-function"))
+	})
+
+	It("uses the audience of the upstream", func() {
+		upstream.GetGcp().Audience = "my-audience"
+		Expect(p.ProcessUpstream(params, upstream, &envoyapi.Cluster{})).To(Succeed())
+
+		Expect(requestHeaders()["authorization"].GetText()).To(Equal("Bearer my-audience"))
+	})
+
+	It("does not process routes to other upstreams", func() {
+		err := p.ProcessRoute(plugins.RouteParams{VirtualHostParams: plugins.VirtualHostParams{Params: params}}, route, outroute)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outroute.TypedPerFilterConfig).NotTo(HaveKey(transformation.FilterName))
+		Expect(transformsAdded).To(BeFalse())
+	})
+})
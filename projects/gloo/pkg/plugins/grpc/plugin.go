@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha1"
 	"fmt"
+	"strings"
 
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 
@@ -23,6 +24,7 @@ import (
 	"encoding/base64"
 
 	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/ptypes/duration"
 	errors "github.com/rotisserie/eris"
 	envoy_transform "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -44,15 +46,20 @@ type ServicesAndDescriptor struct {
 
 func NewPlugin(transformsAdded *bool) *plugin {
 	return &plugin{
-		recordedUpstreams: make(map[core.ResourceRef]*v1.Upstream),
-		transformsAdded:   transformsAdded,
+		recordedUpstreams:   make(map[core.ResourceRef]*v1.Upstream),
+		upstreamDescriptors: make(map[core.ResourceRef]*descriptor.FileDescriptorSet),
+		methodBodyFields:    make(map[string]string),
+		transformsAdded:     transformsAdded,
 	}
 }
 
 type plugin struct {
-	transformsAdded   *bool
-	recordedUpstreams map[core.ResourceRef]*v1.Upstream
-	upstreamServices  []ServicesAndDescriptor
+	transformsAdded     *bool
+	recordedUpstreams   map[core.ResourceRef]*v1.Upstream
+	upstreamDescriptors map[core.ResourceRef]*descriptor.FileDescriptorSet
+	upstreamServices    []ServicesAndDescriptor
+	// the body field of the http rule of each method, by http path, as set by the routes to it
+	methodBodyFields map[string]string
 
 	ctx context.Context
 }
@@ -105,6 +112,7 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	addWellKnownProtos(descriptors)
 
 	p.recordedUpstreams[in.Metadata.Ref()] = in
+	p.upstreamDescriptors[in.Metadata.Ref()] = descriptors
 	p.upstreamServices = append(p.upstreamServices, ServicesAndDescriptor{
 		Descriptors: descriptors,
 		Spec:        grpcSpec,
@@ -150,7 +158,11 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 		// copy as it might be modified
 		grpcDestinationSpec := *grpcDestinationSpecWrapper.Grpc
 
-		if grpcDestinationSpec.Parameters == nil {
+		if grpcDestinationSpec.BodyField != "" && grpcDestinationSpec.Parameters != nil {
+			return nil, errors.New("parameters cannot be used along with a body field for grpc route")
+		}
+
+		if grpcDestinationSpec.Parameters == nil && grpcDestinationSpec.BodyField == "" {
 			if out.Match.PathSpecifier == nil {
 				return nil, errors.New("missing path for grpc route")
 			}
@@ -179,6 +191,17 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 		// create the transformation for the route
 		outPath := httpPath(upstream, fullServiceName, methodName)
 
+		method := findMethod(p.upstreamDescriptors[*upstreamRef], grpcDestinationSpec.Package, grpcDestinationSpec.Service, methodName)
+		if err := p.setMethodBodyField(p.upstreamDescriptors[*upstreamRef], method, outPath, grpcDestinationSpec.BodyField); err != nil {
+			return nil, err
+		}
+
+		// server-streaming methods answer with a long-lived response, which the default route timeout would cut short.
+		// a timeout set in the route options still applies, as these are processed after this plugin.
+		if method.GetServerStreaming() && out.GetRoute() != nil && out.GetRoute().GetTimeout() == nil {
+			out.GetRoute().Timeout = &duration.Duration{}
+		}
+
 		// add query matcher to out path. kombina for now
 		// TODO: support query for matching
 		outPath += `?{{ default(query_string, "")}}`
@@ -194,6 +217,26 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 
 		// we always choose post
 		httpMethod := "POST"
+
+		if grpcDestinationSpec.BodyField != "" {
+			// the transcoder reads the raw body, so it must not be buffered and parsed as json by the transformation
+			return &envoy_transform.RouteTransformations{
+				RequestTransformation: &envoy_transform.Transformation{
+					TransformationType: &envoy_transform.Transformation_TransformationTemplate{
+						TransformationTemplate: &envoy_transform.TransformationTemplate{
+							Headers: map[string]*envoy_transform.InjaTemplate{
+								":method": {Text: httpMethod},
+								":path":   {Text: outPath},
+							},
+							BodyTransformation: &envoy_transform.TransformationTemplate_Passthrough{
+								Passthrough: &envoy_transform.Passthrough{},
+							},
+						},
+					},
+				},
+			}, nil
+		}
+
 		return &envoy_transform.RouteTransformations{
 			RequestTransformation: &envoy_transform.Transformation{
 				TransformationType: &envoy_transform.Transformation_TransformationTemplate{
@@ -225,22 +268,128 @@ func addHttpRulesToProto(upstream *v1.Upstream, currentsvc *grpcapi.ServiceSpec_
 			}
 			for _, method := range svc.Method {
 				fullServiceName := genFullServiceName(currentsvc.PackageName, currentsvc.ServiceName)
-				if method.Options == nil {
-					method.Options = &descriptor.MethodOptions{}
+				if err := setHttpRule(method, httpPath(upstream, fullServiceName, *method.Name), "*"); err != nil {
+					return err
 				}
-				if err := proto.SetExtension(method.Options, api.E_Http, &api.HttpRule{
-					Pattern: &api.HttpRule_Post{
-						Post: httpPath(upstream, fullServiceName, *method.Name),
-					},
-					Body: "*",
-				}); err != nil {
-					return errors.Wrap(err, "setting http extensions for method.Options")
+			}
+		}
+	}
+
+	return nil
+}
+
+func setHttpRule(method *descriptor.MethodDescriptorProto, path, body string) error {
+	if method.Options == nil {
+		method.Options = &descriptor.MethodOptions{}
+	}
+	if err := proto.SetExtension(method.Options, api.E_Http, &api.HttpRule{
+		Pattern: &api.HttpRule_Post{
+			Post: path,
+		},
+		Body: body,
+	}); err != nil {
+		return errors.Wrap(err, "setting http extensions for method.Options")
+	}
+	log.Debugf("method.options: %v", *method.Options)
+	return nil
+}
+
+// sets the body of the http rule of the method, which tells the transcoder which field of the request message the
+// request body maps to. all the routes to a method must agree on it.
+func (p *plugin) setMethodBodyField(set *descriptor.FileDescriptorSet, method *descriptor.MethodDescriptorProto, path, bodyField string) error {
+	body := bodyField
+	if body == "" {
+		body = "*"
+	}
+	if existing, ok := p.methodBodyFields[path]; ok && existing != body {
+		return errors.Errorf("grpc routes to the same function use different body fields: %q and %q", existing, body)
+	}
+	p.methodBodyFields[path] = body
+
+	if bodyField == "" {
+		return nil
+	}
+	if method == nil {
+		return errors.New("function of grpc route with a body field was not found in the proto descriptors")
+	}
+	if err := validateBodyField(set, method, bodyField); err != nil {
+		return err
+	}
+	return setHttpRule(method, path, bodyField)
+}
+
+func validateBodyField(set *descriptor.FileDescriptorSet, method *descriptor.MethodDescriptorProto, bodyField string) error {
+	if bodyField == "*" {
+		if method.GetInputType() != httpBodyType {
+			return errors.Errorf("request message %s of function %s is not a %s", method.GetInputType(), method.GetName(), httpBodyType)
+		}
+		return nil
+	}
+	input := findMessage(set, method.GetInputType())
+	if input == nil {
+		return errors.Errorf("request message %s of function %s was not found in the proto descriptors", method.GetInputType(), method.GetName())
+	}
+	for _, field := range input.GetField() {
+		if field.GetName() != bodyField {
+			continue
+		}
+		if field.GetTypeName() != httpBodyType || field.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+			return errors.Errorf("body field %s of request message %s is not a %s", bodyField, method.GetInputType(), httpBodyType)
+		}
+		return nil
+	}
+	return errors.Errorf("request message %s has no field %s", method.GetInputType(), bodyField)
+}
+
+const httpBodyType = ".google.api.HttpBody"
+
+func findMethod(set *descriptor.FileDescriptorSet, packageName, serviceName, methodName string) *descriptor.MethodDescriptorProto {
+	for _, file := range set.GetFile() {
+		if file.GetPackage() != packageName {
+			continue
+		}
+		for _, svc := range file.GetService() {
+			if svc.GetName() != serviceName {
+				continue
+			}
+			for _, method := range svc.GetMethod() {
+				if method.GetName() == methodName {
+					return method
 				}
-				log.Debugf("method.options: %v", *method.Options)
 			}
 		}
 	}
+	return nil
+}
+
+// finds a message by its fully qualified name, e.g. `.foo.Bar.Baz`
+func findMessage(set *descriptor.FileDescriptorSet, fullName string) *descriptor.DescriptorProto {
+	for _, file := range set.GetFile() {
+		prefix := "."
+		if file.GetPackage() != "" {
+			prefix += file.GetPackage() + "."
+		}
+		if !strings.HasPrefix(fullName, prefix) {
+			continue
+		}
+		if msg := findNestedMessage(file.GetMessageType(), strings.TrimPrefix(fullName, prefix)); msg != nil {
+			return msg
+		}
+	}
+	return nil
+}
 
+func findNestedMessage(messages []*descriptor.DescriptorProto, name string) *descriptor.DescriptorProto {
+	for _, msg := range messages {
+		if msg.GetName() == name {
+			return msg
+		}
+		if strings.HasPrefix(name, msg.GetName()+".") {
+			if nested := findNestedMessage(msg.GetNestedType(), strings.TrimPrefix(name, msg.GetName()+".")); nested != nil {
+				return nested
+			}
+		}
+	}
 	return nil
 }
 
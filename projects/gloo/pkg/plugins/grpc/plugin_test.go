@@ -1,6 +1,7 @@
 package grpc
 
 import (
+	"encoding/base64"
 	"regexp"
 
 	. "github.com/onsi/ginkgo"
@@ -19,7 +20,11 @@ import (
 
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/googleapis/google/api"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
 	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/ptypes/duration"
 )
 
 var _ = Describe("Plugin", func() {
@@ -200,5 +205,127 @@ var _ = Describe("Plugin", func() {
 			Expect(subMatches[extrs["ever"].Subgroup]).To(Equal("second%34value"))
 			Expect(subMatches[extrs["nested.field"].Subgroup]).To(Equal("third-value"))
 		})
+
+		Context("with proto descriptors", func() {
+
+			getRequestTransformation := func() *envoy_transform.TransformationTemplate {
+				var cfg envoy_transform.RouteTransformations
+				goTypedConfig := routeOut.GetTypedPerFilterConfig()[transformation.FilterName]
+				gogoTypedConfig := &types.Any{TypeUrl: goTypedConfig.TypeUrl, Value: goTypedConfig.Value}
+				err := types.UnmarshalAny(gogoTypedConfig, &cfg)
+				Expect(err).NotTo(HaveOccurred())
+				return cfg.GetRequestTransformation().GetTransformationTemplate()
+			}
+
+			getHttpRule := func(methodName string) *api.HttpRule {
+				method := findMethod(p.upstreamDescriptors[upstream.Metadata.Ref()], "foo", "bar", methodName)
+				Expect(method).NotTo(BeNil())
+				ext, err := proto.GetExtension(method.GetOptions(), api.E_Http)
+				Expect(err).NotTo(HaveOccurred())
+				return ext.(*api.HttpRule)
+			}
+
+			BeforeEach(func() {
+				httpBody := &descriptor.FieldDescriptorProto{
+					Name:     proto.String("file"),
+					Number:   proto.Int32(1),
+					Label:    descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descriptor.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+					TypeName: proto.String(".google.api.HttpBody"),
+				}
+				name := &descriptor.FieldDescriptorProto{
+					Name:   proto.String("name"),
+					Number: proto.Int32(2),
+					Label:  descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:   descriptor.FieldDescriptorProto_TYPE_STRING.Enum(),
+				}
+				descriptors, err := proto.Marshal(&descriptor.FileDescriptorSet{
+					File: []*descriptor.FileDescriptorProto{{
+						Name:    proto.String("foo.proto"),
+						Package: proto.String("foo"),
+						MessageType: []*descriptor.DescriptorProto{
+							{Name: proto.String("Request"), Field: []*descriptor.FieldDescriptorProto{name}},
+							{Name: proto.String("Upload"), Field: []*descriptor.FieldDescriptorProto{httpBody, name}},
+						},
+						Service: []*descriptor.ServiceDescriptorProto{{
+							Name: proto.String("bar"),
+							Method: []*descriptor.MethodDescriptorProto{
+								{Name: proto.String("func"), InputType: proto.String(".foo.Request"), OutputType: proto.String(".foo.Request")},
+								{Name: proto.String("upload"), InputType: proto.String(".foo.Upload"), OutputType: proto.String(".foo.Request")},
+								{Name: proto.String("watch"), InputType: proto.String(".foo.Request"), OutputType: proto.String(".foo.Request"), ServerStreaming: proto.Bool(true)},
+							},
+						}},
+					}},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				grpcSpec.Grpc.Descriptors = []byte(base64.StdEncoding.EncodeToString(descriptors))
+
+				err = p.ProcessUpstream(params, upstream, out)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should keep the default timeout of unary functions", func() {
+				err := p.ProcessRoute(plugins.RouteParams{}, routeIn, routeOut)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(routeOut.GetRoute().GetTimeout()).To(BeNil())
+			})
+
+			It("should disable the timeout of server-streaming functions", func() {
+				routeIn.GetRouteAction().GetSingle().GetDestinationSpec().GetGrpc().Function = "watch"
+
+				err := p.ProcessRoute(plugins.RouteParams{}, routeIn, routeOut)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(routeOut.GetRoute().GetTimeout()).To(Equal(&duration.Duration{}))
+			})
+
+			It("should pass the body through to a body field", func() {
+				grpcDest := routeIn.GetRouteAction().GetSingle().GetDestinationSpec().GetGrpc()
+				grpcDest.Function = "upload"
+				grpcDest.Parameters = nil
+				grpcDest.BodyField = "file"
+
+				err := p.ProcessRoute(plugins.RouteParams{}, routeIn, routeOut)
+				Expect(err).NotTo(HaveOccurred())
+
+				tt := getRequestTransformation()
+				Expect(tt.GetPassthrough()).NotTo(BeNil())
+				Expect(tt.GetExtractors()).To(BeEmpty())
+				Expect(getHttpRule("upload").GetBody()).To(Equal("file"))
+				Expect(getHttpRule("func").GetBody()).To(Equal("*"))
+			})
+
+			It("should error if the body field is not an HttpBody", func() {
+				grpcDest := routeIn.GetRouteAction().GetSingle().GetDestinationSpec().GetGrpc()
+				grpcDest.Function = "upload"
+				grpcDest.Parameters = nil
+				grpcDest.BodyField = "name"
+
+				err := p.ProcessRoute(plugins.RouteParams{}, routeIn, routeOut)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("body field name of request message .foo.Upload is not a .google.api.HttpBody"))
+			})
+
+			It("should error if the body field is used with parameters", func() {
+				routeIn.GetRouteAction().GetSingle().GetDestinationSpec().GetGrpc().BodyField = "file"
+
+				err := p.ProcessRoute(plugins.RouteParams{}, routeIn, routeOut)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("parameters cannot be used along with a body field"))
+			})
+
+			It("should error if routes to a function use different body fields", func() {
+				grpcDest := routeIn.GetRouteAction().GetSingle().GetDestinationSpec().GetGrpc()
+				grpcDest.Function = "upload"
+
+				err := p.ProcessRoute(plugins.RouteParams{}, routeIn, routeOut)
+				Expect(err).NotTo(HaveOccurred())
+
+				grpcDest.Parameters = nil
+				grpcDest.BodyField = "file"
+				err = p.ProcessRoute(plugins.RouteParams{}, routeIn, routeOut)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("grpc routes to the same function use different body fields"))
+			})
+		})
 	})
 })
@@ -1,12 +1,15 @@
 package grpcjson
 
 import (
+	"encoding/base64"
+
 	envoy_extensions_filters_http_grpc_json_transcoder_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_json_transcoder/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/rotisserie/eris"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 // filter info
@@ -32,7 +35,7 @@ func (p *Plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) (
 		return nil, nil
 	}
 
-	envoyGrpcJsonConf, err := translateGlooToEnvoyGrpcJson(grpcJsonConf)
+	envoyGrpcJsonConf, err := translateGlooToEnvoyGrpcJson(params.Snapshot, grpcJsonConf)
 	if err != nil {
 		return nil, err
 	}
@@ -45,7 +48,7 @@ func (p *Plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) (
 	return []plugins.StagedHttpFilter{grpcJsonFilter}, nil
 }
 
-func translateGlooToEnvoyGrpcJson(grpcJsonConf *grpc_json.GrpcJsonTranscoder) (*envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder, error) {
+func translateGlooToEnvoyGrpcJson(snap *v1.ApiSnapshot, grpcJsonConf *grpc_json.GrpcJsonTranscoder) (*envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder, error) {
 
 	envoyGrpcJsonConf := &envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder{
 		DescriptorSet:                nil, // to be filled in later
@@ -63,11 +66,43 @@ func translateGlooToEnvoyGrpcJson(grpcJsonConf *grpc_json.GrpcJsonTranscoder) (*
 		envoyGrpcJsonConf.DescriptorSet = &envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder_ProtoDescriptor{ProtoDescriptor: typedDescriptorSet.ProtoDescriptor}
 	case *grpc_json.GrpcJsonTranscoder_ProtoDescriptorBin:
 		envoyGrpcJsonConf.DescriptorSet = &envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder_ProtoDescriptorBin{ProtoDescriptorBin: typedDescriptorSet.ProtoDescriptorBin}
+	case *grpc_json.GrpcJsonTranscoder_UpstreamRef:
+		descriptorBin, err := upstreamProtoDescriptors(snap, typedDescriptorSet.UpstreamRef)
+		if err != nil {
+			return nil, err
+		}
+		envoyGrpcJsonConf.DescriptorSet = &envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder_ProtoDescriptorBin{ProtoDescriptorBin: descriptorBin}
 	}
 
 	return envoyGrpcJsonConf, nil
 }
 
+// the descriptors in the grpc service spec of an upstream are stored base64 encoded, both when
+// discovered by FDS and when provided by the user.
+func upstreamProtoDescriptors(snap *v1.ApiSnapshot, ref *core.ResourceRef) ([]byte, error) {
+	var upstreams v1.UpstreamList
+	if snap != nil {
+		upstreams = snap.Upstreams
+	}
+	upstream, err := upstreams.Find(ref.Strings())
+	if err != nil {
+		return nil, eris.Wrapf(err, "finding upstream for proto descriptors")
+	}
+	serviceSpecGetter, ok := upstream.GetUpstreamType().(v1.ServiceSpecGetter)
+	if !ok {
+		return nil, eris.Errorf("upstream %v does not support a service spec", ref.Key())
+	}
+	descriptors := serviceSpecGetter.GetServiceSpec().GetGrpc().GetDescriptors()
+	if len(descriptors) == 0 {
+		return nil, eris.Errorf("upstream %v has no grpc proto descriptors", ref.Key())
+	}
+	descriptorBin, err := base64.StdEncoding.DecodeString(string(descriptors))
+	if err != nil {
+		return nil, eris.Wrapf(err, "decoding proto descriptors of upstream %v", ref.Key())
+	}
+	return descriptorBin, nil
+}
+
 func translateGlooToEnvoyPrintOptions(options *grpc_json.GrpcJsonTranscoder_PrintOptions) *envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder_PrintOptions {
 	if options == nil {
 		return nil
@@ -1,17 +1,23 @@
 package grpcjson_test
 
 import (
+	"encoding/base64"
+
 	envoy_extensions_filters_http_grpc_json_transcoder_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_json_transcoder/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpcjson"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/gloo/test/matchers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 var _ = Describe("GrpcJson", func() {
@@ -62,4 +68,63 @@ var _ = Describe("GrpcJson", func() {
 		Expect(f).To(matchers.BeEquivalentToDiff(expectedFilter))
 	})
 
+	It("should use the proto descriptors of an upstream", func() {
+		descriptorBin := []byte("descriptor-set")
+		us := &v1.Upstream{
+			Metadata: core.Metadata{Name: "bookstore", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Static{
+				Static: &static.UpstreamSpec{
+					ServiceSpec: &options.ServiceSpec{
+						PluginType: &options.ServiceSpec_Grpc{
+							Grpc: &grpc.ServiceSpec{
+								Descriptors: []byte(base64.StdEncoding.EncodeToString(descriptorBin)),
+							},
+						},
+					},
+				},
+			},
+		}
+		hl := &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{
+				GrpcJsonTranscoder: &grpc_json.GrpcJsonTranscoder{
+					DescriptorSet: &grpc_json.GrpcJsonTranscoder_UpstreamRef{UpstreamRef: &core.ResourceRef{Name: "bookstore", Namespace: "gloo-system"}},
+					Services:      []string{"main.Bookstore"},
+				},
+			},
+		}
+
+		p := grpcjson.NewPlugin()
+		p.Init(initParams)
+		f, err := p.HttpFilters(plugins.Params{Snapshot: &v1.ApiSnapshot{Upstreams: v1.UpstreamList{us}}}, hl)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f).To(HaveLen(1))
+
+		any, err := utils.MessageToAny(&envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder{
+			DescriptorSet: &envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder_ProtoDescriptorBin{ProtoDescriptorBin: descriptorBin},
+			Services:      []string{"main.Bookstore"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f[0].HttpFilter.GetTypedConfig()).To(Equal(any))
+	})
+
+	It("should error if the upstream has no proto descriptors", func() {
+		us := &v1.Upstream{
+			Metadata:     core.Metadata{Name: "bookstore", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Static{Static: &static.UpstreamSpec{}},
+		}
+		hl := &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{
+				GrpcJsonTranscoder: &grpc_json.GrpcJsonTranscoder{
+					DescriptorSet: &grpc_json.GrpcJsonTranscoder_UpstreamRef{UpstreamRef: &core.ResourceRef{Name: "bookstore", Namespace: "gloo-system"}},
+					Services:      []string{"main.Bookstore"},
+				},
+			},
+		}
+
+		p := grpcjson.NewPlugin()
+		p.Init(initParams)
+		_, err := p.HttpFilters(plugins.Params{Snapshot: &v1.ApiSnapshot{Upstreams: v1.UpstreamList{us}}}, hl)
+		Expect(err).To(MatchError("upstream gloo-system.bookstore has no grpc proto descriptors"))
+	})
+
 })
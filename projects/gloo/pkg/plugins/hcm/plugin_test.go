@@ -45,6 +45,7 @@ var _ = Describe("Plugin", func() {
 			DrainTimeout:        pd(time.Hour),
 			DelayedCloseTimeout: pd(time.Hour),
 			ServerName:          "ServerName",
+			MaxStreamDuration:   pd(time.Hour),
 
 			AcceptHttp_10:             true,
 			ProperCaseHeaderKeyFormat: true,
@@ -132,6 +133,7 @@ var _ = Describe("Plugin", func() {
 
 		Expect(cfg.CommonHttpProtocolOptions).NotTo(BeNil())
 		Expect(cfg.CommonHttpProtocolOptions.IdleTimeout).To(Equal(gogoutils.DurationStdToProto(hcms.IdleTimeout)))
+		Expect(cfg.CommonHttpProtocolOptions.MaxStreamDuration).To(Equal(gogoutils.DurationStdToProto(hcms.MaxStreamDuration)))
 
 		trace := cfg.Tracing
 		Expect(trace.CustomTags).To(ConsistOf([]*envoytracing.CustomTag{
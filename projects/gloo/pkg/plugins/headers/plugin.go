@@ -1,6 +1,8 @@
 package headers
 
 import (
+	"strings"
+
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
 	"github.com/solo-io/gloo/pkg/utils/gogoutils"
@@ -12,8 +14,57 @@ import (
 
 var (
 	MissingHeaderValueError = errors.Errorf("header section of header value option cannot be nil")
+
+	// header values may come from secrets, so the errors identify the header by its key and never include its value
+	UnterminatedCommandOperatorError = func(key string) error {
+		return errors.Errorf("value of header %v contains an unterminated command operator; use %%%% for a literal %%", key)
+	}
+	UnsupportedCommandOperatorError = func(key, operator string) error {
+		return errors.Errorf("value of header %v contains unsupported command operator %%%v%%", key, operator)
+	}
 )
 
+type operatorArgs int
+
+const (
+	noArgs operatorArgs = iota
+	optionalArgs
+	requiredArgs
+)
+
+// command operators envoy can substitute into custom header values, mapped to the arguments they accept.
+// see https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_conn_man/headers#custom-request-response-headers
+var commandOperators = map[string]operatorArgs{
+	"DOWNSTREAM_REMOTE_ADDRESS":              noArgs,
+	"DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT": noArgs,
+	"DOWNSTREAM_LOCAL_ADDRESS":               noArgs,
+	"DOWNSTREAM_LOCAL_ADDRESS_WITHOUT_PORT":  noArgs,
+	"DOWNSTREAM_LOCAL_PORT":                  noArgs,
+	"DOWNSTREAM_LOCAL_URI_SAN":               noArgs,
+	"DOWNSTREAM_PEER_URI_SAN":                noArgs,
+	"DOWNSTREAM_LOCAL_SUBJECT":               noArgs,
+	"DOWNSTREAM_PEER_SUBJECT":                noArgs,
+	"DOWNSTREAM_PEER_ISSUER":                 noArgs,
+	"DOWNSTREAM_TLS_SESSION_ID":              noArgs,
+	"DOWNSTREAM_TLS_CIPHER":                  noArgs,
+	"DOWNSTREAM_TLS_VERSION":                 noArgs,
+	"DOWNSTREAM_PEER_FINGERPRINT_256":        noArgs,
+	"DOWNSTREAM_PEER_FINGERPRINT_1":          noArgs,
+	"DOWNSTREAM_PEER_SERIAL":                 noArgs,
+	"DOWNSTREAM_PEER_CERT":                   noArgs,
+	"DOWNSTREAM_PEER_CERT_V_START":           noArgs,
+	"DOWNSTREAM_PEER_CERT_V_END":             noArgs,
+	"UPSTREAM_REMOTE_ADDRESS":                noArgs,
+	"HOSTNAME":                               noArgs,
+	"PROTOCOL":                               noArgs,
+	"RESPONSE_FLAGS":                         noArgs,
+	"START_TIME":                             optionalArgs,
+	"REQ":                                    requiredArgs,
+	"UPSTREAM_METADATA":                      requiredArgs,
+	"DYNAMIC_METADATA":                       requiredArgs,
+	"PER_REQUEST_STATE":                      requiredArgs,
+}
+
 // Puts Header Manipulation config on Routes, VirtualHosts, and Weighted Clusters
 type Plugin struct{}
 
@@ -118,6 +169,11 @@ func convertHeaderConfig(in *headers.HeaderManipulation, secrets *v1.SecretList)
 	if err != nil {
 		return nil, err
 	}
+	for _, h := range append(requestAdd, responseAdd...) {
+		if err := validateCommandOperators(h.GetHeader().GetKey(), h.GetHeader().GetValue()); err != nil {
+			return nil, err
+		}
+	}
 
 	return &envoyHeaderManipulation{
 		RequestHeadersToAdd:     requestAdd,
@@ -143,3 +199,50 @@ func convertResponseHeaderValueOption(in []*headers.HeaderValueOption) ([]*envoy
 	}
 	return out, nil
 }
+
+// envoy rejects the whole listener if a header value references an unknown command operator,
+// so catch these here and report them on the offending resource instead.
+func validateCommandOperators(key, value string) error {
+	rest := value
+	for {
+		start := strings.Index(rest, "%")
+		if start < 0 {
+			return nil
+		}
+		rest = rest[start+1:]
+		if strings.HasPrefix(rest, "%") {
+			// escaped literal percent sign
+			rest = rest[1:]
+			continue
+		}
+
+		nameLen := strings.IndexFunc(rest, func(r rune) bool {
+			return !(r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_')
+		})
+		if nameLen < 0 {
+			return UnterminatedCommandOperatorError(key)
+		}
+		name := rest[:nameLen]
+		rest = rest[nameLen:]
+
+		hasArgs := strings.HasPrefix(rest, "(")
+		var end int
+		if hasArgs {
+			// arguments may themselves contain percent signs, e.g. %START_TIME(%s)%
+			end = strings.Index(rest, ")%")
+			if end < 0 {
+				return UnterminatedCommandOperatorError(key)
+			}
+			end++
+		} else if !strings.HasPrefix(rest, "%") {
+			return UnterminatedCommandOperatorError(key)
+		}
+		operator := name + rest[:end]
+		rest = rest[end+1:]
+
+		args, ok := commandOperators[name]
+		if !ok || (hasArgs && args == noArgs) || (!hasArgs && args == requiredArgs) {
+			return UnsupportedCommandOperatorError(key, operator)
+		}
+	}
+}
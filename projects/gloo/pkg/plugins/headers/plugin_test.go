@@ -76,6 +76,46 @@ var _ = Describe("Plugin", func() {
 		Expect(out.ResponseHeadersToAdd).To(Equal(expectedHeaders.ResponseHeadersToAdd))
 		Expect(out.ResponseHeadersToRemove).To(Equal(expectedHeaders.ResponseHeadersToRemove))
 	})
+	It("passes envoy command operators through to header values", func() {
+		out := &envoyroute.WeightedCluster_ClusterWeight{}
+		err := p.ProcessWeightedDestination(plugins.RouteParams{}, &v1.WeightedDestination{
+			Options: &v1.WeightedDestinationOptions{
+				HeaderManipulation: &headers.HeaderManipulation{
+					RequestHeadersToAdd: []*envoycore_sk.HeaderValueOption{{HeaderOption: &envoycore_sk.HeaderValueOption_Header{
+						Header: &envoycore_sk.HeaderValue{Key: "x-client", Value: "%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT% 100%%"}}}},
+					ResponseHeadersToAdd: []*headers.HeaderValueOption{
+						{Header: &headers.HeaderValue{Key: "x-started", Value: "%START_TIME(%s.%3f)%"}},
+						{Header: &headers.HeaderValue{Key: "x-tenant", Value: `%DYNAMIC_METADATA(["com.example", "tenant"])%`}},
+					},
+				},
+			},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.RequestHeadersToAdd[0].Header.Value).To(Equal("%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT% 100%%"))
+		Expect(out.ResponseHeadersToAdd[0].Header.Value).To(Equal("%START_TIME(%s.%3f)%"))
+		Expect(out.ResponseHeadersToAdd[1].Header.Value).To(Equal(`%DYNAMIC_METADATA(["com.example", "tenant"])%`))
+	})
+	It("errors on unsupported or malformed command operators", func() {
+		for value, expectedErr := range map[string]error{
+			"%NOT_AN_OPERATOR%": UnsupportedCommandOperatorError("foo", "NOT_AN_OPERATOR"),
+			"%HOSTNAME(foo)%":   UnsupportedCommandOperatorError("foo", "HOSTNAME(foo)"),
+			"%REQ%":             UnsupportedCommandOperatorError("foo", "REQ"),
+			"100%":              UnterminatedCommandOperatorError("foo"),
+			"%REQ(x-foo)":       UnterminatedCommandOperatorError("foo"),
+			"%PROTOCOL":         UnterminatedCommandOperatorError("foo"),
+		} {
+			out := &envoyroute.Route{}
+			err := p.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+				Options: &v1.RouteOptions{
+					HeaderManipulation: &headers.HeaderManipulation{
+						ResponseHeadersToAdd: []*headers.HeaderValueOption{{Header: &headers.HeaderValue{Key: "foo", Value: value}}},
+					},
+				},
+			}, out)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal(expectedErr.Error()))
+		}
+	})
 	It("Can add secrets to headers", func() {
 		paramsWithSecret := plugins.VirtualHostParams{
 			Params: plugins.Params{
@@ -111,6 +151,39 @@ var _ = Describe("Plugin", func() {
 		Expect(out.ResponseHeadersToAdd).To(Equal(expectedHeadersWithSecrets.ResponseHeadersToAdd))
 		Expect(out.ResponseHeadersToRemove).To(Equal(expectedHeadersWithSecrets.ResponseHeadersToRemove))
 	})
+	It("does not include secret header values in command operator errors", func() {
+		paramsWithSecret := plugins.VirtualHostParams{
+			Params: plugins.Params{
+				Snapshot: &v1.ApiSnapshot{
+					Secrets: v1.SecretList{
+						{
+							Kind: &v1.Secret_Header{
+								Header: &v1.HeaderSecret{
+									Headers: map[string]string{
+										"Authorization": "basic 100%dXNlcjpwYXNzd29yZA==",
+									},
+								},
+							},
+							Metadata: coreV1.Metadata{
+								Name:      "foo",
+								Namespace: "bar",
+							},
+						},
+					},
+				},
+			},
+		}
+
+		out := &envoyroute.VirtualHost{}
+		err := p.ProcessVirtualHost(paramsWithSecret, &v1.VirtualHost{
+			Options: &v1.VirtualHostOptions{
+				HeaderManipulation: testHeaderManipWithSecrets,
+			},
+		}, out)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal(UnterminatedCommandOperatorError("Authorization").Error()))
+		Expect(err.Error()).NotTo(ContainSubstring("dXNlcjpwYXNzd29yZA=="))
+	})
 })
 
 var testBrokenConfigNoRequestHeader = &headers.HeaderManipulation{
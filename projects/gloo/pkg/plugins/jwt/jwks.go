@@ -0,0 +1,99 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"strings"
+
+	"github.com/rotisserie/eris"
+)
+
+var (
+	InvalidPemError           = eris.New("local jwks key is neither json nor a PEM encoded public key")
+	UnsupportedPublicKeyError = func(key interface{}) error {
+		return eris.Errorf("unsupported public key type %T, only RSA and EC keys can verify JWTs", key)
+	}
+)
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// EC
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// envoy only reads key sets, so single keys are wrapped and PEM keys converted
+func translateLocalJwks(key string) (string, error) {
+	key = strings.TrimSpace(key)
+	if strings.HasPrefix(key, "{") {
+		var jwk map[string]interface{}
+		if err := json.Unmarshal([]byte(key), &jwk); err != nil {
+			return "", eris.Wrapf(err, "parsing json web key")
+		}
+		if _, ok := jwk["keys"]; ok {
+			return key, nil
+		}
+		return marshalKeySet(jwk)
+	}
+
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		return "", InvalidPemError
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		// older tools write RSA keys in the PKCS #1 format
+		rsaKey, pkcs1Err := x509.ParsePKCS1PublicKey(block.Bytes)
+		if pkcs1Err != nil {
+			return "", eris.Wrapf(err, "parsing PEM public key")
+		}
+		publicKey = rsaKey
+	}
+
+	switch publicKey := publicKey.(type) {
+	case *rsa.PublicKey:
+		return marshalKeySet(jsonWebKey{
+			Kty: "RSA",
+			N:   encodeBigInt(publicKey.N, 0),
+			E:   encodeBigInt(big.NewInt(int64(publicKey.E)), 0),
+		})
+	case *ecdsa.PublicKey:
+		// coordinates are padded to the size of the curve
+		size := (publicKey.Curve.Params().BitSize + 7) / 8
+		return marshalKeySet(jsonWebKey{
+			Kty: "EC",
+			Crv: publicKey.Curve.Params().Name,
+			X:   encodeBigInt(publicKey.X, size),
+			Y:   encodeBigInt(publicKey.Y, size),
+		})
+	default:
+		return "", UnsupportedPublicKeyError(publicKey)
+	}
+}
+
+func marshalKeySet(key interface{}) (string, error) {
+	keySet, err := json.Marshal(map[string]interface{}{
+		"keys": []interface{}{key},
+	})
+	if err != nil {
+		return "", eris.Wrapf(err, "marshalling json web key set")
+	}
+	return string(keySet), nil
+}
+
+func encodeBigInt(i *big.Int, size int) string {
+	bytes := i.Bytes()
+	if len(bytes) < size {
+		bytes = append(make([]byte, size-len(bytes)), bytes...)
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes)
+}
@@ -0,0 +1,443 @@
+package jwt
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/rotisserie/eris"
+
+	envoycorev3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	envoyroutev3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/route/v3"
+	envoyjwt "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/jwt_authn/v3"
+	envoymatcher "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+)
+
+const (
+	FilterName = "envoy.filters.http.jwt_authn"
+
+	// the namespace of the dynamic metadata that holds the claims of verified JWTs
+	MetadataNamespace = FilterName
+
+	// how long envoy waits for a remote JWKS server before failing the requests that need its keys
+	RemoteJwksTimeout = 5 * time.Second
+)
+
+// JWTs are verified before the other auth filters, so that they can use the claims
+var pluginStage = plugins.BeforeStage(plugins.AuthNStage)
+
+var (
+	MissingJwksError = func(provider string) error {
+		return eris.Errorf("jwt provider %s must specify a jwks", provider)
+	}
+	MissingRemoteJwksUrlError = func(provider string) error {
+		return eris.Errorf("remote jwks of jwt provider %s must specify a url", provider)
+	}
+	MissingRemoteJwksUpstreamError = func(provider string) error {
+		return eris.Errorf("remote jwks of jwt provider %s must specify an upstream", provider)
+	}
+	NoProvidersError = func(virtualHost string) error {
+		return eris.Errorf("virtual host %s does not define any jwt provider to verify the requirement of its routes", virtualHost)
+	}
+	UnknownProviderError = func(virtualHost, provider string) error {
+		return eris.Errorf("jwt requirement references provider %s, which virtual host %s does not define", provider, virtualHost)
+	}
+)
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+var _ plugins.Plugin = new(Plugin)
+var _ plugins.HttpFilterPlugin = new(Plugin)
+var _ plugins.VirtualHostPlugin = new(Plugin)
+var _ plugins.RoutePlugin = new(Plugin)
+
+type Plugin struct {
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+// The filter has no per-route config: the requirements of the virtual hosts and routes are translated to the rules of
+// the filter in HttpFilters. The virtual hosts are only validated here.
+func (p *Plugin) ProcessVirtualHost(params plugins.VirtualHostParams, in *v1.VirtualHost, out *envoyroute.VirtualHost) error {
+	jwtConfig := in.GetOptions().GetJwt()
+	if len(jwtConfig.GetProviders()) == 0 {
+		return nil
+	}
+
+	_, err := translateRequirement(in.GetName(), jwtConfig.GetProviders(), jwtConfig.GetRequirement())
+	return err
+}
+
+// The claims are copied to headers by the routes which verify JWTs, so that the routes which disable the verification
+// do not forward headers with the claims of an unverified JWT.
+func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoyroute.Route) error {
+	routeConfig := in.GetOptions().GetJwt()
+	if routeConfig.GetDisable() {
+		return nil
+	}
+
+	virtualHostName := params.VirtualHost.GetName()
+	providers := params.VirtualHost.GetOptions().GetJwt().GetProviders()
+	out.RequestHeadersToAdd = append(out.RequestHeadersToAdd, claimsToHeaders(providers)...)
+
+	if routeConfig.GetRequirement() == nil {
+		return nil
+	}
+	if len(providers) == 0 {
+		return NoProvidersError(virtualHostName)
+	}
+
+	_, err := translateRequirement(virtualHostName, providers, routeConfig.GetRequirement())
+	return err
+}
+
+func (p *Plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	config := &envoyjwt.JwtAuthentication{
+		Providers: map[string]*envoyjwt.JwtProvider{},
+	}
+
+	for _, virtualHost := range listener.GetVirtualHosts() {
+		for name, provider := range virtualHost.GetOptions().GetJwt().GetProviders() {
+			envoyProvider, err := translateProvider(name, provider, params.Snapshot.Upstreams)
+			if err != nil {
+				return nil, err
+			}
+			config.Providers[providerName(virtualHost.GetName(), name)] = envoyProvider
+		}
+	}
+
+	if len(config.GetProviders()) == 0 {
+		return nil, nil
+	}
+
+	rules, err := translateRules(params, listener.GetVirtualHosts())
+	if err != nil {
+		return nil, err
+	}
+	config.Rules = rules
+
+	jwtFilter, err := plugins.NewStagedFilterWithConfig(FilterName, config, pluginStage)
+	if err != nil {
+		return nil, eris.Wrapf(err, "generating filter config")
+	}
+
+	return []plugins.StagedHttpFilter{jwtFilter}, nil
+}
+
+// Envoy selects the virtual host of a request by the most specific of their domains, while the filter applies the
+// first of its rules that matches the request. The rules of each virtual host are restricted to its domains, and
+// ordered by the specificity of the domains: exact domains first, then the longest suffix and prefix wildcards, and
+// finally the `*` domain.
+func translateRules(params plugins.Params, virtualHosts []*v1.VirtualHost) ([]*envoyjwt.RequirementRule, error) {
+	type virtualHostDomain struct {
+		domain      string
+		virtualHost *v1.VirtualHost
+	}
+	var domains []virtualHostDomain
+	for _, virtualHost := range virtualHosts {
+		for _, domain := range virtualHost.GetDomains() {
+			domains = append(domains, virtualHostDomain{domain: domain, virtualHost: virtualHost})
+		}
+	}
+	sort.SliceStable(domains, func(i, j int) bool {
+		iPriority, jPriority := domainPriority(domains[i].domain), domainPriority(domains[j].domain)
+		if iPriority != jPriority {
+			return iPriority < jPriority
+		}
+		return len(domains[i].domain) > len(domains[j].domain)
+	})
+
+	var rules []*envoyjwt.RequirementRule
+	for _, vd := range domains {
+		authorityMatcher := authorityHeaderMatcher(vd.domain)
+		virtualHostRules, err := translateVirtualHostRules(params, vd.virtualHost)
+		if err != nil {
+			return nil, err
+		}
+		// requests that match none of the routes are not verified, as they do not reach an upstream
+		virtualHostRules = append(virtualHostRules, &envoyjwt.RequirementRule{
+			Match: &envoyroutev3.RouteMatch{
+				PathSpecifier: &envoyroutev3.RouteMatch_Prefix{Prefix: "/"},
+			},
+		})
+		for _, rule := range virtualHostRules {
+			if authorityMatcher != nil {
+				rule.Match.Headers = append(rule.Match.Headers, authorityMatcher)
+			}
+		}
+		rules = append(rules, virtualHostRules...)
+	}
+	return rules, nil
+}
+
+// the rules of the routes of the virtual host, without the catch-all rule
+func translateVirtualHostRules(params plugins.Params, virtualHost *v1.VirtualHost) ([]*envoyjwt.RequirementRule, error) {
+	jwtConfig := virtualHost.GetOptions().GetJwt()
+	if len(jwtConfig.GetProviders()) == 0 {
+		return nil, nil
+	}
+	virtualHostRequirement, err := translateRequirement(virtualHost.GetName(), jwtConfig.GetProviders(), jwtConfig.GetRequirement())
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []*envoyjwt.RequirementRule
+	for _, route := range virtualHost.GetRoutes() {
+		routeConfig := route.GetOptions().GetJwt()
+		requirement := virtualHostRequirement
+		switch {
+		case routeConfig.GetDisable():
+			// rules without a requirement do not verify JWTs
+			requirement = nil
+		case routeConfig.GetRequirement() != nil:
+			requirement, err = translateRequirement(virtualHost.GetName(), jwtConfig.GetProviders(), routeConfig.GetRequirement())
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		routeMatchers := route.GetMatchers()
+		if len(routeMatchers) == 0 {
+			routeMatchers = []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"}}}
+		}
+		for _, matcher := range routeMatchers {
+			match := translator.GlooMatcherToEnvoyMatcher(params, matcher)
+			matchV3, err := toV3RouteMatch(&match)
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, &envoyjwt.RequirementRule{
+				Match:    matchV3,
+				Requires: requirement,
+			})
+		}
+	}
+	return rules, nil
+}
+
+func domainPriority(domain string) int {
+	switch {
+	case domain == "*":
+		return 3
+	case strings.HasPrefix(domain, "*"):
+		return 1
+	case strings.HasSuffix(domain, "*"):
+		return 2
+	default:
+		return 0
+	}
+}
+
+// matches the host of the requests with the domain of a virtual host, in the same way as envoy.
+// The host of a request may include its port, which the domains without a port match as well.
+func authorityHeaderMatcher(domain string) *envoyroutev3.HeaderMatcher {
+	var regex string
+	switch {
+	case domain == "*":
+		return nil
+	case strings.HasPrefix(domain, "*"):
+		regex = ".+" + regexp.QuoteMeta(strings.TrimPrefix(domain, "*"))
+	case strings.HasSuffix(domain, "*"):
+		// the wildcard matches the port as well
+		return &envoyroutev3.HeaderMatcher{
+			Name:                 ":authority",
+			HeaderMatchSpecifier: &envoyroutev3.HeaderMatcher_PrefixMatch{PrefixMatch: strings.TrimSuffix(domain, "*")},
+		}
+	default:
+		regex = regexp.QuoteMeta(domain)
+	}
+	if !strings.Contains(domain, ":") {
+		regex += "(:[0-9]+)?"
+	}
+	return &envoyroutev3.HeaderMatcher{
+		Name: ":authority",
+		HeaderMatchSpecifier: &envoyroutev3.HeaderMatcher_SafeRegexMatch{
+			SafeRegexMatch: &envoymatcher.RegexMatcher{
+				EngineType: &envoymatcher.RegexMatcher_GoogleRe2{GoogleRe2: &envoymatcher.RegexMatcher_GoogleRE2{}},
+				Regex:      regex,
+			},
+		},
+	}
+}
+
+// the v2 and v3 route matches share their wire format
+func toV3RouteMatch(match *envoyroute.RouteMatch) (*envoyroutev3.RouteMatch, error) {
+	bytes, err := proto.Marshal(match)
+	if err != nil {
+		return nil, eris.Wrapf(err, "marshalling route match")
+	}
+	var matchV3 envoyroutev3.RouteMatch
+	if err := gogoproto.Unmarshal(bytes, &matchV3); err != nil {
+		return nil, eris.Wrapf(err, "converting route match")
+	}
+	return &matchV3, nil
+}
+
+// The claims of verified JWTs are stored in the dynamic metadata by the filter, and copied to the headers of the
+// upstream request by the router. Hence, routes cannot match on these headers.
+func claimsToHeaders(providers map[string]*jwt.Provider) []*envoycore.HeaderValueOption {
+	var names []string
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var headers []*envoycore.HeaderValueOption
+	for _, name := range names {
+		for _, claimToHeader := range providers[name].GetClaimsToHeaders() {
+			headers = append(headers, &envoycore.HeaderValueOption{
+				Header: &envoycore.HeaderValue{
+					Key:   claimToHeader.GetHeader(),
+					Value: fmt.Sprintf(`%%DYNAMIC_METADATA(["%s", "%s", "%s"])%%`, MetadataNamespace, name, claimToHeader.GetClaim()),
+				},
+				Append: &wrappers.BoolValue{Value: claimToHeader.GetAppend()},
+			})
+		}
+	}
+	return headers
+}
+
+// provider names are only unique within their virtual host
+func providerName(virtualHost, provider string) string {
+	return virtualHost + "_" + provider
+}
+
+func translateProvider(name string, provider *jwt.Provider, upstreams v1.UpstreamList) (*envoyjwt.JwtProvider, error) {
+	envoyProvider := &envoyjwt.JwtProvider{
+		Issuer:    provider.GetIssuer(),
+		Audiences: provider.GetAudiences(),
+		Forward:   provider.GetKeepToken(),
+		// the claims of verified JWTs are available to the access logs, keyed by the name of their provider
+		PayloadInMetadata: name,
+		FromParams:        provider.GetTokenSource().GetQueryParams(),
+	}
+	for _, header := range provider.GetTokenSource().GetHeaders() {
+		envoyProvider.FromHeaders = append(envoyProvider.FromHeaders, &envoyjwt.JwtHeader{
+			Name:        header.GetHeader(),
+			ValuePrefix: header.GetPrefix(),
+		})
+	}
+
+	switch jwks := provider.GetJwks().GetJwks().(type) {
+	case *jwt.Jwks_Remote:
+		remoteJwks, err := translateRemoteJwks(name, jwks.Remote, upstreams)
+		if err != nil {
+			return nil, err
+		}
+		envoyProvider.JwksSourceSpecifier = &envoyjwt.JwtProvider_RemoteJwks{
+			RemoteJwks: remoteJwks,
+		}
+	case *jwt.Jwks_Local:
+		keySet, err := translateLocalJwks(jwks.Local.GetKey())
+		if err != nil {
+			return nil, eris.Wrapf(err, "translating local jwks of jwt provider %s", name)
+		}
+		envoyProvider.JwksSourceSpecifier = &envoyjwt.JwtProvider_LocalJwks{
+			LocalJwks: &envoycorev3.DataSource{
+				Specifier: &envoycorev3.DataSource_InlineString{
+					InlineString: keySet,
+				},
+			},
+		}
+	default:
+		return nil, MissingJwksError(name)
+	}
+
+	return envoyProvider, nil
+}
+
+func translateRemoteJwks(name string, remoteJwks *jwt.RemoteJwks, upstreams v1.UpstreamList) (*envoyjwt.RemoteJwks, error) {
+	if remoteJwks.GetUrl() == "" {
+		return nil, MissingRemoteJwksUrlError(name)
+	}
+	upstreamRef := remoteJwks.GetUpstreamRef()
+	if upstreamRef == nil {
+		return nil, MissingRemoteJwksUpstreamError(name)
+	}
+	if _, err := upstreams.Find(upstreamRef.GetNamespace(), upstreamRef.GetName()); err != nil {
+		return nil, eris.Wrapf(err, "finding the upstream of the remote jwks of jwt provider %s", name)
+	}
+
+	// envoy fetches the keys in the main thread before the listener is activated, then again once the cache
+	// duration expires, so that the requests do not wait for the keys and the worker threads share them
+	return &envoyjwt.RemoteJwks{
+		HttpUri: &envoycorev3.HttpUri{
+			Uri: remoteJwks.GetUrl(),
+			HttpUpstreamType: &envoycorev3.HttpUri_Cluster{
+				Cluster: translator.UpstreamToClusterName(*upstreamRef),
+			},
+			Timeout: types.DurationProto(RemoteJwksTimeout),
+		},
+		CacheDuration: remoteJwks.GetCacheDuration(),
+		AsyncFetch:    &envoyjwt.JwksAsyncFetch{},
+	}, nil
+}
+
+func translateRequirement(virtualHost string, providers map[string]*jwt.Provider, requirement *jwt.Requirement) (*envoyjwt.JwtRequirement, error) {
+	var names []string
+	if len(requirement.GetProviders()) == 0 {
+		for name := range providers {
+			names = append(names, name)
+		}
+	} else {
+		for _, name := range requirement.GetProviders() {
+			if _, ok := providers[name]; !ok {
+				return nil, UnknownProviderError(virtualHost, name)
+			}
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var requirements []*envoyjwt.JwtRequirement
+	for _, name := range names {
+		requirements = append(requirements, &envoyjwt.JwtRequirement{
+			RequiresType: &envoyjwt.JwtRequirement_ProviderName{
+				ProviderName: providerName(virtualHost, name),
+			},
+		})
+	}
+
+	switch requirement.GetValidationPolicy() {
+	case jwt.Requirement_ALLOW_MISSING:
+		requirements = append(requirements, &envoyjwt.JwtRequirement{
+			RequiresType: &envoyjwt.JwtRequirement_AllowMissing{
+				AllowMissing: &types.Empty{},
+			},
+		})
+	case jwt.Requirement_ALLOW_MISSING_OR_FAILED:
+		requirements = append(requirements, &envoyjwt.JwtRequirement{
+			RequiresType: &envoyjwt.JwtRequirement_AllowMissingOrFailed{
+				AllowMissingOrFailed: &types.Empty{},
+			},
+		})
+	}
+
+	if len(requirements) == 1 {
+		return requirements[0], nil
+	}
+	return &envoyjwt.JwtRequirement{
+		RequiresType: &envoyjwt.JwtRequirement_RequiresAny{
+			RequiresAny: &envoyjwt.JwtRequirementOrList{
+				Requirements: requirements,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,464 @@
+package jwt_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	envoycorev3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	envoyroutev3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/route/v3"
+	envoyjwt "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/jwt_authn/v3"
+	envoymatcher "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		params      plugins.Params
+		upstream    *v1.Upstream
+		upstreamRef core.ResourceRef
+		vhostConfig *jwt.VhostExtension
+		virtualHost *v1.VirtualHost
+	)
+
+	BeforeEach(func() {
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{Name: "jwks", Namespace: "gloo-system"},
+		}
+		upstreamRef = upstream.Metadata.Ref()
+		params = plugins.Params{
+			Snapshot: &v1.ApiSnapshot{
+				Upstreams: v1.UpstreamList{upstream},
+			},
+		}
+
+		cacheDuration := &types.Duration{Seconds: 600}
+		vhostConfig = &jwt.VhostExtension{
+			Providers: map[string]*jwt.Provider{
+				"employees": {
+					Issuer:    "https://idp.example.com",
+					Audiences: []string{"internal"},
+					Jwks: &jwt.Jwks{
+						Jwks: &jwt.Jwks_Remote{
+							Remote: &jwt.RemoteJwks{
+								Url:           "https://idp.example.com/keys",
+								UpstreamRef:   &upstreamRef,
+								CacheDuration: cacheDuration,
+							},
+						},
+					},
+					TokenSource: &jwt.TokenSource{
+						Headers:     []*jwt.TokenSource_HeaderSource{{Header: "x-jwt", Prefix: "JWT "}},
+						QueryParams: []string{"token"},
+					},
+					KeepToken: true,
+					ClaimsToHeaders: []*jwt.ClaimToHeader{{
+						Claim:  "sub",
+						Header: "x-sub",
+					}},
+				},
+				"partners": {
+					Issuer: "partners",
+					Jwks: &jwt.Jwks{
+						Jwks: &jwt.Jwks_Local{
+							Local: &jwt.LocalJwks{Key: `{"keys":[]}`},
+						},
+					},
+				},
+			},
+		}
+		virtualHost = &v1.VirtualHost{
+			Name:    "vhost",
+			Domains: []string{"*"},
+			Options: &v1.VirtualHostOptions{Jwt: vhostConfig},
+			Routes:  []*v1.Route{{}},
+		}
+	})
+
+	filterConfig := func(filters []plugins.StagedHttpFilter) *envoyjwt.JwtAuthentication {
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].Stage).To(Equal(plugins.BeforeStage(plugins.AuthNStage)))
+		Expect(filters[0].HttpFilter.Name).To(Equal(FilterName))
+		var config envoyjwt.JwtAuthentication
+		Expect(proto.Unmarshal(filters[0].HttpFilter.GetTypedConfig().GetValue(), &config)).To(Succeed())
+		return &config
+	}
+
+	prefixMatch := func(prefix string, headers ...*envoyroutev3.HeaderMatcher) *envoyroutev3.RouteMatch {
+		return &envoyroutev3.RouteMatch{
+			PathSpecifier: &envoyroutev3.RouteMatch_Prefix{Prefix: prefix},
+			Headers:       headers,
+		}
+	}
+
+	providerRequirement := func(name string) *envoyjwt.JwtRequirement {
+		return &envoyjwt.JwtRequirement{
+			RequiresType: &envoyjwt.JwtRequirement_ProviderName{ProviderName: name},
+		}
+	}
+
+	Context("filter", func() {
+
+		It("is not added when no virtual host defines providers", func() {
+			filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{{
+					Routes: []*v1.Route{{}},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+
+		It("translates the providers of all virtual hosts", func() {
+			filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{virtualHost},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			config := filterConfig(filters)
+			Expect(config.GetProviders()).To(HaveLen(2))
+			Expect(config.GetProviders()["vhost_employees"]).To(Equal(&envoyjwt.JwtProvider{
+				Issuer:    "https://idp.example.com",
+				Audiences: []string{"internal"},
+				JwksSourceSpecifier: &envoyjwt.JwtProvider_RemoteJwks{
+					RemoteJwks: &envoyjwt.RemoteJwks{
+						HttpUri: &envoycorev3.HttpUri{
+							Uri: "https://idp.example.com/keys",
+							HttpUpstreamType: &envoycorev3.HttpUri_Cluster{
+								Cluster: translator.UpstreamToClusterName(upstreamRef),
+							},
+							Timeout: types.DurationProto(RemoteJwksTimeout),
+						},
+						CacheDuration: &types.Duration{Seconds: 600},
+						AsyncFetch:    &envoyjwt.JwksAsyncFetch{},
+					},
+				},
+				Forward:           true,
+				FromHeaders:       []*envoyjwt.JwtHeader{{Name: "x-jwt", ValuePrefix: "JWT "}},
+				FromParams:        []string{"token"},
+				PayloadInMetadata: "employees",
+			}))
+			Expect(config.GetProviders()["vhost_partners"].GetLocalJwks().GetInlineString()).To(Equal(`{"keys":[]}`))
+
+			Expect(config.GetRules()).To(HaveLen(2))
+			Expect(proto.Equal(config.GetRules()[0], &envoyjwt.RequirementRule{
+				Match: prefixMatch("/"),
+				Requires: &envoyjwt.JwtRequirement{
+					RequiresType: &envoyjwt.JwtRequirement_RequiresAny{
+						RequiresAny: &envoyjwt.JwtRequirementOrList{
+							Requirements: []*envoyjwt.JwtRequirement{
+								providerRequirement("vhost_employees"),
+								providerRequirement("vhost_partners"),
+							},
+						},
+					},
+				},
+			})).To(BeTrue())
+			// the catch-all rule of the virtual host
+			Expect(proto.Equal(config.GetRules()[1], &envoyjwt.RequirementRule{Match: prefixMatch("/")})).To(BeTrue())
+		})
+
+		It("uses the requirements of the routes", func() {
+			virtualHost.Routes = []*v1.Route{{
+				Matchers: []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/catalog"}}},
+				Options: &v1.RouteOptions{
+					Jwt: &jwt.RouteExtension{
+						Requirement: &jwt.Requirement{
+							Providers:        []string{"partners"},
+							ValidationPolicy: jwt.Requirement_ALLOW_MISSING,
+						},
+					},
+				},
+			}, {
+				Matchers: []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/health"}}},
+				Options: &v1.RouteOptions{
+					Jwt: &jwt.RouteExtension{Disable: true},
+				},
+			}, {
+				Matchers: []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Exact{Exact: "/employees"}}},
+				Options: &v1.RouteOptions{
+					Jwt: &jwt.RouteExtension{
+						Requirement: &jwt.Requirement{
+							Providers: []string{"employees"},
+						},
+					},
+				},
+			}}
+
+			filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{virtualHost},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			rules := filterConfig(filters).GetRules()
+			Expect(rules).To(HaveLen(4))
+			Expect(proto.Equal(rules[0], &envoyjwt.RequirementRule{
+				Match: prefixMatch("/catalog"),
+				Requires: &envoyjwt.JwtRequirement{
+					RequiresType: &envoyjwt.JwtRequirement_RequiresAny{
+						RequiresAny: &envoyjwt.JwtRequirementOrList{
+							Requirements: []*envoyjwt.JwtRequirement{
+								providerRequirement("vhost_partners"),
+								{RequiresType: &envoyjwt.JwtRequirement_AllowMissing{AllowMissing: &types.Empty{}}},
+							},
+						},
+					},
+				},
+			})).To(BeTrue())
+			Expect(proto.Equal(rules[1], &envoyjwt.RequirementRule{Match: prefixMatch("/health")})).To(BeTrue())
+			Expect(proto.Equal(rules[2], &envoyjwt.RequirementRule{
+				Match:    &envoyroutev3.RouteMatch{PathSpecifier: &envoyroutev3.RouteMatch_Path{Path: "/employees"}},
+				Requires: providerRequirement("vhost_employees"),
+			})).To(BeTrue())
+			Expect(proto.Equal(rules[3], &envoyjwt.RequirementRule{Match: prefixMatch("/")})).To(BeTrue())
+		})
+
+		It("restricts the rules of the virtual hosts to their domains, the most specific first", func() {
+			virtualHost.Domains = []string{"*.example.com", "api.example.com"}
+			other := &v1.VirtualHost{
+				Name:    "other",
+				Domains: []string{"*", "api.*"},
+				Routes:  []*v1.Route{{}},
+			}
+
+			filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{other, virtualHost},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			authority := func(regex string) *envoyroutev3.HeaderMatcher {
+				return &envoyroutev3.HeaderMatcher{
+					Name: ":authority",
+					HeaderMatchSpecifier: &envoyroutev3.HeaderMatcher_SafeRegexMatch{
+						SafeRegexMatch: &envoymatcher.RegexMatcher{
+							EngineType: &envoymatcher.RegexMatcher_GoogleRe2{GoogleRe2: &envoymatcher.RegexMatcher_GoogleRE2{}},
+							Regex:      regex,
+						},
+					},
+				}
+			}
+			// the hosts of the requests may include their port
+			exact := authority(`api\.example\.com(:[0-9]+)?`)
+			suffix := authority(`.+\.example\.com(:[0-9]+)?`)
+			prefix := &envoyroutev3.HeaderMatcher{
+				Name:                 ":authority",
+				HeaderMatchSpecifier: &envoyroutev3.HeaderMatcher_PrefixMatch{PrefixMatch: "api."},
+			}
+
+			var matches []*envoyroutev3.RouteMatch
+			var requires []bool
+			for _, rule := range filterConfig(filters).GetRules() {
+				matches = append(matches, rule.GetMatch())
+				requires = append(requires, rule.GetRequires() != nil)
+			}
+			expectedMatches := []*envoyroutev3.RouteMatch{
+				prefixMatch("/", exact), prefixMatch("/", exact),
+				prefixMatch("/", suffix), prefixMatch("/", suffix),
+				// virtual hosts without providers do not verify JWTs
+				prefixMatch("/", prefix),
+				prefixMatch("/"),
+			}
+			Expect(matches).To(HaveLen(len(expectedMatches)))
+			for i := range expectedMatches {
+				Expect(proto.Equal(matches[i], expectedMatches[i])).To(BeTrue(), "rule %d", i)
+			}
+			Expect(requires).To(Equal([]bool{true, false, true, false, false, false}))
+		})
+
+		It("converts PEM encoded RSA keys to key sets", func() {
+			rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).NotTo(HaveOccurred())
+			vhostConfig.Providers["partners"].Jwks.GetLocal().Key = encodePublicKey(&rsaKey.PublicKey)
+
+			filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{virtualHost},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			keys := decodeKeySet(filterConfig(filters).GetProviders()["vhost_partners"].GetLocalJwks().GetInlineString())
+			Expect(keys).To(ConsistOf(map[string]string{
+				"kty": "RSA",
+				"n":   base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(rsaKey.E)).Bytes()),
+			}))
+		})
+
+		It("converts PEM encoded EC keys to key sets", func() {
+			ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			vhostConfig.Providers["partners"].Jwks.GetLocal().Key = encodePublicKey(&ecKey.PublicKey)
+
+			filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{virtualHost},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			keys := decodeKeySet(filterConfig(filters).GetProviders()["vhost_partners"].GetLocalJwks().GetInlineString())
+			Expect(keys).To(HaveLen(1))
+			Expect(keys[0]).To(HaveKeyWithValue("kty", "EC"))
+			Expect(keys[0]).To(HaveKeyWithValue("crv", "P-256"))
+			Expect(keys[0]["x"]).To(HaveLen(43))
+			Expect(keys[0]["y"]).To(HaveLen(43))
+		})
+
+		It("wraps single json web keys in a key set", func() {
+			vhostConfig.Providers["partners"].Jwks.GetLocal().Key = `{"kty":"oct","k":"c2VjcmV0"}`
+
+			filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{virtualHost},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			keys := decodeKeySet(filterConfig(filters).GetProviders()["vhost_partners"].GetLocalJwks().GetInlineString())
+			Expect(keys).To(ConsistOf(map[string]string{"kty": "oct", "k": "c2VjcmV0"}))
+		})
+
+		It("rejects remote jwks whose upstream does not exist", func() {
+			params.Snapshot.Upstreams = nil
+			_, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{virtualHost},
+			})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("rejects providers without jwks", func() {
+			vhostConfig.Providers["partners"].Jwks = nil
+			_, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{virtualHost},
+			})
+			Expect(err).To(MatchError(MissingJwksError("partners").Error()))
+		})
+	})
+
+	It("rejects virtual host requirements with unknown providers", func() {
+		vhostConfig.Requirement = &jwt.Requirement{Providers: []string{"contractors"}}
+		err := NewPlugin().ProcessVirtualHost(plugins.VirtualHostParams{Params: params}, virtualHost, &envoyroute.VirtualHost{})
+		Expect(err).To(MatchError(UnknownProviderError("vhost", "contractors").Error()))
+	})
+
+	It("does not copy the claims to headers on the virtual hosts", func() {
+		out := &envoyroute.VirtualHost{}
+		err := NewPlugin().ProcessVirtualHost(plugins.VirtualHostParams{Params: params}, virtualHost, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetRequestHeadersToAdd()).To(BeEmpty())
+	})
+
+	Context("routes", func() {
+
+		var routeParams plugins.RouteParams
+
+		BeforeEach(func() {
+			routeParams = plugins.RouteParams{
+				VirtualHostParams: plugins.VirtualHostParams{Params: params},
+				VirtualHost:       virtualHost,
+			}
+		})
+
+		It("copies the claims of the virtual host providers to headers", func() {
+			vhostConfig.Providers["employees"].ClaimsToHeaders = append(vhostConfig.Providers["employees"].ClaimsToHeaders, &jwt.ClaimToHeader{
+				Claim:  "groups",
+				Header: "x-groups",
+				Append: true,
+			})
+			out := &envoyroute.Route{}
+			err := NewPlugin().ProcessRoute(routeParams, &v1.Route{}, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetRequestHeadersToAdd()).To(Equal([]*envoycore.HeaderValueOption{{
+				Header: &envoycore.HeaderValue{
+					Key:   "x-sub",
+					Value: `%DYNAMIC_METADATA(["envoy.filters.http.jwt_authn", "employees", "sub"])%`,
+				},
+				Append: &wrappers.BoolValue{Value: false},
+			}, {
+				Header: &envoycore.HeaderValue{
+					Key:   "x-groups",
+					Value: `%DYNAMIC_METADATA(["envoy.filters.http.jwt_authn", "employees", "groups"])%`,
+				},
+				Append: &wrappers.BoolValue{Value: true},
+			}}))
+		})
+
+		It("does not copy the claims to headers on routes which disable the verification", func() {
+			out := &envoyroute.Route{}
+			err := NewPlugin().ProcessRoute(routeParams, &v1.Route{
+				Options: &v1.RouteOptions{
+					Jwt: &jwt.RouteExtension{Disable: true},
+				},
+			}, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetRequestHeadersToAdd()).To(BeEmpty())
+		})
+
+		It("accept requirements of the virtual host providers", func() {
+			out := &envoyroute.Route{}
+			err := NewPlugin().ProcessRoute(routeParams, &v1.Route{
+				Options: &v1.RouteOptions{
+					Jwt: &jwt.RouteExtension{
+						Requirement: &jwt.Requirement{
+							ValidationPolicy: jwt.Requirement_ALLOW_MISSING_OR_FAILED,
+						},
+					},
+				},
+			}, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetTypedPerFilterConfig()).To(BeEmpty())
+		})
+
+		It("reject requirements with unknown providers", func() {
+			err := NewPlugin().ProcessRoute(routeParams, &v1.Route{
+				Options: &v1.RouteOptions{
+					Jwt: &jwt.RouteExtension{
+						Requirement: &jwt.Requirement{Providers: []string{"contractors"}},
+					},
+				},
+			}, &envoyroute.Route{})
+			Expect(err).To(MatchError(UnknownProviderError("vhost", "contractors").Error()))
+		})
+
+		It("reject requirements on virtual hosts without providers", func() {
+			routeParams.VirtualHost = &v1.VirtualHost{Name: "other"}
+			err := NewPlugin().ProcessRoute(routeParams, &v1.Route{
+				Options: &v1.RouteOptions{
+					Jwt: &jwt.RouteExtension{
+						Requirement: &jwt.Requirement{},
+					},
+				},
+			}, &envoyroute.Route{})
+			Expect(err).To(MatchError(NoProvidersError("other").Error()))
+		})
+	})
+})
+
+func encodePublicKey(publicKey interface{}) string {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	Expect(err).NotTo(HaveOccurred())
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func decodeKeySet(keySet string) []map[string]string {
+	var decoded struct {
+		Keys []map[string]string `json:"keys"`
+	}
+	Expect(json.Unmarshal([]byte(keySet), &decoded)).To(Succeed())
+	return decoded.Keys
+}
@@ -6,11 +6,13 @@ import (
 	"time"
 
 	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/controller"
 	kubeinformers "k8s.io/client-go/informers"
 	kubelisters "k8s.io/client-go/listers/core/v1"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1beta1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -22,6 +24,8 @@ import (
 
 type KubePluginSharedFactory interface {
 	EndpointsLister(ns string) kubelisters.EndpointsLister
+	EndpointSlicesLister(ns string) discoverylisters.EndpointSliceLister
+	NodeLister() kubelisters.NodeLister
 	Subscribe() <-chan struct{}
 	Unsubscribe(<-chan struct{})
 }
@@ -29,36 +33,53 @@ type KubePluginSharedFactory interface {
 type KubePluginListers struct {
 	initError error
 
-	endpointsLister map[string]kubelisters.EndpointsLister
+	endpointsLister      map[string]kubelisters.EndpointsLister
+	endpointSlicesLister map[string]discoverylisters.EndpointSliceLister
+	nodeLister           kubelisters.NodeLister
 
 	cacheUpdatedWatchers      []chan struct{}
 	cacheUpdatedWatchersMutex sync.Mutex
 }
 
-func getInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string) *KubePluginListers {
+// Watches either the Endpoints or the EndpointSlices of the namespaces, and the nodes of the cluster if their
+// labels are used for the localities of the endpoints
+func getInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string, kubeSettings *v1.Settings_KubernetesConfiguration) *KubePluginListers {
 	if len(watchNamespaces) == 0 {
 		watchNamespaces = []string{metav1.NamespaceAll}
 	}
-	kubePluginSharedFactory := startInformerFactory(ctx, client, watchNamespaces)
+	kubePluginSharedFactory := startInformerFactory(ctx, client, watchNamespaces, kubeSettings)
 	if kubePluginSharedFactory.initError != nil {
 		panic(kubePluginSharedFactory.initError)
 	}
 	return kubePluginSharedFactory
 }
 
-func startInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string) *KubePluginListers {
+func startInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string, kubeSettings *v1.Settings_KubernetesConfiguration) *KubePluginListers {
 	resyncDuration := 12 * time.Hour
 
 	var informers []cache.SharedIndexInformer
 	k := &KubePluginListers{
-		endpointsLister: map[string]kubelisters.EndpointsLister{},
+		endpointsLister:      map[string]kubelisters.EndpointsLister{},
+		endpointSlicesLister: map[string]discoverylisters.EndpointSliceLister{},
 	}
 	for _, nsToWatch := range watchNamespaces {
 		kubeInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(client, resyncDuration, kubeinformers.WithNamespace(nsToWatch))
+		if kubeSettings.GetUseEndpointSlices() {
+			endpointSliceInformer := kubeInformerFactory.Discovery().V1beta1().EndpointSlices()
+			informers = append(informers, endpointSliceInformer.Informer())
+			k.endpointSlicesLister[nsToWatch] = endpointSliceInformer.Lister()
+			continue
+		}
 		endpointInformer := kubeInformerFactory.Core().V1().Endpoints()
 		informers = append(informers, endpointInformer.Informer())
 		k.endpointsLister[nsToWatch] = endpointInformer.Lister()
 	}
+	if kubeSettings.GetUseNodeLocalities() {
+		// nodes are not namespaced
+		nodeInformer := kubeinformers.NewSharedInformerFactory(client, resyncDuration).Core().V1().Nodes()
+		informers = append(informers, nodeInformer.Informer())
+		k.nodeLister = nodeInformer.Lister()
+	}
 
 	kubeController := controller.NewController("kube-plugin-controller",
 		controller.NewLockingSyncHandler(k.updatedOccured),
@@ -89,6 +110,14 @@ func (k *KubePluginListers) EndpointsLister(ns string) kubelisters.EndpointsList
 	return k.endpointsLister[ns]
 }
 
+func (k *KubePluginListers) EndpointSlicesLister(ns string) discoverylisters.EndpointSliceLister {
+	return k.endpointSlicesLister[ns]
+}
+
+func (k *KubePluginListers) NodeLister() kubelisters.NodeLister {
+	return k.nodeLister
+}
+
 func (k *KubePluginListers) Subscribe() <-chan struct{} {
 	k.cacheUpdatedWatchersMutex.Lock()
 	defer k.cacheUpdatedWatchersMutex.Unlock()
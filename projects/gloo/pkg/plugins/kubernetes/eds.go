@@ -10,20 +10,26 @@ import (
 	"github.com/solo-io/gloo/pkg/utils/settingsutil"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes/serviceconverter"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	corecache "github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/errors"
 	kubev1 "k8s.io/api/core/v1"
+	discoveryv1beta1 "k8s.io/api/discovery/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 )
 
+// The label of the nodes with the sub-zone of the pods running on them
+const SubZoneLabel = "topology.gloo.solo.io/subzone"
+
 func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
 
-	kubeFactory := func(namespaces []string) KubePluginSharedFactory {
-		return getInformerFactory(opts.Ctx, p.kube, namespaces)
+	kubeFactory := func(namespaces []string, kubeSettings *v1.Settings_KubernetesConfiguration) KubePluginSharedFactory {
+		return getInformerFactory(opts.Ctx, p.kube, namespaces, kubeSettings)
 	}
 	watcher, err := newEndpointWatcherForUpstreams(kubeFactory, p.kubeCoreCache, writeNamespace, upstreamsToTrack, opts)
 	if err != nil {
@@ -32,7 +38,7 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 	return watcher.watch(writeNamespace, opts)
 }
 
-func newEndpointWatcherForUpstreams(kubeFactoryFactory func(ns []string) KubePluginSharedFactory, kubeCoreCache corecache.KubeCoreCache, writeNamespace string, upstreamsToTrack v1.UpstreamList, opts clients.WatchOpts) (*edsWatcher, error) {
+func newEndpointWatcherForUpstreams(kubeFactoryFactory func(ns []string, kubeSettings *v1.Settings_KubernetesConfiguration) KubePluginSharedFactory, kubeCoreCache corecache.KubeCoreCache, writeNamespace string, upstreamsToTrack v1.UpstreamList, opts clients.WatchOpts) (*edsWatcher, error) {
 	var namespaces []string
 
 	settings := settingsutil.FromContext(opts.Ctx)
@@ -53,14 +59,14 @@ func newEndpointWatcherForUpstreams(kubeFactoryFactory func(ns []string) KubePlu
 		}
 	}
 
-	kubeFactory := kubeFactoryFactory(namespaces)
+	kubeFactory := kubeFactoryFactory(namespaces, settings.GetKubernetes())
 	// this can take a bit of time some make sure we are still in business
 	if opts.Ctx.Err() != nil {
 		return nil, opts.Ctx.Err()
 	}
 	opts = opts.WithDefaults()
 
-	return newEndpointsWatcher(kubeCoreCache, namespaces, kubeFactory, upstreamsToTrack), nil
+	return newEndpointsWatcher(kubeCoreCache, namespaces, kubeFactory, upstreamsToTrack, settings.GetKubernetes()), nil
 }
 
 type edsWatcher struct {
@@ -68,9 +74,13 @@ type edsWatcher struct {
 	kubeShareFactory KubePluginSharedFactory
 	kubeCoreCache    corecache.KubeCoreCache
 	namespaces       []string
+	// whether the endpoints are listed from EndpointSlices rather than Endpoints
+	useEndpointSlices bool
+	// whether the localities of the endpoints are set from the labels of their nodes
+	useNodeLocalities bool
 }
 
-func newEndpointsWatcher(kubeCoreCache corecache.KubeCoreCache, namespaces []string, kubeShareFactory KubePluginSharedFactory, upstreams v1.UpstreamList) *edsWatcher {
+func newEndpointsWatcher(kubeCoreCache corecache.KubeCoreCache, namespaces []string, kubeShareFactory KubePluginSharedFactory, upstreams v1.UpstreamList, kubeSettings *v1.Settings_KubernetesConfiguration) *edsWatcher {
 	upstreamSpecs := make(map[core.ResourceRef]*kubeplugin.UpstreamSpec)
 	for _, us := range upstreams {
 		kubeUpstream, ok := us.UpstreamType.(*v1.Upstream_Kube)
@@ -81,15 +91,18 @@ func newEndpointsWatcher(kubeCoreCache corecache.KubeCoreCache, namespaces []str
 		upstreamSpecs[us.Metadata.Ref()] = kubeUpstream.Kube
 	}
 	return &edsWatcher{
-		upstreams:        upstreamSpecs,
-		kubeShareFactory: kubeShareFactory,
-		kubeCoreCache:    kubeCoreCache,
-		namespaces:       namespaces,
+		upstreams:         upstreamSpecs,
+		kubeShareFactory:  kubeShareFactory,
+		kubeCoreCache:     kubeCoreCache,
+		namespaces:        namespaces,
+		useEndpointSlices: kubeSettings.GetUseEndpointSlices(),
+		useNodeLocalities: kubeSettings.GetUseNodeLocalities(),
 	}
 }
 
 func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.EndpointList, error) {
 	var endpointList []*kubev1.Endpoints
+	var endpointSliceList []*discoveryv1beta1.EndpointSlice
 	var serviceList []*kubev1.Service
 	var podList []*kubev1.Pod
 	ctx := contextutils.WithLogger(opts.Ctx, "kubernetes_eds")
@@ -112,13 +125,32 @@ func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.Endp
 		}
 		podList = append(podList, pods...)
 
+		if c.useEndpointSlices {
+			endpointSlices, err := c.kubeShareFactory.EndpointSlicesLister(ns).List(labels.SelectorFromSet(opts.Selector))
+			if err != nil {
+				return nil, err
+			}
+			endpointSliceList = append(endpointSliceList, endpointSlices...)
+			continue
+		}
 		endpoints, err := c.kubeShareFactory.EndpointsLister(ns).List(labels.SelectorFromSet(opts.Selector))
 		if err != nil {
 			return nil, err
 		}
 		endpointList = append(endpointList, endpoints...)
 	}
-	return filterEndpoints(ctx, writeNamespace, endpointList, serviceList, podList, c.upstreams), nil
+	var localities map[string]*v1.Locality
+	if c.useEndpointSlices {
+		endpointList, localities = endpointsFromSlices(endpointSliceList)
+	}
+	if c.useNodeLocalities {
+		nodes, err := c.kubeShareFactory.NodeLister().List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		localities = nodeLocalities(endpointList, nodes, localities)
+	}
+	return filterEndpoints(ctx, writeNamespace, endpointList, localities, serviceList, podList, c.upstreams), nil
 }
 
 func (c *edsWatcher) watch(writeNamespace string, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
@@ -164,7 +196,8 @@ func (c *edsWatcher) watch(writeNamespace string, opts clients.WatchOpts) (<-cha
 	return endpointsChan, errs, nil
 }
 
-func filterEndpoints(ctx context.Context, writeNamespace string, kubeEndpoints []*kubev1.Endpoints,
+// localities are the localities of the endpoint addresses by IP, if known
+func filterEndpoints(ctx context.Context, writeNamespace string, kubeEndpoints []*kubev1.Endpoints, localities map[string]*v1.Locality,
 	services []*kubev1.Service, pods []*kubev1.Pod, upstreams map[core.ResourceRef]*kubeplugin.UpstreamSpec) v1.EndpointList {
 	var endpoints v1.EndpointList
 
@@ -228,6 +261,7 @@ func filterEndpoints(ctx context.Context, writeNamespace string, kubeEndpoints [
 					logger.Warnf("upstream %v: port %v not found for service %v in endpoint %v", usRef.Key(), spec.ServicePort, spec.ServiceName, subset)
 					continue
 				}
+				// only the ready addresses are used, and pods are only ready once their readiness gates pass
 				for _, addr := range subset.Addresses {
 					var podName, podNamespace string
 					targetRef := addr.TargetRef
@@ -278,7 +312,7 @@ func filterEndpoints(ctx context.Context, writeNamespace string, kubeEndpoints [
 		}, addr.Address)
 		endpointName := fmt.Sprintf("ep-%v-%v-%x", dnsname, addr.Port, hasher.Sum64())
 		pod, _ := getPodForIp(addr.Address, addr.PodName, addr.PodNamespace, pods)
-		ep := createEndpoint(writeNamespace, endpointName, refs, addr.Address, addr.Port, pod)
+		ep := createEndpoint(writeNamespace, endpointName, refs, addr.Address, addr.Port, pod, localities[addr.Address])
 		endpoints = append(endpoints, ep)
 	}
 
@@ -288,7 +322,7 @@ func filterEndpoints(ctx context.Context, writeNamespace string, kubeEndpoints [
 	return endpoints
 }
 
-func createEndpoint(namespace, name string, upstreams []*core.ResourceRef, address string, port uint32, pod *kubev1.Pod) *v1.Endpoint {
+func createEndpoint(namespace, name string, upstreams []*core.ResourceRef, address string, port uint32, pod *kubev1.Pod, locality *v1.Locality) *v1.Endpoint {
 	ep := &v1.Endpoint{
 		Metadata: core.Metadata{
 			Namespace: namespace,
@@ -297,11 +331,16 @@ func createEndpoint(namespace, name string, upstreams []*core.ResourceRef, addre
 		Upstreams: upstreams,
 		Address:   address,
 		Port:      port,
-		// TODO: add locality info
+		Locality:  locality,
 	}
 
 	if pod != nil {
-		ep.Metadata.Labels = pod.Labels
+		// the labels of the pod are copied, as they are shared with the cache of pods
+		ep.Metadata.Labels = make(map[string]string, len(pod.Labels)+1)
+		for k, v := range pod.Labels {
+			ep.Metadata.Labels[k] = v
+		}
+		ep.Metadata.Labels[serviceconverter.PodNameLabel] = pod.Name
 	}
 	return ep
 }
@@ -339,3 +378,127 @@ func getPodForIp(ip string, podName, podNamespace string, pods []*kubev1.Pod) (*
 
 	return nil, errors.Errorf("running pod not found with ip %v", ip)
 }
+
+// Converts EndpointSlices to the Endpoints of their services, so that they are filtered like Endpoints.
+// Also returns the localities of the addresses by IP, from the topology of the endpoints.
+func endpointsFromSlices(endpointSlices []*discoveryv1beta1.EndpointSlice) ([]*kubev1.Endpoints, map[string]*v1.Locality) {
+	var endpointsList []*kubev1.Endpoints
+	endpointsByService := make(map[types.NamespacedName]*kubev1.Endpoints)
+	localities := make(map[string]*v1.Locality)
+	for _, slice := range endpointSlices {
+		serviceName := slice.Labels[discoveryv1beta1.LabelServiceName]
+		// envoy connects to IP addresses, and not every slice belongs to a service
+		if serviceName == "" || slice.AddressType == discoveryv1beta1.AddressTypeFQDN {
+			continue
+		}
+
+		service := types.NamespacedName{Namespace: slice.Namespace, Name: serviceName}
+		endpoints, ok := endpointsByService[service]
+		if !ok {
+			endpoints = &kubev1.Endpoints{
+				ObjectMeta: metav1.ObjectMeta{Namespace: service.Namespace, Name: service.Name},
+			}
+			endpointsByService[service] = endpoints
+			endpointsList = append(endpointsList, endpoints)
+		}
+
+		var subset kubev1.EndpointSubset
+		for _, port := range slice.Ports {
+			endpointPort := kubev1.EndpointPort{}
+			if port.Name != nil {
+				endpointPort.Name = *port.Name
+			}
+			if port.Port != nil {
+				endpointPort.Port = *port.Port
+			}
+			if port.Protocol != nil {
+				endpointPort.Protocol = *port.Protocol
+			}
+			subset.Ports = append(subset.Ports, endpointPort)
+		}
+		for _, endpoint := range slice.Endpoints {
+			// endpoints with an unknown condition are considered ready
+			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+				continue
+			}
+			locality := topologyLocality(endpoint.Topology)
+			for _, ip := range endpoint.Addresses {
+				subset.Addresses = append(subset.Addresses, kubev1.EndpointAddress{
+					IP:        ip,
+					NodeName:  nodeName(endpoint.Topology),
+					TargetRef: endpoint.TargetRef,
+				})
+				if locality != nil {
+					localities[ip] = locality
+				}
+			}
+		}
+		endpoints.Subsets = append(endpoints.Subsets, subset)
+	}
+	return endpointsList, localities
+}
+
+func topologyLocality(topology map[string]string) *v1.Locality {
+	region, zone := topology[kubev1.LabelZoneRegionStable], topology[kubev1.LabelZoneFailureDomainStable]
+	if region == "" && zone == "" {
+		return nil
+	}
+	return &v1.Locality{
+		Region: region,
+		Zone:   zone,
+	}
+}
+
+func nodeName(topology map[string]string) *string {
+	if name, ok := topology[kubev1.LabelHostname]; ok {
+		return &name
+	}
+	return nil
+}
+
+// Sets the localities of the addresses of the endpoints from the labels of the nodes they are on,
+// in place of the localities they already have.
+func nodeLocalities(kubeEndpoints []*kubev1.Endpoints, nodes []*kubev1.Node, localities map[string]*v1.Locality) map[string]*v1.Locality {
+	localitiesByNode := make(map[string]*v1.Locality, len(nodes))
+	for _, node := range nodes {
+		if locality := nodeLocality(node.Labels); locality != nil {
+			localitiesByNode[node.Name] = locality
+		}
+	}
+	if localities == nil {
+		localities = make(map[string]*v1.Locality)
+	}
+	for _, endpoints := range kubeEndpoints {
+		for _, subset := range endpoints.Subsets {
+			for _, addr := range subset.Addresses {
+				if addr.NodeName == nil {
+					continue
+				}
+				if locality, ok := localitiesByNode[*addr.NodeName]; ok {
+					localities[addr.IP] = locality
+				}
+			}
+		}
+	}
+	return localities
+}
+
+func nodeLocality(nodeLabels map[string]string) *v1.Locality {
+	region := nodeLabels[kubev1.LabelZoneRegionStable]
+	if region == "" {
+		region = nodeLabels[kubev1.LabelZoneRegion]
+	}
+	zone := nodeLabels[kubev1.LabelZoneFailureDomainStable]
+	if zone == "" {
+		zone = nodeLabels[kubev1.LabelZoneFailureDomain]
+	}
+	subZone := nodeLabels[SubZoneLabel]
+	if region == "" && zone == "" && subZone == "" {
+		return nil
+	}
+	return &v1.Locality{
+		Region:  region,
+		Zone:    zone,
+		SubZone: subZone,
+	}
+}
@@ -4,12 +4,20 @@ import (
 	"context"
 
 	"github.com/golang/mock/gomock"
+	"github.com/solo-io/gloo/pkg/utils"
 	"github.com/solo-io/gloo/pkg/utils/settingsutil"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	kubev1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
 	mock_kubernetes "github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes/mocks"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes/serviceconverter"
 	mock_cache "github.com/solo-io/gloo/test/mocks/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1beta1 "k8s.io/api/discovery/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1beta1"
+	"k8s.io/client-go/tools/cache"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -35,6 +43,14 @@ var _ = Describe("Eds", func() {
 		controller.Finish()
 	})
 
+	newIndexer := func(objects ...interface{}) cache.Indexer {
+		indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		for _, obj := range objects {
+			Expect(indexer.Add(obj)).To(Succeed())
+		}
+		return indexer
+	}
+
 	It("should ignore upstreams in non watched namesapces", func() {
 		up := v1.NewUpstream("foo", "name")
 		up.UpstreamType = &v1.Upstream_Kube{
@@ -47,11 +63,223 @@ var _ = Describe("Eds", func() {
 
 		mockCache.EXPECT().NamespacedServiceLister("bar").Return(nil)
 
-		watcher, err := newEndpointWatcherForUpstreams(func([]string) KubePluginSharedFactory { return mockSharedFactory }, mockCache, "foo", upstreamsToTrack, clients.WatchOpts{Ctx: ctx})
+		watcher, err := newEndpointWatcherForUpstreams(func([]string, *v1.Settings_KubernetesConfiguration) KubePluginSharedFactory { return mockSharedFactory }, mockCache, "foo", upstreamsToTrack, clients.WatchOpts{Ctx: ctx})
 		Expect(err).NotTo(HaveOccurred())
 		watcher.List("foo", clients.ListOpts{Ctx: ctx})
 		Expect(func() {}).NotTo(Panic())
 
 	})
 
+	It("should list the endpoints of EndpointSlices with their locality", func() {
+		ctx = settingsutil.WithSettings(ctx, &v1.Settings{
+			WatchNamespaces: []string{"foo"},
+			Kubernetes:      &v1.Settings_KubernetesConfiguration{UseEndpointSlices: true},
+		})
+		up := v1.NewUpstream("gloo-system", "foo-svc-80")
+		up.UpstreamType = &v1.Upstream_Kube{
+			Kube: &kubev1.UpstreamSpec{
+				ServiceName:      "svc",
+				ServiceNamespace: "foo",
+				ServicePort:      80,
+			},
+		}
+		service := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "svc"},
+			Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{
+				{Name: "http", Port: 80},
+				{Name: "grpc", Port: 9090},
+			}},
+		}
+		portName, port := "http", int32(8080)
+		ready, notReady := true, false
+		slice := &discoveryv1beta1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo",
+				Name:      "svc-abcde",
+				Labels:    map[string]string{discoveryv1beta1.LabelServiceName: "svc"},
+			},
+			AddressType: discoveryv1beta1.AddressTypeIPv4,
+			Ports:       []discoveryv1beta1.EndpointPort{{Name: &portName, Port: &port}},
+			Endpoints: []discoveryv1beta1.Endpoint{
+				{
+					Addresses:  []string{"10.0.0.1"},
+					Conditions: discoveryv1beta1.EndpointConditions{Ready: &ready},
+					Topology: map[string]string{
+						corev1.LabelZoneRegionStable:        "us-east-1",
+						corev1.LabelZoneFailureDomainStable: "us-east-1a",
+					},
+				},
+				{
+					Addresses: []string{"10.0.0.2"},
+				},
+				{
+					Addresses:  []string{"10.0.0.3"},
+					Conditions: discoveryv1beta1.EndpointConditions{Ready: &notReady},
+				},
+			},
+		}
+		fqdnSlice := &discoveryv1beta1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo",
+				Name:      "svc-fghij",
+				Labels:    map[string]string{discoveryv1beta1.LabelServiceName: "svc"},
+			},
+			AddressType: discoveryv1beta1.AddressTypeFQDN,
+			Ports:       []discoveryv1beta1.EndpointPort{{Name: &portName, Port: &port}},
+			Endpoints:   []discoveryv1beta1.Endpoint{{Addresses: []string{"svc.example.com"}}},
+		}
+
+		mockCache.EXPECT().NamespacedServiceLister("foo").Return(corelisters.NewServiceLister(newIndexer(service)).Services("foo")).AnyTimes()
+		mockCache.EXPECT().NamespacedPodLister("foo").Return(corelisters.NewPodLister(newIndexer()).Pods("foo"))
+		mockSharedFactory.EXPECT().EndpointSlicesLister("foo").Return(discoverylisters.NewEndpointSliceLister(newIndexer(slice, fqdnSlice)))
+
+		var watchesEndpointSlices bool
+		watcher, err := newEndpointWatcherForUpstreams(func(_ []string, kubeSettings *v1.Settings_KubernetesConfiguration) KubePluginSharedFactory {
+			watchesEndpointSlices = kubeSettings.GetUseEndpointSlices()
+			return mockSharedFactory
+		}, mockCache, "gloo-system", v1.UpstreamList{up}, clients.WatchOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(watchesEndpointSlices).To(BeTrue())
+
+		endpoints, err := watcher.List("gloo-system", clients.ListOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpoints).To(HaveLen(2))
+		byAddress := map[string]*v1.Endpoint{}
+		for _, endpoint := range endpoints {
+			Expect(endpoint.Port).To(BeEquivalentTo(8080))
+			Expect(endpoint.Upstreams).To(ConsistOf(utils.ResourceRefPtr(up.Metadata.Ref())))
+			byAddress[endpoint.Address] = endpoint
+		}
+		Expect(byAddress).To(HaveKey("10.0.0.1"))
+		Expect(byAddress["10.0.0.1"].Locality).To(Equal(&v1.Locality{Region: "us-east-1", Zone: "us-east-1a"}))
+		Expect(byAddress).To(HaveKey("10.0.0.2"))
+		Expect(byAddress["10.0.0.2"].Locality).To(BeNil())
+	})
+
+	It("should label the endpoints of headless services with the names of their pods", func() {
+		up := v1.NewUpstream("gloo-system", "foo-db-5432")
+		up.UpstreamType = &v1.Upstream_Kube{
+			Kube: &kubev1.UpstreamSpec{
+				ServiceName:      "db",
+				ServiceNamespace: "foo",
+				ServicePort:      5432,
+			},
+		}
+		service := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "db"},
+			Spec: corev1.ServiceSpec{
+				ClusterIP: corev1.ClusterIPNone,
+				Ports:     []corev1.ServicePort{{Name: "postgres", Port: 5432}},
+			},
+		}
+		newPod := func(name, ip string) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: name, Labels: map[string]string{"app": "db"}},
+				Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIP: ip},
+			}
+		}
+		pod0, pod1 := newPod("db-0", "10.0.0.1"), newPod("db-1", "10.0.0.2")
+		endpoints := &corev1.Endpoints{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "db"},
+			Subsets: []corev1.EndpointSubset{{
+				Addresses: []corev1.EndpointAddress{
+					{IP: "10.0.0.1", TargetRef: &corev1.ObjectReference{Kind: "Pod", Namespace: "foo", Name: "db-0"}},
+					{IP: "10.0.0.2", TargetRef: &corev1.ObjectReference{Kind: "Pod", Namespace: "foo", Name: "db-1"}},
+				},
+				Ports: []corev1.EndpointPort{{Name: "postgres", Port: 5432}},
+			}},
+		}
+
+		mockCache.EXPECT().NamespacedServiceLister("foo").Return(corelisters.NewServiceLister(newIndexer(service)).Services("foo")).AnyTimes()
+		mockCache.EXPECT().NamespacedPodLister("foo").Return(corelisters.NewPodLister(newIndexer(pod0, pod1)).Pods("foo"))
+		mockSharedFactory.EXPECT().EndpointsLister("foo").Return(corelisters.NewEndpointsLister(newIndexer(endpoints)))
+
+		watcher, err := newEndpointWatcherForUpstreams(func([]string, *v1.Settings_KubernetesConfiguration) KubePluginSharedFactory {
+			return mockSharedFactory
+		}, mockCache, "gloo-system", v1.UpstreamList{up}, clients.WatchOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		list, err := watcher.List("gloo-system", clients.ListOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		podNames := map[string]string{}
+		for _, endpoint := range list {
+			Expect(endpoint.Metadata.Labels).To(HaveKeyWithValue("app", "db"))
+			podNames[endpoint.Address] = endpoint.Metadata.Labels[serviceconverter.PodNameLabel]
+		}
+		Expect(podNames).To(Equal(map[string]string{
+			"10.0.0.1": "db-0",
+			"10.0.0.2": "db-1",
+		}))
+		// the labels of the cached pods are left untouched
+		Expect(pod0.Labels).NotTo(HaveKey(serviceconverter.PodNameLabel))
+	})
+
+	It("should set the locality of the endpoints from the labels of their nodes", func() {
+		ctx = settingsutil.WithSettings(ctx, &v1.Settings{
+			WatchNamespaces: []string{"foo"},
+			Kubernetes:      &v1.Settings_KubernetesConfiguration{UseNodeLocalities: true},
+		})
+		up := v1.NewUpstream("gloo-system", "foo-svc-80")
+		up.UpstreamType = &v1.Upstream_Kube{
+			Kube: &kubev1.UpstreamSpec{
+				ServiceName:      "svc",
+				ServiceNamespace: "foo",
+				ServicePort:      80,
+			},
+		}
+		service := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "svc"},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "http", Port: 80}}},
+		}
+		nodeA, nodeB, nodeC := "node-a", "node-b", "node-c"
+		endpoints := &corev1.Endpoints{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "svc"},
+			Subsets: []corev1.EndpointSubset{{
+				Addresses: []corev1.EndpointAddress{
+					{IP: "10.0.0.1", NodeName: &nodeA},
+					{IP: "10.0.0.2", NodeName: &nodeB},
+					{IP: "10.0.0.3", NodeName: &nodeC},
+					{IP: "10.0.0.4"},
+				},
+				NotReadyAddresses: []corev1.EndpointAddress{{IP: "10.0.0.5", NodeName: &nodeA}},
+				Ports:             []corev1.EndpointPort{{Name: "http", Port: 8080}},
+			}},
+		}
+		nodes := []interface{}{
+			&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeA, Labels: map[string]string{
+				corev1.LabelZoneRegionStable:        "us-east-1",
+				corev1.LabelZoneFailureDomainStable: "us-east-1a",
+				SubZoneLabel:                        "rack-1",
+			}}},
+			&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeB, Labels: map[string]string{
+				corev1.LabelZoneRegion:        "us-east-1",
+				corev1.LabelZoneFailureDomain: "us-east-1b",
+			}}},
+			&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeC}},
+		}
+
+		mockCache.EXPECT().NamespacedServiceLister("foo").Return(corelisters.NewServiceLister(newIndexer(service)).Services("foo")).AnyTimes()
+		mockCache.EXPECT().NamespacedPodLister("foo").Return(corelisters.NewPodLister(newIndexer()).Pods("foo"))
+		mockSharedFactory.EXPECT().EndpointsLister("foo").Return(corelisters.NewEndpointsLister(newIndexer(endpoints)))
+		mockSharedFactory.EXPECT().NodeLister().Return(corelisters.NewNodeLister(newIndexer(nodes...)))
+
+		watcher, err := newEndpointWatcherForUpstreams(func([]string, *v1.Settings_KubernetesConfiguration) KubePluginSharedFactory {
+			return mockSharedFactory
+		}, mockCache, "gloo-system", v1.UpstreamList{up}, clients.WatchOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		list, err := watcher.List("gloo-system", clients.ListOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		localities := map[string]*v1.Locality{}
+		for _, endpoint := range list {
+			localities[endpoint.Address] = endpoint.Locality
+		}
+		Expect(localities).To(Equal(map[string]*v1.Locality{
+			"10.0.0.1": {Region: "us-east-1", Zone: "us-east-1a", SubZone: "rack-1"},
+			"10.0.0.2": {Region: "us-east-1", Zone: "us-east-1b"},
+			"10.0.0.3": nil,
+			"10.0.0.4": nil,
+		}))
+	})
+
 })
@@ -9,6 +9,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	v1 "k8s.io/client-go/listers/core/v1"
+	v1beta1 "k8s.io/client-go/listers/discovery/v1beta1"
 )
 
 // MockKubePluginSharedFactory is a mock of KubePluginSharedFactory interface
@@ -34,6 +35,20 @@ func (m *MockKubePluginSharedFactory) EXPECT() *MockKubePluginSharedFactoryMockR
 	return m.recorder
 }
 
+// EndpointSlicesLister mocks base method
+func (m *MockKubePluginSharedFactory) EndpointSlicesLister(arg0 string) v1beta1.EndpointSliceLister {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndpointSlicesLister", arg0)
+	ret0, _ := ret[0].(v1beta1.EndpointSliceLister)
+	return ret0
+}
+
+// EndpointSlicesLister indicates an expected call of EndpointSlicesLister
+func (mr *MockKubePluginSharedFactoryMockRecorder) EndpointSlicesLister(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndpointSlicesLister", reflect.TypeOf((*MockKubePluginSharedFactory)(nil).EndpointSlicesLister), arg0)
+}
+
 // EndpointsLister mocks base method
 func (m *MockKubePluginSharedFactory) EndpointsLister(arg0 string) v1.EndpointsLister {
 	m.ctrl.T.Helper()
@@ -48,6 +63,20 @@ func (mr *MockKubePluginSharedFactoryMockRecorder) EndpointsLister(arg0 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndpointsLister", reflect.TypeOf((*MockKubePluginSharedFactory)(nil).EndpointsLister), arg0)
 }
 
+// NodeLister mocks base method
+func (m *MockKubePluginSharedFactory) NodeLister() v1.NodeLister {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NodeLister")
+	ret0, _ := ret[0].(v1.NodeLister)
+	return ret0
+}
+
+// NodeLister indicates an expected call of NodeLister
+func (mr *MockKubePluginSharedFactoryMockRecorder) NodeLister() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeLister", reflect.TypeOf((*MockKubePluginSharedFactory)(nil).NodeLister))
+}
+
 // Subscribe mocks base method
 func (m *MockKubePluginSharedFactory) Subscribe() <-chan struct{} {
 	m.ctrl.T.Helper()
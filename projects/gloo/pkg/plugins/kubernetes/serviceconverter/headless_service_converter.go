@@ -0,0 +1,30 @@
+package serviceconverter
+
+import (
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	kubev1 "k8s.io/api/core/v1"
+)
+
+func init() {
+	DefaultServiceConverters = append(DefaultServiceConverters, &HeadlessServiceConverter{})
+}
+
+// the label of the endpoints of kubernetes upstreams with the name of their pod
+const PodNameLabel = "gloo.solo.io/pod-name"
+
+// routes to the upstreams of headless services can select a single pod, e.g. a replica of a StatefulSet,
+// with a subset of the pod name label
+type HeadlessServiceConverter struct{}
+
+func (h *HeadlessServiceConverter) ConvertService(svc *kubev1.Service, port kubev1.ServicePort, us *v1.Upstream) error {
+	if svc.Spec.ClusterIP != kubev1.ClusterIPNone {
+		return nil
+	}
+	us.GetKube().SubsetSpec = &options.SubsetSpec{
+		Selectors: []*options.Selector{{
+			Keys: []string{PodNameLabel},
+		}},
+	}
+	return nil
+}
@@ -15,8 +15,13 @@ import (
 )
 
 const (
-	discoveryAnnotationKey  = "gloo.solo.io/discover"
-	discoveryAnnotationTrue = "true"
+	discoveryAnnotationKey   = "gloo.solo.io/discover"
+	discoveryAnnotationTrue  = "true"
+	discoveryAnnotationFalse = "false"
+
+	// services with this annotation set to `false` are not discovered,
+	// and services with it set to `true` are discovered regardless of the discovery settings
+	discoveryEnabledAnnotationKey = "discovery.solo.io/enabled"
 )
 
 func (p *plugin) DiscoverUpstreams(watchNamespaces []string, writeNamespace string, opts clients.WatchOpts, discOpts discovery.Opts) (chan v1.UpstreamList, chan error, error) {
@@ -74,6 +79,8 @@ func (p *plugin) DiscoverUpstreams(watchNamespaces []string, writeNamespace stri
 
 func (p *plugin) ConvertServices(ctx context.Context, watchNamespaces []string, services []*kubev1.Service, opts discovery.Opts, writeNamespace string) v1.UpstreamList {
 	var upstreams v1.UpstreamList
+	// the templated names of the upstreams, in the same order
+	var names []string
 	for _, svc := range services {
 		if skip(svc, opts) {
 			continue
@@ -88,9 +95,11 @@ func (p *plugin) ConvertServices(ctx context.Context, watchNamespaces []string,
 		upstreamsToCreate := p.UpstreamConverter.UpstreamsForService(ctx, svc)
 		for _, u := range upstreamsToCreate {
 			u.Metadata.Namespace = writeNamespace
+			names = append(names, applyUpstreamTemplates(ctx, svc, u, opts))
 		}
 
 		upstreams = append(upstreams, upstreamsToCreate...)
 	}
+	renameUpstreams(ctx, upstreams, names)
 	return upstreams
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/solo-io/solo-kit/pkg/errors"
 	"github.com/solo-io/solo-kit/pkg/utils/kubeutils"
 	kubev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 type UpstreamConverter interface {
@@ -91,6 +92,13 @@ func containsString(s string, slice []string) bool {
 }
 
 func skip(svc *kubev1.Service, opts discovery.Opts) bool {
+	// the enabled annotation takes precedence over the filters of the discovery settings
+	switch svc.ObjectMeta.Annotations[discoveryEnabledAnnotationKey] {
+	case discoveryAnnotationTrue:
+		return false
+	case discoveryAnnotationFalse:
+		return true
+	}
 	// ilackarms: allow user to override the skip with an annotation
 	// force discovery for a service with no selector
 	if svc.ObjectMeta.Annotations[discoveryAnnotationKey] == discoveryAnnotationTrue {
@@ -102,6 +110,15 @@ func skip(svc *kubev1.Service, opts discovery.Opts) bool {
 			return true
 		}
 	}
+	if len(opts.KubeOpts.IncludedNamespaces) > 0 && !containsString(svc.Namespace, opts.KubeOpts.IncludedNamespaces) {
+		return true
+	}
+	if containsString(svc.Namespace, opts.KubeOpts.ExcludedNamespaces) {
+		return true
+	}
+	if opts.KubeOpts.Selector != nil && !opts.KubeOpts.Selector.Matches(labels.Set(svc.Labels)) {
+		return true
+	}
 	return false
 }
 
@@ -122,6 +139,10 @@ func UpdateUpstream(original, desired *v1.Upstream) (didChange bool, err error)
 	desiredSpec.Kube.ServiceSpec = originalSpec.Kube.ServiceSpec
 	// copy labels; user may have written them over. cannot be auto-discovered
 	desiredSpec.Kube.Selector = originalSpec.Kube.Selector
+	// keep the subsets the user configured in place of the discovered ones
+	if originalSpec.Kube.SubsetSpec != nil {
+		desiredSpec.Kube.SubsetSpec = originalSpec.Kube.SubsetSpec
+	}
 
 	utils.UpdateUpstream(original, desired)
 
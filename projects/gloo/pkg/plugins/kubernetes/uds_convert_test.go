@@ -3,10 +3,13 @@ package kubernetes
 import (
 	"context"
 	"strings"
+	"text/template"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes/serviceconverter"
 
@@ -31,6 +34,37 @@ var _ = Describe("UdsConvert", func() {
 		Expect(name).ToNot(Equal(name2))
 	})
 
+	Context("headless services", func() {
+		It("should create upstreams with a subset of the pod name for headless services", func() {
+			svc := &kubev1.Service{
+				Spec: kubev1.ServiceSpec{ClusterIP: kubev1.ClusterIPNone},
+			}
+			svc.Name = "test"
+			svc.Namespace = "test"
+
+			port := kubev1.ServicePort{
+				Port: 123,
+			}
+			up := createUpstream(context.TODO(), svc, port)
+			Expect(up.GetKube().GetSubsetSpec().GetSelectors()).To(HaveLen(1))
+			Expect(up.GetKube().GetSubsetSpec().GetSelectors()[0].GetKeys()).To(Equal([]string{serviceconverter.PodNameLabel}))
+		})
+
+		It("should not create upstreams with subsets for services with a cluster ip", func() {
+			svc := &kubev1.Service{
+				Spec: kubev1.ServiceSpec{ClusterIP: "10.96.0.10"},
+			}
+			svc.Name = "test"
+			svc.Namespace = "test"
+
+			port := kubev1.ServicePort{
+				Port: 123,
+			}
+			up := createUpstream(context.TODO(), svc, port)
+			Expect(up.GetKube().GetSubsetSpec()).To(BeNil())
+		})
+	})
+
 	Context("h2 upstream", func() {
 		It("should not normally create upstream with grpc service spec", func() {
 			svc := &kubev1.Service{
@@ -169,4 +203,143 @@ var _ = Describe("UdsConvert", func() {
 			}, nil),
 		)
 	})
+
+	Context("discovery filters", func() {
+		var (
+			p        *plugin
+			services []*kubev1.Service
+		)
+
+		service := func(namespace, name string, labels, annotations map[string]string) *kubev1.Service {
+			svc := &kubev1.Service{
+				Spec: kubev1.ServiceSpec{
+					Ports: []kubev1.ServicePort{{Port: 123}},
+				},
+			}
+			svc.Name = name
+			svc.Namespace = namespace
+			svc.Labels = labels
+			svc.Annotations = annotations
+			return svc
+		}
+
+		discoveredNames := func(opts discovery.Opts) []string {
+			var names []string
+			for _, us := range p.ConvertServices(context.TODO(), nil, services, opts, "gloo-system") {
+				names = append(names, us.GetKube().GetServiceName())
+			}
+			return names
+		}
+
+		BeforeEach(func() {
+			p = &plugin{UpstreamConverter: DefaultUpstreamConverter()}
+			services = []*kubev1.Service{
+				service("default", "web", map[string]string{"app": "web"}, nil),
+				service("default", "cache", map[string]string{"app": "cache"}, nil),
+				service("kube-system", "dns", map[string]string{"app": "dns"}, nil),
+				service("kube-system", "forced", nil, map[string]string{discoveryEnabledAnnotationKey: "true"}),
+				service("default", "disabled", nil, map[string]string{discoveryEnabledAnnotationKey: "false"}),
+			}
+		})
+
+		It("should discover all services without the disabled annotation by default", func() {
+			Expect(discoveredNames(discovery.Opts{})).To(ConsistOf("web", "cache", "dns", "forced"))
+		})
+
+		It("should only discover the services of the included namespaces", func() {
+			var opts discovery.Opts
+			opts.KubeOpts.IncludedNamespaces = []string{"default"}
+			Expect(discoveredNames(opts)).To(ConsistOf("web", "cache", "forced"))
+		})
+
+		It("should not discover the services of the excluded namespaces", func() {
+			var opts discovery.Opts
+			opts.KubeOpts.ExcludedNamespaces = []string{"kube-system"}
+			Expect(discoveredNames(opts)).To(ConsistOf("web", "cache", "forced"))
+		})
+
+		It("should only discover the services matching the label selector", func() {
+			selector, err := labels.Parse("app,app notin (cache)")
+			Expect(err).NotTo(HaveOccurred())
+			var opts discovery.Opts
+			opts.KubeOpts.Selector = selector
+			Expect(discoveredNames(opts)).To(ConsistOf("web", "dns", "forced"))
+		})
+	})
+
+	Context("upstream templates", func() {
+		var (
+			p        *plugin
+			services []*kubev1.Service
+			opts     discovery.Opts
+		)
+
+		service := func(namespace, name string, labels map[string]string, ports ...kubev1.ServicePort) *kubev1.Service {
+			svc := &kubev1.Service{
+				Spec: kubev1.ServiceSpec{
+					Ports: ports,
+				},
+			}
+			svc.Name = name
+			svc.Namespace = namespace
+			svc.Labels = labels
+			return svc
+		}
+
+		parse := func(text string) *template.Template {
+			tmpl, err := ParseUpstreamTemplate("test", text)
+			Expect(err).NotTo(HaveOccurred())
+			return tmpl
+		}
+
+		upstreamNames := func() []string {
+			var names []string
+			for _, us := range p.ConvertServices(context.TODO(), nil, services, opts, "gloo-system") {
+				names = append(names, us.Metadata.Name)
+			}
+			return names
+		}
+
+		BeforeEach(func() {
+			p = &plugin{UpstreamConverter: DefaultUpstreamConverter()}
+			opts = discovery.Opts{}
+			services = []*kubev1.Service{
+				service("default", "web", map[string]string{"team": "Frontend"},
+					kubev1.ServicePort{Name: "http", Port: 80}, kubev1.ServicePort{Name: "grpc", Port: 9090}),
+				service("apps", "api", nil, kubev1.ServicePort{Name: "http", Port: 8080}),
+			}
+		})
+
+		It("should use the default names without a template", func() {
+			Expect(upstreamNames()).To(Equal([]string{"default-web-80", "default-web-9090", "apps-api-8080"}))
+		})
+
+		It("should render the sanitized names of the upstreams", func() {
+			opts.KubeOpts.UpstreamNameTemplate = parse("{{.Name}}-{{.PortName}}.{{.Namespace}}")
+			Expect(upstreamNames()).To(Equal([]string{"web-http-default", "web-grpc-default", "api-http-apps"}))
+		})
+
+		It("should keep the default names of upstreams whose templated names collide", func() {
+			opts.KubeOpts.UpstreamNameTemplate = parse("{{.Name}}")
+			Expect(upstreamNames()).To(Equal([]string{"default-web-80", "default-web-9090", "api"}))
+		})
+
+		It("should keep the default names of upstreams whose templated names are empty", func() {
+			opts.KubeOpts.UpstreamNameTemplate = parse("{{.Labels.missing}}")
+			Expect(upstreamNames()).To(Equal([]string{"default-web-80", "default-web-9090", "apps-api-8080"}))
+		})
+
+		It("should add the templated labels to the upstreams", func() {
+			opts.KubeOpts.UpstreamLabelTemplates = map[string]*template.Template{
+				"team":    parse("{{.Labels.team}}"),
+				"port":    parse("{{.PortName}}"),
+				"invalid": parse("{{.Namespace}}/{{.Name}}"),
+			}
+			upstreams := p.ConvertServices(context.TODO(), nil, services, opts, "gloo-system")
+			Expect(upstreams).To(HaveLen(3))
+			Expect(upstreams[0].Metadata.Labels).To(Equal(map[string]string{"team": "Frontend", "port": "http"}))
+			Expect(upstreams[1].Metadata.Labels).To(Equal(map[string]string{"team": "Frontend", "port": "grpc"}))
+			Expect(upstreams[2].Metadata.Labels).To(Equal(map[string]string{"team": "", "port": "http"}))
+		})
+	})
 })
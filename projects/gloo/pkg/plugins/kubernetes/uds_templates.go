@@ -0,0 +1,116 @@
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"text/template"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/go-utils/contextutils"
+	sanitizer "github.com/solo-io/go-utils/kubeutils"
+	kubev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// the data the templates of the names and labels of discovered upstreams are executed with
+type upstreamTemplateData struct {
+	Namespace   string
+	Name        string
+	Labels      map[string]string
+	Annotations map[string]string
+	Port        int32
+	PortName    string
+}
+
+// Parses a template of the name or of a label of discovered upstreams.
+// Missing labels and annotations of services render as empty strings.
+func ParseUpstreamTemplate(name, text string) (*template.Template, error) {
+	return template.New(name).Option("missingkey=zero").Parse(text)
+}
+
+// adds the templated labels to the upstream and returns its templated name,
+// which is empty if there is no name template or it fails to render
+func applyUpstreamTemplates(ctx context.Context, svc *kubev1.Service, us *v1.Upstream, opts discovery.Opts) string {
+	nameTemplate := opts.KubeOpts.UpstreamNameTemplate
+	labelTemplates := opts.KubeOpts.UpstreamLabelTemplates
+	if nameTemplate == nil && len(labelTemplates) == 0 {
+		return ""
+	}
+	logger := contextutils.LoggerFrom(ctx)
+
+	data := upstreamTemplateData{
+		Namespace:   svc.Namespace,
+		Name:        svc.Name,
+		Labels:      svc.Labels,
+		Annotations: svc.Annotations,
+		Port:        int32(us.GetKube().GetServicePort()),
+	}
+	for _, port := range svc.Spec.Ports {
+		if port.Port == data.Port {
+			data.PortName = port.Name
+		}
+	}
+
+	for key, labelTemplate := range labelTemplates {
+		value, err := renderUpstreamTemplate(labelTemplate, data)
+		if err != nil {
+			logger.Warnw("not adding label to discovered upstream", "upstream", us.Metadata.Name, "label", key, "error", err)
+			continue
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			logger.Warnw("not adding invalid label to discovered upstream", "upstream", us.Metadata.Name,
+				"label", key, "value", value, "error", strings.Join(errs, "; "))
+			continue
+		}
+		if us.Metadata.Labels == nil {
+			us.Metadata.Labels = make(map[string]string)
+		}
+		us.Metadata.Labels[key] = value
+	}
+
+	if nameTemplate == nil {
+		return ""
+	}
+	name, err := renderUpstreamTemplate(nameTemplate, data)
+	if err != nil {
+		logger.Warnw("using the default name for discovered upstream", "upstream", us.Metadata.Name, "error", err)
+		return ""
+	}
+	if name == "" {
+		return ""
+	}
+	return sanitizer.SanitizeNameV2(strings.ToLower(name))
+}
+
+func renderUpstreamTemplate(tmpl *template.Template, data upstreamTemplateData) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// renames the upstreams to their templated names, unless the names are empty or collide with the templated
+// or default name of another upstream, in which case the upstreams keep their default names
+func renameUpstreams(ctx context.Context, upstreams v1.UpstreamList, names []string) {
+	counts := make(map[string]int)
+	for i, us := range upstreams {
+		counts[us.Metadata.Name]++
+		if names[i] != "" && names[i] != us.Metadata.Name {
+			counts[names[i]]++
+		}
+	}
+	for i, us := range upstreams {
+		if names[i] == "" || names[i] == us.Metadata.Name {
+			continue
+		}
+		if counts[names[i]] > 1 {
+			contextutils.LoggerFrom(ctx).Warnw("using the default name for discovered upstream, "+
+				"the templated name collides with another upstream", "upstream", us.Metadata.Name, "name", names[i])
+			continue
+		}
+		us.Metadata.Name = names[i]
+	}
+}
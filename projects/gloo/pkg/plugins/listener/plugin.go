@@ -1,12 +1,36 @@
 package listener
 
 import (
+	"math"
+	"time"
+
 	envoy_api_v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/pkg/utils/gogoutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 )
 
+// the socket options of tcp keepalives on linux, which envoy runs on
+const (
+	solSocket   = 1
+	soKeepalive = 9
+	ipprotoTcp  = 6
+	tcpKeepidle = 4
+	tcpKeepintv = 5
+	tcpKeepcnt  = 6
+)
+
+var (
+	MissingSocketOptionValueErr = func(option *v1.SocketOption) error {
+		return eris.Errorf("socket option %v (level %v, name %v) must have a value", option.GetDescription(), option.GetLevel(), option.GetName())
+	}
+	InvalidSocketOptionStateErr = func(state v1.SocketOption_SocketState) error {
+		return eris.Errorf("invalid socket option state %v", state)
+	}
+)
+
 func NewPlugin() *Plugin {
 	return &Plugin{}
 }
@@ -23,11 +47,90 @@ func (p *Plugin) Init(_ plugins.InitParams) error {
 
 // Used to set config that are directly on the [Envoy listener](https://www.envoyproxy.io/docs/envoy/latest/api-v2/api/v2/listener.proto)
 func (p *Plugin) ProcessListener(_ plugins.Params, in *v1.Listener, out *envoy_api_v2.Listener) error {
-	if in.GetOptions().GetPerConnectionBufferLimitBytes() == nil || in.GetOptions().GetPerConnectionBufferLimitBytes().Value == 0 {
-		// Rely on default behavior
+	options := in.GetOptions()
+	if options == nil {
 		return nil
 	}
 
-	out.PerConnectionBufferLimitBytes = gogoutils.UInt32GogoToProto(in.GetOptions().PerConnectionBufferLimitBytes)
+	// Rely on default behavior if unset or zero
+	if options.GetPerConnectionBufferLimitBytes().GetValue() != 0 {
+		out.PerConnectionBufferLimitBytes = gogoutils.UInt32GogoToProto(options.GetPerConnectionBufferLimitBytes())
+	}
+
+	if options.GetReusePort() != nil {
+		out.ReusePort = options.GetReusePort().GetValue()
+	}
+
+	if options.GetTcpKeepalive() != nil {
+		out.SocketOptions = append(out.SocketOptions, tcpKeepaliveSocketOptions(options.GetTcpKeepalive())...)
+	}
+
+	for _, socketOption := range options.GetSocketOptions() {
+		converted, err := convertSocketOption(socketOption)
+		if err != nil {
+			return err
+		}
+		out.SocketOptions = append(out.SocketOptions, converted)
+	}
 	return nil
 }
+
+// tcpKeepaliveSocketOptions returns the socket options that enable tcp keepalives on the listener socket. The sockets
+// of the accepted connections inherit them.
+func tcpKeepaliveSocketOptions(keepalive *v1.ConnectionConfig_TcpKeepAlive) []*envoycore.SocketOption {
+	options := []*envoycore.SocketOption{
+		keepaliveSocketOption("SO_KEEPALIVE", solSocket, soKeepalive, 1),
+	}
+	if keepalive.GetKeepaliveTime() != nil {
+		options = append(options, keepaliveSocketOption("TCP_KEEPIDLE", ipprotoTcp, tcpKeepidle, roundToSecond(keepalive.GetKeepaliveTime())))
+	}
+	if keepalive.GetKeepaliveInterval() != nil {
+		options = append(options, keepaliveSocketOption("TCP_KEEPINTVL", ipprotoTcp, tcpKeepintv, roundToSecond(keepalive.GetKeepaliveInterval())))
+	}
+	if keepalive.GetKeepaliveProbes() > 0 {
+		options = append(options, keepaliveSocketOption("TCP_KEEPCNT", ipprotoTcp, tcpKeepcnt, int64(keepalive.GetKeepaliveProbes())))
+	}
+	return options
+}
+
+func keepaliveSocketOption(description string, level, name, value int64) *envoycore.SocketOption {
+	return &envoycore.SocketOption{
+		Description: description,
+		Level:       level,
+		Name:        name,
+		Value:       &envoycore.SocketOption_IntValue{IntValue: value},
+		State:       envoycore.SocketOption_STATE_LISTENING,
+	}
+}
+
+func convertSocketOption(in *v1.SocketOption) (*envoycore.SocketOption, error) {
+	out := &envoycore.SocketOption{
+		Description: in.GetDescription(),
+		Level:       in.GetLevel(),
+		Name:        in.GetName(),
+	}
+	switch value := in.GetValue().(type) {
+	case *v1.SocketOption_IntValue:
+		out.Value = &envoycore.SocketOption_IntValue{IntValue: value.IntValue}
+	case *v1.SocketOption_BufValue:
+		out.Value = &envoycore.SocketOption_BufValue{BufValue: value.BufValue}
+	default:
+		return nil, MissingSocketOptionValueErr(in)
+	}
+	switch in.GetState() {
+	case v1.SocketOption_STATE_PREBIND:
+		out.State = envoycore.SocketOption_STATE_PREBIND
+	case v1.SocketOption_STATE_BOUND:
+		out.State = envoycore.SocketOption_STATE_BOUND
+	case v1.SocketOption_STATE_LISTENING:
+		out.State = envoycore.SocketOption_STATE_LISTENING
+	default:
+		return nil, InvalidSocketOptionStateErr(in.GetState())
+	}
+	return out, nil
+}
+
+// roundToSecond rounds the duration up to the second, like the keepalives of upstreams
+func roundToSecond(d *time.Duration) int64 {
+	return int64(math.Ceil(d.Seconds()))
+}
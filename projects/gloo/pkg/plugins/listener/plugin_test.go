@@ -1,7 +1,10 @@
 package listener_test
 
 import (
+	"time"
+
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	"github.com/gogo/protobuf/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -34,4 +37,109 @@ var _ = Describe("Plugin", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(out.PerConnectionBufferLimitBytes.Value).To(BeEquivalentTo(uint32(4096)))
 	})
+
+	It("should set reusePort", func() {
+		in := &v1.Listener{
+			Options: &v1.ListenerOptions{
+				ReusePort: &types.BoolValue{Value: true},
+			},
+		}
+		err := plugin.ProcessListener(plugins.Params{}, in, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.ReusePort).To(BeTrue())
+	})
+
+	It("should translate tcp keepalives to socket options", func() {
+		keepaliveTime := 90 * time.Second
+		keepaliveInterval := 1500 * time.Millisecond
+		in := &v1.Listener{
+			Options: &v1.ListenerOptions{
+				TcpKeepalive: &v1.ConnectionConfig_TcpKeepAlive{
+					KeepaliveProbes:   3,
+					KeepaliveTime:     &keepaliveTime,
+					KeepaliveInterval: &keepaliveInterval,
+				},
+			},
+		}
+		err := plugin.ProcessListener(plugins.Params{}, in, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.SocketOptions).To(Equal([]*envoycore.SocketOption{
+			{
+				Description: "SO_KEEPALIVE",
+				Level:       1,
+				Name:        9,
+				Value:       &envoycore.SocketOption_IntValue{IntValue: 1},
+				State:       envoycore.SocketOption_STATE_LISTENING,
+			},
+			{
+				Description: "TCP_KEEPIDLE",
+				Level:       6,
+				Name:        4,
+				Value:       &envoycore.SocketOption_IntValue{IntValue: 90},
+				State:       envoycore.SocketOption_STATE_LISTENING,
+			},
+			{
+				Description: "TCP_KEEPINTVL",
+				Level:       6,
+				Name:        5,
+				Value:       &envoycore.SocketOption_IntValue{IntValue: 2},
+				State:       envoycore.SocketOption_STATE_LISTENING,
+			},
+			{
+				Description: "TCP_KEEPCNT",
+				Level:       6,
+				Name:        6,
+				Value:       &envoycore.SocketOption_IntValue{IntValue: 3},
+				State:       envoycore.SocketOption_STATE_LISTENING,
+			},
+		}))
+	})
+
+	It("should copy socket options", func() {
+		in := &v1.Listener{
+			Options: &v1.ListenerOptions{
+				SocketOptions: []*v1.SocketOption{
+					{
+						Description: "IP_FREEBIND",
+						Level:       0,
+						Name:        15,
+						Value:       &v1.SocketOption_IntValue{IntValue: 1},
+						State:       v1.SocketOption_STATE_PREBIND,
+					},
+					{
+						Name:  1,
+						Value: &v1.SocketOption_BufValue{BufValue: []byte("value")},
+						State: v1.SocketOption_STATE_BOUND,
+					},
+				},
+			},
+		}
+		err := plugin.ProcessListener(plugins.Params{}, in, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.SocketOptions).To(Equal([]*envoycore.SocketOption{
+			{
+				Description: "IP_FREEBIND",
+				Name:        15,
+				Value:       &envoycore.SocketOption_IntValue{IntValue: 1},
+				State:       envoycore.SocketOption_STATE_PREBIND,
+			},
+			{
+				Name:  1,
+				Value: &envoycore.SocketOption_BufValue{BufValue: []byte("value")},
+				State: envoycore.SocketOption_STATE_BOUND,
+			},
+		}))
+	})
+
+	It("should error on socket options without value", func() {
+		in := &v1.Listener{
+			Options: &v1.ListenerOptions{
+				SocketOptions: []*v1.SocketOption{{
+					Name: 15,
+				}},
+			},
+		}
+		err := plugin.ProcessListener(plugins.Params{}, in, out)
+		Expect(err).To(MatchError(MissingSocketOptionValueErr(in.GetOptions().GetSocketOptions()[0])))
+	})
 })
@@ -85,7 +85,7 @@ func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		return nil
 	}
 
-	if cfg.HealthyPanicThreshold != nil || cfg.UpdateMergeWindow != nil {
+	if cfg.HealthyPanicThreshold != nil || cfg.UpdateMergeWindow != nil || cfg.ZoneAwareLbConfig != nil {
 		out.CommonLbConfig = &envoyapi.Cluster_CommonLbConfig{}
 		if cfg.HealthyPanicThreshold != nil {
 			out.CommonLbConfig.HealthyPanicThreshold = &envoytype.Percent{
@@ -95,6 +95,9 @@ func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		if cfg.UpdateMergeWindow != nil {
 			out.CommonLbConfig.UpdateMergeWindow = gogoutils.DurationStdToProto(cfg.UpdateMergeWindow)
 		}
+		if cfg.ZoneAwareLbConfig != nil {
+			setZoneAwareLbConfig(out.CommonLbConfig, cfg.ZoneAwareLbConfig)
+		}
 	}
 
 	if cfg.Type != nil {
@@ -143,3 +146,22 @@ func setRingHashLbConfig(out *envoyapi.Cluster, userConfig *v1.LoadBalancerConfi
 	}
 	out.LbConfig = cfg
 }
+
+func setZoneAwareLbConfig(out *envoyapi.Cluster_CommonLbConfig, userConfig *v1.LoadBalancerConfig_ZoneAwareLbConfig) {
+	cfg := &envoyapi.Cluster_CommonLbConfig_ZoneAwareLbConfig{
+		FailTrafficOnPanic: userConfig.FailTrafficOnPanic,
+	}
+	if userConfig.RoutingEnabled != nil {
+		cfg.RoutingEnabled = &envoytype.Percent{
+			Value: userConfig.RoutingEnabled.Value,
+		}
+	}
+	if userConfig.MinClusterSize != nil {
+		cfg.MinClusterSize = &wrappers.UInt64Value{
+			Value: userConfig.MinClusterSize.Value,
+		}
+	}
+	out.LocalityConfigSpecifier = &envoyapi.Cluster_CommonLbConfig_ZoneAwareLbConfig_{
+		ZoneAwareLbConfig: cfg,
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoytype "github.com/envoyproxy/go-control-plane/envoy/type"
 	"github.com/gogo/protobuf/types"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
@@ -61,6 +62,23 @@ var _ = Describe("Plugin", func() {
 		Expect(out.CommonLbConfig.UpdateMergeWindow.Nanos).To(BeEquivalentTo(0))
 	})
 
+	It("should set ZoneAwareLbConfig", func() {
+		upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+			ZoneAwareLbConfig: &v1.LoadBalancerConfig_ZoneAwareLbConfig{
+				RoutingEnabled:     &types.DoubleValue{Value: 80},
+				MinClusterSize:     &types.UInt64Value{Value: 3},
+				FailTrafficOnPanic: true,
+			},
+		}
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.CommonLbConfig.GetZoneAwareLbConfig()).To(Equal(&envoyapi.Cluster_CommonLbConfig_ZoneAwareLbConfig{
+			RoutingEnabled:     &envoytype.Percent{Value: 80},
+			MinClusterSize:     &wrappers.UInt64Value{Value: 3},
+			FailTrafficOnPanic: true,
+		}))
+	})
+
 	It("should set lb policy random", func() {
 		upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
 			Type: &v1.LoadBalancerConfig_Random_{
@@ -0,0 +1,142 @@
+package localratelimit
+
+import (
+	"time"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/types"
+	"github.com/rotisserie/eris"
+
+	envoycore "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	envoylocalratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoytype "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+)
+
+const (
+	FilterName = "envoy.filters.http.local_ratelimit"
+	StatPrefix = "http_local_rate_limit"
+
+	// envoy refills token buckets no more often than this
+	minFillInterval = 50 * time.Millisecond
+)
+
+var pluginStage = plugins.DuringStage(plugins.RateLimitStage)
+
+var (
+	MissingMaxTokensError    = eris.New("local rate limit max_tokens must be greater than 0")
+	MissingFillIntervalError = eris.New("local rate limit fill_interval must be set")
+
+	InvalidFillIntervalError = func(fillInterval time.Duration) error {
+		return eris.Errorf("local rate limit fill_interval must be at least %v, got %v", minFillInterval, fillInterval)
+	}
+)
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+var _ plugins.Plugin = new(Plugin)
+var _ plugins.HttpFilterPlugin = new(Plugin)
+var _ plugins.VirtualHostPlugin = new(Plugin)
+var _ plugins.RoutePlugin = new(Plugin)
+
+type Plugin struct {
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *Plugin) ProcessVirtualHost(params plugins.VirtualHostParams, in *v1.VirtualHost, out *envoyroute.VirtualHost) error {
+	tokenBucket := in.GetOptions().GetLocalRatelimit()
+	if tokenBucket == nil {
+		return nil
+	}
+
+	config, err := generatePerFilterConfig(tokenBucket)
+	if err != nil {
+		return err
+	}
+	return pluginutils.SetVhostPerFilterConfig(out, FilterName, config)
+}
+
+func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoyroute.Route) error {
+	tokenBucket := in.GetOptions().GetLocalRatelimit()
+	if tokenBucket == nil {
+		return nil
+	}
+
+	config, err := generatePerFilterConfig(tokenBucket)
+	if err != nil {
+		return err
+	}
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, config)
+}
+
+func (p *Plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	if !usesLocalRatelimit(listener) {
+		return nil, nil
+	}
+
+	// the token buckets are configured on the virtual hosts and routes, so requests to the others are not limited
+	localRatelimitFilter, err := plugins.NewStagedFilterWithConfig(FilterName, &envoylocalratelimit.LocalRateLimit{
+		StatPrefix: StatPrefix,
+	}, pluginStage)
+	if err != nil {
+		return nil, eris.Wrapf(err, "generating filter config")
+	}
+
+	return []plugins.StagedHttpFilter{localRatelimitFilter}, nil
+}
+
+func usesLocalRatelimit(listener *v1.HttpListener) bool {
+	for _, virtualHost := range listener.GetVirtualHosts() {
+		if virtualHost.GetOptions().GetLocalRatelimit() != nil {
+			return true
+		}
+		for _, route := range virtualHost.GetRoutes() {
+			if route.GetOptions().GetLocalRatelimit() != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func generatePerFilterConfig(tokenBucket *local_ratelimit.TokenBucket) (*envoylocalratelimit.LocalRateLimit, error) {
+	if tokenBucket.GetMaxTokens() == 0 {
+		return nil, MissingMaxTokensError
+	}
+	fillInterval := tokenBucket.GetFillInterval()
+	if fillInterval == nil {
+		return nil, MissingFillIntervalError
+	}
+	if *fillInterval < minFillInterval {
+		return nil, InvalidFillIntervalError(*fillInterval)
+	}
+
+	return &envoylocalratelimit.LocalRateLimit{
+		StatPrefix: StatPrefix,
+		TokenBucket: &envoytype.TokenBucket{
+			MaxTokens:     tokenBucket.GetMaxTokens(),
+			TokensPerFill: tokenBucket.GetTokensPerFill(),
+			FillInterval:  types.DurationProto(*fillInterval),
+		},
+		// envoy neither enables nor enforces the filter by default
+		FilterEnabled:  alwaysOn(),
+		FilterEnforced: alwaysOn(),
+	}, nil
+}
+
+func alwaysOn() *envoycore.RuntimeFractionalPercent {
+	return &envoycore.RuntimeFractionalPercent{
+		DefaultValue: &envoytype.FractionalPercent{
+			Numerator:   100,
+			Denominator: envoytype.FractionalPercent_HUNDRED,
+		},
+	}
+}
@@ -0,0 +1,134 @@
+package localratelimit_test
+
+import (
+	"time"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	envoycore "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	envoylocalratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoytype "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/localratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		fillInterval time.Duration
+		tokenBucket  *local_ratelimit.TokenBucket
+	)
+
+	BeforeEach(func() {
+		fillInterval = time.Second
+		tokenBucket = &local_ratelimit.TokenBucket{
+			MaxTokens:     10,
+			TokensPerFill: &types.UInt32Value{Value: 5},
+			FillInterval:  &fillInterval,
+		}
+	})
+
+	alwaysOn := &envoycore.RuntimeFractionalPercent{
+		DefaultValue: &envoytype.FractionalPercent{
+			Numerator:   100,
+			Denominator: envoytype.FractionalPercent_HUNDRED,
+		},
+	}
+
+	expectedPerFilterConfig := func() *envoylocalratelimit.LocalRateLimit {
+		return &envoylocalratelimit.LocalRateLimit{
+			StatPrefix: StatPrefix,
+			TokenBucket: &envoytype.TokenBucket{
+				MaxTokens:     10,
+				TokensPerFill: &types.UInt32Value{Value: 5},
+				FillInterval:  &types.Duration{Seconds: 1},
+			},
+			FilterEnabled:  alwaysOn,
+			FilterEnforced: alwaysOn,
+		}
+	}
+
+	Context("filter", func() {
+
+		It("is not added when no virtual host or route uses local rate limiting", func() {
+			filters, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{{
+					Routes: []*v1.Route{{}},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+
+		It("is added without a token bucket when a route uses local rate limiting", func() {
+			filters, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{{
+					Routes: []*v1.Route{{
+						Options: &v1.RouteOptions{LocalRatelimit: tokenBucket},
+					}},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].Stage).To(Equal(plugins.DuringStage(plugins.RateLimitStage)))
+			Expect(filters[0].HttpFilter.Name).To(Equal(FilterName))
+			Expect(filters[0].HttpFilter.GetTypedConfig()).To(Equal(utils.MustMessageToAny(&envoylocalratelimit.LocalRateLimit{
+				StatPrefix: StatPrefix,
+			})))
+		})
+	})
+
+	It("sets the token bucket of a virtual host", func() {
+		out := &envoyroute.VirtualHost{}
+		err := NewPlugin().ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{
+			Options: &v1.VirtualHostOptions{LocalRatelimit: tokenBucket},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetTypedPerFilterConfig()[FilterName]).To(Equal(utils.MustMessageToAny(expectedPerFilterConfig())))
+	})
+
+	It("sets the token bucket of a route", func() {
+		out := &envoyroute.Route{}
+		err := NewPlugin().ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{LocalRatelimit: tokenBucket},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetTypedPerFilterConfig()[FilterName]).To(Equal(utils.MustMessageToAny(expectedPerFilterConfig())))
+	})
+
+	It("does nothing for routes without local rate limiting", func() {
+		out := &envoyroute.Route{}
+		err := NewPlugin().ProcessRoute(plugins.RouteParams{}, &v1.Route{}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetTypedPerFilterConfig()).To(BeEmpty())
+	})
+
+	It("rejects token buckets without tokens", func() {
+		tokenBucket.MaxTokens = 0
+		err := NewPlugin().ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{LocalRatelimit: tokenBucket},
+		}, &envoyroute.Route{})
+		Expect(err).To(Equal(MissingMaxTokensError))
+	})
+
+	It("rejects token buckets without a fill interval", func() {
+		tokenBucket.FillInterval = nil
+		err := NewPlugin().ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{LocalRatelimit: tokenBucket},
+		}, &envoyroute.Route{})
+		Expect(err).To(Equal(MissingFillIntervalError))
+	})
+
+	It("rejects fill intervals shorter than envoy supports", func() {
+		fillInterval = 10 * time.Millisecond
+		err := NewPlugin().ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{
+			Options: &v1.VirtualHostOptions{LocalRatelimit: tokenBucket},
+		}, &envoyroute.VirtualHost{})
+		Expect(err).To(MatchError(InvalidFillIntervalError(fillInterval).Error()))
+	})
+})
@@ -4,6 +4,7 @@ import (
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	envoyendpoint "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
+	"github.com/solo-io/gloo/projects/gloo/pkg/dns"
 )
 
 func EnvoySingleEndpointLoadAssignment(out *envoyapi.Cluster, address string, port uint32) {
@@ -37,3 +38,26 @@ func EnvoyEndpoint(address string, port uint32) *envoyendpoint.Endpoint {
 		},
 	}
 }
+
+// Converts nameservers, as IP addresses with an optional port, to the addresses of the DNS resolvers of a cluster
+func EnvoyDnsResolvers(nameservers []string) ([]*envoycore.Address, error) {
+	var resolvers []*envoycore.Address
+	for _, nameserver := range nameservers {
+		ip, port, err := dns.SplitNameserver(nameserver)
+		if err != nil {
+			return nil, err
+		}
+		resolvers = append(resolvers, &envoycore.Address{
+			Address: &envoycore.Address_SocketAddress{
+				SocketAddress: &envoycore.SocketAddress{
+					Protocol: envoycore.SocketAddress_UDP,
+					Address:  ip.String(),
+					PortSpecifier: &envoycore.SocketAddress_PortValue{
+						PortValue: port,
+					},
+				},
+			},
+		})
+	}
+	return resolvers, nil
+}
@@ -55,17 +55,27 @@ func (p *Plugin) Init(params plugins.InitParams) error {
 }
 
 func (p *Plugin) ProcessVirtualHost(params plugins.VirtualHostParams, in *v1.VirtualHost, out *envoyroute.VirtualHost) error {
-	if newRateLimits := in.GetOptions().GetRatelimit().GetRateLimits(); len(newRateLimits) > 0 {
-		out.RateLimits = generateCustomEnvoyConfigForVhost(params.Ctx, newRateLimits)
+	rateLimits := in.GetOptions().GetRatelimit()
+	if len(rateLimits.GetRateLimits()) > 0 || len(rateLimits.GetRateLimitsWithMetadata()) > 0 {
+		out.RateLimits = append(generateCustomEnvoyConfigForVhost(params.Ctx, rateLimits.GetRateLimits()),
+			generateEnvoyConfigWithMetadata(params.Ctx, rateLimits.GetRateLimitsWithMetadata())...)
 	}
 	return nil
 }
 
 func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoyroute.Route) error {
 	if rateLimits := in.GetOptions().GetRatelimit(); rateLimits != nil {
+		if rateLimits.GetDisableVhRateLimits() && rateLimits.GetIncludeVhRateLimits() {
+			return fmt.Errorf("cannot both include and disable the virtual host rate limits")
+		}
 		if ra := out.GetRoute(); ra != nil {
-			ra.RateLimits = generateCustomEnvoyConfigForVhost(params.Ctx, rateLimits.GetRateLimits())
+			ra.RateLimits = append(generateCustomEnvoyConfigForVhost(params.Ctx, rateLimits.GetRateLimits()),
+				generateEnvoyConfigWithMetadata(params.Ctx, rateLimits.GetRateLimitsWithMetadata())...)
 			ra.IncludeVhRateLimits = &wrappers.BoolValue{Value: rateLimits.GetIncludeVhRateLimits()}
+			if rateLimits.GetDisableVhRateLimits() && len(ra.RateLimits) == 0 {
+				// envoy applies the virtual host rate limits to routes without rate limits of their own
+				ra.RateLimits = []*envoyroute.RateLimit{noopRateLimit()}
+			}
 		} else {
 			// TODO(yuval-k): maybe return nil here instead and just log a warning?
 			return fmt.Errorf("cannot apply rate limits without a route action")
@@ -15,6 +15,7 @@ import (
 
 	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
 
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	rlconfig "github.com/envoyproxy/go-control-plane/envoy/config/ratelimit/v3"
 	envoyratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
@@ -22,6 +23,7 @@ import (
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	ratelimitpb "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	gloorl "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
@@ -211,6 +213,103 @@ var _ = Describe("RateLimit Plugin", func() {
 		})
 	})
 
+	Context("route rate limits", func() {
+
+		var (
+			route *gloov1.Route
+			out   *envoyroute.Route
+		)
+
+		BeforeEach(func() {
+			route = &gloov1.Route{
+				Options: &gloov1.RouteOptions{
+					RateLimitConfigType: &gloov1.RouteOptions_Ratelimit{
+						Ratelimit: &ratelimitpb.RateLimitRouteExtension{},
+					},
+				},
+			}
+			out = &envoyroute.Route{
+				Action: &envoyroute.Route_Route{Route: &envoyroute.RouteAction{}},
+			}
+		})
+
+		It("applies the virtual host rate limits to routes without rate limits", func() {
+			err := rlPlugin.ProcessRoute(plugins.RouteParams{}, route, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetRoute().GetRateLimits()).To(BeEmpty())
+		})
+
+		It("opts routes out of the virtual host rate limits", func() {
+			route.GetOptions().GetRatelimit().DisableVhRateLimits = true
+			err := rlPlugin.ProcessRoute(plugins.RouteParams{}, route, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			rateLimits := out.GetRoute().GetRateLimits()
+			Expect(rateLimits).To(HaveLen(1))
+			Expect(out.GetRoute().GetIncludeVhRateLimits().GetValue()).To(BeFalse())
+			headerValueMatch := rateLimits[0].GetActions()[0].GetHeaderValueMatch()
+			Expect(headerValueMatch.GetHeaders()).To(ConsistOf(&envoyroute.HeaderMatcher{
+				Name:                 ":path",
+				HeaderMatchSpecifier: &envoyroute.HeaderMatcher_PresentMatch{PresentMatch: true},
+				InvertMatch:          true,
+			}))
+		})
+
+		It("keeps the rate limits of routes which opt out of the virtual host rate limits", func() {
+			route.GetOptions().GetRatelimit().DisableVhRateLimits = true
+			route.GetOptions().GetRatelimit().RateLimits = []*gloorl.RateLimitActions{{
+				Actions: []*gloorl.Action{{
+					ActionSpecifier: &gloorl.Action_GenericKey_{
+						GenericKey: &gloorl.Action_GenericKey{DescriptorValue: "route"},
+					},
+				}},
+			}}
+			err := rlPlugin.ProcessRoute(plugins.RouteParams{}, route, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			rateLimits := out.GetRoute().GetRateLimits()
+			Expect(rateLimits).To(HaveLen(1))
+			Expect(rateLimits[0].GetActions()[0].GetGenericKey().GetDescriptorValue()).To(Equal("route"))
+		})
+
+		It("adds the rate limits with metadata after the other rate limits", func() {
+			route.GetOptions().GetRatelimit().DisableVhRateLimits = true
+			route.GetOptions().GetRatelimit().RateLimits = []*gloorl.RateLimitActions{{
+				Actions: []*gloorl.Action{{
+					ActionSpecifier: &gloorl.Action_GenericKey_{
+						GenericKey: &gloorl.Action_GenericKey{DescriptorValue: "route"},
+					},
+				}},
+			}}
+			route.GetOptions().GetRatelimit().RateLimitsWithMetadata = []*ratelimitpb.RateLimitActionsWithMetadata{{
+				Actions: []*ratelimitpb.ActionWithMetadata{{
+					ActionSpecifier: &ratelimitpb.ActionWithMetadata_DynamicMetadata_{
+						DynamicMetadata: &ratelimitpb.ActionWithMetadata_DynamicMetadata{
+							DescriptorKey: "user",
+							Filter:        "envoy.filters.http.jwt_authn",
+							Path:          []string{"employees", "sub"},
+						},
+					},
+				}},
+			}}
+			err := rlPlugin.ProcessRoute(plugins.RouteParams{}, route, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			rateLimits := out.GetRoute().GetRateLimits()
+			Expect(rateLimits).To(HaveLen(2))
+			Expect(rateLimits[0].GetActions()[0].GetGenericKey().GetDescriptorValue()).To(Equal("route"))
+			Expect(rateLimits[1].GetStage().GetValue()).To(BeEquivalentTo(1))
+			Expect(rateLimits[1].GetActions()).To(HaveLen(1))
+			Expect(rateLimits[1].GetActions()[0].XXX_unrecognized).NotTo(BeEmpty())
+		})
+
+		It("rejects routes which both include and opt out of the virtual host rate limits", func() {
+			route.GetOptions().GetRatelimit().DisableVhRateLimits = true
+			route.GetOptions().GetRatelimit().IncludeVhRateLimits = true
+			err := rlPlugin.ProcessRoute(plugins.RouteParams{}, route, out)
+			Expect(err).To(MatchError(ContainSubstring("cannot both include and disable the virtual host rate limits")))
+		})
+	})
 })
 
 func getTypedConfig(f *envoyhttp.HttpFilter) *envoyratelimit.RateLimit {
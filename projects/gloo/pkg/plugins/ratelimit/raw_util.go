@@ -9,10 +9,15 @@ import (
 	envoyvhostratelimit "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
 	envoyratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
 	envoytype "github.com/envoyproxy/go-control-plane/envoy/type"
+	gogoproto "github.com/gogo/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/solo-io/gloo/pkg/utils/gogoutils"
+	"github.com/solo-io/go-utils/contextutils"
 
 	regexutils "github.com/solo-io/gloo/pkg/utils/regexutils"
+	envoyroutev3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/route/v3"
+	envoymetadata "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/metadata/v3"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
@@ -32,6 +37,38 @@ func generateCustomEnvoyConfigForVhost(ctx context.Context, rlactions []*gloorl.
 	return ret
 }
 
+func generateEnvoyConfigWithMetadata(ctx context.Context, rlactions []*ratelimit.RateLimitActionsWithMetadata) []*envoyvhostratelimit.RateLimit {
+	var ret []*envoyvhostratelimit.RateLimit
+	for _, rlaction := range rlactions {
+		rl := &envoyvhostratelimit.RateLimit{
+			Stage: &wrappers.UInt32Value{Value: customStage},
+		}
+		rl.Actions = ConvertActionsWithMetadata(ctx, rlaction.GetActions())
+		ret = append(ret, rl)
+	}
+	return ret
+}
+
+// a rate limit whose descriptor is never generated, so that no request is sent to the rate limit server
+func noopRateLimit() *envoyvhostratelimit.RateLimit {
+	return &envoyvhostratelimit.RateLimit{
+		Stage: &wrappers.UInt32Value{Value: customStage},
+		Actions: []*envoyvhostratelimit.RateLimit_Action{{
+			ActionSpecifier: &envoyvhostratelimit.RateLimit_Action_HeaderValueMatch_{
+				HeaderValueMatch: &envoyvhostratelimit.RateLimit_Action_HeaderValueMatch{
+					DescriptorValue: "disabled",
+					// every request has a path
+					Headers: []*envoyvhostratelimit.HeaderMatcher{{
+						Name:                 ":path",
+						HeaderMatchSpecifier: &envoyvhostratelimit.HeaderMatcher_PresentMatch{PresentMatch: true},
+						InvertMatch:          true,
+					}},
+				},
+			},
+		}},
+	}
+}
+
 func ConvertActions(ctx context.Context, actions []*gloorl.Action) []*envoyvhostratelimit.RateLimit_Action {
 	var retActions []*envoyvhostratelimit.RateLimit_Action
 
@@ -41,6 +78,46 @@ func ConvertActions(ctx context.Context, actions []*gloorl.Action) []*envoyvhost
 	return retActions
 }
 
+func ConvertActionsWithMetadata(ctx context.Context, actions []*ratelimit.ActionWithMetadata) []*envoyvhostratelimit.RateLimit_Action {
+	var retActions []*envoyvhostratelimit.RateLimit_Action
+
+	for _, action := range actions {
+		switch specificAction := action.GetActionSpecifier().(type) {
+		case *ratelimit.ActionWithMetadata_Action:
+			retActions = append(retActions, convertAction(ctx, specificAction.Action))
+		case *ratelimit.ActionWithMetadata_DynamicMetadata_:
+			retActions = append(retActions, convertDynamicMetadataAction(ctx, specificAction.DynamicMetadata))
+		}
+	}
+	return retActions
+}
+
+// the v2 route api has no dynamic metadata action, so it is added as the field of the v3 action, which envoy keeps
+// when it upgrades the routes to v3
+func convertDynamicMetadataAction(ctx context.Context, action *ratelimit.ActionWithMetadata_DynamicMetadata) *envoyvhostratelimit.RateLimit_Action {
+	metadataKey := &envoymetadata.MetadataKey{Key: action.GetFilter()}
+	for _, key := range action.GetPath() {
+		metadataKey.Path = append(metadataKey.Path, &envoymetadata.MetadataKey_PathSegment{
+			Segment: &envoymetadata.MetadataKey_PathSegment_Key{Key: key},
+		})
+	}
+	actionV3 := &envoyroutev3.RateLimit_Action{
+		ActionSpecifier: &envoyroutev3.RateLimit_Action_DynamicMetadata{
+			DynamicMetadata: &envoyroutev3.RateLimit_Action_DynamicMetaData{
+				DescriptorKey: action.GetDescriptorKey(),
+				MetadataKey:   metadataKey,
+			},
+		},
+	}
+	bytes, err := gogoproto.Marshal(actionV3)
+	if err != nil {
+		// this should never happen
+		contextutils.LoggerFrom(ctx).DPanicw("failed to marshal the dynamic metadata rate limit action", "error", err)
+		return &envoyvhostratelimit.RateLimit_Action{}
+	}
+	return &envoyvhostratelimit.RateLimit_Action{XXX_unrecognized: bytes}
+}
+
 func convertAction(ctx context.Context, action *gloorl.Action) *envoyvhostratelimit.RateLimit_Action {
 	var retAction envoyvhostratelimit.RateLimit_Action
 
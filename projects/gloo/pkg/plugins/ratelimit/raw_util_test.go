@@ -8,8 +8,13 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/gogo/protobuf/jsonpb"
+	gogoproto "github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/proto"
 	regexutils "github.com/solo-io/gloo/pkg/utils/regexutils"
+	envoyroutev3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/route/v3"
+	envoymetadata "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/metadata/v3"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
 	gloorl "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
 )
@@ -124,6 +129,84 @@ var _ = Describe("RawUtil", func() {
 
 	})
 
+	Context("actions with metadata", func() {
+
+		// envoy upgrades the v2 routes to v3 through their wire format
+		toV3 := func(action *envoyvhostratelimit.RateLimit_Action) *envoyroutev3.RateLimit_Action {
+			bytes, err := proto.Marshal(action)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			var actionV3 envoyroutev3.RateLimit_Action
+			ExpectWithOffset(1, gogoproto.Unmarshal(bytes, &actionV3)).To(Succeed())
+			return &actionV3
+		}
+
+		It("should convert the dynamic metadata of jwt claims and the external auth server", func() {
+			out := ConvertActionsWithMetadata(nil, []*ratelimit.ActionWithMetadata{{
+				ActionSpecifier: &ratelimit.ActionWithMetadata_DynamicMetadata_{
+					DynamicMetadata: &ratelimit.ActionWithMetadata_DynamicMetadata{
+						DescriptorKey: "user",
+						Filter:        "envoy.filters.http.jwt_authn",
+						Path:          []string{"employees", "sub"},
+					},
+				},
+			}, {
+				ActionSpecifier: &ratelimit.ActionWithMetadata_DynamicMetadata_{
+					DynamicMetadata: &ratelimit.ActionWithMetadata_DynamicMetadata{
+						DescriptorKey: "plan",
+						Filter:        "envoy.filters.http.ext_authz",
+						Path:          []string{"plan"},
+					},
+				},
+			}})
+
+			Expect(out).To(HaveLen(2))
+			Expect(toV3(out[0])).To(Equal(&envoyroutev3.RateLimit_Action{
+				ActionSpecifier: &envoyroutev3.RateLimit_Action_DynamicMetadata{
+					DynamicMetadata: &envoyroutev3.RateLimit_Action_DynamicMetaData{
+						DescriptorKey: "user",
+						MetadataKey: &envoymetadata.MetadataKey{
+							Key: "envoy.filters.http.jwt_authn",
+							Path: []*envoymetadata.MetadataKey_PathSegment{
+								{Segment: &envoymetadata.MetadataKey_PathSegment_Key{Key: "employees"}},
+								{Segment: &envoymetadata.MetadataKey_PathSegment_Key{Key: "sub"}},
+							},
+						},
+					},
+				},
+			}))
+			Expect(toV3(out[1]).GetDynamicMetadata().GetMetadataKey()).To(Equal(&envoymetadata.MetadataKey{
+				Key: "envoy.filters.http.ext_authz",
+				Path: []*envoymetadata.MetadataKey_PathSegment{
+					{Segment: &envoymetadata.MetadataKey_PathSegment_Key{Key: "plan"}},
+				},
+			}))
+		})
+
+		It("should keep the order of the other actions", func() {
+			out := ConvertActionsWithMetadata(nil, []*ratelimit.ActionWithMetadata{{
+				ActionSpecifier: &ratelimit.ActionWithMetadata_Action{
+					Action: &gloorl.Action{
+						ActionSpecifier: &gloorl.Action_GenericKey_{
+							GenericKey: &gloorl.Action_GenericKey{DescriptorValue: "per-user"},
+						},
+					},
+				},
+			}, {
+				ActionSpecifier: &ratelimit.ActionWithMetadata_DynamicMetadata_{
+					DynamicMetadata: &ratelimit.ActionWithMetadata_DynamicMetadata{
+						DescriptorKey: "user",
+						Filter:        "envoy.filters.http.jwt_authn",
+						Path:          []string{"employees", "sub"},
+					},
+				},
+			}})
+
+			Expect(out).To(HaveLen(2))
+			Expect(out[0].GetGenericKey().GetDescriptorValue()).To(Equal("per-user"))
+			Expect(toV3(out[1]).GetDynamicMetadata().GetDescriptorKey()).To(Equal("user"))
+		})
+	})
+
 })
 
 func ExpectActionsSame(actions []*gloorl.Action) {
@@ -0,0 +1,433 @@
+package rbac
+
+import (
+	"net"
+	"sort"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyrbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyrbacfilter "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/rotisserie/eris"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/rbac"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	jwtplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+)
+
+const FilterName = wellknown.HTTPRoleBasedAccessControl
+
+// RBAC policies can use the identity established by the JWT and ext auth filters
+var pluginStage = plugins.DuringStage(plugins.AuthZStage)
+
+var (
+	NoPrincipalsError = func(policy string) error {
+		return eris.Errorf("rbac policy %s must define at least one principal", policy)
+	}
+	EmptyPrincipalError = func(policy string) error {
+		return eris.Errorf("principals of rbac policy %s must set at least one field", policy)
+	}
+	NoJwtProvidersError = func(policy string) error {
+		return eris.Errorf("rbac policy %s uses a jwt principal, but its virtual host does not define jwt providers", policy)
+	}
+	InvalidSourceIpError = func(policy, sourceIp string) error {
+		return eris.Errorf("source ip %s of rbac policy %s is neither an ip address nor a cidr range", sourceIp, policy)
+	}
+)
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+var _ plugins.Plugin = new(Plugin)
+var _ plugins.HttpFilterPlugin = new(Plugin)
+var _ plugins.VirtualHostPlugin = new(Plugin)
+var _ plugins.RoutePlugin = new(Plugin)
+
+type Plugin struct {
+	settings *rbac.Settings
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	p.settings = params.Settings.GetRbac()
+	return nil
+}
+
+func (p *Plugin) ProcessVirtualHost(params plugins.VirtualHostParams, in *v1.VirtualHost, out *envoyroute.VirtualHost) error {
+	rbacConfig := in.GetOptions().GetRbac()
+	if rbacConfig == nil {
+		return nil
+	}
+
+	perRouteConfig, err := translatePerRouteConfig(rbacConfig, in.GetOptions().GetJwt())
+	if err != nil {
+		return err
+	}
+	return pluginutils.SetVhostPerFilterConfig(out, FilterName, perRouteConfig)
+}
+
+func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoyroute.Route) error {
+	rbacConfig := in.GetOptions().GetRbac()
+	if rbacConfig == nil {
+		return nil
+	}
+
+	// the settings of the route replace the ones of its virtual host
+	perRouteConfig, err := translatePerRouteConfig(rbacConfig, params.VirtualHost.GetOptions().GetJwt())
+	if err != nil {
+		return err
+	}
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, perRouteConfig)
+}
+
+func (p *Plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	requireRbac := p.settings.GetRequireRbac()
+	if !requireRbac && !usesRbac(listener) {
+		return nil, nil
+	}
+
+	// without rules, the filter only enforces the policies of the virtual hosts and routes
+	config := &envoyrbacfilter.RBAC{}
+	if requireRbac {
+		// an allow list without policies denies all the requests
+		config.Rules = &envoyrbac.RBAC{
+			Action: envoyrbac.RBAC_ALLOW,
+		}
+	}
+
+	rbacFilter, err := plugins.NewStagedFilterWithConfig(FilterName, config, pluginStage)
+	if err != nil {
+		return nil, eris.Wrapf(err, "generating filter config")
+	}
+
+	return []plugins.StagedHttpFilter{rbacFilter}, nil
+}
+
+func usesRbac(listener *v1.HttpListener) bool {
+	for _, virtualHost := range listener.GetVirtualHosts() {
+		if virtualHost.GetOptions().GetRbac() != nil {
+			return true
+		}
+		for _, route := range virtualHost.GetRoutes() {
+			if route.GetOptions().GetRbac() != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func translatePerRouteConfig(rbacConfig *rbac.ExtensionSettings, jwtConfig *jwt.VhostExtension) (*envoyrbacfilter.RBACPerRoute, error) {
+	// a per-route config without rules lets all the requests through
+	if rbacConfig.GetDisable() {
+		return &envoyrbacfilter.RBACPerRoute{}, nil
+	}
+
+	rules := &envoyrbac.RBAC{
+		Action:   envoyrbac.RBAC_ALLOW,
+		Policies: map[string]*envoyrbac.Policy{},
+	}
+	for name, policy := range rbacConfig.GetPolicies() {
+		envoyPolicy, err := translatePolicy(name, policy, jwtConfig)
+		if err != nil {
+			return nil, err
+		}
+		rules.Policies[name] = envoyPolicy
+	}
+
+	if rbacConfig.GetShadow() {
+		return &envoyrbacfilter.RBACPerRoute{
+			Rbac: &envoyrbacfilter.RBAC{ShadowRules: rules},
+		}, nil
+	}
+	return &envoyrbacfilter.RBACPerRoute{
+		Rbac: &envoyrbacfilter.RBAC{Rules: rules},
+	}, nil
+}
+
+func translatePolicy(name string, policy *rbac.Policy, jwtConfig *jwt.VhostExtension) (*envoyrbac.Policy, error) {
+	if len(policy.GetPrincipals()) == 0 {
+		return nil, NoPrincipalsError(name)
+	}
+
+	var principals []*envoyrbac.Principal
+	for _, principal := range policy.GetPrincipals() {
+		envoyPrincipal, err := translatePrincipal(name, principal, jwtConfig)
+		if err != nil {
+			return nil, err
+		}
+		principals = append(principals, envoyPrincipal)
+	}
+
+	return &envoyrbac.Policy{
+		Principals:  principals,
+		Permissions: []*envoyrbac.Permission{translatePermissions(policy.GetPermissions())},
+	}, nil
+}
+
+func translatePrincipal(policy string, principal *rbac.Principal, jwtConfig *jwt.VhostExtension) (*envoyrbac.Principal, error) {
+	var ids []*envoyrbac.Principal
+
+	if jwtPrincipal := principal.GetJwtPrincipal(); jwtPrincipal != nil {
+		id, err := translateJwtPrincipal(policy, jwtPrincipal, jwtConfig)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if san := principal.GetAuthenticatedSan(); san != "" {
+		ids = append(ids, &envoyrbac.Principal{
+			Identifier: &envoyrbac.Principal_Authenticated_{
+				Authenticated: &envoyrbac.Principal_Authenticated{
+					PrincipalName: exactMatch(san),
+				},
+			},
+		})
+	}
+
+	if sourceIp := principal.GetSourceIp(); sourceIp != "" {
+		cidrRange, err := translateCidrRange(sourceIp)
+		if err != nil {
+			return nil, InvalidSourceIpError(policy, sourceIp)
+		}
+		ids = append(ids, &envoyrbac.Principal{
+			Identifier: &envoyrbac.Principal_RemoteIp{
+				RemoteIp: cidrRange,
+			},
+		})
+	}
+
+	if header := principal.GetHeader(); header != nil {
+		ids = append(ids, &envoyrbac.Principal{
+			Identifier: &envoyrbac.Principal_Header{
+				Header: translateHeaderMatcher(header),
+			},
+		})
+	}
+
+	if len(ids) == 0 {
+		return nil, EmptyPrincipalError(policy)
+	}
+	return andIds(ids), nil
+}
+
+// The JWT filter stores the claims of verified JWTs in the dynamic metadata, under the name of their provider.
+func translateJwtPrincipal(policy string, jwtPrincipal *rbac.JWTPrincipal, jwtConfig *jwt.VhostExtension) (*envoyrbac.Principal, error) {
+	var providers []string
+	if jwtPrincipal.GetProvider() != "" {
+		providers = []string{jwtPrincipal.GetProvider()}
+	} else {
+		for name := range jwtConfig.GetProviders() {
+			providers = append(providers, name)
+		}
+		sort.Strings(providers)
+	}
+	if len(providers) == 0 {
+		return nil, NoJwtProvidersError(policy)
+	}
+
+	var claims []string
+	for claim := range jwtPrincipal.GetClaims() {
+		claims = append(claims, claim)
+	}
+	sort.Strings(claims)
+
+	// a JWT verified by any of the providers must have all the claims
+	var providerIds []*envoyrbac.Principal
+	for _, provider := range providers {
+		if len(claims) == 0 {
+			providerIds = append(providerIds, metadataPrincipal(provider, "", &envoymatcher.ValueMatcher{
+				MatchPattern: &envoymatcher.ValueMatcher_PresentMatch{PresentMatch: true},
+			}))
+			continue
+		}
+
+		var claimIds []*envoyrbac.Principal
+		for _, claim := range claims {
+			claimIds = append(claimIds, claimPrincipal(provider, claim, jwtPrincipal.GetClaims()[claim]))
+		}
+		providerIds = append(providerIds, andIds(claimIds))
+	}
+	return orIds(providerIds), nil
+}
+
+// matches claims whose value is the expected string or a list that contains it, e.g. groups or scopes
+func claimPrincipal(provider, claim, value string) *envoyrbac.Principal {
+	stringMatch := &envoymatcher.ValueMatcher{
+		MatchPattern: &envoymatcher.ValueMatcher_StringMatch{StringMatch: exactMatch(value)},
+	}
+	return orIds([]*envoyrbac.Principal{
+		metadataPrincipal(provider, claim, stringMatch),
+		metadataPrincipal(provider, claim, &envoymatcher.ValueMatcher{
+			MatchPattern: &envoymatcher.ValueMatcher_ListMatch{
+				ListMatch: &envoymatcher.ListMatcher{
+					MatchPattern: &envoymatcher.ListMatcher_OneOf{OneOf: stringMatch},
+				},
+			},
+		}),
+	})
+}
+
+func metadataPrincipal(provider, claim string, value *envoymatcher.ValueMatcher) *envoyrbac.Principal {
+	path := []*envoymatcher.MetadataMatcher_PathSegment{{
+		Segment: &envoymatcher.MetadataMatcher_PathSegment_Key{Key: provider},
+	}}
+	if claim != "" {
+		path = append(path, &envoymatcher.MetadataMatcher_PathSegment{
+			Segment: &envoymatcher.MetadataMatcher_PathSegment_Key{Key: claim},
+		})
+	}
+	return &envoyrbac.Principal{
+		Identifier: &envoyrbac.Principal_Metadata{
+			Metadata: &envoymatcher.MetadataMatcher{
+				Filter: jwtplugin.MetadataNamespace,
+				Path:   path,
+				Value:  value,
+			},
+		},
+	}
+}
+
+func andIds(ids []*envoyrbac.Principal) *envoyrbac.Principal {
+	if len(ids) == 1 {
+		return ids[0]
+	}
+	return &envoyrbac.Principal{
+		Identifier: &envoyrbac.Principal_AndIds{
+			AndIds: &envoyrbac.Principal_Set{Ids: ids},
+		},
+	}
+}
+
+func orIds(ids []*envoyrbac.Principal) *envoyrbac.Principal {
+	if len(ids) == 1 {
+		return ids[0]
+	}
+	return &envoyrbac.Principal{
+		Identifier: &envoyrbac.Principal_OrIds{
+			OrIds: &envoyrbac.Principal_Set{Ids: ids},
+		},
+	}
+}
+
+func translateCidrRange(sourceIp string) (*envoycore.CidrRange, error) {
+	if ip := net.ParseIP(sourceIp); ip != nil {
+		prefixLen := net.IPv6len * 8
+		if ip.To4() != nil {
+			prefixLen = net.IPv4len * 8
+		}
+		return &envoycore.CidrRange{
+			AddressPrefix: ip.String(),
+			PrefixLen:     &wrappers.UInt32Value{Value: uint32(prefixLen)},
+		}, nil
+	}
+
+	ip, ipNet, err := net.ParseCIDR(sourceIp)
+	if err != nil {
+		return nil, err
+	}
+	prefixLen, _ := ipNet.Mask.Size()
+	return &envoycore.CidrRange{
+		AddressPrefix: ip.String(),
+		PrefixLen:     &wrappers.UInt32Value{Value: uint32(prefixLen)},
+	}, nil
+}
+
+func translateHeaderMatcher(header *matchers.HeaderMatcher) *envoyroutev3.HeaderMatcher {
+	envoyMatch := &envoyroutev3.HeaderMatcher{
+		Name:        header.GetName(),
+		InvertMatch: header.GetInvertMatch(),
+	}
+	switch {
+	case header.GetValue() == "":
+		envoyMatch.HeaderMatchSpecifier = &envoyroutev3.HeaderMatcher_PresentMatch{
+			PresentMatch: true,
+		}
+	case header.GetRegex():
+		envoyMatch.HeaderMatchSpecifier = &envoyroutev3.HeaderMatcher_SafeRegexMatch{
+			SafeRegexMatch: &envoymatcher.RegexMatcher{
+				EngineType: &envoymatcher.RegexMatcher_GoogleRe2{
+					GoogleRe2: &envoymatcher.RegexMatcher_GoogleRE2{},
+				},
+				Regex: header.GetValue(),
+			},
+		}
+	default:
+		envoyMatch.HeaderMatchSpecifier = &envoyroutev3.HeaderMatcher_ExactMatch{
+			ExactMatch: header.GetValue(),
+		}
+	}
+	return envoyMatch
+}
+
+func translatePermissions(permissions *rbac.Permissions) *envoyrbac.Permission {
+	var rules []*envoyrbac.Permission
+
+	if prefix := permissions.GetPathPrefix(); prefix != "" {
+		rules = append(rules, &envoyrbac.Permission{
+			Rule: &envoyrbac.Permission_UrlPath{
+				UrlPath: &envoymatcher.PathMatcher{
+					Rule: &envoymatcher.PathMatcher_Path{
+						Path: &envoymatcher.StringMatcher{
+							MatchPattern: &envoymatcher.StringMatcher_Prefix{Prefix: prefix},
+						},
+					},
+				},
+			},
+		})
+	}
+
+	if len(permissions.GetMethods()) > 0 {
+		var methodRules []*envoyrbac.Permission
+		for _, method := range permissions.GetMethods() {
+			methodRules = append(methodRules, &envoyrbac.Permission{
+				Rule: &envoyrbac.Permission_Header{
+					Header: &envoyroutev3.HeaderMatcher{
+						Name: ":method",
+						HeaderMatchSpecifier: &envoyroutev3.HeaderMatcher_ExactMatch{
+							ExactMatch: method,
+						},
+					},
+				},
+			})
+		}
+		if len(methodRules) == 1 {
+			rules = append(rules, methodRules[0])
+		} else {
+			rules = append(rules, &envoyrbac.Permission{
+				Rule: &envoyrbac.Permission_OrRules{
+					OrRules: &envoyrbac.Permission_Set{Rules: methodRules},
+				},
+			})
+		}
+	}
+
+	switch len(rules) {
+	case 0:
+		return &envoyrbac.Permission{
+			Rule: &envoyrbac.Permission_Any{Any: true},
+		}
+	case 1:
+		return rules[0]
+	}
+	return &envoyrbac.Permission{
+		Rule: &envoyrbac.Permission_AndRules{
+			AndRules: &envoyrbac.Permission_Set{Rules: rules},
+		},
+	}
+}
+
+func exactMatch(value string) *envoymatcher.StringMatcher {
+	return &envoymatcher.StringMatcher{
+		MatchPattern: &envoymatcher.StringMatcher_Exact{Exact: value},
+	}
+}
@@ -0,0 +1,316 @@
+package rbac_test
+
+import (
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyrbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyrbacfilter "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/rbac"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/rbac"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		plugin     *Plugin
+		rbacConfig *rbac.ExtensionSettings
+	)
+
+	BeforeEach(func() {
+		plugin = NewPlugin()
+		Expect(plugin.Init(plugins.InitParams{Settings: &v1.Settings{}})).To(Succeed())
+
+		rbacConfig = &rbac.ExtensionSettings{
+			Policies: map[string]*rbac.Policy{
+				"admins": {
+					Principals: []*rbac.Principal{{
+						AuthenticatedSan: "spiffe://cluster.local/ns/default/sa/admin",
+						SourceIp:         "10.0.0.0/8",
+					}, {
+						Header: &matchers.HeaderMatcher{Name: "x-admin", Value: "true"},
+					}},
+					Permissions: &rbac.Permissions{
+						PathPrefix: "/admin",
+						Methods:    []string{"GET", "POST"},
+					},
+				},
+			},
+		}
+	})
+
+	methodPermission := func(method string) *envoyrbac.Permission {
+		return &envoyrbac.Permission{
+			Rule: &envoyrbac.Permission_Header{
+				Header: &envoyroutev3.HeaderMatcher{
+					Name:                 ":method",
+					HeaderMatchSpecifier: &envoyroutev3.HeaderMatcher_ExactMatch{ExactMatch: method},
+				},
+			},
+		}
+	}
+
+	claimPrincipal := func(provider, claim, value string) *envoyrbac.Principal {
+		metadataPrincipal := func(valueMatcher *envoymatcher.ValueMatcher) *envoyrbac.Principal {
+			return &envoyrbac.Principal{
+				Identifier: &envoyrbac.Principal_Metadata{
+					Metadata: &envoymatcher.MetadataMatcher{
+						Filter: "envoy.filters.http.jwt_authn",
+						Path: []*envoymatcher.MetadataMatcher_PathSegment{
+							{Segment: &envoymatcher.MetadataMatcher_PathSegment_Key{Key: provider}},
+							{Segment: &envoymatcher.MetadataMatcher_PathSegment_Key{Key: claim}},
+						},
+						Value: valueMatcher,
+					},
+				},
+			}
+		}
+		stringMatch := &envoymatcher.ValueMatcher{
+			MatchPattern: &envoymatcher.ValueMatcher_StringMatch{
+				StringMatch: &envoymatcher.StringMatcher{
+					MatchPattern: &envoymatcher.StringMatcher_Exact{Exact: value},
+				},
+			},
+		}
+		return &envoyrbac.Principal{
+			Identifier: &envoyrbac.Principal_OrIds{
+				OrIds: &envoyrbac.Principal_Set{
+					Ids: []*envoyrbac.Principal{
+						metadataPrincipal(stringMatch),
+						metadataPrincipal(&envoymatcher.ValueMatcher{
+							MatchPattern: &envoymatcher.ValueMatcher_ListMatch{
+								ListMatch: &envoymatcher.ListMatcher{
+									MatchPattern: &envoymatcher.ListMatcher_OneOf{OneOf: stringMatch},
+								},
+							},
+						}),
+					},
+				},
+			},
+		}
+	}
+
+	perRouteConfig := func(typedPerFilterConfig map[string]*any.Any) *envoyrbacfilter.RBACPerRoute {
+		Expect(typedPerFilterConfig).To(HaveKey(FilterName))
+		var config envoyrbacfilter.RBACPerRoute
+		Expect(ptypes.UnmarshalAny(typedPerFilterConfig[FilterName], &config)).To(Succeed())
+		return &config
+	}
+
+	processVirtualHost := func(virtualHost *v1.VirtualHost) (*envoyrbacfilter.RBACPerRoute, error) {
+		out := &envoyroute.VirtualHost{}
+		if err := plugin.ProcessVirtualHost(plugins.VirtualHostParams{}, virtualHost, out); err != nil {
+			return nil, err
+		}
+		return perRouteConfig(out.GetTypedPerFilterConfig()), nil
+	}
+
+	Context("filter", func() {
+
+		It("is not added when no virtual host or route uses rbac", func() {
+			filters, err := plugin.HttpFilters(plugins.Params{}, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{{
+					Routes: []*v1.Route{{}},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+
+		It("is added without rules when a route uses rbac", func() {
+			filters, err := plugin.HttpFilters(plugins.Params{}, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{{
+					Routes: []*v1.Route{{
+						Options: &v1.RouteOptions{Rbac: rbacConfig},
+					}},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].Stage).To(Equal(plugins.DuringStage(plugins.AuthZStage)))
+			Expect(filters[0].HttpFilter.Name).To(Equal(FilterName))
+
+			var config envoyrbacfilter.RBAC
+			Expect(translator.ParseTypedConfig(filters[0].HttpFilter, &config)).To(Succeed())
+			Expect(config.GetRules()).To(BeNil())
+		})
+
+		It("denies all requests by default when rbac is required", func() {
+			Expect(plugin.Init(plugins.InitParams{Settings: &v1.Settings{
+				Rbac: &rbac.Settings{RequireRbac: true},
+			}})).To(Succeed())
+
+			filters, err := plugin.HttpFilters(plugins.Params{}, &v1.HttpListener{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+
+			var config envoyrbacfilter.RBAC
+			Expect(translator.ParseTypedConfig(filters[0].HttpFilter, &config)).To(Succeed())
+			Expect(config.GetRules()).To(Equal(&envoyrbac.RBAC{Action: envoyrbac.RBAC_ALLOW}))
+		})
+	})
+
+	It("translates the policies of a virtual host", func() {
+		config, err := processVirtualHost(&v1.VirtualHost{
+			Options: &v1.VirtualHostOptions{Rbac: rbacConfig},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config).To(Equal(&envoyrbacfilter.RBACPerRoute{
+			Rbac: &envoyrbacfilter.RBAC{
+				Rules: &envoyrbac.RBAC{
+					Action: envoyrbac.RBAC_ALLOW,
+					Policies: map[string]*envoyrbac.Policy{
+						"admins": {
+							Principals: []*envoyrbac.Principal{{
+								Identifier: &envoyrbac.Principal_AndIds{
+									AndIds: &envoyrbac.Principal_Set{
+										Ids: []*envoyrbac.Principal{{
+											Identifier: &envoyrbac.Principal_Authenticated_{
+												Authenticated: &envoyrbac.Principal_Authenticated{
+													PrincipalName: &envoymatcher.StringMatcher{
+														MatchPattern: &envoymatcher.StringMatcher_Exact{Exact: "spiffe://cluster.local/ns/default/sa/admin"},
+													},
+												},
+											},
+										}, {
+											Identifier: &envoyrbac.Principal_RemoteIp{
+												RemoteIp: &envoycore.CidrRange{
+													AddressPrefix: "10.0.0.0",
+													PrefixLen:     &wrappers.UInt32Value{Value: 8},
+												},
+											},
+										}},
+									},
+								},
+							}, {
+								Identifier: &envoyrbac.Principal_Header{
+									Header: &envoyroutev3.HeaderMatcher{
+										Name:                 "x-admin",
+										HeaderMatchSpecifier: &envoyroutev3.HeaderMatcher_ExactMatch{ExactMatch: "true"},
+									},
+								},
+							}},
+							Permissions: []*envoyrbac.Permission{{
+								Rule: &envoyrbac.Permission_AndRules{
+									AndRules: &envoyrbac.Permission_Set{
+										Rules: []*envoyrbac.Permission{{
+											Rule: &envoyrbac.Permission_UrlPath{
+												UrlPath: &envoymatcher.PathMatcher{
+													Rule: &envoymatcher.PathMatcher_Path{
+														Path: &envoymatcher.StringMatcher{
+															MatchPattern: &envoymatcher.StringMatcher_Prefix{Prefix: "/admin"},
+														},
+													},
+												},
+											},
+										}, {
+											Rule: &envoyrbac.Permission_OrRules{
+												OrRules: &envoyrbac.Permission_Set{
+													Rules: []*envoyrbac.Permission{methodPermission("GET"), methodPermission("POST")},
+												},
+											},
+										}},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		}))
+	})
+
+	It("only logs the decisions of shadow policies", func() {
+		rbacConfig.Shadow = true
+		config, err := processVirtualHost(&v1.VirtualHost{
+			Options: &v1.VirtualHostOptions{Rbac: rbacConfig},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.GetRbac().GetRules()).To(BeNil())
+		Expect(config.GetRbac().GetShadowRules().GetPolicies()).To(HaveKey("admins"))
+	})
+
+	It("matches the claims of JWTs verified by the providers of the virtual host", func() {
+		rbacConfig.Policies["admins"].Principals = []*rbac.Principal{{
+			JwtPrincipal: &rbac.JWTPrincipal{
+				Claims: map[string]string{"groups": "admins"},
+			},
+		}}
+		rbacConfig.Policies["admins"].Permissions = nil
+
+		config, err := processVirtualHost(&v1.VirtualHost{
+			Options: &v1.VirtualHostOptions{
+				Jwt: &jwt.VhostExtension{
+					Providers: map[string]*jwt.Provider{"employees": {}, "partners": {}},
+				},
+				Rbac: rbacConfig,
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.GetRbac().GetRules().GetPolicies()["admins"]).To(Equal(&envoyrbac.Policy{
+			Principals: []*envoyrbac.Principal{{
+				Identifier: &envoyrbac.Principal_OrIds{
+					OrIds: &envoyrbac.Principal_Set{
+						Ids: []*envoyrbac.Principal{
+							claimPrincipal("employees", "groups", "admins"),
+							claimPrincipal("partners", "groups", "admins"),
+						},
+					},
+				},
+			}},
+			Permissions: []*envoyrbac.Permission{{
+				Rule: &envoyrbac.Permission_Any{Any: true},
+			}},
+		}))
+	})
+
+	It("rejects jwt principals on virtual hosts without jwt providers", func() {
+		rbacConfig.Policies["admins"].Principals = []*rbac.Principal{{
+			JwtPrincipal: &rbac.JWTPrincipal{
+				Claims: map[string]string{"sub": "admin"},
+			},
+		}}
+		_, err := processVirtualHost(&v1.VirtualHost{
+			Options: &v1.VirtualHostOptions{Rbac: rbacConfig},
+		})
+		Expect(err).To(MatchError(NoJwtProvidersError("admins").Error()))
+	})
+
+	It("rejects invalid source ips", func() {
+		rbacConfig.Policies["admins"].Principals[0].SourceIp = "10.0.0"
+		_, err := processVirtualHost(&v1.VirtualHost{
+			Options: &v1.VirtualHostOptions{Rbac: rbacConfig},
+		})
+		Expect(err).To(MatchError(InvalidSourceIpError("admins", "10.0.0").Error()))
+	})
+
+	It("rejects empty principals", func() {
+		rbacConfig.Policies["admins"].Principals = []*rbac.Principal{{}}
+		_, err := processVirtualHost(&v1.VirtualHost{
+			Options: &v1.VirtualHostOptions{Rbac: rbacConfig},
+		})
+		Expect(err).To(MatchError(EmptyPrincipalError("admins").Error()))
+	})
+
+	It("disables rbac on routes", func() {
+		out := &envoyroute.Route{}
+		err := plugin.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				Rbac: &rbac.ExtensionSettings{Disable: true},
+			},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(perRouteConfig(out.GetTypedPerFilterConfig())).To(Equal(&envoyrbacfilter.RBACPerRoute{}))
+	})
+})
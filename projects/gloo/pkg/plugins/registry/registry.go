@@ -3,16 +3,23 @@ package registry
 import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/adaptiveconcurrency"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/admissioncontrol"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/als"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws/ec2"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/azure"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/basicroute"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/buffer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/connectionlimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/cors"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/customfilters"
+	dnsplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/dns"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/docker"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/extauth"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/faultinjection"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/gcp"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpc"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpcjson"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpcweb"
@@ -20,13 +27,16 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/hcm"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/headers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/healthcheck"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/linkerd"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/listener"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/loadbalancer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/localratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pipe"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/protocoloptions"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/rbac"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/rest"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/shadowing"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/static"
@@ -34,11 +44,13 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tcp"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tracing"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/udp"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/upstreamconn"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/upstreamssl"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/virtualhost"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 type registry struct {
@@ -56,12 +68,15 @@ var globalRegistry = func(opts bootstrap.Opts, pluginExtensions ...func() plugin
 		upstreamssl.NewPlugin(),
 		azure.NewPlugin(&transformationPlugin.RequireTransformationFilter),
 		aws.NewPlugin(&transformationPlugin.RequireTransformationFilter),
+		gcp.NewPlugin(&transformationPlugin.RequireTransformationFilter),
 		rest.NewPlugin(&transformationPlugin.RequireTransformationFilter),
 		hcmPlugin,
 		als.NewPlugin(),
 		pipe.NewPlugin(),
 		tcp.NewPlugin(utils.NewSslConfigTranslator()),
+		udp.NewPlugin(),
 		static.NewPlugin(),
+		dnsplugin.NewPlugin(),
 		transformationPlugin,
 		grpcweb.NewPlugin(),
 		grpc.NewPlugin(&transformationPlugin.RequireTransformationFilter),
@@ -80,16 +95,30 @@ var globalRegistry = func(opts bootstrap.Opts, pluginExtensions ...func() plugin
 		wasm.NewPlugin(),
 		gzip.NewPlugin(),
 		buffer.NewPlugin(),
+		adaptiveconcurrency.NewPlugin(),
+		admissioncontrol.NewPlugin(),
+		localratelimit.NewPlugin(),
+		jwt.NewPlugin(),
+		rbac.NewPlugin(),
 		listener.NewPlugin(),
 		virtualhost.NewPlugin(),
 		protocoloptions.NewPlugin(),
 		grpcjson.NewPlugin(),
+		customfilters.NewPlugin(),
+		connectionlimit.NewPlugin(),
 	)
 	if opts.KubeClient != nil {
 		reg.plugins = append(reg.plugins, kubernetes.NewPlugin(opts.KubeClient, opts.KubeCoreCache))
 	}
 	if opts.Consul.ConsulWatcher != nil {
-		reg.plugins = append(reg.plugins, consul.NewPlugin(opts.Consul.ConsulWatcher, &consul.ConsulDnsResolver{DnsAddress: opts.Consul.DnsServer}, opts.Consul.DnsPollingInterval))
+		var connectSecretRef *core.ResourceRef
+		if opts.Consul.Connect != nil {
+			connectSecretRef = &opts.Consul.Connect.SecretRef
+		}
+		reg.plugins = append(reg.plugins, consul.NewPlugin(opts.Consul.ConsulWatcher, opts.Consul.DnsResolvers, opts.Consul.DnsPollingInterval, connectSecretRef, opts.Consul.TokenResolver))
+	}
+	if opts.Docker.Client != nil {
+		reg.plugins = append(reg.plugins, docker.NewPlugin(opts.Docker.Client, opts.Docker.Network))
 	}
 	hcmPlugin.RegisterHcmPlugins(reg.plugins)
 
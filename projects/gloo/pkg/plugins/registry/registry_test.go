@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/dlp"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/waf"
 )
 
 func TestPlugins(t *testing.T) {
@@ -19,3 +21,12 @@ func TestPlugins(t *testing.T) {
 		t.Errorf("Multiple plugins with the same type.")
 	}
 }
+
+func TestEnterprisePluginsAreNotRegistered(t *testing.T) {
+	for _, plugin := range Plugins(bootstrap.Opts{}) {
+		switch plugin.(type) {
+		case *waf.Plugin, *dlp.Plugin:
+			t.Errorf("Enterprise plugin %T is registered.", plugin)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package plugins
+
+import "time"
+
+// The configuration is only translated when the resources in the snapshot change. Plugins which put credentials
+// that expire in the envoy configuration request a resync, so that it is translated again before they expire.
+var resyncs = make(chan struct{}, 1)
+
+// RequestResyncAfter requests the configuration to be translated again once the given duration has elapsed,
+// even if no resources changed by then
+func RequestResyncAfter(d time.Duration) {
+	time.AfterFunc(d, func() {
+		select {
+		case resyncs <- struct{}{}:
+		default:
+			// a resync is already pending
+		}
+	})
+}
+
+// Resyncs returns the resync requests of the plugins, for the api emitter to emit the current snapshot again
+func Resyncs() <-chan struct{} {
+	return resyncs
+}
@@ -0,0 +1,20 @@
+package plugins
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Resync", func() {
+
+	It("coalesces the pending resync requests", func() {
+		RequestResyncAfter(0)
+		RequestResyncAfter(0)
+		pending := func() int { return len(resyncs) }
+		Eventually(pending, time.Second).Should(Equal(1))
+		Consistently(pending, 100*time.Millisecond).Should(Equal(1))
+		Expect(Resyncs()).To(Receive())
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/internal/common"
 	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 var (
@@ -56,19 +57,49 @@ func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 }
 
 func applyShadowSpec(out *envoyroute.RouteAction, spec *shadowing.RouteShadowing) error {
-	if spec.Upstream == nil {
-		return UnspecifiedUpstreamError
+	if len(spec.GetMirrors()) == 0 {
+		policy, err := mirrorPolicy(spec.GetUpstream(), spec.GetPercentage())
+		if err != nil {
+			return err
+		}
+		out.RequestMirrorPolicy = policy
+		return nil
 	}
-	if spec.Percentage < 0 || spec.Percentage > 100 {
-		return InvalidNumeratorError(spec.Percentage)
+
+	// envoy doesn't accept both the single and the list of mirror policies, so when there are
+	// several mirrors, all of them go in the list
+	var policies []*envoyroute.RouteAction_RequestMirrorPolicy
+	if spec.GetUpstream() != nil {
+		policy, err := mirrorPolicy(spec.GetUpstream(), spec.GetPercentage())
+		if err != nil {
+			return err
+		}
+		policies = append(policies, policy)
 	}
-	out.RequestMirrorPolicy = &envoyroute.RouteAction_RequestMirrorPolicy{
-		Cluster:         translator.UpstreamToClusterName(*spec.Upstream),
-		RuntimeFraction: getFractionalPercent(spec.Percentage),
+	for _, mirror := range spec.GetMirrors() {
+		policy, err := mirrorPolicy(mirror.GetUpstream(), mirror.GetPercentage())
+		if err != nil {
+			return err
+		}
+		policies = append(policies, policy)
 	}
+	out.RequestMirrorPolicies = policies
 	return nil
 }
 
+func mirrorPolicy(upstream *core.ResourceRef, percentage float32) (*envoyroute.RouteAction_RequestMirrorPolicy, error) {
+	if upstream == nil {
+		return nil, UnspecifiedUpstreamError
+	}
+	if percentage < 0 || percentage > 100 {
+		return nil, InvalidNumeratorError(percentage)
+	}
+	return &envoyroute.RouteAction_RequestMirrorPolicy{
+		Cluster:         translator.UpstreamToClusterName(*upstream),
+		RuntimeFraction: getFractionalPercent(percentage),
+	}, nil
+}
+
 func getFractionalPercent(numerator float32) *envoycore.RuntimeFractionalPercent {
 	return &envoycore.RuntimeFractionalPercent{
 		DefaultValue: common.ToEnvoyv2Percentage(numerator),
@@ -144,6 +144,97 @@ var _ = Describe("Plugin", func() {
 		Expect(err).To(HaveInErrorChain(UnspecifiedUpstreamError))
 	})
 
+	Context("multiple mirrors", func() {
+
+		It("should translate the upstream and the mirrors to a list of mirror policies", func() {
+			p := NewPlugin()
+			in := &v1.Route{
+				Options: &v1.RouteOptions{
+					Shadowing: &shadowing.RouteShadowing{
+						Upstream: &core.ResourceRef{
+							Name:      "some-upstream",
+							Namespace: "default",
+						},
+						Percentage: 100,
+						Mirrors: []*shadowing.RouteMirror{
+							{
+								Upstream: &core.ResourceRef{
+									Name:      "other-upstream",
+									Namespace: "default",
+								},
+								Percentage: 25,
+							},
+							{
+								Upstream: &core.ResourceRef{
+									Name:      "third-upstream",
+									Namespace: "other",
+								},
+								Percentage: 0.5,
+							},
+						},
+					},
+				},
+			}
+			out := &envoyroute.Route{}
+			err := p.ProcessRoute(plugins.RouteParams{}, in, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetRoute().RequestMirrorPolicy).To(BeNil())
+			policies := out.GetRoute().RequestMirrorPolicies
+			Expect(policies).To(HaveLen(3))
+			Expect(policies[0].Cluster).To(Equal("some-upstream_default"))
+			checkFraction(policies[0].RuntimeFraction, 100)
+			Expect(policies[1].Cluster).To(Equal("other-upstream_default"))
+			checkFraction(policies[1].RuntimeFraction, 25)
+			Expect(policies[2].Cluster).To(Equal("third-upstream_other"))
+			checkFraction(policies[2].RuntimeFraction, 0.5)
+		})
+
+		It("should not require an upstream when mirrors are set", func() {
+			p := NewPlugin()
+			in := &v1.Route{
+				Options: &v1.RouteOptions{
+					Shadowing: &shadowing.RouteShadowing{
+						Mirrors: []*shadowing.RouteMirror{{
+							Upstream: &core.ResourceRef{
+								Name:      "other-upstream",
+								Namespace: "default",
+							},
+							Percentage: 10,
+						}},
+					},
+				},
+			}
+			out := &envoyroute.Route{}
+			err := p.ProcessRoute(plugins.RouteParams{}, in, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetRoute().RequestMirrorPolicies).To(HaveLen(1))
+			Expect(out.GetRoute().RequestMirrorPolicies[0].Cluster).To(Equal("other-upstream_default"))
+		})
+
+		It("should error when given invalid mirrors", func() {
+			p := NewPlugin()
+			in := &v1.Route{
+				Options: &v1.RouteOptions{
+					Shadowing: &shadowing.RouteShadowing{
+						Mirrors: []*shadowing.RouteMirror{{
+							Upstream: &core.ResourceRef{
+								Name:      "other-upstream",
+								Namespace: "default",
+							},
+							Percentage: 101,
+						}},
+					},
+				},
+			}
+			err := p.ProcessRoute(plugins.RouteParams{}, in, &envoyroute.Route{})
+			Expect(err).To(HaveInErrorChain(InvalidNumeratorError(101)))
+
+			in.Options.Shadowing.Mirrors = []*shadowing.RouteMirror{{Percentage: 10}}
+			err = p.ProcessRoute(plugins.RouteParams{}, in, &envoyroute.Route{})
+			Expect(err).To(HaveInErrorChain(UnspecifiedUpstreamError))
+		})
+	})
+
 })
 
 func checkFraction(frac *envoycore.RuntimeFractionalPercent, percentage float32) {
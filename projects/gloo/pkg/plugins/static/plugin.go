@@ -1,9 +1,11 @@
 package static
 
 import (
+	"context"
 	"net"
 
 	pbgostruct "github.com/golang/protobuf/ptypes/struct"
+	"github.com/solo-io/gloo/pkg/utils/gogoutils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
@@ -17,7 +19,9 @@ import (
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	v1static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/dns"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 	"github.com/solo-io/solo-kit/pkg/errors"
 )
 
@@ -26,10 +30,15 @@ const (
 	PathFieldName       = "path"
 )
 
-type plugin struct{}
+type plugin struct {
+	// resolves the hostnames of upstreams with nameservers for function discovery
+	resolvers *dns.Resolvers
+}
 
 func NewPlugin() plugins.Plugin {
-	return &plugin{}
+	return &plugin{
+		resolvers: dns.NewResolvers(nil, dns.CacheOptions{NegativeTtl: dns.DefaultNegativeTtl}),
+	}
 }
 
 func (p *plugin) Resolve(u *v1.Upstream) (*url.URL, error) {
@@ -41,7 +50,18 @@ func (p *plugin) Resolve(u *v1.Upstream) (*url.URL, error) {
 		return nil, errors.Errorf("must provide at least 1 host in static spec")
 	}
 
-	return url.Parse(fmt.Sprintf("tcp://%v:%v", staticSpec.Static.Hosts[0].Addr, staticSpec.Static.Hosts[0].Port))
+	addr := staticSpec.Static.Hosts[0].Addr
+	// the nameservers of the upstream can't be used to resolve the URL, so we resolve the host beforehand
+	if nameservers := staticSpec.Static.DnsNameservers; len(nameservers) > 0 && net.ParseIP(addr) == nil {
+		ipAddrs, err := p.resolvers.ForNameservers(nameservers).Resolve(context.TODO(), addr)
+		if err != nil {
+			return nil, err
+		}
+		// arbitrarily default to the first result
+		addr = ipAddrs[0].String()
+	}
+
+	return url.Parse(fmt.Sprintf("tcp://%v:%v", addr, staticSpec.Static.Hosts[0].Port))
 }
 
 func (p *plugin) Init(params plugins.InitParams) error {
@@ -138,6 +158,12 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 
 		// fix issue where ipv6 addr cannot bind
 		out.DnsLookupFamily = envoyapi.Cluster_V4_ONLY
+
+		dnsResolvers, err := pluginutils.EnvoyDnsResolvers(spec.DnsNameservers)
+		if err != nil {
+			return err
+		}
+		out.DnsResolvers = append(out.DnsResolvers, dnsResolvers...)
 	}
 
 	return nil
@@ -147,20 +173,31 @@ func getMetadata(in *v1static.Host) *envoycore.Metadata {
 	if in == nil {
 		return nil
 	}
-	if in.GetHealthCheckConfig().GetPath() != "" {
-		return &envoycore.Metadata{
-			FilterMetadata: map[string]*pbgostruct.Struct{
-				HttpPathCheckerName: {
-					Fields: map[string]*pbgostruct.Value{
-						PathFieldName: {
-							Kind: &pbgostruct.Value_StringValue{
-								StringValue: in.GetHealthCheckConfig().GetPath(),
-							},
-						},
-					},
-				},
+	if len(in.GetMetadata()) == 0 && in.GetHealthCheckConfig().GetPath() == "" {
+		return nil
+	}
+
+	metadata := &envoycore.Metadata{
+		FilterMetadata: make(map[string]*pbgostruct.Struct, len(in.GetMetadata())),
+	}
+	for filterName, filterMetadata := range in.GetMetadata() {
+		metadata.FilterMetadata[filterName] = gogoutils.StructGogoToProto(filterMetadata)
+	}
+	if path := in.GetHealthCheckConfig().GetPath(); path != "" {
+		checkerMetadata, ok := metadata.FilterMetadata[HttpPathCheckerName]
+		if !ok || checkerMetadata == nil {
+			checkerMetadata = &pbgostruct.Struct{}
+			metadata.FilterMetadata[HttpPathCheckerName] = checkerMetadata
+		}
+		if checkerMetadata.Fields == nil {
+			checkerMetadata.Fields = make(map[string]*pbgostruct.Value)
+		}
+		// the health check config takes precedence over the path in the metadata
+		checkerMetadata.Fields[PathFieldName] = &pbgostruct.Value{
+			Kind: &pbgostruct.Value_StringValue{
+				StringValue: path,
 			},
 		}
 	}
-	return nil
+	return metadata
 }
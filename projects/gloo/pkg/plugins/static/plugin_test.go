@@ -3,10 +3,13 @@ package static
 import (
 	envoyendpoint "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/gogo/protobuf/types"
+	pbgostruct "github.com/golang/protobuf/ptypes/struct"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	v1static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/dns"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 
@@ -140,6 +143,25 @@ var _ = Describe("Plugin", func() {
 		})
 	})
 
+	Context("dns nameservers", func() {
+
+		It("configures envoy to resolve the hosts with the nameservers of the upstream", func() {
+			upstreamSpec.DnsNameservers = []string{"10.0.0.53", "10.0.0.54:8600"}
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.DnsResolvers).To(Equal([]*envoycore.Address{
+				udpAddress("10.0.0.53", 53),
+				udpAddress("10.0.0.54", 8600),
+			}))
+		})
+
+		It("rejects nameservers which are not IP addresses", func() {
+			upstreamSpec.DnsNameservers = []string{"nameserver.example.com"}
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).To(MatchError(dns.InvalidNameserverErr("nameserver.example.com")))
+		})
+	})
+
 	Context("health check config", func() {
 		It("health check config gets propagated", func() {
 			upstreamSpec.Hosts[0].HealthCheckConfig = &v1static.Host_HealthCheckConfig{
@@ -149,6 +171,49 @@ var _ = Describe("Plugin", func() {
 			Expect(out.LoadAssignment.Endpoints[0].LbEndpoints[0].Metadata.FilterMetadata[HttpPathCheckerName].Fields[PathFieldName].GetStringValue()).To(Equal("/foo"))
 		})
 
+		It("health check path overrides the one in the host metadata", func() {
+			upstreamSpec.Hosts[0].HealthCheckConfig = &v1static.Host_HealthCheckConfig{
+				Path: "/foo",
+			}
+			upstreamSpec.Hosts[0].Metadata = map[string]*types.Struct{
+				HttpPathCheckerName: {Fields: map[string]*types.Value{
+					PathFieldName: {Kind: &types.Value_StringValue{StringValue: "/bar"}},
+				}},
+			}
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.LoadAssignment.Endpoints[0].LbEndpoints[0].Metadata.FilterMetadata[HttpPathCheckerName].Fields[PathFieldName].GetStringValue()).To(Equal("/foo"))
+			Expect(upstreamSpec.Hosts[0].Metadata[HttpPathCheckerName].Fields[PathFieldName].GetStringValue()).To(Equal("/bar"))
+		})
+
+	})
+
+	Context("metadata", func() {
+		It("has no endpoint metadata by default", func() {
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.LoadAssignment.Endpoints[0].LbEndpoints[0].Metadata).To(BeNil())
+		})
+
+		It("propagates the host metadata to the endpoints", func() {
+			upstreamSpec.Hosts = append(upstreamSpec.Hosts, &v1static.Host{Addr: "localhost", Port: 1235})
+			upstreamSpec.Hosts[0].Metadata = map[string]*types.Struct{
+				"envoy.lb": {Fields: map[string]*types.Value{
+					"version": {Kind: &types.Value_StringValue{StringValue: "v2"}},
+					"weight":  {Kind: &types.Value_NumberValue{NumberValue: 3}},
+				}},
+			}
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			lbEndpoints := out.LoadAssignment.Endpoints[0].LbEndpoints
+			Expect(lbEndpoints).To(HaveLen(2))
+			Expect(lbEndpoints[0].Metadata.FilterMetadata["envoy.lb"]).To(Equal(&pbgostruct.Struct{Fields: map[string]*pbgostruct.Value{
+				"version": {Kind: &pbgostruct.Value_StringValue{StringValue: "v2"}},
+				"weight":  {Kind: &pbgostruct.Value_NumberValue{NumberValue: 3}},
+			}}))
+			Expect(lbEndpoints[1].Metadata).To(BeNil())
+		})
 	})
 
 	Context("ssl", func() {
@@ -187,3 +252,15 @@ var _ = Describe("Plugin", func() {
 		})
 	})
 })
+
+func udpAddress(address string, port uint32) *envoycore.Address {
+	return &envoycore.Address{
+		Address: &envoycore.Address_SocketAddress{
+			SocketAddress: &envoycore.SocketAddress{
+				Protocol:      envoycore.SocketAddress_UDP,
+				Address:       address,
+				PortSpecifier: &envoycore.SocketAddress_PortValue{PortValue: port},
+			},
+		},
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/solo-io/gloo/pkg/utils/gogoutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/als"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 	translatorutil "github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	usconversion "github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
@@ -24,6 +25,9 @@ const (
 	DefaultTcpStatPrefix = "tcp"
 
 	SniFilter = "envoy.filters.network.sni_cluster"
+
+	// the transport protocol detected by the tls inspector
+	TlsTransportProtocol = "tls"
 )
 
 func NewPlugin(sslConfigTranslator utils.SslConfigTranslator) *Plugin {
@@ -39,6 +43,8 @@ var (
 		return eris.Errorf("no destination type was specified for tcp host %v", host)
 	}
 
+	NoWeightError = eris.New("at least one of the weighted destinations of a tcp host must have a weight greater than 0")
+
 	InvalidSecretsError = func(err error, name string) error {
 		return eris.Wrapf(err, "invalid secrets for listener %v", name)
 	}
@@ -129,6 +135,24 @@ func (p *Plugin) tcpProxyFilters(
 		}
 	}
 
+	// the settings of the host override the ones of the listener
+	if hostSettings := host.GetOptions().GetTcpProxySettings(); hostSettings != nil {
+		if hostSettings.GetMaxConnectAttempts() != nil {
+			cfg.MaxConnectAttempts = gogoutils.UInt32GogoToProto(hostSettings.GetMaxConnectAttempts())
+		}
+		if hostSettings.GetIdleTimeout() != nil {
+			cfg.IdleTimeout = gogoutils.DurationStdToProto(hostSettings.GetIdleTimeout())
+		}
+	}
+
+	if accessLoggingService := host.GetOptions().GetAccessLoggingService(); accessLoggingService != nil {
+		accessLogs, err := als.AccessLogs(params, accessLoggingService)
+		if err != nil {
+			return nil, err
+		}
+		cfg.AccessLog = accessLogs
+	}
+
 	if err := translatorutil.ValidateTcpRouteDestinations(params.Snapshot, host.GetDestination()); err != nil {
 		return nil, err
 	}
@@ -194,24 +218,33 @@ func (p *Plugin) convertToWeightedCluster(multiDest *v1.MultiDestination) (*envo
 		return nil, translatorutil.NoDestinationSpecifiedError
 	}
 
-	wc := make([]*envoytcp.TcpProxy_WeightedCluster_ClusterWeight, len(multiDest.GetDestinations()))
-	for i, weightedDest := range multiDest.GetDestinations() {
+	var wc []*envoytcp.TcpProxy_WeightedCluster_ClusterWeight
+	for _, weightedDest := range multiDest.GetDestinations() {
 
 		usRef, err := usconversion.DestinationToUpstreamRef(weightedDest.GetDestination())
 		if err != nil {
 			return nil, err
 		}
 
-		wc[i] = &envoytcp.TcpProxy_WeightedCluster_ClusterWeight{
+		// envoy rejects clusters without weight, they would not receive any connection anyway
+		if weightedDest.GetWeight() == 0 {
+			continue
+		}
+
+		wc = append(wc, &envoytcp.TcpProxy_WeightedCluster_ClusterWeight{
 			Name:   translatorutil.UpstreamToClusterName(*usRef),
 			Weight: weightedDest.GetWeight(),
-		}
+		})
+	}
+	if len(wc) == 0 {
+		return nil, NoWeightError
 	}
 	return &envoytcp.TcpProxy_WeightedCluster{Clusters: wc}, nil
 }
 
 // create a duplicate of the listener filter chain for each ssl cert we want to serve
 // if there is no SSL config on the listener, the envoy listener will have one insecure filter chain
+// if the SSL config has no secrets, the filter chain only matches tls connections, but does not terminate tls
 func (p *Plugin) computerTcpFilterChain(
 	snap *v1.ApiSnapshot,
 	listener *v1.Listener,
@@ -226,6 +259,18 @@ func (p *Plugin) computerTcpFilterChain(
 		}, nil
 	}
 
+	// without secrets, the tls connections selected by the sni domains are passed through to the destination
+	if sslConfig.GetSslSecrets() == nil {
+		return &envoylistener.FilterChain{
+			FilterChainMatch: &envoylistener.FilterChainMatch{
+				ServerNames:       sslConfig.GetSniDomains(),
+				TransportProtocol: TlsTransportProtocol,
+			},
+			Filters:       listenerFilters,
+			UseProxyProto: gogoutils.BoolGogoToProto(listener.GetUseProxyProto()),
+		}, nil
+	}
+
 	downstreamConfig, err := p.sslConfigTranslator.ResolveDownstreamSslConfig(snap.Secrets, sslConfig)
 	if err != nil {
 		return nil, InvalidSecretsError(err, listener.GetName())
@@ -13,6 +13,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/solo-io/gloo/pkg/utils/gogoutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/als"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tcp"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/tcp"
@@ -145,6 +146,54 @@ var _ = Describe("Plugin", func() {
 			Expect(cfg.MaxConnectAttempts).To(Equal(gogoutils.UInt32GogoToProto(tcps.MaxConnectAttempts)))
 		})
 
+		It("overrides the listener tcp plugin settings with the ones of the host", func() {
+			tcpListener.TcpHosts = append(tcpListener.TcpHosts, &v1.TcpHost{
+				Name: "one",
+				Destination: &v1.TcpHost_TcpAction{
+					Destination: &v1.TcpHost_TcpAction_Single{
+						Single: &v1.Destination{
+							DestinationType: &v1.Destination_Upstream{
+								Upstream: &core.ResourceRef{
+									Name:      "one",
+									Namespace: ns,
+								},
+							},
+						},
+					},
+				},
+				Options: &v1.TcpHostOptions{
+					TcpProxySettings: &tcp.TcpProxySettings{
+						MaxConnectAttempts: &types.UInt32Value{
+							Value: 2,
+						},
+					},
+					AccessLoggingService: &als.AccessLoggingService{
+						AccessLog: []*als.AccessLog{{
+							OutputDestination: &als.AccessLog_FileSink{
+								FileSink: &als.FileSink{
+									Path: "/dev/stdout",
+								},
+							},
+						}},
+					},
+				},
+			})
+
+			p := NewPlugin(sslTranslator)
+			filterChains, err := p.ProcessListenerFilterChain(plugins.Params{Snapshot: snap}, in)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filterChains).To(HaveLen(1))
+
+			var cfg envoytcp.TcpProxy
+			err = translatorutil.ParseTypedConfig(filterChains[0].Filters[0], &cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(cfg.IdleTimeout).To(Equal(gogoutils.DurationStdToProto(tcps.IdleTimeout)))
+			Expect(cfg.MaxConnectAttempts.GetValue()).To(Equal(uint32(2)))
+			Expect(cfg.AccessLog).To(HaveLen(1))
+			Expect(cfg.AccessLog[0].Name).To(Equal(wellknown.FileAccessLog))
+		})
+
 		It("can transform a single destination", func() {
 			tcpListener.TcpHosts = append(tcpListener.TcpHosts, &v1.TcpHost{
 				Name: "one",
@@ -198,6 +247,49 @@ var _ = Describe("Plugin", func() {
 			Expect(clusters.Clusters[1].Name).To(Equal(translatorutil.UpstreamToClusterName(core.ResourceRef{Namespace: ns, Name: "two"})))
 			Expect(clusters.Clusters[1].Weight).To(Equal(uint32(1)))
 		})
+		It("drops the destinations without weight", func() {
+			tcpListener.TcpHosts = append(tcpListener.TcpHosts, &v1.TcpHost{
+				Name: "one",
+				Destination: &v1.TcpHost_TcpAction{
+					Destination: &v1.TcpHost_TcpAction_Multi{
+						Multi: &v1.MultiDestination{
+							Destinations: []*v1.WeightedDestination{wd[0], {
+								Destination: wd[1].Destination,
+							}},
+						},
+					},
+				},
+			})
+			p := NewPlugin(sslTranslator)
+			filterChains, err := p.ProcessListenerFilterChain(plugins.Params{Snapshot: snap}, in)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filterChains).To(HaveLen(1))
+
+			var cfg envoytcp.TcpProxy
+			err = translatorutil.ParseTypedConfig(filterChains[0].Filters[0], &cfg)
+			Expect(err).NotTo(HaveOccurred())
+			clusters := cfg.GetWeightedClusters()
+			Expect(clusters.Clusters).To(HaveLen(1))
+			Expect(clusters.Clusters[0].Name).To(Equal(translatorutil.UpstreamToClusterName(core.ResourceRef{Namespace: ns, Name: "one"})))
+		})
+		It("errors when no destination has a weight", func() {
+			tcpListener.TcpHosts = append(tcpListener.TcpHosts, &v1.TcpHost{
+				Name: "one",
+				Destination: &v1.TcpHost_TcpAction{
+					Destination: &v1.TcpHost_TcpAction_Multi{
+						Multi: &v1.MultiDestination{
+							Destinations: []*v1.WeightedDestination{{
+								Destination: wd[0].Destination,
+							}},
+						},
+					},
+				},
+			})
+			p := NewPlugin(sslTranslator)
+			_, err := p.ProcessListenerFilterChain(plugins.Params{Snapshot: snap}, in)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(NoWeightError.Error()))
+		})
 		It("can transform an upstream group", func() {
 			snap.UpstreamGroups = append(snap.UpstreamGroups, &v1.UpstreamGroup{
 				Destinations: wd,
@@ -274,6 +366,44 @@ var _ = Describe("Plugin", func() {
 			Expect(cluster.Cluster).To(Equal(""))
 		})
 
+		It("passes tls through to the destination selected by sni when the ssl config has no secrets", func() {
+			for _, name := range []string{"one", "two"} {
+				tcpListener.TcpHosts = append(tcpListener.TcpHosts, &v1.TcpHost{
+					Name: name,
+					Destination: &v1.TcpHost_TcpAction{
+						Destination: &v1.TcpHost_TcpAction_Single{
+							Single: &v1.Destination{
+								DestinationType: &v1.Destination_Upstream{
+									Upstream: &core.ResourceRef{
+										Name:      name,
+										Namespace: ns,
+									},
+								},
+							},
+						},
+					},
+					SslConfig: &v1.SslConfig{
+						SniDomains: []string{name + ".example.com"},
+					},
+				})
+			}
+
+			p := NewPlugin(sslTranslator)
+			filterChains, err := p.ProcessListenerFilterChain(plugins.Params{Snapshot: snap}, in)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filterChains).To(HaveLen(2))
+
+			for i, name := range []string{"one", "two"} {
+				Expect(filterChains[i].TransportSocket).To(BeNil())
+				Expect(filterChains[i].FilterChainMatch.ServerNames).To(Equal([]string{name + ".example.com"}))
+				Expect(filterChains[i].FilterChainMatch.TransportProtocol).To(Equal(TlsTransportProtocol))
+
+				var cfg envoytcp.TcpProxy
+				err = translatorutil.ParseTypedConfig(filterChains[i].Filters[0], &cfg)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.GetCluster()).To(Equal(translatorutil.UpstreamToClusterName(core.ResourceRef{Namespace: ns, Name: name})))
+			}
+		})
 	})
 
 	Context("ListenerPlugin", func() {
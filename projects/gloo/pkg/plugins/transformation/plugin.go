@@ -21,6 +21,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/solo-kit/pkg/errors"
 )
 
 const (
@@ -31,6 +32,8 @@ const (
 var (
 	earlyPluginStage = plugins.AfterStage(plugins.FaultStage)
 	pluginStage      = plugins.AfterStage(plugins.AuthZStage)
+
+	ListenerResponseTransformsError = errors.Errorf("response_transforms are not supported in the staged transformations of a listener")
 )
 
 type Plugin struct {
@@ -95,21 +98,65 @@ func (p *Plugin) ProcessWeightedDestination(params plugins.RouteParams, in *v1.W
 }
 
 func (p *Plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	listenerTransformations := listener.GetOptions().GetStagedTransformations()
+	earlyRules, err := getListenerTransformations(params.Ctx, listenerTransformations.GetEarly())
+	if err != nil {
+		return nil, err
+	}
+	regularRules, err := getListenerTransformations(params.Ctx, listenerTransformations.GetRegular())
+	if err != nil {
+		return nil, err
+	}
+
 	earlyStageConfig := &envoytransformation.FilterTransformations{
-		Stage: EarlyStageNumber,
+		Stage:           EarlyStageNumber,
+		Transformations: earlyRules,
 	}
 	earlyFilter, err := plugins.NewStagedFilterWithConfig(FilterName, earlyStageConfig, earlyPluginStage)
 	if err != nil {
 		return nil, err
 	}
 	var filters []plugins.StagedHttpFilter
-	if p.requireEarlyTransformation {
+	if p.requireEarlyTransformation || len(earlyRules) > 0 {
 		// only add early transformations if we have to, to allow rolling gloo updates;
 		// i.e. an older envoy without stages connects to gloo, it shouldn't have 2 filters.
 		filters = append(filters, earlyFilter)
 	}
-	filters = append(filters, plugins.NewStagedFilter(FilterName, pluginStage))
-	return filters, nil
+	if len(regularRules) == 0 {
+		return append(filters, plugins.NewStagedFilter(FilterName, pluginStage)), nil
+	}
+	regularFilter, err := plugins.NewStagedFilterWithConfig(FilterName, &envoytransformation.FilterTransformations{
+		Transformations: regularRules,
+	}, pluginStage)
+	if err != nil {
+		return nil, err
+	}
+	return append(filters, regularFilter), nil
+}
+
+// listener level transformations are configured on the filter itself, so they are matched
+// before envoy selects a route for the request.
+func getListenerTransformations(ctx context.Context, transformations *transformation.RequestResponseTransformations) ([]*envoytransformation.TransformationRule, error) {
+	if len(transformations.GetResponseTransforms()) > 0 {
+		return nil, ListenerResponseTransformsError
+	}
+	var rules []*envoytransformation.TransformationRule
+	for _, requestTransform := range transformations.GetRequestTransforms() {
+		match := getRequestMatcher(ctx, requestTransform.GetMatcher())
+		if match == nil {
+			// the filter requires a match, default to matching all requests
+			match = &envoyroutev3.RouteMatch{PathSpecifier: &envoyroutev3.RouteMatch_Prefix{Prefix: "/"}}
+		}
+		rules = append(rules, &envoytransformation.TransformationRule{
+			Match: match,
+			RouteTransformations: &envoytransformation.TransformationRule_Transformations{
+				RequestTransformation:  requestTransform.GetRequestTransformation(),
+				ClearRouteCache:        requestTransform.GetClearRouteCache(),
+				ResponseTransformation: requestTransform.GetResponseTransformation(),
+			},
+		})
+	}
+	return rules, nil
 }
 
 func (p *Plugin) convertTransformation(ctx context.Context, t *transformation.Transformations, stagedTransformations *transformation.TransformationStages) *envoytransformation.RouteTransformations {
@@ -304,4 +304,90 @@ var _ = Describe("Plugin", func() {
 		})
 	})
 
+	Context("listener transformations", func() {
+		var (
+			requestTransform *envoytransformation.Transformation
+		)
+		BeforeEach(func() {
+			p = NewPlugin()
+			requestTransform = &envoytransformation.Transformation{
+				TransformationType: &envoytransformation.Transformation_TransformationTemplate{
+					TransformationTemplate: &envoytransformation.TransformationTemplate{
+						Headers: map[string]*envoytransformation.InjaTemplate{
+							"x-tenant": {Text: "{{ tenant }}"},
+						},
+					},
+				},
+			}
+		})
+		It("configures the filters with the transformations matched before routing", func() {
+			filters, err := p.HttpFilters(plugins.Params{}, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					StagedTransformations: &transformation.TransformationStages{
+						Early: &transformation.RequestResponseTransformations{
+							RequestTransforms: []*transformation.RequestMatch{
+								{
+									ClearRouteCache:       true,
+									RequestTransformation: requestTransform,
+								},
+							},
+						},
+						Regular: &transformation.RequestResponseTransformations{
+							RequestTransforms: []*transformation.RequestMatch{
+								{
+									Matcher:               &matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/foo"}},
+									ClearRouteCache:       true,
+									RequestTransformation: requestTransform,
+								},
+							},
+						},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(2))
+
+			expectedEarly, err := utils.MessageToAny(&envoytransformation.FilterTransformations{
+				Stage: EarlyStageNumber,
+				Transformations: []*envoytransformation.TransformationRule{
+					{
+						Match: &v3.RouteMatch{PathSpecifier: &v3.RouteMatch_Prefix{Prefix: "/"}},
+						RouteTransformations: &envoytransformation.TransformationRule_Transformations{
+							RequestTransformation: requestTransform,
+							ClearRouteCache:       true,
+						},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters[0].HttpFilter.GetTypedConfig()).To(Equal(expectedEarly))
+
+			expectedRegular, err := utils.MessageToAny(&envoytransformation.FilterTransformations{
+				Transformations: []*envoytransformation.TransformationRule{
+					{
+						Match: &v3.RouteMatch{PathSpecifier: &v3.RouteMatch_Prefix{Prefix: "/foo"}},
+						RouteTransformations: &envoytransformation.TransformationRule_Transformations{
+							RequestTransformation: requestTransform,
+							ClearRouteCache:       true,
+						},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters[1].HttpFilter.GetTypedConfig()).To(Equal(expectedRegular))
+		})
+		It("errors on listener response transformations", func() {
+			_, err := p.HttpFilters(plugins.Params{}, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					StagedTransformations: &transformation.TransformationStages{
+						Regular: &transformation.RequestResponseTransformations{
+							ResponseTransforms: []*transformation.ResponseMatch{{ResponseCodeDetails: "abcd"}},
+						},
+					},
+				},
+			})
+			Expect(err).To(Equal(ListenerResponseTransformsError))
+		})
+	})
+
 })
@@ -0,0 +1,101 @@
+package udp
+
+import (
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	"github.com/gogo/protobuf/types"
+	"github.com/rotisserie/eris"
+	envoyudp "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/udp/udp_proxy/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	translatorutil "github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	usconversion "github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+const (
+	DefaultUdpStatPrefix = "udp"
+
+	UdpProxyFilter = "envoy.filters.udp_listener.udp_proxy"
+)
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+var (
+	_ plugins.Plugin         = (*Plugin)(nil)
+	_ plugins.ListenerPlugin = (*Plugin)(nil)
+
+	NoDestinationError = eris.New("no destination was specified for the udp listener")
+)
+
+type Plugin struct{}
+
+func (p *Plugin) Init(_ plugins.InitParams) error {
+	return nil
+}
+
+// ProcessListener adds the udp proxy filter to udp listeners. Envoy runs it as a listener filter,
+// so udp listeners do not have filter chains.
+func (p *Plugin) ProcessListener(params plugins.Params, in *v1.Listener, out *envoyapi.Listener) error {
+	udpListener := in.GetUdpListener()
+	if udpListener == nil {
+		return nil
+	}
+
+	cfg, err := udpProxyConfig(params, udpListener)
+	if err != nil {
+		return err
+	}
+	typedConfig, err := utils.MessageToAny(cfg)
+	if err != nil {
+		return err
+	}
+
+	out.ListenerFilters = append(out.ListenerFilters, &envoylistener.ListenerFilter{
+		Name: UdpProxyFilter,
+		ConfigType: &envoylistener.ListenerFilter_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	})
+	return nil
+}
+
+func udpProxyConfig(params plugins.Params, udpListener *v1.UdpListener) (*envoyudp.UdpProxyConfig, error) {
+	destination := udpListener.GetDestination()
+	if destination == nil {
+		return nil, NoDestinationError
+	}
+	if err := translatorutil.ValidateUdpDestination(params.Snapshot, destination); err != nil {
+		return nil, err
+	}
+	usRef, err := usconversion.DestinationToUpstreamRef(destination)
+	if err != nil {
+		return nil, err
+	}
+
+	statPrefix := udpListener.GetStatPrefix()
+	if statPrefix == "" {
+		statPrefix = DefaultUdpStatPrefix
+	}
+	cfg := &envoyudp.UdpProxyConfig{
+		StatPrefix: statPrefix,
+		RouteSpecifier: &envoyudp.UdpProxyConfig_Cluster{
+			Cluster: translatorutil.UpstreamToClusterName(*usRef),
+		},
+	}
+
+	settings := udpListener.GetOptions().GetUdpProxySettings()
+	if sessionTimeout := settings.GetSessionTimeout(); sessionTimeout != nil {
+		cfg.IdleTimeout = types.DurationProto(*sessionTimeout)
+	}
+	if settings.GetHashPolicy().GetSourceIp() {
+		cfg.HashPolicies = []*envoyudp.UdpProxyConfig_HashPolicy{{
+			PolicySpecifier: &envoyudp.UdpProxyConfig_HashPolicy_SourceIp{
+				SourceIp: true,
+			},
+		}}
+	}
+	return cfg, nil
+}
@@ -0,0 +1,114 @@
+package udp_test
+
+import (
+	"time"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	envoyudp "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/udp/udp_proxy/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/udp"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/udp"
+	translatorutil "github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Plugin", func() {
+	var (
+		p      *Plugin
+		params plugins.Params
+		usRef  core.ResourceRef
+		in     *v1.Listener
+		out    *envoyapi.Listener
+	)
+
+	BeforeEach(func() {
+		p = NewPlugin()
+		usRef = core.ResourceRef{Name: "dns", Namespace: "one"}
+		params = plugins.Params{
+			Snapshot: &v1.ApiSnapshot{
+				Upstreams: v1.UpstreamList{{Metadata: core.Metadata{Name: usRef.Name, Namespace: usRef.Namespace}}},
+			},
+		}
+		in = &v1.Listener{
+			ListenerType: &v1.Listener_UdpListener{
+				UdpListener: &v1.UdpListener{
+					Destination: &v1.Destination{
+						DestinationType: &v1.Destination_Upstream{
+							Upstream: &usRef,
+						},
+					},
+				},
+			},
+		}
+		out = &envoyapi.Listener{}
+	})
+
+	expectUdpProxyConfig := func(cfg *envoyudp.UdpProxyConfig) {
+		Expect(out.ListenerFilters).To(HaveLen(1))
+		Expect(out.ListenerFilters[0].Name).To(Equal(UdpProxyFilter))
+		Expect(out.ListenerFilters[0].GetTypedConfig()).To(Equal(utils.MustMessageToAny(cfg)))
+	}
+
+	It("ignores other listeners", func() {
+		in.ListenerType = &v1.Listener_TcpListener{TcpListener: &v1.TcpListener{}}
+		err := p.ProcessListener(params, in, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.ListenerFilters).To(BeEmpty())
+	})
+
+	It("adds the udp proxy filter forwarding to the upstream", func() {
+		err := p.ProcessListener(params, in, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		expectUdpProxyConfig(&envoyudp.UdpProxyConfig{
+			StatPrefix: DefaultUdpStatPrefix,
+			RouteSpecifier: &envoyudp.UdpProxyConfig_Cluster{
+				Cluster: translatorutil.UpstreamToClusterName(usRef),
+			},
+		})
+	})
+
+	It("translates the session timeout and hash policy", func() {
+		sessionTimeout := 10 * time.Second
+		in.GetUdpListener().Options = &v1.UdpListenerOptions{
+			UdpProxySettings: &udp.UdpProxySettings{
+				SessionTimeout: &sessionTimeout,
+				HashPolicy: &udp.UdpProxySettings_HashPolicy{
+					PolicySpecifier: &udp.UdpProxySettings_HashPolicy_SourceIp{SourceIp: true},
+				},
+			},
+		}
+		in.GetUdpListener().StatPrefix = "syslog"
+		err := p.ProcessListener(params, in, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		expectUdpProxyConfig(&envoyudp.UdpProxyConfig{
+			StatPrefix: "syslog",
+			RouteSpecifier: &envoyudp.UdpProxyConfig_Cluster{
+				Cluster: translatorutil.UpstreamToClusterName(usRef),
+			},
+			IdleTimeout: types.DurationProto(sessionTimeout),
+			HashPolicies: []*envoyudp.UdpProxyConfig_HashPolicy{{
+				PolicySpecifier: &envoyudp.UdpProxyConfig_HashPolicy_SourceIp{SourceIp: true},
+			}},
+		})
+	})
+
+	It("errors without a destination", func() {
+		in.GetUdpListener().Destination = nil
+		err := p.ProcessListener(params, in, out)
+		Expect(err).To(Equal(NoDestinationError))
+	})
+
+	It("errors when the upstream does not exist", func() {
+		params.Snapshot.Upstreams = nil
+		err := p.ProcessListener(params, in, out)
+		Expect(err).To(HaveOccurred())
+		Expect(out.ListenerFilters).To(BeEmpty())
+	})
+})
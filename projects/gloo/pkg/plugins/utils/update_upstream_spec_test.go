@@ -19,14 +19,15 @@ var _ = Describe("UpdateUpstream", func() {
 	It("should preseve config when updating upstreams", func() {
 		desired := &gloov1.Upstream{}
 		original := &gloov1.Upstream{
-			SslConfig:          &gloov1.UpstreamSslConfig{Sni: "testsni"},
-			CircuitBreakers:    &gloov1.CircuitBreakerConfig{MaxConnections: &types.UInt32Value{Value: 6}},
-			LoadBalancerConfig: &gloov1.LoadBalancerConfig{HealthyPanicThreshold: &types.DoubleValue{Value: 7}},
-			ConnectionConfig:   &gloov1.ConnectionConfig{MaxRequestsPerConnection: 8},
-			HealthChecks:       []*envoycore_gloo.HealthCheck{{}},
-			OutlierDetection:   &cluster.OutlierDetection{Consecutive_5Xx: &types.UInt32Value{Value: 9}},
-			Failover:           &gloov1.Failover{PrioritizedLocalities: []*gloov1.Failover_PrioritizedLocality{{}}},
-			UseHttp2:           &types.BoolValue{Value: true},
+			SslConfig:            &gloov1.UpstreamSslConfig{Sni: "testsni"},
+			CircuitBreakers:      &gloov1.CircuitBreakerConfig{MaxConnections: &types.UInt32Value{Value: 6}},
+			LoadBalancerConfig:   &gloov1.LoadBalancerConfig{HealthyPanicThreshold: &types.DoubleValue{Value: 7}},
+			ConnectionConfig:     &gloov1.ConnectionConfig{MaxRequestsPerConnection: 8},
+			HealthChecks:         []*envoycore_gloo.HealthCheck{{}},
+			OutlierDetection:     &cluster.OutlierDetection{Consecutive_5Xx: &types.UInt32Value{Value: 9}},
+			Failover:             &gloov1.Failover{PrioritizedLocalities: []*gloov1.Failover_PrioritizedLocality{{}}},
+			UseHttp2:             &types.BoolValue{Value: true},
+			ProxyProtocolVersion: &types.StringValue{Value: "V1"},
 		}
 		utils.UpdateUpstream(original, desired)
 		Expect(desired.SslConfig).To(Equal(original.SslConfig))
@@ -37,6 +38,7 @@ var _ = Describe("UpdateUpstream", func() {
 		Expect(desired.OutlierDetection).To(Equal(original.OutlierDetection))
 		Expect(desired.Failover).To(Equal(original.Failover))
 		Expect(desired.UseHttp2).To(Equal(original.UseHttp2))
+		Expect(desired.ProxyProtocolVersion).To(Equal(original.ProxyProtocolVersion))
 	})
 
 	It("should update config when one is desired", func() {
@@ -89,7 +91,7 @@ var _ = Describe("UpdateUpstream", func() {
 		// This should happen very rarely, and should be used as an indication that the `UpdateUpstream` function
 		// most likely needs to change.
 		Expect(reflect.TypeOf(gloov1.Upstream{}).NumField()).To(
-			Equal(17),
+			Equal(18),
 			"wrong number of fields found",
 		)
 	})
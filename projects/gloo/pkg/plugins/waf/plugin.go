@@ -0,0 +1,192 @@
+package waf
+
+import (
+	"sort"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+
+	modsecurity "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/waf"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/waf"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+)
+
+const (
+	FilterName = "io.solo.filters.http.modsecurity"
+
+	// the gateway proxy image ships the OWASP core rule set in this directory
+	CoreRuleSetPath = "/etc/owasp-modsecurity-crs"
+)
+
+var pluginStage = plugins.DuringStage(plugins.WafStage)
+
+var (
+	ConfigMapRuleSetError = func(err error, ref *core.ResourceRef) error {
+		return eris.Wrapf(err, "finding config map rule set %s", ref.Key())
+	}
+	EmptyConfigMapRuleSetError = func(ref *core.ResourceRef) error {
+		return eris.Errorf("config map rule set %s does not contain any rules", ref.Key())
+	}
+)
+
+// The WAF filter is only built into the enterprise gateway proxy, so this plugin is not part of the open source
+// plugin registry: it must be added explicitly, as a plugin extension of the gloo syncer.
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+var _ plugins.Plugin = new(Plugin)
+var _ plugins.HttpFilterPlugin = new(Plugin)
+var _ plugins.VirtualHostPlugin = new(Plugin)
+var _ plugins.RoutePlugin = new(Plugin)
+
+type Plugin struct{}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *Plugin) ProcessVirtualHost(params plugins.VirtualHostParams, in *v1.VirtualHost, out *envoyroute.VirtualHost) error {
+	wafSettings := in.GetOptions().GetWaf()
+	if wafSettings == nil {
+		return nil
+	}
+
+	perRouteConfig, err := translatePerRouteConfig(params.Snapshot, wafSettings)
+	if err != nil {
+		return err
+	}
+	return pluginutils.SetVhostPerFilterConfig(out, FilterName, perRouteConfig)
+}
+
+func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoyroute.Route) error {
+	wafSettings := in.GetOptions().GetWaf()
+	if wafSettings == nil {
+		return nil
+	}
+
+	perRouteConfig, err := translatePerRouteConfig(params.Snapshot, wafSettings)
+	if err != nil {
+		return err
+	}
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, perRouteConfig)
+}
+
+func (p *Plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	wafSettings := listener.GetOptions().GetWaf()
+	if wafSettings == nil && !usesWaf(listener) {
+		return nil, nil
+	}
+
+	// without listener settings, the filter only applies the rules of the virtual hosts and routes
+	config := &modsecurity.ModSecurity{}
+	if wafSettings != nil {
+		ruleSets, err := translateRuleSets(params.Snapshot, wafSettings)
+		if err != nil {
+			return nil, err
+		}
+		config = &modsecurity.ModSecurity{
+			Disabled:                  wafSettings.GetDisabled(),
+			RuleSets:                  ruleSets,
+			CustomInterventionMessage: wafSettings.GetCustomInterventionMessage(),
+			AuditLogging:              wafSettings.GetAuditLogging(),
+			RequestHeadersOnly:        wafSettings.GetRequestHeadersOnly(),
+			ResponseHeadersOnly:       wafSettings.GetResponseHeadersOnly(),
+		}
+	}
+
+	wafFilter, err := plugins.NewStagedFilterWithConfig(FilterName, config, pluginStage)
+	if err != nil {
+		return nil, eris.Wrapf(err, "generating filter config")
+	}
+
+	return []plugins.StagedHttpFilter{wafFilter}, nil
+}
+
+func usesWaf(listener *v1.HttpListener) bool {
+	for _, virtualHost := range listener.GetVirtualHosts() {
+		if virtualHost.GetOptions().GetWaf() != nil {
+			return true
+		}
+		for _, route := range virtualHost.GetRoutes() {
+			if route.GetOptions().GetWaf() != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func translatePerRouteConfig(snap *v1.ApiSnapshot, wafSettings *waf.Settings) (*modsecurity.ModSecurityPerRoute, error) {
+	// disabled resources do not need their rules to be valid
+	if wafSettings.GetDisabled() {
+		return &modsecurity.ModSecurityPerRoute{Disabled: true}, nil
+	}
+
+	ruleSets, err := translateRuleSets(snap, wafSettings)
+	if err != nil {
+		return nil, err
+	}
+	return &modsecurity.ModSecurityPerRoute{
+		RuleSets:                  ruleSets,
+		CustomInterventionMessage: wafSettings.GetCustomInterventionMessage(),
+		AuditLogging:              wafSettings.GetAuditLogging(),
+		RequestHeadersOnly:        wafSettings.GetRequestHeadersOnly(),
+		ResponseHeadersOnly:       wafSettings.GetResponseHeadersOnly(),
+	}, nil
+}
+
+// later rule sets can override the directives of earlier ones, so the user's rules come last
+func translateRuleSets(snap *v1.ApiSnapshot, wafSettings *waf.Settings) ([]*modsecurity.RuleSet, error) {
+	var ruleSets []*modsecurity.RuleSet
+	if coreRuleSet := wafSettings.GetCoreRuleSet(); coreRuleSet != nil {
+		ruleSets = append(ruleSets, translateCoreRuleSet(coreRuleSet)...)
+	}
+
+	for _, ref := range wafSettings.GetConfigMapRuleSets() {
+		ruleSet, err := translateConfigMapRuleSet(snap, ref)
+		if err != nil {
+			return nil, err
+		}
+		ruleSets = append(ruleSets, ruleSet)
+	}
+
+	return append(ruleSets, wafSettings.GetRuleSets()...), nil
+}
+
+func translateCoreRuleSet(coreRuleSet *waf.CoreRuleSet) []*modsecurity.RuleSet {
+	// the custom settings configure the rules, so they are loaded first
+	var ruleSets []*modsecurity.RuleSet
+	switch customSettings := coreRuleSet.GetCustomSettingsType().(type) {
+	case *waf.CoreRuleSet_CustomSettingsString:
+		ruleSets = append(ruleSets, &modsecurity.RuleSet{RuleStr: customSettings.CustomSettingsString})
+	case *waf.CoreRuleSet_CustomSettingsFile:
+		ruleSets = append(ruleSets, &modsecurity.RuleSet{Files: []string{customSettings.CustomSettingsFile}})
+	}
+	return append(ruleSets, &modsecurity.RuleSet{Directory: CoreRuleSetPath + "/rules"})
+}
+
+func translateConfigMapRuleSet(snap *v1.ApiSnapshot, ref *core.ResourceRef) (*modsecurity.RuleSet, error) {
+	artifact, err := snap.Artifacts.Find(ref.Strings())
+	if err != nil {
+		return nil, ConfigMapRuleSetError(err, ref)
+	}
+
+	var keys []string
+	for key := range artifact.GetData() {
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, EmptyConfigMapRuleSetError(ref)
+	}
+	sort.Strings(keys)
+
+	var rules string
+	for _, key := range keys {
+		rules += artifact.GetData()[key] + "\n"
+	}
+	return &modsecurity.RuleSet{RuleStr: rules}, nil
+}
@@ -0,0 +1,214 @@
+package waf_test
+
+import (
+	"context"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/proto"
+	pany "github.com/golang/protobuf/ptypes/any"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	modsecurity "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/waf"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/waf"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/waf"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Plugin", func() {
+
+	const scammerRule = `SecRule REQUEST_HEADERS:User-Agent "scammer" "deny,status:403,id:107,phase:1"`
+
+	auditLogging := func() *modsecurity.AuditLogging {
+		return &modsecurity.AuditLogging{
+			Action:   modsecurity.AuditLogging_ALWAYS,
+			Location: modsecurity.AuditLogging_DYNAMIC_METADATA,
+		}
+	}
+
+	var (
+		params      plugins.Params
+		wafSettings *waf.Settings
+	)
+
+	BeforeEach(func() {
+		params = plugins.Params{
+			Ctx: context.Background(),
+			Snapshot: &v1.ApiSnapshot{
+				Artifacts: v1.ArtifactList{{
+					Metadata: core.Metadata{Name: "crs-rules", Namespace: "gloo-system"},
+					Data: map[string]string{
+						"b.conf": "SecRule ARGS b",
+						"a.conf": "SecRule ARGS a",
+					},
+				}},
+			},
+		}
+
+		wafSettings = &waf.Settings{
+			CustomInterventionMessage: "blocked",
+			CoreRuleSet: &waf.CoreRuleSet{
+				CustomSettingsType: &waf.CoreRuleSet_CustomSettingsString{CustomSettingsString: "SecRuleEngine On"},
+			},
+			ConfigMapRuleSets: []*core.ResourceRef{{Name: "crs-rules", Namespace: "gloo-system"}},
+			RuleSets:          []*modsecurity.RuleSet{{RuleStr: scammerRule}},
+			AuditLogging:      auditLogging(),
+		}
+	})
+
+	expectedRuleSets := func() []*modsecurity.RuleSet {
+		return []*modsecurity.RuleSet{
+			{RuleStr: "SecRuleEngine On"},
+			{Directory: CoreRuleSetPath + "/rules"},
+			{RuleStr: "SecRule ARGS a\nSecRule ARGS b\n"},
+			{RuleStr: scammerRule},
+		}
+	}
+
+	filterConfig := func(filters []plugins.StagedHttpFilter) *modsecurity.ModSecurity {
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].Stage).To(Equal(plugins.DuringStage(plugins.WafStage)))
+		Expect(filters[0].HttpFilter.Name).To(Equal(FilterName))
+		var config modsecurity.ModSecurity
+		Expect(proto.Unmarshal(filters[0].HttpFilter.GetTypedConfig().GetValue(), &config)).To(Succeed())
+		return &config
+	}
+
+	perFilterConfig := func(typedPerFilterConfig map[string]*pany.Any) *modsecurity.ModSecurityPerRoute {
+		Expect(typedPerFilterConfig).To(HaveKey(FilterName))
+		var config modsecurity.ModSecurityPerRoute
+		Expect(proto.Unmarshal(typedPerFilterConfig[FilterName].GetValue(), &config)).To(Succeed())
+		return &config
+	}
+
+	Context("filter", func() {
+
+		It("is not added without waf settings", func() {
+			filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{{
+					Routes: []*v1.Route{{}},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+
+		It("translates the listener settings", func() {
+			filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{Waf: wafSettings},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filterConfig(filters)).To(Equal(&modsecurity.ModSecurity{
+				RuleSets:                  expectedRuleSets(),
+				CustomInterventionMessage: "blocked",
+				AuditLogging:              auditLogging(),
+			}))
+		})
+
+		It("is added without rules when only a route uses waf", func() {
+			filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{{
+					Routes: []*v1.Route{{
+						Options: &v1.RouteOptions{Waf: wafSettings},
+					}},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filterConfig(filters)).To(Equal(&modsecurity.ModSecurity{}))
+		})
+
+		It("loads the custom core rule set settings file before the rules", func() {
+			wafSettings.CoreRuleSet = &waf.CoreRuleSet{
+				CustomSettingsType: &waf.CoreRuleSet_CustomSettingsFile{CustomSettingsFile: "/etc/crs-setup.conf"},
+			}
+			wafSettings.ConfigMapRuleSets = nil
+			wafSettings.RuleSets = nil
+
+			filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{Waf: wafSettings},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filterConfig(filters).GetRuleSets()).To(Equal([]*modsecurity.RuleSet{
+				{Files: []string{"/etc/crs-setup.conf"}},
+				{Directory: CoreRuleSetPath + "/rules"},
+			}))
+		})
+
+		It("errors when a config map rule set does not exist", func() {
+			wafSettings.ConfigMapRuleSets = []*core.ResourceRef{{Name: "missing", Namespace: "gloo-system"}}
+
+			_, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{Waf: wafSettings},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("finding config map rule set gloo-system.missing"))
+		})
+
+		It("errors when a config map rule set is empty", func() {
+			params.Snapshot.Artifacts[0].Data = nil
+
+			_, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{Waf: wafSettings},
+			})
+			Expect(err).To(MatchError(EmptyConfigMapRuleSetError(wafSettings.GetConfigMapRuleSets()[0])))
+		})
+	})
+
+	Context("per filter config", func() {
+
+		It("translates the virtual host settings", func() {
+			out := &envoyroute.VirtualHost{}
+			err := NewPlugin().ProcessVirtualHost(plugins.VirtualHostParams{Params: params}, &v1.VirtualHost{
+				Options: &v1.VirtualHostOptions{Waf: wafSettings},
+			}, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(perFilterConfig(out.GetTypedPerFilterConfig())).To(Equal(&modsecurity.ModSecurityPerRoute{
+				RuleSets:                  expectedRuleSets(),
+				CustomInterventionMessage: "blocked",
+				AuditLogging:              auditLogging(),
+			}))
+		})
+
+		It("translates the route settings", func() {
+			wafSettings.RequestHeadersOnly = true
+			wafSettings.ResponseHeadersOnly = true
+
+			out := &envoyroute.Route{}
+			err := NewPlugin().ProcessRoute(plugins.RouteParams{
+				VirtualHostParams: plugins.VirtualHostParams{Params: params},
+			}, &v1.Route{
+				Options: &v1.RouteOptions{Waf: wafSettings},
+			}, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(perFilterConfig(out.GetTypedPerFilterConfig())).To(Equal(&modsecurity.ModSecurityPerRoute{
+				RuleSets:                  expectedRuleSets(),
+				CustomInterventionMessage: "blocked",
+				AuditLogging:              auditLogging(),
+				RequestHeadersOnly:        true,
+				ResponseHeadersOnly:       true,
+			}))
+		})
+
+		It("disables the rules of a route without checking them", func() {
+			wafSettings.Disabled = true
+			wafSettings.ConfigMapRuleSets = []*core.ResourceRef{{Name: "missing", Namespace: "gloo-system"}}
+
+			out := &envoyroute.Route{}
+			err := NewPlugin().ProcessRoute(plugins.RouteParams{
+				VirtualHostParams: plugins.VirtualHostParams{Params: params},
+			}, &v1.Route{
+				Options: &v1.RouteOptions{Waf: wafSettings},
+			}, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(perFilterConfig(out.GetTypedPerFilterConfig())).To(Equal(&modsecurity.ModSecurityPerRoute{Disabled: true}))
+		})
+
+		It("does nothing without waf settings", func() {
+			out := &envoyroute.Route{}
+			err := NewPlugin().ProcessRoute(plugins.RouteParams{}, &v1.Route{}, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetTypedPerFilterConfig()).To(BeEmpty())
+		})
+	})
+})
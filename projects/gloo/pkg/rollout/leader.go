@@ -0,0 +1,70 @@
+package rollout
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/solo-io/go-utils/contextutils"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+const (
+	// the name of the config map the replicas of gloo elect the leader which advances the rollouts with
+	LeaderElectionLockName = "gloo-rollout-leader"
+
+	leaseDuration = 15 * time.Second
+	renewDeadline = 10 * time.Second
+	retryPeriod   = 2 * time.Second
+)
+
+// RunAsLeader runs the controller only while this replica holds the leader lock in the given namespace, so that
+// a single replica of gloo advances the rollouts. If the kube client is nil, the controller is run right away.
+func (c *Controller) RunAsLeader(ctx context.Context, kube kubernetes.Interface, namespace string) {
+	if kube == nil {
+		c.Run(ctx)
+		return
+	}
+	logger := contextutils.LoggerFrom(contextutils.WithLogger(ctx, "rollout"))
+
+	identity, err := os.Hostname()
+	if err != nil {
+		logger.Errorw("failed to get the hostname for the leader election of the rollout controller", "error", err)
+		return
+	}
+	lock, err := resourcelock.New(
+		resourcelock.ConfigMapsResourceLock,
+		namespace,
+		LeaderElectionLockName,
+		kube.CoreV1(),
+		kube.CoordinationV1(),
+		resourcelock.ResourceLockConfig{Identity: identity},
+	)
+	if err != nil {
+		logger.Errorw("failed to create the leader lock of the rollout controller", "error", err)
+		return
+	}
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   leaseDuration,
+		RenewDeadline:   renewDeadline,
+		RetryPeriod:     retryPeriod,
+		ReleaseOnCancel: true,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: c.Run,
+			OnStoppedLeading: func() {
+				logger.Infow("stopped leading the rollouts", "identity", identity)
+			},
+		},
+	})
+	if err != nil {
+		logger.Errorw("failed to create the leader elector of the rollout controller", "error", err)
+		return
+	}
+	// the elector returns when the leadership is lost, in which case this replica campaigns again
+	for ctx.Err() == nil {
+		elector.Run(ctx)
+	}
+}
@@ -0,0 +1,109 @@
+package rollout
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/rotisserie/eris"
+)
+
+var (
+	QueryFailedError = func(status, errorType, message string) error {
+		return eris.Errorf("prometheus query failed with status %s: %s: %s", status, errorType, message)
+	}
+
+	UnexpectedResultTypeError = func(resultType string) error {
+		return eris.Errorf("the prometheus query returned a %s, expected a scalar or a vector with a single sample", resultType)
+	}
+
+	NotSingleSampleError = func(samples int) error {
+		return eris.Errorf("the prometheus query returned %d samples, expected a single one", samples)
+	}
+
+	InvalidSampleError = eris.New("the prometheus query returned an invalid sample")
+)
+
+// MetricQuerier evaluates the queries of the metric guards of rollouts.
+type MetricQuerier interface {
+	// Query evaluates the query against the Prometheus server at the given address, and returns its single value.
+	Query(ctx context.Context, address, query string) (float64, error)
+}
+
+type prometheusQuerier struct {
+	client *http.Client
+}
+
+// NewPrometheusQuerier creates a querier which evaluates instant queries with the HTTP API of Prometheus.
+func NewPrometheusQuerier(client *http.Client) MetricQuerier {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &prometheusQuerier{client: client}
+}
+
+// the parts of the response of the query endpoint which are needed
+type prometheusResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+func (q *prometheusQuerier) Query(ctx context.Context, address, query string) (float64, error) {
+	queryUrl := strings.TrimSuffix(address, "/") + "/api/v1/query?" + url.Values{"query": {query}}.Encode()
+	req, err := http.NewRequest(http.MethodGet, queryUrl, nil)
+	if err != nil {
+		return 0, eris.Wrapf(err, "creating the prometheus query request")
+	}
+	res, err := q.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, eris.Wrapf(err, "querying prometheus at %v", address)
+	}
+	defer res.Body.Close()
+
+	var body prometheusResponse
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return 0, eris.Wrapf(err, "decoding the prometheus response with status %v", res.Status)
+	}
+	if body.Status != "success" {
+		return 0, QueryFailedError(res.Status, body.ErrorType, body.Error)
+	}
+
+	// samples are pairs of a timestamp and a value, which is a string
+	var sample []interface{}
+	switch body.Data.ResultType {
+	case "scalar":
+		if err := json.Unmarshal(body.Data.Result, &sample); err != nil {
+			return 0, eris.Wrapf(err, "decoding the prometheus scalar")
+		}
+	case "vector":
+		var vector []struct {
+			Value []interface{} `json:"value"`
+		}
+		if err := json.Unmarshal(body.Data.Result, &vector); err != nil {
+			return 0, eris.Wrapf(err, "decoding the prometheus vector")
+		}
+		if len(vector) != 1 {
+			return 0, NotSingleSampleError(len(vector))
+		}
+		sample = vector[0].Value
+	default:
+		return 0, UnexpectedResultTypeError(body.Data.ResultType)
+	}
+
+	if len(sample) != 2 {
+		return 0, InvalidSampleError
+	}
+	value, ok := sample[1].(string)
+	if !ok {
+		return 0, InvalidSampleError
+	}
+	return strconv.ParseFloat(value, 64)
+}
@@ -0,0 +1,60 @@
+package rollout
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Prometheus querier", func() {
+
+	var (
+		server   *httptest.Server
+		response string
+		query    string
+	)
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Path).To(Equal("/api/v1/query"))
+			query = r.URL.Query().Get("query")
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(response))
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("returns the value of a vector with a single sample", func() {
+		response = `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1601510400.1,"0.25"]}]}}`
+		value, err := NewPrometheusQuerier(nil).Query(context.Background(), server.URL, `sum(rate(errors[1m]))`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(Equal(0.25))
+		Expect(query).To(Equal(`sum(rate(errors[1m]))`))
+	})
+
+	It("returns the value of a scalar", func() {
+		response = `{"status":"success","data":{"resultType":"scalar","result":[1601510400.1,"3"]}}`
+		value, err := NewPrometheusQuerier(nil).Query(context.Background(), server.URL+"/", "scalar(errors)")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(Equal(3.0))
+	})
+
+	It("fails if there is no data", func() {
+		response = `{"status":"success","data":{"resultType":"vector","result":[]}}`
+		_, err := NewPrometheusQuerier(nil).Query(context.Background(), server.URL, "errors")
+		Expect(err).To(MatchError(NotSingleSampleError(0).Error()))
+	})
+
+	It("fails with the error of the query", func() {
+		response = `{"status":"error","errorType":"bad_data","error":"parse error"}`
+		_, err := NewPrometheusQuerier(nil).Query(context.Background(), server.URL, "errors{")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("parse error"))
+	})
+})
@@ -0,0 +1,216 @@
+package rollout
+
+import (
+	"context"
+	"hash/fnv"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+)
+
+const (
+	// the phase of the rollout of an upstream group
+	PhaseAnnotation = "rollout.gloo.solo.io/phase"
+	// when the weight of the canary was last increased, in RFC 3339 format
+	LastStepAnnotation = "rollout.gloo.solo.io/last-step"
+	// the hash of the rollout the phase is for, the rollout starts over when it changes
+	SpecHashAnnotation = "rollout.gloo.solo.io/spec-hash"
+	// why the rollout was aborted
+	ReasonAnnotation = "rollout.gloo.solo.io/reason"
+
+	// the weight of the canary is increased step by step
+	PhaseProgressing = "Progressing"
+	// the canary receives the max weight
+	PhaseSucceeded = "Succeeded"
+	// the metric guard exceeded its max value, and the traffic was shifted back off the canary
+	PhaseAborted = "Aborted"
+
+	DefaultStepWeight = 10
+	DefaultInterval   = time.Minute
+	DefaultMaxWeight  = 100
+)
+
+// the rollouts are checked on this interval
+var DefaultResyncPeriod = 5 * time.Second
+
+var _ v1.ApiSyncer = new(Controller)
+
+// Controller progressively shifts the traffic of upstream groups with a rollout to their canaries. Every interval of
+// a rollout, the weight of its canary is increased by a step, until it reaches the max weight, or the metric guard
+// of the rollout exceeds its max value, in which case the traffic is shifted back to the other destinations.
+// The state of a rollout is kept in the annotations of its upstream group.
+type Controller struct {
+	client  v1.UpstreamGroupClient
+	querier MetricQuerier
+	// the current time, replaced in tests
+	now func() time.Time
+
+	lock           sync.Mutex
+	upstreamGroups v1.UpstreamGroupList
+}
+
+func NewController(client v1.UpstreamGroupClient, querier MetricQuerier) *Controller {
+	return &Controller{
+		client:  client,
+		querier: querier,
+		now:     time.Now,
+	}
+}
+
+// Sync keeps the upstream groups of the snapshot, which the rollouts are resynced from.
+func (c *Controller) Sync(_ context.Context, snap *v1.ApiSnapshot) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.upstreamGroups = snap.UpstreamGroups
+	return nil
+}
+
+// Run resyncs the rollouts periodically, until the context is done.
+func (c *Controller) Run(ctx context.Context) {
+	ctx = contextutils.WithLogger(ctx, "rollout")
+	ticker := time.NewTicker(DefaultResyncPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.Resync(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Resync advances the rollouts of the upstream groups of the last snapshot which are due.
+func (c *Controller) Resync(ctx context.Context) {
+	logger := contextutils.LoggerFrom(ctx)
+
+	c.lock.Lock()
+	upstreamGroups := c.upstreamGroups
+	c.lock.Unlock()
+
+	for _, ug := range upstreamGroups {
+		desired, err := c.advance(ctx, ug)
+		if err != nil {
+			logger.Warnw("failed to advance rollout", "upstreamGroup", ug.GetMetadata().Ref().Key(), "error", err)
+			continue
+		}
+		if desired == nil {
+			continue
+		}
+		if _, err := c.client.Write(desired, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true}); err != nil {
+			logger.Warnw("failed to write upstream group", "upstreamGroup", ug.GetMetadata().Ref().Key(), "error", err)
+		}
+	}
+}
+
+// returns the upstream group with its rollout advanced, or nil if it is unchanged
+func (c *Controller) advance(ctx context.Context, ug *v1.UpstreamGroup) (*v1.UpstreamGroup, error) {
+	rollout := ug.GetRollout()
+	if rollout == nil {
+		return nil, nil
+	}
+	canary := CanaryIndex(ug)
+	if canary < 0 {
+		// reported by the translator
+		return nil, nil
+	}
+
+	specHash, err := hashRollout(rollout)
+	if err != nil {
+		return nil, err
+	}
+	annotations := ug.GetMetadata().Annotations
+	restarted := annotations[SpecHashAnnotation] != specHash
+	if !restarted {
+		switch annotations[PhaseAnnotation] {
+		case PhaseSucceeded, PhaseAborted:
+			return nil, nil
+		}
+		if lastStep, err := time.Parse(time.RFC3339, annotations[LastStepAnnotation]); err == nil &&
+			c.now().Sub(lastStep) < interval(rollout) {
+			return nil, nil
+		}
+	}
+
+	desired := proto.Clone(ug).(*v1.UpstreamGroup)
+	desiredAnnotations := map[string]string{}
+	for key, value := range annotations {
+		desiredAnnotations[key] = value
+	}
+	desired.Metadata.Annotations = desiredAnnotations
+	desiredAnnotations[SpecHashAnnotation] = specHash
+	delete(desiredAnnotations, ReasonAnnotation)
+
+	current := canaryPercent(ug.GetDestinations(), canary)
+	if guard := rollout.GetMetricGuard(); guard != nil && current > 0 && !restarted {
+		value, err := c.querier.Query(ctx, guard.GetPrometheusAddress(), guard.GetQuery())
+		if err != nil {
+			// the rollout is held until the guard can be checked
+			return nil, err
+		}
+		if value > guard.GetMaxValue() {
+			setCanaryPercent(desired.GetDestinations(), canary, 0)
+			desiredAnnotations[PhaseAnnotation] = PhaseAborted
+			desiredAnnotations[ReasonAnnotation] = "the value " + strconv.FormatFloat(value, 'g', -1, 64) +
+				" of the metric guard exceeded its max value " + strconv.FormatFloat(guard.GetMaxValue(), 'g', -1, 64)
+			return desired, nil
+		}
+	}
+
+	maxWeight := maxWeight(rollout)
+	next := current + stepWeight(rollout)
+	if restarted {
+		// a new rollout starts with the first step
+		next = stepWeight(rollout)
+	}
+	if next > maxWeight {
+		next = maxWeight
+	}
+	if current >= maxWeight && !restarted {
+		desiredAnnotations[PhaseAnnotation] = PhaseSucceeded
+		return desired, nil
+	}
+	setCanaryPercent(desired.GetDestinations(), canary, next)
+	desiredAnnotations[PhaseAnnotation] = PhaseProgressing
+	desiredAnnotations[LastStepAnnotation] = c.now().UTC().Format(time.RFC3339)
+	return desired, nil
+}
+
+func hashRollout(rollout *v1.UpstreamGroupRollout) (string, error) {
+	hash, err := rollout.Hash(fnv.New64())
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatUint(hash, 16), nil
+}
+
+func stepWeight(rollout *v1.UpstreamGroupRollout) uint32 {
+	if rollout.GetStepWeight() == 0 {
+		return DefaultStepWeight
+	}
+	return rollout.GetStepWeight()
+}
+
+func maxWeight(rollout *v1.UpstreamGroupRollout) uint32 {
+	if rollout.GetMaxWeight() == 0 {
+		return DefaultMaxWeight
+	}
+	return rollout.GetMaxWeight()
+}
+
+func interval(rollout *v1.UpstreamGroupRollout) time.Duration {
+	if rollout.GetInterval() == nil {
+		return DefaultInterval
+	}
+	interval, err := types.DurationFromProto(rollout.GetInterval())
+	if err != nil || interval <= 0 {
+		return DefaultInterval
+	}
+	return interval
+}
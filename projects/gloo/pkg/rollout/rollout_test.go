@@ -0,0 +1,327 @@
+package rollout
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type fakeQuerier struct {
+	value float64
+	err   error
+}
+
+func (q *fakeQuerier) Query(_ context.Context, _, _ string) (float64, error) {
+	return q.value, q.err
+}
+
+var _ = Describe("Rollout", func() {
+
+	destination := func(name string, weight uint32) *v1.WeightedDestination {
+		return &v1.WeightedDestination{
+			Destination: &v1.Destination{
+				DestinationType: &v1.Destination_Upstream{
+					Upstream: &core.ResourceRef{Name: name},
+				},
+			},
+			Weight: weight,
+		}
+	}
+
+	weights := func(ug *v1.UpstreamGroup) []uint32 {
+		var out []uint32
+		for _, dest := range ug.GetDestinations() {
+			out = append(out, dest.GetWeight())
+		}
+		return out
+	}
+
+	Context("weights", func() {
+
+		It("shifts the rest of the traffic in proportion to the weights of the other destinations", func() {
+			destinations := []*v1.WeightedDestination{destination("a", 1), destination("b", 2), destination("canary", 0)}
+			setCanaryPercent(destinations, 2, 10)
+			Expect(weights(&v1.UpstreamGroup{Destinations: destinations})).To(Equal([]uint32{30, 60, 10}))
+			Expect(canaryPercent(destinations, 2)).To(BeEquivalentTo(10))
+		})
+
+		It("shares the rest equally if the other destinations have no weight", func() {
+			destinations := []*v1.WeightedDestination{destination("a", 0), destination("b", 0), destination("canary", 0)}
+			setCanaryPercent(destinations, 2, 25)
+			Expect(weights(&v1.UpstreamGroup{Destinations: destinations})).To(Equal([]uint32{38, 37, 25}))
+		})
+
+		It("finds the canary by its upstream and subset, in the namespace of the upstream group", func() {
+			ug := &v1.UpstreamGroup{
+				Metadata:     core.Metadata{Name: "ug", Namespace: "ns"},
+				Destinations: []*v1.WeightedDestination{destination("a", 1), destination("canary", 1)},
+				Rollout: &v1.UpstreamGroupRollout{
+					Canary: &v1.Destination{
+						DestinationType: &v1.Destination_Upstream{
+							Upstream: &core.ResourceRef{Name: "canary", Namespace: "ns"},
+						},
+					},
+				},
+			}
+			Expect(CanaryIndex(ug)).To(Equal(1))
+
+			ug.Rollout.Canary.Subset = &v1.Subset{Values: map[string]string{"version": "v2"}}
+			Expect(CanaryIndex(ug)).To(Equal(-1))
+		})
+	})
+
+	Context("controller", func() {
+
+		var (
+			ctx        context.Context
+			cancel     context.CancelFunc
+			client     v1.UpstreamGroupClient
+			querier    *fakeQuerier
+			controller *Controller
+			now        time.Time
+		)
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+			var err error
+			client, err = v1.NewUpstreamGroupClient(&factory.MemoryResourceClientFactory{
+				Cache: memory.NewInMemoryResourceCache(),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			querier = &fakeQuerier{}
+			controller = NewController(client, querier)
+			now = time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)
+			controller.now = func() time.Time { return now }
+		})
+
+		AfterEach(func() {
+			cancel()
+		})
+
+		writeUpstreamGroup := func(rollout *v1.UpstreamGroupRollout) {
+			_, err := client.Write(&v1.UpstreamGroup{
+				Metadata:     core.Metadata{Name: "ug", Namespace: "ns"},
+				Destinations: []*v1.WeightedDestination{destination("stable", 1), destination("canary", 0)},
+				Rollout:      rollout,
+			}, clients.WriteOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		// syncs the controller with the current upstream groups, and resyncs the rollouts
+		resync := func() *v1.UpstreamGroup {
+			upstreamGroups, err := client.List("ns", clients.ListOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(controller.Sync(ctx, &v1.ApiSnapshot{UpstreamGroups: upstreamGroups})).To(Succeed())
+			controller.Resync(ctx)
+			ug, err := client.Read("ns", "ug", clients.ReadOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			return ug
+		}
+
+		canary := &v1.Destination{
+			DestinationType: &v1.Destination_Upstream{
+				Upstream: &core.ResourceRef{Name: "canary"},
+			},
+		}
+
+		It("shifts the traffic to the canary step by step, every interval", func() {
+			writeUpstreamGroup(&v1.UpstreamGroupRollout{
+				Canary:     canary,
+				StepWeight: 40,
+				Interval:   &types.Duration{Seconds: 30},
+			})
+
+			ug := resync()
+			Expect(weights(ug)).To(Equal([]uint32{60, 40}))
+			Expect(ug.Metadata.Annotations).To(HaveKeyWithValue(PhaseAnnotation, PhaseProgressing))
+			Expect(ug.Metadata.Annotations).To(HaveKeyWithValue(LastStepAnnotation, "2020-10-01T00:00:00Z"))
+
+			// not yet due
+			now = now.Add(10 * time.Second)
+			Expect(weights(resync())).To(Equal([]uint32{60, 40}))
+
+			now = now.Add(20 * time.Second)
+			Expect(weights(resync())).To(Equal([]uint32{20, 80}))
+
+			now = now.Add(30 * time.Second)
+			Expect(weights(resync())).To(Equal([]uint32{0, 100}))
+
+			now = now.Add(30 * time.Second)
+			ug = resync()
+			Expect(weights(ug)).To(Equal([]uint32{0, 100}))
+			Expect(ug.Metadata.Annotations).To(HaveKeyWithValue(PhaseAnnotation, PhaseSucceeded))
+		})
+
+		It("stops at the max weight", func() {
+			writeUpstreamGroup(&v1.UpstreamGroupRollout{
+				Canary:     canary,
+				StepWeight: 40,
+				MaxWeight:  50,
+			})
+
+			Expect(weights(resync())).To(Equal([]uint32{60, 40}))
+			now = now.Add(DefaultInterval)
+			Expect(weights(resync())).To(Equal([]uint32{50, 50}))
+			now = now.Add(DefaultInterval)
+			ug := resync()
+			Expect(weights(ug)).To(Equal([]uint32{50, 50}))
+			Expect(ug.Metadata.Annotations).To(HaveKeyWithValue(PhaseAnnotation, PhaseSucceeded))
+		})
+
+		It("shifts the traffic back off the canary when the metric guard exceeds its max value", func() {
+			writeUpstreamGroup(&v1.UpstreamGroupRollout{
+				Canary: canary,
+				MetricGuard: &v1.UpstreamGroupRollout_MetricGuard{
+					PrometheusAddress: "http://prometheus:9090",
+					Query:             "errors",
+					MaxValue:          5,
+				},
+			})
+
+			Expect(weights(resync())).To(Equal([]uint32{90, 10}))
+
+			// the rollout is held while the guard can't be checked
+			querier.err = eris.New("unavailable")
+			now = now.Add(DefaultInterval)
+			Expect(weights(resync())).To(Equal([]uint32{90, 10}))
+
+			querier.err = nil
+			querier.value = 3
+			Expect(weights(resync())).To(Equal([]uint32{80, 20}))
+
+			querier.value = 7
+			now = now.Add(DefaultInterval)
+			ug := resync()
+			Expect(weights(ug)).To(Equal([]uint32{100, 0}))
+			Expect(ug.Metadata.Annotations).To(HaveKeyWithValue(PhaseAnnotation, PhaseAborted))
+			Expect(ug.Metadata.Annotations).To(HaveKey(ReasonAnnotation))
+
+			// aborted rollouts stay aborted
+			now = now.Add(DefaultInterval)
+			Expect(weights(resync())).To(Equal([]uint32{100, 0}))
+		})
+
+		It("starts the rollout over when it changes", func() {
+			writeUpstreamGroup(&v1.UpstreamGroupRollout{Canary: canary, StepWeight: 100})
+			Expect(weights(resync())).To(Equal([]uint32{0, 100}))
+			now = now.Add(DefaultInterval)
+			Expect(resync().Metadata.Annotations).To(HaveKeyWithValue(PhaseAnnotation, PhaseSucceeded))
+
+			ug, err := client.Read("ns", "ug", clients.ReadOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			ug.Rollout.StepWeight = 30
+			_, err = client.Write(ug, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+			Expect(err).NotTo(HaveOccurred())
+
+			ug = resync()
+			Expect(weights(ug)).To(Equal([]uint32{70, 30}))
+			Expect(ug.Metadata.Annotations).To(HaveKeyWithValue(PhaseAnnotation, PhaseProgressing))
+		})
+
+		It("ignores upstream groups without a rollout", func() {
+			writeUpstreamGroup(nil)
+			ug := resync()
+			Expect(weights(ug)).To(Equal([]uint32{1, 0}))
+			Expect(ug.Metadata.Annotations).To(BeEmpty())
+		})
+	})
+
+	Context("leader election", func() {
+
+		var (
+			ctx          context.Context
+			cancel       context.CancelFunc
+			client       v1.UpstreamGroupClient
+			kube         *fake.Clientset
+			controller   *Controller
+			resyncPeriod time.Duration
+		)
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+			var err error
+			client, err = v1.NewUpstreamGroupClient(&factory.MemoryResourceClientFactory{
+				Cache: memory.NewInMemoryResourceCache(),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			kube = fake.NewSimpleClientset()
+			controller = NewController(client, &fakeQuerier{})
+			resyncPeriod = DefaultResyncPeriod
+			DefaultResyncPeriod = 10 * time.Millisecond
+
+			ug, err := client.Write(&v1.UpstreamGroup{
+				Metadata:     core.Metadata{Name: "ug", Namespace: "ns"},
+				Destinations: []*v1.WeightedDestination{destination("stable", 1), destination("canary", 0)},
+				Rollout: &v1.UpstreamGroupRollout{
+					Canary: &v1.Destination{
+						DestinationType: &v1.Destination_Upstream{
+							Upstream: &core.ResourceRef{Name: "canary", Namespace: "ns"},
+						},
+					},
+				},
+			}, clients.WriteOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(controller.Sync(ctx, &v1.ApiSnapshot{UpstreamGroups: v1.UpstreamGroupList{ug}})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			cancel()
+			DefaultResyncPeriod = resyncPeriod
+		})
+
+		canaryWeight := func() uint32 {
+			ug, err := client.Read("ns", "ug", clients.ReadOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			return ug.GetDestinations()[1].GetWeight()
+		}
+
+		It("advances the rollouts once it holds the leader lock", func() {
+			go controller.RunAsLeader(ctx, kube, "gloo-system")
+
+			Eventually(canaryWeight, time.Second).Should(BeNumerically(">", 0))
+			lock, err := kube.CoreV1().ConfigMaps("gloo-system").Get(LeaderElectionLockName, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			hostname, err := os.Hostname()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lock.Annotations[resourcelock.LeaderElectionRecordAnnotationKey]).To(ContainSubstring(hostname))
+		})
+
+		It("does not advance the rollouts while another replica holds the leader lock", func() {
+			record, err := json.Marshal(resourcelock.LeaderElectionRecord{
+				HolderIdentity:       "other-replica",
+				LeaseDurationSeconds: 60,
+				AcquireTime:          metav1.Now(),
+				RenewTime:            metav1.Now(),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = kube.CoreV1().ConfigMaps("gloo-system").Create(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        LeaderElectionLockName,
+					Namespace:   "gloo-system",
+					Annotations: map[string]string{resourcelock.LeaderElectionRecordAnnotationKey: string(record)},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			go controller.RunAsLeader(ctx, kube, "gloo-system")
+
+			Consistently(canaryWeight, 200*time.Millisecond).Should(BeZero())
+		})
+	})
+})
@@ -0,0 +1,102 @@
+package rollout
+
+import (
+	"sort"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	usconversions "github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
+)
+
+// the weights of the destinations of an upstream group add up to this total during a rollout, so they're percentages
+const totalWeight = 100
+
+// CanaryIndex returns the index of the canary of the rollout among the destinations of the upstream group, or -1 if
+// it isn't one of them. Destinations are matched by their upstreams and subsets.
+func CanaryIndex(ug *v1.UpstreamGroup) int {
+	canary := ug.GetRollout().GetCanary()
+	if canary == nil {
+		return -1
+	}
+	for i, dest := range ug.GetDestinations() {
+		if sameDestination(ug, dest.GetDestination(), canary) {
+			return i
+		}
+	}
+	return -1
+}
+
+func sameDestination(ug *v1.UpstreamGroup, a, b *v1.Destination) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	refA, err := usconversions.DestinationToUpstreamRef(a)
+	if err != nil {
+		return false
+	}
+	refB, err := usconversions.DestinationToUpstreamRef(b)
+	if err != nil {
+		return false
+	}
+	// upstreams without a namespace are in the namespace of the upstream group
+	namespace := func(ns string) string {
+		if ns == "" {
+			return ug.GetMetadata().Namespace
+		}
+		return ns
+	}
+	return refA.Name == refB.Name && namespace(refA.Namespace) == namespace(refB.Namespace) && a.GetSubset().Equal(b.GetSubset())
+}
+
+// the percentage of the traffic the canary receives, rounded to the nearest percent
+func canaryPercent(destinations []*v1.WeightedDestination, canary int) uint32 {
+	var total uint64
+	for _, dest := range destinations {
+		total += uint64(dest.GetWeight())
+	}
+	if total == 0 {
+		return 0
+	}
+	return uint32((uint64(destinations[canary].GetWeight())*totalWeight + total/2) / total)
+}
+
+// sets the weights of the destinations so the canary receives the given percentage of the traffic, and the other
+// destinations share the rest in proportion to their current weights
+func setCanaryPercent(destinations []*v1.WeightedDestination, canary int, percent uint32) {
+	if percent > totalWeight {
+		percent = totalWeight
+	}
+	destinations[canary].Weight = percent
+
+	var others []int
+	var othersTotal uint64
+	for i, dest := range destinations {
+		if i == canary {
+			continue
+		}
+		others = append(others, i)
+		othersTotal += uint64(dest.GetWeight())
+	}
+	if len(others) == 0 {
+		return
+	}
+
+	// the rest is distributed by the largest remainder method, so the weights add up to the total exactly
+	rest := uint64(totalWeight - percent)
+	remainders := make([]uint64, len(destinations))
+	var distributed uint64
+	for _, i := range others {
+		weight, share := uint64(1), uint64(len(others))
+		if othersTotal > 0 {
+			weight, share = uint64(destinations[i].GetWeight()), othersTotal
+		}
+		destinations[i].Weight = uint32(rest * weight / share)
+		remainders[i] = rest * weight % share
+		distributed += uint64(destinations[i].Weight)
+	}
+	sort.SliceStable(others, func(a, b int) bool {
+		return remainders[others[a]] > remainders[others[b]]
+	})
+	for _, i := range others[:rest-distributed] {
+		destinations[i].Weight++
+	}
+}
@@ -4,15 +4,20 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"runtime"
+	"sync"
+	"time"
 
 	syncerstats "github.com/solo-io/gloo/projects/gloo/pkg/syncer/stats"
 	"github.com/solo-io/go-utils/hashutils"
 
 	"github.com/gorilla/mux"
 	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils"
 	"github.com/solo-io/gloo/pkg/utils/syncutil"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/log"
@@ -38,6 +43,9 @@ var (
 		Aggregation: view.LastValue(),
 		TagKeys:     []tag.Key{syncerstats.ProxyNameKey, resourceNameKey},
 	}
+
+	mProxyTranslationDuration = utils.MakeCounter("gloo.solo.io/translator/proxy_duration_ms", "The time in milliseconds taken to translate and sanitize a proxy", view.Distribution(1, 5, 10, 50, 100, 500, 1000, 5000), syncerstats.ProxyNameKey)
+	mSnapshotsSkipped         = utils.MakeSumCounter("gloo.solo.io/translator/snapshots_skipped", "The number of xDS snapshots which were not set because they were identical to the previous snapshot of the proxy", syncerstats.ProxyNameKey)
 )
 
 func init() {
@@ -107,37 +115,29 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1.ApiSnapshot)
 				if err := s.xdsCache.SetSnapshot(key, emptySnapshot); err != nil {
 					return err
 				}
+				delete(s.snapshotHashes, key)
 			}
 		}
 	}
 
-	for _, proxy := range snap.Proxies {
-		proxyCtx := ctx
-		if ctxWithTags, err := tag.New(proxyCtx, tag.Insert(syncerstats.ProxyNameKey, proxy.Metadata.Ref().Key())); err == nil {
-			proxyCtx = ctxWithTags
-		}
-
-		params := plugins.Params{
-			Ctx:      proxyCtx,
-			Snapshot: snap,
-		}
-
-		xdsSnapshot, reports, _, err := s.translator.Translate(params, proxy)
-		if err != nil {
-			err := eris.Wrapf(err, "translation loop failed")
+	// proxies are translated concurrently, but their snapshots are set and reports merged in order
+	for _, result := range s.translateProxies(ctx, snap) {
+		proxy := result.proxy
+		if result.err != nil {
+			err := eris.Wrapf(result.err, "translation loop failed")
 			logger.DPanicw("", zap.Error(err))
 			return err
 		}
 
-		if validateErr := reports.ValidateStrict(); validateErr != nil {
-			logger.Warnw("Proxy had invalid config", zap.Any("proxy", proxy.Metadata.Ref()), zap.Error(validateErr))
+		allReports.Merge(result.reports)
+		for resource, report := range result.warningReports {
+			allReports.AddWarnings(resource, report.Warnings...)
 		}
 
-		allReports.Merge(reports)
-
 		key := xds.SnapshotKey(proxy)
+		xdsSnapshot := result.xdsSnapshot
 
-		sanitizedSnapshot, err := s.sanitizer.SanitizeSnapshot(ctx, snap, xdsSnapshot, reports)
+		sanitizedSnapshot, snapshotHash, err := result.sanitizedSnapshot, result.snapshotHash, result.sanitizeErr
 		if err != nil {
 			logger.Warnf("proxy %v was rejected due to invalid config: %v\n"+
 				"Attempting to update only EDS information", proxy.Metadata.Ref().Key(), err)
@@ -151,6 +151,17 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1.ApiSnapshot)
 				continue
 			}
 			logger.Infof("successfully updated EDS information for proxy %v", proxy.Metadata.Ref().Key())
+			snapshotHash, err = xds.SnapshotHash(sanitizedSnapshot)
+			if err != nil {
+				return eris.Wrapf(err, "hashing xDS snapshot")
+			}
+		}
+
+		// setting a byte-identical snapshot would only make envoy process the same configuration again
+		if previousHash, ok := s.snapshotHashes[key]; ok && previousHash == snapshotHash {
+			logger.Debugw("xDS snapshot is unchanged, not setting it", "key", key)
+			utils.MeasureOne(result.ctx, mSnapshotsSkipped)
+			continue
 		}
 
 		if err := s.xdsCache.SetSnapshot(key, sanitizedSnapshot); err != nil {
@@ -158,6 +169,12 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1.ApiSnapshot)
 			logger.DPanicw("", zap.Error(err))
 			return err
 		}
+		s.snapshotHashes[key] = snapshotHash
+
+		// only the changes of the sanitized snapshots actually sent to Envoy are counted
+		if result.sanitizeErr == nil && result.sanitizerChanges != nil {
+			result.sanitizerChanges.Record(result.ctx)
+		}
 
 		// Record some metrics
 		clustersLen := len(xdsSnapshot.GetResources(xds.ClusterType).Items)
@@ -165,10 +182,10 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1.ApiSnapshot)
 		routesLen := len(xdsSnapshot.GetResources(xds.RouteType).Items)
 		endpointsLen := len(xdsSnapshot.GetResources(xds.EndpointType).Items)
 
-		measureResource(proxyCtx, "clusters", clustersLen)
-		measureResource(proxyCtx, "listeners", listenersLen)
-		measureResource(proxyCtx, "routes", routesLen)
-		measureResource(proxyCtx, "endpoints", endpointsLen)
+		measureResource(result.ctx, "clusters", clustersLen)
+		measureResource(result.ctx, "listeners", listenersLen)
+		measureResource(result.ctx, "routes", routesLen)
+		measureResource(result.ctx, "endpoints", endpointsLen)
 
 		logger.Infow("Setting xDS Snapshot", "key", key,
 			"clusters", clustersLen,
@@ -188,6 +205,126 @@ func (s *translatorSyncer) syncEnvoy(ctx context.Context, snap *v1.ApiSnapshot)
 	return nil
 }
 
+// the result of translating and sanitizing a single proxy
+type proxyTranslation struct {
+	proxy *v1.Proxy
+	// the context of the proxy, tagged with its name
+	ctx         context.Context
+	xdsSnapshot envoycache.Snapshot
+	reports     reporter.ResourceReports
+	// the warnings of the sanitizer dry run, and of the sanitizers which accept the snapshot despite them,
+	// which are kept apart from the reports as those are validated strictly
+	warningReports    reporter.ResourceReports
+	sanitizedSnapshot envoycache.Snapshot
+	// the hash of the sanitized snapshot
+	snapshotHash uint64
+	sanitizeErr  error
+	// the changes the sanitizers made to the snapshot, nil for a dry run
+	sanitizerChanges *sanitizer.Changes
+	// translation errors fail the whole sync
+	err error
+}
+
+// translates the proxies of the snapshot with a pool of workers, and returns the results in the order of the proxies
+func (s *translatorSyncer) translateProxies(ctx context.Context, snap *v1.ApiSnapshot) []proxyTranslation {
+	results := make([]proxyTranslation, len(snap.Proxies))
+
+	workers := runtime.NumCPU()
+	if concurrency := s.settings.GetGloo().GetProxyTranslationConcurrency(); concurrency != nil && concurrency.GetValue() > 0 {
+		workers = int(concurrency.GetValue())
+	}
+	if workers > len(snap.Proxies) {
+		workers = len(snap.Proxies)
+	}
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				results[i] = s.translateProxy(ctx, snap, snap.Proxies[i])
+			}
+		}()
+	}
+	for i := range snap.Proxies {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	return results
+}
+
+func (s *translatorSyncer) translateProxy(ctx context.Context, snap *v1.ApiSnapshot, proxy *v1.Proxy) proxyTranslation {
+	logger := contextutils.LoggerFrom(ctx)
+
+	proxyCtx := ctx
+	if ctxWithTags, err := tag.New(proxyCtx, tag.Insert(syncerstats.ProxyNameKey, proxy.Metadata.Ref().Key())); err == nil {
+		proxyCtx = ctxWithTags
+	}
+	start := time.Now()
+	defer func() {
+		utils.Measure(proxyCtx, mProxyTranslationDuration, time.Since(start).Milliseconds())
+	}()
+
+	result := proxyTranslation{
+		proxy:          proxy,
+		ctx:            proxyCtx,
+		warningReports: make(reporter.ResourceReports),
+	}
+
+	params := plugins.Params{
+		Ctx:      proxyCtx,
+		Snapshot: snap,
+	}
+
+	result.xdsSnapshot, result.reports, _, result.err = s.translator.Translate(params, proxy)
+	if result.err != nil {
+		return result
+	}
+
+	if validateErr := result.reports.ValidateStrict(); validateErr != nil {
+		logger.Warnw("Proxy had invalid config", zap.Any("proxy", proxy.Metadata.Ref()), zap.Error(validateErr))
+	}
+
+	if s.settings.GetGloo().GetInvalidConfigPolicy().GetSanitizerDryRun() {
+		result.sanitizedSnapshot, result.sanitizeErr = s.dryRunSanitizers(proxyCtx, snap, proxy, result.xdsSnapshot, result.reports, result.warningReports)
+	} else {
+		var sanitizeCtx context.Context
+		sanitizeCtx, result.sanitizerChanges = sanitizer.WithChanges(sanitizer.WithWarnings(proxyCtx, result.warningReports))
+		result.sanitizedSnapshot, result.sanitizeErr = s.sanitizer.SanitizeSnapshot(sanitizeCtx, snap, result.xdsSnapshot, result.reports)
+	}
+	if result.sanitizeErr == nil {
+		result.snapshotHash, result.err = xds.SnapshotHash(result.sanitizedSnapshot)
+	}
+	return result
+}
+
+// runs the sanitizers against a copy of the xds snapshot and reports the changes they would make, as warnings
+// on the proxy, without applying them. The unmodified snapshot is returned, validated as if no sanitizers were enabled.
+func (s *translatorSyncer) dryRunSanitizers(ctx context.Context, snap *v1.ApiSnapshot, proxy *v1.Proxy, xdsSnapshot envoycache.Snapshot, reports, warningReports reporter.ResourceReports) (envoycache.Snapshot, error) {
+	logger := contextutils.LoggerFrom(ctx)
+
+	// sanitizers may modify the reports they are given
+	dryRunReports := make(reporter.ResourceReports)
+	dryRunReports.Merge(reports)
+
+	sanitizedSnapshot, err := s.sanitizer.SanitizeSnapshot(sanitizer.WithDryRun(ctx), snap, xdsSnapshot.Clone(), dryRunReports)
+	if err != nil {
+		logger.Infow("sanitizer dry run: proxy would be rejected", zap.Any("proxy", proxy.Metadata.Ref()), zap.Error(err))
+		warningReports.AddWarning(proxy, fmt.Sprintf("sanitizer dry run: proxy would be rejected: %v", err))
+	} else if diff := sanitizer.DiffSnapshots(xdsSnapshot, sanitizedSnapshot); !diff.Empty() {
+		logger.Infow("sanitizer dry run: proxy would be modified", zap.Any("proxy", proxy.Metadata.Ref()), zap.Any("diff", diff))
+		for _, change := range diff.Changes() {
+			warningReports.AddWarning(proxy, fmt.Sprintf("sanitizer dry run: %v", change))
+		}
+	}
+
+	return xdsSnapshot, reports.ValidateStrict()
+}
+
 // TODO(ilackarms): move this somewhere else, make it part of dev-mode
 func (s *translatorSyncer) ServeXdsSnapshots() error {
 	r := mux.NewRouter()
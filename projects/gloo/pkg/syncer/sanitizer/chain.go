@@ -0,0 +1,103 @@
+package sanitizer
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/stats"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+var (
+	sanitizerNameKey, _ = tag.NewKey("sanitizer_name")
+
+	mSanitizerErrors   = utils.MakeSumCounter("gloo.solo.io/sanitizer/errors", "The number of errors returned by a sanitizer in the sanitizer chain", stats.ProxyNameKey, sanitizerNameKey)
+	mSanitizerDuration = utils.MakeCounter("gloo.solo.io/sanitizer/duration_ms", "The time in milliseconds taken by a sanitizer in the sanitizer chain", view.Distribution(1, 5, 10, 50, 100, 500, 1000, 5000), stats.ProxyNameKey, sanitizerNameKey)
+)
+
+// a XdsSanitizerFactory creates a sanitizer to be registered with the sanitizer chain.
+// The Gloo settings are provided so that custom sanitizers can be configured alongside Gloo's own.
+type XdsSanitizerFactory func(ctx context.Context, settings *v1.Settings) (XdsSanitizer, error)
+
+// a Registration adds a custom sanitizer to the sanitizer chain which Gloo runs on every xds snapshot.
+// Custom sanitizers run after Gloo's own sanitizers, in the order they are registered.
+type Registration struct {
+	// the name under which the sanitizer's metrics and errors are reported
+	Name    string
+	Factory XdsSanitizerFactory
+}
+
+// a Chain runs a list of named sanitizers in the order they were registered.
+//
+// Unlike XdsSanitizers, a Chain does not stop at the first error: each sanitizer that fails is skipped
+// (the next sanitizer receives the last successfully sanitized snapshot), and the errors from every
+// sanitizer are aggregated and returned once the whole chain has run.
+// The number of errors and the time spent in each sanitizer are recorded as metrics tagged with its name.
+type Chain struct {
+	links []chainLink
+}
+
+type chainLink struct {
+	name      string
+	sanitizer XdsSanitizer
+}
+
+var _ XdsSanitizer = new(Chain)
+
+func NewChain() *Chain {
+	return &Chain{}
+}
+
+// Register appends a sanitizer to the end of the chain. The name is used to tag the sanitizer's
+// metrics and errors, and must be unique within the chain.
+func (c *Chain) Register(name string, sanitizer XdsSanitizer) error {
+	if sanitizer == nil {
+		return eris.Errorf("cannot register nil sanitizer %v", name)
+	}
+	for _, link := range c.links {
+		if link.name == name {
+			return eris.Errorf("a sanitizer named %v is already registered", name)
+		}
+	}
+	c.links = append(c.links, chainLink{name: name, sanitizer: sanitizer})
+	return nil
+}
+
+// Names returns the names of the registered sanitizers, in the order they run.
+func (c *Chain) Names() []string {
+	var names []string
+	for _, link := range c.links {
+		names = append(names, link.name)
+	}
+	return names
+}
+
+func (c *Chain) SanitizeSnapshot(ctx context.Context, glooSnapshot *v1.ApiSnapshot, xdsSnapshot envoycache.Snapshot, reports reporter.ResourceReports) (envoycache.Snapshot, error) {
+	var errs *multierror.Error
+	for _, link := range c.links {
+		nameTag := tag.Insert(sanitizerNameKey, link.name)
+
+		start := time.Now()
+		sanitized, err := link.sanitizer.SanitizeSnapshot(ctx, glooSnapshot, xdsSnapshot, reports)
+		utils.Measure(ctx, mSanitizerDuration, time.Since(start).Milliseconds(), nameTag)
+
+		if err != nil {
+			utils.MeasureOne(ctx, mSanitizerErrors, nameTag)
+			errs = multierror.Append(errs, eris.Wrapf(err, "sanitizer %v", link.name))
+			continue
+		}
+		utils.MeasureZero(ctx, mSanitizerErrors, nameTag)
+		xdsSnapshot = sanitized
+	}
+	if err := errs.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+	return xdsSnapshot, nil
+}
@@ -0,0 +1,93 @@
+package sanitizer
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+)
+
+type sanitizerFunc func(xdsSnapshot envoycache.Snapshot) (envoycache.Snapshot, error)
+
+func (f sanitizerFunc) SanitizeSnapshot(_ context.Context, _ *v1.ApiSnapshot, xdsSnapshot envoycache.Snapshot, _ reporter.ResourceReports) (envoycache.Snapshot, error) {
+	return f(xdsSnapshot)
+}
+
+var _ = Describe("Chain", func() {
+	var (
+		chain *Chain
+
+		snapshot = func(version string) envoycache.Snapshot {
+			return xds.NewSnapshotFromResources(
+				envoycache.NewResources(version, nil),
+				envoycache.NewResources(version, nil),
+				envoycache.NewResources(version, nil),
+				envoycache.NewResources(version, nil),
+			)
+		}
+
+		// records the version of the snapshot it receives, and returns a snapshot with the given version
+		versioning = func(seen *[]string, version string) XdsSanitizer {
+			return sanitizerFunc(func(xdsSnapshot envoycache.Snapshot) (envoycache.Snapshot, error) {
+				*seen = append(*seen, xdsSnapshot.GetResources(xds.RouteType).Version)
+				return snapshot(version), nil
+			})
+		}
+
+		failing = func(seen *[]string, err error) XdsSanitizer {
+			return sanitizerFunc(func(xdsSnapshot envoycache.Snapshot) (envoycache.Snapshot, error) {
+				*seen = append(*seen, xdsSnapshot.GetResources(xds.RouteType).Version)
+				return nil, err
+			})
+		}
+	)
+
+	BeforeEach(func() {
+		chain = NewChain()
+	})
+
+	It("runs sanitizers in the order they are registered", func() {
+		var seen []string
+		Expect(chain.Register("first", versioning(&seen, "1"))).NotTo(HaveOccurred())
+		Expect(chain.Register("second", versioning(&seen, "2"))).NotTo(HaveOccurred())
+
+		snap, err := chain.SanitizeSnapshot(context.TODO(), &v1.ApiSnapshot{}, snapshot("0"), reporter.ResourceReports{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(seen).To(Equal([]string{"0", "1"}))
+		Expect(snap.GetResources(xds.RouteType).Version).To(Equal("2"))
+		Expect(chain.Names()).To(Equal([]string{"first", "second"}))
+	})
+
+	It("runs every sanitizer and aggregates their errors", func() {
+		var seen []string
+		Expect(chain.Register("first", versioning(&seen, "1"))).NotTo(HaveOccurred())
+		Expect(chain.Register("second", failing(&seen, eris.New("bad routes")))).NotTo(HaveOccurred())
+		Expect(chain.Register("third", failing(&seen, eris.New("bad listeners")))).NotTo(HaveOccurred())
+		Expect(chain.Register("fourth", versioning(&seen, "4"))).NotTo(HaveOccurred())
+
+		snap, err := chain.SanitizeSnapshot(context.TODO(), &v1.ApiSnapshot{}, snapshot("0"), reporter.ResourceReports{})
+		Expect(snap).To(BeNil())
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*multierror.Error).Errors).To(HaveLen(2))
+		Expect(err.Error()).To(ContainSubstring("sanitizer second: bad routes"))
+		Expect(err.Error()).To(ContainSubstring("sanitizer third: bad listeners"))
+
+		// failing sanitizers are skipped, so later sanitizers receive the last valid snapshot
+		Expect(seen).To(Equal([]string{"0", "1", "1", "1"}))
+	})
+
+	It("rejects duplicate and nil sanitizers", func() {
+		var seen []string
+		Expect(chain.Register("first", versioning(&seen, "1"))).NotTo(HaveOccurred())
+		Expect(chain.Register("first", versioning(&seen, "2"))).To(MatchError(ContainSubstring("already registered")))
+		Expect(chain.Register("second", nil)).To(HaveOccurred())
+		Expect(chain.Names()).To(Equal([]string{"first"}))
+	})
+})
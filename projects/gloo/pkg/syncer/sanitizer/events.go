@@ -0,0 +1,90 @@
+package sanitizer
+
+import (
+	"context"
+
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/crd"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	// the reasons for the events recorded by the sanitizers
+	UpstreamRemovedReason      = "UpstreamRemoved"
+	InvalidRouteReplacedReason = "InvalidRouteReplaced"
+
+	eventSourceComponent = "gloo"
+)
+
+// an EventRecorder notifies operators about the resources whose configuration was changed by a sanitizer,
+// so that they can be alerted on configuration which is degraded but still serving traffic.
+type EventRecorder interface {
+	// Warning records a warning about the resource of the given kind, as returned by resources.Kind
+	Warning(kind string, ref core.ResourceRef, reason, message string)
+}
+
+// an EventRecorder which discards every event, used when no recorder is configured
+type noopEventRecorder struct{}
+
+func (noopEventRecorder) Warning(string, core.ResourceRef, string, string) {}
+
+func eventRecorderOrNoop(recorder EventRecorder) EventRecorder {
+	if recorder == nil {
+		return noopEventRecorder{}
+	}
+	return recorder
+}
+
+// the custom resources which events can be recorded on, by resource kind
+var eventCrds = map[string]crd.Crd{
+	resources.Kind(&v1.Upstream{}):              v1.UpstreamCrd,
+	resources.Kind(&gatewayv1.VirtualService{}): gatewayv1.VirtualServiceCrd,
+	resources.Kind(&gatewayv1.RouteTable{}):     gatewayv1.RouteTableCrd,
+	resources.Kind(&gatewayv1.Gateway{}):        gatewayv1.GatewayCrd,
+}
+
+type kubeEventRecorder struct {
+	ctx      context.Context
+	recorder record.EventRecorder
+}
+
+// NewKubeEventRecorder returns an EventRecorder which records Kubernetes events on the custom resources
+// which caused the change. Events on resources which are not stored as custom resources are dropped.
+func NewKubeEventRecorder(ctx context.Context, kube kubernetes.Interface) EventRecorder {
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kube.CoreV1().Events("")})
+	go func() {
+		<-ctx.Done()
+		broadcaster.Shutdown()
+	}()
+
+	return &kubeEventRecorder{
+		ctx:      ctx,
+		recorder: broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: eventSourceComponent}),
+	}
+}
+
+func (r *kubeEventRecorder) Warning(kind string, ref core.ResourceRef, reason, message string) {
+	resourceCrd, ok := eventCrds[kind]
+	if !ok {
+		contextutils.LoggerFrom(r.ctx).Debugf("not recording %v event on unsupported resource kind %v", reason, kind)
+		return
+	}
+
+	// the event recorder accepts object references in place of the object itself
+	involvedObject := &corev1.ObjectReference{
+		APIVersion: resourceCrd.GroupVersion().String(),
+		Kind:       resourceCrd.KindName,
+		Namespace:  ref.GetNamespace(),
+		Name:       ref.GetName(),
+	}
+	r.recorder.Event(involvedObject, corev1.EventTypeWarning, reason, message)
+}
@@ -0,0 +1,99 @@
+package sanitizer
+
+import (
+	"context"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+type recordedEvent struct {
+	kind    string
+	ref     core.ResourceRef
+	reason  string
+	message string
+}
+
+type fakeEventRecorder struct {
+	events []recordedEvent
+}
+
+func (r *fakeEventRecorder) Warning(kind string, ref core.ResourceRef, reason, message string) {
+	r.events = append(r.events, recordedEvent{kind: kind, ref: ref, reason: reason, message: message})
+}
+
+var _ = Describe("KubeEventRecorder", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		kube   *fake.Clientset
+
+		lock    sync.Mutex
+		created []corev1.Event
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		created = nil
+
+		// the fake clientset cannot store events created in another namespace than the client's,
+		// so capture them as they are created
+		kube = fake.NewSimpleClientset()
+		kube.PrependReactor("create", "events", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			event := action.(k8stesting.CreateAction).GetObject().(*corev1.Event)
+			lock.Lock()
+			defer lock.Unlock()
+			created = append(created, *event)
+			return true, event, nil
+		})
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	createdEvents := func() []corev1.Event {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([]corev1.Event{}, created...)
+	}
+
+	It("records warning events on the custom resource", func() {
+		recorder := NewKubeEventRecorder(ctx, kube)
+		ref := core.ResourceRef{Name: "my-vs", Namespace: "gloo-system"}
+		recorder.Warning(resources.Kind(&gatewayv1.VirtualService{}), ref, InvalidRouteReplacedReason, "route replaced")
+
+		Eventually(createdEvents).Should(HaveLen(1))
+		event := createdEvents()[0]
+		Expect(event.Namespace).To(Equal("gloo-system"))
+		Expect(event.Type).To(Equal(corev1.EventTypeWarning))
+		Expect(event.Reason).To(Equal(InvalidRouteReplacedReason))
+		Expect(event.Message).To(Equal("route replaced"))
+		Expect(event.Source.Component).To(Equal("gloo"))
+		Expect(event.InvolvedObject).To(Equal(corev1.ObjectReference{
+			APIVersion: "gateway.solo.io/v1",
+			Kind:       "VirtualService",
+			Namespace:  "gloo-system",
+			Name:       "my-vs",
+		}))
+	})
+
+	It("drops events on resources which are not custom resources", func() {
+		recorder := NewKubeEventRecorder(ctx, kube)
+		recorder.Warning(resources.Kind(&v1.Proxy{}), core.ResourceRef{Name: "proxy", Namespace: "gloo-system"}, UpstreamRemovedReason, "dropped")
+		recorder.Warning(resources.Kind(&v1.Upstream{}), core.ResourceRef{Name: "us", Namespace: "gloo-system"}, UpstreamRemovedReason, "recorded")
+
+		Eventually(createdEvents).Should(HaveLen(1))
+		Consistently(createdEvents).Should(HaveLen(1))
+		Expect(createdEvents()[0].InvolvedObject.Kind).To(Equal("Upstream"))
+	})
+})
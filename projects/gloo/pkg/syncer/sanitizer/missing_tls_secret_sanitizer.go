@@ -0,0 +1,172 @@
+package sanitizer
+
+import (
+	"context"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	"github.com/hashicorp/go-multierror"
+	"github.com/solo-io/gloo/pkg/utils"
+	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/stats"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	glooutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/go-utils/contextutils"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+var (
+	mTlsFilterChainsRemoved = utils.MakeLastValueCounter("gloo.solo.io/sanitizer/tls_filter_chains_removed", "The number of filter chains removed from the sanitized xds snapshot because their TLS secret is missing", stats.ProxyNameKey)
+)
+
+// MissingTlsSecretSanitizer accepts proxies whose listeners reference TLS secrets which do not exist.
+//
+// The translator leaves out the filter chain for an SslConfig whose secret is missing and reports an error
+// on the proxy, which would otherwise cause the whole snapshot to be rejected. This sanitizer downgrades those
+// errors to warnings on the proxy so that the remaining filter chains (and every other listener) keep receiving
+// updates, and removes listeners which are left without any filter chain (along with their routes), as Envoy
+// rejects them. The warnings are added to the reports of the context (see WithWarnings), as strict validation
+// rejects the snapshot on warnings when the route replacing sanitizer is disabled. The removals are counted by
+// the tls_filter_chains_removed metric.
+//
+// Only listener SslConfigs which reference a secret are sanitized: SDS secrets are resolved by the
+// SDS server rather than by Gloo, so Gloo cannot tell whether they exist.
+// This sanitizer must run before any sanitizer which rejects the snapshot based on the reports.
+type MissingTlsSecretSanitizer struct{}
+
+func NewMissingTlsSecretSanitizer() *MissingTlsSecretSanitizer {
+	return &MissingTlsSecretSanitizer{}
+}
+
+func (s *MissingTlsSecretSanitizer) SanitizeSnapshot(ctx context.Context, glooSnapshot *v1.ApiSnapshot, xdsSnapshot envoycache.Snapshot, reports reporter.ResourceReports) (envoycache.Snapshot, error) {
+	ctx = contextutils.WithLogger(ctx, "missing-tls-secret-remover")
+
+	listeners := xdsSnapshot.GetResources(xds.ListenerType)
+	routes := xdsSnapshot.GetResources(xds.RouteType)
+
+	var removed int64
+	for resource, report := range reports {
+		proxy, ok := resource.(*v1.Proxy)
+		if !ok || report.Errors == nil {
+			continue
+		}
+
+		// the same missing secret produces the same error on every listener which references it,
+		// so collect the errors for the whole proxy before removing them
+		secretErrs := map[string]struct{}{}
+		var brokenListeners []*v1.Listener
+		for _, listener := range proxy.GetListeners() {
+			listenerErrs := missingSecretErrors(glooSnapshot.Secrets, listener)
+			if len(listenerErrs) == 0 {
+				continue
+			}
+			for listenerErr := range listenerErrs {
+				secretErrs[listenerErr] = struct{}{}
+			}
+			brokenListeners = append(brokenListeners, listener)
+		}
+
+		var downgraded []string
+		report.Errors, downgraded = removeErrors(report.Errors, secretErrs)
+		if len(downgraded) == 0 {
+			continue
+		}
+		removed += int64(len(downgraded))
+
+		for _, listener := range brokenListeners {
+			contextutils.LoggerFrom(ctx).Warnw("accepting listener without the filter chains whose TLS secret is missing",
+				zap.String("proxy", proxy.GetMetadata().Ref().Key()), zap.String("listener", listener.GetName()))
+
+			// envoy rejects listeners without any filter chains, so the translator leaves them out of the snapshot;
+			// make sure they are removed along with the routes which are no longer referenced
+			if resource, ok := listeners.Items[listener.GetName()]; ok {
+				if envoyListener, ok := resource.ResourceProto().(*envoyapi.Listener); !ok || len(envoyListener.GetFilterChains()) > 0 {
+					continue
+				}
+				delete(listeners.Items, listener.GetName())
+			}
+			delete(routes.Items, translator.RouteConfigName(listener))
+			for i := range listener.GetHybridListener().GetMatchedListeners() {
+				delete(routes.Items, translator.RouteConfigName(&v1.Listener{Name: translator.MatchedListenerName(listener, i)}))
+			}
+		}
+
+		reports[resource] = report
+		addWarnings(ctx, reports, resource, downgraded...)
+	}
+
+	utils.Measure(ctx, mTlsFilterChainsRemoved, removed)
+
+	if removed == 0 {
+		return xdsSnapshot, nil
+	}
+
+	xdsSnapshot = xds.NewSnapshotFromResources(
+		xdsSnapshot.GetResources(xds.EndpointType),
+		xdsSnapshot.GetResources(xds.ClusterType),
+		routes,
+		listeners,
+	)
+
+	return xdsSnapshot, nil
+}
+
+// returns the errors reported by the translator for the listener's ssl configs whose secret is missing
+func missingSecretErrors(secrets v1.SecretList, listener *v1.Listener) map[string]struct{} {
+	errs := map[string]struct{}{}
+	sslConfigs := listener.GetSslConfigurations()
+	for _, matchedListener := range listener.GetHybridListener().GetMatchedListeners() {
+		if sslConfig := matchedListener.GetMatcher().GetSslConfig(); sslConfig != nil {
+			sslConfigs = append(sslConfigs, sslConfig)
+		}
+	}
+	for _, sslConfig := range sslConfigs {
+		ref := sslConfig.GetSecretRef()
+		if ref == nil {
+			continue
+		}
+		_, err := secrets.Find(ref.Strings())
+		if err == nil {
+			continue
+		}
+		listenerErr := validation.MakeListenerErr(validationapi.ListenerReport_Error_SSLConfigError, glooutils.SslSecretNotFoundError(err).Error())
+		errs[listenerErr.Error()] = struct{}{}
+	}
+	return errs
+}
+
+// removes the errors whose message is in toRemove, returning the remaining errors and the messages of the errors removed
+func removeErrors(errs error, toRemove map[string]struct{}) (error, []string) {
+	var (
+		remaining error
+		removed   []string
+	)
+	for _, reportErr := range flattenErrors(errs) {
+		if _, ok := toRemove[reportErr.Error()]; ok {
+			removed = append(removed, reportErr.Error())
+			continue
+		}
+		remaining = multierror.Append(remaining, reportErr)
+	}
+	if len(removed) == 0 {
+		return errs, nil
+	}
+	return remaining, removed
+}
+
+// the errors on a report are a hashicorp multierror, which may contain the uber multierr produced by proxy validation
+func flattenErrors(err error) []error {
+	var flattened []error
+	if merr, ok := err.(*multierror.Error); ok {
+		for _, e := range merr.Errors {
+			flattened = append(flattened, flattenErrors(e)...)
+		}
+		return flattened
+	}
+	return multierr.Errors(err)
+}
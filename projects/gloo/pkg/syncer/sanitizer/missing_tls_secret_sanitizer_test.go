@@ -0,0 +1,182 @@
+package sanitizer_test
+
+import (
+	"context"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"go.uber.org/multierr"
+
+	. "github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
+)
+
+var _ = Describe("MissingTlsSecretSanitizer", func() {
+	var (
+		secret = &v1.Secret{
+			Metadata: core.Metadata{
+				Name:      "present",
+				Namespace: "gloo-system",
+			},
+			Kind: &v1.Secret_Tls{
+				Tls: &v1.TlsSecret{},
+			},
+		}
+		presentRef = secret.Metadata.Ref()
+		missingRef = core.ResourceRef{
+			Name:      "missing",
+			Namespace: "gloo-system",
+		}
+
+		glooSnapshot = &v1.ApiSnapshot{
+			Secrets: v1.SecretList{secret},
+		}
+
+		sslListener = func(name string, refs ...core.ResourceRef) *v1.Listener {
+			listener := &v1.Listener{
+				Name: name,
+				ListenerType: &v1.Listener_HttpListener{
+					HttpListener: &v1.HttpListener{},
+				},
+			}
+			for _, ref := range refs {
+				ref := ref
+				listener.SslConfigurations = append(listener.SslConfigurations, &v1.SslConfig{
+					SslSecrets: &v1.SslConfig_SecretRef{SecretRef: &ref},
+				})
+			}
+			return listener
+		}
+
+		// the error the translator reports for an ssl config whose secret is missing
+		missingSecretErr = func(ref core.ResourceRef) error {
+			_, err := glooSnapshot.Secrets.Find(ref.Strings())
+			Expect(err).To(HaveOccurred())
+			return validation.MakeListenerErr(validationapi.ListenerReport_Error_SSLConfigError, utils.SslSecretNotFoundError(err).Error())
+		}
+
+		envoyListener = func(name string, filterChains int) *envoyapi.Listener {
+			listener := &envoyapi.Listener{Name: name}
+			for i := 0; i < filterChains; i++ {
+				listener.FilterChains = append(listener.FilterChains, &envoylistener.FilterChain{})
+			}
+			return listener
+		}
+	)
+
+	It("accepts listeners with a missing TLS secret and removes listeners left without filter chains", func() {
+		partial := envoyListener("partial", 1)
+		broken := envoyListener("broken", 0)
+		partialRoutes := &envoyapi.RouteConfiguration{Name: "partial-routes"}
+
+		xdsSnapshot := xds.NewSnapshotFromResources(
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("routes", []envoycache.Resource{
+				xds.NewEnvoyResource(partialRoutes),
+				xds.NewEnvoyResource(&envoyapi.RouteConfiguration{Name: "broken-routes"}),
+			}),
+			envoycache.NewResources("listeners", []envoycache.Resource{
+				xds.NewEnvoyResource(partial),
+				xds.NewEnvoyResource(broken),
+			}),
+		)
+
+		proxy := &v1.Proxy{
+			Listeners: []*v1.Listener{
+				sslListener("partial", presentRef, missingRef),
+				sslListener("broken", missingRef),
+			},
+		}
+
+		otherErr := eris.New("some other problem")
+		reports := reporter.ResourceReports{}
+		reports.AddError(proxy, multierr.Combine(missingSecretErr(missingRef), otherErr, missingSecretErr(missingRef)))
+
+		snap, err := NewMissingTlsSecretSanitizer().SanitizeSnapshot(context.TODO(), glooSnapshot, xdsSnapshot, reports)
+		Expect(err).NotTo(HaveOccurred())
+
+		listeners := snap.GetResources(xds.ListenerType)
+		Expect(listeners.Items).To(HaveLen(1))
+		Expect(listeners.Items["partial"].ResourceProto()).To(Equal(partial))
+
+		routes := snap.GetResources(xds.RouteType)
+		Expect(routes.Items).To(HaveLen(1))
+		Expect(routes.Items["partial-routes"].ResourceProto()).To(Equal(partialRoutes))
+
+		// unrelated errors are left on the report
+		Expect(reports[proxy].Errors).To(HaveOccurred())
+		Expect(reports[proxy].Errors.Error()).To(ContainSubstring(otherErr.Error()))
+		Expect(reports[proxy].Errors.Error()).NotTo(ContainSubstring("SSL secret not found"))
+
+		// the missing secrets are reported as warnings
+		Expect(reports[proxy].Warnings).To(ConsistOf(missingSecretErr(missingRef).Error(), missingSecretErr(missingRef).Error()))
+	})
+
+	It("reports the missing secrets apart from the reports it validates, when given reports for the warnings", func() {
+		listener := envoyListener("partial", 1)
+
+		xdsSnapshot := xds.NewSnapshotFromResources(
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("listeners", []envoycache.Resource{
+				xds.NewEnvoyResource(listener),
+			}),
+		)
+
+		proxy := &v1.Proxy{
+			Listeners: []*v1.Listener{
+				sslListener("partial", presentRef, missingRef),
+			},
+		}
+
+		reports := reporter.ResourceReports{}
+		reports.AddError(proxy, multierr.Combine(missingSecretErr(missingRef)))
+		warnings := reporter.ResourceReports{}
+
+		snap, err := NewMissingTlsSecretSanitizer().SanitizeSnapshot(WithWarnings(context.TODO(), warnings), glooSnapshot, xdsSnapshot, reports)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(snap.GetResources(xds.ListenerType).Items).To(HaveLen(1))
+		// strict validation accepts the snapshot, as the route replacing sanitizer does when it is disabled
+		Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+		Expect(warnings[proxy].Warnings).To(ConsistOf(missingSecretErr(missingRef).Error()))
+	})
+
+	It("does not modify proxies without missing TLS secrets", func() {
+		xdsSnapshot := xds.NewSnapshotFromResources(
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("listeners", []envoycache.Resource{
+				xds.NewEnvoyResource(envoyListener("secure", 1)),
+			}),
+		)
+
+		proxy := &v1.Proxy{
+			Listeners: []*v1.Listener{
+				sslListener("secure", presentRef),
+			},
+		}
+
+		proxyErr := eris.New("some other problem")
+		reports := reporter.ResourceReports{}
+		reports.AddError(proxy, proxyErr)
+
+		snap, err := NewMissingTlsSecretSanitizer().SanitizeSnapshot(context.TODO(), glooSnapshot, xdsSnapshot, reports)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(snap).To(Equal(xdsSnapshot))
+		Expect(reports[proxy].Errors.Error()).To(ContainSubstring(proxyErr.Error()))
+	})
+})
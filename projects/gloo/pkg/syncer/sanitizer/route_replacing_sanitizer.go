@@ -2,6 +2,7 @@ package sanitizer
 
 import (
 	"context"
+	"fmt"
 	"sort"
 
 	endpoint "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
@@ -24,6 +25,7 @@ import (
 	envoyroute "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	"github.com/gogo/protobuf/proto"
 	"github.com/rotisserie/eris"
+	gatewaytranslator "github.com/solo-io/gloo/projects/gateway/pkg/translator"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
@@ -35,22 +37,28 @@ import (
 const (
 	fallbackListenerName   = "fallback_listener_for_invalid_routes"
 	fallbackListenerSocket = "@" + fallbackListenerName
-	fallbackClusterName    = "fallback_cluster_for_invalid_routes"
+	// FallbackClusterName is the cluster of the routes replaced because their destination is invalid
+	FallbackClusterName = "fallback_cluster_for_invalid_routes"
 )
 
 var (
 	routeConfigKey, _ = tag.NewKey("route_config_name")
 
-	mRoutesReplaced = utils.MakeLastValueCounter("gloo.solo.io/sanitizer/routes_replaced", "The number routes replaced in the sanitized xds snapshot", stats.ProxyNameKey, routeConfigKey)
+	mRoutesReplaced      = utils.MakeLastValueCounter("gloo.solo.io/sanitizer/routes_replaced", "The number routes replaced in the sanitized xds snapshot", stats.ProxyNameKey, routeConfigKey)
+	mProxyRoutesReplaced = utils.MakeGauge("gloo.solo.io/sanitizer/proxy_routes_replaced", "The number of routes currently replaced in the xds snapshot of the proxy", stats.ProxyNameKey)
 )
 
 type RouteReplacingSanitizer struct {
 	enabled          bool
+	responseCode     uint32
+	responseBody     string
 	fallbackListener *envoyapi.Listener
 	fallbackCluster  *envoyapi.Cluster
+	recorder         EventRecorder
 }
 
-func NewRouteReplacingSanitizer(cfg *v1.GlooOptions_InvalidConfigPolicy) (*RouteReplacingSanitizer, error) {
+// the recorder is notified of the resources which define the routes that are replaced, and may be nil
+func NewRouteReplacingSanitizer(cfg *v1.GlooOptions_InvalidConfigPolicy, recorder EventRecorder) (*RouteReplacingSanitizer, error) {
 
 	responseCode := cfg.GetInvalidRouteResponseCode()
 	responseBody := cfg.GetInvalidRouteResponseBody()
@@ -62,8 +70,11 @@ func NewRouteReplacingSanitizer(cfg *v1.GlooOptions_InvalidConfigPolicy) (*Route
 
 	return &RouteReplacingSanitizer{
 		enabled:          cfg.GetReplaceInvalidRoutes(),
+		responseCode:     responseCode,
+		responseBody:     responseBody,
 		fallbackListener: listener,
 		fallbackCluster:  cluster,
+		recorder:         eventRecorderOrNoop(recorder),
 	}, nil
 }
 
@@ -127,10 +138,10 @@ func makeFallbackListenerAndCluster(responseCode uint32, responseBody string) (*
 	}
 
 	fallbackCluster := &envoyapi.Cluster{
-		Name:           fallbackClusterName,
+		Name:           FallbackClusterName,
 		ConnectTimeout: gogoutils.DurationStdToProto(&translator.ClusterConnectionTimeout),
 		LoadAssignment: &envoyapi.ClusterLoadAssignment{
-			ClusterName: fallbackClusterName,
+			ClusterName: FallbackClusterName,
 			Endpoints: []*endpoint.LocalityLbEndpoints{{
 				LbEndpoints: []*endpoint.LbEndpoint{{
 					HostIdentifier: &endpoint.LbEndpoint_Endpoint{
@@ -171,7 +182,10 @@ func (s *RouteReplacingSanitizer) SanitizeSnapshot(ctx context.Context, glooSnap
 	// mark all valid destination clusters
 	validClusters := getClusters(glooSnapshot)
 
-	replacedRouteConfigs, needsListener := s.replaceMissingClusterRoutes(ctx, validClusters, routeConfigs)
+	// collect the custom invalid route responses configured on the proxy's virtual hosts
+	invalidRouteResponses := getInvalidRouteResponses(ctx, reports)
+
+	replacedRouteConfigs, needsListener := s.replaceMissingClusterRoutes(ctx, validClusters, invalidRouteResponses, routeConfigs)
 
 	clusters := xdsSnapshot.GetResources(xds.ClusterType)
 	listeners := xdsSnapshot.GetResources(xds.ListenerType)
@@ -193,9 +207,53 @@ func (s *RouteReplacingSanitizer) SanitizeSnapshot(ctx context.Context, glooSnap
 		return xdsSnapshot, err
 	}
 
+	s.recordReplacedRoutes(ctx, glooSnapshot, reports)
+
 	return xdsSnapshot, nil
 }
 
+// records an event on the resources which define each route replaced because its destination is invalid.
+// The routes are found on the proxy rather than the xds snapshot, as only the proxy knows where they came from.
+func (s *RouteReplacingSanitizer) recordReplacedRoutes(ctx context.Context, glooSnapshot *v1.ApiSnapshot, reports reporter.ResourceReports) {
+	if isDryRun(ctx) {
+		return
+	}
+	for resource := range reports {
+		proxy, ok := resource.(*v1.Proxy)
+		if !ok {
+			continue
+		}
+		for _, listener := range proxy.GetListeners() {
+			for _, httpListener := range translator.HttpListenersByRouteConfigName(listener) {
+				for _, vh := range httpListener.GetVirtualHosts() {
+					for _, route := range vh.GetRoutes() {
+						routeAction := route.GetRouteAction()
+						if routeAction == nil {
+							continue
+						}
+						destinationErr := translator.ValidateRouteDestinations(glooSnapshot, routeAction)
+						if destinationErr == nil {
+							continue
+						}
+						routeDescription := "a route"
+						if route.GetName() != "" {
+							routeDescription = fmt.Sprintf("route %v", route.GetName())
+						}
+						message := fmt.Sprintf("%v on proxy %v was replaced with a direct response because its destination is invalid: %v",
+							routeDescription, proxy.GetMetadata().Ref().Key(), destinationErr)
+						if err := gatewaytranslator.ForEachSource(route, func(source gatewaytranslator.SourceRef) error {
+							s.recorder.Warning(source.ResourceKind, source.ResourceRef, InvalidRouteReplacedReason, message)
+							return nil
+						}); err != nil {
+							contextutils.LoggerFrom(ctx).Debugw("could not find the sources of replaced route", zap.Error(err))
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
 func getRoutes(snap envoycache.Snapshot) ([]*envoyapi.RouteConfiguration, error) {
 	routeConfigProtos := snap.GetResources(xds.RouteType)
 	var routeConfigs []*envoyapi.RouteConfiguration
@@ -225,7 +283,79 @@ func getClusters(snap *v1.ApiSnapshot) map[string]struct{} {
 	return validClusters
 }
 
-func (s *RouteReplacingSanitizer) replaceMissingClusterRoutes(ctx context.Context, validClusters map[string]struct{}, routeConfigs []*envoyapi.RouteConfiguration) ([]*envoyapi.RouteConfiguration, bool) {
+// maps route config name -> virtual host name -> the custom response for invalid routes on that virtual host
+type invalidRouteResponses map[string]map[string]*v1.InvalidRouteResponse
+
+func (r invalidRouteResponses) get(routeConfigName, virtualHostName string) *v1.InvalidRouteResponse {
+	return r[routeConfigName][virtualHostName]
+}
+
+// the reports for a translated proxy contain the proxy itself, which we use to look up
+// the invalid route responses configured on its virtual hosts
+func getInvalidRouteResponses(ctx context.Context, reports reporter.ResourceReports) invalidRouteResponses {
+	responses := invalidRouteResponses{}
+	for resource := range reports {
+		proxy, ok := resource.(*v1.Proxy)
+		if !ok {
+			continue
+		}
+		for _, listener := range proxy.GetListeners() {
+			for routeConfigName, httpListener := range translator.HttpListenersByRouteConfigName(listener) {
+				var vhostResponses map[string]*v1.InvalidRouteResponse
+				for _, vh := range httpListener.GetVirtualHosts() {
+					response := vh.GetOptions().GetInvalidRouteResponse()
+					if response == nil {
+						continue
+					}
+					if vhostResponses == nil {
+						vhostResponses = map[string]*v1.InvalidRouteResponse{}
+						responses[routeConfigName] = vhostResponses
+					}
+					vhostResponses[glooutils.SanitizeForEnvoy(ctx, vh.GetName(), "virtual host")] = response
+				}
+			}
+		}
+	}
+	return responses
+}
+
+// builds a direct response action which replaces a route on a virtual host with a custom invalid route response
+func (s *RouteReplacingSanitizer) makeDirectResponseRoute(route *envoyroutev2.Route, response *v1.InvalidRouteResponse) {
+	status := response.GetStatusCode()
+	if status == 0 {
+		status = s.responseCode
+	}
+	body := response.GetBody()
+	if body == "" {
+		body = s.responseBody
+	}
+
+	route.Action = &envoyroutev2.Route_DirectResponse{
+		DirectResponse: &envoyroutev2.DirectResponseAction{
+			Status: status,
+			Body: &corev2.DataSource{
+				Specifier: &corev2.DataSource_InlineString{
+					InlineString: body,
+				},
+			},
+		},
+	}
+
+	for _, h := range response.GetResponseHeadersToAdd() {
+		if h.GetHeader() == nil {
+			continue
+		}
+		route.ResponseHeadersToAdd = append(route.ResponseHeadersToAdd, &corev2.HeaderValueOption{
+			Header: &corev2.HeaderValue{
+				Key:   h.GetHeader().GetKey(),
+				Value: h.GetHeader().GetValue(),
+			},
+			Append: gogoutils.BoolGogoToProto(h.GetAppend()),
+		})
+	}
+}
+
+func (s *RouteReplacingSanitizer) replaceMissingClusterRoutes(ctx context.Context, validClusters map[string]struct{}, responses invalidRouteResponses, routeConfigs []*envoyapi.RouteConfiguration) ([]*envoyapi.RouteConfiguration, bool) {
 	var sanitizedRouteConfigs []*envoyapi.RouteConfiguration
 
 	isInvalid := func(cluster string) bool {
@@ -235,7 +365,10 @@ func (s *RouteReplacingSanitizer) replaceMissingClusterRoutes(ctx context.Contex
 
 	debugW := contextutils.LoggerFrom(ctx).Debugw
 
-	var anyRoutesReplaced bool
+	var (
+		anyRoutesReplaced bool
+		totalReplaced     int64
+	)
 
 	// replace any routes which do not point to a valid destination cluster
 	for _, cfg := range routeConfigs {
@@ -243,11 +376,23 @@ func (s *RouteReplacingSanitizer) replaceMissingClusterRoutes(ctx context.Contex
 		sanitizedRouteConfig := proto.Clone(cfg).(*envoyapi.RouteConfiguration)
 
 		for i, vh := range sanitizedRouteConfig.GetVirtualHosts() {
+			customResponse := responses.get(sanitizedRouteConfig.GetName(), vh.GetName())
 			for j, route := range vh.GetRoutes() {
 				routeAction := route.GetRoute()
 				if routeAction == nil {
 					continue
 				}
+				if customResponse != nil {
+					// virtual hosts with a custom response replace the entire route with a direct response,
+					// so they do not need the fallback listener and cluster
+					if hasInvalidCluster(routeAction, isInvalid) {
+						debugW("replacing route in virtual host with custom direct response",
+							zap.Any("route", j), zap.Any("virtualhost", i))
+						s.makeDirectResponseRoute(route, customResponse)
+						replaced++
+					}
+					continue
+				}
 				switch action := routeAction.GetClusterSpecifier().(type) {
 				case *envoyroutev2.RouteAction_Cluster:
 					if isInvalid(action.Cluster) {
@@ -277,12 +422,33 @@ func (s *RouteReplacingSanitizer) replaceMissingClusterRoutes(ctx context.Contex
 		}
 
 		utils.Measure(ctx, mRoutesReplaced, replaced, tag.Insert(routeConfigKey, sanitizedRouteConfig.GetName()))
+		totalReplaced += replaced
 		sanitizedRouteConfigs = append(sanitizedRouteConfigs, sanitizedRouteConfig)
 	}
 
+	// the gauge is only updated by the runs whose snapshot is sent to Envoy
+	if !isDryRun(ctx) {
+		utils.Measure(ctx, mProxyRoutesReplaced, totalReplaced)
+	}
+	addRoutesReplaced(ctx, totalReplaced)
+
 	return sanitizedRouteConfigs, anyRoutesReplaced
 }
 
+func hasInvalidCluster(routeAction *envoyroutev2.RouteAction, isInvalid func(cluster string) bool) bool {
+	switch action := routeAction.GetClusterSpecifier().(type) {
+	case *envoyroutev2.RouteAction_Cluster:
+		return isInvalid(action.Cluster)
+	case *envoyroutev2.RouteAction_WeightedClusters:
+		for _, weightedCluster := range action.WeightedClusters.GetClusters() {
+			if isInvalid(weightedCluster.GetName()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (s *RouteReplacingSanitizer) insertFallbackListener(listeners *envoycache.Resources) {
 	if listeners.Items == nil {
 		listeners.Items = map[string]envoycache.Resource{}
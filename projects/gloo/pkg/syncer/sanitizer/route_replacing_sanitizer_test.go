@@ -2,20 +2,28 @@ package sanitizer
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
 	route "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
 	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gatewaytranslator "github.com/solo-io/gloo/projects/gateway/pkg/translator"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 )
@@ -75,7 +83,7 @@ var _ = Describe("RouteReplacingSanitizer", func() {
 			Action: &route.Route_Route{
 				Route: &route.RouteAction{
 					ClusterSpecifier: &route.RouteAction_Cluster{
-						Cluster: fallbackClusterName,
+						Cluster: FallbackClusterName,
 					},
 				},
 			},
@@ -110,7 +118,7 @@ var _ = Describe("RouteReplacingSanitizer", func() {
 									Name: clusterName,
 								},
 								{
-									Name: fallbackClusterName,
+									Name: FallbackClusterName,
 								},
 							},
 						},
@@ -130,7 +138,7 @@ var _ = Describe("RouteReplacingSanitizer", func() {
 		config = &listener.Filter_TypedConfig{}
 
 		// make Consistent() happy
-		listener = &envoyapi.Listener{
+		envoyListener = &envoyapi.Listener{
 			FilterChains: []*listener.FilterChain{{
 				Filters: []*listener.Filter{{
 					Name:       wellknown.HTTPConnectionManager,
@@ -194,11 +202,11 @@ var _ = Describe("RouteReplacingSanitizer", func() {
 				xds.NewEnvoyResource(routeCfg),
 			}),
 			envoycache.NewResources("listeners", []envoycache.Resource{
-				xds.NewEnvoyResource(listener),
+				xds.NewEnvoyResource(envoyListener),
 			}),
 		)
 
-		sanitizer, err := NewRouteReplacingSanitizer(invalidCfgPolicy)
+		sanitizer, err := NewRouteReplacingSanitizer(invalidCfgPolicy, nil)
 		Expect(err).NotTo(HaveOccurred())
 
 		// should have a warning to trigger this sanitizer
@@ -221,10 +229,232 @@ var _ = Describe("RouteReplacingSanitizer", func() {
 
 		sanitizedRoutes := routeCfgs.Items[routeCfg.GetName()]
 		listenersWithFallback := listeners.Items[fallbackListenerName]
-		clustersWithFallback := clusters.Items[fallbackClusterName]
+		clustersWithFallback := clusters.Items[FallbackClusterName]
 
 		Expect(sanitizedRoutes.ResourceProto()).To(Equal(expectedRoutes))
 		Expect(listenersWithFallback.ResourceProto()).To(Equal(sanitizer.fallbackListener))
 		Expect(clustersWithFallback.ResourceProto()).To(Equal(sanitizer.fallbackCluster))
 	})
+	It("replaces routes with a direct response on virtual hosts with a custom invalid route response", func() {
+		glooListener := &v1.Listener{
+			Name: "http",
+			ListenerType: &v1.Listener_HttpListener{
+				HttpListener: &v1.HttpListener{
+					VirtualHosts: []*v1.VirtualHost{
+						{
+							Name: "custom",
+							Options: &v1.VirtualHostOptions{
+								InvalidRouteResponse: &v1.InvalidRouteResponse{
+									Body: "custom body",
+									ResponseHeadersToAdd: []*headers.HeaderValueOption{{
+										Header: &headers.HeaderValue{Key: "x-invalid-route", Value: "true"},
+									}},
+								},
+							},
+						},
+						{
+							Name: "default",
+						},
+					},
+				},
+			},
+		}
+		customRouteCfgName := translator.RouteConfigName(glooListener)
+
+		customListenerConfig := &listener.Filter_TypedConfig{}
+		var err error
+		customListenerConfig.TypedConfig, err = utils.MessageToAny(&hcm.HttpConnectionManager{
+			RouteSpecifier: &hcm.HttpConnectionManager_Rds{
+				Rds: &hcm.Rds{
+					RouteConfigName: customRouteCfgName,
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		customListener := &envoyapi.Listener{
+			FilterChains: []*listener.FilterChain{{
+				Filters: []*listener.Filter{{
+					Name:       wellknown.HTTPConnectionManager,
+					ConfigType: customListenerConfig,
+				}},
+			}},
+		}
+
+		routeCfg := &envoyapi.RouteConfiguration{
+			Name: customRouteCfgName,
+			VirtualHosts: []*route.VirtualHost{
+				{
+					Name: "custom",
+					Routes: []*route.Route{
+						validRouteSingle,
+						missingRouteSingle,
+						missingRouteMulti,
+					},
+				},
+				{
+					Name: "default",
+					Routes: []*route.Route{
+						missingRouteSingle,
+					},
+				},
+			},
+		}
+
+		directResponseRoute := &route.Route{
+			Action: &route.Route_DirectResponse{
+				DirectResponse: &route.DirectResponseAction{
+					Status: http.StatusTeapot,
+					Body: &envoycore.DataSource{
+						Specifier: &envoycore.DataSource_InlineString{
+							InlineString: "custom body",
+						},
+					},
+				},
+			},
+			ResponseHeadersToAdd: []*envoycore.HeaderValueOption{{
+				Header: &envoycore.HeaderValue{Key: "x-invalid-route", Value: "true"},
+			}},
+		}
+
+		expectedRoutes := &envoyapi.RouteConfiguration{
+			Name: customRouteCfgName,
+			VirtualHosts: []*route.VirtualHost{
+				{
+					Name: "custom",
+					Routes: []*route.Route{
+						validRouteSingle,
+						directResponseRoute,
+						directResponseRoute,
+					},
+				},
+				{
+					Name: "default",
+					Routes: []*route.Route{
+						fixedRouteSingle,
+					},
+				},
+			},
+		}
+
+		xdsSnapshot := xds.NewSnapshotFromResources(
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("routes", []envoycache.Resource{
+				xds.NewEnvoyResource(routeCfg),
+			}),
+			envoycache.NewResources("listeners", []envoycache.Resource{
+				xds.NewEnvoyResource(customListener),
+			}),
+		)
+
+		sanitizer, err := NewRouteReplacingSanitizer(invalidCfgPolicy, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		reports := reporter.ResourceReports{
+			&v1.Proxy{Listeners: []*v1.Listener{glooListener}}: {
+				Warnings: []string{"route with missing upstream"},
+			},
+		}
+
+		glooSnapshot := &v1.ApiSnapshot{
+			Upstreams: v1.UpstreamList{us},
+		}
+
+		snap, err := sanitizer.SanitizeSnapshot(context.TODO(), glooSnapshot, xdsSnapshot, reports)
+		Expect(err).NotTo(HaveOccurred())
+
+		sanitizedRoutes := snap.GetResources(xds.RouteType).Items[customRouteCfgName]
+		Expect(sanitizedRoutes.ResourceProto()).To(Equal(expectedRoutes))
+	})
+	It("records an event on the resources which define the replaced routes", func() {
+		vsRef := core.ResourceRef{Name: "my-vs", Namespace: "gloo-system"}
+		sourceMeta, err := json.Marshal(&gatewaytranslator.SourceMetadata{
+			Sources: []gatewaytranslator.SourceRef{{
+				ResourceRef:  vsRef,
+				ResourceKind: resources.Kind(&gatewayv1.VirtualService{}),
+			}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		var routeMeta types.Struct
+		Expect(jsonpb.UnmarshalString(string(sourceMeta), &routeMeta)).NotTo(HaveOccurred())
+
+		routeTo := func(name string, upstream core.ResourceRef) *v1.Route {
+			return &v1.Route{
+				Name:     name,
+				Metadata: &routeMeta,
+				Action: &v1.Route_RouteAction{
+					RouteAction: &v1.RouteAction{
+						Destination: &v1.RouteAction_Single{
+							Single: &v1.Destination{
+								DestinationType: &v1.Destination_Upstream{Upstream: &upstream},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		proxy := &v1.Proxy{
+			Metadata: core.Metadata{Name: "proxy", Namespace: "gloo-system"},
+			Listeners: []*v1.Listener{{
+				Name: "http",
+				ListenerType: &v1.Listener_HttpListener{
+					HttpListener: &v1.HttpListener{
+						VirtualHosts: []*v1.VirtualHost{{
+							Routes: []*v1.Route{
+								routeTo("valid", us.Metadata.Ref()),
+								routeTo("invalid", core.ResourceRef{Name: "missing", Namespace: "upstream"}),
+							},
+						}},
+					},
+				},
+			}},
+		}
+
+		routeCfg := &envoyapi.RouteConfiguration{
+			Name: routeCfgName,
+			VirtualHosts: []*route.VirtualHost{{
+				Routes: []*route.Route{
+					validRouteSingle,
+					missingRouteSingle,
+				},
+			}},
+		}
+
+		xdsSnapshot := xds.NewSnapshotFromResources(
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("routes", []envoycache.Resource{
+				xds.NewEnvoyResource(routeCfg),
+			}),
+			envoycache.NewResources("listeners", []envoycache.Resource{
+				xds.NewEnvoyResource(envoyListener),
+			}),
+		)
+
+		recorder := &fakeEventRecorder{}
+		sanitizer, err := NewRouteReplacingSanitizer(invalidCfgPolicy, recorder)
+		Expect(err).NotTo(HaveOccurred())
+
+		reports := reporter.ResourceReports{
+			proxy: {
+				Warnings: []string{"route with missing upstream"},
+			},
+		}
+
+		glooSnapshot := &v1.ApiSnapshot{
+			Upstreams: v1.UpstreamList{us},
+		}
+
+		_, err = sanitizer.SanitizeSnapshot(context.TODO(), glooSnapshot, xdsSnapshot, reports)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(recorder.events).To(HaveLen(1))
+		event := recorder.events[0]
+		Expect(event.kind).To(Equal(resources.Kind(&gatewayv1.VirtualService{})))
+		Expect(event.ref).To(Equal(vsRef))
+		Expect(event.reason).To(Equal(InvalidRouteReplacedReason))
+		Expect(event.message).To(ContainSubstring("route invalid on proxy gloo-system.proxy was replaced"))
+	})
 })
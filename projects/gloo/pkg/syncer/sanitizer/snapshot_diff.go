@@ -0,0 +1,163 @@
+package sanitizer
+
+import (
+	"fmt"
+	"sort"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/golang/protobuf/proto"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+)
+
+// the resource types compared by DiffSnapshots, and the names used to describe them
+var diffResourceTypes = []struct {
+	typeUrl string
+	kind    string
+}{
+	{xds.ListenerType, "listener"},
+	{xds.RouteType, "route config"},
+	{xds.ClusterType, "cluster"},
+	{xds.EndpointType, "endpoints"},
+}
+
+// ResourceDiff lists the names of the resources of a single type which differ between two xds snapshots
+type ResourceDiff struct {
+	Added    []string `json:"added,omitempty"`
+	Removed  []string `json:"removed,omitempty"`
+	Modified []string `json:"modified,omitempty"`
+}
+
+func (d ResourceDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Modified) == 0
+}
+
+// SnapshotDiff describes the changes made to an xds snapshot, e.g. by the sanitizers
+type SnapshotDiff struct {
+	Listeners    ResourceDiff `json:"listeners,omitempty"`
+	RouteConfigs ResourceDiff `json:"routeConfigs,omitempty"`
+	Clusters     ResourceDiff `json:"clusters,omitempty"`
+	Endpoints    ResourceDiff `json:"endpoints,omitempty"`
+
+	// the routes in modified route configs which were changed,
+	// in the form <route config>/<virtual host>/<route name or index>
+	Routes []string `json:"routes,omitempty"`
+}
+
+func (d SnapshotDiff) Empty() bool {
+	return d.Listeners.Empty() && d.RouteConfigs.Empty() && d.Clusters.Empty() && d.Endpoints.Empty() && len(d.Routes) == 0
+}
+
+// Changes returns a human-readable description of every change in the diff
+func (d SnapshotDiff) Changes() []string {
+	var changes []string
+	for _, resourceType := range diffResourceTypes {
+		resourceDiff := d.forType(resourceType.typeUrl)
+		for _, name := range resourceDiff.Added {
+			changes = append(changes, fmt.Sprintf("%v %v added", resourceType.kind, name))
+		}
+		for _, name := range resourceDiff.Removed {
+			changes = append(changes, fmt.Sprintf("%v %v removed", resourceType.kind, name))
+		}
+		// modified route configs are described by the routes which changed
+		if resourceType.typeUrl == xds.RouteType {
+			continue
+		}
+		for _, name := range resourceDiff.Modified {
+			changes = append(changes, fmt.Sprintf("%v %v modified", resourceType.kind, name))
+		}
+	}
+	for _, route := range d.Routes {
+		changes = append(changes, fmt.Sprintf("route %v modified", route))
+	}
+	return changes
+}
+
+func (d *SnapshotDiff) forType(typeUrl string) *ResourceDiff {
+	switch typeUrl {
+	case xds.ListenerType:
+		return &d.Listeners
+	case xds.RouteType:
+		return &d.RouteConfigs
+	case xds.ClusterType:
+		return &d.Clusters
+	default:
+		return &d.Endpoints
+	}
+}
+
+// DiffSnapshots compares the resources in two xds snapshots
+func DiffSnapshots(before, after envoycache.Snapshot) SnapshotDiff {
+	var diff SnapshotDiff
+	for _, resourceType := range diffResourceTypes {
+		*diff.forType(resourceType.typeUrl) = diffResources(
+			before.GetResources(resourceType.typeUrl).Items,
+			after.GetResources(resourceType.typeUrl).Items,
+		)
+	}
+
+	beforeRoutes := before.GetResources(xds.RouteType).Items
+	afterRoutes := after.GetResources(xds.RouteType).Items
+	for _, name := range diff.RouteConfigs.Modified {
+		beforeCfg, ok := beforeRoutes[name].ResourceProto().(*envoyapi.RouteConfiguration)
+		if !ok {
+			continue
+		}
+		afterCfg, ok := afterRoutes[name].ResourceProto().(*envoyapi.RouteConfiguration)
+		if !ok {
+			continue
+		}
+		diff.Routes = append(diff.Routes, diffRoutes(beforeCfg, afterCfg)...)
+	}
+
+	return diff
+}
+
+func diffResources(before, after map[string]envoycache.Resource) ResourceDiff {
+	var diff ResourceDiff
+	for name, beforeResource := range before {
+		afterResource, ok := after[name]
+		if !ok {
+			diff.Removed = append(diff.Removed, name)
+			continue
+		}
+		if !proto.Equal(beforeResource.ResourceProto(), afterResource.ResourceProto()) {
+			diff.Modified = append(diff.Modified, name)
+		}
+	}
+	for name := range after {
+		if _, ok := before[name]; !ok {
+			diff.Added = append(diff.Added, name)
+		}
+	}
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Strings(diff.Modified)
+	return diff
+}
+
+// returns the routes which differ between two versions of a route config.
+// virtual hosts are matched by name, and their routes by position.
+func diffRoutes(before, after *envoyapi.RouteConfiguration) []string {
+	afterVirtualHosts := map[string][]*envoyroute.Route{}
+	for _, vh := range after.GetVirtualHosts() {
+		afterVirtualHosts[vh.GetName()] = vh.GetRoutes()
+	}
+
+	var changed []string
+	for _, vh := range before.GetVirtualHosts() {
+		afterRoutes := afterVirtualHosts[vh.GetName()]
+		for i, route := range vh.GetRoutes() {
+			if i < len(afterRoutes) && proto.Equal(route, afterRoutes[i]) {
+				continue
+			}
+			routeName := route.GetName()
+			if routeName == "" {
+				routeName = fmt.Sprintf("%d", i)
+			}
+			changed = append(changed, fmt.Sprintf("%v/%v/%v", before.GetName(), vh.GetName(), routeName))
+		}
+	}
+	return changed
+}
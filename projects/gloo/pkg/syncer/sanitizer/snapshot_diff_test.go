@@ -0,0 +1,90 @@
+package sanitizer_test
+
+import (
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	route "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+
+	. "github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
+)
+
+var _ = Describe("DiffSnapshots", func() {
+	var (
+		clusterRoute = func(name, cluster string) *route.Route {
+			return &route.Route{
+				Name: name,
+				Action: &route.Route_Route{
+					Route: &route.RouteAction{
+						ClusterSpecifier: &route.RouteAction_Cluster{Cluster: cluster},
+					},
+				},
+			}
+		}
+
+		directResponseRoute = func(name string) *route.Route {
+			return &route.Route{
+				Name: name,
+				Action: &route.Route_DirectResponse{
+					DirectResponse: &route.DirectResponseAction{Status: 404},
+				},
+			}
+		}
+
+		routeConfig = func(routes ...*route.Route) *envoyapi.RouteConfiguration {
+			return &envoyapi.RouteConfiguration{
+				Name: "listener-routes",
+				VirtualHosts: []*route.VirtualHost{{
+					Name:   "vhost",
+					Routes: routes,
+				}},
+			}
+		}
+
+		snapshot = func(routeCfg *envoyapi.RouteConfiguration, clusters ...string) envoycache.Snapshot {
+			var clusterResources []envoycache.Resource
+			for _, cluster := range clusters {
+				clusterResources = append(clusterResources, xds.NewEnvoyResource(&envoyapi.Cluster{Name: cluster}))
+			}
+			return xds.NewSnapshotFromResources(
+				envoycache.NewResources("", nil),
+				envoycache.NewResources("clusters", clusterResources),
+				envoycache.NewResources("routes", []envoycache.Resource{xds.NewEnvoyResource(routeCfg)}),
+				envoycache.NewResources("", nil),
+			)
+		}
+	)
+
+	It("reports the clusters dropped and routes replaced", func() {
+		before := snapshot(routeConfig(clusterRoute("good", "good-cluster"), clusterRoute("", "bad-cluster")), "good-cluster", "bad-cluster")
+		after := snapshot(routeConfig(clusterRoute("good", "good-cluster"), directResponseRoute("")), "good-cluster", "fallback-cluster")
+
+		diff := DiffSnapshots(before, after)
+		Expect(diff.Empty()).To(BeFalse())
+		Expect(diff.Clusters).To(Equal(ResourceDiff{
+			Added:   []string{"fallback-cluster"},
+			Removed: []string{"bad-cluster"},
+		}))
+		Expect(diff.RouteConfigs).To(Equal(ResourceDiff{
+			Modified: []string{"listener-routes"},
+		}))
+		Expect(diff.Routes).To(Equal([]string{"listener-routes/vhost/1"}))
+
+		Expect(diff.Changes()).To(Equal([]string{
+			"cluster fallback-cluster added",
+			"cluster bad-cluster removed",
+			"route listener-routes/vhost/1 modified",
+		}))
+	})
+
+	It("returns an empty diff for identical snapshots", func() {
+		before := snapshot(routeConfig(clusterRoute("good", "good-cluster")), "good-cluster")
+		after := snapshot(routeConfig(clusterRoute("good", "good-cluster")), "good-cluster")
+
+		diff := DiffSnapshots(before, after)
+		Expect(diff.Empty()).To(BeTrue())
+		Expect(diff.Changes()).To(BeEmpty())
+	})
+})
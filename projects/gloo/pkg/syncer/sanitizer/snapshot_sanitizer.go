@@ -2,12 +2,21 @@ package sanitizer
 
 import (
 	"context"
+	"sync/atomic"
 
+	"github.com/solo-io/gloo/pkg/utils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/stats"
 	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 )
 
+var (
+	mRoutesReplacedTotal  = utils.MakeSumCounter("gloo.solo.io/sanitizer/routes_replaced_total", "The number of routes replaced in the xds snapshots set for the proxy", stats.ProxyNameKey)
+	mClustersRemovedTotal = utils.MakeSumCounter("gloo.solo.io/sanitizer/clusters_removed_total", "The number of clusters removed from the xds snapshots set for the proxy because their upstream has errors", stats.ProxyNameKey)
+)
+
 // an XdsSanitizer modifies an xds snapshot before it is stored in the xds cache
 // the if the sanitizer returns an error, Gloo will not update the xds cache with the snapshot
 // else Gloo will assume the snapshot is valid to send to Envoy
@@ -27,3 +36,66 @@ func (s XdsSanitizers) SanitizeSnapshot(ctx context.Context, glooSnapshot *v1.Ap
 	}
 	return xdsSnapshot, nil
 }
+
+type dryRunKey struct{}
+
+// WithDryRun marks the context of a sanitizer run whose result is not sent to Envoy.
+// Sanitizers do not record metrics or events for a dry run, as nothing they report has actually changed.
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+func isDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool)
+	return dryRun
+}
+
+type changesKey struct{}
+
+// Changes counts the changes the sanitizers made to an xds snapshot.
+type Changes struct {
+	routesReplaced  int64
+	clustersRemoved int64
+}
+
+// WithChanges returns a context for a sanitizer run, whose changes are counted in the returned Changes.
+func WithChanges(ctx context.Context) (context.Context, *Changes) {
+	changes := &Changes{}
+	return context.WithValue(ctx, changesKey{}, changes), changes
+}
+
+// Record adds the changes to the counters of the proxy the context is tagged with. It is called once the sanitized
+// snapshot has been set, so that the counters only reflect the changes actually sent to Envoy.
+func (c *Changes) Record(ctx context.Context) {
+	utils.Measure(ctx, mRoutesReplacedTotal, atomic.LoadInt64(&c.routesReplaced))
+	utils.Measure(ctx, mClustersRemovedTotal, atomic.LoadInt64(&c.clustersRemoved))
+}
+
+func addRoutesReplaced(ctx context.Context, replaced int64) {
+	if changes, ok := ctx.Value(changesKey{}).(*Changes); ok {
+		atomic.AddInt64(&changes.routesReplaced, replaced)
+	}
+}
+
+func addClustersRemoved(ctx context.Context, removed int64) {
+	if changes, ok := ctx.Value(changesKey{}).(*Changes); ok {
+		atomic.AddInt64(&changes.clustersRemoved, removed)
+	}
+}
+
+type warningsKey struct{}
+
+// WithWarnings returns a context for a sanitizer run, whose sanitizers add the warnings about the changes they accept
+// the snapshot with to the given reports. As the sanitizers validate the reports they are given strictly when the
+// route replacing sanitizer is disabled, those warnings must be kept apart so that they don't reject the snapshot.
+func WithWarnings(ctx context.Context, warnings reporter.ResourceReports) context.Context {
+	return context.WithValue(ctx, warningsKey{}, warnings)
+}
+
+// adds the warnings to the reports of the context, or to the given reports if the context has none
+func addWarnings(ctx context.Context, reports reporter.ResourceReports, resource resources.InputResource, warnings ...string) {
+	if ctxReports, ok := ctx.Value(warningsKey{}).(reporter.ResourceReports); ok {
+		reports = ctxReports
+	}
+	reports.AddWarnings(resource, warnings...)
+}
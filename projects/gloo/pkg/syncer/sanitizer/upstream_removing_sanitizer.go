@@ -2,6 +2,7 @@ package sanitizer
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/solo-io/gloo/pkg/utils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/stats"
@@ -11,28 +12,35 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	"github.com/solo-io/go-utils/contextutils"
 	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 )
 
 var (
 	mUpstreamsRemoved = utils.MakeLastValueCounter("gloo.solo.io/sanitizer/upstreams_removed", "The number upstreams removed from the sanitized xds snapshot", stats.ProxyNameKey)
+	mClustersRemoved  = utils.MakeGauge("gloo.solo.io/sanitizer/proxy_clusters_removed", "The number of clusters currently removed from the xds snapshot of the proxy because their upstream has errors", stats.ProxyNameKey)
 )
 
-type UpstreamRemovingSanitizer struct{}
+type UpstreamRemovingSanitizer struct {
+	recorder EventRecorder
+}
 
-func NewUpstreamRemovingSanitizer() *UpstreamRemovingSanitizer {
-	return &UpstreamRemovingSanitizer{}
+// the recorder is notified of every upstream removed from the snapshot, and may be nil
+func NewUpstreamRemovingSanitizer(recorder EventRecorder) *UpstreamRemovingSanitizer {
+	return &UpstreamRemovingSanitizer{
+		recorder: eventRecorderOrNoop(recorder),
+	}
 }
 
 // If there are any errors on upstreams, this function tries to remove the correspondent clusters and endpoints from
 // the xDS snapshot. If the snapshot is still consistent after these mutations and there are no errors related to other
 // resources, we are good to send it to Envoy.
-//
 func (s *UpstreamRemovingSanitizer) SanitizeSnapshot(ctx context.Context, glooSnapshot *v1.ApiSnapshot, xdsSnapshot envoycache.Snapshot, reports reporter.ResourceReports) (envoycache.Snapshot, error) {
 	ctx = contextutils.WithLogger(ctx, "invalid-upstream-remover")
 
 	resourcesErr := reports.Validate()
 	if resourcesErr == nil {
+		s.measureClustersRemoved(ctx, 0)
 		return xdsSnapshot, nil
 	}
 
@@ -41,7 +49,10 @@ func (s *UpstreamRemovingSanitizer) SanitizeSnapshot(ctx context.Context, glooSn
 	clusters := xdsSnapshot.GetResources(xds.ClusterType)
 	endpoints := xdsSnapshot.GetResources(xds.EndpointType)
 
-	var removed int64
+	var (
+		removed          int64
+		removedUpstreams []resources.InputResource
+	)
 
 	// Find all the errored upstreams and remove them from the xDS snapshot
 	for _, up := range glooSnapshot.Upstreams.AsInputResources() {
@@ -51,6 +62,7 @@ func (s *UpstreamRemovingSanitizer) SanitizeSnapshot(ctx context.Context, glooSn
 			delete(clusters.Items, clusterName)
 			delete(endpoints.Items, clusterName)
 			removed++
+			removedUpstreams = append(removedUpstreams, up)
 		}
 	}
 
@@ -68,9 +80,19 @@ func (s *UpstreamRemovingSanitizer) SanitizeSnapshot(ctx context.Context, glooSn
 
 	// If the snapshot is not consistent,
 	if xdsSnapshot.Consistent() != nil {
+		s.measureClustersRemoved(ctx, 0)
 		return xdsSnapshot, resourcesErr
 	}
 
+	s.measureClustersRemoved(ctx, removed)
+	addClustersRemoved(ctx, removed)
+	if !isDryRun(ctx) {
+		for _, up := range removedUpstreams {
+			s.recorder.Warning(resources.Kind(up), up.GetMetadata().Ref(), UpstreamRemovedReason,
+				fmt.Sprintf("the upstream was removed from the configuration sent to Envoy because it has errors: %v", reports[up].Errors))
+		}
+	}
+
 	// Convert errors related to upstreams to warnings
 	for _, up := range glooSnapshot.Upstreams.AsInputResources() {
 		if upReport := reports[up]; upReport.Errors != nil {
@@ -85,3 +107,11 @@ func (s *UpstreamRemovingSanitizer) SanitizeSnapshot(ctx context.Context, glooSn
 
 	return xdsSnapshot, resourcesErr
 }
+
+// the gauge is only updated by the runs whose snapshot is sent to Envoy
+func (s *UpstreamRemovingSanitizer) measureClustersRemoved(ctx context.Context, removed int64) {
+	if isDryRun(ctx) {
+		return
+	}
+	utils.Measure(ctx, mClustersRemoved, removed)
+}
@@ -8,11 +8,15 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/rotisserie/eris"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/stats"
 	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
 
 	. "github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
 )
@@ -53,7 +57,7 @@ var _ = Describe("UpstreamRemovingSanitizer", func() {
 			envoycache.NewResources("", nil),
 		)
 
-		sanitizer := NewUpstreamRemovingSanitizer()
+		sanitizer := NewUpstreamRemovingSanitizer(nil)
 
 		reports := reporter.ResourceReports{
 			&v1.Proxy{}: {
@@ -81,4 +85,148 @@ var _ = Describe("UpstreamRemovingSanitizer", func() {
 			Warnings: []string{"don't get me started"},
 		}))
 	})
+	It("records an event on the removed upstreams", func() {
+		xdsSnapshot := xds.NewSnapshotFromResources(
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("clusters", []envoycache.Resource{
+				xds.NewEnvoyResource(goodCluster),
+				xds.NewEnvoyResource(badCluster),
+			}),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
+		)
+
+		var removed []core.ResourceRef
+		sanitizer := NewUpstreamRemovingSanitizer(warningFunc(func(kind string, ref core.ResourceRef, reason, message string) {
+			Expect(kind).To(Equal(resources.Kind(badUs)))
+			Expect(reason).To(Equal(UpstreamRemovedReason))
+			Expect(message).To(ContainSubstring("don't get me started"))
+			removed = append(removed, ref)
+		}))
+
+		reports := reporter.ResourceReports{
+			us: {},
+			badUs: {
+				Errors: eris.Errorf("don't get me started"),
+			},
+		}
+
+		glooSnapshot := &v1.ApiSnapshot{
+			Upstreams: v1.UpstreamList{us, badUs},
+		}
+
+		_, err := sanitizer.SanitizeSnapshot(context.TODO(), glooSnapshot, xdsSnapshot, reports)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(removed).To(Equal([]core.ResourceRef{badUs.Metadata.Ref()}))
+	})
+	It("reports the number of clusters currently removed, and neither metrics nor events in a dry run", func() {
+		makeXdsSnapshot := func() envoycache.Snapshot {
+			return xds.NewSnapshotFromResources(
+				envoycache.NewResources("", nil),
+				envoycache.NewResources("clusters", []envoycache.Resource{
+					xds.NewEnvoyResource(goodCluster),
+					xds.NewEnvoyResource(badCluster),
+				}),
+				envoycache.NewResources("", nil),
+				envoycache.NewResources("", nil),
+			)
+		}
+		makeReports := func() reporter.ResourceReports {
+			return reporter.ResourceReports{
+				us: {},
+				badUs: {
+					Errors: eris.Errorf("don't get me started"),
+				},
+			}
+		}
+		glooSnapshot := &v1.ApiSnapshot{
+			Upstreams: v1.UpstreamList{us, badUs},
+		}
+
+		ctx, err := tag.New(context.TODO(), tag.Insert(stats.ProxyNameKey, "clusters-removed-gauge"))
+		Expect(err).NotTo(HaveOccurred())
+		clustersRemoved := func() float64 {
+			rows, err := view.RetrieveData("gloo.solo.io/sanitizer/proxy_clusters_removed")
+			Expect(err).NotTo(HaveOccurred())
+			for _, row := range rows {
+				for _, t := range row.Tags {
+					if t.Key == stats.ProxyNameKey && t.Value == "clusters-removed-gauge" {
+						return row.Data.(*view.LastValueData).Value
+					}
+				}
+			}
+			return -1
+		}
+
+		var events int
+		sanitizer := NewUpstreamRemovingSanitizer(warningFunc(func(string, core.ResourceRef, string, string) {
+			events++
+		}))
+
+		// the same upstream removed on every sync is counted once
+		for i := 0; i < 2; i++ {
+			_, err = sanitizer.SanitizeSnapshot(ctx, glooSnapshot, makeXdsSnapshot(), makeReports())
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(clustersRemoved()).To(Equal(float64(1)))
+		Expect(events).To(Equal(2))
+
+		_, err = sanitizer.SanitizeSnapshot(ctx, glooSnapshot, makeXdsSnapshot(), reporter.ResourceReports{us: {}, badUs: {}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clustersRemoved()).To(Equal(float64(0)))
+
+		_, err = sanitizer.SanitizeSnapshot(WithDryRun(ctx), glooSnapshot, makeXdsSnapshot(), makeReports())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clustersRemoved()).To(Equal(float64(0)))
+		Expect(events).To(Equal(2))
+	})
+	It("counts the clusters removed once the changes are recorded", func() {
+		xdsSnapshot := xds.NewSnapshotFromResources(
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("clusters", []envoycache.Resource{
+				xds.NewEnvoyResource(goodCluster),
+				xds.NewEnvoyResource(badCluster),
+			}),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
+		)
+		glooSnapshot := &v1.ApiSnapshot{
+			Upstreams: v1.UpstreamList{us, badUs},
+		}
+		reports := reporter.ResourceReports{
+			us: {},
+			badUs: {
+				Errors: eris.Errorf("don't get me started"),
+			},
+		}
+
+		ctx, err := tag.New(context.TODO(), tag.Insert(stats.ProxyNameKey, "clusters-removed-total"))
+		Expect(err).NotTo(HaveOccurred())
+		clustersRemovedTotal := func() float64 {
+			rows, err := view.RetrieveData("gloo.solo.io/sanitizer/clusters_removed_total")
+			Expect(err).NotTo(HaveOccurred())
+			for _, row := range rows {
+				for _, t := range row.Tags {
+					if t.Key == stats.ProxyNameKey && t.Value == "clusters-removed-total" {
+						return row.Data.(*view.SumData).Value
+					}
+				}
+			}
+			return 0
+		}
+
+		sanitizeCtx, changes := WithChanges(ctx)
+		_, err = NewUpstreamRemovingSanitizer(nil).SanitizeSnapshot(sanitizeCtx, glooSnapshot, xdsSnapshot, reports)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clustersRemovedTotal()).To(Equal(float64(0)))
+
+		changes.Record(ctx)
+		Expect(clustersRemovedTotal()).To(Equal(float64(1)))
+	})
 })
+
+type warningFunc func(kind string, ref core.ResourceRef, reason, message string)
+
+func (f warningFunc) Warning(kind string, ref core.ResourceRef, reason, message string) {
+	f(kind, ref, reason, message)
+}
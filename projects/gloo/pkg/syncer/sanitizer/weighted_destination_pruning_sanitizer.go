@@ -0,0 +1,116 @@
+package sanitizer
+
+import (
+	"context"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyroutev2 "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/solo-io/gloo/pkg/utils"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/stats"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/go-utils/contextutils"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+)
+
+var (
+	mWeightedDestinationsPruned = utils.MakeLastValueCounter("gloo.solo.io/sanitizer/weighted_destinations_pruned", "The number of weighted destinations pruned from routes in the sanitized xds snapshot", stats.ProxyNameKey, routeConfigKey)
+)
+
+// WeightedDestinationPruningSanitizer removes the weighted clusters which point to a missing destination
+// from multi-destination routes, so that the remaining destinations keep serving all of the route's traffic.
+// It must run before the RouteReplacingSanitizer, which replaces any routes that are left without a valid destination.
+type WeightedDestinationPruningSanitizer struct {
+	enabled bool
+}
+
+func NewWeightedDestinationPruningSanitizer(cfg *v1.GlooOptions_InvalidConfigPolicy) *WeightedDestinationPruningSanitizer {
+	return &WeightedDestinationPruningSanitizer{
+		enabled: cfg.GetReplaceInvalidRoutes() && cfg.GetPruneInvalidWeightedDestinations(),
+	}
+}
+
+func (s *WeightedDestinationPruningSanitizer) SanitizeSnapshot(ctx context.Context, glooSnapshot *v1.ApiSnapshot, xdsSnapshot envoycache.Snapshot, reports reporter.ResourceReports) (envoycache.Snapshot, error) {
+	if !s.enabled {
+		return xdsSnapshot, nil
+	}
+
+	ctx = contextutils.WithLogger(ctx, "invalid-weighted-destination-pruner")
+
+	contextutils.LoggerFrom(ctx).Debug("pruning weighted destinations which point to missing or errored upstreams")
+
+	routeConfigs, err := getRoutes(xdsSnapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	validClusters := getClusters(glooSnapshot)
+
+	prunedRouteConfigs := pruneMissingWeightedClusters(ctx, validClusters, routeConfigs)
+
+	xdsSnapshot = xds.NewSnapshotFromResources(
+		xdsSnapshot.GetResources(xds.EndpointType),
+		xdsSnapshot.GetResources(xds.ClusterType),
+		translator.MakeRdsResources(prunedRouteConfigs),
+		xdsSnapshot.GetResources(xds.ListenerType),
+	)
+
+	return xdsSnapshot, nil
+}
+
+func pruneMissingWeightedClusters(ctx context.Context, validClusters map[string]struct{}, routeConfigs []*envoyapi.RouteConfiguration) []*envoyapi.RouteConfiguration {
+	var sanitizedRouteConfigs []*envoyapi.RouteConfiguration
+
+	debugW := contextutils.LoggerFrom(ctx).Debugw
+
+	for _, cfg := range routeConfigs {
+		var pruned int64
+		sanitizedRouteConfig := proto.Clone(cfg).(*envoyapi.RouteConfiguration)
+
+		for i, vh := range sanitizedRouteConfig.GetVirtualHosts() {
+			for j, route := range vh.GetRoutes() {
+				weightedClusters := route.GetRoute().GetWeightedClusters()
+				if weightedClusters == nil {
+					continue
+				}
+
+				var (
+					remaining   []*envoyroutev2.WeightedCluster_ClusterWeight
+					totalWeight uint32
+				)
+				for _, weightedCluster := range weightedClusters.GetClusters() {
+					if _, ok := validClusters[weightedCluster.GetName()]; !ok {
+						continue
+					}
+					remaining = append(remaining, weightedCluster)
+					totalWeight += weightedCluster.GetWeight().GetValue()
+				}
+
+				removed := len(weightedClusters.GetClusters()) - len(remaining)
+				// leave routes without any remaining weight untouched; they will be replaced entirely
+				if removed == 0 || totalWeight == 0 {
+					continue
+				}
+
+				debugW("pruning missing weighted destinations from route",
+					zap.Any("route", j), zap.Any("virtualhost", i), zap.Int("pruned", removed))
+
+				weightedClusters.Clusters = remaining
+				weightedClusters.TotalWeight = &wrappers.UInt32Value{Value: totalWeight}
+				pruned += int64(removed)
+			}
+		}
+
+		utils.Measure(ctx, mWeightedDestinationsPruned, pruned, tag.Insert(routeConfigKey, sanitizedRouteConfig.GetName()))
+
+		sanitizedRouteConfigs = append(sanitizedRouteConfigs, sanitizedRouteConfig)
+	}
+
+	return sanitizedRouteConfigs
+}
@@ -0,0 +1,143 @@
+package sanitizer
+
+import (
+	"context"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	route "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+)
+
+var _ = Describe("WeightedDestinationPruningSanitizer", func() {
+	var (
+		us = &v1.Upstream{
+			Metadata: core.Metadata{
+				Name:      "my",
+				Namespace: "upstream",
+			},
+		}
+		otherUs = &v1.Upstream{
+			Metadata: core.Metadata{
+				Name:      "other",
+				Namespace: "upstream",
+			},
+		}
+		clusterName      = translator.UpstreamToClusterName(us.Metadata.Ref())
+		otherClusterName = translator.UpstreamToClusterName(otherUs.Metadata.Ref())
+
+		missingCluster = "missing_cluster"
+
+		routeCfgName = "some dirty routes"
+
+		glooSnapshot = &v1.ApiSnapshot{
+			Upstreams: v1.UpstreamList{us, otherUs},
+		}
+
+		weightedRoute = func(totalWeight uint32, clusters ...*route.WeightedCluster_ClusterWeight) *route.Route {
+			return &route.Route{
+				Action: &route.Route_Route{
+					Route: &route.RouteAction{
+						ClusterSpecifier: &route.RouteAction_WeightedClusters{
+							WeightedClusters: &route.WeightedCluster{
+								Clusters:    clusters,
+								TotalWeight: &wrappers.UInt32Value{Value: totalWeight},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		weightedCluster = func(name string, weight uint32) *route.WeightedCluster_ClusterWeight {
+			return &route.WeightedCluster_ClusterWeight{
+				Name:   name,
+				Weight: &wrappers.UInt32Value{Value: weight},
+			}
+		}
+
+		sanitize = func(policy *v1.GlooOptions_InvalidConfigPolicy, routeCfg *envoyapi.RouteConfiguration) *envoyapi.RouteConfiguration {
+			xdsSnapshot := xds.NewSnapshotFromResources(
+				envoycache.NewResources("", nil),
+				envoycache.NewResources("", nil),
+				envoycache.NewResources("routes", []envoycache.Resource{
+					xds.NewEnvoyResource(routeCfg),
+				}),
+				envoycache.NewResources("", nil),
+			)
+
+			snap, err := NewWeightedDestinationPruningSanitizer(policy).SanitizeSnapshot(context.TODO(), glooSnapshot, xdsSnapshot, reporter.ResourceReports{})
+			Expect(err).NotTo(HaveOccurred())
+
+			return snap.GetResources(xds.RouteType).Items[routeCfgName].ResourceProto().(*envoyapi.RouteConfiguration)
+		}
+	)
+
+	It("removes missing weighted clusters and re-normalizes the total weight", func() {
+		routeCfg := &envoyapi.RouteConfiguration{
+			Name: routeCfgName,
+			VirtualHosts: []*route.VirtualHost{{
+				Routes: []*route.Route{
+					weightedRoute(6,
+						weightedCluster(clusterName, 1),
+						weightedCluster(missingCluster, 3),
+						weightedCluster(otherClusterName, 2),
+					),
+					// every destination is missing, this route is left for the route replacer
+					weightedRoute(2,
+						weightedCluster(missingCluster, 2),
+					),
+				},
+			}},
+		}
+
+		expectedRoutes := &envoyapi.RouteConfiguration{
+			Name: routeCfgName,
+			VirtualHosts: []*route.VirtualHost{{
+				Routes: []*route.Route{
+					weightedRoute(3,
+						weightedCluster(clusterName, 1),
+						weightedCluster(otherClusterName, 2),
+					),
+					weightedRoute(2,
+						weightedCluster(missingCluster, 2),
+					),
+				},
+			}},
+		}
+
+		sanitized := sanitize(&v1.GlooOptions_InvalidConfigPolicy{
+			ReplaceInvalidRoutes:             true,
+			PruneInvalidWeightedDestinations: true,
+		}, routeCfg)
+
+		Expect(sanitized).To(Equal(expectedRoutes))
+	})
+
+	It("does not modify routes unless route replacement is enabled", func() {
+		routeCfg := &envoyapi.RouteConfiguration{
+			Name: routeCfgName,
+			VirtualHosts: []*route.VirtualHost{{
+				Routes: []*route.Route{
+					weightedRoute(4,
+						weightedCluster(clusterName, 1),
+						weightedCluster(missingCluster, 3),
+					),
+				},
+			}},
+		}
+
+		sanitized := sanitize(&v1.GlooOptions_InvalidConfigPolicy{
+			PruneInvalidWeightedDestinations: true,
+		}, routeCfg)
+
+		Expect(sanitized).To(Equal(routeCfg))
+	})
+})
@@ -27,7 +27,9 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	consulplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/docker"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
+	"github.com/solo-io/gloo/projects/gloo/pkg/rollout"
 	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
 	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
@@ -47,6 +49,7 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/server"
 	xdsserver "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/server"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 	"github.com/solo-io/solo-kit/pkg/errors"
 	"go.uber.org/zap"
@@ -63,7 +66,7 @@ func NewSetupFunc() setuputils.SetupFunc {
 }
 
 // used outside of this repo
-//noinspection GoUnusedExportedFunction
+// noinspection GoUnusedExportedFunction
 func NewSetupFuncWithExtensions(extensions Extensions) setuputils.SetupFunc {
 	runWithExtensions := func(opts bootstrap.Opts) error {
 		return RunGlooWithExtensions(opts, extensions)
@@ -116,7 +119,7 @@ func NewControlPlane(ctx context.Context, grpcServer *grpc.Server, bindAddr net.
 	hasher := &xds.ProxyKeyHasher{}
 	snapshotCache := cache.NewSnapshotCache(true, hasher, contextutils.LoggerFrom(ctx))
 	xdsServer := server.NewServer(snapshotCache, callbacks)
-	envoyv2.RegisterAggregatedDiscoveryServiceServer(grpcServer, xdsServer)
+	envoyv2.RegisterAggregatedDiscoveryServiceServer(grpcServer, xds.NewAggregatedServer(xdsServer, xds.NewDeltaServer(snapshotCache, hasher)))
 	reflection.Register(grpcServer)
 
 	return bootstrap.ControlPlane{
@@ -280,6 +283,7 @@ func (s *setupSyncer) Setup(ctx context.Context, kubeCache kube.SharedCache, mem
 	if len(opts.Consul.DnsServer) == 0 {
 		opts.Consul.DnsServer = consulplugin.DefaultDnsAddress
 	}
+	opts.Consul.DnsResolvers = consulplugin.NewDnsResolvers(opts.Consul.DnsServer)
 	if pollingInterval := settings.GetConsul().GetDnsPollingInterval(); pollingInterval != nil {
 		dnsPollingInterval, err := types.DurationFromProto(pollingInterval)
 		if err != nil {
@@ -290,12 +294,48 @@ func (s *setupSyncer) Setup(ctx context.Context, kubeCache kube.SharedCache, mem
 
 	// if vault service discovery specified, initialize consul watcher
 	if consulServiceDiscovery := settings.GetConsul().GetServiceDiscovery(); consulServiceDiscovery != nil {
+		// The ACL tokens referenced by the settings and the upstreams are read from secrets
+		tokenSecretClient, err := v1.NewSecretClient(opts.Secrets)
+		if err != nil {
+			return err
+		}
+		if err := tokenSecretClient.Register(); err != nil {
+			return err
+		}
+		opts.Consul.TokenResolver = consul.NewSecretTokenResolver(tokenSecretClient)
+		serviceTokens := &consul.ServiceTokens{
+			SecretRefs: settings.GetConsul().GetServiceTokenSecretRefs(),
+			Resolver:   opts.Consul.TokenResolver,
+		}
+
 		// Set up Consul client
-		consulClientWrapper, err := consul.NewConsulWatcher(consulClient, consulServiceDiscovery.GetDataCenters())
+		consulClientWrapper, err := consul.NewConsulWatcher(consulClient, consulServiceDiscovery.GetDataCenters(), serviceTokens)
 		if err != nil {
 			return err
 		}
 		opts.Consul.ConsulWatcher = consulClientWrapper
+
+		if connect := settings.GetConsul().GetConnect(); connect != nil {
+			serviceName := connect.GetServiceName()
+			if serviceName == "" {
+				serviceName = consulplugin.DefaultConnectServiceName
+			}
+			opts.Consul.Connect = &bootstrap.ConsulConnect{
+				Agent:       consulClient.Agent(),
+				ServiceName: serviceName,
+				SecretRef:   core.ResourceRef{Name: consulplugin.ConnectSecretName, Namespace: writeNamespace},
+			}
+		}
+	}
+
+	// if docker service discovery specified, initialize the docker client
+	if dockerServiceDiscovery := settings.GetDocker().GetServiceDiscovery(); dockerServiceDiscovery != nil {
+		dockerClient, err := docker.NewClient(settings.GetDocker().GetAddress())
+		if err != nil {
+			return err
+		}
+		opts.Docker.Client = dockerClient
+		opts.Docker.Network = dockerServiceDiscovery.GetNetwork()
 	}
 
 	err = s.runFunc(opts)
@@ -308,11 +348,15 @@ func (s *setupSyncer) Setup(ctx context.Context, kubeCache kube.SharedCache, mem
 
 type Extensions struct {
 	// Deprecated. Use PluginExtensionsFuncs instead.
+	// These plugins are shared by the translations of all proxies, which run concurrently, so they must be safe for concurrent use.
 	PluginExtensions      []plugins.Plugin
 	PluginExtensionsFuncs []func() plugins.Plugin
 	SyncerExtensions      []TranslatorSyncerExtensionFactory
 	XdsCallbacks          xdsserver.Callbacks
 
+	// custom sanitizers which are added to the end of the xds sanitizer chain
+	XdsSanitizers []sanitizer.Registration
+
 	// optional custom handler for envoy usage metrics that get pushed to the gloo pod
 	MetricsHandler metricsservice.MetricsHandler
 }
@@ -390,6 +434,13 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 	if err != nil {
 		return err
 	}
+	if connect := opts.Consul.Connect; connect != nil {
+		retryInterval := consulplugin.DefaultDnsPollingInterval
+		if opts.Consul.DnsPollingInterval != nil {
+			retryInterval = *opts.Consul.DnsPollingInterval
+		}
+		secretClient = consulplugin.NewConnectSecretClient(secretClient, connect.Agent, connect.ServiceName, connect.SecretRef, retryInterval)
+	}
 
 	artifactClient, err := v1.NewArtifactClient(opts.Artifacts)
 	if err != nil {
@@ -460,7 +511,7 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 
 	go errutils.AggregateErrs(watchOpts.Ctx, errs, edsErrs, "eds.gloo")
 
-	apiCache := v1.NewApiEmitter(
+	apiCache := v1.NewApiEmitterWithEmit(
 		artifactClient,
 		endpointClient,
 		proxyClient,
@@ -469,6 +520,7 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 		hybridUsClient,
 		authConfigClient,
 		rlClient,
+		plugins.Resyncs(),
 	)
 
 	rpt := reporter.NewReporter("gloo",
@@ -486,14 +538,14 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 		opts.ValidationServer.Server.SetValidator(validator)
 	}
 
-	routeReplacingSanitizer, err := sanitizer.NewRouteReplacingSanitizer(opts.Settings.GetGloo().GetInvalidConfigPolicy())
-	if err != nil {
-		return err
+	var eventRecorder sanitizer.EventRecorder
+	if opts.KubeClient != nil {
+		eventRecorder = sanitizer.NewKubeEventRecorder(watchOpts.Ctx, opts.KubeClient)
 	}
 
-	xdsSanitizer := sanitizer.XdsSanitizers{
-		sanitizer.NewUpstreamRemovingSanitizer(),
-		routeReplacingSanitizer,
+	xdsSanitizer, err := makeXdsSanitizerChain(watchOpts.Ctx, opts.Settings, extensions.XdsSanitizers, eventRecorder)
+	if err != nil {
+		return err
 	}
 
 	// Set up the syncer extension
@@ -515,9 +567,14 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 
 	translationSync := NewTranslatorSyncer(t, opts.ControlPlane.SnapshotCache, xdsHasher, xdsSanitizer, rpt, opts.DevMode, syncerExtensions, opts.Settings)
 
+	// progressively shifts the traffic of upstream groups to their canaries, on the elected replica only
+	rolloutController := rollout.NewController(upstreamGroupClient, rollout.NewPrometheusQuerier(nil))
+	go rolloutController.RunAsLeader(watchOpts.Ctx, opts.KubeClient, opts.WriteNamespace)
+
 	syncers := v1.ApiSyncers{
 		translationSync,
 		validator,
+		rolloutController,
 	}
 
 	apiEventLoop := v1.NewApiEventLoop(apiCache, syncers)
@@ -615,6 +672,53 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 	return nil
 }
 
+// builds the chain of sanitizers which run on every xds snapshot: Gloo's own sanitizers first,
+// followed by any custom sanitizers provided as extensions
+func makeXdsSanitizerChain(ctx context.Context, settings *v1.Settings, registrations []sanitizer.Registration, recorder sanitizer.EventRecorder) (*sanitizer.Chain, error) {
+	invalidConfigPolicy := settings.GetGloo().GetInvalidConfigPolicy()
+	if invalidConfigPolicy.GetSanitizerDryRun() {
+		// the chain's changes are only reported in dry-run mode, so show the routes replaceInvalidRoutes would replace.
+		// No events are recorded, as the resources are not actually changed
+		dryRunPolicy := *invalidConfigPolicy
+		dryRunPolicy.ReplaceInvalidRoutes = true
+		invalidConfigPolicy = &dryRunPolicy
+		recorder = nil
+	}
+
+	routeReplacingSanitizer, err := sanitizer.NewRouteReplacingSanitizer(invalidConfigPolicy, recorder)
+	if err != nil {
+		return nil, err
+	}
+
+	chain := sanitizer.NewChain()
+	builtins := []struct {
+		name         string
+		xdsSanitizer sanitizer.XdsSanitizer
+	}{
+		{"missing-tls-secret-remover", sanitizer.NewMissingTlsSecretSanitizer()},
+		{"upstream-remover", sanitizer.NewUpstreamRemovingSanitizer(recorder)},
+		{"weighted-destination-pruner", sanitizer.NewWeightedDestinationPruningSanitizer(invalidConfigPolicy)},
+		{"invalid-route-replacer", routeReplacingSanitizer},
+	}
+	for _, builtin := range builtins {
+		if err := chain.Register(builtin.name, builtin.xdsSanitizer); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, registration := range registrations {
+		xdsSanitizer, err := registration.Factory(ctx, settings)
+		if err != nil {
+			return nil, errors.Wrapf(err, "creating sanitizer %v", registration.Name)
+		}
+		if err := chain.Register(registration.Name, xdsSanitizer); err != nil {
+			return nil, err
+		}
+	}
+
+	return chain, nil
+}
+
 func constructOpts(ctx context.Context, clientset *kubernetes.Interface, kubeCache kube.SharedCache, consulClient *consulapi.Client, vaultClient *vaultapi.Client, memCache memory.InMemoryResourceCache, settings *v1.Settings) (bootstrap.Opts, error) {
 
 	var (
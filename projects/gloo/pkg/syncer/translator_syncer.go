@@ -26,6 +26,8 @@ type translatorSyncer struct {
 	// used to track which envoy node IDs exist without belonging to a proxy
 	extensionKeys map[string]struct{}
 	settings      *v1.Settings
+	// the hashes of the last snapshots set for each proxy, to skip setting identical ones
+	snapshotHashes map[string]uint64
 }
 
 type TranslatorSyncerExtensionParams struct {
@@ -41,13 +43,14 @@ type TranslatorSyncerExtension interface {
 
 func NewTranslatorSyncer(translator translator.Translator, xdsCache envoycache.SnapshotCache, xdsHasher *xds.ProxyKeyHasher, sanitizer sanitizer.XdsSanitizer, reporter reporter.Reporter, devMode bool, extensions []TranslatorSyncerExtension, settings *v1.Settings) v1.ApiSyncer {
 	s := &translatorSyncer{
-		translator: translator,
-		xdsCache:   xdsCache,
-		xdsHasher:  xdsHasher,
-		reporter:   reporter,
-		extensions: extensions,
-		sanitizer:  sanitizer,
-		settings:   settings,
+		translator:     translator,
+		xdsCache:       xdsCache,
+		xdsHasher:      xdsHasher,
+		reporter:       reporter,
+		extensions:     extensions,
+		sanitizer:      sanitizer,
+		settings:       settings,
+		snapshotHashes: map[string]uint64{},
 	}
 	if devMode {
 		// TODO(ilackarms): move this somewhere else?
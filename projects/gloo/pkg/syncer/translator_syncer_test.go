@@ -2,16 +2,25 @@ package syncer_test
 
 import (
 	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 
 	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
 
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/ptypes/duration"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/syncer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	validationutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
@@ -110,7 +119,14 @@ var _ = Describe("Translate Proxy", func() {
 	})
 
 	It("updates the cache with the sanitized snapshot", func() {
-		sanitizer.snap = envoycache.NewEasyGenericSnapshot("easy")
+		sanitizer.snap = xds.NewSnapshotFromResources(
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("sanitized", []envoycache.Resource{
+				xds.NewEnvoyResource(&v2.Cluster{Name: "sanitized-cluster"}),
+			}),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
+		)
 		err := syncer.Sync(context.Background(), snap)
 		Expect(err).NotTo(HaveOccurred())
 
@@ -148,8 +164,275 @@ var _ = Describe("Translate Proxy", func() {
 
 		Expect(oldRoutes).To(Equal(newRoutes))
 	})
+
+	It("reports the changes the sanitizer would make without applying them in dry-run mode", func() {
+		settings.Gloo = &v1.GlooOptions{
+			InvalidConfigPolicy: &v1.GlooOptions_InvalidConfigPolicy{SanitizerDryRun: true},
+		}
+		sanitizer.snap = xds.NewSnapshotFromResources(
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("", nil),
+			envoycache.NewResources("sanitized", []envoycache.Resource{
+				xds.NewEnvoyResource(&v2.Listener{Name: "sanitized-listener"}),
+			}),
+		)
+
+		err := syncer.Sync(context.Background(), snap)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(sanitizer.called).To(BeTrue())
+		Expect(xdsCache.setSnap).To(Equal(envoycache.NilSnapshot{}))
+
+		proxies, err := proxyClient.List(ns, clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(proxies).To(HaveLen(1))
+		Expect(proxies[0].Status.Reason).To(ContainSubstring("sanitizer dry run: listener sanitized-listener added"))
+	})
+})
+
+var _ = Describe("Translate Proxies", func() {
+
+	var (
+		xdsCache    envoycache.SnapshotCache
+		translator  *concurrentTranslator
+		settings    *v1.Settings
+		proxyClient v1.ProxyClient
+		rep         reporter.Reporter
+		snap        *v1.ApiSnapshot
+	)
+
+	BeforeEach(func() {
+		xdsCache = envoycache.NewSnapshotCache(true, xds.NewNodeHasher(), nil)
+		translator = &concurrentTranslator{}
+		settings = &v1.Settings{}
+
+		resourceClientFactory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		var err error
+		proxyClient, err = v1.NewProxyClient(resourceClientFactory)
+		Expect(err).NotTo(HaveOccurred())
+		rep = reporter.NewReporter("syncer-test", proxyClient.BaseClient())
+
+		snap = &v1.ApiSnapshot{}
+		for _, name := range []string{"proxy-1", "proxy-2", "proxy-3", "proxy-4"} {
+			proxy, err := proxyClient.Write(&v1.Proxy{
+				Metadata: core.Metadata{Namespace: "gloo-system", Name: name},
+			}, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			snap.Proxies = append(snap.Proxies, proxy)
+		}
+	})
+
+	sync := func() {
+		syncer := NewTranslatorSyncer(translator, xdsCache, &xds.ProxyKeyHasher{}, sanitizer.XdsSanitizers{}, rep, false, nil, settings)
+		err := syncer.Sync(context.Background(), snap)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	It("translates the proxies concurrently and sets a snapshot for each of them", func() {
+		settings.Gloo = &v1.GlooOptions{ProxyTranslationConcurrency: &types.UInt32Value{Value: 2}}
+		sync()
+
+		Expect(translator.maxActive).To(BeEquivalentTo(2))
+		for _, proxy := range snap.Proxies {
+			xdsSnap, err := xdsCache.GetSnapshot(xds.SnapshotKey(proxy))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(xdsSnap.GetResources(xds.ClusterType).Version).To(Equal(proxy.Metadata.Name))
+		}
+	})
+
+	It("translates the proxies one at a time with a concurrency of 1", func() {
+		settings.Gloo = &v1.GlooOptions{ProxyTranslationConcurrency: &types.UInt32Value{Value: 1}}
+		sync()
+
+		Expect(translator.maxActive).To(BeEquivalentTo(1))
+	})
+
+	It("writes the reports of every proxy", func() {
+		translator.reportErrs = true
+		sync()
+
+		proxies, err := proxyClient.List("gloo-system", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(proxies).To(HaveLen(4))
+		for _, proxy := range proxies {
+			Expect(proxy.Status.State).To(Equal(core.Status_Rejected))
+			Expect(proxy.Status.Reason).To(ContainSubstring("error translating " + proxy.Metadata.Name))
+		}
+	})
 })
 
+var _ = Describe("Skipping unchanged snapshots", func() {
+
+	var (
+		xdsCache   *mockXdsCache
+		translator *snapshotTranslator
+		syncer     v1.ApiSyncer
+		snap       *v1.ApiSnapshot
+	)
+
+	BeforeEach(func() {
+		xdsCache = &mockXdsCache{}
+		translator = &snapshotTranslator{}
+
+		resourceClientFactory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		proxyClient, err := v1.NewProxyClient(resourceClientFactory)
+		Expect(err).NotTo(HaveOccurred())
+		proxy, err := proxyClient.Write(&v1.Proxy{
+			Metadata: core.Metadata{Namespace: "gloo-system", Name: "proxy"},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		snap = &v1.ApiSnapshot{Proxies: v1.ProxyList{proxy}}
+
+		rep := reporter.NewReporter("syncer-test", proxyClient.BaseClient())
+		syncer = NewTranslatorSyncer(translator, xdsCache, &xds.ProxyKeyHasher{}, sanitizer.XdsSanitizers{}, rep, false, nil, &v1.Settings{})
+	})
+
+	sync := func() {
+		xdsCache.called = false
+		err := syncer.Sync(context.Background(), snap)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	It("doesn't set snapshots which are identical to the previous one", func() {
+		translator.timeout = 1
+		sync()
+		Expect(xdsCache.called).To(BeTrue())
+
+		sync()
+		Expect(xdsCache.called).To(BeFalse())
+
+		translator.timeout = 2
+		sync()
+		Expect(xdsCache.called).To(BeTrue())
+		cluster := xdsCache.setSnap.GetResources(xds.ClusterType).Items["cluster"].ResourceProto().(*v2.Cluster)
+		Expect(cluster.GetConnectTimeout().GetSeconds()).To(BeEquivalentTo(2))
+	})
+
+	It("sets the snapshot of a proxy again after it was garbage collected", func() {
+		sync()
+		Expect(xdsCache.called).To(BeTrue())
+
+		// the proxy is deleted and its snapshot emptied, then it's created again
+		proxies := snap.Proxies
+		xdsCache.statusKeys = []string{xds.SnapshotKey(proxies[0])}
+		snap.Proxies = nil
+		sync()
+		Expect(xdsCache.setSnap).To(Equal(xds.NewSnapshotFromResources(
+			envoycache.Resources{Version: "empty", Items: map[string]envoycache.Resource{}},
+			envoycache.Resources{Version: "empty", Items: map[string]envoycache.Resource{}},
+			envoycache.Resources{Version: "empty", Items: map[string]envoycache.Resource{}},
+			envoycache.Resources{Version: "empty", Items: map[string]envoycache.Resource{}},
+		)))
+
+		xdsCache.statusKeys = nil
+		snap.Proxies = proxies
+		sync()
+		Expect(xdsCache.called).To(BeTrue())
+		Expect(xdsCache.setSnap.GetResources(xds.ClusterType).Items).To(HaveKey("cluster"))
+	})
+})
+
+var _ = Describe("Missing TLS secrets", func() {
+	It("sets the snapshot and reports the missing secrets as warnings on the proxy", func() {
+		xdsCache := &mockXdsCache{}
+		resourceClientFactory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		proxyClient, err := v1.NewProxyClient(resourceClientFactory)
+		Expect(err).NotTo(HaveOccurred())
+		proxy, err := proxyClient.Write(&v1.Proxy{
+			Metadata: core.Metadata{Namespace: "gloo-system", Name: "proxy"},
+			Listeners: []*v1.Listener{{
+				Name:         "listener",
+				ListenerType: &v1.Listener_HttpListener{HttpListener: &v1.HttpListener{}},
+				SslConfigurations: []*v1.SslConfig{{
+					SslSecrets: &v1.SslConfig_SecretRef{SecretRef: &core.ResourceRef{Name: "missing", Namespace: "gloo-system"}},
+				}},
+			}},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		snap := &v1.ApiSnapshot{Proxies: v1.ProxyList{proxy}}
+
+		// the route replacing sanitizer validates the reports strictly when it is disabled
+		routeReplacingSanitizer, err := sanitizer.NewRouteReplacingSanitizer(&v1.GlooOptions_InvalidConfigPolicy{}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		xdsSanitizer := sanitizer.XdsSanitizers{sanitizer.NewMissingTlsSecretSanitizer(), routeReplacingSanitizer}
+
+		rep := reporter.NewReporter("syncer-test", proxyClient.BaseClient())
+		syncer := NewTranslatorSyncer(&missingSecretTranslator{}, xdsCache, &xds.ProxyKeyHasher{}, xdsSanitizer, rep, false, nil, &v1.Settings{})
+		err = syncer.Sync(context.Background(), snap)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(xdsCache.setSnap.GetResources(xds.ListenerType).Items).To(HaveKey("listener"))
+
+		proxies, err := proxyClient.List("gloo-system", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(proxies).To(HaveLen(1))
+		Expect(proxies[0].Status.State).To(Equal(core.Status_Warning))
+		Expect(proxies[0].Status.Reason).To(ContainSubstring("SSL secret not found"))
+	})
+})
+
+// a translator which reports the error the gloo translator reports for the missing secret of a listener
+type missingSecretTranslator struct{}
+
+func (t *missingSecretTranslator) Translate(params plugins.Params, proxy *v1.Proxy) (envoycache.Snapshot, reporter.ResourceReports, *validation.ProxyReport, error) {
+	_, err := params.Snapshot.Secrets.Find("gloo-system", "missing")
+	rpts := reporter.ResourceReports{}
+	rpts.AddError(proxy, validationutils.MakeListenerErr(validation.ListenerReport_Error_SSLConfigError, utils.SslSecretNotFoundError(err).Error()))
+
+	listener := &v2.Listener{Name: "listener", FilterChains: []*envoylistener.FilterChain{{}}}
+	empty := envoycache.NewResources("", nil)
+	listeners := envoycache.NewResources("listeners", []envoycache.Resource{xds.NewEnvoyResource(listener)})
+	return xds.NewSnapshotFromResources(empty, empty, empty, listeners), rpts, &validation.ProxyReport{}, nil
+}
+
+// a translator which translates proxies to a snapshot with a single cluster
+type snapshotTranslator struct {
+	timeout int64
+}
+
+func (t *snapshotTranslator) Translate(params plugins.Params, proxy *v1.Proxy) (envoycache.Snapshot, reporter.ResourceReports, *validation.ProxyReport, error) {
+	cluster := &v2.Cluster{Name: "cluster", ConnectTimeout: &duration.Duration{Seconds: t.timeout}}
+	clusters := envoycache.NewResources(fmt.Sprint(t.timeout), []envoycache.Resource{xds.NewEnvoyResource(cluster)})
+	empty := envoycache.NewResources("", nil)
+	rpts := reporter.ResourceReports{}
+	rpts.Accept(proxy)
+	return xds.NewSnapshotFromResources(empty, clusters, empty, empty), rpts, &validation.ProxyReport{}, nil
+}
+
+// a translator which takes a while to translate proxies, and records how many it translates at the same time
+type concurrentTranslator struct {
+	reportErrs bool
+	active     int32
+	maxActive  int32
+}
+
+func (t *concurrentTranslator) Translate(params plugins.Params, proxy *v1.Proxy) (envoycache.Snapshot, reporter.ResourceReports, *validation.ProxyReport, error) {
+	active := atomic.AddInt32(&t.active, 1)
+	defer atomic.AddInt32(&t.active, -1)
+	for {
+		max := atomic.LoadInt32(&t.maxActive)
+		if active <= max || atomic.CompareAndSwapInt32(&t.maxActive, max, active) {
+			break
+		}
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	rpts := reporter.ResourceReports{}
+	rpts.Accept(proxy)
+	if t.reportErrs {
+		rpts.AddError(proxy, errors.Errorf("error translating %v", proxy.Metadata.Name))
+	}
+	resources := envoycache.NewResources(proxy.Metadata.Name, nil)
+	return xds.NewSnapshotFromResources(resources, resources, resources, resources), rpts, &validation.ProxyReport{}, nil
+}
+
 type mockTranslator struct {
 	reportErrs bool
 }
@@ -166,7 +449,8 @@ func (t *mockTranslator) Translate(params plugins.Params, proxy *v1.Proxy) (envo
 var _ envoycache.SnapshotCache = &mockXdsCache{}
 
 type mockXdsCache struct {
-	called bool
+	called     bool
+	statusKeys []string
 	// snap that is set
 	setSnap envoycache.Snapshot
 	// snap that is returned
@@ -186,7 +470,7 @@ func (*mockXdsCache) GetStatusInfo(string) envoycache.StatusInfo {
 }
 
 func (c *mockXdsCache) GetStatusKeys() []string {
-	return []string{}
+	return c.statusKeys
 }
 
 func (c *mockXdsCache) SetSnapshot(node string, snapshot envoycache.Snapshot) error {
@@ -51,6 +51,10 @@ func (t *translatorInstance) computeCluster(params plugins.Params, upstream *v1.
 			reports.AddError(upstream, err)
 		}
 	}
+	// after the plugins, which set the transport socket that is wrapped
+	if err := applyUpstreamProxyProtocol(upstream, out); err != nil {
+		reports.AddError(upstream, err)
+	}
 	if err := validateCluster(out); err != nil {
 		reports.AddError(upstream, eris.Wrapf(err, "cluster was configured improperly "+
 			"by one or more plugins: %v", out))
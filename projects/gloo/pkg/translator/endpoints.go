@@ -45,7 +45,12 @@ func (t *translatorInstance) computeClusterEndpoints(params plugins.Params, repo
 
 func loadAssignmentForUpstream(upstream *v1.Upstream, clusterEndpoints []*v1.Endpoint) *envoyapi.ClusterLoadAssignment {
 	clusterName := UpstreamToClusterName(upstream.Metadata.Ref())
-	var endpoints []*envoyendpoints.LbEndpoint
+	// the endpoints are grouped by their locality, in the order the localities first appear in
+	type localityKey struct {
+		region, zone, subZone string
+	}
+	localities := map[localityKey]*envoyendpoints.LocalityLbEndpoints{}
+	var localityEndpoints []*envoyendpoints.LocalityLbEndpoints
 	for _, addr := range clusterEndpoints {
 		metadata := getLbMetadata(upstream, addr.Metadata.Labels, "")
 		metadata = addAnnotations(metadata, addr.Metadata.Annotations)
@@ -75,14 +80,28 @@ func loadAssignmentForUpstream(upstream *v1.Upstream, clusterEndpoints []*v1.End
 				},
 			},
 		}
-		endpoints = append(endpoints, &lbEndpoint)
+
+		locality := addr.GetLocality()
+		key := localityKey{locality.GetRegion(), locality.GetZone(), locality.GetSubZone()}
+		endpoints, ok := localities[key]
+		if !ok {
+			endpoints = &envoyendpoints.LocalityLbEndpoints{}
+			if key != (localityKey{}) {
+				endpoints.Locality = &envoycore.Locality{
+					Region:  locality.GetRegion(),
+					Zone:    locality.GetZone(),
+					SubZone: locality.GetSubZone(),
+				}
+			}
+			localities[key] = endpoints
+			localityEndpoints = append(localityEndpoints, endpoints)
+		}
+		endpoints.LbEndpoints = append(endpoints.LbEndpoints, &lbEndpoint)
 	}
 
 	return &envoyapi.ClusterLoadAssignment{
 		ClusterName: clusterName,
-		Endpoints: []*envoyendpoints.LocalityLbEndpoints{{
-			LbEndpoints: endpoints,
-		}},
+		Endpoints:   localityEndpoints,
 	}
 }
 
@@ -0,0 +1,223 @@
+package translator
+
+import (
+	"net"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	errors "github.com/rotisserie/eris"
+	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+const tlsTransportProtocol = "tls"
+
+var (
+	InvalidSourcePrefixRangeErr = func(cidr *v1.CidrRange) error {
+		return errors.Errorf("invalid source prefix range %v/%v", cidr.GetAddressPrefix(), cidr.GetPrefixLen().GetValue())
+	}
+)
+
+// computeHybridListenerResources translates each matched listener of the hybrid listener like a listener of its
+// own type, and combines their filter chains into one envoy listener. The filter chains of each matched listener
+// only match the connections selected by its matcher.
+func (t *translatorInstance) computeHybridListenerResources(params plugins.Params, proxy *v1.Proxy, listener *v1.Listener, listenerReport *validationapi.ListenerReport) *listenerResources {
+	params.Ctx = contextutils.WithLogger(params.Ctx, "compute_listener."+listener.Name)
+
+	validateListenerPorts(proxy, listenerReport)
+
+	var (
+		routeConfigs []*envoyapi.RouteConfiguration
+		filterChains []*envoylistener.FilterChain
+	)
+	matchedListenerReports := listenerReport.GetHybridListenerReport().GetMatchedListenerReports()
+	for i, matchedListener := range listener.GetHybridListener().GetMatchedListeners() {
+		if i >= len(matchedListenerReports) {
+			contextutils.LoggerFrom(params.Ctx).DPanic("internal error: missing report for matched listener")
+			return nil
+		}
+		subListener := matchedListenerToListener(listener, i, matchedListener)
+		subListenerReport := matchedListenerReportToListenerReport(matchedListenerReports[i])
+
+		// Calculate routes before listeners, so that HttpFilters is called after ProcessVirtualHost\ProcessRoute
+		if routeConfig := t.computeRouteConfig(params, proxy, subListener, RouteConfigName(subListener), subListenerReport); routeConfig != nil {
+			routeConfigs = append(routeConfigs, routeConfig)
+		}
+
+		subFilterChains, _ := t.computeFilterChains(params, subListener, subListenerReport)
+		for _, filterChain := range subFilterChains {
+			if err := applyMatcher(filterChain, matchedListener.GetMatcher()); err != nil {
+				validation.AppendListenerError(subListenerReport,
+					validationapi.ListenerReport_Error_ProcessingError,
+					err.Error())
+			}
+		}
+		filterChains = append(filterChains, subFilterChains...)
+
+		// the matched listeners have no report for their top-level config, it is part of the hybrid listener's
+		listenerReport.Errors = append(listenerReport.Errors, subListenerReport.Errors...)
+	}
+
+	envoyListener := t.computeListenerWithFilterChains(params, listener, filterChains, listenerReport)
+	if requiresTlsInspector(envoyListener) {
+		prependListenerFilter(envoyListener, &envoylistener.ListenerFilter{Name: wellknown.TlsInspector})
+	}
+
+	if listener.GetOptions().GetHttp3() != nil {
+		validation.AppendListenerError(listenerReport,
+			validationapi.ListenerReport_Error_ProcessingError,
+			Http3NotSupportedErr.Error())
+	}
+
+	return &listenerResources{
+		listeners:    []*envoyapi.Listener{envoyListener},
+		routeConfigs: routeConfigs,
+	}
+}
+
+// matchedListenerToListener returns a listener of the type of the matched listener, with the name, address, port
+// and options of the hybrid listener, and the ssl config of the matcher
+func matchedListenerToListener(listener *v1.Listener, idx int, matchedListener *v1.MatchedListener) *v1.Listener {
+	out := &v1.Listener{
+		Name:          MatchedListenerName(listener, idx),
+		BindAddress:   listener.BindAddress,
+		BindPort:      listener.BindPort,
+		UseProxyProto: listener.UseProxyProto,
+		Options:       listener.Options,
+		Metadata:      listener.Metadata,
+	}
+	sslConfig := matchedListener.GetMatcher().GetSslConfig()
+	switch listenerType := matchedListener.GetListenerType().(type) {
+	case *v1.MatchedListener_HttpListener:
+		out.ListenerType = &v1.Listener_HttpListener{
+			HttpListener: listenerType.HttpListener,
+		}
+		if sslConfig != nil {
+			out.SslConfigurations = []*v1.SslConfig{sslConfig}
+		}
+	case *v1.MatchedListener_TcpListener:
+		tcpListener := listenerType.TcpListener
+		if sslConfig != nil && tcpListener != nil {
+			// the tcp hosts without an ssl config of their own terminate tls with the one of the matcher
+			tcpListenerCopy := *tcpListener
+			tcpListenerCopy.TcpHosts = make([]*v1.TcpHost, len(tcpListener.TcpHosts))
+			for i, host := range tcpListener.TcpHosts {
+				if host.GetSslConfig() == nil {
+					hostCopy := *host
+					hostCopy.SslConfig = sslConfig
+					host = &hostCopy
+				}
+				tcpListenerCopy.TcpHosts[i] = host
+			}
+			tcpListener = &tcpListenerCopy
+		}
+		out.ListenerType = &v1.Listener_TcpListener{
+			TcpListener: tcpListener,
+		}
+	}
+	return out
+}
+
+func matchedListenerReportToListenerReport(report *validationapi.MatchedListenerReport) *validationapi.ListenerReport {
+	switch reportType := report.GetListenerReportType().(type) {
+	case *validationapi.MatchedListenerReport_HttpListenerReport:
+		return &validationapi.ListenerReport{
+			ListenerTypeReport: &validationapi.ListenerReport_HttpListenerReport{
+				HttpListenerReport: reportType.HttpListenerReport,
+			},
+		}
+	case *validationapi.MatchedListenerReport_TcpListenerReport:
+		return &validationapi.ListenerReport{
+			ListenerTypeReport: &validationapi.ListenerReport_TcpListenerReport{
+				TcpListenerReport: reportType.TcpListenerReport,
+			},
+		}
+	}
+	return &validationapi.ListenerReport{}
+}
+
+// applyMatcher restricts the filter chain to the connections selected by the matcher
+func applyMatcher(filterChain *envoylistener.FilterChain, matcher *v1.Matcher) error {
+	if filterChain.FilterChainMatch == nil {
+		filterChain.FilterChainMatch = &envoylistener.FilterChainMatch{}
+	}
+	match := filterChain.FilterChainMatch
+
+	for _, cidr := range matcher.GetSourcePrefixRanges() {
+		cidrRange, err := toEnvoyCidrRange(cidr)
+		if err != nil {
+			return err
+		}
+		match.SourcePrefixRanges = append(match.SourcePrefixRanges, cidrRange)
+	}
+
+	// the server names of terminated tls connections come from the sni domains of the ssl config
+	if matcher.GetSslConfig() == nil && len(match.ServerNames) == 0 {
+		match.ServerNames = matcher.GetServerNames()
+	}
+	match.ApplicationProtocols = matcher.GetApplicationProtocols()
+
+	if filterChain.TransportSocket != nil || len(match.ServerNames) > 0 || len(match.ApplicationProtocols) > 0 {
+		match.TransportProtocol = tlsTransportProtocol
+	}
+	return nil
+}
+
+func toEnvoyCidrRange(cidr *v1.CidrRange) (*envoycore.CidrRange, error) {
+	ip := net.ParseIP(cidr.GetAddressPrefix())
+	if ip == nil {
+		return nil, InvalidSourcePrefixRangeErr(cidr)
+	}
+	maxLen := uint32(net.IPv6len * 8)
+	if ip.To4() != nil {
+		maxLen = net.IPv4len * 8
+	}
+	prefixLen := maxLen
+	if cidr.GetPrefixLen() != nil {
+		prefixLen = cidr.GetPrefixLen().GetValue()
+	}
+	if prefixLen > maxLen {
+		return nil, InvalidSourcePrefixRangeErr(cidr)
+	}
+	return &envoycore.CidrRange{
+		AddressPrefix: cidr.GetAddressPrefix(),
+		PrefixLen:     &wrappers.UInt32Value{Value: prefixLen},
+	}, nil
+}
+
+// requiresTlsInspector returns true if the filter chains of the listener match on properties of tls connections,
+// and the listener does not inspect them already
+func requiresTlsInspector(listener *envoyapi.Listener) bool {
+	for _, listenerFilter := range listener.ListenerFilters {
+		if listenerFilter.Name == wellknown.TlsInspector {
+			return false
+		}
+	}
+	for _, filterChain := range listener.FilterChains {
+		if filterChain.GetFilterChainMatch().GetTransportProtocol() == tlsTransportProtocol {
+			return true
+		}
+	}
+	return false
+}
+
+// HttpListenersByRouteConfigName returns the http listener of the listener, or the http listeners of its matched
+// listeners if it is a hybrid listener, by the name of the route configuration they are translated to
+func HttpListenersByRouteConfigName(listener *v1.Listener) map[string]*v1.HttpListener {
+	httpListeners := map[string]*v1.HttpListener{}
+	if httpListener := listener.GetHttpListener(); httpListener != nil {
+		httpListeners[RouteConfigName(listener)] = httpListener
+	}
+	for i, matchedListener := range listener.GetHybridListener().GetMatchedListeners() {
+		if httpListener := matchedListener.GetHttpListener(); httpListener != nil {
+			httpListeners[RouteConfigName(&v1.Listener{Name: MatchedListenerName(listener, i)})] = httpListener
+		}
+	}
+	return httpListeners
+}
@@ -29,41 +29,27 @@ func (t *translatorInstance) computeListener(params plugins.Params, proxy *v1.Pr
 	params.Ctx = contextutils.WithLogger(params.Ctx, "compute_listener."+listener.Name)
 
 	validateListenerPorts(proxy, listenerReport)
-	var filterChains []*envoylistener.FilterChain
-	switch listener.GetListenerType().(type) {
-	case *v1.Listener_HttpListener:
-		// run the http filter chain plugins and listener plugins
-		listenerFilters := t.computeListenerFilters(params, listener, listenerReport)
-		if len(listenerFilters) == 0 {
-			return nil
-		}
-		filterChains = t.computeFilterChainsFromSslConfig(params.Snapshot, listener, listenerFilters, listenerReport)
-	case *v1.Listener_TcpListener:
-		// run the tcp filter chain plugins
-		for _, plug := range t.plugins {
-			listenerPlugin, ok := plug.(plugins.ListenerFilterChainPlugin)
-			if !ok {
-				continue
-			}
-			result, err := listenerPlugin.ProcessListenerFilterChain(params, listener)
-			if err != nil {
-				validation.AppendListenerError(listenerReport,
-					validationapi.ListenerReport_Error_ProcessingError,
-					err.Error())
-				continue
-			}
-			filterChains = append(filterChains, result...)
-		}
+	filterChains, ok := t.computeFilterChains(params, listener, listenerReport)
+	if !ok {
+		return nil
 	}
+	return t.computeListenerWithFilterChains(params, listener, filterChains, listenerReport)
+}
 
+func (t *translatorInstance) computeListenerWithFilterChains(params plugins.Params, listener *v1.Listener, filterChains []*envoylistener.FilterChain, listenerReport *validationapi.ListenerReport) *envoyapi.Listener {
 	CheckForDuplicateFilterChainMatches(filterChains, listenerReport)
 
+	protocol := envoycore.SocketAddress_TCP
+	if listener.GetUdpListener() != nil {
+		protocol = envoycore.SocketAddress_UDP
+	}
+
 	out := &envoyapi.Listener{
 		Name: listener.Name,
 		Address: &envoycore.Address{
 			Address: &envoycore.Address_SocketAddress{
 				SocketAddress: &envoycore.SocketAddress{
-					Protocol: envoycore.SocketAddress_TCP,
+					Protocol: protocol,
 					Address:  listener.BindAddress,
 					PortSpecifier: &envoycore.SocketAddress_PortValue{
 						PortValue: listener.BindPort,
@@ -87,10 +73,44 @@ func (t *translatorInstance) computeListener(params plugins.Params, proxy *v1.Pr
 				err.Error())
 		}
 	}
+	addProxyProtocolListenerFilter(listener, out)
 
 	return out
 }
 
+// computeFilterChains returns the filter chains of an http or tcp listener. It returns false if the listener
+// should be skipped, i.e. if it is an http listener without virtual hosts.
+// UDP listeners have no filter chains, their udp proxy filter is added by the listener plugins.
+func (t *translatorInstance) computeFilterChains(params plugins.Params, listener *v1.Listener, listenerReport *validationapi.ListenerReport) ([]*envoylistener.FilterChain, bool) {
+	var filterChains []*envoylistener.FilterChain
+	switch listener.GetListenerType().(type) {
+	case *v1.Listener_HttpListener:
+		// run the http filter chain plugins and listener plugins
+		listenerFilters := t.computeListenerFilters(params, listener, listenerReport)
+		if len(listenerFilters) == 0 {
+			return nil, false
+		}
+		filterChains = t.computeFilterChainsFromSslConfig(params.Snapshot, listener, listenerFilters, listenerReport)
+	case *v1.Listener_TcpListener:
+		// run the tcp filter chain plugins
+		for _, plug := range t.plugins {
+			listenerPlugin, ok := plug.(plugins.ListenerFilterChainPlugin)
+			if !ok {
+				continue
+			}
+			result, err := listenerPlugin.ProcessListenerFilterChain(params, listener)
+			if err != nil {
+				validation.AppendListenerError(listenerReport,
+					validationapi.ListenerReport_Error_ProcessingError,
+					err.Error())
+				continue
+			}
+			filterChains = append(filterChains, result...)
+		}
+	}
+	return filterChains, true
+}
+
 func (t *translatorInstance) computeListenerFilters(params plugins.Params, listener *v1.Listener, listenerReport *validationapi.ListenerReport) []*envoylistener.Filter {
 	var listenerFilters []plugins.StagedListenerFilter
 	// run the Listener Filter Plugins
@@ -134,7 +154,7 @@ func (t *translatorInstance) computeListenerFilters(params plugins.Params, liste
 	}
 
 	// add the http connection manager filter after all the InAuth Listener Filters
-	rdsName := routeConfigName(listener)
+	rdsName := RouteConfigName(listener)
 	httpConnMgr := t.computeHttpConnectionManagerFilter(params, httpListener.HttpListener, rdsName, httpListenerReport)
 	listenerFilters = append(listenerFilters, plugins.StagedListenerFilter{
 		ListenerFilter: httpConnMgr,
@@ -1,7 +1,16 @@
 package translator
 
-import v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+import (
+	"fmt"
 
-func routeConfigName(listener *v1.Listener) string {
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+func RouteConfigName(listener *v1.Listener) string {
 	return listener.Name + "-routes"
 }
+
+// MatchedListenerName is the name of the matched listener of a hybrid listener at the given index
+func MatchedListenerName(listener *v1.Listener, idx int) string {
+	return fmt.Sprintf("%s-%d", listener.Name, idx)
+}
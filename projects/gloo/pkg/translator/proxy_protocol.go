@@ -0,0 +1,107 @@
+package translator
+
+import (
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+const (
+	upstreamProxyProtocolTransportSocket = "envoy.transport_sockets.upstream_proxy_protocol"
+
+	// go-control-plane does not have the config of the upstream proxy protocol transport socket yet. it only wraps
+	// the proxy protocol config and the transport socket of the cluster, so it is encoded by hand.
+	upstreamProxyProtocolTypeUrl = "type.googleapis.com/envoy.extensions.transport_sockets.proxy_protocol.v3.ProxyProtocolUpstreamTransport"
+)
+
+var (
+	InvalidProxyProtocolVersionErr = func(version string) error {
+		return errors.Errorf("invalid proxy protocol version %q, must be one of V1 or V2", version)
+	}
+)
+
+// addProxyProtocolListenerFilter adds the proxy protocol listener filter to listeners with the proxy protocol option.
+// It is the first listener filter, so that the other listener filters see the connection after the PROXY header.
+func addProxyProtocolListenerFilter(listener *v1.Listener, out *envoyapi.Listener) {
+	if listener.GetOptions().GetProxyProtocol() == nil {
+		return
+	}
+	out.ListenerFilters = append(
+		[]*envoylistener.ListenerFilter{{Name: wellknown.ProxyProtocol}},
+		out.ListenerFilters...,
+	)
+}
+
+// prependListenerFilter adds the listener filter before the others, but after the proxy protocol filter, which has
+// to read the PROXY header before any other listener filter inspects the connection
+func prependListenerFilter(out *envoyapi.Listener, filter *envoylistener.ListenerFilter) {
+	idx := 0
+	if len(out.ListenerFilters) > 0 && out.ListenerFilters[0].GetName() == wellknown.ProxyProtocol {
+		idx = 1
+	}
+	filters := make([]*envoylistener.ListenerFilter, 0, len(out.ListenerFilters)+1)
+	filters = append(filters, out.ListenerFilters[:idx]...)
+	filters = append(filters, filter)
+	filters = append(filters, out.ListenerFilters[idx:]...)
+	out.ListenerFilters = filters
+}
+
+// applyUpstreamProxyProtocol wraps the transport socket of the cluster in the upstream proxy protocol transport
+// socket, which sends the PROXY header before the data of the wrapped socket, e.g. before the TLS handshake
+func applyUpstreamProxyProtocol(upstream *v1.Upstream, out *envoyapi.Cluster) error {
+	if upstream.GetProxyProtocolVersion() == nil {
+		return nil
+	}
+	versionName := upstream.GetProxyProtocolVersion().GetValue()
+	version, ok := envoycorev3.ProxyProtocolConfig_Version_value[versionName]
+	if !ok {
+		return InvalidProxyProtocolVersionErr(versionName)
+	}
+
+	transportSocket := out.GetTransportSocket()
+	if transportSocket == nil {
+		transportSocket = &envoycore.TransportSocket{
+			Name: wellknown.TransportSocketRawBuffer,
+		}
+	}
+
+	proxyProtocolConfig, err := proto.Marshal(&envoycorev3.ProxyProtocolConfig{
+		Version: envoycorev3.ProxyProtocolConfig_Version(version),
+	})
+	if err != nil {
+		return err
+	}
+	wrappedTransportSocket, err := proto.Marshal(transportSocket)
+	if err != nil {
+		return err
+	}
+
+	// ProxyProtocolUpstreamTransport has the proxy protocol config as its first field, and the wrapped
+	// transport socket as its second
+	config := proto.NewBuffer(nil)
+	for i, field := range [][]byte{proxyProtocolConfig, wrappedTransportSocket} {
+		if err := config.EncodeVarint(uint64(i+1)<<3 | proto.WireBytes); err != nil {
+			return err
+		}
+		if err := config.EncodeRawBytes(field); err != nil {
+			return err
+		}
+	}
+
+	out.TransportSocket = &envoycore.TransportSocket{
+		Name: upstreamProxyProtocolTransportSocket,
+		ConfigType: &envoycore.TransportSocket_TypedConfig{
+			TypedConfig: &any.Any{
+				TypeUrl: upstreamProxyProtocolTypeUrl,
+				Value:   config.Bytes(),
+			},
+		},
+	}
+	return nil
+}
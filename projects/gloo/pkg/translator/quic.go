@@ -0,0 +1,185 @@
+package translator
+
+import (
+	"fmt"
+	"time"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/gogoutils"
+	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+)
+
+const (
+	quicListenerName = "quiche_quic_listener"
+
+	quicTransportSocket = "envoy.transport_sockets.quic"
+
+	// go-control-plane does not have the config of the quic transport socket yet. it only wraps the
+	// downstream tls context, so it is encoded by hand.
+	quicDownstreamTransportTypeUrl = "type.googleapis.com/envoy.extensions.transport_sockets.quic.v3.QuicDownstreamTransport"
+
+	altSvcHeader = "alt-svc"
+
+	// the http/3 draft supported by envoy
+	http3AlpnId = "h3-29"
+
+	defaultAltSvcMaxAge = 24 * time.Hour
+)
+
+var (
+	Http3NotSupportedErr = errors.New("http3 is only supported on http listeners")
+	Http3WithoutSslErr   = errors.New("http3 requires the listener to serve tls")
+)
+
+// QuicListenerName is the name of the udp listener that serves http/3 for the listener
+func QuicListenerName(listener *v1.Listener) string {
+	return listener.Name + "-quic"
+}
+
+// computeQuicListener returns the udp listener that serves http/3 for the tls filter chains of the envoy listener,
+// or nil if the listener does not enable http/3. Its filter chains are copies of the tls filter chains, with the
+// quic transport socket and the http/3 codec.
+func (t *translatorInstance) computeQuicListener(listener *v1.Listener, envoyListener *envoyapi.Listener, listenerReport *validationapi.ListenerReport) *envoyapi.Listener {
+	http3 := listener.GetOptions().GetHttp3()
+	if http3 == nil {
+		return nil
+	}
+	if listener.GetHttpListener() == nil {
+		validation.AppendListenerError(listenerReport,
+			validationapi.ListenerReport_Error_ProcessingError,
+			Http3NotSupportedErr.Error())
+		return nil
+	}
+
+	var filterChains []*envoylistener.FilterChain
+	for _, filterChain := range envoyListener.GetFilterChains() {
+		quicFilterChain, err := toQuicFilterChain(filterChain)
+		if err != nil {
+			validation.AppendListenerError(listenerReport,
+				validationapi.ListenerReport_Error_ProcessingError,
+				err.Error())
+			return nil
+		}
+		filterChains = append(filterChains, quicFilterChain)
+	}
+	if len(filterChains) == 0 {
+		return nil
+	}
+
+	quicOptions := &envoylistener.QuicProtocolOptions{
+		MaxConcurrentStreams: gogoutils.UInt32GogoToProto(http3.GetMaxConcurrentStreams()),
+	}
+	if idleTimeout := http3.GetIdleTimeout(); idleTimeout != nil {
+		quicOptions.IdleTimeout = ptypes.DurationProto(*idleTimeout)
+	}
+	if cryptoHandshakeTimeout := http3.GetCryptoHandshakeTimeout(); cryptoHandshakeTimeout != nil {
+		quicOptions.CryptoHandshakeTimeout = ptypes.DurationProto(*cryptoHandshakeTimeout)
+	}
+	quicConfig, err := ptypes.MarshalAny(quicOptions)
+	if err != nil {
+		validation.AppendListenerError(listenerReport,
+			validationapi.ListenerReport_Error_ProcessingError,
+			err.Error())
+		return nil
+	}
+
+	address := proto.Clone(envoyListener.GetAddress()).(*envoycore.Address)
+	address.GetSocketAddress().Protocol = envoycore.SocketAddress_UDP
+
+	return &envoyapi.Listener{
+		Name:    QuicListenerName(listener),
+		Address: address,
+		UdpListenerConfig: &envoylistener.UdpListenerConfig{
+			UdpListenerName: quicListenerName,
+			ConfigType: &envoylistener.UdpListenerConfig_TypedConfig{
+				TypedConfig: quicConfig,
+			},
+		},
+		// the quic connections of each client are handled by the same worker
+		ReusePort:                     true,
+		PerConnectionBufferLimitBytes: envoyListener.GetPerConnectionBufferLimitBytes(),
+		FilterChains:                  filterChains,
+	}
+}
+
+func toQuicFilterChain(filterChain *envoylistener.FilterChain) (*envoylistener.FilterChain, error) {
+	tlsConfig := filterChain.GetTransportSocket().GetTypedConfig()
+	if filterChain.GetTransportSocket().GetName() != wellknown.TransportSocketTls || tlsConfig == nil {
+		return nil, Http3WithoutSslErr
+	}
+
+	// QuicDownstreamTransport has the downstream tls context as its first field
+	quicConfig := proto.NewBuffer(nil)
+	if err := quicConfig.EncodeVarint(1<<3 | proto.WireBytes); err != nil {
+		return nil, err
+	}
+	if err := quicConfig.EncodeRawBytes(tlsConfig.GetValue()); err != nil {
+		return nil, err
+	}
+
+	out := proto.Clone(filterChain).(*envoylistener.FilterChain)
+	out.TransportSocket = &envoycore.TransportSocket{
+		Name: quicTransportSocket,
+		ConfigType: &envoycore.TransportSocket_TypedConfig{
+			TypedConfig: &any.Any{
+				TypeUrl: quicDownstreamTransportTypeUrl,
+				Value:   quicConfig.Bytes(),
+			},
+		},
+	}
+	out.UseProxyProto = nil
+	if out.GetFilterChainMatch() != nil {
+		out.FilterChainMatch.TransportProtocol = ""
+	}
+
+	for _, filter := range out.GetFilters() {
+		if filter.GetName() != wellknown.HTTPConnectionManager {
+			continue
+		}
+		var hcm envoyhttp.HttpConnectionManager
+		if err := ParseTypedConfig(filter, &hcm); err != nil {
+			return nil, err
+		}
+		hcm.CodecType = envoyhttp.HttpConnectionManager_HTTP3
+		hcmConfig, err := ptypes.MarshalAny(&hcm)
+		if err != nil {
+			return nil, err
+		}
+		filter.ConfigType = &envoylistener.Filter_TypedConfig{
+			TypedConfig: hcmConfig,
+		}
+	}
+	return out, nil
+}
+
+// addAltSvcHeader advertises http/3 in the responses of the route configuration of the listener
+func addAltSvcHeader(listener *v1.Listener, routeConfig *envoyapi.RouteConfiguration) {
+	http3 := listener.GetOptions().GetHttp3()
+	if http3 == nil || http3.GetDisableAltSvc() || listener.GetHttpListener() == nil {
+		return
+	}
+	port := listener.GetBindPort()
+	if http3.GetAltSvcPort() != nil {
+		port = http3.GetAltSvcPort().GetValue()
+	}
+	maxAge := defaultAltSvcMaxAge
+	if http3.GetAltSvcMaxAge() != nil {
+		maxAge = *http3.GetAltSvcMaxAge()
+	}
+	routeConfig.ResponseHeadersToAdd = append(routeConfig.ResponseHeadersToAdd, &envoycore.HeaderValueOption{
+		Header: &envoycore.HeaderValue{
+			Key:   altSvcHeader,
+			Value: fmt.Sprintf(`%s=":%d"; ma=%d`, http3AlpnId, port, int64(maxAge.Seconds())),
+		},
+	})
+}
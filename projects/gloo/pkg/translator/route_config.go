@@ -34,6 +34,12 @@ var (
 	NoDestinationSpecifiedError = errors.New("must specify at least one weighted destination for multi destination routes")
 
 	SubsetsMisconfiguredErr = errors.New("route has a subset config, but the upstream does not.")
+
+	ConflictingSchemeRedirectErr = errors.New("redirect action can't set both https_redirect and scheme_redirect")
+
+	InvalidPortRedirectErr = func(port uint32) error {
+		return errors.Errorf("invalid port redirect %v, must be at most 65535", port)
+	}
 )
 
 func (t *translatorInstance) computeRouteConfig(params plugins.Params, proxy *v1.Proxy, listener *v1.Listener, routeCfgName string, listenerReport *validationapi.ListenerReport) *envoyapi.RouteConfiguration {
@@ -295,26 +301,50 @@ func (t *translatorInstance) setAction(params plugins.RouteParams, routeReport *
 		}
 
 	case *v1.Route_RedirectAction:
+		redirect, err := convertRedirectAction(action.RedirectAction)
+		if err != nil {
+			validation.AppendRouteError(routeReport,
+				validationapi.RouteReport_Error_ProcessingError,
+				err.Error(),
+			)
+			return
+		}
 		out.Action = &envoyroute.Route_Redirect{
-			Redirect: &envoyroute.RedirectAction{
-				HostRedirect:           action.RedirectAction.HostRedirect,
-				ResponseCode:           envoyroute.RedirectAction_RedirectResponseCode(action.RedirectAction.ResponseCode),
-				SchemeRewriteSpecifier: &envoyroute.RedirectAction_HttpsRedirect{HttpsRedirect: action.RedirectAction.HttpsRedirect},
-				StripQuery:             action.RedirectAction.StripQuery,
-			},
+			Redirect: redirect,
 		}
+	}
+}
 
-		switch pathRewrite := action.RedirectAction.PathRewriteSpecifier.(type) {
-		case *v1.RedirectAction_PathRedirect:
-			out.Action.(*envoyroute.Route_Redirect).Redirect.PathRewriteSpecifier = &envoyroute.RedirectAction_PathRedirect{
-				PathRedirect: pathRewrite.PathRedirect,
-			}
-		case *v1.RedirectAction_PrefixRewrite:
-			out.Action.(*envoyroute.Route_Redirect).Redirect.PathRewriteSpecifier = &envoyroute.RedirectAction_PrefixRewrite{
-				PrefixRewrite: pathRewrite.PrefixRewrite,
-			}
+func convertRedirectAction(in *v1.RedirectAction) (*envoyroute.RedirectAction, error) {
+	if in.GetHttpsRedirect() && in.GetSchemeRedirect() != "" {
+		return nil, ConflictingSchemeRedirectErr
+	}
+	if in.GetPortRedirect() > 65535 {
+		return nil, InvalidPortRedirectErr(in.GetPortRedirect())
+	}
+
+	out := &envoyroute.RedirectAction{
+		HostRedirect:           in.GetHostRedirect(),
+		PortRedirect:           in.GetPortRedirect(),
+		ResponseCode:           envoyroute.RedirectAction_RedirectResponseCode(in.GetResponseCode()),
+		SchemeRewriteSpecifier: &envoyroute.RedirectAction_HttpsRedirect{HttpsRedirect: in.GetHttpsRedirect()},
+		StripQuery:             in.GetStripQuery(),
+	}
+	if in.GetSchemeRedirect() != "" {
+		out.SchemeRewriteSpecifier = &envoyroute.RedirectAction_SchemeRedirect{SchemeRedirect: in.GetSchemeRedirect()}
+	}
+
+	switch pathRewrite := in.GetPathRewriteSpecifier().(type) {
+	case *v1.RedirectAction_PathRedirect:
+		out.PathRewriteSpecifier = &envoyroute.RedirectAction_PathRedirect{
+			PathRedirect: pathRewrite.PathRedirect,
+		}
+	case *v1.RedirectAction_PrefixRewrite:
+		out.PathRewriteSpecifier = &envoyroute.RedirectAction_PrefixRewrite{
+			PrefixRewrite: pathRewrite.PrefixRewrite,
 		}
 	}
+	return out, nil
 }
 
 func (t *translatorInstance) setRouteAction(params plugins.RouteParams, in *v1.RouteAction, out *envoyroute.RouteAction, routeReport *validationapi.RouteReport) error {
@@ -490,6 +520,10 @@ func setEnvoyPathMatcher(params plugins.Params, in *matchers.Matcher, out *envoy
 			Prefix: path.Prefix,
 		}
 	}
+	// envoy ignores case sensitivity for regex matchers, so it is only set for prefix and exact matchers
+	if _, isRegex := in.GetPathSpecifier().(*matchers.Matcher_Regex); !isRegex && in.GetCaseSensitive() != nil {
+		out.CaseSensitive = &wrappers.BoolValue{Value: in.GetCaseSensitive().GetValue()}
+	}
 }
 
 func envoyHeaderMatcher(params plugins.Params, in []*matchers.HeaderMatcher) []*envoyroute.HeaderMatcher {
@@ -635,6 +669,10 @@ func ValidateTcpRouteDestinations(snap *v1.ApiSnapshot, action *v1.TcpHost_TcpAc
 	return errors.Errorf("must specify either 'singleDestination', 'multipleDestinations', 'upstreamGroup' or 'forwardSniClusterName' for action")
 }
 
+func ValidateUdpDestination(snap *v1.ApiSnapshot, destination *v1.Destination) error {
+	return validateSingleDestination(snap.Upstreams, destination)
+}
+
 func validateUpstreamGroup(snap *v1.ApiSnapshot, ref *core.ResourceRef) error {
 
 	upstreamGroup, err := snap.UpstreamGroups.Find(ref.Namespace, ref.Name)
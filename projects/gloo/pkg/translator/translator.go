@@ -15,6 +15,7 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	"github.com/mitchellh/hashstructure"
 	errors "github.com/rotisserie/eris"
 	"go.opencensus.io/trace"
@@ -132,10 +133,8 @@ ClusterLoop:
 
 		envoyResources := t.computeListenerResources(params, proxy, listener, listenerReport)
 		if envoyResources != nil {
-			listeners = append(listeners, envoyResources.listener)
-			if envoyResources.routeConfig != nil {
-				routeConfigs = append(routeConfigs, envoyResources.routeConfig)
-			}
+			listeners = append(listeners, envoyResources.listeners...)
+			routeConfigs = append(routeConfigs, envoyResources.routeConfigs...)
 		}
 	}
 
@@ -171,8 +170,8 @@ ClusterLoop:
 // the set of resources returned by one iteration for a single v1.Listener
 // the top level Translate function should aggregate these into a finished snapshot
 type listenerResources struct {
-	routeConfig *envoyapi.RouteConfiguration
-	listener    *envoyapi.Listener
+	routeConfigs []*envoyapi.RouteConfiguration
+	listeners    []*envoyapi.Listener
 }
 
 func (t *translatorInstance) computeListenerResources(params plugins.Params, proxy *v1.Proxy, listener *v1.Listener, listenerReport *validationapi.ListenerReport) *listenerResources {
@@ -180,19 +179,32 @@ func (t *translatorInstance) computeListenerResources(params plugins.Params, pro
 	params.Ctx = ctx
 	defer span.End()
 
-	rdsName := routeConfigName(listener)
+	if listener.GetHybridListener() != nil {
+		return t.computeHybridListenerResources(params, proxy, listener, listenerReport)
+	}
+
+	rdsName := RouteConfigName(listener)
 
 	// Calculate routes before listeners, so that HttpFilters is called after ProcessVirtualHost\ProcessRoute
-	routeConfig := t.computeRouteConfig(params, proxy, listener, rdsName, listenerReport)
+	var routeConfigs []*envoyapi.RouteConfiguration
+	if routeConfig := t.computeRouteConfig(params, proxy, listener, rdsName, listenerReport); routeConfig != nil {
+		addAltSvcHeader(listener, routeConfig)
+		routeConfigs = append(routeConfigs, routeConfig)
+	}
 
 	envoyListener := t.computeListener(params, proxy, listener, listenerReport)
 	if envoyListener == nil {
 		return nil
 	}
 
+	listeners := []*envoyapi.Listener{envoyListener}
+	if quicListener := t.computeQuicListener(listener, envoyListener, listenerReport); quicListener != nil {
+		listeners = append(listeners, quicListener)
+	}
+
 	return &listenerResources{
-		listener:    envoyListener,
-		routeConfig: routeConfig,
+		listeners:    listeners,
+		routeConfigs: routeConfigs,
 	}
 }
 
@@ -210,8 +222,8 @@ func generateXDSSnapshot(clusters []*envoyapi.Cluster,
 		clustersProto = append(clustersProto, xds.NewEnvoyResource(cluster))
 	}
 	for _, listener := range listeners {
-		// don't add empty listeners, envoy will complain
-		if len(listener.FilterChains) < 1 {
+		// don't add empty listeners, envoy will complain. udp listeners only have listener filters
+		if len(listener.FilterChains) < 1 && listener.GetAddress().GetSocketAddress().GetProtocol() != envoycore.SocketAddress_UDP {
 			continue
 		}
 		listenersProto = append(listenersProto, xds.NewEnvoyResource(listener))
@@ -10,11 +10,15 @@ import (
 	envoy_api_v2_endpoint "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
 	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
 	envoyrouteapi "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/mock/gomock"
+	golangproto "github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/duration"
 	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/golang/protobuf/ptypes/wrappers"
@@ -56,11 +60,14 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	v1plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	v1grpc "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/http3"
 	v1kubernetes "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/proxy_protocol"
 	v1static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
 	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/udp"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
@@ -485,6 +492,52 @@ var _ = Describe("Translator", func() {
 			Expect(headerMatch.InvertMatch).To(Equal(true))
 		})
 
+		It("should translate query parameter matcher with regex to a regex string match", func() {
+
+			matcher.QueryParameters = []*matchers.QueryParameterMatcher{
+				{
+					Name:  "test",
+					Value: "\\d+",
+					Regex: true,
+				},
+			}
+			translate()
+
+			queryMatch := routeConfiguration.VirtualHosts[0].Routes[0].Match.QueryParameters[0]
+			Expect(queryMatch.Name).To(Equal("test"))
+			Expect(queryMatch.GetStringMatch().GetSafeRegex().GetRegex()).To(Equal("\\d+"))
+		})
+
+		It("should translate case insensitive exact path matchers", func() {
+
+			matcher.PathSpecifier = &matchers.Matcher_Exact{
+				Exact: "/Foo",
+			}
+			matcher.CaseSensitive = &types.BoolValue{Value: false}
+			translate()
+
+			match := routeConfiguration.VirtualHosts[0].Routes[0].Match
+			Expect(match.GetPath()).To(Equal("/Foo"))
+			Expect(match.CaseSensitive).To(Equal(&wrappers.BoolValue{Value: false}))
+		})
+
+		It("should not set case sensitivity if unset", func() {
+			translate()
+
+			Expect(routeConfiguration.VirtualHosts[0].Routes[0].Match.CaseSensitive).To(BeNil())
+		})
+
+		It("should not set case sensitivity on regex path matchers", func() {
+
+			matcher.PathSpecifier = &matchers.Matcher_Regex{
+				Regex: "/foo.*",
+			}
+			matcher.CaseSensitive = &types.BoolValue{Value: false}
+			translate()
+
+			Expect(routeConfiguration.VirtualHosts[0].Routes[0].Match.CaseSensitive).To(BeNil())
+		})
+
 		It("should default to '/' prefix matcher if none is provided", func() {
 			matcher = nil
 			translate()
@@ -1039,6 +1092,31 @@ var _ = Describe("Translator", func() {
 			Expect(clusters.Clusters[0].Name).To(Equal(UpstreamToClusterName(upstream.Metadata.Ref())))
 			Expect(clusters.Clusters[1].Name).To(Equal(UpstreamToClusterName(upstream2.Metadata.Ref())))
 		})
+
+		It("should error if the canary of the rollout is not one of the destinations", func() {
+			upstreamGroup.Rollout = &v1.UpstreamGroupRollout{
+				Canary: &v1.Destination{
+					DestinationType: &v1.Destination_Upstream{
+						Upstream: &core.ResourceRef{Name: "notexist", Namespace: "gloo-system"},
+					},
+				},
+			}
+
+			_, errs, _, err := translator.Translate(params, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			err = errs.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("rollout: the canary is not one of the destinations"))
+		})
+
+		It("should accept a rollout to one of the destinations", func() {
+			upstreamGroup.Rollout = &v1.UpstreamGroupRollout{
+				Canary:     upstreamGroup.Destinations[1].Destination,
+				StepWeight: 20,
+			}
+
+			translate()
+		})
 	})
 
 	Context("when handling endpoints", func() {
@@ -1089,6 +1167,42 @@ var _ = Describe("Translator", func() {
 			Expect(filterMetadata[SoloAnnotations].Fields).To(HaveKey("testkey"))
 			Expect(filterMetadata[SoloAnnotations].Fields["testkey"].GetStringValue()).To(Equal("testvalue"))
 		})
+
+		It("should group the endpoints by their locality", func() {
+			ref := upstream.Metadata.Ref()
+			newEndpoint := func(name, address string, locality *v1.Locality) *v1.Endpoint {
+				return &v1.Endpoint{
+					Metadata:  core.Metadata{Name: name, Namespace: "gloo-system"},
+					Upstreams: []*core.ResourceRef{&ref},
+					Address:   address,
+					Port:      1234,
+					Locality:  locality,
+				}
+			}
+			params.Snapshot.Endpoints = v1.EndpointList{
+				newEndpoint("a", "1.2.3.4", &v1.Locality{Region: "us-east-1", Zone: "us-east-1a"}),
+				newEndpoint("b", "1.2.3.5", nil),
+				newEndpoint("c", "1.2.3.6", &v1.Locality{Region: "us-east-1", Zone: "us-east-1b"}),
+				newEndpoint("d", "1.2.3.7", &v1.Locality{Region: "us-east-1", Zone: "us-east-1a"}),
+			}
+			translate()
+
+			claConfiguration = endpoints.Items[UpstreamToClusterName(ref)].ResourceProto().(*envoyapi.ClusterLoadAssignment)
+			Expect(claConfiguration.Endpoints).To(HaveLen(3))
+			addresses := func(localityEndpoints *envoy_api_v2_endpoint.LocalityLbEndpoints) []string {
+				var addresses []string
+				for _, lbEndpoint := range localityEndpoints.LbEndpoints {
+					addresses = append(addresses, lbEndpoint.GetEndpoint().GetAddress().GetSocketAddress().GetAddress())
+				}
+				return addresses
+			}
+			Expect(claConfiguration.Endpoints[0].Locality).To(Equal(&envoycore.Locality{Region: "us-east-1", Zone: "us-east-1a"}))
+			Expect(addresses(claConfiguration.Endpoints[0])).To(Equal([]string{"1.2.3.4", "1.2.3.7"}))
+			Expect(claConfiguration.Endpoints[1].Locality).To(BeNil())
+			Expect(addresses(claConfiguration.Endpoints[1])).To(Equal([]string{"1.2.3.5"}))
+			Expect(claConfiguration.Endpoints[2].Locality).To(Equal(&envoycore.Locality{Region: "us-east-1", Zone: "us-east-1b"}))
+			Expect(addresses(claConfiguration.Endpoints[2])).To(Equal([]string{"1.2.3.6"}))
+		})
 	})
 
 	Context("when handling subsets", func() {
@@ -1276,6 +1390,59 @@ var _ = Describe("Translator", func() {
 				Expect(report).To(Equal(expectedReport))
 			})
 		})
+
+		Context("static upstream", func() {
+
+			BeforeEach(func() {
+				upstream.UpstreamType = &v1.Upstream_Static{
+					Static: &v1static.UpstreamSpec{
+						Hosts: []*v1static.Host{
+							{
+								Addr: "1.2.3.4",
+								Port: 1234,
+								Metadata: map[string]*types.Struct{
+									EnvoyLb: {Fields: map[string]*types.Value{
+										"testkey": {Kind: &types.Value_StringValue{StringValue: "testvalue"}},
+									}},
+								},
+							},
+							{
+								Addr: "1.2.3.5",
+								Port: 1234,
+							},
+						},
+						SubsetSpec: &v1plugins.SubsetSpec{
+							Selectors: []*v1plugins.Selector{{
+								Keys: []string{
+									"testkey",
+								},
+							}},
+						},
+					},
+				}
+				params.Snapshot.Endpoints = nil
+			})
+
+			It("should transfer the host metadata to envoy", func() {
+				translate()
+
+				lbEndpoints := cluster.LoadAssignment.Endpoints[0].LbEndpoints
+				Expect(lbEndpoints).To(HaveLen(2))
+				Expect(lbEndpoints[0].Metadata.FilterMetadata[EnvoyLb].Fields).To(HaveKeyWithValue("testkey", sv("testvalue")))
+				Expect(lbEndpoints[1].Metadata).To(BeNil())
+			})
+
+			It("should add subset to cluster and route", func() {
+				translate()
+
+				Expect(cluster.LbSubsetConfig).ToNot(BeNil())
+				Expect(cluster.LbSubsetConfig.SubsetSelectors).To(HaveLen(1))
+				Expect(cluster.LbSubsetConfig.SubsetSelectors[0].Keys).To(Equal([]string{"testkey"}))
+
+				metadataMatch := routeConfiguration.VirtualHosts[0].Routes[0].GetRoute().GetMetadataMatch()
+				Expect(metadataMatch.FilterMetadata[EnvoyLb].Fields).To(HaveKeyWithValue("testkey", sv("testvalue")))
+			})
+		})
 	})
 
 	Context("when translating a route that points directly to a service", func() {
@@ -1554,6 +1721,91 @@ var _ = Describe("Translator", func() {
 			Expect(metadata.Fields[tag(dev)]).To(Equal(falseValue))
 			Expect(metadata.Fields[tag(prod)]).To(Equal(trueValue))
 		})
+
+		It("generates subsets for the service metadata keys of the upstream", func() {
+			meta := func(key string) string {
+				return constants.ConsulMetadataKeyPrefix + key
+			}
+			fakeUsList[0].GetConsul().SubsetMetadataKeys = []string{"version"}
+			for i, version := range []string{"v1", "v1", "v2"} {
+				params.Snapshot.Endpoints[i].Metadata.Labels[meta("version")] = version
+			}
+			routes[0].GetRouteAction().Destination = &v1.RouteAction_Single{
+				Single: &v1.Destination{
+					DestinationType: &v1.Destination_Upstream{
+						Upstream: utils.ResourceRefPtr(fakeUsList[0].Metadata.Ref()),
+					},
+					Subset: &v1.Subset{
+						Values: map[string]string{meta("version"): "v2"},
+					},
+				},
+			}
+
+			translate()
+
+			clusters := snapshot.GetResources(xds.ClusterType)
+			clusterResource := clusters.Items[UpstreamToClusterName(fakeUsList[0].Metadata.Ref())]
+			cluster = clusterResource.ResourceProto().(*envoyapi.Cluster)
+			Expect(cluster).NotTo(BeNil())
+			Expect(cluster.LbSubsetConfig).NotTo(BeNil())
+			Expect(cluster.LbSubsetConfig.SubsetSelectors).To(ConsistOf(
+				&envoyapi.Cluster_LbSubsetConfig_LbSubsetSelector{
+					Keys: []string{dc(east), dc(west)},
+				},
+				&envoyapi.Cluster_LbSubsetConfig_LbSubsetSelector{
+					Keys: []string{tag(dev), tag(prod)},
+				},
+				&envoyapi.Cluster_LbSubsetConfig_LbSubsetSelector{
+					Keys: []string{dc(east), dc(west), tag(dev), tag(prod)},
+				},
+				&envoyapi.Cluster_LbSubsetConfig_LbSubsetSelector{
+					Keys: []string{meta("version")},
+				},
+				&envoyapi.Cluster_LbSubsetConfig_LbSubsetSelector{
+					Keys: []string{dc(east), dc(west), meta("version")},
+				},
+				&envoyapi.Cluster_LbSubsetConfig_LbSubsetSelector{
+					Keys: []string{tag(dev), tag(prod), meta("version")},
+				},
+				&envoyapi.Cluster_LbSubsetConfig_LbSubsetSelector{
+					Keys: []string{dc(east), dc(west), tag(dev), tag(prod), meta("version")},
+				},
+			))
+
+			routes := snapshot.GetResources(xds.RouteType)
+			routeResource := routes.Items["http-listener-routes"]
+			routeConfiguration = routeResource.ResourceProto().(*envoyapi.RouteConfiguration)
+			Expect(routeConfiguration).NotTo(BeNil())
+			routeAction, ok := routeConfiguration.VirtualHosts[0].Routes[0].Action.(*envoyrouteapi.Route_Route)
+			Expect(ok).To(BeTrue())
+			metadata, ok := routeAction.Route.MetadataMatch.FilterMetadata[EnvoyLb]
+			Expect(ok).To(BeTrue())
+			Expect(metadata.Fields).To(Equal(map[string]*structpb.Value{
+				meta("version"): {Kind: &structpb.Value_StringValue{StringValue: "v2"}},
+			}))
+		})
+
+		It("rejects upstreams with too many service metadata keys", func() {
+			fakeUsList[0].GetConsul().SubsetMetadataKeys = []string{"version", "stage", "zone", "team"}
+
+			snap, errs, _, err := translator.Translate(params, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errs[fakeUsList[0]].Errors).To(MatchError(ContainSubstring("consul upstream has 4 subset metadata keys, at most 3 are allowed")))
+
+			clusterResource := snap.GetResources(xds.ClusterType).Items[UpstreamToClusterName(fakeUsList[0].Metadata.Ref())]
+			cluster = clusterResource.ResourceProto().(*envoyapi.Cluster)
+			Expect(cluster.LbSubsetConfig.SubsetSelectors).To(ConsistOf(
+				&envoyapi.Cluster_LbSubsetConfig_LbSubsetSelector{
+					Keys: []string{dc(east), dc(west)},
+				},
+				&envoyapi.Cluster_LbSubsetConfig_LbSubsetSelector{
+					Keys: []string{tag(dev), tag(prod)},
+				},
+				&envoyapi.Cluster_LbSubsetConfig_LbSubsetSelector{
+					Keys: []string{dc(east), dc(west), tag(dev), tag(prod)},
+				},
+			))
+		})
 	})
 
 	Context("Route plugin", func() {
@@ -1709,6 +1961,72 @@ var _ = Describe("Translator", func() {
 
 	})
 
+	Context("redirect actions", func() {
+
+		var redirect *v1.RedirectAction
+
+		BeforeEach(func() {
+			redirect = &v1.RedirectAction{}
+			routes = []*v1.Route{{
+				Matchers: []*matchers.Matcher{matcher},
+				Action: &v1.Route_RedirectAction{
+					RedirectAction: redirect,
+				},
+			}}
+		})
+
+		It("should translate scheme, port and query rewrites", func() {
+			redirect.HostRedirect = "example.com"
+			redirect.SchemeRedirect = "http"
+			redirect.PortRedirect = 8080
+			redirect.StripQuery = true
+			redirect.PathRewriteSpecifier = &v1.RedirectAction_PrefixRewrite{
+				PrefixRewrite: "/new",
+			}
+
+			translate()
+
+			out := routeConfiguration.VirtualHosts[0].Routes[0].GetRedirect()
+			Expect(out).To(Equal(&envoyrouteapi.RedirectAction{
+				HostRedirect:           "example.com",
+				PortRedirect:           8080,
+				SchemeRewriteSpecifier: &envoyrouteapi.RedirectAction_SchemeRedirect{SchemeRedirect: "http"},
+				StripQuery:             true,
+				PathRewriteSpecifier:   &envoyrouteapi.RedirectAction_PrefixRewrite{PrefixRewrite: "/new"},
+			}))
+		})
+
+		It("should translate https redirects", func() {
+			redirect.HttpsRedirect = true
+
+			translate()
+
+			out := routeConfiguration.VirtualHosts[0].Routes[0].GetRedirect()
+			Expect(out.SchemeRewriteSpecifier).To(Equal(&envoyrouteapi.RedirectAction_HttpsRedirect{HttpsRedirect: true}))
+		})
+
+		It("should error on conflicting scheme redirects", func() {
+			redirect.HttpsRedirect = true
+			redirect.SchemeRedirect = "http"
+
+			_, errs, _, err := translator.Translate(params, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			err = errs.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(ConflictingSchemeRedirectErr.Error()))
+		})
+
+		It("should error on invalid port redirects", func() {
+			redirect.PortRedirect = 70000
+
+			_, errs, _, err := translator.Translate(params, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			err = errs.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(InvalidPortRedirectErr(70000).Error()))
+		})
+	})
+
 	Context("TCP", func() {
 		It("can properly create a tcp listener", func() {
 			translate()
@@ -1732,6 +2050,263 @@ var _ = Describe("Translator", func() {
 		})
 	})
 
+	Context("Hybrid", func() {
+
+		var hybridListener *v1.Listener
+
+		BeforeEach(func() {
+			hybridListener = &v1.Listener{
+				Name:        "hybrid-listener",
+				BindAddress: "127.0.0.1",
+				BindPort:    443,
+				ListenerType: &v1.Listener_HybridListener{
+					HybridListener: &v1.HybridListener{
+						MatchedListeners: []*v1.MatchedListener{
+							{
+								Matcher: &v1.Matcher{
+									SslConfig: &v1.SslConfig{
+										SslSecrets: &v1.SslConfig_SslFiles{
+											SslFiles: &v1.SSLFiles{
+												TlsCert: "cert",
+												TlsKey:  "key",
+											},
+										},
+										SniDomains: []string{"terminated.com"},
+									},
+									SourcePrefixRanges: []*v1.CidrRange{{
+										AddressPrefix: "10.0.0.0",
+										PrefixLen:     &types.UInt32Value{Value: 8},
+									}},
+								},
+								ListenerType: &v1.MatchedListener_HttpListener{
+									HttpListener: &v1.HttpListener{
+										VirtualHosts: []*v1.VirtualHost{{
+											Name:    "virt1",
+											Domains: []string{"*"},
+											Routes:  routes,
+										}},
+									},
+								},
+							},
+							{
+								Matcher: &v1.Matcher{
+									ServerNames: []string{"passthrough.com"},
+								},
+								ListenerType: &v1.MatchedListener_TcpListener{
+									TcpListener: proxy.Listeners[1].GetTcpListener(),
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			proxy.Listeners = append(proxy.Listeners, hybridListener)
+		})
+
+		It("translates the matched listeners to filter chains selected by their matchers", func() {
+			translate()
+
+			routes := snapshot.GetResources(xds.RouteType).Items
+			Expect(routes).To(HaveKey("hybrid-listener-0-routes"))
+
+			listeners := snapshot.GetResources(xds.ListenerType).Items
+			Expect(listeners).To(HaveKey("hybrid-listener"))
+			listener := listeners["hybrid-listener"].ResourceProto().(*envoyapi.Listener)
+			Expect(listener.GetListenerFilters()).NotTo(BeEmpty())
+			Expect(listener.GetListenerFilters()[0].GetName()).To(Equal(wellknown.TlsInspector))
+			Expect(listener.GetFilterChains()).To(HaveLen(2))
+
+			httpChain := listener.GetFilterChains()[0]
+			Expect(httpChain.GetTransportSocket()).NotTo(BeNil())
+			Expect(httpChain.GetFilters()[0].GetName()).To(Equal(wellknown.HTTPConnectionManager))
+			Expect(httpChain.GetFilterChainMatch().GetServerNames()).To(Equal([]string{"terminated.com"}))
+			Expect(httpChain.GetFilterChainMatch().GetTransportProtocol()).To(Equal("tls"))
+			Expect(httpChain.GetFilterChainMatch().GetSourcePrefixRanges()).To(HaveLen(1))
+			Expect(httpChain.GetFilterChainMatch().GetSourcePrefixRanges()[0].GetAddressPrefix()).To(Equal("10.0.0.0"))
+			Expect(httpChain.GetFilterChainMatch().GetSourcePrefixRanges()[0].GetPrefixLen().GetValue()).To(BeEquivalentTo(8))
+
+			tcpChain := listener.GetFilterChains()[1]
+			Expect(tcpChain.GetTransportSocket()).To(BeNil())
+			Expect(tcpChain.GetFilters()[0].GetName()).To(Equal(wellknown.TCPProxy))
+			Expect(tcpChain.GetFilterChainMatch().GetServerNames()).To(Equal([]string{"passthrough.com"}))
+			Expect(tcpChain.GetFilterChainMatch().GetTransportProtocol()).To(Equal("tls"))
+		})
+
+		It("reports invalid source prefix ranges", func() {
+			hybridListener.GetHybridListener().MatchedListeners[0].Matcher.SourcePrefixRanges[0].AddressPrefix = "not-an-ip"
+
+			report := translateWithError()
+			Expect(report.GetListenerReports()[2].GetErrors()).To(HaveLen(1))
+			Expect(report.GetListenerReports()[2].GetErrors()[0].GetReason()).To(ContainSubstring("invalid source prefix range"))
+		})
+	})
+
+	Context("UDP", func() {
+
+		var udpListener *v1.Listener
+
+		BeforeEach(func() {
+			udpListener = &v1.Listener{
+				Name:        "udp-listener",
+				BindAddress: "127.0.0.1",
+				BindPort:    53,
+				ListenerType: &v1.Listener_UdpListener{
+					UdpListener: &v1.UdpListener{
+						Destination: &v1.Destination{
+							DestinationType: &v1.Destination_Upstream{
+								Upstream: utils.ResourceRefPtr(upName.Ref()),
+							},
+						},
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			proxy.Listeners = append(proxy.Listeners, udpListener)
+		})
+
+		It("translates to a udp listener with the udp proxy listener filter", func() {
+			translate()
+
+			listeners := snapshot.GetResources(xds.ListenerType).Items
+			Expect(listeners).To(HaveKey("udp-listener"))
+			listener := listeners["udp-listener"].ResourceProto().(*envoyapi.Listener)
+			Expect(listener.GetAddress().GetSocketAddress().GetProtocol()).To(Equal(envoycore.SocketAddress_UDP))
+			Expect(listener.GetFilterChains()).To(BeEmpty())
+			Expect(listener.GetListenerFilters()).To(HaveLen(1))
+			Expect(listener.GetListenerFilters()[0].GetName()).To(Equal(udp.UdpProxyFilter))
+		})
+
+		It("reports a missing upstream on the listener", func() {
+			udpListener.GetUdpListener().GetDestination().GetUpstream().Name = "missing"
+
+			report := translateWithError()
+			Expect(report.GetListenerReports()[2].GetErrors()).To(HaveLen(1))
+			Expect(report.GetListenerReports()[2].GetErrors()[0].GetReason()).To(ContainSubstring("missing"))
+		})
+	})
+
+	Context("HTTP3", func() {
+
+		JustBeforeEach(func() {
+			httpListener := proxy.Listeners[0]
+			httpListener.SslConfigurations = []*v1.SslConfig{{
+				SslSecrets: &v1.SslConfig_SslFiles{
+					SslFiles: &v1.SSLFiles{
+						TlsCert: "cert",
+						TlsKey:  "key",
+					},
+				},
+			}}
+			httpListener.Options = &v1.ListenerOptions{
+				Http3: &http3.Http3{
+					MaxConcurrentStreams: &types.UInt32Value{Value: 50},
+					AltSvcPort:           &types.UInt32Value{Value: 443},
+				},
+			}
+		})
+
+		It("serves http3 on a udp listener alongside the tcp listener", func() {
+			translate()
+
+			listeners := snapshot.GetResources(xds.ListenerType).Items
+			Expect(listeners).To(HaveKey("http-listener-quic"))
+			quicListener := listeners["http-listener-quic"].ResourceProto().(*envoyapi.Listener)
+			Expect(quicListener.GetAddress().GetSocketAddress().GetProtocol()).To(Equal(envoycore.SocketAddress_UDP))
+			Expect(quicListener.GetAddress().GetSocketAddress().GetPortValue()).To(Equal(listener.GetAddress().GetSocketAddress().GetPortValue()))
+			Expect(quicListener.GetReusePort()).To(BeTrue())
+			Expect(quicListener.GetUdpListenerConfig().GetUdpListenerName()).To(Equal("quiche_quic_listener"))
+			var quicOptions envoylistener.QuicProtocolOptions
+			Expect(ptypes.UnmarshalAny(quicListener.GetUdpListenerConfig().GetTypedConfig(), &quicOptions)).NotTo(HaveOccurred())
+			Expect(quicOptions.GetMaxConcurrentStreams().GetValue()).To(BeEquivalentTo(50))
+
+			Expect(quicListener.GetFilterChains()).To(HaveLen(1))
+			fc := quicListener.GetFilterChains()[0]
+			Expect(fc.GetTransportSocket().GetName()).To(Equal("envoy.transport_sockets.quic"))
+			// the quic transport socket wraps the tls context of the tcp listener
+			quicTransport := golangproto.NewBuffer(fc.GetTransportSocket().GetTypedConfig().GetValue())
+			_, err := quicTransport.DecodeVarint()
+			Expect(err).NotTo(HaveOccurred())
+			tlsContext, err := quicTransport.DecodeRawBytes(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tlsContext).To(Equal(listener.GetFilterChains()[0].GetTransportSocket().GetTypedConfig().GetValue()))
+
+			var quicHcm envoyhttp.HttpConnectionManager
+			Expect(ParseTypedConfig(fc.GetFilters()[0], &quicHcm)).NotTo(HaveOccurred())
+			Expect(quicHcm.GetCodecType()).To(Equal(envoyhttp.HttpConnectionManager_HTTP3))
+			Expect(hcmCfg.GetCodecType()).To(Equal(envoyhttp.HttpConnectionManager_AUTO))
+
+			Expect(routeConfiguration.GetResponseHeadersToAdd()).To(HaveLen(1))
+			Expect(routeConfiguration.GetResponseHeadersToAdd()[0].GetHeader().GetKey()).To(Equal("alt-svc"))
+			Expect(routeConfiguration.GetResponseHeadersToAdd()[0].GetHeader().GetValue()).To(Equal(`h3-29=":443"; ma=86400`))
+		})
+
+		It("does not advertise http3 when the alt-svc header is disabled", func() {
+			proxy.Listeners[0].GetOptions().GetHttp3().DisableAltSvc = true
+			translate()
+
+			Expect(routeConfiguration.GetResponseHeadersToAdd()).To(BeEmpty())
+		})
+
+		It("reports listeners without ssl", func() {
+			proxy.Listeners[0].SslConfigurations = nil
+
+			report := translateWithError()
+			Expect(report.GetListenerReports()[0].GetErrors()).To(HaveLen(1))
+			Expect(report.GetListenerReports()[0].GetErrors()[0].GetReason()).To(Equal(Http3WithoutSslErr.Error()))
+		})
+	})
+
+	Context("PROXY protocol", func() {
+
+		It("adds the proxy protocol listener filter before the other listener filters", func() {
+			translate()
+			Expect(listener.GetListenerFilters()).To(BeEmpty())
+
+			proxy.Listeners[0].Options = &v1.ListenerOptions{
+				ProxyProtocol: &proxy_protocol.ProxyProtocol{},
+			}
+			translate()
+			Expect(listener.GetListenerFilters()).To(HaveLen(1))
+			Expect(listener.GetListenerFilters()[0].GetName()).To(Equal(wellknown.ProxyProtocol))
+		})
+
+		It("wraps the transport socket of the upstream in the proxy protocol transport socket", func() {
+			upstream.ProxyProtocolVersion = &types.StringValue{Value: "V2"}
+			translate()
+
+			Expect(cluster.GetTransportSocket().GetName()).To(Equal("envoy.transport_sockets.upstream_proxy_protocol"))
+			config := golangproto.NewBuffer(cluster.GetTransportSocket().GetTypedConfig().GetValue())
+
+			_, err := config.DecodeVarint()
+			Expect(err).NotTo(HaveOccurred())
+			proxyProtocolConfig, err := config.DecodeRawBytes(false)
+			Expect(err).NotTo(HaveOccurred())
+			var version envoycorev3.ProxyProtocolConfig
+			Expect(golangproto.Unmarshal(proxyProtocolConfig, &version)).NotTo(HaveOccurred())
+			Expect(version.GetVersion()).To(Equal(envoycorev3.ProxyProtocolConfig_V2))
+
+			_, err = config.DecodeVarint()
+			Expect(err).NotTo(HaveOccurred())
+			wrapped, err := config.DecodeRawBytes(false)
+			Expect(err).NotTo(HaveOccurred())
+			var transportSocket envoycore.TransportSocket
+			Expect(golangproto.Unmarshal(wrapped, &transportSocket)).NotTo(HaveOccurred())
+			Expect(transportSocket.GetName()).To(Equal(wellknown.TransportSocketRawBuffer))
+		})
+
+		It("reports invalid proxy protocol versions", func() {
+			upstream.ProxyProtocolVersion = &types.StringValue{Value: "V3"}
+			_, errs, _, err := translator.Translate(params, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errs.Validate()).To(MatchError(ContainSubstring(InvalidProxyProtocolVersionErr("V3").Error())))
+		})
+	})
+
 	Context("Ssl", func() {
 
 		var (
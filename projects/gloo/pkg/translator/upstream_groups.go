@@ -2,7 +2,9 @@ package translator
 
 import (
 	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/rollout"
 	usconversions "github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 )
@@ -36,6 +38,31 @@ func (t *translatorInstance) verifyUpstreamGroups(params plugins.Params, reports
 			}
 		}
 
+		if ug.GetRollout() != nil {
+			if err := verifyRollout(ug); err != nil {
+				reports.AddError(ug, err)
+			}
+		}
 	}
 
 }
+
+func verifyRollout(ug *v1.UpstreamGroup) error {
+	ugRollout := ug.GetRollout()
+	if ugRollout.GetCanary() == nil {
+		return errors.New("rollout: canary is nil")
+	}
+	if rollout.CanaryIndex(ug) < 0 {
+		return errors.New("rollout: the canary is not one of the destinations")
+	}
+	if len(ug.GetDestinations()) < 2 {
+		return errors.New("rollout: there is no other destination to shift traffic from")
+	}
+	if ugRollout.GetStepWeight() > 100 {
+		return errors.Errorf("rollout: step weight %d is more than 100", ugRollout.GetStepWeight())
+	}
+	if ugRollout.GetMaxWeight() > 100 {
+		return errors.Errorf("rollout: max weight %d is more than 100", ugRollout.GetMaxWeight())
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package consul
 import (
 	consulapi "github.com/hashicorp/consul/api"
 	"github.com/rotisserie/eris"
+	"github.com/solo-io/go-utils/contextutils"
 )
 
 //go:generate mockgen -destination=./mocks/mock_consul_client.go -source consul_client.go
@@ -24,17 +25,35 @@ type ConsulClient interface {
 	Service(service, tag string, q *consulapi.QueryOptions) ([]*consulapi.CatalogService, *consulapi.QueryMeta, error)
 	// Connect is used to query catalog entries for a given Connect-enabled service
 	Connect(service, tag string, q *consulapi.QueryOptions) ([]*consulapi.CatalogService, *consulapi.QueryMeta, error)
+	// HealthService is used to query the instances of a given service along with their health checks
+	HealthService(service, tag string, passingOnly bool, q *consulapi.QueryOptions) ([]*consulapi.ServiceEntry, *consulapi.QueryMeta, error)
+	// HealthConnect is used to query the instances of a given Connect-enabled service along with their health checks
+	HealthConnect(service, tag string, passingOnly bool, q *consulapi.QueryOptions) ([]*consulapi.ServiceEntry, *consulapi.QueryMeta, error)
+	// ExecutePreparedQuery is used to execute a prepared query, given its name or ID
+	ExecutePreparedQuery(queryIDOrName string, q *consulapi.QueryOptions) (*consulapi.PreparedQueryExecuteResponse, *consulapi.QueryMeta, error)
 }
 
-func NewConsulClient(client *consulapi.Client, dataCenters []string) (ConsulClient, error) {
+// Wrap the Connect part of the Consul agent API in an interface to allow mocking.
+// It is implemented by *consulapi.Agent.
+type ConnectAgent interface {
+	// ConnectCARoots is used to query the root certificates of the Connect CA
+	ConnectCARoots(q *consulapi.QueryOptions) (*consulapi.CARootList, *consulapi.QueryMeta, error)
+	// ConnectCALeaf is used to query the Connect leaf certificate of the given service
+	ConnectCALeaf(serviceID string, q *consulapi.QueryOptions) (*consulapi.LeafCert, *consulapi.QueryMeta, error)
+}
+
+// If serviceTokens is not nil, the queries for the services listed there use the configured tokens instead of the
+// default token of the client.
+func NewConsulClient(client *consulapi.Client, dataCenters []string, serviceTokens *ServiceTokens) (ConsulClient, error) {
 	dcMap := make(map[string]bool)
 	for _, dc := range dataCenters {
 		dcMap[dc] = true
 	}
 
 	return &consul{
-		api:         client,
-		dataCenters: dcMap,
+		api:           client,
+		dataCenters:   dcMap,
+		serviceTokens: serviceTokens,
 	}, nil
 }
 
@@ -42,6 +61,8 @@ type consul struct {
 	api *consulapi.Client
 	// Whitelist of data centers to consider when querying the agent
 	dataCenters map[string]bool
+	// ACL tokens to use for individual services
+	serviceTokens *ServiceTokens
 }
 
 func (c *consul) DataCenters() ([]string, error) {
@@ -56,13 +77,51 @@ func (c *consul) Services(q *consulapi.QueryOptions) (map[string][]string, *cons
 	if err := c.validateDataCenter(q.Datacenter); err != nil {
 		return nil, nil, err
 	}
-	return c.api.Catalog().Services(q)
+	services, queryMeta, err := c.api.Catalog().Services(q)
+	if err != nil || q.Token != "" || c.serviceTokens == nil {
+		return services, queryMeta, err
+	}
+
+	// The default token might not be allowed to read the services which have their own token, so look them up with
+	// their token. The blocking query above returns whenever any service changes, so these don't need to block.
+	// A token which cannot be resolved or used only leaves out the services it is configured for, rather than
+	// failing the whole listing.
+	logger := contextutils.LoggerFrom(q.Context())
+	servicesByToken := make(map[string][]string)
+	for service := range c.serviceTokens.SecretRefs {
+		token, err := c.serviceTokens.tokenFor(q.Context(), service)
+		if err != nil {
+			logger.Warnw("failed to resolve the ACL token of consul service", "service", service, "error", err)
+			continue
+		}
+		servicesByToken[token] = append(servicesByToken[token], service)
+	}
+	for token, tokenServiceNames := range servicesByToken {
+		tokenQuery := *q
+		tokenQuery.Token = token
+		tokenQuery.WaitIndex = 0
+		tokenServices, _, err := c.api.Catalog().Services(&tokenQuery)
+		if err != nil {
+			logger.Warnw("failed to list consul services with their ACL token", "services", tokenServiceNames, "error", err)
+			continue
+		}
+		for _, service := range tokenServiceNames {
+			if tags, ok := tokenServices[service]; ok {
+				services[service] = tags
+			}
+		}
+	}
+	return services, queryMeta, nil
 }
 
 func (c *consul) Service(service, tag string, q *consulapi.QueryOptions) ([]*consulapi.CatalogService, *consulapi.QueryMeta, error) {
 	if err := c.validateDataCenter(q.Datacenter); err != nil {
 		return nil, nil, err
 	}
+	q, err := c.withServiceToken(service, q)
+	if err != nil {
+		return nil, nil, err
+	}
 	return c.api.Catalog().Service(service, tag, q)
 }
 
@@ -70,9 +129,56 @@ func (c *consul) Connect(service, tag string, q *consulapi.QueryOptions) ([]*con
 	if err := c.validateDataCenter(q.Datacenter); err != nil {
 		return nil, nil, err
 	}
+	q, err := c.withServiceToken(service, q)
+	if err != nil {
+		return nil, nil, err
+	}
 	return c.api.Catalog().Connect(service, tag, q)
 }
 
+func (c *consul) HealthService(service, tag string, passingOnly bool, q *consulapi.QueryOptions) ([]*consulapi.ServiceEntry, *consulapi.QueryMeta, error) {
+	if err := c.validateDataCenter(q.Datacenter); err != nil {
+		return nil, nil, err
+	}
+	q, err := c.withServiceToken(service, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.api.Health().Service(service, tag, passingOnly, q)
+}
+
+func (c *consul) HealthConnect(service, tag string, passingOnly bool, q *consulapi.QueryOptions) ([]*consulapi.ServiceEntry, *consulapi.QueryMeta, error) {
+	if err := c.validateDataCenter(q.Datacenter); err != nil {
+		return nil, nil, err
+	}
+	q, err := c.withServiceToken(service, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.api.Health().Connect(service, tag, passingOnly, q)
+}
+
+func (c *consul) ExecutePreparedQuery(queryIDOrName string, q *consulapi.QueryOptions) (*consulapi.PreparedQueryExecuteResponse, *consulapi.QueryMeta, error) {
+	if err := c.validateDataCenter(q.Datacenter); err != nil {
+		return nil, nil, err
+	}
+	return c.api.PreparedQuery().Execute(queryIDOrName, q)
+}
+
+// Sets the token configured for the given service on a copy of the query options, unless they already have a token
+func (c *consul) withServiceToken(service string, q *consulapi.QueryOptions) (*consulapi.QueryOptions, error) {
+	if q.Token != "" {
+		return q, nil
+	}
+	token, err := c.serviceTokens.tokenFor(q.Context(), service)
+	if err != nil || token == "" {
+		return q, err
+	}
+	tokenQuery := *q
+	tokenQuery.Token = token
+	return &tokenQuery, nil
+}
+
 // Filters out the data centers not listed in the config
 func (c *consul) filter(dataCenters []string) []string {
 
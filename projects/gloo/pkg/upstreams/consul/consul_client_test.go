@@ -0,0 +1,170 @@
+package consul_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/golang/mock/gomock"
+	consulapi "github.com/hashicorp/consul/api"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul/mocks"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Consul ACL tokens", func() {
+
+	var (
+		ctx      context.Context
+		ctrl     *gomock.Controller
+		teamRef  = core.ResourceRef{Name: "team-token", Namespace: "gloo-system"}
+		resolver *MockTokenResolver
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(T)
+		resolver = NewMockTokenResolver(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("client with service tokens", func() {
+
+		var (
+			server *httptest.Server
+			client ConsulClient
+			// the ACL tokens of the requests received by the fake Consul server, by path
+			requestTokens map[string][]string
+		)
+
+		BeforeEach(func() {
+			requestTokens = make(map[string][]string)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				token := r.Header.Get("X-Consul-Token")
+				requestTokens[r.URL.Path] = append(requestTokens[r.URL.Path], token)
+
+				var response interface{}
+				switch r.URL.Path {
+				case "/v1/catalog/services":
+					// The default token is not allowed to read the services of the team
+					services := map[string][]string{"shared": {"a"}}
+					if token == "team-secret" {
+						services = map[string][]string{"team-svc": {"b"}, "other-team-svc": {}}
+					}
+					response = services
+				case "/v1/catalog/service/team-svc":
+					response = []*consulapi.CatalogService{{ServiceName: "team-svc"}}
+				}
+				w.Header().Set("X-Consul-Index", "1")
+				Expect(json.NewEncoder(w).Encode(response)).To(Succeed())
+			}))
+
+			apiClient, err := consulapi.NewClient(&consulapi.Config{Address: server.URL, Token: "default-secret"})
+			Expect(err).NotTo(HaveOccurred())
+			client, err = NewConsulClient(apiClient, nil, &ServiceTokens{
+				SecretRefs: map[string]*core.ResourceRef{"team-svc": &teamRef},
+				Resolver:   resolver,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			resolver.EXPECT().ResolveToken(gomock.Any(), teamRef).Return("team-secret", nil).AnyTimes()
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("adds the services which are only visible with their own token", func() {
+			services, _, err := client.Services(&consulapi.QueryOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(services).To(Equal(map[string][]string{
+				"shared":   {"a"},
+				"team-svc": {"b"},
+			}))
+			Expect(requestTokens["/v1/catalog/services"]).To(ConsistOf("default-secret", "team-secret"))
+		})
+
+		It("leaves out only the services whose token cannot be resolved", func() {
+			brokenRef := core.ResourceRef{Name: "broken-token", Namespace: "gloo-system"}
+			otherRef := core.ResourceRef{Name: "other-team-token", Namespace: "gloo-system"}
+			resolver.EXPECT().ResolveToken(gomock.Any(), brokenRef).Return("", NotATokenSecretErr(brokenRef)).AnyTimes()
+			resolver.EXPECT().ResolveToken(gomock.Any(), otherRef).Return("team-secret", nil).AnyTimes()
+
+			apiClient, err := consulapi.NewClient(&consulapi.Config{Address: server.URL, Token: "default-secret"})
+			Expect(err).NotTo(HaveOccurred())
+			client, err = NewConsulClient(apiClient, nil, &ServiceTokens{
+				SecretRefs: map[string]*core.ResourceRef{
+					"team-svc":       &teamRef,
+					"broken-svc":     &brokenRef,
+					"other-team-svc": &otherRef,
+				},
+				Resolver: resolver,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			services, _, err := client.Services(&consulapi.QueryOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(services).To(Equal(map[string][]string{
+				"shared":         {"a"},
+				"team-svc":       {"b"},
+				"other-team-svc": {},
+			}))
+		})
+
+		It("queries the instances of a service with its token", func() {
+			_, _, err := client.Service("team-svc", "", &consulapi.QueryOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requestTokens["/v1/catalog/service/team-svc"]).To(Equal([]string{"team-secret"}))
+		})
+
+		It("does not override tokens set on the query", func() {
+			_, _, err := client.Service("team-svc", "", &consulapi.QueryOptions{Token: "upstream-secret"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requestTokens["/v1/catalog/service/team-svc"]).To(Equal([]string{"upstream-secret"}))
+		})
+	})
+
+	Describe("secret token resolver", func() {
+
+		var secretClient v1.SecretClient
+
+		BeforeEach(func() {
+			var err error
+			secretClient, err = v1.NewSecretClient(&factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("resolves the token held by a consul token secret", func() {
+			_, err := secretClient.Write(&v1.Secret{
+				Metadata: core.Metadata{Name: teamRef.Name, Namespace: teamRef.Namespace},
+				Kind:     &v1.Secret_ConsulToken{ConsulToken: &v1.ConsulTokenSecret{Token: "team-secret"}},
+			}, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+
+			token, err := NewSecretTokenResolver(secretClient).ResolveToken(ctx, teamRef)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(token).To(Equal("team-secret"))
+		})
+
+		It("errors when the secret is not a consul token secret", func() {
+			_, err := secretClient.Write(&v1.Secret{
+				Metadata: core.Metadata{Name: teamRef.Name, Namespace: teamRef.Namespace},
+				Kind:     &v1.Secret_Header{Header: &v1.HeaderSecret{}},
+			}, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = NewSecretTokenResolver(secretClient).ResolveToken(ctx, teamRef)
+			Expect(err).To(MatchError(NotATokenSecretErr(teamRef)))
+		})
+	})
+})
@@ -14,6 +14,9 @@ import (
 
 const UpstreamNamePrefix = "consul-svc:"
 
+// Consul registers the Connect sidecar proxy of a service with this suffix by default
+const ConnectSidecarSuffix = "-sidecar-proxy"
+
 func IsConsulUpstream(upstreamName string) bool {
 	return strings.HasPrefix(upstreamName, UpstreamNamePrefix)
 }
@@ -50,9 +53,10 @@ func ToUpstream(service *ServiceMeta) *v1.Upstream {
 		},
 		UpstreamType: &v1.Upstream_Consul{
 			Consul: &consulplugin.UpstreamSpec{
-				ServiceName: service.Name,
-				DataCenters: service.DataCenters,
-				ServiceTags: service.Tags,
+				ServiceName:    service.Name,
+				DataCenters:    service.DataCenters,
+				ServiceTags:    service.Tags,
+				ConnectEnabled: service.ConnectEnabled,
 			},
 		},
 	}
@@ -78,6 +82,7 @@ func toServiceMetaSlice(dcToSvcMap []*dataCenterServicesTuple) []*ServiceMeta {
 
 	var result []*ServiceMeta
 	for _, serviceMeta := range serviceMap {
+		_, serviceMeta.ConnectEnabled = serviceMap[serviceMeta.Name+ConnectSidecarSuffix]
 		sort.Strings(serviceMeta.DataCenters)
 		sort.Strings(serviceMeta.Tags)
 
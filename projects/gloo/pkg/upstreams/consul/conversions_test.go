@@ -90,4 +90,27 @@ var _ = Describe("Conversions", func() {
 		))
 
 	})
+
+	It("marks the services with a Connect sidecar proxy as connect enabled", func() {
+		input := []*dataCenterServicesTuple{
+			{
+				dataCenter: "dc-1",
+				services: map[string][]string{
+					"svc-1":                        nil,
+					"svc-1" + ConnectSidecarSuffix: nil,
+					"svc-2":                        nil,
+				},
+			},
+		}
+
+		connectEnabled := map[string]bool{}
+		for _, service := range toServiceMetaSlice(input) {
+			connectEnabled[service.Name] = service.ConnectEnabled
+		}
+		Expect(connectEnabled).To(Equal(map[string]bool{
+			"svc-1":                        true,
+			"svc-1" + ConnectSidecarSuffix: false,
+			"svc-2":                        false,
+		}))
+	})
 })
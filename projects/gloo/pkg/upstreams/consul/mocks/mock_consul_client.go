@@ -96,3 +96,106 @@ func (mr *MockConsulClientMockRecorder) Connect(service, tag, q interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockConsulClient)(nil).Connect), service, tag, q)
 }
+
+// HealthService mocks base method
+func (m *MockConsulClient) HealthService(service, tag string, passingOnly bool, q *api.QueryOptions) ([]*api.ServiceEntry, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HealthService", service, tag, passingOnly, q)
+	ret0, _ := ret[0].([]*api.ServiceEntry)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// HealthService indicates an expected call of HealthService
+func (mr *MockConsulClientMockRecorder) HealthService(service, tag, passingOnly, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthService", reflect.TypeOf((*MockConsulClient)(nil).HealthService), service, tag, passingOnly, q)
+}
+
+// HealthConnect mocks base method
+func (m *MockConsulClient) HealthConnect(service, tag string, passingOnly bool, q *api.QueryOptions) ([]*api.ServiceEntry, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HealthConnect", service, tag, passingOnly, q)
+	ret0, _ := ret[0].([]*api.ServiceEntry)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// HealthConnect indicates an expected call of HealthConnect
+func (mr *MockConsulClientMockRecorder) HealthConnect(service, tag, passingOnly, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthConnect", reflect.TypeOf((*MockConsulClient)(nil).HealthConnect), service, tag, passingOnly, q)
+}
+
+// ExecutePreparedQuery mocks base method
+func (m *MockConsulClient) ExecutePreparedQuery(queryIDOrName string, q *api.QueryOptions) (*api.PreparedQueryExecuteResponse, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecutePreparedQuery", queryIDOrName, q)
+	ret0, _ := ret[0].(*api.PreparedQueryExecuteResponse)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ExecutePreparedQuery indicates an expected call of ExecutePreparedQuery
+func (mr *MockConsulClientMockRecorder) ExecutePreparedQuery(queryIDOrName, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecutePreparedQuery", reflect.TypeOf((*MockConsulClient)(nil).ExecutePreparedQuery), queryIDOrName, q)
+}
+
+// MockConnectAgent is a mock of ConnectAgent interface
+type MockConnectAgent struct {
+	ctrl     *gomock.Controller
+	recorder *MockConnectAgentMockRecorder
+}
+
+// MockConnectAgentMockRecorder is the mock recorder for MockConnectAgent
+type MockConnectAgentMockRecorder struct {
+	mock *MockConnectAgent
+}
+
+// NewMockConnectAgent creates a new mock instance
+func NewMockConnectAgent(ctrl *gomock.Controller) *MockConnectAgent {
+	mock := &MockConnectAgent{ctrl: ctrl}
+	mock.recorder = &MockConnectAgentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockConnectAgent) EXPECT() *MockConnectAgentMockRecorder {
+	return m.recorder
+}
+
+// ConnectCARoots mocks base method
+func (m *MockConnectAgent) ConnectCARoots(q *api.QueryOptions) (*api.CARootList, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectCARoots", q)
+	ret0, _ := ret[0].(*api.CARootList)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ConnectCARoots indicates an expected call of ConnectCARoots
+func (mr *MockConnectAgentMockRecorder) ConnectCARoots(q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectCARoots", reflect.TypeOf((*MockConnectAgent)(nil).ConnectCARoots), q)
+}
+
+// ConnectCALeaf mocks base method
+func (m *MockConnectAgent) ConnectCALeaf(serviceID string, q *api.QueryOptions) (*api.LeafCert, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectCALeaf", serviceID, q)
+	ret0, _ := ret[0].(*api.LeafCert)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ConnectCALeaf indicates an expected call of ConnectCALeaf
+func (mr *MockConnectAgentMockRecorder) ConnectCALeaf(serviceID, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectCALeaf", reflect.TypeOf((*MockConnectAgent)(nil).ConnectCALeaf), serviceID, q)
+}
@@ -99,6 +99,54 @@ func (mr *MockConsulWatcherMockRecorder) Connect(service, tag, q interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockConsulWatcher)(nil).Connect), service, tag, q)
 }
 
+// HealthService mocks base method
+func (m *MockConsulWatcher) HealthService(service, tag string, passingOnly bool, q *api.QueryOptions) ([]*api.ServiceEntry, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HealthService", service, tag, passingOnly, q)
+	ret0, _ := ret[0].([]*api.ServiceEntry)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// HealthService indicates an expected call of HealthService
+func (mr *MockConsulWatcherMockRecorder) HealthService(service, tag, passingOnly, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthService", reflect.TypeOf((*MockConsulWatcher)(nil).HealthService), service, tag, passingOnly, q)
+}
+
+// HealthConnect mocks base method
+func (m *MockConsulWatcher) HealthConnect(service, tag string, passingOnly bool, q *api.QueryOptions) ([]*api.ServiceEntry, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HealthConnect", service, tag, passingOnly, q)
+	ret0, _ := ret[0].([]*api.ServiceEntry)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// HealthConnect indicates an expected call of HealthConnect
+func (mr *MockConsulWatcherMockRecorder) HealthConnect(service, tag, passingOnly, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthConnect", reflect.TypeOf((*MockConsulWatcher)(nil).HealthConnect), service, tag, passingOnly, q)
+}
+
+// ExecutePreparedQuery mocks base method
+func (m *MockConsulWatcher) ExecutePreparedQuery(queryIDOrName string, q *api.QueryOptions) (*api.PreparedQueryExecuteResponse, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecutePreparedQuery", queryIDOrName, q)
+	ret0, _ := ret[0].(*api.PreparedQueryExecuteResponse)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ExecutePreparedQuery indicates an expected call of ExecutePreparedQuery
+func (mr *MockConsulWatcherMockRecorder) ExecutePreparedQuery(queryIDOrName, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecutePreparedQuery", reflect.TypeOf((*MockConsulWatcher)(nil).ExecutePreparedQuery), queryIDOrName, q)
+}
+
 // WatchServices mocks base method
 func (m *MockConsulWatcher) WatchServices(ctx context.Context, dataCenters []string) (<-chan []*consul.ServiceMeta, <-chan error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,63 @@
+package consul
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+//go:generate mockgen -destination=./mocks/mock_token.go -source token.go
+
+var NotATokenSecretErr = func(ref core.ResourceRef) error {
+	return eris.Errorf("secret [%s] is not a Consul ACL token secret", ref.Key())
+}
+
+// Resolves the Consul ACL tokens referenced by the settings and the upstreams
+type TokenResolver interface {
+	// ResolveToken returns the ACL token held by the given secret
+	ResolveToken(ctx context.Context, ref core.ResourceRef) (string, error)
+}
+
+// Reads the tokens from the secrets every time they are resolved, so that rotated tokens are picked up
+// with the next query.
+func NewSecretTokenResolver(secretClient v1.SecretClient) TokenResolver {
+	return &secretTokenResolver{secretClient: secretClient}
+}
+
+type secretTokenResolver struct {
+	secretClient v1.SecretClient
+}
+
+func (r *secretTokenResolver) ResolveToken(ctx context.Context, ref core.ResourceRef) (string, error) {
+	secret, err := r.secretClient.Read(ref.Namespace, ref.Name, clients.ReadOpts{Ctx: ctx})
+	if err != nil {
+		return "", err
+	}
+	tokenSecret, ok := secret.Kind.(*v1.Secret_ConsulToken)
+	if !ok {
+		return "", NotATokenSecretErr(ref)
+	}
+	return tokenSecret.ConsulToken.GetToken(), nil
+}
+
+// The ACL tokens configured in the settings for individual services
+type ServiceTokens struct {
+	// The secrets holding the tokens, by service name
+	SecretRefs map[string]*core.ResourceRef
+	Resolver   TokenResolver
+}
+
+// Returns the token configured for the given service, or an empty string if there is none
+func (t *ServiceTokens) tokenFor(ctx context.Context, service string) (string, error) {
+	if t == nil {
+		return "", nil
+	}
+	ref, ok := t.SecretRefs[service]
+	if !ok || ref == nil {
+		return "", nil
+	}
+	return t.Resolver.ResolveToken(ctx, *ref)
+}
@@ -16,6 +16,8 @@ type ServiceMeta struct {
 	Name        string
 	DataCenters []string
 	Tags        []string
+	// True if a Connect sidecar proxy is registered for the service
+	ConnectEnabled bool
 }
 
 type ConsulWatcher interface {
@@ -23,8 +25,8 @@ type ConsulWatcher interface {
 	WatchServices(ctx context.Context, dataCenters []string) (<-chan []*ServiceMeta, <-chan error)
 }
 
-func NewConsulWatcher(client *consulapi.Client, dataCenters []string) (ConsulWatcher, error) {
-	clientWrapper, err := NewConsulClient(client, dataCenters)
+func NewConsulWatcher(client *consulapi.Client, dataCenters []string, serviceTokens *ServiceTokens) (ConsulWatcher, error) {
+	clientWrapper, err := NewConsulClient(client, dataCenters, serviceTokens)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,12 @@
 package utils
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"regexp"
+	"strings"
+
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoygrpccredential "github.com/envoyproxy/go-control-plane/envoy/config/grpc_credential/v3"
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
@@ -18,6 +24,9 @@ import (
 const (
 	MetadataPluginName    = "envoy.grpc_credentials.file_based_metadata"
 	defaultSdsClusterName = "gateway_proxy_sds"
+
+	// the prefix of the errors reported for the ssl configs whose secret is missing
+	SslSecretNotFoundReason = "SSL secret not found"
 )
 
 var (
@@ -26,7 +35,7 @@ var (
 	}
 
 	SslSecretNotFoundError = func(err error) error {
-		return eris.Wrapf(err, "SSL secret not found")
+		return eris.Wrapf(err, SslSecretNotFoundReason)
 	}
 
 	NotTlsSecretError = func(ref core.ResourceRef) error {
@@ -38,6 +47,20 @@ var (
 	MissingValidationContextError = eris.Errorf("must provide validation context name if verifying SAN")
 
 	RootCaMustBeProvidedError = eris.Errorf("a root_ca must be provided if verify_subject_alt_name is not empty")
+
+	ValidationRootCaMustBeProvidedError = eris.Errorf("a root_ca must be provided to validate client certificates")
+
+	InvalidSpkiError = func(spki string) error {
+		return eris.Errorf("%s is not the base64 encoded sha-256 of a subject public key info", spki)
+	}
+
+	InvalidCertificateHashError = func(hash string) error {
+		return eris.Errorf("%s is not the hex encoded sha-256 of a certificate", hash)
+	}
+
+	InvalidSanRegexError = func(err error, regex string) error {
+		return eris.Wrapf(err, "invalid subject alt name regex %s", regex)
+	}
 )
 
 type SslConfigTranslator interface {
@@ -68,6 +91,9 @@ func (s *sslConfigTranslator) ResolveDownstreamSslConfig(secrets v1.SecretList,
 	if err != nil {
 		return nil, err
 	}
+	if err := setClientCertificateValidation(common, dc); err != nil {
+		return nil, err
+	}
 	var requireClientCert *gogo_types.BoolValue
 	if common.ValidationContextType != nil {
 		requireClientCert = &gogo_types.BoolValue{Value: true}
@@ -383,3 +409,87 @@ func verifySanListToMatchSanList(sanList []string) []*envoymatcher.StringMatcher
 	}
 	return matchSanList
 }
+
+// adds the client certificate checks that only downstreams support to the validation context
+func setClientCertificateValidation(tlsContext *envoyauth.CommonTlsContext, dc *v1.SslConfig) error {
+	if len(dc.GetMatchSubjectAltNames()) == 0 && len(dc.GetVerifyCertificateSpki()) == 0 &&
+		len(dc.GetVerifyCertificateHash()) == 0 && dc.GetCrlSource() == nil {
+		return nil
+	}
+
+	var validationContext *envoyauth.CertificateValidationContext
+	switch validationContextType := tlsContext.GetValidationContextType().(type) {
+	case *envoyauth.CommonTlsContext_ValidationContext:
+		validationContext = validationContextType.ValidationContext
+	case *envoyauth.CommonTlsContext_CombinedValidationContext:
+		validationContext = validationContextType.CombinedValidationContext.GetDefaultValidationContext()
+	case *envoyauth.CommonTlsContext_ValidationContextSdsSecretConfig:
+		// the checks are merged with the validation context sent by the sds server
+		validationContext = &envoyauth.CertificateValidationContext{}
+		tlsContext.ValidationContextType = &envoyauth.CommonTlsContext_CombinedValidationContext{
+			CombinedValidationContext: &envoyauth.CommonTlsContext_CombinedCertificateValidationContext{
+				DefaultValidationContext:         validationContext,
+				ValidationContextSdsSecretConfig: validationContextType.ValidationContextSdsSecretConfig,
+			},
+		}
+	default:
+		return ValidationRootCaMustBeProvidedError
+	}
+
+	for _, matcher := range dc.GetMatchSubjectAltNames() {
+		matchSan, err := convertSanMatcher(matcher)
+		if err != nil {
+			return err
+		}
+		validationContext.MatchSubjectAltNames = append(validationContext.MatchSubjectAltNames, matchSan)
+	}
+
+	for _, spki := range dc.GetVerifyCertificateSpki() {
+		if decoded, err := base64.StdEncoding.DecodeString(spki); err != nil || len(decoded) != sha256.Size {
+			return InvalidSpkiError(spki)
+		}
+	}
+	validationContext.VerifyCertificateSpki = dc.GetVerifyCertificateSpki()
+
+	for _, hash := range dc.GetVerifyCertificateHash() {
+		// envoy also accepts the colon separated format of openssl
+		if decoded, err := hex.DecodeString(strings.ReplaceAll(hash, ":", "")); err != nil || len(decoded) != sha256.Size {
+			return InvalidCertificateHashError(hash)
+		}
+	}
+	validationContext.VerifyCertificateHash = dc.GetVerifyCertificateHash()
+
+	switch crl := dc.GetCrlSource().(type) {
+	case *v1.SslConfig_CrlFile:
+		validationContext.Crl = dataSourceGenerator(false)(crl.CrlFile)
+	case *v1.SslConfig_CrlInline:
+		validationContext.Crl = dataSourceGenerator(true)(crl.CrlInline)
+	}
+	return nil
+}
+
+func convertSanMatcher(matcher *v1.SubjectAltNameMatcher) (*envoymatcher.StringMatcher, error) {
+	switch pattern := matcher.GetMatchPattern().(type) {
+	case *v1.SubjectAltNameMatcher_Exact:
+		return &envoymatcher.StringMatcher{
+			MatchPattern: &envoymatcher.StringMatcher_Exact{Exact: pattern.Exact},
+		}, nil
+	case *v1.SubjectAltNameMatcher_Prefix:
+		return &envoymatcher.StringMatcher{
+			MatchPattern: &envoymatcher.StringMatcher_Prefix{Prefix: pattern.Prefix},
+		}, nil
+	case *v1.SubjectAltNameMatcher_Regex:
+		if _, err := regexp.Compile(pattern.Regex); err != nil {
+			return nil, InvalidSanRegexError(err, pattern.Regex)
+		}
+		return &envoymatcher.StringMatcher{
+			MatchPattern: &envoymatcher.StringMatcher_SafeRegex{
+				SafeRegex: &envoymatcher.RegexMatcher{
+					EngineType: &envoymatcher.RegexMatcher_GoogleRe2{GoogleRe2: &envoymatcher.RegexMatcher_GoogleRE2{}},
+					Regex:      pattern.Regex,
+				},
+			},
+		}, nil
+	}
+	return nil, eris.Errorf("subject alt name matcher must set exact, prefix or regex")
+}
@@ -4,6 +4,7 @@ import (
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoygrpccredential "github.com/envoyproxy/go-control-plane/envoy/config/grpc_credential/v3"
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"github.com/golang/protobuf/ptypes"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	. "github.com/solo-io/go-utils/testutils"
@@ -270,6 +271,85 @@ var _ = Describe("Ssl", func() {
 			})
 		})
 
+		Context("client certificate validation", func() {
+			var (
+				spki = "hVxmzlU0b5TjFANwUcR2YDbXy2EpQtp3EJoi9Ds7fJY="
+				hash = "df6ff72fe9116521268f6f2dd4966f51df479883fe7037b39f75916ac3049d1a"
+			)
+
+			It("should add the client certificate checks to the validation context", func() {
+				downstreamCfg.MatchSubjectAltNames = []*v1.SubjectAltNameMatcher{
+					{MatchPattern: &v1.SubjectAltNameMatcher_Exact{Exact: "client.example.com"}},
+					{MatchPattern: &v1.SubjectAltNameMatcher_Prefix{Prefix: "spiffe://example.com/"}},
+					{MatchPattern: &v1.SubjectAltNameMatcher_Regex{Regex: ".*\\.internal"}},
+				}
+				downstreamCfg.VerifyCertificateSpki = []string{spki}
+				downstreamCfg.VerifyCertificateHash = []string{hash}
+				downstreamCfg.CrlSource = &v1.SslConfig_CrlInline{CrlInline: "crl"}
+
+				c, err := configTranslator.ResolveDownstreamSslConfig(secrets, downstreamCfg)
+				Expect(err).NotTo(HaveOccurred())
+				vctx := c.CommonTlsContext.GetValidationContext()
+				Expect(vctx.TrustedCa.GetInlineString()).To(Equal("rootca"))
+				Expect(vctx.MatchSubjectAltNames).To(Equal([]*envoymatcher.StringMatcher{
+					{MatchPattern: &envoymatcher.StringMatcher_Exact{Exact: "client.example.com"}},
+					{MatchPattern: &envoymatcher.StringMatcher_Prefix{Prefix: "spiffe://example.com/"}},
+					{MatchPattern: &envoymatcher.StringMatcher_SafeRegex{SafeRegex: &envoymatcher.RegexMatcher{
+						EngineType: &envoymatcher.RegexMatcher_GoogleRe2{GoogleRe2: &envoymatcher.RegexMatcher_GoogleRE2{}},
+						Regex:      ".*\\.internal",
+					}}},
+				}))
+				Expect(vctx.VerifyCertificateSpki).To(Equal([]string{spki}))
+				Expect(vctx.VerifyCertificateHash).To(Equal([]string{hash}))
+				Expect(vctx.Crl.GetInlineString()).To(Equal("crl"))
+				Expect(c.RequireClientCertificate.GetValue()).To(BeTrue())
+			})
+
+			It("should add the typed SAN matchers to the flat list", func() {
+				downstreamCfg.VerifySubjectAltName = []string{"test"}
+				downstreamCfg.MatchSubjectAltNames = []*v1.SubjectAltNameMatcher{
+					{MatchPattern: &v1.SubjectAltNameMatcher_Prefix{Prefix: "spiffe://"}},
+				}
+				c, err := configTranslator.ResolveDownstreamSslConfig(secrets, downstreamCfg)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(c.CommonTlsContext.GetValidationContext().MatchSubjectAltNames).To(HaveLen(2))
+			})
+
+			It("should read the crl from a file", func() {
+				downstreamCfg.CrlSource = &v1.SslConfig_CrlFile{CrlFile: "/etc/ssl/crl.pem"}
+				c, err := configTranslator.ResolveDownstreamSslConfig(secrets, downstreamCfg)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(c.CommonTlsContext.GetValidationContext().Crl.GetFilename()).To(Equal("/etc/ssl/crl.pem"))
+			})
+
+			It("should error without rootca", func() {
+				tlsSecret.RootCa = ""
+				downstreamCfg.CrlSource = &v1.SslConfig_CrlFile{CrlFile: "/etc/ssl/crl.pem"}
+				_, err := configTranslator.ResolveDownstreamSslConfig(secrets, downstreamCfg)
+				Expect(err).To(Equal(ValidationRootCaMustBeProvidedError))
+			})
+
+			It("should error on invalid pins", func() {
+				downstreamCfg.VerifyCertificateSpki = []string{"not-base64"}
+				_, err := configTranslator.ResolveDownstreamSslConfig(secrets, downstreamCfg)
+				Expect(err).To(MatchError(InvalidSpkiError("not-base64")))
+
+				downstreamCfg.VerifyCertificateSpki = nil
+				downstreamCfg.VerifyCertificateHash = []string{"df:6f"}
+				_, err = configTranslator.ResolveDownstreamSslConfig(secrets, downstreamCfg)
+				Expect(err).To(MatchError(InvalidCertificateHashError("df:6f")))
+			})
+
+			It("should error on invalid regexes", func() {
+				downstreamCfg.MatchSubjectAltNames = []*v1.SubjectAltNameMatcher{
+					{MatchPattern: &v1.SubjectAltNameMatcher_Regex{Regex: "("}},
+				}
+				_, err := configTranslator.ResolveDownstreamSslConfig(secrets, downstreamCfg)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("invalid subject alt name regex ("))
+			})
+		})
+
 	})
 
 	Context("sds", func() {
@@ -362,6 +442,18 @@ var _ = Describe("Ssl", func() {
 				Expect(vctx.DefaultValidationContext.MatchSubjectAltNames).To(Equal(verifySanListToMatchSanList(upstreamCfg.VerifySubjectAltName)))
 			})
 		})
+
+		It("should merge the client certificate checks with the sds validation context", func() {
+			downstreamCfg = &v1.SslConfig{
+				SslSecrets:            &v1.SslConfig_Sds{Sds: sdsConfig},
+				VerifyCertificateHash: []string{"df6ff72fe9116521268f6f2dd4966f51df479883fe7037b39f75916ac3049d1a"},
+			}
+			c, err := configTranslator.ResolveDownstreamSslConfig(nil, downstreamCfg)
+			Expect(err).NotTo(HaveOccurred())
+			vctx := c.CommonTlsContext.ValidationContextType.(*envoyauth.CommonTlsContext_CombinedValidationContext).CombinedValidationContext
+			Expect(vctx.DefaultValidationContext.VerifyCertificateHash).To(Equal(downstreamCfg.VerifyCertificateHash))
+			Expect(vctx.ValidationContextSdsSecretConfig.Name).To(Equal("ValidationContextName"))
+		})
 	})
 
 	Context("sds with tokenFile", func() {
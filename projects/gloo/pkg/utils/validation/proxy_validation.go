@@ -2,10 +2,12 @@ package validation
 
 import (
 	"fmt"
+	"strings"
 
 	errors "github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"go.uber.org/multierr"
 )
 
@@ -16,36 +18,39 @@ func MakeReport(proxy *v1.Proxy) *validation.ProxyReport {
 	for i, listener := range listeners {
 		switch listenerType := listener.GetListenerType().(type) {
 		case *v1.Listener_HttpListener:
-
-			vhostReports := make([]*validation.VirtualHostReport, len(listenerType.HttpListener.GetVirtualHosts()))
-
-			for j, vh := range listenerType.HttpListener.GetVirtualHosts() {
-				routeReports := make([]*validation.RouteReport, len(vh.GetRoutes()))
-				for k := range vh.GetRoutes() {
-					routeReports[k] = &validation.RouteReport{}
-				}
-
-				vhostReports[j] = &validation.VirtualHostReport{
-					RouteReports: routeReports,
-				}
-			}
-
 			listenerReports[i] = &validation.ListenerReport{
 				ListenerTypeReport: &validation.ListenerReport_HttpListenerReport{
-					HttpListenerReport: &validation.HttpListenerReport{
-						VirtualHostReports: vhostReports,
-					},
+					HttpListenerReport: makeHttpListenerReport(listenerType.HttpListener),
 				},
 			}
 		case *v1.Listener_TcpListener:
-			tcpHostReports := make([]*validation.TcpHostReport, len(listenerType.TcpListener.GetTcpHosts()))
-			for j := range listenerType.TcpListener.GetTcpHosts() {
-				tcpHostReports[j] = &validation.TcpHostReport{}
-			}
 			listenerReports[i] = &validation.ListenerReport{
 				ListenerTypeReport: &validation.ListenerReport_TcpListenerReport{
-					TcpListenerReport: &validation.TcpListenerReport{
-						TcpHostReports: tcpHostReports,
+					TcpListenerReport: makeTcpListenerReport(listenerType.TcpListener),
+				},
+			}
+		case *v1.Listener_UdpListener:
+			// udp listeners only have listener level errors
+			listenerReports[i] = &validation.ListenerReport{}
+		case *v1.Listener_HybridListener:
+			matchedListenerReports := make([]*validation.MatchedListenerReport, len(listenerType.HybridListener.GetMatchedListeners()))
+			for j, matchedListener := range listenerType.HybridListener.GetMatchedListeners() {
+				matchedListenerReports[j] = &validation.MatchedListenerReport{}
+				switch matchedListenerType := matchedListener.GetListenerType().(type) {
+				case *v1.MatchedListener_HttpListener:
+					matchedListenerReports[j].ListenerReportType = &validation.MatchedListenerReport_HttpListenerReport{
+						HttpListenerReport: makeHttpListenerReport(matchedListenerType.HttpListener),
+					}
+				case *v1.MatchedListener_TcpListener:
+					matchedListenerReports[j].ListenerReportType = &validation.MatchedListenerReport_TcpListenerReport{
+						TcpListenerReport: makeTcpListenerReport(matchedListenerType.TcpListener),
+					}
+				}
+			}
+			listenerReports[i] = &validation.ListenerReport{
+				ListenerTypeReport: &validation.ListenerReport_HybridListenerReport{
+					HybridListenerReport: &validation.HybridListenerReport{
+						MatchedListenerReports: matchedListenerReports,
 					},
 				},
 			}
@@ -57,10 +62,52 @@ func MakeReport(proxy *v1.Proxy) *validation.ProxyReport {
 	}
 }
 
+func makeHttpListenerReport(httpListener *v1.HttpListener) *validation.HttpListenerReport {
+	vhostReports := make([]*validation.VirtualHostReport, len(httpListener.GetVirtualHosts()))
+
+	for j, vh := range httpListener.GetVirtualHosts() {
+		routeReports := make([]*validation.RouteReport, len(vh.GetRoutes()))
+		for k := range vh.GetRoutes() {
+			routeReports[k] = &validation.RouteReport{}
+		}
+
+		vhostReports[j] = &validation.VirtualHostReport{
+			RouteReports: routeReports,
+		}
+	}
+
+	return &validation.HttpListenerReport{
+		VirtualHostReports: vhostReports,
+	}
+}
+
+func makeTcpListenerReport(tcpListener *v1.TcpListener) *validation.TcpListenerReport {
+	tcpHostReports := make([]*validation.TcpHostReport, len(tcpListener.GetTcpHosts()))
+	for j := range tcpListener.GetTcpHosts() {
+		tcpHostReports[j] = &validation.TcpHostReport{}
+	}
+	return &validation.TcpListenerReport{
+		TcpHostReports: tcpHostReports,
+	}
+}
+
 func mkErr(level, errType, reason string) error {
 	return errors.Errorf("%v Error: %v. Reason: %v", level, errType, reason)
 }
 
+// MakeListenerErr returns the error which GetProxyError reports for a listener error of the given type and reason
+func MakeListenerErr(errType validation.ListenerReport_Error_Type, reason string) error {
+	return mkErr("Listener", errType.String(), reason)
+}
+
+// IsSslSecretNotFoundErr returns true if the listener error is reported for an ssl config whose secret is missing.
+// Gloo accepts the listener without the filter chain of that ssl config, so the error is only a warning for the
+// resources the listener is created from.
+func IsSslSecretNotFoundErr(errReport *validation.ListenerReport_Error) bool {
+	return errReport.GetType() == validation.ListenerReport_Error_SSLConfigError &&
+		strings.HasPrefix(errReport.GetReason(), glooutils.SslSecretNotFoundReason)
+}
+
 func GetListenerErr(listener *validation.ListenerReport) []error {
 	var errs []error
 	for _, errReport := range listener.GetErrors() {
@@ -130,29 +177,13 @@ func GetProxyError(proxyRpt *validation.ProxyReport) error {
 		}
 		switch listenerType := listener.ListenerTypeReport.(type) {
 		case *validation.ListenerReport_HttpListenerReport:
-			httpListener := listenerType.HttpListenerReport
-			if err := GetHttpListenerErr(httpListener); err != nil {
-				errs = append(errs, err...)
-			}
-			for _, vhReport := range httpListener.GetVirtualHostReports() {
-				if err := GetVirtualHostErr(vhReport); err != nil {
-					errs = append(errs, err...)
-				}
-				for _, routeReport := range vhReport.GetRouteReports() {
-					if err := GetRouteErr(routeReport); err != nil {
-						errs = append(errs, err...)
-					}
-				}
-			}
+			errs = append(errs, getHttpListenerReportErrs(listenerType.HttpListenerReport)...)
 		case *validation.ListenerReport_TcpListenerReport:
-			tcpListener := listenerType.TcpListenerReport
-			if err := GetTcpListenerErr(tcpListener); err != nil {
-				errs = append(errs, err...)
-			}
-			for _, hostReport := range tcpListener.GetTcpHostReports() {
-				if err := GetTcpHostErr(hostReport); err != nil {
-					errs = append(errs, err...)
-				}
+			errs = append(errs, getTcpListenerReportErrs(listenerType.TcpListenerReport)...)
+		case *validation.ListenerReport_HybridListenerReport:
+			for _, matchedListener := range listenerType.HybridListenerReport.GetMatchedListenerReports() {
+				errs = append(errs, getHttpListenerReportErrs(matchedListener.GetHttpListenerReport())...)
+				errs = append(errs, getTcpListenerReportErrs(matchedListener.GetTcpListenerReport())...)
 			}
 		}
 	}
@@ -165,19 +196,47 @@ func GetProxyError(proxyRpt *validation.ProxyReport) error {
 	return combinedErr
 }
 
+func getHttpListenerReportErrs(httpListener *validation.HttpListenerReport) []error {
+	var errs []error
+	if err := GetHttpListenerErr(httpListener); err != nil {
+		errs = append(errs, err...)
+	}
+	for _, vhReport := range httpListener.GetVirtualHostReports() {
+		if err := GetVirtualHostErr(vhReport); err != nil {
+			errs = append(errs, err...)
+		}
+		for _, routeReport := range vhReport.GetRouteReports() {
+			if err := GetRouteErr(routeReport); err != nil {
+				errs = append(errs, err...)
+			}
+		}
+	}
+	return errs
+}
+
+func getTcpListenerReportErrs(tcpListener *validation.TcpListenerReport) []error {
+	var errs []error
+	if err := GetTcpListenerErr(tcpListener); err != nil {
+		errs = append(errs, err...)
+	}
+	for _, hostReport := range tcpListener.GetTcpHostReports() {
+		if err := GetTcpHostErr(hostReport); err != nil {
+			errs = append(errs, err...)
+		}
+	}
+	return errs
+}
+
 func GetProxyWarning(proxyRpt *validation.ProxyReport) []string {
 	var warnings []string
 
 	for _, listener := range proxyRpt.GetListenerReports() {
 		switch listenerType := listener.ListenerTypeReport.(type) {
 		case *validation.ListenerReport_HttpListenerReport:
-			httpListener := listenerType.HttpListenerReport
-			for _, vhReport := range httpListener.GetVirtualHostReports() {
-				for _, routeReport := range vhReport.GetRouteReports() {
-					if warns := GetRouteWarning(routeReport); len(warns) > 0 {
-						warnings = append(warnings, warns...)
-					}
-				}
+			warnings = append(warnings, getHttpListenerReportWarnings(listenerType.HttpListenerReport)...)
+		case *validation.ListenerReport_HybridListenerReport:
+			for _, matchedListener := range listenerType.HybridListenerReport.GetMatchedListenerReports() {
+				warnings = append(warnings, getHttpListenerReportWarnings(matchedListener.GetHttpListenerReport())...)
 			}
 		}
 	}
@@ -185,6 +244,18 @@ func GetProxyWarning(proxyRpt *validation.ProxyReport) []string {
 	return warnings
 }
 
+func getHttpListenerReportWarnings(httpListener *validation.HttpListenerReport) []string {
+	var warnings []string
+	for _, vhReport := range httpListener.GetVirtualHostReports() {
+		for _, routeReport := range vhReport.GetRouteReports() {
+			if warns := GetRouteWarning(routeReport); len(warns) > 0 {
+				warnings = append(warnings, warns...)
+			}
+		}
+	}
+	return warnings
+}
+
 func AppendListenerError(listenerReport *validation.ListenerReport, errType validation.ListenerReport_Error_Type, reason string) {
 	listenerReport.Errors = append(listenerReport.Errors, &validation.ListenerReport_Error{
 		Type:   errType,
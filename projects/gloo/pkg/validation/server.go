@@ -168,13 +168,16 @@ func (s *validator) ValidateProxy(ctx context.Context, req *validation.ProxyVali
 	logger := contextutils.LoggerFrom(ctx)
 
 	logger.Infof("received proxy validation request")
-	_, _, report, err := s.translator.Translate(params, req.GetProxy())
+	xdsSnapshot, resourceReports, report, err := s.translator.Translate(params, req.GetProxy())
 	if err != nil {
 		logger.Errorw("failed to validate proxy", zap.Error(err))
 		return nil, err
 	}
 	logger.Infof("proxy validation report result: %v", report.String())
-	return &validation.ProxyValidationServiceResponse{ProxyReport: report}, nil
+	return &validation.ProxyValidationServiceResponse{
+		ProxyReport:       report,
+		TranslationErrors: translationErrors(&snapCopy, xdsSnapshot, resourceReports),
+	}, nil
 }
 
 type ValidationServer interface {
@@ -12,6 +12,8 @@ import (
 	mock_consul "github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul/mocks"
 	"google.golang.org/grpc"
 
+	envoycore "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/core"
+
 	"github.com/solo-io/gloo/test/samples"
 
 	validationgrpc "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
@@ -84,6 +86,31 @@ var _ = Describe("Validation Server", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(rpt).To(Equal(&validationgrpc.ProxyValidationServiceResponse{ProxyReport: validation.MakeReport(proxy)}))
 		})
+
+		It("returns the errors of the upstreams the proxy routes to", func() {
+			proxy := params.Snapshot.Proxies[0]
+			params.Snapshot.Upstreams[0].HealthChecks = []*envoycore.HealthCheck{{}}
+			s := NewValidator(context.TODO(), translator)
+			_ = s.Sync(context.TODO(), params.Snapshot)
+			rpt, err := s.ValidateProxy(context.TODO(), &validationgrpc.ProxyValidationServiceRequest{Proxy: proxy})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rpt.ProxyReport).To(Equal(validation.MakeReport(proxy)))
+			Expect(rpt.TranslationErrors).To(Equal([]string{
+				"upstream {test gloo-system}: 1 error occurred:\n\t* The field HealthCheck[0].HealthyThreshold cannot be nil\n\n",
+			}))
+		})
+
+		It("returns the Envoy resources of the proxy which do not pass the Envoy validation", func() {
+			proxy := params.Snapshot.Proxies[0]
+			proxy.Listeners[0].GetHttpListener().VirtualHosts[0].Name = ""
+			s := NewValidator(context.TODO(), translator)
+			_ = s.Sync(context.TODO(), params.Snapshot)
+			rpt, err := s.ValidateProxy(context.TODO(), &validationgrpc.ProxyValidationServiceRequest{Proxy: proxy})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rpt.TranslationErrors).To(HaveLen(1))
+			Expect(rpt.TranslationErrors[0]).To(HavePrefix("invalid Envoy route configuration http-listener-routes: "))
+			Expect(rpt.TranslationErrors[0]).To(ContainSubstring("invalid VirtualHost.Name"))
+		})
 	})
 
 	Context("Watch Sync Notifications", func() {
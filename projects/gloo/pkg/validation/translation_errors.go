@@ -0,0 +1,100 @@
+package validation
+
+import (
+	"fmt"
+	"sort"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+)
+
+// the generated envoy types validate themselves with the constraints of the envoy api
+type envoyValidator interface {
+	Validate() error
+}
+
+// translationErrors returns the errors of the full translation of a proxy which are not reported on the proxy:
+// the listeners, route configurations and routed clusters which do not pass the Envoy validation,
+// and the errors of the upstreams the proxy routes to.
+// The other clusters are generated for all the upstreams of the snapshot, whatever the proxy, so they are ignored.
+func translationErrors(snap *v1.ApiSnapshot, xdsSnapshot envoycache.Snapshot, reports reporter.ResourceReports) []string {
+	var errs []string
+
+	routedClusters := map[string]bool{}
+	for _, res := range sortedResources(xdsSnapshot.GetResources(xds.RouteType)) {
+		if routeConfig, ok := res.ResourceProto().(*envoyapi.RouteConfiguration); ok {
+			for _, cluster := range routedClusterNames(routeConfig) {
+				routedClusters[cluster] = true
+			}
+		}
+	}
+
+	for _, typ := range []struct {
+		name, typeUrl string
+	}{
+		{name: "listener", typeUrl: xds.ListenerType},
+		{name: "route configuration", typeUrl: xds.RouteType},
+		{name: "cluster", typeUrl: xds.ClusterType},
+	} {
+		for _, res := range sortedResources(xdsSnapshot.GetResources(typ.typeUrl)) {
+			if typ.typeUrl == xds.ClusterType && !routedClusters[res.Self().Name] {
+				continue
+			}
+			validator, ok := res.ResourceProto().(envoyValidator)
+			if !ok {
+				continue
+			}
+			if err := validator.Validate(); err != nil {
+				errs = append(errs, fmt.Sprintf("invalid Envoy %s %s: %v", typ.name, res.Self().Name, err))
+			}
+		}
+	}
+
+	for _, upstream := range snap.Upstreams {
+		if !routedClusters[translator.UpstreamToClusterName(upstream.Metadata.Ref())] {
+			continue
+		}
+		if err := reports[upstream].Errors; err != nil {
+			errs = append(errs, fmt.Sprintf("upstream %v: %v", upstream.Metadata.Ref(), err))
+		}
+	}
+
+	return errs
+}
+
+// routedClusterNames returns the names of the clusters the routes of the route configuration send traffic to
+func routedClusterNames(routeConfig *envoyapi.RouteConfiguration) []string {
+	var clusters []string
+	for _, virtualHost := range routeConfig.GetVirtualHosts() {
+		for _, route := range virtualHost.GetRoutes() {
+			action := route.GetRoute()
+			if action == nil {
+				continue
+			}
+			if cluster := action.GetCluster(); cluster != "" {
+				clusters = append(clusters, cluster)
+			}
+			for _, weightedCluster := range action.GetWeightedClusters().GetClusters() {
+				clusters = append(clusters, weightedCluster.GetName())
+			}
+		}
+	}
+	return clusters
+}
+
+func sortedResources(resources envoycache.Resources) []envoycache.Resource {
+	var names []string
+	for name := range resources.Items {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var sorted []envoycache.Resource
+	for _, name := range names {
+		sorted = append(sorted, resources.Items[name])
+	}
+	return sorted
+}
@@ -0,0 +1,348 @@
+package xds
+
+import (
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"sync/atomic"
+
+	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v2"
+	"github.com/gogo/protobuf/proto"
+	any "github.com/golang/protobuf/ptypes/any"
+	"github.com/solo-io/go-utils/contextutils"
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	envoyserver "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/server"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type DeltaStream interface {
+	Send(*v2.DeltaDiscoveryResponse) error
+	Recv() (*v2.DeltaDiscoveryRequest, error)
+	grpc.ServerStream
+}
+
+// DeltaServer serves the incremental (delta) variant of the xDS protocol from the snapshot cache.
+// Instead of the state of the world, only the resources which were added, changed or removed since
+// the last response are sent to each proxy. The resources a proxy has are tracked per stream, by the
+// hashes of their contents.
+type DeltaServer interface {
+	// DeltaStream serves a stream of the given type, or of any type for ADS.
+	DeltaStream(stream DeltaStream, typeURL string) error
+}
+
+// NewDeltaServer creates a delta xDS server, which watches the snapshots of the cache for the nodes the hasher identifies.
+func NewDeltaServer(snapshotCache envoycache.SnapshotCache, hasher envoycache.NodeHash) DeltaServer {
+	return &deltaServer{cache: snapshotCache, hasher: hasher}
+}
+
+type deltaServer struct {
+	cache  envoycache.SnapshotCache
+	hasher envoycache.NodeHash
+
+	// streamCount for counting bi-di streams
+	streamCount int64
+}
+
+// the delta state of a type of resource on a stream
+type deltaState struct {
+	// whether the proxy is subscribed to all the resources of the type
+	wildcard bool
+	// the names of the resources the proxy is subscribed to, if not subscribed to all of them
+	subscribed map[string]bool
+	// the versions of the resources the proxy has, by name
+	versions map[string]string
+	// the version of the snapshot the proxy is up to date with
+	snapshotVersion string
+
+	watchID     int64
+	watchCancel func()
+}
+
+func (s *deltaState) isSubscribed(name string) bool {
+	return s.wildcard || s.subscribed[name]
+}
+
+// applies the subscription changes of the request, and returns whether there were any
+func (s *deltaState) update(req *v2.DeltaDiscoveryRequest) bool {
+	changed := false
+	for _, name := range req.GetResourceNamesSubscribe() {
+		if !s.subscribed[name] {
+			s.subscribed[name] = true
+			changed = true
+		}
+	}
+	for _, name := range req.GetResourceNamesUnsubscribe() {
+		if s.subscribed[name] {
+			delete(s.subscribed, name)
+			changed = true
+		}
+		// the proxy forgets resources it unsubscribes from, so they're sent again if it subscribes again
+		delete(s.versions, name)
+	}
+	return changed
+}
+
+// Returns the response with the resources which changed since the last response, and the names of those
+// which were removed, or nil if there were no changes. The state is updated as if the response was sent.
+func (s *deltaState) diff(resources []envoycache.Resource, typeURL string, version string) (*v2.DeltaDiscoveryResponse, error) {
+	s.snapshotVersion = version
+
+	current := make(map[string]envoycache.Resource, len(resources))
+	var names []string
+	for _, resource := range resources {
+		name := resource.Self().Name
+		current[name] = resource
+		names = append(names, name)
+	}
+	// sort for a deterministic order of resources in responses
+	sort.Strings(names)
+
+	out := &v2.DeltaDiscoveryResponse{
+		SystemVersionInfo: version,
+		TypeUrl:           typeURL,
+	}
+	for _, name := range names {
+		if !s.isSubscribed(name) {
+			continue
+		}
+		data, err := marshalDeterministic(current[name].ResourceProto())
+		if err != nil {
+			return nil, err
+		}
+		resourceVersion := hashResource(data)
+		if s.versions[name] == resourceVersion {
+			continue
+		}
+		s.versions[name] = resourceVersion
+		out.Resources = append(out.Resources, &v2.Resource{
+			Name:     name,
+			Version:  resourceVersion,
+			Resource: &any.Any{TypeUrl: typeURL, Value: data},
+		})
+	}
+
+	var removed []string
+	for name := range s.versions {
+		if _, ok := current[name]; !ok {
+			removed = append(removed, name)
+			delete(s.versions, name)
+		}
+	}
+	sort.Strings(removed)
+	out.RemovedResources = removed
+
+	if len(out.Resources) == 0 && len(out.RemovedResources) == 0 {
+		return nil, nil
+	}
+	return out, nil
+}
+
+// marshals the resource deterministically, so its hash only changes when its content does
+func marshalDeterministic(resource envoycache.ResourceProto) ([]byte, error) {
+	buf := proto.NewBuffer(nil)
+	buf.SetDeterministic(true)
+	if err := buf.Marshal(resource); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func hashResource(data []byte) string {
+	hasher := fnv.New64a()
+	hasher.Write(data)
+	return strconv.FormatUint(hasher.Sum64(), 16)
+}
+
+type deltaWatchResponse struct {
+	typeURL string
+	watchID int64
+	// nil if the watch failed
+	response *envoycache.Response
+}
+
+func (s *deltaServer) DeltaStream(stream DeltaStream, typeURL string) error {
+	// a channel for receiving incoming requests
+	reqCh := make(chan *v2.DeltaDiscoveryRequest)
+	go func() {
+		defer close(reqCh)
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			select {
+			case reqCh <- req:
+			case <-stream.Context().Done():
+				return
+			}
+		}
+	}()
+
+	return s.process(stream, reqCh, typeURL)
+}
+
+// process handles a bi-di delta stream
+func (s *deltaServer) process(stream DeltaStream, reqCh <-chan *v2.DeltaDiscoveryRequest, defaultTypeURL string) error {
+	ctx := stream.Context()
+	logger := contextutils.LoggerFrom(ctx)
+	streamID := atomic.AddInt64(&s.streamCount, 1)
+
+	// unique nonce generator for req-resp pairs per xDS stream
+	var streamNonce int64
+	var watchCount int64
+
+	states := map[string]*deltaState{}
+	defer func() {
+		for _, state := range states {
+			if state.watchCancel != nil {
+				state.watchCancel()
+			}
+		}
+	}()
+
+	responses := make(chan deltaWatchResponse)
+
+	// watches the snapshot of the node for a version of the resources other than the given one, which is
+	// answered immediately if the version is empty and the node has a snapshot
+	watch := func(node *core.Node, typeURL string, state *deltaState, version string) {
+		if state.watchCancel != nil {
+			state.watchCancel()
+		}
+		watchCount++
+		watchID := watchCount
+		value, cancel := s.cache.CreateWatch(envoycache.Request{
+			Node:        node,
+			TypeUrl:     typeURL,
+			VersionInfo: version,
+		})
+		canceled := make(chan struct{})
+		state.watchID = watchID
+		state.watchCancel = func() {
+			close(canceled)
+			if cancel != nil {
+				cancel()
+			}
+		}
+		go func() {
+			var result deltaWatchResponse
+			select {
+			case response, ok := <-value:
+				result = deltaWatchResponse{typeURL: typeURL, watchID: watchID}
+				if ok {
+					result.response = &response
+				}
+			case <-canceled:
+				return
+			}
+			select {
+			case responses <- result:
+			case <-canceled:
+			case <-ctx.Done():
+			}
+		}()
+	}
+
+	// node may only be set on the first discovery request
+	var node = &core.Node{}
+	for {
+		select {
+		case resp := <-responses:
+			state, ok := states[resp.typeURL]
+			if !ok || state.watchID != resp.watchID {
+				// the watch was replaced in the meantime
+				continue
+			}
+			// the watch is done, and mustn't be canceled again
+			state.watchCancel = nil
+			if resp.response == nil {
+				return status.Errorf(codes.Unavailable, "watching failed for "+resp.typeURL)
+			}
+			out, err := state.diff(resp.response.Resources, resp.typeURL, resp.response.Version)
+			if err != nil {
+				return err
+			}
+			if out != nil {
+				streamNonce++
+				out.Nonce = strconv.FormatInt(streamNonce, 10)
+				logger.Debugw("sending delta xds response", "stream", streamID, "type", resp.typeURL,
+					"version", out.SystemVersionInfo, "resources", len(out.Resources), "removed", len(out.RemovedResources))
+				if err := stream.Send(out); err != nil {
+					return err
+				}
+			}
+			watch(node, resp.typeURL, state, state.snapshotVersion)
+
+		case req, more := <-reqCh:
+			// input stream ended or errored out
+			if !more {
+				return nil
+			}
+			if req == nil {
+				return status.Errorf(codes.Unavailable, "empty request")
+			}
+
+			// node field in discovery request is delta-compressed
+			if req.Node != nil {
+				node = req.Node
+			}
+
+			// type URL is required for ADS but is implicit for xDS
+			typeURL := req.GetTypeUrl()
+			if defaultTypeURL == envoycache.AnyType {
+				if typeURL == "" {
+					return status.Errorf(codes.InvalidArgument, "type URL is required for ADS")
+				}
+			} else if typeURL == "" {
+				typeURL = defaultTypeURL
+			}
+
+			if req.GetErrorDetail() != nil {
+				logger.Warnw("proxy rejected delta xds response", "stream", streamID, "node", s.hasher.ID(node),
+					"type", typeURL, "nonce", req.GetResponseNonce(), "error", req.GetErrorDetail().GetMessage())
+			}
+
+			state, ok := states[typeURL]
+			if !ok {
+				// clusters and listeners are subscribed to as a whole, unless the first request names them
+				wildcard := len(req.GetResourceNamesSubscribe()) == 0 && (typeURL == ClusterType || typeURL == ListenerType)
+				state = &deltaState{
+					wildcard:   wildcard,
+					subscribed: map[string]bool{},
+					versions:   map[string]string{},
+				}
+				// the proxy tells which versions it already has when it reconnects
+				for name, version := range req.GetInitialResourceVersions() {
+					state.versions[name] = version
+				}
+				state.update(req)
+				states[typeURL] = state
+			} else if !state.update(req) {
+				// an ACK or NACK of a response, which the current watch already follows up on
+				continue
+			}
+
+			// (re-)request the current snapshot, to send the resources the proxy newly subscribed to
+			watch(node, typeURL, state, "")
+		}
+	}
+}
+
+// AggregatedServer serves both the state of the world and the delta variants of ADS.
+type AggregatedServer struct {
+	envoyserver.Server
+	delta DeltaServer
+}
+
+var _ discovery.AggregatedDiscoveryServiceServer = new(AggregatedServer)
+
+func NewAggregatedServer(server envoyserver.Server, delta DeltaServer) *AggregatedServer {
+	return &AggregatedServer{Server: server, delta: delta}
+}
+
+func (s *AggregatedServer) DeltaAggregatedResources(stream discovery.AggregatedDiscoveryService_DeltaAggregatedResourcesServer) error {
+	return s.delta.DeltaStream(stream, envoycache.AnyType)
+}
@@ -0,0 +1,161 @@
+package xds_test
+
+import (
+	"context"
+	"io"
+
+	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	"github.com/golang/protobuf/ptypes/duration"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+	"google.golang.org/grpc"
+)
+
+type fakeDeltaStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	requests  chan *v2.DeltaDiscoveryRequest
+	responses chan *v2.DeltaDiscoveryResponse
+}
+
+func (s *fakeDeltaStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeDeltaStream) Send(resp *v2.DeltaDiscoveryResponse) error {
+	s.responses <- resp
+	return nil
+}
+
+func (s *fakeDeltaStream) Recv() (*v2.DeltaDiscoveryRequest, error) {
+	select {
+	case req := <-s.requests:
+		return req, nil
+	case <-s.ctx.Done():
+		return nil, io.EOF
+	}
+}
+
+var _ = Describe("DeltaServer", func() {
+
+	const nodeKey = "gloo-system~gateway-proxy"
+
+	var (
+		ctx           context.Context
+		cancel        context.CancelFunc
+		snapshotCache cache.SnapshotCache
+		stream        *fakeDeltaStream
+		node          *core.Node
+	)
+
+	cluster := func(name string, timeout int64) cache.Resource {
+		return xds.NewEnvoyResource(&v2.Cluster{Name: name, ConnectTimeout: &duration.Duration{Seconds: timeout}})
+	}
+
+	endpoint := func(name string) cache.Resource {
+		return xds.NewEnvoyResource(&v2.ClusterLoadAssignment{ClusterName: name})
+	}
+
+	setSnapshot := func(version string, endpoints []cache.Resource, clusters []cache.Resource) {
+		err := snapshotCache.SetSnapshot(nodeKey, xds.NewSnapshot(version, endpoints, clusters, nil, nil))
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	names := func(resp *v2.DeltaDiscoveryResponse) []string {
+		var names []string
+		for _, resource := range resp.GetResources() {
+			names = append(names, resource.GetName())
+		}
+		return names
+	}
+
+	startStream := func(typeURL string) {
+		server := xds.NewDeltaServer(snapshotCache, xds.NewNodeHasher())
+		go server.DeltaStream(stream, typeURL)
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		snapshotCache = cache.NewSnapshotCache(true, xds.NewNodeHasher(), nil)
+		stream = &fakeDeltaStream{
+			ctx:       ctx,
+			requests:  make(chan *v2.DeltaDiscoveryRequest, 10),
+			responses: make(chan *v2.DeltaDiscoveryResponse, 10),
+		}
+		node = &core.Node{Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+			"role": {Kind: &structpb.Value_StringValue{StringValue: nodeKey}},
+		}}}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("should only send the clusters which changed", func() {
+		setSnapshot("1", nil, []cache.Resource{cluster("a", 1), cluster("b", 1), cluster("c", 1)})
+		startStream(xds.ClusterType)
+
+		stream.requests <- &v2.DeltaDiscoveryRequest{Node: node}
+		var resp *v2.DeltaDiscoveryResponse
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(Equal([]string{"a", "b", "c"}))
+		Expect(resp.GetSystemVersionInfo()).To(Equal("1"))
+		Expect(resp.GetTypeUrl()).To(Equal(xds.ClusterType))
+
+		// ACK
+		stream.requests <- &v2.DeltaDiscoveryRequest{ResponseNonce: resp.GetNonce()}
+		Consistently(stream.responses).ShouldNot(Receive())
+
+		setSnapshot("2", nil, []cache.Resource{cluster("a", 1), cluster("b", 2)})
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(Equal([]string{"b"}))
+		Expect(resp.GetRemovedResources()).To(Equal([]string{"c"}))
+
+		// snapshots with the same resources send nothing
+		setSnapshot("3", nil, []cache.Resource{cluster("a", 1), cluster("b", 2)})
+		Consistently(stream.responses).ShouldNot(Receive())
+	})
+
+	It("should not send the resources the proxy already has when it reconnects", func() {
+		setSnapshot("1", nil, []cache.Resource{cluster("a", 1), cluster("b", 1)})
+		startStream(xds.ClusterType)
+
+		stream.requests <- &v2.DeltaDiscoveryRequest{Node: node}
+		var resp *v2.DeltaDiscoveryResponse
+		Eventually(stream.responses).Should(Receive(&resp))
+		versions := map[string]string{}
+		for _, resource := range resp.GetResources() {
+			versions[resource.GetName()] = resource.GetVersion()
+		}
+		cancel()
+
+		ctx, cancel = context.WithCancel(context.Background())
+		stream.ctx = ctx
+		setSnapshot("2", nil, []cache.Resource{cluster("a", 1), cluster("b", 2)})
+		startStream(xds.ClusterType)
+
+		stream.requests <- &v2.DeltaDiscoveryRequest{Node: node, InitialResourceVersions: versions}
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(Equal([]string{"b"}))
+	})
+
+	It("should only send the endpoints the proxy subscribed to", func() {
+		setSnapshot("1", []cache.Resource{endpoint("a"), endpoint("b")}, []cache.Resource{cluster("a", 1), cluster("b", 1)})
+		startStream(cache.AnyType)
+
+		stream.requests <- &v2.DeltaDiscoveryRequest{Node: node, TypeUrl: xds.EndpointType, ResourceNamesSubscribe: []string{"a"}}
+		var resp *v2.DeltaDiscoveryResponse
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(Equal([]string{"a"}))
+		Expect(resp.GetTypeUrl()).To(Equal(xds.EndpointType))
+
+		stream.requests <- &v2.DeltaDiscoveryRequest{TypeUrl: xds.EndpointType, ResponseNonce: resp.GetNonce(),
+			ResourceNamesSubscribe: []string{"b"}}
+		Eventually(stream.responses).Should(Receive(&resp))
+		Expect(names(resp)).To(Equal([]string{"b"}))
+	})
+})
@@ -17,7 +17,6 @@ package xds
 
 import (
 	"context"
-	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -36,11 +35,12 @@ type EnvoyServer interface {
 
 type envoyServer struct {
 	server.Server
+	delta DeltaServer
 }
 
 // NewServer creates handlers from a config watcher and an optional logger.
-func NewEnvoyServer(genericServer server.Server) EnvoyServer {
-	return &envoyServer{Server: genericServer}
+func NewEnvoyServer(genericServer server.Server, deltaServer DeltaServer) EnvoyServer {
+	return &envoyServer{Server: genericServer, delta: deltaServer}
 }
 
 func (s *envoyServer) StreamEndpoints(stream v2.EndpointDiscoveryService_StreamEndpointsServer) error {
@@ -91,18 +91,18 @@ func (s *envoyServer) FetchListeners(ctx context.Context, req *v2.DiscoveryReque
 	return s.Server.Fetch(ctx, req)
 }
 
-func (s *envoyServer) DeltaClusters(_ v2.ClusterDiscoveryService_DeltaClustersServer) error {
-	return errors.New("not implemented")
+func (s *envoyServer) DeltaClusters(stream v2.ClusterDiscoveryService_DeltaClustersServer) error {
+	return s.delta.DeltaStream(stream, ClusterType)
 }
 
-func (s *envoyServer) DeltaRoutes(_ v2.RouteDiscoveryService_DeltaRoutesServer) error {
-	return errors.New("not implemented")
+func (s *envoyServer) DeltaRoutes(stream v2.RouteDiscoveryService_DeltaRoutesServer) error {
+	return s.delta.DeltaStream(stream, RouteType)
 }
 
-func (s *envoyServer) DeltaEndpoints(v2.EndpointDiscoveryService_DeltaEndpointsServer) error {
-	return errors.New("not implemented")
+func (s *envoyServer) DeltaEndpoints(stream v2.EndpointDiscoveryService_DeltaEndpointsServer) error {
+	return s.delta.DeltaStream(stream, EndpointType)
 }
 
-func (s *envoyServer) DeltaListeners(v2.ListenerDiscoveryService_DeltaListenersServer) error {
-	return errors.New("not implemented")
+func (s *envoyServer) DeltaListeners(stream v2.ListenerDiscoveryService_DeltaListenersServer) error {
+	return s.delta.DeltaStream(stream, ListenerType)
 }
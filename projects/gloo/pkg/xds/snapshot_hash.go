@@ -0,0 +1,44 @@
+package xds
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"sort"
+
+	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+)
+
+// the types of resources of the snapshots gloo sends to envoy
+var snapshotTypes = []string{ClusterType, EndpointType, ListenerType, RouteType}
+
+// SnapshotHash hashes the versions and the contents of the resources of the snapshot. Snapshots with the same hash
+// are byte-identical, so setting one in place of the other wouldn't change the configuration of envoy.
+func SnapshotHash(snapshot envoycache.Snapshot) (uint64, error) {
+	hasher := fnv.New64a()
+	for _, typeURL := range snapshotTypes {
+		resources := snapshot.GetResources(typeURL)
+		hasher.Write([]byte(typeURL))
+		hasher.Write([]byte{0})
+		hasher.Write([]byte(resources.Version))
+		hasher.Write([]byte{0})
+
+		// sort for a deterministic hash, as the resources are kept in a map
+		names := make([]string, 0, len(resources.Items))
+		for name := range resources.Items {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			data, err := marshalDeterministic(resources.Items[name].ResourceProto())
+			if err != nil {
+				return 0, err
+			}
+			hasher.Write([]byte(name))
+			hasher.Write([]byte{0})
+			// the length keeps the end of one resource from being mistaken for the start of the next
+			_ = binary.Write(hasher, binary.LittleEndian, uint64(len(data)))
+			hasher.Write(data)
+		}
+	}
+	return hasher.Sum64(), nil
+}
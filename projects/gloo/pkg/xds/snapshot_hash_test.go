@@ -0,0 +1,40 @@
+package xds_test
+
+import (
+	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	"github.com/golang/protobuf/ptypes/duration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
+)
+
+var _ = Describe("SnapshotHash", func() {
+
+	snapshot := func(version string, timeout int64, clusterNames ...string) cache.Snapshot {
+		var clusters []cache.Resource
+		for _, name := range clusterNames {
+			clusters = append(clusters, xds.NewEnvoyResource(&v2.Cluster{Name: name, ConnectTimeout: &duration.Duration{Seconds: timeout}}))
+		}
+		return xds.NewSnapshot(version, nil, clusters, nil, nil)
+	}
+
+	hash := func(snapshot cache.Snapshot) uint64 {
+		hash, err := xds.SnapshotHash(snapshot)
+		Expect(err).NotTo(HaveOccurred())
+		return hash
+	}
+
+	It("hashes identical snapshots the same", func() {
+		Expect(hash(snapshot("1", 1, "a", "b"))).To(Equal(hash(snapshot("1", 1, "b", "a"))))
+	})
+
+	It("hashes snapshots with different resources differently", func() {
+		Expect(hash(snapshot("1", 1, "a", "b"))).NotTo(Equal(hash(snapshot("1", 2, "a", "b"))))
+		Expect(hash(snapshot("1", 1, "a", "b"))).NotTo(Equal(hash(snapshot("1", 1, "a"))))
+	})
+
+	It("hashes snapshots with different versions differently", func() {
+		Expect(hash(snapshot("1", 1, "a"))).NotTo(Equal(hash(snapshot("2", 1, "a"))))
+	})
+})
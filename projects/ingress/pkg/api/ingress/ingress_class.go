@@ -0,0 +1,45 @@
+package ingress
+
+import (
+	"encoding/json"
+
+	"github.com/solo-io/solo-kit/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	ingressClassResource = "ingressclasses"
+
+	// IsDefaultIngressClassAnnotation marks the IngressClass of the ingresses that do not specify one
+	IsDefaultIngressClassAnnotation = "ingressclass.kubernetes.io/is-default-class"
+)
+
+// IngressClassLister lists the IngressClasses of the cluster
+type IngressClassLister interface {
+	List() ([]IngressClass, error)
+}
+
+type ingressClassLister struct {
+	kube kubernetes.Interface
+}
+
+func NewIngressClassLister(kube kubernetes.Interface) IngressClassLister {
+	return &ingressClassLister{kube: kube}
+}
+
+func (l *ingressClassLister) List() ([]IngressClass, error) {
+	raw, err := l.kube.NetworkingV1beta1().RESTClient().Get().Resource(ingressClassResource).DoRaw()
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// clusters older than Kubernetes 1.18 have no IngressClasses
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "listing ingress classes")
+	}
+	var list IngressClassList
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return nil, errors.Wrapf(err, "unmarshalling ingress classes")
+	}
+	return list.Items, nil
+}
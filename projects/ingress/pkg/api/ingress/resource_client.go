@@ -18,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	kubewatch "k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 )
 
 const typeUrl = "k8s.io/extensions.v1beta1/Ingress"
@@ -92,6 +93,65 @@ func ToKube(resource resources.Resource) (*v1beta1.Ingress, error) {
 	return &ingress, nil
 }
 
+// FromIngress is like FromKube, but keeps the fields of the ingress that the kube client drops
+func FromIngress(ingress *Ingress) (*v1.Ingress, error) {
+	rawSpec, err := json.Marshal(ingress.Spec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshalling kube ingress object")
+	}
+	rawStatus, err := json.Marshal(ingress.Status)
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshalling kube ingress object")
+	}
+
+	resource := &v1.Ingress{
+		KubeIngressSpec: &types.Any{
+			TypeUrl: typeUrl,
+			Value:   rawSpec,
+		},
+		KubeIngressStatus: &types.Any{
+			TypeUrl: typeUrl,
+			Value:   rawStatus,
+		},
+	}
+
+	resource.SetMetadata(kubeutils.FromKubeMeta(ingress.ObjectMeta))
+
+	return resource, nil
+}
+
+// ToIngress is like ToKube, but keeps the fields of the ingress that the kube client drops
+func ToIngress(resource resources.Resource) (*Ingress, error) {
+	ingResource, ok := resource.(*v1.Ingress)
+	if !ok {
+		return nil, errors.Errorf("internal error: invalid resource %v passed to ingress-only client", resources.Kind(resource))
+	}
+	if ingResource.KubeIngressSpec == nil {
+		return nil, errors.Errorf("internal error: %v ingress spec cannot be nil", ingResource.GetMetadata().Ref())
+	}
+	ingress := Ingress{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: apiVersion,
+			Kind:       kind,
+		},
+	}
+	if err := json.Unmarshal(ingResource.KubeIngressSpec.Value, &ingress.Spec); err != nil {
+		return nil, errors.Wrapf(err, "unmarshalling kube ingress spec data")
+	}
+	if ingResource.KubeIngressStatus != nil {
+		if err := json.Unmarshal(ingResource.KubeIngressStatus.Value, &ingress.Status); err != nil {
+			return nil, errors.Wrapf(err, "unmarshalling kube ingress status data")
+		}
+	}
+
+	meta := kubeutils.ToKubeMeta(resource.GetMetadata())
+	if meta.Annotations == nil {
+		meta.Annotations = make(map[string]string)
+	}
+	ingress.ObjectMeta = meta
+	return &ingress, nil
+}
+
 var _ clients.ResourceClient = &ResourceClient{}
 
 func (rc *ResourceClient) Kind() string {
@@ -113,14 +173,14 @@ func (rc *ResourceClient) Read(namespace, name string, opts clients.ReadOpts) (r
 	opts = opts.WithDefaults()
 	namespace = clients.DefaultNamespaceIfEmpty(namespace)
 
-	ingressObj, err := rc.kube.ExtensionsV1beta1().Ingresses(namespace).Get(name, metav1.GetOptions{})
-	if err != nil {
+	var ingressObj Ingress
+	if err := rc.do(rc.restClient().Get().Namespace(namespace).Resource(ingressResource).Name(name), nil, &ingressObj); err != nil {
 		if apierrors.IsNotFound(err) {
 			return nil, errors.NewNotExistErr(namespace, name, err)
 		}
 		return nil, errors.Wrapf(err, "reading ingressObj from kubernetes")
 	}
-	resource, err := FromKube(ingressObj)
+	resource, err := FromIngress(&ingressObj)
 	if err != nil {
 		return nil, err
 	}
@@ -152,7 +212,7 @@ func (rc *ResourceClient) write(resource resources.Resource, opts clients.WriteO
 	// mutate and return clone
 	clone := resources.Clone(resource)
 	clone.SetMetadata(meta)
-	ingressObj, err := ToKube(resource)
+	ingressObj, err := ToIngress(resource)
 	if err != nil {
 		return nil, err
 	}
@@ -167,11 +227,11 @@ func (rc *ResourceClient) write(resource resources.Resource, opts clients.WriteO
 		if meta.ResourceVersion != original.GetMetadata().ResourceVersion {
 			return nil, errors.NewResourceVersionErr(meta.Namespace, meta.Name, meta.ResourceVersion, original.GetMetadata().ResourceVersion)
 		}
-		if _, err := rc.kube.ExtensionsV1beta1().Ingresses(ingressObj.Namespace).Update(ingressObj); err != nil {
+		if err := rc.do(rc.restClient().Put().Namespace(ingressObj.Namespace).Resource(ingressResource).Name(ingressObj.Name), ingressObj, nil); err != nil {
 			return nil, errors.Wrapf(err, "updating kube ingressObj %v", ingressObj.Name)
 		}
 	} else {
-		if _, err := rc.kube.ExtensionsV1beta1().Ingresses(ingressObj.Namespace).Create(ingressObj); err != nil {
+		if err := rc.do(rc.restClient().Post().Namespace(ingressObj.Namespace).Resource(ingressResource), ingressObj, nil); err != nil {
 			return nil, errors.Wrapf(err, "creating kube ingressObj %v", ingressObj.Name)
 		}
 	}
@@ -191,7 +251,7 @@ func (rc *ResourceClient) writeStatus(resource resources.Resource, opts clients.
 	// mutate and return clone
 	clone := resources.Clone(resource)
 	clone.SetMetadata(meta)
-	ingressObj, err := ToKube(resource)
+	ingressObj, err := ToIngress(resource)
 	if err != nil {
 		return nil, err
 	}
@@ -206,11 +266,11 @@ func (rc *ResourceClient) writeStatus(resource resources.Resource, opts clients.
 		if meta.ResourceVersion != original.GetMetadata().ResourceVersion {
 			return nil, errors.NewResourceVersionErr(meta.Namespace, meta.Name, meta.ResourceVersion, original.GetMetadata().ResourceVersion)
 		}
-		if _, err := rc.kube.ExtensionsV1beta1().Ingresses(ingressObj.Namespace).UpdateStatus(ingressObj); err != nil {
+		if err := rc.do(rc.restClient().Put().Namespace(ingressObj.Namespace).Resource(ingressResource).Name(ingressObj.Name).SubResource("status"), ingressObj, nil); err != nil {
 			return nil, errors.Wrapf(err, "updating kube ingressObj status %v", ingressObj.Name)
 		}
 	} else {
-		if _, err := rc.kube.ExtensionsV1beta1().Ingresses(ingressObj.Namespace).Create(ingressObj); err != nil {
+		if err := rc.do(rc.restClient().Post().Namespace(ingressObj.Namespace).Resource(ingressResource), ingressObj, nil); err != nil {
 			return nil, errors.Wrapf(err, "creating kube ingressObj status %v", ingressObj.Name)
 		}
 	}
@@ -237,15 +297,15 @@ func (rc *ResourceClient) Delete(namespace, name string, opts clients.DeleteOpts
 func (rc *ResourceClient) List(namespace string, opts clients.ListOpts) (resources.ResourceList, error) {
 	opts = opts.WithDefaults()
 
-	ingressObjList, err := rc.kube.ExtensionsV1beta1().Ingresses(namespace).List(metav1.ListOptions{
-		LabelSelector: labels.SelectorFromSet(opts.Selector).String(),
-	})
-	if err != nil {
+	var ingressObjList IngressList
+	if err := rc.do(rc.restClient().Get().Namespace(namespace).Resource(ingressResource).
+		Param("labelSelector", labels.SelectorFromSet(opts.Selector).String()), nil, &ingressObjList); err != nil {
 		return nil, errors.Wrapf(err, "listing ingressObjs in %v", namespace)
 	}
 	var resourceList resources.ResourceList
 	for _, ingressObj := range ingressObjList.Items {
-		resource, err := FromKube(&ingressObj)
+		ingressObj := ingressObj
+		resource, err := FromIngress(&ingressObj)
 		if err != nil {
 			return nil, err
 		}
@@ -314,3 +374,27 @@ func (rc *ResourceClient) exist(namespace, name string) bool {
 	_, err := rc.kube.ExtensionsV1beta1().Ingresses(namespace).Get(name, metav1.GetOptions{})
 	return err == nil
 }
+
+// the ingresses are read and written as json with the rest client of the kube client, rather than with its typed
+// client, to keep the fields it does not know about
+func (rc *ResourceClient) restClient() rest.Interface {
+	return rc.kube.ExtensionsV1beta1().RESTClient()
+}
+
+func (rc *ResourceClient) do(request *rest.Request, body, into interface{}) error {
+	if body != nil {
+		rawBody, err := json.Marshal(body)
+		if err != nil {
+			return errors.Wrapf(err, "marshalling kube ingress object")
+		}
+		request = request.SetHeader("Content-Type", "application/json").Body(rawBody)
+	}
+	raw, err := request.DoRaw()
+	if err != nil {
+		return err
+	}
+	if into == nil {
+		return nil
+	}
+	return json.Unmarshal(raw, into)
+}
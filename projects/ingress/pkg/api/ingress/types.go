@@ -0,0 +1,88 @@
+package ingress
+
+import (
+	"k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The types below mirror the extensions/v1beta1 Ingress and networking.k8s.io/v1beta1 IngressClass types of
+// Kubernetes 1.18. The kube client this project is built with predates them, and drops the `ingressClassName` and
+// `pathType` fields of the ingresses it reads.
+
+const (
+	apiVersion      = "extensions/v1beta1"
+	kind            = "Ingress"
+	ingressResource = "ingresses"
+)
+
+// PathType is the type of the path of an ingress rule, which determines how the path is matched
+type PathType string
+
+const (
+	// PathTypeExact matches the URL path exactly
+	PathTypeExact PathType = "Exact"
+	// PathTypePrefix matches the URL path by prefix, split into elements by `/`
+	PathTypePrefix PathType = "Prefix"
+	// PathTypeImplementationSpecific matches the URL path as a regex, like Gloo did before path types existed
+	PathTypeImplementationSpecific PathType = "ImplementationSpecific"
+)
+
+// Ingress is a kube ingress, with the fields added in Kubernetes 1.18
+type Ingress struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IngressSpec           `json:"spec,omitempty"`
+	Status v1beta1.IngressStatus `json:"status,omitempty"`
+}
+
+type IngressList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Ingress `json:"items"`
+}
+
+type IngressSpec struct {
+	// IngressClassName is the name of the IngressClass of the ingress, which replaces the deprecated
+	// `kubernetes.io/ingress.class` annotation
+	IngressClassName *string                 `json:"ingressClassName,omitempty"`
+	Backend          *v1beta1.IngressBackend `json:"backend,omitempty"`
+	TLS              []v1beta1.IngressTLS    `json:"tls,omitempty"`
+	Rules            []IngressRule           `json:"rules,omitempty"`
+}
+
+type IngressRule struct {
+	Host string                `json:"host,omitempty"`
+	HTTP *HTTPIngressRuleValue `json:"http,omitempty"`
+}
+
+type HTTPIngressRuleValue struct {
+	Paths []HTTPIngressPath `json:"paths"`
+}
+
+type HTTPIngressPath struct {
+	Path     string                 `json:"path,omitempty"`
+	PathType *PathType              `json:"pathType,omitempty"`
+	Backend  v1beta1.IngressBackend `json:"backend"`
+}
+
+// IngressClass is a networking.k8s.io/v1beta1 IngressClass
+type IngressClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec IngressClassSpec `json:"spec,omitempty"`
+}
+
+type IngressClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []IngressClass `json:"items"`
+}
+
+type IngressClassSpec struct {
+	// Controller is the name of the controller that implements the class
+	Controller string `json:"controller,omitempty"`
+}
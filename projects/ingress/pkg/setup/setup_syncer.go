@@ -195,8 +195,10 @@ func RunIngress(opts Opts) error {
 		baseKubeServiceClient := service.NewResourceClient(kube, &v1.KubeService{})
 		kubeServiceClient := v1.NewKubeServiceClientWithBase(baseKubeServiceClient)
 
+		ingressClassLister := ingress.NewIngressClassLister(kube)
+
 		translatorEmitter := v1.NewTranslatorEmitter(upstreamClient, kubeServiceClient, ingressClient)
-		translatorSync := translator.NewSyncer(opts.WriteNamespace, proxyClient, ingressClient, writeErrs, opts.RequireIngressClass, opts.CustomIngressClass)
+		translatorSync := translator.NewSyncer(opts.WriteNamespace, proxyClient, upstreamClient, ingressClient, writeErrs, opts.RequireIngressClass, opts.CustomIngressClass, ingressClassLister)
 		translatorEventLoop := v1.NewTranslatorEventLoop(translatorEmitter, translatorSync)
 		translatorEventLoopErrs, err := translatorEventLoop.Run(opts.WatchNamespaces, opts.WatchOpts)
 		if err != nil {
@@ -210,7 +212,7 @@ func RunIngress(opts Opts) error {
 			"gloo": opts.IngressProxyLabel,
 		})
 		statusEmitter := v1.NewStatusEmitter(ingressServiceClient, ingressClient)
-		statusSync := status.NewSyncer(ingressClient)
+		statusSync := status.NewSyncer(ingressClient, opts.RequireIngressClass, opts.CustomIngressClass, ingressClassLister)
 		statusEventLoop := v1.NewStatusEventLoop(statusEmitter, statusSync)
 		statusEventLoopErrs, err := statusEventLoop.Run(opts.WatchNamespaces, opts.WatchOpts)
 		if err != nil {
@@ -3,6 +3,7 @@ package status
 import (
 	"context"
 	"net"
+	"reflect"
 	"sort"
 
 	"github.com/solo-io/gloo/pkg/utils/syncutil"
@@ -13,6 +14,7 @@ import (
 	errors "github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/ingress"
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/service"
+	"github.com/solo-io/gloo/projects/ingress/pkg/translator"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	kubev1 "k8s.io/api/core/v1"
 
@@ -22,12 +24,20 @@ import (
 )
 
 type statusSyncer struct {
-	ingressClient v1.IngressClient
+	ingressClient       v1.IngressClient
+	requireIngressClass bool
+	customIngressClass  string
+	ingressClassLister  ingress.IngressClassLister
 }
 
-func NewSyncer(ingressClient v1.IngressClient) v1.StatusSyncer {
+// NewSyncer returns a syncer that writes the addresses of the ingress proxy service to the load balancer status of
+// the ingresses that Gloo serves, e.g. for external-dns
+func NewSyncer(ingressClient v1.IngressClient, requireIngressClass bool, customIngressClass string, ingressClassLister ingress.IngressClassLister) v1.StatusSyncer {
 	return &statusSyncer{
-		ingressClient: ingressClient,
+		ingressClient:       ingressClient,
+		requireIngressClass: requireIngressClass,
+		customIngressClass:  customIngressClass,
+		ingressClassLister:  ingressClassLister,
 	}
 }
 
@@ -52,14 +62,29 @@ func (s *statusSyncer) Sync(ctx context.Context, snap *v1.StatusSnapshot) error
 		return err
 	}
 
+	var ingressClasses []ingress.IngressClass
+	if s.requireIngressClass && s.ingressClassLister != nil {
+		ingressClasses, err = s.ingressClassLister.List()
+		if err != nil {
+			logger.Warnf("failed to list ingress classes: %v", err)
+		}
+	}
+
 	for _, ing := range snap.Ingresses {
-		kubeIngress, err := ingress.ToKube(ing)
+		kubeIngress, err := ingress.ToIngress(ing)
 		if err != nil {
 			return errors.Wrapf(err, "internal error: converting proto ingress to kube ingress")
 		}
-		kubeIngress.Status.LoadBalancer.Ingress = lbStatus
+		if !s.requireIngressClass || translator.IsOurIngress(kubeIngress, s.customIngressClass, ingressClasses) {
+			kubeIngress.Status.LoadBalancer.Ingress = lbStatus
+		} else if len(lbStatus) > 0 && reflect.DeepEqual(kubeIngress.Status.LoadBalancer.Ingress, lbStatus) {
+			// the ingress is not ours anymore, e.g. because its class changed
+			kubeIngress.Status.LoadBalancer.Ingress = nil
+		} else {
+			continue
+		}
 
-		updatedIngress, err := ingress.FromKube(kubeIngress)
+		updatedIngress, err := ingress.FromIngress(kubeIngress)
 		if err != nil {
 			return errors.Wrapf(err, "internal error: converting back to proto ingress from kube ingress")
 		}
@@ -15,6 +15,8 @@ import (
 	"github.com/solo-io/gloo/projects/ingress/pkg/status"
 	"github.com/solo-io/go-utils/kubeutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
 	"github.com/solo-io/solo-kit/test/helpers"
 	"github.com/solo-io/solo-kit/test/setup"
 	kubev1 "k8s.io/api/core/v1"
@@ -66,7 +68,7 @@ var _ = Describe("StatusSyncer", func() {
 			"gloo": "ingress-proxy",
 		})
 		statusEmitter := v1.NewStatusEmitter(kubeServiceClient, ingressClient)
-		statusSync := status.NewSyncer(ingressClient)
+		statusSync := status.NewSyncer(ingressClient, false, "", nil)
 		statusEventLoop := v1.NewStatusEventLoop(statusEmitter, statusSync)
 		statusEventLoopErrs, err := statusEventLoop.Run([]string{namespace}, clients.WatchOpts{Ctx: context.TODO()})
 		Expect(err).NotTo(HaveOccurred())
@@ -188,3 +190,86 @@ var _ = Describe("StatusSyncer", func() {
 		}, time.Second*10).Should(Equal(svc.Status.LoadBalancer.Ingress))
 	})
 })
+
+var _ = Describe("StatusSyncer Sync", func() {
+	var (
+		ctx           context.Context
+		ingressClient v1.IngressClient
+		proxyService  *v1.KubeService
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		ingressClient, err = v1.NewIngressClient(&factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		proxyService, err = service.FromKube(&kubev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "ingress-proxy", Namespace: "gloo-system"},
+			Spec:       kubev1.ServiceSpec{Type: kubev1.ServiceTypeLoadBalancer},
+			Status: kubev1.ServiceStatus{
+				LoadBalancer: kubev1.LoadBalancerStatus{
+					Ingress: []kubev1.LoadBalancerIngress{{IP: "1.2.3.4"}, {Hostname: "proxy.example.com"}},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	writeIngress := func(name, class string, lbStatus ...kubev1.LoadBalancerIngress) *v1.Ingress {
+		ing, err := ingress.FromIngress(&ingress.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "default",
+				Annotations: map[string]string{translator.IngressClassKey: class},
+			},
+			Status: v1beta1.IngressStatus{
+				LoadBalancer: kubev1.LoadBalancerStatus{Ingress: lbStatus},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		ing, err = ingressClient.Write(ing, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		return ing
+	}
+	lbStatusOf := func(name string) []kubev1.LoadBalancerIngress {
+		ing, err := ingressClient.Read("default", name, clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		kubeIng, err := ingress.ToIngress(ing)
+		Expect(err).NotTo(HaveOccurred())
+		return kubeIng.Status.LoadBalancer.Ingress
+	}
+
+	It("writes the addresses of the proxy service to the ingresses of gloo only", func() {
+		ours := writeIngress("ours", "gloo")
+		theirs := writeIngress("theirs", "nginx", kubev1.LoadBalancerIngress{IP: "5.6.7.8"})
+		previouslyOurs := writeIngress("previously-ours", "nginx", kubev1.LoadBalancerIngress{Hostname: "proxy.example.com"}, kubev1.LoadBalancerIngress{IP: "1.2.3.4"})
+
+		syncer := status.NewSyncer(ingressClient, true, "", nil)
+		err := syncer.Sync(ctx, &v1.StatusSnapshot{
+			Services:  v1.KubeServiceList{proxyService},
+			Ingresses: v1.IngressList{ours, theirs, previouslyOurs},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		proxyAddresses := []kubev1.LoadBalancerIngress{{Hostname: "proxy.example.com"}, {IP: "1.2.3.4"}}
+		Expect(lbStatusOf("ours")).To(Equal(proxyAddresses))
+		Expect(lbStatusOf("theirs")).To(Equal([]kubev1.LoadBalancerIngress{{IP: "5.6.7.8"}}))
+		Expect(lbStatusOf("previously-ours")).To(BeEmpty())
+	})
+
+	It("writes the addresses of the proxy service to all the ingresses when the ingress class is not required", func() {
+		ing := writeIngress("ing", "nginx")
+
+		syncer := status.NewSyncer(ingressClient, false, "", nil)
+		err := syncer.Sync(ctx, &v1.StatusSnapshot{
+			Services:  v1.KubeServiceList{proxyService},
+			Ingresses: v1.IngressList{ing},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(lbStatusOf("ing")).To(HaveLen(2))
+	})
+})
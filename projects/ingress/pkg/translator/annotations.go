@@ -0,0 +1,162 @@
+package translator
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	errors "github.com/rotisserie/eris"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
+	"github.com/solo-io/gloo/projects/ingress/pkg/api/ingress"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// The annotations of ingresses that set the options of all their routes
+const (
+	// the timeout of the requests, e.g. `15s`
+	TimeoutAnnotation = "gloo.solo.io/timeout"
+	// the number of times the requests are retried
+	RetriesAnnotation = "gloo.solo.io/retries"
+	// the conditions under which the requests are retried, as documented by Envoy. Defaults to `5xx`
+	RetryOnAnnotation = "gloo.solo.io/retry-on"
+	// the timeout of each attempt of the retried requests, e.g. `5s`
+	PerTryTimeoutAnnotation = "gloo.solo.io/per-try-timeout"
+	// the path prefix that replaces the path of the rule in the requests, e.g. `/`
+	PrefixRewriteAnnotation = "gloo.solo.io/prefix-rewrite"
+	// the protocol that is used to connect to the backends, `http1`, `http2` or `grpc`
+	UpstreamProtocolAnnotation = "gloo.solo.io/upstream-protocol"
+)
+
+const defaultRetryOn = "5xx"
+
+const (
+	upstreamProtocolHttp1 = "http1"
+	upstreamProtocolHttp2 = "http2"
+	upstreamProtocolGrpc  = "grpc"
+)
+
+var (
+	InvalidAnnotationErr = func(annotation, value string, err error) error {
+		return errors.Wrapf(err, "invalid value %q of annotation %v", value, annotation)
+	}
+	UnknownUpstreamProtocolErr = errors.Errorf("expected one of %v, %v or %v", upstreamProtocolHttp1, upstreamProtocolHttp2, upstreamProtocolGrpc)
+)
+
+// routeOptions returns the options of the routes of an ingress, from its annotations.
+// The annotations with invalid values are returned as errors, and ignored.
+func routeOptions(annotations map[string]string) (*gloov1.RouteOptions, []error) {
+	var (
+		options gloov1.RouteOptions
+		errs    []error
+	)
+
+	parseDuration := func(annotation string) *time.Duration {
+		value, ok := annotations[annotation]
+		if !ok {
+			return nil
+		}
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			errs = append(errs, InvalidAnnotationErr(annotation, value, err))
+			return nil
+		}
+		return &duration
+	}
+
+	options.Timeout = parseDuration(TimeoutAnnotation)
+
+	if value, ok := annotations[RetriesAnnotation]; ok {
+		numRetries, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			errs = append(errs, InvalidAnnotationErr(RetriesAnnotation, value, err))
+		} else {
+			retryOn := annotations[RetryOnAnnotation]
+			if retryOn == "" {
+				retryOn = defaultRetryOn
+			}
+			options.Retries = &retries.RetryPolicy{
+				RetryOn:       retryOn,
+				NumRetries:    uint32(numRetries),
+				PerTryTimeout: parseDuration(PerTryTimeoutAnnotation),
+			}
+		}
+	}
+
+	if value, ok := annotations[PrefixRewriteAnnotation]; ok {
+		options.PrefixRewrite = &types.StringValue{Value: value}
+	}
+
+	if proto.Equal(&options, &gloov1.RouteOptions{}) {
+		return nil, errs
+	}
+	return &options, errs
+}
+
+// rewriteForPath returns the options of the route of the path of a rule. The prefix rewrite replaces the whole path
+// of regex matchers, so the Prefix paths, which are matched with regexes, are rewritten with a regex instead.
+func rewriteForPath(options *gloov1.RouteOptions, path string, pathType *ingress.PathType) *gloov1.RouteOptions {
+	if options.GetPrefixRewrite() == nil || pathType == nil || *pathType != ingress.PathTypePrefix {
+		return options
+	}
+	prefix := strings.TrimRight(path, "/")
+	if prefix == "" {
+		// matched with a prefix matcher
+		return options
+	}
+	rewrite := options.GetPrefixRewrite().GetValue()
+	pattern := "^" + regexp.QuoteMeta(prefix)
+	if strings.HasSuffix(rewrite, "/") {
+		// avoid a double slash when the prefix is followed by one
+		pattern += "/?"
+	}
+	out := *options
+	out.PrefixRewrite = nil
+	out.RegexRewrite = &gloov1.RegexRewrite{
+		Pattern:      pattern,
+		Substitution: rewrite,
+	}
+	return &out
+}
+
+// upstreamProtocol returns whether the backends of the ingress use http2, from its annotation
+func upstreamProtocol(annotations map[string]string) (*bool, error) {
+	value, ok := annotations[UpstreamProtocolAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	var useHttp2 bool
+	switch strings.ToLower(value) {
+	case upstreamProtocolHttp1:
+		useHttp2 = false
+	case upstreamProtocolHttp2, upstreamProtocolGrpc:
+		useHttp2 = true
+	default:
+		return nil, InvalidAnnotationErr(UpstreamProtocolAnnotation, value, UnknownUpstreamProtocolErr)
+	}
+	return &useHttp2, nil
+}
+
+// upstreamWithProtocol returns the upstream, or a copy of the upstream with the protocol if it uses a different one.
+// The copies are owned by the ingress controller, and are written to the write namespace.
+func upstreamWithProtocol(upstream *gloov1.Upstream, useHttp2 bool, namespace string) *gloov1.Upstream {
+	if upstream.GetUseHttp2().GetValue() == useHttp2 {
+		return upstream
+	}
+	protocol := upstreamProtocolHttp1
+	if useHttp2 {
+		protocol = upstreamProtocolHttp2
+	}
+	out := proto.Clone(upstream).(*gloov1.Upstream)
+	out.Metadata = core.Metadata{
+		Name:      upstream.GetMetadata().Name + "-" + protocol,
+		Namespace: namespace,
+		Labels:    ownerLabels(),
+	}
+	out.Status = core.Status{}
+	out.UseHttp2 = &types.BoolValue{Value: useHttp2}
+	return out
+}
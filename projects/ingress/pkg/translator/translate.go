@@ -2,7 +2,9 @@ package translator
 
 import (
 	"context"
+	"regexp"
 	"sort"
+	"strings"
 
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/service"
 	"github.com/solo-io/go-utils/contextutils"
@@ -26,15 +28,20 @@ const defaultIngressClass = "gloo"
 
 const IngressClassKey = "kubernetes.io/ingress.class"
 
-func translateProxy(ctx context.Context, namespace string, snap *v1.TranslatorSnapshot, requireIngressClass bool, ingressClass string) *gloov1.Proxy {
+// IngressController is the controller of the IngressClasses of the ingresses that Gloo serves
+const IngressController = "gloo.solo.io/ingress"
+
+// translateProxy returns the proxy of the ingresses, and the copies of the upstreams with the protocol of the
+// ingresses that set a different one
+func translateProxy(ctx context.Context, namespace string, snap *v1.TranslatorSnapshot, requireIngressClass bool, ingressClass string, ingressClasses []ingress.IngressClass) (*gloov1.Proxy, gloov1.UpstreamList) {
 
 	if ingressClass == "" {
 		ingressClass = defaultIngressClass
 	}
 
-	var ingresses []*v1beta1.Ingress
+	var ingresses []*ingress.Ingress
 	for _, ig := range snap.Ingresses {
-		kubeIngress, err := ingress.ToKube(ig)
+		kubeIngress, err := ingress.ToIngress(ig)
 		if err != nil {
 			contextutils.LoggerFrom(ctx).Errorf("internal error: parsing internal ingress representation: %v", err)
 			continue
@@ -54,7 +61,7 @@ func translateProxy(ctx context.Context, namespace string, snap *v1.TranslatorSn
 
 	upstreams := snap.Upstreams
 
-	virtualHostsHttp, secureVirtualHosts := virtualHosts(ctx, ingresses, upstreams, services, requireIngressClass, ingressClass)
+	virtualHostsHttp, secureVirtualHosts, protocolUpstreams := virtualHosts(ctx, namespace, ingresses, upstreams, services, requireIngressClass, ingressClass, ingressClasses)
 
 	var virtualHostsHttps []*gloov1.VirtualHost
 	var sslConfigs []*gloov1.SslConfig
@@ -100,7 +107,7 @@ func translateProxy(ctx context.Context, namespace string, snap *v1.TranslatorSn
 			Namespace: namespace,
 		},
 		Listeners: listeners,
-	}
+	}, protocolUpstreams
 }
 
 func upstreamForBackend(upstreams gloov1.UpstreamList, services []*kubev1.Service, ingressNamespace string, backend v1beta1.IngressBackend) (*gloov1.Upstream, error) {
@@ -113,6 +120,9 @@ func upstreamForBackend(upstreams gloov1.UpstreamList, services []*kubev1.Servic
 	// longer selectors represent subsets of pods for a service
 	var matchingUpstream *gloov1.Upstream
 	for _, us := range upstreams {
+		if isOwnedUpstream(us) {
+			continue
+		}
 		switch spec := us.UpstreamType.(type) {
 		case *gloov1.Upstream_Kube:
 			if spec.Kube.ServiceNamespace == ingressNamespace &&
@@ -158,15 +168,24 @@ type secureVirtualHost struct {
 	secret core.ResourceRef
 }
 
-func virtualHosts(ctx context.Context, ingresses []*v1beta1.Ingress, upstreams gloov1.UpstreamList, services []*kubev1.Service, requireIngressClass bool, ingressClass string) ([]*gloov1.VirtualHost, []secureVirtualHost) {
+func virtualHosts(ctx context.Context, namespace string, ingresses []*ingress.Ingress, upstreams gloov1.UpstreamList, services []*kubev1.Service, requireIngressClass bool, ingressClass string, ingressClasses []ingress.IngressClass) ([]*gloov1.VirtualHost, []secureVirtualHost, gloov1.UpstreamList) {
 	routesByHostHttp := make(map[string][]*gloov1.Route)
 	routesByHostHttps := make(map[string][]*gloov1.Route)
 	secretsByHost := make(map[string]*core.ResourceRef)
+	protocolUpstreams := make(map[core.ResourceRef]*gloov1.Upstream)
 	var defaultBackend *v1beta1.IngressBackend
 	for _, ing := range ingresses {
-		if requireIngressClass && !isOurIngress(ing, ingressClass) {
+		if requireIngressClass && !IsOurIngress(ing, ingressClass, ingressClasses) {
 			continue
 		}
+		options, errs := routeOptions(ing.Annotations)
+		for _, err := range errs {
+			contextutils.LoggerFrom(ctx).Warnf("ignoring annotation of ingress %v: %v", ing.Name, err)
+		}
+		useHttp2, err := upstreamProtocol(ing.Annotations)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Warnf("ignoring annotation of ingress %v: %v", ing.Name, err)
+		}
 		spec := ing.Spec
 		if spec.Backend != nil {
 			if defaultBackend != nil {
@@ -208,17 +227,16 @@ func virtualHosts(ctx context.Context, ingresses []*v1beta1.Ingress, upstreams g
 					contextutils.LoggerFrom(ctx).Errorf("lookup upstream for ingress %v: %v", ing.Name, err)
 					continue
 				}
-
-				pathRegex := route.Path
-				if pathRegex == "" {
-					pathRegex = ".*"
+				if useHttp2 != nil {
+					if protocolUpstream := upstreamWithProtocol(upstream, *useHttp2, namespace); protocolUpstream != upstream {
+						upstream = protocolUpstream
+						protocolUpstreams[upstream.Metadata.Ref()] = upstream
+					}
 				}
+
 				route := &gloov1.Route{
-					Matchers: []*matchers.Matcher{{
-						PathSpecifier: &matchers.Matcher_Regex{
-							Regex: pathRegex,
-						},
-					}},
+					Matchers: []*matchers.Matcher{pathMatcher(route.Path, route.PathType)},
+					Options:  rewriteForPath(options, route.Path, route.PathType),
 					Action: &gloov1.Route_RouteAction{
 						RouteAction: &gloov1.RouteAction{
 							Destination: &gloov1.RouteAction_Single{
@@ -231,7 +249,7 @@ func virtualHosts(ctx context.Context, ingresses []*v1beta1.Ingress, upstreams g
 						},
 					},
 				}
-				if _, useTls := secretsByHost[host]; useTls {
+				if secretForHost(secretsByHost, host) != nil {
 					routesByHostHttps[host] = append(routesByHostHttps[host], route)
 				} else {
 					routesByHostHttp[host] = append(routesByHostHttp[host], route)
@@ -254,8 +272,8 @@ func virtualHosts(ctx context.Context, ingresses []*v1beta1.Ingress, upstreams g
 
 	for host, routes := range routesByHostHttps {
 		glooutils.SortRoutesByPath(routes)
-		secret, ok := secretsByHost[host]
-		if !ok {
+		secret := secretForHost(secretsByHost, host)
+		if secret == nil {
 			contextutils.LoggerFrom(ctx).Errorf("internal error: secret not found for host %v after processing ingresses", host)
 			continue
 		}
@@ -275,9 +293,92 @@ func virtualHosts(ctx context.Context, ingresses []*v1beta1.Ingress, upstreams g
 	sort.SliceStable(virtualHostsHttps, func(i, j int) bool {
 		return virtualHostsHttps[i].vh.Name < virtualHostsHttps[j].vh.Name
 	})
-	return virtualHostsHttp, virtualHostsHttps
+
+	var upstreamList gloov1.UpstreamList
+	for _, us := range protocolUpstreams {
+		upstreamList = append(upstreamList, us)
+	}
+	return virtualHostsHttp, virtualHostsHttps, upstreamList.Sort()
 }
 
-func isOurIngress(ingress *v1beta1.Ingress, ingressClassToUse string) bool {
-	return ingress.Annotations[IngressClassKey] == ingressClassToUse
+// IsOurIngress returns true if the ingress has the ingress class of Gloo. The deprecated annotation takes precedence
+// over the IngressClass named by the ingress, and the ingresses that specify neither have the default IngressClass.
+func IsOurIngress(ing *ingress.Ingress, ingressClassToUse string, ingressClasses []ingress.IngressClass) bool {
+	if ingressClassToUse == "" {
+		ingressClassToUse = defaultIngressClass
+	}
+	if class, ok := ing.Annotations[IngressClassKey]; ok {
+		return class == ingressClassToUse
+	}
+	if className := ing.Spec.IngressClassName; className != nil {
+		for _, class := range ingressClasses {
+			if class.Name == *className {
+				return class.Spec.Controller == IngressController
+			}
+		}
+		// the IngressClass does not exist, e.g. on clusters older than Kubernetes 1.18
+		return *className == ingressClassToUse
+	}
+	for _, class := range ingressClasses {
+		if class.Annotations[ingress.IsDefaultIngressClassAnnotation] == "true" && class.Spec.Controller == IngressController {
+			return true
+		}
+	}
+	return false
+}
+
+// pathMatcher returns the matcher of the path of an ingress rule. The paths without a path type, or with the
+// ImplementationSpecific one, are regexes.
+func pathMatcher(path string, pathType *ingress.PathType) *matchers.Matcher {
+	var typ ingress.PathType
+	if pathType != nil {
+		typ = *pathType
+	}
+	switch typ {
+	case ingress.PathTypeExact:
+		if path == "" {
+			path = "/"
+		}
+		return &matchers.Matcher{
+			PathSpecifier: &matchers.Matcher_Exact{
+				Exact: path,
+			},
+		}
+	case ingress.PathTypePrefix:
+		// prefixes match whole elements of the path: /foo matches /foo and /foo/bar, but not /foobar
+		trimmed := strings.TrimRight(path, "/")
+		if trimmed == "" {
+			return &matchers.Matcher{
+				PathSpecifier: &matchers.Matcher_Prefix{
+					Prefix: "/",
+				},
+			}
+		}
+		return &matchers.Matcher{
+			PathSpecifier: &matchers.Matcher_Regex{
+				Regex: regexp.QuoteMeta(trimmed) + "(/.*)?",
+			},
+		}
+	}
+	if path == "" {
+		path = ".*"
+	}
+	return &matchers.Matcher{
+		PathSpecifier: &matchers.Matcher_Regex{
+			Regex: path,
+		},
+	}
+}
+
+// secretForHost returns the TLS secret of the host, or else the one of the wildcard host that matches it.
+// Like in Kubernetes, a wildcard only matches a single DNS label: *.foo.com matches bar.foo.com, but not foo.com or
+// baz.bar.foo.com.
+func secretForHost(secretsByHost map[string]*core.ResourceRef, host string) *core.ResourceRef {
+	if secret, ok := secretsByHost[host]; ok {
+		return secret
+	}
+	if i := strings.Index(host, "."); i > 0 && !strings.HasPrefix(host, "*") {
+		return secretsByHost["*"+host[i:]]
+	}
+	return nil
 }
@@ -2,12 +2,16 @@ package translator
 
 import (
 	"context"
+	"time"
+
+	"github.com/gogo/protobuf/types"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 	ingresstype "github.com/solo-io/gloo/projects/ingress/pkg/api/ingress"
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/service"
 	v1 "github.com/solo-io/gloo/projects/ingress/pkg/api/v1"
@@ -186,7 +190,7 @@ var _ = Describe("Translate", func() {
 				Ingresses: v1.IngressList{ingressRes, ingressResTls, ingressResTls2},
 				Upstreams: gloov1.UpstreamList{us, usSubset},
 			}
-			proxy := translateProxy(ctx, namespace, snap, requireIngressClass, "")
+			proxy, _ := translateProxy(ctx, namespace, snap, requireIngressClass, "", nil)
 
 			Expect(proxy.String()).To(Equal((&gloov1.Proxy{
 				Listeners: []*gloov1.Listener{
@@ -355,7 +359,7 @@ var _ = Describe("Translate", func() {
 			Upstreams: gloov1.UpstreamList{us1, us2},
 		}
 
-		proxy := translateProxy(ctx, "gloo-system", snap, false, "")
+		proxy, _ := translateProxy(ctx, "gloo-system", snap, false, "", nil)
 
 		Expect(proxy.Listeners).To(HaveLen(1))
 		Expect(proxy.Listeners[0].SslConfigurations).To(Equal([]*gloov1.SslConfig{
@@ -399,11 +403,11 @@ var _ = Describe("Translate", func() {
 		ing1 := makeIng("ing1", namespace, "", host1, "svc", port)
 		ing2 := makeIng("invalid-svc", namespace, "", "host2", "svc-that-doesnt-exist", port)
 
-		proxy := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+		proxy, _ := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
 			Upstreams: []*gloov1.Upstream{us},
 			Services:  []*v1.KubeService{svc},
 			Ingresses: []*v1.Ingress{ing1, ing2},
-		}, false, "")
+		}, false, "", nil)
 
 		Expect(proxy.Listeners).To(HaveLen(1))
 		vhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
@@ -428,11 +432,11 @@ var _ = Describe("Translate", func() {
 		ing1 := makeIng("ing1", namespace, customClass1, host1, "svc", port)
 		ing2 := makeIng("ing2", namespace, customClass2, "host2", "svc", port)
 
-		proxy := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+		proxy, _ := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
 			Upstreams: []*gloov1.Upstream{us},
 			Services:  []*v1.KubeService{svc},
 			Ingresses: []*v1.Ingress{ing1, ing2},
-		}, true, customClass1)
+		}, true, customClass1, nil)
 
 		Expect(proxy.Listeners).To(HaveLen(1))
 		vhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
@@ -451,19 +455,390 @@ var _ = Describe("Translate", func() {
 
 		ing1 := makeIng("ing1", namespace, "", "host", "svc", port)
 
-		proxy := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+		proxy, _ := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
 			Upstreams: []*gloov1.Upstream{us},
 			Services:  []*v1.KubeService{svc},
 			Ingresses: []*v1.Ingress{ing1},
-		}, false, "")
+		}, false, "", nil)
 
 		Expect(proxy.Listeners).To(HaveLen(1))
 		vhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
 		// successful translation
 		Expect(vhosts).To(HaveLen(1))
 	})
+	Context("ingress classes", func() {
+		var (
+			svc *v1.KubeService
+			us  *gloov1.Upstream
+		)
+		BeforeEach(func() {
+			svc = makeService("svc", "ns", "http", 8080)
+			us = makeUpstream("us", "ns", svc)
+		})
+
+		translatedHosts := func(ingressClasses []ingresstype.IngressClass, ingresses ...*v1.Ingress) []string {
+			proxy, _ := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+				Upstreams: []*gloov1.Upstream{us},
+				Services:  []*v1.KubeService{svc},
+				Ingresses: ingresses,
+			}, true, "", ingressClasses)
+			var hosts []string
+			for _, listener := range proxy.Listeners {
+				for _, vhost := range listener.GetHttpListener().GetVirtualHosts() {
+					hosts = append(hosts, vhost.Domains[0])
+				}
+			}
+			return hosts
+		}
+		ingressClass := func(name, controller string, isDefault bool) ingresstype.IngressClass {
+			class := ingresstype.IngressClass{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec:       ingresstype.IngressClassSpec{Controller: controller},
+			}
+			if isDefault {
+				class.Annotations = map[string]string{ingresstype.IsDefaultIngressClassAnnotation: "true"}
+			}
+			return class
+		}
+
+		It("translates the ingresses of an ingress class of the gloo controller", func() {
+			hosts := translatedHosts([]ingresstype.IngressClass{
+				ingressClass("external", IngressController, false),
+				ingressClass("nginx", "k8s.io/ingress-nginx", false),
+			},
+				makeIngFromYaml(ingressWithClassName("external", "host1")),
+				makeIngFromYaml(ingressWithClassName("nginx", "host2")),
+			)
+			Expect(hosts).To(Equal([]string{"host1"}))
+		})
+		It("matches the name of ingress classes that do not exist", func() {
+			hosts := translatedHosts(nil,
+				makeIngFromYaml(ingressWithClassName("gloo", "host1")),
+				makeIngFromYaml(ingressWithClassName("other", "host2")),
+			)
+			Expect(hosts).To(Equal([]string{"host1"}))
+		})
+		It("translates the ingresses without a class if the default ingress class is of the gloo controller", func() {
+			ing := makeIngFromYaml(ingressWithClassName("", "host1"))
+			Expect(translatedHosts([]ingresstype.IngressClass{ingressClass("gloo", IngressController, true)}, ing)).To(Equal([]string{"host1"}))
+			Expect(translatedHosts([]ingresstype.IngressClass{ingressClass("gloo", IngressController, false)}, ing)).To(BeEmpty())
+		})
+		It("gives precedence to the ingress class annotation", func() {
+			ing := makeIngFromYaml(`
+metadata:
+  name: ing
+  namespace: ns
+  annotations:
+    kubernetes.io/ingress.class: other
+spec:
+  ingressClassName: gloo
+  rules:
+  - host: host1
+    http:
+      paths:
+      - path: /
+        backend:
+          serviceName: svc
+          servicePort: 8080
+`)
+			Expect(translatedHosts(nil, ing)).To(BeEmpty())
+		})
+	})
+	It("translates path types", func() {
+		svc := makeService("svc", "ns", "http", 8080)
+		us := makeUpstream("us", "ns", svc)
+		ing := makeIngFromYaml(`
+metadata:
+  name: ing
+  namespace: ns
+spec:
+  rules:
+  - host: host
+    http:
+      paths:
+      - path: /exact
+        pathType: Exact
+        backend:
+          serviceName: svc
+          servicePort: 8080
+      - path: /prefix/
+        pathType: Prefix
+        backend:
+          serviceName: svc
+          servicePort: 8080
+      - path: /
+        pathType: Prefix
+        backend:
+          serviceName: svc
+          servicePort: 8080
+      - path: /regex/.*
+        pathType: ImplementationSpecific
+        backend:
+          serviceName: svc
+          servicePort: 8080
+`)
+		proxy, _ := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+			Upstreams: []*gloov1.Upstream{us},
+			Services:  []*v1.KubeService{svc},
+			Ingresses: []*v1.Ingress{ing},
+		}, false, "", nil)
+
+		Expect(proxy.Listeners).To(HaveLen(1))
+		vhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
+		Expect(vhosts).To(HaveLen(1))
+		var pathMatchers []*matchers.Matcher
+		for _, route := range vhosts[0].Routes {
+			pathMatchers = append(pathMatchers, route.Matchers...)
+		}
+		Expect(pathMatchers).To(ConsistOf(
+			&matchers.Matcher{PathSpecifier: &matchers.Matcher_Exact{Exact: "/exact"}},
+			&matchers.Matcher{PathSpecifier: &matchers.Matcher_Regex{Regex: "/prefix(/.*)?"}},
+			&matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"}},
+			&matchers.Matcher{PathSpecifier: &matchers.Matcher_Regex{Regex: "/regex/.*"}},
+		))
+	})
+	It("uses the TLS secret of wildcard hosts", func() {
+		svc := makeService("svc", "ns", "http", 8080)
+		us := makeUpstream("us", "ns", svc)
+		ing := makeIngFromYaml(`
+metadata:
+  name: ing
+  namespace: ns
+spec:
+  tls:
+  - hosts:
+    - '*.example.com'
+    secretName: wildcard
+  rules:
+  - host: foo.example.com
+    http:
+      paths:
+      - path: /
+        backend:
+          serviceName: svc
+          servicePort: 8080
+  - host: foo.bar.example.com
+    http:
+      paths:
+      - path: /
+        backend:
+          serviceName: svc
+          servicePort: 8080
+`)
+		proxy, _ := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+			Upstreams: []*gloov1.Upstream{us},
+			Services:  []*v1.KubeService{svc},
+			Ingresses: []*v1.Ingress{ing},
+		}, false, "", nil)
+
+		Expect(proxy.Listeners).To(HaveLen(2))
+		httpListener := proxy.Listeners[0]
+		Expect(httpListener.Name).To(Equal("http"))
+		Expect(httpListener.GetHttpListener().GetVirtualHosts()).To(HaveLen(1))
+		// a wildcard only matches a single DNS label
+		Expect(httpListener.GetHttpListener().GetVirtualHosts()[0].Domains[0]).To(Equal("foo.bar.example.com"))
+
+		httpsListener := proxy.Listeners[1]
+		Expect(httpsListener.Name).To(Equal("https"))
+		Expect(httpsListener.GetHttpListener().GetVirtualHosts()).To(HaveLen(1))
+		Expect(httpsListener.GetHttpListener().GetVirtualHosts()[0].Domains[0]).To(Equal("foo.example.com"))
+		Expect(httpsListener.SslConfigurations).To(Equal([]*gloov1.SslConfig{{
+			SslSecrets: &gloov1.SslConfig_SecretRef{
+				SecretRef: &core.ResourceRef{Name: "wildcard", Namespace: "ns"},
+			},
+			SniDomains: []string{"foo.example.com", "foo.example.com:443"},
+		}}))
+	})
 })
 
+var _ = Describe("Translate annotations", func() {
+	var (
+		ctx = context.Background()
+		svc *v1.KubeService
+		us  *gloov1.Upstream
+	)
+	BeforeEach(func() {
+		svc = makeService("svc", "ns", "http", 8080)
+		us = makeUpstream("us", "ns", svc)
+	})
+
+	translate := func(ingYaml string) (*gloov1.Proxy, gloov1.UpstreamList) {
+		return translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+			Upstreams: []*gloov1.Upstream{us},
+			Services:  []*v1.KubeService{svc},
+			Ingresses: []*v1.Ingress{makeIngFromYaml(ingYaml)},
+		}, false, "", nil)
+	}
+	routesOf := func(proxy *gloov1.Proxy) []*gloov1.Route {
+		Expect(proxy.Listeners).To(HaveLen(1))
+		vhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
+		Expect(vhosts).To(HaveLen(1))
+		return vhosts[0].Routes
+	}
+
+	It("sets the timeout, retries and prefix rewrite of the routes", func() {
+		proxy, upstreams := translate(`
+metadata:
+  name: ing
+  namespace: ns
+  annotations:
+    gloo.solo.io/timeout: 15s
+    gloo.solo.io/retries: "3"
+    gloo.solo.io/per-try-timeout: 5s
+    gloo.solo.io/prefix-rewrite: /
+spec:
+  rules:
+  - host: host
+    http:
+      paths:
+      - path: /api/.*
+        backend:
+          serviceName: svc
+          servicePort: 8080
+      - path: /app/
+        pathType: Prefix
+        backend:
+          serviceName: svc
+          servicePort: 8080
+`)
+		Expect(upstreams).To(BeEmpty())
+		timeout := 15 * time.Second
+		perTryTimeout := 5 * time.Second
+		retryPolicy := &retries.RetryPolicy{
+			RetryOn:       "5xx",
+			NumRetries:    3,
+			PerTryTimeout: &perTryTimeout,
+		}
+		routes := routesOf(proxy)
+		Expect(routes).To(HaveLen(2))
+		// the routes are sorted by the length of their path
+		Expect(routes[1].Matchers[0].GetRegex()).To(Equal("/api/.*"))
+		Expect(routes[1].Options).To(Equal(&gloov1.RouteOptions{
+			Timeout:       &timeout,
+			Retries:       retryPolicy,
+			PrefixRewrite: &types.StringValue{Value: "/"},
+		}))
+		Expect(routes[0].Matchers[0].GetRegex()).To(Equal("/app(/.*)?"))
+		Expect(routes[0].Options).To(Equal(&gloov1.RouteOptions{
+			Timeout: &timeout,
+			Retries: retryPolicy,
+			RegexRewrite: &gloov1.RegexRewrite{
+				Pattern:      "^/app/?",
+				Substitution: "/",
+			},
+		}))
+	})
+
+	It("ignores annotations with invalid values", func() {
+		proxy, _ := translate(`
+metadata:
+  name: ing
+  namespace: ns
+  annotations:
+    gloo.solo.io/timeout: fifteen
+    gloo.solo.io/retries: "2"
+    gloo.solo.io/retry-on: connect-failure
+    gloo.solo.io/upstream-protocol: carrier-pigeon
+spec:
+  rules:
+  - host: host
+    http:
+      paths:
+      - path: /
+        backend:
+          serviceName: svc
+          servicePort: 8080
+`)
+		routes := routesOf(proxy)
+		Expect(routes).To(HaveLen(1))
+		Expect(routes[0].Options).To(Equal(&gloov1.RouteOptions{
+			Retries: &retries.RetryPolicy{
+				RetryOn:    "connect-failure",
+				NumRetries: 2,
+			},
+		}))
+		Expect(routes[0].GetRouteAction().GetSingle().GetUpstream().Name).To(Equal("us"))
+	})
+
+	It("routes to a copy of the upstream with the upstream protocol", func() {
+		proxy, upstreams := translate(`
+metadata:
+  name: ing
+  namespace: ns
+  annotations:
+    gloo.solo.io/upstream-protocol: grpc
+spec:
+  rules:
+  - host: host
+    http:
+      paths:
+      - path: /
+        backend:
+          serviceName: svc
+          servicePort: 8080
+`)
+		Expect(upstreams).To(HaveLen(1))
+		Expect(upstreams[0].Metadata).To(Equal(core.Metadata{
+			Name:      "us-http2",
+			Namespace: "write-namespace",
+			Labels:    map[string]string{"created_by": "ingress"},
+		}))
+		Expect(upstreams[0].UseHttp2).To(Equal(&types.BoolValue{Value: true}))
+		Expect(upstreams[0].GetKube()).To(Equal(us.GetKube()))
+
+		routes := routesOf(proxy)
+		Expect(routes).To(HaveLen(1))
+		Expect(routes[0].GetRouteAction().GetSingle().GetUpstream()).To(Equal(&core.ResourceRef{
+			Name:      "us-http2",
+			Namespace: "write-namespace",
+		}))
+	})
+
+	It("does not route to the copies of upstreams without the annotation", func() {
+		copied := upstreamWithProtocol(us, true, "write-namespace")
+		proxy, upstreams := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+			Upstreams: []*gloov1.Upstream{us, copied},
+			Services:  []*v1.KubeService{svc},
+			Ingresses: []*v1.Ingress{makeIngFromYaml(ingressWithClassName("", "host"))},
+		}, false, "", nil)
+		Expect(upstreams).To(BeEmpty())
+		routes := routesOf(proxy)
+		Expect(routes[0].GetRouteAction().GetSingle().GetUpstream().Name).To(Equal("us"))
+	})
+})
+
+func ingressWithClassName(className, host string) string {
+	ing := `
+metadata:
+  name: ` + host + `
+  namespace: ns
+spec:
+  rules:
+  - host: ` + host + `
+    http:
+      paths:
+      - path: /
+        backend:
+          serviceName: svc
+          servicePort: 8080
+`
+	if className != "" {
+		ing += `
+  ingressClassName: ` + className + `
+`
+	}
+	return ing
+}
+
+func makeIngFromYaml(ingYaml string) *v1.Ingress {
+	var ing ingresstype.Ingress
+	err := yaml.Unmarshal([]byte(ingYaml), &ing)
+	Expect(err).NotTo(HaveOccurred())
+	ingType, err := ingresstype.FromIngress(&ing)
+	Expect(err).NotTo(HaveOccurred())
+	return ingType
+}
+
 func getFirstPort(svc *kubev1.Service) int32 {
 	return svc.Spec.Ports[0].Port
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/solo-io/gloo/projects/gateway/pkg/utils"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/ingress/pkg/api/ingress"
 	v1 "github.com/solo-io/gloo/projects/ingress/pkg/api/v1"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
@@ -20,23 +21,41 @@ type translatorSyncer struct {
 	proxyClient         gloov1.ProxyClient
 	ingressClient       v1.IngressClient
 	proxyReconciler     gloov1.ProxyReconciler
+	upstreamReconciler  gloov1.UpstreamReconciler
 	requireIngressClass bool
 
 	// support custom ingress class.
 	// only relevant when requireIngressClass is true.
 	// defaults to 'gloo'
 	customIngressClass string
+
+	// lists the IngressClasses that the ingresses refer to.
+	// only relevant when requireIngressClass is true.
+	ingressClassLister ingress.IngressClassLister
 }
 
-func NewSyncer(writeNamespace string, proxyClient gloov1.ProxyClient, ingressClient v1.IngressClient, writeErrs chan error, requireIngressClass bool, customIngressClass string) v1.TranslatorSyncer {
+// ownerLabels are the labels of the resources written by the ingress controller
+func ownerLabels() map[string]string {
+	return map[string]string{
+		"created_by": "ingress",
+	}
+}
+
+func isOwnedUpstream(us *gloov1.Upstream) bool {
+	return us.GetMetadata().Labels["created_by"] == ownerLabels()["created_by"]
+}
+
+func NewSyncer(writeNamespace string, proxyClient gloov1.ProxyClient, upstreamClient gloov1.UpstreamClient, ingressClient v1.IngressClient, writeErrs chan error, requireIngressClass bool, customIngressClass string, ingressClassLister ingress.IngressClassLister) v1.TranslatorSyncer {
 	return &translatorSyncer{
 		writeNamespace:      writeNamespace,
 		writeErrs:           writeErrs,
 		proxyClient:         proxyClient,
 		ingressClient:       ingressClient,
 		proxyReconciler:     gloov1.NewProxyReconciler(proxyClient),
+		upstreamReconciler:  gloov1.NewUpstreamReconciler(upstreamClient),
 		requireIngressClass: requireIngressClass,
 		customIngressClass:  customIngressClass,
+		ingressClassLister:  ingressClassLister,
 	}
 }
 
@@ -56,12 +75,20 @@ func (s *translatorSyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot
 		logger.Debug(syncutil.StringifySnapshot(snap))
 	}
 
-	proxy := translateProxy(ctx, s.writeNamespace, snap, s.requireIngressClass, s.customIngressClass)
-
-	labels := map[string]string{
-		"created_by": "ingress",
+	var ingressClasses []ingress.IngressClass
+	if s.requireIngressClass && s.ingressClassLister != nil {
+		var err error
+		ingressClasses, err = s.ingressClassLister.List()
+		if err != nil {
+			// the ingresses that use the annotation can still be translated
+			logger.Warnf("failed to list ingress classes: %v", err)
+		}
 	}
 
+	proxy, upstreams := translateProxy(ctx, s.writeNamespace, snap, s.requireIngressClass, s.customIngressClass, ingressClasses)
+
+	labels := ownerLabels()
+
 	var desiredResources gloov1.ProxyList
 	if proxy != nil {
 		logger.Infof("creating proxy %v", proxy.Metadata.Ref())
@@ -76,5 +103,13 @@ func (s *translatorSyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot
 		return err
 	}
 
+	// the copies of the upstreams with the protocol of the ingresses
+	if err := s.upstreamReconciler.Reconcile(s.writeNamespace, upstreams, nil, clients.ListOpts{
+		Ctx:      ctx,
+		Selector: labels,
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
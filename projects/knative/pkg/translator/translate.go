@@ -48,6 +48,9 @@ const (
 	sslAnnotationKeySecretNamespace = "gloo.networking.knative.dev/ssl.secret_namespace"
 )
 
+// the conditions under which the requests of the routes with retries are retried, like the other Knative ingresses
+const retryOn = "5xx,connect-failure,refused-stream"
+
 func sslConfigFromAnnotations(annotations map[string]string, namespace string) *gloov1.SslConfig {
 	secretName, ok := annotations[sslAnnotationKeySecretName]
 	if !ok {
@@ -83,6 +86,24 @@ func translateProxy(ctx context.Context, proxyName, proxyNamespace string, ingre
 	return TranslateProxyFromSpecs(ctx, proxyName, proxyNamespace, ingressSpecsByRef)
 }
 
+// publicRules returns copies of the ingresses with only the rules that are exposed outside of the cluster
+func publicRules(ingresses v1alpha1.IngressList) v1alpha1.IngressList {
+	var out v1alpha1.IngressList
+	for _, ing := range ingresses {
+		var rules []knativev1alpha1.IngressRule
+		for _, rule := range ing.Spec.Rules {
+			if rule.Visibility == knativev1alpha1.IngressVisibilityClusterLocal {
+				continue
+			}
+			rules = append(rules, rule)
+		}
+		public := ing.Clone().(*v1alpha1.Ingress)
+		public.Spec.Rules = rules
+		out = append(out, public)
+	}
+	return out
+}
+
 // made public to be shared with the (soon to be deprecated) clusteringress controller
 func TranslateProxyFromSpecs(ctx context.Context, proxyName, proxyNamespace string, ingresses map[*core.Metadata]knativev1alpha1.IngressSpec) (*gloov1.Proxy, error) {
 	virtualHostsHttp, virtualHostsHttps, sslConfigs, err := routingConfig(ctx, ingresses)
@@ -192,6 +213,7 @@ func routingConfig(ctx context.Context, ingresses map[*core.Metadata]knativev1al
 						perTryTimeout = &route.Retries.PerTryTimeout.Duration
 					}
 					retryPolicy = &retries.RetryPolicy{
+						RetryOn:       retryOn,
 						NumRetries:    uint32(route.Retries.Attempts),
 						PerTryTimeout: perTryTimeout,
 					}
@@ -262,16 +284,20 @@ func routeActionFromSplits(splits []knativev1alpha1.IngressBackendSplit) (*gloov
 
 	var destinations []*gloov1.WeightedDestination
 	for _, split := range splits {
+		weight := uint32(split.Percent)
+		if len(splits) == 1 {
+			weight = 100
+		}
+		if weight == 0 {
+			// the splits without traffic are left out, as Envoy does not accept clusters without weight
+			continue
+		}
 		var weightedDestinationPlugins *gloov1.WeightedDestinationOptions
 		if headerManipulaion := getHeaderManipulation(split.AppendHeaders); headerManipulaion != nil {
 			weightedDestinationPlugins = &gloov1.WeightedDestinationOptions{
 				HeaderManipulation: headerManipulaion,
 			}
 		}
-		weight := uint32(split.Percent)
-		if len(splits) == 1 {
-			weight = 100
-		}
 		destinations = append(destinations, &gloov1.WeightedDestination{
 			Destination: &gloov1.Destination{
 				DestinationType: serviceForSplit(split),
@@ -280,6 +306,9 @@ func routeActionFromSplits(splits []knativev1alpha1.IngressBackendSplit) (*gloov
 			Options: weightedDestinationPlugins,
 		})
 	}
+	if len(destinations) == 0 {
+		return nil, errors.Errorf("invalid cluster ingress: the percent of at least 1 split must be greater than 0")
+	}
 	return &gloov1.RouteAction{
 		Destination: &gloov1.RouteAction_Multi{
 			Multi: &gloov1.MultiDestination{
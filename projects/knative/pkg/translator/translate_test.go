@@ -211,6 +211,7 @@ var _ = Describe("Translate", func() {
 											Options: &gloov1.RouteOptions{
 												Timeout: durptr(1),
 												Retries: &retries.RetryPolicy{
+													RetryOn:       "5xx,connect-failure,refused-stream",
 													NumRetries:    0x0000000e,
 													PerTryTimeout: durptr(1000),
 												},
@@ -265,6 +266,7 @@ var _ = Describe("Translate", func() {
 											Options: &gloov1.RouteOptions{
 												Timeout: durptr(1),
 												Retries: &retries.RetryPolicy{
+													RetryOn:       "5xx,connect-failure,refused-stream",
 													NumRetries:    0x0000000e,
 													PerTryTimeout: durptr(1000),
 												},
@@ -328,6 +330,7 @@ var _ = Describe("Translate", func() {
 											Options: &gloov1.RouteOptions{
 												Timeout: durptr(1),
 												Retries: &retries.RetryPolicy{
+													RetryOn:       "5xx,connect-failure,refused-stream",
 													NumRetries:    0x0000000e,
 													PerTryTimeout: durptr(1000),
 												},
@@ -420,6 +423,55 @@ var _ = Describe("Translate", func() {
 		Expect(proxy.Listeners[0].SslConfigurations[0].SslSecrets).To(Equal(&gloov1.SslConfig_SecretRef{SecretRef: &core.ResourceRef{Name: secretName, Namespace: secretNamespace}}))
 		Expect(proxy.Listeners[0].SslConfigurations[0].SniDomains).To(Equal([]string{"domain.com", "domain.io"}))
 	})
+
+	It("weighs the destinations of the splits by their percent, leaving out the splits without traffic", func() {
+		split := func(name string, percent int) v1alpha1.IngressBackendSplit {
+			return v1alpha1.IngressBackendSplit{
+				IngressBackend: v1alpha1.IngressBackend{
+					ServiceName:      name,
+					ServiceNamespace: "ns",
+					ServicePort:      intstr.FromInt(80),
+				},
+				Percent:       percent,
+				AppendHeaders: map[string]string{"Knative-Serving-Revision": name},
+			}
+		}
+		action, err := routeActionFromSplits([]v1alpha1.IngressBackendSplit{split("a", 90), split("b", 10), split("c", 0)})
+		Expect(err).NotTo(HaveOccurred())
+		destinations := action.GetMulti().GetDestinations()
+		Expect(destinations).To(HaveLen(2))
+		Expect(destinations[0].Weight).To(Equal(uint32(90)))
+		Expect(destinations[0].Destination.GetKube().Ref.Name).To(Equal("a"))
+		Expect(destinations[0].Options.HeaderManipulation.RequestHeadersToAdd[0].GetHeader().Value).To(Equal("a"))
+		Expect(destinations[1].Weight).To(Equal(uint32(10)))
+		Expect(destinations[1].Destination.GetKube().Ref.Name).To(Equal("b"))
+
+		_, err = routeActionFromSplits([]v1alpha1.IngressBackendSplit{split("a", 0), split("b", 0)})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("leaves the cluster-local rules out of the public ingresses", func() {
+		rule := func(host string, visibility v1alpha1.IngressVisibility) v1alpha1.IngressRule {
+			return v1alpha1.IngressRule{Hosts: []string{host}, Visibility: visibility}
+		}
+		ingress := &v1alpha12.Ingress{Ingress: knative.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: "ing", Namespace: "example"},
+			Spec: v1alpha1.IngressSpec{
+				Rules: []v1alpha1.IngressRule{
+					rule("public.example.com", ""),
+					rule("svc.example.svc.cluster.local", v1alpha1.IngressVisibilityClusterLocal),
+					rule("external.example.com", v1alpha1.IngressVisibilityExternalIP),
+				},
+			},
+		}}
+		public := publicRules(v1alpha12.IngressList{ingress})
+		Expect(public).To(HaveLen(1))
+		Expect(public[0].Spec.Rules).To(Equal([]v1alpha1.IngressRule{
+			rule("public.example.com", ""),
+			rule("external.example.com", v1alpha1.IngressVisibilityExternalIP),
+		}))
+		Expect(ingress.Spec.Rules).To(HaveLen(3))
+	})
 })
 
 func durptr(d int) *time.Duration {
@@ -96,7 +96,8 @@ func (s *translatorSyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot
 		internalIngresses = append(internalIngresses, ing)
 	}
 
-	externalProxy, err := s.translateProxy(ctx, externalProxyName, s.writeNamespace, externalIngresses)
+	// the cluster-local rules of the public ingresses are only served by the internal proxy
+	externalProxy, err := s.translateProxy(ctx, externalProxyName, s.writeNamespace, publicRules(externalIngresses))
 	if err != nil {
 		logger.Warnf("snapshot %v was rejected due to invalid config: %v\n"+
 			"knative ingress externalProxy will not be updated.", snapHash, err)
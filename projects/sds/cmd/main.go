@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/solo-io/gloo/pkg/version"
 	"github.com/solo-io/gloo/projects/sds/pkg/run"
 	"github.com/solo-io/gloo/projects/sds/pkg/server"
+	"github.com/solo-io/gloo/projects/sds/pkg/vault"
 	"github.com/solo-io/go-utils/contextutils"
 
 	"github.com/avast/retry-go"
+	vaultapi "github.com/hashicorp/vault/api"
 	"github.com/kelseyhightower/envconfig"
 	"go.uber.org/zap"
 	v1 "k8s.io/api/core/v1"
@@ -37,6 +41,29 @@ type Config struct {
 	IstioCertDir           string `split_words:"true" default:"/etc/istio-certs/"`
 	IstioServerCert        string `split_words:"true" default:"istio_server_cert"`
 	IstioValidationContext string `split_words:"true" default:"istio_validation_context"`
+
+	// serves arbitrary cert files, e.g. for installations outside of kubernetes
+	FileSdsEnabled           bool   `split_words:"true"`
+	FileSdsCertFile          string `split_words:"true"`
+	FileSdsKeyFile           string `split_words:"true"`
+	FileSdsCaFile            string `split_words:"true"` // optional
+	FileSdsServerCert        string `split_words:"true" default:"server_cert"`
+	FileSdsValidationContext string `split_words:"true" default:"validation_context"`
+
+	// issues certs from Vault's PKI secrets engine and renews them before they expire. The vault client is
+	// configured with the standard VAULT_ADDR, VAULT_TOKEN and VAULT_CACERT env vars
+	VaultSdsEnabled           bool          `split_words:"true"`
+	VaultSdsPkiMount          string        `split_words:"true" default:"pki"`
+	VaultSdsRole              string        `split_words:"true"`
+	VaultSdsCommonName        string        `split_words:"true"`
+	VaultSdsAltNames          []string      `split_words:"true"`
+	VaultSdsTtl               time.Duration `split_words:"true"` // optional, the role's ttl is used without it
+	VaultSdsCertDir           string        `split_words:"true" default:"/etc/envoy/vault-certs/"`
+	VaultSdsServerCert        string        `split_words:"true" default:"server_cert"`
+	VaultSdsValidationContext string        `split_words:"true" default:"validation_context"`
+
+	// the secret files are polled in addition to being watched, 0 disables polling
+	SdsPollInterval time.Duration `split_words:"true" default:"30s"`
 }
 
 func main() {
@@ -51,6 +78,8 @@ func main() {
 		"config loaded",
 		zap.Bool("glooMtlsSdsEnabled", c.GlooMtlsSdsEnabled),
 		zap.Bool("istioMtlsSdsEnabled", c.IstioMtlsSdsEnabled),
+		zap.Bool("fileSdsEnabled", c.FileSdsEnabled),
+		zap.Bool("vaultSdsEnabled", c.VaultSdsEnabled),
 	)
 
 	secrets := []server.Secret{}
@@ -76,18 +105,47 @@ func main() {
 		secrets = append(secrets, glooMtlsSecret)
 	}
 
+	if c.FileSdsEnabled {
+		fileSecret := server.Secret{
+			ServerCert:        c.FileSdsServerCert,
+			ValidationContext: c.FileSdsValidationContext,
+			SslCaFile:         c.FileSdsCaFile,
+			SslCertFile:       c.FileSdsCertFile,
+			SslKeyFile:        c.FileSdsKeyFile,
+		}
+		secrets = append(secrets, fileSecret)
+	}
+
+	if c.VaultSdsEnabled {
+		vaultSecret := server.Secret{
+			ServerCert:        c.VaultSdsServerCert,
+			ValidationContext: c.VaultSdsValidationContext,
+			SslCaFile:         filepath.Join(c.VaultSdsCertDir, "ca.crt"),
+			SslCertFile:       filepath.Join(c.VaultSdsCertDir, "tls.crt"),
+			SslKeyFile:        filepath.Join(c.VaultSdsCertDir, "tls.key"),
+		}
+		if err := startVaultIssuer(ctx, c, vaultSecret); err != nil {
+			contextutils.LoggerFrom(ctx).Fatal(err)
+		}
+		secrets = append(secrets, vaultSecret)
+	}
+
 	contextutils.LoggerFrom(ctx).Info("checking for existence of secrets")
 
 	for _, s := range secrets {
 		// Check to see if files exist first to avoid crashloops
-		if err := checkFilesExist([]string{s.SslKeyFile, s.SslCertFile, s.SslCaFile}); err != nil {
+		files := []string{s.SslKeyFile, s.SslCertFile}
+		if s.SslCaFile != "" {
+			files = append(files, s.SslCaFile)
+		}
+		if err := checkFilesExist(files); err != nil {
 			contextutils.LoggerFrom(ctx).Fatal(err)
 		}
 	}
 
 	contextutils.LoggerFrom(ctx).Info("secrets confirmed present, proceeding to start SDS server")
 
-	if err := run.Run(ctx, secrets, c.SdsClient, c.SdsServerAddress); err != nil {
+	if err := run.Run(ctx, secrets, c.SdsClient, c.SdsServerAddress, c.SdsPollInterval); err != nil {
 		contextutils.LoggerFrom(ctx).Fatal(err)
 	}
 }
@@ -105,13 +163,56 @@ func setup(ctx context.Context) Config {
 	}
 
 	// At least one must be enabled, otherwise we have nothing to do.
-	if !c.GlooMtlsSdsEnabled && !c.IstioMtlsSdsEnabled {
-		err := fmt.Errorf("at least one of Istio Cert rotation or Gloo Cert rotation must be enabled, using env vars GLOO_MTLS_SDS_ENABLED or ISTIO_MTLS_SDS_ENABLED")
+	if !c.GlooMtlsSdsEnabled && !c.IstioMtlsSdsEnabled && !c.FileSdsEnabled && !c.VaultSdsEnabled {
+		err := fmt.Errorf("at least one of Istio Cert rotation, Gloo Cert rotation, File Cert rotation or Vault Cert rotation must be enabled, using env vars GLOO_MTLS_SDS_ENABLED, ISTIO_MTLS_SDS_ENABLED, FILE_SDS_ENABLED or VAULT_SDS_ENABLED")
+		contextutils.LoggerFrom(ctx).Fatal(err)
+	}
+
+	if c.FileSdsEnabled && (c.FileSdsCertFile == "" || c.FileSdsKeyFile == "") {
+		err := fmt.Errorf("File Cert rotation requires the cert and key files, using env vars FILE_SDS_CERT_FILE and FILE_SDS_KEY_FILE")
+		contextutils.LoggerFrom(ctx).Fatal(err)
+	}
+
+	if c.VaultSdsEnabled && (c.VaultSdsRole == "" || c.VaultSdsCommonName == "") {
+		err := fmt.Errorf("Vault Cert rotation requires the PKI role and common name, using env vars VAULT_SDS_ROLE and VAULT_SDS_COMMON_NAME")
 		contextutils.LoggerFrom(ctx).Fatal(err)
 	}
 	return c
 }
 
+// startVaultIssuer issues the first cert from vault, so that the files exist
+// when the SDS server starts, and renews it in the background
+func startVaultIssuer(ctx context.Context, c Config, secret server.Secret) error {
+	if err := os.MkdirAll(c.VaultSdsCertDir, 0700); err != nil {
+		return err
+	}
+	client, err := vaultapi.NewClient(vaultapi.DefaultConfig())
+	if err != nil {
+		return err
+	}
+	issuer := vault.NewIssuer(client, vault.PkiConfig{
+		Mount:      c.VaultSdsPkiMount,
+		Role:       c.VaultSdsRole,
+		CommonName: c.VaultSdsCommonName,
+		AltNames:   c.VaultSdsAltNames,
+		Ttl:        c.VaultSdsTtl,
+	}, secret)
+
+	var renewAt time.Time
+	err = retry.Do(
+		func() error {
+			renewAt, err = issuer.Issue(ctx)
+			return err
+		},
+		retry.Attempts(8), // Exponential backoff over ~13s, vault may still be starting up
+	)
+	if err != nil {
+		return err
+	}
+	go issuer.Run(ctx, renewAt)
+	return nil
+}
+
 // determineSdsClient checks POD_NAME or POD_NAMESPACE
 // environment vars to try and figure out the NodeID,
 // otherwise returns the default "sds_client"
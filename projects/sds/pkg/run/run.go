@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 	"time"
 
@@ -14,7 +15,10 @@ import (
 	"github.com/solo-io/go-utils/contextutils"
 )
 
-func Run(ctx context.Context, secrets []server.Secret, sdsClient, sdsServerAddress string) error {
+// Run serves the secrets until SIGINT or SIGTERM. The secret files are watched with inotify, and also polled
+// every pollInterval (if it is positive) for the filesystems that do not support inotify, e.g. network file
+// systems and docker bind mounts.
+func Run(ctx context.Context, secrets []server.Secret, sdsClient, sdsServerAddress string, pollInterval time.Duration) error {
 	ctx, cancel := context.WithCancel(ctx)
 
 	// Set up the gRPC server
@@ -33,13 +37,28 @@ func Run(ctx context.Context, secrets []server.Secret, sdsClient, sdsServerAddre
 		return err
 	}
 
-	// create a new file watcher
+	// create a new file watcher, falling back to polling if the system runs out of inotify instances
+	var events <-chan fsnotify.Event
+	var watchErrors <-chan error
 	watcher, err := fsnotify.NewWatcher()
 	if err != nil {
-		cancel()
-		return err
+		if pollInterval <= 0 {
+			cancel()
+			return err
+		}
+		contextutils.LoggerFrom(ctx).Warnw("Could not create file watcher, polling the secret files instead", zap.Error(err))
+	} else {
+		defer watcher.Close()
+		events = watcher.Events
+		watchErrors = watcher.Errors
+	}
+
+	var poll <-chan time.Time
+	if pollInterval > 0 {
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		poll = ticker.C
 	}
-	defer watcher.Close()
 
 	// Wire in signal handling
 	sigs := make(chan os.Signal, 1)
@@ -49,19 +68,24 @@ func Run(ctx context.Context, secrets []server.Secret, sdsClient, sdsServerAddre
 		for {
 			select {
 			// watch for events
-			case event := <-watcher.Events:
+			case event := <-events:
 				contextutils.LoggerFrom(ctx).Infow("received event", zap.Any("event", event))
-				sdsServer.UpdateSDSConfig(ctx)
+				updateSDSConfig(ctx, sdsServer)
 				watchFiles(ctx, watcher, secrets)
 			// watch for errors
-			case err := <-watcher.Errors:
+			case err := <-watchErrors:
 				contextutils.LoggerFrom(ctx).Warnw("Received error from file watcher", zap.Error(err))
+			// the server only pushes a new snapshot if the files changed
+			case <-poll:
+				updateSDSConfig(ctx, sdsServer)
 			case <-ctx.Done():
 				return
 			}
 		}
 	}()
-	watchFiles(ctx, watcher, secrets)
+	if watcher != nil {
+		watchFiles(ctx, watcher, secrets)
+	}
 
 	<-sigs
 	cancel()
@@ -73,17 +97,26 @@ func Run(ctx context.Context, secrets []server.Secret, sdsClient, sdsServerAddre
 	}
 }
 
+func updateSDSConfig(ctx context.Context, sdsServer *server.Server) {
+	if err := sdsServer.UpdateSDSConfig(ctx); err != nil {
+		contextutils.LoggerFrom(ctx).Warnw("Failed to update SDS config", zap.Error(err))
+	}
+}
+
 func watchFiles(ctx context.Context, watcher *fsnotify.Watcher, secrets []server.Secret) {
 	for _, s := range secrets {
 		contextutils.LoggerFrom(ctx).Infow("watcher started", zap.String("sslKeyFile", s.SslKeyFile), zap.String("sshCertFile", s.SslCertFile), zap.String("sslCaFile", s.SslCaFile))
-		if err := watcher.Add(s.SslKeyFile); err != nil {
-			contextutils.LoggerFrom(ctx).Warn(zap.Error(err))
-		}
-		if err := watcher.Add(s.SslCertFile); err != nil {
-			contextutils.LoggerFrom(ctx).Warn(zap.Error(err))
-		}
-		if err := watcher.Add(s.SslCaFile); err != nil {
-			contextutils.LoggerFrom(ctx).Warn(zap.Error(err))
+		for _, file := range []string{s.SslKeyFile, s.SslCertFile, s.SslCaFile} {
+			if file == "" {
+				continue
+			}
+			if err := watcher.Add(file); err != nil {
+				contextutils.LoggerFrom(ctx).Warn(zap.Error(err))
+			}
+			// files replaced with a rename (e.g. by certbot or an editor) are only seen by a watch on their directory
+			if err := watcher.Add(filepath.Dir(file)); err != nil {
+				contextutils.LoggerFrom(ctx).Warn(zap.Error(err))
+			}
 		}
 	}
 }
@@ -2,6 +2,7 @@ package run_test
 
 import (
 	"context"
+	"encoding/pem"
 	"os"
 	"path"
 	"time"
@@ -73,7 +74,7 @@ var _ = Describe("SDS Server E2E Test", func() {
 	It("runs and stops correctly", func() {
 		ctx, cancel := context.WithCancel(context.Background())
 		go func() {
-			if err := run.Run(ctx, []server.Secret{secret}, sdsClient, testServerAddress); err != nil {
+			if err := run.Run(ctx, []server.Secret{secret}, sdsClient, testServerAddress, 0); err != nil {
 				Expect(err).To(BeNil())
 			}
 		}()
@@ -104,7 +105,7 @@ var _ = Describe("SDS Server E2E Test", func() {
 
 	It("correctly picks up multiple cert rotations", func() {
 
-		go run.Run(context.Background(), []server.Secret{secret}, sdsClient, testServerAddress)
+		go run.Run(context.Background(), []server.Secret{secret}, sdsClient, testServerAddress, 0)
 
 		// Give it a second to spin up + read the files
 		time.Sleep(1 * time.Second)
@@ -174,4 +175,47 @@ var _ = Describe("SDS Server E2E Test", func() {
 			return resp.VersionInfo == snapshotVersion
 		}, "15s", "1s").Should(BeTrue())
 	})
+
+	It("picks up cert files that are replaced with a rename", func() {
+		// Valid PEM blocks are read without retries
+		pemBlock := func(content string) []byte {
+			return pem.EncodeToMemory(&pem.Block{Type: "TEST", Bytes: []byte(content)})
+		}
+		for _, file := range []string{keyName, certName, caName} {
+			err = afero.WriteFile(fs, file, pemBlock(file), 0644)
+			Expect(err).To(BeNil())
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		address := "127.0.0.1:8237"
+		go run.Run(ctx, []server.Secret{secret}, sdsClient, address, time.Second)
+
+		conn, err := grpc.Dial(address, grpc.WithInsecure())
+		Expect(err).To(BeNil())
+		defer conn.Close()
+		client := envoy_service_discovery_v2.NewSecretDiscoveryServiceClient(conn)
+
+		Eventually(func() bool {
+			_, err = client.FetchSecrets(context.TODO(), &envoy_api_v2.DiscoveryRequest{})
+			return err == nil
+		}, "5s", "1s").Should(BeTrue())
+
+		// Write the new cert next to the old one, then move it in place
+		newKeyName := path.Join(dir, "/", "tls.key.new")
+		err = afero.WriteFile(fs, newKeyName, pemBlock("tls.key-rename"), 0644)
+		Expect(err).To(BeNil())
+		err = os.Rename(newKeyName, keyName)
+		Expect(err).To(BeNil())
+
+		certs, err := testutils.FilesToBytes(keyName, certName, caName)
+		Expect(err).NotTo(HaveOccurred())
+		snapshotVersion, err := server.GetSnapshotVersion(certs)
+		Expect(err).To(BeNil())
+		Eventually(func() string {
+			resp, err := client.FetchSecrets(context.TODO(), &envoy_api_v2.DiscoveryRequest{})
+			Expect(err).To(BeNil())
+			return resp.VersionInfo
+		}, "15s", "1s").Should(Equal(snapshotVersion))
+	})
 })
@@ -29,7 +29,7 @@ var (
 
 // Secret represents an envoy auth secret
 type Secret struct {
-	SslCaFile         string
+	SslCaFile         string // optional, no validation context is served without it
 	SslKeyFile        string
 	SslCertFile       string
 	ServerCert        string // name of a tls_certificate_sds_secret_config
@@ -38,11 +38,12 @@ type Secret struct {
 
 // Server is the SDS server. Holds config & secrets.
 type Server struct {
-	secrets       []Secret
-	sdsClient     string
-	grpcServer    *grpc.Server
-	address       string
-	snapshotCache cache.SnapshotCache
+	secrets         []Secret
+	sdsClient       string
+	grpcServer      *grpc.Server
+	address         string
+	snapshotCache   cache.SnapshotCache
+	snapshotVersion string
 }
 
 // ID needed for snapshotCache
@@ -106,12 +107,15 @@ func (s *Server) UpdateSDSConfig(ctx context.Context) error {
 			return err
 		}
 		certs = append(certs, certChain)
+		items = append(items, serverCertSecret(key, certChain, sec.ServerCert))
+		if sec.SslCaFile == "" {
+			continue
+		}
 		ca, err := readAndVerifyCert(sec.SslCaFile)
 		if err != nil {
 			return err
 		}
 		certs = append(certs, ca)
-		items = append(items, serverCertSecret(key, certChain, sec.ServerCert))
 		items = append(items, validationContextSecret(ca, sec.ValidationContext))
 	}
 
@@ -120,11 +124,20 @@ func (s *Server) UpdateSDSConfig(ctx context.Context) error {
 		contextutils.LoggerFrom(ctx).Info("Error getting snapshot version", zap.Error(err))
 		return err
 	}
+	// the files are also polled, so most updates do not change anything
+	if snapshotVersion == s.snapshotVersion {
+		contextutils.LoggerFrom(ctx).Debugf("SDS config is up to date. Snapshot version is %s", snapshotVersion)
+		return nil
+	}
 	contextutils.LoggerFrom(ctx).Infof("Updating SDS config. sdsClient is %s. Snapshot version is %s", s.sdsClient, snapshotVersion)
 
 	secretSnapshot := cache.Snapshot{}
 	secretSnapshot.Resources[cache_types.Secret] = cache.NewResources(snapshotVersion, items)
-	return s.snapshotCache.SetSnapshot(s.sdsClient, secretSnapshot)
+	if err := s.snapshotCache.SetSnapshot(s.sdsClient, secretSnapshot); err != nil {
+		return err
+	}
+	s.snapshotVersion = snapshotVersion
+	return nil
 }
 
 // GetSnapshotVersion generates a version string by hashing the certs
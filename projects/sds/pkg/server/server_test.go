@@ -121,4 +121,25 @@ var _ = Describe("SDS Server", func() {
 			Expect(resp.Validate()).To(BeNil())
 		})
 	})
+
+	It("serves only the server cert without a ca file", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		srv = server.SetupEnvoySDS([]server.Secret{{
+			ServerCert:  "test-server",
+			SslCertFile: certFile.Name(),
+			SslKeyFile:  keyFile.Name(),
+		}}, sdsClient, "127.0.0.1:8889")
+		_, err = srv.Run(ctx)
+		Expect(err).To(BeNil())
+		Expect(srv.UpdateSDSConfig(ctx)).To(Succeed())
+
+		conn, err := grpc.Dial("127.0.0.1:8889", grpc.WithInsecure())
+		Expect(err).To(BeNil())
+		defer conn.Close()
+		client := envoy_service_discovery_v2.NewSecretDiscoveryServiceClient(conn)
+		resp, err := client.FetchSecrets(ctx, &envoy_api_v2.DiscoveryRequest{})
+		Expect(err).To(BeNil())
+		Expect(resp.GetResources()).To(HaveLen(1))
+	})
 })
@@ -0,0 +1,190 @@
+package vault
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/sds/pkg/server"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
+)
+
+var (
+	// certs are renewed once this fraction of their lifetime has elapsed
+	renewalFraction = 2.0 / 3.0
+
+	// how long to wait before retrying a failed issue request
+	retryInterval = 30 * time.Second
+
+	MissingFieldError = func(field string) error {
+		return errors.Errorf("vault response is missing %v", field)
+	}
+)
+
+// PkiConfig selects the Vault PKI role that the leaf certificates are issued from
+type PkiConfig struct {
+	Mount      string // path the PKI secrets engine is mounted at, e.g. "pki"
+	Role       string
+	CommonName string
+	AltNames   []string
+	Ttl        time.Duration // optional, the role's ttl is used without it
+}
+
+// Issuer requests leaf certificates from Vault's PKI secrets engine and writes them to the files of
+// the secret. The SDS server watches the files, so renewed certificates are pushed to envoy.
+type Issuer struct {
+	client *api.Client
+	config PkiConfig
+	secret server.Secret
+}
+
+// NewIssuer creates an Issuer that writes the issued certificate chain, private key and CA to the
+// SslCertFile, SslKeyFile and SslCaFile (if set) of the given secret
+func NewIssuer(client *api.Client, config PkiConfig, secret server.Secret) *Issuer {
+	return &Issuer{
+		client: client,
+		config: config,
+		secret: secret,
+	}
+}
+
+// Issue requests a new certificate and writes it to the secret files. It returns the time at which
+// the certificate should be renewed.
+func (i *Issuer) Issue(ctx context.Context) (time.Time, error) {
+	data := map[string]interface{}{
+		"common_name": i.config.CommonName,
+	}
+	if len(i.config.AltNames) > 0 {
+		data["alt_names"] = strings.Join(i.config.AltNames, ",")
+	}
+	if i.config.Ttl > 0 {
+		data["ttl"] = i.config.Ttl.String()
+	}
+	path := strings.Trim(i.config.Mount, "/") + "/issue/" + i.config.Role
+	resp, err := i.client.Logical().Write(path, data)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "issuing certificate from %v", path)
+	}
+	if resp == nil {
+		return time.Time{}, MissingFieldError("data")
+	}
+
+	cert, err := stringField(resp, "certificate")
+	if err != nil {
+		return time.Time{}, err
+	}
+	key, err := stringField(resp, "private_key")
+	if err != nil {
+		return time.Time{}, err
+	}
+	ca, err := stringField(resp, "issuing_ca")
+	if err != nil {
+		return time.Time{}, err
+	}
+	chain := []string{cert}
+	if caChain, ok := resp.Data["ca_chain"].([]interface{}); ok && len(caChain) > 0 {
+		for _, c := range caChain {
+			if s, ok := c.(string); ok {
+				chain = append(chain, s)
+			}
+		}
+	} else {
+		chain = append(chain, ca)
+	}
+
+	renewAt, err := renewalTime(cert)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// envoy rejects the secret while the key and cert do not match, and keeps serving the old one
+	if err := writeFile(i.secret.SslKeyFile, key, 0600); err != nil {
+		return time.Time{}, err
+	}
+	if err := writeFile(i.secret.SslCertFile, strings.Join(chain, "\n"), 0644); err != nil {
+		return time.Time{}, err
+	}
+	if i.secret.SslCaFile != "" {
+		if err := writeFile(i.secret.SslCaFile, ca, 0644); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	contextutils.LoggerFrom(ctx).Infow("issued certificate from vault",
+		zap.String("path", path),
+		zap.Any("serialNumber", resp.Data["serial_number"]),
+		zap.Time("renewAt", renewAt))
+	return renewAt, nil
+}
+
+// Run renews the certificate until the context is cancelled, starting at renewAt. Failed requests
+// are retried every 30 seconds.
+func (i *Issuer) Run(ctx context.Context, renewAt time.Time) {
+	for {
+		timer := time.NewTimer(time.Until(renewAt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		next, err := i.Issue(ctx)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Warnw("Failed to renew certificate from vault", zap.Error(err))
+			renewAt = time.Now().Add(retryInterval)
+			continue
+		}
+		renewAt = next
+	}
+}
+
+func stringField(resp *api.Secret, field string) (string, error) {
+	value, ok := resp.Data[field].(string)
+	if !ok || value == "" {
+		return "", MissingFieldError(field)
+	}
+	return value, nil
+}
+
+// renewalTime returns the time at which renewalFraction of the certificate's lifetime has elapsed
+func renewalTime(certPem string) (time.Time, error) {
+	block, _ := pem.Decode([]byte(certPem))
+	if block == nil {
+		return time.Time{}, errors.Errorf("failed to decode certificate issued by vault")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "parsing certificate issued by vault")
+	}
+	lifetime := cert.NotAfter.Sub(cert.NotBefore)
+	return cert.NotBefore.Add(time.Duration(float64(lifetime) * renewalFraction)), nil
+}
+
+// writeFile replaces the file with a rename, so that it is never read half-written
+func writeFile(file, content string, perm os.FileMode) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(file), "."+filepath.Base(file))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.WriteString(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), file)
+}
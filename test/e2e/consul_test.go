@@ -77,7 +77,7 @@ var _ = Describe("Consul e2e", func() {
 		Expect(err).NotTo(HaveOccurred())
 
 		// Start Gloo
-		consulClient, err := consul.NewConsulWatcher(client, nil)
+		consulClient, err := consul.NewConsulWatcher(client, nil, nil)
 		Expect(err).NotTo(HaveOccurred())
 
 		ro := &services.RunOptions{
@@ -162,6 +162,23 @@ var _ = Describe("Rate Limit", func() {
 
 			ConsistentlyNotRateLimited("host1", envoyPort)
 		})
+
+		It("shouldn't rate limit routes which disable the virtual host rate limits", func() {
+			srv = startSimpleRateLimitServer(false, rlPort)
+
+			hosts := map[string]bool{"host1": true}
+			proxy := getProxy(envoyPort, testUpstream.Upstream.Metadata.Ref(), hosts)
+			// envoy skips the rate limit the route opts out with, as its descriptor is never generated
+			proxy.GetListeners()[0].GetHttpListener().GetVirtualHosts()[0].GetRoutes()[0].Options = &gloov1.RouteOptions{
+				RateLimitConfigType: &gloov1.RouteOptions_Ratelimit{
+					Ratelimit: &ratelimit.RateLimitRouteExtension{DisableVhRateLimits: true},
+				},
+			}
+			_, err := testClients.ProxyClient.Write(proxy, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+
+			ConsistentlyNotRateLimited("host1", envoyPort)
+		})
 	})
 })
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
 
+	consulplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
 
 	"github.com/solo-io/solo-kit/test/helpers"
@@ -192,13 +193,15 @@ func defaultTestConstructOpts(ctx context.Context, runOptions *RunOptions) trans
 
 	meta := runOptions.Settings.GetMetadata()
 	return translator.Opts{
-		GlooNamespace:   meta.GetNamespace(),
-		WriteNamespace:  runOptions.NsToWrite,
-		WatchNamespaces: runOptions.NsToWatch,
-		Gateways:        f,
-		VirtualServices: f,
-		RouteTables:     f,
-		Proxies:         f,
+		GlooNamespace:      meta.GetNamespace(),
+		WriteNamespace:     runOptions.NsToWrite,
+		WatchNamespaces:    runOptions.NsToWatch,
+		Gateways:           f,
+		VirtualServices:    f,
+		RouteTables:        f,
+		VirtualHostOptions: f,
+		RouteOptions:       f,
+		Proxies:            f,
 		WatchOpts: clients.WatchOpts{
 			Ctx:         ctx,
 			RefreshRate: time.Minute,
@@ -270,6 +273,7 @@ func defaultGlooOpts(ctx context.Context, runOptions *RunOptions) bootstrap.Opts
 		Consul: bootstrap.Consul{
 			ConsulWatcher: runOptions.ConsulClient,
 			DnsServer:     runOptions.ConsulDnsAddress,
+			DnsResolvers:  consulplugin.NewDnsResolvers(runOptions.ConsulDnsAddress),
 		},
 	}
 }